@@ -0,0 +1,2080 @@
+// The protocol of the cluster-autoscaler externalgrpc cloud provider, served by Omni.
+//
+// It is wire compatible with cluster-autoscaler/cloudprovider/externalgrpc/protos/externalgrpc.proto:
+// the package, the service and the field numbers are kept as is, and the fields carrying the Kubernetes
+// API types are declared as bytes, which is the same encoding on the wire. Omni doesn't implement
+// the methods which take or return them.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.31.1
+// source: omni/autoscaler/externalgrpc.proto
+
+package autoscaler
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type InstanceStatus_InstanceState int32
+
+const (
+	// An unknown instance state.
+	InstanceStatus_unspecified InstanceStatus_InstanceState = 0
+	// InstanceRunning means instance is running.
+	InstanceStatus_instanceRunning InstanceStatus_InstanceState = 1
+	// InstanceCreating means instance is being created.
+	InstanceStatus_instanceCreating InstanceStatus_InstanceState = 2
+	// InstanceDeleting means instance is being deleted.
+	InstanceStatus_instanceDeleting InstanceStatus_InstanceState = 3
+)
+
+// Enum value maps for InstanceStatus_InstanceState.
+var (
+	InstanceStatus_InstanceState_name = map[int32]string{
+		0: "unspecified",
+		1: "instanceRunning",
+		2: "instanceCreating",
+		3: "instanceDeleting",
+	}
+	InstanceStatus_InstanceState_value = map[string]int32{
+		"unspecified":      0,
+		"instanceRunning":  1,
+		"instanceCreating": 2,
+		"instanceDeleting": 3,
+	}
+)
+
+func (x InstanceStatus_InstanceState) Enum() *InstanceStatus_InstanceState {
+	p := new(InstanceStatus_InstanceState)
+	*p = x
+	return p
+}
+
+func (x InstanceStatus_InstanceState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InstanceStatus_InstanceState) Descriptor() protoreflect.EnumDescriptor {
+	return file_omni_autoscaler_externalgrpc_proto_enumTypes[0].Descriptor()
+}
+
+func (InstanceStatus_InstanceState) Type() protoreflect.EnumType {
+	return &file_omni_autoscaler_externalgrpc_proto_enumTypes[0]
+}
+
+func (x InstanceStatus_InstanceState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InstanceStatus_InstanceState.Descriptor instead.
+func (InstanceStatus_InstanceState) EnumDescriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{29, 0}
+}
+
+type NodeGroup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the node group on the cloud provider.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// MinSize of the node group on the cloud provider.
+	MinSize int32 `protobuf:"varint,2,opt,name=minSize,proto3" json:"minSize,omitempty"`
+	// MaxSize of the node group on the cloud provider.
+	MaxSize int32 `protobuf:"varint,3,opt,name=maxSize,proto3" json:"maxSize,omitempty"`
+	// Debug returns a string containing all information regarding this node group.
+	Debug         string `protobuf:"bytes,4,opt,name=debug,proto3" json:"debug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeGroup) Reset() {
+	*x = NodeGroup{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeGroup) ProtoMessage() {}
+
+func (x *NodeGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeGroup.ProtoReflect.Descriptor instead.
+func (*NodeGroup) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NodeGroup) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NodeGroup) GetMinSize() int32 {
+	if x != nil {
+		return x.MinSize
+	}
+	return 0
+}
+
+func (x *NodeGroup) GetMaxSize() int32 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
+func (x *NodeGroup) GetDebug() string {
+	if x != nil {
+		return x.Debug
+	}
+	return ""
+}
+
+type ExternalGrpcNode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the node assigned by the cloud provider in the format: <ProviderName>://<ProviderSpecificNodeID>.
+	ProviderID string `protobuf:"bytes,1,opt,name=providerID,proto3" json:"providerID,omitempty"`
+	// Name of the node assigned by the cloud provider.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Labels of the node.
+	Labels map[string]string `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Annotations of the node.
+	Annotations   map[string]string `protobuf:"bytes,4,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExternalGrpcNode) Reset() {
+	*x = ExternalGrpcNode{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExternalGrpcNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalGrpcNode) ProtoMessage() {}
+
+func (x *ExternalGrpcNode) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalGrpcNode.ProtoReflect.Descriptor instead.
+func (*ExternalGrpcNode) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExternalGrpcNode) GetProviderID() string {
+	if x != nil {
+		return x.ProviderID
+	}
+	return ""
+}
+
+func (x *ExternalGrpcNode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExternalGrpcNode) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *ExternalGrpcNode) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+type NodeGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeGroupsRequest) Reset() {
+	*x = NodeGroupsRequest{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeGroupsRequest) ProtoMessage() {}
+
+func (x *NodeGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeGroupsRequest.ProtoReflect.Descriptor instead.
+func (*NodeGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{2}
+}
+
+type NodeGroupsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// All the node groups that the cloud provider service supports.
+	NodeGroups    []*NodeGroup `protobuf:"bytes,1,rep,name=nodeGroups,proto3" json:"nodeGroups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeGroupsResponse) Reset() {
+	*x = NodeGroupsResponse{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeGroupsResponse) ProtoMessage() {}
+
+func (x *NodeGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeGroupsResponse.ProtoReflect.Descriptor instead.
+func (*NodeGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *NodeGroupsResponse) GetNodeGroups() []*NodeGroup {
+	if x != nil {
+		return x.NodeGroups
+	}
+	return nil
+}
+
+type NodeGroupForNodeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Node for which the request is performed.
+	Node          *ExternalGrpcNode `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeGroupForNodeRequest) Reset() {
+	*x = NodeGroupForNodeRequest{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeGroupForNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeGroupForNodeRequest) ProtoMessage() {}
+
+func (x *NodeGroupForNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeGroupForNodeRequest.ProtoReflect.Descriptor instead.
+func (*NodeGroupForNodeRequest) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NodeGroupForNodeRequest) GetNode() *ExternalGrpcNode {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+type NodeGroupForNodeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Node group for the given node. The node group with id = "" means no node group.
+	NodeGroup     *NodeGroup `protobuf:"bytes,1,opt,name=nodeGroup,proto3" json:"nodeGroup,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeGroupForNodeResponse) Reset() {
+	*x = NodeGroupForNodeResponse{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeGroupForNodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeGroupForNodeResponse) ProtoMessage() {}
+
+func (x *NodeGroupForNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeGroupForNodeResponse.ProtoReflect.Descriptor instead.
+func (*NodeGroupForNodeResponse) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *NodeGroupForNodeResponse) GetNodeGroup() *NodeGroup {
+	if x != nil {
+		return x.NodeGroup
+	}
+	return nil
+}
+
+type PricingNodePriceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Node for which the request is performed.
+	Node *ExternalGrpcNode `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	// Start time for the request period, k8s.io.apimachinery.pkg.apis.meta.v1.Time.
+	StartTime []byte `protobuf:"bytes,2,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	// End time for the request period, k8s.io.apimachinery.pkg.apis.meta.v1.Time.
+	EndTime       []byte `protobuf:"bytes,3,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PricingNodePriceRequest) Reset() {
+	*x = PricingNodePriceRequest{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PricingNodePriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PricingNodePriceRequest) ProtoMessage() {}
+
+func (x *PricingNodePriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PricingNodePriceRequest.ProtoReflect.Descriptor instead.
+func (*PricingNodePriceRequest) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PricingNodePriceRequest) GetNode() *ExternalGrpcNode {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *PricingNodePriceRequest) GetStartTime() []byte {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *PricingNodePriceRequest) GetEndTime() []byte {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type PricingNodePriceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Theoretical minimum price of running a node for a given period.
+	Price         float64 `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PricingNodePriceResponse) Reset() {
+	*x = PricingNodePriceResponse{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PricingNodePriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PricingNodePriceResponse) ProtoMessage() {}
+
+func (x *PricingNodePriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PricingNodePriceResponse.ProtoReflect.Descriptor instead.
+func (*PricingNodePriceResponse) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PricingNodePriceResponse) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type PricingPodPriceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Pod for which the request is performed, k8s.io.api.core.v1.Pod.
+	Pod []byte `protobuf:"bytes,1,opt,name=pod,proto3" json:"pod,omitempty"`
+	// Start time for the request period, k8s.io.apimachinery.pkg.apis.meta.v1.Time.
+	StartTime []byte `protobuf:"bytes,2,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	// End time for the request period, k8s.io.apimachinery.pkg.apis.meta.v1.Time.
+	EndTime       []byte `protobuf:"bytes,3,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PricingPodPriceRequest) Reset() {
+	*x = PricingPodPriceRequest{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PricingPodPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PricingPodPriceRequest) ProtoMessage() {}
+
+func (x *PricingPodPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PricingPodPriceRequest.ProtoReflect.Descriptor instead.
+func (*PricingPodPriceRequest) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PricingPodPriceRequest) GetPod() []byte {
+	if x != nil {
+		return x.Pod
+	}
+	return nil
+}
+
+func (x *PricingPodPriceRequest) GetStartTime() []byte {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *PricingPodPriceRequest) GetEndTime() []byte {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type PricingPodPriceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Theoretical minimum price of running a pod for a given period.
+	Price         float64 `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PricingPodPriceResponse) Reset() {
+	*x = PricingPodPriceResponse{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PricingPodPriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PricingPodPriceResponse) ProtoMessage() {}
+
+func (x *PricingPodPriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PricingPodPriceResponse.ProtoReflect.Descriptor instead.
+func (*PricingPodPriceResponse) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PricingPodPriceResponse) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type GPULabelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GPULabelRequest) Reset() {
+	*x = GPULabelRequest{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GPULabelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GPULabelRequest) ProtoMessage() {}
+
+func (x *GPULabelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GPULabelRequest.ProtoReflect.Descriptor instead.
+func (*GPULabelRequest) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{10}
+}
+
+type GPULabelResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Label added to nodes with GPU resource.
+	Label         string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GPULabelResponse) Reset() {
+	*x = GPULabelResponse{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GPULabelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GPULabelResponse) ProtoMessage() {}
+
+func (x *GPULabelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GPULabelResponse.ProtoReflect.Descriptor instead.
+func (*GPULabelResponse) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GPULabelResponse) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type GetAvailableGPUTypesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAvailableGPUTypesRequest) Reset() {
+	*x = GetAvailableGPUTypesRequest{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailableGPUTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailableGPUTypesRequest) ProtoMessage() {}
+
+func (x *GetAvailableGPUTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailableGPUTypesRequest.ProtoReflect.Descriptor instead.
+func (*GetAvailableGPUTypesRequest) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{12}
+}
+
+type GetAvailableGPUTypesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// GPU types passed in as opaque key-value pairs.
+	GpuTypes      map[string]*anypb.Any `protobuf:"bytes,1,rep,name=gpuTypes,proto3" json:"gpuTypes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAvailableGPUTypesResponse) Reset() {
+	*x = GetAvailableGPUTypesResponse{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailableGPUTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailableGPUTypesResponse) ProtoMessage() {}
+
+func (x *GetAvailableGPUTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailableGPUTypesResponse.ProtoReflect.Descriptor instead.
+func (*GetAvailableGPUTypesResponse) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetAvailableGPUTypesResponse) GetGpuTypes() map[string]*anypb.Any {
+	if x != nil {
+		return x.GpuTypes
+	}
+	return nil
+}
+
+type CleanupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CleanupRequest) Reset() {
+	*x = CleanupRequest{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CleanupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CleanupRequest) ProtoMessage() {}
+
+func (x *CleanupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CleanupRequest.ProtoReflect.Descriptor instead.
+func (*CleanupRequest) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{14}
+}
+
+type CleanupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CleanupResponse) Reset() {
+	*x = CleanupResponse{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CleanupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CleanupResponse) ProtoMessage() {}
+
+func (x *CleanupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CleanupResponse.ProtoReflect.Descriptor instead.
+func (*CleanupResponse) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{15}
+}
+
+type RefreshRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshRequest) Reset() {
+	*x = RefreshRequest{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshRequest) ProtoMessage() {}
+
+func (x *RefreshRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshRequest.ProtoReflect.Descriptor instead.
+func (*RefreshRequest) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{16}
+}
+
+type RefreshResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshResponse) Reset() {
+	*x = RefreshResponse{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshResponse) ProtoMessage() {}
+
+func (x *RefreshResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshResponse.ProtoReflect.Descriptor instead.
+func (*RefreshResponse) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{17}
+}
+
+type NodeGroupTargetSizeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the node group for the request.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeGroupTargetSizeRequest) Reset() {
+	*x = NodeGroupTargetSizeRequest{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeGroupTargetSizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeGroupTargetSizeRequest) ProtoMessage() {}
+
+func (x *NodeGroupTargetSizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeGroupTargetSizeRequest.ProtoReflect.Descriptor instead.
+func (*NodeGroupTargetSizeRequest) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *NodeGroupTargetSizeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type NodeGroupTargetSizeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Current target size of the node group.
+	TargetSize    int32 `protobuf:"varint,1,opt,name=targetSize,proto3" json:"targetSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeGroupTargetSizeResponse) Reset() {
+	*x = NodeGroupTargetSizeResponse{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeGroupTargetSizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeGroupTargetSizeResponse) ProtoMessage() {}
+
+func (x *NodeGroupTargetSizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeGroupTargetSizeResponse.ProtoReflect.Descriptor instead.
+func (*NodeGroupTargetSizeResponse) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *NodeGroupTargetSizeResponse) GetTargetSize() int32 {
+	if x != nil {
+		return x.TargetSize
+	}
+	return 0
+}
+
+type NodeGroupIncreaseSizeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of nodes to add.
+	Delta int32 `protobuf:"varint,1,opt,name=delta,proto3" json:"delta,omitempty"`
+	// ID of the node group for the request.
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeGroupIncreaseSizeRequest) Reset() {
+	*x = NodeGroupIncreaseSizeRequest{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeGroupIncreaseSizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeGroupIncreaseSizeRequest) ProtoMessage() {}
+
+func (x *NodeGroupIncreaseSizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeGroupIncreaseSizeRequest.ProtoReflect.Descriptor instead.
+func (*NodeGroupIncreaseSizeRequest) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *NodeGroupIncreaseSizeRequest) GetDelta() int32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *NodeGroupIncreaseSizeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type NodeGroupIncreaseSizeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeGroupIncreaseSizeResponse) Reset() {
+	*x = NodeGroupIncreaseSizeResponse{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeGroupIncreaseSizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeGroupIncreaseSizeResponse) ProtoMessage() {}
+
+func (x *NodeGroupIncreaseSizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeGroupIncreaseSizeResponse.ProtoReflect.Descriptor instead.
+func (*NodeGroupIncreaseSizeResponse) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{21}
+}
+
+type NodeGroupDeleteNodesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of nodes to delete.
+	Nodes []*ExternalGrpcNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// ID of the node group for the request.
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeGroupDeleteNodesRequest) Reset() {
+	*x = NodeGroupDeleteNodesRequest{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeGroupDeleteNodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeGroupDeleteNodesRequest) ProtoMessage() {}
+
+func (x *NodeGroupDeleteNodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeGroupDeleteNodesRequest.ProtoReflect.Descriptor instead.
+func (*NodeGroupDeleteNodesRequest) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *NodeGroupDeleteNodesRequest) GetNodes() []*ExternalGrpcNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *NodeGroupDeleteNodesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type NodeGroupDeleteNodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeGroupDeleteNodesResponse) Reset() {
+	*x = NodeGroupDeleteNodesResponse{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeGroupDeleteNodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeGroupDeleteNodesResponse) ProtoMessage() {}
+
+func (x *NodeGroupDeleteNodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeGroupDeleteNodesResponse.ProtoReflect.Descriptor instead.
+func (*NodeGroupDeleteNodesResponse) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{23}
+}
+
+type NodeGroupDecreaseTargetSizeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of nodes to delete, negative.
+	Delta int32 `protobuf:"varint,1,opt,name=delta,proto3" json:"delta,omitempty"`
+	// ID of the node group for the request.
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeGroupDecreaseTargetSizeRequest) Reset() {
+	*x = NodeGroupDecreaseTargetSizeRequest{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeGroupDecreaseTargetSizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeGroupDecreaseTargetSizeRequest) ProtoMessage() {}
+
+func (x *NodeGroupDecreaseTargetSizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeGroupDecreaseTargetSizeRequest.ProtoReflect.Descriptor instead.
+func (*NodeGroupDecreaseTargetSizeRequest) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *NodeGroupDecreaseTargetSizeRequest) GetDelta() int32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *NodeGroupDecreaseTargetSizeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type NodeGroupDecreaseTargetSizeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeGroupDecreaseTargetSizeResponse) Reset() {
+	*x = NodeGroupDecreaseTargetSizeResponse{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeGroupDecreaseTargetSizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeGroupDecreaseTargetSizeResponse) ProtoMessage() {}
+
+func (x *NodeGroupDecreaseTargetSizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeGroupDecreaseTargetSizeResponse.ProtoReflect.Descriptor instead.
+func (*NodeGroupDecreaseTargetSizeResponse) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{25}
+}
+
+type NodeGroupNodesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the node group for the request.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeGroupNodesRequest) Reset() {
+	*x = NodeGroupNodesRequest{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeGroupNodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeGroupNodesRequest) ProtoMessage() {}
+
+func (x *NodeGroupNodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeGroupNodesRequest.ProtoReflect.Descriptor instead.
+func (*NodeGroupNodesRequest) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *NodeGroupNodesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type NodeGroupNodesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of cloud provider instances in a node group.
+	Instances     []*Instance `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeGroupNodesResponse) Reset() {
+	*x = NodeGroupNodesResponse{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeGroupNodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeGroupNodesResponse) ProtoMessage() {}
+
+func (x *NodeGroupNodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeGroupNodesResponse.ProtoReflect.Descriptor instead.
+func (*NodeGroupNodesResponse) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *NodeGroupNodesResponse) GetInstances() []*Instance {
+	if x != nil {
+		return x.Instances
+	}
+	return nil
+}
+
+type Instance struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Id of the instance.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Status of the node.
+	Status        *InstanceStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Instance) Reset() {
+	*x = Instance{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Instance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Instance) ProtoMessage() {}
+
+func (x *Instance) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Instance.ProtoReflect.Descriptor instead.
+func (*Instance) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Instance) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Instance) GetStatus() *InstanceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// InstanceStatus represents the instance status.
+type InstanceStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// InstanceState tells if the instance is running, being created or being deleted.
+	InstanceState InstanceStatus_InstanceState `protobuf:"varint,1,opt,name=instanceState,proto3,enum=clusterautoscaler.cloudprovider.v1.externalgrpc.InstanceStatus_InstanceState" json:"instanceState,omitempty"`
+	// ErrorInfo provides information about the error status.
+	ErrorInfo     *InstanceErrorInfo `protobuf:"bytes,2,opt,name=errorInfo,proto3" json:"errorInfo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceStatus) Reset() {
+	*x = InstanceStatus{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceStatus) ProtoMessage() {}
+
+func (x *InstanceStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceStatus.ProtoReflect.Descriptor instead.
+func (*InstanceStatus) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *InstanceStatus) GetInstanceState() InstanceStatus_InstanceState {
+	if x != nil {
+		return x.InstanceState
+	}
+	return InstanceStatus_unspecified
+}
+
+func (x *InstanceStatus) GetErrorInfo() *InstanceErrorInfo {
+	if x != nil {
+		return x.ErrorInfo
+	}
+	return nil
+}
+
+// InstanceErrorInfo provides information about error condition on instance.
+type InstanceErrorInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ErrorCode is cloud-provider specific error code for error condition.
+	// An empty string means there is no error info for the instance.
+	ErrorCode string `protobuf:"bytes,1,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+	// ErrorMessage is the human readable description of error condition.
+	ErrorMessage string `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	// InstanceErrorClass defines the class of error condition.
+	InstanceErrorClass int32 `protobuf:"varint,3,opt,name=instanceErrorClass,proto3" json:"instanceErrorClass,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *InstanceErrorInfo) Reset() {
+	*x = InstanceErrorInfo{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceErrorInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceErrorInfo) ProtoMessage() {}
+
+func (x *InstanceErrorInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceErrorInfo.ProtoReflect.Descriptor instead.
+func (*InstanceErrorInfo) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *InstanceErrorInfo) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *InstanceErrorInfo) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *InstanceErrorInfo) GetInstanceErrorClass() int32 {
+	if x != nil {
+		return x.InstanceErrorClass
+	}
+	return 0
+}
+
+type NodeGroupTemplateNodeInfoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the node group for the request.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeGroupTemplateNodeInfoRequest) Reset() {
+	*x = NodeGroupTemplateNodeInfoRequest{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeGroupTemplateNodeInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeGroupTemplateNodeInfoRequest) ProtoMessage() {}
+
+func (x *NodeGroupTemplateNodeInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeGroupTemplateNodeInfoRequest.ProtoReflect.Descriptor instead.
+func (*NodeGroupTemplateNodeInfoRequest) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *NodeGroupTemplateNodeInfoRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type NodeGroupTemplateNodeInfoResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// NodeInfo is the node the new nodes of the node group would look like, k8s.io.api.core.v1.Node.
+	NodeInfo      []byte `protobuf:"bytes,1,opt,name=nodeInfo,proto3" json:"nodeInfo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeGroupTemplateNodeInfoResponse) Reset() {
+	*x = NodeGroupTemplateNodeInfoResponse{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeGroupTemplateNodeInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeGroupTemplateNodeInfoResponse) ProtoMessage() {}
+
+func (x *NodeGroupTemplateNodeInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeGroupTemplateNodeInfoResponse.ProtoReflect.Descriptor instead.
+func (*NodeGroupTemplateNodeInfoResponse) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *NodeGroupTemplateNodeInfoResponse) GetNodeInfo() []byte {
+	if x != nil {
+		return x.NodeInfo
+	}
+	return nil
+}
+
+type NodeGroupAutoscalingOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ScaleDownUtilizationThreshold sets threshold for nodes to be considered for scale down.
+	ScaleDownUtilizationThreshold float64 `protobuf:"fixed64,1,opt,name=scaleDownUtilizationThreshold,proto3" json:"scaleDownUtilizationThreshold,omitempty"`
+	// ScaleDownGpuUtilizationThreshold sets threshold for gpu nodes to be considered for scale down.
+	ScaleDownGpuUtilizationThreshold float64 `protobuf:"fixed64,2,opt,name=scaleDownGpuUtilizationThreshold,proto3" json:"scaleDownGpuUtilizationThreshold,omitempty"`
+	// ScaleDownUnneededTime sets the duration a node has to be unneeded before it is scaled down, k8s.io.apimachinery.pkg.apis.meta.v1.Duration.
+	ScaleDownUnneededTime []byte `protobuf:"bytes,3,opt,name=scaleDownUnneededTime,proto3" json:"scaleDownUnneededTime,omitempty"`
+	// ScaleDownUnreadyTime sets the duration an unready node has to be unneeded before it is scaled down, k8s.io.apimachinery.pkg.apis.meta.v1.Duration.
+	ScaleDownUnreadyTime []byte `protobuf:"bytes,4,opt,name=scaleDownUnreadyTime,proto3" json:"scaleDownUnreadyTime,omitempty"`
+	// MaxNodeProvisionTime is the time cluster autoscaler waits for a node to be provisioned, k8s.io.apimachinery.pkg.apis.meta.v1.Duration.
+	MaxNodeProvisionTime []byte `protobuf:"bytes,5,opt,name=MaxNodeProvisionTime,proto3" json:"MaxNodeProvisionTime,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *NodeGroupAutoscalingOptions) Reset() {
+	*x = NodeGroupAutoscalingOptions{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeGroupAutoscalingOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeGroupAutoscalingOptions) ProtoMessage() {}
+
+func (x *NodeGroupAutoscalingOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeGroupAutoscalingOptions.ProtoReflect.Descriptor instead.
+func (*NodeGroupAutoscalingOptions) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *NodeGroupAutoscalingOptions) GetScaleDownUtilizationThreshold() float64 {
+	if x != nil {
+		return x.ScaleDownUtilizationThreshold
+	}
+	return 0
+}
+
+func (x *NodeGroupAutoscalingOptions) GetScaleDownGpuUtilizationThreshold() float64 {
+	if x != nil {
+		return x.ScaleDownGpuUtilizationThreshold
+	}
+	return 0
+}
+
+func (x *NodeGroupAutoscalingOptions) GetScaleDownUnneededTime() []byte {
+	if x != nil {
+		return x.ScaleDownUnneededTime
+	}
+	return nil
+}
+
+func (x *NodeGroupAutoscalingOptions) GetScaleDownUnreadyTime() []byte {
+	if x != nil {
+		return x.ScaleDownUnreadyTime
+	}
+	return nil
+}
+
+func (x *NodeGroupAutoscalingOptions) GetMaxNodeProvisionTime() []byte {
+	if x != nil {
+		return x.MaxNodeProvisionTime
+	}
+	return nil
+}
+
+type NodeGroupAutoscalingOptionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the node group for the request.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Default node group autoscaling options.
+	Defaults      *NodeGroupAutoscalingOptions `protobuf:"bytes,2,opt,name=defaults,proto3" json:"defaults,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeGroupAutoscalingOptionsRequest) Reset() {
+	*x = NodeGroupAutoscalingOptionsRequest{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeGroupAutoscalingOptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeGroupAutoscalingOptionsRequest) ProtoMessage() {}
+
+func (x *NodeGroupAutoscalingOptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeGroupAutoscalingOptionsRequest.ProtoReflect.Descriptor instead.
+func (*NodeGroupAutoscalingOptionsRequest) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *NodeGroupAutoscalingOptionsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NodeGroupAutoscalingOptionsRequest) GetDefaults() *NodeGroupAutoscalingOptions {
+	if x != nil {
+		return x.Defaults
+	}
+	return nil
+}
+
+type NodeGroupAutoscalingOptionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Autoscaling options for the requested node group.
+	NodeGroupAutoscalingOptions *NodeGroupAutoscalingOptions `protobuf:"bytes,1,opt,name=nodeGroupAutoscalingOptions,proto3" json:"nodeGroupAutoscalingOptions,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *NodeGroupAutoscalingOptionsResponse) Reset() {
+	*x = NodeGroupAutoscalingOptionsResponse{}
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeGroupAutoscalingOptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeGroupAutoscalingOptionsResponse) ProtoMessage() {}
+
+func (x *NodeGroupAutoscalingOptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_autoscaler_externalgrpc_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeGroupAutoscalingOptionsResponse.ProtoReflect.Descriptor instead.
+func (*NodeGroupAutoscalingOptionsResponse) Descriptor() ([]byte, []int) {
+	return file_omni_autoscaler_externalgrpc_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *NodeGroupAutoscalingOptionsResponse) GetNodeGroupAutoscalingOptions() *NodeGroupAutoscalingOptions {
+	if x != nil {
+		return x.NodeGroupAutoscalingOptions
+	}
+	return nil
+}
+
+var File_omni_autoscaler_externalgrpc_proto protoreflect.FileDescriptor
+
+const file_omni_autoscaler_externalgrpc_proto_rawDesc = "" +
+	"\n" +
+	"\"omni/autoscaler/externalgrpc.proto\x12/clusterautoscaler.cloudprovider.v1.externalgrpc\x1a\x19google/protobuf/any.proto\"e\n" +
+	"\tNodeGroup\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aminSize\x18\x02 \x01(\x05R\aminSize\x12\x18\n" +
+	"\amaxSize\x18\x03 \x01(\x05R\amaxSize\x12\x14\n" +
+	"\x05debug\x18\x04 \x01(\tR\x05debug\"\x9e\x03\n" +
+	"\x10ExternalGrpcNode\x12\x1e\n" +
+	"\n" +
+	"providerID\x18\x01 \x01(\tR\n" +
+	"providerID\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12e\n" +
+	"\x06labels\x18\x03 \x03(\v2M.clusterautoscaler.cloudprovider.v1.externalgrpc.ExternalGrpcNode.LabelsEntryR\x06labels\x12t\n" +
+	"\vannotations\x18\x04 \x03(\v2R.clusterautoscaler.cloudprovider.v1.externalgrpc.ExternalGrpcNode.AnnotationsEntryR\vannotations\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x13\n" +
+	"\x11NodeGroupsRequest\"p\n" +
+	"\x12NodeGroupsResponse\x12Z\n" +
+	"\n" +
+	"nodeGroups\x18\x01 \x03(\v2:.clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupR\n" +
+	"nodeGroups\"p\n" +
+	"\x17NodeGroupForNodeRequest\x12U\n" +
+	"\x04node\x18\x01 \x01(\v2A.clusterautoscaler.cloudprovider.v1.externalgrpc.ExternalGrpcNodeR\x04node\"t\n" +
+	"\x18NodeGroupForNodeResponse\x12X\n" +
+	"\tnodeGroup\x18\x01 \x01(\v2:.clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupR\tnodeGroup\"\xa8\x01\n" +
+	"\x17PricingNodePriceRequest\x12U\n" +
+	"\x04node\x18\x01 \x01(\v2A.clusterautoscaler.cloudprovider.v1.externalgrpc.ExternalGrpcNodeR\x04node\x12\x1c\n" +
+	"\tstartTime\x18\x02 \x01(\fR\tstartTime\x12\x18\n" +
+	"\aendTime\x18\x03 \x01(\fR\aendTime\"0\n" +
+	"\x18PricingNodePriceResponse\x12\x14\n" +
+	"\x05price\x18\x01 \x01(\x01R\x05price\"b\n" +
+	"\x16PricingPodPriceRequest\x12\x10\n" +
+	"\x03pod\x18\x01 \x01(\fR\x03pod\x12\x1c\n" +
+	"\tstartTime\x18\x02 \x01(\fR\tstartTime\x12\x18\n" +
+	"\aendTime\x18\x03 \x01(\fR\aendTime\"/\n" +
+	"\x17PricingPodPriceResponse\x12\x14\n" +
+	"\x05price\x18\x01 \x01(\x01R\x05price\"\x11\n" +
+	"\x0fGPULabelRequest\"(\n" +
+	"\x10GPULabelResponse\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\"\x1d\n" +
+	"\x1bGetAvailableGPUTypesRequest\"\xea\x01\n" +
+	"\x1cGetAvailableGPUTypesResponse\x12w\n" +
+	"\bgpuTypes\x18\x01 \x03(\v2[.clusterautoscaler.cloudprovider.v1.externalgrpc.GetAvailableGPUTypesResponse.GpuTypesEntryR\bgpuTypes\x1aQ\n" +
+	"\rGpuTypesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12*\n" +
+	"\x05value\x18\x02 \x01(\v2\x14.google.protobuf.AnyR\x05value:\x028\x01\"\x10\n" +
+	"\x0eCleanupRequest\"\x11\n" +
+	"\x0fCleanupResponse\"\x10\n" +
+	"\x0eRefreshRequest\"\x11\n" +
+	"\x0fRefreshResponse\",\n" +
+	"\x1aNodeGroupTargetSizeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"=\n" +
+	"\x1bNodeGroupTargetSizeResponse\x12\x1e\n" +
+	"\n" +
+	"targetSize\x18\x01 \x01(\x05R\n" +
+	"targetSize\"D\n" +
+	"\x1cNodeGroupIncreaseSizeRequest\x12\x14\n" +
+	"\x05delta\x18\x01 \x01(\x05R\x05delta\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x1f\n" +
+	"\x1dNodeGroupIncreaseSizeResponse\"\x86\x01\n" +
+	"\x1bNodeGroupDeleteNodesRequest\x12W\n" +
+	"\x05nodes\x18\x01 \x03(\v2A.clusterautoscaler.cloudprovider.v1.externalgrpc.ExternalGrpcNodeR\x05nodes\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x1e\n" +
+	"\x1cNodeGroupDeleteNodesResponse\"J\n" +
+	"\"NodeGroupDecreaseTargetSizeRequest\x12\x14\n" +
+	"\x05delta\x18\x01 \x01(\x05R\x05delta\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"%\n" +
+	"#NodeGroupDecreaseTargetSizeResponse\"'\n" +
+	"\x15NodeGroupNodesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"q\n" +
+	"\x16NodeGroupNodesResponse\x12W\n" +
+	"\tinstances\x18\x01 \x03(\v29.clusterautoscaler.cloudprovider.v1.externalgrpc.InstanceR\tinstances\"s\n" +
+	"\bInstance\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12W\n" +
+	"\x06status\x18\x02 \x01(\v2?.clusterautoscaler.cloudprovider.v1.externalgrpc.InstanceStatusR\x06status\"\xca\x02\n" +
+	"\x0eInstanceStatus\x12s\n" +
+	"\rinstanceState\x18\x01 \x01(\x0e2M.clusterautoscaler.cloudprovider.v1.externalgrpc.InstanceStatus.InstanceStateR\rinstanceState\x12`\n" +
+	"\terrorInfo\x18\x02 \x01(\v2B.clusterautoscaler.cloudprovider.v1.externalgrpc.InstanceErrorInfoR\terrorInfo\"a\n" +
+	"\rInstanceState\x12\x0f\n" +
+	"\vunspecified\x10\x00\x12\x13\n" +
+	"\x0finstanceRunning\x10\x01\x12\x14\n" +
+	"\x10instanceCreating\x10\x02\x12\x14\n" +
+	"\x10instanceDeleting\x10\x03\"\x85\x01\n" +
+	"\x11InstanceErrorInfo\x12\x1c\n" +
+	"\terrorCode\x18\x01 \x01(\tR\terrorCode\x12\"\n" +
+	"\ferrorMessage\x18\x02 \x01(\tR\ferrorMessage\x12.\n" +
+	"\x12instanceErrorClass\x18\x03 \x01(\x05R\x12instanceErrorClass\"2\n" +
+	" NodeGroupTemplateNodeInfoRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"?\n" +
+	"!NodeGroupTemplateNodeInfoResponse\x12\x1a\n" +
+	"\bnodeInfo\x18\x01 \x01(\fR\bnodeInfo\"\xcd\x02\n" +
+	"\x1bNodeGroupAutoscalingOptions\x12D\n" +
+	"\x1dscaleDownUtilizationThreshold\x18\x01 \x01(\x01R\x1dscaleDownUtilizationThreshold\x12J\n" +
+	" scaleDownGpuUtilizationThreshold\x18\x02 \x01(\x01R scaleDownGpuUtilizationThreshold\x124\n" +
+	"\x15scaleDownUnneededTime\x18\x03 \x01(\fR\x15scaleDownUnneededTime\x122\n" +
+	"\x14scaleDownUnreadyTime\x18\x04 \x01(\fR\x14scaleDownUnreadyTime\x122\n" +
+	"\x14MaxNodeProvisionTime\x18\x05 \x01(\fR\x14MaxNodeProvisionTime\"\x9e\x01\n" +
+	"\"NodeGroupAutoscalingOptionsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12h\n" +
+	"\bdefaults\x18\x02 \x01(\v2L.clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupAutoscalingOptionsR\bdefaults\"\xb6\x01\n" +
+	"#NodeGroupAutoscalingOptionsResponse\x12\x8e\x01\n" +
+	"\x1bnodeGroupAutoscalingOptions\x18\x01 \x01(\v2L.clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupAutoscalingOptionsR\x1bnodeGroupAutoscalingOptions2\xa1\x14\n" +
+	"\rCloudProvider\x12\x95\x01\n" +
+	"\n" +
+	"NodeGroups\x12B.clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupsRequest\x1aC.clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupsResponse\x12\xa7\x01\n" +
+	"\x10NodeGroupForNode\x12H.clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupForNodeRequest\x1aI.clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupForNodeResponse\x12\xa7\x01\n" +
+	"\x10PricingNodePrice\x12H.clusterautoscaler.cloudprovider.v1.externalgrpc.PricingNodePriceRequest\x1aI.clusterautoscaler.cloudprovider.v1.externalgrpc.PricingNodePriceResponse\x12\xa4\x01\n" +
+	"\x0fPricingPodPrice\x12G.clusterautoscaler.cloudprovider.v1.externalgrpc.PricingPodPriceRequest\x1aH.clusterautoscaler.cloudprovider.v1.externalgrpc.PricingPodPriceResponse\x12\x8f\x01\n" +
+	"\bGPULabel\x12@.clusterautoscaler.cloudprovider.v1.externalgrpc.GPULabelRequest\x1aA.clusterautoscaler.cloudprovider.v1.externalgrpc.GPULabelResponse\x12\xb3\x01\n" +
+	"\x14GetAvailableGPUTypes\x12L.clusterautoscaler.cloudprovider.v1.externalgrpc.GetAvailableGPUTypesRequest\x1aM.clusterautoscaler.cloudprovider.v1.externalgrpc.GetAvailableGPUTypesResponse\x12\x8c\x01\n" +
+	"\aCleanup\x12?.clusterautoscaler.cloudprovider.v1.externalgrpc.CleanupRequest\x1a@.clusterautoscaler.cloudprovider.v1.externalgrpc.CleanupResponse\x12\x8c\x01\n" +
+	"\aRefresh\x12?.clusterautoscaler.cloudprovider.v1.externalgrpc.RefreshRequest\x1a@.clusterautoscaler.cloudprovider.v1.externalgrpc.RefreshResponse\x12\xb0\x01\n" +
+	"\x13NodeGroupTargetSize\x12K.clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupTargetSizeRequest\x1aL.clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupTargetSizeResponse\x12\xb6\x01\n" +
+	"\x15NodeGroupIncreaseSize\x12M.clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupIncreaseSizeRequest\x1aN.clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupIncreaseSizeResponse\x12\xb3\x01\n" +
+	"\x14NodeGroupDeleteNodes\x12L.clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupDeleteNodesRequest\x1aM.clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupDeleteNodesResponse\x12\xc8\x01\n" +
+	"\x1bNodeGroupDecreaseTargetSize\x12S.clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupDecreaseTargetSizeRequest\x1aT.clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupDecreaseTargetSizeResponse\x12\xa1\x01\n" +
+	"\x0eNodeGroupNodes\x12F.clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupNodesRequest\x1aG.clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupNodesResponse\x12\xc2\x01\n" +
+	"\x19NodeGroupTemplateNodeInfo\x12Q.clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupTemplateNodeInfoRequest\x1aR.clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupTemplateNodeInfoResponse\x12\xc0\x01\n" +
+	"\x13NodeGroupGetOptions\x12S.clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupAutoscalingOptionsRequest\x1aT.clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupAutoscalingOptionsResponseB7Z5github.com/siderolabs/omni/client/api/omni/autoscalerb\x06proto3"
+
+var (
+	file_omni_autoscaler_externalgrpc_proto_rawDescOnce sync.Once
+	file_omni_autoscaler_externalgrpc_proto_rawDescData []byte
+)
+
+func file_omni_autoscaler_externalgrpc_proto_rawDescGZIP() []byte {
+	file_omni_autoscaler_externalgrpc_proto_rawDescOnce.Do(func() {
+		file_omni_autoscaler_externalgrpc_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_omni_autoscaler_externalgrpc_proto_rawDesc), len(file_omni_autoscaler_externalgrpc_proto_rawDesc)))
+	})
+	return file_omni_autoscaler_externalgrpc_proto_rawDescData
+}
+
+var file_omni_autoscaler_externalgrpc_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_omni_autoscaler_externalgrpc_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
+var file_omni_autoscaler_externalgrpc_proto_goTypes = []any{
+	(InstanceStatus_InstanceState)(0),           // 0: clusterautoscaler.cloudprovider.v1.externalgrpc.InstanceStatus.InstanceState
+	(*NodeGroup)(nil),                           // 1: clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroup
+	(*ExternalGrpcNode)(nil),                    // 2: clusterautoscaler.cloudprovider.v1.externalgrpc.ExternalGrpcNode
+	(*NodeGroupsRequest)(nil),                   // 3: clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupsRequest
+	(*NodeGroupsResponse)(nil),                  // 4: clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupsResponse
+	(*NodeGroupForNodeRequest)(nil),             // 5: clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupForNodeRequest
+	(*NodeGroupForNodeResponse)(nil),            // 6: clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupForNodeResponse
+	(*PricingNodePriceRequest)(nil),             // 7: clusterautoscaler.cloudprovider.v1.externalgrpc.PricingNodePriceRequest
+	(*PricingNodePriceResponse)(nil),            // 8: clusterautoscaler.cloudprovider.v1.externalgrpc.PricingNodePriceResponse
+	(*PricingPodPriceRequest)(nil),              // 9: clusterautoscaler.cloudprovider.v1.externalgrpc.PricingPodPriceRequest
+	(*PricingPodPriceResponse)(nil),             // 10: clusterautoscaler.cloudprovider.v1.externalgrpc.PricingPodPriceResponse
+	(*GPULabelRequest)(nil),                     // 11: clusterautoscaler.cloudprovider.v1.externalgrpc.GPULabelRequest
+	(*GPULabelResponse)(nil),                    // 12: clusterautoscaler.cloudprovider.v1.externalgrpc.GPULabelResponse
+	(*GetAvailableGPUTypesRequest)(nil),         // 13: clusterautoscaler.cloudprovider.v1.externalgrpc.GetAvailableGPUTypesRequest
+	(*GetAvailableGPUTypesResponse)(nil),        // 14: clusterautoscaler.cloudprovider.v1.externalgrpc.GetAvailableGPUTypesResponse
+	(*CleanupRequest)(nil),                      // 15: clusterautoscaler.cloudprovider.v1.externalgrpc.CleanupRequest
+	(*CleanupResponse)(nil),                     // 16: clusterautoscaler.cloudprovider.v1.externalgrpc.CleanupResponse
+	(*RefreshRequest)(nil),                      // 17: clusterautoscaler.cloudprovider.v1.externalgrpc.RefreshRequest
+	(*RefreshResponse)(nil),                     // 18: clusterautoscaler.cloudprovider.v1.externalgrpc.RefreshResponse
+	(*NodeGroupTargetSizeRequest)(nil),          // 19: clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupTargetSizeRequest
+	(*NodeGroupTargetSizeResponse)(nil),         // 20: clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupTargetSizeResponse
+	(*NodeGroupIncreaseSizeRequest)(nil),        // 21: clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupIncreaseSizeRequest
+	(*NodeGroupIncreaseSizeResponse)(nil),       // 22: clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupIncreaseSizeResponse
+	(*NodeGroupDeleteNodesRequest)(nil),         // 23: clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupDeleteNodesRequest
+	(*NodeGroupDeleteNodesResponse)(nil),        // 24: clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupDeleteNodesResponse
+	(*NodeGroupDecreaseTargetSizeRequest)(nil),  // 25: clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupDecreaseTargetSizeRequest
+	(*NodeGroupDecreaseTargetSizeResponse)(nil), // 26: clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupDecreaseTargetSizeResponse
+	(*NodeGroupNodesRequest)(nil),               // 27: clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupNodesRequest
+	(*NodeGroupNodesResponse)(nil),              // 28: clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupNodesResponse
+	(*Instance)(nil),                            // 29: clusterautoscaler.cloudprovider.v1.externalgrpc.Instance
+	(*InstanceStatus)(nil),                      // 30: clusterautoscaler.cloudprovider.v1.externalgrpc.InstanceStatus
+	(*InstanceErrorInfo)(nil),                   // 31: clusterautoscaler.cloudprovider.v1.externalgrpc.InstanceErrorInfo
+	(*NodeGroupTemplateNodeInfoRequest)(nil),    // 32: clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupTemplateNodeInfoRequest
+	(*NodeGroupTemplateNodeInfoResponse)(nil),   // 33: clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupTemplateNodeInfoResponse
+	(*NodeGroupAutoscalingOptions)(nil),         // 34: clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupAutoscalingOptions
+	(*NodeGroupAutoscalingOptionsRequest)(nil),  // 35: clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupAutoscalingOptionsRequest
+	(*NodeGroupAutoscalingOptionsResponse)(nil), // 36: clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupAutoscalingOptionsResponse
+	nil,               // 37: clusterautoscaler.cloudprovider.v1.externalgrpc.ExternalGrpcNode.LabelsEntry
+	nil,               // 38: clusterautoscaler.cloudprovider.v1.externalgrpc.ExternalGrpcNode.AnnotationsEntry
+	nil,               // 39: clusterautoscaler.cloudprovider.v1.externalgrpc.GetAvailableGPUTypesResponse.GpuTypesEntry
+	(*anypb.Any)(nil), // 40: google.protobuf.Any
+}
+var file_omni_autoscaler_externalgrpc_proto_depIdxs = []int32{
+	37, // 0: clusterautoscaler.cloudprovider.v1.externalgrpc.ExternalGrpcNode.labels:type_name -> clusterautoscaler.cloudprovider.v1.externalgrpc.ExternalGrpcNode.LabelsEntry
+	38, // 1: clusterautoscaler.cloudprovider.v1.externalgrpc.ExternalGrpcNode.annotations:type_name -> clusterautoscaler.cloudprovider.v1.externalgrpc.ExternalGrpcNode.AnnotationsEntry
+	1,  // 2: clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupsResponse.nodeGroups:type_name -> clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroup
+	2,  // 3: clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupForNodeRequest.node:type_name -> clusterautoscaler.cloudprovider.v1.externalgrpc.ExternalGrpcNode
+	1,  // 4: clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupForNodeResponse.nodeGroup:type_name -> clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroup
+	2,  // 5: clusterautoscaler.cloudprovider.v1.externalgrpc.PricingNodePriceRequest.node:type_name -> clusterautoscaler.cloudprovider.v1.externalgrpc.ExternalGrpcNode
+	39, // 6: clusterautoscaler.cloudprovider.v1.externalgrpc.GetAvailableGPUTypesResponse.gpuTypes:type_name -> clusterautoscaler.cloudprovider.v1.externalgrpc.GetAvailableGPUTypesResponse.GpuTypesEntry
+	2,  // 7: clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupDeleteNodesRequest.nodes:type_name -> clusterautoscaler.cloudprovider.v1.externalgrpc.ExternalGrpcNode
+	29, // 8: clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupNodesResponse.instances:type_name -> clusterautoscaler.cloudprovider.v1.externalgrpc.Instance
+	30, // 9: clusterautoscaler.cloudprovider.v1.externalgrpc.Instance.status:type_name -> clusterautoscaler.cloudprovider.v1.externalgrpc.InstanceStatus
+	0,  // 10: clusterautoscaler.cloudprovider.v1.externalgrpc.InstanceStatus.instanceState:type_name -> clusterautoscaler.cloudprovider.v1.externalgrpc.InstanceStatus.InstanceState
+	31, // 11: clusterautoscaler.cloudprovider.v1.externalgrpc.InstanceStatus.errorInfo:type_name -> clusterautoscaler.cloudprovider.v1.externalgrpc.InstanceErrorInfo
+	34, // 12: clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupAutoscalingOptionsRequest.defaults:type_name -> clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupAutoscalingOptions
+	34, // 13: clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupAutoscalingOptionsResponse.nodeGroupAutoscalingOptions:type_name -> clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupAutoscalingOptions
+	40, // 14: clusterautoscaler.cloudprovider.v1.externalgrpc.GetAvailableGPUTypesResponse.GpuTypesEntry.value:type_name -> google.protobuf.Any
+	3,  // 15: clusterautoscaler.cloudprovider.v1.externalgrpc.CloudProvider.NodeGroups:input_type -> clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupsRequest
+	5,  // 16: clusterautoscaler.cloudprovider.v1.externalgrpc.CloudProvider.NodeGroupForNode:input_type -> clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupForNodeRequest
+	7,  // 17: clusterautoscaler.cloudprovider.v1.externalgrpc.CloudProvider.PricingNodePrice:input_type -> clusterautoscaler.cloudprovider.v1.externalgrpc.PricingNodePriceRequest
+	9,  // 18: clusterautoscaler.cloudprovider.v1.externalgrpc.CloudProvider.PricingPodPrice:input_type -> clusterautoscaler.cloudprovider.v1.externalgrpc.PricingPodPriceRequest
+	11, // 19: clusterautoscaler.cloudprovider.v1.externalgrpc.CloudProvider.GPULabel:input_type -> clusterautoscaler.cloudprovider.v1.externalgrpc.GPULabelRequest
+	13, // 20: clusterautoscaler.cloudprovider.v1.externalgrpc.CloudProvider.GetAvailableGPUTypes:input_type -> clusterautoscaler.cloudprovider.v1.externalgrpc.GetAvailableGPUTypesRequest
+	15, // 21: clusterautoscaler.cloudprovider.v1.externalgrpc.CloudProvider.Cleanup:input_type -> clusterautoscaler.cloudprovider.v1.externalgrpc.CleanupRequest
+	17, // 22: clusterautoscaler.cloudprovider.v1.externalgrpc.CloudProvider.Refresh:input_type -> clusterautoscaler.cloudprovider.v1.externalgrpc.RefreshRequest
+	19, // 23: clusterautoscaler.cloudprovider.v1.externalgrpc.CloudProvider.NodeGroupTargetSize:input_type -> clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupTargetSizeRequest
+	21, // 24: clusterautoscaler.cloudprovider.v1.externalgrpc.CloudProvider.NodeGroupIncreaseSize:input_type -> clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupIncreaseSizeRequest
+	23, // 25: clusterautoscaler.cloudprovider.v1.externalgrpc.CloudProvider.NodeGroupDeleteNodes:input_type -> clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupDeleteNodesRequest
+	25, // 26: clusterautoscaler.cloudprovider.v1.externalgrpc.CloudProvider.NodeGroupDecreaseTargetSize:input_type -> clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupDecreaseTargetSizeRequest
+	27, // 27: clusterautoscaler.cloudprovider.v1.externalgrpc.CloudProvider.NodeGroupNodes:input_type -> clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupNodesRequest
+	32, // 28: clusterautoscaler.cloudprovider.v1.externalgrpc.CloudProvider.NodeGroupTemplateNodeInfo:input_type -> clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupTemplateNodeInfoRequest
+	35, // 29: clusterautoscaler.cloudprovider.v1.externalgrpc.CloudProvider.NodeGroupGetOptions:input_type -> clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupAutoscalingOptionsRequest
+	4,  // 30: clusterautoscaler.cloudprovider.v1.externalgrpc.CloudProvider.NodeGroups:output_type -> clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupsResponse
+	6,  // 31: clusterautoscaler.cloudprovider.v1.externalgrpc.CloudProvider.NodeGroupForNode:output_type -> clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupForNodeResponse
+	8,  // 32: clusterautoscaler.cloudprovider.v1.externalgrpc.CloudProvider.PricingNodePrice:output_type -> clusterautoscaler.cloudprovider.v1.externalgrpc.PricingNodePriceResponse
+	10, // 33: clusterautoscaler.cloudprovider.v1.externalgrpc.CloudProvider.PricingPodPrice:output_type -> clusterautoscaler.cloudprovider.v1.externalgrpc.PricingPodPriceResponse
+	12, // 34: clusterautoscaler.cloudprovider.v1.externalgrpc.CloudProvider.GPULabel:output_type -> clusterautoscaler.cloudprovider.v1.externalgrpc.GPULabelResponse
+	14, // 35: clusterautoscaler.cloudprovider.v1.externalgrpc.CloudProvider.GetAvailableGPUTypes:output_type -> clusterautoscaler.cloudprovider.v1.externalgrpc.GetAvailableGPUTypesResponse
+	16, // 36: clusterautoscaler.cloudprovider.v1.externalgrpc.CloudProvider.Cleanup:output_type -> clusterautoscaler.cloudprovider.v1.externalgrpc.CleanupResponse
+	18, // 37: clusterautoscaler.cloudprovider.v1.externalgrpc.CloudProvider.Refresh:output_type -> clusterautoscaler.cloudprovider.v1.externalgrpc.RefreshResponse
+	20, // 38: clusterautoscaler.cloudprovider.v1.externalgrpc.CloudProvider.NodeGroupTargetSize:output_type -> clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupTargetSizeResponse
+	22, // 39: clusterautoscaler.cloudprovider.v1.externalgrpc.CloudProvider.NodeGroupIncreaseSize:output_type -> clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupIncreaseSizeResponse
+	24, // 40: clusterautoscaler.cloudprovider.v1.externalgrpc.CloudProvider.NodeGroupDeleteNodes:output_type -> clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupDeleteNodesResponse
+	26, // 41: clusterautoscaler.cloudprovider.v1.externalgrpc.CloudProvider.NodeGroupDecreaseTargetSize:output_type -> clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupDecreaseTargetSizeResponse
+	28, // 42: clusterautoscaler.cloudprovider.v1.externalgrpc.CloudProvider.NodeGroupNodes:output_type -> clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupNodesResponse
+	33, // 43: clusterautoscaler.cloudprovider.v1.externalgrpc.CloudProvider.NodeGroupTemplateNodeInfo:output_type -> clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupTemplateNodeInfoResponse
+	36, // 44: clusterautoscaler.cloudprovider.v1.externalgrpc.CloudProvider.NodeGroupGetOptions:output_type -> clusterautoscaler.cloudprovider.v1.externalgrpc.NodeGroupAutoscalingOptionsResponse
+	30, // [30:45] is the sub-list for method output_type
+	15, // [15:30] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_omni_autoscaler_externalgrpc_proto_init() }
+func file_omni_autoscaler_externalgrpc_proto_init() {
+	if File_omni_autoscaler_externalgrpc_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_omni_autoscaler_externalgrpc_proto_rawDesc), len(file_omni_autoscaler_externalgrpc_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   39,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_omni_autoscaler_externalgrpc_proto_goTypes,
+		DependencyIndexes: file_omni_autoscaler_externalgrpc_proto_depIdxs,
+		EnumInfos:         file_omni_autoscaler_externalgrpc_proto_enumTypes,
+		MessageInfos:      file_omni_autoscaler_externalgrpc_proto_msgTypes,
+	}.Build()
+	File_omni_autoscaler_externalgrpc_proto = out.File
+	file_omni_autoscaler_externalgrpc_proto_goTypes = nil
+	file_omni_autoscaler_externalgrpc_proto_depIdxs = nil
+}
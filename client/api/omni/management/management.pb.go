@@ -90,20 +90,24 @@ const (
 	AuditLogEventType_AUDIT_LOG_EVENT_TYPE_TALOS_ACCESS          AuditLogEventType = 6
 	AuditLogEventType_AUDIT_LOG_EVENT_TYPE_K8S_ACCESS            AuditLogEventType = 7
 	AuditLogEventType_AUDIT_LOG_EVENT_TYPE_AUDIT_LOG_ACCESS      AuditLogEventType = 8
+	AuditLogEventType_AUDIT_LOG_EVENT_TYPE_WORKLOAD_PROXY_ACCESS AuditLogEventType = 9
+	AuditLogEventType_AUDIT_LOG_EVENT_TYPE_RATE_LIMIT            AuditLogEventType = 10
 )
 
 // Enum value maps for AuditLogEventType.
 var (
 	AuditLogEventType_name = map[int32]string{
-		0: "AUDIT_LOG_EVENT_TYPE_UNSPECIFIED",
-		1: "AUDIT_LOG_EVENT_TYPE_CREATE",
-		2: "AUDIT_LOG_EVENT_TYPE_UPDATE",
-		3: "AUDIT_LOG_EVENT_TYPE_UPDATE_WITH_CONFLICTS",
-		4: "AUDIT_LOG_EVENT_TYPE_DESTROY",
-		5: "AUDIT_LOG_EVENT_TYPE_TEARDOWN",
-		6: "AUDIT_LOG_EVENT_TYPE_TALOS_ACCESS",
-		7: "AUDIT_LOG_EVENT_TYPE_K8S_ACCESS",
-		8: "AUDIT_LOG_EVENT_TYPE_AUDIT_LOG_ACCESS",
+		0:  "AUDIT_LOG_EVENT_TYPE_UNSPECIFIED",
+		1:  "AUDIT_LOG_EVENT_TYPE_CREATE",
+		2:  "AUDIT_LOG_EVENT_TYPE_UPDATE",
+		3:  "AUDIT_LOG_EVENT_TYPE_UPDATE_WITH_CONFLICTS",
+		4:  "AUDIT_LOG_EVENT_TYPE_DESTROY",
+		5:  "AUDIT_LOG_EVENT_TYPE_TEARDOWN",
+		6:  "AUDIT_LOG_EVENT_TYPE_TALOS_ACCESS",
+		7:  "AUDIT_LOG_EVENT_TYPE_K8S_ACCESS",
+		8:  "AUDIT_LOG_EVENT_TYPE_AUDIT_LOG_ACCESS",
+		9:  "AUDIT_LOG_EVENT_TYPE_WORKLOAD_PROXY_ACCESS",
+		10: "AUDIT_LOG_EVENT_TYPE_RATE_LIMIT",
 	}
 	AuditLogEventType_value = map[string]int32{
 		"AUDIT_LOG_EVENT_TYPE_UNSPECIFIED":           0,
@@ -115,6 +119,8 @@ var (
 		"AUDIT_LOG_EVENT_TYPE_TALOS_ACCESS":          6,
 		"AUDIT_LOG_EVENT_TYPE_K8S_ACCESS":            7,
 		"AUDIT_LOG_EVENT_TYPE_AUDIT_LOG_ACCESS":      8,
+		"AUDIT_LOG_EVENT_TYPE_WORKLOAD_PROXY_ACCESS": 9,
+		"AUDIT_LOG_EVENT_TYPE_RATE_LIMIT":            10,
 	}
 )
 
@@ -459,6 +465,55 @@ func (BootAssetURLRequest_BootAssetKind) EnumDescriptor() ([]byte, []int) {
 	return file_omni_management_management_proto_rawDescGZIP(), []int{21, 0}
 }
 
+type AuditLogChainMarker_Kind int32
+
+const (
+	// Checkpoint signs the hash of the event with the marker id.
+	AuditLogChainMarker_CHECKPOINT AuditLogChainMarker_Kind = 0
+	// Truncation records that the events before the marker id were removed by the cleanup, signing the hash
+	// of the last removed event, which the event with the marker id is chained to.
+	AuditLogChainMarker_TRUNCATION AuditLogChainMarker_Kind = 1
+)
+
+// Enum value maps for AuditLogChainMarker_Kind.
+var (
+	AuditLogChainMarker_Kind_name = map[int32]string{
+		0: "CHECKPOINT",
+		1: "TRUNCATION",
+	}
+	AuditLogChainMarker_Kind_value = map[string]int32{
+		"CHECKPOINT": 0,
+		"TRUNCATION": 1,
+	}
+)
+
+func (x AuditLogChainMarker_Kind) Enum() *AuditLogChainMarker_Kind {
+	p := new(AuditLogChainMarker_Kind)
+	*p = x
+	return p
+}
+
+func (x AuditLogChainMarker_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditLogChainMarker_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_omni_management_management_proto_enumTypes[8].Descriptor()
+}
+
+func (AuditLogChainMarker_Kind) Type() protoreflect.EnumType {
+	return &file_omni_management_management_proto_enumTypes[8]
+}
+
+func (x AuditLogChainMarker_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditLogChainMarker_Kind.Descriptor instead.
+func (AuditLogChainMarker_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_omni_management_management_proto_rawDescGZIP(), []int{26, 0}
+}
+
 type MaintenanceLifecycleRequest_Operation int32
 
 const (
@@ -494,11 +549,11 @@ func (x MaintenanceLifecycleRequest_Operation) String() string {
 }
 
 func (MaintenanceLifecycleRequest_Operation) Descriptor() protoreflect.EnumDescriptor {
-	return file_omni_management_management_proto_enumTypes[8].Descriptor()
+	return file_omni_management_management_proto_enumTypes[9].Descriptor()
 }
 
 func (MaintenanceLifecycleRequest_Operation) Type() protoreflect.EnumType {
-	return &file_omni_management_management_proto_enumTypes[8]
+	return &file_omni_management_management_proto_enumTypes[9]
 }
 
 func (x MaintenanceLifecycleRequest_Operation) Number() protoreflect.EnumNumber {
@@ -507,7 +562,7 @@ func (x MaintenanceLifecycleRequest_Operation) Number() protoreflect.EnumNumber
 
 // Deprecated: Use MaintenanceLifecycleRequest_Operation.Descriptor instead.
 func (MaintenanceLifecycleRequest_Operation) EnumDescriptor() ([]byte, []int) {
-	return file_omni_management_management_proto_rawDescGZIP(), []int{31, 0}
+	return file_omni_management_management_proto_rawDescGZIP(), []int{34, 0}
 }
 
 type KubeconfigResponse struct {
@@ -812,9 +867,11 @@ type CreateServiceAccountRequest struct {
 	ArmoredPgpPublicKey string                 `protobuf:"bytes,1,opt,name=armored_pgp_public_key,json=armoredPgpPublicKey,proto3" json:"armored_pgp_public_key,omitempty"`
 	// UseUserRole indicates whether to use the role of the creating user.
 	// When true, role will be ignored and the service account will be created with the role of the creating user.
-	UseUserRole   bool   `protobuf:"varint,3,opt,name=use_user_role,json=useUserRole,proto3" json:"use_user_role,omitempty"`
-	Role          string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
-	Name          string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	UseUserRole bool   `protobuf:"varint,3,opt,name=use_user_role,json=useUserRole,proto3" json:"use_user_role,omitempty"`
+	Role        string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	Name        string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	// CustomRoles are the IDs of the Role resources granting the service account permissions on top of its role.
+	CustomRoles   []string `protobuf:"bytes,6,rep,name=custom_roles,json=customRoles,proto3" json:"custom_roles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -877,6 +934,13 @@ func (x *CreateServiceAccountRequest) GetName() string {
 	return ""
 }
 
+func (x *CreateServiceAccountRequest) GetCustomRoles() []string {
+	if x != nil {
+		return x.CustomRoles
+	}
+	return nil
+}
+
 type CreateServiceAccountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PublicKeyId   string                 `protobuf:"bytes,1,opt,name=public_key_id,json=publicKeyId,proto3" json:"public_key_id,omitempty"`
@@ -2100,7 +2164,12 @@ type ReadAuditLogRequest struct {
 	// Zero means unset. When set, it takes precedence over the timestamp fields, and the resume is
 	// exact. Only usable with follow, and only with ids obtained from the same server: following an
 	// id position the server no longer knows fails the stream.
-	FromId        int64 `protobuf:"varint,13,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
+	FromId int64 `protobuf:"varint,13,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
+	// WithProofs returns the hash chain proof of each event, for verifying that the audit log was not modified.
+	//
+	// Events are delivered in insertion order, with their ids. No ordering, search or filter fields may be
+	// combined with it, only the time range, and it cannot be combined with follow.
+	WithProofs    bool `protobuf:"varint,14,opt,name=with_proofs,json=withProofs,proto3" json:"with_proofs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2226,6 +2295,160 @@ func (x *ReadAuditLogRequest) GetFromId() int64 {
 	return 0
 }
 
+func (x *ReadAuditLogRequest) GetWithProofs() bool {
+	if x != nil {
+		return x.WithProofs
+	}
+	return false
+}
+
+type AuditLogChainMarker struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Kind          AuditLogChainMarker_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=management.AuditLogChainMarker_Kind" json:"kind,omitempty"`
+	Id            int64                    `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Hash          []byte                   `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	TimestampMs   int64                    `protobuf:"varint,4,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	PublicKey     []byte                   `protobuf:"bytes,5,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Signature     []byte                   `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLogChainMarker) Reset() {
+	*x = AuditLogChainMarker{}
+	mi := &file_omni_management_management_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLogChainMarker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogChainMarker) ProtoMessage() {}
+
+func (x *AuditLogChainMarker) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_management_management_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogChainMarker.ProtoReflect.Descriptor instead.
+func (*AuditLogChainMarker) Descriptor() ([]byte, []int) {
+	return file_omni_management_management_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AuditLogChainMarker) GetKind() AuditLogChainMarker_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return AuditLogChainMarker_CHECKPOINT
+}
+
+func (x *AuditLogChainMarker) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditLogChainMarker) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *AuditLogChainMarker) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *AuditLogChainMarker) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *AuditLogChainMarker) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type AuditLogProof struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// PrevHash is the hash of the previous event, empty for the first event of the chain.
+	PrevHash []byte `protobuf:"bytes,1,opt,name=prev_hash,json=prevHash,proto3" json:"prev_hash,omitempty"`
+	// Hash is the hash of the previous event hash, the event id and the event, empty for the events written
+	// before the audit log was chained.
+	Hash          []byte                 `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Markers       []*AuditLogChainMarker `protobuf:"bytes,3,rep,name=markers,proto3" json:"markers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLogProof) Reset() {
+	*x = AuditLogProof{}
+	mi := &file_omni_management_management_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLogProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogProof) ProtoMessage() {}
+
+func (x *AuditLogProof) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_management_management_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogProof.ProtoReflect.Descriptor instead.
+func (*AuditLogProof) Descriptor() ([]byte, []int) {
+	return file_omni_management_management_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AuditLogProof) GetPrevHash() []byte {
+	if x != nil {
+		return x.PrevHash
+	}
+	return nil
+}
+
+func (x *AuditLogProof) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *AuditLogProof) GetMarkers() []*AuditLogChainMarker {
+	if x != nil {
+		return x.Markers
+	}
+	return nil
+}
+
 type ReadAuditLogResponse struct {
 	state    protoimpl.MessageState `protogen:"open.v1"`
 	AuditLog []byte                 `protobuf:"bytes,1,opt,name=audit_log,json=auditLog,proto3" json:"audit_log,omitempty"`
@@ -2234,14 +2457,16 @@ type ReadAuditLogResponse struct {
 	//
 	// Every follow stream starts with an acknowledgment response carrying an empty AuditLog, whose id is
 	// the resolved start position: the id just before the first event the stream will deliver.
-	Id            int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Id int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Proof is the hash chain proof of the event, populated when WithProofs is set.
+	Proof         *AuditLogProof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ReadAuditLogResponse) Reset() {
 	*x = ReadAuditLogResponse{}
-	mi := &file_omni_management_management_proto_msgTypes[26]
+	mi := &file_omni_management_management_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2253,7 +2478,7 @@ func (x *ReadAuditLogResponse) String() string {
 func (*ReadAuditLogResponse) ProtoMessage() {}
 
 func (x *ReadAuditLogResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_management_management_proto_msgTypes[26]
+	mi := &file_omni_management_management_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2266,7 +2491,7 @@ func (x *ReadAuditLogResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadAuditLogResponse.ProtoReflect.Descriptor instead.
 func (*ReadAuditLogResponse) Descriptor() ([]byte, []int) {
-	return file_omni_management_management_proto_rawDescGZIP(), []int{26}
+	return file_omni_management_management_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ReadAuditLogResponse) GetAuditLog() []byte {
@@ -2283,6 +2508,58 @@ func (x *ReadAuditLogResponse) GetId() int64 {
 	return 0
 }
 
+func (x *ReadAuditLogResponse) GetProof() *AuditLogProof {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+type AuditLogSigningKeyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// PublicKey is the Ed25519 public key the audit log chain markers are signed with.
+	PublicKey     []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLogSigningKeyResponse) Reset() {
+	*x = AuditLogSigningKeyResponse{}
+	mi := &file_omni_management_management_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLogSigningKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogSigningKeyResponse) ProtoMessage() {}
+
+func (x *AuditLogSigningKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_management_management_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogSigningKeyResponse.ProtoReflect.Descriptor instead.
+func (*AuditLogSigningKeyResponse) Descriptor() ([]byte, []int) {
+	return file_omni_management_management_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AuditLogSigningKeyResponse) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
 type ValidateJsonSchemaRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Data          string                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
@@ -2293,7 +2570,7 @@ type ValidateJsonSchemaRequest struct {
 
 func (x *ValidateJsonSchemaRequest) Reset() {
 	*x = ValidateJsonSchemaRequest{}
-	mi := &file_omni_management_management_proto_msgTypes[27]
+	mi := &file_omni_management_management_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2305,7 +2582,7 @@ func (x *ValidateJsonSchemaRequest) String() string {
 func (*ValidateJsonSchemaRequest) ProtoMessage() {}
 
 func (x *ValidateJsonSchemaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_management_management_proto_msgTypes[27]
+	mi := &file_omni_management_management_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2318,7 +2595,7 @@ func (x *ValidateJsonSchemaRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateJsonSchemaRequest.ProtoReflect.Descriptor instead.
 func (*ValidateJsonSchemaRequest) Descriptor() ([]byte, []int) {
-	return file_omni_management_management_proto_rawDescGZIP(), []int{27}
+	return file_omni_management_management_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *ValidateJsonSchemaRequest) GetData() string {
@@ -2344,7 +2621,7 @@ type ValidateJsonSchemaResponse struct {
 
 func (x *ValidateJsonSchemaResponse) Reset() {
 	*x = ValidateJsonSchemaResponse{}
-	mi := &file_omni_management_management_proto_msgTypes[28]
+	mi := &file_omni_management_management_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2356,7 +2633,7 @@ func (x *ValidateJsonSchemaResponse) String() string {
 func (*ValidateJsonSchemaResponse) ProtoMessage() {}
 
 func (x *ValidateJsonSchemaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_management_management_proto_msgTypes[28]
+	mi := &file_omni_management_management_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2369,7 +2646,7 @@ func (x *ValidateJsonSchemaResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateJsonSchemaResponse.ProtoReflect.Descriptor instead.
 func (*ValidateJsonSchemaResponse) Descriptor() ([]byte, []int) {
-	return file_omni_management_management_proto_rawDescGZIP(), []int{28}
+	return file_omni_management_management_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *ValidateJsonSchemaResponse) GetErrors() []*ValidateJsonSchemaResponse_Error {
@@ -2389,7 +2666,7 @@ type MaintenanceUpgradeRequest struct {
 
 func (x *MaintenanceUpgradeRequest) Reset() {
 	*x = MaintenanceUpgradeRequest{}
-	mi := &file_omni_management_management_proto_msgTypes[29]
+	mi := &file_omni_management_management_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2401,7 +2678,7 @@ func (x *MaintenanceUpgradeRequest) String() string {
 func (*MaintenanceUpgradeRequest) ProtoMessage() {}
 
 func (x *MaintenanceUpgradeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_management_management_proto_msgTypes[29]
+	mi := &file_omni_management_management_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2414,7 +2691,7 @@ func (x *MaintenanceUpgradeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MaintenanceUpgradeRequest.ProtoReflect.Descriptor instead.
 func (*MaintenanceUpgradeRequest) Descriptor() ([]byte, []int) {
-	return file_omni_management_management_proto_rawDescGZIP(), []int{29}
+	return file_omni_management_management_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *MaintenanceUpgradeRequest) GetMachineId() string {
@@ -2439,7 +2716,7 @@ type MaintenanceUpgradeResponse struct {
 
 func (x *MaintenanceUpgradeResponse) Reset() {
 	*x = MaintenanceUpgradeResponse{}
-	mi := &file_omni_management_management_proto_msgTypes[30]
+	mi := &file_omni_management_management_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2451,7 +2728,7 @@ func (x *MaintenanceUpgradeResponse) String() string {
 func (*MaintenanceUpgradeResponse) ProtoMessage() {}
 
 func (x *MaintenanceUpgradeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_management_management_proto_msgTypes[30]
+	mi := &file_omni_management_management_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2464,7 +2741,7 @@ func (x *MaintenanceUpgradeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MaintenanceUpgradeResponse.ProtoReflect.Descriptor instead.
 func (*MaintenanceUpgradeResponse) Descriptor() ([]byte, []int) {
-	return file_omni_management_management_proto_rawDescGZIP(), []int{30}
+	return file_omni_management_management_proto_rawDescGZIP(), []int{33}
 }
 
 type MaintenanceLifecycleRequest struct {
@@ -2482,7 +2759,7 @@ type MaintenanceLifecycleRequest struct {
 
 func (x *MaintenanceLifecycleRequest) Reset() {
 	*x = MaintenanceLifecycleRequest{}
-	mi := &file_omni_management_management_proto_msgTypes[31]
+	mi := &file_omni_management_management_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2494,7 +2771,7 @@ func (x *MaintenanceLifecycleRequest) String() string {
 func (*MaintenanceLifecycleRequest) ProtoMessage() {}
 
 func (x *MaintenanceLifecycleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_management_management_proto_msgTypes[31]
+	mi := &file_omni_management_management_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2507,7 +2784,7 @@ func (x *MaintenanceLifecycleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MaintenanceLifecycleRequest.ProtoReflect.Descriptor instead.
 func (*MaintenanceLifecycleRequest) Descriptor() ([]byte, []int) {
-	return file_omni_management_management_proto_rawDescGZIP(), []int{31}
+	return file_omni_management_management_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *MaintenanceLifecycleRequest) GetMachineId() string {
@@ -2548,7 +2825,7 @@ type MaintenanceLifecycleResponse struct {
 
 func (x *MaintenanceLifecycleResponse) Reset() {
 	*x = MaintenanceLifecycleResponse{}
-	mi := &file_omni_management_management_proto_msgTypes[32]
+	mi := &file_omni_management_management_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2560,7 +2837,7 @@ func (x *MaintenanceLifecycleResponse) String() string {
 func (*MaintenanceLifecycleResponse) ProtoMessage() {}
 
 func (x *MaintenanceLifecycleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_management_management_proto_msgTypes[32]
+	mi := &file_omni_management_management_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2573,7 +2850,7 @@ func (x *MaintenanceLifecycleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MaintenanceLifecycleResponse.ProtoReflect.Descriptor instead.
 func (*MaintenanceLifecycleResponse) Descriptor() ([]byte, []int) {
-	return file_omni_management_management_proto_rawDescGZIP(), []int{32}
+	return file_omni_management_management_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *MaintenanceLifecycleResponse) GetMessage() string {
@@ -2583,29 +2860,35 @@ func (x *MaintenanceLifecycleResponse) GetMessage() string {
 	return ""
 }
 
-type GetMachineJoinConfigRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UseGrpcTunnel bool                   `protobuf:"varint,1,opt,name=use_grpc_tunnel,json=useGrpcTunnel,proto3" json:"use_grpc_tunnel,omitempty"`
-	JoinToken     string                 `protobuf:"bytes,2,opt,name=join_token,json=joinToken,proto3" json:"join_token,omitempty"`
+type EtcdRestoreRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// cluster is the ID of the cluster to restore etcd of.
+	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// snapshot is the name of the etcd backup snapshot of the cluster to restore.
+	Snapshot string `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// wipe_ephemeral confirms wiping the EPHEMERAL partition of the control plane nodes.
+	// Talos versions before 1.14 keep the etcd data in the EPHEMERAL partition, so the restore is rejected for them unless it is set.
+	// The newer versions keep the etcd data in its own volume, which is the only one wiped unless it is set.
+	WipeEphemeral bool `protobuf:"varint,3,opt,name=wipe_ephemeral,json=wipeEphemeral,proto3" json:"wipe_ephemeral,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMachineJoinConfigRequest) Reset() {
-	*x = GetMachineJoinConfigRequest{}
-	mi := &file_omni_management_management_proto_msgTypes[33]
+func (x *EtcdRestoreRequest) Reset() {
+	*x = EtcdRestoreRequest{}
+	mi := &file_omni_management_management_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMachineJoinConfigRequest) String() string {
+func (x *EtcdRestoreRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMachineJoinConfigRequest) ProtoMessage() {}
+func (*EtcdRestoreRequest) ProtoMessage() {}
 
-func (x *GetMachineJoinConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_management_management_proto_msgTypes[33]
+func (x *EtcdRestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_management_management_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2616,36 +2899,140 @@ func (x *GetMachineJoinConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMachineJoinConfigRequest.ProtoReflect.Descriptor instead.
-func (*GetMachineJoinConfigRequest) Descriptor() ([]byte, []int) {
-	return file_omni_management_management_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use EtcdRestoreRequest.ProtoReflect.Descriptor instead.
+func (*EtcdRestoreRequest) Descriptor() ([]byte, []int) {
+	return file_omni_management_management_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetMachineJoinConfigRequest) GetUseGrpcTunnel() bool {
+func (x *EtcdRestoreRequest) GetCluster() string {
 	if x != nil {
-		return x.UseGrpcTunnel
+		return x.Cluster
 	}
-	return false
+	return ""
 }
 
-func (x *GetMachineJoinConfigRequest) GetJoinToken() string {
+func (x *EtcdRestoreRequest) GetSnapshot() string {
 	if x != nil {
-		return x.JoinToken
+		return x.Snapshot
 	}
 	return ""
 }
 
-type GetMachineJoinConfigResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	KernelArgs    []string               `protobuf:"bytes,1,rep,name=kernel_args,json=kernelArgs,proto3" json:"kernel_args,omitempty"`
-	Config        string                 `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *EtcdRestoreRequest) GetWipeEphemeral() bool {
+	if x != nil {
+		return x.WipeEphemeral
+	}
+	return false
 }
 
-func (x *GetMachineJoinConfigResponse) Reset() {
+type EtcdRestoreResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// message indicates the current progress of the restore.
+	Message       string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EtcdRestoreResponse) Reset() {
+	*x = EtcdRestoreResponse{}
+	mi := &file_omni_management_management_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EtcdRestoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EtcdRestoreResponse) ProtoMessage() {}
+
+func (x *EtcdRestoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_management_management_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EtcdRestoreResponse.ProtoReflect.Descriptor instead.
+func (*EtcdRestoreResponse) Descriptor() ([]byte, []int) {
+	return file_omni_management_management_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *EtcdRestoreResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetMachineJoinConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UseGrpcTunnel bool                   `protobuf:"varint,1,opt,name=use_grpc_tunnel,json=useGrpcTunnel,proto3" json:"use_grpc_tunnel,omitempty"`
+	JoinToken     string                 `protobuf:"bytes,2,opt,name=join_token,json=joinToken,proto3" json:"join_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMachineJoinConfigRequest) Reset() {
+	*x = GetMachineJoinConfigRequest{}
+	mi := &file_omni_management_management_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMachineJoinConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMachineJoinConfigRequest) ProtoMessage() {}
+
+func (x *GetMachineJoinConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_management_management_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMachineJoinConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetMachineJoinConfigRequest) Descriptor() ([]byte, []int) {
+	return file_omni_management_management_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetMachineJoinConfigRequest) GetUseGrpcTunnel() bool {
+	if x != nil {
+		return x.UseGrpcTunnel
+	}
+	return false
+}
+
+func (x *GetMachineJoinConfigRequest) GetJoinToken() string {
+	if x != nil {
+		return x.JoinToken
+	}
+	return ""
+}
+
+type GetMachineJoinConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KernelArgs    []string               `protobuf:"bytes,1,rep,name=kernel_args,json=kernelArgs,proto3" json:"kernel_args,omitempty"`
+	Config        string                 `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMachineJoinConfigResponse) Reset() {
 	*x = GetMachineJoinConfigResponse{}
-	mi := &file_omni_management_management_proto_msgTypes[34]
+	mi := &file_omni_management_management_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2657,7 +3044,7 @@ func (x *GetMachineJoinConfigResponse) String() string {
 func (*GetMachineJoinConfigResponse) ProtoMessage() {}
 
 func (x *GetMachineJoinConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_management_management_proto_msgTypes[34]
+	mi := &file_omni_management_management_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2670,7 +3057,7 @@ func (x *GetMachineJoinConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMachineJoinConfigResponse.ProtoReflect.Descriptor instead.
 func (*GetMachineJoinConfigResponse) Descriptor() ([]byte, []int) {
-	return file_omni_management_management_proto_rawDescGZIP(), []int{34}
+	return file_omni_management_management_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *GetMachineJoinConfigResponse) GetKernelArgs() []string {
@@ -2696,7 +3083,7 @@ type GenJoinTokenResponse struct {
 
 func (x *GenJoinTokenResponse) Reset() {
 	*x = GenJoinTokenResponse{}
-	mi := &file_omni_management_management_proto_msgTypes[35]
+	mi := &file_omni_management_management_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2708,7 +3095,7 @@ func (x *GenJoinTokenResponse) String() string {
 func (*GenJoinTokenResponse) ProtoMessage() {}
 
 func (x *GenJoinTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_management_management_proto_msgTypes[35]
+	mi := &file_omni_management_management_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2721,7 +3108,7 @@ func (x *GenJoinTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenJoinTokenResponse.ProtoReflect.Descriptor instead.
 func (*GenJoinTokenResponse) Descriptor() ([]byte, []int) {
-	return file_omni_management_management_proto_rawDescGZIP(), []int{35}
+	return file_omni_management_management_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GenJoinTokenResponse) GetToken() string {
@@ -2741,7 +3128,7 @@ type CreateJoinTokenRequest struct {
 
 func (x *CreateJoinTokenRequest) Reset() {
 	*x = CreateJoinTokenRequest{}
-	mi := &file_omni_management_management_proto_msgTypes[36]
+	mi := &file_omni_management_management_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2753,7 +3140,7 @@ func (x *CreateJoinTokenRequest) String() string {
 func (*CreateJoinTokenRequest) ProtoMessage() {}
 
 func (x *CreateJoinTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_management_management_proto_msgTypes[36]
+	mi := &file_omni_management_management_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2766,7 +3153,7 @@ func (x *CreateJoinTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateJoinTokenRequest.ProtoReflect.Descriptor instead.
 func (*CreateJoinTokenRequest) Descriptor() ([]byte, []int) {
-	return file_omni_management_management_proto_rawDescGZIP(), []int{36}
+	return file_omni_management_management_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *CreateJoinTokenRequest) GetName() string {
@@ -2792,7 +3179,7 @@ type CreateJoinTokenResponse struct {
 
 func (x *CreateJoinTokenResponse) Reset() {
 	*x = CreateJoinTokenResponse{}
-	mi := &file_omni_management_management_proto_msgTypes[37]
+	mi := &file_omni_management_management_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2804,7 +3191,7 @@ func (x *CreateJoinTokenResponse) String() string {
 func (*CreateJoinTokenResponse) ProtoMessage() {}
 
 func (x *CreateJoinTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_management_management_proto_msgTypes[37]
+	mi := &file_omni_management_management_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2817,7 +3204,7 @@ func (x *CreateJoinTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateJoinTokenResponse.ProtoReflect.Descriptor instead.
 func (*CreateJoinTokenResponse) Descriptor() ([]byte, []int) {
-	return file_omni_management_management_proto_rawDescGZIP(), []int{37}
+	return file_omni_management_management_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *CreateJoinTokenResponse) GetId() string {
@@ -2836,7 +3223,7 @@ type ResetNodeUniqueTokenRequest struct {
 
 func (x *ResetNodeUniqueTokenRequest) Reset() {
 	*x = ResetNodeUniqueTokenRequest{}
-	mi := &file_omni_management_management_proto_msgTypes[38]
+	mi := &file_omni_management_management_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2848,7 +3235,7 @@ func (x *ResetNodeUniqueTokenRequest) String() string {
 func (*ResetNodeUniqueTokenRequest) ProtoMessage() {}
 
 func (x *ResetNodeUniqueTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_management_management_proto_msgTypes[38]
+	mi := &file_omni_management_management_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2861,7 +3248,7 @@ func (x *ResetNodeUniqueTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetNodeUniqueTokenRequest.ProtoReflect.Descriptor instead.
 func (*ResetNodeUniqueTokenRequest) Descriptor() ([]byte, []int) {
-	return file_omni_management_management_proto_rawDescGZIP(), []int{38}
+	return file_omni_management_management_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *ResetNodeUniqueTokenRequest) GetId() string {
@@ -2879,7 +3266,7 @@ type ResetNodeUniqueTokenResponse struct {
 
 func (x *ResetNodeUniqueTokenResponse) Reset() {
 	*x = ResetNodeUniqueTokenResponse{}
-	mi := &file_omni_management_management_proto_msgTypes[39]
+	mi := &file_omni_management_management_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2891,7 +3278,7 @@ func (x *ResetNodeUniqueTokenResponse) String() string {
 func (*ResetNodeUniqueTokenResponse) ProtoMessage() {}
 
 func (x *ResetNodeUniqueTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_management_management_proto_msgTypes[39]
+	mi := &file_omni_management_management_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2904,20 +3291,22 @@ func (x *ResetNodeUniqueTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetNodeUniqueTokenResponse.ProtoReflect.Descriptor instead.
 func (*ResetNodeUniqueTokenResponse) Descriptor() ([]byte, []int) {
-	return file_omni_management_management_proto_rawDescGZIP(), []int{39}
+	return file_omni_management_management_proto_rawDescGZIP(), []int{44}
 }
 
 type CreateUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Email string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Role  string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	// CustomRoles are the IDs of the Role resources granting the user permissions on top of the role.
+	CustomRoles   []string `protobuf:"bytes,3,rep,name=custom_roles,json=customRoles,proto3" json:"custom_roles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateUserRequest) Reset() {
 	*x = CreateUserRequest{}
-	mi := &file_omni_management_management_proto_msgTypes[40]
+	mi := &file_omni_management_management_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2929,7 +3318,7 @@ func (x *CreateUserRequest) String() string {
 func (*CreateUserRequest) ProtoMessage() {}
 
 func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_management_management_proto_msgTypes[40]
+	mi := &file_omni_management_management_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2942,7 +3331,7 @@ func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
 func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return file_omni_management_management_proto_rawDescGZIP(), []int{40}
+	return file_omni_management_management_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *CreateUserRequest) GetEmail() string {
@@ -2959,6 +3348,13 @@ func (x *CreateUserRequest) GetRole() string {
 	return ""
 }
 
+func (x *CreateUserRequest) GetCustomRoles() []string {
+	if x != nil {
+		return x.CustomRoles
+	}
+	return nil
+}
+
 type CreateUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -2968,7 +3364,7 @@ type CreateUserResponse struct {
 
 func (x *CreateUserResponse) Reset() {
 	*x = CreateUserResponse{}
-	mi := &file_omni_management_management_proto_msgTypes[41]
+	mi := &file_omni_management_management_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2980,7 +3376,7 @@ func (x *CreateUserResponse) String() string {
 func (*CreateUserResponse) ProtoMessage() {}
 
 func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_management_management_proto_msgTypes[41]
+	mi := &file_omni_management_management_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2993,7 +3389,7 @@ func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
 func (*CreateUserResponse) Descriptor() ([]byte, []int) {
-	return file_omni_management_management_proto_rawDescGZIP(), []int{41}
+	return file_omni_management_management_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *CreateUserResponse) GetUserId() string {
@@ -3003,17 +3399,64 @@ func (x *CreateUserResponse) GetUserId() string {
 	return ""
 }
 
-type UpdateUserRequest struct {
+// CustomRoles is a list of the Role resource IDs.
+type CustomRoles struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Roles         []string               `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomRoles) Reset() {
+	*x = CustomRoles{}
+	mi := &file_omni_management_management_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomRoles) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomRoles) ProtoMessage() {}
+
+func (x *CustomRoles) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_management_management_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomRoles.ProtoReflect.Descriptor instead.
+func (*CustomRoles) Descriptor() ([]byte, []int) {
+	return file_omni_management_management_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CustomRoles) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type UpdateUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Email string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Role  string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	// CustomRoles replace the custom roles of the user if set, and are kept as is otherwise.
+	CustomRoles   *CustomRoles `protobuf:"bytes,3,opt,name=custom_roles,json=customRoles,proto3" json:"custom_roles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateUserRequest) Reset() {
 	*x = UpdateUserRequest{}
-	mi := &file_omni_management_management_proto_msgTypes[42]
+	mi := &file_omni_management_management_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3025,7 +3468,7 @@ func (x *UpdateUserRequest) String() string {
 func (*UpdateUserRequest) ProtoMessage() {}
 
 func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_management_management_proto_msgTypes[42]
+	mi := &file_omni_management_management_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3038,7 +3481,7 @@ func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
-	return file_omni_management_management_proto_rawDescGZIP(), []int{42}
+	return file_omni_management_management_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *UpdateUserRequest) GetEmail() string {
@@ -3055,6 +3498,13 @@ func (x *UpdateUserRequest) GetRole() string {
 	return ""
 }
 
+func (x *UpdateUserRequest) GetCustomRoles() *CustomRoles {
+	if x != nil {
+		return x.CustomRoles
+	}
+	return nil
+}
+
 type DestroyUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
@@ -3064,7 +3514,7 @@ type DestroyUserRequest struct {
 
 func (x *DestroyUserRequest) Reset() {
 	*x = DestroyUserRequest{}
-	mi := &file_omni_management_management_proto_msgTypes[43]
+	mi := &file_omni_management_management_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3076,7 +3526,7 @@ func (x *DestroyUserRequest) String() string {
 func (*DestroyUserRequest) ProtoMessage() {}
 
 func (x *DestroyUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_management_management_proto_msgTypes[43]
+	mi := &file_omni_management_management_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3089,7 +3539,7 @@ func (x *DestroyUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DestroyUserRequest.ProtoReflect.Descriptor instead.
 func (*DestroyUserRequest) Descriptor() ([]byte, []int) {
-	return file_omni_management_management_proto_rawDescGZIP(), []int{43}
+	return file_omni_management_management_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *DestroyUserRequest) GetEmail() string {
@@ -3109,7 +3559,7 @@ type MachinePowerOffRequest struct {
 
 func (x *MachinePowerOffRequest) Reset() {
 	*x = MachinePowerOffRequest{}
-	mi := &file_omni_management_management_proto_msgTypes[44]
+	mi := &file_omni_management_management_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3121,7 +3571,7 @@ func (x *MachinePowerOffRequest) String() string {
 func (*MachinePowerOffRequest) ProtoMessage() {}
 
 func (x *MachinePowerOffRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_management_management_proto_msgTypes[44]
+	mi := &file_omni_management_management_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3134,7 +3584,7 @@ func (x *MachinePowerOffRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachinePowerOffRequest.ProtoReflect.Descriptor instead.
 func (*MachinePowerOffRequest) Descriptor() ([]byte, []int) {
-	return file_omni_management_management_proto_rawDescGZIP(), []int{44}
+	return file_omni_management_management_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *MachinePowerOffRequest) GetMachineId() string {
@@ -3152,7 +3602,7 @@ type MachinePowerOffResponse struct {
 
 func (x *MachinePowerOffResponse) Reset() {
 	*x = MachinePowerOffResponse{}
-	mi := &file_omni_management_management_proto_msgTypes[45]
+	mi := &file_omni_management_management_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3164,7 +3614,7 @@ func (x *MachinePowerOffResponse) String() string {
 func (*MachinePowerOffResponse) ProtoMessage() {}
 
 func (x *MachinePowerOffResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_management_management_proto_msgTypes[45]
+	mi := &file_omni_management_management_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3177,7 +3627,7 @@ func (x *MachinePowerOffResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachinePowerOffResponse.ProtoReflect.Descriptor instead.
 func (*MachinePowerOffResponse) Descriptor() ([]byte, []int) {
-	return file_omni_management_management_proto_rawDescGZIP(), []int{45}
+	return file_omni_management_management_proto_rawDescGZIP(), []int{51}
 }
 
 type MachinePowerOnRequest struct {
@@ -3190,7 +3640,7 @@ type MachinePowerOnRequest struct {
 
 func (x *MachinePowerOnRequest) Reset() {
 	*x = MachinePowerOnRequest{}
-	mi := &file_omni_management_management_proto_msgTypes[46]
+	mi := &file_omni_management_management_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3202,7 +3652,870 @@ func (x *MachinePowerOnRequest) String() string {
 func (*MachinePowerOnRequest) ProtoMessage() {}
 
 func (x *MachinePowerOnRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_management_management_proto_msgTypes[46]
+	mi := &file_omni_management_management_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MachinePowerOnRequest.ProtoReflect.Descriptor instead.
+func (*MachinePowerOnRequest) Descriptor() ([]byte, []int) {
+	return file_omni_management_management_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *MachinePowerOnRequest) GetMachineId() string {
+	if x != nil {
+		return x.MachineId
+	}
+	return ""
+}
+
+type MachinePowerOnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MachinePowerOnResponse) Reset() {
+	*x = MachinePowerOnResponse{}
+	mi := &file_omni_management_management_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MachinePowerOnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MachinePowerOnResponse) ProtoMessage() {}
+
+func (x *MachinePowerOnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_management_management_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MachinePowerOnResponse.ProtoReflect.Descriptor instead.
+func (*MachinePowerOnResponse) Descriptor() ([]byte, []int) {
+	return file_omni_management_management_proto_rawDescGZIP(), []int{53}
+}
+
+type ListUsersResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Users         []*ListUsersResponse_User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_omni_management_management_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersResponse) ProtoMessage() {}
+
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_management_management_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_omni_management_management_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListUsersResponse) GetUsers() []*ListUsersResponse_User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type RequestElevationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Role requested, Operator or Admin.
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// Clusters limits the elevation to the clusters with the matching names, glob patterns are supported.
+	Clusters []string `protobuf:"bytes,2,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	// Reason for the elevation, like the ticket it is needed for.
+	Reason        string               `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Duration      *durationpb.Duration `protobuf:"bytes,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestElevationRequest) Reset() {
+	*x = RequestElevationRequest{}
+	mi := &file_omni_management_management_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestElevationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestElevationRequest) ProtoMessage() {}
+
+func (x *RequestElevationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_management_management_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestElevationRequest.ProtoReflect.Descriptor instead.
+func (*RequestElevationRequest) Descriptor() ([]byte, []int) {
+	return file_omni_management_management_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RequestElevationRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *RequestElevationRequest) GetClusters() []string {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+func (x *RequestElevationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RequestElevationRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+type RequestElevationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestElevationResponse) Reset() {
+	*x = RequestElevationResponse{}
+	mi := &file_omni_management_management_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestElevationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestElevationResponse) ProtoMessage() {}
+
+func (x *RequestElevationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_management_management_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestElevationResponse.ProtoReflect.Descriptor instead.
+func (*RequestElevationResponse) Descriptor() ([]byte, []int) {
+	return file_omni_management_management_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *RequestElevationResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ApproveElevationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveElevationRequest) Reset() {
+	*x = ApproveElevationRequest{}
+	mi := &file_omni_management_management_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveElevationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveElevationRequest) ProtoMessage() {}
+
+func (x *ApproveElevationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_management_management_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveElevationRequest.ProtoReflect.Descriptor instead.
+func (*ApproveElevationRequest) Descriptor() ([]byte, []int) {
+	return file_omni_management_management_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ApproveElevationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RevokeElevationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeElevationRequest) Reset() {
+	*x = RevokeElevationRequest{}
+	mi := &file_omni_management_management_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeElevationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeElevationRequest) ProtoMessage() {}
+
+func (x *RevokeElevationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_management_management_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeElevationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeElevationRequest) Descriptor() ([]byte, []int) {
+	return file_omni_management_management_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RevokeElevationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListElevationsResponse struct {
+	state         protoimpl.MessageState              `protogen:"open.v1"`
+	Elevations    []*ListElevationsResponse_Elevation `protobuf:"bytes,1,rep,name=elevations,proto3" json:"elevations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListElevationsResponse) Reset() {
+	*x = ListElevationsResponse{}
+	mi := &file_omni_management_management_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListElevationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListElevationsResponse) ProtoMessage() {}
+
+func (x *ListElevationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_management_management_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListElevationsResponse.ProtoReflect.Descriptor instead.
+func (*ListElevationsResponse) Descriptor() ([]byte, []int) {
+	return file_omni_management_management_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListElevationsResponse) GetElevations() []*ListElevationsResponse_Elevation {
+	if x != nil {
+		return x.Elevations
+	}
+	return nil
+}
+
+type DetachClusterRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// cluster is the ID of the cluster to detach.
+	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// control_plane_endpoint replaces the Omni load balancer in the machine configs, defaults to the first control plane node.
+	ControlPlaneEndpoint string `protobuf:"bytes,2,opt,name=control_plane_endpoint,json=controlPlaneEndpoint,proto3" json:"control_plane_endpoint,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DetachClusterRequest) Reset() {
+	*x = DetachClusterRequest{}
+	mi := &file_omni_management_management_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetachClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachClusterRequest) ProtoMessage() {}
+
+func (x *DetachClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_management_management_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetachClusterRequest.ProtoReflect.Descriptor instead.
+func (*DetachClusterRequest) Descriptor() ([]byte, []int) {
+	return file_omni_management_management_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DetachClusterRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *DetachClusterRequest) GetControlPlaneEndpoint() string {
+	if x != nil {
+		return x.ControlPlaneEndpoint
+	}
+	return ""
+}
+
+type DetachClusterResponse struct {
+	state    protoimpl.MessageState           `protogen:"open.v1"`
+	Machines []*DetachClusterResponse_Machine `protobuf:"bytes,1,rep,name=machines,proto3" json:"machines,omitempty"`
+	// talosconfig is the admin talosconfig with the node endpoints.
+	Talosconfig []byte `protobuf:"bytes,2,opt,name=talosconfig,proto3" json:"talosconfig,omitempty"`
+	// kubeconfig is the admin kubeconfig pointing to the control plane endpoint.
+	Kubeconfig    []byte `protobuf:"bytes,3,opt,name=kubeconfig,proto3" json:"kubeconfig,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetachClusterResponse) Reset() {
+	*x = DetachClusterResponse{}
+	mi := &file_omni_management_management_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetachClusterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachClusterResponse) ProtoMessage() {}
+
+func (x *DetachClusterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_management_management_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetachClusterResponse.ProtoReflect.Descriptor instead.
+func (*DetachClusterResponse) Descriptor() ([]byte, []int) {
+	return file_omni_management_management_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DetachClusterResponse) GetMachines() []*DetachClusterResponse_Machine {
+	if x != nil {
+		return x.Machines
+	}
+	return nil
+}
+
+func (x *DetachClusterResponse) GetTalosconfig() []byte {
+	if x != nil {
+		return x.Talosconfig
+	}
+	return nil
+}
+
+func (x *DetachClusterResponse) GetKubeconfig() []byte {
+	if x != nil {
+		return x.Kubeconfig
+	}
+	return nil
+}
+
+type ReleaseDetachedClusterRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// cluster is the ID of the detached cluster to release.
+	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// force releases the cluster even if some of its machines are not connected to Omni.
+	// The detached config is not applied to these machines, it has to be applied to them manually.
+	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseDetachedClusterRequest) Reset() {
+	*x = ReleaseDetachedClusterRequest{}
+	mi := &file_omni_management_management_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseDetachedClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseDetachedClusterRequest) ProtoMessage() {}
+
+func (x *ReleaseDetachedClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_management_management_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseDetachedClusterRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseDetachedClusterRequest) Descriptor() ([]byte, []int) {
+	return file_omni_management_management_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ReleaseDetachedClusterRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *ReleaseDetachedClusterRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type ReleaseDetachedClusterResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// message indicates the current progress of the release.
+	Message       string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseDetachedClusterResponse) Reset() {
+	*x = ReleaseDetachedClusterResponse{}
+	mi := &file_omni_management_management_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseDetachedClusterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseDetachedClusterResponse) ProtoMessage() {}
+
+func (x *ReleaseDetachedClusterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_management_management_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseDetachedClusterResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseDetachedClusterResponse) Descriptor() ([]byte, []int) {
+	return file_omni_management_management_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ReleaseDetachedClusterResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type WorkloadProxyCredentialsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// alias is the alias of the exposed service.
+	Alias string `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	// csr is the PEM encoded certificate signing request for the client certificate, the certificate is not issued if it is empty.
+	Csr []byte `protobuf:"bytes,2,opt,name=csr,proto3" json:"csr,omitempty"`
+	// ttl is the lifetime of the credentials.
+	Ttl           *durationpb.Duration `protobuf:"bytes,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkloadProxyCredentialsRequest) Reset() {
+	*x = WorkloadProxyCredentialsRequest{}
+	mi := &file_omni_management_management_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkloadProxyCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkloadProxyCredentialsRequest) ProtoMessage() {}
+
+func (x *WorkloadProxyCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_management_management_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkloadProxyCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*WorkloadProxyCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_omni_management_management_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *WorkloadProxyCredentialsRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *WorkloadProxyCredentialsRequest) GetCsr() []byte {
+	if x != nil {
+		return x.Csr
+	}
+	return nil
+}
+
+func (x *WorkloadProxyCredentialsRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+type WorkloadProxyCredentialsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// token authenticates the requests to the exposed service.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// certificate is the PEM encoded client certificate issued for the csr.
+	Certificate []byte `protobuf:"bytes,2,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	// expiration is the time the credentials expire at.
+	Expiration *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// url is the URL of the exposed service.
+	Url           string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkloadProxyCredentialsResponse) Reset() {
+	*x = WorkloadProxyCredentialsResponse{}
+	mi := &file_omni_management_management_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkloadProxyCredentialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkloadProxyCredentialsResponse) ProtoMessage() {}
+
+func (x *WorkloadProxyCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_management_management_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkloadProxyCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*WorkloadProxyCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_omni_management_management_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *WorkloadProxyCredentialsResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *WorkloadProxyCredentialsResponse) GetCertificate() []byte {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+func (x *WorkloadProxyCredentialsResponse) GetExpiration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+func (x *WorkloadProxyCredentialsResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type ClusterAutoscalerCredentialsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// cluster is the name of the cluster the cluster autoscaler scales.
+	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// csr is the PEM encoded certificate signing request for the client certificate.
+	Csr []byte `protobuf:"bytes,2,opt,name=csr,proto3" json:"csr,omitempty"`
+	// ttl is the lifetime of the client certificate.
+	Ttl           *durationpb.Duration `protobuf:"bytes,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClusterAutoscalerCredentialsRequest) Reset() {
+	*x = ClusterAutoscalerCredentialsRequest{}
+	mi := &file_omni_management_management_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClusterAutoscalerCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterAutoscalerCredentialsRequest) ProtoMessage() {}
+
+func (x *ClusterAutoscalerCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_management_management_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterAutoscalerCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*ClusterAutoscalerCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_omni_management_management_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ClusterAutoscalerCredentialsRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *ClusterAutoscalerCredentialsRequest) GetCsr() []byte {
+	if x != nil {
+		return x.Csr
+	}
+	return nil
+}
+
+func (x *ClusterAutoscalerCredentialsRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+type ClusterAutoscalerCredentialsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// certificate is the PEM encoded client certificate issued for the csr.
+	Certificate []byte `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	// expiration is the time the certificate expires at.
+	Expiration *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// address is the endpoint of the cluster autoscaler service, in the form "host:port".
+	Address       string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClusterAutoscalerCredentialsResponse) Reset() {
+	*x = ClusterAutoscalerCredentialsResponse{}
+	mi := &file_omni_management_management_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClusterAutoscalerCredentialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterAutoscalerCredentialsResponse) ProtoMessage() {}
+
+func (x *ClusterAutoscalerCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_management_management_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterAutoscalerCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*ClusterAutoscalerCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_omni_management_management_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ClusterAutoscalerCredentialsResponse) GetCertificate() []byte {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+func (x *ClusterAutoscalerCredentialsResponse) GetExpiration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+func (x *ClusterAutoscalerCredentialsResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type ClusterLogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// cluster is the name of the cluster.
+	Cluster string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// selectors limit the logs to the machines of the cluster matching any of the label selectors.
+	Selectors []string `protobuf:"bytes,2,rep,name=selectors,proto3" json:"selectors,omitempty"`
+	// follow is whether to follow the logs.
+	Follow bool `protobuf:"varint,3,opt,name=follow,proto3" json:"follow,omitempty"`
+	// tail_lines is the number of lines to tail, the logs are read from the beginning if negative.
+	TailLines int32 `protobuf:"varint,4,opt,name=tail_lines,json=tailLines,proto3" json:"tail_lines,omitempty"`
+	// since limits the logs to the lines received at or after the given time.
+	Since *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=since,proto3" json:"since,omitempty"`
+	// until limits the logs to the lines received before the given time.
+	Until *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=until,proto3" json:"until,omitempty"`
+	// services limit the logs to the lines of the given Talos services.
+	Services []string `protobuf:"bytes,7,rep,name=services,proto3" json:"services,omitempty"`
+	// pattern limits the logs to the lines matching the regular expression.
+	Pattern       string `protobuf:"bytes,8,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClusterLogsRequest) Reset() {
+	*x = ClusterLogsRequest{}
+	mi := &file_omni_management_management_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClusterLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterLogsRequest) ProtoMessage() {}
+
+func (x *ClusterLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_management_management_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3213,76 +4526,96 @@ func (x *MachinePowerOnRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MachinePowerOnRequest.ProtoReflect.Descriptor instead.
-func (*MachinePowerOnRequest) Descriptor() ([]byte, []int) {
-	return file_omni_management_management_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use ClusterLogsRequest.ProtoReflect.Descriptor instead.
+func (*ClusterLogsRequest) Descriptor() ([]byte, []int) {
+	return file_omni_management_management_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *MachinePowerOnRequest) GetMachineId() string {
+func (x *ClusterLogsRequest) GetCluster() string {
 	if x != nil {
-		return x.MachineId
+		return x.Cluster
 	}
 	return ""
 }
 
-type MachinePowerOnResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ClusterLogsRequest) GetSelectors() []string {
+	if x != nil {
+		return x.Selectors
+	}
+	return nil
 }
 
-func (x *MachinePowerOnResponse) Reset() {
-	*x = MachinePowerOnResponse{}
-	mi := &file_omni_management_management_proto_msgTypes[47]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ClusterLogsRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
 }
 
-func (x *MachinePowerOnResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ClusterLogsRequest) GetTailLines() int32 {
+	if x != nil {
+		return x.TailLines
+	}
+	return 0
 }
 
-func (*MachinePowerOnResponse) ProtoMessage() {}
+func (x *ClusterLogsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
 
-func (x *MachinePowerOnResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_management_management_proto_msgTypes[47]
+func (x *ClusterLogsRequest) GetUntil() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Until
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use MachinePowerOnResponse.ProtoReflect.Descriptor instead.
-func (*MachinePowerOnResponse) Descriptor() ([]byte, []int) {
-	return file_omni_management_management_proto_rawDescGZIP(), []int{47}
+func (x *ClusterLogsRequest) GetServices() []string {
+	if x != nil {
+		return x.Services
+	}
+	return nil
 }
 
-type ListUsersResponse struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Users         []*ListUsersResponse_User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+func (x *ClusterLogsRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+type ClusterLogsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// machine_id is the ID of the machine the line was received from.
+	MachineId string `protobuf:"bytes,1,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`
+	// node is the node name of the machine in the cluster.
+	Node string `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	// data is the log line.
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// time is the time the line was received at.
+	Time          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUsersResponse) Reset() {
-	*x = ListUsersResponse{}
-	mi := &file_omni_management_management_proto_msgTypes[48]
+func (x *ClusterLogsResponse) Reset() {
+	*x = ClusterLogsResponse{}
+	mi := &file_omni_management_management_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUsersResponse) String() string {
+func (x *ClusterLogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUsersResponse) ProtoMessage() {}
+func (*ClusterLogsResponse) ProtoMessage() {}
 
-func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_management_management_proto_msgTypes[48]
+func (x *ClusterLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_management_management_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3293,14 +4626,35 @@ func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
-func (*ListUsersResponse) Descriptor() ([]byte, []int) {
-	return file_omni_management_management_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use ClusterLogsResponse.ProtoReflect.Descriptor instead.
+func (*ClusterLogsResponse) Descriptor() ([]byte, []int) {
+	return file_omni_management_management_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *ListUsersResponse) GetUsers() []*ListUsersResponse_User {
+func (x *ClusterLogsResponse) GetMachineId() string {
 	if x != nil {
-		return x.Users
+		return x.MachineId
+	}
+	return ""
+}
+
+func (x *ClusterLogsResponse) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *ClusterLogsResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ClusterLogsResponse) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
 	}
 	return nil
 }
@@ -3311,13 +4665,14 @@ type ListServiceAccountsResponse_ServiceAccount struct {
 	PgpPublicKeys []*ListServiceAccountsResponse_ServiceAccount_PgpPublicKey `protobuf:"bytes,2,rep,name=pgp_public_keys,json=pgpPublicKeys,proto3" json:"pgp_public_keys,omitempty"`
 	Role          string                                                     `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
 	LastActive    string                                                     `protobuf:"bytes,5,opt,name=last_active,json=lastActive,proto3" json:"last_active,omitempty"`
+	CustomRoles   []string                                                   `protobuf:"bytes,6,rep,name=custom_roles,json=customRoles,proto3" json:"custom_roles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListServiceAccountsResponse_ServiceAccount) Reset() {
 	*x = ListServiceAccountsResponse_ServiceAccount{}
-	mi := &file_omni_management_management_proto_msgTypes[49]
+	mi := &file_omni_management_management_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3329,7 +4684,7 @@ func (x *ListServiceAccountsResponse_ServiceAccount) String() string {
 func (*ListServiceAccountsResponse_ServiceAccount) ProtoMessage() {}
 
 func (x *ListServiceAccountsResponse_ServiceAccount) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_management_management_proto_msgTypes[49]
+	mi := &file_omni_management_management_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3373,6 +4728,13 @@ func (x *ListServiceAccountsResponse_ServiceAccount) GetLastActive() string {
 	return ""
 }
 
+func (x *ListServiceAccountsResponse_ServiceAccount) GetCustomRoles() []string {
+	if x != nil {
+		return x.CustomRoles
+	}
+	return nil
+}
+
 type ListServiceAccountsResponse_ServiceAccount_PgpPublicKey struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -3386,7 +4748,7 @@ type ListServiceAccountsResponse_ServiceAccount_PgpPublicKey struct {
 
 func (x *ListServiceAccountsResponse_ServiceAccount_PgpPublicKey) Reset() {
 	*x = ListServiceAccountsResponse_ServiceAccount_PgpPublicKey{}
-	mi := &file_omni_management_management_proto_msgTypes[50]
+	mi := &file_omni_management_management_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3398,7 +4760,7 @@ func (x *ListServiceAccountsResponse_ServiceAccount_PgpPublicKey) String() strin
 func (*ListServiceAccountsResponse_ServiceAccount_PgpPublicKey) ProtoMessage() {}
 
 func (x *ListServiceAccountsResponse_ServiceAccount_PgpPublicKey) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_management_management_proto_msgTypes[50]
+	mi := &file_omni_management_management_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3460,7 +4822,7 @@ type CreateSchematicRequest_Overlay struct {
 
 func (x *CreateSchematicRequest_Overlay) Reset() {
 	*x = CreateSchematicRequest_Overlay{}
-	mi := &file_omni_management_management_proto_msgTypes[51]
+	mi := &file_omni_management_management_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3472,7 +4834,7 @@ func (x *CreateSchematicRequest_Overlay) String() string {
 func (*CreateSchematicRequest_Overlay) ProtoMessage() {}
 
 func (x *CreateSchematicRequest_Overlay) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_management_management_proto_msgTypes[51]
+	mi := &file_omni_management_management_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3522,7 +4884,7 @@ type GetSupportBundleResponse_Progress struct {
 
 func (x *GetSupportBundleResponse_Progress) Reset() {
 	*x = GetSupportBundleResponse_Progress{}
-	mi := &file_omni_management_management_proto_msgTypes[54]
+	mi := &file_omni_management_management_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3534,7 +4896,7 @@ func (x *GetSupportBundleResponse_Progress) String() string {
 func (*GetSupportBundleResponse_Progress) ProtoMessage() {}
 
 func (x *GetSupportBundleResponse_Progress) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_management_management_proto_msgTypes[54]
+	mi := &file_omni_management_management_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3597,7 +4959,7 @@ type ValidateJsonSchemaResponse_Error struct {
 
 func (x *ValidateJsonSchemaResponse_Error) Reset() {
 	*x = ValidateJsonSchemaResponse_Error{}
-	mi := &file_omni_management_management_proto_msgTypes[55]
+	mi := &file_omni_management_management_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3609,7 +4971,7 @@ func (x *ValidateJsonSchemaResponse_Error) String() string {
 func (*ValidateJsonSchemaResponse_Error) ProtoMessage() {}
 
 func (x *ValidateJsonSchemaResponse_Error) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_management_management_proto_msgTypes[55]
+	mi := &file_omni_management_management_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3622,7 +4984,7 @@ func (x *ValidateJsonSchemaResponse_Error) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateJsonSchemaResponse_Error.ProtoReflect.Descriptor instead.
 func (*ValidateJsonSchemaResponse_Error) Descriptor() ([]byte, []int) {
-	return file_omni_management_management_proto_rawDescGZIP(), []int{28, 0}
+	return file_omni_management_management_proto_rawDescGZIP(), []int{31, 0}
 }
 
 func (x *ValidateJsonSchemaResponse_Error) GetErrors() []*ValidateJsonSchemaResponse_Error {
@@ -3660,13 +5022,14 @@ type ListUsersResponse_User struct {
 	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
 	SamlLabels    map[string]string      `protobuf:"bytes,4,rep,name=saml_labels,json=samlLabels,proto3" json:"saml_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	LastActive    string                 `protobuf:"bytes,5,opt,name=last_active,json=lastActive,proto3" json:"last_active,omitempty"`
+	CustomRoles   []string               `protobuf:"bytes,6,rep,name=custom_roles,json=customRoles,proto3" json:"custom_roles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListUsersResponse_User) Reset() {
 	*x = ListUsersResponse_User{}
-	mi := &file_omni_management_management_proto_msgTypes[56]
+	mi := &file_omni_management_management_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3678,7 +5041,7 @@ func (x *ListUsersResponse_User) String() string {
 func (*ListUsersResponse_User) ProtoMessage() {}
 
 func (x *ListUsersResponse_User) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_management_management_proto_msgTypes[56]
+	mi := &file_omni_management_management_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3691,7 +5054,7 @@ func (x *ListUsersResponse_User) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsersResponse_User.ProtoReflect.Descriptor instead.
 func (*ListUsersResponse_User) Descriptor() ([]byte, []int) {
-	return file_omni_management_management_proto_rawDescGZIP(), []int{48, 0}
+	return file_omni_management_management_proto_rawDescGZIP(), []int{54, 0}
 }
 
 func (x *ListUsersResponse_User) GetId() string {
@@ -3729,6 +5092,207 @@ func (x *ListUsersResponse_User) GetLastActive() string {
 	return ""
 }
 
+func (x *ListUsersResponse_User) GetCustomRoles() []string {
+	if x != nil {
+		return x.CustomRoles
+	}
+	return nil
+}
+
+type ListElevationsResponse_Elevation struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Identity string                 `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	Role     string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Clusters []string               `protobuf:"bytes,4,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	Reason   string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Duration *durationpb.Duration   `protobuf:"bytes,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	// State is one of pending, active, expired or revoked.
+	State         string                 `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`
+	ApprovedBy    string                 `protobuf:"bytes,8,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
+	RevokedBy     string                 `protobuf:"bytes,9,opt,name=revoked_by,json=revokedBy,proto3" json:"revoked_by,omitempty"`
+	Created       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created,proto3" json:"created,omitempty"`
+	Expiration    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListElevationsResponse_Elevation) Reset() {
+	*x = ListElevationsResponse_Elevation{}
+	mi := &file_omni_management_management_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListElevationsResponse_Elevation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListElevationsResponse_Elevation) ProtoMessage() {}
+
+func (x *ListElevationsResponse_Elevation) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_management_management_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListElevationsResponse_Elevation.ProtoReflect.Descriptor instead.
+func (*ListElevationsResponse_Elevation) Descriptor() ([]byte, []int) {
+	return file_omni_management_management_proto_rawDescGZIP(), []int{59, 0}
+}
+
+func (x *ListElevationsResponse_Elevation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListElevationsResponse_Elevation) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *ListElevationsResponse_Elevation) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ListElevationsResponse_Elevation) GetClusters() []string {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+func (x *ListElevationsResponse_Elevation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ListElevationsResponse_Elevation) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *ListElevationsResponse_Elevation) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ListElevationsResponse_Elevation) GetApprovedBy() string {
+	if x != nil {
+		return x.ApprovedBy
+	}
+	return ""
+}
+
+func (x *ListElevationsResponse_Elevation) GetRevokedBy() string {
+	if x != nil {
+		return x.RevokedBy
+	}
+	return ""
+}
+
+func (x *ListElevationsResponse_Elevation) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *ListElevationsResponse_Elevation) GetExpiration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+type DetachClusterResponse_Machine struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Hostname     string                 `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	ControlPlane bool                   `protobuf:"varint,3,opt,name=control_plane,json=controlPlane,proto3" json:"control_plane,omitempty"`
+	// config is the machine config with the cluster secrets, without the Omni specific settings.
+	Config        []byte `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetachClusterResponse_Machine) Reset() {
+	*x = DetachClusterResponse_Machine{}
+	mi := &file_omni_management_management_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetachClusterResponse_Machine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachClusterResponse_Machine) ProtoMessage() {}
+
+func (x *DetachClusterResponse_Machine) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_management_management_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetachClusterResponse_Machine.ProtoReflect.Descriptor instead.
+func (*DetachClusterResponse_Machine) Descriptor() ([]byte, []int) {
+	return file_omni_management_management_proto_rawDescGZIP(), []int{61, 0}
+}
+
+func (x *DetachClusterResponse_Machine) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DetachClusterResponse_Machine) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *DetachClusterResponse_Machine) GetControlPlane() bool {
+	if x != nil {
+		return x.ControlPlane
+	}
+	return false
+}
+
+func (x *DetachClusterResponse_Machine) GetConfig() []byte {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
 var File_omni_management_management_proto protoreflect.FileDescriptor
 
 const file_omni_management_management_proto_rawDesc = "" +
@@ -3756,12 +5320,13 @@ const file_omni_management_management_proto_rawDesc = "" +
 	"\x12TalosconfigRequest\x12\x10\n" +
 	"\x03raw\x18\x01 \x01(\bR\x03raw\x12\x1f\n" +
 	"\vbreak_glass\x18\x02 \x01(\bR\n" +
-	"breakGlass\"\xa4\x01\n" +
+	"breakGlass\"\xc7\x01\n" +
 	"\x1bCreateServiceAccountRequest\x123\n" +
 	"\x16armored_pgp_public_key\x18\x01 \x01(\tR\x13armoredPgpPublicKey\x12\"\n" +
 	"\ruse_user_role\x18\x03 \x01(\bR\vuseUserRole\x12\x12\n" +
 	"\x04role\x18\x04 \x01(\tR\x04role\x12\x12\n" +
-	"\x04name\x18\x05 \x01(\tR\x04nameJ\x04\b\x02\x10\x03\"B\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12!\n" +
+	"\fcustom_roles\x18\x06 \x03(\tR\vcustomRolesJ\x04\b\x02\x10\x03\"B\n" +
 	"\x1cCreateServiceAccountResponse\x12\"\n" +
 	"\rpublic_key_id\x18\x01 \x01(\tR\vpublicKeyId\"e\n" +
 	"\x1aRenewServiceAccountRequest\x12\x12\n" +
@@ -3770,15 +5335,16 @@ const file_omni_management_management_proto_rawDesc = "" +
 	"\x1bRenewServiceAccountResponse\x12\"\n" +
 	"\rpublic_key_id\x18\x01 \x01(\tR\vpublicKeyId\"2\n" +
 	"\x1cDestroyServiceAccountRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"\xb5\x04\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xd8\x04\n" +
 	"\x1bListServiceAccountsResponse\x12a\n" +
-	"\x10service_accounts\x18\x01 \x03(\v26.management.ListServiceAccountsResponse.ServiceAccountR\x0fserviceAccounts\x1a\xb2\x03\n" +
+	"\x10service_accounts\x18\x01 \x03(\v26.management.ListServiceAccountsResponse.ServiceAccountR\x0fserviceAccounts\x1a\xd5\x03\n" +
 	"\x0eServiceAccount\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12k\n" +
 	"\x0fpgp_public_keys\x18\x02 \x03(\v2C.management.ListServiceAccountsResponse.ServiceAccount.PgpPublicKeyR\rpgpPublicKeys\x12\x12\n" +
 	"\x04role\x18\x04 \x01(\tR\x04role\x12\x1f\n" +
 	"\vlast_active\x18\x05 \x01(\tR\n" +
-	"lastActive\x1a\xe3\x01\n" +
+	"lastActive\x12!\n" +
+	"\fcustom_roles\x18\x06 \x03(\tR\vcustomRoles\x1a\xe3\x01\n" +
 	"\fPgpPublicKey\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
 	"\aarmored\x18\x02 \x01(\tR\aarmored\x12:\n" +
@@ -3904,7 +5470,7 @@ const file_omni_management_management_proto_rawDesc = "" +
 	"\x05error\x18\x02 \x01(\tR\x05error\x12\x14\n" +
 	"\x05state\x18\x03 \x01(\tR\x05state\x12\x14\n" +
 	"\x05total\x18\x04 \x01(\x05R\x05total\x12\x14\n" +
-	"\x05value\x18\x05 \x01(\x05R\x05value\"\xfb\x03\n" +
+	"\x05value\x18\x05 \x01(\x05R\x05value\"\x9c\x04\n" +
 	"\x13ReadAuditLogRequest\x12\x1d\n" +
 	"\n" +
 	"start_time\x18\x01 \x01(\tR\tstartTime\x12\x19\n" +
@@ -3924,10 +5490,33 @@ const file_omni_management_management_proto_rawDesc = "" +
 	" \x01(\tR\x05actor\x12\x16\n" +
 	"\x06follow\x18\v \x01(\bR\x06follow\x12\x1e\n" +
 	"\vstart_ts_ms\x18\f \x01(\x03R\tstartTsMs\x12\x17\n" +
-	"\afrom_id\x18\r \x01(\x03R\x06fromId\"C\n" +
+	"\afrom_id\x18\r \x01(\x03R\x06fromId\x12\x1f\n" +
+	"\vwith_proofs\x18\x0e \x01(\bR\n" +
+	"withProofs\"\xfb\x01\n" +
+	"\x13AuditLogChainMarker\x128\n" +
+	"\x04kind\x18\x01 \x01(\x0e2$.management.AuditLogChainMarker.KindR\x04kind\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04hash\x18\x03 \x01(\fR\x04hash\x12!\n" +
+	"\ftimestamp_ms\x18\x04 \x01(\x03R\vtimestampMs\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x05 \x01(\fR\tpublicKey\x12\x1c\n" +
+	"\tsignature\x18\x06 \x01(\fR\tsignature\"&\n" +
+	"\x04Kind\x12\x0e\n" +
+	"\n" +
+	"CHECKPOINT\x10\x00\x12\x0e\n" +
+	"\n" +
+	"TRUNCATION\x10\x01\"{\n" +
+	"\rAuditLogProof\x12\x1b\n" +
+	"\tprev_hash\x18\x01 \x01(\fR\bprevHash\x12\x12\n" +
+	"\x04hash\x18\x02 \x01(\fR\x04hash\x129\n" +
+	"\amarkers\x18\x03 \x03(\v2\x1f.management.AuditLogChainMarkerR\amarkers\"t\n" +
 	"\x14ReadAuditLogResponse\x12\x1b\n" +
 	"\taudit_log\x18\x01 \x01(\fR\bauditLog\x12\x0e\n" +
-	"\x02id\x18\x02 \x01(\x03R\x02id\"G\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12/\n" +
+	"\x05proof\x18\x03 \x01(\v2\x19.management.AuditLogProofR\x05proof\";\n" +
+	"\x1aAuditLogSigningKeyResponse\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x01 \x01(\fR\tpublicKey\"G\n" +
 	"\x19ValidateJsonSchemaRequest\x12\x12\n" +
 	"\x04data\x18\x01 \x01(\tR\x04data\x12\x16\n" +
 	"\x06schema\x18\x02 \x01(\tR\x06schema\"\x86\x02\n" +
@@ -3955,6 +5544,12 @@ const file_omni_management_management_proto_rawDesc = "" +
 	"\x11OPERATION_INSTALL\x10\x01\x12\x15\n" +
 	"\x11OPERATION_UPGRADE\x10\x02\"8\n" +
 	"\x1cMaintenanceLifecycleResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"q\n" +
+	"\x12EtcdRestoreRequest\x12\x18\n" +
+	"\acluster\x18\x01 \x01(\tR\acluster\x12\x1a\n" +
+	"\bsnapshot\x18\x02 \x01(\tR\bsnapshot\x12%\n" +
+	"\x0ewipe_ephemeral\x18\x03 \x01(\bR\rwipeEphemeral\"/\n" +
+	"\x13EtcdRestoreResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\"d\n" +
 	"\x1bGetMachineJoinConfigRequest\x12&\n" +
 	"\x0fuse_grpc_tunnel\x18\x01 \x01(\bR\ruseGrpcTunnel\x12\x1d\n" +
@@ -3973,15 +5568,19 @@ const file_omni_management_management_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"-\n" +
 	"\x1bResetNodeUniqueTokenRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x1e\n" +
-	"\x1cResetNodeUniqueTokenResponse\"=\n" +
+	"\x1cResetNodeUniqueTokenResponse\"`\n" +
 	"\x11CreateUserRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x12\n" +
-	"\x04role\x18\x02 \x01(\tR\x04role\"-\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12!\n" +
+	"\fcustom_roles\x18\x03 \x03(\tR\vcustomRoles\"-\n" +
 	"\x12CreateUserResponse\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"=\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"#\n" +
+	"\vCustomRoles\x12\x14\n" +
+	"\x05roles\x18\x01 \x03(\tR\x05roles\"y\n" +
 	"\x11UpdateUserRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x12\n" +
-	"\x04role\x18\x02 \x01(\tR\x04role\"*\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12:\n" +
+	"\fcustom_roles\x18\x03 \x01(\v2\x17.management.CustomRolesR\vcustomRoles\"*\n" +
 	"\x12DestroyUserRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\"7\n" +
 	"\x16MachinePowerOffRequest\x12\x1d\n" +
@@ -3991,9 +5590,9 @@ const file_omni_management_management_proto_rawDesc = "" +
 	"\x15MachinePowerOnRequest\x12\x1d\n" +
 	"\n" +
 	"machine_id\x18\x01 \x01(\tR\tmachineId\"\x18\n" +
-	"\x16MachinePowerOnResponse\"\xc5\x02\n" +
+	"\x16MachinePowerOnResponse\"\xe8\x02\n" +
 	"\x11ListUsersResponse\x128\n" +
-	"\x05users\x18\x01 \x03(\v2\".management.ListUsersResponse.UserR\x05users\x1a\xf5\x01\n" +
+	"\x05users\x18\x01 \x03(\v2\".management.ListUsersResponse.UserR\x05users\x1a\x98\x02\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
@@ -4001,15 +5600,104 @@ const file_omni_management_management_proto_rawDesc = "" +
 	"\vsaml_labels\x18\x04 \x03(\v22.management.ListUsersResponse.User.SamlLabelsEntryR\n" +
 	"samlLabels\x12\x1f\n" +
 	"\vlast_active\x18\x05 \x01(\tR\n" +
-	"lastActive\x1a=\n" +
+	"lastActive\x12!\n" +
+	"\fcustom_roles\x18\x06 \x03(\tR\vcustomRoles\x1a=\n" +
 	"\x0fSamlLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01*O\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x98\x01\n" +
+	"\x17RequestElevationRequest\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12\x1a\n" +
+	"\bclusters\x18\x02 \x03(\tR\bclusters\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x125\n" +
+	"\bduration\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\bduration\"*\n" +
+	"\x18RequestElevationResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\")\n" +
+	"\x17ApproveElevationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"(\n" +
+	"\x16RevokeElevationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xe7\x03\n" +
+	"\x16ListElevationsResponse\x12L\n" +
+	"\n" +
+	"elevations\x18\x01 \x03(\v2,.management.ListElevationsResponse.ElevationR\n" +
+	"elevations\x1a\xfe\x02\n" +
+	"\tElevation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bidentity\x18\x02 \x01(\tR\bidentity\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x1a\n" +
+	"\bclusters\x18\x04 \x03(\tR\bclusters\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x125\n" +
+	"\bduration\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12\x14\n" +
+	"\x05state\x18\a \x01(\tR\x05state\x12\x1f\n" +
+	"\vapproved_by\x18\b \x01(\tR\n" +
+	"approvedBy\x12\x1d\n" +
+	"\n" +
+	"revoked_by\x18\t \x01(\tR\trevokedBy\x124\n" +
+	"\acreated\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\acreated\x12:\n" +
+	"\n" +
+	"expiration\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expiration\"f\n" +
+	"\x14DetachClusterRequest\x12\x18\n" +
+	"\acluster\x18\x01 \x01(\tR\acluster\x124\n" +
+	"\x16control_plane_endpoint\x18\x02 \x01(\tR\x14controlPlaneEndpoint\"\x94\x02\n" +
+	"\x15DetachClusterResponse\x12E\n" +
+	"\bmachines\x18\x01 \x03(\v2).management.DetachClusterResponse.MachineR\bmachines\x12 \n" +
+	"\vtalosconfig\x18\x02 \x01(\fR\vtalosconfig\x12\x1e\n" +
+	"\n" +
+	"kubeconfig\x18\x03 \x01(\fR\n" +
+	"kubeconfig\x1ar\n" +
+	"\aMachine\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bhostname\x18\x02 \x01(\tR\bhostname\x12#\n" +
+	"\rcontrol_plane\x18\x03 \x01(\bR\fcontrolPlane\x12\x16\n" +
+	"\x06config\x18\x04 \x01(\fR\x06config\"O\n" +
+	"\x1dReleaseDetachedClusterRequest\x12\x18\n" +
+	"\acluster\x18\x01 \x01(\tR\acluster\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\":\n" +
+	"\x1eReleaseDetachedClusterResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"v\n" +
+	"\x1fWorkloadProxyCredentialsRequest\x12\x14\n" +
+	"\x05alias\x18\x01 \x01(\tR\x05alias\x12\x10\n" +
+	"\x03csr\x18\x02 \x01(\fR\x03csr\x12+\n" +
+	"\x03ttl\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\"\xa8\x01\n" +
+	" WorkloadProxyCredentialsResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12 \n" +
+	"\vcertificate\x18\x02 \x01(\fR\vcertificate\x12:\n" +
+	"\n" +
+	"expiration\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expiration\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\"~\n" +
+	"#ClusterAutoscalerCredentialsRequest\x12\x18\n" +
+	"\acluster\x18\x01 \x01(\tR\acluster\x12\x10\n" +
+	"\x03csr\x18\x02 \x01(\fR\x03csr\x12+\n" +
+	"\x03ttl\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\"\x9e\x01\n" +
+	"$ClusterAutoscalerCredentialsResponse\x12 \n" +
+	"\vcertificate\x18\x01 \x01(\fR\vcertificate\x12:\n" +
+	"\n" +
+	"expiration\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expiration\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\"\x9d\x02\n" +
+	"\x12ClusterLogsRequest\x12\x18\n" +
+	"\acluster\x18\x01 \x01(\tR\acluster\x12\x1c\n" +
+	"\tselectors\x18\x02 \x03(\tR\tselectors\x12\x16\n" +
+	"\x06follow\x18\x03 \x01(\bR\x06follow\x12\x1d\n" +
+	"\n" +
+	"tail_lines\x18\x04 \x01(\x05R\ttailLines\x120\n" +
+	"\x05since\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x120\n" +
+	"\x05until\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\x12\x1a\n" +
+	"\bservices\x18\a \x03(\tR\bservices\x12\x18\n" +
+	"\apattern\x18\b \x01(\tR\apattern\"\x8c\x01\n" +
+	"\x13ClusterLogsResponse\x12\x1d\n" +
+	"\n" +
+	"machine_id\x18\x01 \x01(\tR\tmachineId\x12\x12\n" +
+	"\x04node\x18\x02 \x01(\tR\x04node\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\x12.\n" +
+	"\x04time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04time*O\n" +
 	"\x13SchematicBootloader\x12\r\n" +
 	"\tBOOT_AUTO\x10\x00\x12\r\n" +
 	"\tBOOT_DUAL\x10\x01\x12\v\n" +
 	"\aBOOT_SD\x10\x02\x12\r\n" +
-	"\tBOOT_GRUB\x10\x03*\xe7\x02\n" +
+	"\tBOOT_GRUB\x10\x03*\xbc\x03\n" +
 	"\x11AuditLogEventType\x12$\n" +
 	" AUDIT_LOG_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
 	"\x1bAUDIT_LOG_EVENT_TYPE_CREATE\x10\x01\x12\x1f\n" +
@@ -4019,7 +5707,10 @@ const file_omni_management_management_proto_rawDesc = "" +
 	"\x1dAUDIT_LOG_EVENT_TYPE_TEARDOWN\x10\x05\x12%\n" +
 	"!AUDIT_LOG_EVENT_TYPE_TALOS_ACCESS\x10\x06\x12#\n" +
 	"\x1fAUDIT_LOG_EVENT_TYPE_K8S_ACCESS\x10\a\x12)\n" +
-	"%AUDIT_LOG_EVENT_TYPE_AUDIT_LOG_ACCESS\x10\b*\xaf\x02\n" +
+	"%AUDIT_LOG_EVENT_TYPE_AUDIT_LOG_ACCESS\x10\b\x12.\n" +
+	"*AUDIT_LOG_EVENT_TYPE_WORKLOAD_PROXY_ACCESS\x10\t\x12#\n" +
+	"\x1fAUDIT_LOG_EVENT_TYPE_RATE_LIMIT\x10\n" +
+	"*\xaf\x02\n" +
 	"\x14AuditLogOrderByField\x12(\n" +
 	"$AUDIT_LOG_ORDER_BY_FIELD_UNSPECIFIED\x10\x00\x12!\n" +
 	"\x1dAUDIT_LOG_ORDER_BY_FIELD_DATE\x10\x01\x12'\n" +
@@ -4031,7 +5722,7 @@ const file_omni_management_management_proto_rawDesc = "" +
 	"\x12AuditLogOrderByDir\x12&\n" +
 	"\"AUDIT_LOG_ORDER_BY_DIR_UNSPECIFIED\x10\x00\x12\x1e\n" +
 	"\x1aAUDIT_LOG_ORDER_BY_DIR_ASC\x10\x01\x12\x1f\n" +
-	"\x1bAUDIT_LOG_ORDER_BY_DIR_DESC\x10\x022\x86\x14\n" +
+	"\x1bAUDIT_LOG_ORDER_BY_DIR_DESC\x10\x022\x91\x1c\n" +
 	"\x11ManagementService\x12K\n" +
 	"\n" +
 	"Kubeconfig\x12\x1d.management.KubeconfigRequest\x1a\x1e.management.KubeconfigResponse\x12N\n" +
@@ -4051,9 +5742,11 @@ const file_omni_management_management_proto_rawDesc = "" +
 	"\x16CreateSchematicFromRaw\x12).management.CreateSchematicFromRawRequest\x1a#.management.CreateSchematicResponse\x12T\n" +
 	"\x0fGetBootAssetURL\x12\x1f.management.BootAssetURLRequest\x1a .management.BootAssetURLResponse\x12_\n" +
 	"\x10GetSupportBundle\x12#.management.GetSupportBundleRequest\x1a$.management.GetSupportBundleResponse0\x01\x12S\n" +
-	"\fReadAuditLog\x12\x1f.management.ReadAuditLogRequest\x1a .management.ReadAuditLogResponse0\x01\x12c\n" +
+	"\fReadAuditLog\x12\x1f.management.ReadAuditLogRequest\x1a .management.ReadAuditLogResponse0\x01\x12T\n" +
+	"\x12AuditLogSigningKey\x12\x16.google.protobuf.Empty\x1a&.management.AuditLogSigningKeyResponse\x12c\n" +
 	"\x12MaintenanceUpgrade\x12%.management.MaintenanceUpgradeRequest\x1a&.management.MaintenanceUpgradeResponse\x12k\n" +
-	"\x14MaintenanceLifecycle\x12'.management.MaintenanceLifecycleRequest\x1a(.management.MaintenanceLifecycleResponse0\x01\x12i\n" +
+	"\x14MaintenanceLifecycle\x12'.management.MaintenanceLifecycleRequest\x1a(.management.MaintenanceLifecycleResponse0\x01\x12P\n" +
+	"\vEtcdRestore\x12\x1e.management.EtcdRestoreRequest\x1a\x1f.management.EtcdRestoreResponse0\x01\x12i\n" +
 	"\x14GetMachineJoinConfig\x12'.management.GetMachineJoinConfigRequest\x1a(.management.GetMachineJoinConfigResponse\x12Z\n" +
 	"\x0fCreateJoinToken\x12\".management.CreateJoinTokenRequest\x1a#.management.CreateJoinTokenResponse\x12i\n" +
 	"\x14ResetNodeUniqueToken\x12'.management.ResetNodeUniqueTokenRequest\x1a(.management.ResetNodeUniqueTokenResponse\x12K\n" +
@@ -4064,7 +5757,16 @@ const file_omni_management_management_proto_rawDesc = "" +
 	"UpdateUser\x12\x1d.management.UpdateUserRequest\x1a\x16.google.protobuf.Empty\x12E\n" +
 	"\vDestroyUser\x12\x1e.management.DestroyUserRequest\x1a\x16.google.protobuf.Empty\x12Z\n" +
 	"\x0fMachinePowerOff\x12\".management.MachinePowerOffRequest\x1a#.management.MachinePowerOffResponse\x12W\n" +
-	"\x0eMachinePowerOn\x12!.management.MachinePowerOnRequest\x1a\".management.MachinePowerOnResponseB7Z5github.com/siderolabs/omni/client/api/omni/managementb\x06proto3"
+	"\x0eMachinePowerOn\x12!.management.MachinePowerOnRequest\x1a\".management.MachinePowerOnResponse\x12]\n" +
+	"\x10RequestElevation\x12#.management.RequestElevationRequest\x1a$.management.RequestElevationResponse\x12O\n" +
+	"\x10ApproveElevation\x12#.management.ApproveElevationRequest\x1a\x16.google.protobuf.Empty\x12M\n" +
+	"\x0fRevokeElevation\x12\".management.RevokeElevationRequest\x1a\x16.google.protobuf.Empty\x12L\n" +
+	"\x0eListElevations\x12\x16.google.protobuf.Empty\x1a\".management.ListElevationsResponse\x12T\n" +
+	"\rDetachCluster\x12 .management.DetachClusterRequest\x1a!.management.DetachClusterResponse\x12q\n" +
+	"\x16ReleaseDetachedCluster\x12).management.ReleaseDetachedClusterRequest\x1a*.management.ReleaseDetachedClusterResponse0\x01\x12u\n" +
+	"\x18WorkloadProxyCredentials\x12+.management.WorkloadProxyCredentialsRequest\x1a,.management.WorkloadProxyCredentialsResponse\x12\x81\x01\n" +
+	"\x1cClusterAutoscalerCredentials\x12/.management.ClusterAutoscalerCredentialsRequest\x1a0.management.ClusterAutoscalerCredentialsResponse\x12P\n" +
+	"\vClusterLogs\x12\x1e.management.ClusterLogsRequest\x1a\x1f.management.ClusterLogsResponse0\x01B7Z5github.com/siderolabs/omni/client/api/omni/managementb\x06proto3"
 
 var (
 	file_omni_management_management_proto_rawDescOnce sync.Once
@@ -4078,8 +5780,8 @@ func file_omni_management_management_proto_rawDescGZIP() []byte {
 	return file_omni_management_management_proto_rawDescData
 }
 
-var file_omni_management_management_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
-var file_omni_management_management_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
+var file_omni_management_management_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_omni_management_management_proto_msgTypes = make([]protoimpl.MessageInfo, 81)
 var file_omni_management_management_proto_goTypes = []any{
 	(SchematicBootloader)(0),                                        // 0: management.SchematicBootloader
 	(AuditLogEventType)(0),                                          // 1: management.AuditLogEventType
@@ -4089,158 +5791,221 @@ var file_omni_management_management_proto_goTypes = []any{
 	(KubernetesSyncManifestResponse_ResponseType)(0),                // 5: management.KubernetesSyncManifestResponse.ResponseType
 	(CreateSchematicRequest_SiderolinkGRPCTunnelMode)(0),            // 6: management.CreateSchematicRequest.SiderolinkGRPCTunnelMode
 	(BootAssetURLRequest_BootAssetKind)(0),                          // 7: management.BootAssetURLRequest.BootAssetKind
-	(MaintenanceLifecycleRequest_Operation)(0),                      // 8: management.MaintenanceLifecycleRequest.Operation
-	(*KubeconfigResponse)(nil),                                      // 9: management.KubeconfigResponse
-	(*TalosconfigResponse)(nil),                                     // 10: management.TalosconfigResponse
-	(*OmniconfigResponse)(nil),                                      // 11: management.OmniconfigResponse
-	(*MachineLogsRequest)(nil),                                      // 12: management.MachineLogsRequest
-	(*ValidateConfigRequest)(nil),                                   // 13: management.ValidateConfigRequest
-	(*TalosconfigRequest)(nil),                                      // 14: management.TalosconfigRequest
-	(*CreateServiceAccountRequest)(nil),                             // 15: management.CreateServiceAccountRequest
-	(*CreateServiceAccountResponse)(nil),                            // 16: management.CreateServiceAccountResponse
-	(*RenewServiceAccountRequest)(nil),                              // 17: management.RenewServiceAccountRequest
-	(*RenewServiceAccountResponse)(nil),                             // 18: management.RenewServiceAccountResponse
-	(*DestroyServiceAccountRequest)(nil),                            // 19: management.DestroyServiceAccountRequest
-	(*ListServiceAccountsResponse)(nil),                             // 20: management.ListServiceAccountsResponse
-	(*KubeconfigRequest)(nil),                                       // 21: management.KubeconfigRequest
-	(*KubernetesUpgradePreChecksRequest)(nil),                       // 22: management.KubernetesUpgradePreChecksRequest
-	(*KubernetesUpgradePreChecksResponse)(nil),                      // 23: management.KubernetesUpgradePreChecksResponse
-	(*KubernetesSSAOptions)(nil),                                    // 24: management.KubernetesSSAOptions
-	(*KubernetesSyncManifestRequest)(nil),                           // 25: management.KubernetesSyncManifestRequest
-	(*KubernetesSyncManifestResponse)(nil),                          // 26: management.KubernetesSyncManifestResponse
-	(*CreateSchematicRequest)(nil),                                  // 27: management.CreateSchematicRequest
-	(*CreateSchematicFromRawRequest)(nil),                           // 28: management.CreateSchematicFromRawRequest
-	(*CreateSchematicResponse)(nil),                                 // 29: management.CreateSchematicResponse
-	(*BootAssetURLRequest)(nil),                                     // 30: management.BootAssetURLRequest
-	(*BootAssetURLResponse)(nil),                                    // 31: management.BootAssetURLResponse
-	(*GetSupportBundleRequest)(nil),                                 // 32: management.GetSupportBundleRequest
-	(*GetSupportBundleResponse)(nil),                                // 33: management.GetSupportBundleResponse
-	(*ReadAuditLogRequest)(nil),                                     // 34: management.ReadAuditLogRequest
-	(*ReadAuditLogResponse)(nil),                                    // 35: management.ReadAuditLogResponse
-	(*ValidateJsonSchemaRequest)(nil),                               // 36: management.ValidateJsonSchemaRequest
-	(*ValidateJsonSchemaResponse)(nil),                              // 37: management.ValidateJsonSchemaResponse
-	(*MaintenanceUpgradeRequest)(nil),                               // 38: management.MaintenanceUpgradeRequest
-	(*MaintenanceUpgradeResponse)(nil),                              // 39: management.MaintenanceUpgradeResponse
-	(*MaintenanceLifecycleRequest)(nil),                             // 40: management.MaintenanceLifecycleRequest
-	(*MaintenanceLifecycleResponse)(nil),                            // 41: management.MaintenanceLifecycleResponse
-	(*GetMachineJoinConfigRequest)(nil),                             // 42: management.GetMachineJoinConfigRequest
-	(*GetMachineJoinConfigResponse)(nil),                            // 43: management.GetMachineJoinConfigResponse
-	(*GenJoinTokenResponse)(nil),                                    // 44: management.GenJoinTokenResponse
-	(*CreateJoinTokenRequest)(nil),                                  // 45: management.CreateJoinTokenRequest
-	(*CreateJoinTokenResponse)(nil),                                 // 46: management.CreateJoinTokenResponse
-	(*ResetNodeUniqueTokenRequest)(nil),                             // 47: management.ResetNodeUniqueTokenRequest
-	(*ResetNodeUniqueTokenResponse)(nil),                            // 48: management.ResetNodeUniqueTokenResponse
-	(*CreateUserRequest)(nil),                                       // 49: management.CreateUserRequest
-	(*CreateUserResponse)(nil),                                      // 50: management.CreateUserResponse
-	(*UpdateUserRequest)(nil),                                       // 51: management.UpdateUserRequest
-	(*DestroyUserRequest)(nil),                                      // 52: management.DestroyUserRequest
-	(*MachinePowerOffRequest)(nil),                                  // 53: management.MachinePowerOffRequest
-	(*MachinePowerOffResponse)(nil),                                 // 54: management.MachinePowerOffResponse
-	(*MachinePowerOnRequest)(nil),                                   // 55: management.MachinePowerOnRequest
-	(*MachinePowerOnResponse)(nil),                                  // 56: management.MachinePowerOnResponse
-	(*ListUsersResponse)(nil),                                       // 57: management.ListUsersResponse
-	(*ListServiceAccountsResponse_ServiceAccount)(nil),              // 58: management.ListServiceAccountsResponse.ServiceAccount
-	(*ListServiceAccountsResponse_ServiceAccount_PgpPublicKey)(nil), // 59: management.ListServiceAccountsResponse.ServiceAccount.PgpPublicKey
-	(*CreateSchematicRequest_Overlay)(nil),                          // 60: management.CreateSchematicRequest.Overlay
-	nil,                                                             // 61: management.CreateSchematicRequest.MetaValuesEntry
-	nil,                                                             // 62: management.BootAssetURLResponse.HeadersEntry
-	(*GetSupportBundleResponse_Progress)(nil),                       // 63: management.GetSupportBundleResponse.Progress
-	(*ValidateJsonSchemaResponse_Error)(nil),                        // 64: management.ValidateJsonSchemaResponse.Error
-	(*ListUsersResponse_User)(nil),                                  // 65: management.ListUsersResponse.User
-	nil,                                                             // 66: management.ListUsersResponse.User.SamlLabelsEntry
-	(*durationpb.Duration)(nil),                                     // 67: google.protobuf.Duration
-	(*timestamppb.Timestamp)(nil),                                   // 68: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                                           // 69: google.protobuf.Empty
-	(*common.Data)(nil),                                             // 70: common.Data
+	(AuditLogChainMarker_Kind)(0),                                   // 8: management.AuditLogChainMarker.Kind
+	(MaintenanceLifecycleRequest_Operation)(0),                      // 9: management.MaintenanceLifecycleRequest.Operation
+	(*KubeconfigResponse)(nil),                                      // 10: management.KubeconfigResponse
+	(*TalosconfigResponse)(nil),                                     // 11: management.TalosconfigResponse
+	(*OmniconfigResponse)(nil),                                      // 12: management.OmniconfigResponse
+	(*MachineLogsRequest)(nil),                                      // 13: management.MachineLogsRequest
+	(*ValidateConfigRequest)(nil),                                   // 14: management.ValidateConfigRequest
+	(*TalosconfigRequest)(nil),                                      // 15: management.TalosconfigRequest
+	(*CreateServiceAccountRequest)(nil),                             // 16: management.CreateServiceAccountRequest
+	(*CreateServiceAccountResponse)(nil),                            // 17: management.CreateServiceAccountResponse
+	(*RenewServiceAccountRequest)(nil),                              // 18: management.RenewServiceAccountRequest
+	(*RenewServiceAccountResponse)(nil),                             // 19: management.RenewServiceAccountResponse
+	(*DestroyServiceAccountRequest)(nil),                            // 20: management.DestroyServiceAccountRequest
+	(*ListServiceAccountsResponse)(nil),                             // 21: management.ListServiceAccountsResponse
+	(*KubeconfigRequest)(nil),                                       // 22: management.KubeconfigRequest
+	(*KubernetesUpgradePreChecksRequest)(nil),                       // 23: management.KubernetesUpgradePreChecksRequest
+	(*KubernetesUpgradePreChecksResponse)(nil),                      // 24: management.KubernetesUpgradePreChecksResponse
+	(*KubernetesSSAOptions)(nil),                                    // 25: management.KubernetesSSAOptions
+	(*KubernetesSyncManifestRequest)(nil),                           // 26: management.KubernetesSyncManifestRequest
+	(*KubernetesSyncManifestResponse)(nil),                          // 27: management.KubernetesSyncManifestResponse
+	(*CreateSchematicRequest)(nil),                                  // 28: management.CreateSchematicRequest
+	(*CreateSchematicFromRawRequest)(nil),                           // 29: management.CreateSchematicFromRawRequest
+	(*CreateSchematicResponse)(nil),                                 // 30: management.CreateSchematicResponse
+	(*BootAssetURLRequest)(nil),                                     // 31: management.BootAssetURLRequest
+	(*BootAssetURLResponse)(nil),                                    // 32: management.BootAssetURLResponse
+	(*GetSupportBundleRequest)(nil),                                 // 33: management.GetSupportBundleRequest
+	(*GetSupportBundleResponse)(nil),                                // 34: management.GetSupportBundleResponse
+	(*ReadAuditLogRequest)(nil),                                     // 35: management.ReadAuditLogRequest
+	(*AuditLogChainMarker)(nil),                                     // 36: management.AuditLogChainMarker
+	(*AuditLogProof)(nil),                                           // 37: management.AuditLogProof
+	(*ReadAuditLogResponse)(nil),                                    // 38: management.ReadAuditLogResponse
+	(*AuditLogSigningKeyResponse)(nil),                              // 39: management.AuditLogSigningKeyResponse
+	(*ValidateJsonSchemaRequest)(nil),                               // 40: management.ValidateJsonSchemaRequest
+	(*ValidateJsonSchemaResponse)(nil),                              // 41: management.ValidateJsonSchemaResponse
+	(*MaintenanceUpgradeRequest)(nil),                               // 42: management.MaintenanceUpgradeRequest
+	(*MaintenanceUpgradeResponse)(nil),                              // 43: management.MaintenanceUpgradeResponse
+	(*MaintenanceLifecycleRequest)(nil),                             // 44: management.MaintenanceLifecycleRequest
+	(*MaintenanceLifecycleResponse)(nil),                            // 45: management.MaintenanceLifecycleResponse
+	(*EtcdRestoreRequest)(nil),                                      // 46: management.EtcdRestoreRequest
+	(*EtcdRestoreResponse)(nil),                                     // 47: management.EtcdRestoreResponse
+	(*GetMachineJoinConfigRequest)(nil),                             // 48: management.GetMachineJoinConfigRequest
+	(*GetMachineJoinConfigResponse)(nil),                            // 49: management.GetMachineJoinConfigResponse
+	(*GenJoinTokenResponse)(nil),                                    // 50: management.GenJoinTokenResponse
+	(*CreateJoinTokenRequest)(nil),                                  // 51: management.CreateJoinTokenRequest
+	(*CreateJoinTokenResponse)(nil),                                 // 52: management.CreateJoinTokenResponse
+	(*ResetNodeUniqueTokenRequest)(nil),                             // 53: management.ResetNodeUniqueTokenRequest
+	(*ResetNodeUniqueTokenResponse)(nil),                            // 54: management.ResetNodeUniqueTokenResponse
+	(*CreateUserRequest)(nil),                                       // 55: management.CreateUserRequest
+	(*CreateUserResponse)(nil),                                      // 56: management.CreateUserResponse
+	(*CustomRoles)(nil),                                             // 57: management.CustomRoles
+	(*UpdateUserRequest)(nil),                                       // 58: management.UpdateUserRequest
+	(*DestroyUserRequest)(nil),                                      // 59: management.DestroyUserRequest
+	(*MachinePowerOffRequest)(nil),                                  // 60: management.MachinePowerOffRequest
+	(*MachinePowerOffResponse)(nil),                                 // 61: management.MachinePowerOffResponse
+	(*MachinePowerOnRequest)(nil),                                   // 62: management.MachinePowerOnRequest
+	(*MachinePowerOnResponse)(nil),                                  // 63: management.MachinePowerOnResponse
+	(*ListUsersResponse)(nil),                                       // 64: management.ListUsersResponse
+	(*RequestElevationRequest)(nil),                                 // 65: management.RequestElevationRequest
+	(*RequestElevationResponse)(nil),                                // 66: management.RequestElevationResponse
+	(*ApproveElevationRequest)(nil),                                 // 67: management.ApproveElevationRequest
+	(*RevokeElevationRequest)(nil),                                  // 68: management.RevokeElevationRequest
+	(*ListElevationsResponse)(nil),                                  // 69: management.ListElevationsResponse
+	(*DetachClusterRequest)(nil),                                    // 70: management.DetachClusterRequest
+	(*DetachClusterResponse)(nil),                                   // 71: management.DetachClusterResponse
+	(*ReleaseDetachedClusterRequest)(nil),                           // 72: management.ReleaseDetachedClusterRequest
+	(*ReleaseDetachedClusterResponse)(nil),                          // 73: management.ReleaseDetachedClusterResponse
+	(*WorkloadProxyCredentialsRequest)(nil),                         // 74: management.WorkloadProxyCredentialsRequest
+	(*WorkloadProxyCredentialsResponse)(nil),                        // 75: management.WorkloadProxyCredentialsResponse
+	(*ClusterAutoscalerCredentialsRequest)(nil),                     // 76: management.ClusterAutoscalerCredentialsRequest
+	(*ClusterAutoscalerCredentialsResponse)(nil),                    // 77: management.ClusterAutoscalerCredentialsResponse
+	(*ClusterLogsRequest)(nil),                                      // 78: management.ClusterLogsRequest
+	(*ClusterLogsResponse)(nil),                                     // 79: management.ClusterLogsResponse
+	(*ListServiceAccountsResponse_ServiceAccount)(nil),              // 80: management.ListServiceAccountsResponse.ServiceAccount
+	(*ListServiceAccountsResponse_ServiceAccount_PgpPublicKey)(nil), // 81: management.ListServiceAccountsResponse.ServiceAccount.PgpPublicKey
+	(*CreateSchematicRequest_Overlay)(nil),                          // 82: management.CreateSchematicRequest.Overlay
+	nil,                                                             // 83: management.CreateSchematicRequest.MetaValuesEntry
+	nil,                                                             // 84: management.BootAssetURLResponse.HeadersEntry
+	(*GetSupportBundleResponse_Progress)(nil),                       // 85: management.GetSupportBundleResponse.Progress
+	(*ValidateJsonSchemaResponse_Error)(nil),                        // 86: management.ValidateJsonSchemaResponse.Error
+	(*ListUsersResponse_User)(nil),                                  // 87: management.ListUsersResponse.User
+	nil,                                                             // 88: management.ListUsersResponse.User.SamlLabelsEntry
+	(*ListElevationsResponse_Elevation)(nil),                        // 89: management.ListElevationsResponse.Elevation
+	(*DetachClusterResponse_Machine)(nil),                           // 90: management.DetachClusterResponse.Machine
+	(*durationpb.Duration)(nil),                                     // 91: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),                                   // 92: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                                           // 93: google.protobuf.Empty
+	(*common.Data)(nil),                                             // 94: common.Data
 }
 var file_omni_management_management_proto_depIdxs = []int32{
-	58, // 0: management.ListServiceAccountsResponse.service_accounts:type_name -> management.ListServiceAccountsResponse.ServiceAccount
-	67, // 1: management.KubeconfigRequest.service_account_ttl:type_name -> google.protobuf.Duration
+	80, // 0: management.ListServiceAccountsResponse.service_accounts:type_name -> management.ListServiceAccountsResponse.ServiceAccount
+	91, // 1: management.KubeconfigRequest.service_account_ttl:type_name -> google.protobuf.Duration
 	4,  // 2: management.KubernetesSSAOptions.inventory_policy:type_name -> management.KubernetesSSAOptions.InventoryPolicy
-	67, // 3: management.KubernetesSSAOptions.reconcile_timeout:type_name -> google.protobuf.Duration
-	24, // 4: management.KubernetesSyncManifestRequest.ssa:type_name -> management.KubernetesSSAOptions
+	91, // 3: management.KubernetesSSAOptions.reconcile_timeout:type_name -> google.protobuf.Duration
+	25, // 4: management.KubernetesSyncManifestRequest.ssa:type_name -> management.KubernetesSSAOptions
 	5,  // 5: management.KubernetesSyncManifestResponse.response_type:type_name -> management.KubernetesSyncManifestResponse.ResponseType
-	61, // 6: management.CreateSchematicRequest.meta_values:type_name -> management.CreateSchematicRequest.MetaValuesEntry
+	83, // 6: management.CreateSchematicRequest.meta_values:type_name -> management.CreateSchematicRequest.MetaValuesEntry
 	6,  // 7: management.CreateSchematicRequest.siderolink_grpc_tunnel_mode:type_name -> management.CreateSchematicRequest.SiderolinkGRPCTunnelMode
-	60, // 8: management.CreateSchematicRequest.overlay:type_name -> management.CreateSchematicRequest.Overlay
+	82, // 8: management.CreateSchematicRequest.overlay:type_name -> management.CreateSchematicRequest.Overlay
 	0,  // 9: management.CreateSchematicRequest.bootloader:type_name -> management.SchematicBootloader
 	7,  // 10: management.BootAssetURLRequest.boot_asset_kind:type_name -> management.BootAssetURLRequest.BootAssetKind
-	62, // 11: management.BootAssetURLResponse.headers:type_name -> management.BootAssetURLResponse.HeadersEntry
-	63, // 12: management.GetSupportBundleResponse.progress:type_name -> management.GetSupportBundleResponse.Progress
+	84, // 11: management.BootAssetURLResponse.headers:type_name -> management.BootAssetURLResponse.HeadersEntry
+	85, // 12: management.GetSupportBundleResponse.progress:type_name -> management.GetSupportBundleResponse.Progress
 	2,  // 13: management.ReadAuditLogRequest.order_by_field:type_name -> management.AuditLogOrderByField
 	3,  // 14: management.ReadAuditLogRequest.order_by_dir:type_name -> management.AuditLogOrderByDir
 	1,  // 15: management.ReadAuditLogRequest.event_type:type_name -> management.AuditLogEventType
-	64, // 16: management.ValidateJsonSchemaResponse.errors:type_name -> management.ValidateJsonSchemaResponse.Error
-	8,  // 17: management.MaintenanceLifecycleRequest.operation:type_name -> management.MaintenanceLifecycleRequest.Operation
-	68, // 18: management.CreateJoinTokenRequest.expiration_time:type_name -> google.protobuf.Timestamp
-	65, // 19: management.ListUsersResponse.users:type_name -> management.ListUsersResponse.User
-	59, // 20: management.ListServiceAccountsResponse.ServiceAccount.pgp_public_keys:type_name -> management.ListServiceAccountsResponse.ServiceAccount.PgpPublicKey
-	68, // 21: management.ListServiceAccountsResponse.ServiceAccount.PgpPublicKey.expiration:type_name -> google.protobuf.Timestamp
-	68, // 22: management.ListServiceAccountsResponse.ServiceAccount.PgpPublicKey.created:type_name -> google.protobuf.Timestamp
-	68, // 23: management.ListServiceAccountsResponse.ServiceAccount.PgpPublicKey.last_used:type_name -> google.protobuf.Timestamp
-	64, // 24: management.ValidateJsonSchemaResponse.Error.errors:type_name -> management.ValidateJsonSchemaResponse.Error
-	66, // 25: management.ListUsersResponse.User.saml_labels:type_name -> management.ListUsersResponse.User.SamlLabelsEntry
-	21, // 26: management.ManagementService.Kubeconfig:input_type -> management.KubeconfigRequest
-	14, // 27: management.ManagementService.Talosconfig:input_type -> management.TalosconfigRequest
-	69, // 28: management.ManagementService.Omniconfig:input_type -> google.protobuf.Empty
-	12, // 29: management.ManagementService.MachineLogs:input_type -> management.MachineLogsRequest
-	13, // 30: management.ManagementService.ValidateConfig:input_type -> management.ValidateConfigRequest
-	36, // 31: management.ManagementService.ValidateJSONSchema:input_type -> management.ValidateJsonSchemaRequest
-	15, // 32: management.ManagementService.CreateServiceAccount:input_type -> management.CreateServiceAccountRequest
-	17, // 33: management.ManagementService.RenewServiceAccount:input_type -> management.RenewServiceAccountRequest
-	69, // 34: management.ManagementService.ListServiceAccounts:input_type -> google.protobuf.Empty
-	19, // 35: management.ManagementService.DestroyServiceAccount:input_type -> management.DestroyServiceAccountRequest
-	22, // 36: management.ManagementService.KubernetesUpgradePreChecks:input_type -> management.KubernetesUpgradePreChecksRequest
-	25, // 37: management.ManagementService.KubernetesSyncManifests:input_type -> management.KubernetesSyncManifestRequest
-	27, // 38: management.ManagementService.CreateSchematic:input_type -> management.CreateSchematicRequest
-	28, // 39: management.ManagementService.CreateSchematicFromRaw:input_type -> management.CreateSchematicFromRawRequest
-	30, // 40: management.ManagementService.GetBootAssetURL:input_type -> management.BootAssetURLRequest
-	32, // 41: management.ManagementService.GetSupportBundle:input_type -> management.GetSupportBundleRequest
-	34, // 42: management.ManagementService.ReadAuditLog:input_type -> management.ReadAuditLogRequest
-	38, // 43: management.ManagementService.MaintenanceUpgrade:input_type -> management.MaintenanceUpgradeRequest
-	40, // 44: management.ManagementService.MaintenanceLifecycle:input_type -> management.MaintenanceLifecycleRequest
-	42, // 45: management.ManagementService.GetMachineJoinConfig:input_type -> management.GetMachineJoinConfigRequest
-	45, // 46: management.ManagementService.CreateJoinToken:input_type -> management.CreateJoinTokenRequest
-	47, // 47: management.ManagementService.ResetNodeUniqueToken:input_type -> management.ResetNodeUniqueTokenRequest
-	49, // 48: management.ManagementService.CreateUser:input_type -> management.CreateUserRequest
-	69, // 49: management.ManagementService.ListUsers:input_type -> google.protobuf.Empty
-	51, // 50: management.ManagementService.UpdateUser:input_type -> management.UpdateUserRequest
-	52, // 51: management.ManagementService.DestroyUser:input_type -> management.DestroyUserRequest
-	53, // 52: management.ManagementService.MachinePowerOff:input_type -> management.MachinePowerOffRequest
-	55, // 53: management.ManagementService.MachinePowerOn:input_type -> management.MachinePowerOnRequest
-	9,  // 54: management.ManagementService.Kubeconfig:output_type -> management.KubeconfigResponse
-	10, // 55: management.ManagementService.Talosconfig:output_type -> management.TalosconfigResponse
-	11, // 56: management.ManagementService.Omniconfig:output_type -> management.OmniconfigResponse
-	70, // 57: management.ManagementService.MachineLogs:output_type -> common.Data
-	69, // 58: management.ManagementService.ValidateConfig:output_type -> google.protobuf.Empty
-	37, // 59: management.ManagementService.ValidateJSONSchema:output_type -> management.ValidateJsonSchemaResponse
-	16, // 60: management.ManagementService.CreateServiceAccount:output_type -> management.CreateServiceAccountResponse
-	18, // 61: management.ManagementService.RenewServiceAccount:output_type -> management.RenewServiceAccountResponse
-	20, // 62: management.ManagementService.ListServiceAccounts:output_type -> management.ListServiceAccountsResponse
-	69, // 63: management.ManagementService.DestroyServiceAccount:output_type -> google.protobuf.Empty
-	23, // 64: management.ManagementService.KubernetesUpgradePreChecks:output_type -> management.KubernetesUpgradePreChecksResponse
-	26, // 65: management.ManagementService.KubernetesSyncManifests:output_type -> management.KubernetesSyncManifestResponse
-	29, // 66: management.ManagementService.CreateSchematic:output_type -> management.CreateSchematicResponse
-	29, // 67: management.ManagementService.CreateSchematicFromRaw:output_type -> management.CreateSchematicResponse
-	31, // 68: management.ManagementService.GetBootAssetURL:output_type -> management.BootAssetURLResponse
-	33, // 69: management.ManagementService.GetSupportBundle:output_type -> management.GetSupportBundleResponse
-	35, // 70: management.ManagementService.ReadAuditLog:output_type -> management.ReadAuditLogResponse
-	39, // 71: management.ManagementService.MaintenanceUpgrade:output_type -> management.MaintenanceUpgradeResponse
-	41, // 72: management.ManagementService.MaintenanceLifecycle:output_type -> management.MaintenanceLifecycleResponse
-	43, // 73: management.ManagementService.GetMachineJoinConfig:output_type -> management.GetMachineJoinConfigResponse
-	46, // 74: management.ManagementService.CreateJoinToken:output_type -> management.CreateJoinTokenResponse
-	48, // 75: management.ManagementService.ResetNodeUniqueToken:output_type -> management.ResetNodeUniqueTokenResponse
-	50, // 76: management.ManagementService.CreateUser:output_type -> management.CreateUserResponse
-	57, // 77: management.ManagementService.ListUsers:output_type -> management.ListUsersResponse
-	69, // 78: management.ManagementService.UpdateUser:output_type -> google.protobuf.Empty
-	69, // 79: management.ManagementService.DestroyUser:output_type -> google.protobuf.Empty
-	54, // 80: management.ManagementService.MachinePowerOff:output_type -> management.MachinePowerOffResponse
-	56, // 81: management.ManagementService.MachinePowerOn:output_type -> management.MachinePowerOnResponse
-	54, // [54:82] is the sub-list for method output_type
-	26, // [26:54] is the sub-list for method input_type
-	26, // [26:26] is the sub-list for extension type_name
-	26, // [26:26] is the sub-list for extension extendee
-	0,  // [0:26] is the sub-list for field type_name
+	8,  // 16: management.AuditLogChainMarker.kind:type_name -> management.AuditLogChainMarker.Kind
+	36, // 17: management.AuditLogProof.markers:type_name -> management.AuditLogChainMarker
+	37, // 18: management.ReadAuditLogResponse.proof:type_name -> management.AuditLogProof
+	86, // 19: management.ValidateJsonSchemaResponse.errors:type_name -> management.ValidateJsonSchemaResponse.Error
+	9,  // 20: management.MaintenanceLifecycleRequest.operation:type_name -> management.MaintenanceLifecycleRequest.Operation
+	92, // 21: management.CreateJoinTokenRequest.expiration_time:type_name -> google.protobuf.Timestamp
+	57, // 22: management.UpdateUserRequest.custom_roles:type_name -> management.CustomRoles
+	87, // 23: management.ListUsersResponse.users:type_name -> management.ListUsersResponse.User
+	91, // 24: management.RequestElevationRequest.duration:type_name -> google.protobuf.Duration
+	89, // 25: management.ListElevationsResponse.elevations:type_name -> management.ListElevationsResponse.Elevation
+	90, // 26: management.DetachClusterResponse.machines:type_name -> management.DetachClusterResponse.Machine
+	91, // 27: management.WorkloadProxyCredentialsRequest.ttl:type_name -> google.protobuf.Duration
+	92, // 28: management.WorkloadProxyCredentialsResponse.expiration:type_name -> google.protobuf.Timestamp
+	91, // 29: management.ClusterAutoscalerCredentialsRequest.ttl:type_name -> google.protobuf.Duration
+	92, // 30: management.ClusterAutoscalerCredentialsResponse.expiration:type_name -> google.protobuf.Timestamp
+	92, // 31: management.ClusterLogsRequest.since:type_name -> google.protobuf.Timestamp
+	92, // 32: management.ClusterLogsRequest.until:type_name -> google.protobuf.Timestamp
+	92, // 33: management.ClusterLogsResponse.time:type_name -> google.protobuf.Timestamp
+	81, // 34: management.ListServiceAccountsResponse.ServiceAccount.pgp_public_keys:type_name -> management.ListServiceAccountsResponse.ServiceAccount.PgpPublicKey
+	92, // 35: management.ListServiceAccountsResponse.ServiceAccount.PgpPublicKey.expiration:type_name -> google.protobuf.Timestamp
+	92, // 36: management.ListServiceAccountsResponse.ServiceAccount.PgpPublicKey.created:type_name -> google.protobuf.Timestamp
+	92, // 37: management.ListServiceAccountsResponse.ServiceAccount.PgpPublicKey.last_used:type_name -> google.protobuf.Timestamp
+	86, // 38: management.ValidateJsonSchemaResponse.Error.errors:type_name -> management.ValidateJsonSchemaResponse.Error
+	88, // 39: management.ListUsersResponse.User.saml_labels:type_name -> management.ListUsersResponse.User.SamlLabelsEntry
+	91, // 40: management.ListElevationsResponse.Elevation.duration:type_name -> google.protobuf.Duration
+	92, // 41: management.ListElevationsResponse.Elevation.created:type_name -> google.protobuf.Timestamp
+	92, // 42: management.ListElevationsResponse.Elevation.expiration:type_name -> google.protobuf.Timestamp
+	22, // 43: management.ManagementService.Kubeconfig:input_type -> management.KubeconfigRequest
+	15, // 44: management.ManagementService.Talosconfig:input_type -> management.TalosconfigRequest
+	93, // 45: management.ManagementService.Omniconfig:input_type -> google.protobuf.Empty
+	13, // 46: management.ManagementService.MachineLogs:input_type -> management.MachineLogsRequest
+	14, // 47: management.ManagementService.ValidateConfig:input_type -> management.ValidateConfigRequest
+	40, // 48: management.ManagementService.ValidateJSONSchema:input_type -> management.ValidateJsonSchemaRequest
+	16, // 49: management.ManagementService.CreateServiceAccount:input_type -> management.CreateServiceAccountRequest
+	18, // 50: management.ManagementService.RenewServiceAccount:input_type -> management.RenewServiceAccountRequest
+	93, // 51: management.ManagementService.ListServiceAccounts:input_type -> google.protobuf.Empty
+	20, // 52: management.ManagementService.DestroyServiceAccount:input_type -> management.DestroyServiceAccountRequest
+	23, // 53: management.ManagementService.KubernetesUpgradePreChecks:input_type -> management.KubernetesUpgradePreChecksRequest
+	26, // 54: management.ManagementService.KubernetesSyncManifests:input_type -> management.KubernetesSyncManifestRequest
+	28, // 55: management.ManagementService.CreateSchematic:input_type -> management.CreateSchematicRequest
+	29, // 56: management.ManagementService.CreateSchematicFromRaw:input_type -> management.CreateSchematicFromRawRequest
+	31, // 57: management.ManagementService.GetBootAssetURL:input_type -> management.BootAssetURLRequest
+	33, // 58: management.ManagementService.GetSupportBundle:input_type -> management.GetSupportBundleRequest
+	35, // 59: management.ManagementService.ReadAuditLog:input_type -> management.ReadAuditLogRequest
+	93, // 60: management.ManagementService.AuditLogSigningKey:input_type -> google.protobuf.Empty
+	42, // 61: management.ManagementService.MaintenanceUpgrade:input_type -> management.MaintenanceUpgradeRequest
+	44, // 62: management.ManagementService.MaintenanceLifecycle:input_type -> management.MaintenanceLifecycleRequest
+	46, // 63: management.ManagementService.EtcdRestore:input_type -> management.EtcdRestoreRequest
+	48, // 64: management.ManagementService.GetMachineJoinConfig:input_type -> management.GetMachineJoinConfigRequest
+	51, // 65: management.ManagementService.CreateJoinToken:input_type -> management.CreateJoinTokenRequest
+	53, // 66: management.ManagementService.ResetNodeUniqueToken:input_type -> management.ResetNodeUniqueTokenRequest
+	55, // 67: management.ManagementService.CreateUser:input_type -> management.CreateUserRequest
+	93, // 68: management.ManagementService.ListUsers:input_type -> google.protobuf.Empty
+	58, // 69: management.ManagementService.UpdateUser:input_type -> management.UpdateUserRequest
+	59, // 70: management.ManagementService.DestroyUser:input_type -> management.DestroyUserRequest
+	60, // 71: management.ManagementService.MachinePowerOff:input_type -> management.MachinePowerOffRequest
+	62, // 72: management.ManagementService.MachinePowerOn:input_type -> management.MachinePowerOnRequest
+	65, // 73: management.ManagementService.RequestElevation:input_type -> management.RequestElevationRequest
+	67, // 74: management.ManagementService.ApproveElevation:input_type -> management.ApproveElevationRequest
+	68, // 75: management.ManagementService.RevokeElevation:input_type -> management.RevokeElevationRequest
+	93, // 76: management.ManagementService.ListElevations:input_type -> google.protobuf.Empty
+	70, // 77: management.ManagementService.DetachCluster:input_type -> management.DetachClusterRequest
+	72, // 78: management.ManagementService.ReleaseDetachedCluster:input_type -> management.ReleaseDetachedClusterRequest
+	74, // 79: management.ManagementService.WorkloadProxyCredentials:input_type -> management.WorkloadProxyCredentialsRequest
+	76, // 80: management.ManagementService.ClusterAutoscalerCredentials:input_type -> management.ClusterAutoscalerCredentialsRequest
+	78, // 81: management.ManagementService.ClusterLogs:input_type -> management.ClusterLogsRequest
+	10, // 82: management.ManagementService.Kubeconfig:output_type -> management.KubeconfigResponse
+	11, // 83: management.ManagementService.Talosconfig:output_type -> management.TalosconfigResponse
+	12, // 84: management.ManagementService.Omniconfig:output_type -> management.OmniconfigResponse
+	94, // 85: management.ManagementService.MachineLogs:output_type -> common.Data
+	93, // 86: management.ManagementService.ValidateConfig:output_type -> google.protobuf.Empty
+	41, // 87: management.ManagementService.ValidateJSONSchema:output_type -> management.ValidateJsonSchemaResponse
+	17, // 88: management.ManagementService.CreateServiceAccount:output_type -> management.CreateServiceAccountResponse
+	19, // 89: management.ManagementService.RenewServiceAccount:output_type -> management.RenewServiceAccountResponse
+	21, // 90: management.ManagementService.ListServiceAccounts:output_type -> management.ListServiceAccountsResponse
+	93, // 91: management.ManagementService.DestroyServiceAccount:output_type -> google.protobuf.Empty
+	24, // 92: management.ManagementService.KubernetesUpgradePreChecks:output_type -> management.KubernetesUpgradePreChecksResponse
+	27, // 93: management.ManagementService.KubernetesSyncManifests:output_type -> management.KubernetesSyncManifestResponse
+	30, // 94: management.ManagementService.CreateSchematic:output_type -> management.CreateSchematicResponse
+	30, // 95: management.ManagementService.CreateSchematicFromRaw:output_type -> management.CreateSchematicResponse
+	32, // 96: management.ManagementService.GetBootAssetURL:output_type -> management.BootAssetURLResponse
+	34, // 97: management.ManagementService.GetSupportBundle:output_type -> management.GetSupportBundleResponse
+	38, // 98: management.ManagementService.ReadAuditLog:output_type -> management.ReadAuditLogResponse
+	39, // 99: management.ManagementService.AuditLogSigningKey:output_type -> management.AuditLogSigningKeyResponse
+	43, // 100: management.ManagementService.MaintenanceUpgrade:output_type -> management.MaintenanceUpgradeResponse
+	45, // 101: management.ManagementService.MaintenanceLifecycle:output_type -> management.MaintenanceLifecycleResponse
+	47, // 102: management.ManagementService.EtcdRestore:output_type -> management.EtcdRestoreResponse
+	49, // 103: management.ManagementService.GetMachineJoinConfig:output_type -> management.GetMachineJoinConfigResponse
+	52, // 104: management.ManagementService.CreateJoinToken:output_type -> management.CreateJoinTokenResponse
+	54, // 105: management.ManagementService.ResetNodeUniqueToken:output_type -> management.ResetNodeUniqueTokenResponse
+	56, // 106: management.ManagementService.CreateUser:output_type -> management.CreateUserResponse
+	64, // 107: management.ManagementService.ListUsers:output_type -> management.ListUsersResponse
+	93, // 108: management.ManagementService.UpdateUser:output_type -> google.protobuf.Empty
+	93, // 109: management.ManagementService.DestroyUser:output_type -> google.protobuf.Empty
+	61, // 110: management.ManagementService.MachinePowerOff:output_type -> management.MachinePowerOffResponse
+	63, // 111: management.ManagementService.MachinePowerOn:output_type -> management.MachinePowerOnResponse
+	66, // 112: management.ManagementService.RequestElevation:output_type -> management.RequestElevationResponse
+	93, // 113: management.ManagementService.ApproveElevation:output_type -> google.protobuf.Empty
+	93, // 114: management.ManagementService.RevokeElevation:output_type -> google.protobuf.Empty
+	69, // 115: management.ManagementService.ListElevations:output_type -> management.ListElevationsResponse
+	71, // 116: management.ManagementService.DetachCluster:output_type -> management.DetachClusterResponse
+	73, // 117: management.ManagementService.ReleaseDetachedCluster:output_type -> management.ReleaseDetachedClusterResponse
+	75, // 118: management.ManagementService.WorkloadProxyCredentials:output_type -> management.WorkloadProxyCredentialsResponse
+	77, // 119: management.ManagementService.ClusterAutoscalerCredentials:output_type -> management.ClusterAutoscalerCredentialsResponse
+	79, // 120: management.ManagementService.ClusterLogs:output_type -> management.ClusterLogsResponse
+	82, // [82:121] is the sub-list for method output_type
+	43, // [43:82] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
 }
 
 func init() { file_omni_management_management_proto_init() }
@@ -4253,8 +6018,8 @@ func file_omni_management_management_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_omni_management_management_proto_rawDesc), len(file_omni_management_management_proto_rawDesc)),
-			NumEnums:      9,
-			NumMessages:   58,
+			NumEnums:      10,
+			NumMessages:   81,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
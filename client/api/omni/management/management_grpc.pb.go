@@ -22,34 +22,45 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ManagementService_Kubeconfig_FullMethodName                 = "/management.ManagementService/Kubeconfig"
-	ManagementService_Talosconfig_FullMethodName                = "/management.ManagementService/Talosconfig"
-	ManagementService_Omniconfig_FullMethodName                 = "/management.ManagementService/Omniconfig"
-	ManagementService_MachineLogs_FullMethodName                = "/management.ManagementService/MachineLogs"
-	ManagementService_ValidateConfig_FullMethodName             = "/management.ManagementService/ValidateConfig"
-	ManagementService_ValidateJSONSchema_FullMethodName         = "/management.ManagementService/ValidateJSONSchema"
-	ManagementService_CreateServiceAccount_FullMethodName       = "/management.ManagementService/CreateServiceAccount"
-	ManagementService_RenewServiceAccount_FullMethodName        = "/management.ManagementService/RenewServiceAccount"
-	ManagementService_ListServiceAccounts_FullMethodName        = "/management.ManagementService/ListServiceAccounts"
-	ManagementService_DestroyServiceAccount_FullMethodName      = "/management.ManagementService/DestroyServiceAccount"
-	ManagementService_KubernetesUpgradePreChecks_FullMethodName = "/management.ManagementService/KubernetesUpgradePreChecks"
-	ManagementService_KubernetesSyncManifests_FullMethodName    = "/management.ManagementService/KubernetesSyncManifests"
-	ManagementService_CreateSchematic_FullMethodName            = "/management.ManagementService/CreateSchematic"
-	ManagementService_CreateSchematicFromRaw_FullMethodName     = "/management.ManagementService/CreateSchematicFromRaw"
-	ManagementService_GetBootAssetURL_FullMethodName            = "/management.ManagementService/GetBootAssetURL"
-	ManagementService_GetSupportBundle_FullMethodName           = "/management.ManagementService/GetSupportBundle"
-	ManagementService_ReadAuditLog_FullMethodName               = "/management.ManagementService/ReadAuditLog"
-	ManagementService_MaintenanceUpgrade_FullMethodName         = "/management.ManagementService/MaintenanceUpgrade"
-	ManagementService_MaintenanceLifecycle_FullMethodName       = "/management.ManagementService/MaintenanceLifecycle"
-	ManagementService_GetMachineJoinConfig_FullMethodName       = "/management.ManagementService/GetMachineJoinConfig"
-	ManagementService_CreateJoinToken_FullMethodName            = "/management.ManagementService/CreateJoinToken"
-	ManagementService_ResetNodeUniqueToken_FullMethodName       = "/management.ManagementService/ResetNodeUniqueToken"
-	ManagementService_CreateUser_FullMethodName                 = "/management.ManagementService/CreateUser"
-	ManagementService_ListUsers_FullMethodName                  = "/management.ManagementService/ListUsers"
-	ManagementService_UpdateUser_FullMethodName                 = "/management.ManagementService/UpdateUser"
-	ManagementService_DestroyUser_FullMethodName                = "/management.ManagementService/DestroyUser"
-	ManagementService_MachinePowerOff_FullMethodName            = "/management.ManagementService/MachinePowerOff"
-	ManagementService_MachinePowerOn_FullMethodName             = "/management.ManagementService/MachinePowerOn"
+	ManagementService_Kubeconfig_FullMethodName                   = "/management.ManagementService/Kubeconfig"
+	ManagementService_Talosconfig_FullMethodName                  = "/management.ManagementService/Talosconfig"
+	ManagementService_Omniconfig_FullMethodName                   = "/management.ManagementService/Omniconfig"
+	ManagementService_MachineLogs_FullMethodName                  = "/management.ManagementService/MachineLogs"
+	ManagementService_ValidateConfig_FullMethodName               = "/management.ManagementService/ValidateConfig"
+	ManagementService_ValidateJSONSchema_FullMethodName           = "/management.ManagementService/ValidateJSONSchema"
+	ManagementService_CreateServiceAccount_FullMethodName         = "/management.ManagementService/CreateServiceAccount"
+	ManagementService_RenewServiceAccount_FullMethodName          = "/management.ManagementService/RenewServiceAccount"
+	ManagementService_ListServiceAccounts_FullMethodName          = "/management.ManagementService/ListServiceAccounts"
+	ManagementService_DestroyServiceAccount_FullMethodName        = "/management.ManagementService/DestroyServiceAccount"
+	ManagementService_KubernetesUpgradePreChecks_FullMethodName   = "/management.ManagementService/KubernetesUpgradePreChecks"
+	ManagementService_KubernetesSyncManifests_FullMethodName      = "/management.ManagementService/KubernetesSyncManifests"
+	ManagementService_CreateSchematic_FullMethodName              = "/management.ManagementService/CreateSchematic"
+	ManagementService_CreateSchematicFromRaw_FullMethodName       = "/management.ManagementService/CreateSchematicFromRaw"
+	ManagementService_GetBootAssetURL_FullMethodName              = "/management.ManagementService/GetBootAssetURL"
+	ManagementService_GetSupportBundle_FullMethodName             = "/management.ManagementService/GetSupportBundle"
+	ManagementService_ReadAuditLog_FullMethodName                 = "/management.ManagementService/ReadAuditLog"
+	ManagementService_AuditLogSigningKey_FullMethodName           = "/management.ManagementService/AuditLogSigningKey"
+	ManagementService_MaintenanceUpgrade_FullMethodName           = "/management.ManagementService/MaintenanceUpgrade"
+	ManagementService_MaintenanceLifecycle_FullMethodName         = "/management.ManagementService/MaintenanceLifecycle"
+	ManagementService_EtcdRestore_FullMethodName                  = "/management.ManagementService/EtcdRestore"
+	ManagementService_GetMachineJoinConfig_FullMethodName         = "/management.ManagementService/GetMachineJoinConfig"
+	ManagementService_CreateJoinToken_FullMethodName              = "/management.ManagementService/CreateJoinToken"
+	ManagementService_ResetNodeUniqueToken_FullMethodName         = "/management.ManagementService/ResetNodeUniqueToken"
+	ManagementService_CreateUser_FullMethodName                   = "/management.ManagementService/CreateUser"
+	ManagementService_ListUsers_FullMethodName                    = "/management.ManagementService/ListUsers"
+	ManagementService_UpdateUser_FullMethodName                   = "/management.ManagementService/UpdateUser"
+	ManagementService_DestroyUser_FullMethodName                  = "/management.ManagementService/DestroyUser"
+	ManagementService_MachinePowerOff_FullMethodName              = "/management.ManagementService/MachinePowerOff"
+	ManagementService_MachinePowerOn_FullMethodName               = "/management.ManagementService/MachinePowerOn"
+	ManagementService_RequestElevation_FullMethodName             = "/management.ManagementService/RequestElevation"
+	ManagementService_ApproveElevation_FullMethodName             = "/management.ManagementService/ApproveElevation"
+	ManagementService_RevokeElevation_FullMethodName              = "/management.ManagementService/RevokeElevation"
+	ManagementService_ListElevations_FullMethodName               = "/management.ManagementService/ListElevations"
+	ManagementService_DetachCluster_FullMethodName                = "/management.ManagementService/DetachCluster"
+	ManagementService_ReleaseDetachedCluster_FullMethodName       = "/management.ManagementService/ReleaseDetachedCluster"
+	ManagementService_WorkloadProxyCredentials_FullMethodName     = "/management.ManagementService/WorkloadProxyCredentials"
+	ManagementService_ClusterAutoscalerCredentials_FullMethodName = "/management.ManagementService/ClusterAutoscalerCredentials"
+	ManagementService_ClusterLogs_FullMethodName                  = "/management.ManagementService/ClusterLogs"
 )
 
 // ManagementServiceClient is the client API for ManagementService service.
@@ -73,8 +84,10 @@ type ManagementServiceClient interface {
 	GetBootAssetURL(ctx context.Context, in *BootAssetURLRequest, opts ...grpc.CallOption) (*BootAssetURLResponse, error)
 	GetSupportBundle(ctx context.Context, in *GetSupportBundleRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetSupportBundleResponse], error)
 	ReadAuditLog(ctx context.Context, in *ReadAuditLogRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReadAuditLogResponse], error)
+	AuditLogSigningKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AuditLogSigningKeyResponse, error)
 	MaintenanceUpgrade(ctx context.Context, in *MaintenanceUpgradeRequest, opts ...grpc.CallOption) (*MaintenanceUpgradeResponse, error)
 	MaintenanceLifecycle(ctx context.Context, in *MaintenanceLifecycleRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MaintenanceLifecycleResponse], error)
+	EtcdRestore(ctx context.Context, in *EtcdRestoreRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EtcdRestoreResponse], error)
 	GetMachineJoinConfig(ctx context.Context, in *GetMachineJoinConfigRequest, opts ...grpc.CallOption) (*GetMachineJoinConfigResponse, error)
 	CreateJoinToken(ctx context.Context, in *CreateJoinTokenRequest, opts ...grpc.CallOption) (*CreateJoinTokenResponse, error)
 	ResetNodeUniqueToken(ctx context.Context, in *ResetNodeUniqueTokenRequest, opts ...grpc.CallOption) (*ResetNodeUniqueTokenResponse, error)
@@ -84,6 +97,15 @@ type ManagementServiceClient interface {
 	DestroyUser(ctx context.Context, in *DestroyUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	MachinePowerOff(ctx context.Context, in *MachinePowerOffRequest, opts ...grpc.CallOption) (*MachinePowerOffResponse, error)
 	MachinePowerOn(ctx context.Context, in *MachinePowerOnRequest, opts ...grpc.CallOption) (*MachinePowerOnResponse, error)
+	RequestElevation(ctx context.Context, in *RequestElevationRequest, opts ...grpc.CallOption) (*RequestElevationResponse, error)
+	ApproveElevation(ctx context.Context, in *ApproveElevationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RevokeElevation(ctx context.Context, in *RevokeElevationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListElevations(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListElevationsResponse, error)
+	DetachCluster(ctx context.Context, in *DetachClusterRequest, opts ...grpc.CallOption) (*DetachClusterResponse, error)
+	ReleaseDetachedCluster(ctx context.Context, in *ReleaseDetachedClusterRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReleaseDetachedClusterResponse], error)
+	WorkloadProxyCredentials(ctx context.Context, in *WorkloadProxyCredentialsRequest, opts ...grpc.CallOption) (*WorkloadProxyCredentialsResponse, error)
+	ClusterAutoscalerCredentials(ctx context.Context, in *ClusterAutoscalerCredentialsRequest, opts ...grpc.CallOption) (*ClusterAutoscalerCredentialsResponse, error)
+	ClusterLogs(ctx context.Context, in *ClusterLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ClusterLogsResponse], error)
 }
 
 type managementServiceClient struct {
@@ -300,6 +322,16 @@ func (c *managementServiceClient) ReadAuditLog(ctx context.Context, in *ReadAudi
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type ManagementService_ReadAuditLogClient = grpc.ServerStreamingClient[ReadAuditLogResponse]
 
+func (c *managementServiceClient) AuditLogSigningKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AuditLogSigningKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditLogSigningKeyResponse)
+	err := c.cc.Invoke(ctx, ManagementService_AuditLogSigningKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *managementServiceClient) MaintenanceUpgrade(ctx context.Context, in *MaintenanceUpgradeRequest, opts ...grpc.CallOption) (*MaintenanceUpgradeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MaintenanceUpgradeResponse)
@@ -329,6 +361,25 @@ func (c *managementServiceClient) MaintenanceLifecycle(ctx context.Context, in *
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type ManagementService_MaintenanceLifecycleClient = grpc.ServerStreamingClient[MaintenanceLifecycleResponse]
 
+func (c *managementServiceClient) EtcdRestore(ctx context.Context, in *EtcdRestoreRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EtcdRestoreResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ManagementService_ServiceDesc.Streams[5], ManagementService_EtcdRestore_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[EtcdRestoreRequest, EtcdRestoreResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ManagementService_EtcdRestoreClient = grpc.ServerStreamingClient[EtcdRestoreResponse]
+
 func (c *managementServiceClient) GetMachineJoinConfig(ctx context.Context, in *GetMachineJoinConfigRequest, opts ...grpc.CallOption) (*GetMachineJoinConfigResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetMachineJoinConfigResponse)
@@ -419,6 +470,114 @@ func (c *managementServiceClient) MachinePowerOn(ctx context.Context, in *Machin
 	return out, nil
 }
 
+func (c *managementServiceClient) RequestElevation(ctx context.Context, in *RequestElevationRequest, opts ...grpc.CallOption) (*RequestElevationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestElevationResponse)
+	err := c.cc.Invoke(ctx, ManagementService_RequestElevation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) ApproveElevation(ctx context.Context, in *ApproveElevationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ManagementService_ApproveElevation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) RevokeElevation(ctx context.Context, in *RevokeElevationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ManagementService_RevokeElevation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) ListElevations(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListElevationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListElevationsResponse)
+	err := c.cc.Invoke(ctx, ManagementService_ListElevations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) DetachCluster(ctx context.Context, in *DetachClusterRequest, opts ...grpc.CallOption) (*DetachClusterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetachClusterResponse)
+	err := c.cc.Invoke(ctx, ManagementService_DetachCluster_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) ReleaseDetachedCluster(ctx context.Context, in *ReleaseDetachedClusterRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReleaseDetachedClusterResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ManagementService_ServiceDesc.Streams[6], ManagementService_ReleaseDetachedCluster_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ReleaseDetachedClusterRequest, ReleaseDetachedClusterResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ManagementService_ReleaseDetachedClusterClient = grpc.ServerStreamingClient[ReleaseDetachedClusterResponse]
+
+func (c *managementServiceClient) WorkloadProxyCredentials(ctx context.Context, in *WorkloadProxyCredentialsRequest, opts ...grpc.CallOption) (*WorkloadProxyCredentialsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WorkloadProxyCredentialsResponse)
+	err := c.cc.Invoke(ctx, ManagementService_WorkloadProxyCredentials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) ClusterAutoscalerCredentials(ctx context.Context, in *ClusterAutoscalerCredentialsRequest, opts ...grpc.CallOption) (*ClusterAutoscalerCredentialsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClusterAutoscalerCredentialsResponse)
+	err := c.cc.Invoke(ctx, ManagementService_ClusterAutoscalerCredentials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementServiceClient) ClusterLogs(ctx context.Context, in *ClusterLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ClusterLogsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ManagementService_ServiceDesc.Streams[7], ManagementService_ClusterLogs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ClusterLogsRequest, ClusterLogsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ManagementService_ClusterLogsClient = grpc.ServerStreamingClient[ClusterLogsResponse]
+
 // ManagementServiceServer is the server API for ManagementService service.
 // All implementations must embed UnimplementedManagementServiceServer
 // for forward compatibility.
@@ -440,8 +599,10 @@ type ManagementServiceServer interface {
 	GetBootAssetURL(context.Context, *BootAssetURLRequest) (*BootAssetURLResponse, error)
 	GetSupportBundle(*GetSupportBundleRequest, grpc.ServerStreamingServer[GetSupportBundleResponse]) error
 	ReadAuditLog(*ReadAuditLogRequest, grpc.ServerStreamingServer[ReadAuditLogResponse]) error
+	AuditLogSigningKey(context.Context, *emptypb.Empty) (*AuditLogSigningKeyResponse, error)
 	MaintenanceUpgrade(context.Context, *MaintenanceUpgradeRequest) (*MaintenanceUpgradeResponse, error)
 	MaintenanceLifecycle(*MaintenanceLifecycleRequest, grpc.ServerStreamingServer[MaintenanceLifecycleResponse]) error
+	EtcdRestore(*EtcdRestoreRequest, grpc.ServerStreamingServer[EtcdRestoreResponse]) error
 	GetMachineJoinConfig(context.Context, *GetMachineJoinConfigRequest) (*GetMachineJoinConfigResponse, error)
 	CreateJoinToken(context.Context, *CreateJoinTokenRequest) (*CreateJoinTokenResponse, error)
 	ResetNodeUniqueToken(context.Context, *ResetNodeUniqueTokenRequest) (*ResetNodeUniqueTokenResponse, error)
@@ -451,6 +612,15 @@ type ManagementServiceServer interface {
 	DestroyUser(context.Context, *DestroyUserRequest) (*emptypb.Empty, error)
 	MachinePowerOff(context.Context, *MachinePowerOffRequest) (*MachinePowerOffResponse, error)
 	MachinePowerOn(context.Context, *MachinePowerOnRequest) (*MachinePowerOnResponse, error)
+	RequestElevation(context.Context, *RequestElevationRequest) (*RequestElevationResponse, error)
+	ApproveElevation(context.Context, *ApproveElevationRequest) (*emptypb.Empty, error)
+	RevokeElevation(context.Context, *RevokeElevationRequest) (*emptypb.Empty, error)
+	ListElevations(context.Context, *emptypb.Empty) (*ListElevationsResponse, error)
+	DetachCluster(context.Context, *DetachClusterRequest) (*DetachClusterResponse, error)
+	ReleaseDetachedCluster(*ReleaseDetachedClusterRequest, grpc.ServerStreamingServer[ReleaseDetachedClusterResponse]) error
+	WorkloadProxyCredentials(context.Context, *WorkloadProxyCredentialsRequest) (*WorkloadProxyCredentialsResponse, error)
+	ClusterAutoscalerCredentials(context.Context, *ClusterAutoscalerCredentialsRequest) (*ClusterAutoscalerCredentialsResponse, error)
+	ClusterLogs(*ClusterLogsRequest, grpc.ServerStreamingServer[ClusterLogsResponse]) error
 	mustEmbedUnimplementedManagementServiceServer()
 }
 
@@ -512,12 +682,18 @@ func (UnimplementedManagementServiceServer) GetSupportBundle(*GetSupportBundleRe
 func (UnimplementedManagementServiceServer) ReadAuditLog(*ReadAuditLogRequest, grpc.ServerStreamingServer[ReadAuditLogResponse]) error {
 	return status.Error(codes.Unimplemented, "method ReadAuditLog not implemented")
 }
+func (UnimplementedManagementServiceServer) AuditLogSigningKey(context.Context, *emptypb.Empty) (*AuditLogSigningKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AuditLogSigningKey not implemented")
+}
 func (UnimplementedManagementServiceServer) MaintenanceUpgrade(context.Context, *MaintenanceUpgradeRequest) (*MaintenanceUpgradeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method MaintenanceUpgrade not implemented")
 }
 func (UnimplementedManagementServiceServer) MaintenanceLifecycle(*MaintenanceLifecycleRequest, grpc.ServerStreamingServer[MaintenanceLifecycleResponse]) error {
 	return status.Error(codes.Unimplemented, "method MaintenanceLifecycle not implemented")
 }
+func (UnimplementedManagementServiceServer) EtcdRestore(*EtcdRestoreRequest, grpc.ServerStreamingServer[EtcdRestoreResponse]) error {
+	return status.Error(codes.Unimplemented, "method EtcdRestore not implemented")
+}
 func (UnimplementedManagementServiceServer) GetMachineJoinConfig(context.Context, *GetMachineJoinConfigRequest) (*GetMachineJoinConfigResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetMachineJoinConfig not implemented")
 }
@@ -545,6 +721,33 @@ func (UnimplementedManagementServiceServer) MachinePowerOff(context.Context, *Ma
 func (UnimplementedManagementServiceServer) MachinePowerOn(context.Context, *MachinePowerOnRequest) (*MachinePowerOnResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method MachinePowerOn not implemented")
 }
+func (UnimplementedManagementServiceServer) RequestElevation(context.Context, *RequestElevationRequest) (*RequestElevationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RequestElevation not implemented")
+}
+func (UnimplementedManagementServiceServer) ApproveElevation(context.Context, *ApproveElevationRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveElevation not implemented")
+}
+func (UnimplementedManagementServiceServer) RevokeElevation(context.Context, *RevokeElevationRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeElevation not implemented")
+}
+func (UnimplementedManagementServiceServer) ListElevations(context.Context, *emptypb.Empty) (*ListElevationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListElevations not implemented")
+}
+func (UnimplementedManagementServiceServer) DetachCluster(context.Context, *DetachClusterRequest) (*DetachClusterResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DetachCluster not implemented")
+}
+func (UnimplementedManagementServiceServer) ReleaseDetachedCluster(*ReleaseDetachedClusterRequest, grpc.ServerStreamingServer[ReleaseDetachedClusterResponse]) error {
+	return status.Error(codes.Unimplemented, "method ReleaseDetachedCluster not implemented")
+}
+func (UnimplementedManagementServiceServer) WorkloadProxyCredentials(context.Context, *WorkloadProxyCredentialsRequest) (*WorkloadProxyCredentialsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method WorkloadProxyCredentials not implemented")
+}
+func (UnimplementedManagementServiceServer) ClusterAutoscalerCredentials(context.Context, *ClusterAutoscalerCredentialsRequest) (*ClusterAutoscalerCredentialsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ClusterAutoscalerCredentials not implemented")
+}
+func (UnimplementedManagementServiceServer) ClusterLogs(*ClusterLogsRequest, grpc.ServerStreamingServer[ClusterLogsResponse]) error {
+	return status.Error(codes.Unimplemented, "method ClusterLogs not implemented")
+}
 func (UnimplementedManagementServiceServer) mustEmbedUnimplementedManagementServiceServer() {}
 func (UnimplementedManagementServiceServer) testEmbeddedByValue()                           {}
 
@@ -844,6 +1047,24 @@ func _ManagementService_ReadAuditLog_Handler(srv interface{}, stream grpc.Server
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type ManagementService_ReadAuditLogServer = grpc.ServerStreamingServer[ReadAuditLogResponse]
 
+func _ManagementService_AuditLogSigningKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).AuditLogSigningKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_AuditLogSigningKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).AuditLogSigningKey(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ManagementService_MaintenanceUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MaintenanceUpgradeRequest)
 	if err := dec(in); err != nil {
@@ -873,6 +1094,17 @@ func _ManagementService_MaintenanceLifecycle_Handler(srv interface{}, stream grp
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type ManagementService_MaintenanceLifecycleServer = grpc.ServerStreamingServer[MaintenanceLifecycleResponse]
 
+func _ManagementService_EtcdRestore_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EtcdRestoreRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ManagementServiceServer).EtcdRestore(m, &grpc.GenericServerStream[EtcdRestoreRequest, EtcdRestoreResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ManagementService_EtcdRestoreServer = grpc.ServerStreamingServer[EtcdRestoreResponse]
+
 func _ManagementService_GetMachineJoinConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetMachineJoinConfigRequest)
 	if err := dec(in); err != nil {
@@ -1035,6 +1267,154 @@ func _ManagementService_MachinePowerOn_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ManagementService_RequestElevation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestElevationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).RequestElevation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_RequestElevation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).RequestElevation(ctx, req.(*RequestElevationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_ApproveElevation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveElevationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).ApproveElevation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_ApproveElevation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).ApproveElevation(ctx, req.(*ApproveElevationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_RevokeElevation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeElevationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).RevokeElevation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_RevokeElevation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).RevokeElevation(ctx, req.(*RevokeElevationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_ListElevations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).ListElevations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_ListElevations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).ListElevations(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_DetachCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetachClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).DetachCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_DetachCluster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).DetachCluster(ctx, req.(*DetachClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_ReleaseDetachedCluster_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReleaseDetachedClusterRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ManagementServiceServer).ReleaseDetachedCluster(m, &grpc.GenericServerStream[ReleaseDetachedClusterRequest, ReleaseDetachedClusterResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ManagementService_ReleaseDetachedClusterServer = grpc.ServerStreamingServer[ReleaseDetachedClusterResponse]
+
+func _ManagementService_WorkloadProxyCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkloadProxyCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).WorkloadProxyCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_WorkloadProxyCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).WorkloadProxyCredentials(ctx, req.(*WorkloadProxyCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_ClusterAutoscalerCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterAutoscalerCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).ClusterAutoscalerCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_ClusterAutoscalerCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).ClusterAutoscalerCredentials(ctx, req.(*ClusterAutoscalerCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagementService_ClusterLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ClusterLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ManagementServiceServer).ClusterLogs(m, &grpc.GenericServerStream[ClusterLogsRequest, ClusterLogsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ManagementService_ClusterLogsServer = grpc.ServerStreamingServer[ClusterLogsResponse]
+
 // ManagementService_ServiceDesc is the grpc.ServiceDesc for ManagementService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1094,6 +1474,10 @@ var ManagementService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBootAssetURL",
 			Handler:    _ManagementService_GetBootAssetURL_Handler,
 		},
+		{
+			MethodName: "AuditLogSigningKey",
+			Handler:    _ManagementService_AuditLogSigningKey_Handler,
+		},
 		{
 			MethodName: "MaintenanceUpgrade",
 			Handler:    _ManagementService_MaintenanceUpgrade_Handler,
@@ -1134,6 +1518,34 @@ var ManagementService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MachinePowerOn",
 			Handler:    _ManagementService_MachinePowerOn_Handler,
 		},
+		{
+			MethodName: "RequestElevation",
+			Handler:    _ManagementService_RequestElevation_Handler,
+		},
+		{
+			MethodName: "ApproveElevation",
+			Handler:    _ManagementService_ApproveElevation_Handler,
+		},
+		{
+			MethodName: "RevokeElevation",
+			Handler:    _ManagementService_RevokeElevation_Handler,
+		},
+		{
+			MethodName: "ListElevations",
+			Handler:    _ManagementService_ListElevations_Handler,
+		},
+		{
+			MethodName: "DetachCluster",
+			Handler:    _ManagementService_DetachCluster_Handler,
+		},
+		{
+			MethodName: "WorkloadProxyCredentials",
+			Handler:    _ManagementService_WorkloadProxyCredentials_Handler,
+		},
+		{
+			MethodName: "ClusterAutoscalerCredentials",
+			Handler:    _ManagementService_ClusterAutoscalerCredentials_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1161,6 +1573,21 @@ var ManagementService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ManagementService_MaintenanceLifecycle_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "EtcdRestore",
+			Handler:       _ManagementService_EtcdRestore_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ReleaseDetachedCluster",
+			Handler:       _ManagementService_ReleaseDetachedCluster_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ClusterLogs",
+			Handler:       _ManagementService_ClusterLogs_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "omni/management/management.proto",
 }
@@ -14,6 +14,7 @@ import (
 	_ "github.com/siderolabs/talos/pkg/machinery/api/machine"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -229,8 +230,10 @@ type UserSpec struct {
 	//
 	// Deprecated: will be removed once all environments are migrated to use roles.
 	// TODO: remove after all environments are migrated to use roles.
-	Scopes        []string `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
-	Role          string   `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Scopes []string `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	Role   string   `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	// CustomRoles are the IDs of the Role resources granting the user permissions on top of the built-in role.
+	CustomRoles   []string `protobuf:"bytes,4,rep,name=custom_roles,json=customRoles,proto3" json:"custom_roles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -279,6 +282,13 @@ func (x *UserSpec) GetRole() string {
 	return ""
 }
 
+func (x *UserSpec) GetCustomRoles() []string {
+	if x != nil {
+		return x.CustomRoles
+	}
+	return nil
+}
+
 // IdentitySpec describes a user identity.
 type IdentitySpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -557,13 +567,15 @@ func (x *AccessPolicyClusterGroup) GetClusters() []*AccessPolicyClusterGroup_Clu
 
 // AccessPolicyRule describes a rule in the ACLs context.
 type AccessPolicyRule struct {
-	state         protoimpl.MessageState       `protogen:"open.v1"`
-	Users         []string                     `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	Clusters      []string                     `protobuf:"bytes,2,rep,name=clusters,proto3" json:"clusters,omitempty"`
-	Kubernetes    *AccessPolicyRule_Kubernetes `protobuf:"bytes,3,opt,name=kubernetes,proto3" json:"kubernetes,omitempty"`
-	Role          string                       `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState            `protogen:"open.v1"`
+	Users           []string                          `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Clusters        []string                          `protobuf:"bytes,2,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	Kubernetes      *AccessPolicyRule_Kubernetes      `protobuf:"bytes,3,opt,name=kubernetes,proto3" json:"kubernetes,omitempty"`
+	Role            string                            `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	ExposedServices *AccessPolicyRule_ExposedServices `protobuf:"bytes,5,opt,name=exposed_services,json=exposedServices,proto3" json:"exposed_services,omitempty"`
+	Talos           *AccessPolicyRule_Talos           `protobuf:"bytes,6,opt,name=talos,proto3" json:"talos,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *AccessPolicyRule) Reset() {
@@ -624,6 +636,20 @@ func (x *AccessPolicyRule) GetRole() string {
 	return ""
 }
 
+func (x *AccessPolicyRule) GetExposedServices() *AccessPolicyRule_ExposedServices {
+	if x != nil {
+		return x.ExposedServices
+	}
+	return nil
+}
+
+func (x *AccessPolicyRule) GetTalos() *AccessPolicyRule_Talos {
+	if x != nil {
+		return x.Talos
+	}
+	return nil
+}
+
 type AccessPolicyTest struct {
 	state         protoimpl.MessageState     `protogen:"open.v1"`
 	Name          string                     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -774,6 +800,9 @@ type SAMLLabelRuleSpec struct {
 	AssignRole string `protobuf:"bytes,3,opt,name=assign_role,json=assignRole,proto3" json:"assign_role,omitempty"`
 	// UpdateOnEachLogin makes the rule to be applied every time user logs in.
 	UpdateOnEachLogin bool `protobuf:"varint,4,opt,name=update_on_each_login,json=updateOnEachLogin,proto3" json:"update_on_each_login,omitempty"`
+	// AssignCustomRoles to the user matched by this rule, on top of the built-in role.
+	// Only the custom roles of the rule selected for the user are assigned.
+	AssignCustomRoles []string `protobuf:"bytes,5,rep,name=assign_custom_roles,json=assignCustomRoles,proto3" json:"assign_custom_roles,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -836,6 +865,260 @@ func (x *SAMLLabelRuleSpec) GetUpdateOnEachLogin() bool {
 	return false
 }
 
+func (x *SAMLLabelRuleSpec) GetAssignCustomRoles() []string {
+	if x != nil {
+		return x.AssignCustomRoles
+	}
+	return nil
+}
+
+// RoleSpec describes a custom role, granting permissions on top of the built-in roles.
+type RoleSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Description   string                 `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Rules         []*RoleSpec_Rule       `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleSpec) Reset() {
+	*x = RoleSpec{}
+	mi := &file_omni_specs_auth_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleSpec) ProtoMessage() {}
+
+func (x *RoleSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_auth_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleSpec.ProtoReflect.Descriptor instead.
+func (*RoleSpec) Descriptor() ([]byte, []int) {
+	return file_omni_specs_auth_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RoleSpec) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RoleSpec) GetRules() []*RoleSpec_Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// ElevationRequestSpec describes a request for a temporary role elevation.
+type ElevationRequestSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identity which requested the elevation.
+	Identity string `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	// Role requested for the identity.
+	Role string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	// Clusters limits the elevation to the clusters with the matching names, glob patterns are supported.
+	// If empty, the role of the identity is elevated on the whole instance.
+	Clusters []string `protobuf:"bytes,3,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	// Reason for the elevation, like the ticket it is needed for.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Duration of the elevation, counted from the approval.
+	Duration *durationpb.Duration `protobuf:"bytes,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	// ApprovedBy is the identity which approved the request.
+	ApprovedBy string                 `protobuf:"bytes,6,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
+	ApprovedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=approved_at,json=approvedAt,proto3" json:"approved_at,omitempty"`
+	// Revoked is set when the pending request is denied or the approved elevation is revoked.
+	Revoked       bool   `protobuf:"varint,8,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	RevokedBy     string `protobuf:"bytes,9,opt,name=revoked_by,json=revokedBy,proto3" json:"revoked_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ElevationRequestSpec) Reset() {
+	*x = ElevationRequestSpec{}
+	mi := &file_omni_specs_auth_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ElevationRequestSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ElevationRequestSpec) ProtoMessage() {}
+
+func (x *ElevationRequestSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_auth_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ElevationRequestSpec.ProtoReflect.Descriptor instead.
+func (*ElevationRequestSpec) Descriptor() ([]byte, []int) {
+	return file_omni_specs_auth_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ElevationRequestSpec) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *ElevationRequestSpec) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ElevationRequestSpec) GetClusters() []string {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+func (x *ElevationRequestSpec) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ElevationRequestSpec) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *ElevationRequestSpec) GetApprovedBy() string {
+	if x != nil {
+		return x.ApprovedBy
+	}
+	return ""
+}
+
+func (x *ElevationRequestSpec) GetApprovedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ApprovedAt
+	}
+	return nil
+}
+
+func (x *ElevationRequestSpec) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+func (x *ElevationRequestSpec) GetRevokedBy() string {
+	if x != nil {
+		return x.RevokedBy
+	}
+	return ""
+}
+
+// ElevationGrantSpec describes an active role elevation, it exists while the approved elevation request is not expired or revoked.
+type ElevationGrantSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identity      string                 `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Clusters      []string               `protobuf:"bytes,3,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	Expiration    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	ApprovedBy    string                 `protobuf:"bytes,5,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ElevationGrantSpec) Reset() {
+	*x = ElevationGrantSpec{}
+	mi := &file_omni_specs_auth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ElevationGrantSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ElevationGrantSpec) ProtoMessage() {}
+
+func (x *ElevationGrantSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_auth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ElevationGrantSpec.ProtoReflect.Descriptor instead.
+func (*ElevationGrantSpec) Descriptor() ([]byte, []int) {
+	return file_omni_specs_auth_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ElevationGrantSpec) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *ElevationGrantSpec) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ElevationGrantSpec) GetClusters() []string {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+func (x *ElevationGrantSpec) GetExpiration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+func (x *ElevationGrantSpec) GetApprovedBy() string {
+	if x != nil {
+		return x.ApprovedBy
+	}
+	return ""
+}
+
 // IdentityLastActiveSpec tracks the last time a user or service account was active.
 type IdentityLastActiveSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -846,7 +1129,7 @@ type IdentityLastActiveSpec struct {
 
 func (x *IdentityLastActiveSpec) Reset() {
 	*x = IdentityLastActiveSpec{}
-	mi := &file_omni_specs_auth_proto_msgTypes[12]
+	mi := &file_omni_specs_auth_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -858,7 +1141,7 @@ func (x *IdentityLastActiveSpec) String() string {
 func (*IdentityLastActiveSpec) ProtoMessage() {}
 
 func (x *IdentityLastActiveSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_auth_proto_msgTypes[12]
+	mi := &file_omni_specs_auth_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -871,7 +1154,7 @@ func (x *IdentityLastActiveSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IdentityLastActiveSpec.ProtoReflect.Descriptor instead.
 func (*IdentityLastActiveSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_auth_proto_rawDescGZIP(), []int{12}
+	return file_omni_specs_auth_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *IdentityLastActiveSpec) GetLastActive() *timestamppb.Timestamp {
@@ -891,7 +1174,7 @@ type PublicKeyLastActiveSpec struct {
 
 func (x *PublicKeyLastActiveSpec) Reset() {
 	*x = PublicKeyLastActiveSpec{}
-	mi := &file_omni_specs_auth_proto_msgTypes[13]
+	mi := &file_omni_specs_auth_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -903,7 +1186,7 @@ func (x *PublicKeyLastActiveSpec) String() string {
 func (*PublicKeyLastActiveSpec) ProtoMessage() {}
 
 func (x *PublicKeyLastActiveSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_auth_proto_msgTypes[13]
+	mi := &file_omni_specs_auth_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -916,7 +1199,7 @@ func (x *PublicKeyLastActiveSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PublicKeyLastActiveSpec.ProtoReflect.Descriptor instead.
 func (*PublicKeyLastActiveSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_auth_proto_rawDescGZIP(), []int{13}
+	return file_omni_specs_auth_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *PublicKeyLastActiveSpec) GetLastUsed() *timestamppb.Timestamp {
@@ -938,7 +1221,7 @@ type IdentityStatusSpec struct {
 
 func (x *IdentityStatusSpec) Reset() {
 	*x = IdentityStatusSpec{}
-	mi := &file_omni_specs_auth_proto_msgTypes[14]
+	mi := &file_omni_specs_auth_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -950,7 +1233,7 @@ func (x *IdentityStatusSpec) String() string {
 func (*IdentityStatusSpec) ProtoMessage() {}
 
 func (x *IdentityStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_auth_proto_msgTypes[14]
+	mi := &file_omni_specs_auth_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -963,7 +1246,7 @@ func (x *IdentityStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IdentityStatusSpec.ProtoReflect.Descriptor instead.
 func (*IdentityStatusSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_auth_proto_rawDescGZIP(), []int{14}
+	return file_omni_specs_auth_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *IdentityStatusSpec) GetUserId() string {
@@ -992,13 +1275,14 @@ type ServiceAccountStatusSpec struct {
 	Role          string                                   `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
 	PublicKeys    []*ServiceAccountStatusSpec_PgpPublicKey `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
 	Expiration    *timestamppb.Timestamp                   `protobuf:"bytes,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	CustomRoles   []string                                 `protobuf:"bytes,4,rep,name=custom_roles,json=customRoles,proto3" json:"custom_roles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ServiceAccountStatusSpec) Reset() {
 	*x = ServiceAccountStatusSpec{}
-	mi := &file_omni_specs_auth_proto_msgTypes[15]
+	mi := &file_omni_specs_auth_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1010,7 +1294,7 @@ func (x *ServiceAccountStatusSpec) String() string {
 func (*ServiceAccountStatusSpec) ProtoMessage() {}
 
 func (x *ServiceAccountStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_auth_proto_msgTypes[15]
+	mi := &file_omni_specs_auth_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1023,7 +1307,7 @@ func (x *ServiceAccountStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServiceAccountStatusSpec.ProtoReflect.Descriptor instead.
 func (*ServiceAccountStatusSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_auth_proto_rawDescGZIP(), []int{15}
+	return file_omni_specs_auth_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ServiceAccountStatusSpec) GetRole() string {
@@ -1047,6 +1331,13 @@ func (x *ServiceAccountStatusSpec) GetExpiration() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ServiceAccountStatusSpec) GetCustomRoles() []string {
+	if x != nil {
+		return x.CustomRoles
+	}
+	return nil
+}
+
 // EulaAcceptanceSpec records the instance-wide EULA acceptance.
 type EulaAcceptanceSpec struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1060,7 +1351,7 @@ type EulaAcceptanceSpec struct {
 
 func (x *EulaAcceptanceSpec) Reset() {
 	*x = EulaAcceptanceSpec{}
-	mi := &file_omni_specs_auth_proto_msgTypes[16]
+	mi := &file_omni_specs_auth_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1072,7 +1363,7 @@ func (x *EulaAcceptanceSpec) String() string {
 func (*EulaAcceptanceSpec) ProtoMessage() {}
 
 func (x *EulaAcceptanceSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_auth_proto_msgTypes[16]
+	mi := &file_omni_specs_auth_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1085,7 +1376,7 @@ func (x *EulaAcceptanceSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EulaAcceptanceSpec.ProtoReflect.Descriptor instead.
 func (*EulaAcceptanceSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_auth_proto_rawDescGZIP(), []int{16}
+	return file_omni_specs_auth_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *EulaAcceptanceSpec) GetAcceptedByName() string {
@@ -1114,7 +1405,7 @@ type AuthConfigSpec_Auth0 struct {
 
 func (x *AuthConfigSpec_Auth0) Reset() {
 	*x = AuthConfigSpec_Auth0{}
-	mi := &file_omni_specs_auth_proto_msgTypes[17]
+	mi := &file_omni_specs_auth_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1126,7 +1417,7 @@ func (x *AuthConfigSpec_Auth0) String() string {
 func (*AuthConfigSpec_Auth0) ProtoMessage() {}
 
 func (x *AuthConfigSpec_Auth0) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_auth_proto_msgTypes[17]
+	mi := &file_omni_specs_auth_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1183,7 +1474,7 @@ type AuthConfigSpec_OIDC struct {
 
 func (x *AuthConfigSpec_OIDC) Reset() {
 	*x = AuthConfigSpec_OIDC{}
-	mi := &file_omni_specs_auth_proto_msgTypes[18]
+	mi := &file_omni_specs_auth_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1195,7 +1486,7 @@ func (x *AuthConfigSpec_OIDC) String() string {
 func (*AuthConfigSpec_OIDC) ProtoMessage() {}
 
 func (x *AuthConfigSpec_OIDC) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_auth_proto_msgTypes[18]
+	mi := &file_omni_specs_auth_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1256,7 +1547,7 @@ type AuthConfigSpec_Webauthn struct {
 
 func (x *AuthConfigSpec_Webauthn) Reset() {
 	*x = AuthConfigSpec_Webauthn{}
-	mi := &file_omni_specs_auth_proto_msgTypes[19]
+	mi := &file_omni_specs_auth_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1268,7 +1559,7 @@ func (x *AuthConfigSpec_Webauthn) String() string {
 func (*AuthConfigSpec_Webauthn) ProtoMessage() {}
 
 func (x *AuthConfigSpec_Webauthn) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_auth_proto_msgTypes[19]
+	mi := &file_omni_specs_auth_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1316,7 +1607,7 @@ type AuthConfigSpec_SAML struct {
 
 func (x *AuthConfigSpec_SAML) Reset() {
 	*x = AuthConfigSpec_SAML{}
-	mi := &file_omni_specs_auth_proto_msgTypes[20]
+	mi := &file_omni_specs_auth_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1328,7 +1619,7 @@ func (x *AuthConfigSpec_SAML) String() string {
 func (*AuthConfigSpec_SAML) ProtoMessage() {}
 
 func (x *AuthConfigSpec_SAML) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_auth_proto_msgTypes[20]
+	mi := &file_omni_specs_auth_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1397,7 +1688,7 @@ type AccessPolicyUserGroup_User struct {
 
 func (x *AccessPolicyUserGroup_User) Reset() {
 	*x = AccessPolicyUserGroup_User{}
-	mi := &file_omni_specs_auth_proto_msgTypes[23]
+	mi := &file_omni_specs_auth_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1409,7 +1700,7 @@ func (x *AccessPolicyUserGroup_User) String() string {
 func (*AccessPolicyUserGroup_User) ProtoMessage() {}
 
 func (x *AccessPolicyUserGroup_User) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_auth_proto_msgTypes[23]
+	mi := &file_omni_specs_auth_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1456,7 +1747,7 @@ type AccessPolicyClusterGroup_Cluster struct {
 
 func (x *AccessPolicyClusterGroup_Cluster) Reset() {
 	*x = AccessPolicyClusterGroup_Cluster{}
-	mi := &file_omni_specs_auth_proto_msgTypes[24]
+	mi := &file_omni_specs_auth_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1468,7 +1759,7 @@ func (x *AccessPolicyClusterGroup_Cluster) String() string {
 func (*AccessPolicyClusterGroup_Cluster) ProtoMessage() {}
 
 func (x *AccessPolicyClusterGroup_Cluster) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_auth_proto_msgTypes[24]
+	mi := &file_omni_specs_auth_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1507,7 +1798,7 @@ type AccessPolicyRule_Kubernetes struct {
 
 func (x *AccessPolicyRule_Kubernetes) Reset() {
 	*x = AccessPolicyRule_Kubernetes{}
-	mi := &file_omni_specs_auth_proto_msgTypes[25]
+	mi := &file_omni_specs_auth_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1519,7 +1810,7 @@ func (x *AccessPolicyRule_Kubernetes) String() string {
 func (*AccessPolicyRule_Kubernetes) ProtoMessage() {}
 
 func (x *AccessPolicyRule_Kubernetes) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_auth_proto_msgTypes[25]
+	mi := &file_omni_specs_auth_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1542,6 +1833,107 @@ func (x *AccessPolicyRule_Kubernetes) GetImpersonate() *AccessPolicyRule_Kuberne
 	return nil
 }
 
+type AccessPolicyRule_ExposedServices struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Aliases of the exposed services the rule grants access to, glob patterns are supported.
+	Aliases       []string `protobuf:"bytes,1,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessPolicyRule_ExposedServices) Reset() {
+	*x = AccessPolicyRule_ExposedServices{}
+	mi := &file_omni_specs_auth_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessPolicyRule_ExposedServices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessPolicyRule_ExposedServices) ProtoMessage() {}
+
+func (x *AccessPolicyRule_ExposedServices) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_auth_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessPolicyRule_ExposedServices.ProtoReflect.Descriptor instead.
+func (*AccessPolicyRule_ExposedServices) Descriptor() ([]byte, []int) {
+	return file_omni_specs_auth_proto_rawDescGZIP(), []int{8, 1}
+}
+
+func (x *AccessPolicyRule_ExposedServices) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+type AccessPolicyRule_Talos struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Allow limits the Talos API methods the rule users can call on the rule clusters.
+	// The methods are given either by the name, like Logs, or by the service and the name, like machine.MachineService/Logs,
+	// glob patterns are supported. If empty, the methods are not limited.
+	Allow []string `protobuf:"bytes,1,rep,name=allow,proto3" json:"allow,omitempty"`
+	// Deny lists the Talos API methods the rule users can't call on the rule clusters, in the same format as Allow.
+	Deny          []string `protobuf:"bytes,2,rep,name=deny,proto3" json:"deny,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessPolicyRule_Talos) Reset() {
+	*x = AccessPolicyRule_Talos{}
+	mi := &file_omni_specs_auth_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessPolicyRule_Talos) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessPolicyRule_Talos) ProtoMessage() {}
+
+func (x *AccessPolicyRule_Talos) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_auth_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessPolicyRule_Talos.ProtoReflect.Descriptor instead.
+func (*AccessPolicyRule_Talos) Descriptor() ([]byte, []int) {
+	return file_omni_specs_auth_proto_rawDescGZIP(), []int{8, 2}
+}
+
+func (x *AccessPolicyRule_Talos) GetAllow() []string {
+	if x != nil {
+		return x.Allow
+	}
+	return nil
+}
+
+func (x *AccessPolicyRule_Talos) GetDeny() []string {
+	if x != nil {
+		return x.Deny
+	}
+	return nil
+}
+
 type AccessPolicyRule_Kubernetes_Impersonate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Groups        []string               `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
@@ -1551,7 +1943,7 @@ type AccessPolicyRule_Kubernetes_Impersonate struct {
 
 func (x *AccessPolicyRule_Kubernetes_Impersonate) Reset() {
 	*x = AccessPolicyRule_Kubernetes_Impersonate{}
-	mi := &file_omni_specs_auth_proto_msgTypes[26]
+	mi := &file_omni_specs_auth_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1563,7 +1955,7 @@ func (x *AccessPolicyRule_Kubernetes_Impersonate) String() string {
 func (*AccessPolicyRule_Kubernetes_Impersonate) ProtoMessage() {}
 
 func (x *AccessPolicyRule_Kubernetes_Impersonate) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_auth_proto_msgTypes[26]
+	mi := &file_omni_specs_auth_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1590,13 +1982,14 @@ type AccessPolicyTest_Expected struct {
 	state         protoimpl.MessageState                `protogen:"open.v1"`
 	Kubernetes    *AccessPolicyTest_Expected_Kubernetes `protobuf:"bytes,1,opt,name=kubernetes,proto3" json:"kubernetes,omitempty"`
 	Role          string                                `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Talos         *AccessPolicyTest_Expected_Talos      `protobuf:"bytes,3,opt,name=talos,proto3" json:"talos,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AccessPolicyTest_Expected) Reset() {
 	*x = AccessPolicyTest_Expected{}
-	mi := &file_omni_specs_auth_proto_msgTypes[27]
+	mi := &file_omni_specs_auth_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1608,7 +2001,7 @@ func (x *AccessPolicyTest_Expected) String() string {
 func (*AccessPolicyTest_Expected) ProtoMessage() {}
 
 func (x *AccessPolicyTest_Expected) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_auth_proto_msgTypes[27]
+	mi := &file_omni_specs_auth_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1638,6 +2031,13 @@ func (x *AccessPolicyTest_Expected) GetRole() string {
 	return ""
 }
 
+func (x *AccessPolicyTest_Expected) GetTalos() *AccessPolicyTest_Expected_Talos {
+	if x != nil {
+		return x.Talos
+	}
+	return nil
+}
+
 type AccessPolicyTest_User struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -1648,7 +2048,7 @@ type AccessPolicyTest_User struct {
 
 func (x *AccessPolicyTest_User) Reset() {
 	*x = AccessPolicyTest_User{}
-	mi := &file_omni_specs_auth_proto_msgTypes[28]
+	mi := &file_omni_specs_auth_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1660,7 +2060,7 @@ func (x *AccessPolicyTest_User) String() string {
 func (*AccessPolicyTest_User) ProtoMessage() {}
 
 func (x *AccessPolicyTest_User) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_auth_proto_msgTypes[28]
+	mi := &file_omni_specs_auth_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1699,7 +2099,7 @@ type AccessPolicyTest_Cluster struct {
 
 func (x *AccessPolicyTest_Cluster) Reset() {
 	*x = AccessPolicyTest_Cluster{}
-	mi := &file_omni_specs_auth_proto_msgTypes[29]
+	mi := &file_omni_specs_auth_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1711,7 +2111,7 @@ func (x *AccessPolicyTest_Cluster) String() string {
 func (*AccessPolicyTest_Cluster) ProtoMessage() {}
 
 func (x *AccessPolicyTest_Cluster) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_auth_proto_msgTypes[29]
+	mi := &file_omni_specs_auth_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1743,7 +2143,7 @@ type AccessPolicyTest_Expected_Kubernetes struct {
 
 func (x *AccessPolicyTest_Expected_Kubernetes) Reset() {
 	*x = AccessPolicyTest_Expected_Kubernetes{}
-	mi := &file_omni_specs_auth_proto_msgTypes[30]
+	mi := &file_omni_specs_auth_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1755,7 +2155,7 @@ func (x *AccessPolicyTest_Expected_Kubernetes) String() string {
 func (*AccessPolicyTest_Expected_Kubernetes) ProtoMessage() {}
 
 func (x *AccessPolicyTest_Expected_Kubernetes) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_auth_proto_msgTypes[30]
+	mi := &file_omni_specs_auth_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1778,6 +2178,60 @@ func (x *AccessPolicyTest_Expected_Kubernetes) GetImpersonate() *AccessPolicyTes
 	return nil
 }
 
+type AccessPolicyTest_Expected_Talos struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Allowed Talos API methods, in the same format as the rule Talos section.
+	Allowed []string `protobuf:"bytes,1,rep,name=allowed,proto3" json:"allowed,omitempty"`
+	// Denied Talos API methods, in the same format as the rule Talos section.
+	Denied        []string `protobuf:"bytes,2,rep,name=denied,proto3" json:"denied,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessPolicyTest_Expected_Talos) Reset() {
+	*x = AccessPolicyTest_Expected_Talos{}
+	mi := &file_omni_specs_auth_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessPolicyTest_Expected_Talos) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessPolicyTest_Expected_Talos) ProtoMessage() {}
+
+func (x *AccessPolicyTest_Expected_Talos) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_auth_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessPolicyTest_Expected_Talos.ProtoReflect.Descriptor instead.
+func (*AccessPolicyTest_Expected_Talos) Descriptor() ([]byte, []int) {
+	return file_omni_specs_auth_proto_rawDescGZIP(), []int{9, 0, 1}
+}
+
+func (x *AccessPolicyTest_Expected_Talos) GetAllowed() []string {
+	if x != nil {
+		return x.Allowed
+	}
+	return nil
+}
+
+func (x *AccessPolicyTest_Expected_Talos) GetDenied() []string {
+	if x != nil {
+		return x.Denied
+	}
+	return nil
+}
+
 type AccessPolicyTest_Expected_Kubernetes_Impersonate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Groups        []string               `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
@@ -1787,7 +2241,7 @@ type AccessPolicyTest_Expected_Kubernetes_Impersonate struct {
 
 func (x *AccessPolicyTest_Expected_Kubernetes_Impersonate) Reset() {
 	*x = AccessPolicyTest_Expected_Kubernetes_Impersonate{}
-	mi := &file_omni_specs_auth_proto_msgTypes[31]
+	mi := &file_omni_specs_auth_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1799,7 +2253,7 @@ func (x *AccessPolicyTest_Expected_Kubernetes_Impersonate) String() string {
 func (*AccessPolicyTest_Expected_Kubernetes_Impersonate) ProtoMessage() {}
 
 func (x *AccessPolicyTest_Expected_Kubernetes_Impersonate) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_auth_proto_msgTypes[31]
+	mi := &file_omni_specs_auth_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1822,6 +2276,89 @@ func (x *AccessPolicyTest_Expected_Kubernetes_Impersonate) GetGroups() []string
 	return nil
 }
 
+// Rule grants the verbs on the resource types and the ManagementService API methods.
+type RoleSpec_Rule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ResourceTypes the rule applies to, "*" matches all of them.
+	ResourceTypes []string `protobuf:"bytes,1,rep,name=resource_types,json=resourceTypes,proto3" json:"resource_types,omitempty"`
+	// Verbs granted on the resource types: get, list, watch, create, update and destroy, "*" matches all of them.
+	Verbs []string `protobuf:"bytes,2,rep,name=verbs,proto3" json:"verbs,omitempty"`
+	// Clusters limits the rule to the resources of the clusters with the matching names, glob patterns are supported.
+	Clusters []string `protobuf:"bytes,3,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	// Apis are the ManagementService methods the rule grants, like MachineLogs.
+	Apis []string `protobuf:"bytes,4,rep,name=apis,proto3" json:"apis,omitempty"`
+	// ClusterLabelSelectors limits the rule to the resources of the clusters with the labels matching any of the selectors.
+	// A cluster matching either Clusters or ClusterLabelSelectors is matched by the rule.
+	ClusterLabelSelectors []string `protobuf:"bytes,5,rep,name=cluster_label_selectors,json=clusterLabelSelectors,proto3" json:"cluster_label_selectors,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *RoleSpec_Rule) Reset() {
+	*x = RoleSpec_Rule{}
+	mi := &file_omni_specs_auth_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleSpec_Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleSpec_Rule) ProtoMessage() {}
+
+func (x *RoleSpec_Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_auth_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleSpec_Rule.ProtoReflect.Descriptor instead.
+func (*RoleSpec_Rule) Descriptor() ([]byte, []int) {
+	return file_omni_specs_auth_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *RoleSpec_Rule) GetResourceTypes() []string {
+	if x != nil {
+		return x.ResourceTypes
+	}
+	return nil
+}
+
+func (x *RoleSpec_Rule) GetVerbs() []string {
+	if x != nil {
+		return x.Verbs
+	}
+	return nil
+}
+
+func (x *RoleSpec_Rule) GetClusters() []string {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+func (x *RoleSpec_Rule) GetApis() []string {
+	if x != nil {
+		return x.Apis
+	}
+	return nil
+}
+
+func (x *RoleSpec_Rule) GetClusterLabelSelectors() []string {
+	if x != nil {
+		return x.ClusterLabelSelectors
+	}
+	return nil
+}
+
 type ServiceAccountStatusSpec_PgpPublicKey struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1835,7 +2372,7 @@ type ServiceAccountStatusSpec_PgpPublicKey struct {
 
 func (x *ServiceAccountStatusSpec_PgpPublicKey) Reset() {
 	*x = ServiceAccountStatusSpec_PgpPublicKey{}
-	mi := &file_omni_specs_auth_proto_msgTypes[35]
+	mi := &file_omni_specs_auth_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1847,7 +2384,7 @@ func (x *ServiceAccountStatusSpec_PgpPublicKey) String() string {
 func (*ServiceAccountStatusSpec_PgpPublicKey) ProtoMessage() {}
 
 func (x *ServiceAccountStatusSpec_PgpPublicKey) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_auth_proto_msgTypes[35]
+	mi := &file_omni_specs_auth_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1860,7 +2397,7 @@ func (x *ServiceAccountStatusSpec_PgpPublicKey) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use ServiceAccountStatusSpec_PgpPublicKey.ProtoReflect.Descriptor instead.
 func (*ServiceAccountStatusSpec_PgpPublicKey) Descriptor() ([]byte, []int) {
-	return file_omni_specs_auth_proto_rawDescGZIP(), []int{15, 0}
+	return file_omni_specs_auth_proto_rawDescGZIP(), []int{18, 0}
 }
 
 func (x *ServiceAccountStatusSpec_PgpPublicKey) GetId() string {
@@ -1902,7 +2439,7 @@ var File_omni_specs_auth_proto protoreflect.FileDescriptor
 
 const file_omni_specs_auth_proto_rawDesc = "" +
 	"\n" +
-	"\x15omni/specs/auth.proto\x12\x05specs\x1a\x1btalos/machine/machine.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa2\b\n" +
+	"\x15omni/specs/auth.proto\x12\x05specs\x1a\x1btalos/machine/machine.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa2\b\n" +
 	"\x0eAuthConfigSpec\x121\n" +
 	"\x05auth0\x18\x01 \x01(\v2\x1b.specs.AuthConfigSpec.Auth0R\x05auth0\x12:\n" +
 	"\bwebauthn\x18\x02 \x01(\v2\x1e.specs.AuthConfigSpec.WebauthnR\bwebauthn\x12\x1c\n" +
@@ -1941,10 +2478,11 @@ const file_omni_specs_auth_proto_rawDesc = "" +
 	"\x11SAMLAssertionSpec\x12\x12\n" +
 	"\x04data\x18\x01 \x01(\fR\x04data\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
-	"\x04used\x18\x03 \x01(\bR\x04used\"<\n" +
+	"\x04used\x18\x03 \x01(\bR\x04used\"_\n" +
 	"\bUserSpec\x12\x16\n" +
 	"\x06scopes\x18\x02 \x03(\tR\x06scopes\x12\x12\n" +
-	"\x04role\x18\x03 \x01(\tR\x04roleJ\x04\b\x01\x10\x02\"'\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12!\n" +
+	"\fcustom_roles\x18\x04 \x03(\tR\vcustomRolesJ\x04\b\x01\x10\x02\"'\n" +
 	"\fIdentitySpec\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\" \n" +
 	"\bIdentity\x12\x14\n" +
@@ -1974,34 +2512,45 @@ const file_omni_specs_auth_proto_rawDesc = "" +
 	"\bclusters\x18\x01 \x03(\v2'.specs.AccessPolicyClusterGroup.ClusterR\bclusters\x1a3\n" +
 	"\aCluster\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
-	"\x05match\x18\x02 \x01(\tR\x05match\"\xa4\x02\n" +
+	"\x05match\x18\x02 \x01(\tR\x05match\"\x8d\x04\n" +
 	"\x10AccessPolicyRule\x12\x14\n" +
 	"\x05users\x18\x01 \x03(\tR\x05users\x12\x1a\n" +
 	"\bclusters\x18\x02 \x03(\tR\bclusters\x12B\n" +
 	"\n" +
 	"kubernetes\x18\x03 \x01(\v2\".specs.AccessPolicyRule.KubernetesR\n" +
 	"kubernetes\x12\x12\n" +
-	"\x04role\x18\x04 \x01(\tR\x04role\x1a\x85\x01\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12R\n" +
+	"\x10exposed_services\x18\x05 \x01(\v2'.specs.AccessPolicyRule.ExposedServicesR\x0fexposedServices\x123\n" +
+	"\x05talos\x18\x06 \x01(\v2\x1d.specs.AccessPolicyRule.TalosR\x05talos\x1a\x85\x01\n" +
 	"\n" +
 	"Kubernetes\x12P\n" +
 	"\vimpersonate\x18\x01 \x01(\v2..specs.AccessPolicyRule.Kubernetes.ImpersonateR\vimpersonate\x1a%\n" +
 	"\vImpersonate\x12\x16\n" +
-	"\x06groups\x18\x01 \x03(\tR\x06groups\"\x89\x05\n" +
+	"\x06groups\x18\x01 \x03(\tR\x06groups\x1a+\n" +
+	"\x0fExposedServices\x12\x18\n" +
+	"\aaliases\x18\x01 \x03(\tR\aaliases\x1a1\n" +
+	"\x05Talos\x12\x14\n" +
+	"\x05allow\x18\x01 \x03(\tR\x05allow\x12\x12\n" +
+	"\x04deny\x18\x02 \x03(\tR\x04deny\"\x82\x06\n" +
 	"\x10AccessPolicyTest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x120\n" +
 	"\x04user\x18\x02 \x01(\v2\x1c.specs.AccessPolicyTest.UserR\x04user\x129\n" +
 	"\acluster\x18\x03 \x01(\v2\x1f.specs.AccessPolicyTest.ClusterR\acluster\x12<\n" +
-	"\bexpected\x18\x04 \x01(\v2 .specs.AccessPolicyTest.ExpectedR\bexpected\x1a\xfc\x01\n" +
+	"\bexpected\x18\x04 \x01(\v2 .specs.AccessPolicyTest.ExpectedR\bexpected\x1a\xf5\x02\n" +
 	"\bExpected\x12K\n" +
 	"\n" +
 	"kubernetes\x18\x01 \x01(\v2+.specs.AccessPolicyTest.Expected.KubernetesR\n" +
 	"kubernetes\x12\x12\n" +
-	"\x04role\x18\x02 \x01(\tR\x04role\x1a\x8e\x01\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12<\n" +
+	"\x05talos\x18\x03 \x01(\v2&.specs.AccessPolicyTest.Expected.TalosR\x05talos\x1a\x8e\x01\n" +
 	"\n" +
 	"Kubernetes\x12Y\n" +
 	"\vimpersonate\x18\x01 \x01(\v27.specs.AccessPolicyTest.Expected.Kubernetes.ImpersonateR\vimpersonate\x1a%\n" +
 	"\vImpersonate\x12\x16\n" +
-	"\x06groups\x18\x01 \x03(\tR\x06groups\x1a\x97\x01\n" +
+	"\x06groups\x18\x01 \x03(\tR\x06groups\x1a9\n" +
+	"\x05Talos\x12\x18\n" +
+	"\aallowed\x18\x01 \x03(\tR\aallowed\x12\x16\n" +
+	"\x06denied\x18\x02 \x03(\tR\x06denied\x1a\x97\x01\n" +
 	"\x04User\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12@\n" +
 	"\x06labels\x18\x02 \x03(\v2(.specs.AccessPolicyTest.User.LabelsEntryR\x06labels\x1a9\n" +
@@ -2021,13 +2570,45 @@ const file_omni_specs_auth_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\v2\x1c.specs.AccessPolicyUserGroupR\x05value:\x028\x01\x1aa\n" +
 	"\x12ClusterGroupsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x125\n" +
-	"\x05value\x18\x02 \x01(\v2\x1f.specs.AccessPolicyClusterGroupR\x05value:\x028\x01\"\xc7\x01\n" +
+	"\x05value\x18\x02 \x01(\v2\x1f.specs.AccessPolicyClusterGroupR\x05value:\x028\x01\"\xf7\x01\n" +
 	"\x11SAMLLabelRuleSpec\x12!\n" +
 	"\fmatch_labels\x18\x01 \x03(\tR\vmatchLabels\x12=\n" +
 	"\x1bassign_role_on_registration\x18\x02 \x01(\tR\x18assignRoleOnRegistration\x12\x1f\n" +
 	"\vassign_role\x18\x03 \x01(\tR\n" +
 	"assignRole\x12/\n" +
-	"\x14update_on_each_login\x18\x04 \x01(\bR\x11updateOnEachLogin\"U\n" +
+	"\x14update_on_each_login\x18\x04 \x01(\bR\x11updateOnEachLogin\x12.\n" +
+	"\x13assign_custom_roles\x18\x05 \x03(\tR\x11assignCustomRoles\"\x86\x02\n" +
+	"\bRoleSpec\x12 \n" +
+	"\vdescription\x18\x01 \x01(\tR\vdescription\x12*\n" +
+	"\x05rules\x18\x02 \x03(\v2\x14.specs.RoleSpec.RuleR\x05rules\x1a\xab\x01\n" +
+	"\x04Rule\x12%\n" +
+	"\x0eresource_types\x18\x01 \x03(\tR\rresourceTypes\x12\x14\n" +
+	"\x05verbs\x18\x02 \x03(\tR\x05verbs\x12\x1a\n" +
+	"\bclusters\x18\x03 \x03(\tR\bclusters\x12\x12\n" +
+	"\x04apis\x18\x04 \x03(\tR\x04apis\x126\n" +
+	"\x17cluster_label_selectors\x18\x05 \x03(\tR\x15clusterLabelSelectors\"\xc8\x02\n" +
+	"\x14ElevationRequestSpec\x12\x1a\n" +
+	"\bidentity\x18\x01 \x01(\tR\bidentity\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x1a\n" +
+	"\bclusters\x18\x03 \x03(\tR\bclusters\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x125\n" +
+	"\bduration\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12\x1f\n" +
+	"\vapproved_by\x18\x06 \x01(\tR\n" +
+	"approvedBy\x12;\n" +
+	"\vapproved_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"approvedAt\x12\x18\n" +
+	"\arevoked\x18\b \x01(\bR\arevoked\x12\x1d\n" +
+	"\n" +
+	"revoked_by\x18\t \x01(\tR\trevokedBy\"\xbd\x01\n" +
+	"\x12ElevationGrantSpec\x12\x1a\n" +
+	"\bidentity\x18\x01 \x01(\tR\bidentity\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x1a\n" +
+	"\bclusters\x18\x03 \x03(\tR\bclusters\x12:\n" +
+	"\n" +
+	"expiration\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expiration\x12\x1f\n" +
+	"\vapproved_by\x18\x05 \x01(\tR\n" +
+	"approvedBy\"U\n" +
 	"\x16IdentityLastActiveSpec\x12;\n" +
 	"\vlast_active\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"lastActive\"R\n" +
@@ -2037,14 +2618,15 @@ const file_omni_specs_auth_proto_rawDesc = "" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04role\x18\x02 \x01(\tR\x04role\x12\x1f\n" +
 	"\vlast_active\x18\x03 \x01(\tR\n" +
-	"lastActive\"\x9f\x03\n" +
+	"lastActive\"\xc2\x03\n" +
 	"\x18ServiceAccountStatusSpec\x12\x12\n" +
 	"\x04role\x18\x01 \x01(\tR\x04role\x12M\n" +
 	"\vpublic_keys\x18\x02 \x03(\v2,.specs.ServiceAccountStatusSpec.PgpPublicKeyR\n" +
 	"publicKeys\x12:\n" +
 	"\n" +
 	"expiration\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"expiration\x1a\xe3\x01\n" +
+	"expiration\x12!\n" +
+	"\fcustom_roles\x18\x04 \x03(\tR\vcustomRoles\x1a\xe3\x01\n" +
 	"\fPgpPublicKey\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
 	"\aarmored\x18\x02 \x01(\tR\aarmored\x12:\n" +
@@ -2070,7 +2652,7 @@ func file_omni_specs_auth_proto_rawDescGZIP() []byte {
 }
 
 var file_omni_specs_auth_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_omni_specs_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_omni_specs_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
 var file_omni_specs_auth_proto_goTypes = []any{
 	(PublicKeySpec_Type)(0),                                  // 0: specs.PublicKeySpec.Type
 	(*AuthConfigSpec)(nil),                                   // 1: specs.AuthConfigSpec
@@ -2085,70 +2667,85 @@ var file_omni_specs_auth_proto_goTypes = []any{
 	(*AccessPolicyTest)(nil),                                 // 10: specs.AccessPolicyTest
 	(*AccessPolicySpec)(nil),                                 // 11: specs.AccessPolicySpec
 	(*SAMLLabelRuleSpec)(nil),                                // 12: specs.SAMLLabelRuleSpec
-	(*IdentityLastActiveSpec)(nil),                           // 13: specs.IdentityLastActiveSpec
-	(*PublicKeyLastActiveSpec)(nil),                          // 14: specs.PublicKeyLastActiveSpec
-	(*IdentityStatusSpec)(nil),                               // 15: specs.IdentityStatusSpec
-	(*ServiceAccountStatusSpec)(nil),                         // 16: specs.ServiceAccountStatusSpec
-	(*EulaAcceptanceSpec)(nil),                               // 17: specs.EulaAcceptanceSpec
-	(*AuthConfigSpec_Auth0)(nil),                             // 18: specs.AuthConfigSpec.Auth0
-	(*AuthConfigSpec_OIDC)(nil),                              // 19: specs.AuthConfigSpec.OIDC
-	(*AuthConfigSpec_Webauthn)(nil),                          // 20: specs.AuthConfigSpec.Webauthn
-	(*AuthConfigSpec_SAML)(nil),                              // 21: specs.AuthConfigSpec.SAML
-	nil,                                                      // 22: specs.AuthConfigSpec.SAML.LabelRulesEntry
-	nil,                                                      // 23: specs.AuthConfigSpec.SAML.AttributeRulesEntry
-	(*AccessPolicyUserGroup_User)(nil),                       // 24: specs.AccessPolicyUserGroup.User
-	(*AccessPolicyClusterGroup_Cluster)(nil),                 // 25: specs.AccessPolicyClusterGroup.Cluster
-	(*AccessPolicyRule_Kubernetes)(nil),                      // 26: specs.AccessPolicyRule.Kubernetes
-	(*AccessPolicyRule_Kubernetes_Impersonate)(nil),          // 27: specs.AccessPolicyRule.Kubernetes.Impersonate
-	(*AccessPolicyTest_Expected)(nil),                        // 28: specs.AccessPolicyTest.Expected
-	(*AccessPolicyTest_User)(nil),                            // 29: specs.AccessPolicyTest.User
-	(*AccessPolicyTest_Cluster)(nil),                         // 30: specs.AccessPolicyTest.Cluster
-	(*AccessPolicyTest_Expected_Kubernetes)(nil),             // 31: specs.AccessPolicyTest.Expected.Kubernetes
-	(*AccessPolicyTest_Expected_Kubernetes_Impersonate)(nil), // 32: specs.AccessPolicyTest.Expected.Kubernetes.Impersonate
-	nil, // 33: specs.AccessPolicyTest.User.LabelsEntry
-	nil, // 34: specs.AccessPolicySpec.UserGroupsEntry
-	nil, // 35: specs.AccessPolicySpec.ClusterGroupsEntry
-	(*ServiceAccountStatusSpec_PgpPublicKey)(nil), // 36: specs.ServiceAccountStatusSpec.PgpPublicKey
-	(*timestamppb.Timestamp)(nil),                 // 37: google.protobuf.Timestamp
+	(*RoleSpec)(nil),                                         // 13: specs.RoleSpec
+	(*ElevationRequestSpec)(nil),                             // 14: specs.ElevationRequestSpec
+	(*ElevationGrantSpec)(nil),                               // 15: specs.ElevationGrantSpec
+	(*IdentityLastActiveSpec)(nil),                           // 16: specs.IdentityLastActiveSpec
+	(*PublicKeyLastActiveSpec)(nil),                          // 17: specs.PublicKeyLastActiveSpec
+	(*IdentityStatusSpec)(nil),                               // 18: specs.IdentityStatusSpec
+	(*ServiceAccountStatusSpec)(nil),                         // 19: specs.ServiceAccountStatusSpec
+	(*EulaAcceptanceSpec)(nil),                               // 20: specs.EulaAcceptanceSpec
+	(*AuthConfigSpec_Auth0)(nil),                             // 21: specs.AuthConfigSpec.Auth0
+	(*AuthConfigSpec_OIDC)(nil),                              // 22: specs.AuthConfigSpec.OIDC
+	(*AuthConfigSpec_Webauthn)(nil),                          // 23: specs.AuthConfigSpec.Webauthn
+	(*AuthConfigSpec_SAML)(nil),                              // 24: specs.AuthConfigSpec.SAML
+	nil,                                                      // 25: specs.AuthConfigSpec.SAML.LabelRulesEntry
+	nil,                                                      // 26: specs.AuthConfigSpec.SAML.AttributeRulesEntry
+	(*AccessPolicyUserGroup_User)(nil),                       // 27: specs.AccessPolicyUserGroup.User
+	(*AccessPolicyClusterGroup_Cluster)(nil),                 // 28: specs.AccessPolicyClusterGroup.Cluster
+	(*AccessPolicyRule_Kubernetes)(nil),                      // 29: specs.AccessPolicyRule.Kubernetes
+	(*AccessPolicyRule_ExposedServices)(nil),                 // 30: specs.AccessPolicyRule.ExposedServices
+	(*AccessPolicyRule_Talos)(nil),                           // 31: specs.AccessPolicyRule.Talos
+	(*AccessPolicyRule_Kubernetes_Impersonate)(nil),          // 32: specs.AccessPolicyRule.Kubernetes.Impersonate
+	(*AccessPolicyTest_Expected)(nil),                        // 33: specs.AccessPolicyTest.Expected
+	(*AccessPolicyTest_User)(nil),                            // 34: specs.AccessPolicyTest.User
+	(*AccessPolicyTest_Cluster)(nil),                         // 35: specs.AccessPolicyTest.Cluster
+	(*AccessPolicyTest_Expected_Kubernetes)(nil),             // 36: specs.AccessPolicyTest.Expected.Kubernetes
+	(*AccessPolicyTest_Expected_Talos)(nil),                  // 37: specs.AccessPolicyTest.Expected.Talos
+	(*AccessPolicyTest_Expected_Kubernetes_Impersonate)(nil), // 38: specs.AccessPolicyTest.Expected.Kubernetes.Impersonate
+	nil,                   // 39: specs.AccessPolicyTest.User.LabelsEntry
+	nil,                   // 40: specs.AccessPolicySpec.UserGroupsEntry
+	nil,                   // 41: specs.AccessPolicySpec.ClusterGroupsEntry
+	(*RoleSpec_Rule)(nil), // 42: specs.RoleSpec.Rule
+	(*ServiceAccountStatusSpec_PgpPublicKey)(nil), // 43: specs.ServiceAccountStatusSpec.PgpPublicKey
+	(*timestamppb.Timestamp)(nil),                 // 44: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),                   // 45: google.protobuf.Duration
 }
 var file_omni_specs_auth_proto_depIdxs = []int32{
-	18, // 0: specs.AuthConfigSpec.auth0:type_name -> specs.AuthConfigSpec.Auth0
-	20, // 1: specs.AuthConfigSpec.webauthn:type_name -> specs.AuthConfigSpec.Webauthn
-	21, // 2: specs.AuthConfigSpec.saml:type_name -> specs.AuthConfigSpec.SAML
-	19, // 3: specs.AuthConfigSpec.oidc:type_name -> specs.AuthConfigSpec.OIDC
-	37, // 4: specs.PublicKeySpec.expiration:type_name -> google.protobuf.Timestamp
+	21, // 0: specs.AuthConfigSpec.auth0:type_name -> specs.AuthConfigSpec.Auth0
+	23, // 1: specs.AuthConfigSpec.webauthn:type_name -> specs.AuthConfigSpec.Webauthn
+	24, // 2: specs.AuthConfigSpec.saml:type_name -> specs.AuthConfigSpec.SAML
+	22, // 3: specs.AuthConfigSpec.oidc:type_name -> specs.AuthConfigSpec.OIDC
+	44, // 4: specs.PublicKeySpec.expiration:type_name -> google.protobuf.Timestamp
 	5,  // 5: specs.PublicKeySpec.identity:type_name -> specs.Identity
 	0,  // 6: specs.PublicKeySpec.type:type_name -> specs.PublicKeySpec.Type
-	24, // 7: specs.AccessPolicyUserGroup.users:type_name -> specs.AccessPolicyUserGroup.User
-	25, // 8: specs.AccessPolicyClusterGroup.clusters:type_name -> specs.AccessPolicyClusterGroup.Cluster
-	26, // 9: specs.AccessPolicyRule.kubernetes:type_name -> specs.AccessPolicyRule.Kubernetes
-	29, // 10: specs.AccessPolicyTest.user:type_name -> specs.AccessPolicyTest.User
-	30, // 11: specs.AccessPolicyTest.cluster:type_name -> specs.AccessPolicyTest.Cluster
-	28, // 12: specs.AccessPolicyTest.expected:type_name -> specs.AccessPolicyTest.Expected
-	34, // 13: specs.AccessPolicySpec.user_groups:type_name -> specs.AccessPolicySpec.UserGroupsEntry
-	35, // 14: specs.AccessPolicySpec.cluster_groups:type_name -> specs.AccessPolicySpec.ClusterGroupsEntry
-	9,  // 15: specs.AccessPolicySpec.rules:type_name -> specs.AccessPolicyRule
-	10, // 16: specs.AccessPolicySpec.tests:type_name -> specs.AccessPolicyTest
-	37, // 17: specs.IdentityLastActiveSpec.last_active:type_name -> google.protobuf.Timestamp
-	37, // 18: specs.PublicKeyLastActiveSpec.last_used:type_name -> google.protobuf.Timestamp
-	36, // 19: specs.ServiceAccountStatusSpec.public_keys:type_name -> specs.ServiceAccountStatusSpec.PgpPublicKey
-	37, // 20: specs.ServiceAccountStatusSpec.expiration:type_name -> google.protobuf.Timestamp
-	22, // 21: specs.AuthConfigSpec.SAML.label_rules:type_name -> specs.AuthConfigSpec.SAML.LabelRulesEntry
-	23, // 22: specs.AuthConfigSpec.SAML.attribute_rules:type_name -> specs.AuthConfigSpec.SAML.AttributeRulesEntry
-	27, // 23: specs.AccessPolicyRule.Kubernetes.impersonate:type_name -> specs.AccessPolicyRule.Kubernetes.Impersonate
-	31, // 24: specs.AccessPolicyTest.Expected.kubernetes:type_name -> specs.AccessPolicyTest.Expected.Kubernetes
-	33, // 25: specs.AccessPolicyTest.User.labels:type_name -> specs.AccessPolicyTest.User.LabelsEntry
-	32, // 26: specs.AccessPolicyTest.Expected.Kubernetes.impersonate:type_name -> specs.AccessPolicyTest.Expected.Kubernetes.Impersonate
-	7,  // 27: specs.AccessPolicySpec.UserGroupsEntry.value:type_name -> specs.AccessPolicyUserGroup
-	8,  // 28: specs.AccessPolicySpec.ClusterGroupsEntry.value:type_name -> specs.AccessPolicyClusterGroup
-	37, // 29: specs.ServiceAccountStatusSpec.PgpPublicKey.expiration:type_name -> google.protobuf.Timestamp
-	37, // 30: specs.ServiceAccountStatusSpec.PgpPublicKey.created:type_name -> google.protobuf.Timestamp
-	37, // 31: specs.ServiceAccountStatusSpec.PgpPublicKey.last_used:type_name -> google.protobuf.Timestamp
-	32, // [32:32] is the sub-list for method output_type
-	32, // [32:32] is the sub-list for method input_type
-	32, // [32:32] is the sub-list for extension type_name
-	32, // [32:32] is the sub-list for extension extendee
-	0,  // [0:32] is the sub-list for field type_name
+	27, // 7: specs.AccessPolicyUserGroup.users:type_name -> specs.AccessPolicyUserGroup.User
+	28, // 8: specs.AccessPolicyClusterGroup.clusters:type_name -> specs.AccessPolicyClusterGroup.Cluster
+	29, // 9: specs.AccessPolicyRule.kubernetes:type_name -> specs.AccessPolicyRule.Kubernetes
+	30, // 10: specs.AccessPolicyRule.exposed_services:type_name -> specs.AccessPolicyRule.ExposedServices
+	31, // 11: specs.AccessPolicyRule.talos:type_name -> specs.AccessPolicyRule.Talos
+	34, // 12: specs.AccessPolicyTest.user:type_name -> specs.AccessPolicyTest.User
+	35, // 13: specs.AccessPolicyTest.cluster:type_name -> specs.AccessPolicyTest.Cluster
+	33, // 14: specs.AccessPolicyTest.expected:type_name -> specs.AccessPolicyTest.Expected
+	40, // 15: specs.AccessPolicySpec.user_groups:type_name -> specs.AccessPolicySpec.UserGroupsEntry
+	41, // 16: specs.AccessPolicySpec.cluster_groups:type_name -> specs.AccessPolicySpec.ClusterGroupsEntry
+	9,  // 17: specs.AccessPolicySpec.rules:type_name -> specs.AccessPolicyRule
+	10, // 18: specs.AccessPolicySpec.tests:type_name -> specs.AccessPolicyTest
+	42, // 19: specs.RoleSpec.rules:type_name -> specs.RoleSpec.Rule
+	45, // 20: specs.ElevationRequestSpec.duration:type_name -> google.protobuf.Duration
+	44, // 21: specs.ElevationRequestSpec.approved_at:type_name -> google.protobuf.Timestamp
+	44, // 22: specs.ElevationGrantSpec.expiration:type_name -> google.protobuf.Timestamp
+	44, // 23: specs.IdentityLastActiveSpec.last_active:type_name -> google.protobuf.Timestamp
+	44, // 24: specs.PublicKeyLastActiveSpec.last_used:type_name -> google.protobuf.Timestamp
+	43, // 25: specs.ServiceAccountStatusSpec.public_keys:type_name -> specs.ServiceAccountStatusSpec.PgpPublicKey
+	44, // 26: specs.ServiceAccountStatusSpec.expiration:type_name -> google.protobuf.Timestamp
+	25, // 27: specs.AuthConfigSpec.SAML.label_rules:type_name -> specs.AuthConfigSpec.SAML.LabelRulesEntry
+	26, // 28: specs.AuthConfigSpec.SAML.attribute_rules:type_name -> specs.AuthConfigSpec.SAML.AttributeRulesEntry
+	32, // 29: specs.AccessPolicyRule.Kubernetes.impersonate:type_name -> specs.AccessPolicyRule.Kubernetes.Impersonate
+	36, // 30: specs.AccessPolicyTest.Expected.kubernetes:type_name -> specs.AccessPolicyTest.Expected.Kubernetes
+	37, // 31: specs.AccessPolicyTest.Expected.talos:type_name -> specs.AccessPolicyTest.Expected.Talos
+	39, // 32: specs.AccessPolicyTest.User.labels:type_name -> specs.AccessPolicyTest.User.LabelsEntry
+	38, // 33: specs.AccessPolicyTest.Expected.Kubernetes.impersonate:type_name -> specs.AccessPolicyTest.Expected.Kubernetes.Impersonate
+	7,  // 34: specs.AccessPolicySpec.UserGroupsEntry.value:type_name -> specs.AccessPolicyUserGroup
+	8,  // 35: specs.AccessPolicySpec.ClusterGroupsEntry.value:type_name -> specs.AccessPolicyClusterGroup
+	44, // 36: specs.ServiceAccountStatusSpec.PgpPublicKey.expiration:type_name -> google.protobuf.Timestamp
+	44, // 37: specs.ServiceAccountStatusSpec.PgpPublicKey.created:type_name -> google.protobuf.Timestamp
+	44, // 38: specs.ServiceAccountStatusSpec.PgpPublicKey.last_used:type_name -> google.protobuf.Timestamp
+	39, // [39:39] is the sub-list for method output_type
+	39, // [39:39] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
 }
 
 func init() { file_omni_specs_auth_proto_init() }
@@ -2162,7 +2759,7 @@ func file_omni_specs_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_omni_specs_auth_proto_rawDesc), len(file_omni_specs_auth_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   36,
+			NumMessages:   43,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
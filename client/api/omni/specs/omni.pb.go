@@ -393,6 +393,59 @@ func (MachineStatusSpec_PowerState) EnumDescriptor() ([]byte, []int) {
 	return file_omni_specs_omni_proto_rawDescGZIP(), []int{4, 1}
 }
 
+type EtcdBackupStoreConfigSpec_Backend int32
+
+const (
+	// S3 stores the backups in the S3 bucket configured by the EtcdBackupS3Conf resource.
+	EtcdBackupStoreConfigSpec_S3         EtcdBackupStoreConfigSpec_Backend = 0
+	EtcdBackupStoreConfigSpec_GCS        EtcdBackupStoreConfigSpec_Backend = 1
+	EtcdBackupStoreConfigSpec_AZURE_BLOB EtcdBackupStoreConfigSpec_Backend = 2
+	EtcdBackupStoreConfigSpec_SFTP       EtcdBackupStoreConfigSpec_Backend = 3
+)
+
+// Enum value maps for EtcdBackupStoreConfigSpec_Backend.
+var (
+	EtcdBackupStoreConfigSpec_Backend_name = map[int32]string{
+		0: "S3",
+		1: "GCS",
+		2: "AZURE_BLOB",
+		3: "SFTP",
+	}
+	EtcdBackupStoreConfigSpec_Backend_value = map[string]int32{
+		"S3":         0,
+		"GCS":        1,
+		"AZURE_BLOB": 2,
+		"SFTP":       3,
+	}
+)
+
+func (x EtcdBackupStoreConfigSpec_Backend) Enum() *EtcdBackupStoreConfigSpec_Backend {
+	p := new(EtcdBackupStoreConfigSpec_Backend)
+	*p = x
+	return p
+}
+
+func (x EtcdBackupStoreConfigSpec_Backend) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EtcdBackupStoreConfigSpec_Backend) Descriptor() protoreflect.EnumDescriptor {
+	return file_omni_specs_omni_proto_enumTypes[7].Descriptor()
+}
+
+func (EtcdBackupStoreConfigSpec_Backend) Type() protoreflect.EnumType {
+	return &file_omni_specs_omni_proto_enumTypes[7]
+}
+
+func (x EtcdBackupStoreConfigSpec_Backend) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EtcdBackupStoreConfigSpec_Backend.Descriptor instead.
+func (EtcdBackupStoreConfigSpec_Backend) EnumDescriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{17, 0}
+}
+
 type EtcdBackupStatusSpec_Status int32
 
 const (
@@ -429,11 +482,11 @@ func (x EtcdBackupStatusSpec_Status) String() string {
 }
 
 func (EtcdBackupStatusSpec_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_omni_specs_omni_proto_enumTypes[7].Descriptor()
+	return file_omni_specs_omni_proto_enumTypes[8].Descriptor()
 }
 
 func (EtcdBackupStatusSpec_Status) Type() protoreflect.EnumType {
-	return &file_omni_specs_omni_proto_enumTypes[7]
+	return &file_omni_specs_omni_proto_enumTypes[8]
 }
 
 func (x EtcdBackupStatusSpec_Status) Number() protoreflect.EnumNumber {
@@ -442,7 +495,7 @@ func (x EtcdBackupStatusSpec_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EtcdBackupStatusSpec_Status.Descriptor instead.
 func (EtcdBackupStatusSpec_Status) EnumDescriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{14, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{18, 0}
 }
 
 type ClusterMachineStatusSpec_Stage int32
@@ -505,11 +558,11 @@ func (x ClusterMachineStatusSpec_Stage) String() string {
 }
 
 func (ClusterMachineStatusSpec_Stage) Descriptor() protoreflect.EnumDescriptor {
-	return file_omni_specs_omni_proto_enumTypes[8].Descriptor()
+	return file_omni_specs_omni_proto_enumTypes[9].Descriptor()
 }
 
 func (ClusterMachineStatusSpec_Stage) Type() protoreflect.EnumType {
-	return &file_omni_specs_omni_proto_enumTypes[8]
+	return &file_omni_specs_omni_proto_enumTypes[9]
 }
 
 func (x ClusterMachineStatusSpec_Stage) Number() protoreflect.EnumNumber {
@@ -518,7 +571,7 @@ func (x ClusterMachineStatusSpec_Stage) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ClusterMachineStatusSpec_Stage.Descriptor instead.
 func (ClusterMachineStatusSpec_Stage) EnumDescriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{24, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{28, 0}
 }
 
 type ClusterStatusSpec_Phase int32
@@ -560,11 +613,11 @@ func (x ClusterStatusSpec_Phase) String() string {
 }
 
 func (ClusterStatusSpec_Phase) Descriptor() protoreflect.EnumDescriptor {
-	return file_omni_specs_omni_proto_enumTypes[9].Descriptor()
+	return file_omni_specs_omni_proto_enumTypes[10].Descriptor()
 }
 
 func (ClusterStatusSpec_Phase) Type() protoreflect.EnumType {
-	return &file_omni_specs_omni_proto_enumTypes[9]
+	return &file_omni_specs_omni_proto_enumTypes[10]
 }
 
 func (x ClusterStatusSpec_Phase) Number() protoreflect.EnumNumber {
@@ -573,7 +626,7 @@ func (x ClusterStatusSpec_Phase) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ClusterStatusSpec_Phase.Descriptor instead.
 func (ClusterStatusSpec_Phase) EnumDescriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{26, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{30, 0}
 }
 
 // UpdateStrategy defines the update strategy of the machine set.
@@ -582,6 +635,7 @@ type MachineSetSpec_UpdateStrategy int32
 const (
 	MachineSetSpec_Unset   MachineSetSpec_UpdateStrategy = 0
 	MachineSetSpec_Rolling MachineSetSpec_UpdateStrategy = 1
+	MachineSetSpec_Canary  MachineSetSpec_UpdateStrategy = 2
 )
 
 // Enum value maps for MachineSetSpec_UpdateStrategy.
@@ -589,10 +643,12 @@ var (
 	MachineSetSpec_UpdateStrategy_name = map[int32]string{
 		0: "Unset",
 		1: "Rolling",
+		2: "Canary",
 	}
 	MachineSetSpec_UpdateStrategy_value = map[string]int32{
 		"Unset":   0,
 		"Rolling": 1,
+		"Canary":  2,
 	}
 )
 
@@ -607,11 +663,11 @@ func (x MachineSetSpec_UpdateStrategy) String() string {
 }
 
 func (MachineSetSpec_UpdateStrategy) Descriptor() protoreflect.EnumDescriptor {
-	return file_omni_specs_omni_proto_enumTypes[10].Descriptor()
+	return file_omni_specs_omni_proto_enumTypes[11].Descriptor()
 }
 
 func (MachineSetSpec_UpdateStrategy) Type() protoreflect.EnumType {
-	return &file_omni_specs_omni_proto_enumTypes[10]
+	return &file_omni_specs_omni_proto_enumTypes[11]
 }
 
 func (x MachineSetSpec_UpdateStrategy) Number() protoreflect.EnumNumber {
@@ -620,7 +676,7 @@ func (x MachineSetSpec_UpdateStrategy) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MachineSetSpec_UpdateStrategy.Descriptor instead.
 func (MachineSetSpec_UpdateStrategy) EnumDescriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{40, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{44, 0}
 }
 
 type MachineSetSpec_MachineClass_Type int32
@@ -655,11 +711,11 @@ func (x MachineSetSpec_MachineClass_Type) String() string {
 }
 
 func (MachineSetSpec_MachineClass_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_omni_specs_omni_proto_enumTypes[11].Descriptor()
+	return file_omni_specs_omni_proto_enumTypes[12].Descriptor()
 }
 
 func (MachineSetSpec_MachineClass_Type) Type() protoreflect.EnumType {
-	return &file_omni_specs_omni_proto_enumTypes[11]
+	return &file_omni_specs_omni_proto_enumTypes[12]
 }
 
 func (x MachineSetSpec_MachineClass_Type) Number() protoreflect.EnumNumber {
@@ -668,7 +724,7 @@ func (x MachineSetSpec_MachineClass_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MachineSetSpec_MachineClass_Type.Descriptor instead.
 func (MachineSetSpec_MachineClass_Type) EnumDescriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{40, 0, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{44, 0, 0}
 }
 
 type MachineSetSpec_MachineAllocation_Type int32
@@ -703,11 +759,11 @@ func (x MachineSetSpec_MachineAllocation_Type) String() string {
 }
 
 func (MachineSetSpec_MachineAllocation_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_omni_specs_omni_proto_enumTypes[12].Descriptor()
+	return file_omni_specs_omni_proto_enumTypes[13].Descriptor()
 }
 
 func (MachineSetSpec_MachineAllocation_Type) Type() protoreflect.EnumType {
-	return &file_omni_specs_omni_proto_enumTypes[12]
+	return &file_omni_specs_omni_proto_enumTypes[13]
 }
 
 func (x MachineSetSpec_MachineAllocation_Type) Number() protoreflect.EnumNumber {
@@ -716,7 +772,7 @@ func (x MachineSetSpec_MachineAllocation_Type) Number() protoreflect.EnumNumber
 
 // Deprecated: Use MachineSetSpec_MachineAllocation_Type.Descriptor instead.
 func (MachineSetSpec_MachineAllocation_Type) EnumDescriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{40, 1, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{44, 1, 0}
 }
 
 type TalosUpgradeStatusSpec_Phase int32
@@ -761,11 +817,11 @@ func (x TalosUpgradeStatusSpec_Phase) String() string {
 }
 
 func (TalosUpgradeStatusSpec_Phase) Descriptor() protoreflect.EnumDescriptor {
-	return file_omni_specs_omni_proto_enumTypes[13].Descriptor()
+	return file_omni_specs_omni_proto_enumTypes[14].Descriptor()
 }
 
 func (TalosUpgradeStatusSpec_Phase) Type() protoreflect.EnumType {
-	return &file_omni_specs_omni_proto_enumTypes[13]
+	return &file_omni_specs_omni_proto_enumTypes[14]
 }
 
 func (x TalosUpgradeStatusSpec_Phase) Number() protoreflect.EnumNumber {
@@ -774,7 +830,70 @@ func (x TalosUpgradeStatusSpec_Phase) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TalosUpgradeStatusSpec_Phase.Descriptor instead.
 func (TalosUpgradeStatusSpec_Phase) EnumDescriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{41, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{45, 0}
+}
+
+type CanaryRolloutStatus_Phase int32
+
+const (
+	CanaryRolloutStatus_Unknown CanaryRolloutStatus_Phase = 0
+	// Updating means that the canary machines are being updated.
+	CanaryRolloutStatus_Updating CanaryRolloutStatus_Phase = 1
+	// Verifying means that the canary machines are updated and the rollout waits for them to be verified.
+	CanaryRolloutStatus_Verifying CanaryRolloutStatus_Phase = 2
+	// Promoted means that the canaries were verified and the rest of the machine set is being updated.
+	CanaryRolloutStatus_Promoted CanaryRolloutStatus_Phase = 3
+	// RollingBack means that the canaries failed the verification and are being rolled back.
+	CanaryRolloutStatus_RollingBack CanaryRolloutStatus_Phase = 4
+	// Failed means that the canaries failed the verification, the rollout is held until the pending changes are reverted or replaced.
+	CanaryRolloutStatus_Failed CanaryRolloutStatus_Phase = 5
+)
+
+// Enum value maps for CanaryRolloutStatus_Phase.
+var (
+	CanaryRolloutStatus_Phase_name = map[int32]string{
+		0: "Unknown",
+		1: "Updating",
+		2: "Verifying",
+		3: "Promoted",
+		4: "RollingBack",
+		5: "Failed",
+	}
+	CanaryRolloutStatus_Phase_value = map[string]int32{
+		"Unknown":     0,
+		"Updating":    1,
+		"Verifying":   2,
+		"Promoted":    3,
+		"RollingBack": 4,
+		"Failed":      5,
+	}
+)
+
+func (x CanaryRolloutStatus_Phase) Enum() *CanaryRolloutStatus_Phase {
+	p := new(CanaryRolloutStatus_Phase)
+	*p = x
+	return p
+}
+
+func (x CanaryRolloutStatus_Phase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CanaryRolloutStatus_Phase) Descriptor() protoreflect.EnumDescriptor {
+	return file_omni_specs_omni_proto_enumTypes[15].Descriptor()
+}
+
+func (CanaryRolloutStatus_Phase) Type() protoreflect.EnumType {
+	return &file_omni_specs_omni_proto_enumTypes[15]
+}
+
+func (x CanaryRolloutStatus_Phase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CanaryRolloutStatus_Phase.Descriptor instead.
+func (CanaryRolloutStatus_Phase) EnumDescriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{47, 0}
 }
 
 type MachineStatusSnapshotSpec_PowerStage int32
@@ -810,11 +929,11 @@ func (x MachineStatusSnapshotSpec_PowerStage) String() string {
 }
 
 func (MachineStatusSnapshotSpec_PowerStage) Descriptor() protoreflect.EnumDescriptor {
-	return file_omni_specs_omni_proto_enumTypes[14].Descriptor()
+	return file_omni_specs_omni_proto_enumTypes[16].Descriptor()
 }
 
 func (MachineStatusSnapshotSpec_PowerStage) Type() protoreflect.EnumType {
-	return &file_omni_specs_omni_proto_enumTypes[14]
+	return &file_omni_specs_omni_proto_enumTypes[16]
 }
 
 func (x MachineStatusSnapshotSpec_PowerStage) Number() protoreflect.EnumNumber {
@@ -823,7 +942,7 @@ func (x MachineStatusSnapshotSpec_PowerStage) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MachineStatusSnapshotSpec_PowerStage.Descriptor instead.
 func (MachineStatusSnapshotSpec_PowerStage) EnumDescriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{46, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{52, 0}
 }
 
 type ControlPlaneStatusSpec_Condition_Status int32
@@ -859,11 +978,11 @@ func (x ControlPlaneStatusSpec_Condition_Status) String() string {
 }
 
 func (ControlPlaneStatusSpec_Condition_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_omni_specs_omni_proto_enumTypes[15].Descriptor()
+	return file_omni_specs_omni_proto_enumTypes[17].Descriptor()
 }
 
 func (ControlPlaneStatusSpec_Condition_Status) Type() protoreflect.EnumType {
-	return &file_omni_specs_omni_proto_enumTypes[15]
+	return &file_omni_specs_omni_proto_enumTypes[17]
 }
 
 func (x ControlPlaneStatusSpec_Condition_Status) Number() protoreflect.EnumNumber {
@@ -872,7 +991,7 @@ func (x ControlPlaneStatusSpec_Condition_Status) Number() protoreflect.EnumNumbe
 
 // Deprecated: Use ControlPlaneStatusSpec_Condition_Status.Descriptor instead.
 func (ControlPlaneStatusSpec_Condition_Status) EnumDescriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{47, 0, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{53, 0, 0}
 }
 
 type ControlPlaneStatusSpec_Condition_Severity int32
@@ -908,11 +1027,11 @@ func (x ControlPlaneStatusSpec_Condition_Severity) String() string {
 }
 
 func (ControlPlaneStatusSpec_Condition_Severity) Descriptor() protoreflect.EnumDescriptor {
-	return file_omni_specs_omni_proto_enumTypes[16].Descriptor()
+	return file_omni_specs_omni_proto_enumTypes[18].Descriptor()
 }
 
 func (ControlPlaneStatusSpec_Condition_Severity) Type() protoreflect.EnumType {
-	return &file_omni_specs_omni_proto_enumTypes[16]
+	return &file_omni_specs_omni_proto_enumTypes[18]
 }
 
 func (x ControlPlaneStatusSpec_Condition_Severity) Number() protoreflect.EnumNumber {
@@ -921,7 +1040,7 @@ func (x ControlPlaneStatusSpec_Condition_Severity) Number() protoreflect.EnumNum
 
 // Deprecated: Use ControlPlaneStatusSpec_Condition_Severity.Descriptor instead.
 func (ControlPlaneStatusSpec_Condition_Severity) EnumDescriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{47, 0, 1}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{53, 0, 1}
 }
 
 type KubernetesUpgradeStatusSpec_Phase int32
@@ -963,11 +1082,11 @@ func (x KubernetesUpgradeStatusSpec_Phase) String() string {
 }
 
 func (KubernetesUpgradeStatusSpec_Phase) Descriptor() protoreflect.EnumDescriptor {
-	return file_omni_specs_omni_proto_enumTypes[17].Descriptor()
+	return file_omni_specs_omni_proto_enumTypes[19].Descriptor()
 }
 
 func (KubernetesUpgradeStatusSpec_Phase) Type() protoreflect.EnumType {
-	return &file_omni_specs_omni_proto_enumTypes[17]
+	return &file_omni_specs_omni_proto_enumTypes[19]
 }
 
 func (x KubernetesUpgradeStatusSpec_Phase) Number() protoreflect.EnumNumber {
@@ -976,7 +1095,111 @@ func (x KubernetesUpgradeStatusSpec_Phase) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use KubernetesUpgradeStatusSpec_Phase.Descriptor instead.
 func (KubernetesUpgradeStatusSpec_Phase) EnumDescriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{50, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{56, 0}
+}
+
+type ExposedServiceSpec_Protocol int32
+
+const (
+	// HTTP services are proxied on the workload proxy subdomain, authenticated by the browser cookies.
+	ExposedServiceSpec_HTTP ExposedServiceSpec_Protocol = 0
+	// GRPC services are proxied like the HTTP ones, passing HTTP/2 through, authenticated by a workload proxy token.
+	ExposedServiceSpec_GRPC ExposedServiceSpec_Protocol = 1
+	// TCP services are proxied on their own port, authenticated by a workload proxy client certificate or token.
+	ExposedServiceSpec_TCP ExposedServiceSpec_Protocol = 2
+)
+
+// Enum value maps for ExposedServiceSpec_Protocol.
+var (
+	ExposedServiceSpec_Protocol_name = map[int32]string{
+		0: "HTTP",
+		1: "GRPC",
+		2: "TCP",
+	}
+	ExposedServiceSpec_Protocol_value = map[string]int32{
+		"HTTP": 0,
+		"GRPC": 1,
+		"TCP":  2,
+	}
+)
+
+func (x ExposedServiceSpec_Protocol) Enum() *ExposedServiceSpec_Protocol {
+	p := new(ExposedServiceSpec_Protocol)
+	*p = x
+	return p
+}
+
+func (x ExposedServiceSpec_Protocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExposedServiceSpec_Protocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_omni_specs_omni_proto_enumTypes[20].Descriptor()
+}
+
+func (ExposedServiceSpec_Protocol) Type() protoreflect.EnumType {
+	return &file_omni_specs_omni_proto_enumTypes[20]
+}
+
+func (x ExposedServiceSpec_Protocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExposedServiceSpec_Protocol.Descriptor instead.
+func (ExposedServiceSpec_Protocol) EnumDescriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{61, 0}
+}
+
+type ExposedServiceSpec_IdentityForwarding int32
+
+const (
+	// NONE doesn't forward the identity of the user to the service.
+	ExposedServiceSpec_NONE ExposedServiceSpec_IdentityForwarding = 0
+	// HEADERS forwards the email, the role and the groups of the user in the identity headers, along with the JWT signing them.
+	ExposedServiceSpec_HEADERS ExposedServiceSpec_IdentityForwarding = 1
+	// JWT forwards only the JWT carrying the identity of the user.
+	ExposedServiceSpec_JWT ExposedServiceSpec_IdentityForwarding = 2
+)
+
+// Enum value maps for ExposedServiceSpec_IdentityForwarding.
+var (
+	ExposedServiceSpec_IdentityForwarding_name = map[int32]string{
+		0: "NONE",
+		1: "HEADERS",
+		2: "JWT",
+	}
+	ExposedServiceSpec_IdentityForwarding_value = map[string]int32{
+		"NONE":    0,
+		"HEADERS": 1,
+		"JWT":     2,
+	}
+)
+
+func (x ExposedServiceSpec_IdentityForwarding) Enum() *ExposedServiceSpec_IdentityForwarding {
+	p := new(ExposedServiceSpec_IdentityForwarding)
+	*p = x
+	return p
+}
+
+func (x ExposedServiceSpec_IdentityForwarding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExposedServiceSpec_IdentityForwarding) Descriptor() protoreflect.EnumDescriptor {
+	return file_omni_specs_omni_proto_enumTypes[21].Descriptor()
+}
+
+func (ExposedServiceSpec_IdentityForwarding) Type() protoreflect.EnumType {
+	return &file_omni_specs_omni_proto_enumTypes[21]
+}
+
+func (x ExposedServiceSpec_IdentityForwarding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExposedServiceSpec_IdentityForwarding.Descriptor instead.
+func (ExposedServiceSpec_IdentityForwarding) EnumDescriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{61, 1}
 }
 
 type MachineUpgradeStatusSpec_Phase int32
@@ -1015,11 +1238,11 @@ func (x MachineUpgradeStatusSpec_Phase) String() string {
 }
 
 func (MachineUpgradeStatusSpec_Phase) Descriptor() protoreflect.EnumDescriptor {
-	return file_omni_specs_omni_proto_enumTypes[18].Descriptor()
+	return file_omni_specs_omni_proto_enumTypes[22].Descriptor()
 }
 
 func (MachineUpgradeStatusSpec_Phase) Type() protoreflect.EnumType {
-	return &file_omni_specs_omni_proto_enumTypes[18]
+	return &file_omni_specs_omni_proto_enumTypes[22]
 }
 
 func (x MachineUpgradeStatusSpec_Phase) Number() protoreflect.EnumNumber {
@@ -1028,7 +1251,7 @@ func (x MachineUpgradeStatusSpec_Phase) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MachineUpgradeStatusSpec_Phase.Descriptor instead.
 func (MachineUpgradeStatusSpec_Phase) EnumDescriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{76, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{82, 0}
 }
 
 type MachineExtensionsStatusSpec_Item_Phase int32
@@ -1064,11 +1287,11 @@ func (x MachineExtensionsStatusSpec_Item_Phase) String() string {
 }
 
 func (MachineExtensionsStatusSpec_Item_Phase) Descriptor() protoreflect.EnumDescriptor {
-	return file_omni_specs_omni_proto_enumTypes[19].Descriptor()
+	return file_omni_specs_omni_proto_enumTypes[23].Descriptor()
 }
 
 func (MachineExtensionsStatusSpec_Item_Phase) Type() protoreflect.EnumType {
-	return &file_omni_specs_omni_proto_enumTypes[19]
+	return &file_omni_specs_omni_proto_enumTypes[23]
 }
 
 func (x MachineExtensionsStatusSpec_Item_Phase) Number() protoreflect.EnumNumber {
@@ -1077,7 +1300,7 @@ func (x MachineExtensionsStatusSpec_Item_Phase) Number() protoreflect.EnumNumber
 
 // Deprecated: Use MachineExtensionsStatusSpec_Item_Phase.Descriptor instead.
 func (MachineExtensionsStatusSpec_Item_Phase) EnumDescriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{78, 0, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{84, 0, 0}
 }
 
 type ClusterMachineRequestStatusSpec_Stage int32
@@ -1122,11 +1345,11 @@ func (x ClusterMachineRequestStatusSpec_Stage) String() string {
 }
 
 func (ClusterMachineRequestStatusSpec_Stage) Descriptor() protoreflect.EnumDescriptor {
-	return file_omni_specs_omni_proto_enumTypes[20].Descriptor()
+	return file_omni_specs_omni_proto_enumTypes[24].Descriptor()
 }
 
 func (ClusterMachineRequestStatusSpec_Stage) Type() protoreflect.EnumType {
-	return &file_omni_specs_omni_proto_enumTypes[20]
+	return &file_omni_specs_omni_proto_enumTypes[24]
 }
 
 func (x ClusterMachineRequestStatusSpec_Stage) Number() protoreflect.EnumNumber {
@@ -1135,7 +1358,7 @@ func (x ClusterMachineRequestStatusSpec_Stage) Number() protoreflect.EnumNumber
 
 // Deprecated: Use ClusterMachineRequestStatusSpec_Stage.Descriptor instead.
 func (ClusterMachineRequestStatusSpec_Stage) EnumDescriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{88, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{94, 0}
 }
 
 type InfraMachineConfigSpec_AcceptanceStatus int32
@@ -1171,11 +1394,11 @@ func (x InfraMachineConfigSpec_AcceptanceStatus) String() string {
 }
 
 func (InfraMachineConfigSpec_AcceptanceStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_omni_specs_omni_proto_enumTypes[21].Descriptor()
+	return file_omni_specs_omni_proto_enumTypes[25].Descriptor()
 }
 
 func (InfraMachineConfigSpec_AcceptanceStatus) Type() protoreflect.EnumType {
-	return &file_omni_specs_omni_proto_enumTypes[21]
+	return &file_omni_specs_omni_proto_enumTypes[25]
 }
 
 func (x InfraMachineConfigSpec_AcceptanceStatus) Number() protoreflect.EnumNumber {
@@ -1184,7 +1407,7 @@ func (x InfraMachineConfigSpec_AcceptanceStatus) Number() protoreflect.EnumNumbe
 
 // Deprecated: Use InfraMachineConfigSpec_AcceptanceStatus.Descriptor instead.
 func (InfraMachineConfigSpec_AcceptanceStatus) EnumDescriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{89, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{95, 0}
 }
 
 type InfraMachineConfigSpec_MachinePowerState int32
@@ -1220,11 +1443,11 @@ func (x InfraMachineConfigSpec_MachinePowerState) String() string {
 }
 
 func (InfraMachineConfigSpec_MachinePowerState) Descriptor() protoreflect.EnumDescriptor {
-	return file_omni_specs_omni_proto_enumTypes[22].Descriptor()
+	return file_omni_specs_omni_proto_enumTypes[26].Descriptor()
 }
 
 func (InfraMachineConfigSpec_MachinePowerState) Type() protoreflect.EnumType {
-	return &file_omni_specs_omni_proto_enumTypes[22]
+	return &file_omni_specs_omni_proto_enumTypes[26]
 }
 
 func (x InfraMachineConfigSpec_MachinePowerState) Number() protoreflect.EnumNumber {
@@ -1233,7 +1456,7 @@ func (x InfraMachineConfigSpec_MachinePowerState) Number() protoreflect.EnumNumb
 
 // Deprecated: Use InfraMachineConfigSpec_MachinePowerState.Descriptor instead.
 func (InfraMachineConfigSpec_MachinePowerState) EnumDescriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{89, 1}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{95, 1}
 }
 
 type SecretRotationSpec_Status int32
@@ -1266,11 +1489,11 @@ func (x SecretRotationSpec_Status) String() string {
 }
 
 func (SecretRotationSpec_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_omni_specs_omni_proto_enumTypes[23].Descriptor()
+	return file_omni_specs_omni_proto_enumTypes[27].Descriptor()
 }
 
 func (SecretRotationSpec_Status) Type() protoreflect.EnumType {
-	return &file_omni_specs_omni_proto_enumTypes[23]
+	return &file_omni_specs_omni_proto_enumTypes[27]
 }
 
 func (x SecretRotationSpec_Status) Number() protoreflect.EnumNumber {
@@ -1279,7 +1502,7 @@ func (x SecretRotationSpec_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SecretRotationSpec_Status.Descriptor instead.
 func (SecretRotationSpec_Status) EnumDescriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{98, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{104, 0}
 }
 
 type SecretRotationSpec_Phase int32
@@ -1318,11 +1541,11 @@ func (x SecretRotationSpec_Phase) String() string {
 }
 
 func (SecretRotationSpec_Phase) Descriptor() protoreflect.EnumDescriptor {
-	return file_omni_specs_omni_proto_enumTypes[24].Descriptor()
+	return file_omni_specs_omni_proto_enumTypes[28].Descriptor()
 }
 
 func (SecretRotationSpec_Phase) Type() protoreflect.EnumType {
-	return &file_omni_specs_omni_proto_enumTypes[24]
+	return &file_omni_specs_omni_proto_enumTypes[28]
 }
 
 func (x SecretRotationSpec_Phase) Number() protoreflect.EnumNumber {
@@ -1331,15 +1554,19 @@ func (x SecretRotationSpec_Phase) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SecretRotationSpec_Phase.Descriptor instead.
 func (SecretRotationSpec_Phase) EnumDescriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{98, 1}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{104, 1}
 }
 
 type SecretRotationSpec_Component int32
 
 const (
-	SecretRotationSpec_NONE          SecretRotationSpec_Component = 0
-	SecretRotationSpec_TALOS_CA      SecretRotationSpec_Component = 1
-	SecretRotationSpec_KUBERNETES_CA SecretRotationSpec_Component = 2
+	SecretRotationSpec_NONE                           SecretRotationSpec_Component = 0
+	SecretRotationSpec_TALOS_CA                       SecretRotationSpec_Component = 1
+	SecretRotationSpec_KUBERNETES_CA                  SecretRotationSpec_Component = 2
+	SecretRotationSpec_ETCD_CA                        SecretRotationSpec_Component = 3
+	SecretRotationSpec_KUBERNETES_AGGREGATOR_CA       SecretRotationSpec_Component = 4
+	SecretRotationSpec_KUBERNETES_SERVICE_ACCOUNT_KEY SecretRotationSpec_Component = 5
+	SecretRotationSpec_BOOTSTRAP_TOKEN                SecretRotationSpec_Component = 6
 )
 
 // Enum value maps for SecretRotationSpec_Component.
@@ -1348,11 +1575,19 @@ var (
 		0: "NONE",
 		1: "TALOS_CA",
 		2: "KUBERNETES_CA",
+		3: "ETCD_CA",
+		4: "KUBERNETES_AGGREGATOR_CA",
+		5: "KUBERNETES_SERVICE_ACCOUNT_KEY",
+		6: "BOOTSTRAP_TOKEN",
 	}
 	SecretRotationSpec_Component_value = map[string]int32{
-		"NONE":          0,
-		"TALOS_CA":      1,
-		"KUBERNETES_CA": 2,
+		"NONE":                           0,
+		"TALOS_CA":                       1,
+		"KUBERNETES_CA":                  2,
+		"ETCD_CA":                        3,
+		"KUBERNETES_AGGREGATOR_CA":       4,
+		"KUBERNETES_SERVICE_ACCOUNT_KEY": 5,
+		"BOOTSTRAP_TOKEN":                6,
 	}
 )
 
@@ -1367,11 +1602,11 @@ func (x SecretRotationSpec_Component) String() string {
 }
 
 func (SecretRotationSpec_Component) Descriptor() protoreflect.EnumDescriptor {
-	return file_omni_specs_omni_proto_enumTypes[25].Descriptor()
+	return file_omni_specs_omni_proto_enumTypes[29].Descriptor()
 }
 
 func (SecretRotationSpec_Component) Type() protoreflect.EnumType {
-	return &file_omni_specs_omni_proto_enumTypes[25]
+	return &file_omni_specs_omni_proto_enumTypes[29]
 }
 
 func (x SecretRotationSpec_Component) Number() protoreflect.EnumNumber {
@@ -1380,7 +1615,7 @@ func (x SecretRotationSpec_Component) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SecretRotationSpec_Component.Descriptor instead.
 func (SecretRotationSpec_Component) EnumDescriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{98, 2}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{104, 2}
 }
 
 // Type describes the severity of a notification.
@@ -1417,11 +1652,11 @@ func (x NotificationSpec_Type) String() string {
 }
 
 func (NotificationSpec_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_omni_specs_omni_proto_enumTypes[26].Descriptor()
+	return file_omni_specs_omni_proto_enumTypes[30].Descriptor()
 }
 
 func (NotificationSpec_Type) Type() protoreflect.EnumType {
-	return &file_omni_specs_omni_proto_enumTypes[26]
+	return &file_omni_specs_omni_proto_enumTypes[30]
 }
 
 func (x NotificationSpec_Type) Number() protoreflect.EnumNumber {
@@ -1430,7 +1665,62 @@ func (x NotificationSpec_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NotificationSpec_Type.Descriptor instead.
 func (NotificationSpec_Type) EnumDescriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{103, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{113, 0}
+}
+
+type NotificationChannelSpec_Type int32
+
+const (
+	NotificationChannelSpec_UNKNOWN NotificationChannelSpec_Type = 0
+	NotificationChannelSpec_SLACK   NotificationChannelSpec_Type = 1
+	NotificationChannelSpec_TEAMS   NotificationChannelSpec_Type = 2
+	NotificationChannelSpec_WEBHOOK NotificationChannelSpec_Type = 3
+	NotificationChannelSpec_SMTP    NotificationChannelSpec_Type = 4
+)
+
+// Enum value maps for NotificationChannelSpec_Type.
+var (
+	NotificationChannelSpec_Type_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "SLACK",
+		2: "TEAMS",
+		3: "WEBHOOK",
+		4: "SMTP",
+	}
+	NotificationChannelSpec_Type_value = map[string]int32{
+		"UNKNOWN": 0,
+		"SLACK":   1,
+		"TEAMS":   2,
+		"WEBHOOK": 3,
+		"SMTP":    4,
+	}
+)
+
+func (x NotificationChannelSpec_Type) Enum() *NotificationChannelSpec_Type {
+	p := new(NotificationChannelSpec_Type)
+	*p = x
+	return p
+}
+
+func (x NotificationChannelSpec_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationChannelSpec_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_omni_specs_omni_proto_enumTypes[31].Descriptor()
+}
+
+func (NotificationChannelSpec_Type) Type() protoreflect.EnumType {
+	return &file_omni_specs_omni_proto_enumTypes[31]
+}
+
+func (x NotificationChannelSpec_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationChannelSpec_Type.Descriptor instead.
+func (NotificationChannelSpec_Type) EnumDescriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{114, 0}
 }
 
 type KubernetesManifestGroupSpec_Mode int32
@@ -1466,11 +1756,11 @@ func (x KubernetesManifestGroupSpec_Mode) String() string {
 }
 
 func (KubernetesManifestGroupSpec_Mode) Descriptor() protoreflect.EnumDescriptor {
-	return file_omni_specs_omni_proto_enumTypes[27].Descriptor()
+	return file_omni_specs_omni_proto_enumTypes[32].Descriptor()
 }
 
 func (KubernetesManifestGroupSpec_Mode) Type() protoreflect.EnumType {
-	return &file_omni_specs_omni_proto_enumTypes[27]
+	return &file_omni_specs_omni_proto_enumTypes[32]
 }
 
 func (x KubernetesManifestGroupSpec_Mode) Number() protoreflect.EnumNumber {
@@ -1479,7 +1769,7 @@ func (x KubernetesManifestGroupSpec_Mode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use KubernetesManifestGroupSpec_Mode.Descriptor instead.
 func (KubernetesManifestGroupSpec_Mode) EnumDescriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{104, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{117, 0}
 }
 
 type ClusterKubernetesManifestsStatusSpec_ManifestStatus_Phase int32
@@ -1518,11 +1808,11 @@ func (x ClusterKubernetesManifestsStatusSpec_ManifestStatus_Phase) String() stri
 }
 
 func (ClusterKubernetesManifestsStatusSpec_ManifestStatus_Phase) Descriptor() protoreflect.EnumDescriptor {
-	return file_omni_specs_omni_proto_enumTypes[28].Descriptor()
+	return file_omni_specs_omni_proto_enumTypes[33].Descriptor()
 }
 
 func (ClusterKubernetesManifestsStatusSpec_ManifestStatus_Phase) Type() protoreflect.EnumType {
-	return &file_omni_specs_omni_proto_enumTypes[28]
+	return &file_omni_specs_omni_proto_enumTypes[33]
 }
 
 func (x ClusterKubernetesManifestsStatusSpec_ManifestStatus_Phase) Number() protoreflect.EnumNumber {
@@ -1531,7 +1821,7 @@ func (x ClusterKubernetesManifestsStatusSpec_ManifestStatus_Phase) Number() prot
 
 // Deprecated: Use ClusterKubernetesManifestsStatusSpec_ManifestStatus_Phase.Descriptor instead.
 func (ClusterKubernetesManifestsStatusSpec_ManifestStatus_Phase) EnumDescriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{105, 0, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{118, 0, 0}
 }
 
 type ClusterKubernetesManifestsStatusSpec_GroupStatus_Phase int32
@@ -1573,11 +1863,11 @@ func (x ClusterKubernetesManifestsStatusSpec_GroupStatus_Phase) String() string
 }
 
 func (ClusterKubernetesManifestsStatusSpec_GroupStatus_Phase) Descriptor() protoreflect.EnumDescriptor {
-	return file_omni_specs_omni_proto_enumTypes[29].Descriptor()
+	return file_omni_specs_omni_proto_enumTypes[34].Descriptor()
 }
 
 func (ClusterKubernetesManifestsStatusSpec_GroupStatus_Phase) Type() protoreflect.EnumType {
-	return &file_omni_specs_omni_proto_enumTypes[29]
+	return &file_omni_specs_omni_proto_enumTypes[34]
 }
 
 func (x ClusterKubernetesManifestsStatusSpec_GroupStatus_Phase) Number() protoreflect.EnumNumber {
@@ -1586,7 +1876,7 @@ func (x ClusterKubernetesManifestsStatusSpec_GroupStatus_Phase) Number() protore
 
 // Deprecated: Use ClusterKubernetesManifestsStatusSpec_GroupStatus_Phase.Descriptor instead.
 func (ClusterKubernetesManifestsStatusSpec_GroupStatus_Phase) EnumDescriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{105, 1, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{118, 1, 0}
 }
 
 type KubernetesHealthCheckStatusSpec_State int32
@@ -1628,11 +1918,11 @@ func (x KubernetesHealthCheckStatusSpec_State) String() string {
 }
 
 func (KubernetesHealthCheckStatusSpec_State) Descriptor() protoreflect.EnumDescriptor {
-	return file_omni_specs_omni_proto_enumTypes[30].Descriptor()
+	return file_omni_specs_omni_proto_enumTypes[35].Descriptor()
 }
 
 func (KubernetesHealthCheckStatusSpec_State) Type() protoreflect.EnumType {
-	return &file_omni_specs_omni_proto_enumTypes[30]
+	return &file_omni_specs_omni_proto_enumTypes[35]
 }
 
 func (x KubernetesHealthCheckStatusSpec_State) Number() protoreflect.EnumNumber {
@@ -1641,34 +1931,141 @@ func (x KubernetesHealthCheckStatusSpec_State) Number() protoreflect.EnumNumber
 
 // Deprecated: Use KubernetesHealthCheckStatusSpec_State.Descriptor instead.
 func (KubernetesHealthCheckStatusSpec_State) EnumDescriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{107, 0}
-}
-
-// MachineSpec describes a Machine.
-type MachineSpec struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// IP which can be used to access Talos API.
-	ManagementAddress string `protobuf:"bytes,1,opt,name=management_address,json=managementAddress,proto3" json:"management_address,omitempty"`
-	// Connected is copied from the corresponding Link resource.
-	Connected bool `protobuf:"varint,2,opt,name=connected,proto3" json:"connected,omitempty"`
-	// UseGrpcTunnel enables using gRPC tunnel for the machine.
-	UseGrpcTunnel bool `protobuf:"varint,5,opt,name=use_grpc_tunnel,json=useGrpcTunnel,proto3" json:"use_grpc_tunnel,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{120, 0}
 }
 
-func (x *MachineSpec) Reset() {
-	*x = MachineSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
+type ClusterTemplateStatusSpec_Phase int32
 
-func (x *MachineSpec) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+const (
+	ClusterTemplateStatusSpec_UNKNOWN ClusterTemplateStatusSpec_Phase = 0
+	ClusterTemplateStatusSpec_SYNCING ClusterTemplateStatusSpec_Phase = 1
+	ClusterTemplateStatusSpec_SYNCED  ClusterTemplateStatusSpec_Phase = 2
+	ClusterTemplateStatusSpec_FAILED  ClusterTemplateStatusSpec_Phase = 3
+)
 
-func (*MachineSpec) ProtoMessage() {}
+// Enum value maps for ClusterTemplateStatusSpec_Phase.
+var (
+	ClusterTemplateStatusSpec_Phase_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "SYNCING",
+		2: "SYNCED",
+		3: "FAILED",
+	}
+	ClusterTemplateStatusSpec_Phase_value = map[string]int32{
+		"UNKNOWN": 0,
+		"SYNCING": 1,
+		"SYNCED":  2,
+		"FAILED":  3,
+	}
+)
+
+func (x ClusterTemplateStatusSpec_Phase) Enum() *ClusterTemplateStatusSpec_Phase {
+	p := new(ClusterTemplateStatusSpec_Phase)
+	*p = x
+	return p
+}
+
+func (x ClusterTemplateStatusSpec_Phase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClusterTemplateStatusSpec_Phase) Descriptor() protoreflect.EnumDescriptor {
+	return file_omni_specs_omni_proto_enumTypes[36].Descriptor()
+}
+
+func (ClusterTemplateStatusSpec_Phase) Type() protoreflect.EnumType {
+	return &file_omni_specs_omni_proto_enumTypes[36]
+}
+
+func (x ClusterTemplateStatusSpec_Phase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClusterTemplateStatusSpec_Phase.Descriptor instead.
+func (ClusterTemplateStatusSpec_Phase) EnumDescriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{126, 0}
+}
+
+type GitRepositoryStatusSpec_Phase int32
+
+const (
+	GitRepositoryStatusSpec_UNKNOWN   GitRepositoryStatusSpec_Phase = 0
+	GitRepositoryStatusSpec_SYNCING   GitRepositoryStatusSpec_Phase = 1
+	GitRepositoryStatusSpec_SYNCED    GitRepositoryStatusSpec_Phase = 2
+	GitRepositoryStatusSpec_FAILED    GitRepositoryStatusSpec_Phase = 3
+	GitRepositoryStatusSpec_SUSPENDED GitRepositoryStatusSpec_Phase = 4
+)
+
+// Enum value maps for GitRepositoryStatusSpec_Phase.
+var (
+	GitRepositoryStatusSpec_Phase_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "SYNCING",
+		2: "SYNCED",
+		3: "FAILED",
+		4: "SUSPENDED",
+	}
+	GitRepositoryStatusSpec_Phase_value = map[string]int32{
+		"UNKNOWN":   0,
+		"SYNCING":   1,
+		"SYNCED":    2,
+		"FAILED":    3,
+		"SUSPENDED": 4,
+	}
+)
+
+func (x GitRepositoryStatusSpec_Phase) Enum() *GitRepositoryStatusSpec_Phase {
+	p := new(GitRepositoryStatusSpec_Phase)
+	*p = x
+	return p
+}
+
+func (x GitRepositoryStatusSpec_Phase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GitRepositoryStatusSpec_Phase) Descriptor() protoreflect.EnumDescriptor {
+	return file_omni_specs_omni_proto_enumTypes[37].Descriptor()
+}
+
+func (GitRepositoryStatusSpec_Phase) Type() protoreflect.EnumType {
+	return &file_omni_specs_omni_proto_enumTypes[37]
+}
+
+func (x GitRepositoryStatusSpec_Phase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GitRepositoryStatusSpec_Phase.Descriptor instead.
+func (GitRepositoryStatusSpec_Phase) EnumDescriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{129, 0}
+}
+
+// MachineSpec describes a Machine.
+type MachineSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// IP which can be used to access Talos API.
+	ManagementAddress string `protobuf:"bytes,1,opt,name=management_address,json=managementAddress,proto3" json:"management_address,omitempty"`
+	// Connected is copied from the corresponding Link resource.
+	Connected bool `protobuf:"varint,2,opt,name=connected,proto3" json:"connected,omitempty"`
+	// UseGrpcTunnel enables using gRPC tunnel for the machine.
+	UseGrpcTunnel bool `protobuf:"varint,5,opt,name=use_grpc_tunnel,json=useGrpcTunnel,proto3" json:"use_grpc_tunnel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MachineSpec) Reset() {
+	*x = MachineSpec{}
+	mi := &file_omni_specs_omni_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MachineSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MachineSpec) ProtoMessage() {}
 
 func (x *MachineSpec) ProtoReflect() protoreflect.Message {
 	mi := &file_omni_specs_omni_proto_msgTypes[0]
@@ -2144,8 +2541,15 @@ type ClusterSpec struct {
 	Features *ClusterSpec_Features `protobuf:"bytes,4,opt,name=features,proto3" json:"features,omitempty"`
 	// Backup describes the backup configuration. If it set to null that means that backups are disabled for this cluster.
 	BackupConfiguration *EtcdBackupConf `protobuf:"bytes,5,opt,name=backup_configuration,json=backupConfiguration,proto3" json:"backup_configuration,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	// MaintenanceWindow is the ID of the MaintenanceWindow resource which limits when the disruptive operations
+	// (Talos and Kubernetes upgrades, reboot-requiring config changes) are started in the cluster.
+	// When empty, the operations are started as soon as they are pending.
+	MaintenanceWindow string `protobuf:"bytes,6,opt,name=maintenance_window,json=maintenanceWindow,proto3" json:"maintenance_window,omitempty"`
+	// SecretRotationPolicy enables the automatic rotation of the cluster secrets.
+	// When empty, the secrets are only rotated on request.
+	SecretRotationPolicy *SecretRotationPolicy `protobuf:"bytes,7,opt,name=secret_rotation_policy,json=secretRotationPolicy,proto3" json:"secret_rotation_policy,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *ClusterSpec) Reset() {
@@ -2206,27 +2610,49 @@ func (x *ClusterSpec) GetBackupConfiguration() *EtcdBackupConf {
 	return nil
 }
 
-// ClusterTaintSpec describe a Talos cluster taint.
-type ClusterTaintSpec struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *ClusterSpec) GetMaintenanceWindow() string {
+	if x != nil {
+		return x.MaintenanceWindow
+	}
+	return ""
+}
+
+func (x *ClusterSpec) GetSecretRotationPolicy() *SecretRotationPolicy {
+	if x != nil {
+		return x.SecretRotationPolicy
+	}
+	return nil
+}
+
+// SecretRotationPolicy describes when the cluster secrets are rotated automatically.
+//
+// A secret is rotated once it is older than the interval, or once it expires in less than the expiry threshold.
+type SecretRotationPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Interval is the maximum age of the secrets, measured from their last rotation or creation.
+	Interval *durationpb.Duration `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	// ExpiryThreshold is how long before the expiration the certificate authorities are rotated.
+	ExpiryThreshold *durationpb.Duration `protobuf:"bytes,2,opt,name=expiry_threshold,json=expiryThreshold,proto3" json:"expiry_threshold,omitempty"`
+	// Components are the secrets rotated by the policy.
+	Components    []SecretRotationSpec_Component `protobuf:"varint,3,rep,packed,name=components,proto3,enum=specs.SecretRotationSpec_Component" json:"components,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ClusterTaintSpec) Reset() {
-	*x = ClusterTaintSpec{}
+func (x *SecretRotationPolicy) Reset() {
+	*x = SecretRotationPolicy{}
 	mi := &file_omni_specs_omni_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClusterTaintSpec) String() string {
+func (x *SecretRotationPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClusterTaintSpec) ProtoMessage() {}
+func (*SecretRotationPolicy) ProtoMessage() {}
 
-func (x *ClusterTaintSpec) ProtoReflect() protoreflect.Message {
+func (x *SecretRotationPolicy) ProtoReflect() protoreflect.Message {
 	mi := &file_omni_specs_omni_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2238,36 +2664,59 @@ func (x *ClusterTaintSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClusterTaintSpec.ProtoReflect.Descriptor instead.
-func (*ClusterTaintSpec) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecretRotationPolicy.ProtoReflect.Descriptor instead.
+func (*SecretRotationPolicy) Descriptor() ([]byte, []int) {
 	return file_omni_specs_omni_proto_rawDescGZIP(), []int{7}
 }
 
-// EtcdBackupConf describes the backup configuration.
-type EtcdBackupConf struct {
+func (x *SecretRotationPolicy) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *SecretRotationPolicy) GetExpiryThreshold() *durationpb.Duration {
+	if x != nil {
+		return x.ExpiryThreshold
+	}
+	return nil
+}
+
+func (x *SecretRotationPolicy) GetComponents() []SecretRotationSpec_Component {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+// MaintenanceWindowSpec describes a recurring window in which the disruptive operations are allowed.
+type MaintenanceWindowSpec struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Interval is the interval between backups. If not set, backups are disabled.
-	Interval *durationpb.Duration `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`
-	// Enabled etcd backups.
-	Enabled       bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Schedule is a cron expression (minute, hour, day of month, month, day of week) which defines when the window opens.
+	Schedule string `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// Duration is how long the window stays open.
+	Duration *durationpb.Duration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	// Timezone is the IANA name of the time zone the schedule is evaluated in. Defaults to UTC.
+	Timezone      string `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EtcdBackupConf) Reset() {
-	*x = EtcdBackupConf{}
+func (x *MaintenanceWindowSpec) Reset() {
+	*x = MaintenanceWindowSpec{}
 	mi := &file_omni_specs_omni_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EtcdBackupConf) String() string {
+func (x *MaintenanceWindowSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EtcdBackupConf) ProtoMessage() {}
+func (*MaintenanceWindowSpec) ProtoMessage() {}
 
-func (x *EtcdBackupConf) ProtoReflect() protoreflect.Message {
+func (x *MaintenanceWindowSpec) ProtoReflect() protoreflect.Message {
 	mi := &file_omni_specs_omni_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2279,47 +2728,53 @@ func (x *EtcdBackupConf) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EtcdBackupConf.ProtoReflect.Descriptor instead.
-func (*EtcdBackupConf) Descriptor() ([]byte, []int) {
+// Deprecated: Use MaintenanceWindowSpec.ProtoReflect.Descriptor instead.
+func (*MaintenanceWindowSpec) Descriptor() ([]byte, []int) {
 	return file_omni_specs_omni_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *EtcdBackupConf) GetInterval() *durationpb.Duration {
+func (x *MaintenanceWindowSpec) GetSchedule() string {
 	if x != nil {
-		return x.Interval
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *MaintenanceWindowSpec) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
 	}
 	return nil
 }
 
-func (x *EtcdBackupConf) GetEnabled() bool {
+func (x *MaintenanceWindowSpec) GetTimezone() string {
 	if x != nil {
-		return x.Enabled
+		return x.Timezone
 	}
-	return false
+	return ""
 }
 
-// EtcdBackupEncryptionSpec describes the backup encryption.
-type EtcdBackupEncryptionSpec struct {
+// ClusterTaintSpec describe a Talos cluster taint.
+type ClusterTaintSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EncryptionKey []byte                 `protobuf:"bytes,1,opt,name=encryption_key,json=encryptionKey,proto3" json:"encryption_key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EtcdBackupEncryptionSpec) Reset() {
-	*x = EtcdBackupEncryptionSpec{}
+func (x *ClusterTaintSpec) Reset() {
+	*x = ClusterTaintSpec{}
 	mi := &file_omni_specs_omni_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EtcdBackupEncryptionSpec) String() string {
+func (x *ClusterTaintSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EtcdBackupEncryptionSpec) ProtoMessage() {}
+func (*ClusterTaintSpec) ProtoMessage() {}
 
-func (x *EtcdBackupEncryptionSpec) ProtoReflect() protoreflect.Message {
+func (x *ClusterTaintSpec) ProtoReflect() protoreflect.Message {
 	mi := &file_omni_specs_omni_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2331,41 +2786,38 @@ func (x *EtcdBackupEncryptionSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EtcdBackupEncryptionSpec.ProtoReflect.Descriptor instead.
-func (*EtcdBackupEncryptionSpec) Descriptor() ([]byte, []int) {
+// Deprecated: Use ClusterTaintSpec.ProtoReflect.Descriptor instead.
+func (*ClusterTaintSpec) Descriptor() ([]byte, []int) {
 	return file_omni_specs_omni_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *EtcdBackupEncryptionSpec) GetEncryptionKey() []byte {
-	if x != nil {
-		return x.EncryptionKey
-	}
-	return nil
-}
-
-// EtcdBackupHeader describes the backup header.
-type EtcdBackupHeader struct {
+// EtcdBackupConf describes the backup configuration.
+type EtcdBackupConf struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Version is the version of the backup creator.
-	Version       int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Interval is the interval between backups. If not set, backups are disabled.
+	Interval *durationpb.Duration `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Enabled etcd backups.
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Retention configures which backups are kept, all backups are kept if not set.
+	Retention     *EtcdBackupRetention `protobuf:"bytes,3,opt,name=retention,proto3" json:"retention,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EtcdBackupHeader) Reset() {
-	*x = EtcdBackupHeader{}
+func (x *EtcdBackupConf) Reset() {
+	*x = EtcdBackupConf{}
 	mi := &file_omni_specs_omni_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EtcdBackupHeader) String() string {
+func (x *EtcdBackupConf) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EtcdBackupHeader) ProtoMessage() {}
+func (*EtcdBackupConf) ProtoMessage() {}
 
-func (x *EtcdBackupHeader) ProtoReflect() protoreflect.Message {
+func (x *EtcdBackupConf) ProtoReflect() protoreflect.Message {
 	mi := &file_omni_specs_omni_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2377,45 +2829,64 @@ func (x *EtcdBackupHeader) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EtcdBackupHeader.ProtoReflect.Descriptor instead.
-func (*EtcdBackupHeader) Descriptor() ([]byte, []int) {
+// Deprecated: Use EtcdBackupConf.ProtoReflect.Descriptor instead.
+func (*EtcdBackupConf) Descriptor() ([]byte, []int) {
 	return file_omni_specs_omni_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *EtcdBackupHeader) GetVersion() int64 {
+func (x *EtcdBackupConf) GetInterval() *durationpb.Duration {
 	if x != nil {
-		return x.Version
+		return x.Interval
 	}
-	return 0
+	return nil
 }
 
-// EtcdBackupSpec describes the backup.
-type EtcdBackupSpec struct {
+func (x *EtcdBackupConf) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *EtcdBackupConf) GetRetention() *EtcdBackupRetention {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+// EtcdBackupRetention describes the grandfather-father-son rotation of the etcd backups.
+//
+// A backup is kept if it matches any of the rules, the backups matching none of them are deleted.
+// All backups are kept if every rule is zero.
+type EtcdBackupRetention struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// CreatedAt is the time when the backup was created.
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	// Snapshot is the snapshot file name.
-	Snapshot string `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
-	// Size specifies the etcd backup size.
-	Size          uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// KeepLast is the number of the most recent backups to keep.
+	KeepLast uint32 `protobuf:"varint,1,opt,name=keep_last,json=keepLast,proto3" json:"keep_last,omitempty"`
+	// KeepDaily is the number of days to keep the latest backup of each day for.
+	KeepDaily uint32 `protobuf:"varint,2,opt,name=keep_daily,json=keepDaily,proto3" json:"keep_daily,omitempty"`
+	// KeepWeekly is the number of weeks to keep the latest backup of each week for.
+	KeepWeekly uint32 `protobuf:"varint,3,opt,name=keep_weekly,json=keepWeekly,proto3" json:"keep_weekly,omitempty"`
+	// KeepMonthly is the number of months to keep the latest backup of each month for.
+	KeepMonthly   uint32 `protobuf:"varint,4,opt,name=keep_monthly,json=keepMonthly,proto3" json:"keep_monthly,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EtcdBackupSpec) Reset() {
-	*x = EtcdBackupSpec{}
+func (x *EtcdBackupRetention) Reset() {
+	*x = EtcdBackupRetention{}
 	mi := &file_omni_specs_omni_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EtcdBackupSpec) String() string {
+func (x *EtcdBackupRetention) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EtcdBackupSpec) ProtoMessage() {}
+func (*EtcdBackupRetention) ProtoMessage() {}
 
-func (x *EtcdBackupSpec) ProtoReflect() protoreflect.Message {
+func (x *EtcdBackupRetention) ProtoReflect() protoreflect.Message {
 	mi := &file_omni_specs_omni_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2427,49 +2898,212 @@ func (x *EtcdBackupSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EtcdBackupSpec.ProtoReflect.Descriptor instead.
-func (*EtcdBackupSpec) Descriptor() ([]byte, []int) {
+// Deprecated: Use EtcdBackupRetention.ProtoReflect.Descriptor instead.
+func (*EtcdBackupRetention) Descriptor() ([]byte, []int) {
 	return file_omni_specs_omni_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *EtcdBackupSpec) GetCreatedAt() *timestamppb.Timestamp {
+func (x *EtcdBackupRetention) GetKeepLast() uint32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.KeepLast
 	}
-	return nil
+	return 0
 }
 
-func (x *EtcdBackupSpec) GetSnapshot() string {
+func (x *EtcdBackupRetention) GetKeepDaily() uint32 {
 	if x != nil {
-		return x.Snapshot
+		return x.KeepDaily
 	}
-	return ""
+	return 0
 }
 
-func (x *EtcdBackupSpec) GetSize() uint64 {
+func (x *EtcdBackupRetention) GetKeepWeekly() uint32 {
 	if x != nil {
-		return x.Size
+		return x.KeepWeekly
 	}
 	return 0
 }
 
-// BackupDataSpec describes the data needed for etcd backup.
-type BackupDataSpec struct {
-	state                     protoimpl.MessageState `protogen:"open.v1"`
-	Interval                  *durationpb.Duration   `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`
-	ClusterUuid               string                 `protobuf:"bytes,2,opt,name=cluster_uuid,json=clusterUuid,proto3" json:"cluster_uuid,omitempty"`
-	EncryptionKey             []byte                 `protobuf:"bytes,3,opt,name=encryption_key,json=encryptionKey,proto3" json:"encryption_key,omitempty"`
-	AesCbcEncryptionSecret    string                 `protobuf:"bytes,4,opt,name=aes_cbc_encryption_secret,json=aesCbcEncryptionSecret,proto3" json:"aes_cbc_encryption_secret,omitempty"`
-	SecretboxEncryptionSecret string                 `protobuf:"bytes,5,opt,name=secretbox_encryption_secret,json=secretboxEncryptionSecret,proto3" json:"secretbox_encryption_secret,omitempty"`
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
-}
-
-func (x *BackupDataSpec) Reset() {
-	*x = BackupDataSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[12]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *EtcdBackupRetention) GetKeepMonthly() uint32 {
+	if x != nil {
+		return x.KeepMonthly
+	}
+	return 0
+}
+
+// EtcdBackupEncryptionSpec describes the backup encryption.
+type EtcdBackupEncryptionSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EncryptionKey []byte                 `protobuf:"bytes,1,opt,name=encryption_key,json=encryptionKey,proto3" json:"encryption_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EtcdBackupEncryptionSpec) Reset() {
+	*x = EtcdBackupEncryptionSpec{}
+	mi := &file_omni_specs_omni_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EtcdBackupEncryptionSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EtcdBackupEncryptionSpec) ProtoMessage() {}
+
+func (x *EtcdBackupEncryptionSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EtcdBackupEncryptionSpec.ProtoReflect.Descriptor instead.
+func (*EtcdBackupEncryptionSpec) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EtcdBackupEncryptionSpec) GetEncryptionKey() []byte {
+	if x != nil {
+		return x.EncryptionKey
+	}
+	return nil
+}
+
+// EtcdBackupHeader describes the backup header.
+type EtcdBackupHeader struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Version is the version of the backup creator.
+	Version       int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EtcdBackupHeader) Reset() {
+	*x = EtcdBackupHeader{}
+	mi := &file_omni_specs_omni_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EtcdBackupHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EtcdBackupHeader) ProtoMessage() {}
+
+func (x *EtcdBackupHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EtcdBackupHeader.ProtoReflect.Descriptor instead.
+func (*EtcdBackupHeader) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *EtcdBackupHeader) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// EtcdBackupSpec describes the backup.
+type EtcdBackupSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CreatedAt is the time when the backup was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Snapshot is the snapshot file name.
+	Snapshot string `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// Size specifies the etcd backup size.
+	Size          uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EtcdBackupSpec) Reset() {
+	*x = EtcdBackupSpec{}
+	mi := &file_omni_specs_omni_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EtcdBackupSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EtcdBackupSpec) ProtoMessage() {}
+
+func (x *EtcdBackupSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EtcdBackupSpec.ProtoReflect.Descriptor instead.
+func (*EtcdBackupSpec) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EtcdBackupSpec) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *EtcdBackupSpec) GetSnapshot() string {
+	if x != nil {
+		return x.Snapshot
+	}
+	return ""
+}
+
+func (x *EtcdBackupSpec) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// BackupDataSpec describes the data needed for etcd backup.
+type BackupDataSpec struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	Interval                  *durationpb.Duration   `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	ClusterUuid               string                 `protobuf:"bytes,2,opt,name=cluster_uuid,json=clusterUuid,proto3" json:"cluster_uuid,omitempty"`
+	EncryptionKey             []byte                 `protobuf:"bytes,3,opt,name=encryption_key,json=encryptionKey,proto3" json:"encryption_key,omitempty"`
+	AesCbcEncryptionSecret    string                 `protobuf:"bytes,4,opt,name=aes_cbc_encryption_secret,json=aesCbcEncryptionSecret,proto3" json:"aes_cbc_encryption_secret,omitempty"`
+	SecretboxEncryptionSecret string                 `protobuf:"bytes,5,opt,name=secretbox_encryption_secret,json=secretboxEncryptionSecret,proto3" json:"secretbox_encryption_secret,omitempty"`
+	Retention                 *EtcdBackupRetention   `protobuf:"bytes,6,opt,name=retention,proto3" json:"retention,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *BackupDataSpec) Reset() {
+	*x = BackupDataSpec{}
+	mi := &file_omni_specs_omni_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *BackupDataSpec) String() string {
@@ -2479,7 +3113,7 @@ func (x *BackupDataSpec) String() string {
 func (*BackupDataSpec) ProtoMessage() {}
 
 func (x *BackupDataSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[12]
+	mi := &file_omni_specs_omni_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2492,7 +3126,7 @@ func (x *BackupDataSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BackupDataSpec.ProtoReflect.Descriptor instead.
 func (*BackupDataSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{12}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *BackupDataSpec) GetInterval() *durationpb.Duration {
@@ -2530,6 +3164,13 @@ func (x *BackupDataSpec) GetSecretboxEncryptionSecret() string {
 	return ""
 }
 
+func (x *BackupDataSpec) GetRetention() *EtcdBackupRetention {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
 // EtcdBackupS3ConfSpec describes the S3 configuration for the backup process.
 type EtcdBackupS3ConfSpec struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
@@ -2545,7 +3186,7 @@ type EtcdBackupS3ConfSpec struct {
 
 func (x *EtcdBackupS3ConfSpec) Reset() {
 	*x = EtcdBackupS3ConfSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[13]
+	mi := &file_omni_specs_omni_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2557,7 +3198,7 @@ func (x *EtcdBackupS3ConfSpec) String() string {
 func (*EtcdBackupS3ConfSpec) ProtoMessage() {}
 
 func (x *EtcdBackupS3ConfSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[13]
+	mi := &file_omni_specs_omni_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2570,7 +3211,7 @@ func (x *EtcdBackupS3ConfSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdBackupS3ConfSpec.ProtoReflect.Descriptor instead.
 func (*EtcdBackupS3ConfSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{13}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *EtcdBackupS3ConfSpec) GetBucket() string {
@@ -2615,6 +3256,75 @@ func (x *EtcdBackupS3ConfSpec) GetSessionToken() string {
 	return ""
 }
 
+// EtcdBackupStoreConfigSpec selects the remote store for the etcd backups and configures it.
+type EtcdBackupStoreConfigSpec struct {
+	state         protoimpl.MessageState                     `protogen:"open.v1"`
+	Backend       EtcdBackupStoreConfigSpec_Backend          `protobuf:"varint,1,opt,name=backend,proto3,enum=specs.EtcdBackupStoreConfigSpec_Backend" json:"backend,omitempty"`
+	Gcs           *EtcdBackupStoreConfigSpec_GCSConfig       `protobuf:"bytes,2,opt,name=gcs,proto3" json:"gcs,omitempty"`
+	AzureBlob     *EtcdBackupStoreConfigSpec_AzureBlobConfig `protobuf:"bytes,3,opt,name=azure_blob,json=azureBlob,proto3" json:"azure_blob,omitempty"`
+	Sftp          *EtcdBackupStoreConfigSpec_SFTPConfig      `protobuf:"bytes,4,opt,name=sftp,proto3" json:"sftp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EtcdBackupStoreConfigSpec) Reset() {
+	*x = EtcdBackupStoreConfigSpec{}
+	mi := &file_omni_specs_omni_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EtcdBackupStoreConfigSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EtcdBackupStoreConfigSpec) ProtoMessage() {}
+
+func (x *EtcdBackupStoreConfigSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EtcdBackupStoreConfigSpec.ProtoReflect.Descriptor instead.
+func (*EtcdBackupStoreConfigSpec) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *EtcdBackupStoreConfigSpec) GetBackend() EtcdBackupStoreConfigSpec_Backend {
+	if x != nil {
+		return x.Backend
+	}
+	return EtcdBackupStoreConfigSpec_S3
+}
+
+func (x *EtcdBackupStoreConfigSpec) GetGcs() *EtcdBackupStoreConfigSpec_GCSConfig {
+	if x != nil {
+		return x.Gcs
+	}
+	return nil
+}
+
+func (x *EtcdBackupStoreConfigSpec) GetAzureBlob() *EtcdBackupStoreConfigSpec_AzureBlobConfig {
+	if x != nil {
+		return x.AzureBlob
+	}
+	return nil
+}
+
+func (x *EtcdBackupStoreConfigSpec) GetSftp() *EtcdBackupStoreConfigSpec_SFTPConfig {
+	if x != nil {
+		return x.Sftp
+	}
+	return nil
+}
+
 // EtcdBackupStatus describes cluster last backup status.
 type EtcdBackupStatusSpec struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -2626,13 +3336,17 @@ type EtcdBackupStatusSpec struct {
 	LastBackupTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_backup_time,json=lastBackupTime,proto3" json:"last_backup_time,omitempty"`
 	// LastBackupAttempt is the time of the last backup attempt.
 	LastBackupAttempt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_backup_attempt,json=lastBackupAttempt,proto3" json:"last_backup_attempt,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// LastPrunedSnapshots are the snapshots deleted by the retention policy after the last backup.
+	LastPrunedSnapshots []string `protobuf:"bytes,5,rep,name=last_pruned_snapshots,json=lastPrunedSnapshots,proto3" json:"last_pruned_snapshots,omitempty"`
+	// PruneError is the error message if the retention policy enforcement failed.
+	PruneError    string `protobuf:"bytes,6,opt,name=prune_error,json=pruneError,proto3" json:"prune_error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EtcdBackupStatusSpec) Reset() {
 	*x = EtcdBackupStatusSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[14]
+	mi := &file_omni_specs_omni_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2644,7 +3358,7 @@ func (x *EtcdBackupStatusSpec) String() string {
 func (*EtcdBackupStatusSpec) ProtoMessage() {}
 
 func (x *EtcdBackupStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[14]
+	mi := &file_omni_specs_omni_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2657,7 +3371,7 @@ func (x *EtcdBackupStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdBackupStatusSpec.ProtoReflect.Descriptor instead.
 func (*EtcdBackupStatusSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{14}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *EtcdBackupStatusSpec) GetStatus() EtcdBackupStatusSpec_Status {
@@ -2688,6 +3402,20 @@ func (x *EtcdBackupStatusSpec) GetLastBackupAttempt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *EtcdBackupStatusSpec) GetLastPrunedSnapshots() []string {
+	if x != nil {
+		return x.LastPrunedSnapshots
+	}
+	return nil
+}
+
+func (x *EtcdBackupStatusSpec) GetPruneError() string {
+	if x != nil {
+		return x.PruneError
+	}
+	return ""
+}
+
 // EtcdManualBackupSpec describes the manual backup request.
 type EtcdManualBackupSpec struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -2699,7 +3427,7 @@ type EtcdManualBackupSpec struct {
 
 func (x *EtcdManualBackupSpec) Reset() {
 	*x = EtcdManualBackupSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[15]
+	mi := &file_omni_specs_omni_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2711,7 +3439,7 @@ func (x *EtcdManualBackupSpec) String() string {
 func (*EtcdManualBackupSpec) ProtoMessage() {}
 
 func (x *EtcdManualBackupSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[15]
+	mi := &file_omni_specs_omni_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2724,7 +3452,7 @@ func (x *EtcdManualBackupSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdManualBackupSpec.ProtoReflect.Descriptor instead.
 func (*EtcdManualBackupSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{15}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *EtcdManualBackupSpec) GetBackupAt() *timestamppb.Timestamp {
@@ -2747,7 +3475,7 @@ type EtcdBackupStoreStatusSpec struct {
 
 func (x *EtcdBackupStoreStatusSpec) Reset() {
 	*x = EtcdBackupStoreStatusSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[16]
+	mi := &file_omni_specs_omni_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2759,7 +3487,7 @@ func (x *EtcdBackupStoreStatusSpec) String() string {
 func (*EtcdBackupStoreStatusSpec) ProtoMessage() {}
 
 func (x *EtcdBackupStoreStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[16]
+	mi := &file_omni_specs_omni_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2772,7 +3500,7 @@ func (x *EtcdBackupStoreStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdBackupStoreStatusSpec.ProtoReflect.Descriptor instead.
 func (*EtcdBackupStoreStatusSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{16}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *EtcdBackupStoreStatusSpec) GetConfigurationName() string {
@@ -2804,7 +3532,7 @@ type EtcdBackupOverallStatusSpec struct {
 
 func (x *EtcdBackupOverallStatusSpec) Reset() {
 	*x = EtcdBackupOverallStatusSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[17]
+	mi := &file_omni_specs_omni_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2816,7 +3544,7 @@ func (x *EtcdBackupOverallStatusSpec) String() string {
 func (*EtcdBackupOverallStatusSpec) ProtoMessage() {}
 
 func (x *EtcdBackupOverallStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[17]
+	mi := &file_omni_specs_omni_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2829,7 +3557,7 @@ func (x *EtcdBackupOverallStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdBackupOverallStatusSpec.ProtoReflect.Descriptor instead.
 func (*EtcdBackupOverallStatusSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{17}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *EtcdBackupOverallStatusSpec) GetConfigurationName() string {
@@ -2864,7 +3592,7 @@ type ClusterMachineSpec struct {
 
 func (x *ClusterMachineSpec) Reset() {
 	*x = ClusterMachineSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[18]
+	mi := &file_omni_specs_omni_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2876,7 +3604,7 @@ func (x *ClusterMachineSpec) String() string {
 func (*ClusterMachineSpec) ProtoMessage() {}
 
 func (x *ClusterMachineSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[18]
+	mi := &file_omni_specs_omni_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2889,7 +3617,7 @@ func (x *ClusterMachineSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterMachineSpec.ProtoReflect.Descriptor instead.
 func (*ClusterMachineSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{18}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ClusterMachineSpec) GetKubernetesVersion() string {
@@ -2910,13 +3638,16 @@ type ClusterMachineConfigPatchesSpec struct {
 	//
 	// Deprecated: use accessor methods GetUncompressedData/SetUncompressedData to manage this field.
 	CompressedPatches [][]byte `protobuf:"bytes,2,rep,name=compressed_patches,json=compressedPatches,proto3" json:"compressed_patches,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Previous are the patches the machine ran before the current ones were set.
+	// They are only kept in the machine sets which use the canary update strategy, to restore the failed canaries.
+	Previous      *ClusterMachineConfigPatchesSpec `protobuf:"bytes,3,opt,name=previous,proto3" json:"previous,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ClusterMachineConfigPatchesSpec) Reset() {
 	*x = ClusterMachineConfigPatchesSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[19]
+	mi := &file_omni_specs_omni_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2928,7 +3659,7 @@ func (x *ClusterMachineConfigPatchesSpec) String() string {
 func (*ClusterMachineConfigPatchesSpec) ProtoMessage() {}
 
 func (x *ClusterMachineConfigPatchesSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[19]
+	mi := &file_omni_specs_omni_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2941,7 +3672,7 @@ func (x *ClusterMachineConfigPatchesSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterMachineConfigPatchesSpec.ProtoReflect.Descriptor instead.
 func (*ClusterMachineConfigPatchesSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{19}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ClusterMachineConfigPatchesSpec) GetPatches() []string {
@@ -2958,6 +3689,13 @@ func (x *ClusterMachineConfigPatchesSpec) GetCompressedPatches() [][]byte {
 	return nil
 }
 
+func (x *ClusterMachineConfigPatchesSpec) GetPrevious() *ClusterMachineConfigPatchesSpec {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
 // ClusterMachineTalosVersionSpec describes a machine Talos version and schematic.
 type ClusterMachineTalosVersionSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2969,7 +3707,7 @@ type ClusterMachineTalosVersionSpec struct {
 
 func (x *ClusterMachineTalosVersionSpec) Reset() {
 	*x = ClusterMachineTalosVersionSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[20]
+	mi := &file_omni_specs_omni_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2981,7 +3719,7 @@ func (x *ClusterMachineTalosVersionSpec) String() string {
 func (*ClusterMachineTalosVersionSpec) ProtoMessage() {}
 
 func (x *ClusterMachineTalosVersionSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[20]
+	mi := &file_omni_specs_omni_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2994,7 +3732,7 @@ func (x *ClusterMachineTalosVersionSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterMachineTalosVersionSpec.ProtoReflect.Descriptor instead.
 func (*ClusterMachineTalosVersionSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{20}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ClusterMachineTalosVersionSpec) GetTalosVersion() string {
@@ -3040,7 +3778,7 @@ type ClusterMachineConfigSpec struct {
 
 func (x *ClusterMachineConfigSpec) Reset() {
 	*x = ClusterMachineConfigSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[21]
+	mi := &file_omni_specs_omni_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3052,7 +3790,7 @@ func (x *ClusterMachineConfigSpec) String() string {
 func (*ClusterMachineConfigSpec) ProtoMessage() {}
 
 func (x *ClusterMachineConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[21]
+	mi := &file_omni_specs_omni_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3065,7 +3803,7 @@ func (x *ClusterMachineConfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterMachineConfigSpec.ProtoReflect.Descriptor instead.
 func (*ClusterMachineConfigSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{21}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ClusterMachineConfigSpec) GetData() []byte {
@@ -3127,7 +3865,7 @@ type RedactedClusterMachineConfigSpec struct {
 
 func (x *RedactedClusterMachineConfigSpec) Reset() {
 	*x = RedactedClusterMachineConfigSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[22]
+	mi := &file_omni_specs_omni_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3139,7 +3877,7 @@ func (x *RedactedClusterMachineConfigSpec) String() string {
 func (*RedactedClusterMachineConfigSpec) ProtoMessage() {}
 
 func (x *RedactedClusterMachineConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[22]
+	mi := &file_omni_specs_omni_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3152,7 +3890,7 @@ func (x *RedactedClusterMachineConfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RedactedClusterMachineConfigSpec.ProtoReflect.Descriptor instead.
 func (*RedactedClusterMachineConfigSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{22}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *RedactedClusterMachineConfigSpec) GetData() string {
@@ -3201,7 +3939,7 @@ type ClusterMachineIdentitySpec struct {
 
 func (x *ClusterMachineIdentitySpec) Reset() {
 	*x = ClusterMachineIdentitySpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[23]
+	mi := &file_omni_specs_omni_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3213,7 +3951,7 @@ func (x *ClusterMachineIdentitySpec) String() string {
 func (*ClusterMachineIdentitySpec) ProtoMessage() {}
 
 func (x *ClusterMachineIdentitySpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[23]
+	mi := &file_omni_specs_omni_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3226,7 +3964,7 @@ func (x *ClusterMachineIdentitySpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterMachineIdentitySpec.ProtoReflect.Descriptor instead.
 func (*ClusterMachineIdentitySpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{23}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ClusterMachineIdentitySpec) GetNodeIdentity() string {
@@ -3286,13 +4024,15 @@ type ClusterMachineStatusSpec struct {
 	IsRemoved bool `protobuf:"varint,8,opt,name=is_removed,json=isRemoved,proto3" json:"is_removed,omitempty"`
 	// ProvisionStatus is set when the cluster machine is provisioned using a machine request.
 	ProvisionStatus *ClusterMachineStatusSpec_ProvisionStatus `protobuf:"bytes,9,opt,name=provision_status,json=provisionStatus,proto3" json:"provision_status,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// PendingReason is copied from the ClusterMachineConfigStatus: it explains why the pending changes are not applied yet.
+	PendingReason string `protobuf:"bytes,10,opt,name=pending_reason,json=pendingReason,proto3" json:"pending_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ClusterMachineStatusSpec) Reset() {
 	*x = ClusterMachineStatusSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[24]
+	mi := &file_omni_specs_omni_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3304,7 +4044,7 @@ func (x *ClusterMachineStatusSpec) String() string {
 func (*ClusterMachineStatusSpec) ProtoMessage() {}
 
 func (x *ClusterMachineStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[24]
+	mi := &file_omni_specs_omni_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3317,7 +4057,7 @@ func (x *ClusterMachineStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterMachineStatusSpec.ProtoReflect.Descriptor instead.
 func (*ClusterMachineStatusSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{24}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ClusterMachineStatusSpec) GetReady() bool {
@@ -3376,6 +4116,13 @@ func (x *ClusterMachineStatusSpec) GetProvisionStatus() *ClusterMachineStatusSpe
 	return nil
 }
 
+func (x *ClusterMachineStatusSpec) GetPendingReason() string {
+	if x != nil {
+		return x.PendingReason
+	}
+	return ""
+}
+
 // Machines counts the number of machines in a set including health status.
 type Machines struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -3393,7 +4140,7 @@ type Machines struct {
 
 func (x *Machines) Reset() {
 	*x = Machines{}
-	mi := &file_omni_specs_omni_proto_msgTypes[25]
+	mi := &file_omni_specs_omni_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3405,7 +4152,7 @@ func (x *Machines) String() string {
 func (*Machines) ProtoMessage() {}
 
 func (x *Machines) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[25]
+	mi := &file_omni_specs_omni_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3418,7 +4165,7 @@ func (x *Machines) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Machines.ProtoReflect.Descriptor instead.
 func (*Machines) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{25}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *Machines) GetTotal() uint32 {
@@ -3479,7 +4226,7 @@ type ClusterStatusSpec struct {
 
 func (x *ClusterStatusSpec) Reset() {
 	*x = ClusterStatusSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[26]
+	mi := &file_omni_specs_omni_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3491,7 +4238,7 @@ func (x *ClusterStatusSpec) String() string {
 func (*ClusterStatusSpec) ProtoMessage() {}
 
 func (x *ClusterStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[26]
+	mi := &file_omni_specs_omni_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3504,7 +4251,7 @@ func (x *ClusterStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterStatusSpec.ProtoReflect.Descriptor instead.
 func (*ClusterStatusSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{26}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *ClusterStatusSpec) GetAvailable() bool {
@@ -3601,7 +4348,7 @@ type ClusterUUID struct {
 
 func (x *ClusterUUID) Reset() {
 	*x = ClusterUUID{}
-	mi := &file_omni_specs_omni_proto_msgTypes[27]
+	mi := &file_omni_specs_omni_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3613,7 +4360,7 @@ func (x *ClusterUUID) String() string {
 func (*ClusterUUID) ProtoMessage() {}
 
 func (x *ClusterUUID) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[27]
+	mi := &file_omni_specs_omni_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3626,7 +4373,7 @@ func (x *ClusterUUID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterUUID.ProtoReflect.Descriptor instead.
 func (*ClusterUUID) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{27}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *ClusterUUID) GetUuid() string {
@@ -3646,7 +4393,7 @@ type ClusterConfigVersionSpec struct {
 
 func (x *ClusterConfigVersionSpec) Reset() {
 	*x = ClusterConfigVersionSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[28]
+	mi := &file_omni_specs_omni_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3658,7 +4405,7 @@ func (x *ClusterConfigVersionSpec) String() string {
 func (*ClusterConfigVersionSpec) ProtoMessage() {}
 
 func (x *ClusterConfigVersionSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[28]
+	mi := &file_omni_specs_omni_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3671,7 +4418,7 @@ func (x *ClusterConfigVersionSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterConfigVersionSpec.ProtoReflect.Descriptor instead.
 func (*ClusterConfigVersionSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{28}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ClusterConfigVersionSpec) GetVersion() string {
@@ -3710,13 +4457,16 @@ type ClusterMachineConfigStatusSpec struct {
 	// ImageFactoryHost is the host of the image factory that served the install image for the
 	// last successful Talos install/upgrade.
 	ImageFactoryHost string `protobuf:"bytes,13,opt,name=image_factory_host,json=imageFactoryHost,proto3" json:"image_factory_host,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// PendingReason explains why the pending changes are not applied to the machine yet,
+	// e.g. a reboot-requiring config change waiting for the maintenance window to open.
+	PendingReason string `protobuf:"bytes,14,opt,name=pending_reason,json=pendingReason,proto3" json:"pending_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ClusterMachineConfigStatusSpec) Reset() {
 	*x = ClusterMachineConfigStatusSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[29]
+	mi := &file_omni_specs_omni_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3728,7 +4478,7 @@ func (x *ClusterMachineConfigStatusSpec) String() string {
 func (*ClusterMachineConfigStatusSpec) ProtoMessage() {}
 
 func (x *ClusterMachineConfigStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[29]
+	mi := &file_omni_specs_omni_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3741,7 +4491,7 @@ func (x *ClusterMachineConfigStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterMachineConfigStatusSpec.ProtoReflect.Descriptor instead.
 func (*ClusterMachineConfigStatusSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{29}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ClusterMachineConfigStatusSpec) GetClusterMachineConfigVersion() string {
@@ -3814,6 +4564,13 @@ func (x *ClusterMachineConfigStatusSpec) GetImageFactoryHost() string {
 	return ""
 }
 
+func (x *ClusterMachineConfigStatusSpec) GetPendingReason() string {
+	if x != nil {
+		return x.PendingReason
+	}
+	return ""
+}
+
 type MachinePendingUpdatesSpec struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Upgrade represents pending machine upgrade.
@@ -3832,7 +4589,7 @@ type MachinePendingUpdatesSpec struct {
 
 func (x *MachinePendingUpdatesSpec) Reset() {
 	*x = MachinePendingUpdatesSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[30]
+	mi := &file_omni_specs_omni_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3844,7 +4601,7 @@ func (x *MachinePendingUpdatesSpec) String() string {
 func (*MachinePendingUpdatesSpec) ProtoMessage() {}
 
 func (x *MachinePendingUpdatesSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[30]
+	mi := &file_omni_specs_omni_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3857,7 +4614,7 @@ func (x *MachinePendingUpdatesSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachinePendingUpdatesSpec.ProtoReflect.Descriptor instead.
 func (*MachinePendingUpdatesSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{30}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *MachinePendingUpdatesSpec) GetUpgrade() *MachinePendingUpdatesSpec_Upgrade {
@@ -3891,7 +4648,7 @@ type ClusterBootstrapStatusSpec struct {
 
 func (x *ClusterBootstrapStatusSpec) Reset() {
 	*x = ClusterBootstrapStatusSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[31]
+	mi := &file_omni_specs_omni_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3903,7 +4660,7 @@ func (x *ClusterBootstrapStatusSpec) String() string {
 func (*ClusterBootstrapStatusSpec) ProtoMessage() {}
 
 func (x *ClusterBootstrapStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[31]
+	mi := &file_omni_specs_omni_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3916,7 +4673,7 @@ func (x *ClusterBootstrapStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterBootstrapStatusSpec.ProtoReflect.Descriptor instead.
 func (*ClusterBootstrapStatusSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{31}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ClusterBootstrapStatusSpec) GetBootstrapped() bool {
@@ -3941,7 +4698,7 @@ type ClusterSecretsSpec struct {
 
 func (x *ClusterSecretsSpec) Reset() {
 	*x = ClusterSecretsSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[32]
+	mi := &file_omni_specs_omni_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3953,7 +4710,7 @@ func (x *ClusterSecretsSpec) String() string {
 func (*ClusterSecretsSpec) ProtoMessage() {}
 
 func (x *ClusterSecretsSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[32]
+	mi := &file_omni_specs_omni_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3966,7 +4723,7 @@ func (x *ClusterSecretsSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterSecretsSpec.ProtoReflect.Descriptor instead.
 func (*ClusterSecretsSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{32}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ClusterSecretsSpec) GetData() []byte {
@@ -4001,7 +4758,7 @@ type ImportedClusterSecretsSpec struct {
 
 func (x *ImportedClusterSecretsSpec) Reset() {
 	*x = ImportedClusterSecretsSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[33]
+	mi := &file_omni_specs_omni_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4013,7 +4770,7 @@ func (x *ImportedClusterSecretsSpec) String() string {
 func (*ImportedClusterSecretsSpec) ProtoMessage() {}
 
 func (x *ImportedClusterSecretsSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[33]
+	mi := &file_omni_specs_omni_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4026,7 +4783,7 @@ func (x *ImportedClusterSecretsSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportedClusterSecretsSpec.ProtoReflect.Descriptor instead.
 func (*ImportedClusterSecretsSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{33}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ImportedClusterSecretsSpec) GetData() string {
@@ -4050,7 +4807,7 @@ type LoadBalancerConfigSpec struct {
 
 func (x *LoadBalancerConfigSpec) Reset() {
 	*x = LoadBalancerConfigSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[34]
+	mi := &file_omni_specs_omni_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4062,7 +4819,7 @@ func (x *LoadBalancerConfigSpec) String() string {
 func (*LoadBalancerConfigSpec) ProtoMessage() {}
 
 func (x *LoadBalancerConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[34]
+	mi := &file_omni_specs_omni_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4075,7 +4832,7 @@ func (x *LoadBalancerConfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoadBalancerConfigSpec.ProtoReflect.Descriptor instead.
 func (*LoadBalancerConfigSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{34}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *LoadBalancerConfigSpec) GetBindPort() string {
@@ -4110,7 +4867,7 @@ type LoadBalancerStatusSpec struct {
 
 func (x *LoadBalancerStatusSpec) Reset() {
 	*x = LoadBalancerStatusSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[35]
+	mi := &file_omni_specs_omni_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4122,7 +4879,7 @@ func (x *LoadBalancerStatusSpec) String() string {
 func (*LoadBalancerStatusSpec) ProtoMessage() {}
 
 func (x *LoadBalancerStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[35]
+	mi := &file_omni_specs_omni_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4135,7 +4892,7 @@ func (x *LoadBalancerStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoadBalancerStatusSpec.ProtoReflect.Descriptor instead.
 func (*LoadBalancerStatusSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{35}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *LoadBalancerStatusSpec) GetHealthy() bool {
@@ -4162,7 +4919,7 @@ type KubernetesVersionSpec struct {
 
 func (x *KubernetesVersionSpec) Reset() {
 	*x = KubernetesVersionSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[36]
+	mi := &file_omni_specs_omni_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4174,7 +4931,7 @@ func (x *KubernetesVersionSpec) String() string {
 func (*KubernetesVersionSpec) ProtoMessage() {}
 
 func (x *KubernetesVersionSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[36]
+	mi := &file_omni_specs_omni_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4187,7 +4944,7 @@ func (x *KubernetesVersionSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KubernetesVersionSpec.ProtoReflect.Descriptor instead.
 func (*KubernetesVersionSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{36}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *KubernetesVersionSpec) GetVersion() string {
@@ -4218,7 +4975,7 @@ type TalosVersionSpec struct {
 
 func (x *TalosVersionSpec) Reset() {
 	*x = TalosVersionSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[37]
+	mi := &file_omni_specs_omni_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4230,7 +4987,7 @@ func (x *TalosVersionSpec) String() string {
 func (*TalosVersionSpec) ProtoMessage() {}
 
 func (x *TalosVersionSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[37]
+	mi := &file_omni_specs_omni_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4243,7 +5000,7 @@ func (x *TalosVersionSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TalosVersionSpec.ProtoReflect.Descriptor instead.
 func (*TalosVersionSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{37}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *TalosVersionSpec) GetVersion() string {
@@ -4321,7 +5078,7 @@ type InstallationMediaSpec struct {
 
 func (x *InstallationMediaSpec) Reset() {
 	*x = InstallationMediaSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[38]
+	mi := &file_omni_specs_omni_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4333,7 +5090,7 @@ func (x *InstallationMediaSpec) String() string {
 func (*InstallationMediaSpec) ProtoMessage() {}
 
 func (x *InstallationMediaSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[38]
+	mi := &file_omni_specs_omni_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4346,7 +5103,7 @@ func (x *InstallationMediaSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InstallationMediaSpec.ProtoReflect.Descriptor instead.
 func (*InstallationMediaSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{38}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *InstallationMediaSpec) GetName() string {
@@ -4436,7 +5193,7 @@ type ConfigPatchSpec struct {
 
 func (x *ConfigPatchSpec) Reset() {
 	*x = ConfigPatchSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[39]
+	mi := &file_omni_specs_omni_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4448,7 +5205,7 @@ func (x *ConfigPatchSpec) String() string {
 func (*ConfigPatchSpec) ProtoMessage() {}
 
 func (x *ConfigPatchSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[39]
+	mi := &file_omni_specs_omni_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4461,7 +5218,7 @@ func (x *ConfigPatchSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfigPatchSpec.ProtoReflect.Descriptor instead.
 func (*ConfigPatchSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{39}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *ConfigPatchSpec) GetData() string {
@@ -4505,13 +5262,16 @@ type MachineSetSpec struct {
 	UpgradeStrategy MachineSetSpec_UpdateStrategy `protobuf:"varint,8,opt,name=upgrade_strategy,json=upgradeStrategy,proto3,enum=specs.MachineSetSpec_UpdateStrategy" json:"upgrade_strategy,omitempty"`
 	// UpgradeStrategyConfig defines the upgrade strategy configuration.
 	UpgradeStrategyConfig *MachineSetSpec_UpdateStrategyConfig `protobuf:"bytes,9,opt,name=upgrade_strategy_config,json=upgradeStrategyConfig,proto3" json:"upgrade_strategy_config,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	// MaintenanceWindow is the ID of the MaintenanceWindow resource which applies to the machine set.
+	// It overrides the maintenance window of the cluster.
+	MaintenanceWindow string `protobuf:"bytes,10,opt,name=maintenance_window,json=maintenanceWindow,proto3" json:"maintenance_window,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *MachineSetSpec) Reset() {
 	*x = MachineSetSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[40]
+	mi := &file_omni_specs_omni_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4523,7 +5283,7 @@ func (x *MachineSetSpec) String() string {
 func (*MachineSetSpec) ProtoMessage() {}
 
 func (x *MachineSetSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[40]
+	mi := &file_omni_specs_omni_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4536,7 +5296,7 @@ func (x *MachineSetSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachineSetSpec.ProtoReflect.Descriptor instead.
 func (*MachineSetSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{40}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *MachineSetSpec) GetUpdateStrategy() MachineSetSpec_UpdateStrategy {
@@ -4603,6 +5363,13 @@ func (x *MachineSetSpec) GetUpgradeStrategyConfig() *MachineSetSpec_UpdateStrate
 	return nil
 }
 
+func (x *MachineSetSpec) GetMaintenanceWindow() string {
+	if x != nil {
+		return x.MaintenanceWindow
+	}
+	return ""
+}
+
 // TalosUpgradeStatusSpec contains the status of the Talos upgrade process.
 type TalosUpgradeStatusSpec struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -4626,7 +5393,7 @@ type TalosUpgradeStatusSpec struct {
 
 func (x *TalosUpgradeStatusSpec) Reset() {
 	*x = TalosUpgradeStatusSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[41]
+	mi := &file_omni_specs_omni_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4638,7 +5405,7 @@ func (x *TalosUpgradeStatusSpec) String() string {
 func (*TalosUpgradeStatusSpec) ProtoMessage() {}
 
 func (x *TalosUpgradeStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[41]
+	mi := &file_omni_specs_omni_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4651,7 +5418,7 @@ func (x *TalosUpgradeStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TalosUpgradeStatusSpec.ProtoReflect.Descriptor instead.
 func (*TalosUpgradeStatusSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{41}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *TalosUpgradeStatusSpec) GetPhase() TalosUpgradeStatusSpec_Phase {
@@ -4716,13 +5483,17 @@ type MachineSetStatusSpec struct {
 	MachineAllocation *MachineSetSpec_MachineAllocation `protobuf:"bytes,6,opt,name=machine_allocation,json=machineAllocation,proto3" json:"machine_allocation,omitempty"`
 	// LockedUpdates is the number of machines which have pending config update but are locked.
 	LockedUpdates uint32 `protobuf:"varint,7,opt,name=locked_updates,json=lockedUpdates,proto3" json:"locked_updates,omitempty"`
+	// UpgradeCanary is the state of the canary rollout of the Talos upgrades, if any.
+	UpgradeCanary *CanaryRolloutStatus `protobuf:"bytes,11,opt,name=upgrade_canary,json=upgradeCanary,proto3" json:"upgrade_canary,omitempty"`
+	// UpdateCanary is the state of the canary rollout of the config updates, if any.
+	UpdateCanary  *CanaryRolloutStatus `protobuf:"bytes,12,opt,name=update_canary,json=updateCanary,proto3" json:"update_canary,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MachineSetStatusSpec) Reset() {
 	*x = MachineSetStatusSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[42]
+	mi := &file_omni_specs_omni_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4734,7 +5505,7 @@ func (x *MachineSetStatusSpec) String() string {
 func (*MachineSetStatusSpec) ProtoMessage() {}
 
 func (x *MachineSetStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[42]
+	mi := &file_omni_specs_omni_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4747,7 +5518,7 @@ func (x *MachineSetStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachineSetStatusSpec.ProtoReflect.Descriptor instead.
 func (*MachineSetStatusSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{42}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *MachineSetStatusSpec) GetPhase() MachineSetPhase {
@@ -4799,39 +5570,59 @@ func (x *MachineSetStatusSpec) GetLockedUpdates() uint32 {
 	return 0
 }
 
-// MachineSetConfigStatusSpec is the settings used by the MachineConfigStatus controller:
-// - update strategy is copied from the MachineSet
-// - config updates allow flag is controller by the MachineSetStatus controller phase.
-type MachineSetConfigStatusSpec struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// ConfigUpdatesAllowed controls graceful rollout of the machine config.
-	// If false, no new config updates should happen.
-	ConfigUpdatesAllowed bool `protobuf:"varint,1,opt,name=config_updates_allowed,json=configUpdatesAllowed,proto3" json:"config_updates_allowed,omitempty"`
-	// ShouldResetGraceful controls graceful reset of the machine config.
-	ShouldResetGraceful bool `protobuf:"varint,2,opt,name=should_reset_graceful,json=shouldResetGraceful,proto3" json:"should_reset_graceful,omitempty"`
-	// UpdateStrategy is the update strategy of the machine set.
-	UpdateStrategy MachineSetSpec_UpdateStrategy `protobuf:"varint,3,opt,name=update_strategy,json=updateStrategy,proto3,enum=specs.MachineSetSpec_UpdateStrategy" json:"update_strategy,omitempty"`
-	// UpdateStrategyConfig defines the update strategy configuration.
-	UpdateStrategyConfig *MachineSetSpec_UpdateStrategyConfig `protobuf:"bytes,4,opt,name=update_strategy_config,json=updateStrategyConfig,proto3" json:"update_strategy_config,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+func (x *MachineSetStatusSpec) GetUpgradeCanary() *CanaryRolloutStatus {
+	if x != nil {
+		return x.UpgradeCanary
+	}
+	return nil
 }
 
-func (x *MachineSetConfigStatusSpec) Reset() {
-	*x = MachineSetConfigStatusSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[43]
+func (x *MachineSetStatusSpec) GetUpdateCanary() *CanaryRolloutStatus {
+	if x != nil {
+		return x.UpdateCanary
+	}
+	return nil
+}
+
+// CanaryRolloutStatus describes the state of a canary rollout in a machine set.
+type CanaryRolloutStatus struct {
+	state protoimpl.MessageState    `protogen:"open.v1"`
+	Phase CanaryRolloutStatus_Phase `protobuf:"varint,1,opt,name=phase,proto3,enum=specs.CanaryRolloutStatus_Phase" json:"phase,omitempty"`
+	// Machines are the canary machines.
+	Machines []string `protobuf:"bytes,2,rep,name=machines,proto3" json:"machines,omitempty"`
+	// Targets maps the machines participating in the rollout to the hash of their pending change.
+	// The rollout starts over when any of them changes.
+	Targets map[string]string `protobuf:"bytes,3,rep,name=targets,proto3" json:"targets,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// VerificationStarted is the time the canary machines finished updating.
+	VerificationStarted *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=verification_started,json=verificationStarted,proto3" json:"verification_started,omitempty"`
+	// UnhealthySince is the time the updated canary machines became unhealthy.
+	UnhealthySince *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=unhealthy_since,json=unhealthySince,proto3" json:"unhealthy_since,omitempty"`
+	// Reason explains why the rollout is held.
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// RollbackVersions are the versions the canary machines had before the rollout started.
+	RollbackVersions map[string]*CanaryRolloutStatus_RollbackVersion `protobuf:"bytes,7,rep,name=rollback_versions,json=rollbackVersions,proto3" json:"rollback_versions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// RollbackConfigMachines are the canary machines whose config patches are restored to the ones they had before the rollout
+	// if it fails. Once the rollout fails, only these machines are updated.
+	RollbackConfigMachines []string `protobuf:"bytes,8,rep,name=rollback_config_machines,json=rollbackConfigMachines,proto3" json:"rollback_config_machines,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CanaryRolloutStatus) Reset() {
+	*x = CanaryRolloutStatus{}
+	mi := &file_omni_specs_omni_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MachineSetConfigStatusSpec) String() string {
+func (x *CanaryRolloutStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MachineSetConfigStatusSpec) ProtoMessage() {}
+func (*CanaryRolloutStatus) ProtoMessage() {}
 
-func (x *MachineSetConfigStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[43]
+func (x *CanaryRolloutStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4842,40 +5633,192 @@ func (x *MachineSetConfigStatusSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MachineSetConfigStatusSpec.ProtoReflect.Descriptor instead.
-func (*MachineSetConfigStatusSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use CanaryRolloutStatus.ProtoReflect.Descriptor instead.
+func (*CanaryRolloutStatus) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *MachineSetConfigStatusSpec) GetConfigUpdatesAllowed() bool {
+func (x *CanaryRolloutStatus) GetPhase() CanaryRolloutStatus_Phase {
 	if x != nil {
-		return x.ConfigUpdatesAllowed
+		return x.Phase
 	}
-	return false
+	return CanaryRolloutStatus_Unknown
 }
 
-func (x *MachineSetConfigStatusSpec) GetShouldResetGraceful() bool {
+func (x *CanaryRolloutStatus) GetMachines() []string {
 	if x != nil {
-		return x.ShouldResetGraceful
+		return x.Machines
 	}
-	return false
+	return nil
 }
 
-func (x *MachineSetConfigStatusSpec) GetUpdateStrategy() MachineSetSpec_UpdateStrategy {
+func (x *CanaryRolloutStatus) GetTargets() map[string]string {
 	if x != nil {
-		return x.UpdateStrategy
+		return x.Targets
 	}
-	return MachineSetSpec_Unset
+	return nil
 }
 
-func (x *MachineSetConfigStatusSpec) GetUpdateStrategyConfig() *MachineSetSpec_UpdateStrategyConfig {
+func (x *CanaryRolloutStatus) GetVerificationStarted() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UpdateStrategyConfig
+		return x.VerificationStarted
 	}
 	return nil
 }
 
-// MachineSetNodeSpec is a binding between ClusterMachine and MachineSet.
+func (x *CanaryRolloutStatus) GetUnhealthySince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnhealthySince
+	}
+	return nil
+}
+
+func (x *CanaryRolloutStatus) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CanaryRolloutStatus) GetRollbackVersions() map[string]*CanaryRolloutStatus_RollbackVersion {
+	if x != nil {
+		return x.RollbackVersions
+	}
+	return nil
+}
+
+func (x *CanaryRolloutStatus) GetRollbackConfigMachines() []string {
+	if x != nil {
+		return x.RollbackConfigMachines
+	}
+	return nil
+}
+
+// CanaryApprovalSpec approves the verified canaries of a machine set, promoting the rollout to the rest of its machines.
+//
+// The approval applies to the canaries which finished updating before it was created or last updated.
+type CanaryApprovalSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Comment is an optional note attached to the approval.
+	Comment       string `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanaryApprovalSpec) Reset() {
+	*x = CanaryApprovalSpec{}
+	mi := &file_omni_specs_omni_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanaryApprovalSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanaryApprovalSpec) ProtoMessage() {}
+
+func (x *CanaryApprovalSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanaryApprovalSpec.ProtoReflect.Descriptor instead.
+func (*CanaryApprovalSpec) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CanaryApprovalSpec) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+// MachineSetConfigStatusSpec is the settings used by the MachineConfigStatus controller:
+// - update strategy is copied from the MachineSet
+// - config updates allow flag is controller by the MachineSetStatus controller phase.
+type MachineSetConfigStatusSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ConfigUpdatesAllowed controls graceful rollout of the machine config.
+	// If false, no new config updates should happen.
+	ConfigUpdatesAllowed bool `protobuf:"varint,1,opt,name=config_updates_allowed,json=configUpdatesAllowed,proto3" json:"config_updates_allowed,omitempty"`
+	// ShouldResetGraceful controls graceful reset of the machine config.
+	ShouldResetGraceful bool `protobuf:"varint,2,opt,name=should_reset_graceful,json=shouldResetGraceful,proto3" json:"should_reset_graceful,omitempty"`
+	// UpdateStrategy is the update strategy of the machine set.
+	UpdateStrategy MachineSetSpec_UpdateStrategy `protobuf:"varint,3,opt,name=update_strategy,json=updateStrategy,proto3,enum=specs.MachineSetSpec_UpdateStrategy" json:"update_strategy,omitempty"`
+	// UpdateStrategyConfig defines the update strategy configuration.
+	UpdateStrategyConfig *MachineSetSpec_UpdateStrategyConfig `protobuf:"bytes,4,opt,name=update_strategy_config,json=updateStrategyConfig,proto3" json:"update_strategy_config,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *MachineSetConfigStatusSpec) Reset() {
+	*x = MachineSetConfigStatusSpec{}
+	mi := &file_omni_specs_omni_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MachineSetConfigStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MachineSetConfigStatusSpec) ProtoMessage() {}
+
+func (x *MachineSetConfigStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MachineSetConfigStatusSpec.ProtoReflect.Descriptor instead.
+func (*MachineSetConfigStatusSpec) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *MachineSetConfigStatusSpec) GetConfigUpdatesAllowed() bool {
+	if x != nil {
+		return x.ConfigUpdatesAllowed
+	}
+	return false
+}
+
+func (x *MachineSetConfigStatusSpec) GetShouldResetGraceful() bool {
+	if x != nil {
+		return x.ShouldResetGraceful
+	}
+	return false
+}
+
+func (x *MachineSetConfigStatusSpec) GetUpdateStrategy() MachineSetSpec_UpdateStrategy {
+	if x != nil {
+		return x.UpdateStrategy
+	}
+	return MachineSetSpec_Unset
+}
+
+func (x *MachineSetConfigStatusSpec) GetUpdateStrategyConfig() *MachineSetSpec_UpdateStrategyConfig {
+	if x != nil {
+		return x.UpdateStrategyConfig
+	}
+	return nil
+}
+
+// MachineSetNodeSpec is a binding between ClusterMachine and MachineSet.
 type MachineSetNodeSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -4884,7 +5827,7 @@ type MachineSetNodeSpec struct {
 
 func (x *MachineSetNodeSpec) Reset() {
 	*x = MachineSetNodeSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[44]
+	mi := &file_omni_specs_omni_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4896,7 +5839,7 @@ func (x *MachineSetNodeSpec) String() string {
 func (*MachineSetNodeSpec) ProtoMessage() {}
 
 func (x *MachineSetNodeSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[44]
+	mi := &file_omni_specs_omni_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4909,7 +5852,7 @@ func (x *MachineSetNodeSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachineSetNodeSpec.ProtoReflect.Descriptor instead.
 func (*MachineSetNodeSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{44}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{50}
 }
 
 // MachineLabelsSpec is the resource that adds user defined labels to the MachineStatus.
@@ -4921,7 +5864,7 @@ type MachineLabelsSpec struct {
 
 func (x *MachineLabelsSpec) Reset() {
 	*x = MachineLabelsSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[45]
+	mi := &file_omni_specs_omni_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4933,7 +5876,7 @@ func (x *MachineLabelsSpec) String() string {
 func (*MachineLabelsSpec) ProtoMessage() {}
 
 func (x *MachineLabelsSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[45]
+	mi := &file_omni_specs_omni_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4946,7 +5889,7 @@ func (x *MachineLabelsSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachineLabelsSpec.ProtoReflect.Descriptor instead.
 func (*MachineLabelsSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{45}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{51}
 }
 
 // MachineStatusSnapshotSpec describes latest known status of MachineStatus Talos resource.
@@ -4962,7 +5905,7 @@ type MachineStatusSnapshotSpec struct {
 
 func (x *MachineStatusSnapshotSpec) Reset() {
 	*x = MachineStatusSnapshotSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[46]
+	mi := &file_omni_specs_omni_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4974,7 +5917,7 @@ func (x *MachineStatusSnapshotSpec) String() string {
 func (*MachineStatusSnapshotSpec) ProtoMessage() {}
 
 func (x *MachineStatusSnapshotSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[46]
+	mi := &file_omni_specs_omni_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4987,7 +5930,7 @@ func (x *MachineStatusSnapshotSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachineStatusSnapshotSpec.ProtoReflect.Descriptor instead.
 func (*MachineStatusSnapshotSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{46}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *MachineStatusSnapshotSpec) GetMachineStatus() *machine.MachineStatusEvent {
@@ -5021,7 +5964,7 @@ type ControlPlaneStatusSpec struct {
 
 func (x *ControlPlaneStatusSpec) Reset() {
 	*x = ControlPlaneStatusSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[47]
+	mi := &file_omni_specs_omni_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5033,7 +5976,7 @@ func (x *ControlPlaneStatusSpec) String() string {
 func (*ControlPlaneStatusSpec) ProtoMessage() {}
 
 func (x *ControlPlaneStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[47]
+	mi := &file_omni_specs_omni_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5046,7 +5989,7 @@ func (x *ControlPlaneStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ControlPlaneStatusSpec.ProtoReflect.Descriptor instead.
 func (*ControlPlaneStatusSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{47}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *ControlPlaneStatusSpec) GetConditions() []*ControlPlaneStatusSpec_Condition {
@@ -5067,7 +6010,7 @@ type ClusterEndpointSpec struct {
 
 func (x *ClusterEndpointSpec) Reset() {
 	*x = ClusterEndpointSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[48]
+	mi := &file_omni_specs_omni_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5079,7 +6022,7 @@ func (x *ClusterEndpointSpec) String() string {
 func (*ClusterEndpointSpec) ProtoMessage() {}
 
 func (x *ClusterEndpointSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[48]
+	mi := &file_omni_specs_omni_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5092,7 +6035,7 @@ func (x *ClusterEndpointSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterEndpointSpec.ProtoReflect.Descriptor instead.
 func (*ClusterEndpointSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{48}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *ClusterEndpointSpec) GetManagementAddresses() []string {
@@ -5115,7 +6058,7 @@ type KubernetesStatusSpec struct {
 
 func (x *KubernetesStatusSpec) Reset() {
 	*x = KubernetesStatusSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[49]
+	mi := &file_omni_specs_omni_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5127,7 +6070,7 @@ func (x *KubernetesStatusSpec) String() string {
 func (*KubernetesStatusSpec) ProtoMessage() {}
 
 func (x *KubernetesStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[49]
+	mi := &file_omni_specs_omni_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5140,7 +6083,7 @@ func (x *KubernetesStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KubernetesStatusSpec.ProtoReflect.Descriptor instead.
 func (*KubernetesStatusSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{49}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *KubernetesStatusSpec) GetNodes() []*KubernetesStatusSpec_NodeStatus {
@@ -5180,7 +6123,7 @@ type KubernetesUpgradeStatusSpec struct {
 
 func (x *KubernetesUpgradeStatusSpec) Reset() {
 	*x = KubernetesUpgradeStatusSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[50]
+	mi := &file_omni_specs_omni_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5192,7 +6135,7 @@ func (x *KubernetesUpgradeStatusSpec) String() string {
 func (*KubernetesUpgradeStatusSpec) ProtoMessage() {}
 
 func (x *KubernetesUpgradeStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[50]
+	mi := &file_omni_specs_omni_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5205,7 +6148,7 @@ func (x *KubernetesUpgradeStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KubernetesUpgradeStatusSpec.ProtoReflect.Descriptor instead.
 func (*KubernetesUpgradeStatusSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{50}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *KubernetesUpgradeStatusSpec) GetPhase() KubernetesUpgradeStatusSpec_Phase {
@@ -5270,7 +6213,7 @@ type KubernetesUpgradeManifestStatusSpec struct {
 
 func (x *KubernetesUpgradeManifestStatusSpec) Reset() {
 	*x = KubernetesUpgradeManifestStatusSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[51]
+	mi := &file_omni_specs_omni_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5282,7 +6225,7 @@ func (x *KubernetesUpgradeManifestStatusSpec) String() string {
 func (*KubernetesUpgradeManifestStatusSpec) ProtoMessage() {}
 
 func (x *KubernetesUpgradeManifestStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[51]
+	mi := &file_omni_specs_omni_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5295,7 +6238,7 @@ func (x *KubernetesUpgradeManifestStatusSpec) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use KubernetesUpgradeManifestStatusSpec.ProtoReflect.Descriptor instead.
 func (*KubernetesUpgradeManifestStatusSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{51}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *KubernetesUpgradeManifestStatusSpec) GetOutOfSync() int32 {
@@ -5323,7 +6266,7 @@ type DestroyStatusSpec struct {
 
 func (x *DestroyStatusSpec) Reset() {
 	*x = DestroyStatusSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[52]
+	mi := &file_omni_specs_omni_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5335,7 +6278,7 @@ func (x *DestroyStatusSpec) String() string {
 func (*DestroyStatusSpec) ProtoMessage() {}
 
 func (x *DestroyStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[52]
+	mi := &file_omni_specs_omni_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5348,7 +6291,7 @@ func (x *DestroyStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DestroyStatusSpec.ProtoReflect.Descriptor instead.
 func (*DestroyStatusSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{52}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *DestroyStatusSpec) GetPhase() string {
@@ -5381,7 +6324,7 @@ type OngoingTaskSpec struct {
 
 func (x *OngoingTaskSpec) Reset() {
 	*x = OngoingTaskSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[53]
+	mi := &file_omni_specs_omni_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5393,7 +6336,7 @@ func (x *OngoingTaskSpec) String() string {
 func (*OngoingTaskSpec) ProtoMessage() {}
 
 func (x *OngoingTaskSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[53]
+	mi := &file_omni_specs_omni_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5406,7 +6349,7 @@ func (x *OngoingTaskSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OngoingTaskSpec.ProtoReflect.Descriptor instead.
 func (*OngoingTaskSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{53}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *OngoingTaskSpec) GetTitle() string {
@@ -5520,7 +6463,7 @@ type ClusterMachineEncryptionKeySpec struct {
 
 func (x *ClusterMachineEncryptionKeySpec) Reset() {
 	*x = ClusterMachineEncryptionKeySpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[54]
+	mi := &file_omni_specs_omni_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5532,7 +6475,7 @@ func (x *ClusterMachineEncryptionKeySpec) String() string {
 func (*ClusterMachineEncryptionKeySpec) ProtoMessage() {}
 
 func (x *ClusterMachineEncryptionKeySpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[54]
+	mi := &file_omni_specs_omni_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5545,7 +6488,7 @@ func (x *ClusterMachineEncryptionKeySpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterMachineEncryptionKeySpec.ProtoReflect.Descriptor instead.
 func (*ClusterMachineEncryptionKeySpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{54}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *ClusterMachineEncryptionKeySpec) GetData() []byte {
@@ -5570,13 +6513,21 @@ type ExposedServiceSpec struct {
 	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
 	// HasExplicitAlias is true if the service alias is set explicitly.
 	HasExplicitAlias bool `protobuf:"varint,6,opt,name=has_explicit_alias,json=hasExplicitAlias,proto3" json:"has_explicit_alias,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// Protocol is the protocol the service is proxied with.
+	Protocol ExposedServiceSpec_Protocol `protobuf:"varint,7,opt,name=protocol,proto3,enum=specs.ExposedServiceSpec_Protocol" json:"protocol,omitempty"`
+	// ProxyPort is the port Omni listens on for the TCP service.
+	ProxyPort uint32 `protobuf:"varint,8,opt,name=proxy_port,json=proxyPort,proto3" json:"proxy_port,omitempty"`
+	// Access is set when the Kubernetes Service restricts who can access the exposed service.
+	Access *ExposedServiceSpec_Access `protobuf:"bytes,9,opt,name=access,proto3" json:"access,omitempty"`
+	// IdentityForwarding sets how the identity of the user is forwarded to the HTTP and gRPC services.
+	IdentityForwarding ExposedServiceSpec_IdentityForwarding `protobuf:"varint,10,opt,name=identity_forwarding,json=identityForwarding,proto3,enum=specs.ExposedServiceSpec_IdentityForwarding" json:"identity_forwarding,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *ExposedServiceSpec) Reset() {
 	*x = ExposedServiceSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[55]
+	mi := &file_omni_specs_omni_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5588,7 +6539,7 @@ func (x *ExposedServiceSpec) String() string {
 func (*ExposedServiceSpec) ProtoMessage() {}
 
 func (x *ExposedServiceSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[55]
+	mi := &file_omni_specs_omni_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5601,7 +6552,7 @@ func (x *ExposedServiceSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExposedServiceSpec.ProtoReflect.Descriptor instead.
 func (*ExposedServiceSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{55}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *ExposedServiceSpec) GetPort() uint32 {
@@ -5646,6 +6597,34 @@ func (x *ExposedServiceSpec) GetHasExplicitAlias() bool {
 	return false
 }
 
+func (x *ExposedServiceSpec) GetProtocol() ExposedServiceSpec_Protocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return ExposedServiceSpec_HTTP
+}
+
+func (x *ExposedServiceSpec) GetProxyPort() uint32 {
+	if x != nil {
+		return x.ProxyPort
+	}
+	return 0
+}
+
+func (x *ExposedServiceSpec) GetAccess() *ExposedServiceSpec_Access {
+	if x != nil {
+		return x.Access
+	}
+	return nil
+}
+
+func (x *ExposedServiceSpec) GetIdentityForwarding() ExposedServiceSpec_IdentityForwarding {
+	if x != nil {
+		return x.IdentityForwarding
+	}
+	return ExposedServiceSpec_NONE
+}
+
 // ClusterWorkloadProxyStatusSpec describes the status of the exposed services in a cluster.
 type ClusterWorkloadProxyStatusSpec struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
@@ -5656,7 +6635,7 @@ type ClusterWorkloadProxyStatusSpec struct {
 
 func (x *ClusterWorkloadProxyStatusSpec) Reset() {
 	*x = ClusterWorkloadProxyStatusSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[56]
+	mi := &file_omni_specs_omni_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5668,7 +6647,7 @@ func (x *ClusterWorkloadProxyStatusSpec) String() string {
 func (*ClusterWorkloadProxyStatusSpec) ProtoMessage() {}
 
 func (x *ClusterWorkloadProxyStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[56]
+	mi := &file_omni_specs_omni_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5681,7 +6660,7 @@ func (x *ClusterWorkloadProxyStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterWorkloadProxyStatusSpec.ProtoReflect.Descriptor instead.
 func (*ClusterWorkloadProxyStatusSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{56}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *ClusterWorkloadProxyStatusSpec) GetNumExposedServices() uint32 {
@@ -5727,7 +6706,7 @@ type FeaturesConfigSpec struct {
 
 func (x *FeaturesConfigSpec) Reset() {
 	*x = FeaturesConfigSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[57]
+	mi := &file_omni_specs_omni_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5739,7 +6718,7 @@ func (x *FeaturesConfigSpec) String() string {
 func (*FeaturesConfigSpec) ProtoMessage() {}
 
 func (x *FeaturesConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[57]
+	mi := &file_omni_specs_omni_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5752,7 +6731,7 @@ func (x *FeaturesConfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FeaturesConfigSpec.ProtoReflect.Descriptor instead.
 func (*FeaturesConfigSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{57}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *FeaturesConfigSpec) GetEnableWorkloadProxying() bool {
@@ -5862,7 +6841,7 @@ type UserPilotSettings struct {
 
 func (x *UserPilotSettings) Reset() {
 	*x = UserPilotSettings{}
-	mi := &file_omni_specs_omni_proto_msgTypes[58]
+	mi := &file_omni_specs_omni_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5874,7 +6853,7 @@ func (x *UserPilotSettings) String() string {
 func (*UserPilotSettings) ProtoMessage() {}
 
 func (x *UserPilotSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[58]
+	mi := &file_omni_specs_omni_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5887,7 +6866,7 @@ func (x *UserPilotSettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserPilotSettings.ProtoReflect.Descriptor instead.
 func (*UserPilotSettings) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{58}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *UserPilotSettings) GetAppToken() string {
@@ -5909,7 +6888,7 @@ type PosthogSettings struct {
 
 func (x *PosthogSettings) Reset() {
 	*x = PosthogSettings{}
-	mi := &file_omni_specs_omni_proto_msgTypes[59]
+	mi := &file_omni_specs_omni_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5921,7 +6900,7 @@ func (x *PosthogSettings) String() string {
 func (*PosthogSettings) ProtoMessage() {}
 
 func (x *PosthogSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[59]
+	mi := &file_omni_specs_omni_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5934,7 +6913,7 @@ func (x *PosthogSettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PosthogSettings.ProtoReflect.Descriptor instead.
 func (*PosthogSettings) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{59}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *PosthogSettings) GetApiKey() string {
@@ -5961,7 +6940,7 @@ type StripeSettings struct {
 
 func (x *StripeSettings) Reset() {
 	*x = StripeSettings{}
-	mi := &file_omni_specs_omni_proto_msgTypes[60]
+	mi := &file_omni_specs_omni_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5973,7 +6952,7 @@ func (x *StripeSettings) String() string {
 func (*StripeSettings) ProtoMessage() {}
 
 func (x *StripeSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[60]
+	mi := &file_omni_specs_omni_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5986,7 +6965,7 @@ func (x *StripeSettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StripeSettings.ProtoReflect.Descriptor instead.
 func (*StripeSettings) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{60}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *StripeSettings) GetEnabled() bool {
@@ -6013,7 +6992,7 @@ type Account struct {
 
 func (x *Account) Reset() {
 	*x = Account{}
-	mi := &file_omni_specs_omni_proto_msgTypes[61]
+	mi := &file_omni_specs_omni_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6025,7 +7004,7 @@ func (x *Account) String() string {
 func (*Account) ProtoMessage() {}
 
 func (x *Account) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[61]
+	mi := &file_omni_specs_omni_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6038,7 +7017,7 @@ func (x *Account) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Account.ProtoReflect.Descriptor instead.
 func (*Account) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{61}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *Account) GetId() string {
@@ -6069,7 +7048,7 @@ type EtcdBackupSettings struct {
 
 func (x *EtcdBackupSettings) Reset() {
 	*x = EtcdBackupSettings{}
-	mi := &file_omni_specs_omni_proto_msgTypes[62]
+	mi := &file_omni_specs_omni_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6081,7 +7060,7 @@ func (x *EtcdBackupSettings) String() string {
 func (*EtcdBackupSettings) ProtoMessage() {}
 
 func (x *EtcdBackupSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[62]
+	mi := &file_omni_specs_omni_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6094,7 +7073,7 @@ func (x *EtcdBackupSettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdBackupSettings.ProtoReflect.Descriptor instead.
 func (*EtcdBackupSettings) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{62}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *EtcdBackupSettings) GetTickInterval() *durationpb.Duration {
@@ -6131,7 +7110,7 @@ type MachineClassSpec struct {
 
 func (x *MachineClassSpec) Reset() {
 	*x = MachineClassSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[63]
+	mi := &file_omni_specs_omni_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6143,7 +7122,7 @@ func (x *MachineClassSpec) String() string {
 func (*MachineClassSpec) ProtoMessage() {}
 
 func (x *MachineClassSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[63]
+	mi := &file_omni_specs_omni_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6156,7 +7135,7 @@ func (x *MachineClassSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachineClassSpec.ProtoReflect.Descriptor instead.
 func (*MachineClassSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{63}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *MachineClassSpec) GetMatchLabels() []string {
@@ -6184,7 +7163,7 @@ type MachineConfigGenOptionsSpec struct {
 
 func (x *MachineConfigGenOptionsSpec) Reset() {
 	*x = MachineConfigGenOptionsSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[64]
+	mi := &file_omni_specs_omni_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6196,7 +7175,7 @@ func (x *MachineConfigGenOptionsSpec) String() string {
 func (*MachineConfigGenOptionsSpec) ProtoMessage() {}
 
 func (x *MachineConfigGenOptionsSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[64]
+	mi := &file_omni_specs_omni_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6209,7 +7188,7 @@ func (x *MachineConfigGenOptionsSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachineConfigGenOptionsSpec.ProtoReflect.Descriptor instead.
 func (*MachineConfigGenOptionsSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{64}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *MachineConfigGenOptionsSpec) GetInstallImage() *MachineConfigGenOptionsSpec_InstallImage {
@@ -6233,7 +7212,7 @@ type EtcdAuditResultSpec struct {
 
 func (x *EtcdAuditResultSpec) Reset() {
 	*x = EtcdAuditResultSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[65]
+	mi := &file_omni_specs_omni_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6245,7 +7224,7 @@ func (x *EtcdAuditResultSpec) String() string {
 func (*EtcdAuditResultSpec) ProtoMessage() {}
 
 func (x *EtcdAuditResultSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[65]
+	mi := &file_omni_specs_omni_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6258,7 +7237,7 @@ func (x *EtcdAuditResultSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EtcdAuditResultSpec.ProtoReflect.Descriptor instead.
 func (*EtcdAuditResultSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{65}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *EtcdAuditResultSpec) GetEtcdMemberIds() []uint64 {
@@ -6279,7 +7258,7 @@ type KubeconfigSpec struct {
 
 func (x *KubeconfigSpec) Reset() {
 	*x = KubeconfigSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[66]
+	mi := &file_omni_specs_omni_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6291,7 +7270,7 @@ func (x *KubeconfigSpec) String() string {
 func (*KubeconfigSpec) ProtoMessage() {}
 
 func (x *KubeconfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[66]
+	mi := &file_omni_specs_omni_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6304,7 +7283,7 @@ func (x *KubeconfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KubeconfigSpec.ProtoReflect.Descriptor instead.
 func (*KubeconfigSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{66}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *KubeconfigSpec) GetData() []byte {
@@ -6327,7 +7306,7 @@ type KubernetesUsageSpec struct {
 
 func (x *KubernetesUsageSpec) Reset() {
 	*x = KubernetesUsageSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[67]
+	mi := &file_omni_specs_omni_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6339,7 +7318,7 @@ func (x *KubernetesUsageSpec) String() string {
 func (*KubernetesUsageSpec) ProtoMessage() {}
 
 func (x *KubernetesUsageSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[67]
+	mi := &file_omni_specs_omni_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6352,7 +7331,7 @@ func (x *KubernetesUsageSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KubernetesUsageSpec.ProtoReflect.Descriptor instead.
 func (*KubernetesUsageSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{67}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *KubernetesUsageSpec) GetCpu() *KubernetesUsageSpec_Quantity {
@@ -6394,7 +7373,7 @@ type ImagePullRequestSpec struct {
 
 func (x *ImagePullRequestSpec) Reset() {
 	*x = ImagePullRequestSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[68]
+	mi := &file_omni_specs_omni_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6406,7 +7385,7 @@ func (x *ImagePullRequestSpec) String() string {
 func (*ImagePullRequestSpec) ProtoMessage() {}
 
 func (x *ImagePullRequestSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[68]
+	mi := &file_omni_specs_omni_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6419,7 +7398,7 @@ func (x *ImagePullRequestSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImagePullRequestSpec.ProtoReflect.Descriptor instead.
 func (*ImagePullRequestSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{68}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *ImagePullRequestSpec) GetNodeImageList() []*ImagePullRequestSpec_NodeImageList {
@@ -6445,7 +7424,7 @@ type ImagePullStatusSpec struct {
 
 func (x *ImagePullStatusSpec) Reset() {
 	*x = ImagePullStatusSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[69]
+	mi := &file_omni_specs_omni_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6457,7 +7436,7 @@ func (x *ImagePullStatusSpec) String() string {
 func (*ImagePullStatusSpec) ProtoMessage() {}
 
 func (x *ImagePullStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[69]
+	mi := &file_omni_specs_omni_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6470,7 +7449,7 @@ func (x *ImagePullStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImagePullStatusSpec.ProtoReflect.Descriptor instead.
 func (*ImagePullStatusSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{69}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *ImagePullStatusSpec) GetLastProcessedNode() string {
@@ -6525,7 +7504,7 @@ type SchematicSpec struct {
 
 func (x *SchematicSpec) Reset() {
 	*x = SchematicSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[70]
+	mi := &file_omni_specs_omni_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6537,7 +7516,7 @@ func (x *SchematicSpec) String() string {
 func (*SchematicSpec) ProtoMessage() {}
 
 func (x *SchematicSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[70]
+	mi := &file_omni_specs_omni_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6550,7 +7529,7 @@ func (x *SchematicSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SchematicSpec.ProtoReflect.Descriptor instead.
 func (*SchematicSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{70}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{76}
 }
 
 // TalosExtensionsSpec represents all available extensions for a particular Talos version.
@@ -6563,7 +7542,7 @@ type TalosExtensionsSpec struct {
 
 func (x *TalosExtensionsSpec) Reset() {
 	*x = TalosExtensionsSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[71]
+	mi := &file_omni_specs_omni_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6575,7 +7554,7 @@ func (x *TalosExtensionsSpec) String() string {
 func (*TalosExtensionsSpec) ProtoMessage() {}
 
 func (x *TalosExtensionsSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[71]
+	mi := &file_omni_specs_omni_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6588,7 +7567,7 @@ func (x *TalosExtensionsSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TalosExtensionsSpec.ProtoReflect.Descriptor instead.
 func (*TalosExtensionsSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{71}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *TalosExtensionsSpec) GetItems() []*TalosExtensionsSpec_Info {
@@ -6609,7 +7588,7 @@ type SchematicConfigurationSpec struct {
 
 func (x *SchematicConfigurationSpec) Reset() {
 	*x = SchematicConfigurationSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[72]
+	mi := &file_omni_specs_omni_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6621,7 +7600,7 @@ func (x *SchematicConfigurationSpec) String() string {
 func (*SchematicConfigurationSpec) ProtoMessage() {}
 
 func (x *SchematicConfigurationSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[72]
+	mi := &file_omni_specs_omni_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6634,7 +7613,7 @@ func (x *SchematicConfigurationSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SchematicConfigurationSpec.ProtoReflect.Descriptor instead.
 func (*SchematicConfigurationSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{72}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *SchematicConfigurationSpec) GetSchematicId() string {
@@ -6661,7 +7640,7 @@ type ExtensionsConfigurationSpec struct {
 
 func (x *ExtensionsConfigurationSpec) Reset() {
 	*x = ExtensionsConfigurationSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[73]
+	mi := &file_omni_specs_omni_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6673,7 +7652,7 @@ func (x *ExtensionsConfigurationSpec) String() string {
 func (*ExtensionsConfigurationSpec) ProtoMessage() {}
 
 func (x *ExtensionsConfigurationSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[73]
+	mi := &file_omni_specs_omni_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6686,7 +7665,7 @@ func (x *ExtensionsConfigurationSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtensionsConfigurationSpec.ProtoReflect.Descriptor instead.
 func (*ExtensionsConfigurationSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{73}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *ExtensionsConfigurationSpec) GetExtensions() []string {
@@ -6706,7 +7685,7 @@ type KernelArgsSpec struct {
 
 func (x *KernelArgsSpec) Reset() {
 	*x = KernelArgsSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[74]
+	mi := &file_omni_specs_omni_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6718,7 +7697,7 @@ func (x *KernelArgsSpec) String() string {
 func (*KernelArgsSpec) ProtoMessage() {}
 
 func (x *KernelArgsSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[74]
+	mi := &file_omni_specs_omni_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6731,7 +7710,7 @@ func (x *KernelArgsSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KernelArgsSpec.ProtoReflect.Descriptor instead.
 func (*KernelArgsSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{74}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *KernelArgsSpec) GetArgs() []string {
@@ -6753,7 +7732,7 @@ type KernelArgsStatusSpec struct {
 
 func (x *KernelArgsStatusSpec) Reset() {
 	*x = KernelArgsStatusSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[75]
+	mi := &file_omni_specs_omni_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6765,7 +7744,7 @@ func (x *KernelArgsStatusSpec) String() string {
 func (*KernelArgsStatusSpec) ProtoMessage() {}
 
 func (x *KernelArgsStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[75]
+	mi := &file_omni_specs_omni_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6778,7 +7757,7 @@ func (x *KernelArgsStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KernelArgsStatusSpec.ProtoReflect.Descriptor instead.
 func (*KernelArgsStatusSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{75}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *KernelArgsStatusSpec) GetArgs() []string {
@@ -6825,7 +7804,7 @@ type MachineUpgradeStatusSpec struct {
 
 func (x *MachineUpgradeStatusSpec) Reset() {
 	*x = MachineUpgradeStatusSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[76]
+	mi := &file_omni_specs_omni_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6837,7 +7816,7 @@ func (x *MachineUpgradeStatusSpec) String() string {
 func (*MachineUpgradeStatusSpec) ProtoMessage() {}
 
 func (x *MachineUpgradeStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[76]
+	mi := &file_omni_specs_omni_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6850,7 +7829,7 @@ func (x *MachineUpgradeStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachineUpgradeStatusSpec.ProtoReflect.Descriptor instead.
 func (*MachineUpgradeStatusSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{76}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *MachineUpgradeStatusSpec) GetSchematicId() string {
@@ -6919,7 +7898,7 @@ type MachineExtensionsSpec struct {
 
 func (x *MachineExtensionsSpec) Reset() {
 	*x = MachineExtensionsSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[77]
+	mi := &file_omni_specs_omni_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6931,7 +7910,7 @@ func (x *MachineExtensionsSpec) String() string {
 func (*MachineExtensionsSpec) ProtoMessage() {}
 
 func (x *MachineExtensionsSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[77]
+	mi := &file_omni_specs_omni_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6944,7 +7923,7 @@ func (x *MachineExtensionsSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachineExtensionsSpec.ProtoReflect.Descriptor instead.
 func (*MachineExtensionsSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{77}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *MachineExtensionsSpec) GetExtensions() []string {
@@ -6965,7 +7944,7 @@ type MachineExtensionsStatusSpec struct {
 
 func (x *MachineExtensionsStatusSpec) Reset() {
 	*x = MachineExtensionsStatusSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[78]
+	mi := &file_omni_specs_omni_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6977,7 +7956,7 @@ func (x *MachineExtensionsStatusSpec) String() string {
 func (*MachineExtensionsStatusSpec) ProtoMessage() {}
 
 func (x *MachineExtensionsStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[78]
+	mi := &file_omni_specs_omni_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6990,7 +7969,7 @@ func (x *MachineExtensionsStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachineExtensionsStatusSpec.ProtoReflect.Descriptor instead.
 func (*MachineExtensionsStatusSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{78}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *MachineExtensionsStatusSpec) GetExtensions() []*MachineExtensionsStatusSpec_Item {
@@ -7029,7 +8008,7 @@ type MachineStatusMetricsSpec struct {
 
 func (x *MachineStatusMetricsSpec) Reset() {
 	*x = MachineStatusMetricsSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[79]
+	mi := &file_omni_specs_omni_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7041,7 +8020,7 @@ func (x *MachineStatusMetricsSpec) String() string {
 func (*MachineStatusMetricsSpec) ProtoMessage() {}
 
 func (x *MachineStatusMetricsSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[79]
+	mi := &file_omni_specs_omni_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7054,7 +8033,7 @@ func (x *MachineStatusMetricsSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachineStatusMetricsSpec.ProtoReflect.Descriptor instead.
 func (*MachineStatusMetricsSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{79}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *MachineStatusMetricsSpec) GetRegisteredMachinesCount() uint32 {
@@ -7158,7 +8137,7 @@ type ClusterMetricsSpec struct {
 
 func (x *ClusterMetricsSpec) Reset() {
 	*x = ClusterMetricsSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[80]
+	mi := &file_omni_specs_omni_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7170,7 +8149,7 @@ func (x *ClusterMetricsSpec) String() string {
 func (*ClusterMetricsSpec) ProtoMessage() {}
 
 func (x *ClusterMetricsSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[80]
+	mi := &file_omni_specs_omni_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7183,7 +8162,7 @@ func (x *ClusterMetricsSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterMetricsSpec.ProtoReflect.Descriptor instead.
 func (*ClusterMetricsSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{80}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *ClusterMetricsSpec) GetFeatures() map[string]uint32 {
@@ -7205,7 +8184,7 @@ type ClusterStatusMetricsSpec struct {
 
 func (x *ClusterStatusMetricsSpec) Reset() {
 	*x = ClusterStatusMetricsSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[81]
+	mi := &file_omni_specs_omni_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7217,7 +8196,7 @@ func (x *ClusterStatusMetricsSpec) String() string {
 func (*ClusterStatusMetricsSpec) ProtoMessage() {}
 
 func (x *ClusterStatusMetricsSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[81]
+	mi := &file_omni_specs_omni_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7230,7 +8209,7 @@ func (x *ClusterStatusMetricsSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterStatusMetricsSpec.ProtoReflect.Descriptor instead.
 func (*ClusterStatusMetricsSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{81}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *ClusterStatusMetricsSpec) GetNotReadyCount() uint32 {
@@ -7260,7 +8239,7 @@ type ClusterKubernetesNodesSpec struct {
 
 func (x *ClusterKubernetesNodesSpec) Reset() {
 	*x = ClusterKubernetesNodesSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[82]
+	mi := &file_omni_specs_omni_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7272,7 +8251,7 @@ func (x *ClusterKubernetesNodesSpec) String() string {
 func (*ClusterKubernetesNodesSpec) ProtoMessage() {}
 
 func (x *ClusterKubernetesNodesSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[82]
+	mi := &file_omni_specs_omni_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7285,7 +8264,7 @@ func (x *ClusterKubernetesNodesSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterKubernetesNodesSpec.ProtoReflect.Descriptor instead.
 func (*ClusterKubernetesNodesSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{82}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *ClusterKubernetesNodesSpec) GetNodes() []string {
@@ -7308,7 +8287,7 @@ type KubernetesNodeAuditResultSpec struct {
 
 func (x *KubernetesNodeAuditResultSpec) Reset() {
 	*x = KubernetesNodeAuditResultSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[83]
+	mi := &file_omni_specs_omni_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7320,7 +8299,7 @@ func (x *KubernetesNodeAuditResultSpec) String() string {
 func (*KubernetesNodeAuditResultSpec) ProtoMessage() {}
 
 func (x *KubernetesNodeAuditResultSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[83]
+	mi := &file_omni_specs_omni_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7333,7 +8312,7 @@ func (x *KubernetesNodeAuditResultSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KubernetesNodeAuditResultSpec.ProtoReflect.Descriptor instead.
 func (*KubernetesNodeAuditResultSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{83}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *KubernetesNodeAuditResultSpec) GetDeletedNodes() []string {
@@ -7361,7 +8340,7 @@ type MachineRequestSetSpec struct {
 
 func (x *MachineRequestSetSpec) Reset() {
 	*x = MachineRequestSetSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[84]
+	mi := &file_omni_specs_omni_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7373,7 +8352,7 @@ func (x *MachineRequestSetSpec) String() string {
 func (*MachineRequestSetSpec) ProtoMessage() {}
 
 func (x *MachineRequestSetSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[84]
+	mi := &file_omni_specs_omni_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7386,7 +8365,7 @@ func (x *MachineRequestSetSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachineRequestSetSpec.ProtoReflect.Descriptor instead.
 func (*MachineRequestSetSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{84}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *MachineRequestSetSpec) GetProviderId() string {
@@ -7454,7 +8433,7 @@ type MachineRequestSetStatusSpec struct {
 
 func (x *MachineRequestSetStatusSpec) Reset() {
 	*x = MachineRequestSetStatusSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[85]
+	mi := &file_omni_specs_omni_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7466,7 +8445,7 @@ func (x *MachineRequestSetStatusSpec) String() string {
 func (*MachineRequestSetStatusSpec) ProtoMessage() {}
 
 func (x *MachineRequestSetStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[85]
+	mi := &file_omni_specs_omni_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7479,7 +8458,7 @@ func (x *MachineRequestSetStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachineRequestSetStatusSpec.ProtoReflect.Descriptor instead.
 func (*MachineRequestSetStatusSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{85}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{91}
 }
 
 // ClusterDiagnosticSpec describes the nodes in a cluster with diagnostics information available (indicating potential problems).
@@ -7492,7 +8471,7 @@ type ClusterDiagnosticsSpec struct {
 
 func (x *ClusterDiagnosticsSpec) Reset() {
 	*x = ClusterDiagnosticsSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[86]
+	mi := &file_omni_specs_omni_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7504,7 +8483,7 @@ func (x *ClusterDiagnosticsSpec) String() string {
 func (*ClusterDiagnosticsSpec) ProtoMessage() {}
 
 func (x *ClusterDiagnosticsSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[86]
+	mi := &file_omni_specs_omni_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7517,7 +8496,7 @@ func (x *ClusterDiagnosticsSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterDiagnosticsSpec.ProtoReflect.Descriptor instead.
 func (*ClusterDiagnosticsSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{86}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *ClusterDiagnosticsSpec) GetNodes() []*ClusterDiagnosticsSpec_Node {
@@ -7538,7 +8517,7 @@ type MachineRequestSetPressureSpec struct {
 
 func (x *MachineRequestSetPressureSpec) Reset() {
 	*x = MachineRequestSetPressureSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[87]
+	mi := &file_omni_specs_omni_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7550,7 +8529,7 @@ func (x *MachineRequestSetPressureSpec) String() string {
 func (*MachineRequestSetPressureSpec) ProtoMessage() {}
 
 func (x *MachineRequestSetPressureSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[87]
+	mi := &file_omni_specs_omni_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7563,7 +8542,7 @@ func (x *MachineRequestSetPressureSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachineRequestSetPressureSpec.ProtoReflect.Descriptor instead.
 func (*MachineRequestSetPressureSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{87}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *MachineRequestSetPressureSpec) GetRequiredMachines() uint32 {
@@ -7588,7 +8567,7 @@ type ClusterMachineRequestStatusSpec struct {
 
 func (x *ClusterMachineRequestStatusSpec) Reset() {
 	*x = ClusterMachineRequestStatusSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[88]
+	mi := &file_omni_specs_omni_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7600,7 +8579,7 @@ func (x *ClusterMachineRequestStatusSpec) String() string {
 func (*ClusterMachineRequestStatusSpec) ProtoMessage() {}
 
 func (x *ClusterMachineRequestStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[88]
+	mi := &file_omni_specs_omni_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7613,7 +8592,7 @@ func (x *ClusterMachineRequestStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterMachineRequestStatusSpec.ProtoReflect.Descriptor instead.
 func (*ClusterMachineRequestStatusSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{88}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *ClusterMachineRequestStatusSpec) GetStatus() string {
@@ -7670,7 +8649,7 @@ type InfraMachineConfigSpec struct {
 
 func (x *InfraMachineConfigSpec) Reset() {
 	*x = InfraMachineConfigSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[89]
+	mi := &file_omni_specs_omni_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7682,7 +8661,7 @@ func (x *InfraMachineConfigSpec) String() string {
 func (*InfraMachineConfigSpec) ProtoMessage() {}
 
 func (x *InfraMachineConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[89]
+	mi := &file_omni_specs_omni_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7695,7 +8674,7 @@ func (x *InfraMachineConfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InfraMachineConfigSpec.ProtoReflect.Descriptor instead.
 func (*InfraMachineConfigSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{89}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *InfraMachineConfigSpec) GetPowerState() InfraMachineConfigSpec_MachinePowerState {
@@ -7750,7 +8729,7 @@ type InfraMachineBMCConfigSpec struct {
 
 func (x *InfraMachineBMCConfigSpec) Reset() {
 	*x = InfraMachineBMCConfigSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[90]
+	mi := &file_omni_specs_omni_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7762,7 +8741,7 @@ func (x *InfraMachineBMCConfigSpec) String() string {
 func (*InfraMachineBMCConfigSpec) ProtoMessage() {}
 
 func (x *InfraMachineBMCConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[90]
+	mi := &file_omni_specs_omni_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7775,7 +8754,7 @@ func (x *InfraMachineBMCConfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InfraMachineBMCConfigSpec.ProtoReflect.Descriptor instead.
 func (*InfraMachineBMCConfigSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{90}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *InfraMachineBMCConfigSpec) GetIpmi() *InfraMachineBMCConfigSpec_IPMI {
@@ -7804,7 +8783,7 @@ type MaintenanceConfigStatusSpec struct {
 
 func (x *MaintenanceConfigStatusSpec) Reset() {
 	*x = MaintenanceConfigStatusSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[91]
+	mi := &file_omni_specs_omni_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7816,7 +8795,7 @@ func (x *MaintenanceConfigStatusSpec) String() string {
 func (*MaintenanceConfigStatusSpec) ProtoMessage() {}
 
 func (x *MaintenanceConfigStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[91]
+	mi := &file_omni_specs_omni_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7829,7 +8808,7 @@ func (x *MaintenanceConfigStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MaintenanceConfigStatusSpec.ProtoReflect.Descriptor instead.
 func (*MaintenanceConfigStatusSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{91}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *MaintenanceConfigStatusSpec) GetPublicKeyAtLastApply() string {
@@ -7855,7 +8834,7 @@ type NodeForceDestroyRequestSpec struct {
 
 func (x *NodeForceDestroyRequestSpec) Reset() {
 	*x = NodeForceDestroyRequestSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[92]
+	mi := &file_omni_specs_omni_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7867,7 +8846,7 @@ func (x *NodeForceDestroyRequestSpec) String() string {
 func (*NodeForceDestroyRequestSpec) ProtoMessage() {}
 
 func (x *NodeForceDestroyRequestSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[92]
+	mi := &file_omni_specs_omni_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7880,7 +8859,7 @@ func (x *NodeForceDestroyRequestSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeForceDestroyRequestSpec.ProtoReflect.Descriptor instead.
 func (*NodeForceDestroyRequestSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{92}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{98}
 }
 
 type DiscoveryAffiliateDeleteTaskSpec struct {
@@ -7896,7 +8875,7 @@ type DiscoveryAffiliateDeleteTaskSpec struct {
 
 func (x *DiscoveryAffiliateDeleteTaskSpec) Reset() {
 	*x = DiscoveryAffiliateDeleteTaskSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[93]
+	mi := &file_omni_specs_omni_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7908,7 +8887,7 @@ func (x *DiscoveryAffiliateDeleteTaskSpec) String() string {
 func (*DiscoveryAffiliateDeleteTaskSpec) ProtoMessage() {}
 
 func (x *DiscoveryAffiliateDeleteTaskSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[93]
+	mi := &file_omni_specs_omni_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7921,7 +8900,7 @@ func (x *DiscoveryAffiliateDeleteTaskSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiscoveryAffiliateDeleteTaskSpec.ProtoReflect.Descriptor instead.
 func (*DiscoveryAffiliateDeleteTaskSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{93}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *DiscoveryAffiliateDeleteTaskSpec) GetClusterId() string {
@@ -7959,7 +8938,7 @@ type InfraProviderCombinedStatusSpec struct {
 
 func (x *InfraProviderCombinedStatusSpec) Reset() {
 	*x = InfraProviderCombinedStatusSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[94]
+	mi := &file_omni_specs_omni_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7971,7 +8950,7 @@ func (x *InfraProviderCombinedStatusSpec) String() string {
 func (*InfraProviderCombinedStatusSpec) ProtoMessage() {}
 
 func (x *InfraProviderCombinedStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[94]
+	mi := &file_omni_specs_omni_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7984,7 +8963,7 @@ func (x *InfraProviderCombinedStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InfraProviderCombinedStatusSpec.ProtoReflect.Descriptor instead.
 func (*InfraProviderCombinedStatusSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{94}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *InfraProviderCombinedStatusSpec) GetName() string {
@@ -8038,7 +9017,7 @@ type MachineConfigDiffSpec struct {
 
 func (x *MachineConfigDiffSpec) Reset() {
 	*x = MachineConfigDiffSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[95]
+	mi := &file_omni_specs_omni_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8050,7 +9029,7 @@ func (x *MachineConfigDiffSpec) String() string {
 func (*MachineConfigDiffSpec) ProtoMessage() {}
 
 func (x *MachineConfigDiffSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[95]
+	mi := &file_omni_specs_omni_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8063,7 +9042,7 @@ func (x *MachineConfigDiffSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachineConfigDiffSpec.ProtoReflect.Descriptor instead.
 func (*MachineConfigDiffSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{95}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *MachineConfigDiffSpec) GetDiff() string {
@@ -8103,7 +9082,7 @@ type InstallationMediaConfigSpec struct {
 
 func (x *InstallationMediaConfigSpec) Reset() {
 	*x = InstallationMediaConfigSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[96]
+	mi := &file_omni_specs_omni_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8115,7 +9094,7 @@ func (x *InstallationMediaConfigSpec) String() string {
 func (*InstallationMediaConfigSpec) ProtoMessage() {}
 
 func (x *InstallationMediaConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[96]
+	mi := &file_omni_specs_omni_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8128,7 +9107,7 @@ func (x *InstallationMediaConfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InstallationMediaConfigSpec.ProtoReflect.Descriptor instead.
 func (*InstallationMediaConfigSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{96}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *InstallationMediaConfigSpec) GetTalosVersion() string {
@@ -8231,7 +9210,7 @@ type RotateTalosCASpec struct {
 
 func (x *RotateTalosCASpec) Reset() {
 	*x = RotateTalosCASpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[97]
+	mi := &file_omni_specs_omni_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8243,7 +9222,7 @@ func (x *RotateTalosCASpec) String() string {
 func (*RotateTalosCASpec) ProtoMessage() {}
 
 func (x *RotateTalosCASpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[97]
+	mi := &file_omni_specs_omni_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8256,7 +9235,7 @@ func (x *RotateTalosCASpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RotateTalosCASpec.ProtoReflect.Descriptor instead.
 func (*RotateTalosCASpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{97}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{103}
 }
 
 type SecretRotationSpec struct {
@@ -8275,13 +9254,21 @@ type SecretRotationSpec struct {
 	BackupCertsOs []*ClusterSecretsSpec_Certs_CA `protobuf:"bytes,6,rep,name=backup_certs_os,json=backupCertsOs,proto3" json:"backup_certs_os,omitempty"`
 	// Backup_certs_k8s holds backup of the original Kubernetes CA certs before rotation started.
 	BackupCertsK8S []*ClusterSecretsSpec_Certs_CA `protobuf:"bytes,7,rep,name=backup_certs_k8s,json=backupCertsK8s,proto3" json:"backup_certs_k8s,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Backup_certs_etcd holds backup of the original etcd CA certs before rotation started.
+	BackupCertsEtcd []*ClusterSecretsSpec_Certs_CA `protobuf:"bytes,8,rep,name=backup_certs_etcd,json=backupCertsEtcd,proto3" json:"backup_certs_etcd,omitempty"`
+	// Backup_certs_k8s_aggregator holds backup of the original Kubernetes front-proxy CA certs before rotation started.
+	BackupCertsK8SAggregator []*ClusterSecretsSpec_Certs_CA `protobuf:"bytes,9,rep,name=backup_certs_k8s_aggregator,json=backupCertsK8sAggregator,proto3" json:"backup_certs_k8s_aggregator,omitempty"`
+	// Backup_certs_k8s_sa holds backup of the original Kubernetes service account keys before rotation started.
+	BackupCertsK8SSa []*ClusterSecretsSpec_Certs_CA `protobuf:"bytes,10,rep,name=backup_certs_k8s_sa,json=backupCertsK8sSa,proto3" json:"backup_certs_k8s_sa,omitempty"`
+	// Extra_bootstrap_token holds the new bootstrap token during the bootstrap token rotation.
+	ExtraBootstrapToken string `protobuf:"bytes,11,opt,name=extra_bootstrap_token,json=extraBootstrapToken,proto3" json:"extra_bootstrap_token,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *SecretRotationSpec) Reset() {
 	*x = SecretRotationSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[98]
+	mi := &file_omni_specs_omni_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8293,7 +9280,7 @@ func (x *SecretRotationSpec) String() string {
 func (*SecretRotationSpec) ProtoMessage() {}
 
 func (x *SecretRotationSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[98]
+	mi := &file_omni_specs_omni_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8306,7 +9293,7 @@ func (x *SecretRotationSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SecretRotationSpec.ProtoReflect.Descriptor instead.
 func (*SecretRotationSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{98}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *SecretRotationSpec) GetStatus() SecretRotationSpec_Status {
@@ -8358,6 +9345,34 @@ func (x *SecretRotationSpec) GetBackupCertsK8S() []*ClusterSecretsSpec_Certs_CA
 	return nil
 }
 
+func (x *SecretRotationSpec) GetBackupCertsEtcd() []*ClusterSecretsSpec_Certs_CA {
+	if x != nil {
+		return x.BackupCertsEtcd
+	}
+	return nil
+}
+
+func (x *SecretRotationSpec) GetBackupCertsK8SAggregator() []*ClusterSecretsSpec_Certs_CA {
+	if x != nil {
+		return x.BackupCertsK8SAggregator
+	}
+	return nil
+}
+
+func (x *SecretRotationSpec) GetBackupCertsK8SSa() []*ClusterSecretsSpec_Certs_CA {
+	if x != nil {
+		return x.BackupCertsK8SSa
+	}
+	return nil
+}
+
+func (x *SecretRotationSpec) GetExtraBootstrapToken() string {
+	if x != nil {
+		return x.ExtraBootstrapToken
+	}
+	return ""
+}
+
 // ClusterSecretsRotationStatusSpec contains the status of the cluster secrets rotation process.
 type ClusterSecretsRotationStatusSpec struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -8370,14 +9385,16 @@ type ClusterSecretsRotationStatusSpec struct {
 	// Contains the current rotation step.
 	Step string `protobuf:"bytes,4,opt,name=step,proto3" json:"step,omitempty"`
 	// Contains the current rotation step progress.
-	Status        string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	// Secrets holds the expiration and the rotation schedule of the cluster secrets.
+	Secrets       []*ClusterSecretsRotationStatusSpec_Secret `protobuf:"bytes,6,rep,name=secrets,proto3" json:"secrets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ClusterSecretsRotationStatusSpec) Reset() {
 	*x = ClusterSecretsRotationStatusSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[99]
+	mi := &file_omni_specs_omni_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8389,7 +9406,7 @@ func (x *ClusterSecretsRotationStatusSpec) String() string {
 func (*ClusterSecretsRotationStatusSpec) ProtoMessage() {}
 
 func (x *ClusterSecretsRotationStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[99]
+	mi := &file_omni_specs_omni_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8402,7 +9419,7 @@ func (x *ClusterSecretsRotationStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterSecretsRotationStatusSpec.ProtoReflect.Descriptor instead.
 func (*ClusterSecretsRotationStatusSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{99}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{105}
 }
 
 func (x *ClusterSecretsRotationStatusSpec) GetPhase() SecretRotationSpec_Phase {
@@ -8440,6 +9457,13 @@ func (x *ClusterSecretsRotationStatusSpec) GetStatus() string {
 	return ""
 }
 
+func (x *ClusterSecretsRotationStatusSpec) GetSecrets() []*ClusterSecretsRotationStatusSpec_Secret {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
 // ClusterMachineSecretsSpec describes cluster machine secrets.
 type ClusterMachineSecretsSpec struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -8453,7 +9477,7 @@ type ClusterMachineSecretsSpec struct {
 
 func (x *ClusterMachineSecretsSpec) Reset() {
 	*x = ClusterMachineSecretsSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[100]
+	mi := &file_omni_specs_omni_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8465,7 +9489,7 @@ func (x *ClusterMachineSecretsSpec) String() string {
 func (*ClusterMachineSecretsSpec) ProtoMessage() {}
 
 func (x *ClusterMachineSecretsSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[100]
+	mi := &file_omni_specs_omni_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8478,7 +9502,7 @@ func (x *ClusterMachineSecretsSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterMachineSecretsSpec.ProtoReflect.Descriptor instead.
 func (*ClusterMachineSecretsSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{100}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{106}
 }
 
 func (x *ClusterMachineSecretsSpec) GetData() []byte {
@@ -8504,7 +9528,7 @@ type RotateKubernetesCASpec struct {
 
 func (x *RotateKubernetesCASpec) Reset() {
 	*x = RotateKubernetesCASpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[101]
+	mi := &file_omni_specs_omni_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8516,7 +9540,7 @@ func (x *RotateKubernetesCASpec) String() string {
 func (*RotateKubernetesCASpec) ProtoMessage() {}
 
 func (x *RotateKubernetesCASpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[101]
+	mi := &file_omni_specs_omni_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8529,20 +9553,172 @@ func (x *RotateKubernetesCASpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RotateKubernetesCASpec.ProtoReflect.Descriptor instead.
 func (*RotateKubernetesCASpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{101}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{107}
+}
+
+// RotateEtcdCASpec is used to initiate etcd CA rotation.
+type RotateEtcdCASpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateEtcdCASpec) Reset() {
+	*x = RotateEtcdCASpec{}
+	mi := &file_omni_specs_omni_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateEtcdCASpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateEtcdCASpec) ProtoMessage() {}
+
+func (x *RotateEtcdCASpec) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateEtcdCASpec.ProtoReflect.Descriptor instead.
+func (*RotateEtcdCASpec) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{108}
+}
+
+// RotateKubernetesAggregatorCASpec is used to initiate Kubernetes front-proxy (aggregator) CA rotation.
+type RotateKubernetesAggregatorCASpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateKubernetesAggregatorCASpec) Reset() {
+	*x = RotateKubernetesAggregatorCASpec{}
+	mi := &file_omni_specs_omni_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateKubernetesAggregatorCASpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateKubernetesAggregatorCASpec) ProtoMessage() {}
+
+func (x *RotateKubernetesAggregatorCASpec) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateKubernetesAggregatorCASpec.ProtoReflect.Descriptor instead.
+func (*RotateKubernetesAggregatorCASpec) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{109}
+}
+
+// RotateKubernetesServiceAccountKeySpec is used to initiate Kubernetes service account signing key rotation.
+type RotateKubernetesServiceAccountKeySpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateKubernetesServiceAccountKeySpec) Reset() {
+	*x = RotateKubernetesServiceAccountKeySpec{}
+	mi := &file_omni_specs_omni_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateKubernetesServiceAccountKeySpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateKubernetesServiceAccountKeySpec) ProtoMessage() {}
+
+func (x *RotateKubernetesServiceAccountKeySpec) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateKubernetesServiceAccountKeySpec.ProtoReflect.Descriptor instead.
+func (*RotateKubernetesServiceAccountKeySpec) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{110}
+}
+
+// RotateBootstrapTokenSpec is used to initiate Kubernetes bootstrap token rotation.
+type RotateBootstrapTokenSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateBootstrapTokenSpec) Reset() {
+	*x = RotateBootstrapTokenSpec{}
+	mi := &file_omni_specs_omni_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateBootstrapTokenSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateBootstrapTokenSpec) ProtoMessage() {}
+
+func (x *RotateBootstrapTokenSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateBootstrapTokenSpec.ProtoReflect.Descriptor instead.
+func (*RotateBootstrapTokenSpec) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{111}
 }
 
 // UpgradeRolloutSpec describes the rollout for the cluster upgrade process.
 type UpgradeRolloutSpec struct {
 	state                   protoimpl.MessageState `protogen:"open.v1"`
 	MachineSetsUpgradeQuota map[string]int32       `protobuf:"bytes,1,rep,name=machine_sets_upgrade_quota,json=machineSetsUpgradeQuota,proto3" json:"machine_sets_upgrade_quota,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// MachineSetsUpgradeCanary is the state of the canary rollout of the Talos upgrades per machine set.
+	MachineSetsUpgradeCanary map[string]*CanaryRolloutStatus `protobuf:"bytes,2,rep,name=machine_sets_upgrade_canary,json=machineSetsUpgradeCanary,proto3" json:"machine_sets_upgrade_canary,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// MachineSetsUpdateCanary is the state of the canary rollout of the config updates per machine set.
+	MachineSetsUpdateCanary map[string]*CanaryRolloutStatus `protobuf:"bytes,3,rep,name=machine_sets_update_canary,json=machineSetsUpdateCanary,proto3" json:"machine_sets_update_canary,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields           protoimpl.UnknownFields
 	sizeCache               protoimpl.SizeCache
 }
 
 func (x *UpgradeRolloutSpec) Reset() {
 	*x = UpgradeRolloutSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[102]
+	mi := &file_omni_specs_omni_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8554,7 +9730,7 @@ func (x *UpgradeRolloutSpec) String() string {
 func (*UpgradeRolloutSpec) ProtoMessage() {}
 
 func (x *UpgradeRolloutSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[102]
+	mi := &file_omni_specs_omni_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8567,7 +9743,7 @@ func (x *UpgradeRolloutSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpgradeRolloutSpec.ProtoReflect.Descriptor instead.
 func (*UpgradeRolloutSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{102}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{112}
 }
 
 func (x *UpgradeRolloutSpec) GetMachineSetsUpgradeQuota() map[string]int32 {
@@ -8577,6 +9753,20 @@ func (x *UpgradeRolloutSpec) GetMachineSetsUpgradeQuota() map[string]int32 {
 	return nil
 }
 
+func (x *UpgradeRolloutSpec) GetMachineSetsUpgradeCanary() map[string]*CanaryRolloutStatus {
+	if x != nil {
+		return x.MachineSetsUpgradeCanary
+	}
+	return nil
+}
+
+func (x *UpgradeRolloutSpec) GetMachineSetsUpdateCanary() map[string]*CanaryRolloutStatus {
+	if x != nil {
+		return x.MachineSetsUpdateCanary
+	}
+	return nil
+}
+
 // NotificationSpec describes a generic notification emitted by a controller.
 type NotificationSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -8589,7 +9779,7 @@ type NotificationSpec struct {
 
 func (x *NotificationSpec) Reset() {
 	*x = NotificationSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[103]
+	mi := &file_omni_specs_omni_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8601,7 +9791,7 @@ func (x *NotificationSpec) String() string {
 func (*NotificationSpec) ProtoMessage() {}
 
 func (x *NotificationSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[103]
+	mi := &file_omni_specs_omni_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8614,7 +9804,7 @@ func (x *NotificationSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotificationSpec.ProtoReflect.Descriptor instead.
 func (*NotificationSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{103}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{113}
 }
 
 func (x *NotificationSpec) GetTitle() string {
@@ -8638,33 +9828,34 @@ func (x *NotificationSpec) GetType() NotificationSpec_Type {
 	return NotificationSpec_INFO
 }
 
-// KubernetesManifestGroup is the collection (or a single) kubernetes manifests to be applied on the cluster.
-type KubernetesManifestGroupSpec struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Deprecated: use accessor methods GetUncompressedData/SetUncompressedData to manage this field.
-	CompressedData []byte `protobuf:"bytes,1,opt,name=compressed_data,json=compressedData,proto3" json:"compressed_data,omitempty"`
-	// Deprecated: use accessor methods GetUncompressedData/SetUncompressedData to manage this field.
-	Data          string                           `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	Mode          KubernetesManifestGroupSpec_Mode `protobuf:"varint,4,opt,name=mode,proto3,enum=specs.KubernetesManifestGroupSpec_Mode" json:"mode,omitempty"`
+// NotificationChannelSpec describes an external destination the notification events are delivered to.
+type NotificationChannelSpec struct {
+	state protoimpl.MessageState       `protogen:"open.v1"`
+	Type  NotificationChannelSpec_Type `protobuf:"varint,1,opt,name=type,proto3,enum=specs.NotificationChannelSpec_Type" json:"type,omitempty"`
+	// URL is the incoming webhook URL for Slack and Microsoft Teams, and the endpoint URL for the generic webhook.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Secret is the key the generic webhook requests are signed with, the requests are not signed if it is empty.
+	Secret        string                              `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	Smtp          *NotificationChannelSpec_SMTPConfig `protobuf:"bytes,4,opt,name=smtp,proto3" json:"smtp,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *KubernetesManifestGroupSpec) Reset() {
-	*x = KubernetesManifestGroupSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[104]
+func (x *NotificationChannelSpec) Reset() {
+	*x = NotificationChannelSpec{}
+	mi := &file_omni_specs_omni_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *KubernetesManifestGroupSpec) String() string {
+func (x *NotificationChannelSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KubernetesManifestGroupSpec) ProtoMessage() {}
+func (*NotificationChannelSpec) ProtoMessage() {}
 
-func (x *KubernetesManifestGroupSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[104]
+func (x *NotificationChannelSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8675,57 +9866,72 @@ func (x *KubernetesManifestGroupSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KubernetesManifestGroupSpec.ProtoReflect.Descriptor instead.
-func (*KubernetesManifestGroupSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{104}
+// Deprecated: Use NotificationChannelSpec.ProtoReflect.Descriptor instead.
+func (*NotificationChannelSpec) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *KubernetesManifestGroupSpec) GetCompressedData() []byte {
+func (x *NotificationChannelSpec) GetType() NotificationChannelSpec_Type {
 	if x != nil {
-		return x.CompressedData
+		return x.Type
 	}
-	return nil
+	return NotificationChannelSpec_UNKNOWN
 }
 
-func (x *KubernetesManifestGroupSpec) GetData() string {
+func (x *NotificationChannelSpec) GetUrl() string {
 	if x != nil {
-		return x.Data
+		return x.Url
 	}
 	return ""
 }
 
-func (x *KubernetesManifestGroupSpec) GetMode() KubernetesManifestGroupSpec_Mode {
+func (x *NotificationChannelSpec) GetSecret() string {
 	if x != nil {
-		return x.Mode
+		return x.Secret
 	}
-	return KubernetesManifestGroupSpec_UNKNOWN
+	return ""
 }
 
-type ClusterKubernetesManifestsStatusSpec struct {
-	state         protoimpl.MessageState                                       `protogen:"open.v1"`
-	Groups        map[string]*ClusterKubernetesManifestsStatusSpec_GroupStatus `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	OutOfSync     int32                                                        `protobuf:"varint,2,opt,name=out_of_sync,json=outOfSync,proto3" json:"out_of_sync,omitempty"`
-	Total         int32                                                        `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
-	LastError     string                                                       `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+func (x *NotificationChannelSpec) GetSmtp() *NotificationChannelSpec_SMTPConfig {
+	if x != nil {
+		return x.Smtp
+	}
+	return nil
+}
+
+// NotificationRuleSpec selects the events which are delivered to the notification channels.
+type NotificationRuleSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Channels are the IDs of the NotificationChannels the matching events are delivered to.
+	Channels []string `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	// Events are the types of the events the rule matches, all events are matched if empty.
+	Events []string `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	// ClusterSelector is the label selector of the clusters the rule matches.
+	// If set, the events which are not related to a cluster are not matched.
+	ClusterSelector string `protobuf:"bytes,3,opt,name=cluster_selector,json=clusterSelector,proto3" json:"cluster_selector,omitempty"`
+	// MinSeverity is the lowest severity of the matched events.
+	MinSeverity NotificationSpec_Type `protobuf:"varint,4,opt,name=min_severity,json=minSeverity,proto3,enum=specs.NotificationSpec_Type" json:"min_severity,omitempty"`
+	// Throttle is the minimum interval between the deliveries of the events of the same type about the same resource.
+	Throttle      *durationpb.Duration `protobuf:"bytes,5,opt,name=throttle,proto3" json:"throttle,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ClusterKubernetesManifestsStatusSpec) Reset() {
-	*x = ClusterKubernetesManifestsStatusSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[105]
+func (x *NotificationRuleSpec) Reset() {
+	*x = NotificationRuleSpec{}
+	mi := &file_omni_specs_omni_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClusterKubernetesManifestsStatusSpec) String() string {
+func (x *NotificationRuleSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClusterKubernetesManifestsStatusSpec) ProtoMessage() {}
+func (*NotificationRuleSpec) ProtoMessage() {}
 
-func (x *ClusterKubernetesManifestsStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[105]
+func (x *NotificationRuleSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8736,69 +9942,78 @@ func (x *ClusterKubernetesManifestsStatusSpec) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClusterKubernetesManifestsStatusSpec.ProtoReflect.Descriptor instead.
-func (*ClusterKubernetesManifestsStatusSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{105}
+// Deprecated: Use NotificationRuleSpec.ProtoReflect.Descriptor instead.
+func (*NotificationRuleSpec) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *ClusterKubernetesManifestsStatusSpec) GetGroups() map[string]*ClusterKubernetesManifestsStatusSpec_GroupStatus {
+func (x *NotificationRuleSpec) GetChannels() []string {
 	if x != nil {
-		return x.Groups
+		return x.Channels
 	}
 	return nil
 }
 
-func (x *ClusterKubernetesManifestsStatusSpec) GetOutOfSync() int32 {
+func (x *NotificationRuleSpec) GetEvents() []string {
 	if x != nil {
-		return x.OutOfSync
+		return x.Events
 	}
-	return 0
+	return nil
 }
 
-func (x *ClusterKubernetesManifestsStatusSpec) GetTotal() int32 {
+func (x *NotificationRuleSpec) GetClusterSelector() string {
 	if x != nil {
-		return x.Total
+		return x.ClusterSelector
 	}
-	return 0
+	return ""
 }
 
-func (x *ClusterKubernetesManifestsStatusSpec) GetLastError() string {
+func (x *NotificationRuleSpec) GetMinSeverity() NotificationSpec_Type {
 	if x != nil {
-		return x.LastError
+		return x.MinSeverity
 	}
-	return ""
+	return NotificationSpec_INFO
 }
 
-// HealthcheckSpec describes a Healthcheck.
-type KubernetesHealthCheckSpec struct {
+func (x *NotificationRuleSpec) GetThrottle() *durationpb.Duration {
+	if x != nil {
+		return x.Throttle
+	}
+	return nil
+}
+
+// NotificationChannelStatusSpec describes the delivery of the events to the notification channel.
+type NotificationChannelStatusSpec struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// job is the full Kubernetes Job manifest (YAML) Omni runs to perform the healthcheck. The job runs the
-	// check as a single container that terminates: exit code 0 means healthy, a failed job means unhealthy.
-	// The job manifest is responsible for its own namespace, service account and RBAC (e.g. provided via
-	// KubernetesManifests). Omni overrides the job's name and creates it fresh on every attempt.
-	Job string `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
-	// interval is how often Omni re-checks (re-creates the job and polls its status) while it is holding an
-	// upgrade. If not set, a default interval is used.
-	Interval      *durationpb.Duration `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Delivered is the number of the events delivered since Omni was started.
+	Delivered uint64 `protobuf:"varint,1,opt,name=delivered,proto3" json:"delivered,omitempty"`
+	// Failed is the number of the events which failed to be delivered after all retries since Omni was started.
+	Failed uint64 `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	// Pending is the number of the events waiting for the delivery or for a retry.
+	Pending      uint32                 `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`
+	LastDelivery *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_delivery,json=lastDelivery,proto3" json:"last_delivery,omitempty"`
+	LastFailure  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_failure,json=lastFailure,proto3" json:"last_failure,omitempty"`
+	// LastError is the error of the last failed delivery attempt.
+	LastError     string `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *KubernetesHealthCheckSpec) Reset() {
-	*x = KubernetesHealthCheckSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[106]
+func (x *NotificationChannelStatusSpec) Reset() {
+	*x = NotificationChannelStatusSpec{}
+	mi := &file_omni_specs_omni_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *KubernetesHealthCheckSpec) String() string {
+func (x *NotificationChannelStatusSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KubernetesHealthCheckSpec) ProtoMessage() {}
+func (*NotificationChannelStatusSpec) ProtoMessage() {}
 
-func (x *KubernetesHealthCheckSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[106]
+func (x *NotificationChannelStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8809,28 +10024,255 @@ func (x *KubernetesHealthCheckSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KubernetesHealthCheckSpec.ProtoReflect.Descriptor instead.
-func (*KubernetesHealthCheckSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{106}
+// Deprecated: Use NotificationChannelStatusSpec.ProtoReflect.Descriptor instead.
+func (*NotificationChannelStatusSpec) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *KubernetesHealthCheckSpec) GetJob() string {
+func (x *NotificationChannelStatusSpec) GetDelivered() uint64 {
 	if x != nil {
-		return x.Job
+		return x.Delivered
 	}
-	return ""
+	return 0
 }
 
-func (x *KubernetesHealthCheckSpec) GetInterval() *durationpb.Duration {
+func (x *NotificationChannelStatusSpec) GetFailed() uint64 {
 	if x != nil {
-		return x.Interval
+		return x.Failed
 	}
-	return nil
+	return 0
 }
 
-// KubernetesHealthCheckStatusSpec is the outcome of the last run of a KubernetesHealthCheck.
-//
-// The runner job is deleted as soon as it reaches a terminal state, so the failure output is captured here
+func (x *NotificationChannelStatusSpec) GetPending() uint32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *NotificationChannelStatusSpec) GetLastDelivery() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastDelivery
+	}
+	return nil
+}
+
+func (x *NotificationChannelStatusSpec) GetLastFailure() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastFailure
+	}
+	return nil
+}
+
+func (x *NotificationChannelStatusSpec) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+// KubernetesManifestGroup is the collection (or a single) kubernetes manifests to be applied on the cluster.
+type KubernetesManifestGroupSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: use accessor methods GetUncompressedData/SetUncompressedData to manage this field.
+	CompressedData []byte `protobuf:"bytes,1,opt,name=compressed_data,json=compressedData,proto3" json:"compressed_data,omitempty"`
+	// Deprecated: use accessor methods GetUncompressedData/SetUncompressedData to manage this field.
+	Data string                           `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Mode KubernetesManifestGroupSpec_Mode `protobuf:"varint,4,opt,name=mode,proto3,enum=specs.KubernetesManifestGroupSpec_Mode" json:"mode,omitempty"`
+	// Helm is set if the manifests are rendered from a Helm chart instead of being stored in the data.
+	Helm          *KubernetesManifestGroupSpec_HelmSource `protobuf:"bytes,5,opt,name=helm,proto3" json:"helm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KubernetesManifestGroupSpec) Reset() {
+	*x = KubernetesManifestGroupSpec{}
+	mi := &file_omni_specs_omni_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KubernetesManifestGroupSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KubernetesManifestGroupSpec) ProtoMessage() {}
+
+func (x *KubernetesManifestGroupSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KubernetesManifestGroupSpec.ProtoReflect.Descriptor instead.
+func (*KubernetesManifestGroupSpec) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *KubernetesManifestGroupSpec) GetCompressedData() []byte {
+	if x != nil {
+		return x.CompressedData
+	}
+	return nil
+}
+
+func (x *KubernetesManifestGroupSpec) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *KubernetesManifestGroupSpec) GetMode() KubernetesManifestGroupSpec_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return KubernetesManifestGroupSpec_UNKNOWN
+}
+
+func (x *KubernetesManifestGroupSpec) GetHelm() *KubernetesManifestGroupSpec_HelmSource {
+	if x != nil {
+		return x.Helm
+	}
+	return nil
+}
+
+type ClusterKubernetesManifestsStatusSpec struct {
+	state         protoimpl.MessageState                                       `protogen:"open.v1"`
+	Groups        map[string]*ClusterKubernetesManifestsStatusSpec_GroupStatus `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	OutOfSync     int32                                                        `protobuf:"varint,2,opt,name=out_of_sync,json=outOfSync,proto3" json:"out_of_sync,omitempty"`
+	Total         int32                                                        `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	LastError     string                                                       `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClusterKubernetesManifestsStatusSpec) Reset() {
+	*x = ClusterKubernetesManifestsStatusSpec{}
+	mi := &file_omni_specs_omni_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClusterKubernetesManifestsStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterKubernetesManifestsStatusSpec) ProtoMessage() {}
+
+func (x *ClusterKubernetesManifestsStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterKubernetesManifestsStatusSpec.ProtoReflect.Descriptor instead.
+func (*ClusterKubernetesManifestsStatusSpec) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ClusterKubernetesManifestsStatusSpec) GetGroups() map[string]*ClusterKubernetesManifestsStatusSpec_GroupStatus {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *ClusterKubernetesManifestsStatusSpec) GetOutOfSync() int32 {
+	if x != nil {
+		return x.OutOfSync
+	}
+	return 0
+}
+
+func (x *ClusterKubernetesManifestsStatusSpec) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ClusterKubernetesManifestsStatusSpec) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+// HealthcheckSpec describes a Healthcheck.
+type KubernetesHealthCheckSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// job is the full Kubernetes Job manifest (YAML) Omni runs to perform the healthcheck. The job runs the
+	// check as a single container that terminates: exit code 0 means healthy, a failed job means unhealthy.
+	// The job manifest is responsible for its own namespace, service account and RBAC (e.g. provided via
+	// KubernetesManifests). Omni overrides the job's name and creates it fresh on every attempt.
+	Job string `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	// interval is how often Omni re-checks (re-creates the job and polls its status) while it is holding an
+	// upgrade. If not set, a default interval is used.
+	Interval      *durationpb.Duration `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KubernetesHealthCheckSpec) Reset() {
+	*x = KubernetesHealthCheckSpec{}
+	mi := &file_omni_specs_omni_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KubernetesHealthCheckSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KubernetesHealthCheckSpec) ProtoMessage() {}
+
+func (x *KubernetesHealthCheckSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KubernetesHealthCheckSpec.ProtoReflect.Descriptor instead.
+func (*KubernetesHealthCheckSpec) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *KubernetesHealthCheckSpec) GetJob() string {
+	if x != nil {
+		return x.Job
+	}
+	return ""
+}
+
+func (x *KubernetesHealthCheckSpec) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+// KubernetesHealthCheckStatusSpec is the outcome of the last run of a KubernetesHealthCheck.
+//
+// The runner job is deleted as soon as it reaches a terminal state, so the failure output is captured here
 // while the job's pod still exists: it stays readable after the pod, the job and the upgrade itself are gone.
 type KubernetesHealthCheckStatusSpec struct {
 	state protoimpl.MessageState                `protogen:"open.v1"`
@@ -8850,7 +10292,7 @@ type KubernetesHealthCheckStatusSpec struct {
 
 func (x *KubernetesHealthCheckStatusSpec) Reset() {
 	*x = KubernetesHealthCheckStatusSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[107]
+	mi := &file_omni_specs_omni_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8862,7 +10304,7 @@ func (x *KubernetesHealthCheckStatusSpec) String() string {
 func (*KubernetesHealthCheckStatusSpec) ProtoMessage() {}
 
 func (x *KubernetesHealthCheckStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[107]
+	mi := &file_omni_specs_omni_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8875,7 +10317,7 @@ func (x *KubernetesHealthCheckStatusSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KubernetesHealthCheckStatusSpec.ProtoReflect.Descriptor instead.
 func (*KubernetesHealthCheckStatusSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{107}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{120}
 }
 
 func (x *KubernetesHealthCheckStatusSpec) GetState() KubernetesHealthCheckStatusSpec_State {
@@ -8926,7 +10368,7 @@ type MachineConfigExtractionStatusSpec struct {
 
 func (x *MachineConfigExtractionStatusSpec) Reset() {
 	*x = MachineConfigExtractionStatusSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[108]
+	mi := &file_omni_specs_omni_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8938,7 +10380,7 @@ func (x *MachineConfigExtractionStatusSpec) String() string {
 func (*MachineConfigExtractionStatusSpec) ProtoMessage() {}
 
 func (x *MachineConfigExtractionStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[108]
+	mi := &file_omni_specs_omni_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8951,7 +10393,7 @@ func (x *MachineConfigExtractionStatusSpec) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use MachineConfigExtractionStatusSpec.ProtoReflect.Descriptor instead.
 func (*MachineConfigExtractionStatusSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{108}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{121}
 }
 
 func (x *MachineConfigExtractionStatusSpec) GetInitialized() bool {
@@ -8978,7 +10420,7 @@ type ImageFactoryAuthSpec struct {
 
 func (x *ImageFactoryAuthSpec) Reset() {
 	*x = ImageFactoryAuthSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[109]
+	mi := &file_omni_specs_omni_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8990,7 +10432,7 @@ func (x *ImageFactoryAuthSpec) String() string {
 func (*ImageFactoryAuthSpec) ProtoMessage() {}
 
 func (x *ImageFactoryAuthSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[109]
+	mi := &file_omni_specs_omni_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9003,7 +10445,7 @@ func (x *ImageFactoryAuthSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImageFactoryAuthSpec.ProtoReflect.Descriptor instead.
 func (*ImageFactoryAuthSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{109}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{122}
 }
 
 func (x *ImageFactoryAuthSpec) GetUsername() string {
@@ -9034,7 +10476,7 @@ type MachineInstallDiskConfigSpec struct {
 
 func (x *MachineInstallDiskConfigSpec) Reset() {
 	*x = MachineInstallDiskConfigSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[110]
+	mi := &file_omni_specs_omni_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9046,7 +10488,7 @@ func (x *MachineInstallDiskConfigSpec) String() string {
 func (*MachineInstallDiskConfigSpec) ProtoMessage() {}
 
 func (x *MachineInstallDiskConfigSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[110]
+	mi := &file_omni_specs_omni_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9059,62 +10501,466 @@ func (x *MachineInstallDiskConfigSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachineInstallDiskConfigSpec.ProtoReflect.Descriptor instead.
 func (*MachineInstallDiskConfigSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{110}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *MachineInstallDiskConfigSpec) GetDiskSelector() string {
+	if x != nil {
+		return x.DiskSelector
+	}
+	return ""
+}
+
+func (x *MachineInstallDiskConfigSpec) GetDisk() string {
+	if x != nil {
+		return x.Disk
+	}
+	return ""
+}
+
+// MachineInstallDiskStatusSpec describes the resolved install disk of a machine.
+type MachineInstallDiskStatusSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Disk is the resolved install disk dev path. It is empty while unresolved.
+	//
+	// The install disk resolution is computed asynchronously from the selection, so it can be stale.
+	// Because of this, the only backend consumer, ClusterMachineConfigController, reads it
+	// through installdisk.GetResolved, which detects the staleness. Everything downstream
+	// uses the disk recorded on ClusterMachineConfig instead.
+	Disk string `protobuf:"bytes,1,opt,name=disk,proto3" json:"disk,omitempty"`
+	// Message explains why Disk is empty, or carries extra information about the resolved disk.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// SelectionHash is the hash of the MachineInstallDiskConfig fields this install disk
+	// resolution was computed from. It is empty when there was no selection. Comparing it
+	// against the hash of the current selection tells whether the resolution is stale.
+	SelectionHash string `protobuf:"bytes,4,opt,name=selection_hash,json=selectionHash,proto3" json:"selection_hash,omitempty"`
+	// Disks lists every disk of the machine with its install disk eligibility. The candidates
+	// come first in the automatic preference order, then the disks only an explicit selection
+	// can target, then the rest.
+	Disks         []*MachineInstallDiskStatusSpec_Disk `protobuf:"bytes,2,rep,name=disks,proto3" json:"disks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MachineInstallDiskStatusSpec) Reset() {
+	*x = MachineInstallDiskStatusSpec{}
+	mi := &file_omni_specs_omni_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MachineInstallDiskStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MachineInstallDiskStatusSpec) ProtoMessage() {}
+
+func (x *MachineInstallDiskStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MachineInstallDiskStatusSpec.ProtoReflect.Descriptor instead.
+func (*MachineInstallDiskStatusSpec) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *MachineInstallDiskStatusSpec) GetDisk() string {
+	if x != nil {
+		return x.Disk
+	}
+	return ""
+}
+
+func (x *MachineInstallDiskStatusSpec) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MachineInstallDiskStatusSpec) GetSelectionHash() string {
+	if x != nil {
+		return x.SelectionHash
+	}
+	return ""
+}
+
+func (x *MachineInstallDiskStatusSpec) GetDisks() []*MachineInstallDiskStatusSpec_Disk {
+	if x != nil {
+		return x.Disks
+	}
+	return nil
+}
+
+// ClusterTemplateSpec describes a cluster template reconciled by Omni into the cluster resources.
+type ClusterTemplateSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Template is the cluster template document, in the same format as used by `omnictl cluster template sync`.
+	//
+	// The templates which reference files (patches, manifests, overlay bases) are not supported.
+	Template string `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	// Values are the values of the template variables.
+	//
+	// The values of the variables of type string are used as is, the other values are parsed as YAML.
+	Values        map[string]string `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClusterTemplateSpec) Reset() {
+	*x = ClusterTemplateSpec{}
+	mi := &file_omni_specs_omni_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClusterTemplateSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterTemplateSpec) ProtoMessage() {}
+
+func (x *ClusterTemplateSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterTemplateSpec.ProtoReflect.Descriptor instead.
+func (*ClusterTemplateSpec) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *ClusterTemplateSpec) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *ClusterTemplateSpec) GetValues() map[string]string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// ClusterTemplateStatusSpec describes the reconciliation of the cluster template.
+type ClusterTemplateStatusSpec struct {
+	state protoimpl.MessageState          `protogen:"open.v1"`
+	Phase ClusterTemplateStatusSpec_Phase `protobuf:"varint,1,opt,name=phase,proto3,enum=specs.ClusterTemplateStatusSpec_Phase" json:"phase,omitempty"`
+	// Error is the error of the last reconciliation.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// Cluster is the name of the cluster created from the template.
+	Cluster string `protobuf:"bytes,3,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// TemplateVersion is the version of the cluster template the last successful reconciliation was done for.
+	TemplateVersion string `protobuf:"bytes,4,opt,name=template_version,json=templateVersion,proto3" json:"template_version,omitempty"`
+	// Resources is the number of the resources created from the template.
+	Resources uint32 `protobuf:"varint,5,opt,name=resources,proto3" json:"resources,omitempty"`
+	// DriftedResources are the resources which were changed outside of the template, and were reverted by the last reconciliation.
+	DriftedResources []string               `protobuf:"bytes,6,rep,name=drifted_resources,json=driftedResources,proto3" json:"drifted_resources,omitempty"`
+	LastDrift        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_drift,json=lastDrift,proto3" json:"last_drift,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ClusterTemplateStatusSpec) Reset() {
+	*x = ClusterTemplateStatusSpec{}
+	mi := &file_omni_specs_omni_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClusterTemplateStatusSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterTemplateStatusSpec) ProtoMessage() {}
+
+func (x *ClusterTemplateStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterTemplateStatusSpec.ProtoReflect.Descriptor instead.
+func (*ClusterTemplateStatusSpec) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *ClusterTemplateStatusSpec) GetPhase() ClusterTemplateStatusSpec_Phase {
+	if x != nil {
+		return x.Phase
+	}
+	return ClusterTemplateStatusSpec_UNKNOWN
+}
+
+func (x *ClusterTemplateStatusSpec) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ClusterTemplateStatusSpec) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *ClusterTemplateStatusSpec) GetTemplateVersion() string {
+	if x != nil {
+		return x.TemplateVersion
+	}
+	return ""
+}
+
+func (x *ClusterTemplateStatusSpec) GetResources() uint32 {
+	if x != nil {
+		return x.Resources
+	}
+	return 0
+}
+
+func (x *ClusterTemplateStatusSpec) GetDriftedResources() []string {
+	if x != nil {
+		return x.DriftedResources
+	}
+	return nil
+}
+
+func (x *ClusterTemplateStatusSpec) GetLastDrift() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastDrift
+	}
+	return nil
+}
+
+// GitRepositorySpec describes a Git repository which Omni watches and syncs into the resources.
+type GitRepositorySpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// URL is the clone URL of the repository, either HTTP(S), SSH (`ssh://` or `git@host:path`) or a local `file://` path.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Branch is the branch to sync, the default branch of the repository is used if not set.
+	Branch string `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	// Path is the directory in the repository which contains the resources, the root of the repository is used if not set.
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// Credentials is the ID of the GitCredentials resource used to access the repository.
+	Credentials string `protobuf:"bytes,4,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	// PollInterval is the interval between the checks for the new commits.
+	PollInterval *durationpb.Duration `protobuf:"bytes,5,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"`
+	// Suspend stops syncing the repository, the resources which were synced are left as is.
+	Suspend bool `protobuf:"varint,6,opt,name=suspend,proto3" json:"suspend,omitempty"`
+	// Prune destroys the resources which were removed from the repository, and all synced resources when the repository is deleted.
+	Prune         bool `protobuf:"varint,7,opt,name=prune,proto3" json:"prune,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GitRepositorySpec) Reset() {
+	*x = GitRepositorySpec{}
+	mi := &file_omni_specs_omni_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitRepositorySpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitRepositorySpec) ProtoMessage() {}
+
+func (x *GitRepositorySpec) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitRepositorySpec.ProtoReflect.Descriptor instead.
+func (*GitRepositorySpec) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *GitRepositorySpec) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GitRepositorySpec) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *GitRepositorySpec) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GitRepositorySpec) GetCredentials() string {
+	if x != nil {
+		return x.Credentials
+	}
+	return ""
+}
+
+func (x *GitRepositorySpec) GetPollInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PollInterval
+	}
+	return nil
+}
+
+func (x *GitRepositorySpec) GetSuspend() bool {
+	if x != nil {
+		return x.Suspend
+	}
+	return false
+}
+
+func (x *GitRepositorySpec) GetPrune() bool {
+	if x != nil {
+		return x.Prune
+	}
+	return false
+}
+
+// GitCredentialsSpec keeps the secrets used to access the Git repositories.
+type GitCredentialsSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Username and password (or access token) are used for HTTP(S) repositories.
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// SSHPrivateKey is the PEM encoded private key used for SSH repositories.
+	SshPrivateKey string `protobuf:"bytes,3,opt,name=ssh_private_key,json=sshPrivateKey,proto3" json:"ssh_private_key,omitempty"`
+	// SSHKnownHosts are the known_hosts entries the SSH host keys are verified against.
+	SshKnownHosts string `protobuf:"bytes,4,opt,name=ssh_known_hosts,json=sshKnownHosts,proto3" json:"ssh_known_hosts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GitCredentialsSpec) Reset() {
+	*x = GitCredentialsSpec{}
+	mi := &file_omni_specs_omni_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitCredentialsSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitCredentialsSpec) ProtoMessage() {}
+
+func (x *GitCredentialsSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitCredentialsSpec.ProtoReflect.Descriptor instead.
+func (*GitCredentialsSpec) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *GitCredentialsSpec) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GitCredentialsSpec) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
 }
 
-func (x *MachineInstallDiskConfigSpec) GetDiskSelector() string {
+func (x *GitCredentialsSpec) GetSshPrivateKey() string {
 	if x != nil {
-		return x.DiskSelector
+		return x.SshPrivateKey
 	}
 	return ""
 }
 
-func (x *MachineInstallDiskConfigSpec) GetDisk() string {
+func (x *GitCredentialsSpec) GetSshKnownHosts() string {
 	if x != nil {
-		return x.Disk
+		return x.SshKnownHosts
 	}
 	return ""
 }
 
-// MachineInstallDiskStatusSpec describes the resolved install disk of a machine.
-type MachineInstallDiskStatusSpec struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Disk is the resolved install disk dev path. It is empty while unresolved.
-	//
-	// The install disk resolution is computed asynchronously from the selection, so it can be stale.
-	// Because of this, the only backend consumer, ClusterMachineConfigController, reads it
-	// through installdisk.GetResolved, which detects the staleness. Everything downstream
-	// uses the disk recorded on ClusterMachineConfig instead.
-	Disk string `protobuf:"bytes,1,opt,name=disk,proto3" json:"disk,omitempty"`
-	// Message explains why Disk is empty, or carries extra information about the resolved disk.
-	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	// SelectionHash is the hash of the MachineInstallDiskConfig fields this install disk
-	// resolution was computed from. It is empty when there was no selection. Comparing it
-	// against the hash of the current selection tells whether the resolution is stale.
-	SelectionHash string `protobuf:"bytes,4,opt,name=selection_hash,json=selectionHash,proto3" json:"selection_hash,omitempty"`
-	// Disks lists every disk of the machine with its install disk eligibility. The candidates
-	// come first in the automatic preference order, then the disks only an explicit selection
-	// can target, then the rest.
-	Disks         []*MachineInstallDiskStatusSpec_Disk `protobuf:"bytes,2,rep,name=disks,proto3" json:"disks,omitempty"`
+// GitRepositoryStatusSpec describes the sync of the Git repository.
+type GitRepositoryStatusSpec struct {
+	state protoimpl.MessageState        `protogen:"open.v1"`
+	Phase GitRepositoryStatusSpec_Phase `protobuf:"varint,1,opt,name=phase,proto3,enum=specs.GitRepositoryStatusSpec_Phase" json:"phase,omitempty"`
+	// Error is the error of the last sync.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// Commit is the last synced commit.
+	Commit string `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`
+	// Commits are the sync results of the recent commits, the newest first.
+	Commits []*GitRepositoryStatusSpec_CommitStatus `protobuf:"bytes,4,rep,name=commits,proto3" json:"commits,omitempty"`
+	// Resources are the resources created from the repository, they are pruned when removed from the repository.
+	Resources     []*GitRepositoryStatusSpec_ManagedResource `protobuf:"bytes,5,rep,name=resources,proto3" json:"resources,omitempty"`
+	LastFetch     *timestamppb.Timestamp                     `protobuf:"bytes,6,opt,name=last_fetch,json=lastFetch,proto3" json:"last_fetch,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MachineInstallDiskStatusSpec) Reset() {
-	*x = MachineInstallDiskStatusSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[111]
+func (x *GitRepositoryStatusSpec) Reset() {
+	*x = GitRepositoryStatusSpec{}
+	mi := &file_omni_specs_omni_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MachineInstallDiskStatusSpec) String() string {
+func (x *GitRepositoryStatusSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MachineInstallDiskStatusSpec) ProtoMessage() {}
+func (*GitRepositoryStatusSpec) ProtoMessage() {}
 
-func (x *MachineInstallDiskStatusSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[111]
+func (x *GitRepositoryStatusSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9125,35 +10971,49 @@ func (x *MachineInstallDiskStatusSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MachineInstallDiskStatusSpec.ProtoReflect.Descriptor instead.
-func (*MachineInstallDiskStatusSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{111}
+// Deprecated: Use GitRepositoryStatusSpec.ProtoReflect.Descriptor instead.
+func (*GitRepositoryStatusSpec) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *MachineInstallDiskStatusSpec) GetDisk() string {
+func (x *GitRepositoryStatusSpec) GetPhase() GitRepositoryStatusSpec_Phase {
 	if x != nil {
-		return x.Disk
+		return x.Phase
 	}
-	return ""
+	return GitRepositoryStatusSpec_UNKNOWN
 }
 
-func (x *MachineInstallDiskStatusSpec) GetMessage() string {
+func (x *GitRepositoryStatusSpec) GetError() string {
 	if x != nil {
-		return x.Message
+		return x.Error
 	}
 	return ""
 }
 
-func (x *MachineInstallDiskStatusSpec) GetSelectionHash() string {
+func (x *GitRepositoryStatusSpec) GetCommit() string {
 	if x != nil {
-		return x.SelectionHash
+		return x.Commit
 	}
 	return ""
 }
 
-func (x *MachineInstallDiskStatusSpec) GetDisks() []*MachineInstallDiskStatusSpec_Disk {
+func (x *GitRepositoryStatusSpec) GetCommits() []*GitRepositoryStatusSpec_CommitStatus {
 	if x != nil {
-		return x.Disks
+		return x.Commits
+	}
+	return nil
+}
+
+func (x *GitRepositoryStatusSpec) GetResources() []*GitRepositoryStatusSpec_ManagedResource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *GitRepositoryStatusSpec) GetLastFetch() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastFetch
 	}
 	return nil
 }
@@ -9175,7 +11035,7 @@ type MachineStatusSpec_HardwareStatus struct {
 
 func (x *MachineStatusSpec_HardwareStatus) Reset() {
 	*x = MachineStatusSpec_HardwareStatus{}
-	mi := &file_omni_specs_omni_proto_msgTypes[112]
+	mi := &file_omni_specs_omni_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9187,7 +11047,7 @@ func (x *MachineStatusSpec_HardwareStatus) String() string {
 func (*MachineStatusSpec_HardwareStatus) ProtoMessage() {}
 
 func (x *MachineStatusSpec_HardwareStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[112]
+	mi := &file_omni_specs_omni_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9250,7 +11110,7 @@ type MachineStatusSpec_NetworkStatus struct {
 
 func (x *MachineStatusSpec_NetworkStatus) Reset() {
 	*x = MachineStatusSpec_NetworkStatus{}
-	mi := &file_omni_specs_omni_proto_msgTypes[113]
+	mi := &file_omni_specs_omni_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9262,7 +11122,7 @@ func (x *MachineStatusSpec_NetworkStatus) String() string {
 func (*MachineStatusSpec_NetworkStatus) ProtoMessage() {}
 
 func (x *MachineStatusSpec_NetworkStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[113]
+	mi := &file_omni_specs_omni_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9340,7 +11200,7 @@ type MachineStatusSpec_PlatformMetadata struct {
 
 func (x *MachineStatusSpec_PlatformMetadata) Reset() {
 	*x = MachineStatusSpec_PlatformMetadata{}
-	mi := &file_omni_specs_omni_proto_msgTypes[114]
+	mi := &file_omni_specs_omni_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9352,7 +11212,7 @@ func (x *MachineStatusSpec_PlatformMetadata) String() string {
 func (*MachineStatusSpec_PlatformMetadata) ProtoMessage() {}
 
 func (x *MachineStatusSpec_PlatformMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[114]
+	mi := &file_omni_specs_omni_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9457,7 +11317,7 @@ type MachineStatusSpec_Schematic struct {
 
 func (x *MachineStatusSpec_Schematic) Reset() {
 	*x = MachineStatusSpec_Schematic{}
-	mi := &file_omni_specs_omni_proto_msgTypes[115]
+	mi := &file_omni_specs_omni_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9469,7 +11329,7 @@ func (x *MachineStatusSpec_Schematic) String() string {
 func (*MachineStatusSpec_Schematic) ProtoMessage() {}
 
 func (x *MachineStatusSpec_Schematic) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[115]
+	mi := &file_omni_specs_omni_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9552,7 +11412,7 @@ type MachineStatusSpec_Diagnostic struct {
 
 func (x *MachineStatusSpec_Diagnostic) Reset() {
 	*x = MachineStatusSpec_Diagnostic{}
-	mi := &file_omni_specs_omni_proto_msgTypes[116]
+	mi := &file_omni_specs_omni_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9564,7 +11424,7 @@ func (x *MachineStatusSpec_Diagnostic) String() string {
 func (*MachineStatusSpec_Diagnostic) ProtoMessage() {}
 
 func (x *MachineStatusSpec_Diagnostic) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[116]
+	mi := &file_omni_specs_omni_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9620,7 +11480,7 @@ type MachineStatusSpec_HardwareStatus_Processor struct {
 
 func (x *MachineStatusSpec_HardwareStatus_Processor) Reset() {
 	*x = MachineStatusSpec_HardwareStatus_Processor{}
-	mi := &file_omni_specs_omni_proto_msgTypes[118]
+	mi := &file_omni_specs_omni_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9632,7 +11492,7 @@ func (x *MachineStatusSpec_HardwareStatus_Processor) String() string {
 func (*MachineStatusSpec_HardwareStatus_Processor) ProtoMessage() {}
 
 func (x *MachineStatusSpec_HardwareStatus_Processor) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[118]
+	mi := &file_omni_specs_omni_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9696,7 +11556,7 @@ type MachineStatusSpec_HardwareStatus_MemoryModule struct {
 
 func (x *MachineStatusSpec_HardwareStatus_MemoryModule) Reset() {
 	*x = MachineStatusSpec_HardwareStatus_MemoryModule{}
-	mi := &file_omni_specs_omni_proto_msgTypes[119]
+	mi := &file_omni_specs_omni_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9708,7 +11568,7 @@ func (x *MachineStatusSpec_HardwareStatus_MemoryModule) String() string {
 func (*MachineStatusSpec_HardwareStatus_MemoryModule) ProtoMessage() {}
 
 func (x *MachineStatusSpec_HardwareStatus_MemoryModule) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[119]
+	mi := &file_omni_specs_omni_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9793,7 +11653,7 @@ type MachineStatusSpec_HardwareStatus_BlockDevice struct {
 
 func (x *MachineStatusSpec_HardwareStatus_BlockDevice) Reset() {
 	*x = MachineStatusSpec_HardwareStatus_BlockDevice{}
-	mi := &file_omni_specs_omni_proto_msgTypes[120]
+	mi := &file_omni_specs_omni_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9805,7 +11665,7 @@ func (x *MachineStatusSpec_HardwareStatus_BlockDevice) String() string {
 func (*MachineStatusSpec_HardwareStatus_BlockDevice) ProtoMessage() {}
 
 func (x *MachineStatusSpec_HardwareStatus_BlockDevice) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[120]
+	mi := &file_omni_specs_omni_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10001,7 +11861,7 @@ type MachineStatusSpec_NetworkStatus_NetworkLinkStatus struct {
 
 func (x *MachineStatusSpec_NetworkStatus_NetworkLinkStatus) Reset() {
 	*x = MachineStatusSpec_NetworkStatus_NetworkLinkStatus{}
-	mi := &file_omni_specs_omni_proto_msgTypes[121]
+	mi := &file_omni_specs_omni_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10013,7 +11873,7 @@ func (x *MachineStatusSpec_NetworkStatus_NetworkLinkStatus) String() string {
 func (*MachineStatusSpec_NetworkStatus_NetworkLinkStatus) ProtoMessage() {}
 
 func (x *MachineStatusSpec_NetworkStatus_NetworkLinkStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[121]
+	mi := &file_omni_specs_omni_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10073,7 +11933,7 @@ type MachineStatusSpec_Schematic_InitialState struct {
 
 func (x *MachineStatusSpec_Schematic_InitialState) Reset() {
 	*x = MachineStatusSpec_Schematic_InitialState{}
-	mi := &file_omni_specs_omni_proto_msgTypes[123]
+	mi := &file_omni_specs_omni_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10085,7 +11945,7 @@ func (x *MachineStatusSpec_Schematic_InitialState) String() string {
 func (*MachineStatusSpec_Schematic_InitialState) ProtoMessage() {}
 
 func (x *MachineStatusSpec_Schematic_InitialState) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[123]
+	mi := &file_omni_specs_omni_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10131,7 +11991,7 @@ type ClusterSpec_Features struct {
 
 func (x *ClusterSpec_Features) Reset() {
 	*x = ClusterSpec_Features{}
-	mi := &file_omni_specs_omni_proto_msgTypes[124]
+	mi := &file_omni_specs_omni_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10143,7 +12003,7 @@ func (x *ClusterSpec_Features) String() string {
 func (*ClusterSpec_Features) ProtoMessage() {}
 
 func (x *ClusterSpec_Features) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[124]
+	mi := &file_omni_specs_omni_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10173,25 +12033,245 @@ func (x *ClusterSpec_Features) GetDiskEncryption() bool {
 	return false
 }
 
-func (x *ClusterSpec_Features) GetUseEmbeddedDiscoveryService() bool {
+func (x *ClusterSpec_Features) GetUseEmbeddedDiscoveryService() bool {
+	if x != nil {
+		return x.UseEmbeddedDiscoveryService
+	}
+	return false
+}
+
+func (x *ClusterSpec_Features) GetEnableNodeAuditSkip() bool {
+	if x != nil {
+		return x.EnableNodeAuditSkip
+	}
+	return false
+}
+
+func (x *ClusterSpec_Features) GetDisablePublicDiscoveryService() bool {
+	if x != nil {
+		return x.DisablePublicDiscoveryService
+	}
+	return false
+}
+
+type EtcdBackupStoreConfigSpec_GCSConfig struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Bucket string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// CredentialsJson is the service account key, the application default credentials are used if it's empty.
+	CredentialsJson string `protobuf:"bytes,2,opt,name=credentials_json,json=credentialsJson,proto3" json:"credentials_json,omitempty"`
+	// Endpoint overrides the storage API endpoint, e.g. to use fake-gcs-server.
+	Endpoint      string `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EtcdBackupStoreConfigSpec_GCSConfig) Reset() {
+	*x = EtcdBackupStoreConfigSpec_GCSConfig{}
+	mi := &file_omni_specs_omni_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EtcdBackupStoreConfigSpec_GCSConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EtcdBackupStoreConfigSpec_GCSConfig) ProtoMessage() {}
+
+func (x *EtcdBackupStoreConfigSpec_GCSConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EtcdBackupStoreConfigSpec_GCSConfig.ProtoReflect.Descriptor instead.
+func (*EtcdBackupStoreConfigSpec_GCSConfig) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{17, 0}
+}
+
+func (x *EtcdBackupStoreConfigSpec_GCSConfig) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *EtcdBackupStoreConfigSpec_GCSConfig) GetCredentialsJson() string {
+	if x != nil {
+		return x.CredentialsJson
+	}
+	return ""
+}
+
+func (x *EtcdBackupStoreConfigSpec_GCSConfig) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+type EtcdBackupStoreConfigSpec_AzureBlobConfig struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AccountName string                 `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// AccountKey is the shared key of the storage account, the default Azure credentials are used if it's empty.
+	AccountKey string `protobuf:"bytes,2,opt,name=account_key,json=accountKey,proto3" json:"account_key,omitempty"`
+	Container  string `protobuf:"bytes,3,opt,name=container,proto3" json:"container,omitempty"`
+	// Endpoint overrides the blob service URL, e.g. to use Azurite.
+	Endpoint      string `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EtcdBackupStoreConfigSpec_AzureBlobConfig) Reset() {
+	*x = EtcdBackupStoreConfigSpec_AzureBlobConfig{}
+	mi := &file_omni_specs_omni_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EtcdBackupStoreConfigSpec_AzureBlobConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EtcdBackupStoreConfigSpec_AzureBlobConfig) ProtoMessage() {}
+
+func (x *EtcdBackupStoreConfigSpec_AzureBlobConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EtcdBackupStoreConfigSpec_AzureBlobConfig.ProtoReflect.Descriptor instead.
+func (*EtcdBackupStoreConfigSpec_AzureBlobConfig) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{17, 1}
+}
+
+func (x *EtcdBackupStoreConfigSpec_AzureBlobConfig) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *EtcdBackupStoreConfigSpec_AzureBlobConfig) GetAccountKey() string {
+	if x != nil {
+		return x.AccountKey
+	}
+	return ""
+}
+
+func (x *EtcdBackupStoreConfigSpec_AzureBlobConfig) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+func (x *EtcdBackupStoreConfigSpec_AzureBlobConfig) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+type EtcdBackupStoreConfigSpec_SFTPConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Address is the host:port of the SFTP server.
+	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	User     string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// PrivateKey is the PEM encoded private key used for the public key authentication.
+	PrivateKey string `protobuf:"bytes,4,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	// HostKey is the public key of the server in the authorized_keys format.
+	HostKey string `protobuf:"bytes,5,opt,name=host_key,json=hostKey,proto3" json:"host_key,omitempty"`
+	// Path is the directory the backups are stored in.
+	Path          string `protobuf:"bytes,6,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EtcdBackupStoreConfigSpec_SFTPConfig) Reset() {
+	*x = EtcdBackupStoreConfigSpec_SFTPConfig{}
+	mi := &file_omni_specs_omni_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EtcdBackupStoreConfigSpec_SFTPConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EtcdBackupStoreConfigSpec_SFTPConfig) ProtoMessage() {}
+
+func (x *EtcdBackupStoreConfigSpec_SFTPConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EtcdBackupStoreConfigSpec_SFTPConfig.ProtoReflect.Descriptor instead.
+func (*EtcdBackupStoreConfigSpec_SFTPConfig) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{17, 2}
+}
+
+func (x *EtcdBackupStoreConfigSpec_SFTPConfig) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *EtcdBackupStoreConfigSpec_SFTPConfig) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *EtcdBackupStoreConfigSpec_SFTPConfig) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *EtcdBackupStoreConfigSpec_SFTPConfig) GetPrivateKey() string {
 	if x != nil {
-		return x.UseEmbeddedDiscoveryService
+		return x.PrivateKey
 	}
-	return false
+	return ""
 }
 
-func (x *ClusterSpec_Features) GetEnableNodeAuditSkip() bool {
+func (x *EtcdBackupStoreConfigSpec_SFTPConfig) GetHostKey() string {
 	if x != nil {
-		return x.EnableNodeAuditSkip
+		return x.HostKey
 	}
-	return false
+	return ""
 }
 
-func (x *ClusterSpec_Features) GetDisablePublicDiscoveryService() bool {
+func (x *EtcdBackupStoreConfigSpec_SFTPConfig) GetPath() string {
 	if x != nil {
-		return x.DisablePublicDiscoveryService
+		return x.Path
 	}
-	return false
+	return ""
 }
 
 type ClusterMachineStatusSpec_ProvisionStatus struct {
@@ -10204,7 +12284,7 @@ type ClusterMachineStatusSpec_ProvisionStatus struct {
 
 func (x *ClusterMachineStatusSpec_ProvisionStatus) Reset() {
 	*x = ClusterMachineStatusSpec_ProvisionStatus{}
-	mi := &file_omni_specs_omni_proto_msgTypes[125]
+	mi := &file_omni_specs_omni_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10216,7 +12296,7 @@ func (x *ClusterMachineStatusSpec_ProvisionStatus) String() string {
 func (*ClusterMachineStatusSpec_ProvisionStatus) ProtoMessage() {}
 
 func (x *ClusterMachineStatusSpec_ProvisionStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[125]
+	mi := &file_omni_specs_omni_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10229,7 +12309,7 @@ func (x *ClusterMachineStatusSpec_ProvisionStatus) ProtoReflect() protoreflect.M
 
 // Deprecated: Use ClusterMachineStatusSpec_ProvisionStatus.ProtoReflect.Descriptor instead.
 func (*ClusterMachineStatusSpec_ProvisionStatus) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{24, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{28, 0}
 }
 
 func (x *ClusterMachineStatusSpec_ProvisionStatus) GetProviderId() string {
@@ -10258,7 +12338,7 @@ type MachinePendingUpdatesSpec_Upgrade struct {
 
 func (x *MachinePendingUpdatesSpec_Upgrade) Reset() {
 	*x = MachinePendingUpdatesSpec_Upgrade{}
-	mi := &file_omni_specs_omni_proto_msgTypes[126]
+	mi := &file_omni_specs_omni_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10270,7 +12350,7 @@ func (x *MachinePendingUpdatesSpec_Upgrade) String() string {
 func (*MachinePendingUpdatesSpec_Upgrade) ProtoMessage() {}
 
 func (x *MachinePendingUpdatesSpec_Upgrade) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[126]
+	mi := &file_omni_specs_omni_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10283,7 +12363,7 @@ func (x *MachinePendingUpdatesSpec_Upgrade) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use MachinePendingUpdatesSpec_Upgrade.ProtoReflect.Descriptor instead.
 func (*MachinePendingUpdatesSpec_Upgrade) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{30, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{34, 0}
 }
 
 func (x *MachinePendingUpdatesSpec_Upgrade) GetFromSchematic() string {
@@ -10319,14 +12399,20 @@ type ClusterSecretsSpec_Certs struct {
 	// Os holds the cluster OS CA.
 	Os *ClusterSecretsSpec_Certs_CA `protobuf:"bytes,1,opt,name=os,proto3" json:"os,omitempty"`
 	// K8s holds the cluster Kubernetes CA.
-	K8S           *ClusterSecretsSpec_Certs_CA `protobuf:"bytes,2,opt,name=k8s,proto3" json:"k8s,omitempty"`
+	K8S *ClusterSecretsSpec_Certs_CA `protobuf:"bytes,2,opt,name=k8s,proto3" json:"k8s,omitempty"`
+	// Etcd holds the cluster etcd CA.
+	Etcd *ClusterSecretsSpec_Certs_CA `protobuf:"bytes,3,opt,name=etcd,proto3" json:"etcd,omitempty"`
+	// K8s_aggregator holds the cluster Kubernetes front-proxy (aggregator) CA.
+	K8SAggregator *ClusterSecretsSpec_Certs_CA `protobuf:"bytes,4,opt,name=k8s_aggregator,json=k8sAggregator,proto3" json:"k8s_aggregator,omitempty"`
+	// K8s_sa holds the cluster Kubernetes service account signing key, the certificate is not set.
+	K8SSa         *ClusterSecretsSpec_Certs_CA `protobuf:"bytes,5,opt,name=k8s_sa,json=k8sSa,proto3" json:"k8s_sa,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ClusterSecretsSpec_Certs) Reset() {
 	*x = ClusterSecretsSpec_Certs{}
-	mi := &file_omni_specs_omni_proto_msgTypes[127]
+	mi := &file_omni_specs_omni_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10338,7 +12424,7 @@ func (x *ClusterSecretsSpec_Certs) String() string {
 func (*ClusterSecretsSpec_Certs) ProtoMessage() {}
 
 func (x *ClusterSecretsSpec_Certs) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[127]
+	mi := &file_omni_specs_omni_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10351,7 +12437,7 @@ func (x *ClusterSecretsSpec_Certs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterSecretsSpec_Certs.ProtoReflect.Descriptor instead.
 func (*ClusterSecretsSpec_Certs) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{32, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{36, 0}
 }
 
 func (x *ClusterSecretsSpec_Certs) GetOs() *ClusterSecretsSpec_Certs_CA {
@@ -10368,6 +12454,27 @@ func (x *ClusterSecretsSpec_Certs) GetK8S() *ClusterSecretsSpec_Certs_CA {
 	return nil
 }
 
+func (x *ClusterSecretsSpec_Certs) GetEtcd() *ClusterSecretsSpec_Certs_CA {
+	if x != nil {
+		return x.Etcd
+	}
+	return nil
+}
+
+func (x *ClusterSecretsSpec_Certs) GetK8SAggregator() *ClusterSecretsSpec_Certs_CA {
+	if x != nil {
+		return x.K8SAggregator
+	}
+	return nil
+}
+
+func (x *ClusterSecretsSpec_Certs) GetK8SSa() *ClusterSecretsSpec_Certs_CA {
+	if x != nil {
+		return x.K8SSa
+	}
+	return nil
+}
+
 type ClusterSecretsSpec_Certs_CA struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Crt           []byte                 `protobuf:"bytes,1,opt,name=crt,proto3" json:"crt,omitempty"`
@@ -10378,7 +12485,7 @@ type ClusterSecretsSpec_Certs_CA struct {
 
 func (x *ClusterSecretsSpec_Certs_CA) Reset() {
 	*x = ClusterSecretsSpec_Certs_CA{}
-	mi := &file_omni_specs_omni_proto_msgTypes[128]
+	mi := &file_omni_specs_omni_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10390,7 +12497,7 @@ func (x *ClusterSecretsSpec_Certs_CA) String() string {
 func (*ClusterSecretsSpec_Certs_CA) ProtoMessage() {}
 
 func (x *ClusterSecretsSpec_Certs_CA) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[128]
+	mi := &file_omni_specs_omni_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10403,7 +12510,7 @@ func (x *ClusterSecretsSpec_Certs_CA) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterSecretsSpec_Certs_CA.ProtoReflect.Descriptor instead.
 func (*ClusterSecretsSpec_Certs_CA) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{32, 0, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{36, 0, 0}
 }
 
 func (x *ClusterSecretsSpec_Certs_CA) GetCrt() []byte {
@@ -10435,7 +12542,7 @@ type MachineSetSpec_MachineClass struct {
 
 func (x *MachineSetSpec_MachineClass) Reset() {
 	*x = MachineSetSpec_MachineClass{}
-	mi := &file_omni_specs_omni_proto_msgTypes[129]
+	mi := &file_omni_specs_omni_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10447,7 +12554,7 @@ func (x *MachineSetSpec_MachineClass) String() string {
 func (*MachineSetSpec_MachineClass) ProtoMessage() {}
 
 func (x *MachineSetSpec_MachineClass) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[129]
+	mi := &file_omni_specs_omni_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10460,7 +12567,7 @@ func (x *MachineSetSpec_MachineClass) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachineSetSpec_MachineClass.ProtoReflect.Descriptor instead.
 func (*MachineSetSpec_MachineClass) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{40, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{44, 0}
 }
 
 func (x *MachineSetSpec_MachineClass) GetName() string {
@@ -10493,13 +12600,15 @@ type MachineSetSpec_MachineAllocation struct {
 	MachineCount uint32 `protobuf:"varint,2,opt,name=machine_count,json=machineCount,proto3" json:"machine_count,omitempty"`
 	// AllocationType defines special constants for the amount of machines to be allocated.
 	AllocationType MachineSetSpec_MachineAllocation_Type `protobuf:"varint,3,opt,name=allocation_type,json=allocationType,proto3,enum=specs.MachineSetSpec_MachineAllocation_Type" json:"allocation_type,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Autoscaling enables the cluster autoscaler to manage the machine count of the machine set.
+	Autoscaling   *MachineSetSpec_MachineAllocation_Autoscaling `protobuf:"bytes,5,opt,name=autoscaling,proto3" json:"autoscaling,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MachineSetSpec_MachineAllocation) Reset() {
 	*x = MachineSetSpec_MachineAllocation{}
-	mi := &file_omni_specs_omni_proto_msgTypes[130]
+	mi := &file_omni_specs_omni_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10511,7 +12620,7 @@ func (x *MachineSetSpec_MachineAllocation) String() string {
 func (*MachineSetSpec_MachineAllocation) ProtoMessage() {}
 
 func (x *MachineSetSpec_MachineAllocation) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[130]
+	mi := &file_omni_specs_omni_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10524,7 +12633,7 @@ func (x *MachineSetSpec_MachineAllocation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachineSetSpec_MachineAllocation.ProtoReflect.Descriptor instead.
 func (*MachineSetSpec_MachineAllocation) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{40, 1}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{44, 1}
 }
 
 func (x *MachineSetSpec_MachineAllocation) GetName() string {
@@ -10548,6 +12657,13 @@ func (x *MachineSetSpec_MachineAllocation) GetAllocationType() MachineSetSpec_Ma
 	return MachineSetSpec_MachineAllocation_Static
 }
 
+func (x *MachineSetSpec_MachineAllocation) GetAutoscaling() *MachineSetSpec_MachineAllocation_Autoscaling {
+	if x != nil {
+		return x.Autoscaling
+	}
+	return nil
+}
+
 // BootstrapSpec defines the bootstrap spec for the control plane machine set.
 // It can contain a reference to an etcd backup, which can be used to bootstrap etcd.
 type MachineSetSpec_BootstrapSpec struct {
@@ -10562,7 +12678,7 @@ type MachineSetSpec_BootstrapSpec struct {
 
 func (x *MachineSetSpec_BootstrapSpec) Reset() {
 	*x = MachineSetSpec_BootstrapSpec{}
-	mi := &file_omni_specs_omni_proto_msgTypes[131]
+	mi := &file_omni_specs_omni_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10574,7 +12690,7 @@ func (x *MachineSetSpec_BootstrapSpec) String() string {
 func (*MachineSetSpec_BootstrapSpec) ProtoMessage() {}
 
 func (x *MachineSetSpec_BootstrapSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[131]
+	mi := &file_omni_specs_omni_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10587,7 +12703,7 @@ func (x *MachineSetSpec_BootstrapSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachineSetSpec_BootstrapSpec.ProtoReflect.Descriptor instead.
 func (*MachineSetSpec_BootstrapSpec) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{40, 2}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{44, 2}
 }
 
 func (x *MachineSetSpec_BootstrapSpec) GetClusterUuid() string {
@@ -10616,7 +12732,7 @@ type MachineSetSpec_RollingUpdateStrategyConfig struct {
 
 func (x *MachineSetSpec_RollingUpdateStrategyConfig) Reset() {
 	*x = MachineSetSpec_RollingUpdateStrategyConfig{}
-	mi := &file_omni_specs_omni_proto_msgTypes[132]
+	mi := &file_omni_specs_omni_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10628,7 +12744,7 @@ func (x *MachineSetSpec_RollingUpdateStrategyConfig) String() string {
 func (*MachineSetSpec_RollingUpdateStrategyConfig) ProtoMessage() {}
 
 func (x *MachineSetSpec_RollingUpdateStrategyConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[132]
+	mi := &file_omni_specs_omni_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10641,7 +12757,7 @@ func (x *MachineSetSpec_RollingUpdateStrategyConfig) ProtoReflect() protoreflect
 
 // Deprecated: Use MachineSetSpec_RollingUpdateStrategyConfig.ProtoReflect.Descriptor instead.
 func (*MachineSetSpec_RollingUpdateStrategyConfig) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{40, 3}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{44, 3}
 }
 
 func (x *MachineSetSpec_RollingUpdateStrategyConfig) GetMaxParallelism() uint32 {
@@ -10651,19 +12767,113 @@ func (x *MachineSetSpec_RollingUpdateStrategyConfig) GetMaxParallelism() uint32
 	return 0
 }
 
+// CanaryUpdateStrategyConfig defines the canary update strategy configuration.
+//
+// The first Count machines are updated first, then the rollout is held until the canaries are verified:
+// they must be healthy, pass the cluster KubernetesHealthChecks for SoakDuration and, if RequireApproval is set,
+// be approved with a CanaryApproval resource. The rest of the machine set is then updated with MaxParallelism.
+type MachineSetSpec_CanaryUpdateStrategyConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Count is the number of canary machines.
+	// When unset or set to 0, it defaults to 1.
+	Count uint32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// MaxParallelism is the maximum number of machines that can be processed in parallel after the canaries are promoted.
+	// When unset or set to 0, it defaults to 1.
+	MaxParallelism uint32 `protobuf:"varint,2,opt,name=max_parallelism,json=maxParallelism,proto3" json:"max_parallelism,omitempty"`
+	// SoakDuration is how long the canaries must stay healthy before they are promoted.
+	SoakDuration *durationpb.Duration `protobuf:"bytes,3,opt,name=soak_duration,json=soakDuration,proto3" json:"soak_duration,omitempty"`
+	// RequireApproval holds the promotion until the canaries are approved with a CanaryApproval resource.
+	RequireApproval bool `protobuf:"varint,4,opt,name=require_approval,json=requireApproval,proto3" json:"require_approval,omitempty"`
+	// FailureTimeout is how long the updated canaries may stay unhealthy before the rollout fails.
+	// Upgrades of the failed canaries are rolled back to the previous Talos version and schematic,
+	// config updates of the failed canaries are rolled back to the previous config patches.
+	// When unset, it defaults to 10 minutes.
+	FailureTimeout *durationpb.Duration `protobuf:"bytes,5,opt,name=failure_timeout,json=failureTimeout,proto3" json:"failure_timeout,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MachineSetSpec_CanaryUpdateStrategyConfig) Reset() {
+	*x = MachineSetSpec_CanaryUpdateStrategyConfig{}
+	mi := &file_omni_specs_omni_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MachineSetSpec_CanaryUpdateStrategyConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MachineSetSpec_CanaryUpdateStrategyConfig) ProtoMessage() {}
+
+func (x *MachineSetSpec_CanaryUpdateStrategyConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MachineSetSpec_CanaryUpdateStrategyConfig.ProtoReflect.Descriptor instead.
+func (*MachineSetSpec_CanaryUpdateStrategyConfig) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{44, 4}
+}
+
+func (x *MachineSetSpec_CanaryUpdateStrategyConfig) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *MachineSetSpec_CanaryUpdateStrategyConfig) GetMaxParallelism() uint32 {
+	if x != nil {
+		return x.MaxParallelism
+	}
+	return 0
+}
+
+func (x *MachineSetSpec_CanaryUpdateStrategyConfig) GetSoakDuration() *durationpb.Duration {
+	if x != nil {
+		return x.SoakDuration
+	}
+	return nil
+}
+
+func (x *MachineSetSpec_CanaryUpdateStrategyConfig) GetRequireApproval() bool {
+	if x != nil {
+		return x.RequireApproval
+	}
+	return false
+}
+
+func (x *MachineSetSpec_CanaryUpdateStrategyConfig) GetFailureTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.FailureTimeout
+	}
+	return nil
+}
+
 // UpdateConfig defines the update configuration.
 type MachineSetSpec_UpdateStrategyConfig struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Rolling is the rolling update configuration.
 	// Used only when the UpdateStrategy is set to Rolling.
-	Rolling       *MachineSetSpec_RollingUpdateStrategyConfig `protobuf:"bytes,1,opt,name=rolling,proto3" json:"rolling,omitempty"`
+	Rolling *MachineSetSpec_RollingUpdateStrategyConfig `protobuf:"bytes,1,opt,name=rolling,proto3" json:"rolling,omitempty"`
+	// Canary is the canary update configuration.
+	// Used only when the UpdateStrategy is set to Canary.
+	Canary        *MachineSetSpec_CanaryUpdateStrategyConfig `protobuf:"bytes,2,opt,name=canary,proto3" json:"canary,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MachineSetSpec_UpdateStrategyConfig) Reset() {
 	*x = MachineSetSpec_UpdateStrategyConfig{}
-	mi := &file_omni_specs_omni_proto_msgTypes[133]
+	mi := &file_omni_specs_omni_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10675,7 +12885,7 @@ func (x *MachineSetSpec_UpdateStrategyConfig) String() string {
 func (*MachineSetSpec_UpdateStrategyConfig) ProtoMessage() {}
 
 func (x *MachineSetSpec_UpdateStrategyConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[133]
+	mi := &file_omni_specs_omni_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10688,7 +12898,7 @@ func (x *MachineSetSpec_UpdateStrategyConfig) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use MachineSetSpec_UpdateStrategyConfig.ProtoReflect.Descriptor instead.
 func (*MachineSetSpec_UpdateStrategyConfig) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{40, 4}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{44, 5}
 }
 
 func (x *MachineSetSpec_UpdateStrategyConfig) GetRolling() *MachineSetSpec_RollingUpdateStrategyConfig {
@@ -10698,6 +12908,121 @@ func (x *MachineSetSpec_UpdateStrategyConfig) GetRolling() *MachineSetSpec_Rolli
 	return nil
 }
 
+func (x *MachineSetSpec_UpdateStrategyConfig) GetCanary() *MachineSetSpec_CanaryUpdateStrategyConfig {
+	if x != nil {
+		return x.Canary
+	}
+	return nil
+}
+
+// Autoscaling defines the bounds the cluster autoscaler can change the machine count within.
+type MachineSetSpec_MachineAllocation_Autoscaling struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// MinMachineCount is the smallest machine count the cluster autoscaler can scale the machine set down to.
+	MinMachineCount uint32 `protobuf:"varint,1,opt,name=min_machine_count,json=minMachineCount,proto3" json:"min_machine_count,omitempty"`
+	// MaxMachineCount is the largest machine count the cluster autoscaler can scale the machine set up to.
+	MaxMachineCount uint32 `protobuf:"varint,2,opt,name=max_machine_count,json=maxMachineCount,proto3" json:"max_machine_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MachineSetSpec_MachineAllocation_Autoscaling) Reset() {
+	*x = MachineSetSpec_MachineAllocation_Autoscaling{}
+	mi := &file_omni_specs_omni_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MachineSetSpec_MachineAllocation_Autoscaling) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MachineSetSpec_MachineAllocation_Autoscaling) ProtoMessage() {}
+
+func (x *MachineSetSpec_MachineAllocation_Autoscaling) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MachineSetSpec_MachineAllocation_Autoscaling.ProtoReflect.Descriptor instead.
+func (*MachineSetSpec_MachineAllocation_Autoscaling) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{44, 1, 0}
+}
+
+func (x *MachineSetSpec_MachineAllocation_Autoscaling) GetMinMachineCount() uint32 {
+	if x != nil {
+		return x.MinMachineCount
+	}
+	return 0
+}
+
+func (x *MachineSetSpec_MachineAllocation_Autoscaling) GetMaxMachineCount() uint32 {
+	if x != nil {
+		return x.MaxMachineCount
+	}
+	return 0
+}
+
+// RollbackVersion is the Talos version and schematic a canary machine is rolled back to.
+type CanaryRolloutStatus_RollbackVersion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TalosVersion  string                 `protobuf:"bytes,1,opt,name=talos_version,json=talosVersion,proto3" json:"talos_version,omitempty"`
+	SchematicId   string                 `protobuf:"bytes,2,opt,name=schematic_id,json=schematicId,proto3" json:"schematic_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CanaryRolloutStatus_RollbackVersion) Reset() {
+	*x = CanaryRolloutStatus_RollbackVersion{}
+	mi := &file_omni_specs_omni_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CanaryRolloutStatus_RollbackVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CanaryRolloutStatus_RollbackVersion) ProtoMessage() {}
+
+func (x *CanaryRolloutStatus_RollbackVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CanaryRolloutStatus_RollbackVersion.ProtoReflect.Descriptor instead.
+func (*CanaryRolloutStatus_RollbackVersion) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{47, 0}
+}
+
+func (x *CanaryRolloutStatus_RollbackVersion) GetTalosVersion() string {
+	if x != nil {
+		return x.TalosVersion
+	}
+	return ""
+}
+
+func (x *CanaryRolloutStatus_RollbackVersion) GetSchematicId() string {
+	if x != nil {
+		return x.SchematicId
+	}
+	return ""
+}
+
 type ControlPlaneStatusSpec_Condition struct {
 	state         protoimpl.MessageState                    `protogen:"open.v1"`
 	Type          ConditionType                             `protobuf:"varint,1,opt,name=type,proto3,enum=specs.ConditionType" json:"type,omitempty"`
@@ -10710,7 +13035,7 @@ type ControlPlaneStatusSpec_Condition struct {
 
 func (x *ControlPlaneStatusSpec_Condition) Reset() {
 	*x = ControlPlaneStatusSpec_Condition{}
-	mi := &file_omni_specs_omni_proto_msgTypes[134]
+	mi := &file_omni_specs_omni_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10722,7 +13047,7 @@ func (x *ControlPlaneStatusSpec_Condition) String() string {
 func (*ControlPlaneStatusSpec_Condition) ProtoMessage() {}
 
 func (x *ControlPlaneStatusSpec_Condition) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[134]
+	mi := &file_omni_specs_omni_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10735,7 +13060,7 @@ func (x *ControlPlaneStatusSpec_Condition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ControlPlaneStatusSpec_Condition.ProtoReflect.Descriptor instead.
 func (*ControlPlaneStatusSpec_Condition) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{47, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{53, 0}
 }
 
 func (x *ControlPlaneStatusSpec_Condition) GetType() ConditionType {
@@ -10778,7 +13103,7 @@ type KubernetesStatusSpec_NodeStatus struct {
 
 func (x *KubernetesStatusSpec_NodeStatus) Reset() {
 	*x = KubernetesStatusSpec_NodeStatus{}
-	mi := &file_omni_specs_omni_proto_msgTypes[135]
+	mi := &file_omni_specs_omni_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10790,7 +13115,7 @@ func (x *KubernetesStatusSpec_NodeStatus) String() string {
 func (*KubernetesStatusSpec_NodeStatus) ProtoMessage() {}
 
 func (x *KubernetesStatusSpec_NodeStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[135]
+	mi := &file_omni_specs_omni_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10803,7 +13128,7 @@ func (x *KubernetesStatusSpec_NodeStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KubernetesStatusSpec_NodeStatus.ProtoReflect.Descriptor instead.
 func (*KubernetesStatusSpec_NodeStatus) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{49, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{55, 0}
 }
 
 func (x *KubernetesStatusSpec_NodeStatus) GetNodename() string {
@@ -10845,7 +13170,7 @@ type KubernetesStatusSpec_StaticPodStatus struct {
 
 func (x *KubernetesStatusSpec_StaticPodStatus) Reset() {
 	*x = KubernetesStatusSpec_StaticPodStatus{}
-	mi := &file_omni_specs_omni_proto_msgTypes[136]
+	mi := &file_omni_specs_omni_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10857,7 +13182,7 @@ func (x *KubernetesStatusSpec_StaticPodStatus) String() string {
 func (*KubernetesStatusSpec_StaticPodStatus) ProtoMessage() {}
 
 func (x *KubernetesStatusSpec_StaticPodStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[136]
+	mi := &file_omni_specs_omni_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10870,7 +13195,7 @@ func (x *KubernetesStatusSpec_StaticPodStatus) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use KubernetesStatusSpec_StaticPodStatus.ProtoReflect.Descriptor instead.
 func (*KubernetesStatusSpec_StaticPodStatus) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{49, 1}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{55, 1}
 }
 
 func (x *KubernetesStatusSpec_StaticPodStatus) GetApp() string {
@@ -10904,19 +13229,77 @@ type KubernetesStatusSpec_NodeStaticPods struct {
 
 func (x *KubernetesStatusSpec_NodeStaticPods) Reset() {
 	*x = KubernetesStatusSpec_NodeStaticPods{}
-	mi := &file_omni_specs_omni_proto_msgTypes[137]
+	mi := &file_omni_specs_omni_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KubernetesStatusSpec_NodeStaticPods) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KubernetesStatusSpec_NodeStaticPods) ProtoMessage() {}
+
+func (x *KubernetesStatusSpec_NodeStaticPods) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KubernetesStatusSpec_NodeStaticPods.ProtoReflect.Descriptor instead.
+func (*KubernetesStatusSpec_NodeStaticPods) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{55, 2}
+}
+
+func (x *KubernetesStatusSpec_NodeStaticPods) GetNodename() string {
+	if x != nil {
+		return x.Nodename
+	}
+	return ""
+}
+
+func (x *KubernetesStatusSpec_NodeStaticPods) GetStaticPods() []*KubernetesStatusSpec_StaticPodStatus {
+	if x != nil {
+		return x.StaticPods
+	}
+	return nil
+}
+
+// Access restricts who can access the service, on top of the access to its cluster.
+type ExposedServiceSpec_Access struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// MinRole is the minimum role on the cluster required to access the service, Reader if empty.
+	MinRole string `protobuf:"bytes,1,opt,name=min_role,json=minRole,proto3" json:"min_role,omitempty"`
+	// Users are the users allowed to access the service: identities, glob patterns of identities,
+	// or user groups of the access policy prefixed with "group/".
+	Users []string `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+	// LabelSelector selects the users allowed to access the service by the labels of their identities, e.g. the SAML labels.
+	LabelSelector string `protobuf:"bytes,3,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExposedServiceSpec_Access) Reset() {
+	*x = ExposedServiceSpec_Access{}
+	mi := &file_omni_specs_omni_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *KubernetesStatusSpec_NodeStaticPods) String() string {
+func (x *ExposedServiceSpec_Access) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KubernetesStatusSpec_NodeStaticPods) ProtoMessage() {}
+func (*ExposedServiceSpec_Access) ProtoMessage() {}
 
-func (x *KubernetesStatusSpec_NodeStaticPods) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[137]
+func (x *ExposedServiceSpec_Access) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10927,25 +13310,32 @@ func (x *KubernetesStatusSpec_NodeStaticPods) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KubernetesStatusSpec_NodeStaticPods.ProtoReflect.Descriptor instead.
-func (*KubernetesStatusSpec_NodeStaticPods) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{49, 2}
+// Deprecated: Use ExposedServiceSpec_Access.ProtoReflect.Descriptor instead.
+func (*ExposedServiceSpec_Access) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{61, 0}
 }
 
-func (x *KubernetesStatusSpec_NodeStaticPods) GetNodename() string {
+func (x *ExposedServiceSpec_Access) GetMinRole() string {
 	if x != nil {
-		return x.Nodename
+		return x.MinRole
 	}
 	return ""
 }
 
-func (x *KubernetesStatusSpec_NodeStaticPods) GetStaticPods() []*KubernetesStatusSpec_StaticPodStatus {
+func (x *ExposedServiceSpec_Access) GetUsers() []string {
 	if x != nil {
-		return x.StaticPods
+		return x.Users
 	}
 	return nil
 }
 
+func (x *ExposedServiceSpec_Access) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
 // MachineProvisionSpec describes the rules for creating and scaling the machine request set.
 type MachineClassSpec_Provision struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -10960,7 +13350,7 @@ type MachineClassSpec_Provision struct {
 
 func (x *MachineClassSpec_Provision) Reset() {
 	*x = MachineClassSpec_Provision{}
-	mi := &file_omni_specs_omni_proto_msgTypes[138]
+	mi := &file_omni_specs_omni_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10972,7 +13362,7 @@ func (x *MachineClassSpec_Provision) String() string {
 func (*MachineClassSpec_Provision) ProtoMessage() {}
 
 func (x *MachineClassSpec_Provision) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[138]
+	mi := &file_omni_specs_omni_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10985,7 +13375,7 @@ func (x *MachineClassSpec_Provision) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachineClassSpec_Provision.ProtoReflect.Descriptor instead.
 func (*MachineClassSpec_Provision) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{63, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{69, 0}
 }
 
 func (x *MachineClassSpec_Provision) GetProviderId() string {
@@ -11046,7 +13436,7 @@ type MachineConfigGenOptionsSpec_InstallImage struct {
 
 func (x *MachineConfigGenOptionsSpec_InstallImage) Reset() {
 	*x = MachineConfigGenOptionsSpec_InstallImage{}
-	mi := &file_omni_specs_omni_proto_msgTypes[139]
+	mi := &file_omni_specs_omni_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11058,7 +13448,7 @@ func (x *MachineConfigGenOptionsSpec_InstallImage) String() string {
 func (*MachineConfigGenOptionsSpec_InstallImage) ProtoMessage() {}
 
 func (x *MachineConfigGenOptionsSpec_InstallImage) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[139]
+	mi := &file_omni_specs_omni_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11071,7 +13461,7 @@ func (x *MachineConfigGenOptionsSpec_InstallImage) ProtoReflect() protoreflect.M
 
 // Deprecated: Use MachineConfigGenOptionsSpec_InstallImage.ProtoReflect.Descriptor instead.
 func (*MachineConfigGenOptionsSpec_InstallImage) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{64, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{70, 0}
 }
 
 func (x *MachineConfigGenOptionsSpec_InstallImage) GetTalosVersion() string {
@@ -11134,7 +13524,7 @@ type KubernetesUsageSpec_Quantity struct {
 
 func (x *KubernetesUsageSpec_Quantity) Reset() {
 	*x = KubernetesUsageSpec_Quantity{}
-	mi := &file_omni_specs_omni_proto_msgTypes[140]
+	mi := &file_omni_specs_omni_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11146,7 +13536,7 @@ func (x *KubernetesUsageSpec_Quantity) String() string {
 func (*KubernetesUsageSpec_Quantity) ProtoMessage() {}
 
 func (x *KubernetesUsageSpec_Quantity) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[140]
+	mi := &file_omni_specs_omni_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11159,7 +13549,7 @@ func (x *KubernetesUsageSpec_Quantity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KubernetesUsageSpec_Quantity.ProtoReflect.Descriptor instead.
 func (*KubernetesUsageSpec_Quantity) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{67, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{73, 0}
 }
 
 func (x *KubernetesUsageSpec_Quantity) GetRequests() float64 {
@@ -11193,7 +13583,7 @@ type KubernetesUsageSpec_Pod struct {
 
 func (x *KubernetesUsageSpec_Pod) Reset() {
 	*x = KubernetesUsageSpec_Pod{}
-	mi := &file_omni_specs_omni_proto_msgTypes[141]
+	mi := &file_omni_specs_omni_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11205,7 +13595,7 @@ func (x *KubernetesUsageSpec_Pod) String() string {
 func (*KubernetesUsageSpec_Pod) ProtoMessage() {}
 
 func (x *KubernetesUsageSpec_Pod) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[141]
+	mi := &file_omni_specs_omni_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11218,7 +13608,7 @@ func (x *KubernetesUsageSpec_Pod) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KubernetesUsageSpec_Pod.ProtoReflect.Descriptor instead.
 func (*KubernetesUsageSpec_Pod) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{67, 1}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{73, 1}
 }
 
 func (x *KubernetesUsageSpec_Pod) GetCount() int32 {
@@ -11245,7 +13635,7 @@ type ImagePullRequestSpec_NodeImageList struct {
 
 func (x *ImagePullRequestSpec_NodeImageList) Reset() {
 	*x = ImagePullRequestSpec_NodeImageList{}
-	mi := &file_omni_specs_omni_proto_msgTypes[142]
+	mi := &file_omni_specs_omni_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11257,7 +13647,7 @@ func (x *ImagePullRequestSpec_NodeImageList) String() string {
 func (*ImagePullRequestSpec_NodeImageList) ProtoMessage() {}
 
 func (x *ImagePullRequestSpec_NodeImageList) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[142]
+	mi := &file_omni_specs_omni_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11270,7 +13660,7 @@ func (x *ImagePullRequestSpec_NodeImageList) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ImagePullRequestSpec_NodeImageList.ProtoReflect.Descriptor instead.
 func (*ImagePullRequestSpec_NodeImageList) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{68, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{74, 0}
 }
 
 func (x *ImagePullRequestSpec_NodeImageList) GetNode() string {
@@ -11302,7 +13692,7 @@ type TalosExtensionsSpec_Info struct {
 
 func (x *TalosExtensionsSpec_Info) Reset() {
 	*x = TalosExtensionsSpec_Info{}
-	mi := &file_omni_specs_omni_proto_msgTypes[143]
+	mi := &file_omni_specs_omni_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11314,7 +13704,7 @@ func (x *TalosExtensionsSpec_Info) String() string {
 func (*TalosExtensionsSpec_Info) ProtoMessage() {}
 
 func (x *TalosExtensionsSpec_Info) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[143]
+	mi := &file_omni_specs_omni_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11327,7 +13717,7 @@ func (x *TalosExtensionsSpec_Info) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TalosExtensionsSpec_Info.ProtoReflect.Descriptor instead.
 func (*TalosExtensionsSpec_Info) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{71, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{77, 0}
 }
 
 func (x *TalosExtensionsSpec_Info) GetName() string {
@@ -11383,7 +13773,7 @@ type MachineExtensionsStatusSpec_Item struct {
 
 func (x *MachineExtensionsStatusSpec_Item) Reset() {
 	*x = MachineExtensionsStatusSpec_Item{}
-	mi := &file_omni_specs_omni_proto_msgTypes[144]
+	mi := &file_omni_specs_omni_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11395,7 +13785,7 @@ func (x *MachineExtensionsStatusSpec_Item) String() string {
 func (*MachineExtensionsStatusSpec_Item) ProtoMessage() {}
 
 func (x *MachineExtensionsStatusSpec_Item) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[144]
+	mi := &file_omni_specs_omni_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11408,7 +13798,7 @@ func (x *MachineExtensionsStatusSpec_Item) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachineExtensionsStatusSpec_Item.ProtoReflect.Descriptor instead.
 func (*MachineExtensionsStatusSpec_Item) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{78, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{84, 0}
 }
 
 func (x *MachineExtensionsStatusSpec_Item) GetName() string {
@@ -11442,7 +13832,7 @@ type ClusterDiagnosticsSpec_Node struct {
 
 func (x *ClusterDiagnosticsSpec_Node) Reset() {
 	*x = ClusterDiagnosticsSpec_Node{}
-	mi := &file_omni_specs_omni_proto_msgTypes[150]
+	mi := &file_omni_specs_omni_proto_msgTypes[177]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11454,7 +13844,7 @@ func (x *ClusterDiagnosticsSpec_Node) String() string {
 func (*ClusterDiagnosticsSpec_Node) ProtoMessage() {}
 
 func (x *ClusterDiagnosticsSpec_Node) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[150]
+	mi := &file_omni_specs_omni_proto_msgTypes[177]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11467,7 +13857,7 @@ func (x *ClusterDiagnosticsSpec_Node) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterDiagnosticsSpec_Node.ProtoReflect.Descriptor instead.
 func (*ClusterDiagnosticsSpec_Node) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{86, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{92, 0}
 }
 
 func (x *ClusterDiagnosticsSpec_Node) GetId() string {
@@ -11496,7 +13886,7 @@ type InfraMachineBMCConfigSpec_IPMI struct {
 
 func (x *InfraMachineBMCConfigSpec_IPMI) Reset() {
 	*x = InfraMachineBMCConfigSpec_IPMI{}
-	mi := &file_omni_specs_omni_proto_msgTypes[151]
+	mi := &file_omni_specs_omni_proto_msgTypes[178]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11508,7 +13898,7 @@ func (x *InfraMachineBMCConfigSpec_IPMI) String() string {
 func (*InfraMachineBMCConfigSpec_IPMI) ProtoMessage() {}
 
 func (x *InfraMachineBMCConfigSpec_IPMI) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[151]
+	mi := &file_omni_specs_omni_proto_msgTypes[178]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11521,7 +13911,7 @@ func (x *InfraMachineBMCConfigSpec_IPMI) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InfraMachineBMCConfigSpec_IPMI.ProtoReflect.Descriptor instead.
 func (*InfraMachineBMCConfigSpec_IPMI) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{90, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{96, 0}
 }
 
 func (x *InfraMachineBMCConfigSpec_IPMI) GetAddress() string {
@@ -11561,7 +13951,7 @@ type InfraMachineBMCConfigSpec_API struct {
 
 func (x *InfraMachineBMCConfigSpec_API) Reset() {
 	*x = InfraMachineBMCConfigSpec_API{}
-	mi := &file_omni_specs_omni_proto_msgTypes[152]
+	mi := &file_omni_specs_omni_proto_msgTypes[179]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11573,7 +13963,7 @@ func (x *InfraMachineBMCConfigSpec_API) String() string {
 func (*InfraMachineBMCConfigSpec_API) ProtoMessage() {}
 
 func (x *InfraMachineBMCConfigSpec_API) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[152]
+	mi := &file_omni_specs_omni_proto_msgTypes[179]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11586,7 +13976,7 @@ func (x *InfraMachineBMCConfigSpec_API) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InfraMachineBMCConfigSpec_API.ProtoReflect.Descriptor instead.
 func (*InfraMachineBMCConfigSpec_API) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{90, 1}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{96, 1}
 }
 
 func (x *InfraMachineBMCConfigSpec_API) GetAddress() string {
@@ -11607,7 +13997,7 @@ type InfraProviderCombinedStatusSpec_Health struct {
 
 func (x *InfraProviderCombinedStatusSpec_Health) Reset() {
 	*x = InfraProviderCombinedStatusSpec_Health{}
-	mi := &file_omni_specs_omni_proto_msgTypes[153]
+	mi := &file_omni_specs_omni_proto_msgTypes[180]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11619,7 +14009,7 @@ func (x *InfraProviderCombinedStatusSpec_Health) String() string {
 func (*InfraProviderCombinedStatusSpec_Health) ProtoMessage() {}
 
 func (x *InfraProviderCombinedStatusSpec_Health) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[153]
+	mi := &file_omni_specs_omni_proto_msgTypes[180]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11632,7 +14022,7 @@ func (x *InfraProviderCombinedStatusSpec_Health) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use InfraProviderCombinedStatusSpec_Health.ProtoReflect.Descriptor instead.
 func (*InfraProviderCombinedStatusSpec_Health) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{94, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{100, 0}
 }
 
 func (x *InfraProviderCombinedStatusSpec_Health) GetConnected() bool {
@@ -11651,33 +14041,290 @@ func (x *InfraProviderCombinedStatusSpec_Health) GetError() string {
 
 func (x *InfraProviderCombinedStatusSpec_Health) GetInitialized() bool {
 	if x != nil {
-		return x.Initialized
+		return x.Initialized
+	}
+	return false
+}
+
+type InstallationMediaConfigSpec_Cloud struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Platform      string                 `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstallationMediaConfigSpec_Cloud) Reset() {
+	*x = InstallationMediaConfigSpec_Cloud{}
+	mi := &file_omni_specs_omni_proto_msgTypes[181]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallationMediaConfigSpec_Cloud) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallationMediaConfigSpec_Cloud) ProtoMessage() {}
+
+func (x *InstallationMediaConfigSpec_Cloud) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[181]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallationMediaConfigSpec_Cloud.ProtoReflect.Descriptor instead.
+func (*InstallationMediaConfigSpec_Cloud) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{102, 0}
+}
+
+func (x *InstallationMediaConfigSpec_Cloud) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+type InstallationMediaConfigSpec_SBC struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Overlay        string                 `protobuf:"bytes,1,opt,name=overlay,proto3" json:"overlay,omitempty"`
+	OverlayOptions string                 `protobuf:"bytes,2,opt,name=overlay_options,json=overlayOptions,proto3" json:"overlay_options,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InstallationMediaConfigSpec_SBC) Reset() {
+	*x = InstallationMediaConfigSpec_SBC{}
+	mi := &file_omni_specs_omni_proto_msgTypes[182]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallationMediaConfigSpec_SBC) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallationMediaConfigSpec_SBC) ProtoMessage() {}
+
+func (x *InstallationMediaConfigSpec_SBC) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[182]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallationMediaConfigSpec_SBC.ProtoReflect.Descriptor instead.
+func (*InstallationMediaConfigSpec_SBC) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{102, 1}
+}
+
+func (x *InstallationMediaConfigSpec_SBC) GetOverlay() string {
+	if x != nil {
+		return x.Overlay
+	}
+	return ""
+}
+
+func (x *InstallationMediaConfigSpec_SBC) GetOverlayOptions() string {
+	if x != nil {
+		return x.OverlayOptions
+	}
+	return ""
+}
+
+type ClusterSecretsRotationStatusSpec_Secret struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Component is the rotated secret.
+	Component SecretRotationSpec_Component `protobuf:"varint,1,opt,name=component,proto3,enum=specs.SecretRotationSpec_Component" json:"component,omitempty"`
+	// Expiration is the expiration time of the certificate authority, not set for the secrets which don't expire.
+	Expiration *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// LastRotation is the time of the last rotation of the secret, not set if it was never rotated.
+	LastRotation *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_rotation,json=lastRotation,proto3" json:"last_rotation,omitempty"`
+	// NextRotation is the time of the next automatic rotation of the secret, not set if it is not covered by the rotation policy.
+	NextRotation  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=next_rotation,json=nextRotation,proto3" json:"next_rotation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClusterSecretsRotationStatusSpec_Secret) Reset() {
+	*x = ClusterSecretsRotationStatusSpec_Secret{}
+	mi := &file_omni_specs_omni_proto_msgTypes[184]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClusterSecretsRotationStatusSpec_Secret) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterSecretsRotationStatusSpec_Secret) ProtoMessage() {}
+
+func (x *ClusterSecretsRotationStatusSpec_Secret) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[184]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterSecretsRotationStatusSpec_Secret.ProtoReflect.Descriptor instead.
+func (*ClusterSecretsRotationStatusSpec_Secret) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{105, 0}
+}
+
+func (x *ClusterSecretsRotationStatusSpec_Secret) GetComponent() SecretRotationSpec_Component {
+	if x != nil {
+		return x.Component
+	}
+	return SecretRotationSpec_NONE
+}
+
+func (x *ClusterSecretsRotationStatusSpec_Secret) GetExpiration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+func (x *ClusterSecretsRotationStatusSpec_Secret) GetLastRotation() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRotation
+	}
+	return nil
+}
+
+func (x *ClusterSecretsRotationStatusSpec_Secret) GetNextRotation() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRotation
+	}
+	return nil
+}
+
+type ClusterMachineSecretsSpec_Rotation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Status holds the status of the secrets rotation.
+	Status SecretRotationSpec_Status `protobuf:"varint,1,opt,name=status,proto3,enum=specs.SecretRotationSpec_Status" json:"status,omitempty"`
+	// Phase holds the current phase of the ongoing secrets rotation.
+	Phase SecretRotationSpec_Phase `protobuf:"varint,2,opt,name=phase,proto3,enum=specs.SecretRotationSpec_Phase" json:"phase,omitempty"`
+	// Component holds the component currently being rotated.
+	Component SecretRotationSpec_Component `protobuf:"varint,3,opt,name=component,proto3,enum=specs.SecretRotationSpec_Component" json:"component,omitempty"`
+	// Extra_certs holds extra cluster CAs to be used during CA rotation.
+	ExtraCerts *ClusterSecretsSpec_Certs `protobuf:"bytes,4,opt,name=extra_certs,json=extraCerts,proto3" json:"extra_certs,omitempty"`
+	// Secret_rotation_version holds the version of the SecretsRotation used to build Rotation.
+	SecretRotationVersion string `protobuf:"bytes,5,opt,name=secret_rotation_version,json=secretRotationVersion,proto3" json:"secret_rotation_version,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ClusterMachineSecretsSpec_Rotation) Reset() {
+	*x = ClusterMachineSecretsSpec_Rotation{}
+	mi := &file_omni_specs_omni_proto_msgTypes[185]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClusterMachineSecretsSpec_Rotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterMachineSecretsSpec_Rotation) ProtoMessage() {}
+
+func (x *ClusterMachineSecretsSpec_Rotation) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[185]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterMachineSecretsSpec_Rotation.ProtoReflect.Descriptor instead.
+func (*ClusterMachineSecretsSpec_Rotation) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{106, 0}
+}
+
+func (x *ClusterMachineSecretsSpec_Rotation) GetStatus() SecretRotationSpec_Status {
+	if x != nil {
+		return x.Status
+	}
+	return SecretRotationSpec_IDLE
+}
+
+func (x *ClusterMachineSecretsSpec_Rotation) GetPhase() SecretRotationSpec_Phase {
+	if x != nil {
+		return x.Phase
+	}
+	return SecretRotationSpec_OK
+}
+
+func (x *ClusterMachineSecretsSpec_Rotation) GetComponent() SecretRotationSpec_Component {
+	if x != nil {
+		return x.Component
+	}
+	return SecretRotationSpec_NONE
+}
+
+func (x *ClusterMachineSecretsSpec_Rotation) GetExtraCerts() *ClusterSecretsSpec_Certs {
+	if x != nil {
+		return x.ExtraCerts
+	}
+	return nil
+}
+
+func (x *ClusterMachineSecretsSpec_Rotation) GetSecretRotationVersion() string {
+	if x != nil {
+		return x.SecretRotationVersion
 	}
-	return false
+	return ""
 }
 
-type InstallationMediaConfigSpec_Cloud struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Platform      string                 `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
+// SMTP configures the delivery of the events by email.
+type NotificationChannelSpec_SMTPConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Host is the SMTP server address, as host:port.
+	Host     string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// From is the sender address.
+	From string `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	// To are the recipient addresses.
+	To            []string `protobuf:"bytes,5,rep,name=to,proto3" json:"to,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InstallationMediaConfigSpec_Cloud) Reset() {
-	*x = InstallationMediaConfigSpec_Cloud{}
-	mi := &file_omni_specs_omni_proto_msgTypes[154]
+func (x *NotificationChannelSpec_SMTPConfig) Reset() {
+	*x = NotificationChannelSpec_SMTPConfig{}
+	mi := &file_omni_specs_omni_proto_msgTypes[189]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InstallationMediaConfigSpec_Cloud) String() string {
+func (x *NotificationChannelSpec_SMTPConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstallationMediaConfigSpec_Cloud) ProtoMessage() {}
+func (*NotificationChannelSpec_SMTPConfig) ProtoMessage() {}
 
-func (x *InstallationMediaConfigSpec_Cloud) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[154]
+func (x *NotificationChannelSpec_SMTPConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[189]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11688,101 +14335,88 @@ func (x *InstallationMediaConfigSpec_Cloud) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstallationMediaConfigSpec_Cloud.ProtoReflect.Descriptor instead.
-func (*InstallationMediaConfigSpec_Cloud) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{96, 0}
+// Deprecated: Use NotificationChannelSpec_SMTPConfig.ProtoReflect.Descriptor instead.
+func (*NotificationChannelSpec_SMTPConfig) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{114, 0}
 }
 
-func (x *InstallationMediaConfigSpec_Cloud) GetPlatform() string {
+func (x *NotificationChannelSpec_SMTPConfig) GetHost() string {
 	if x != nil {
-		return x.Platform
+		return x.Host
 	}
 	return ""
 }
 
-type InstallationMediaConfigSpec_SBC struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Overlay        string                 `protobuf:"bytes,1,opt,name=overlay,proto3" json:"overlay,omitempty"`
-	OverlayOptions string                 `protobuf:"bytes,2,opt,name=overlay_options,json=overlayOptions,proto3" json:"overlay_options,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *InstallationMediaConfigSpec_SBC) Reset() {
-	*x = InstallationMediaConfigSpec_SBC{}
-	mi := &file_omni_specs_omni_proto_msgTypes[155]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *InstallationMediaConfigSpec_SBC) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*InstallationMediaConfigSpec_SBC) ProtoMessage() {}
-
-func (x *InstallationMediaConfigSpec_SBC) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[155]
+func (x *NotificationChannelSpec_SMTPConfig) GetUsername() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Username
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use InstallationMediaConfigSpec_SBC.ProtoReflect.Descriptor instead.
-func (*InstallationMediaConfigSpec_SBC) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{96, 1}
+func (x *NotificationChannelSpec_SMTPConfig) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
 }
 
-func (x *InstallationMediaConfigSpec_SBC) GetOverlay() string {
+func (x *NotificationChannelSpec_SMTPConfig) GetFrom() string {
 	if x != nil {
-		return x.Overlay
+		return x.From
 	}
 	return ""
 }
 
-func (x *InstallationMediaConfigSpec_SBC) GetOverlayOptions() string {
+func (x *NotificationChannelSpec_SMTPConfig) GetTo() []string {
 	if x != nil {
-		return x.OverlayOptions
+		return x.To
 	}
-	return ""
+	return nil
 }
 
-type ClusterMachineSecretsSpec_Rotation struct {
+// HelmSource references a Helm chart which is rendered by Omni into the manifests of the group.
+type KubernetesManifestGroupSpec_HelmSource struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Status holds the status of the secrets rotation.
-	Status SecretRotationSpec_Status `protobuf:"varint,1,opt,name=status,proto3,enum=specs.SecretRotationSpec_Status" json:"status,omitempty"`
-	// Phase holds the current phase of the ongoing secrets rotation.
-	Phase SecretRotationSpec_Phase `protobuf:"varint,2,opt,name=phase,proto3,enum=specs.SecretRotationSpec_Phase" json:"phase,omitempty"`
-	// Component holds the component currently being rotated.
-	Component SecretRotationSpec_Component `protobuf:"varint,3,opt,name=component,proto3,enum=specs.SecretRotationSpec_Component" json:"component,omitempty"`
-	// Extra_certs holds extra cluster CAs to be used during CA rotation.
-	ExtraCerts *ClusterSecretsSpec_Certs `protobuf:"bytes,4,opt,name=extra_certs,json=extraCerts,proto3" json:"extra_certs,omitempty"`
-	// Secret_rotation_version holds the version of the SecretsRotation used to build Rotation.
-	SecretRotationVersion string `protobuf:"bytes,5,opt,name=secret_rotation_version,json=secretRotationVersion,proto3" json:"secret_rotation_version,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	// Repository is the URL of the chart repository: either an HTTP(S) chart repository, or an OCI registry (oci://...).
+	//
+	// Empty if the chart is stored in chart_archive.
+	Repository string `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	// Chart is the name of the chart in the repository.
+	Chart string `protobuf:"bytes,2,opt,name=chart,proto3" json:"chart,omitempty"`
+	// Version of the chart, the latest version is used if empty.
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// ReleaseName is the name of the Helm release, defaults to the chart name.
+	ReleaseName string `protobuf:"bytes,4,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	// Namespace is the namespace of the release, the namespaced objects without a namespace are created there.
+	Namespace string `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Values is the YAML encoded chart values.
+	Values string `protobuf:"bytes,6,opt,name=values,proto3" json:"values,omitempty"`
+	// ValuesFrom is the list of the ConfigPatch IDs of the same cluster, which contain the YAML encoded chart values.
+	//
+	// The values are merged in order, the inline values have the highest priority.
+	ValuesFrom []string `protobuf:"bytes,7,rep,name=values_from,json=valuesFrom,proto3" json:"values_from,omitempty"`
+	// ChartArchive is the packaged chart (.tgz), used instead of fetching the chart from the repository.
+	ChartArchive  []byte `protobuf:"bytes,8,opt,name=chart_archive,json=chartArchive,proto3" json:"chart_archive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ClusterMachineSecretsSpec_Rotation) Reset() {
-	*x = ClusterMachineSecretsSpec_Rotation{}
-	mi := &file_omni_specs_omni_proto_msgTypes[157]
+func (x *KubernetesManifestGroupSpec_HelmSource) Reset() {
+	*x = KubernetesManifestGroupSpec_HelmSource{}
+	mi := &file_omni_specs_omni_proto_msgTypes[190]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClusterMachineSecretsSpec_Rotation) String() string {
+func (x *KubernetesManifestGroupSpec_HelmSource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClusterMachineSecretsSpec_Rotation) ProtoMessage() {}
+func (*KubernetesManifestGroupSpec_HelmSource) ProtoMessage() {}
 
-func (x *ClusterMachineSecretsSpec_Rotation) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[157]
+func (x *KubernetesManifestGroupSpec_HelmSource) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[190]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11793,46 +14427,67 @@ func (x *ClusterMachineSecretsSpec_Rotation) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClusterMachineSecretsSpec_Rotation.ProtoReflect.Descriptor instead.
-func (*ClusterMachineSecretsSpec_Rotation) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{100, 0}
+// Deprecated: Use KubernetesManifestGroupSpec_HelmSource.ProtoReflect.Descriptor instead.
+func (*KubernetesManifestGroupSpec_HelmSource) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{117, 0}
 }
 
-func (x *ClusterMachineSecretsSpec_Rotation) GetStatus() SecretRotationSpec_Status {
+func (x *KubernetesManifestGroupSpec_HelmSource) GetRepository() string {
 	if x != nil {
-		return x.Status
+		return x.Repository
 	}
-	return SecretRotationSpec_IDLE
+	return ""
 }
 
-func (x *ClusterMachineSecretsSpec_Rotation) GetPhase() SecretRotationSpec_Phase {
+func (x *KubernetesManifestGroupSpec_HelmSource) GetChart() string {
 	if x != nil {
-		return x.Phase
+		return x.Chart
 	}
-	return SecretRotationSpec_OK
+	return ""
 }
 
-func (x *ClusterMachineSecretsSpec_Rotation) GetComponent() SecretRotationSpec_Component {
+func (x *KubernetesManifestGroupSpec_HelmSource) GetVersion() string {
 	if x != nil {
-		return x.Component
+		return x.Version
 	}
-	return SecretRotationSpec_NONE
+	return ""
 }
 
-func (x *ClusterMachineSecretsSpec_Rotation) GetExtraCerts() *ClusterSecretsSpec_Certs {
+func (x *KubernetesManifestGroupSpec_HelmSource) GetReleaseName() string {
 	if x != nil {
-		return x.ExtraCerts
+		return x.ReleaseName
 	}
-	return nil
+	return ""
 }
 
-func (x *ClusterMachineSecretsSpec_Rotation) GetSecretRotationVersion() string {
+func (x *KubernetesManifestGroupSpec_HelmSource) GetNamespace() string {
 	if x != nil {
-		return x.SecretRotationVersion
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *KubernetesManifestGroupSpec_HelmSource) GetValues() string {
+	if x != nil {
+		return x.Values
 	}
 	return ""
 }
 
+func (x *KubernetesManifestGroupSpec_HelmSource) GetValuesFrom() []string {
+	if x != nil {
+		return x.ValuesFrom
+	}
+	return nil
+}
+
+func (x *KubernetesManifestGroupSpec_HelmSource) GetChartArchive() []byte {
+	if x != nil {
+		return x.ChartArchive
+	}
+	return nil
+}
+
 type ClusterKubernetesManifestsStatusSpec_ManifestStatus struct {
 	state         protoimpl.MessageState                                    `protogen:"open.v1"`
 	Phase         ClusterKubernetesManifestsStatusSpec_ManifestStatus_Phase `protobuf:"varint,1,opt,name=phase,proto3,enum=specs.ClusterKubernetesManifestsStatusSpec_ManifestStatus_Phase" json:"phase,omitempty"`
@@ -11846,7 +14501,7 @@ type ClusterKubernetesManifestsStatusSpec_ManifestStatus struct {
 
 func (x *ClusterKubernetesManifestsStatusSpec_ManifestStatus) Reset() {
 	*x = ClusterKubernetesManifestsStatusSpec_ManifestStatus{}
-	mi := &file_omni_specs_omni_proto_msgTypes[159]
+	mi := &file_omni_specs_omni_proto_msgTypes[191]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11858,7 +14513,7 @@ func (x *ClusterKubernetesManifestsStatusSpec_ManifestStatus) String() string {
 func (*ClusterKubernetesManifestsStatusSpec_ManifestStatus) ProtoMessage() {}
 
 func (x *ClusterKubernetesManifestsStatusSpec_ManifestStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[159]
+	mi := &file_omni_specs_omni_proto_msgTypes[191]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11871,7 +14526,7 @@ func (x *ClusterKubernetesManifestsStatusSpec_ManifestStatus) ProtoReflect() pro
 
 // Deprecated: Use ClusterKubernetesManifestsStatusSpec_ManifestStatus.ProtoReflect.Descriptor instead.
 func (*ClusterKubernetesManifestsStatusSpec_ManifestStatus) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{105, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{118, 0}
 }
 
 func (x *ClusterKubernetesManifestsStatusSpec_ManifestStatus) GetPhase() ClusterKubernetesManifestsStatusSpec_ManifestStatus_Phase {
@@ -11920,7 +14575,7 @@ type ClusterKubernetesManifestsStatusSpec_GroupStatus struct {
 
 func (x *ClusterKubernetesManifestsStatusSpec_GroupStatus) Reset() {
 	*x = ClusterKubernetesManifestsStatusSpec_GroupStatus{}
-	mi := &file_omni_specs_omni_proto_msgTypes[160]
+	mi := &file_omni_specs_omni_proto_msgTypes[192]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11932,7 +14587,7 @@ func (x *ClusterKubernetesManifestsStatusSpec_GroupStatus) String() string {
 func (*ClusterKubernetesManifestsStatusSpec_GroupStatus) ProtoMessage() {}
 
 func (x *ClusterKubernetesManifestsStatusSpec_GroupStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[160]
+	mi := &file_omni_specs_omni_proto_msgTypes[192]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11945,7 +14600,7 @@ func (x *ClusterKubernetesManifestsStatusSpec_GroupStatus) ProtoReflect() protor
 
 // Deprecated: Use ClusterKubernetesManifestsStatusSpec_GroupStatus.ProtoReflect.Descriptor instead.
 func (*ClusterKubernetesManifestsStatusSpec_GroupStatus) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{105, 1}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{118, 1}
 }
 
 func (x *ClusterKubernetesManifestsStatusSpec_GroupStatus) GetPhase() ClusterKubernetesManifestsStatusSpec_GroupStatus_Phase {
@@ -11988,7 +14643,7 @@ type MachineInstallDiskStatusSpec_Disk struct {
 
 func (x *MachineInstallDiskStatusSpec_Disk) Reset() {
 	*x = MachineInstallDiskStatusSpec_Disk{}
-	mi := &file_omni_specs_omni_proto_msgTypes[163]
+	mi := &file_omni_specs_omni_proto_msgTypes[195]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12000,7 +14655,7 @@ func (x *MachineInstallDiskStatusSpec_Disk) String() string {
 func (*MachineInstallDiskStatusSpec_Disk) ProtoMessage() {}
 
 func (x *MachineInstallDiskStatusSpec_Disk) ProtoReflect() protoreflect.Message {
-	mi := &file_omni_specs_omni_proto_msgTypes[163]
+	mi := &file_omni_specs_omni_proto_msgTypes[195]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12013,7 +14668,7 @@ func (x *MachineInstallDiskStatusSpec_Disk) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use MachineInstallDiskStatusSpec_Disk.ProtoReflect.Descriptor instead.
 func (*MachineInstallDiskStatusSpec_Disk) Descriptor() ([]byte, []int) {
-	return file_omni_specs_omni_proto_rawDescGZIP(), []int{111, 0}
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{124, 0}
 }
 
 func (x *MachineInstallDiskStatusSpec_Disk) GetDevPath() string {
@@ -12044,6 +14699,144 @@ func (x *MachineInstallDiskStatusSpec_Disk) GetMembers() []string {
 	return nil
 }
 
+// CommitStatus is the result of the sync of a single commit.
+type GitRepositoryStatusSpec_CommitStatus struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Sha           string                        `protobuf:"bytes,1,opt,name=sha,proto3" json:"sha,omitempty"`
+	Message       string                        `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Author        string                        `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Phase         GitRepositoryStatusSpec_Phase `protobuf:"varint,4,opt,name=phase,proto3,enum=specs.GitRepositoryStatusSpec_Phase" json:"phase,omitempty"`
+	Error         string                        `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	SyncedAt      *timestamppb.Timestamp        `protobuf:"bytes,6,opt,name=synced_at,json=syncedAt,proto3" json:"synced_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GitRepositoryStatusSpec_CommitStatus) Reset() {
+	*x = GitRepositoryStatusSpec_CommitStatus{}
+	mi := &file_omni_specs_omni_proto_msgTypes[197]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitRepositoryStatusSpec_CommitStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitRepositoryStatusSpec_CommitStatus) ProtoMessage() {}
+
+func (x *GitRepositoryStatusSpec_CommitStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[197]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitRepositoryStatusSpec_CommitStatus.ProtoReflect.Descriptor instead.
+func (*GitRepositoryStatusSpec_CommitStatus) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{129, 0}
+}
+
+func (x *GitRepositoryStatusSpec_CommitStatus) GetSha() string {
+	if x != nil {
+		return x.Sha
+	}
+	return ""
+}
+
+func (x *GitRepositoryStatusSpec_CommitStatus) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GitRepositoryStatusSpec_CommitStatus) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *GitRepositoryStatusSpec_CommitStatus) GetPhase() GitRepositoryStatusSpec_Phase {
+	if x != nil {
+		return x.Phase
+	}
+	return GitRepositoryStatusSpec_UNKNOWN
+}
+
+func (x *GitRepositoryStatusSpec_CommitStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GitRepositoryStatusSpec_CommitStatus) GetSyncedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SyncedAt
+	}
+	return nil
+}
+
+// ManagedResource is a resource created from the repository.
+type GitRepositoryStatusSpec_ManagedResource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GitRepositoryStatusSpec_ManagedResource) Reset() {
+	*x = GitRepositoryStatusSpec_ManagedResource{}
+	mi := &file_omni_specs_omni_proto_msgTypes[198]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitRepositoryStatusSpec_ManagedResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitRepositoryStatusSpec_ManagedResource) ProtoMessage() {}
+
+func (x *GitRepositoryStatusSpec_ManagedResource) ProtoReflect() protoreflect.Message {
+	mi := &file_omni_specs_omni_proto_msgTypes[198]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitRepositoryStatusSpec_ManagedResource.ProtoReflect.Descriptor instead.
+func (*GitRepositoryStatusSpec_ManagedResource) Descriptor() ([]byte, []int) {
+	return file_omni_specs_omni_proto_rawDescGZIP(), []int{129, 1}
+}
+
+func (x *GitRepositoryStatusSpec_ManagedResource) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GitRepositoryStatusSpec_ManagedResource) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
 var File_omni_specs_omni_proto protoreflect.FileDescriptor
 
 const file_omni_specs_omni_proto_rawDesc = "" +
@@ -12210,22 +15003,42 @@ const file_omni_specs_omni_proto_rawDesc = "" +
 	"\x0fTalosConfigSpec\x12\x0e\n" +
 	"\x02ca\x18\x01 \x01(\tR\x02ca\x12\x10\n" +
 	"\x03crt\x18\x02 \x01(\tR\x03crt\x12\x10\n" +
-	"\x03key\x18\x03 \x01(\tR\x03key\"\x97\x04\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\"\x99\x05\n" +
 	"\vClusterSpec\x12-\n" +
 	"\x12kubernetes_version\x18\x02 \x01(\tR\x11kubernetesVersion\x12#\n" +
 	"\rtalos_version\x18\x03 \x01(\tR\ftalosVersion\x127\n" +
 	"\bfeatures\x18\x04 \x01(\v2\x1b.specs.ClusterSpec.FeaturesR\bfeatures\x12H\n" +
-	"\x14backup_configuration\x18\x05 \x01(\v2\x15.specs.EtcdBackupConfR\x13backupConfiguration\x1a\xaa\x02\n" +
+	"\x14backup_configuration\x18\x05 \x01(\v2\x15.specs.EtcdBackupConfR\x13backupConfiguration\x12-\n" +
+	"\x12maintenance_window\x18\x06 \x01(\tR\x11maintenanceWindow\x12Q\n" +
+	"\x16secret_rotation_policy\x18\a \x01(\v2\x1b.specs.SecretRotationPolicyR\x14secretRotationPolicy\x1a\xaa\x02\n" +
 	"\bFeatures\x122\n" +
 	"\x15enable_workload_proxy\x18\x01 \x01(\bR\x13enableWorkloadProxy\x12'\n" +
 	"\x0fdisk_encryption\x18\x02 \x01(\bR\x0ediskEncryption\x12C\n" +
 	"\x1euse_embedded_discovery_service\x18\x03 \x01(\bR\x1buseEmbeddedDiscoveryService\x123\n" +
 	"\x16enable_node_audit_skip\x18\x04 \x01(\bR\x13enableNodeAuditSkip\x12G\n" +
-	" disable_public_discovery_service\x18\x05 \x01(\bR\x1ddisablePublicDiscoveryServiceJ\x04\b\x01\x10\x02\"\x12\n" +
-	"\x10ClusterTaintSpec\"a\n" +
+	" disable_public_discovery_service\x18\x05 \x01(\bR\x1ddisablePublicDiscoveryServiceJ\x04\b\x01\x10\x02\"\xd8\x01\n" +
+	"\x14SecretRotationPolicy\x125\n" +
+	"\binterval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12D\n" +
+	"\x10expiry_threshold\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x0fexpiryThreshold\x12C\n" +
+	"\n" +
+	"components\x18\x03 \x03(\x0e2#.specs.SecretRotationSpec.ComponentR\n" +
+	"components\"\x86\x01\n" +
+	"\x15MaintenanceWindowSpec\x12\x1a\n" +
+	"\bschedule\x18\x01 \x01(\tR\bschedule\x125\n" +
+	"\bduration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\"\x12\n" +
+	"\x10ClusterTaintSpec\"\x9b\x01\n" +
 	"\x0eEtcdBackupConf\x125\n" +
 	"\binterval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12\x18\n" +
-	"\aenabled\x18\x02 \x01(\bR\aenabled\"A\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x128\n" +
+	"\tretention\x18\x03 \x01(\v2\x1a.specs.EtcdBackupRetentionR\tretention\"\x95\x01\n" +
+	"\x13EtcdBackupRetention\x12\x1b\n" +
+	"\tkeep_last\x18\x01 \x01(\rR\bkeepLast\x12\x1d\n" +
+	"\n" +
+	"keep_daily\x18\x02 \x01(\rR\tkeepDaily\x12\x1f\n" +
+	"\vkeep_weekly\x18\x03 \x01(\rR\n" +
+	"keepWeekly\x12!\n" +
+	"\fkeep_monthly\x18\x04 \x01(\rR\vkeepMonthly\"A\n" +
 	"\x18EtcdBackupEncryptionSpec\x12%\n" +
 	"\x0eencryption_key\x18\x01 \x01(\fR\rencryptionKey\",\n" +
 	"\x10EtcdBackupHeader\x12\x18\n" +
@@ -12234,25 +15047,60 @@ const file_omni_specs_omni_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1a\n" +
 	"\bsnapshot\x18\x02 \x01(\tR\bsnapshot\x12\x12\n" +
-	"\x04size\x18\x03 \x01(\x04R\x04size\"\x8c\x02\n" +
+	"\x04size\x18\x03 \x01(\x04R\x04size\"\xc6\x02\n" +
 	"\x0eBackupDataSpec\x125\n" +
 	"\binterval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12!\n" +
 	"\fcluster_uuid\x18\x02 \x01(\tR\vclusterUuid\x12%\n" +
 	"\x0eencryption_key\x18\x03 \x01(\fR\rencryptionKey\x129\n" +
 	"\x19aes_cbc_encryption_secret\x18\x04 \x01(\tR\x16aesCbcEncryptionSecret\x12>\n" +
-	"\x1bsecretbox_encryption_secret\x18\x05 \x01(\tR\x19secretboxEncryptionSecret\"\xd7\x01\n" +
+	"\x1bsecretbox_encryption_secret\x18\x05 \x01(\tR\x19secretboxEncryptionSecret\x128\n" +
+	"\tretention\x18\x06 \x01(\v2\x1a.specs.EtcdBackupRetentionR\tretention\"\xd7\x01\n" +
 	"\x14EtcdBackupS3ConfSpec\x12\x16\n" +
 	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x16\n" +
 	"\x06region\x18\x02 \x01(\tR\x06region\x12\x1a\n" +
 	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12\"\n" +
 	"\raccess_key_id\x18\x04 \x01(\tR\vaccessKeyId\x12*\n" +
 	"\x11secret_access_key\x18\x05 \x01(\tR\x0fsecretAccessKey\x12#\n" +
-	"\rsession_token\x18\x06 \x01(\tR\fsessionToken\"\xb1\x02\n" +
+	"\rsession_token\x18\x06 \x01(\tR\fsessionToken\"\x8c\x06\n" +
+	"\x19EtcdBackupStoreConfigSpec\x12B\n" +
+	"\abackend\x18\x01 \x01(\x0e2(.specs.EtcdBackupStoreConfigSpec.BackendR\abackend\x12<\n" +
+	"\x03gcs\x18\x02 \x01(\v2*.specs.EtcdBackupStoreConfigSpec.GCSConfigR\x03gcs\x12O\n" +
+	"\n" +
+	"azure_blob\x18\x03 \x01(\v20.specs.EtcdBackupStoreConfigSpec.AzureBlobConfigR\tazureBlob\x12?\n" +
+	"\x04sftp\x18\x04 \x01(\v2+.specs.EtcdBackupStoreConfigSpec.SFTPConfigR\x04sftp\x1aj\n" +
+	"\tGCSConfig\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12)\n" +
+	"\x10credentials_json\x18\x02 \x01(\tR\x0fcredentialsJson\x12\x1a\n" +
+	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x1a\x8f\x01\n" +
+	"\x0fAzureBlobConfig\x12!\n" +
+	"\faccount_name\x18\x01 \x01(\tR\vaccountName\x12\x1f\n" +
+	"\vaccount_key\x18\x02 \x01(\tR\n" +
+	"accountKey\x12\x1c\n" +
+	"\tcontainer\x18\x03 \x01(\tR\tcontainer\x12\x1a\n" +
+	"\bendpoint\x18\x04 \x01(\tR\bendpoint\x1a\xa6\x01\n" +
+	"\n" +
+	"SFTPConfig\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x12\n" +
+	"\x04user\x18\x02 \x01(\tR\x04user\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x1f\n" +
+	"\vprivate_key\x18\x04 \x01(\tR\n" +
+	"privateKey\x12\x19\n" +
+	"\bhost_key\x18\x05 \x01(\tR\ahostKey\x12\x12\n" +
+	"\x04path\x18\x06 \x01(\tR\x04path\"4\n" +
+	"\aBackend\x12\x06\n" +
+	"\x02S3\x10\x00\x12\a\n" +
+	"\x03GCS\x10\x01\x12\x0e\n" +
+	"\n" +
+	"AZURE_BLOB\x10\x02\x12\b\n" +
+	"\x04SFTP\x10\x03\"\x86\x03\n" +
 	"\x14EtcdBackupStatusSpec\x12:\n" +
 	"\x06status\x18\x01 \x01(\x0e2\".specs.EtcdBackupStatusSpec.StatusR\x06status\x12\x14\n" +
 	"\x05error\x18\x02 \x01(\tR\x05error\x12D\n" +
 	"\x10last_backup_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastBackupTime\x12J\n" +
-	"\x13last_backup_attempt\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x11lastBackupAttempt\"5\n" +
+	"\x13last_backup_attempt\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x11lastBackupAttempt\x122\n" +
+	"\x15last_pruned_snapshots\x18\x05 \x03(\tR\x13lastPrunedSnapshots\x12\x1f\n" +
+	"\vprune_error\x18\x06 \x01(\tR\n" +
+	"pruneError\"5\n" +
 	"\x06Status\x12\v\n" +
 	"\aUnknown\x10\x00\x12\x06\n" +
 	"\x02Ok\x10\x01\x12\t\n" +
@@ -12268,10 +15116,11 @@ const file_omni_specs_omni_proto_rawDesc = "" +
 	"\x13configuration_error\x18\x02 \x01(\tR\x12configurationError\x12I\n" +
 	"\x12last_backup_status\x18\x03 \x01(\v2\x1b.specs.EtcdBackupStatusSpecR\x10lastBackupStatus\"I\n" +
 	"\x12ClusterMachineSpec\x12-\n" +
-	"\x12kubernetes_version\x18\x02 \x01(\tR\x11kubernetesVersionJ\x04\b\x01\x10\x02\"j\n" +
+	"\x12kubernetes_version\x18\x02 \x01(\tR\x11kubernetesVersionJ\x04\b\x01\x10\x02\"\xae\x01\n" +
 	"\x1fClusterMachineConfigPatchesSpec\x12\x18\n" +
 	"\apatches\x18\x01 \x03(\tR\apatches\x12-\n" +
-	"\x12compressed_patches\x18\x02 \x03(\fR\x11compressedPatches\"h\n" +
+	"\x12compressed_patches\x18\x02 \x03(\fR\x11compressedPatches\x12B\n" +
+	"\bprevious\x18\x03 \x01(\v2&.specs.ClusterMachineConfigPatchesSpecR\bprevious\"h\n" +
 	"\x1eClusterMachineTalosVersionSpec\x12#\n" +
 	"\rtalos_version\x18\x01 \x01(\tR\ftalosVersion\x12!\n" +
 	"\fschematic_id\x18\x02 \x01(\tR\vschematicId\"\x87\x02\n" +
@@ -12292,7 +15141,7 @@ const file_omni_specs_omni_proto_rawDesc = "" +
 	"\bnode_ips\x18\b \x03(\tR\anodeIps\x12<\n" +
 	"\x1adiscovery_service_endpoint\x18\t \x01(\tR\x18discoveryServiceEndpoint\x12>\n" +
 	"\x1bdiscovery_service_endpoints\x18\n" +
-	" \x03(\tR\x19discoveryServiceEndpoints\"\xda\x05\n" +
+	" \x03(\tR\x19discoveryServiceEndpoints\"\x81\x06\n" +
 	"\x18ClusterMachineStatusSpec\x12\x14\n" +
 	"\x05ready\x18\x01 \x01(\bR\x05ready\x12;\n" +
 	"\x05stage\x18\x02 \x01(\x0e2%.specs.ClusterMachineStatusSpec.StageR\x05stage\x12)\n" +
@@ -12302,7 +15151,9 @@ const file_omni_specs_omni_proto_rawDesc = "" +
 	"\x13config_apply_status\x18\a \x01(\x0e2\x18.specs.ConfigApplyStatusR\x11configApplyStatus\x12\x1d\n" +
 	"\n" +
 	"is_removed\x18\b \x01(\bR\tisRemoved\x12Z\n" +
-	"\x10provision_status\x18\t \x01(\v2/.specs.ClusterMachineStatusSpec.ProvisionStatusR\x0fprovisionStatus\x1aQ\n" +
+	"\x10provision_status\x18\t \x01(\v2/.specs.ClusterMachineStatusSpec.ProvisionStatusR\x0fprovisionStatus\x12%\n" +
+	"\x0epending_reason\x18\n" +
+	" \x01(\tR\rpendingReason\x1aQ\n" +
 	"\x0fProvisionStatus\x12\x1f\n" +
 	"\vprovider_id\x18\x01 \x01(\tR\n" +
 	"providerId\x12\x1d\n" +
@@ -12354,7 +15205,7 @@ const file_omni_specs_omni_proto_rawDesc = "" +
 	"\vClusterUUID\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"4\n" +
 	"\x18ClusterConfigVersionSpec\x12\x18\n" +
-	"\aversion\x18\x01 \x01(\tR\aversion\"\xe7\x04\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\"\x8e\x05\n" +
 	"\x1eClusterMachineConfigStatusSpec\x12C\n" +
 	"\x1ecluster_machine_config_version\x18\x03 \x01(\tR\x1bclusterMachineConfigVersion\x12A\n" +
 	"\x1dcluster_machine_config_sha256\x18\x05 \x01(\tR\x1aclusterMachineConfigSha256\x12*\n" +
@@ -12366,7 +15217,8 @@ const file_omni_specs_omni_proto_rawDesc = "" +
 	" \x01(\fR\x1fcompressedRedactedMachineConfig\x12+\n" +
 	"\x12pre_reboot_boot_id\x18\v \x01(\tR\x0fpreRebootBootId\x12H\n" +
 	"!applied_high_priority_config_hash\x18\f \x01(\tR\x1dappliedHighPriorityConfigHash\x12,\n" +
-	"\x12image_factory_host\x18\r \x01(\tR\x10imageFactoryHostJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03J\x04\b\x04\x10\x05\"\xce\x02\n" +
+	"\x12image_factory_host\x18\r \x01(\tR\x10imageFactoryHost\x12%\n" +
+	"\x0epending_reason\x18\x0e \x01(\tR\rpendingReasonJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03J\x04\b\x04\x10\x05\"\xce\x02\n" +
 	"\x19MachinePendingUpdatesSpec\x12B\n" +
 	"\aupgrade\x18\x01 \x01(\v2(.specs.MachinePendingUpdatesSpec.UpgradeR\aupgrade\x12\x1f\n" +
 	"\vconfig_diff\x18\x02 \x01(\tR\n" +
@@ -12379,15 +15231,18 @@ const file_omni_specs_omni_proto_rawDesc = "" +
 	"\n" +
 	"to_version\x18\x04 \x01(\tR\ttoVersion\"@\n" +
 	"\x1aClusterBootstrapStatusSpec\x12\"\n" +
-	"\fbootstrapped\x18\x01 \x01(\bR\fbootstrapped\"\xa4\x02\n" +
+	"\fbootstrapped\x18\x01 \x01(\bR\fbootstrapped\"\xe2\x03\n" +
 	"\x12ClusterSecretsSpec\x12\x12\n" +
 	"\x04data\x18\x01 \x01(\fR\x04data\x12\x1a\n" +
 	"\bimported\x18\x02 \x01(\bR\bimported\x12@\n" +
 	"\vextra_certs\x18\x03 \x01(\v2\x1f.specs.ClusterSecretsSpec.CertsR\n" +
-	"extraCerts\x1a\x9b\x01\n" +
+	"extraCerts\x1a\xd9\x02\n" +
 	"\x05Certs\x122\n" +
 	"\x02os\x18\x01 \x01(\v2\".specs.ClusterSecretsSpec.Certs.CAR\x02os\x124\n" +
-	"\x03k8s\x18\x02 \x01(\v2\".specs.ClusterSecretsSpec.Certs.CAR\x03k8s\x1a(\n" +
+	"\x03k8s\x18\x02 \x01(\v2\".specs.ClusterSecretsSpec.Certs.CAR\x03k8s\x126\n" +
+	"\x04etcd\x18\x03 \x01(\v2\".specs.ClusterSecretsSpec.Certs.CAR\x04etcd\x12I\n" +
+	"\x0ek8s_aggregator\x18\x04 \x01(\v2\".specs.ClusterSecretsSpec.Certs.CAR\rk8sAggregator\x129\n" +
+	"\x06k8s_sa\x18\x05 \x01(\v2\".specs.ClusterSecretsSpec.Certs.CAR\x05k8sSa\x1a(\n" +
 	"\x02CA\x12\x10\n" +
 	"\x03crt\x18\x01 \x01(\fR\x03crt\x12\x10\n" +
 	"\x03key\x18\x02 \x01(\fR\x03key\"0\n" +
@@ -12425,7 +15280,7 @@ const file_omni_specs_omni_proto_rawDesc = "" +
 	"\x11min_talos_version\x18\r \x01(\tR\x0fminTalosVersion\"N\n" +
 	"\x0fConfigPatchSpec\x12\x12\n" +
 	"\x04data\x18\x01 \x01(\tR\x04data\x12'\n" +
-	"\x0fcompressed_data\x18\x02 \x01(\fR\x0ecompressedData\"\xd2\v\n" +
+	"\x0fcompressed_data\x18\x02 \x01(\fR\x0ecompressedData\"\xa3\x10\n" +
 	"\x0eMachineSetSpec\x12M\n" +
 	"\x0fupdate_strategy\x18\x01 \x01(\x0e2$.specs.MachineSetSpec.UpdateStrategyR\x0eupdateStrategy\x12P\n" +
 	"\rmachine_class\x18\x02 \x01(\v2'.specs.MachineSetSpec.MachineAllocationB\x02\x18\x01R\fmachineClass\x12J\n" +
@@ -12435,7 +15290,9 @@ const file_omni_specs_omni_proto_rawDesc = "" +
 	"\x16delete_strategy_config\x18\x06 \x01(\v2*.specs.MachineSetSpec.UpdateStrategyConfigR\x14deleteStrategyConfig\x12V\n" +
 	"\x12machine_allocation\x18\a \x01(\v2'.specs.MachineSetSpec.MachineAllocationR\x11machineAllocation\x12O\n" +
 	"\x10upgrade_strategy\x18\b \x01(\x0e2$.specs.MachineSetSpec.UpdateStrategyR\x0fupgradeStrategy\x12b\n" +
-	"\x17upgrade_strategy_config\x18\t \x01(\v2*.specs.MachineSetSpec.UpdateStrategyConfigR\x15upgradeStrategyConfig\x1a\xbc\x01\n" +
+	"\x17upgrade_strategy_config\x18\t \x01(\v2*.specs.MachineSetSpec.UpdateStrategyConfigR\x15upgradeStrategyConfig\x12-\n" +
+	"\x12maintenance_window\x18\n" +
+	" \x01(\tR\x11maintenanceWindow\x1a\xbc\x01\n" +
 	"\fMachineClass\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
 	"\rmachine_count\x18\x02 \x01(\rR\fmachineCount\x12P\n" +
@@ -12443,11 +15300,15 @@ const file_omni_specs_omni_proto_rawDesc = "" +
 	"\x04Type\x12\n" +
 	"\n" +
 	"\x06Static\x10\x00\x12\r\n" +
-	"\tUnlimited\x10\x01\x1a\xcc\x01\n" +
+	"\tUnlimited\x10\x01\x1a\x8a\x03\n" +
 	"\x11MachineAllocation\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
 	"\rmachine_count\x18\x02 \x01(\rR\fmachineCount\x12U\n" +
-	"\x0fallocation_type\x18\x03 \x01(\x0e2,.specs.MachineSetSpec.MachineAllocation.TypeR\x0eallocationType\"!\n" +
+	"\x0fallocation_type\x18\x03 \x01(\x0e2,.specs.MachineSetSpec.MachineAllocation.TypeR\x0eallocationType\x12U\n" +
+	"\vautoscaling\x18\x05 \x01(\v23.specs.MachineSetSpec.MachineAllocation.AutoscalingR\vautoscaling\x1ae\n" +
+	"\vAutoscaling\x12*\n" +
+	"\x11min_machine_count\x18\x01 \x01(\rR\x0fminMachineCount\x12*\n" +
+	"\x11max_machine_count\x18\x02 \x01(\rR\x0fmaxMachineCount\"!\n" +
 	"\x04Type\x12\n" +
 	"\n" +
 	"\x06Static\x10\x00\x12\r\n" +
@@ -12456,12 +15317,21 @@ const file_omni_specs_omni_proto_rawDesc = "" +
 	"\fcluster_uuid\x18\x01 \x01(\tR\vclusterUuid\x12\x1a\n" +
 	"\bsnapshot\x18\x02 \x01(\tR\bsnapshot\x1aF\n" +
 	"\x1bRollingUpdateStrategyConfig\x12'\n" +
-	"\x0fmax_parallelism\x18\x01 \x01(\rR\x0emaxParallelism\x1ac\n" +
+	"\x0fmax_parallelism\x18\x01 \x01(\rR\x0emaxParallelism\x1a\x8a\x02\n" +
+	"\x1aCanaryUpdateStrategyConfig\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\rR\x05count\x12'\n" +
+	"\x0fmax_parallelism\x18\x02 \x01(\rR\x0emaxParallelism\x12>\n" +
+	"\rsoak_duration\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\fsoakDuration\x12)\n" +
+	"\x10require_approval\x18\x04 \x01(\bR\x0frequireApproval\x12B\n" +
+	"\x0ffailure_timeout\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x0efailureTimeout\x1a\xad\x01\n" +
 	"\x14UpdateStrategyConfig\x12K\n" +
-	"\arolling\x18\x01 \x01(\v21.specs.MachineSetSpec.RollingUpdateStrategyConfigR\arolling\"(\n" +
+	"\arolling\x18\x01 \x01(\v21.specs.MachineSetSpec.RollingUpdateStrategyConfigR\arolling\x12H\n" +
+	"\x06canary\x18\x02 \x01(\v20.specs.MachineSetSpec.CanaryUpdateStrategyConfigR\x06canary\"4\n" +
 	"\x0eUpdateStrategy\x12\t\n" +
 	"\x05Unset\x10\x00\x12\v\n" +
-	"\aRolling\x10\x01\"\x93\x03\n" +
+	"\aRolling\x10\x01\x12\n" +
+	"\n" +
+	"\x06Canary\x10\x02\"\x93\x03\n" +
 	"\x16TalosUpgradeStatusSpec\x129\n" +
 	"\x05phase\x18\x01 \x01(\x0e2#.specs.TalosUpgradeStatusSpec.PhaseR\x05phase\x12\x14\n" +
 	"\x05error\x18\x02 \x01(\tR\x05error\x12\x12\n" +
@@ -12477,7 +15347,7 @@ const file_omni_specs_omni_proto_rawDesc = "" +
 	"\n" +
 	"\x06Failed\x10\x03\x12\r\n" +
 	"\tReverting\x10\x04\x12\x1d\n" +
-	"\x19UpdatingMachineSchematics\x10\x05\"\xcf\x02\n" +
+	"\x19UpdatingMachineSchematics\x10\x05\"\xd3\x03\n" +
 	"\x14MachineSetStatusSpec\x12,\n" +
 	"\x05phase\x18\x01 \x01(\x0e2\x16.specs.MachineSetPhaseR\x05phase\x12\x14\n" +
 	"\x05ready\x18\x02 \x01(\bR\x05ready\x12\x14\n" +
@@ -12486,9 +15356,39 @@ const file_omni_specs_omni_proto_rawDesc = "" +
 	"\vconfig_hash\x18\x05 \x01(\tR\n" +
 	"configHash\x12V\n" +
 	"\x12machine_allocation\x18\x06 \x01(\v2'.specs.MachineSetSpec.MachineAllocationR\x11machineAllocation\x12%\n" +
-	"\x0elocked_updates\x18\a \x01(\rR\rlockedUpdatesJ\x04\b\b\x10\tJ\x04\b\t\x10\n" +
+	"\x0elocked_updates\x18\a \x01(\rR\rlockedUpdates\x12A\n" +
+	"\x0eupgrade_canary\x18\v \x01(\v2\x1a.specs.CanaryRolloutStatusR\rupgradeCanary\x12?\n" +
+	"\rupdate_canary\x18\f \x01(\v2\x1a.specs.CanaryRolloutStatusR\fupdateCanaryJ\x04\b\b\x10\tJ\x04\b\t\x10\n" +
 	"J\x04\b\n" +
-	"\x10\v\"\xb7\x02\n" +
+	"\x10\v\"\xd7\x06\n" +
+	"\x13CanaryRolloutStatus\x126\n" +
+	"\x05phase\x18\x01 \x01(\x0e2 .specs.CanaryRolloutStatus.PhaseR\x05phase\x12\x1a\n" +
+	"\bmachines\x18\x02 \x03(\tR\bmachines\x12A\n" +
+	"\atargets\x18\x03 \x03(\v2'.specs.CanaryRolloutStatus.TargetsEntryR\atargets\x12M\n" +
+	"\x14verification_started\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x13verificationStarted\x12C\n" +
+	"\x0funhealthy_since\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0eunhealthySince\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12]\n" +
+	"\x11rollback_versions\x18\a \x03(\v20.specs.CanaryRolloutStatus.RollbackVersionsEntryR\x10rollbackVersions\x128\n" +
+	"\x18rollback_config_machines\x18\b \x03(\tR\x16rollbackConfigMachines\x1aY\n" +
+	"\x0fRollbackVersion\x12#\n" +
+	"\rtalos_version\x18\x01 \x01(\tR\ftalosVersion\x12!\n" +
+	"\fschematic_id\x18\x02 \x01(\tR\vschematicId\x1a:\n" +
+	"\fTargetsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1ao\n" +
+	"\x15RollbackVersionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12@\n" +
+	"\x05value\x18\x02 \x01(\v2*.specs.CanaryRolloutStatus.RollbackVersionR\x05value:\x028\x01\"\\\n" +
+	"\x05Phase\x12\v\n" +
+	"\aUnknown\x10\x00\x12\f\n" +
+	"\bUpdating\x10\x01\x12\r\n" +
+	"\tVerifying\x10\x02\x12\f\n" +
+	"\bPromoted\x10\x03\x12\x0f\n" +
+	"\vRollingBack\x10\x04\x12\n" +
+	"\n" +
+	"\x06Failed\x10\x05\".\n" +
+	"\x12CanaryApprovalSpec\x12\x18\n" +
+	"\acomment\x18\x01 \x01(\tR\acomment\"\xb7\x02\n" +
 	"\x1aMachineSetConfigStatusSpec\x124\n" +
 	"\x16config_updates_allowed\x18\x01 \x01(\bR\x14configUpdatesAllowed\x122\n" +
 	"\x15should_reset_graceful\x18\x02 \x01(\bR\x13shouldResetGraceful\x12M\n" +
@@ -12575,7 +15475,7 @@ const file_omni_specs_omni_proto_rawDesc = "" +
 	"resourceIdB\t\n" +
 	"\adetails\"5\n" +
 	"\x1fClusterMachineEncryptionKeySpec\x12\x12\n" +
-	"\x04data\x18\x01 \x01(\fR\x04data\"\xb5\x01\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"\xee\x04\n" +
 	"\x12ExposedServiceSpec\x12\x12\n" +
 	"\x04port\x18\x01 \x01(\rR\x04port\x12\x14\n" +
 	"\x05label\x18\x02 \x01(\tR\x05label\x12\x1f\n" +
@@ -12583,7 +15483,25 @@ const file_omni_specs_omni_proto_rawDesc = "" +
 	"iconBase64\x12\x10\n" +
 	"\x03url\x18\x04 \x01(\tR\x03url\x12\x14\n" +
 	"\x05error\x18\x05 \x01(\tR\x05error\x12,\n" +
-	"\x12has_explicit_alias\x18\x06 \x01(\bR\x10hasExplicitAlias\"R\n" +
+	"\x12has_explicit_alias\x18\x06 \x01(\bR\x10hasExplicitAlias\x12>\n" +
+	"\bprotocol\x18\a \x01(\x0e2\".specs.ExposedServiceSpec.ProtocolR\bprotocol\x12\x1d\n" +
+	"\n" +
+	"proxy_port\x18\b \x01(\rR\tproxyPort\x128\n" +
+	"\x06access\x18\t \x01(\v2 .specs.ExposedServiceSpec.AccessR\x06access\x12]\n" +
+	"\x13identity_forwarding\x18\n" +
+	" \x01(\x0e2,.specs.ExposedServiceSpec.IdentityForwardingR\x12identityForwarding\x1a`\n" +
+	"\x06Access\x12\x19\n" +
+	"\bmin_role\x18\x01 \x01(\tR\aminRole\x12\x14\n" +
+	"\x05users\x18\x02 \x03(\tR\x05users\x12%\n" +
+	"\x0elabel_selector\x18\x03 \x01(\tR\rlabelSelector\"'\n" +
+	"\bProtocol\x12\b\n" +
+	"\x04HTTP\x10\x00\x12\b\n" +
+	"\x04GRPC\x10\x01\x12\a\n" +
+	"\x03TCP\x10\x02\"4\n" +
+	"\x12IdentityForwarding\x12\b\n" +
+	"\x04NONE\x10\x00\x12\v\n" +
+	"\aHEADERS\x10\x01\x12\a\n" +
+	"\x03JWT\x10\x02\"R\n" +
 	"\x1eClusterWorkloadProxyStatusSpec\x120\n" +
 	"\x14num_exposed_services\x18\x01 \x01(\rR\x12numExposedServices\"\x8f\a\n" +
 	"\x12FeaturesConfigSpec\x128\n" +
@@ -12882,7 +15800,7 @@ const file_omni_specs_omni_proto_rawDesc = "" +
 	"\x12MachineLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x13\n" +
-	"\x11RotateTalosCASpec\"\xf6\x04\n" +
+	"\x11RotateTalosCASpec\"\x95\b\n" +
 	"\x12SecretRotationSpec\x128\n" +
 	"\x06status\x18\x01 \x01(\x0e2 .specs.SecretRotationSpec.StatusR\x06status\x125\n" +
 	"\x05phase\x18\x02 \x01(\x0e2\x1f.specs.SecretRotationSpec.PhaseR\x05phase\x12A\n" +
@@ -12891,7 +15809,12 @@ const file_omni_specs_omni_proto_rawDesc = "" +
 	"\vextra_certs\x18\x05 \x01(\v2\x1f.specs.ClusterSecretsSpec.CertsR\n" +
 	"extraCerts\x12J\n" +
 	"\x0fbackup_certs_os\x18\x06 \x03(\v2\".specs.ClusterSecretsSpec.Certs.CAR\rbackupCertsOs\x12L\n" +
-	"\x10backup_certs_k8s\x18\a \x03(\v2\".specs.ClusterSecretsSpec.Certs.CAR\x0ebackupCertsK8s\"#\n" +
+	"\x10backup_certs_k8s\x18\a \x03(\v2\".specs.ClusterSecretsSpec.Certs.CAR\x0ebackupCertsK8s\x12N\n" +
+	"\x11backup_certs_etcd\x18\b \x03(\v2\".specs.ClusterSecretsSpec.Certs.CAR\x0fbackupCertsEtcd\x12a\n" +
+	"\x1bbackup_certs_k8s_aggregator\x18\t \x03(\v2\".specs.ClusterSecretsSpec.Certs.CAR\x18backupCertsK8sAggregator\x12Q\n" +
+	"\x13backup_certs_k8s_sa\x18\n" +
+	" \x03(\v2\".specs.ClusterSecretsSpec.Certs.CAR\x10backupCertsK8sSa\x122\n" +
+	"\x15extra_bootstrap_token\x18\v \x01(\tR\x13extraBootstrapToken\"#\n" +
 	"\x06Status\x12\b\n" +
 	"\x04IDLE\x10\x00\x12\x0f\n" +
 	"\vIN_PROGRESS\x10\x01\"<\n" +
@@ -12901,17 +15824,29 @@ const file_omni_specs_omni_proto_rawDesc = "" +
 	"PRE_ROTATE\x10\x01\x12\n" +
 	"\n" +
 	"\x06ROTATE\x10\x02\x12\x0f\n" +
-	"\vPOST_ROTATE\x10\x03\"6\n" +
+	"\vPOST_ROTATE\x10\x03\"\x9a\x01\n" +
 	"\tComponent\x12\b\n" +
 	"\x04NONE\x10\x00\x12\f\n" +
 	"\bTALOS_CA\x10\x01\x12\x11\n" +
-	"\rKUBERNETES_CA\x10\x02\"\xde\x01\n" +
+	"\rKUBERNETES_CA\x10\x02\x12\v\n" +
+	"\aETCD_CA\x10\x03\x12\x1c\n" +
+	"\x18KUBERNETES_AGGREGATOR_CA\x10\x04\x12\"\n" +
+	"\x1eKUBERNETES_SERVICE_ACCOUNT_KEY\x10\x05\x12\x13\n" +
+	"\x0fBOOTSTRAP_TOKEN\x10\x06\"\xb4\x04\n" +
 	" ClusterSecretsRotationStatusSpec\x125\n" +
 	"\x05phase\x18\x01 \x01(\x0e2\x1f.specs.SecretRotationSpec.PhaseR\x05phase\x12A\n" +
 	"\tcomponent\x18\x02 \x01(\x0e2#.specs.SecretRotationSpec.ComponentR\tcomponent\x12\x14\n" +
 	"\x05error\x18\x03 \x01(\tR\x05error\x12\x12\n" +
 	"\x04step\x18\x04 \x01(\tR\x04step\x12\x16\n" +
-	"\x06status\x18\x05 \x01(\tR\x06status\"\xb1\x03\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12H\n" +
+	"\asecrets\x18\x06 \x03(\v2..specs.ClusterSecretsRotationStatusSpec.SecretR\asecrets\x1a\x89\x02\n" +
+	"\x06Secret\x12A\n" +
+	"\tcomponent\x18\x01 \x01(\x0e2#.specs.SecretRotationSpec.ComponentR\tcomponent\x12:\n" +
+	"\n" +
+	"expiration\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expiration\x12?\n" +
+	"\rlast_rotation\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\flastRotation\x12?\n" +
+	"\rnext_rotation\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\fnextRotation\"\xb1\x03\n" +
 	"\x19ClusterMachineSecretsSpec\x12\x12\n" +
 	"\x04data\x18\x01 \x01(\fR\x04data\x12E\n" +
 	"\brotation\x18\x02 \x01(\v2).specs.ClusterMachineSecretsSpec.RotationR\brotation\x1a\xb8\x02\n" +
@@ -12922,12 +15857,24 @@ const file_omni_specs_omni_proto_rawDesc = "" +
 	"\vextra_certs\x18\x04 \x01(\v2\x1f.specs.ClusterSecretsSpec.CertsR\n" +
 	"extraCerts\x126\n" +
 	"\x17secret_rotation_version\x18\x05 \x01(\tR\x15secretRotationVersion\"\x18\n" +
-	"\x16RotateKubernetesCASpec\"\xd5\x01\n" +
+	"\x16RotateKubernetesCASpec\"\x12\n" +
+	"\x10RotateEtcdCASpec\"\"\n" +
+	" RotateKubernetesAggregatorCASpec\"'\n" +
+	"%RotateKubernetesServiceAccountKeySpec\"\x1a\n" +
+	"\x18RotateBootstrapTokenSpec\"\x93\x05\n" +
 	"\x12UpgradeRolloutSpec\x12s\n" +
-	"\x1amachine_sets_upgrade_quota\x18\x01 \x03(\v26.specs.UpgradeRolloutSpec.MachineSetsUpgradeQuotaEntryR\x17machineSetsUpgradeQuota\x1aJ\n" +
+	"\x1amachine_sets_upgrade_quota\x18\x01 \x03(\v26.specs.UpgradeRolloutSpec.MachineSetsUpgradeQuotaEntryR\x17machineSetsUpgradeQuota\x12v\n" +
+	"\x1bmachine_sets_upgrade_canary\x18\x02 \x03(\v27.specs.UpgradeRolloutSpec.MachineSetsUpgradeCanaryEntryR\x18machineSetsUpgradeCanary\x12s\n" +
+	"\x1amachine_sets_update_canary\x18\x03 \x03(\v26.specs.UpgradeRolloutSpec.MachineSetsUpdateCanaryEntryR\x17machineSetsUpdateCanary\x1aJ\n" +
 	"\x1cMachineSetsUpgradeQuotaEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\x98\x01\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1ag\n" +
+	"\x1dMachineSetsUpgradeCanaryEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x120\n" +
+	"\x05value\x18\x02 \x01(\v2\x1a.specs.CanaryRolloutStatusR\x05value:\x028\x01\x1af\n" +
+	"\x1cMachineSetsUpdateCanaryEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x120\n" +
+	"\x05value\x18\x02 \x01(\v2\x1a.specs.CanaryRolloutStatusR\x05value:\x028\x01\"\x98\x01\n" +
 	"\x10NotificationSpec\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
 	"\x04body\x18\x02 \x01(\tR\x04body\x120\n" +
@@ -12935,11 +15882,57 @@ const file_omni_specs_omni_proto_rawDesc = "" +
 	"\x04Type\x12\b\n" +
 	"\x04INFO\x10\x00\x12\v\n" +
 	"\aWARNING\x10\x01\x12\t\n" +
-	"\x05ERROR\x10\x02\"\xca\x01\n" +
+	"\x05ERROR\x10\x02\"\xfb\x02\n" +
+	"\x17NotificationChannelSpec\x127\n" +
+	"\x04type\x18\x01 \x01(\x0e2#.specs.NotificationChannelSpec.TypeR\x04type\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x03 \x01(\tR\x06secret\x12=\n" +
+	"\x04smtp\x18\x04 \x01(\v2).specs.NotificationChannelSpec.SMTPConfigR\x04smtp\x1a|\n" +
+	"\n" +
+	"SMTPConfig\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x12\n" +
+	"\x04from\x18\x04 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x05 \x03(\tR\x02to\"@\n" +
+	"\x04Type\x12\v\n" +
+	"\aUNKNOWN\x10\x00\x12\t\n" +
+	"\x05SLACK\x10\x01\x12\t\n" +
+	"\x05TEAMS\x10\x02\x12\v\n" +
+	"\aWEBHOOK\x10\x03\x12\b\n" +
+	"\x04SMTP\x10\x04\"\xed\x01\n" +
+	"\x14NotificationRuleSpec\x12\x1a\n" +
+	"\bchannels\x18\x01 \x03(\tR\bchannels\x12\x16\n" +
+	"\x06events\x18\x02 \x03(\tR\x06events\x12)\n" +
+	"\x10cluster_selector\x18\x03 \x01(\tR\x0fclusterSelector\x12?\n" +
+	"\fmin_severity\x18\x04 \x01(\x0e2\x1c.specs.NotificationSpec.TypeR\vminSeverity\x125\n" +
+	"\bthrottle\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\bthrottle\"\x8e\x02\n" +
+	"\x1dNotificationChannelStatusSpec\x12\x1c\n" +
+	"\tdelivered\x18\x01 \x01(\x04R\tdelivered\x12\x16\n" +
+	"\x06failed\x18\x02 \x01(\x04R\x06failed\x12\x18\n" +
+	"\apending\x18\x03 \x01(\rR\apending\x12?\n" +
+	"\rlast_delivery\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\flastDelivery\x12=\n" +
+	"\flast_failure\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vlastFailure\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x06 \x01(\tR\tlastError\"\x8b\x04\n" +
 	"\x1bKubernetesManifestGroupSpec\x12'\n" +
 	"\x0fcompressed_data\x18\x01 \x01(\fR\x0ecompressedData\x12\x12\n" +
 	"\x04data\x18\x02 \x01(\tR\x04data\x12;\n" +
-	"\x04mode\x18\x04 \x01(\x0e2'.specs.KubernetesManifestGroupSpec.ModeR\x04mode\"+\n" +
+	"\x04mode\x18\x04 \x01(\x0e2'.specs.KubernetesManifestGroupSpec.ModeR\x04mode\x12A\n" +
+	"\x04helm\x18\x05 \x01(\v2-.specs.KubernetesManifestGroupSpec.HelmSourceR\x04helm\x1a\xfb\x01\n" +
+	"\n" +
+	"HelmSource\x12\x1e\n" +
+	"\n" +
+	"repository\x18\x01 \x01(\tR\n" +
+	"repository\x12\x14\n" +
+	"\x05chart\x18\x02 \x01(\tR\x05chart\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x12!\n" +
+	"\frelease_name\x18\x04 \x01(\tR\vreleaseName\x12\x1c\n" +
+	"\tnamespace\x18\x05 \x01(\tR\tnamespace\x12\x16\n" +
+	"\x06values\x18\x06 \x01(\tR\x06values\x12\x1f\n" +
+	"\vvalues_from\x18\a \x03(\tR\n" +
+	"valuesFrom\x12#\n" +
+	"\rchart_archive\x18\b \x01(\fR\fchartArchive\"+\n" +
 	"\x04Mode\x12\v\n" +
 	"\aUNKNOWN\x10\x00\x12\b\n" +
 	"\x04FULL\x10\x01\x12\f\n" +
@@ -13014,7 +16007,68 @@ const file_omni_specs_omni_proto_rawDesc = "" +
 	"selectable\x12\x1f\n" +
 	"\vskip_reason\x18\x03 \x01(\tR\n" +
 	"skipReason\x12\x18\n" +
-	"\amembers\x18\x04 \x03(\tR\amembers*F\n" +
+	"\amembers\x18\x04 \x03(\tR\amembers\"\xac\x01\n" +
+	"\x13ClusterTemplateSpec\x12\x1a\n" +
+	"\btemplate\x18\x01 \x01(\tR\btemplate\x12>\n" +
+	"\x06values\x18\x02 \x03(\v2&.specs.ClusterTemplateSpec.ValuesEntryR\x06values\x1a9\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf5\x02\n" +
+	"\x19ClusterTemplateStatusSpec\x12<\n" +
+	"\x05phase\x18\x01 \x01(\x0e2&.specs.ClusterTemplateStatusSpec.PhaseR\x05phase\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x18\n" +
+	"\acluster\x18\x03 \x01(\tR\acluster\x12)\n" +
+	"\x10template_version\x18\x04 \x01(\tR\x0ftemplateVersion\x12\x1c\n" +
+	"\tresources\x18\x05 \x01(\rR\tresources\x12+\n" +
+	"\x11drifted_resources\x18\x06 \x03(\tR\x10driftedResources\x129\n" +
+	"\n" +
+	"last_drift\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tlastDrift\"9\n" +
+	"\x05Phase\x12\v\n" +
+	"\aUNKNOWN\x10\x00\x12\v\n" +
+	"\aSYNCING\x10\x01\x12\n" +
+	"\n" +
+	"\x06SYNCED\x10\x02\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x03\"\xe3\x01\n" +
+	"\x11GitRepositorySpec\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x16\n" +
+	"\x06branch\x18\x02 \x01(\tR\x06branch\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12 \n" +
+	"\vcredentials\x18\x04 \x01(\tR\vcredentials\x12>\n" +
+	"\rpoll_interval\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\fpollInterval\x12\x18\n" +
+	"\asuspend\x18\x06 \x01(\bR\asuspend\x12\x14\n" +
+	"\x05prune\x18\a \x01(\bR\x05prune\"\x9c\x01\n" +
+	"\x12GitCredentialsSpec\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12&\n" +
+	"\x0fssh_private_key\x18\x03 \x01(\tR\rsshPrivateKey\x12&\n" +
+	"\x0fssh_known_hosts\x18\x04 \x01(\tR\rsshKnownHosts\"\xb4\x05\n" +
+	"\x17GitRepositoryStatusSpec\x12:\n" +
+	"\x05phase\x18\x01 \x01(\x0e2$.specs.GitRepositoryStatusSpec.PhaseR\x05phase\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x16\n" +
+	"\x06commit\x18\x03 \x01(\tR\x06commit\x12E\n" +
+	"\acommits\x18\x04 \x03(\v2+.specs.GitRepositoryStatusSpec.CommitStatusR\acommits\x12L\n" +
+	"\tresources\x18\x05 \x03(\v2..specs.GitRepositoryStatusSpec.ManagedResourceR\tresources\x129\n" +
+	"\n" +
+	"last_fetch\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tlastFetch\x1a\xdd\x01\n" +
+	"\fCommitStatus\x12\x10\n" +
+	"\x03sha\x18\x01 \x01(\tR\x03sha\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
+	"\x06author\x18\x03 \x01(\tR\x06author\x12:\n" +
+	"\x05phase\x18\x04 \x01(\x0e2$.specs.GitRepositoryStatusSpec.PhaseR\x05phase\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x127\n" +
+	"\tsynced_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bsyncedAt\x1a5\n" +
+	"\x0fManagedResource\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"H\n" +
+	"\x05Phase\x12\v\n" +
+	"\aUNKNOWN\x10\x00\x12\v\n" +
+	"\aSYNCING\x10\x01\x12\n" +
+	"\n" +
+	"\x06SYNCED\x10\x02\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x03\x12\r\n" +
+	"\tSUSPENDED\x10\x04*F\n" +
 	"\x11ConfigApplyStatus\x12\v\n" +
 	"\aUNKNOWN\x10\x00\x12\v\n" +
 	"\aPENDING\x10\x01\x12\v\n" +
@@ -13053,8 +16107,8 @@ func file_omni_specs_omni_proto_rawDescGZIP() []byte {
 	return file_omni_specs_omni_proto_rawDescData
 }
 
-var file_omni_specs_omni_proto_enumTypes = make([]protoimpl.EnumInfo, 31)
-var file_omni_specs_omni_proto_msgTypes = make([]protoimpl.MessageInfo, 164)
+var file_omni_specs_omni_proto_enumTypes = make([]protoimpl.EnumInfo, 38)
+var file_omni_specs_omni_proto_msgTypes = make([]protoimpl.MessageInfo, 199)
 var file_omni_specs_omni_proto_goTypes = []any{
 	(ConfigApplyStatus)(0),                                         // 0: specs.ConfigApplyStatus
 	(MachineSetPhase)(0),                                           // 1: specs.MachineSetPhase
@@ -13063,343 +16117,444 @@ var file_omni_specs_omni_proto_goTypes = []any{
 	(SecurityState_FIPSState)(0),                                   // 4: specs.SecurityState.FIPSState
 	(MachineStatusSpec_Role)(0),                                    // 5: specs.MachineStatusSpec.Role
 	(MachineStatusSpec_PowerState)(0),                              // 6: specs.MachineStatusSpec.PowerState
-	(EtcdBackupStatusSpec_Status)(0),                               // 7: specs.EtcdBackupStatusSpec.Status
-	(ClusterMachineStatusSpec_Stage)(0),                            // 8: specs.ClusterMachineStatusSpec.Stage
-	(ClusterStatusSpec_Phase)(0),                                   // 9: specs.ClusterStatusSpec.Phase
-	(MachineSetSpec_UpdateStrategy)(0),                             // 10: specs.MachineSetSpec.UpdateStrategy
-	(MachineSetSpec_MachineClass_Type)(0),                          // 11: specs.MachineSetSpec.MachineClass.Type
-	(MachineSetSpec_MachineAllocation_Type)(0),                     // 12: specs.MachineSetSpec.MachineAllocation.Type
-	(TalosUpgradeStatusSpec_Phase)(0),                              // 13: specs.TalosUpgradeStatusSpec.Phase
-	(MachineStatusSnapshotSpec_PowerStage)(0),                      // 14: specs.MachineStatusSnapshotSpec.PowerStage
-	(ControlPlaneStatusSpec_Condition_Status)(0),                   // 15: specs.ControlPlaneStatusSpec.Condition.Status
-	(ControlPlaneStatusSpec_Condition_Severity)(0),                 // 16: specs.ControlPlaneStatusSpec.Condition.Severity
-	(KubernetesUpgradeStatusSpec_Phase)(0),                         // 17: specs.KubernetesUpgradeStatusSpec.Phase
-	(MachineUpgradeStatusSpec_Phase)(0),                            // 18: specs.MachineUpgradeStatusSpec.Phase
-	(MachineExtensionsStatusSpec_Item_Phase)(0),                    // 19: specs.MachineExtensionsStatusSpec.Item.Phase
-	(ClusterMachineRequestStatusSpec_Stage)(0),                     // 20: specs.ClusterMachineRequestStatusSpec.Stage
-	(InfraMachineConfigSpec_AcceptanceStatus)(0),                   // 21: specs.InfraMachineConfigSpec.AcceptanceStatus
-	(InfraMachineConfigSpec_MachinePowerState)(0),                  // 22: specs.InfraMachineConfigSpec.MachinePowerState
-	(SecretRotationSpec_Status)(0),                                 // 23: specs.SecretRotationSpec.Status
-	(SecretRotationSpec_Phase)(0),                                  // 24: specs.SecretRotationSpec.Phase
-	(SecretRotationSpec_Component)(0),                              // 25: specs.SecretRotationSpec.Component
-	(NotificationSpec_Type)(0),                                     // 26: specs.NotificationSpec.Type
-	(KubernetesManifestGroupSpec_Mode)(0),                          // 27: specs.KubernetesManifestGroupSpec.Mode
-	(ClusterKubernetesManifestsStatusSpec_ManifestStatus_Phase)(0), // 28: specs.ClusterKubernetesManifestsStatusSpec.ManifestStatus.Phase
-	(ClusterKubernetesManifestsStatusSpec_GroupStatus_Phase)(0),    // 29: specs.ClusterKubernetesManifestsStatusSpec.GroupStatus.Phase
-	(KubernetesHealthCheckStatusSpec_State)(0),                     // 30: specs.KubernetesHealthCheckStatusSpec.State
-	(*MachineSpec)(nil),                                            // 31: specs.MachineSpec
-	(*SecurityState)(nil),                                          // 32: specs.SecurityState
-	(*Overlay)(nil),                                                // 33: specs.Overlay
-	(*MetaValue)(nil),                                              // 34: specs.MetaValue
-	(*MachineStatusSpec)(nil),                                      // 35: specs.MachineStatusSpec
-	(*TalosConfigSpec)(nil),                                        // 36: specs.TalosConfigSpec
-	(*ClusterSpec)(nil),                                            // 37: specs.ClusterSpec
-	(*ClusterTaintSpec)(nil),                                       // 38: specs.ClusterTaintSpec
-	(*EtcdBackupConf)(nil),                                         // 39: specs.EtcdBackupConf
-	(*EtcdBackupEncryptionSpec)(nil),                               // 40: specs.EtcdBackupEncryptionSpec
-	(*EtcdBackupHeader)(nil),                                       // 41: specs.EtcdBackupHeader
-	(*EtcdBackupSpec)(nil),                                         // 42: specs.EtcdBackupSpec
-	(*BackupDataSpec)(nil),                                         // 43: specs.BackupDataSpec
-	(*EtcdBackupS3ConfSpec)(nil),                                   // 44: specs.EtcdBackupS3ConfSpec
-	(*EtcdBackupStatusSpec)(nil),                                   // 45: specs.EtcdBackupStatusSpec
-	(*EtcdManualBackupSpec)(nil),                                   // 46: specs.EtcdManualBackupSpec
-	(*EtcdBackupStoreStatusSpec)(nil),                              // 47: specs.EtcdBackupStoreStatusSpec
-	(*EtcdBackupOverallStatusSpec)(nil),                            // 48: specs.EtcdBackupOverallStatusSpec
-	(*ClusterMachineSpec)(nil),                                     // 49: specs.ClusterMachineSpec
-	(*ClusterMachineConfigPatchesSpec)(nil),                        // 50: specs.ClusterMachineConfigPatchesSpec
-	(*ClusterMachineTalosVersionSpec)(nil),                         // 51: specs.ClusterMachineTalosVersionSpec
-	(*ClusterMachineConfigSpec)(nil),                               // 52: specs.ClusterMachineConfigSpec
-	(*RedactedClusterMachineConfigSpec)(nil),                       // 53: specs.RedactedClusterMachineConfigSpec
-	(*ClusterMachineIdentitySpec)(nil),                             // 54: specs.ClusterMachineIdentitySpec
-	(*ClusterMachineStatusSpec)(nil),                               // 55: specs.ClusterMachineStatusSpec
-	(*Machines)(nil),                                               // 56: specs.Machines
-	(*ClusterStatusSpec)(nil),                                      // 57: specs.ClusterStatusSpec
-	(*ClusterUUID)(nil),                                            // 58: specs.ClusterUUID
-	(*ClusterConfigVersionSpec)(nil),                               // 59: specs.ClusterConfigVersionSpec
-	(*ClusterMachineConfigStatusSpec)(nil),                         // 60: specs.ClusterMachineConfigStatusSpec
-	(*MachinePendingUpdatesSpec)(nil),                              // 61: specs.MachinePendingUpdatesSpec
-	(*ClusterBootstrapStatusSpec)(nil),                             // 62: specs.ClusterBootstrapStatusSpec
-	(*ClusterSecretsSpec)(nil),                                     // 63: specs.ClusterSecretsSpec
-	(*ImportedClusterSecretsSpec)(nil),                             // 64: specs.ImportedClusterSecretsSpec
-	(*LoadBalancerConfigSpec)(nil),                                 // 65: specs.LoadBalancerConfigSpec
-	(*LoadBalancerStatusSpec)(nil),                                 // 66: specs.LoadBalancerStatusSpec
-	(*KubernetesVersionSpec)(nil),                                  // 67: specs.KubernetesVersionSpec
-	(*TalosVersionSpec)(nil),                                       // 68: specs.TalosVersionSpec
-	(*InstallationMediaSpec)(nil),                                  // 69: specs.InstallationMediaSpec
-	(*ConfigPatchSpec)(nil),                                        // 70: specs.ConfigPatchSpec
-	(*MachineSetSpec)(nil),                                         // 71: specs.MachineSetSpec
-	(*TalosUpgradeStatusSpec)(nil),                                 // 72: specs.TalosUpgradeStatusSpec
-	(*MachineSetStatusSpec)(nil),                                   // 73: specs.MachineSetStatusSpec
-	(*MachineSetConfigStatusSpec)(nil),                             // 74: specs.MachineSetConfigStatusSpec
-	(*MachineSetNodeSpec)(nil),                                     // 75: specs.MachineSetNodeSpec
-	(*MachineLabelsSpec)(nil),                                      // 76: specs.MachineLabelsSpec
-	(*MachineStatusSnapshotSpec)(nil),                              // 77: specs.MachineStatusSnapshotSpec
-	(*ControlPlaneStatusSpec)(nil),                                 // 78: specs.ControlPlaneStatusSpec
-	(*ClusterEndpointSpec)(nil),                                    // 79: specs.ClusterEndpointSpec
-	(*KubernetesStatusSpec)(nil),                                   // 80: specs.KubernetesStatusSpec
-	(*KubernetesUpgradeStatusSpec)(nil),                            // 81: specs.KubernetesUpgradeStatusSpec
-	(*KubernetesUpgradeManifestStatusSpec)(nil),                    // 82: specs.KubernetesUpgradeManifestStatusSpec
-	(*DestroyStatusSpec)(nil),                                      // 83: specs.DestroyStatusSpec
-	(*OngoingTaskSpec)(nil),                                        // 84: specs.OngoingTaskSpec
-	(*ClusterMachineEncryptionKeySpec)(nil),                        // 85: specs.ClusterMachineEncryptionKeySpec
-	(*ExposedServiceSpec)(nil),                                     // 86: specs.ExposedServiceSpec
-	(*ClusterWorkloadProxyStatusSpec)(nil),                         // 87: specs.ClusterWorkloadProxyStatusSpec
-	(*FeaturesConfigSpec)(nil),                                     // 88: specs.FeaturesConfigSpec
-	(*UserPilotSettings)(nil),                                      // 89: specs.UserPilotSettings
-	(*PosthogSettings)(nil),                                        // 90: specs.PosthogSettings
-	(*StripeSettings)(nil),                                         // 91: specs.StripeSettings
-	(*Account)(nil),                                                // 92: specs.Account
-	(*EtcdBackupSettings)(nil),                                     // 93: specs.EtcdBackupSettings
-	(*MachineClassSpec)(nil),                                       // 94: specs.MachineClassSpec
-	(*MachineConfigGenOptionsSpec)(nil),                            // 95: specs.MachineConfigGenOptionsSpec
-	(*EtcdAuditResultSpec)(nil),                                    // 96: specs.EtcdAuditResultSpec
-	(*KubeconfigSpec)(nil),                                         // 97: specs.KubeconfigSpec
-	(*KubernetesUsageSpec)(nil),                                    // 98: specs.KubernetesUsageSpec
-	(*ImagePullRequestSpec)(nil),                                   // 99: specs.ImagePullRequestSpec
-	(*ImagePullStatusSpec)(nil),                                    // 100: specs.ImagePullStatusSpec
-	(*SchematicSpec)(nil),                                          // 101: specs.SchematicSpec
-	(*TalosExtensionsSpec)(nil),                                    // 102: specs.TalosExtensionsSpec
-	(*SchematicConfigurationSpec)(nil),                             // 103: specs.SchematicConfigurationSpec
-	(*ExtensionsConfigurationSpec)(nil),                            // 104: specs.ExtensionsConfigurationSpec
-	(*KernelArgsSpec)(nil),                                         // 105: specs.KernelArgsSpec
-	(*KernelArgsStatusSpec)(nil),                                   // 106: specs.KernelArgsStatusSpec
-	(*MachineUpgradeStatusSpec)(nil),                               // 107: specs.MachineUpgradeStatusSpec
-	(*MachineExtensionsSpec)(nil),                                  // 108: specs.MachineExtensionsSpec
-	(*MachineExtensionsStatusSpec)(nil),                            // 109: specs.MachineExtensionsStatusSpec
-	(*MachineStatusMetricsSpec)(nil),                               // 110: specs.MachineStatusMetricsSpec
-	(*ClusterMetricsSpec)(nil),                                     // 111: specs.ClusterMetricsSpec
-	(*ClusterStatusMetricsSpec)(nil),                               // 112: specs.ClusterStatusMetricsSpec
-	(*ClusterKubernetesNodesSpec)(nil),                             // 113: specs.ClusterKubernetesNodesSpec
-	(*KubernetesNodeAuditResultSpec)(nil),                          // 114: specs.KubernetesNodeAuditResultSpec
-	(*MachineRequestSetSpec)(nil),                                  // 115: specs.MachineRequestSetSpec
-	(*MachineRequestSetStatusSpec)(nil),                            // 116: specs.MachineRequestSetStatusSpec
-	(*ClusterDiagnosticsSpec)(nil),                                 // 117: specs.ClusterDiagnosticsSpec
-	(*MachineRequestSetPressureSpec)(nil),                          // 118: specs.MachineRequestSetPressureSpec
-	(*ClusterMachineRequestStatusSpec)(nil),                        // 119: specs.ClusterMachineRequestStatusSpec
-	(*InfraMachineConfigSpec)(nil),                                 // 120: specs.InfraMachineConfigSpec
-	(*InfraMachineBMCConfigSpec)(nil),                              // 121: specs.InfraMachineBMCConfigSpec
-	(*MaintenanceConfigStatusSpec)(nil),                            // 122: specs.MaintenanceConfigStatusSpec
-	(*NodeForceDestroyRequestSpec)(nil),                            // 123: specs.NodeForceDestroyRequestSpec
-	(*DiscoveryAffiliateDeleteTaskSpec)(nil),                       // 124: specs.DiscoveryAffiliateDeleteTaskSpec
-	(*InfraProviderCombinedStatusSpec)(nil),                        // 125: specs.InfraProviderCombinedStatusSpec
-	(*MachineConfigDiffSpec)(nil),                                  // 126: specs.MachineConfigDiffSpec
-	(*InstallationMediaConfigSpec)(nil),                            // 127: specs.InstallationMediaConfigSpec
-	(*RotateTalosCASpec)(nil),                                      // 128: specs.RotateTalosCASpec
-	(*SecretRotationSpec)(nil),                                     // 129: specs.SecretRotationSpec
-	(*ClusterSecretsRotationStatusSpec)(nil),                       // 130: specs.ClusterSecretsRotationStatusSpec
-	(*ClusterMachineSecretsSpec)(nil),                              // 131: specs.ClusterMachineSecretsSpec
-	(*RotateKubernetesCASpec)(nil),                                 // 132: specs.RotateKubernetesCASpec
-	(*UpgradeRolloutSpec)(nil),                                     // 133: specs.UpgradeRolloutSpec
-	(*NotificationSpec)(nil),                                       // 134: specs.NotificationSpec
-	(*KubernetesManifestGroupSpec)(nil),                            // 135: specs.KubernetesManifestGroupSpec
-	(*ClusterKubernetesManifestsStatusSpec)(nil),                   // 136: specs.ClusterKubernetesManifestsStatusSpec
-	(*KubernetesHealthCheckSpec)(nil),                              // 137: specs.KubernetesHealthCheckSpec
-	(*KubernetesHealthCheckStatusSpec)(nil),                        // 138: specs.KubernetesHealthCheckStatusSpec
-	(*MachineConfigExtractionStatusSpec)(nil),                      // 139: specs.MachineConfigExtractionStatusSpec
-	(*ImageFactoryAuthSpec)(nil),                                   // 140: specs.ImageFactoryAuthSpec
-	(*MachineInstallDiskConfigSpec)(nil),                           // 141: specs.MachineInstallDiskConfigSpec
-	(*MachineInstallDiskStatusSpec)(nil),                           // 142: specs.MachineInstallDiskStatusSpec
-	(*MachineStatusSpec_HardwareStatus)(nil),                       // 143: specs.MachineStatusSpec.HardwareStatus
-	(*MachineStatusSpec_NetworkStatus)(nil),                        // 144: specs.MachineStatusSpec.NetworkStatus
-	(*MachineStatusSpec_PlatformMetadata)(nil),                     // 145: specs.MachineStatusSpec.PlatformMetadata
-	(*MachineStatusSpec_Schematic)(nil),                            // 146: specs.MachineStatusSpec.Schematic
-	(*MachineStatusSpec_Diagnostic)(nil),                           // 147: specs.MachineStatusSpec.Diagnostic
-	nil,                                                            // 148: specs.MachineStatusSpec.ImageLabelsEntry
-	(*MachineStatusSpec_HardwareStatus_Processor)(nil),             // 149: specs.MachineStatusSpec.HardwareStatus.Processor
-	(*MachineStatusSpec_HardwareStatus_MemoryModule)(nil),          // 150: specs.MachineStatusSpec.HardwareStatus.MemoryModule
-	(*MachineStatusSpec_HardwareStatus_BlockDevice)(nil),           // 151: specs.MachineStatusSpec.HardwareStatus.BlockDevice
-	(*MachineStatusSpec_NetworkStatus_NetworkLinkStatus)(nil),      // 152: specs.MachineStatusSpec.NetworkStatus.NetworkLinkStatus
-	nil, // 153: specs.MachineStatusSpec.PlatformMetadata.TagsEntry
-	(*MachineStatusSpec_Schematic_InitialState)(nil),   // 154: specs.MachineStatusSpec.Schematic.InitialState
-	(*ClusterSpec_Features)(nil),                       // 155: specs.ClusterSpec.Features
-	(*ClusterMachineStatusSpec_ProvisionStatus)(nil),   // 156: specs.ClusterMachineStatusSpec.ProvisionStatus
-	(*MachinePendingUpdatesSpec_Upgrade)(nil),          // 157: specs.MachinePendingUpdatesSpec.Upgrade
-	(*ClusterSecretsSpec_Certs)(nil),                   // 158: specs.ClusterSecretsSpec.Certs
-	(*ClusterSecretsSpec_Certs_CA)(nil),                // 159: specs.ClusterSecretsSpec.Certs.CA
-	(*MachineSetSpec_MachineClass)(nil),                // 160: specs.MachineSetSpec.MachineClass
-	(*MachineSetSpec_MachineAllocation)(nil),           // 161: specs.MachineSetSpec.MachineAllocation
-	(*MachineSetSpec_BootstrapSpec)(nil),               // 162: specs.MachineSetSpec.BootstrapSpec
-	(*MachineSetSpec_RollingUpdateStrategyConfig)(nil), // 163: specs.MachineSetSpec.RollingUpdateStrategyConfig
-	(*MachineSetSpec_UpdateStrategyConfig)(nil),        // 164: specs.MachineSetSpec.UpdateStrategyConfig
-	(*ControlPlaneStatusSpec_Condition)(nil),           // 165: specs.ControlPlaneStatusSpec.Condition
-	(*KubernetesStatusSpec_NodeStatus)(nil),            // 166: specs.KubernetesStatusSpec.NodeStatus
-	(*KubernetesStatusSpec_StaticPodStatus)(nil),       // 167: specs.KubernetesStatusSpec.StaticPodStatus
-	(*KubernetesStatusSpec_NodeStaticPods)(nil),        // 168: specs.KubernetesStatusSpec.NodeStaticPods
-	(*MachineClassSpec_Provision)(nil),                 // 169: specs.MachineClassSpec.Provision
-	(*MachineConfigGenOptionsSpec_InstallImage)(nil),   // 170: specs.MachineConfigGenOptionsSpec.InstallImage
-	(*KubernetesUsageSpec_Quantity)(nil),               // 171: specs.KubernetesUsageSpec.Quantity
-	(*KubernetesUsageSpec_Pod)(nil),                    // 172: specs.KubernetesUsageSpec.Pod
-	(*ImagePullRequestSpec_NodeImageList)(nil),         // 173: specs.ImagePullRequestSpec.NodeImageList
-	(*TalosExtensionsSpec_Info)(nil),                   // 174: specs.TalosExtensionsSpec.Info
-	(*MachineExtensionsStatusSpec_Item)(nil),           // 175: specs.MachineExtensionsStatusSpec.Item
-	nil,                                                // 176: specs.MachineStatusMetricsSpec.PlatformsEntry
-	nil,                                                // 177: specs.MachineStatusMetricsSpec.SecureBootStatusEntry
-	nil,                                                // 178: specs.MachineStatusMetricsSpec.UkiStatusEntry
-	nil,                                                // 179: specs.ClusterMetricsSpec.FeaturesEntry
-	nil,                                                // 180: specs.ClusterStatusMetricsSpec.PhasesEntry
-	(*ClusterDiagnosticsSpec_Node)(nil),                // 181: specs.ClusterDiagnosticsSpec.Node
-	(*InfraMachineBMCConfigSpec_IPMI)(nil),             // 182: specs.InfraMachineBMCConfigSpec.IPMI
-	(*InfraMachineBMCConfigSpec_API)(nil),              // 183: specs.InfraMachineBMCConfigSpec.API
-	(*InfraProviderCombinedStatusSpec_Health)(nil),     // 184: specs.InfraProviderCombinedStatusSpec.Health
-	(*InstallationMediaConfigSpec_Cloud)(nil),          // 185: specs.InstallationMediaConfigSpec.Cloud
-	(*InstallationMediaConfigSpec_SBC)(nil),            // 186: specs.InstallationMediaConfigSpec.SBC
-	nil,                                                // 187: specs.InstallationMediaConfigSpec.MachineLabelsEntry
-	(*ClusterMachineSecretsSpec_Rotation)(nil),         // 188: specs.ClusterMachineSecretsSpec.Rotation
-	nil, // 189: specs.UpgradeRolloutSpec.MachineSetsUpgradeQuotaEntry
-	(*ClusterKubernetesManifestsStatusSpec_ManifestStatus)(nil), // 190: specs.ClusterKubernetesManifestsStatusSpec.ManifestStatus
-	(*ClusterKubernetesManifestsStatusSpec_GroupStatus)(nil),    // 191: specs.ClusterKubernetesManifestsStatusSpec.GroupStatus
-	nil, // 192: specs.ClusterKubernetesManifestsStatusSpec.GroupsEntry
-	nil, // 193: specs.ClusterKubernetesManifestsStatusSpec.GroupStatus.ManifestsEntry
-	(*MachineInstallDiskStatusSpec_Disk)(nil), // 194: specs.MachineInstallDiskStatusSpec.Disk
-	(*durationpb.Duration)(nil),               // 195: google.protobuf.Duration
-	(*timestamppb.Timestamp)(nil),             // 196: google.protobuf.Timestamp
-	(*machine.MachineStatusEvent)(nil),        // 197: machine.MachineStatusEvent
-	(PlatformConfigSpec_Arch)(0),              // 198: specs.PlatformConfigSpec.Arch
-	(management.SchematicBootloader)(0),       // 199: management.SchematicBootloader
+	(EtcdBackupStoreConfigSpec_Backend)(0),                         // 7: specs.EtcdBackupStoreConfigSpec.Backend
+	(EtcdBackupStatusSpec_Status)(0),                               // 8: specs.EtcdBackupStatusSpec.Status
+	(ClusterMachineStatusSpec_Stage)(0),                            // 9: specs.ClusterMachineStatusSpec.Stage
+	(ClusterStatusSpec_Phase)(0),                                   // 10: specs.ClusterStatusSpec.Phase
+	(MachineSetSpec_UpdateStrategy)(0),                             // 11: specs.MachineSetSpec.UpdateStrategy
+	(MachineSetSpec_MachineClass_Type)(0),                          // 12: specs.MachineSetSpec.MachineClass.Type
+	(MachineSetSpec_MachineAllocation_Type)(0),                     // 13: specs.MachineSetSpec.MachineAllocation.Type
+	(TalosUpgradeStatusSpec_Phase)(0),                              // 14: specs.TalosUpgradeStatusSpec.Phase
+	(CanaryRolloutStatus_Phase)(0),                                 // 15: specs.CanaryRolloutStatus.Phase
+	(MachineStatusSnapshotSpec_PowerStage)(0),                      // 16: specs.MachineStatusSnapshotSpec.PowerStage
+	(ControlPlaneStatusSpec_Condition_Status)(0),                   // 17: specs.ControlPlaneStatusSpec.Condition.Status
+	(ControlPlaneStatusSpec_Condition_Severity)(0),                 // 18: specs.ControlPlaneStatusSpec.Condition.Severity
+	(KubernetesUpgradeStatusSpec_Phase)(0),                         // 19: specs.KubernetesUpgradeStatusSpec.Phase
+	(ExposedServiceSpec_Protocol)(0),                               // 20: specs.ExposedServiceSpec.Protocol
+	(ExposedServiceSpec_IdentityForwarding)(0),                     // 21: specs.ExposedServiceSpec.IdentityForwarding
+	(MachineUpgradeStatusSpec_Phase)(0),                            // 22: specs.MachineUpgradeStatusSpec.Phase
+	(MachineExtensionsStatusSpec_Item_Phase)(0),                    // 23: specs.MachineExtensionsStatusSpec.Item.Phase
+	(ClusterMachineRequestStatusSpec_Stage)(0),                     // 24: specs.ClusterMachineRequestStatusSpec.Stage
+	(InfraMachineConfigSpec_AcceptanceStatus)(0),                   // 25: specs.InfraMachineConfigSpec.AcceptanceStatus
+	(InfraMachineConfigSpec_MachinePowerState)(0),                  // 26: specs.InfraMachineConfigSpec.MachinePowerState
+	(SecretRotationSpec_Status)(0),                                 // 27: specs.SecretRotationSpec.Status
+	(SecretRotationSpec_Phase)(0),                                  // 28: specs.SecretRotationSpec.Phase
+	(SecretRotationSpec_Component)(0),                              // 29: specs.SecretRotationSpec.Component
+	(NotificationSpec_Type)(0),                                     // 30: specs.NotificationSpec.Type
+	(NotificationChannelSpec_Type)(0),                              // 31: specs.NotificationChannelSpec.Type
+	(KubernetesManifestGroupSpec_Mode)(0),                          // 32: specs.KubernetesManifestGroupSpec.Mode
+	(ClusterKubernetesManifestsStatusSpec_ManifestStatus_Phase)(0), // 33: specs.ClusterKubernetesManifestsStatusSpec.ManifestStatus.Phase
+	(ClusterKubernetesManifestsStatusSpec_GroupStatus_Phase)(0),    // 34: specs.ClusterKubernetesManifestsStatusSpec.GroupStatus.Phase
+	(KubernetesHealthCheckStatusSpec_State)(0),                     // 35: specs.KubernetesHealthCheckStatusSpec.State
+	(ClusterTemplateStatusSpec_Phase)(0),                           // 36: specs.ClusterTemplateStatusSpec.Phase
+	(GitRepositoryStatusSpec_Phase)(0),                             // 37: specs.GitRepositoryStatusSpec.Phase
+	(*MachineSpec)(nil),                                            // 38: specs.MachineSpec
+	(*SecurityState)(nil),                                          // 39: specs.SecurityState
+	(*Overlay)(nil),                                                // 40: specs.Overlay
+	(*MetaValue)(nil),                                              // 41: specs.MetaValue
+	(*MachineStatusSpec)(nil),                                      // 42: specs.MachineStatusSpec
+	(*TalosConfigSpec)(nil),                                        // 43: specs.TalosConfigSpec
+	(*ClusterSpec)(nil),                                            // 44: specs.ClusterSpec
+	(*SecretRotationPolicy)(nil),                                   // 45: specs.SecretRotationPolicy
+	(*MaintenanceWindowSpec)(nil),                                  // 46: specs.MaintenanceWindowSpec
+	(*ClusterTaintSpec)(nil),                                       // 47: specs.ClusterTaintSpec
+	(*EtcdBackupConf)(nil),                                         // 48: specs.EtcdBackupConf
+	(*EtcdBackupRetention)(nil),                                    // 49: specs.EtcdBackupRetention
+	(*EtcdBackupEncryptionSpec)(nil),                               // 50: specs.EtcdBackupEncryptionSpec
+	(*EtcdBackupHeader)(nil),                                       // 51: specs.EtcdBackupHeader
+	(*EtcdBackupSpec)(nil),                                         // 52: specs.EtcdBackupSpec
+	(*BackupDataSpec)(nil),                                         // 53: specs.BackupDataSpec
+	(*EtcdBackupS3ConfSpec)(nil),                                   // 54: specs.EtcdBackupS3ConfSpec
+	(*EtcdBackupStoreConfigSpec)(nil),                              // 55: specs.EtcdBackupStoreConfigSpec
+	(*EtcdBackupStatusSpec)(nil),                                   // 56: specs.EtcdBackupStatusSpec
+	(*EtcdManualBackupSpec)(nil),                                   // 57: specs.EtcdManualBackupSpec
+	(*EtcdBackupStoreStatusSpec)(nil),                              // 58: specs.EtcdBackupStoreStatusSpec
+	(*EtcdBackupOverallStatusSpec)(nil),                            // 59: specs.EtcdBackupOverallStatusSpec
+	(*ClusterMachineSpec)(nil),                                     // 60: specs.ClusterMachineSpec
+	(*ClusterMachineConfigPatchesSpec)(nil),                        // 61: specs.ClusterMachineConfigPatchesSpec
+	(*ClusterMachineTalosVersionSpec)(nil),                         // 62: specs.ClusterMachineTalosVersionSpec
+	(*ClusterMachineConfigSpec)(nil),                               // 63: specs.ClusterMachineConfigSpec
+	(*RedactedClusterMachineConfigSpec)(nil),                       // 64: specs.RedactedClusterMachineConfigSpec
+	(*ClusterMachineIdentitySpec)(nil),                             // 65: specs.ClusterMachineIdentitySpec
+	(*ClusterMachineStatusSpec)(nil),                               // 66: specs.ClusterMachineStatusSpec
+	(*Machines)(nil),                                               // 67: specs.Machines
+	(*ClusterStatusSpec)(nil),                                      // 68: specs.ClusterStatusSpec
+	(*ClusterUUID)(nil),                                            // 69: specs.ClusterUUID
+	(*ClusterConfigVersionSpec)(nil),                               // 70: specs.ClusterConfigVersionSpec
+	(*ClusterMachineConfigStatusSpec)(nil),                         // 71: specs.ClusterMachineConfigStatusSpec
+	(*MachinePendingUpdatesSpec)(nil),                              // 72: specs.MachinePendingUpdatesSpec
+	(*ClusterBootstrapStatusSpec)(nil),                             // 73: specs.ClusterBootstrapStatusSpec
+	(*ClusterSecretsSpec)(nil),                                     // 74: specs.ClusterSecretsSpec
+	(*ImportedClusterSecretsSpec)(nil),                             // 75: specs.ImportedClusterSecretsSpec
+	(*LoadBalancerConfigSpec)(nil),                                 // 76: specs.LoadBalancerConfigSpec
+	(*LoadBalancerStatusSpec)(nil),                                 // 77: specs.LoadBalancerStatusSpec
+	(*KubernetesVersionSpec)(nil),                                  // 78: specs.KubernetesVersionSpec
+	(*TalosVersionSpec)(nil),                                       // 79: specs.TalosVersionSpec
+	(*InstallationMediaSpec)(nil),                                  // 80: specs.InstallationMediaSpec
+	(*ConfigPatchSpec)(nil),                                        // 81: specs.ConfigPatchSpec
+	(*MachineSetSpec)(nil),                                         // 82: specs.MachineSetSpec
+	(*TalosUpgradeStatusSpec)(nil),                                 // 83: specs.TalosUpgradeStatusSpec
+	(*MachineSetStatusSpec)(nil),                                   // 84: specs.MachineSetStatusSpec
+	(*CanaryRolloutStatus)(nil),                                    // 85: specs.CanaryRolloutStatus
+	(*CanaryApprovalSpec)(nil),                                     // 86: specs.CanaryApprovalSpec
+	(*MachineSetConfigStatusSpec)(nil),                             // 87: specs.MachineSetConfigStatusSpec
+	(*MachineSetNodeSpec)(nil),                                     // 88: specs.MachineSetNodeSpec
+	(*MachineLabelsSpec)(nil),                                      // 89: specs.MachineLabelsSpec
+	(*MachineStatusSnapshotSpec)(nil),                              // 90: specs.MachineStatusSnapshotSpec
+	(*ControlPlaneStatusSpec)(nil),                                 // 91: specs.ControlPlaneStatusSpec
+	(*ClusterEndpointSpec)(nil),                                    // 92: specs.ClusterEndpointSpec
+	(*KubernetesStatusSpec)(nil),                                   // 93: specs.KubernetesStatusSpec
+	(*KubernetesUpgradeStatusSpec)(nil),                            // 94: specs.KubernetesUpgradeStatusSpec
+	(*KubernetesUpgradeManifestStatusSpec)(nil),                    // 95: specs.KubernetesUpgradeManifestStatusSpec
+	(*DestroyStatusSpec)(nil),                                      // 96: specs.DestroyStatusSpec
+	(*OngoingTaskSpec)(nil),                                        // 97: specs.OngoingTaskSpec
+	(*ClusterMachineEncryptionKeySpec)(nil),                        // 98: specs.ClusterMachineEncryptionKeySpec
+	(*ExposedServiceSpec)(nil),                                     // 99: specs.ExposedServiceSpec
+	(*ClusterWorkloadProxyStatusSpec)(nil),                         // 100: specs.ClusterWorkloadProxyStatusSpec
+	(*FeaturesConfigSpec)(nil),                                     // 101: specs.FeaturesConfigSpec
+	(*UserPilotSettings)(nil),                                      // 102: specs.UserPilotSettings
+	(*PosthogSettings)(nil),                                        // 103: specs.PosthogSettings
+	(*StripeSettings)(nil),                                         // 104: specs.StripeSettings
+	(*Account)(nil),                                                // 105: specs.Account
+	(*EtcdBackupSettings)(nil),                                     // 106: specs.EtcdBackupSettings
+	(*MachineClassSpec)(nil),                                       // 107: specs.MachineClassSpec
+	(*MachineConfigGenOptionsSpec)(nil),                            // 108: specs.MachineConfigGenOptionsSpec
+	(*EtcdAuditResultSpec)(nil),                                    // 109: specs.EtcdAuditResultSpec
+	(*KubeconfigSpec)(nil),                                         // 110: specs.KubeconfigSpec
+	(*KubernetesUsageSpec)(nil),                                    // 111: specs.KubernetesUsageSpec
+	(*ImagePullRequestSpec)(nil),                                   // 112: specs.ImagePullRequestSpec
+	(*ImagePullStatusSpec)(nil),                                    // 113: specs.ImagePullStatusSpec
+	(*SchematicSpec)(nil),                                          // 114: specs.SchematicSpec
+	(*TalosExtensionsSpec)(nil),                                    // 115: specs.TalosExtensionsSpec
+	(*SchematicConfigurationSpec)(nil),                             // 116: specs.SchematicConfigurationSpec
+	(*ExtensionsConfigurationSpec)(nil),                            // 117: specs.ExtensionsConfigurationSpec
+	(*KernelArgsSpec)(nil),                                         // 118: specs.KernelArgsSpec
+	(*KernelArgsStatusSpec)(nil),                                   // 119: specs.KernelArgsStatusSpec
+	(*MachineUpgradeStatusSpec)(nil),                               // 120: specs.MachineUpgradeStatusSpec
+	(*MachineExtensionsSpec)(nil),                                  // 121: specs.MachineExtensionsSpec
+	(*MachineExtensionsStatusSpec)(nil),                            // 122: specs.MachineExtensionsStatusSpec
+	(*MachineStatusMetricsSpec)(nil),                               // 123: specs.MachineStatusMetricsSpec
+	(*ClusterMetricsSpec)(nil),                                     // 124: specs.ClusterMetricsSpec
+	(*ClusterStatusMetricsSpec)(nil),                               // 125: specs.ClusterStatusMetricsSpec
+	(*ClusterKubernetesNodesSpec)(nil),                             // 126: specs.ClusterKubernetesNodesSpec
+	(*KubernetesNodeAuditResultSpec)(nil),                          // 127: specs.KubernetesNodeAuditResultSpec
+	(*MachineRequestSetSpec)(nil),                                  // 128: specs.MachineRequestSetSpec
+	(*MachineRequestSetStatusSpec)(nil),                            // 129: specs.MachineRequestSetStatusSpec
+	(*ClusterDiagnosticsSpec)(nil),                                 // 130: specs.ClusterDiagnosticsSpec
+	(*MachineRequestSetPressureSpec)(nil),                          // 131: specs.MachineRequestSetPressureSpec
+	(*ClusterMachineRequestStatusSpec)(nil),                        // 132: specs.ClusterMachineRequestStatusSpec
+	(*InfraMachineConfigSpec)(nil),                                 // 133: specs.InfraMachineConfigSpec
+	(*InfraMachineBMCConfigSpec)(nil),                              // 134: specs.InfraMachineBMCConfigSpec
+	(*MaintenanceConfigStatusSpec)(nil),                            // 135: specs.MaintenanceConfigStatusSpec
+	(*NodeForceDestroyRequestSpec)(nil),                            // 136: specs.NodeForceDestroyRequestSpec
+	(*DiscoveryAffiliateDeleteTaskSpec)(nil),                       // 137: specs.DiscoveryAffiliateDeleteTaskSpec
+	(*InfraProviderCombinedStatusSpec)(nil),                        // 138: specs.InfraProviderCombinedStatusSpec
+	(*MachineConfigDiffSpec)(nil),                                  // 139: specs.MachineConfigDiffSpec
+	(*InstallationMediaConfigSpec)(nil),                            // 140: specs.InstallationMediaConfigSpec
+	(*RotateTalosCASpec)(nil),                                      // 141: specs.RotateTalosCASpec
+	(*SecretRotationSpec)(nil),                                     // 142: specs.SecretRotationSpec
+	(*ClusterSecretsRotationStatusSpec)(nil),                       // 143: specs.ClusterSecretsRotationStatusSpec
+	(*ClusterMachineSecretsSpec)(nil),                              // 144: specs.ClusterMachineSecretsSpec
+	(*RotateKubernetesCASpec)(nil),                                 // 145: specs.RotateKubernetesCASpec
+	(*RotateEtcdCASpec)(nil),                                       // 146: specs.RotateEtcdCASpec
+	(*RotateKubernetesAggregatorCASpec)(nil),                       // 147: specs.RotateKubernetesAggregatorCASpec
+	(*RotateKubernetesServiceAccountKeySpec)(nil),                  // 148: specs.RotateKubernetesServiceAccountKeySpec
+	(*RotateBootstrapTokenSpec)(nil),                               // 149: specs.RotateBootstrapTokenSpec
+	(*UpgradeRolloutSpec)(nil),                                     // 150: specs.UpgradeRolloutSpec
+	(*NotificationSpec)(nil),                                       // 151: specs.NotificationSpec
+	(*NotificationChannelSpec)(nil),                                // 152: specs.NotificationChannelSpec
+	(*NotificationRuleSpec)(nil),                                   // 153: specs.NotificationRuleSpec
+	(*NotificationChannelStatusSpec)(nil),                          // 154: specs.NotificationChannelStatusSpec
+	(*KubernetesManifestGroupSpec)(nil),                            // 155: specs.KubernetesManifestGroupSpec
+	(*ClusterKubernetesManifestsStatusSpec)(nil),                   // 156: specs.ClusterKubernetesManifestsStatusSpec
+	(*KubernetesHealthCheckSpec)(nil),                              // 157: specs.KubernetesHealthCheckSpec
+	(*KubernetesHealthCheckStatusSpec)(nil),                        // 158: specs.KubernetesHealthCheckStatusSpec
+	(*MachineConfigExtractionStatusSpec)(nil),                      // 159: specs.MachineConfigExtractionStatusSpec
+	(*ImageFactoryAuthSpec)(nil),                                   // 160: specs.ImageFactoryAuthSpec
+	(*MachineInstallDiskConfigSpec)(nil),                           // 161: specs.MachineInstallDiskConfigSpec
+	(*MachineInstallDiskStatusSpec)(nil),                           // 162: specs.MachineInstallDiskStatusSpec
+	(*ClusterTemplateSpec)(nil),                                    // 163: specs.ClusterTemplateSpec
+	(*ClusterTemplateStatusSpec)(nil),                              // 164: specs.ClusterTemplateStatusSpec
+	(*GitRepositorySpec)(nil),                                      // 165: specs.GitRepositorySpec
+	(*GitCredentialsSpec)(nil),                                     // 166: specs.GitCredentialsSpec
+	(*GitRepositoryStatusSpec)(nil),                                // 167: specs.GitRepositoryStatusSpec
+	(*MachineStatusSpec_HardwareStatus)(nil),                       // 168: specs.MachineStatusSpec.HardwareStatus
+	(*MachineStatusSpec_NetworkStatus)(nil),                        // 169: specs.MachineStatusSpec.NetworkStatus
+	(*MachineStatusSpec_PlatformMetadata)(nil),                     // 170: specs.MachineStatusSpec.PlatformMetadata
+	(*MachineStatusSpec_Schematic)(nil),                            // 171: specs.MachineStatusSpec.Schematic
+	(*MachineStatusSpec_Diagnostic)(nil),                           // 172: specs.MachineStatusSpec.Diagnostic
+	nil,                                                            // 173: specs.MachineStatusSpec.ImageLabelsEntry
+	(*MachineStatusSpec_HardwareStatus_Processor)(nil),             // 174: specs.MachineStatusSpec.HardwareStatus.Processor
+	(*MachineStatusSpec_HardwareStatus_MemoryModule)(nil),          // 175: specs.MachineStatusSpec.HardwareStatus.MemoryModule
+	(*MachineStatusSpec_HardwareStatus_BlockDevice)(nil),           // 176: specs.MachineStatusSpec.HardwareStatus.BlockDevice
+	(*MachineStatusSpec_NetworkStatus_NetworkLinkStatus)(nil),      // 177: specs.MachineStatusSpec.NetworkStatus.NetworkLinkStatus
+	nil, // 178: specs.MachineStatusSpec.PlatformMetadata.TagsEntry
+	(*MachineStatusSpec_Schematic_InitialState)(nil),     // 179: specs.MachineStatusSpec.Schematic.InitialState
+	(*ClusterSpec_Features)(nil),                         // 180: specs.ClusterSpec.Features
+	(*EtcdBackupStoreConfigSpec_GCSConfig)(nil),          // 181: specs.EtcdBackupStoreConfigSpec.GCSConfig
+	(*EtcdBackupStoreConfigSpec_AzureBlobConfig)(nil),    // 182: specs.EtcdBackupStoreConfigSpec.AzureBlobConfig
+	(*EtcdBackupStoreConfigSpec_SFTPConfig)(nil),         // 183: specs.EtcdBackupStoreConfigSpec.SFTPConfig
+	(*ClusterMachineStatusSpec_ProvisionStatus)(nil),     // 184: specs.ClusterMachineStatusSpec.ProvisionStatus
+	(*MachinePendingUpdatesSpec_Upgrade)(nil),            // 185: specs.MachinePendingUpdatesSpec.Upgrade
+	(*ClusterSecretsSpec_Certs)(nil),                     // 186: specs.ClusterSecretsSpec.Certs
+	(*ClusterSecretsSpec_Certs_CA)(nil),                  // 187: specs.ClusterSecretsSpec.Certs.CA
+	(*MachineSetSpec_MachineClass)(nil),                  // 188: specs.MachineSetSpec.MachineClass
+	(*MachineSetSpec_MachineAllocation)(nil),             // 189: specs.MachineSetSpec.MachineAllocation
+	(*MachineSetSpec_BootstrapSpec)(nil),                 // 190: specs.MachineSetSpec.BootstrapSpec
+	(*MachineSetSpec_RollingUpdateStrategyConfig)(nil),   // 191: specs.MachineSetSpec.RollingUpdateStrategyConfig
+	(*MachineSetSpec_CanaryUpdateStrategyConfig)(nil),    // 192: specs.MachineSetSpec.CanaryUpdateStrategyConfig
+	(*MachineSetSpec_UpdateStrategyConfig)(nil),          // 193: specs.MachineSetSpec.UpdateStrategyConfig
+	(*MachineSetSpec_MachineAllocation_Autoscaling)(nil), // 194: specs.MachineSetSpec.MachineAllocation.Autoscaling
+	(*CanaryRolloutStatus_RollbackVersion)(nil),          // 195: specs.CanaryRolloutStatus.RollbackVersion
+	nil,                                      // 196: specs.CanaryRolloutStatus.TargetsEntry
+	nil,                                      // 197: specs.CanaryRolloutStatus.RollbackVersionsEntry
+	(*ControlPlaneStatusSpec_Condition)(nil), // 198: specs.ControlPlaneStatusSpec.Condition
+	(*KubernetesStatusSpec_NodeStatus)(nil),  // 199: specs.KubernetesStatusSpec.NodeStatus
+	(*KubernetesStatusSpec_StaticPodStatus)(nil),     // 200: specs.KubernetesStatusSpec.StaticPodStatus
+	(*KubernetesStatusSpec_NodeStaticPods)(nil),      // 201: specs.KubernetesStatusSpec.NodeStaticPods
+	(*ExposedServiceSpec_Access)(nil),                // 202: specs.ExposedServiceSpec.Access
+	(*MachineClassSpec_Provision)(nil),               // 203: specs.MachineClassSpec.Provision
+	(*MachineConfigGenOptionsSpec_InstallImage)(nil), // 204: specs.MachineConfigGenOptionsSpec.InstallImage
+	(*KubernetesUsageSpec_Quantity)(nil),             // 205: specs.KubernetesUsageSpec.Quantity
+	(*KubernetesUsageSpec_Pod)(nil),                  // 206: specs.KubernetesUsageSpec.Pod
+	(*ImagePullRequestSpec_NodeImageList)(nil),       // 207: specs.ImagePullRequestSpec.NodeImageList
+	(*TalosExtensionsSpec_Info)(nil),                 // 208: specs.TalosExtensionsSpec.Info
+	(*MachineExtensionsStatusSpec_Item)(nil),         // 209: specs.MachineExtensionsStatusSpec.Item
+	nil,                                              // 210: specs.MachineStatusMetricsSpec.PlatformsEntry
+	nil,                                              // 211: specs.MachineStatusMetricsSpec.SecureBootStatusEntry
+	nil,                                              // 212: specs.MachineStatusMetricsSpec.UkiStatusEntry
+	nil,                                              // 213: specs.ClusterMetricsSpec.FeaturesEntry
+	nil,                                              // 214: specs.ClusterStatusMetricsSpec.PhasesEntry
+	(*ClusterDiagnosticsSpec_Node)(nil),              // 215: specs.ClusterDiagnosticsSpec.Node
+	(*InfraMachineBMCConfigSpec_IPMI)(nil),           // 216: specs.InfraMachineBMCConfigSpec.IPMI
+	(*InfraMachineBMCConfigSpec_API)(nil),            // 217: specs.InfraMachineBMCConfigSpec.API
+	(*InfraProviderCombinedStatusSpec_Health)(nil),   // 218: specs.InfraProviderCombinedStatusSpec.Health
+	(*InstallationMediaConfigSpec_Cloud)(nil),        // 219: specs.InstallationMediaConfigSpec.Cloud
+	(*InstallationMediaConfigSpec_SBC)(nil),          // 220: specs.InstallationMediaConfigSpec.SBC
+	nil,                                              // 221: specs.InstallationMediaConfigSpec.MachineLabelsEntry
+	(*ClusterSecretsRotationStatusSpec_Secret)(nil),  // 222: specs.ClusterSecretsRotationStatusSpec.Secret
+	(*ClusterMachineSecretsSpec_Rotation)(nil),       // 223: specs.ClusterMachineSecretsSpec.Rotation
+	nil, // 224: specs.UpgradeRolloutSpec.MachineSetsUpgradeQuotaEntry
+	nil, // 225: specs.UpgradeRolloutSpec.MachineSetsUpgradeCanaryEntry
+	nil, // 226: specs.UpgradeRolloutSpec.MachineSetsUpdateCanaryEntry
+	(*NotificationChannelSpec_SMTPConfig)(nil),                  // 227: specs.NotificationChannelSpec.SMTPConfig
+	(*KubernetesManifestGroupSpec_HelmSource)(nil),              // 228: specs.KubernetesManifestGroupSpec.HelmSource
+	(*ClusterKubernetesManifestsStatusSpec_ManifestStatus)(nil), // 229: specs.ClusterKubernetesManifestsStatusSpec.ManifestStatus
+	(*ClusterKubernetesManifestsStatusSpec_GroupStatus)(nil),    // 230: specs.ClusterKubernetesManifestsStatusSpec.GroupStatus
+	nil, // 231: specs.ClusterKubernetesManifestsStatusSpec.GroupsEntry
+	nil, // 232: specs.ClusterKubernetesManifestsStatusSpec.GroupStatus.ManifestsEntry
+	(*MachineInstallDiskStatusSpec_Disk)(nil), // 233: specs.MachineInstallDiskStatusSpec.Disk
+	nil, // 234: specs.ClusterTemplateSpec.ValuesEntry
+	(*GitRepositoryStatusSpec_CommitStatus)(nil),    // 235: specs.GitRepositoryStatusSpec.CommitStatus
+	(*GitRepositoryStatusSpec_ManagedResource)(nil), // 236: specs.GitRepositoryStatusSpec.ManagedResource
+	(*durationpb.Duration)(nil),                     // 237: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),                   // 238: google.protobuf.Timestamp
+	(*machine.MachineStatusEvent)(nil),              // 239: machine.MachineStatusEvent
+	(PlatformConfigSpec_Arch)(0),                    // 240: specs.PlatformConfigSpec.Arch
+	(management.SchematicBootloader)(0),             // 241: management.SchematicBootloader
 }
 var file_omni_specs_omni_proto_depIdxs = []int32{
 	4,   // 0: specs.SecurityState.fips_state:type_name -> specs.SecurityState.FIPSState
-	143, // 1: specs.MachineStatusSpec.hardware:type_name -> specs.MachineStatusSpec.HardwareStatus
-	144, // 2: specs.MachineStatusSpec.network:type_name -> specs.MachineStatusSpec.NetworkStatus
+	168, // 1: specs.MachineStatusSpec.hardware:type_name -> specs.MachineStatusSpec.HardwareStatus
+	169, // 2: specs.MachineStatusSpec.network:type_name -> specs.MachineStatusSpec.NetworkStatus
 	5,   // 3: specs.MachineStatusSpec.role:type_name -> specs.MachineStatusSpec.Role
-	145, // 4: specs.MachineStatusSpec.platform_metadata:type_name -> specs.MachineStatusSpec.PlatformMetadata
-	148, // 5: specs.MachineStatusSpec.image_labels:type_name -> specs.MachineStatusSpec.ImageLabelsEntry
-	146, // 6: specs.MachineStatusSpec.schematic:type_name -> specs.MachineStatusSpec.Schematic
-	147, // 7: specs.MachineStatusSpec.diagnostics:type_name -> specs.MachineStatusSpec.Diagnostic
+	170, // 4: specs.MachineStatusSpec.platform_metadata:type_name -> specs.MachineStatusSpec.PlatformMetadata
+	173, // 5: specs.MachineStatusSpec.image_labels:type_name -> specs.MachineStatusSpec.ImageLabelsEntry
+	171, // 6: specs.MachineStatusSpec.schematic:type_name -> specs.MachineStatusSpec.Schematic
+	172, // 7: specs.MachineStatusSpec.diagnostics:type_name -> specs.MachineStatusSpec.Diagnostic
 	6,   // 8: specs.MachineStatusSpec.power_state:type_name -> specs.MachineStatusSpec.PowerState
-	32,  // 9: specs.MachineStatusSpec.security_state:type_name -> specs.SecurityState
-	155, // 10: specs.ClusterSpec.features:type_name -> specs.ClusterSpec.Features
-	39,  // 11: specs.ClusterSpec.backup_configuration:type_name -> specs.EtcdBackupConf
-	195, // 12: specs.EtcdBackupConf.interval:type_name -> google.protobuf.Duration
-	196, // 13: specs.EtcdBackupSpec.created_at:type_name -> google.protobuf.Timestamp
-	195, // 14: specs.BackupDataSpec.interval:type_name -> google.protobuf.Duration
-	7,   // 15: specs.EtcdBackupStatusSpec.status:type_name -> specs.EtcdBackupStatusSpec.Status
-	196, // 16: specs.EtcdBackupStatusSpec.last_backup_time:type_name -> google.protobuf.Timestamp
-	196, // 17: specs.EtcdBackupStatusSpec.last_backup_attempt:type_name -> google.protobuf.Timestamp
-	196, // 18: specs.EtcdManualBackupSpec.backup_at:type_name -> google.protobuf.Timestamp
-	45,  // 19: specs.EtcdBackupOverallStatusSpec.last_backup_status:type_name -> specs.EtcdBackupStatusSpec
-	8,   // 20: specs.ClusterMachineStatusSpec.stage:type_name -> specs.ClusterMachineStatusSpec.Stage
-	0,   // 21: specs.ClusterMachineStatusSpec.config_apply_status:type_name -> specs.ConfigApplyStatus
-	156, // 22: specs.ClusterMachineStatusSpec.provision_status:type_name -> specs.ClusterMachineStatusSpec.ProvisionStatus
-	56,  // 23: specs.ClusterStatusSpec.machines:type_name -> specs.Machines
-	9,   // 24: specs.ClusterStatusSpec.phase:type_name -> specs.ClusterStatusSpec.Phase
-	157, // 25: specs.MachinePendingUpdatesSpec.upgrade:type_name -> specs.MachinePendingUpdatesSpec.Upgrade
-	158, // 26: specs.ClusterSecretsSpec.extra_certs:type_name -> specs.ClusterSecretsSpec.Certs
-	10,  // 27: specs.MachineSetSpec.update_strategy:type_name -> specs.MachineSetSpec.UpdateStrategy
-	161, // 28: specs.MachineSetSpec.machine_class:type_name -> specs.MachineSetSpec.MachineAllocation
-	162, // 29: specs.MachineSetSpec.bootstrap_spec:type_name -> specs.MachineSetSpec.BootstrapSpec
-	10,  // 30: specs.MachineSetSpec.delete_strategy:type_name -> specs.MachineSetSpec.UpdateStrategy
-	164, // 31: specs.MachineSetSpec.update_strategy_config:type_name -> specs.MachineSetSpec.UpdateStrategyConfig
-	164, // 32: specs.MachineSetSpec.delete_strategy_config:type_name -> specs.MachineSetSpec.UpdateStrategyConfig
-	161, // 33: specs.MachineSetSpec.machine_allocation:type_name -> specs.MachineSetSpec.MachineAllocation
-	10,  // 34: specs.MachineSetSpec.upgrade_strategy:type_name -> specs.MachineSetSpec.UpdateStrategy
-	164, // 35: specs.MachineSetSpec.upgrade_strategy_config:type_name -> specs.MachineSetSpec.UpdateStrategyConfig
-	13,  // 36: specs.TalosUpgradeStatusSpec.phase:type_name -> specs.TalosUpgradeStatusSpec.Phase
-	1,   // 37: specs.MachineSetStatusSpec.phase:type_name -> specs.MachineSetPhase
-	56,  // 38: specs.MachineSetStatusSpec.machines:type_name -> specs.Machines
-	161, // 39: specs.MachineSetStatusSpec.machine_allocation:type_name -> specs.MachineSetSpec.MachineAllocation
-	10,  // 40: specs.MachineSetConfigStatusSpec.update_strategy:type_name -> specs.MachineSetSpec.UpdateStrategy
-	164, // 41: specs.MachineSetConfigStatusSpec.update_strategy_config:type_name -> specs.MachineSetSpec.UpdateStrategyConfig
-	197, // 42: specs.MachineStatusSnapshotSpec.machine_status:type_name -> machine.MachineStatusEvent
-	14,  // 43: specs.MachineStatusSnapshotSpec.power_stage:type_name -> specs.MachineStatusSnapshotSpec.PowerStage
-	165, // 44: specs.ControlPlaneStatusSpec.conditions:type_name -> specs.ControlPlaneStatusSpec.Condition
-	166, // 45: specs.KubernetesStatusSpec.nodes:type_name -> specs.KubernetesStatusSpec.NodeStatus
-	168, // 46: specs.KubernetesStatusSpec.static_pods:type_name -> specs.KubernetesStatusSpec.NodeStaticPods
-	17,  // 47: specs.KubernetesUpgradeStatusSpec.phase:type_name -> specs.KubernetesUpgradeStatusSpec.Phase
-	72,  // 48: specs.OngoingTaskSpec.talos_upgrade:type_name -> specs.TalosUpgradeStatusSpec
-	81,  // 49: specs.OngoingTaskSpec.kubernetes_upgrade:type_name -> specs.KubernetesUpgradeStatusSpec
-	83,  // 50: specs.OngoingTaskSpec.destroy:type_name -> specs.DestroyStatusSpec
-	107, // 51: specs.OngoingTaskSpec.machine_upgrade:type_name -> specs.MachineUpgradeStatusSpec
-	130, // 52: specs.OngoingTaskSpec.secrets_rotation:type_name -> specs.ClusterSecretsRotationStatusSpec
-	93,  // 53: specs.FeaturesConfigSpec.etcd_backup_settings:type_name -> specs.EtcdBackupSettings
-	89,  // 54: specs.FeaturesConfigSpec.user_pilot_settings:type_name -> specs.UserPilotSettings
-	91,  // 55: specs.FeaturesConfigSpec.stripe_settings:type_name -> specs.StripeSettings
-	92,  // 56: specs.FeaturesConfigSpec.account:type_name -> specs.Account
-	90,  // 57: specs.FeaturesConfigSpec.posthog_settings:type_name -> specs.PosthogSettings
-	195, // 58: specs.EtcdBackupSettings.tick_interval:type_name -> google.protobuf.Duration
-	195, // 59: specs.EtcdBackupSettings.min_interval:type_name -> google.protobuf.Duration
-	195, // 60: specs.EtcdBackupSettings.max_interval:type_name -> google.protobuf.Duration
-	169, // 61: specs.MachineClassSpec.auto_provision:type_name -> specs.MachineClassSpec.Provision
-	170, // 62: specs.MachineConfigGenOptionsSpec.install_image:type_name -> specs.MachineConfigGenOptionsSpec.InstallImage
-	171, // 63: specs.KubernetesUsageSpec.cpu:type_name -> specs.KubernetesUsageSpec.Quantity
-	171, // 64: specs.KubernetesUsageSpec.mem:type_name -> specs.KubernetesUsageSpec.Quantity
-	171, // 65: specs.KubernetesUsageSpec.storage:type_name -> specs.KubernetesUsageSpec.Quantity
-	172, // 66: specs.KubernetesUsageSpec.pods:type_name -> specs.KubernetesUsageSpec.Pod
-	173, // 67: specs.ImagePullRequestSpec.node_image_list:type_name -> specs.ImagePullRequestSpec.NodeImageList
-	174, // 68: specs.TalosExtensionsSpec.items:type_name -> specs.TalosExtensionsSpec.Info
-	18,  // 69: specs.MachineUpgradeStatusSpec.phase:type_name -> specs.MachineUpgradeStatusSpec.Phase
-	175, // 70: specs.MachineExtensionsStatusSpec.extensions:type_name -> specs.MachineExtensionsStatusSpec.Item
-	176, // 71: specs.MachineStatusMetricsSpec.platforms:type_name -> specs.MachineStatusMetricsSpec.PlatformsEntry
-	177, // 72: specs.MachineStatusMetricsSpec.secure_boot_status:type_name -> specs.MachineStatusMetricsSpec.SecureBootStatusEntry
-	178, // 73: specs.MachineStatusMetricsSpec.uki_status:type_name -> specs.MachineStatusMetricsSpec.UkiStatusEntry
-	179, // 74: specs.ClusterMetricsSpec.features:type_name -> specs.ClusterMetricsSpec.FeaturesEntry
-	180, // 75: specs.ClusterStatusMetricsSpec.phases:type_name -> specs.ClusterStatusMetricsSpec.PhasesEntry
-	34,  // 76: specs.MachineRequestSetSpec.meta_values:type_name -> specs.MetaValue
-	3,   // 77: specs.MachineRequestSetSpec.grpc_tunnel:type_name -> specs.GrpcTunnelMode
-	181, // 78: specs.ClusterDiagnosticsSpec.nodes:type_name -> specs.ClusterDiagnosticsSpec.Node
-	20,  // 79: specs.ClusterMachineRequestStatusSpec.stage:type_name -> specs.ClusterMachineRequestStatusSpec.Stage
-	22,  // 80: specs.InfraMachineConfigSpec.power_state:type_name -> specs.InfraMachineConfigSpec.MachinePowerState
-	21,  // 81: specs.InfraMachineConfigSpec.acceptance_status:type_name -> specs.InfraMachineConfigSpec.AcceptanceStatus
-	182, // 82: specs.InfraMachineBMCConfigSpec.ipmi:type_name -> specs.InfraMachineBMCConfigSpec.IPMI
-	183, // 83: specs.InfraMachineBMCConfigSpec.api:type_name -> specs.InfraMachineBMCConfigSpec.API
-	184, // 84: specs.InfraProviderCombinedStatusSpec.health:type_name -> specs.InfraProviderCombinedStatusSpec.Health
-	198, // 85: specs.InstallationMediaConfigSpec.architecture:type_name -> specs.PlatformConfigSpec.Arch
-	185, // 86: specs.InstallationMediaConfigSpec.cloud:type_name -> specs.InstallationMediaConfigSpec.Cloud
-	186, // 87: specs.InstallationMediaConfigSpec.sbc:type_name -> specs.InstallationMediaConfigSpec.SBC
-	3,   // 88: specs.InstallationMediaConfigSpec.grpc_tunnel:type_name -> specs.GrpcTunnelMode
-	187, // 89: specs.InstallationMediaConfigSpec.machine_labels:type_name -> specs.InstallationMediaConfigSpec.MachineLabelsEntry
-	199, // 90: specs.InstallationMediaConfigSpec.bootloader:type_name -> management.SchematicBootloader
-	23,  // 91: specs.SecretRotationSpec.status:type_name -> specs.SecretRotationSpec.Status
-	24,  // 92: specs.SecretRotationSpec.phase:type_name -> specs.SecretRotationSpec.Phase
-	25,  // 93: specs.SecretRotationSpec.component:type_name -> specs.SecretRotationSpec.Component
-	158, // 94: specs.SecretRotationSpec.certs:type_name -> specs.ClusterSecretsSpec.Certs
-	158, // 95: specs.SecretRotationSpec.extra_certs:type_name -> specs.ClusterSecretsSpec.Certs
-	159, // 96: specs.SecretRotationSpec.backup_certs_os:type_name -> specs.ClusterSecretsSpec.Certs.CA
-	159, // 97: specs.SecretRotationSpec.backup_certs_k8s:type_name -> specs.ClusterSecretsSpec.Certs.CA
-	24,  // 98: specs.ClusterSecretsRotationStatusSpec.phase:type_name -> specs.SecretRotationSpec.Phase
-	25,  // 99: specs.ClusterSecretsRotationStatusSpec.component:type_name -> specs.SecretRotationSpec.Component
-	188, // 100: specs.ClusterMachineSecretsSpec.rotation:type_name -> specs.ClusterMachineSecretsSpec.Rotation
-	189, // 101: specs.UpgradeRolloutSpec.machine_sets_upgrade_quota:type_name -> specs.UpgradeRolloutSpec.MachineSetsUpgradeQuotaEntry
-	26,  // 102: specs.NotificationSpec.type:type_name -> specs.NotificationSpec.Type
-	27,  // 103: specs.KubernetesManifestGroupSpec.mode:type_name -> specs.KubernetesManifestGroupSpec.Mode
-	192, // 104: specs.ClusterKubernetesManifestsStatusSpec.groups:type_name -> specs.ClusterKubernetesManifestsStatusSpec.GroupsEntry
-	195, // 105: specs.KubernetesHealthCheckSpec.interval:type_name -> google.protobuf.Duration
-	30,  // 106: specs.KubernetesHealthCheckStatusSpec.state:type_name -> specs.KubernetesHealthCheckStatusSpec.State
-	194, // 107: specs.MachineInstallDiskStatusSpec.disks:type_name -> specs.MachineInstallDiskStatusSpec.Disk
-	149, // 108: specs.MachineStatusSpec.HardwareStatus.processors:type_name -> specs.MachineStatusSpec.HardwareStatus.Processor
-	150, // 109: specs.MachineStatusSpec.HardwareStatus.memory_modules:type_name -> specs.MachineStatusSpec.HardwareStatus.MemoryModule
-	151, // 110: specs.MachineStatusSpec.HardwareStatus.blockdevices:type_name -> specs.MachineStatusSpec.HardwareStatus.BlockDevice
-	152, // 111: specs.MachineStatusSpec.NetworkStatus.network_links:type_name -> specs.MachineStatusSpec.NetworkStatus.NetworkLinkStatus
-	153, // 112: specs.MachineStatusSpec.PlatformMetadata.tags:type_name -> specs.MachineStatusSpec.PlatformMetadata.TagsEntry
-	154, // 113: specs.MachineStatusSpec.Schematic.initial_state:type_name -> specs.MachineStatusSpec.Schematic.InitialState
-	159, // 114: specs.ClusterSecretsSpec.Certs.os:type_name -> specs.ClusterSecretsSpec.Certs.CA
-	159, // 115: specs.ClusterSecretsSpec.Certs.k8s:type_name -> specs.ClusterSecretsSpec.Certs.CA
-	11,  // 116: specs.MachineSetSpec.MachineClass.allocation_type:type_name -> specs.MachineSetSpec.MachineClass.Type
-	12,  // 117: specs.MachineSetSpec.MachineAllocation.allocation_type:type_name -> specs.MachineSetSpec.MachineAllocation.Type
-	163, // 118: specs.MachineSetSpec.UpdateStrategyConfig.rolling:type_name -> specs.MachineSetSpec.RollingUpdateStrategyConfig
-	2,   // 119: specs.ControlPlaneStatusSpec.Condition.type:type_name -> specs.ConditionType
-	15,  // 120: specs.ControlPlaneStatusSpec.Condition.status:type_name -> specs.ControlPlaneStatusSpec.Condition.Status
-	16,  // 121: specs.ControlPlaneStatusSpec.Condition.severity:type_name -> specs.ControlPlaneStatusSpec.Condition.Severity
-	167, // 122: specs.KubernetesStatusSpec.NodeStaticPods.static_pods:type_name -> specs.KubernetesStatusSpec.StaticPodStatus
-	34,  // 123: specs.MachineClassSpec.Provision.meta_values:type_name -> specs.MetaValue
-	3,   // 124: specs.MachineClassSpec.Provision.grpc_tunnel:type_name -> specs.GrpcTunnelMode
-	32,  // 125: specs.MachineConfigGenOptionsSpec.InstallImage.security_state:type_name -> specs.SecurityState
-	19,  // 126: specs.MachineExtensionsStatusSpec.Item.phase:type_name -> specs.MachineExtensionsStatusSpec.Item.Phase
-	23,  // 127: specs.ClusterMachineSecretsSpec.Rotation.status:type_name -> specs.SecretRotationSpec.Status
-	24,  // 128: specs.ClusterMachineSecretsSpec.Rotation.phase:type_name -> specs.SecretRotationSpec.Phase
-	25,  // 129: specs.ClusterMachineSecretsSpec.Rotation.component:type_name -> specs.SecretRotationSpec.Component
-	158, // 130: specs.ClusterMachineSecretsSpec.Rotation.extra_certs:type_name -> specs.ClusterSecretsSpec.Certs
-	28,  // 131: specs.ClusterKubernetesManifestsStatusSpec.ManifestStatus.phase:type_name -> specs.ClusterKubernetesManifestsStatusSpec.ManifestStatus.Phase
-	29,  // 132: specs.ClusterKubernetesManifestsStatusSpec.GroupStatus.phase:type_name -> specs.ClusterKubernetesManifestsStatusSpec.GroupStatus.Phase
-	27,  // 133: specs.ClusterKubernetesManifestsStatusSpec.GroupStatus.mode:type_name -> specs.KubernetesManifestGroupSpec.Mode
-	193, // 134: specs.ClusterKubernetesManifestsStatusSpec.GroupStatus.manifests:type_name -> specs.ClusterKubernetesManifestsStatusSpec.GroupStatus.ManifestsEntry
-	191, // 135: specs.ClusterKubernetesManifestsStatusSpec.GroupsEntry.value:type_name -> specs.ClusterKubernetesManifestsStatusSpec.GroupStatus
-	190, // 136: specs.ClusterKubernetesManifestsStatusSpec.GroupStatus.ManifestsEntry.value:type_name -> specs.ClusterKubernetesManifestsStatusSpec.ManifestStatus
-	137, // [137:137] is the sub-list for method output_type
-	137, // [137:137] is the sub-list for method input_type
-	137, // [137:137] is the sub-list for extension type_name
-	137, // [137:137] is the sub-list for extension extendee
-	0,   // [0:137] is the sub-list for field type_name
+	39,  // 9: specs.MachineStatusSpec.security_state:type_name -> specs.SecurityState
+	180, // 10: specs.ClusterSpec.features:type_name -> specs.ClusterSpec.Features
+	48,  // 11: specs.ClusterSpec.backup_configuration:type_name -> specs.EtcdBackupConf
+	45,  // 12: specs.ClusterSpec.secret_rotation_policy:type_name -> specs.SecretRotationPolicy
+	237, // 13: specs.SecretRotationPolicy.interval:type_name -> google.protobuf.Duration
+	237, // 14: specs.SecretRotationPolicy.expiry_threshold:type_name -> google.protobuf.Duration
+	29,  // 15: specs.SecretRotationPolicy.components:type_name -> specs.SecretRotationSpec.Component
+	237, // 16: specs.MaintenanceWindowSpec.duration:type_name -> google.protobuf.Duration
+	237, // 17: specs.EtcdBackupConf.interval:type_name -> google.protobuf.Duration
+	49,  // 18: specs.EtcdBackupConf.retention:type_name -> specs.EtcdBackupRetention
+	238, // 19: specs.EtcdBackupSpec.created_at:type_name -> google.protobuf.Timestamp
+	237, // 20: specs.BackupDataSpec.interval:type_name -> google.protobuf.Duration
+	49,  // 21: specs.BackupDataSpec.retention:type_name -> specs.EtcdBackupRetention
+	7,   // 22: specs.EtcdBackupStoreConfigSpec.backend:type_name -> specs.EtcdBackupStoreConfigSpec.Backend
+	181, // 23: specs.EtcdBackupStoreConfigSpec.gcs:type_name -> specs.EtcdBackupStoreConfigSpec.GCSConfig
+	182, // 24: specs.EtcdBackupStoreConfigSpec.azure_blob:type_name -> specs.EtcdBackupStoreConfigSpec.AzureBlobConfig
+	183, // 25: specs.EtcdBackupStoreConfigSpec.sftp:type_name -> specs.EtcdBackupStoreConfigSpec.SFTPConfig
+	8,   // 26: specs.EtcdBackupStatusSpec.status:type_name -> specs.EtcdBackupStatusSpec.Status
+	238, // 27: specs.EtcdBackupStatusSpec.last_backup_time:type_name -> google.protobuf.Timestamp
+	238, // 28: specs.EtcdBackupStatusSpec.last_backup_attempt:type_name -> google.protobuf.Timestamp
+	238, // 29: specs.EtcdManualBackupSpec.backup_at:type_name -> google.protobuf.Timestamp
+	56,  // 30: specs.EtcdBackupOverallStatusSpec.last_backup_status:type_name -> specs.EtcdBackupStatusSpec
+	61,  // 31: specs.ClusterMachineConfigPatchesSpec.previous:type_name -> specs.ClusterMachineConfigPatchesSpec
+	9,   // 32: specs.ClusterMachineStatusSpec.stage:type_name -> specs.ClusterMachineStatusSpec.Stage
+	0,   // 33: specs.ClusterMachineStatusSpec.config_apply_status:type_name -> specs.ConfigApplyStatus
+	184, // 34: specs.ClusterMachineStatusSpec.provision_status:type_name -> specs.ClusterMachineStatusSpec.ProvisionStatus
+	67,  // 35: specs.ClusterStatusSpec.machines:type_name -> specs.Machines
+	10,  // 36: specs.ClusterStatusSpec.phase:type_name -> specs.ClusterStatusSpec.Phase
+	185, // 37: specs.MachinePendingUpdatesSpec.upgrade:type_name -> specs.MachinePendingUpdatesSpec.Upgrade
+	186, // 38: specs.ClusterSecretsSpec.extra_certs:type_name -> specs.ClusterSecretsSpec.Certs
+	11,  // 39: specs.MachineSetSpec.update_strategy:type_name -> specs.MachineSetSpec.UpdateStrategy
+	189, // 40: specs.MachineSetSpec.machine_class:type_name -> specs.MachineSetSpec.MachineAllocation
+	190, // 41: specs.MachineSetSpec.bootstrap_spec:type_name -> specs.MachineSetSpec.BootstrapSpec
+	11,  // 42: specs.MachineSetSpec.delete_strategy:type_name -> specs.MachineSetSpec.UpdateStrategy
+	193, // 43: specs.MachineSetSpec.update_strategy_config:type_name -> specs.MachineSetSpec.UpdateStrategyConfig
+	193, // 44: specs.MachineSetSpec.delete_strategy_config:type_name -> specs.MachineSetSpec.UpdateStrategyConfig
+	189, // 45: specs.MachineSetSpec.machine_allocation:type_name -> specs.MachineSetSpec.MachineAllocation
+	11,  // 46: specs.MachineSetSpec.upgrade_strategy:type_name -> specs.MachineSetSpec.UpdateStrategy
+	193, // 47: specs.MachineSetSpec.upgrade_strategy_config:type_name -> specs.MachineSetSpec.UpdateStrategyConfig
+	14,  // 48: specs.TalosUpgradeStatusSpec.phase:type_name -> specs.TalosUpgradeStatusSpec.Phase
+	1,   // 49: specs.MachineSetStatusSpec.phase:type_name -> specs.MachineSetPhase
+	67,  // 50: specs.MachineSetStatusSpec.machines:type_name -> specs.Machines
+	189, // 51: specs.MachineSetStatusSpec.machine_allocation:type_name -> specs.MachineSetSpec.MachineAllocation
+	85,  // 52: specs.MachineSetStatusSpec.upgrade_canary:type_name -> specs.CanaryRolloutStatus
+	85,  // 53: specs.MachineSetStatusSpec.update_canary:type_name -> specs.CanaryRolloutStatus
+	15,  // 54: specs.CanaryRolloutStatus.phase:type_name -> specs.CanaryRolloutStatus.Phase
+	196, // 55: specs.CanaryRolloutStatus.targets:type_name -> specs.CanaryRolloutStatus.TargetsEntry
+	238, // 56: specs.CanaryRolloutStatus.verification_started:type_name -> google.protobuf.Timestamp
+	238, // 57: specs.CanaryRolloutStatus.unhealthy_since:type_name -> google.protobuf.Timestamp
+	197, // 58: specs.CanaryRolloutStatus.rollback_versions:type_name -> specs.CanaryRolloutStatus.RollbackVersionsEntry
+	11,  // 59: specs.MachineSetConfigStatusSpec.update_strategy:type_name -> specs.MachineSetSpec.UpdateStrategy
+	193, // 60: specs.MachineSetConfigStatusSpec.update_strategy_config:type_name -> specs.MachineSetSpec.UpdateStrategyConfig
+	239, // 61: specs.MachineStatusSnapshotSpec.machine_status:type_name -> machine.MachineStatusEvent
+	16,  // 62: specs.MachineStatusSnapshotSpec.power_stage:type_name -> specs.MachineStatusSnapshotSpec.PowerStage
+	198, // 63: specs.ControlPlaneStatusSpec.conditions:type_name -> specs.ControlPlaneStatusSpec.Condition
+	199, // 64: specs.KubernetesStatusSpec.nodes:type_name -> specs.KubernetesStatusSpec.NodeStatus
+	201, // 65: specs.KubernetesStatusSpec.static_pods:type_name -> specs.KubernetesStatusSpec.NodeStaticPods
+	19,  // 66: specs.KubernetesUpgradeStatusSpec.phase:type_name -> specs.KubernetesUpgradeStatusSpec.Phase
+	83,  // 67: specs.OngoingTaskSpec.talos_upgrade:type_name -> specs.TalosUpgradeStatusSpec
+	94,  // 68: specs.OngoingTaskSpec.kubernetes_upgrade:type_name -> specs.KubernetesUpgradeStatusSpec
+	96,  // 69: specs.OngoingTaskSpec.destroy:type_name -> specs.DestroyStatusSpec
+	120, // 70: specs.OngoingTaskSpec.machine_upgrade:type_name -> specs.MachineUpgradeStatusSpec
+	143, // 71: specs.OngoingTaskSpec.secrets_rotation:type_name -> specs.ClusterSecretsRotationStatusSpec
+	20,  // 72: specs.ExposedServiceSpec.protocol:type_name -> specs.ExposedServiceSpec.Protocol
+	202, // 73: specs.ExposedServiceSpec.access:type_name -> specs.ExposedServiceSpec.Access
+	21,  // 74: specs.ExposedServiceSpec.identity_forwarding:type_name -> specs.ExposedServiceSpec.IdentityForwarding
+	106, // 75: specs.FeaturesConfigSpec.etcd_backup_settings:type_name -> specs.EtcdBackupSettings
+	102, // 76: specs.FeaturesConfigSpec.user_pilot_settings:type_name -> specs.UserPilotSettings
+	104, // 77: specs.FeaturesConfigSpec.stripe_settings:type_name -> specs.StripeSettings
+	105, // 78: specs.FeaturesConfigSpec.account:type_name -> specs.Account
+	103, // 79: specs.FeaturesConfigSpec.posthog_settings:type_name -> specs.PosthogSettings
+	237, // 80: specs.EtcdBackupSettings.tick_interval:type_name -> google.protobuf.Duration
+	237, // 81: specs.EtcdBackupSettings.min_interval:type_name -> google.protobuf.Duration
+	237, // 82: specs.EtcdBackupSettings.max_interval:type_name -> google.protobuf.Duration
+	203, // 83: specs.MachineClassSpec.auto_provision:type_name -> specs.MachineClassSpec.Provision
+	204, // 84: specs.MachineConfigGenOptionsSpec.install_image:type_name -> specs.MachineConfigGenOptionsSpec.InstallImage
+	205, // 85: specs.KubernetesUsageSpec.cpu:type_name -> specs.KubernetesUsageSpec.Quantity
+	205, // 86: specs.KubernetesUsageSpec.mem:type_name -> specs.KubernetesUsageSpec.Quantity
+	205, // 87: specs.KubernetesUsageSpec.storage:type_name -> specs.KubernetesUsageSpec.Quantity
+	206, // 88: specs.KubernetesUsageSpec.pods:type_name -> specs.KubernetesUsageSpec.Pod
+	207, // 89: specs.ImagePullRequestSpec.node_image_list:type_name -> specs.ImagePullRequestSpec.NodeImageList
+	208, // 90: specs.TalosExtensionsSpec.items:type_name -> specs.TalosExtensionsSpec.Info
+	22,  // 91: specs.MachineUpgradeStatusSpec.phase:type_name -> specs.MachineUpgradeStatusSpec.Phase
+	209, // 92: specs.MachineExtensionsStatusSpec.extensions:type_name -> specs.MachineExtensionsStatusSpec.Item
+	210, // 93: specs.MachineStatusMetricsSpec.platforms:type_name -> specs.MachineStatusMetricsSpec.PlatformsEntry
+	211, // 94: specs.MachineStatusMetricsSpec.secure_boot_status:type_name -> specs.MachineStatusMetricsSpec.SecureBootStatusEntry
+	212, // 95: specs.MachineStatusMetricsSpec.uki_status:type_name -> specs.MachineStatusMetricsSpec.UkiStatusEntry
+	213, // 96: specs.ClusterMetricsSpec.features:type_name -> specs.ClusterMetricsSpec.FeaturesEntry
+	214, // 97: specs.ClusterStatusMetricsSpec.phases:type_name -> specs.ClusterStatusMetricsSpec.PhasesEntry
+	41,  // 98: specs.MachineRequestSetSpec.meta_values:type_name -> specs.MetaValue
+	3,   // 99: specs.MachineRequestSetSpec.grpc_tunnel:type_name -> specs.GrpcTunnelMode
+	215, // 100: specs.ClusterDiagnosticsSpec.nodes:type_name -> specs.ClusterDiagnosticsSpec.Node
+	24,  // 101: specs.ClusterMachineRequestStatusSpec.stage:type_name -> specs.ClusterMachineRequestStatusSpec.Stage
+	26,  // 102: specs.InfraMachineConfigSpec.power_state:type_name -> specs.InfraMachineConfigSpec.MachinePowerState
+	25,  // 103: specs.InfraMachineConfigSpec.acceptance_status:type_name -> specs.InfraMachineConfigSpec.AcceptanceStatus
+	216, // 104: specs.InfraMachineBMCConfigSpec.ipmi:type_name -> specs.InfraMachineBMCConfigSpec.IPMI
+	217, // 105: specs.InfraMachineBMCConfigSpec.api:type_name -> specs.InfraMachineBMCConfigSpec.API
+	218, // 106: specs.InfraProviderCombinedStatusSpec.health:type_name -> specs.InfraProviderCombinedStatusSpec.Health
+	240, // 107: specs.InstallationMediaConfigSpec.architecture:type_name -> specs.PlatformConfigSpec.Arch
+	219, // 108: specs.InstallationMediaConfigSpec.cloud:type_name -> specs.InstallationMediaConfigSpec.Cloud
+	220, // 109: specs.InstallationMediaConfigSpec.sbc:type_name -> specs.InstallationMediaConfigSpec.SBC
+	3,   // 110: specs.InstallationMediaConfigSpec.grpc_tunnel:type_name -> specs.GrpcTunnelMode
+	221, // 111: specs.InstallationMediaConfigSpec.machine_labels:type_name -> specs.InstallationMediaConfigSpec.MachineLabelsEntry
+	241, // 112: specs.InstallationMediaConfigSpec.bootloader:type_name -> management.SchematicBootloader
+	27,  // 113: specs.SecretRotationSpec.status:type_name -> specs.SecretRotationSpec.Status
+	28,  // 114: specs.SecretRotationSpec.phase:type_name -> specs.SecretRotationSpec.Phase
+	29,  // 115: specs.SecretRotationSpec.component:type_name -> specs.SecretRotationSpec.Component
+	186, // 116: specs.SecretRotationSpec.certs:type_name -> specs.ClusterSecretsSpec.Certs
+	186, // 117: specs.SecretRotationSpec.extra_certs:type_name -> specs.ClusterSecretsSpec.Certs
+	187, // 118: specs.SecretRotationSpec.backup_certs_os:type_name -> specs.ClusterSecretsSpec.Certs.CA
+	187, // 119: specs.SecretRotationSpec.backup_certs_k8s:type_name -> specs.ClusterSecretsSpec.Certs.CA
+	187, // 120: specs.SecretRotationSpec.backup_certs_etcd:type_name -> specs.ClusterSecretsSpec.Certs.CA
+	187, // 121: specs.SecretRotationSpec.backup_certs_k8s_aggregator:type_name -> specs.ClusterSecretsSpec.Certs.CA
+	187, // 122: specs.SecretRotationSpec.backup_certs_k8s_sa:type_name -> specs.ClusterSecretsSpec.Certs.CA
+	28,  // 123: specs.ClusterSecretsRotationStatusSpec.phase:type_name -> specs.SecretRotationSpec.Phase
+	29,  // 124: specs.ClusterSecretsRotationStatusSpec.component:type_name -> specs.SecretRotationSpec.Component
+	222, // 125: specs.ClusterSecretsRotationStatusSpec.secrets:type_name -> specs.ClusterSecretsRotationStatusSpec.Secret
+	223, // 126: specs.ClusterMachineSecretsSpec.rotation:type_name -> specs.ClusterMachineSecretsSpec.Rotation
+	224, // 127: specs.UpgradeRolloutSpec.machine_sets_upgrade_quota:type_name -> specs.UpgradeRolloutSpec.MachineSetsUpgradeQuotaEntry
+	225, // 128: specs.UpgradeRolloutSpec.machine_sets_upgrade_canary:type_name -> specs.UpgradeRolloutSpec.MachineSetsUpgradeCanaryEntry
+	226, // 129: specs.UpgradeRolloutSpec.machine_sets_update_canary:type_name -> specs.UpgradeRolloutSpec.MachineSetsUpdateCanaryEntry
+	30,  // 130: specs.NotificationSpec.type:type_name -> specs.NotificationSpec.Type
+	31,  // 131: specs.NotificationChannelSpec.type:type_name -> specs.NotificationChannelSpec.Type
+	227, // 132: specs.NotificationChannelSpec.smtp:type_name -> specs.NotificationChannelSpec.SMTPConfig
+	30,  // 133: specs.NotificationRuleSpec.min_severity:type_name -> specs.NotificationSpec.Type
+	237, // 134: specs.NotificationRuleSpec.throttle:type_name -> google.protobuf.Duration
+	238, // 135: specs.NotificationChannelStatusSpec.last_delivery:type_name -> google.protobuf.Timestamp
+	238, // 136: specs.NotificationChannelStatusSpec.last_failure:type_name -> google.protobuf.Timestamp
+	32,  // 137: specs.KubernetesManifestGroupSpec.mode:type_name -> specs.KubernetesManifestGroupSpec.Mode
+	228, // 138: specs.KubernetesManifestGroupSpec.helm:type_name -> specs.KubernetesManifestGroupSpec.HelmSource
+	231, // 139: specs.ClusterKubernetesManifestsStatusSpec.groups:type_name -> specs.ClusterKubernetesManifestsStatusSpec.GroupsEntry
+	237, // 140: specs.KubernetesHealthCheckSpec.interval:type_name -> google.protobuf.Duration
+	35,  // 141: specs.KubernetesHealthCheckStatusSpec.state:type_name -> specs.KubernetesHealthCheckStatusSpec.State
+	233, // 142: specs.MachineInstallDiskStatusSpec.disks:type_name -> specs.MachineInstallDiskStatusSpec.Disk
+	234, // 143: specs.ClusterTemplateSpec.values:type_name -> specs.ClusterTemplateSpec.ValuesEntry
+	36,  // 144: specs.ClusterTemplateStatusSpec.phase:type_name -> specs.ClusterTemplateStatusSpec.Phase
+	238, // 145: specs.ClusterTemplateStatusSpec.last_drift:type_name -> google.protobuf.Timestamp
+	237, // 146: specs.GitRepositorySpec.poll_interval:type_name -> google.protobuf.Duration
+	37,  // 147: specs.GitRepositoryStatusSpec.phase:type_name -> specs.GitRepositoryStatusSpec.Phase
+	235, // 148: specs.GitRepositoryStatusSpec.commits:type_name -> specs.GitRepositoryStatusSpec.CommitStatus
+	236, // 149: specs.GitRepositoryStatusSpec.resources:type_name -> specs.GitRepositoryStatusSpec.ManagedResource
+	238, // 150: specs.GitRepositoryStatusSpec.last_fetch:type_name -> google.protobuf.Timestamp
+	174, // 151: specs.MachineStatusSpec.HardwareStatus.processors:type_name -> specs.MachineStatusSpec.HardwareStatus.Processor
+	175, // 152: specs.MachineStatusSpec.HardwareStatus.memory_modules:type_name -> specs.MachineStatusSpec.HardwareStatus.MemoryModule
+	176, // 153: specs.MachineStatusSpec.HardwareStatus.blockdevices:type_name -> specs.MachineStatusSpec.HardwareStatus.BlockDevice
+	177, // 154: specs.MachineStatusSpec.NetworkStatus.network_links:type_name -> specs.MachineStatusSpec.NetworkStatus.NetworkLinkStatus
+	178, // 155: specs.MachineStatusSpec.PlatformMetadata.tags:type_name -> specs.MachineStatusSpec.PlatformMetadata.TagsEntry
+	179, // 156: specs.MachineStatusSpec.Schematic.initial_state:type_name -> specs.MachineStatusSpec.Schematic.InitialState
+	187, // 157: specs.ClusterSecretsSpec.Certs.os:type_name -> specs.ClusterSecretsSpec.Certs.CA
+	187, // 158: specs.ClusterSecretsSpec.Certs.k8s:type_name -> specs.ClusterSecretsSpec.Certs.CA
+	187, // 159: specs.ClusterSecretsSpec.Certs.etcd:type_name -> specs.ClusterSecretsSpec.Certs.CA
+	187, // 160: specs.ClusterSecretsSpec.Certs.k8s_aggregator:type_name -> specs.ClusterSecretsSpec.Certs.CA
+	187, // 161: specs.ClusterSecretsSpec.Certs.k8s_sa:type_name -> specs.ClusterSecretsSpec.Certs.CA
+	12,  // 162: specs.MachineSetSpec.MachineClass.allocation_type:type_name -> specs.MachineSetSpec.MachineClass.Type
+	13,  // 163: specs.MachineSetSpec.MachineAllocation.allocation_type:type_name -> specs.MachineSetSpec.MachineAllocation.Type
+	194, // 164: specs.MachineSetSpec.MachineAllocation.autoscaling:type_name -> specs.MachineSetSpec.MachineAllocation.Autoscaling
+	237, // 165: specs.MachineSetSpec.CanaryUpdateStrategyConfig.soak_duration:type_name -> google.protobuf.Duration
+	237, // 166: specs.MachineSetSpec.CanaryUpdateStrategyConfig.failure_timeout:type_name -> google.protobuf.Duration
+	191, // 167: specs.MachineSetSpec.UpdateStrategyConfig.rolling:type_name -> specs.MachineSetSpec.RollingUpdateStrategyConfig
+	192, // 168: specs.MachineSetSpec.UpdateStrategyConfig.canary:type_name -> specs.MachineSetSpec.CanaryUpdateStrategyConfig
+	195, // 169: specs.CanaryRolloutStatus.RollbackVersionsEntry.value:type_name -> specs.CanaryRolloutStatus.RollbackVersion
+	2,   // 170: specs.ControlPlaneStatusSpec.Condition.type:type_name -> specs.ConditionType
+	17,  // 171: specs.ControlPlaneStatusSpec.Condition.status:type_name -> specs.ControlPlaneStatusSpec.Condition.Status
+	18,  // 172: specs.ControlPlaneStatusSpec.Condition.severity:type_name -> specs.ControlPlaneStatusSpec.Condition.Severity
+	200, // 173: specs.KubernetesStatusSpec.NodeStaticPods.static_pods:type_name -> specs.KubernetesStatusSpec.StaticPodStatus
+	41,  // 174: specs.MachineClassSpec.Provision.meta_values:type_name -> specs.MetaValue
+	3,   // 175: specs.MachineClassSpec.Provision.grpc_tunnel:type_name -> specs.GrpcTunnelMode
+	39,  // 176: specs.MachineConfigGenOptionsSpec.InstallImage.security_state:type_name -> specs.SecurityState
+	23,  // 177: specs.MachineExtensionsStatusSpec.Item.phase:type_name -> specs.MachineExtensionsStatusSpec.Item.Phase
+	29,  // 178: specs.ClusterSecretsRotationStatusSpec.Secret.component:type_name -> specs.SecretRotationSpec.Component
+	238, // 179: specs.ClusterSecretsRotationStatusSpec.Secret.expiration:type_name -> google.protobuf.Timestamp
+	238, // 180: specs.ClusterSecretsRotationStatusSpec.Secret.last_rotation:type_name -> google.protobuf.Timestamp
+	238, // 181: specs.ClusterSecretsRotationStatusSpec.Secret.next_rotation:type_name -> google.protobuf.Timestamp
+	27,  // 182: specs.ClusterMachineSecretsSpec.Rotation.status:type_name -> specs.SecretRotationSpec.Status
+	28,  // 183: specs.ClusterMachineSecretsSpec.Rotation.phase:type_name -> specs.SecretRotationSpec.Phase
+	29,  // 184: specs.ClusterMachineSecretsSpec.Rotation.component:type_name -> specs.SecretRotationSpec.Component
+	186, // 185: specs.ClusterMachineSecretsSpec.Rotation.extra_certs:type_name -> specs.ClusterSecretsSpec.Certs
+	85,  // 186: specs.UpgradeRolloutSpec.MachineSetsUpgradeCanaryEntry.value:type_name -> specs.CanaryRolloutStatus
+	85,  // 187: specs.UpgradeRolloutSpec.MachineSetsUpdateCanaryEntry.value:type_name -> specs.CanaryRolloutStatus
+	33,  // 188: specs.ClusterKubernetesManifestsStatusSpec.ManifestStatus.phase:type_name -> specs.ClusterKubernetesManifestsStatusSpec.ManifestStatus.Phase
+	34,  // 189: specs.ClusterKubernetesManifestsStatusSpec.GroupStatus.phase:type_name -> specs.ClusterKubernetesManifestsStatusSpec.GroupStatus.Phase
+	32,  // 190: specs.ClusterKubernetesManifestsStatusSpec.GroupStatus.mode:type_name -> specs.KubernetesManifestGroupSpec.Mode
+	232, // 191: specs.ClusterKubernetesManifestsStatusSpec.GroupStatus.manifests:type_name -> specs.ClusterKubernetesManifestsStatusSpec.GroupStatus.ManifestsEntry
+	230, // 192: specs.ClusterKubernetesManifestsStatusSpec.GroupsEntry.value:type_name -> specs.ClusterKubernetesManifestsStatusSpec.GroupStatus
+	229, // 193: specs.ClusterKubernetesManifestsStatusSpec.GroupStatus.ManifestsEntry.value:type_name -> specs.ClusterKubernetesManifestsStatusSpec.ManifestStatus
+	37,  // 194: specs.GitRepositoryStatusSpec.CommitStatus.phase:type_name -> specs.GitRepositoryStatusSpec.Phase
+	238, // 195: specs.GitRepositoryStatusSpec.CommitStatus.synced_at:type_name -> google.protobuf.Timestamp
+	196, // [196:196] is the sub-list for method output_type
+	196, // [196:196] is the sub-list for method input_type
+	196, // [196:196] is the sub-list for extension type_name
+	196, // [196:196] is the sub-list for extension extendee
+	0,   // [0:196] is the sub-list for field type_name
 }
 
 func init() { file_omni_specs_omni_proto_init() }
@@ -13408,7 +16563,7 @@ func file_omni_specs_omni_proto_init() {
 		return
 	}
 	file_omni_specs_virtual_proto_init()
-	file_omni_specs_omni_proto_msgTypes[53].OneofWrappers = []any{
+	file_omni_specs_omni_proto_msgTypes[59].OneofWrappers = []any{
 		(*OngoingTaskSpec_TalosUpgrade)(nil),
 		(*OngoingTaskSpec_KubernetesUpgrade)(nil),
 		(*OngoingTaskSpec_Destroy)(nil),
@@ -13420,8 +16575,8 @@ func file_omni_specs_omni_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_omni_specs_omni_proto_rawDesc), len(file_omni_specs_omni_proto_rawDesc)),
-			NumEnums:      31,
-			NumMessages:   164,
+			NumEnums:      38,
+			NumMessages:   199,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
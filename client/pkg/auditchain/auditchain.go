@@ -0,0 +1,104 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package auditchain implements the hash chain of the Omni audit log.
+//
+// Each event is chained to the previous one by hashing the hash of the previous event together with its id
+// and its JSON, so modifying, removing or reordering an event breaks the chain after it. The chain is anchored
+// by the markers signed with the Omni key: checkpoints sign the hash of an event every so often, and truncation
+// markers sign the hash of the last event removed by the audit log cleanup, so the pruned log still verifies.
+package auditchain
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+)
+
+// MarkerKind is the kind of the chain marker.
+type MarkerKind string
+
+const (
+	// MarkerCheckpoint signs the hash of the event with the marker id.
+	MarkerCheckpoint MarkerKind = "checkpoint"
+
+	// MarkerTruncation records that the events before the marker id were removed, signing the hash of the last
+	// removed event, which the event with the marker id is chained to.
+	MarkerTruncation MarkerKind = "truncation"
+)
+
+// signaturePrefix separates the signed marker data from anything else the key might sign.
+const signaturePrefix = "omni audit log chain marker v1\x00"
+
+// Hash returns the chain hash of the event.
+//
+// The event is the JSON of the event without the trailing newline, as returned by the audit log.
+func Hash(prevHash []byte, id int64, event []byte) []byte {
+	h := sha256.New()
+
+	var idBytes [8]byte
+
+	binary.BigEndian.PutUint64(idBytes[:], uint64(id))
+
+	h.Write([]byte{byte(len(prevHash))})
+	h.Write(prevHash)
+	h.Write(idBytes[:])
+	h.Write(event)
+
+	return h.Sum(nil)
+}
+
+// Marker is a signed statement about the chain at the event with the given id.
+type Marker struct {
+	Kind        MarkerKind
+	Hash        []byte
+	PublicKey   ed25519.PublicKey
+	Signature   []byte
+	ID          int64
+	TimestampMs int64
+}
+
+// Sign signs the marker with the given key.
+func (m *Marker) Sign(key ed25519.PrivateKey) {
+	m.PublicKey = key.Public().(ed25519.PublicKey) //nolint:forcetypeassert,errcheck
+	m.Signature = ed25519.Sign(key, m.signedData())
+}
+
+// Verify checks that the marker is signed with the given public key.
+func (m *Marker) Verify(publicKey ed25519.PublicKey) error {
+	if len(m.Signature) == 0 {
+		return errors.New("marker is not signed")
+	}
+
+	if len(publicKey) != ed25519.PublicKeySize {
+		return errors.New("invalid public key")
+	}
+
+	if !publicKey.Equal(m.PublicKey) {
+		return errors.New("marker is signed with an unexpected key")
+	}
+
+	if !ed25519.Verify(publicKey, m.signedData(), m.Signature) {
+		return errors.New("marker signature is invalid")
+	}
+
+	return nil
+}
+
+func (m *Marker) signedData() []byte {
+	var buf bytes.Buffer
+
+	buf.WriteString(signaturePrefix)
+	buf.WriteString(string(m.Kind))
+	buf.WriteByte(0)
+
+	binary.Write(&buf, binary.BigEndian, m.ID)          //nolint:errcheck
+	binary.Write(&buf, binary.BigEndian, m.TimestampMs) //nolint:errcheck
+
+	buf.Write(m.Hash)
+
+	return buf.Bytes()
+}
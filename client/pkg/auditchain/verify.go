@@ -0,0 +1,198 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package auditchain
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"fmt"
+	"slices"
+)
+
+// Event is an audit log event with its chain proof.
+type Event struct {
+	// Payload is the JSON of the event, with or without the trailing newline.
+	Payload  []byte
+	PrevHash []byte
+	Hash     []byte
+	Markers  []Marker
+	ID       int64
+}
+
+// IssueKind is the kind of the problem found in the chain.
+type IssueKind string
+
+const (
+	// IssueModified means the event does not match its hash or the checkpoint signing it.
+	IssueModified IssueKind = "modified"
+
+	// IssueBrokenLink means the event is not chained to the event before it.
+	IssueBrokenLink IssueKind = "broken link"
+
+	// IssueGap means events are missing before the event, and no truncation marker records their removal.
+	IssueGap IssueKind = "gap"
+
+	// IssueOutOfOrder means the event id is not above the id of the event before it.
+	IssueOutOfOrder IssueKind = "out of order"
+
+	// IssueInvalidMarker means a marker of the event is not validly signed or does not match the chain.
+	IssueInvalidMarker IssueKind = "invalid marker"
+
+	// IssueUnanchored means the chain neither starts with the first event ever written nor with a truncation marker.
+	IssueUnanchored IssueKind = "unanchored"
+)
+
+// Issue is a problem found in the chain at the event with the given id.
+type Issue struct {
+	Kind    IssueKind
+	Message string
+	ID      int64
+}
+
+// String implements [fmt.Stringer].
+func (i Issue) String() string {
+	return fmt.Sprintf("event %d: %s: %s", i.ID, i.Kind, i.Message)
+}
+
+// Summary describes the verified events.
+type Summary struct {
+	// PublicKeys are the distinct keys the valid markers are signed with.
+	PublicKeys []ed25519.PublicKey
+
+	// Events is the number of verified events, Unchained is the number of them written before the chaining was enabled.
+	Events    int
+	Unchained int
+
+	Checkpoints int
+	Truncations int
+
+	FirstID int64
+	LastID  int64
+
+	// LastCheckpointID is the id of the last event signed by a checkpoint, the events after it are only protected by the chain.
+	LastCheckpointID int64
+}
+
+// Verifier walks the events in id order and reports the problems in the chain.
+type Verifier struct {
+	// PublicKey is the key the markers must be signed with, e.g. the audit log signing key of the server.
+	//
+	// If it is not set, the markers are only checked to be signed with the key they carry, which anyone can forge.
+	PublicKey ed25519.PublicKey
+
+	// RequireAnchor reports the start of the chain when it's neither the first event ever written nor recorded by a
+	// truncation marker. Set it when verifying everything retained, a range starting in the middle of the log is never anchored.
+	RequireAnchor bool
+
+	prevHash []byte
+	summary  Summary
+	prevID   int64
+}
+
+// Add verifies the next event.
+//
+//nolint:gocognit,gocyclo,cyclop
+func (v *Verifier) Add(event Event) []Issue {
+	var issues []Issue
+
+	report := func(kind IssueKind, format string, args ...any) {
+		issues = append(issues, Issue{ID: event.ID, Kind: kind, Message: fmt.Sprintf(format, args...)})
+	}
+
+	first := v.summary.Events == 0
+
+	v.summary.Events++
+
+	if first {
+		v.summary.FirstID = event.ID
+	}
+
+	v.summary.LastID = event.ID
+
+	truncated := false
+
+	for _, marker := range event.Markers {
+		if err := v.verifyMarker(marker); err != nil {
+			report(IssueInvalidMarker, "%s: %v", marker.Kind, err)
+
+			continue
+		}
+
+		switch marker.Kind {
+		case MarkerCheckpoint:
+			if !bytes.Equal(marker.Hash, event.Hash) {
+				report(IssueModified, "event does not match the signed checkpoint")
+
+				continue
+			}
+
+			v.summary.Checkpoints++
+			v.summary.LastCheckpointID = event.ID
+		case MarkerTruncation:
+			if !bytes.Equal(marker.Hash, event.PrevHash) {
+				report(IssueInvalidMarker, "%s: the marker does not match the previous event hash", marker.Kind)
+
+				continue
+			}
+
+			v.summary.Truncations++
+
+			truncated = true
+		default:
+			report(IssueInvalidMarker, "unknown marker kind %q", marker.Kind)
+		}
+	}
+
+	defer func() {
+		v.prevID, v.prevHash = event.ID, event.Hash
+	}()
+
+	if len(event.Hash) == 0 {
+		v.summary.Unchained++
+
+		return issues
+	}
+
+	if !bytes.Equal(Hash(event.PrevHash, event.ID, bytes.TrimSuffix(event.Payload, []byte("\n"))), event.Hash) {
+		report(IssueModified, "event does not match its hash")
+	}
+
+	switch {
+	case first:
+		if v.RequireAnchor && len(event.PrevHash) != 0 && !truncated {
+			report(IssueUnanchored, "the chain does not start with the first event or a truncation marker")
+		}
+	case event.ID <= v.prevID:
+		report(IssueOutOfOrder, "event follows event %d", v.prevID)
+	case event.ID != v.prevID+1 && !truncated:
+		report(IssueGap, "events %d to %d are missing", v.prevID+1, event.ID-1)
+	case event.ID == v.prevID+1 && !bytes.Equal(event.PrevHash, v.prevHash):
+		report(IssueBrokenLink, "event is not chained to event %d", v.prevID)
+	}
+
+	return issues
+}
+
+// Summary returns the summary of the events verified so far.
+func (v *Verifier) Summary() Summary {
+	return v.summary
+}
+
+func (v *Verifier) verifyMarker(marker Marker) error {
+	publicKey := v.PublicKey
+	if publicKey == nil {
+		publicKey = marker.PublicKey
+	}
+
+	if err := marker.Verify(publicKey); err != nil {
+		return err
+	}
+
+	if !slices.ContainsFunc(v.summary.PublicKeys, func(key ed25519.PublicKey) bool { return key.Equal(marker.PublicKey) }) {
+		v.summary.PublicKeys = append(v.summary.PublicKeys, marker.PublicKey)
+	}
+
+	return nil
+}
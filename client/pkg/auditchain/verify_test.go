@@ -0,0 +1,186 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package auditchain_test
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/pkg/auditchain"
+)
+
+// chain builds the events with ids from 1 to n, signing a checkpoint for the last one.
+func chain(t *testing.T, key ed25519.PrivateKey, n int) []auditchain.Event {
+	t.Helper()
+
+	events := make([]auditchain.Event, 0, n)
+
+	var prevHash []byte
+
+	for id := int64(1); id <= int64(n); id++ {
+		payload := fmt.Appendf(nil, `{"event_type":"create","resource_id":"res-%d"}`, id)
+		hash := auditchain.Hash(prevHash, id, payload)
+
+		events = append(events, auditchain.Event{
+			ID:       id,
+			Payload:  append(payload, '\n'),
+			PrevHash: prevHash,
+			Hash:     hash,
+		})
+
+		prevHash = hash
+	}
+
+	checkpoint := auditchain.Marker{Kind: auditchain.MarkerCheckpoint, ID: int64(n), Hash: events[n-1].Hash, TimestampMs: 1}
+	checkpoint.Sign(key)
+
+	events[n-1].Markers = append(events[n-1].Markers, checkpoint)
+
+	return events
+}
+
+func verify(verifier *auditchain.Verifier, events []auditchain.Event) []auditchain.Issue {
+	var issues []auditchain.Issue
+
+	for _, event := range events {
+		issues = append(issues, verifier.Add(event)...)
+	}
+
+	return issues
+}
+
+func issueKinds(issues []auditchain.Issue) []auditchain.IssueKind {
+	kinds := make([]auditchain.IssueKind, 0, len(issues))
+
+	for _, issue := range issues {
+		kinds = append(kinds, issue.Kind)
+	}
+
+	return kinds
+}
+
+func newKey(t *testing.T) ed25519.PrivateKey {
+	t.Helper()
+
+	_, key, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	return key
+}
+
+func TestVerifyValidChain(t *testing.T) {
+	t.Parallel()
+
+	key := newKey(t)
+	verifier := &auditchain.Verifier{PublicKey: key.Public().(ed25519.PublicKey), RequireAnchor: true} //nolint:forcetypeassert,errcheck
+
+	assert.Empty(t, verify(verifier, chain(t, key, 5)))
+
+	summary := verifier.Summary()
+	assert.Equal(t, 5, summary.Events)
+	assert.Equal(t, 1, summary.Checkpoints)
+	assert.Equal(t, int64(5), summary.LastCheckpointID)
+	assert.Len(t, summary.PublicKeys, 1)
+}
+
+func TestVerifyModifiedEvent(t *testing.T) {
+	t.Parallel()
+
+	events := chain(t, newKey(t), 5)
+	events[2].Payload = []byte(`{"event_type":"destroy","resource_id":"res-3"}`)
+
+	assert.Equal(t, []auditchain.IssueKind{auditchain.IssueModified}, issueKinds(verify(&auditchain.Verifier{}, events)))
+}
+
+func TestVerifyRehashedChain(t *testing.T) {
+	t.Parallel()
+
+	key := newKey(t)
+	events := chain(t, key, 5)
+
+	// rewriting an event and recomputing every hash after it keeps the chain intact, but not the signed checkpoint
+	events[2].Payload = []byte(`{"event_type":"destroy","resource_id":"res-3"}`)
+
+	for i := 2; i < len(events); i++ {
+		events[i].PrevHash = events[i-1].Hash
+		events[i].Hash = auditchain.Hash(events[i].PrevHash, events[i].ID, bytes.TrimSuffix(events[i].Payload, []byte("\n")))
+	}
+
+	assert.Equal(t, []auditchain.IssueKind{auditchain.IssueModified}, issueKinds(verify(&auditchain.Verifier{}, events)))
+}
+
+func TestVerifyRemovedEvent(t *testing.T) {
+	t.Parallel()
+
+	events := chain(t, newKey(t), 5)
+	events = append(events[:2], events[3:]...)
+
+	issues := verify(&auditchain.Verifier{}, events)
+	require.Equal(t, []auditchain.IssueKind{auditchain.IssueGap}, issueKinds(issues))
+	assert.Equal(t, int64(4), issues[0].ID)
+}
+
+func TestVerifyTruncation(t *testing.T) {
+	t.Parallel()
+
+	key := newKey(t)
+	events := chain(t, key, 5)
+
+	truncation := auditchain.Marker{Kind: auditchain.MarkerTruncation, ID: 3, Hash: events[1].Hash, TimestampMs: 1}
+	truncation.Sign(key)
+
+	events[2].Markers = append(events[2].Markers, truncation)
+
+	verifier := &auditchain.Verifier{RequireAnchor: true}
+
+	assert.Empty(t, verify(verifier, events[2:]))
+	assert.Equal(t, 1, verifier.Summary().Truncations)
+
+	// without the marker, the start of the chain is not anchored
+	events[2].Markers = nil
+
+	assert.Equal(t, []auditchain.IssueKind{auditchain.IssueUnanchored}, issueKinds(verify(&auditchain.Verifier{RequireAnchor: true}, events[2:])))
+
+	// but it is fine for a range starting in the middle
+	assert.Empty(t, verify(&auditchain.Verifier{}, events[2:]))
+}
+
+func TestVerifyForgedMarker(t *testing.T) {
+	t.Parallel()
+
+	key := newKey(t)
+	events := chain(t, key, 5)
+
+	// a marker signed by another key
+	verifier := &auditchain.Verifier{PublicKey: newKey(t).Public().(ed25519.PublicKey)} //nolint:forcetypeassert,errcheck
+
+	assert.Equal(t, []auditchain.IssueKind{auditchain.IssueInvalidMarker}, issueKinds(verify(verifier, events)))
+
+	// a marker modified after signing
+	events[4].Markers[0].TimestampMs++
+
+	assert.Equal(t, []auditchain.IssueKind{auditchain.IssueInvalidMarker}, issueKinds(verify(&auditchain.Verifier{}, events)))
+}
+
+func TestVerifyUnchainedEvents(t *testing.T) {
+	t.Parallel()
+
+	// the events written before the chaining have no hashes, the chain starts after them without a previous hash
+	events := []auditchain.Event{
+		{ID: 1, Payload: []byte(`{"event_type":"create"}`)},
+		{ID: 2, Payload: []byte(`{"event_type":"update"}`)},
+		{ID: 3, Payload: []byte(`{"event_type":"destroy"}`), Hash: auditchain.Hash(nil, 3, []byte(`{"event_type":"destroy"}`))},
+	}
+
+	verifier := &auditchain.Verifier{RequireAnchor: true}
+
+	assert.Empty(t, verify(verifier, events))
+	assert.Equal(t, 2, verifier.Summary().Unchained)
+}
@@ -8,6 +8,7 @@ package management
 import (
 	"bytes"
 	"context"
+	"crypto/ed25519"
 	"errors"
 	"fmt"
 	"io"
@@ -84,6 +85,16 @@ func WithOIDCCacheIsolation(value bool) KubeconfigOption {
 	}
 }
 
+// UpdateUserOption is a functional option for UpdateUser.
+type UpdateUserOption func(*management.UpdateUserRequest)
+
+// WithCustomRoles replaces the custom roles of the user, the empty list removes all of them.
+func WithCustomRoles(roles ...string) UpdateUserOption {
+	return func(request *management.UpdateUserRequest) {
+		request.CustomRoles = &management.CustomRoles{Roles: roles}
+	}
+}
+
 // Client for Management API .
 type Client struct {
 	conn management.ManagementServiceClient
@@ -174,12 +185,13 @@ func (client *Client) GetBootAssetURL(ctx context.Context, request *management.B
 }
 
 // CreateServiceAccount creates a service account and returns the public key ID.
-func (client *Client) CreateServiceAccount(ctx context.Context, name, armoredPGPPublicKey, role string, useUserRole bool) (string, error) {
+func (client *Client) CreateServiceAccount(ctx context.Context, name, armoredPGPPublicKey, role string, useUserRole bool, customRoles ...string) (string, error) {
 	resp, err := client.conn.CreateServiceAccount(ctx, &management.CreateServiceAccountRequest{
 		ArmoredPgpPublicKey: armoredPGPPublicKey,
 		Role:                role,
 		UseUserRole:         useUserRole,
 		Name:                name,
+		CustomRoles:         customRoles,
 	})
 	if err != nil {
 		return "", err
@@ -221,10 +233,11 @@ func (client *Client) DestroyServiceAccount(ctx context.Context, name string) er
 }
 
 // CreateUser creates a user and returns the user ID.
-func (client *Client) CreateUser(ctx context.Context, email, role string) (string, error) {
+func (client *Client) CreateUser(ctx context.Context, email, role string, customRoles ...string) (string, error) {
 	resp, err := client.conn.CreateUser(ctx, &management.CreateUserRequest{
-		Email: email,
-		Role:  role,
+		Email:       email,
+		Role:        role,
+		CustomRoles: customRoles,
 	})
 	if err != nil {
 		return "", err
@@ -244,11 +257,17 @@ func (client *Client) ListUsers(ctx context.Context) ([]*management.ListUsersRes
 }
 
 // UpdateUser updates the role of the user with the given email.
-func (client *Client) UpdateUser(ctx context.Context, email, role string) error {
-	_, err := client.conn.UpdateUser(ctx, &management.UpdateUserRequest{
+func (client *Client) UpdateUser(ctx context.Context, email, role string, opts ...UpdateUserOption) error {
+	req := &management.UpdateUserRequest{
 		Email: email,
 		Role:  role,
-	})
+	}
+
+	for _, opt := range opts {
+		opt(req)
+	}
+
+	_, err := client.conn.UpdateUser(ctx, req)
 
 	return err
 }
@@ -325,6 +344,16 @@ func (client *Client) GetSupportBundleWithRequest(
 	}
 }
 
+// AuditLogSigningKey returns the public key the audit log chain markers are signed with.
+func (client *Client) AuditLogSigningKey(ctx context.Context) (ed25519.PublicKey, error) {
+	response, err := client.conn.AuditLogSigningKey(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the audit log signing key: %w", err)
+	}
+
+	return response.PublicKey, nil
+}
+
 // ReadAuditLog reads the audit log from the backend.
 //
 // To follow the audit log continuously, use [Client.FollowAuditLog], which also handles the
@@ -505,6 +534,41 @@ func (client *Client) MaintenanceLifecycle(
 	}
 }
 
+// EtcdRestore restores etcd of the cluster from the etcd backup snapshot, streaming the restore progress.
+//
+// If wipeEphemeral is set, the EPHEMERAL partition of the control plane nodes is wiped too.
+func (client *Client) EtcdRestore(ctx context.Context, cluster, snapshot string, wipeEphemeral bool) iter.Seq2[*management.EtcdRestoreResponse, error] {
+	return func(yield func(*management.EtcdRestoreResponse, error) bool) {
+		streamingResponse, err := client.conn.EtcdRestore(ctx, &management.EtcdRestoreRequest{
+			Cluster:       cluster,
+			Snapshot:      snapshot,
+			WipeEphemeral: wipeEphemeral,
+		})
+		if err != nil {
+			yield(nil, err)
+
+			return
+		}
+
+		for {
+			response, err := streamingResponse.Recv()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					return
+				}
+
+				yield(nil, err)
+
+				return
+			}
+
+			if !yield(response, nil) {
+				return
+			}
+		}
+	}
+}
+
 // ResetNodeUniqueToken resets the node unique token for the machine, which forces the machine to get a new one on next startup.
 func (client *Client) ResetNodeUniqueToken(ctx context.Context, machineID string) error {
 	_, err := client.conn.ResetNodeUniqueToken(ctx, &management.ResetNodeUniqueTokenRequest{
@@ -533,6 +597,152 @@ func (client *Client) MachinePowerOn(ctx context.Context, machineID string) erro
 	return err
 }
 
+// RequestElevation requests a time-bound elevation to the role, optionally limited to the clusters matching the patterns.
+//
+// It returns the ID of the elevation request, which has to be approved by another admin.
+func (client *Client) RequestElevation(ctx context.Context, role string, clusters []string, reason string, duration time.Duration) (string, error) {
+	response, err := client.conn.RequestElevation(ctx, &management.RequestElevationRequest{
+		Role:     role,
+		Clusters: clusters,
+		Reason:   reason,
+		Duration: durationpb.New(duration),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return response.GetId(), nil
+}
+
+// ApproveElevation approves the pending elevation request.
+func (client *Client) ApproveElevation(ctx context.Context, id string) error {
+	_, err := client.conn.ApproveElevation(ctx, &management.ApproveElevationRequest{
+		Id: id,
+	})
+
+	return err
+}
+
+// RevokeElevation revokes the elevation request, removing the elevation if it is active.
+func (client *Client) RevokeElevation(ctx context.Context, id string) error {
+	_, err := client.conn.RevokeElevation(ctx, &management.RevokeElevationRequest{
+		Id: id,
+	})
+
+	return err
+}
+
+// ListElevations lists the elevation requests, all of them for the admins, and the own ones for everyone else.
+func (client *Client) ListElevations(ctx context.Context) ([]*management.ListElevationsResponse_Elevation, error) {
+	response, err := client.conn.ListElevations(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return response.GetElevations(), nil
+}
+
+// DetachCluster locks the cluster for the detach and exports its machine configs, talosconfig and kubeconfig for running it without Omni.
+func (client *Client) DetachCluster(ctx context.Context, cluster, controlPlaneEndpoint string) (*management.DetachClusterResponse, error) {
+	return client.conn.DetachCluster(ctx, &management.DetachClusterRequest{
+		Cluster:              cluster,
+		ControlPlaneEndpoint: controlPlaneEndpoint,
+	})
+}
+
+// ReleaseDetachedCluster applies the detached configs to the machines and removes the cluster from Omni, streaming the progress.
+//
+// If force is set, the cluster is released even if some of its machines are not connected to Omni.
+func (client *Client) ReleaseDetachedCluster(ctx context.Context, cluster string, force bool) iter.Seq2[*management.ReleaseDetachedClusterResponse, error] {
+	return func(yield func(*management.ReleaseDetachedClusterResponse, error) bool) {
+		streamingResponse, err := client.conn.ReleaseDetachedCluster(ctx, &management.ReleaseDetachedClusterRequest{
+			Cluster: cluster,
+			Force:   force,
+		})
+		if err != nil {
+			yield(nil, err)
+
+			return
+		}
+
+		for {
+			response, err := streamingResponse.Recv()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					return
+				}
+
+				yield(nil, err)
+
+				return
+			}
+
+			if !yield(response, nil) {
+				return
+			}
+		}
+	}
+}
+
+// ClusterLogs streams the logs of the machines of the cluster, interleaved by the time the lines were received at.
+func (client *Client) ClusterLogs(ctx context.Context, req *management.ClusterLogsRequest) iter.Seq2[*management.ClusterLogsResponse, error] {
+	return func(yield func(*management.ClusterLogsResponse, error) bool) {
+		logStream, err := client.conn.ClusterLogs(ctx, req)
+		if err != nil {
+			yield(nil, err)
+
+			return
+		}
+
+		for {
+			response, err := logStream.Recv()
+			if err != nil {
+				if expectedErr(err) {
+					return
+				}
+
+				yield(nil, err)
+
+				return
+			}
+
+			if !yield(response, nil) {
+				return
+			}
+		}
+	}
+}
+
+// WorkloadProxyCredentials issues the credentials to access the exposed service with the given alias without a browser.
+//
+// A client certificate for the TCP services is issued only if the PEM encoded certificate signing request is set.
+func (client *Client) WorkloadProxyCredentials(ctx context.Context, alias string, csr []byte, ttl time.Duration) (*management.WorkloadProxyCredentialsResponse, error) {
+	req := &management.WorkloadProxyCredentialsRequest{
+		Alias: alias,
+		Csr:   csr,
+	}
+
+	if ttl > 0 {
+		req.Ttl = durationpb.New(ttl)
+	}
+
+	return client.conn.WorkloadProxyCredentials(ctx, req)
+}
+
+// ClusterAutoscalerCredentials issues the client certificate for the cluster autoscaler of the cluster to the key of the PEM encoded certificate signing request.
+func (client *Client) ClusterAutoscalerCredentials(ctx context.Context, cluster string, csr []byte, ttl time.Duration) (*management.ClusterAutoscalerCredentialsResponse, error) {
+	req := &management.ClusterAutoscalerCredentialsRequest{
+		Cluster: cluster,
+		Csr:     csr,
+	}
+
+	if ttl > 0 {
+		req.Ttl = durationpb.New(ttl)
+	}
+
+	return client.conn.ClusterAutoscalerCredentials(ctx, req)
+}
+
 // LogReader is a log client reader which implements io.Reader.
 type LogReader struct {
 	ctx    context.Context //nolint:containedctx
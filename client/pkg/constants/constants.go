@@ -90,3 +90,6 @@ const CompressionThresholdBytes = 2048
 
 // InfraProviderHealthCheckInterval is the default interval of the infra provider health checks.
 const InfraProviderHealthCheckInterval = 30 * time.Second
+
+// ClusterAutoscalerProviderIDPrefix prefixes the machine ID in the Kubernetes provider ID of the nodes in the autoscaled machine sets.
+const ClusterAutoscalerProviderIDPrefix = "omni://"
@@ -8,7 +8,7 @@ const (
 	// ExposedServiceAnnotationPrefix is the common prefix shared by all annotations that
 	// configure how Kubernetes Services are exposed to Omni.
 	//
-	// The label, icon, and prefix annotations also accept per-host-port suffixed variants
+	// The label, icon, prefix, protocol, access, and identity forwarding annotations also accept per-host-port suffixed variants
 	// (e.g. "<base>-30080") so that a Service exposing multiple host ports can configure
 	// each one independently. The unsuffixed variant is used as a fallback.
 	ExposedServiceAnnotationPrefix = "omni-kube-service-exposer.sidero.dev/"
@@ -37,4 +37,71 @@ const (
 	//
 	// tsgen:ExposedServicePrefixAnnotationKey
 	ExposedServicePrefixAnnotationKey = ExposedServiceAnnotationPrefix + "prefix"
+
+	// ExposedServiceProtocolAnnotationKey is the annotation to define the protocol Omni proxies the Kubernetes Services with.
+	//
+	// The value is one of "http" (the default), "grpc" or "tcp". HTTP and gRPC services share the workload proxy subdomain,
+	// TCP services are given a port of their own on Omni.
+	//
+	// tsgen:ExposedServiceProtocolAnnotationKey
+	ExposedServiceProtocolAnnotationKey = ExposedServiceAnnotationPrefix + "protocol"
+
+	// ExposedServiceMinRoleAnnotationKey is the annotation to define the minimum role on the cluster required to access the exposed service.
+	//
+	// It can only raise the requirement above the default Reader role.
+	//
+	// tsgen:ExposedServiceMinRoleAnnotationKey
+	ExposedServiceMinRoleAnnotationKey = ExposedServiceAnnotationPrefix + "min-role"
+
+	// ExposedServiceAllowedUsersAnnotationKey is the annotation to restrict the access to the exposed service to the given users.
+	//
+	// The value is a comma-separated list of identities, glob patterns of identities, or access policy user groups prefixed with "group/".
+	//
+	// tsgen:ExposedServiceAllowedUsersAnnotationKey
+	ExposedServiceAllowedUsersAnnotationKey = ExposedServiceAnnotationPrefix + "allowed-users"
+
+	// ExposedServiceAllowedLabelsAnnotationKey is the annotation to restrict the access to the exposed service to the users
+	// whose identity labels match the given label selector, e.g. "saml.omni.sidero.dev/groups/admins".
+	//
+	// When it is set together with the allowed users annotation, matching either of them is enough.
+	//
+	// tsgen:ExposedServiceAllowedLabelsAnnotationKey
+	ExposedServiceAllowedLabelsAnnotationKey = ExposedServiceAnnotationPrefix + "allowed-labels"
+
+	// ExposedServiceIdentityForwardingAnnotationKey is the annotation to define how the identity of the user is forwarded to the exposed service.
+	//
+	// The value is one of "none" (the default), "headers" or "jwt". The identity is forwarded only to the HTTP and gRPC services.
+	//
+	// tsgen:ExposedServiceIdentityForwardingAnnotationKey
+	ExposedServiceIdentityForwardingAnnotationKey = ExposedServiceAnnotationPrefix + "identity-forwarding"
+)
+
+const (
+	// ExposedServiceTokenHeader is the header which carries the workload proxy token of a request to an exposed service.
+	ExposedServiceTokenHeader = "Omni-Workload-Proxy-Token"
+
+	// ExposedServiceTokenPreamblePrefix starts the line a client without a client certificate sends first on a TCP service connection,
+	// followed by its workload proxy token.
+	ExposedServiceTokenPreamblePrefix = "TOKEN "
+)
+
+const (
+	// ExposedServiceIdentityHeaderPrefix is the common prefix of the headers which carry the identity of the user to an exposed service.
+	//
+	// The headers with this prefix are removed from every request to an exposed service, so that a client can't forge them.
+	ExposedServiceIdentityHeaderPrefix = "Omni-Identity-"
+
+	// ExposedServiceIdentityEmailHeader is the header which carries the email of the user.
+	ExposedServiceIdentityEmailHeader = ExposedServiceIdentityHeaderPrefix + "Email"
+
+	// ExposedServiceIdentityRoleHeader is the header which carries the role of the user on the cluster of the exposed service.
+	ExposedServiceIdentityRoleHeader = ExposedServiceIdentityHeaderPrefix + "Role"
+
+	// ExposedServiceIdentityGroupsHeader is the header which carries the comma-separated groups of the user, taken from its SAML labels.
+	ExposedServiceIdentityGroupsHeader = ExposedServiceIdentityHeaderPrefix + "Groups"
+
+	// ExposedServiceIdentityJWTHeader is the header which carries the JWT signed by Omni with the identity of the user.
+	//
+	// The JWT can be verified with the keys published by the Omni OIDC provider.
+	ExposedServiceIdentityJWTHeader = ExposedServiceIdentityHeaderPrefix + "JWT"
 )
@@ -0,0 +1,223 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package maintenancewindow
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxScheduleSearchYears limits the search for the next matching time, so that schedules which never match
+// (e.g. February 30th) don't loop forever.
+const maxScheduleSearchYears = 5
+
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var monthNames = map[string]int{
+	"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+	"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+}
+
+var dayNames = map[string]int{
+	"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+}
+
+// bitSet is a set of the values matched by a single schedule field.
+type bitSet uint64
+
+func (b bitSet) has(v int) bool {
+	return b&(1<<uint(v)) != 0
+}
+
+type field struct {
+	names    map[string]int
+	name     string
+	min, max int
+}
+
+var (
+	minuteField     = field{name: "minute", min: 0, max: 59}
+	hourField       = field{name: "hour", min: 0, max: 23}
+	dayOfMonthField = field{name: "day of month", min: 1, max: 31}
+	monthField      = field{name: "month", min: 1, max: 12, names: monthNames}
+	dayOfWeekField  = field{name: "day of week", min: 0, max: 7, names: dayNames}
+)
+
+// Schedule is a parsed cron expression.
+type Schedule struct {
+	minute, hour, dayOfMonth, month, dayOfWeek bitSet
+
+	// anyDayOfMonth and anyDayOfWeek are set when the day fields start with '*'.
+	// When both day fields are restricted, a day matches if it matches either of them, as in cron.
+	anyDayOfMonth, anyDayOfWeek bool
+}
+
+// ParseSchedule parses a standard 5-field cron expression: minute, hour, day of month, month and day of week.
+//
+// Fields support '*', lists, ranges and steps, month and day of week fields also support three-letter names.
+// The '@yearly', '@monthly', '@weekly', '@daily' and '@hourly' descriptors are supported as well.
+func ParseSchedule(expr string) (*Schedule, error) {
+	expr = strings.TrimSpace(expr)
+
+	if descriptor, ok := descriptors[strings.ToLower(expr)]; ok {
+		expr = descriptor
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid schedule %q: expected 5 fields, got %d", expr, len(fields))
+	}
+
+	var (
+		schedule Schedule
+		err      error
+	)
+
+	for i, target := range []struct {
+		set   *bitSet
+		field field
+	}{
+		{set: &schedule.minute, field: minuteField},
+		{set: &schedule.hour, field: hourField},
+		{set: &schedule.dayOfMonth, field: dayOfMonthField},
+		{set: &schedule.month, field: monthField},
+		{set: &schedule.dayOfWeek, field: dayOfWeekField},
+	} {
+		if *target.set, err = target.field.parse(fields[i]); err != nil {
+			return nil, fmt.Errorf("invalid schedule %q: %w", expr, err)
+		}
+	}
+
+	// 7 is an alias for Sunday
+	if schedule.dayOfWeek.has(7) {
+		schedule.dayOfWeek |= 1
+	}
+
+	schedule.anyDayOfMonth = strings.HasPrefix(fields[2], "*")
+	schedule.anyDayOfWeek = strings.HasPrefix(fields[4], "*")
+
+	return &schedule, nil
+}
+
+func (f field) parse(expr string) (bitSet, error) {
+	var set bitSet
+
+	for part := range strings.SplitSeq(expr, ",") {
+		rangeExpr, stepExpr, hasStep := strings.Cut(part, "/")
+
+		step := 1
+
+		if hasStep {
+			var err error
+
+			if step, err = strconv.Atoi(stepExpr); err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid %s step %q", f.name, stepExpr)
+			}
+		}
+
+		var start, end int
+
+		switch {
+		case rangeExpr == "*":
+			start, end = f.min, f.max
+		case strings.Contains(rangeExpr, "-"):
+			startExpr, endExpr, _ := strings.Cut(rangeExpr, "-")
+
+			var err error
+
+			if start, err = f.value(startExpr); err != nil {
+				return 0, err
+			}
+
+			if end, err = f.value(endExpr); err != nil {
+				return 0, err
+			}
+
+			if start > end {
+				return 0, fmt.Errorf("invalid %s range %q", f.name, rangeExpr)
+			}
+		default:
+			var err error
+
+			if start, err = f.value(rangeExpr); err != nil {
+				return 0, err
+			}
+
+			end = start
+
+			// "5/10" means starting at 5 with the step of 10, as in cron
+			if hasStep {
+				end = f.max
+			}
+		}
+
+		for v := start; v <= end; v += step {
+			set |= 1 << uint(v)
+		}
+	}
+
+	return set, nil
+}
+
+func (f field) value(expr string) (int, error) {
+	if v, ok := f.names[strings.ToLower(expr)]; ok {
+		return v, nil
+	}
+
+	v, err := strconv.Atoi(expr)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("invalid %s value %q", f.name, expr)
+	}
+
+	return v, nil
+}
+
+func (s *Schedule) dayMatches(t time.Time) bool {
+	dayOfMonth := s.dayOfMonth.has(t.Day())
+	dayOfWeek := s.dayOfWeek.has(int(t.Weekday()))
+
+	if s.anyDayOfMonth || s.anyDayOfWeek {
+		return dayOfMonth && dayOfWeek
+	}
+
+	return dayOfMonth || dayOfWeek
+}
+
+// Next returns the first time matching the schedule strictly after t, in the location of t.
+//
+// It returns a zero time if the schedule doesn't match in the next few years.
+func (s *Schedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	limit := t.Year() + maxScheduleSearchYears
+
+	t = t.Truncate(time.Minute).Add(time.Minute)
+
+	for t.Year() <= limit {
+		switch {
+		case !s.month.has(int(t.Month())):
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+		case !s.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		case !s.hour.has(t.Hour()):
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+		case !s.minute.has(t.Minute()):
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+
+	return time.Time{}
+}
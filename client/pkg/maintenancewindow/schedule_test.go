@@ -0,0 +1,129 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package maintenancewindow_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/pkg/maintenancewindow"
+)
+
+func TestScheduleNext(t *testing.T) {
+	t.Parallel()
+
+	// Friday
+	from := time.Date(2026, time.October, 16, 10, 30, 15, 0, time.UTC)
+
+	for _, test := range []struct {
+		name     string
+		schedule string
+		expected time.Time
+	}{
+		{
+			name:     "every minute",
+			schedule: "* * * * *",
+			expected: time.Date(2026, time.October, 16, 10, 31, 0, 0, time.UTC),
+		},
+		{
+			name:     "daily",
+			schedule: "@daily",
+			expected: time.Date(2026, time.October, 17, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "later today",
+			schedule: "0 22 * * *",
+			expected: time.Date(2026, time.October, 16, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "step",
+			schedule: "*/20 * * * *",
+			expected: time.Date(2026, time.October, 16, 10, 40, 0, 0, time.UTC),
+		},
+		{
+			name:     "weekend",
+			schedule: "0 2 * * sat,sun",
+			expected: time.Date(2026, time.October, 17, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "sunday as 7",
+			schedule: "0 2 * * 7",
+			expected: time.Date(2026, time.October, 18, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "weekdays range",
+			schedule: "0 3 * * mon-fri",
+			expected: time.Date(2026, time.October, 19, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "day of month or day of week",
+			schedule: "0 0 1 * mon",
+			expected: time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "next year",
+			schedule: "0 0 1 jan *",
+			expected: time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "leap day",
+			schedule: "0 0 29 2 *",
+			expected: time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "never",
+			schedule: "0 0 30 2 *",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			schedule, err := maintenancewindow.ParseSchedule(test.schedule)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, schedule.Next(from))
+		})
+	}
+}
+
+func TestScheduleNextLocation(t *testing.T) {
+	t.Parallel()
+
+	loc, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	schedule, err := maintenancewindow.ParseSchedule("0 2 * * *")
+	require.NoError(t, err)
+
+	// 12:30 in Berlin
+	from := time.Date(2026, time.October, 16, 10, 30, 0, 0, time.UTC)
+
+	next := schedule.Next(from.In(loc))
+	assert.Equal(t, time.Date(2026, time.October, 17, 2, 0, 0, 0, loc), next)
+	assert.Equal(t, time.Date(2026, time.October, 17, 0, 0, 0, 0, time.UTC), next.UTC())
+}
+
+func TestParseScheduleErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"* * * foo *",
+	} {
+		_, err := maintenancewindow.ParseSchedule(expr)
+		assert.Error(t, err, expr)
+	}
+}
@@ -0,0 +1,68 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package maintenancewindow implements the recurring maintenance windows.
+package maintenancewindow
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+)
+
+// Window is a parsed maintenance window.
+type Window struct {
+	schedule *Schedule
+	location *time.Location
+	duration time.Duration
+}
+
+// New parses the maintenance window spec.
+func New(spec *specs.MaintenanceWindowSpec) (*Window, error) {
+	schedule, err := ParseSchedule(spec.GetSchedule())
+	if err != nil {
+		return nil, err
+	}
+
+	location := time.UTC
+
+	if spec.GetTimezone() != "" {
+		if location, err = time.LoadLocation(spec.GetTimezone()); err != nil {
+			return nil, fmt.Errorf("invalid timezone %q: %w", spec.GetTimezone(), err)
+		}
+	}
+
+	duration := spec.GetDuration().AsDuration()
+	if duration <= 0 {
+		return nil, errors.New("duration must be positive")
+	}
+
+	return &Window{
+		schedule: schedule,
+		location: location,
+		duration: duration,
+	}, nil
+}
+
+// Next returns the start and the end of the window which is open at t or, if the window is closed at t, of the next one.
+//
+// The returned start is zero if the window never opens.
+func (w *Window) Next(t time.Time) (start, end time.Time) {
+	// the first start after t - duration is either the start of the window which is open at t, or the next start
+	start = w.schedule.Next(t.In(w.location).Add(-w.duration))
+	if start.IsZero() {
+		return time.Time{}, time.Time{}
+	}
+
+	return start, start.Add(w.duration)
+}
+
+// IsOpen returns true if the window is open at t.
+func (w *Window) IsOpen(t time.Time) bool {
+	start, _ := w.Next(t)
+
+	return !start.IsZero() && !start.After(t)
+}
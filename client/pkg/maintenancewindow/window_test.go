@@ -0,0 +1,62 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package maintenancewindow_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/maintenancewindow"
+)
+
+func TestWindow(t *testing.T) {
+	t.Parallel()
+
+	window, err := maintenancewindow.New(&specs.MaintenanceWindowSpec{
+		Schedule: "0 22 * * *",
+		Duration: durationpb.New(4 * time.Hour),
+		Timezone: "Europe/Berlin",
+	})
+	require.NoError(t, err)
+
+	// 12:30 in Berlin: closed, the next window opens at 22:00
+	now := time.Date(2026, time.October, 16, 10, 30, 0, 0, time.UTC)
+
+	start, end := window.Next(now)
+	assert.False(t, window.IsOpen(now))
+	assert.True(t, start.Equal(time.Date(2026, time.October, 16, 20, 0, 0, 0, time.UTC)), start)
+	assert.True(t, end.Equal(time.Date(2026, time.October, 17, 0, 0, 0, 0, time.UTC)), end)
+
+	// 1:00 in Berlin on the next day: the window which opened yesterday is still open
+	now = time.Date(2026, time.October, 16, 23, 0, 0, 0, time.UTC)
+
+	start, end = window.Next(now)
+	assert.True(t, window.IsOpen(now))
+	assert.True(t, start.Equal(time.Date(2026, time.October, 16, 20, 0, 0, 0, time.UTC)), start)
+	assert.True(t, end.Equal(time.Date(2026, time.October, 17, 0, 0, 0, 0, time.UTC)), end)
+
+	// the window is closed at its end
+	assert.False(t, window.IsOpen(end))
+	assert.True(t, window.IsOpen(start))
+}
+
+func TestWindowErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, spec := range []*specs.MaintenanceWindowSpec{
+		{Schedule: "0 0 * * *"},
+		{Schedule: "0 0 * * *", Duration: durationpb.New(-time.Hour)},
+		{Schedule: "0 0 * *", Duration: durationpb.New(time.Hour)},
+		{Schedule: "0 0 * * *", Duration: durationpb.New(time.Hour), Timezone: "Mars/Olympus_Mons"},
+	} {
+		_, err := maintenancewindow.New(spec)
+		assert.Error(t, err, spec.String())
+	}
+}
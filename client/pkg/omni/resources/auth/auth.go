@@ -9,6 +9,8 @@ import "github.com/siderolabs/omni/client/pkg/omni/resources/registry"
 
 func init() {
 	registry.MustRegisterResource(AuthConfigType, &Config{})
+	registry.MustRegisterResource(ElevationGrantType, &ElevationGrant{})
+	registry.MustRegisterResource(ElevationRequestType, &ElevationRequest{})
 	registry.MustRegisterResource(EulaAcceptanceType, &EulaAcceptance{})
 	registry.MustRegisterResource(IdentityType, &Identity{})
 	registry.MustRegisterResource(IdentityLastActiveType, &IdentityLastActive{})
@@ -17,6 +19,7 @@ func init() {
 	registry.MustRegisterResource(PublicKeyLastActiveType, &PublicKeyLastActive{})
 	registry.MustRegisterResource(UserType, &User{})
 	registry.MustRegisterResource(AccessPolicyType, &AccessPolicy{})
+	registry.MustRegisterResource(RoleType, &Role{})
 	registry.MustRegisterResource(SAMLAssertionType, &SAMLAssertion{})
 	registry.MustRegisterResource(SAMLLabelRuleType, &SAMLLabelRule{})
 	registry.MustRegisterResource(ServiceAccountStatusType, &ServiceAccountStatus{})
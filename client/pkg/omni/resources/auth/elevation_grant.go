@@ -0,0 +1,64 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package auth
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewElevationGrant creates a new ElevationGrant resource.
+func NewElevationGrant(id string) *ElevationGrant {
+	return typed.NewResource[ElevationGrantSpec, ElevationGrantExtension](
+		resource.NewMetadata(resources.DefaultNamespace, ElevationGrantType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.ElevationGrantSpec{}),
+	)
+}
+
+const (
+	// ElevationGrantType is the type of ElevationGrant resource.
+	//
+	// tsgen:ElevationGrantType
+	ElevationGrantType = resource.Type("ElevationGrants.omni.sidero.dev")
+)
+
+// ElevationGrant resource describes an active role elevation.
+//
+// It has the same ID as the ElevationRequest it is created for, and the LabelIdentity label set to the elevated identity.
+type ElevationGrant = typed.Resource[ElevationGrantSpec, ElevationGrantExtension]
+
+// ElevationGrantSpec wraps specs.ElevationGrantSpec.
+type ElevationGrantSpec = protobuf.ResourceSpec[specs.ElevationGrantSpec, *specs.ElevationGrantSpec]
+
+// ElevationGrantExtension provides auxiliary methods for ElevationGrant resource.
+type ElevationGrantExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (ElevationGrantExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ElevationGrantType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Identity",
+				JSONPath: "{.identity}",
+			},
+			{
+				Name:     "Role",
+				JSONPath: "{.role}",
+			},
+			{
+				Name:     "Expiration",
+				JSONPath: "{.expiration}",
+			},
+		},
+	}
+}
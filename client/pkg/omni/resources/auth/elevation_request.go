@@ -0,0 +1,64 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package auth
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewElevationRequest creates a new ElevationRequest resource.
+func NewElevationRequest(id string) *ElevationRequest {
+	return typed.NewResource[ElevationRequestSpec, ElevationRequestExtension](
+		resource.NewMetadata(resources.DefaultNamespace, ElevationRequestType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.ElevationRequestSpec{}),
+	)
+}
+
+const (
+	// ElevationRequestType is the type of ElevationRequest resource.
+	//
+	// tsgen:ElevationRequestType
+	ElevationRequestType = resource.Type("ElevationRequests.omni.sidero.dev")
+)
+
+// ElevationRequest resource describes a request for a temporary role elevation.
+//
+// The requests are created, approved and revoked through the ManagementService API.
+type ElevationRequest = typed.Resource[ElevationRequestSpec, ElevationRequestExtension]
+
+// ElevationRequestSpec wraps specs.ElevationRequestSpec.
+type ElevationRequestSpec = protobuf.ResourceSpec[specs.ElevationRequestSpec, *specs.ElevationRequestSpec]
+
+// ElevationRequestExtension provides auxiliary methods for ElevationRequest resource.
+type ElevationRequestExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (ElevationRequestExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ElevationRequestType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Identity",
+				JSONPath: "{.identity}",
+			},
+			{
+				Name:     "Role",
+				JSONPath: "{.role}",
+			},
+			{
+				Name:     "Reason",
+				JSONPath: "{.reason}",
+			},
+		},
+	}
+}
@@ -0,0 +1,62 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package auth
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewRole creates a new Role resource.
+func NewRole(id string) *Role {
+	return typed.NewResource[RoleSpec, RoleExtension](
+		resource.NewMetadata(resources.DefaultNamespace, RoleType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.RoleSpec{}),
+	)
+}
+
+const (
+	// RoleType is the type of Role resource.
+	//
+	// tsgen:RoleType
+	RoleType = resource.Type("Roles.omni.sidero.dev")
+
+	// RoleRuleWildcard matches all the resource types or all the verbs in a Role rule.
+	RoleRuleWildcard = "*"
+)
+
+// RoleVerbs are the verbs a Role rule can grant on the resource types.
+var RoleVerbs = []string{"get", "list", "watch", "create", "update", "destroy"}
+
+// Role resource describes a custom role, which grants permissions on top of the built-in role.
+//
+// Custom roles are assigned to the users and the service accounts by the ID, see UserSpec.CustomRoles.
+type Role = typed.Resource[RoleSpec, RoleExtension]
+
+// RoleSpec wraps specs.RoleSpec.
+type RoleSpec = protobuf.ResourceSpec[specs.RoleSpec, *specs.RoleSpec]
+
+// RoleExtension provides auxiliary methods for Role resource.
+type RoleExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (RoleExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             RoleType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Description",
+				JSONPath: "{.description}",
+			},
+		},
+	}
+}
@@ -23,6 +23,7 @@ import (
 // ManagementService.CreateJoinToken) do not belong here.
 var UserManagedResourceTypes = []resource.Type{
 	authres.AccessPolicyType,
+	authres.RoleType,
 	authres.SAMLLabelRuleType,
 	siderolink.DefaultJoinTokenType,
 	siderolink.GRPCTunnelConfigType,
@@ -34,6 +35,7 @@ var UserManagedResourceTypes = []resource.Type{
 	omni.MachineSetType,
 	omni.MachineSetNodeType,
 	omni.EtcdBackupS3ConfType,
+	omni.EtcdBackupStoreConfigType,
 	omni.ExtensionsConfigurationType,
 	omni.KernelArgsType,
 	omni.MachineRequestSetType,
@@ -46,5 +48,16 @@ var UserManagedResourceTypes = []resource.Type{
 	infra.ProviderType,
 	omni.RotateTalosCAType,
 	omni.RotateKubernetesCAType,
+	omni.RotateEtcdCAType,
+	omni.RotateKubernetesAggregatorCAType,
+	omni.RotateKubernetesServiceAccountKeyType,
+	omni.RotateBootstrapTokenType,
 	omni.KubernetesHealthCheckType,
+	omni.CanaryApprovalType,
+	omni.MaintenanceWindowType,
+	omni.NotificationChannelType,
+	omni.NotificationRuleType,
+	omni.ClusterTemplateType,
+	omni.GitRepositoryType,
+	omni.GitCredentialsType,
 }
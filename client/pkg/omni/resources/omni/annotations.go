@@ -54,6 +54,20 @@ const (
 	// tsgen:ClusterImportIsInProgress
 	ClusterImportIsInProgress = SystemLabelPrefix + "cluster-import-is-in-progress"
 
+	// ClusterEtcdRestoreInProgress indicates that etcd of the cluster is being restored from a backup.
+	// This annotation is set on the cluster together with ClusterLocked for the duration of the restore.
+	// tsgen:ClusterEtcdRestoreInProgress
+	ClusterEtcdRestoreInProgress = SystemLabelPrefix + "cluster-etcd-restore-in-progress"
+
+	// ClusterDetachInProgress indicates that the cluster is being detached from Omni.
+	// This annotation is set on the cluster together with ClusterLocked, the value is the control plane endpoint of the detached cluster.
+	// tsgen:ClusterDetachInProgress
+	ClusterDetachInProgress = SystemLabelPrefix + "cluster-detach-in-progress"
+
+	// ClusterDetachReleasedMachines lists the machines of the cluster being detached which already run the detached config.
+	// This annotation is set on the cluster status, the value is the comma separated list of the machine IDs.
+	ClusterDetachReleasedMachines = SystemLabelPrefix + "cluster-detach-released-machines"
+
 	// KernelArgsInitialized indicates that KernelArgs resource has been initialized for the machine.
 	//
 	// This annotation is set on MachineStatus resource.
@@ -92,6 +106,38 @@ const (
 	// This annotation is set on the ClusterSecrets resource.
 	RotateKubernetesCATimestamp = SystemLabelPrefix + "rotate-kubernetes-ca-timestamp"
 
+	// RotateEtcdCAVersion is the version of RotateEtcdCA resource.
+	// This annotation is set on the ClusterSecretsRotationStatus resource when etcd CA rotation is initiated.
+	RotateEtcdCAVersion = SystemLabelPrefix + "rotate-etcd-ca-version"
+
+	// RotateEtcdCATimestamp indicates the time when etcd CA rotation was completed.
+	// This annotation is set on the ClusterSecrets resource.
+	RotateEtcdCATimestamp = SystemLabelPrefix + "rotate-etcd-ca-timestamp"
+
+	// RotateKubernetesAggregatorCAVersion is the version of RotateKubernetesAggregatorCA resource.
+	// This annotation is set on the ClusterSecretsRotationStatus resource when Kubernetes front-proxy CA rotation is initiated.
+	RotateKubernetesAggregatorCAVersion = SystemLabelPrefix + "rotate-kubernetes-aggregator-ca-version"
+
+	// RotateKubernetesAggregatorCATimestamp indicates the time when Kubernetes front-proxy CA rotation was completed.
+	// This annotation is set on the ClusterSecrets resource.
+	RotateKubernetesAggregatorCATimestamp = SystemLabelPrefix + "rotate-kubernetes-aggregator-ca-timestamp"
+
+	// RotateKubernetesServiceAccountKeyVersion is the version of RotateKubernetesServiceAccountKey resource.
+	// This annotation is set on the ClusterSecretsRotationStatus resource when Kubernetes service account key rotation is initiated.
+	RotateKubernetesServiceAccountKeyVersion = SystemLabelPrefix + "rotate-kubernetes-service-account-key-version"
+
+	// RotateKubernetesServiceAccountKeyTimestamp indicates the time when Kubernetes service account key rotation was completed.
+	// This annotation is set on the ClusterSecrets resource.
+	RotateKubernetesServiceAccountKeyTimestamp = SystemLabelPrefix + "rotate-kubernetes-service-account-key-timestamp"
+
+	// RotateBootstrapTokenVersion is the version of RotateBootstrapToken resource.
+	// This annotation is set on the ClusterSecretsRotationStatus resource when bootstrap token rotation is initiated.
+	RotateBootstrapTokenVersion = SystemLabelPrefix + "rotate-bootstrap-token-version"
+
+	// RotateBootstrapTokenTimestamp indicates the time when bootstrap token rotation was completed.
+	// This annotation is set on the ClusterSecrets resource.
+	RotateBootstrapTokenTimestamp = SystemLabelPrefix + "rotate-bootstrap-token-timestamp"
+
 	// KubernetesManifestOwner is added to the kubernetes resources which are created by a KubernetesManifest resource.
 	KubernetesManifestOwner = SystemLabelPrefix + "owned-by-kubernetes-manifest"
 
@@ -0,0 +1,54 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewCanaryApproval creates new CanaryApproval resource.
+//
+// The ID of the resource is the ID of the machine set which canaries are approved.
+func NewCanaryApproval(id string) *CanaryApproval {
+	return typed.NewResource[CanaryApprovalSpec, CanaryApprovalExtension](
+		resource.NewMetadata(resources.DefaultNamespace, CanaryApprovalType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.CanaryApprovalSpec{}),
+	)
+}
+
+// CanaryApprovalType is the type of CanaryApproval resource.
+//
+// tsgen:CanaryApprovalType
+const CanaryApprovalType = resource.Type("CanaryApprovals.omni.sidero.dev")
+
+// CanaryApproval resource approves the verified canaries of a machine set.
+type CanaryApproval = typed.Resource[CanaryApprovalSpec, CanaryApprovalExtension]
+
+// CanaryApprovalSpec wraps specs.CanaryApprovalSpec.
+type CanaryApprovalSpec = protobuf.ResourceSpec[specs.CanaryApprovalSpec, *specs.CanaryApprovalSpec]
+
+// CanaryApprovalExtension provides auxiliary methods for CanaryApproval resource.
+type CanaryApprovalExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (CanaryApprovalExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             CanaryApprovalType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Comment",
+				JSONPath: "{.comment}",
+			},
+		},
+	}
+}
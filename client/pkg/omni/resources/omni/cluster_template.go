@@ -0,0 +1,49 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewClusterTemplate creates new ClusterTemplate resource.
+func NewClusterTemplate(id resource.ID) *ClusterTemplate {
+	return typed.NewResource[ClusterTemplateSpec, ClusterTemplateExtension](
+		resource.NewMetadata(resources.DefaultNamespace, ClusterTemplateType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.ClusterTemplateSpec{}),
+	)
+}
+
+const (
+	// ClusterTemplateType is the type of the ClusterTemplate resource.
+	// tsgen:ClusterTemplateType
+	ClusterTemplateType = resource.Type("ClusterTemplates.omni.sidero.dev")
+)
+
+// ClusterTemplate describes a cluster template which is reconciled into the resources of the cluster with the same ID.
+type ClusterTemplate = typed.Resource[ClusterTemplateSpec, ClusterTemplateExtension]
+
+// ClusterTemplateSpec wraps specs.ClusterTemplateSpec.
+type ClusterTemplateSpec = protobuf.ResourceSpec[specs.ClusterTemplateSpec, *specs.ClusterTemplateSpec]
+
+// ClusterTemplateExtension provides auxiliary methods for ClusterTemplate resource.
+type ClusterTemplateExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (ClusterTemplateExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ClusterTemplateType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		Sensitivity:      meta.Sensitive,
+		PrintColumns:     []meta.PrintColumn{},
+	}
+}
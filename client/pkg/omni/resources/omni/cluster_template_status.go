@@ -0,0 +1,61 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewClusterTemplateStatus creates new ClusterTemplateStatus resource.
+func NewClusterTemplateStatus(id resource.ID) *ClusterTemplateStatus {
+	return typed.NewResource[ClusterTemplateStatusSpec, ClusterTemplateStatusExtension](
+		resource.NewMetadata(resources.DefaultNamespace, ClusterTemplateStatusType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.ClusterTemplateStatusSpec{}),
+	)
+}
+
+const (
+	// ClusterTemplateStatusType is the type of the ClusterTemplateStatus resource.
+	// tsgen:ClusterTemplateStatusType
+	ClusterTemplateStatusType = resource.Type("ClusterTemplateStatuses.omni.sidero.dev")
+)
+
+// ClusterTemplateStatus describes the reconciliation of the ClusterTemplate with the same ID.
+type ClusterTemplateStatus = typed.Resource[ClusterTemplateStatusSpec, ClusterTemplateStatusExtension]
+
+// ClusterTemplateStatusSpec wraps specs.ClusterTemplateStatusSpec.
+type ClusterTemplateStatusSpec = protobuf.ResourceSpec[specs.ClusterTemplateStatusSpec, *specs.ClusterTemplateStatusSpec]
+
+// ClusterTemplateStatusExtension provides auxiliary methods for ClusterTemplateStatus resource.
+type ClusterTemplateStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (ClusterTemplateStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             ClusterTemplateStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Phase",
+				JSONPath: "{.phase}",
+			},
+			{
+				Name:     "Resources",
+				JSONPath: "{.resources}",
+			},
+			{
+				Name:     "Error",
+				JSONPath: "{.error}",
+			},
+		},
+	}
+}
@@ -0,0 +1,60 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewEtcdBackupStoreConfig creates new resource which selects the remote store for the etcd backups.
+func NewEtcdBackupStoreConfig() *EtcdBackupStoreConfig {
+	return typed.NewResource[EtcdBackupStoreConfigSpec, EtcdBackupStoreConfigExtension](
+		resource.NewMetadata(resources.DefaultNamespace, EtcdBackupStoreConfigType, EtcdBackupStoreConfigID, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.EtcdBackupStoreConfigSpec{}),
+	)
+}
+
+const (
+	// EtcdBackupStoreConfigID is the ID of the EtcdBackupStoreConfig resource.
+	// tsgen:EtcdBackupStoreConfigID
+	EtcdBackupStoreConfigID = resource.ID("etcd-backup-store-config")
+
+	// EtcdBackupStoreConfigType is the type of the EtcdBackupStoreConfig resource.
+	// tsgen:EtcdBackupStoreConfigType
+	EtcdBackupStoreConfigType = resource.Type("EtcdBackupStoreConfigs.omni.sidero.dev")
+)
+
+// EtcdBackupStoreConfig selects the remote store backend for the etcd backups.
+//
+// If the resource doesn't exist, the backups are stored in S3 configured by [EtcdBackupS3Conf].
+type EtcdBackupStoreConfig = typed.Resource[EtcdBackupStoreConfigSpec, EtcdBackupStoreConfigExtension]
+
+// EtcdBackupStoreConfigSpec wraps specs.EtcdBackupStoreConfigSpec.
+type EtcdBackupStoreConfigSpec = protobuf.ResourceSpec[specs.EtcdBackupStoreConfigSpec, *specs.EtcdBackupStoreConfigSpec]
+
+// EtcdBackupStoreConfigExtension provides auxiliary methods for EtcdBackupStoreConfig resource.
+type EtcdBackupStoreConfigExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (EtcdBackupStoreConfigExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             EtcdBackupStoreConfigType,
+		DefaultNamespace: resources.DefaultNamespace,
+		Aliases:          []resource.Type{},
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Backend",
+				JSONPath: "{.backend}",
+			},
+		},
+		Sensitivity: meta.Sensitive,
+	}
+}
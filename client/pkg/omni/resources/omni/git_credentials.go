@@ -0,0 +1,49 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewGitCredentials creates new GitCredentials resource.
+func NewGitCredentials(id resource.ID) *GitCredentials {
+	return typed.NewResource[GitCredentialsSpec, GitCredentialsExtension](
+		resource.NewMetadata(resources.DefaultNamespace, GitCredentialsType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.GitCredentialsSpec{}),
+	)
+}
+
+const (
+	// GitCredentialsType is the type of the GitCredentials resource.
+	// tsgen:GitCredentialsType
+	GitCredentialsType = resource.Type("GitCredentialss.omni.sidero.dev")
+)
+
+// GitCredentials keeps the secrets used to access the Git repositories.
+type GitCredentials = typed.Resource[GitCredentialsSpec, GitCredentialsExtension]
+
+// GitCredentialsSpec wraps specs.GitCredentialsSpec.
+type GitCredentialsSpec = protobuf.ResourceSpec[specs.GitCredentialsSpec, *specs.GitCredentialsSpec]
+
+// GitCredentialsExtension provides auxiliary methods for GitCredentials resource.
+type GitCredentialsExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (GitCredentialsExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             GitCredentialsType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		Sensitivity:      meta.Sensitive,
+		PrintColumns:     []meta.PrintColumn{},
+	}
+}
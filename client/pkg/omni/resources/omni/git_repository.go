@@ -0,0 +1,65 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewGitRepository creates new GitRepository resource.
+func NewGitRepository(id resource.ID) *GitRepository {
+	return typed.NewResource[GitRepositorySpec, GitRepositoryExtension](
+		resource.NewMetadata(resources.DefaultNamespace, GitRepositoryType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.GitRepositorySpec{}),
+	)
+}
+
+const (
+	// GitRepositoryType is the type of the GitRepository resource.
+	// tsgen:GitRepositoryType
+	GitRepositoryType = resource.Type("GitRepositorys.omni.sidero.dev")
+)
+
+// GitRepository describes a Git repository which is synced into the resources.
+type GitRepository = typed.Resource[GitRepositorySpec, GitRepositoryExtension]
+
+// GitRepositorySpec wraps specs.GitRepositorySpec.
+type GitRepositorySpec = protobuf.ResourceSpec[specs.GitRepositorySpec, *specs.GitRepositorySpec]
+
+// GitRepositoryExtension provides auxiliary methods for GitRepository resource.
+type GitRepositoryExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (GitRepositoryExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             GitRepositoryType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "URL",
+				JSONPath: "{.url}",
+			},
+			{
+				Name:     "Branch",
+				JSONPath: "{.branch}",
+			},
+			{
+				Name:     "Path",
+				JSONPath: "{.path}",
+			},
+			{
+				Name:     "Suspend",
+				JSONPath: "{.suspend}",
+			},
+		},
+	}
+}
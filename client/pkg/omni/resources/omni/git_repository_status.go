@@ -0,0 +1,61 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewGitRepositoryStatus creates new GitRepositoryStatus resource.
+func NewGitRepositoryStatus(id resource.ID) *GitRepositoryStatus {
+	return typed.NewResource[GitRepositoryStatusSpec, GitRepositoryStatusExtension](
+		resource.NewMetadata(resources.DefaultNamespace, GitRepositoryStatusType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.GitRepositoryStatusSpec{}),
+	)
+}
+
+const (
+	// GitRepositoryStatusType is the type of the GitRepositoryStatus resource.
+	// tsgen:GitRepositoryStatusType
+	GitRepositoryStatusType = resource.Type("GitRepositoryStatuss.omni.sidero.dev")
+)
+
+// GitRepositoryStatus describes the sync of the GitRepository with the same ID.
+type GitRepositoryStatus = typed.Resource[GitRepositoryStatusSpec, GitRepositoryStatusExtension]
+
+// GitRepositoryStatusSpec wraps specs.GitRepositoryStatusSpec.
+type GitRepositoryStatusSpec = protobuf.ResourceSpec[specs.GitRepositoryStatusSpec, *specs.GitRepositoryStatusSpec]
+
+// GitRepositoryStatusExtension provides auxiliary methods for GitRepositoryStatus resource.
+type GitRepositoryStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (GitRepositoryStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             GitRepositoryStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Phase",
+				JSONPath: "{.phase}",
+			},
+			{
+				Name:     "Commit",
+				JSONPath: "{.commit}",
+			},
+			{
+				Name:     "Error",
+				JSONPath: "{.error}",
+			},
+		},
+	}
+}
@@ -85,6 +85,10 @@ const (
 	// tsgen:LabelMachineRequestSet
 	LabelMachineRequestSet = SystemLabelPrefix + "machine-request-set"
 
+	// LabelGitRepository is set on the resources synced from the GitRepository, its value is the ID of the repository.
+	// tsgen:LabelGitRepository
+	LabelGitRepository = SystemLabelPrefix + "git-repository"
+
 	// LabelMachineInfraID is the ID of the machine specific to an infra provider.
 	LabelMachineInfraID = SystemLabelPrefix + "infra-id"
 
@@ -6,6 +6,7 @@ package omni
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
@@ -97,5 +98,53 @@ func GetParallelism(strategyType specs.MachineSetSpec_UpdateStrategy, strategy *
 		return int(strategy.Rolling.MaxParallelism)
 	}
 
+	if strategyType == specs.MachineSetSpec_Canary {
+		if strategy == nil || strategy.Canary == nil || strategy.Canary.MaxParallelism == 0 {
+			return def
+		}
+
+		return int(strategy.Canary.MaxParallelism)
+	}
+
 	return def
 }
+
+// GetCanaryParallelism returns the parallelism for the machine set in the given phase of the canary rollout.
+//
+// While the canaries are updated or rolled back, only the canary machines can be processed, after the canaries are promoted
+// the parallelism of the strategy is used. Once the rollout fails, only the canaries with the config patches to restore can be
+// processed, otherwise the rollout is held.
+func GetCanaryParallelism(canary *specs.CanaryRolloutStatus, strategyType specs.MachineSetSpec_UpdateStrategy, strategy *specs.MachineSetSpec_UpdateStrategyConfig, def int) int {
+	if canary == nil {
+		return GetParallelism(strategyType, strategy, def)
+	}
+
+	switch canary.Phase { //nolint:exhaustive
+	case specs.CanaryRolloutStatus_Updating, specs.CanaryRolloutStatus_RollingBack:
+		return len(canary.Machines)
+	case specs.CanaryRolloutStatus_Promoted:
+		return GetParallelism(strategyType, strategy, def)
+	case specs.CanaryRolloutStatus_Failed:
+		return len(canary.RollbackConfigMachines)
+	default:
+		return 0
+	}
+}
+
+// IsCanaryUpdateAllowed reports whether the machine can be processed in the given phase of the canary rollout.
+func IsCanaryUpdateAllowed(canary *specs.CanaryRolloutStatus, machineID string) bool {
+	if canary == nil {
+		return true
+	}
+
+	switch canary.Phase { //nolint:exhaustive
+	case specs.CanaryRolloutStatus_Updating, specs.CanaryRolloutStatus_RollingBack:
+		return slices.Contains(canary.Machines, machineID)
+	case specs.CanaryRolloutStatus_Promoted:
+		return true
+	case specs.CanaryRolloutStatus_Failed:
+		return slices.Contains(canary.RollbackConfigMachines, machineID)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,143 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+func TestGetParallelism(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct { //nolint:govet
+		name         string
+		strategyType specs.MachineSetSpec_UpdateStrategy
+		strategy     *specs.MachineSetSpec_UpdateStrategyConfig
+		want         int
+	}{
+		{
+			name:         "unset",
+			strategyType: specs.MachineSetSpec_Unset,
+			want:         -1,
+		},
+		{
+			name:         "rolling default",
+			strategyType: specs.MachineSetSpec_Rolling,
+			want:         -1,
+		},
+		{
+			name:         "rolling",
+			strategyType: specs.MachineSetSpec_Rolling,
+			strategy: &specs.MachineSetSpec_UpdateStrategyConfig{
+				Rolling: &specs.MachineSetSpec_RollingUpdateStrategyConfig{MaxParallelism: 3},
+			},
+			want: 3,
+		},
+		{
+			name:         "canary default",
+			strategyType: specs.MachineSetSpec_Canary,
+			strategy: &specs.MachineSetSpec_UpdateStrategyConfig{
+				Canary: &specs.MachineSetSpec_CanaryUpdateStrategyConfig{Count: 2},
+			},
+			want: -1,
+		},
+		{
+			name:         "canary",
+			strategyType: specs.MachineSetSpec_Canary,
+			strategy: &specs.MachineSetSpec_UpdateStrategyConfig{
+				Canary: &specs.MachineSetSpec_CanaryUpdateStrategyConfig{Count: 2, MaxParallelism: 5},
+			},
+			want: 5,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.want, omni.GetParallelism(test.strategyType, test.strategy, -1))
+		})
+	}
+}
+
+func TestGetCanaryParallelism(t *testing.T) {
+	t.Parallel()
+
+	strategy := &specs.MachineSetSpec_UpdateStrategyConfig{
+		Canary: &specs.MachineSetSpec_CanaryUpdateStrategyConfig{Count: 2, MaxParallelism: 4},
+	}
+
+	canary := func(phase specs.CanaryRolloutStatus_Phase) *specs.CanaryRolloutStatus {
+		return &specs.CanaryRolloutStatus{
+			Phase:    phase,
+			Machines: []string{"a", "b"},
+		}
+	}
+
+	for _, test := range []struct { //nolint:govet
+		name        string
+		canary      *specs.CanaryRolloutStatus
+		want        int
+		allowsA     bool
+		allowsOther bool
+	}{
+		{
+			name:        "no rollout",
+			want:        4,
+			allowsA:     true,
+			allowsOther: true,
+		},
+		{
+			name:    "updating",
+			canary:  canary(specs.CanaryRolloutStatus_Updating),
+			want:    2,
+			allowsA: true,
+		},
+		{
+			name:   "verifying",
+			canary: canary(specs.CanaryRolloutStatus_Verifying),
+			want:   0,
+		},
+		{
+			name:        "promoted",
+			canary:      canary(specs.CanaryRolloutStatus_Promoted),
+			want:        4,
+			allowsA:     true,
+			allowsOther: true,
+		},
+		{
+			name:    "rolling back",
+			canary:  canary(specs.CanaryRolloutStatus_RollingBack),
+			want:    2,
+			allowsA: true,
+		},
+		{
+			name:   "failed",
+			canary: canary(specs.CanaryRolloutStatus_Failed),
+			want:   0,
+		},
+		{
+			name: "failed with config to restore",
+			canary: &specs.CanaryRolloutStatus{
+				Phase:                  specs.CanaryRolloutStatus_Failed,
+				Machines:               []string{"a", "b"},
+				RollbackConfigMachines: []string{"a"},
+			},
+			want:    1,
+			allowsA: true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.want, omni.GetCanaryParallelism(test.canary, specs.MachineSetSpec_Canary, strategy, 1))
+			assert.Equal(t, test.allowsA, omni.IsCanaryUpdateAllowed(test.canary, "a"))
+			assert.Equal(t, test.allowsOther, omni.IsCanaryUpdateAllowed(test.canary, "c"))
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewMaintenanceWindow creates new MaintenanceWindow resource.
+func NewMaintenanceWindow(id resource.ID) *MaintenanceWindow {
+	return typed.NewResource[MaintenanceWindowSpec, MaintenanceWindowExtension](
+		resource.NewMetadata(resources.DefaultNamespace, MaintenanceWindowType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.MaintenanceWindowSpec{}),
+	)
+}
+
+const (
+	// MaintenanceWindowType is the type of the MaintenanceWindow resource.
+	// tsgen:MaintenanceWindowType
+	MaintenanceWindowType = resource.Type("MaintenanceWindows.omni.sidero.dev")
+)
+
+// MaintenanceWindow describes a recurring window in which the disruptive operations are started in the clusters and machine sets referencing it.
+type MaintenanceWindow = typed.Resource[MaintenanceWindowSpec, MaintenanceWindowExtension]
+
+// MaintenanceWindowSpec wraps specs.MaintenanceWindowSpec.
+type MaintenanceWindowSpec = protobuf.ResourceSpec[specs.MaintenanceWindowSpec, *specs.MaintenanceWindowSpec]
+
+// MaintenanceWindowExtension provides auxiliary methods for MaintenanceWindow resource.
+type MaintenanceWindowExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (MaintenanceWindowExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             MaintenanceWindowType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Schedule",
+				JSONPath: "{.schedule}",
+			},
+			{
+				Name:     "Duration",
+				JSONPath: "{.duration}",
+			},
+			{
+				Name:     "Timezone",
+				JSONPath: "{.timezone}",
+			},
+		},
+	}
+}
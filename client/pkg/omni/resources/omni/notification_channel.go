@@ -0,0 +1,54 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewNotificationChannel creates new NotificationChannel resource.
+func NewNotificationChannel(id resource.ID) *NotificationChannel {
+	return typed.NewResource[NotificationChannelSpec, NotificationChannelExtension](
+		resource.NewMetadata(resources.DefaultNamespace, NotificationChannelType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.NotificationChannelSpec{}),
+	)
+}
+
+const (
+	// NotificationChannelType is the type of the NotificationChannel resource.
+	// tsgen:NotificationChannelType
+	NotificationChannelType = resource.Type("NotificationChannels.omni.sidero.dev")
+)
+
+// NotificationChannel describes a Slack, Microsoft Teams, webhook or SMTP destination the notification events are delivered to.
+type NotificationChannel = typed.Resource[NotificationChannelSpec, NotificationChannelExtension]
+
+// NotificationChannelSpec wraps specs.NotificationChannelSpec.
+type NotificationChannelSpec = protobuf.ResourceSpec[specs.NotificationChannelSpec, *specs.NotificationChannelSpec]
+
+// NotificationChannelExtension provides auxiliary methods for NotificationChannel resource.
+type NotificationChannelExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (NotificationChannelExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             NotificationChannelType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		Sensitivity:      meta.Sensitive,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Type",
+				JSONPath: "{.type}",
+			},
+		},
+	}
+}
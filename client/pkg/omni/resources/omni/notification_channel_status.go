@@ -0,0 +1,65 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewNotificationChannelStatus creates new NotificationChannelStatus resource.
+func NewNotificationChannelStatus(id resource.ID) *NotificationChannelStatus {
+	return typed.NewResource[NotificationChannelStatusSpec, NotificationChannelStatusExtension](
+		resource.NewMetadata(resources.DefaultNamespace, NotificationChannelStatusType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.NotificationChannelStatusSpec{}),
+	)
+}
+
+const (
+	// NotificationChannelStatusType is the type of the NotificationChannelStatus resource.
+	// tsgen:NotificationChannelStatusType
+	NotificationChannelStatusType = resource.Type("NotificationChannelStatuses.omni.sidero.dev")
+)
+
+// NotificationChannelStatus describes the delivery of the events to the NotificationChannel with the same ID.
+type NotificationChannelStatus = typed.Resource[NotificationChannelStatusSpec, NotificationChannelStatusExtension]
+
+// NotificationChannelStatusSpec wraps specs.NotificationChannelStatusSpec.
+type NotificationChannelStatusSpec = protobuf.ResourceSpec[specs.NotificationChannelStatusSpec, *specs.NotificationChannelStatusSpec]
+
+// NotificationChannelStatusExtension provides auxiliary methods for NotificationChannelStatus resource.
+type NotificationChannelStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (NotificationChannelStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             NotificationChannelStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Delivered",
+				JSONPath: "{.delivered}",
+			},
+			{
+				Name:     "Failed",
+				JSONPath: "{.failed}",
+			},
+			{
+				Name:     "Pending",
+				JSONPath: "{.pending}",
+			},
+			{
+				Name:     "Last Error",
+				JSONPath: "{.lasterror}",
+			},
+		},
+	}
+}
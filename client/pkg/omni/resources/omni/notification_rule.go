@@ -0,0 +1,98 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewNotificationRule creates new NotificationRule resource.
+func NewNotificationRule(id resource.ID) *NotificationRule {
+	return typed.NewResource[NotificationRuleSpec, NotificationRuleExtension](
+		resource.NewMetadata(resources.DefaultNamespace, NotificationRuleType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.NotificationRuleSpec{}),
+	)
+}
+
+const (
+	// NotificationRuleType is the type of the NotificationRule resource.
+	// tsgen:NotificationRuleType
+	NotificationRuleType = resource.Type("NotificationRules.omni.sidero.dev")
+)
+
+// Notification event types which can be selected by the NotificationRule.
+const (
+	// NotificationEventClusterPhase is emitted when the phase of the cluster changes.
+	// tsgen:NotificationEventClusterPhase
+	NotificationEventClusterPhase = "cluster-phase"
+
+	// NotificationEventTalosUpgradeFailed is emitted when the Talos upgrade of the cluster fails.
+	// tsgen:NotificationEventTalosUpgradeFailed
+	NotificationEventTalosUpgradeFailed = "talos-upgrade-failed"
+
+	// NotificationEventKubernetesUpgradeFailed is emitted when the Kubernetes upgrade of the cluster fails.
+	// tsgen:NotificationEventKubernetesUpgradeFailed
+	NotificationEventKubernetesUpgradeFailed = "kubernetes-upgrade-failed"
+
+	// NotificationEventEtcdBackupFailed is emitted when the etcd backup of the cluster fails.
+	// tsgen:NotificationEventEtcdBackupFailed
+	NotificationEventEtcdBackupFailed = "etcd-backup-failed"
+
+	// NotificationEventMachineDisconnected is emitted when a machine disconnects from Omni.
+	// tsgen:NotificationEventMachineDisconnected
+	NotificationEventMachineDisconnected = "machine-disconnected"
+
+	// NotificationEventSecretRotation is emitted when the secret rotation of the cluster moves to the next phase.
+	// tsgen:NotificationEventSecretRotation
+	NotificationEventSecretRotation = "secret-rotation"
+)
+
+// NotificationEvents are all notification event types.
+var NotificationEvents = []string{
+	NotificationEventClusterPhase,
+	NotificationEventTalosUpgradeFailed,
+	NotificationEventKubernetesUpgradeFailed,
+	NotificationEventEtcdBackupFailed,
+	NotificationEventMachineDisconnected,
+	NotificationEventSecretRotation,
+}
+
+// NotificationRule selects the events which are delivered to the notification channels.
+type NotificationRule = typed.Resource[NotificationRuleSpec, NotificationRuleExtension]
+
+// NotificationRuleSpec wraps specs.NotificationRuleSpec.
+type NotificationRuleSpec = protobuf.ResourceSpec[specs.NotificationRuleSpec, *specs.NotificationRuleSpec]
+
+// NotificationRuleExtension provides auxiliary methods for NotificationRule resource.
+type NotificationRuleExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (NotificationRuleExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             NotificationRuleType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Channels",
+				JSONPath: "{.channels}",
+			},
+			{
+				Name:     "Events",
+				JSONPath: "{.events}",
+			},
+			{
+				Name:     "Min Severity",
+				JSONPath: "{.minseverity}",
+			},
+		},
+	}
+}
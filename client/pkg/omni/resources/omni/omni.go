@@ -8,6 +8,7 @@ package omni
 import "github.com/siderolabs/omni/client/pkg/omni/resources/registry"
 
 func init() {
+	registry.MustRegisterResource(CanaryApprovalType, &CanaryApproval{})
 	registry.MustRegisterResource(ClusterBootstrapStatusType, &ClusterBootstrapStatus{})
 	registry.MustRegisterResource(ClusterConfigVersionType, &ClusterConfigVersion{})
 	registry.MustRegisterResource(ClusterEndpointType, &ClusterEndpoint{})
@@ -32,6 +33,11 @@ func init() {
 	registry.MustRegisterResource(ClusterStatusMetricsType, &ClusterStatusMetrics{})
 	registry.MustRegisterResource(ClusterKubernetesManifestsStatusType, &ClusterKubernetesManifestsStatus{})
 	registry.MustRegisterResource(ClusterTaintType, &ClusterTaint{})
+	registry.MustRegisterResource(ClusterTemplateType, &ClusterTemplate{})
+	registry.MustRegisterResource(ClusterTemplateStatusType, &ClusterTemplateStatus{})
+	registry.MustRegisterResource(GitRepositoryType, &GitRepository{})
+	registry.MustRegisterResource(GitRepositoryStatusType, &GitRepositoryStatus{})
+	registry.MustRegisterResource(GitCredentialsType, &GitCredentials{})
 	registry.MustRegisterResource(ConfigPatchType, &ConfigPatch{})
 	registry.MustRegisterResource(DiscoveryAffiliateDeleteTaskType, &DiscoveryAffiliateDeleteTask{})
 	registry.MustRegisterResource(EtcdAuditResultType, &EtcdAuditResult{})
@@ -41,6 +47,7 @@ func init() {
 	registry.MustRegisterResource(EtcdBackupStatusType, &EtcdBackupStatus{})
 	registry.MustRegisterResource(EtcdBackupOverallStatusType, &EtcdBackupOverallStatus{})
 	registry.MustRegisterResource(EtcdBackupStoreStatusType, &EtcdBackupStoreStatus{})
+	registry.MustRegisterResource(EtcdBackupStoreConfigType, &EtcdBackupStoreConfig{})
 	registry.MustRegisterResource(ExtensionsConfigurationType, &ExtensionsConfiguration{})
 	registry.MustRegisterResource(BackupDataType, &BackupData{})
 	registry.MustRegisterResource(EtcdManualBackupType, &EtcdManualBackup{})
@@ -64,6 +71,7 @@ func init() {
 	registry.MustRegisterResource(KubernetesUpgradeManifestStatusType, &KubernetesUpgradeManifestStatus{})
 	registry.MustRegisterResource(KubernetesUpgradeStatusType, &KubernetesUpgradeStatus{})
 	registry.MustRegisterResource(KubernetesVersionType, &KubernetesVersion{})
+	registry.MustRegisterResource(MaintenanceWindowType, &MaintenanceWindow{})
 	registry.MustRegisterResource(MachineConfigDiffType, &MachineConfigDiff{})
 	registry.MustRegisterResource(MachineLabelsType, &MachineLabels{})
 	registry.MustRegisterResource(MachineType, &Machine{})
@@ -86,6 +94,9 @@ func init() {
 	registry.MustRegisterResource(MachineStatusLinkType, &MachineStatusLink{})
 	registry.MustRegisterResource(MachineStatusMetricsType, &MachineStatusMetrics{})
 	registry.MustRegisterResource(NotificationType, &Notification{})
+	registry.MustRegisterResource(NotificationChannelType, &NotificationChannel{})
+	registry.MustRegisterResource(NotificationChannelStatusType, &NotificationChannelStatus{})
+	registry.MustRegisterResource(NotificationRuleType, &NotificationRule{})
 	registry.MustRegisterResource(MaintenanceConfigStatusType, &MaintenanceConfigStatus{})
 	registry.MustRegisterResource(MachineConfigExtractionStatusType, &MachineConfigExtractionStatus{})
 	registry.MustRegisterResource(LoadBalancerConfigType, &LoadBalancerConfig{})
@@ -107,5 +118,9 @@ func init() {
 	registry.MustRegisterResource(SecretRotationType, &SecretRotation{})
 	registry.MustRegisterResource(RotateTalosCAType, &RotateTalosCA{})
 	registry.MustRegisterResource(RotateKubernetesCAType, &RotateKubernetesCA{})
+	registry.MustRegisterResource(RotateEtcdCAType, &RotateEtcdCA{})
+	registry.MustRegisterResource(RotateKubernetesAggregatorCAType, &RotateKubernetesAggregatorCA{})
+	registry.MustRegisterResource(RotateKubernetesServiceAccountKeyType, &RotateKubernetesServiceAccountKey{})
+	registry.MustRegisterResource(RotateBootstrapTokenType, &RotateBootstrapToken{})
 	registry.MustRegisterResource(UpgradeRolloutType, &UpgradeRollout{})
 }
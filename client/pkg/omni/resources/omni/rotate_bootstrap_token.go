@@ -0,0 +1,47 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewRotateBootstrapToken creates a new RotateBootstrapToken resource.
+func NewRotateBootstrapToken(id resource.ID) *RotateBootstrapToken {
+	return typed.NewResource[RotateBootstrapTokenSpec, RotateBootstrapTokenExtension](
+		resource.NewMetadata(resources.DefaultNamespace, RotateBootstrapTokenType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.RotateBootstrapTokenSpec{}),
+	)
+}
+
+// RotateBootstrapTokenType is the type of RotateBootstrapToken resource.
+//
+// tsgen:RotateBootstrapTokenType
+const RotateBootstrapTokenType = resource.Type("RotateBootstrapTokens.omni.sidero.dev")
+
+// RotateBootstrapToken resource describes bootstrap token rotation request.
+type RotateBootstrapToken = typed.Resource[RotateBootstrapTokenSpec, RotateBootstrapTokenExtension]
+
+// RotateBootstrapTokenSpec wraps specs.RotateBootstrapTokenSpec.
+type RotateBootstrapTokenSpec = protobuf.ResourceSpec[specs.RotateBootstrapTokenSpec, *specs.RotateBootstrapTokenSpec]
+
+// RotateBootstrapTokenExtension provides auxiliary methods for RotateBootstrapToken resource.
+type RotateBootstrapTokenExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (RotateBootstrapTokenExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             RotateBootstrapTokenType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns:     []meta.PrintColumn{},
+	}
+}
@@ -0,0 +1,47 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewRotateEtcdCA creates a new RotateEtcdCA resource.
+func NewRotateEtcdCA(id resource.ID) *RotateEtcdCA {
+	return typed.NewResource[RotateEtcdCASpec, RotateEtcdCAExtension](
+		resource.NewMetadata(resources.DefaultNamespace, RotateEtcdCAType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.RotateEtcdCASpec{}),
+	)
+}
+
+// RotateEtcdCAType is the type of RotateEtcdCA resource.
+//
+// tsgen:RotateEtcdCAType
+const RotateEtcdCAType = resource.Type("RotateEtcdCAs.omni.sidero.dev")
+
+// RotateEtcdCA resource describes etcd CA rotation request.
+type RotateEtcdCA = typed.Resource[RotateEtcdCASpec, RotateEtcdCAExtension]
+
+// RotateEtcdCASpec wraps specs.RotateEtcdCASpec.
+type RotateEtcdCASpec = protobuf.ResourceSpec[specs.RotateEtcdCASpec, *specs.RotateEtcdCASpec]
+
+// RotateEtcdCAExtension provides auxiliary methods for RotateEtcdCA resource.
+type RotateEtcdCAExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (RotateEtcdCAExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             RotateEtcdCAType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns:     []meta.PrintColumn{},
+	}
+}
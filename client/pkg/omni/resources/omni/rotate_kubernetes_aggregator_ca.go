@@ -0,0 +1,47 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewRotateKubernetesAggregatorCA creates a new RotateKubernetesAggregatorCA resource.
+func NewRotateKubernetesAggregatorCA(id resource.ID) *RotateKubernetesAggregatorCA {
+	return typed.NewResource[RotateKubernetesAggregatorCASpec, RotateKubernetesAggregatorCAExtension](
+		resource.NewMetadata(resources.DefaultNamespace, RotateKubernetesAggregatorCAType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.RotateKubernetesAggregatorCASpec{}),
+	)
+}
+
+// RotateKubernetesAggregatorCAType is the type of RotateKubernetesAggregatorCA resource.
+//
+// tsgen:RotateKubernetesAggregatorCAType
+const RotateKubernetesAggregatorCAType = resource.Type("RotateKubernetesAggregatorCAs.omni.sidero.dev")
+
+// RotateKubernetesAggregatorCA resource describes Kubernetes front-proxy CA rotation request.
+type RotateKubernetesAggregatorCA = typed.Resource[RotateKubernetesAggregatorCASpec, RotateKubernetesAggregatorCAExtension]
+
+// RotateKubernetesAggregatorCASpec wraps specs.RotateKubernetesAggregatorCASpec.
+type RotateKubernetesAggregatorCASpec = protobuf.ResourceSpec[specs.RotateKubernetesAggregatorCASpec, *specs.RotateKubernetesAggregatorCASpec]
+
+// RotateKubernetesAggregatorCAExtension provides auxiliary methods for RotateKubernetesAggregatorCA resource.
+type RotateKubernetesAggregatorCAExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (RotateKubernetesAggregatorCAExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             RotateKubernetesAggregatorCAType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns:     []meta.PrintColumn{},
+	}
+}
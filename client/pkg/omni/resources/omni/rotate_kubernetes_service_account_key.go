@@ -0,0 +1,47 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omni
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+)
+
+// NewRotateKubernetesServiceAccountKey creates a new RotateKubernetesServiceAccountKey resource.
+func NewRotateKubernetesServiceAccountKey(id resource.ID) *RotateKubernetesServiceAccountKey {
+	return typed.NewResource[RotateKubernetesServiceAccountKeySpec, RotateKubernetesServiceAccountKeyExtension](
+		resource.NewMetadata(resources.DefaultNamespace, RotateKubernetesServiceAccountKeyType, id, resource.VersionUndefined),
+		protobuf.NewResourceSpec(&specs.RotateKubernetesServiceAccountKeySpec{}),
+	)
+}
+
+// RotateKubernetesServiceAccountKeyType is the type of RotateKubernetesServiceAccountKey resource.
+//
+// tsgen:RotateKubernetesServiceAccountKeyType
+const RotateKubernetesServiceAccountKeyType = resource.Type("RotateKubernetesServiceAccountKeys.omni.sidero.dev")
+
+// RotateKubernetesServiceAccountKey resource describes Kubernetes service account key rotation request.
+type RotateKubernetesServiceAccountKey = typed.Resource[RotateKubernetesServiceAccountKeySpec, RotateKubernetesServiceAccountKeyExtension]
+
+// RotateKubernetesServiceAccountKeySpec wraps specs.RotateKubernetesServiceAccountKeySpec.
+type RotateKubernetesServiceAccountKeySpec = protobuf.ResourceSpec[specs.RotateKubernetesServiceAccountKeySpec, *specs.RotateKubernetesServiceAccountKeySpec]
+
+// RotateKubernetesServiceAccountKeyExtension provides auxiliary methods for RotateKubernetesServiceAccountKey resource.
+type RotateKubernetesServiceAccountKeyExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (RotateKubernetesServiceAccountKeyExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             RotateKubernetesServiceAccountKeyType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: resources.DefaultNamespace,
+		PrintColumns:     []meta.PrintColumn{},
+	}
+}
@@ -5,10 +5,15 @@
 package omni
 
 import (
+	"errors"
+	"fmt"
+	"time"
+
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
 	"github.com/cosi-project/runtime/pkg/resource/protobuf"
 	"github.com/cosi-project/runtime/pkg/resource/typed"
+	"github.com/hashicorp/go-multierror"
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
@@ -47,3 +52,81 @@ func (SecretRotationExtension) ResourceDefinition() meta.ResourceDefinitionSpec
 		PrintColumns:     []meta.PrintColumn{},
 	}
 }
+
+var secretRotationComponentNames = map[specs.SecretRotationSpec_Component]string{
+	specs.SecretRotationSpec_TALOS_CA:                       "talos-ca",
+	specs.SecretRotationSpec_KUBERNETES_CA:                  "kubernetes-ca",
+	specs.SecretRotationSpec_ETCD_CA:                        "etcd-ca",
+	specs.SecretRotationSpec_KUBERNETES_AGGREGATOR_CA:       "kubernetes-aggregator-ca",
+	specs.SecretRotationSpec_KUBERNETES_SERVICE_ACCOUNT_KEY: "kubernetes-service-account-key",
+	specs.SecretRotationSpec_BOOTSTRAP_TOKEN:                "bootstrap-token",
+}
+
+// SecretRotationComponentName returns the name of the secret rotation component used in the templates and the CLI.
+func SecretRotationComponentName(component specs.SecretRotationSpec_Component) string {
+	if name, ok := secretRotationComponentNames[component]; ok {
+		return name
+	}
+
+	return component.String()
+}
+
+// ParseSecretRotationComponent parses the name of the secret rotation component used in the templates and the CLI.
+func ParseSecretRotationComponent(name string) (specs.SecretRotationSpec_Component, error) {
+	for component, componentName := range secretRotationComponentNames {
+		if componentName == name {
+			return component, nil
+		}
+	}
+
+	return specs.SecretRotationSpec_NONE, fmt.Errorf("unknown secret rotation component %q", name)
+}
+
+// MinSecretRotationInterval is the shortest interval allowed in the secret rotation policy.
+const MinSecretRotationInterval = 24 * time.Hour
+
+// ValidateSecretRotationPolicy runs the client-side validations of the cluster secret rotation policy.
+func ValidateSecretRotationPolicy(policy *specs.SecretRotationPolicy) error {
+	if policy == nil {
+		return nil
+	}
+
+	var multiErr error
+
+	interval := policy.GetInterval().AsDuration()
+	expiryThreshold := policy.GetExpiryThreshold().AsDuration()
+
+	if interval == 0 && expiryThreshold == 0 {
+		multiErr = multierror.Append(multiErr, errors.New("secret rotation policy requires the interval or the expiry threshold to be set"))
+	}
+
+	if interval != 0 && interval < MinSecretRotationInterval {
+		multiErr = multierror.Append(multiErr, fmt.Errorf("secret rotation interval must be at least %s, actual %s", MinSecretRotationInterval, interval))
+	}
+
+	if expiryThreshold < 0 {
+		multiErr = multierror.Append(multiErr, fmt.Errorf("secret rotation expiry threshold must not be negative, actual %s", expiryThreshold))
+	}
+
+	if len(policy.GetComponents()) == 0 {
+		multiErr = multierror.Append(multiErr, errors.New("secret rotation policy requires at least one component"))
+	}
+
+	seen := map[specs.SecretRotationSpec_Component]struct{}{}
+
+	for _, component := range policy.GetComponents() {
+		if _, ok := specs.SecretRotationSpec_Component_name[int32(component)]; !ok || component == specs.SecretRotationSpec_NONE {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("invalid secret rotation component %q", SecretRotationComponentName(component)))
+
+			continue
+		}
+
+		if _, ok := seen[component]; ok {
+			multiErr = multierror.Append(multiErr, fmt.Errorf("duplicate secret rotation component %q", SecretRotationComponentName(component)))
+		}
+
+		seen[component] = struct{}{}
+	}
+
+	return multiErr
+}
@@ -0,0 +1,158 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omnictl
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/auditchain"
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+var auditLogVerifyFlags struct {
+	publicKey string
+}
+
+// auditLogVerify represents audit-log verify command.
+var auditLogVerify = &cobra.Command{
+	Use:   "verify [start] [end]",
+	Short: "Verify that the audit log was not modified",
+	Long: "Walk the hash chain of the audit log and report the modified, reordered or missing events. " +
+		"The events removed by the retention cleanup are recorded by signed truncation markers and are not reported. " +
+		"Optionally limit the range using start and end arguments in YYYY-MM-DD format. Without the start argument, " +
+		"the chain must start with the first event ever written or with a truncation marker. " +
+		"The markers must be signed with the audit log signing key of the server, unless another key is set with --public-key.",
+	Args: cobra.MaximumNArgs(2),
+	RunE: func(cmd *cobra.Command, arg []string) error {
+		start := safeGet(arg, 0)
+		end := safeGet(arg, 1)
+
+		verifier := &auditchain.Verifier{
+			RequireAnchor: start == "",
+		}
+
+		if auditLogVerifyFlags.publicKey != "" {
+			key, err := base64.StdEncoding.DecodeString(auditLogVerifyFlags.publicKey)
+			if err != nil || len(key) != ed25519.PublicKeySize {
+				return errors.New("--public-key must be a base64 encoded Ed25519 public key")
+			}
+
+			verifier.PublicKey = key
+		}
+
+		return access.WithClient(func(ctx context.Context, client *client.Client, _ access.ServerInfo) error {
+			if verifier.PublicKey == nil {
+				key, err := client.Management().AuditLogSigningKey(ctx)
+				if err != nil {
+					return err
+				}
+
+				if len(key) != ed25519.PublicKeySize {
+					return errors.New("the server returned an invalid audit log signing key")
+				}
+
+				verifier.PublicKey = key
+			}
+
+			req := &management.ReadAuditLogRequest{
+				StartTime:  start,
+				EndTime:    end,
+				WithProofs: true,
+			}
+
+			// everything retained, the default start time of the server covers only the last month
+			if start == "" {
+				req.StartTsMs = 1
+			}
+
+			var issues int
+
+			for resp, err := range client.Management().ReadAuditLog(ctx, req) {
+				if err != nil {
+					return err
+				}
+
+				for _, issue := range verifier.Add(auditChainEvent(resp)) {
+					issues++
+
+					fmt.Fprintln(cmd.OutOrStdout(), issue.String()) //nolint:errcheck
+				}
+			}
+
+			printAuditLogVerifySummary(verifier.Summary())
+
+			if issues > 0 {
+				return fmt.Errorf("audit log verification failed: %d issues found", issues)
+			}
+
+			return nil
+		})
+	},
+}
+
+func auditChainEvent(resp *management.ReadAuditLogResponse) auditchain.Event {
+	event := auditchain.Event{
+		ID:       resp.GetId(),
+		Payload:  resp.GetAuditLog(),
+		PrevHash: resp.GetProof().GetPrevHash(),
+		Hash:     resp.GetProof().GetHash(),
+	}
+
+	for _, marker := range resp.GetProof().GetMarkers() {
+		kind := auditchain.MarkerCheckpoint
+		if marker.GetKind() == management.AuditLogChainMarker_TRUNCATION {
+			kind = auditchain.MarkerTruncation
+		}
+
+		event.Markers = append(event.Markers, auditchain.Marker{
+			Kind:        kind,
+			ID:          marker.GetId(),
+			Hash:        marker.GetHash(),
+			TimestampMs: marker.GetTimestampMs(),
+			PublicKey:   marker.GetPublicKey(),
+			Signature:   marker.GetSignature(),
+		})
+	}
+
+	return event
+}
+
+func printAuditLogVerifySummary(summary auditchain.Summary) {
+	if summary.Events == 0 {
+		fmt.Fprintln(os.Stderr, "no audit log events in the range") //nolint:errcheck
+
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "verified %d events, ids %d to %d: %d checkpoints, %d truncations, %d written before the chaining\n", //nolint:errcheck
+		summary.Events, summary.FirstID, summary.LastID, summary.Checkpoints, summary.Truncations, summary.Unchained)
+
+	for _, key := range summary.PublicKeys {
+		fmt.Fprintf(os.Stderr, "markers signed by %s\n", base64.StdEncoding.EncodeToString(key)) //nolint:errcheck
+	}
+
+	switch {
+	case summary.LastCheckpointID == 0:
+		fmt.Fprintln(os.Stderr, "none of the events are signed by a checkpoint yet") //nolint:errcheck
+	case summary.LastCheckpointID < summary.LastID:
+		fmt.Fprintf(os.Stderr, "the events after id %d are not signed by a checkpoint yet\n", summary.LastCheckpointID) //nolint:errcheck
+	}
+}
+
+func init() {
+	auditLogVerify.Flags().StringVar(&auditLogVerifyFlags.publicKey, "public-key", "",
+		"base64 encoded Ed25519 public key the markers must be signed with, as printed by a previous verification, defaults to the audit log signing key of the server")
+
+	auditLog.AddCommand(auditLogVerify)
+}
@@ -158,6 +158,8 @@ func init() {
 			"talos_access":          management.AuditLogEventType_AUDIT_LOG_EVENT_TYPE_TALOS_ACCESS,
 			"k8s_access":            management.AuditLogEventType_AUDIT_LOG_EVENT_TYPE_K8S_ACCESS,
 			"audit_log_access":      management.AuditLogEventType_AUDIT_LOG_EVENT_TYPE_AUDIT_LOG_ACCESS,
+			"workload_proxy_access": management.AuditLogEventType_AUDIT_LOG_EVENT_TYPE_WORKLOAD_PROXY_ACCESS,
+			"rate_limit":            management.AuditLogEventType_AUDIT_LOG_EVENT_TYPE_RATE_LIMIT,
 		},
 	}
 
@@ -0,0 +1,124 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go.yaml.in/yaml/v4"
+
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+var autoscalerCredentialsCmdFlags struct {
+	output    string
+	mountPath string
+	caCert    string
+	ttl       time.Duration
+}
+
+// autoscalerCredentialsCmd represents the cluster autoscaler-credentials command.
+var autoscalerCredentialsCmd = &cobra.Command{
+	Use:   "autoscaler-credentials cluster-name",
+	Short: "Issue the credentials for the Kubernetes cluster autoscaler of the cluster.",
+	Long: `Issue the client certificate the Kubernetes cluster autoscaler of the cluster authenticates to Omni with.
+
+Omni serves the externalgrpc cloud provider of the cluster autoscaler: the node groups are the worker machine sets
+of the cluster with the autoscaling bounds set. The command writes the key, the certificate and the cloud config
+for the cluster autoscaler to the output directory. Mount them to the cluster autoscaler pod at --mount-path,
+and run it with --cloud-provider=externalgrpc --cloud-config=<mount-path>/cloud-config.yaml.
+
+The certificate is valid for --ttl, at most for 30 days, run the command again to renew it.`,
+	Example: "",
+	Args:    cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		return access.WithClient(autoscalerCredentials(args[0]))
+	},
+}
+
+func autoscalerCredentials(clusterName string) func(ctx context.Context, client *client.Client, _ access.ServerInfo) error {
+	return func(ctx context.Context, client *client.Client, _ access.ServerInfo) error {
+		output := autoscalerCredentialsCmdFlags.output
+		if output == "" {
+			output = clusterName + "-autoscaler"
+		}
+
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return err
+		}
+
+		keyDER, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			return err
+		}
+
+		csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{}, key)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Management().ClusterAutoscalerCredentials(ctx, clusterName,
+			pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr}), autoscalerCredentialsCmdFlags.ttl)
+		if err != nil {
+			return fmt.Errorf("failed to issue the cluster autoscaler credentials of cluster %q: %w", clusterName, err)
+		}
+
+		mountPath := autoscalerCredentialsCmdFlags.mountPath
+
+		cloudConfig, err := yaml.Marshal(map[string]string{
+			"address":      resp.GetAddress(),
+			"key":          path.Join(mountPath, "tls.key"),
+			"cert":         path.Join(mountPath, "tls.crt"),
+			"cacert":       autoscalerCredentialsCmdFlags.caCert,
+			"grpc_timeout": "10s",
+		})
+		if err != nil {
+			return err
+		}
+
+		if err = os.MkdirAll(output, 0o700); err != nil {
+			return err
+		}
+
+		for name, data := range map[string][]byte{
+			"tls.key":           pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+			"tls.crt":           resp.GetCertificate(),
+			"cloud-config.yaml": cloudConfig,
+		} {
+			if err = os.WriteFile(filepath.Join(output, name), data, 0o600); err != nil {
+				return err
+			}
+		}
+
+		fmt.Fprintf(os.Stderr, "the cluster autoscaler credentials of cluster %q are written to %q, valid until %s\n",
+			clusterName, output, resp.GetExpiration().AsTime().Local().Format(time.RFC3339))
+
+		return nil
+	}
+}
+
+func init() {
+	autoscalerCredentialsCmd.Flags().StringVarP(&autoscalerCredentialsCmdFlags.output, "output", "o", "",
+		"directory to write the credentials to, defaults to <cluster-name>-autoscaler")
+	autoscalerCredentialsCmd.Flags().StringVar(&autoscalerCredentialsCmdFlags.mountPath, "mount-path", "/etc/cluster-autoscaler/omni",
+		"path the credentials are mounted at in the cluster autoscaler pod")
+	autoscalerCredentialsCmd.Flags().StringVar(&autoscalerCredentialsCmdFlags.caCert, "ca-cert", "/etc/ssl/certs/ca-certificates.crt",
+		"path of the CA bundle which verifies the certificate of Omni in the cluster autoscaler pod")
+	autoscalerCredentialsCmd.Flags().DurationVar(&autoscalerCredentialsCmdFlags.ttl, "ttl", 0, "lifetime of the credentials, defaults to the longest allowed")
+	clusterCmd.AddCommand(autoscalerCredentialsCmd)
+}
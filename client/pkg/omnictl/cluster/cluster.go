@@ -16,6 +16,7 @@ import (
 
 	"github.com/siderolabs/omni/client/pkg/client"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/omnictl/cluster/etcd"
 	"github.com/siderolabs/omni/client/pkg/omnictl/cluster/kubernetes"
 	"github.com/siderolabs/omni/client/pkg/omnictl/cluster/secret"
 	"github.com/siderolabs/omni/client/pkg/omnictl/cluster/template"
@@ -57,6 +58,8 @@ func setClusterLocked(clusterID resource.ID, lock bool) func(context.Context, *c
 			} else {
 				res.Metadata().Annotations().Delete(omni.ClusterLocked)
 				res.Metadata().Annotations().Delete(omni.ClusterImportIsInProgress)
+				res.Metadata().Annotations().Delete(omni.ClusterEtcdRestoreInProgress)
+				res.Metadata().Annotations().Delete(omni.ClusterDetachInProgress)
 			}
 
 			return nil
@@ -91,4 +94,5 @@ func init() {
 	clusterCmd.AddCommand(lockClusterCmd)
 	clusterCmd.AddCommand(unlockClusterCmd)
 	clusterCmd.AddCommand(secret.RootCmd())
+	clusterCmd.AddCommand(etcd.RootCmd())
 }
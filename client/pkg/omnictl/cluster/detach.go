@@ -0,0 +1,113 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+var detachCmdFlags struct {
+	output               string
+	controlPlaneEndpoint string
+	exportOnly           bool
+	force                bool
+}
+
+// detachCmd represents the cluster detach command.
+var detachCmd = &cobra.Command{
+	Use:   "detach cluster-name",
+	Short: "Detach the cluster from Omni.",
+	Long: `Detach the cluster from Omni, so that it keeps running without it.
+
+The cluster is locked and its machine configs are exported with the cluster secrets, together with the admin talosconfig
+and kubeconfig which connect to the nodes directly. The SideroLink, event sink and kernel log settings, and the Omni embedded
+discovery service are removed from the configs, and the control plane endpoint is replaced with --control-plane-endpoint,
+which defaults to the first control plane node.
+
+Then the configs are applied to the machines, and the cluster and its machines are removed from Omni without resetting them.
+With --export-only the configs are only exported, run the command again to release the machines, or unlock the cluster to cancel the detach.
+The release is refused if some of the machines are not connected to Omni: with --force they are released without the detached config,
+which has to be applied to them manually from the exported files.
+
+The kernel arguments of the installed Talos might still point to Omni: the machines reconnect to Omni after a reboot until
+they are upgraded to an image without them.`,
+	Example: "",
+	Args:    cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		return access.WithClient(detach(args[0]))
+	},
+}
+
+func detach(clusterName string) func(ctx context.Context, client *client.Client, _ access.ServerInfo) error {
+	return func(ctx context.Context, client *client.Client, _ access.ServerInfo) error {
+		output := detachCmdFlags.output
+		if output == "" {
+			output = clusterName + "-detached"
+		}
+
+		resp, err := client.Management().DetachCluster(ctx, clusterName, detachCmdFlags.controlPlaneEndpoint)
+		if err != nil {
+			return fmt.Errorf("failed to detach cluster %q: %w", clusterName, err)
+		}
+
+		if err = os.MkdirAll(output, 0o700); err != nil {
+			return err
+		}
+
+		files := map[string][]byte{
+			"talosconfig": resp.GetTalosconfig(),
+			"kubeconfig":  resp.GetKubeconfig(),
+		}
+
+		for _, machine := range resp.GetMachines() {
+			name := machine.GetHostname()
+			if name == "" {
+				name = machine.GetId()
+			}
+
+			files[name+".yaml"] = machine.GetConfig()
+		}
+
+		for name, data := range files {
+			if err = os.WriteFile(filepath.Join(output, name), data, 0o600); err != nil {
+				return err
+			}
+		}
+
+		fmt.Fprintf(os.Stderr, "the configs of cluster %q are exported to %q\n", clusterName, output)
+
+		if detachCmdFlags.exportOnly {
+			return nil
+		}
+
+		for resp, err := range client.Management().ReleaseDetachedCluster(ctx, clusterName, detachCmdFlags.force) {
+			if err != nil {
+				return fmt.Errorf("failed to release cluster %q: %w", clusterName, err)
+			}
+
+			if msg := resp.GetMessage(); msg != "" {
+				fmt.Fprintln(os.Stderr, msg)
+			}
+		}
+
+		return nil
+	}
+}
+
+func init() {
+	detachCmd.Flags().StringVarP(&detachCmdFlags.output, "output", "o", "", "directory to export the configs to, defaults to <cluster-name>-detached")
+	detachCmd.Flags().StringVar(&detachCmdFlags.controlPlaneEndpoint, "control-plane-endpoint", "", "Kubernetes API endpoint of the detached cluster, e.g. https://10.5.0.2:6443")
+	detachCmd.Flags().BoolVar(&detachCmdFlags.exportOnly, "export-only", false, "only export the configs, keeping the machines managed by Omni")
+	detachCmd.Flags().BoolVar(&detachCmdFlags.force, "force", false, "release the cluster even if some of its machines are not connected to Omni")
+	clusterCmd.AddCommand(detachCmd)
+}
@@ -0,0 +1,23 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package etcd contains commands related to cluster etcd operations.
+package etcd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// etcdCmd represents the etcd sub-command.
+var etcdCmd = &cobra.Command{
+	Use:     "etcd",
+	Short:   "Cluster etcd management subcommands.",
+	Long:    `Commands to manage etcd of the cluster.`,
+	Example: "",
+}
+
+// RootCmd exports etcdCmd.
+func RootCmd() *cobra.Command {
+	return etcdCmd
+}
@@ -0,0 +1,129 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package etcd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+var restoreCmdFlags struct {
+	snapshot      string
+	wipeEphemeral bool
+	noAsk         bool
+}
+
+// restoreCmd represents the cluster etcd restore command.
+var restoreCmd = &cobra.Command{
+	Use:   "restore cluster-name",
+	Short: "Restore etcd of an existing cluster from a backup.",
+	Long: `Restore etcd of an existing cluster from an etcd backup snapshot.
+
+The control plane nodes are drained, their etcd data is wiped, and etcd is bootstrapped again from the snapshot.
+The cluster is locked while the restore is in progress. The latest backup of the cluster is restored when --snapshot is omitted,
+use 'omnictl get etcdbackups -l omni.sidero.dev/cluster=<cluster-name>' to list the available snapshots.
+
+Talos 1.14 and newer keep the etcd data in a separate volume, which is the only one wiped by default.
+Older Talos versions keep it in the EPHEMERAL partition, which also holds the container images, the kubelet data and the local volumes,
+so the restore of such a cluster requires --wipe-ephemeral. Wiping the EPHEMERAL partition asks for confirmation unless --yes is set.
+Restore progress is streamed to the terminal; the restore keeps running on the server if the command is interrupted.`,
+	Example: `  # Restore the latest etcd backup of the cluster
+  omnictl cluster etcd restore my-cluster
+
+  # Restore the given snapshot, wiping the EPHEMERAL partition of the control plane nodes without asking for confirmation
+  omnictl cluster etcd restore my-cluster --snapshot FFFFFFFF9A99FBFE.snapshot --wipe-ephemeral --yes`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		return access.WithClient(restore(args[0]))
+	},
+}
+
+func restore(clusterName string) func(ctx context.Context, client *client.Client, _ access.ServerInfo) error {
+	return func(ctx context.Context, client *client.Client, _ access.ServerInfo) error {
+		snapshot := restoreCmdFlags.snapshot
+
+		if snapshot == "" {
+			var err error
+
+			if snapshot, err = latestSnapshot(ctx, client.Omni().State(), clusterName); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(os.Stderr, "restoring the latest snapshot %q\n", snapshot)
+		}
+
+		if restoreCmdFlags.wipeEphemeral && !restoreCmdFlags.noAsk {
+			var response string
+
+			//nolint:errcheck
+			color.New(color.FgYellow).Fprintf(os.Stderr, `WARNING: the EPHEMERAL partition of the control plane nodes of cluster %s will be wiped.
+All the data in it is lost, including the container images, the kubelet data and the local volumes of the workloads.
+`, clusterName)
+			fmt.Fprint(os.Stderr, `Do you want to continue? (y/N): `)
+
+			if _, err := fmt.Scanln(&response); err != nil {
+				return fmt.Errorf("failed to read user input: %w", err)
+			}
+
+			if !strings.EqualFold(response, "y") {
+				fmt.Fprintln(os.Stderr, "aborting etcd restore")
+
+				return nil
+			}
+		}
+
+		for resp, err := range client.Management().EtcdRestore(ctx, clusterName, snapshot, restoreCmdFlags.wipeEphemeral) {
+			if err != nil {
+				return fmt.Errorf("failed to restore etcd of cluster %q: %w", clusterName, err)
+			}
+
+			if msg := resp.GetMessage(); msg != "" {
+				fmt.Fprintln(os.Stderr, msg)
+			}
+		}
+
+		return nil
+	}
+}
+
+func latestSnapshot(ctx context.Context, st state.State, clusterName string) (string, error) {
+	backups, err := safe.StateListAll[*omni.EtcdBackup](ctx, st, state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, clusterName)))
+	if err != nil {
+		return "", fmt.Errorf("failed to list etcd backups of cluster %q: %w", clusterName, err)
+	}
+
+	var latest *omni.EtcdBackup
+
+	for backup := range backups.All() {
+		if latest == nil || backup.TypedSpec().Value.CreatedAt.AsTime().After(latest.TypedSpec().Value.CreatedAt.AsTime()) {
+			latest = backup
+		}
+	}
+
+	if latest == nil {
+		return "", fmt.Errorf("no etcd backups found for cluster %q", clusterName)
+	}
+
+	return latest.TypedSpec().Value.Snapshot, nil
+}
+
+func init() {
+	restoreCmd.Flags().StringVar(&restoreCmdFlags.snapshot, "snapshot", "", "name of the etcd backup snapshot to restore, defaults to the latest one")
+	restoreCmd.Flags().BoolVar(&restoreCmdFlags.wipeEphemeral, "wipe-ephemeral", false, "wipe the EPHEMERAL partition of the control plane nodes, required for Talos versions before 1.14")
+	restoreCmd.Flags().BoolVarP(&restoreCmdFlags.noAsk, "yes", "y", false, "do not ask for confirmation when using --wipe-ephemeral")
+	etcdCmd.AddCommand(restoreCmd)
+}
@@ -0,0 +1,159 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+var logsCmdFlags struct {
+	since     string
+	until     string
+	pattern   string
+	logFormat string
+	selectors []string
+	services  []string
+	tailLines int32
+	follow    bool
+}
+
+// logsCmd represents the cluster logs command.
+var logsCmd = &cobra.Command{
+	Use:   "logs cluster-name",
+	Short: "Get the logs of the machines of a cluster.",
+	Long: `Get the logs of all the machines of the cluster, or of the ones matching the label selectors,
+interleaved by the time the lines were received at.
+
+Each line is prefixed with the node name of the machine, or with the machine ID if the node name is not known yet.`,
+	Example: `  # Follow the apid and machined logs of the control plane machines.
+  omnictl cluster logs my-cluster -f -l omni.sidero.dev/role-controlplane --service apid --service machined
+
+  # Get the lines mentioning etcd received in the last hour.
+  omnictl cluster logs my-cluster --since 1h --grep etcd`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		return access.WithClient(clusterLogs(args[0]))
+	},
+}
+
+func clusterLogs(clusterName string) func(ctx context.Context, client *client.Client, _ access.ServerInfo) error {
+	return func(ctx context.Context, client *client.Client, _ access.ServerInfo) error {
+		req := &management.ClusterLogsRequest{
+			Cluster:   clusterName,
+			Selectors: logsCmdFlags.selectors,
+			Follow:    logsCmdFlags.follow,
+			TailLines: logsCmdFlags.tailLines,
+			Services:  logsCmdFlags.services,
+			Pattern:   logsCmdFlags.pattern,
+		}
+
+		var err error
+
+		if req.Since, err = parseLogsTime(logsCmdFlags.since); err != nil {
+			return fmt.Errorf("invalid --since: %w", err)
+		}
+
+		if req.Until, err = parseLogsTime(logsCmdFlags.until); err != nil {
+			return fmt.Errorf("invalid --until: %w", err)
+		}
+
+		for resp, err := range client.Management().ClusterLogs(ctx, req) {
+			if err != nil {
+				return fmt.Errorf("failed to get the logs of cluster %q: %w", clusterName, err)
+			}
+
+			printLogLine(resp)
+		}
+
+		return nil
+	}
+}
+
+// parseLogsTime parses either a duration relative to now or an RFC3339 timestamp.
+func parseLogsTime(value string) (*timestamppb.Timestamp, error) {
+	if value == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	if duration, err := time.ParseDuration(value); err == nil {
+		return timestamppb.New(time.Now().Add(-duration)), nil
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("%q is neither a duration nor an RFC3339 timestamp", value)
+	}
+
+	return timestamppb.New(timestamp), nil
+}
+
+func printLogLine(resp *management.ClusterLogsResponse) {
+	prefix := resp.GetNode()
+	if prefix == "" {
+		prefix = resp.GetMachineId()
+	}
+
+	if logsCmdFlags.logFormat != "text" {
+		fmt.Printf("%s: %s\n", prefix, resp.GetData())
+
+		return
+	}
+
+	var msg struct {
+		TalosTime    time.Time `json:"talos-time"`
+		Message      string    `json:"msg"`
+		TalosService string    `json:"talos-service"`
+		Facility     string    `json:"facility"`
+	}
+
+	if err := json.Unmarshal(resp.GetData(), &msg); err != nil || msg.Message == "" {
+		fmt.Printf("%s: %s\n", prefix, resp.GetData())
+
+		return
+	}
+
+	timestamp := msg.TalosTime
+	if timestamp.IsZero() {
+		timestamp = resp.GetTime().AsTime()
+	}
+
+	source := msg.TalosService
+	if source == "" {
+		source = msg.Facility
+	}
+
+	fmt.Printf("%s: %s %s: %s\n", prefix, timestamp.Local().Format(time.RFC3339), source, trimNewLine(msg.Message))
+}
+
+func trimNewLine(message string) string {
+	if len(message) > 0 && message[len(message)-1] == '\n' {
+		message = message[:len(message)-1]
+	}
+
+	return message
+}
+
+func init() {
+	logsCmd.Flags().BoolVarP(&logsCmdFlags.follow, "follow", "f", false, "specify if the logs should be streamed")
+	logsCmd.Flags().Int32Var(&logsCmdFlags.tailLines, "tail", -1, "lines of the logs to display (default is to show from the beginning)")
+	logsCmd.Flags().StringArrayVarP(&logsCmdFlags.selectors, "selector", "l", nil,
+		"label selector of the machines to get the logs of, the machines matching any of the selectors are selected")
+	logsCmd.Flags().StringVar(&logsCmdFlags.since, "since", "", "show the lines received since the given time, either a duration like 1h or an RFC3339 timestamp")
+	logsCmd.Flags().StringVar(&logsCmdFlags.until, "until", "", "show the lines received before the given time, either a duration like 1h or an RFC3339 timestamp")
+	logsCmd.Flags().StringArrayVar(&logsCmdFlags.services, "service", nil, "show the lines of the given Talos service only, can be repeated")
+	logsCmd.Flags().StringVar(&logsCmdFlags.pattern, "grep", "", "show the lines matching the regular expression only")
+	logsCmd.Flags().StringVar(&logsCmdFlags.logFormat, "log-format", "raw", "log format (raw, text) to display")
+	clusterCmd.AddCommand(logsCmd)
+}
@@ -47,7 +47,7 @@ var talosCACmd = &cobra.Command{
 	Args:    cobra.NoArgs,
 	RunE: func(*cobra.Command, []string) error {
 		return access.WithClient(func(ctx context.Context, client *client.Client, info access.ServerInfo) error {
-			return rotateCA(ctx, client, info, omni.NewRotateTalosCA(secretCmdFlags.clusterName), specs.SecretRotationSpec_TALOS_CA, "Talos CA")
+			return rotateSecret(ctx, client, info, omni.NewRotateTalosCA(secretCmdFlags.clusterName), specs.SecretRotationSpec_TALOS_CA, "Talos CA")
 		})
 	},
 }
@@ -61,7 +61,69 @@ var kubernetesCACmd = &cobra.Command{
 	Args:    cobra.NoArgs,
 	RunE: func(*cobra.Command, []string) error {
 		return access.WithClient(func(ctx context.Context, client *client.Client, info access.ServerInfo) error {
-			return rotateCA(ctx, client, info, omni.NewRotateKubernetesCA(secretCmdFlags.clusterName), specs.SecretRotationSpec_KUBERNETES_CA, "Kubernetes CA")
+			return rotateSecret(ctx, client, info, omni.NewRotateKubernetesCA(secretCmdFlags.clusterName), specs.SecretRotationSpec_KUBERNETES_CA, "Kubernetes CA")
+		})
+	},
+}
+
+// etcdCACmd represents the secret rotate etcd-ca command.
+var etcdCACmd = &cobra.Command{
+	Use:     "etcd-ca",
+	Short:   "Rotate etcd CA certificate.",
+	Long:    `Rotate etcd CA certificate for all nodes in the cluster. etcd CA is used by etcd peers and the Kubernetes API server to authenticate to etcd.`,
+	Example: "",
+	Args:    cobra.NoArgs,
+	RunE: func(*cobra.Command, []string) error {
+		return access.WithClient(func(ctx context.Context, client *client.Client, info access.ServerInfo) error {
+			return rotateSecret(ctx, client, info, omni.NewRotateEtcdCA(secretCmdFlags.clusterName), specs.SecretRotationSpec_ETCD_CA, "etcd CA")
+		})
+	},
+}
+
+// kubernetesAggregatorCACmd represents the secret rotate kubernetes-aggregator-ca command.
+var kubernetesAggregatorCACmd = &cobra.Command{
+	Use:     "kubernetes-aggregator-ca",
+	Short:   "Rotate Kubernetes front-proxy (aggregator) CA certificate.",
+	Long:    `Rotate Kubernetes front-proxy (aggregator) CA certificate for all nodes in the cluster. The aggregator CA is used by the Kubernetes API server to authenticate to the extension API servers.`,
+	Example: "",
+	Args:    cobra.NoArgs,
+	RunE: func(*cobra.Command, []string) error {
+		return access.WithClient(func(ctx context.Context, client *client.Client, info access.ServerInfo) error {
+			return rotateSecret(ctx, client, info, omni.NewRotateKubernetesAggregatorCA(secretCmdFlags.clusterName), specs.SecretRotationSpec_KUBERNETES_AGGREGATOR_CA,
+				"Kubernetes aggregator CA")
+		})
+	},
+}
+
+// kubernetesServiceAccountKeyCmd represents the secret rotate kubernetes-service-account-key command.
+var kubernetesServiceAccountKeyCmd = &cobra.Command{
+	Use:   "kubernetes-service-account-key",
+	Short: "Rotate Kubernetes service account signing key.",
+	Long: `Rotate Kubernetes service account signing key for all nodes in the cluster.
+
+Talos configures a single service account key, so the service account tokens signed with the old key are rejected
+once the Kubernetes API server is restarted with the new key, until kubelet refreshes the projected tokens.
+The workloads which use the legacy long-lived service account token secrets have to recreate them.`,
+	Example: "",
+	Args:    cobra.NoArgs,
+	RunE: func(*cobra.Command, []string) error {
+		return access.WithClient(func(ctx context.Context, client *client.Client, info access.ServerInfo) error {
+			return rotateSecret(ctx, client, info, omni.NewRotateKubernetesServiceAccountKey(secretCmdFlags.clusterName), specs.SecretRotationSpec_KUBERNETES_SERVICE_ACCOUNT_KEY,
+				"Kubernetes service account key")
+		})
+	},
+}
+
+// bootstrapTokenCmd represents the secret rotate bootstrap-token command.
+var bootstrapTokenCmd = &cobra.Command{
+	Use:     "bootstrap-token",
+	Short:   "Rotate Kubernetes bootstrap token.",
+	Long:    `Rotate Kubernetes bootstrap token for all nodes in the cluster. The bootstrap token is used by kubelet to join the cluster.`,
+	Example: "",
+	Args:    cobra.NoArgs,
+	RunE: func(*cobra.Command, []string) error {
+		return access.WithClient(func(ctx context.Context, client *client.Client, info access.ServerInfo) error {
+			return rotateSecret(ctx, client, info, omni.NewRotateBootstrapToken(secretCmdFlags.clusterName), specs.SecretRotationSpec_BOOTSTRAP_TOKEN, "bootstrap token")
 		})
 	},
 }
@@ -77,7 +139,7 @@ var statusCmd = &cobra.Command{
 	},
 }
 
-func rotateCA[T resource.Resource](ctx context.Context, client *client.Client, info access.ServerInfo, r T, component specs.SecretRotationSpec_Component, componentName string) error {
+func rotateSecret[T resource.Resource](ctx context.Context, client *client.Client, info access.ServerInfo, r T, component specs.SecretRotationSpec_Component, componentName string) error {
 	if !info.ServerSupports(1, 6) {
 		return fmt.Errorf("cluster secret rotation requires Omni v1.6.0 or newer (server is %s)", info.Version)
 	}
@@ -240,6 +302,10 @@ func init() {
 	rotateCmd.PersistentFlags().DurationVarP(&rotateCmdFlags.waitTimeout, "wait-timeout", "t", 5*time.Minute, "wait timeout for secret rotation, if zero, wait indefinitely")
 	rotateCmd.AddCommand(talosCACmd)
 	rotateCmd.AddCommand(kubernetesCACmd)
+	rotateCmd.AddCommand(etcdCACmd)
+	rotateCmd.AddCommand(kubernetesAggregatorCACmd)
+	rotateCmd.AddCommand(kubernetesServiceAccountKeyCmd)
+	rotateCmd.AddCommand(bootstrapTokenCmd)
 	rotateCmd.AddCommand(statusCmd)
 	secretCmd.AddCommand(rotateCmd)
 }
@@ -0,0 +1,85 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secret
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+// secretStatusCmd represents the secret status command.
+var secretStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the expiration and the rotation schedule of the cluster secrets.",
+	Long: `Shows the expiration of the cluster certificate authorities, the time of the last rotation of the cluster secrets,
+and the time of the next automatic rotation for the secrets covered by the secret rotation policy of the cluster.`,
+	Example: "",
+	Args:    cobra.NoArgs,
+	RunE: func(*cobra.Command, []string) error {
+		return access.WithClient(secretStatus)
+	},
+}
+
+func secretStatus(ctx context.Context, client *client.Client, _ access.ServerInfo) error {
+	cluster, rotationStatus, err := getClusterAndRotationStatus(ctx, client.Omni().State())
+	if err != nil {
+		return err
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	if _, err = fmt.Fprintf(writer, "SECRET\tEXPIRATION\tLAST ROTATION\tNEXT ROTATION\n"); err != nil {
+		return err
+	}
+
+	formatTime := func(timestamp *timestamppb.Timestamp, unset string) string {
+		if timestamp == nil {
+			return unset
+		}
+
+		return timestamp.AsTime().Local().Format(time.RFC3339)
+	}
+
+	for _, secret := range rotationStatus.TypedSpec().Value.Secrets {
+		if _, err = fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n",
+			omni.SecretRotationComponentName(secret.Component),
+			formatTime(secret.Expiration, "never"),
+			formatTime(secret.LastRotation, "never"),
+			formatTime(secret.NextRotation, "-"),
+		); err != nil {
+			return err
+		}
+	}
+
+	if err = writer.Flush(); err != nil {
+		return err
+	}
+
+	if rotationStatus.TypedSpec().Value.Phase == specs.SecretRotationSpec_OK {
+		fmt.Printf("\nno ongoing secret rotation for the cluster %q\n", cluster.Metadata().ID())
+
+		return nil
+	}
+
+	fmt.Printf("\nrotation of %s is in progress for the cluster %q: %s %s\n", omni.SecretRotationComponentName(rotationStatus.TypedSpec().Value.Component),
+		cluster.Metadata().ID(), rotationStatus.TypedSpec().Value.Status, rotationStatus.TypedSpec().Value.Step)
+
+	return nil
+}
+
+func init() {
+	secretCmd.AddCommand(secretStatusCmd)
+}
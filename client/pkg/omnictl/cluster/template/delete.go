@@ -32,6 +32,11 @@ var deleteCmd = &cobra.Command{
 }
 
 func deleteImpl(ctx context.Context, client *client.Client, _ access.ServerInfo) error {
+	opts, err := templateOptions()
+	if err != nil {
+		return err
+	}
+
 	f, err := os.Open(cmdFlags.TemplatePath)
 	if err != nil {
 		return err
@@ -39,11 +44,12 @@ func deleteImpl(ctx context.Context, client *client.Client, _ access.ServerInfo)
 
 	defer f.Close() //nolint:errcheck
 
-	return operations.DeleteTemplate(ctx, f, os.Stdout, client.Omni().State(), deleteCmdFlags.options, resolvedRoot)
+	return operations.DeleteTemplate(ctx, f, os.Stdout, client.Omni().State(), deleteCmdFlags.options, resolvedRoot, opts...)
 }
 
 func init() {
 	addRequiredFileFlag(deleteCmd)
+	addValuesFlags(deleteCmd)
 	deleteCmd.PersistentFlags().BoolVarP(&deleteCmdFlags.options.Verbose, "verbose", "v", false, "verbose output (show diff for each resource)")
 	deleteCmd.PersistentFlags().BoolVarP(&deleteCmdFlags.options.DryRun, "dry-run", "d", false, "dry run")
 	deleteCmd.PersistentFlags().BoolVar(&deleteCmdFlags.options.DestroyMachines, "destroy-disconnected-machines", false, "removes all disconnected machines which are part of the cluster from Omni")
@@ -28,6 +28,11 @@ var diffCmd = &cobra.Command{
 }
 
 func diff(ctx context.Context, client *client.Client, _ access.ServerInfo) error {
+	opts, err := templateOptions()
+	if err != nil {
+		return err
+	}
+
 	f, err := os.Open(cmdFlags.TemplatePath)
 	if err != nil {
 		return err
@@ -35,10 +40,11 @@ func diff(ctx context.Context, client *client.Client, _ access.ServerInfo) error
 
 	defer f.Close() //nolint:errcheck
 
-	return operations.DiffTemplate(ctx, f, os.Stdout, client.Omni().State(), resolvedRoot)
+	return operations.DiffTemplate(ctx, f, os.Stdout, client.Omni().State(), resolvedRoot, opts...)
 }
 
 func init() {
 	addRequiredFileFlag(diffCmd)
+	addValuesFlags(diffCmd)
 	templateCmd.AddCommand(diffCmd)
 }
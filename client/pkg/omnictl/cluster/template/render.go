@@ -25,6 +25,11 @@ var renderCmd = &cobra.Command{
 }
 
 func render() error {
+	opts, err := templateOptions()
+	if err != nil {
+		return err
+	}
+
 	f, err := os.Open(cmdFlags.TemplatePath)
 	if err != nil {
 		return err
@@ -32,10 +37,11 @@ func render() error {
 
 	defer f.Close() //nolint:errcheck
 
-	return operations.RenderTemplate(f, os.Stdout, resolvedRoot)
+	return operations.RenderTemplate(f, os.Stdout, resolvedRoot, opts...)
 }
 
 func init() {
 	addRequiredFileFlag(renderCmd)
+	addValuesFlags(renderCmd)
 	templateCmd.AddCommand(renderCmd)
 }
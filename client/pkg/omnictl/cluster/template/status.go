@@ -34,6 +34,11 @@ var statusCmd = &cobra.Command{
 }
 
 func status(ctx context.Context, client *client.Client, _ access.ServerInfo) error {
+	opts, err := templateOptions()
+	if err != nil {
+		return err
+	}
+
 	f, err := os.Open(cmdFlags.TemplatePath)
 	if err != nil {
 		return err
@@ -52,11 +57,12 @@ func status(ctx context.Context, client *client.Client, _ access.ServerInfo) err
 		statusCmdFlags.options.Wait = false
 	}
 
-	return operations.StatusTemplate(ctx, f, os.Stdout, client.Omni().State(), statusCmdFlags.options, resolvedRoot)
+	return operations.StatusTemplate(ctx, f, os.Stdout, client.Omni().State(), statusCmdFlags.options, resolvedRoot, opts...)
 }
 
 func init() {
 	addRequiredFileFlag(statusCmd)
+	addValuesFlags(statusCmd)
 	statusCmd.PersistentFlags().BoolVarP(&statusCmdFlags.options.Quiet, "quiet", "q", false, "suppress output")
 	statusCmd.PersistentFlags().DurationVarP(&statusCmdFlags.wait, "wait", "w", 5*time.Minute, "wait timeout, if zero, report current status and exit")
 	templateCmd.AddCommand(statusCmd)
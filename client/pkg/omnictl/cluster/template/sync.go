@@ -80,6 +80,11 @@ func discoverTemplateFiles(path string) ([]string, error) {
 }
 
 func syncTemplateFiles(ctx context.Context, client *client.Client, _ access.ServerInfo) error {
+	opts, err := templateOptions()
+	if err != nil {
+		return err
+	}
+
 	files, err := discoverTemplateFiles(cmdFlags.TemplatePath)
 	if err != nil {
 		return fmt.Errorf("failed to discover template files from %q: %w", cmdFlags.TemplatePath, err)
@@ -100,7 +105,7 @@ func syncTemplateFiles(ctx context.Context, client *client.Client, _ access.Serv
 			return fmt.Errorf("failed to open template file %q: %w", file, err)
 		}
 
-		err = operations.SyncTemplate(ctx, f, os.Stdout, client.Omni().State(), syncCmdFlags.options, resolvedRoot)
+		err = operations.SyncTemplate(ctx, f, os.Stdout, client.Omni().State(), syncCmdFlags.options, resolvedRoot, opts...)
 		f.Close() //nolint:errcheck
 
 		if err != nil {
@@ -113,6 +118,7 @@ func syncTemplateFiles(ctx context.Context, client *client.Client, _ access.Serv
 
 func init() {
 	addRequiredFileFlag(syncCmd)
+	addValuesFlags(syncCmd)
 	syncCmd.PersistentFlags().BoolVarP(&syncCmdFlags.options.Verbose, "verbose", "v", false, "verbose output (show diff for each resource)")
 	syncCmd.PersistentFlags().BoolVarP(&syncCmdFlags.options.DryRun, "dry-run", "d", false, "dry run")
 	templateCmd.AddCommand(syncCmd)
@@ -6,11 +6,14 @@
 package template
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 
 	"github.com/siderolabs/gen/ensure"
 	"github.com/spf13/cobra"
+
+	clustertemplate "github.com/siderolabs/omni/client/pkg/template"
 )
 
 // cmdFlags contains shared cluster template flags.
@@ -19,6 +22,10 @@ var cmdFlags struct {
 	TemplatePath string
 	// AllowedDir is the directory that restricts file access in the template.
 	AllowedDir string
+	// ValuesFiles are the paths to the files with the values of the template variables.
+	ValuesFiles []string
+	// Values are the values of the template variables in the name=value format.
+	Values []string
 }
 
 // resolvedRoot is an *os.Root opened at the root dir, resolved relative to the template file's directory.
@@ -64,3 +71,34 @@ func addRequiredFileFlag(cmd *cobra.Command) {
 
 	ensure.NoError(cmd.MarkPersistentFlagRequired("file"))
 }
+
+func addValuesFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringSliceVar(&cmdFlags.ValuesFiles, "values", nil, "paths to the YAML files with the values of the template variables, the later files take precedence.")
+	cmd.PersistentFlags().StringArrayVar(&cmdFlags.Values, "set", nil, "set the value of a template variable (name=value), takes precedence over the values files.")
+}
+
+// templateOptions builds the template options from the values flags.
+func templateOptions() ([]clustertemplate.Option, error) {
+	opts := make([]clustertemplate.Option, 0, len(cmdFlags.ValuesFiles)+1)
+
+	for _, path := range cmdFlags.ValuesFiles {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read values file %q: %w", path, err)
+		}
+
+		values, err := clustertemplate.ParseValues(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse values file %q: %w", path, err)
+		}
+
+		opts = append(opts, clustertemplate.WithValues(values))
+	}
+
+	values, err := clustertemplate.ParseStringValues(cmdFlags.Values)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(opts, clustertemplate.WithStringValues(values)), nil
+}
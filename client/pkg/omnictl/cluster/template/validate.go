@@ -25,6 +25,11 @@ var validateCmd = &cobra.Command{
 }
 
 func validate() error {
+	opts, err := templateOptions()
+	if err != nil {
+		return err
+	}
+
 	f, err := os.Open(cmdFlags.TemplatePath)
 	if err != nil {
 		return err
@@ -32,10 +37,11 @@ func validate() error {
 
 	defer f.Close() //nolint:errcheck
 
-	return operations.ValidateTemplate(f, resolvedRoot)
+	return operations.ValidateTemplate(f, resolvedRoot, opts...)
 }
 
 func init() {
 	addRequiredFileFlag(validateCmd)
+	addValuesFlags(validateCmd)
 	templateCmd.AddCommand(validateCmd)
 }
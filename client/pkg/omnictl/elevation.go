@@ -0,0 +1,167 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omnictl
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+var (
+	elevationRequestFlags struct {
+		role     string
+		reason   string
+		clusters []string
+		duration time.Duration
+	}
+
+	// elevationCmd represents the access command.
+	elevationCmd = &cobra.Command{
+		Use:   "access",
+		Short: "Manage time-bound role elevations",
+		Long: "Request a temporary elevation to a higher role, which becomes active when it is approved by an admin, " +
+			"and is removed when it expires or is revoked.",
+	}
+
+	elevationRequestCmd = &cobra.Command{
+		Use:   "request",
+		Short: "Request a time-bound role elevation",
+		Args:  cobra.NoArgs,
+		RunE: func(*cobra.Command, []string) error {
+			return access.WithClient(func(ctx context.Context, client *client.Client, _ access.ServerInfo) error {
+				id, err := client.Management().RequestElevation(
+					ctx,
+					elevationRequestFlags.role,
+					elevationRequestFlags.clusters,
+					elevationRequestFlags.reason,
+					elevationRequestFlags.duration,
+				)
+				if err != nil {
+					return err
+				}
+
+				fmt.Printf("elevation %q was requested, it becomes active when approved by an admin\n", id)
+
+				return nil
+			})
+		},
+	}
+
+	elevationApproveCmd = &cobra.Command{
+		Use:   "approve <id>",
+		Short: "Approve a pending role elevation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			id := args[0]
+
+			return access.WithClient(func(ctx context.Context, client *client.Client, _ access.ServerInfo) error {
+				if err := client.Management().ApproveElevation(ctx, id); err != nil {
+					return err
+				}
+
+				fmt.Printf("elevation %q was approved\n", id)
+
+				return nil
+			})
+		},
+	}
+
+	elevationRevokeCmd = &cobra.Command{
+		Use:   "revoke <id>",
+		Short: "Revoke a role elevation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			id := args[0]
+
+			return access.WithClient(func(ctx context.Context, client *client.Client, _ access.ServerInfo) error {
+				if err := client.Management().RevokeElevation(ctx, id); err != nil {
+					return err
+				}
+
+				fmt.Printf("elevation %q was revoked\n", id)
+
+				return nil
+			})
+		},
+	}
+
+	elevationListCmd = &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"l"},
+		Short:   "List role elevations",
+		Args:    cobra.NoArgs,
+		RunE: func(*cobra.Command, []string) error {
+			return access.WithClient(func(ctx context.Context, client *client.Client, _ access.ServerInfo) error {
+				elevations, err := client.Management().ListElevations(ctx)
+				if err != nil {
+					return err
+				}
+
+				writer := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+				fmt.Fprintf(writer, "ID\tIDENTITY\tROLE\tCLUSTERS\tSTATE\tDURATION\tEXPIRATION\tAPPROVED BY\tREASON\n") //nolint:errcheck
+
+				for _, elevation := range elevations {
+					clusters := "all"
+
+					if len(elevation.Clusters) > 0 {
+						clusters = strings.Join(elevation.Clusters, ",")
+					}
+
+					var expiration string
+
+					if elevation.Expiration != nil {
+						expiration = elevation.Expiration.AsTime().String()
+					}
+
+					if _, err = fmt.Fprintf(
+						writer,
+						"%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+						elevation.Id,
+						elevation.Identity,
+						elevation.Role,
+						clusters,
+						elevation.State,
+						elevation.Duration.AsDuration(),
+						expiration,
+						elevation.ApprovedBy,
+						elevation.Reason,
+					); err != nil {
+						return err
+					}
+				}
+
+				return writer.Flush()
+			})
+		},
+	}
+)
+
+func init() {
+	RootCmd.AddCommand(elevationCmd)
+
+	elevationCmd.AddCommand(elevationRequestCmd)
+	elevationCmd.AddCommand(elevationApproveCmd)
+	elevationCmd.AddCommand(elevationRevokeCmd)
+	elevationCmd.AddCommand(elevationListCmd)
+
+	elevationRequestCmd.Flags().StringVarP(&elevationRequestFlags.role, "role", "r", "", "Role to elevate to")
+	elevationRequestCmd.Flags().StringVar(&elevationRequestFlags.reason, "reason", "", "Reason for the elevation")
+	elevationRequestCmd.Flags().StringSliceVarP(&elevationRequestFlags.clusters, "clusters", "c", nil,
+		"Limit the elevation to the clusters with the matching names, glob patterns are supported (all clusters if empty)")
+	elevationRequestCmd.Flags().DurationVarP(&elevationRequestFlags.duration, "duration", "d", time.Hour, "Duration of the elevation, starting from its approval")
+
+	elevationRequestCmd.MarkFlagRequired("role")   //nolint:errcheck
+	elevationRequestCmd.MarkFlagRequired("reason") //nolint:errcheck
+}
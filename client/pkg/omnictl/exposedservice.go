@@ -0,0 +1,212 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package omnictl
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/constants"
+	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
+)
+
+var (
+	exposedServiceTokenFlags struct {
+		ttl time.Duration
+	}
+
+	exposedServiceForwardFlags struct {
+		localAddress string
+		ttl          time.Duration
+	}
+
+	// exposedServiceCmd represents the exposed-service command.
+	exposedServiceCmd = &cobra.Command{
+		Use:   "exposed-service",
+		Short: "Access the exposed services without a browser",
+	}
+
+	exposedServiceTokenCmd = &cobra.Command{
+		Use:   "token <alias>",
+		Short: "Issue a workload proxy token for an exposed service",
+		Long: `Issue a workload proxy token for the exposed service with the given alias.
+
+The HTTP and gRPC services accept the token in the ` + constants.ExposedServiceTokenHeader + ` header.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return access.WithClient(func(ctx context.Context, client *client.Client, _ access.ServerInfo) error {
+				resp, err := client.Management().WorkloadProxyCredentials(ctx, args[0], nil, exposedServiceTokenFlags.ttl)
+				if err != nil {
+					return err
+				}
+
+				fmt.Fprintf(os.Stderr, "Issued a token for %s, valid until %s\n", resp.GetUrl(), resp.GetExpiration().AsTime().Local().Format(time.RFC3339))
+				fmt.Fprintf(os.Stderr, "Send it in the %s header\n", constants.ExposedServiceTokenHeader)
+				fmt.Println(resp.GetToken())
+
+				return nil
+			})
+		},
+	}
+
+	exposedServiceForwardCmd = &cobra.Command{
+		Use:   "forward <alias>",
+		Short: "Forward a local port to an exposed TCP service",
+		Long: `Forward a local port to the exposed TCP service with the given alias.
+
+The connections are forwarded over TLS, authenticated with a client certificate issued by Omni for the lifetime set by --ttl.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return access.WithClient(func(ctx context.Context, client *client.Client, _ access.ServerInfo) error {
+				return forwardExposedService(ctx, client, args[0])
+			})
+		},
+	}
+)
+
+func forwardExposedService(ctx context.Context, client *client.Client, alias string) error {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{}, key)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Management().WorkloadProxyCredentials(ctx, alias,
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr}), exposedServiceForwardFlags.ttl)
+	if err != nil {
+		return err
+	}
+
+	serviceURL, err := url.Parse(resp.GetUrl())
+	if err != nil {
+		return fmt.Errorf("failed to parse the exposed service URL %q: %w", resp.GetUrl(), err)
+	}
+
+	if serviceURL.Scheme != "tcp" {
+		return fmt.Errorf("the exposed service %q is not a TCP service", alias)
+	}
+
+	block, _ := pem.Decode(resp.GetCertificate())
+	if block == nil {
+		return errors.New("the issued client certificate is not PEM encoded")
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: serviceURL.Hostname(),
+		Certificates: []tls.Certificate{
+			{
+				Certificate: [][]byte{block.Bytes},
+				PrivateKey:  key,
+			},
+		},
+	}
+
+	listener, err := (&net.ListenConfig{}).Listen(ctx, "tcp", exposedServiceForwardFlags.localAddress)
+	if err != nil {
+		return err
+	}
+
+	stop := context.AfterFunc(ctx, func() {
+		listener.Close() //nolint:errcheck
+	})
+	defer stop()
+
+	expiration := resp.GetExpiration().AsTime()
+
+	fmt.Fprintf(os.Stderr, "Forwarding %s to %s until %s\n", listener.Addr(), serviceURL.Host, expiration.Local().Format(time.RFC3339))
+
+	var wg sync.WaitGroup
+
+	defer wg.Wait()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return err
+		}
+
+		if time.Now().After(expiration) {
+			conn.Close() //nolint:errcheck
+
+			return errors.New("the client certificate has expired, run the command again to forward the service")
+		}
+
+		wg.Go(func() {
+			forwardExposedServiceConn(ctx, conn, serviceURL.Host, tlsConfig)
+		})
+	}
+}
+
+func forwardExposedServiceConn(ctx context.Context, conn net.Conn, address string, tlsConfig *tls.Config) {
+	defer conn.Close() //nolint:errcheck
+
+	upstream, err := (&tls.Dialer{Config: tlsConfig}).DialContext(ctx, "tcp", address)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to connect to %s: %s\n", address, err)
+
+		return
+	}
+
+	defer upstream.Close() //nolint:errcheck
+
+	stop := context.AfterFunc(ctx, func() {
+		conn.Close()     //nolint:errcheck
+		upstream.Close() //nolint:errcheck
+	})
+	defer stop()
+
+	var wg sync.WaitGroup
+
+	wg.Go(func() {
+		io.Copy(upstream, conn) //nolint:errcheck
+
+		upstream.(*tls.Conn).CloseWrite() //nolint:errcheck,forcetypeassert
+	})
+
+	io.Copy(conn, upstream) //nolint:errcheck
+
+	if tcpConn, ok := conn.(*net.TCPConn); ok {
+		tcpConn.CloseWrite() //nolint:errcheck
+	}
+
+	wg.Wait()
+}
+
+func init() {
+	exposedServiceTokenCmd.Flags().DurationVar(&exposedServiceTokenFlags.ttl, "ttl", time.Hour, "lifetime of the token")
+
+	exposedServiceForwardCmd.Flags().StringVar(&exposedServiceForwardFlags.localAddress, "local-address", "127.0.0.1:0", "local address to listen on")
+	exposedServiceForwardCmd.Flags().DurationVar(&exposedServiceForwardFlags.ttl, "ttl", 8*time.Hour, "lifetime of the client certificate")
+
+	RootCmd.AddCommand(exposedServiceCmd)
+
+	exposedServiceCmd.AddCommand(exposedServiceTokenCmd)
+	exposedServiceCmd.AddCommand(exposedServiceForwardCmd)
+}
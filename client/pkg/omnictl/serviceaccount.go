@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"os"
 	"runtime"
+	"strings"
 	"text/tabwriter"
 	"time"
 
@@ -23,7 +24,8 @@ import (
 
 var (
 	serviceAccountCreateFlags struct {
-		role string
+		role        string
+		customRoles []string
 
 		useUserRole bool
 		ttl         time.Duration
@@ -59,7 +61,8 @@ var (
 					return err
 				}
 
-				publicKeyID, err := client.Management().CreateServiceAccount(ctx, name, armoredPublicKey, serviceAccountCreateFlags.role, serviceAccountCreateFlags.useUserRole)
+				publicKeyID, err := client.Management().CreateServiceAccount(ctx, name, armoredPublicKey, serviceAccountCreateFlags.role, serviceAccountCreateFlags.useUserRole,
+					serviceAccountCreateFlags.customRoles...)
 				if err != nil {
 					return err
 				}
@@ -138,7 +141,7 @@ var (
 
 				writer := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
 
-				fmt.Fprintf(writer, "NAME\tROLE\tLAST ACTIVE\tPUBLIC KEY ID\tKEY CREATED\tKEY LAST ACTIVE\tEXPIRATION\n") //nolint:errcheck
+				fmt.Fprintf(writer, "NAME\tROLE\tCUSTOM ROLES\tLAST ACTIVE\tPUBLIC KEY ID\tKEY CREATED\tKEY LAST ACTIVE\tEXPIRATION\n") //nolint:errcheck
 
 				for _, sa := range serviceAccounts {
 					lastActive := sa.LastActive
@@ -161,11 +164,11 @@ var (
 
 						if i == 0 {
 							//nolint:errcheck
-							fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
-								sa.Name, sa.GetRole(), lastActive, publicKey.Id, keyCreated, keyLastActive, expiration)
+							fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+								sa.Name, sa.GetRole(), strings.Join(sa.GetCustomRoles(), ", "), lastActive, publicKey.Id, keyCreated, keyLastActive, expiration)
 						} else {
 							//nolint:errcheck
-							fmt.Fprintf(writer, "\t\t\t%s\t%s\t%s\t%s\n",
+							fmt.Fprintf(writer, "\t\t\t\t%s\t%s\t%s\t%s\n",
 								publicKey.Id, keyCreated, keyLastActive, expiration)
 						}
 					}
@@ -220,6 +223,7 @@ func init() {
 	serviceAccountCreateCmd.Flags().DurationVarP(&serviceAccountCreateFlags.ttl, "ttl", "t", 365*24*time.Hour, "TTL for the service account key")
 	serviceAccountCreateCmd.Flags().StringVarP(&serviceAccountCreateFlags.role, roleFlag, "r", "", "role of the service account. only used when --"+useUserRoleFlag+"=false")
 	serviceAccountCreateCmd.Flags().BoolVarP(&serviceAccountCreateFlags.useUserRole, useUserRoleFlag, "u", true, "use the role of the creating user. if true, --"+roleFlag+" is ignored")
+	serviceAccountCreateCmd.Flags().StringSliceVar(&serviceAccountCreateFlags.customRoles, "custom-roles", nil, "IDs of the custom roles to assign to the service account")
 
 	serviceAccountRenewCmd.Flags().DurationVarP(&serviceAccountRenewFlags.ttl, "ttl", "t", 365*24*time.Hour, "TTL for the service account key")
 }
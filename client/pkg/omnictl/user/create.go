@@ -19,7 +19,8 @@ import (
 )
 
 var createCmdFlags struct {
-	role string
+	role        string
+	customRoles []string
 }
 
 // createCmd represents the user create command.
@@ -40,7 +41,7 @@ func createUser(email string) func(ctx context.Context, client *client.Client, i
 			return createUserLegacy(ctx, client, email)
 		}
 
-		_, err := client.Management().CreateUser(ctx, email, createCmdFlags.role)
+		_, err := client.Management().CreateUser(ctx, email, createCmdFlags.role, createCmdFlags.customRoles...)
 
 		return err
 	}
@@ -77,6 +78,7 @@ func createUserLegacy(ctx context.Context, client *client.Client, email string)
 func init() {
 	createCmd.PersistentFlags().StringVarP(&createCmdFlags.role, "role", "r", "", "Role to use for the user creation")
 	createCmd.MarkPersistentFlagRequired("role") //nolint:errcheck
+	createCmd.PersistentFlags().StringSliceVar(&createCmdFlags.customRoles, "custom-roles", nil, "IDs of the custom roles to assign to the user")
 
 	userCmd.AddCommand(createCmd)
 }
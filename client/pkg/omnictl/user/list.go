@@ -47,7 +47,7 @@ func listUsers(ctx context.Context, client *client.Client, info access.ServerInf
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
 	defer w.Flush() //nolint:errcheck
 
-	if _, err = fmt.Fprintln(w, "ID\tEMAIL\tROLE\tLAST ACTIVE\tLABELS"); err != nil {
+	if _, err = fmt.Fprintln(w, "ID\tEMAIL\tROLE\tCUSTOM ROLES\tLAST ACTIVE\tLABELS"); err != nil {
 		return err
 	}
 
@@ -59,7 +59,7 @@ func listUsers(ctx context.Context, client *client.Client, info access.ServerInf
 			lastActive = "Never"
 		}
 
-		if _, err = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", user.Id, user.Email, user.Role, lastActive, labels); err != nil {
+		if _, err = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", user.Id, user.Email, user.Role, strings.Join(user.CustomRoles, ", "), lastActive, labels); err != nil {
 			return err
 		}
 	}
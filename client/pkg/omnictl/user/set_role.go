@@ -11,12 +11,14 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/siderolabs/omni/client/pkg/client"
+	"github.com/siderolabs/omni/client/pkg/client/management"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/auth"
 	"github.com/siderolabs/omni/client/pkg/omnictl/internal/access"
 )
 
 var setRoleCmdFlags struct {
-	role string
+	role        string
+	customRoles []string
 }
 
 // setRoleCmd represents the user role set command.
@@ -26,18 +28,24 @@ var setRoleCmd = &cobra.Command{
 	Long:    `Update the user role.`,
 	Example: "",
 	Args:    cobra.ExactArgs(1),
-	RunE: func(_ *cobra.Command, args []string) error {
-		return access.WithClient(setUserRole(args[0]))
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var opts []management.UpdateUserOption
+
+		if cmd.Flags().Changed("custom-roles") {
+			opts = append(opts, management.WithCustomRoles(setRoleCmdFlags.customRoles...))
+		}
+
+		return access.WithClient(setUserRole(args[0], opts...))
 	},
 }
 
-func setUserRole(email string) func(ctx context.Context, client *client.Client, info access.ServerInfo) error {
+func setUserRole(email string, opts ...management.UpdateUserOption) func(ctx context.Context, client *client.Client, info access.ServerInfo) error {
 	return func(ctx context.Context, client *client.Client, info access.ServerInfo) error {
 		if !info.ServerSupports(1, 6) {
 			return setUserRoleLegacy(ctx, client, email)
 		}
 
-		return client.Management().UpdateUser(ctx, email, setRoleCmdFlags.role)
+		return client.Management().UpdateUser(ctx, email, setRoleCmdFlags.role, opts...)
 	}
 }
 
@@ -67,6 +75,8 @@ func setUserRoleLegacy(ctx context.Context, client *client.Client, email string)
 func init() {
 	setRoleCmd.PersistentFlags().StringVarP(&setRoleCmdFlags.role, "role", "r", "", "Role to use")
 	setRoleCmd.MarkPersistentFlagRequired("role") //nolint:errcheck
+	setRoleCmd.PersistentFlags().StringSliceVar(&setRoleCmdFlags.customRoles, "custom-roles", nil,
+		"IDs of the custom roles to assign to the user, replacing the current ones. Pass an empty value to remove all of them")
 
 	userCmd.AddCommand(setRoleCmd)
 }
@@ -5,6 +5,7 @@
 package models
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -44,6 +45,12 @@ type Cluster struct { //nolint:govet
 	// Features settings.
 	Features Features `yaml:"features,omitempty"`
 
+	// MaintenanceWindow is the name of the maintenance window the upgrades and the config changes requiring a reboot are held for.
+	MaintenanceWindow string `yaml:"maintenanceWindow,omitempty"`
+
+	// SecretRotation is the policy of the automatic rotation of the cluster secrets.
+	SecretRotation *SecretRotationPolicy `yaml:"secretRotation,omitempty"`
+
 	// Cluster-wide patches.
 	Patches PatchList `yaml:"patches,omitempty"`
 
@@ -73,6 +80,58 @@ type Features struct { //nolint:govet // field order matches the serialized YAML
 type BackupConfiguration struct {
 	// Interval configures intervals between backups. If set to 0, etcd backups for this cluster are disabled.
 	Interval time.Duration `yaml:"interval,omitempty"`
+	// Retention configures which backups are kept, all backups are kept if not set.
+	Retention *BackupRetention `yaml:"retention,omitempty"`
+}
+
+// BackupRetention configures the grandfather-father-son rotation of the etcd backups.
+//
+// A backup is kept if it matches any of the rules.
+type BackupRetention struct {
+	// KeepLast keeps the given number of the most recent backups.
+	KeepLast uint32 `yaml:"keepLast,omitempty"`
+	// KeepDaily keeps the latest backup of each day for the given number of days.
+	KeepDaily uint32 `yaml:"keepDaily,omitempty"`
+	// KeepWeekly keeps the latest backup of each week for the given number of weeks.
+	KeepWeekly uint32 `yaml:"keepWeekly,omitempty"`
+	// KeepMonthly keeps the latest backup of each month for the given number of months.
+	KeepMonthly uint32 `yaml:"keepMonthly,omitempty"`
+}
+
+// SecretRotationPolicy configures the automatic rotation of the cluster secrets.
+//
+// A secret is rotated once it is older than the interval, or once it expires in less than the expiry threshold.
+type SecretRotationPolicy struct {
+	// Interval is the maximum age of the secrets.
+	Interval time.Duration `yaml:"interval,omitempty"`
+	// ExpiryThreshold is how long before the expiration the certificate authorities are rotated.
+	ExpiryThreshold time.Duration `yaml:"expiryThreshold,omitempty"`
+	// Components are the names of the secrets rotated by the policy, e.g. talos-ca or bootstrap-token.
+	Components []string `yaml:"components"`
+}
+
+// Translate the policy into the cluster spec.
+func (policy *SecretRotationPolicy) Translate() (*specs.SecretRotationPolicy, error) {
+	result := &specs.SecretRotationPolicy{}
+
+	if policy.Interval != 0 {
+		result.Interval = durationpb.New(policy.Interval)
+	}
+
+	if policy.ExpiryThreshold != 0 {
+		result.ExpiryThreshold = durationpb.New(policy.ExpiryThreshold)
+	}
+
+	for _, name := range policy.Components {
+		component, err := omni.ParseSecretRotationComponent(name)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Components = append(result.Components, component)
+	}
+
+	return result, nil
 }
 
 // KubernetesCluster is a Kubernetes cluster settings.
@@ -111,6 +170,21 @@ func (cluster *Cluster) Validate(opts ValidateOptions) error {
 		multiErr = multierror.Append(multiErr, err)
 	}
 
+	if cluster.Features.BackupConfiguration.Retention != nil && cluster.Features.BackupConfiguration.Interval <= 0 {
+		multiErr = multierror.Append(multiErr, errors.New("backup retention requires the backup interval to be set"))
+	}
+
+	if cluster.SecretRotation != nil {
+		policy, err := cluster.SecretRotation.Translate()
+		if err == nil {
+			err = omni.ValidateSecretRotationPolicy(policy)
+		}
+
+		if err != nil {
+			multiErr = multierror.Append(multiErr, err)
+		}
+	}
+
 	if err := cluster.Patches.Validate(opts); err != nil {
 		multiErr = multierror.Append(multiErr, err)
 	}
@@ -147,6 +221,16 @@ func (cluster *Cluster) Translate(ctx TranslateContext) ([]resource.Resource, er
 
 	clusterResource.TypedSpec().Value.KubernetesVersion = strings.TrimLeft(cluster.Kubernetes.Version, "v")
 	clusterResource.TypedSpec().Value.TalosVersion = strings.TrimLeft(cluster.Talos.Version, "v")
+	clusterResource.TypedSpec().Value.MaintenanceWindow = cluster.MaintenanceWindow
+
+	if cluster.SecretRotation != nil {
+		policy, err := cluster.SecretRotation.Translate()
+		if err != nil {
+			return nil, err
+		}
+
+		clusterResource.TypedSpec().Value.SecretRotationPolicy = policy
+	}
 
 	patches, err := cluster.Patches.Translate(ctx, fmt.Sprintf("cluster-%s", cluster.Name), constants.PatchBaseWeightCluster, pair.MakePair(omni.LabelCluster, cluster.Name))
 	if err != nil {
@@ -169,6 +253,15 @@ func (cluster *Cluster) Translate(ctx TranslateContext) ([]resource.Resource, er
 
 	if interval := cluster.Features.BackupConfiguration.Interval; interval > 0 {
 		clusterResource.TypedSpec().Value.BackupConfiguration = &specs.EtcdBackupConf{Interval: durationpb.New(interval), Enabled: true}
+
+		if retention := cluster.Features.BackupConfiguration.Retention; retention != nil {
+			clusterResource.TypedSpec().Value.BackupConfiguration.Retention = &specs.EtcdBackupRetention{
+				KeepLast:    retention.KeepLast,
+				KeepDaily:   retention.KeepDaily,
+				KeepWeekly:  retention.KeepWeekly,
+				KeepMonthly: retention.KeepMonthly,
+			}
+		}
 	}
 
 	healthchecks, err := cluster.Kubernetes.Healthchecks.Translate(
@@ -47,6 +47,10 @@ func (controlplane *ControlPlane) Validate(opts ValidateOptions) error {
 		multiErr = multierror.Append(multiErr, fmt.Errorf("deleteStrategy is not allowed in the controlplane"))
 	}
 
+	if controlplane.MachineClass != nil && controlplane.MachineClass.Autoscaling != nil {
+		multiErr = multierror.Append(multiErr, fmt.Errorf("autoscaling is not allowed in the controlplane"))
+	}
+
 	multiErr = joinErrors(multiErr, controlplane.MachineSet.Validate(), controlplane.Machines.Validate(), controlplane.Patches.Validate(opts))
 	if multiErr != nil {
 		return fmt.Errorf("controlplane is invalid: %w", multiErr)
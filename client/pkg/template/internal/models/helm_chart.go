@@ -0,0 +1,177 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package models
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+)
+
+// HelmChart references a Helm chart, which is rendered by Omni into the manifests of the cluster.
+//
+// If the repository is not set, the chart is a path to a local chart directory or a packaged chart (.tgz),
+// which is packaged into the resource.
+type HelmChart struct {
+	Values      *InlineContent `yaml:"values,omitempty"`
+	Repository  string         `yaml:"repository,omitempty"`
+	Chart       string         `yaml:"chart"`
+	Version     string         `yaml:"version,omitempty"`
+	ReleaseName string         `yaml:"releaseName,omitempty"`
+	Namespace   string         `yaml:"namespace,omitempty"`
+	ValuesFrom  []string       `yaml:"valuesFrom,omitempty"`
+}
+
+// Validate the Helm chart reference.
+func (h *HelmChart) Validate(opts ValidateOptions) error {
+	var errs error
+
+	if h.Chart == "" {
+		errs = errors.Join(errs, errors.New("helm chart is required"))
+	}
+
+	if h.Repository != "" {
+		parsed, err := url.Parse(h.Repository)
+
+		switch {
+		case err != nil:
+			errs = errors.Join(errs, fmt.Errorf("invalid helm repository: %w", err))
+		case parsed.Scheme != "http" && parsed.Scheme != "https" && parsed.Scheme != "oci":
+			errs = errors.Join(errs, fmt.Errorf("unsupported helm repository scheme %q, must be one of http, https, oci", parsed.Scheme))
+		}
+	} else if h.Chart != "" {
+		if _, err := opts.StatFile(h.Chart); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("failed to access helm chart %q: %w", h.Chart, err))
+		}
+	}
+
+	if h.Values != nil {
+		if _, err := h.Values.Bytes(); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("failed to marshal helm values: %w", err))
+		}
+	}
+
+	return errs
+}
+
+// Translate the Helm chart reference into the Helm source of the manifest group.
+func (h *HelmChart) Translate(ctx TranslateContext) (*specs.KubernetesManifestGroupSpec_HelmSource, error) {
+	source := &specs.KubernetesManifestGroupSpec_HelmSource{
+		Repository:  h.Repository,
+		Chart:       h.Chart,
+		Version:     h.Version,
+		ReleaseName: h.ReleaseName,
+		Namespace:   h.Namespace,
+		ValuesFrom:  h.ValuesFrom,
+	}
+
+	if h.Values != nil {
+		values, err := h.Values.Bytes()
+		if err != nil {
+			return nil, err
+		}
+
+		source.Values = string(values)
+	}
+
+	if h.Repository != "" {
+		return source, nil
+	}
+
+	archive, err := h.readLocalChart(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read helm chart %q: %w", h.Chart, err)
+	}
+
+	source.Chart = ""
+	source.ChartArchive = archive
+
+	return source, nil
+}
+
+// readLocalChart reads a packaged chart, or packages a chart directory.
+func (h *HelmChart) readLocalChart(ctx TranslateContext) ([]byte, error) {
+	info, err := ctx.StatFile(h.Chart)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return ctx.ReadFile(h.Chart)
+	}
+
+	chartFS, err := ctx.DirFS(h.Chart)
+	if err != nil {
+		return nil, err
+	}
+
+	return packageChart(chartFS, filepath.Base(filepath.Clean(h.Chart)))
+}
+
+// packageChart packages the chart directory the same way `helm package` does: a gzipped tarball with a single top-level directory.
+func packageChart(chartFS fs.FS, name string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	gzipWriter := gzip.NewWriter(&buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	err := fs.WalkDir(chartFS, ".", func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			if filePath != "." && strings.HasPrefix(entry.Name(), ".") {
+				return fs.SkipDir
+			}
+
+			return nil
+		}
+
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		data, err := fs.ReadFile(chartFS, filePath)
+		if err != nil {
+			return err
+		}
+
+		if err = tarWriter.WriteHeader(&tar.Header{
+			Name:     path.Join(name, filePath),
+			Mode:     0o644,
+			Size:     int64(len(data)),
+			Typeflag: tar.TypeReg,
+		}); err != nil {
+			return err
+		}
+
+		_, err = tarWriter.Write(data)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tarWriter.Close(); err != nil {
+		return nil, err
+	}
+
+	if err = gzipWriter.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,117 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package models_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/template/internal/models"
+)
+
+func TestHelmChartLocalDirectory(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	for name, content := range map[string]string{
+		"demo/Chart.yaml":               "apiVersion: v2\nname: demo\nversion: 0.1.0\n",
+		"demo/values.yaml":              "replicas: 1\n",
+		"demo/templates/configmap.yaml": "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: demo\n",
+		"demo/.git/HEAD":                "ref: refs/heads/main\n",
+	} {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, filepath.Dir(name)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+
+	manifest := models.KubernetesManifest{
+		Name: "demo",
+		Mode: models.KubernetesManifestMode(specs.KubernetesManifestGroupSpec_FULL),
+		Helm: &models.HelmChart{
+			Chart:     "demo",
+			Namespace: "demo",
+			Values:    models.NewInlineContent(map[string]any{"replicas": 2}),
+		},
+	}
+
+	fileContext := models.FileContext{Dir: dir}
+
+	require.NoError(t, manifest.Validate(models.ValidateOptions{FileContext: fileContext}))
+
+	res, err := manifest.Translate(models.TranslateContext{FileContext: fileContext, ClusterName: "cluster"}, "cluster-cluster", 200)
+	require.NoError(t, err)
+
+	source := res.TypedSpec().Value.GetHelm()
+	require.NotNil(t, source)
+
+	assert.Empty(t, source.Repository)
+	assert.Empty(t, source.Chart)
+	assert.Equal(t, "demo", source.Namespace)
+	assert.Equal(t, "replicas: 2\n", source.Values)
+
+	gzipReader, err := gzip.NewReader(bytes.NewReader(source.ChartArchive))
+	require.NoError(t, err)
+
+	tarReader := tar.NewReader(gzipReader)
+
+	var files []string
+
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		require.NoError(t, err)
+
+		files = append(files, header.Name)
+	}
+
+	assert.Equal(t, []string{"demo/Chart.yaml", "demo/templates/configmap.yaml", "demo/values.yaml"}, files)
+
+	// the packaging is reproducible, so the template sync doesn't update the resource if the chart is not changed
+	again, err := manifest.Translate(models.TranslateContext{FileContext: fileContext, ClusterName: "cluster"}, "cluster-cluster", 200)
+	require.NoError(t, err)
+
+	assert.Equal(t, source.ChartArchive, again.TypedSpec().Value.GetHelm().GetChartArchive())
+}
+
+func TestHelmChartValidate(t *testing.T) {
+	t.Parallel()
+
+	manifest := models.KubernetesManifest{
+		Name: "demo",
+		Mode: models.KubernetesManifestMode(specs.KubernetesManifestGroupSpec_FULL),
+		Helm: &models.HelmChart{
+			Repository: "ftp://charts.example.com",
+			Chart:      "demo",
+		},
+		File: "demo.yaml",
+	}
+
+	err := manifest.Validate(models.ValidateOptions{FileContext: models.FileContext{Dir: t.TempDir()}})
+	require.Error(t, err)
+
+	assert.ErrorContains(t, err, "helm is mutually exclusive with path and inline")
+
+	manifest.File = ""
+
+	err = manifest.Validate(models.ValidateOptions{FileContext: models.FileContext{Dir: t.TempDir()}})
+	assert.ErrorContains(t, err, "unsupported helm repository scheme")
+
+	manifest.Helm.Repository = "oci://ghcr.io/example/charts"
+
+	assert.NoError(t, manifest.Validate(models.ValidateOptions{FileContext: models.FileContext{Dir: t.TempDir()}}))
+}
@@ -55,6 +55,7 @@ func (c KubernetesManifestMode) MarshalYAML() (any, error) {
 // The manifests are applied on the server side.
 type KubernetesManifest struct {
 	Inline      *InlineContent         `yaml:"inline,omitempty"`
+	Helm        *HelmChart             `yaml:"helm,omitempty"`
 	Descriptors Descriptors            `yaml:",inline"`
 	Name        string                 `yaml:"name"`
 	File        string                 `yaml:"file,omitempty"`
@@ -94,8 +95,12 @@ func (km *KubernetesManifest) Validate(opts ValidateOptions) error {
 		errs = errors.Join(errs, fmt.Errorf("path and inline are mutually exclusive"))
 	}
 
-	if km.File == "" && km.Inline == nil {
-		errs = errors.Join(errs, fmt.Errorf("path or inline is required"))
+	if km.Helm != nil && (km.File != "" || km.Inline != nil) {
+		errs = errors.Join(errs, fmt.Errorf("helm is mutually exclusive with path and inline"))
+	}
+
+	if km.File == "" && km.Inline == nil && km.Helm == nil {
+		errs = errors.Join(errs, fmt.Errorf("path, inline or helm is required"))
 	}
 
 	if err := km.Descriptors.Validate(); err != nil {
@@ -113,6 +118,10 @@ func (km *KubernetesManifest) Validate(opts ValidateOptions) error {
 		if err != nil {
 			errs = errors.Join(errs, fmt.Errorf("failed to marshal inline manifest %q: %w", name, err))
 		}
+	case km.Helm != nil:
+		if err := km.Helm.Validate(opts); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("kubernetes manifest %q helm chart is invalid: %w", name, err))
+		}
 	}
 
 	return errs
@@ -128,8 +137,9 @@ func (km *KubernetesManifest) Translate(ctx TranslateContext, prefix string, wei
 	id := fmt.Sprintf("%03d-%s-%s", weight, prefix, name)
 
 	var (
-		raw []byte
-		err error
+		raw  []byte
+		helm *specs.KubernetesManifestGroupSpec_HelmSource
+		err  error
 	)
 
 	switch {
@@ -137,6 +147,8 @@ func (km *KubernetesManifest) Translate(ctx TranslateContext, prefix string, wei
 		raw, err = ctx.ReadFile(km.File)
 	case km.Inline != nil:
 		raw, err = km.GetManifests()
+	case km.Helm != nil:
+		helm, err = km.Helm.Translate(ctx)
 	default:
 		return nil, fmt.Errorf("missing manifests contents")
 	}
@@ -156,6 +168,7 @@ func (km *KubernetesManifest) Translate(ctx TranslateContext, prefix string, wei
 	resource.Metadata().Annotations().Set(omni.KubernetesManifestName, name)
 
 	resource.TypedSpec().Value.Mode = specs.KubernetesManifestGroupSpec_Mode(km.Mode)
+	resource.TypedSpec().Value.Helm = helm
 
 	km.Descriptors.Apply(resource)
 
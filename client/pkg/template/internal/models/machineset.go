@@ -7,10 +7,12 @@ package models
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/hashicorp/go-multierror"
 	"github.com/siderolabs/gen/pair"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/constants"
@@ -46,6 +48,9 @@ type MachineSet struct { //nolint:govet
 	// UpgradeStrategy defines the upgrade strategy for the machine set.
 	UpgradeStrategy *UpdateStrategyConfig `yaml:"upgradeStrategy,omitempty"`
 
+	// MaintenanceWindow overrides the maintenance window of the cluster for the machine set.
+	MaintenanceWindow string `yaml:"maintenanceWindow,omitempty"`
+
 	// MachineSet patches.
 	Patches PatchList `yaml:"patches,omitempty"`
 
@@ -70,6 +75,61 @@ type MachineClassConfig struct {
 
 	// Size sets the number of machines to be pulled from the machine class.
 	Size Size `yaml:"size"`
+
+	// Autoscaling enables the cluster autoscaler to scale the machine set within the bounds.
+	Autoscaling *AutoscalingConfig `yaml:"autoscaling,omitempty"`
+}
+
+// AutoscalingConfig defines the model for setting the bounds the cluster autoscaler can scale the machine set within.
+//
+// The size of the machine class is the initial number of machines, and it is kept as is on sync while it stays within the bounds.
+type AutoscalingConfig struct {
+	// Min is the smallest number of machines the machine set can be scaled down to.
+	Min uint32 `yaml:"min"`
+
+	// Max is the largest number of machines the machine set can be scaled up to.
+	Max uint32 `yaml:"max"`
+}
+
+func (c *MachineClassConfig) validate() error {
+	if c == nil || c.Autoscaling == nil {
+		return nil
+	}
+
+	var multiErr error
+
+	static := c.Size.AllocationType == specs.MachineSetSpec_MachineAllocation_Static
+	if !static {
+		multiErr = multierror.Append(multiErr, fmt.Errorf("autoscaling requires a static machine class size"))
+	}
+
+	switch {
+	case c.Autoscaling.Max == 0:
+		multiErr = multierror.Append(multiErr, fmt.Errorf("autoscaling max must be greater than zero"))
+	case c.Autoscaling.Min > c.Autoscaling.Max:
+		multiErr = multierror.Append(multiErr, fmt.Errorf("autoscaling min %d is greater than max %d", c.Autoscaling.Min, c.Autoscaling.Max))
+	case static && (c.Size.Value < c.Autoscaling.Min || c.Size.Value > c.Autoscaling.Max):
+		multiErr = multierror.Append(multiErr, fmt.Errorf("machine class size %d is out of the autoscaling bounds [%d, %d]", c.Size.Value, c.Autoscaling.Min, c.Autoscaling.Max))
+	}
+
+	return multiErr
+}
+
+func (c *MachineClassConfig) translate() *specs.MachineSetSpec_MachineAllocation {
+	allocation := &specs.MachineSetSpec_MachineAllocation{
+		Name:           c.Name,
+		MachineCount:   c.Size.Value,
+		AllocationType: c.Size.AllocationType,
+	}
+
+	if c.Autoscaling != nil {
+		allocation.Autoscaling = &specs.MachineSetSpec_MachineAllocation_Autoscaling{
+			MinMachineCount: c.Autoscaling.Min,
+			MaxMachineCount: c.Autoscaling.Max,
+		}
+	}
+
+	return allocation
 }
 
 // Size extends protobuf generated allocation type enum to parse string constants.
@@ -150,10 +210,88 @@ type RollingUpdateStrategyConfig struct {
 	MaxParallelism uint32 `yaml:"maxParallelism,omitempty"`
 }
 
+// CanaryUpdateStrategyConfig defines the model for setting the canary update strategy in the machine set.
+type CanaryUpdateStrategyConfig struct {
+	// Count is the number of machines updated first as canaries.
+	Count uint32 `yaml:"count,omitempty"`
+
+	// MaxParallelism is the number of machines updated in parallel after the canaries are promoted.
+	MaxParallelism uint32 `yaml:"maxParallelism,omitempty"`
+
+	// SoakDuration is the time the canaries should stay healthy before they are promoted.
+	SoakDuration time.Duration `yaml:"soakDuration,omitempty"`
+
+	// RequireApproval holds the promotion until a CanaryApproval is created for the machine set.
+	RequireApproval bool `yaml:"requireApproval,omitempty"`
+
+	// FailureTimeout is the time the canaries can stay unhealthy before the rollout is rolled back.
+	FailureTimeout time.Duration `yaml:"failureTimeout,omitempty"`
+}
+
 // UpdateStrategyConfig defines the model for setting the update strategy in the machine set.
 type UpdateStrategyConfig struct {
 	Type    *UpdateStrategyType          `yaml:"type,omitempty"`
 	Rolling *RollingUpdateStrategyConfig `yaml:"rolling,omitempty"`
+	Canary  *CanaryUpdateStrategyConfig  `yaml:"canary,omitempty"`
+}
+
+func (c *UpdateStrategyConfig) validate(name string, canaryAllowed bool) error {
+	if c == nil {
+		return nil
+	}
+
+	var multiErr error
+
+	isCanary := c.Type != nil && specs.MachineSetSpec_UpdateStrategy(*c.Type) == specs.MachineSetSpec_Canary
+
+	if !canaryAllowed && (isCanary || c.Canary != nil) {
+		multiErr = multierror.Append(multiErr, fmt.Errorf("%s: canary strategy is not supported", name))
+	}
+
+	if canaryAllowed && c.Canary != nil && !isCanary {
+		multiErr = multierror.Append(multiErr, fmt.Errorf("%s: canary config requires the strategy type to be Canary", name))
+	}
+
+	if c.Canary != nil && c.Rolling != nil {
+		multiErr = multierror.Append(multiErr, fmt.Errorf("%s: rolling and canary configs are mutually exclusive", name))
+	}
+
+	if c.Canary != nil && (c.Canary.SoakDuration < 0 || c.Canary.FailureTimeout < 0) {
+		multiErr = multierror.Append(multiErr, fmt.Errorf("%s: canary durations can not be negative", name))
+	}
+
+	return multiErr
+}
+
+func (c *UpdateStrategyConfig) translate() *specs.MachineSetSpec_UpdateStrategyConfig {
+	switch {
+	case c.Rolling != nil:
+		return &specs.MachineSetSpec_UpdateStrategyConfig{
+			Rolling: &specs.MachineSetSpec_RollingUpdateStrategyConfig{
+				MaxParallelism: c.Rolling.MaxParallelism,
+			},
+		}
+	case c.Canary != nil:
+		config := &specs.MachineSetSpec_CanaryUpdateStrategyConfig{
+			Count:           c.Canary.Count,
+			MaxParallelism:  c.Canary.MaxParallelism,
+			RequireApproval: c.Canary.RequireApproval,
+		}
+
+		if c.Canary.SoakDuration != 0 {
+			config.SoakDuration = durationpb.New(c.Canary.SoakDuration)
+		}
+
+		if c.Canary.FailureTimeout != 0 {
+			config.FailureTimeout = durationpb.New(c.Canary.FailureTimeout)
+		}
+
+		return &specs.MachineSetSpec_UpdateStrategyConfig{
+			Canary: config,
+		}
+	}
+
+	return nil
 }
 
 // Validate checks the machine set fields correctness.
@@ -173,6 +311,22 @@ func (machineset *MachineSet) Validate() error {
 		multiErr = multierror.Append(multiErr, fmt.Errorf("kernelArgs cannot be defined for machine set since it uses machine class"))
 	}
 
+	if err := machineset.MachineClass.validate(); err != nil {
+		multiErr = multierror.Append(multiErr, err)
+	}
+
+	if err := machineset.UpdateStrategy.validate("updateStrategy", true); err != nil {
+		multiErr = multierror.Append(multiErr, err)
+	}
+
+	if err := machineset.DeleteStrategy.validate("deleteStrategy", false); err != nil {
+		multiErr = multierror.Append(multiErr, err)
+	}
+
+	if err := machineset.UpgradeStrategy.validate("upgradeStrategy", true); err != nil {
+		multiErr = multierror.Append(multiErr, err)
+	}
+
 	return multiErr
 }
 
@@ -193,13 +347,7 @@ func (machineset *MachineSet) translate(ctx TranslateContext, nameSuffix, roleLa
 			machineSet.TypedSpec().Value.UpdateStrategy = specs.MachineSetSpec_UpdateStrategy(*machineset.UpdateStrategy.Type)
 		}
 
-		if machineset.UpdateStrategy.Rolling != nil {
-			machineSet.TypedSpec().Value.UpdateStrategyConfig = &specs.MachineSetSpec_UpdateStrategyConfig{
-				Rolling: &specs.MachineSetSpec_RollingUpdateStrategyConfig{
-					MaxParallelism: machineset.UpdateStrategy.Rolling.MaxParallelism,
-				},
-			}
-		}
+		machineSet.TypedSpec().Value.UpdateStrategyConfig = machineset.UpdateStrategy.translate()
 	}
 
 	if machineset.DeleteStrategy != nil {
@@ -207,13 +355,7 @@ func (machineset *MachineSet) translate(ctx TranslateContext, nameSuffix, roleLa
 			machineSet.TypedSpec().Value.DeleteStrategy = specs.MachineSetSpec_UpdateStrategy(*machineset.DeleteStrategy.Type)
 		}
 
-		if machineset.DeleteStrategy.Rolling != nil {
-			machineSet.TypedSpec().Value.DeleteStrategyConfig = &specs.MachineSetSpec_UpdateStrategyConfig{
-				Rolling: &specs.MachineSetSpec_RollingUpdateStrategyConfig{
-					MaxParallelism: machineset.DeleteStrategy.Rolling.MaxParallelism,
-				},
-			}
-		}
+		machineSet.TypedSpec().Value.DeleteStrategyConfig = machineset.DeleteStrategy.translate()
 	}
 
 	if machineset.UpgradeStrategy != nil {
@@ -221,15 +363,11 @@ func (machineset *MachineSet) translate(ctx TranslateContext, nameSuffix, roleLa
 			machineSet.TypedSpec().Value.UpgradeStrategy = specs.MachineSetSpec_UpdateStrategy(*machineset.UpgradeStrategy.Type)
 		}
 
-		if machineset.UpgradeStrategy.Rolling != nil {
-			machineSet.TypedSpec().Value.UpgradeStrategyConfig = &specs.MachineSetSpec_UpdateStrategyConfig{
-				Rolling: &specs.MachineSetSpec_RollingUpdateStrategyConfig{
-					MaxParallelism: machineset.UpgradeStrategy.Rolling.MaxParallelism,
-				},
-			}
-		}
+		machineSet.TypedSpec().Value.UpgradeStrategyConfig = machineset.UpgradeStrategy.translate()
 	}
 
+	machineSet.TypedSpec().Value.MaintenanceWindow = machineset.MaintenanceWindow
+
 	resourceList := []resource.Resource{machineSet}
 
 	if machineset.BootstrapSpec != nil {
@@ -244,11 +382,7 @@ func (machineset *MachineSet) translate(ctx TranslateContext, nameSuffix, roleLa
 			return nil, fmt.Errorf("kernelArgs cannot be defined at cluster level for this machine set since it uses a machine class")
 		}
 
-		machineSet.TypedSpec().Value.MachineAllocation = &specs.MachineSetSpec_MachineAllocation{
-			Name:           machineset.MachineClass.Name,
-			MachineCount:   machineset.MachineClass.Size.Value,
-			AllocationType: machineset.MachineClass.Size.AllocationType,
-		}
+		machineSet.TypedSpec().Value.MachineAllocation = machineset.MachineClass.translate()
 	} else {
 		for _, machineID := range machineset.Machines {
 			machineSetNode := omni.NewMachineSetNode(string(machineID), machineSet)
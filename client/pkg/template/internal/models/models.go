@@ -6,7 +6,9 @@
 package models
 
 import (
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
@@ -31,8 +33,13 @@ type FileContext struct {
 	// Dir is the directory used to resolve relative file paths from the template.
 	// When empty, callers should treat it as "." (for example, for non-file-backed templates).
 	Dir string
+	// NoFileAccess disables the file access, so that the templates which reference files fail to validate.
+	NoFileAccess bool
 }
 
+// errNoFileAccess is returned when the template references a file, but the file access is disabled.
+var errNoFileAccess = errors.New("file references are not supported in this template")
+
 // TranslateContext is a context for translation.
 type TranslateContext struct {
 	FileContext
@@ -143,6 +150,10 @@ func (fc FileContext) resolveForRoot(path string) (string, error) {
 // ReadFile reads a file, using fc.Root to restrict access when non-nil.
 // Relative paths are resolved against fc.Dir.
 func (fc FileContext) ReadFile(path string) ([]byte, error) {
+	if fc.NoFileAccess {
+		return nil, errNoFileAccess
+	}
+
 	if fc.Root == nil {
 		return os.ReadFile(fc.resolveForDir(path))
 	}
@@ -158,6 +169,10 @@ func (fc FileContext) ReadFile(path string) ([]byte, error) {
 // StatFile stats a file, using fc.Root to restrict access when non-nil.
 // Relative paths are resolved against fc.Dir.
 func (fc FileContext) StatFile(path string) (os.FileInfo, error) {
+	if fc.NoFileAccess {
+		return nil, errNoFileAccess
+	}
+
 	if fc.Root == nil {
 		return os.Stat(fc.resolveForDir(path))
 	}
@@ -170,6 +185,25 @@ func (fc FileContext) StatFile(path string) (os.FileInfo, error) {
 	return fc.Root.Stat(rel)
 }
 
+// DirFS returns the file system of a directory, using fc.Root to restrict access when non-nil.
+// Relative paths are resolved against fc.Dir.
+func (fc FileContext) DirFS(path string) (fs.FS, error) {
+	if fc.NoFileAccess {
+		return nil, errNoFileAccess
+	}
+
+	if fc.Root == nil {
+		return os.DirFS(fc.resolveForDir(path)), nil
+	}
+
+	rel, err := fc.resolveForRoot(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return fs.Sub(fc.Root.FS(), filepath.ToSlash(rel))
+}
+
 // Model is a base interface for cluster templates.
 type Model interface {
 	Validate(ValidateOptions) error
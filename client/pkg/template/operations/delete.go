@@ -21,8 +21,8 @@ import (
 )
 
 // DeleteTemplate removes all template resources from Omni.
-func DeleteTemplate(ctx context.Context, templateReader io.Reader, out io.Writer, st state.State, syncOptions SyncOptions, root *os.Root) error {
-	tmpl, err := template.Load(templateReader, template.WithRoot(root))
+func DeleteTemplate(ctx context.Context, templateReader io.Reader, out io.Writer, st state.State, syncOptions SyncOptions, root *os.Root, opts ...template.Option) error {
+	tmpl, err := template.Load(templateReader, append([]template.Option{template.WithRoot(root)}, opts...)...)
 	if err != nil {
 		return fmt.Errorf("error loading template: %w", err)
 	}
@@ -17,8 +17,8 @@ import (
 )
 
 // DiffTemplate outputs the diff between template resources and existing resources.
-func DiffTemplate(ctx context.Context, templateReader io.Reader, output io.Writer, st state.State, root *os.Root) error {
-	tmpl, err := template.Load(templateReader, template.WithRoot(root))
+func DiffTemplate(ctx context.Context, templateReader io.Reader, output io.Writer, st state.State, root *os.Root, opts ...template.Option) error {
+	tmpl, err := template.Load(templateReader, append([]template.Option{template.WithRoot(root)}, opts...)...)
 	if err != nil {
 		return fmt.Errorf("error loading template: %w", err)
 	}
@@ -289,6 +289,13 @@ func transformMachineSetToModel(machineSet *omni.MachineSet, nodes []*omni.Machi
 				AllocationType: allocationConfig.GetAllocationType(),
 			},
 		}
+
+		if autoscaling := allocationConfig.GetAutoscaling(); autoscaling != nil {
+			machineAllocation.Autoscaling = &models.AutoscalingConfig{
+				Min: autoscaling.GetMinMachineCount(),
+				Max: autoscaling.GetMaxMachineCount(),
+			}
+		}
 	} else {
 		machineIDs = xslices.Map(nodes, func(node *omni.MachineSetNode) models.MachineID {
 			return models.MachineID(node.Metadata().ID())
@@ -311,6 +318,8 @@ func transformMachineSetToModel(machineSet *omni.MachineSet, nodes []*omni.Machi
 				MaxParallelism: spec.GetUpdateStrategyConfig().GetRolling().GetMaxParallelism(),
 			}
 		}
+
+		updateStrategyConfig.Canary = transformCanaryStrategyConfigToModel(spec.GetUpdateStrategyConfig().GetCanary())
 	}
 
 	var deleteStrategyConfig *models.UpdateStrategyConfig
@@ -343,6 +352,8 @@ func transformMachineSetToModel(machineSet *omni.MachineSet, nodes []*omni.Machi
 				MaxParallelism: spec.GetUpgradeStrategyConfig().GetRolling().GetMaxParallelism(),
 			}
 		}
+
+		upgradeStrategyConfig.Canary = transformCanaryStrategyConfigToModel(spec.GetUpgradeStrategyConfig().GetCanary())
 	}
 
 	kind := models.KindControlPlane
@@ -364,18 +375,33 @@ func transformMachineSetToModel(machineSet *omni.MachineSet, nodes []*omni.Machi
 		Meta: models.Meta{
 			Kind: kind,
 		},
-		Name:            name,
-		Descriptors:     getUserDescriptors(machineSet),
-		BootstrapSpec:   bootstrapSpec,
-		Machines:        machineIDs,
-		MachineClass:    machineAllocation,
-		Patches:         patchModels,
-		UpdateStrategy:  updateStrategyConfig,
-		DeleteStrategy:  deleteStrategyConfig,
-		UpgradeStrategy: upgradeStrategyConfig,
+		Name:              name,
+		Descriptors:       getUserDescriptors(machineSet),
+		BootstrapSpec:     bootstrapSpec,
+		Machines:          machineIDs,
+		MachineClass:      machineAllocation,
+		Patches:           patchModels,
+		UpdateStrategy:    updateStrategyConfig,
+		DeleteStrategy:    deleteStrategyConfig,
+		UpgradeStrategy:   upgradeStrategyConfig,
+		MaintenanceWindow: spec.GetMaintenanceWindow(),
 	}, nil
 }
 
+func transformCanaryStrategyConfigToModel(config *specs.MachineSetSpec_CanaryUpdateStrategyConfig) *models.CanaryUpdateStrategyConfig {
+	if config == nil {
+		return nil
+	}
+
+	return &models.CanaryUpdateStrategyConfig{
+		Count:           config.GetCount(),
+		MaxParallelism:  config.GetMaxParallelism(),
+		SoakDuration:    config.GetSoakDuration().AsDuration(),
+		RequireApproval: config.GetRequireApproval(),
+		FailureTimeout:  config.GetFailureTimeout().AsDuration(),
+	}
+}
+
 func transformClusterToModel(cluster *omni.Cluster, patches []*omni.ConfigPatch, initialTalosVersion string) (models.Cluster, error) {
 	spec := cluster.TypedSpec().Value
 	backupIntervalDuration := time.Duration(0)
@@ -399,6 +425,15 @@ func transformClusterToModel(cluster *omni.Cluster, patches []*omni.ConfigPatch,
 		},
 	}
 
+	if retention := spec.GetBackupConfiguration().GetRetention(); retention != nil {
+		features.BackupConfiguration.Retention = &models.BackupRetention{
+			KeepLast:    retention.GetKeepLast(),
+			KeepDaily:   retention.GetKeepDaily(),
+			KeepWeekly:  retention.GetKeepWeekly(),
+			KeepMonthly: retention.GetKeepMonthly(),
+		}
+	}
+
 	// The public discovery service is only a settable option on clusters created with Talos 1.14+, so emit its
 	// actual value there and omit it otherwise.
 	if initialTalosVersion != "" {
@@ -408,6 +443,16 @@ func transformClusterToModel(cluster *omni.Cluster, patches []*omni.ConfigPatch,
 		}
 	}
 
+	var secretRotation *models.SecretRotationPolicy
+
+	if policy := spec.GetSecretRotationPolicy(); policy != nil {
+		secretRotation = &models.SecretRotationPolicy{
+			Interval:        policy.GetInterval().AsDuration(),
+			ExpiryThreshold: policy.GetExpiryThreshold().AsDuration(),
+			Components:      xslices.Map(policy.GetComponents(), omni.SecretRotationComponentName),
+		}
+	}
+
 	return models.Cluster{
 		Meta: models.Meta{
 			Kind: models.KindCluster,
@@ -420,8 +465,10 @@ func transformClusterToModel(cluster *omni.Cluster, patches []*omni.ConfigPatch,
 		Talos: models.TalosCluster{
 			Version: "v" + spec.GetTalosVersion(),
 		},
-		Features: features,
-		Patches:  patchModels,
+		Features:          features,
+		MaintenanceWindow: spec.GetMaintenanceWindow(),
+		SecretRotation:    secretRotation,
+		Patches:           patchModels,
 	}, nil
 }
 
@@ -604,6 +651,10 @@ func transformManifestsToModels(manifests []*omni.KubernetesManifestGroup) (mode
 }
 
 func transformManifestToModel(manifest *omni.KubernetesManifestGroup) (models.KubernetesManifest, error) {
+	if source := manifest.TypedSpec().Value.GetHelm(); source != nil {
+		return transformHelmManifestToModel(manifest, source)
+	}
+
 	buffer, err := manifest.TypedSpec().Value.GetUncompressedData()
 	if err != nil {
 		return models.KubernetesManifest{}, err
@@ -632,6 +683,42 @@ func transformManifestToModel(manifest *omni.KubernetesManifestGroup) (models.Ku
 	}, nil
 }
 
+func transformHelmManifestToModel(manifest *omni.KubernetesManifestGroup, source *specs.KubernetesManifestGroupSpec_HelmSource) (models.KubernetesManifest, error) {
+	name, ok := manifest.Metadata().Annotations().Get(omni.KubernetesManifestName)
+	if !ok {
+		return models.KubernetesManifest{}, fmt.Errorf("manifest %q has no name annotation", manifest.Metadata().ID())
+	}
+
+	// the packaged local charts can't be turned back into the chart directory
+	if len(source.GetChartArchive()) > 0 {
+		return models.KubernetesManifest{}, fmt.Errorf("manifest %q uses a local helm chart, which can't be exported", name)
+	}
+
+	helm := &models.HelmChart{
+		Repository:  source.GetRepository(),
+		Chart:       source.GetChart(),
+		Version:     source.GetVersion(),
+		ReleaseName: source.GetReleaseName(),
+		Namespace:   source.GetNamespace(),
+		ValuesFrom:  source.GetValuesFrom(),
+	}
+
+	if source.GetValues() != "" {
+		helm.Values = models.NewInlineContentBytes([]byte(source.GetValues()))
+	}
+
+	descriptors := getUserDescriptors(manifest)
+
+	delete(descriptors.Annotations, omni.KubernetesManifestName)
+
+	return models.KubernetesManifest{
+		Name:        name,
+		Mode:        models.KubernetesManifestMode(manifest.TypedSpec().Value.Mode),
+		Helm:        helm,
+		Descriptors: descriptors,
+	}, nil
+}
+
 func transformKubernetesHealthChecksToModels(healthchecks []*omni.KubernetesHealthCheck) models.KubernetesHealthCheckList {
 	if len(healthchecks) == 0 {
 		return nil
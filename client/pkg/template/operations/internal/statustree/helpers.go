@@ -6,10 +6,12 @@ package statustree
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/fatih/color"
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/maintenancewindow"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 )
 
@@ -178,6 +180,10 @@ func clusterMachineConfigStatus(node *omni.ClusterMachineStatus) string {
 		return " " + color.BlueString("Pending Config Update")
 	}
 
+	if reason := node.TypedSpec().Value.PendingReason; reason != "" {
+		return " " + color.BlueString("Pending Config Update (%s)", reason)
+	}
+
 	switch node.TypedSpec().Value.ConfigApplyStatus {
 	case specs.ConfigApplyStatus_UNKNOWN,
 		specs.ConfigApplyStatus_PENDING,
@@ -190,6 +196,26 @@ func clusterMachineConfigStatus(node *omni.ClusterMachineStatus) string {
 	}
 }
 
+func maintenanceWindowString(res *omni.MaintenanceWindow, now time.Time) string {
+	window, err := maintenancewindow.New(res.TypedSpec().Value)
+	if err != nil {
+		return color.RedString("Invalid: %s", err)
+	}
+
+	const timeFormat = "2006-01-02 15:04 MST"
+
+	start, end := window.Next(now)
+
+	switch {
+	case start.IsZero():
+		return color.RedString("Never Opens")
+	case !start.After(now):
+		return color.GreenString("Open") + " until " + end.Format(timeFormat)
+	default:
+		return color.HiYellowString("Closed") + fmt.Sprintf(" next %s - %s", start.Format(timeFormat), end.Format(timeFormat))
+	}
+}
+
 func clusterMachineReadyString(clusterMachine *omni.ClusterMachineStatus) string {
 	if clusterMachine.TypedSpec().Value.Stage != specs.ClusterMachineStatusSpec_RUNNING {
 		return ""
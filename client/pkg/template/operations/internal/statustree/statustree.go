@@ -8,6 +8,7 @@ package statustree
 import (
 	"cmp"
 	"fmt"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/fatih/color"
@@ -81,6 +82,13 @@ func (t NodeWrapper) String() string {
 			color.YellowString("Load Balancer"),
 			readyString(node.TypedSpec().Value.Healthy),
 		)
+	case *omni.MaintenanceWindow:
+		return fmt.Sprintf(
+			"%s %q %s",
+			color.YellowString("Maintenance Window"),
+			node.Metadata().ID(),
+			maintenanceWindowString(node, time.Now()),
+		)
 	default:
 		return resource.String(t.Resource)
 	}
@@ -90,7 +98,8 @@ func (t NodeWrapper) String() string {
 func (t NodeWrapper) IsParentOf(r resource.Resource) bool {
 	switch node := t.Resource.(type) {
 	case *omni.ClusterStatus:
-		return r.Metadata().Type() == omni.MachineSetStatusType || r.Metadata().Type() == omni.KubernetesUpgradeStatusType || r.Metadata().Type() == omni.TalosUpgradeStatusType
+		return r.Metadata().Type() == omni.MachineSetStatusType || r.Metadata().Type() == omni.KubernetesUpgradeStatusType ||
+			r.Metadata().Type() == omni.TalosUpgradeStatusType || r.Metadata().Type() == omni.MaintenanceWindowType
 	case *omni.MachineSetStatus:
 		_, isControlPlane := node.Metadata().Labels().Get(omni.LabelControlPlaneRole)
 		if isControlPlane && r.Metadata().Type() == omni.ControlPlaneStatusType {
@@ -118,18 +127,20 @@ func resourceTypeOrder(resourceType resource.Type) int {
 	switch resourceType {
 	case omni.ClusterStatusType:
 		return 0
-	case omni.KubernetesUpgradeStatusType:
+	case omni.MaintenanceWindowType:
 		return 1
-	case omni.TalosUpgradeStatusType:
+	case omni.KubernetesUpgradeStatusType:
 		return 2
-	case omni.MachineSetStatusType:
+	case omni.TalosUpgradeStatusType:
 		return 3
-	case omni.LoadBalancerStatusType:
+	case omni.MachineSetStatusType:
 		return 4
-	case omni.ControlPlaneStatusType:
+	case omni.LoadBalancerStatusType:
 		return 5
-	case omni.ClusterMachineStatusType:
+	case omni.ControlPlaneStatusType:
 		return 6
+	case omni.ClusterMachineStatusType:
+		return 7
 	default:
 		panic("unknown resource type " + resourceType)
 	}
@@ -16,8 +16,8 @@ import (
 )
 
 // RenderTemplate outputs the rendered template to the given output.
-func RenderTemplate(templateReader io.Reader, output io.Writer, root *os.Root) error {
-	tmpl, err := template.Load(templateReader, template.WithRoot(root))
+func RenderTemplate(templateReader io.Reader, output io.Writer, root *os.Root, opts ...template.Option) error {
+	tmpl, err := template.Load(templateReader, append([]template.Option{template.WithRoot(root)}, opts...)...)
 	if err != nil {
 		return fmt.Errorf("error loading template: %w", err)
 	}
@@ -33,8 +33,8 @@ type StatusOptions struct {
 }
 
 // StatusTemplate queries, renders and (optionally) waits for the cluster status (health).
-func StatusTemplate(ctx context.Context, templateReader io.Reader, out io.Writer, st state.State, options StatusOptions, root *os.Root) error {
-	tmpl, err := template.Load(templateReader, template.WithRoot(root))
+func StatusTemplate(ctx context.Context, templateReader io.Reader, out io.Writer, st state.State, options StatusOptions, root *os.Root, opts ...template.Option) error {
+	tmpl, err := template.Load(templateReader, append([]template.Option{template.WithRoot(root)}, opts...)...)
 	if err != nil {
 		return fmt.Errorf("error loading template: %w", err)
 	}
@@ -77,6 +77,7 @@ func statusTemplate(ctx context.Context, tmpl *template.Template, out io.Writer,
 		omni.KubernetesUpgradeStatusType,
 		omni.TalosUpgradeStatusType,
 		omni.ClusterMachineStatusType,
+		omni.MachineSetType,
 	}
 
 	for _, resourceType := range resourceTypes {
@@ -91,8 +92,22 @@ func statusTemplate(ctx context.Context, tmpl *template.Template, out io.Writer,
 		}
 	}
 
+	// maintenance windows are referenced by the cluster and its machine sets, watch them to render the next window
+	if err = st.Watch(ctx, omni.NewCluster(clusterName).Metadata(), watchCh); err != nil {
+		return err
+	}
+
+	if err = st.WatchKind(
+		ctx,
+		resource.NewMetadata(resources.DefaultNamespace, omni.MaintenanceWindowType, "", resource.VersionUndefined),
+		watchCh,
+		state.WithBootstrapContents(true),
+	); err != nil {
+		return err
+	}
+
 	resources := map[string]resource.Resource{}
-	pendingBootstraps := len(resourceTypes)
+	pendingBootstraps := len(resourceTypes) + 1
 
 	var (
 		clusterBootstrapped, startedRendering, hasUpdates bool
@@ -223,11 +238,43 @@ func render(resources map[string]resource.Resource) ([]byte, bool) {
 	root := statustree.NodeWrapper{Resource: clusterStatus}
 	tree := treeprint.NewWithRoot(root)
 
-	expandTree(tree, root, resources)
+	expandTree(tree, root, treeResources(resources))
 
 	return tree.Bytes(), healthy
 }
 
+// treeResources filters out the resources which are watched only to find the maintenance windows used by the cluster,
+// and the maintenance windows which are not used by the cluster.
+func treeResources(resources map[string]resource.Resource) map[string]resource.Resource {
+	usedWindows := map[string]struct{}{}
+
+	for _, r := range resources {
+		switch item := r.(type) {
+		case *omni.Cluster:
+			usedWindows[item.TypedSpec().Value.MaintenanceWindow] = struct{}{}
+		case *omni.MachineSet:
+			usedWindows[item.TypedSpec().Value.MaintenanceWindow] = struct{}{}
+		}
+	}
+
+	filtered := make(map[string]resource.Resource, len(resources))
+
+	for key, r := range resources {
+		switch r.Metadata().Type() {
+		case omni.ClusterType, omni.MachineSetType:
+			continue
+		case omni.MaintenanceWindowType:
+			if _, used := usedWindows[r.Metadata().ID()]; !used {
+				continue
+			}
+		}
+
+		filtered[key] = r
+	}
+
+	return filtered
+}
+
 // printStatus prints the tree to the terminal.
 //
 // If terminal supports it, previous tree is erased.
@@ -13,8 +13,8 @@ import (
 )
 
 // ValidateTemplate performs template validation.
-func ValidateTemplate(templateReader io.Reader, root *os.Root) error {
-	tmpl, err := template.Load(templateReader, template.WithRoot(root))
+func ValidateTemplate(templateReader io.Reader, root *os.Root, opts ...template.Option) error {
+	tmpl, err := template.Load(templateReader, append([]template.Option{template.WithRoot(root)}, opts...)...)
 	if err != nil {
 		return fmt.Errorf("error loading template: %w", err)
 	}
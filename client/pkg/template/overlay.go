@@ -0,0 +1,294 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package template
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+
+	"go.yaml.in/yaml/v4"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/template/internal/models"
+)
+
+// kindOverlay is the kind of the document which makes the template an overlay of the base template.
+const kindOverlay = "Overlay"
+
+// overlayDocument is the `kind: Overlay` document of the template.
+type overlayDocument struct {
+	Kind string `yaml:"kind"`
+	Base string `yaml:"base"`
+}
+
+// decodeDocuments reads all YAML documents of the input.
+func decodeDocuments(input io.Reader) ([]*yaml.Node, error) {
+	dec := yaml.NewDecoder(input)
+
+	var docs []*yaml.Node
+
+	for {
+		var docNode yaml.Node
+
+		if err := dec.Decode(&docNode); err != nil {
+			if errors.Is(err, io.EOF) {
+				return docs, nil
+			}
+
+			return nil, fmt.Errorf("error decoding template: %w", err)
+		}
+
+		if docNode.Kind != yaml.DocumentNode {
+			return nil, fmt.Errorf("unexpected node kind %v", docNode.Kind)
+		}
+
+		if len(docNode.Content) != 1 {
+			return nil, fmt.Errorf("unexpected number of nodes %d", len(docNode.Content))
+		}
+
+		docs = append(docs, docNode.Content[0])
+	}
+}
+
+// composeOverlay merges the documents of the overlay into the documents of its base template.
+//
+// The documents without an `kind: Overlay` document are returned as is.
+// Bases might be overlays themselves, visited is used to detect the cycles.
+func composeOverlay(fc models.FileContext, docs []*yaml.Node, visited []string) ([]*yaml.Node, error) {
+	var (
+		overlay *overlayDocument
+		patches = make([]*yaml.Node, 0, len(docs))
+	)
+
+	for _, doc := range docs {
+		kind, err := findKind(doc)
+		if err != nil {
+			return nil, fmt.Errorf("error in document at line %d:%d: %w", doc.Line, doc.Column, err)
+		}
+
+		if kind != kindOverlay {
+			patches = append(patches, doc)
+
+			continue
+		}
+
+		if overlay != nil {
+			return nil, fmt.Errorf("error in document at line %d:%d: template should contain at most 1 overlay", doc.Line, doc.Column)
+		}
+
+		overlay = &overlayDocument{}
+
+		if err = decodeStrict(doc, overlay); err != nil {
+			return nil, fmt.Errorf("error decoding document at line %d:%d: %w", doc.Line, doc.Column, err)
+		}
+
+		if overlay.Base == "" {
+			return nil, fmt.Errorf("error in document at line %d:%d: overlay base is required", doc.Line, doc.Column)
+		}
+	}
+
+	if overlay == nil {
+		return docs, nil
+	}
+
+	basePath := filepath.Clean(overlay.Base)
+
+	if slices.Contains(visited, basePath) {
+		return nil, fmt.Errorf("overlay base cycle detected: %q", append(visited, basePath))
+	}
+
+	raw, err := fc.ReadFile(basePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read overlay base %q: %w", basePath, err)
+	}
+
+	baseDocs, err := decodeDocuments(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("error in overlay base %q: %w", basePath, err)
+	}
+
+	// the relative paths of the base are relative to its directory, make them relative to the overlay
+	for _, doc := range baseDocs {
+		rebasePaths(doc, filepath.Dir(basePath))
+	}
+
+	baseDocs, err = composeOverlay(fc, baseDocs, append(visited, basePath))
+	if err != nil {
+		return nil, fmt.Errorf("error in overlay base %q: %w", basePath, err)
+	}
+
+	for _, patch := range patches {
+		key, err := documentKey(patch)
+		if err != nil {
+			return nil, fmt.Errorf("error in document at line %d:%d: %w", patch.Line, patch.Column, err)
+		}
+
+		index := slices.IndexFunc(baseDocs, func(doc *yaml.Node) bool {
+			baseKey, keyErr := documentKey(doc)
+
+			return keyErr == nil && baseKey == key
+		})
+
+		if index == -1 {
+			baseDocs = append(baseDocs, patch)
+
+			continue
+		}
+
+		mergeNodes(baseDocs[index], patch)
+	}
+
+	return baseDocs, nil
+}
+
+// documentKey identifies the document when merging an overlay into the base.
+//
+// The documents which are unique in the template are identified by their kind, others by their kind and name.
+func documentKey(doc *yaml.Node) (string, error) {
+	kind, err := findKind(doc)
+	if err != nil {
+		return "", err
+	}
+
+	switch kind {
+	case models.KindWorkers, models.KindMachine:
+		var name string
+
+		if nameNode := mappingValue(doc, "name"); nameNode != nil {
+			name = nameNode.Value
+		}
+
+		if kind == models.KindWorkers && name == "" {
+			name = omni.DefaultWorkersIDSuffix
+		}
+
+		return kind + "/" + name, nil
+	default:
+		return kind, nil
+	}
+}
+
+// mergeNodes deeply merges the overlay node into the base node.
+//
+// Mappings are merged key by key, and a null overlay value removes the key from the base.
+// Sequences of mappings which all have a name are merged by the name, other sequences and scalars are replaced.
+func mergeNodes(base, overlay *yaml.Node) {
+	switch {
+	case base.Kind == yaml.MappingNode && overlay.Kind == yaml.MappingNode:
+		for i := 0; i+1 < len(overlay.Content); i += 2 {
+			key, value := overlay.Content[i], overlay.Content[i+1]
+
+			index := mappingIndex(base, key.Value)
+
+			switch {
+			case value.Kind == yaml.ScalarNode && value.ShortTag() == "!!null":
+				if index != -1 {
+					base.Content = slices.Delete(base.Content, index, index+2)
+				}
+			case index == -1:
+				base.Content = append(base.Content, key, value)
+			default:
+				mergeNodes(base.Content[index+1], value)
+			}
+		}
+	case base.Kind == yaml.SequenceNode && overlay.Kind == yaml.SequenceNode && len(overlay.Content) > 0 && namedItems(base) && namedItems(overlay):
+		for _, item := range overlay.Content {
+			name := mappingValue(item, "name").Value
+
+			index := slices.IndexFunc(base.Content, func(baseItem *yaml.Node) bool {
+				return mappingValue(baseItem, "name").Value == name
+			})
+
+			if index == -1 {
+				base.Content = append(base.Content, item)
+
+				continue
+			}
+
+			mergeNodes(base.Content[index], item)
+		}
+	default:
+		*base = *overlay
+	}
+}
+
+// namedItems checks whether all items of the sequence are mappings with a name.
+func namedItems(node *yaml.Node) bool {
+	for _, item := range node.Content {
+		if nameNode := mappingValue(item, "name"); nameNode == nil || nameNode.Kind != yaml.ScalarNode {
+			return false
+		}
+	}
+
+	return true
+}
+
+// rebasePaths prefixes the relative file paths in the document with dir.
+//
+// The inline content, Helm values and variable defaults are left untouched, as they are not a part of the template.
+func rebasePaths(node *yaml.Node, dir string) {
+	if node.Kind == yaml.SequenceNode {
+		for _, item := range node.Content {
+			rebasePaths(item, dir)
+		}
+
+		return
+	}
+
+	if node.Kind != yaml.MappingNode {
+		return
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i].Value, node.Content[i+1]
+
+		switch {
+		case key == "inline" || key == "values" || key == "default":
+		case key == "file" || key == "base" || (key == "chart" && mappingValue(node, "repository") == nil):
+			if value.Kind != yaml.ScalarNode || value.Value == "" || filepath.IsAbs(value.Value) {
+				continue
+			}
+
+			// the names default to the file paths, keep them stable, so that the IDs of the resources don't change
+			if key == "file" && mappingValue(node, "name") == nil {
+				node.Content = append(node.Content,
+					&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "name"},
+					&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value.Value},
+				)
+			}
+
+			value.Value = filepath.Join(dir, value.Value)
+		default:
+			rebasePaths(value, dir)
+		}
+	}
+}
+
+func mappingIndex(node *yaml.Node, key string) int {
+	if node.Kind != yaml.MappingNode {
+		return -1
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	index := mappingIndex(node, key)
+	if index == -1 {
+		return nil
+	}
+
+	return node.Content[index+1]
+}
@@ -0,0 +1,108 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package template_test
+
+import (
+	"bytes"
+	_ "embed"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/template"
+)
+
+//go:embed testdata/cluster-overlay.yaml
+var clusterOverlay []byte
+
+func TestOverlay(t *testing.T) {
+	t.Chdir("testdata")
+
+	templ, err := template.Load(bytes.NewReader(clusterOverlay), template.WithRoot(openTestRoot(t)), template.WithValues(map[string]any{
+		"clusterName":   "omni",
+		"controlPlanes": []string{"430d882a-51a8-48b3-ae00-90c5b0b5b0b0"},
+	}))
+	require.NoError(t, err)
+
+	require.NoError(t, templ.Validate())
+
+	resources, err := templ.Translate()
+	require.NoError(t, err)
+
+	// the overlay overrides the cluster name and the variable default
+	cluster := findResource[*omni.Cluster](t, resources, omni.ClusterType, "omni-production")
+	assert.Equal(t, "1.6.0", cluster.TypedSpec().Value.TalosVersion)
+	assert.True(t, cluster.TypedSpec().Value.Features.DiskEncryption)
+
+	// the base patch file is resolved relative to the base, but keeps its name
+	patch := findResource[*omni.ConfigPatch](t, resources, omni.ConfigPatchType, "200-cluster-omni-production-patches/cluster-patch.yaml")
+
+	data, err := patch.TypedSpec().Value.GetUncompressedData()
+	require.NoError(t, err)
+
+	assert.Contains(t, string(data.Data()), "allowSchedulingOnControlPlanes: true")
+
+	data.Free()
+
+	// the patches with the same name are merged
+	patch = findResource[*omni.ConfigPatch](t, resources, omni.ConfigPatchType, "400-omni-production-control-planes-kubespan-enabled")
+
+	data, err = patch.TypedSpec().Value.GetUncompressedData()
+	require.NoError(t, err)
+
+	assert.Contains(t, string(data.Data()), "enabled: false")
+
+	data.Free()
+
+	// the workers of the base are kept, and the new ones are added
+	findResource[*omni.MachineSet](t, resources, omni.MachineSetType, "omni-production-workers")
+	findResource[*omni.MachineSet](t, resources, omni.MachineSetType, "omni-production-gpu")
+	findResource[*omni.MachineSetNode](t, resources, omni.MachineSetNodeType, "430d882a-51a8-48b3-ae00-90c5b0b5b0b0")
+}
+
+func TestOverlayErrors(t *testing.T) {
+	dir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.yaml"), []byte("kind: Overlay\nbase: b.yaml\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.yaml"), []byte("kind: Overlay\nbase: a.yaml\n"), 0o644))
+
+	t.Chdir(dir)
+
+	for _, tt := range []struct {
+		name          string
+		data          string
+		expectedError string
+	}{
+		{
+			name:          "cycle",
+			data:          "kind: Overlay\nbase: a.yaml\n",
+			expectedError: "overlay base cycle detected",
+		},
+		{
+			name:          "missing base",
+			data:          "kind: Overlay\nbase: missing.yaml\n",
+			expectedError: `failed to read overlay base "missing.yaml"`,
+		},
+		{
+			name:          "no base",
+			data:          "kind: Overlay\n",
+			expectedError: "overlay base is required",
+		},
+		{
+			name:          "multiple overlays",
+			data:          "kind: Overlay\nbase: a.yaml\n---\nkind: Overlay\nbase: b.yaml\n",
+			expectedError: "template should contain at most 1 overlay",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := template.Load(bytes.NewReader([]byte(tt.data)))
+			require.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+}
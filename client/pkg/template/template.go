@@ -6,7 +6,6 @@
 package template
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -35,10 +34,32 @@ func WithRoot(root *os.Root) Option {
 	}
 }
 
+// Reader is the part of the state used to compute the sync of the template.
+//
+// It is implemented both by the state.State and by the controller runtime.
+type Reader interface {
+	Get(ctx context.Context, ptr resource.Pointer, opts ...state.GetOption) (resource.Resource, error)
+	List(ctx context.Context, kind resource.Kind, opts ...state.ListOption) (resource.List, error)
+}
+
+// WithoutFileAccess disables the access to the files referenced by the template.
+//
+// It is used for the templates which don't come from the local file system.
+func WithoutFileAccess() Option {
+	return func(t *Template) {
+		t.fc.NoFileAccess = true
+	}
+}
+
 // Template is a cluster template.
 type Template struct {
 	fc     models.FileContext
-	models models.List
+	values map[string]*yaml.Node
+	// rawValues are the names of the values which are set from the strings, and are parsed according to the variable type
+	rawValues    map[string]struct{}
+	variablesErr error
+	models       models.List
+	valueErrs    []error
 }
 
 // named is implemented by readers backed by a path-like name (e.g. *os.File).
@@ -65,67 +86,71 @@ func dirFromReader(input io.Reader) (string, error) {
 // When input is an *os.File, relative file paths in the template are resolved
 // against the directory containing that file. Otherwise, paths are resolved
 // against the current working directory.
+//
+// If the template is an overlay, it is merged into its base template first.
+// Then the references to the template variables are substituted with their values.
+// The errors in the variables are reported by Validate.
 func Load(input io.Reader, opts ...Option) (*Template, error) {
-	dec := yaml.NewDecoder(input)
-
 	dir, err := dirFromReader(input)
 	if err != nil {
 		return nil, fmt.Errorf("error determining directory from input: %w", err)
 	}
 
 	template := Template{
-		fc: models.FileContext{Dir: dir},
+		fc:        models.FileContext{Dir: dir},
+		values:    map[string]*yaml.Node{},
+		rawValues: map[string]struct{}{},
 	}
 
 	for _, opt := range opts {
 		opt(&template)
 	}
 
-	for {
-		var docNode yaml.Node
+	docs, err := decodeDocuments(input)
+	if err != nil {
+		return nil, err
+	}
 
-		if err := dec.Decode(&docNode); err != nil {
-			if errors.Is(err, io.EOF) {
-				return &template, nil
-			}
+	docs, err = composeOverlay(template.fc, docs, nil)
+	if err != nil {
+		return nil, err
+	}
 
-			return nil, fmt.Errorf("error decoding template: %w", err)
-		}
+	docs, values, err := template.resolveVariables(docs)
+	if err != nil {
+		return nil, err
+	}
 
-		if docNode.Kind != yaml.DocumentNode {
-			return nil, fmt.Errorf("unexpected node kind %v", docNode.Kind)
+	if template.variablesErr == nil {
+		for _, doc := range docs {
+			template.variablesErr = errors.Join(template.variablesErr, substituteVariables(doc, values))
 		}
+	}
 
-		if len(docNode.Content) != 1 {
-			return nil, fmt.Errorf("unexpected number of nodes %d", len(docNode.Content))
-		}
+	// the models are not decoded, as the documents with unresolved references are not valid
+	if template.variablesErr != nil {
+		return &template, nil
+	}
 
-		kind, err := findKind(docNode.Content[0])
+	for _, doc := range docs {
+		kind, err := findKind(doc)
 		if err != nil {
-			return nil, fmt.Errorf("error in document at line %d:%d: %w", docNode.Line, docNode.Column, err)
+			return nil, fmt.Errorf("error in document at line %d:%d: %w", doc.Line, doc.Column, err)
 		}
 
 		model, err := models.New(kind)
 		if err != nil {
-			return nil, fmt.Errorf("error in document at line %d:%d: %w", docNode.Line, docNode.Column, err)
-		}
-
-		// YAML decoder doesn't allow to decode with KnownFields: true from a Node
-		// so we do a roundtrip to bytes and back :sigh:
-		raw, err := yaml.Marshal(docNode.Content[0])
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling document at line %d:%d: %w", docNode.Line, docNode.Column, err)
+			return nil, fmt.Errorf("error in document at line %d:%d: %w", doc.Line, doc.Column, err)
 		}
 
-		documentDecoder := yaml.NewDecoder(bytes.NewReader(raw))
-		documentDecoder.KnownFields(true)
-
-		if err = documentDecoder.Decode(model); err != nil {
-			return nil, fmt.Errorf("error decoding document at line %d:%d: %w", docNode.Line, docNode.Column, err)
+		if err = decodeStrict(doc, model); err != nil {
+			return nil, fmt.Errorf("error decoding document at line %d:%d: %w", doc.Line, doc.Column, err)
 		}
 
 		template.models = append(template.models, model)
 	}
+
+	return &template, nil
 }
 
 func findKind(node *yaml.Node) (string, error) {
@@ -172,7 +197,13 @@ func WithCluster(clusterName string) *Template {
 }
 
 // Validate the template.
+//
+// The variables are validated first, as the models are not loaded if there are unset or mistyped variables.
 func (t *Template) Validate() error {
+	if t.variablesErr != nil {
+		return fmt.Errorf("invalid template variables: %w", t.variablesErr)
+	}
+
 	return t.models.Validate(models.ValidateOptions{
 		FileContext: t.fc,
 	})
@@ -180,19 +211,27 @@ func (t *Template) Validate() error {
 
 // Translate the template into resources.
 func (t *Template) Translate() ([]resource.Resource, error) {
+	if t.variablesErr != nil {
+		return nil, fmt.Errorf("invalid template variables: %w", t.variablesErr)
+	}
+
 	return t.models.Translate(t.fc)
 }
 
 // ClusterName returns the name of the cluster associated with the template.
 func (t *Template) ClusterName() (string, error) {
+	if t.variablesErr != nil {
+		return "", fmt.Errorf("invalid template variables: %w", t.variablesErr)
+	}
+
 	return t.models.ClusterName()
 }
 
 // actualResources returns a list of resources in the state related to the cluster template.
 //
 //nolint:gocognit
-func (t *Template) actualResources(ctx context.Context, st state.State, expectedResources []resource.Resource) ([]resource.Resource, error) {
-	clusterName, err := t.models.ClusterName()
+func (t *Template) actualResources(ctx context.Context, st Reader, expectedResources []resource.Resource) ([]resource.Resource, error) {
+	clusterName, err := t.ClusterName()
 	if err != nil {
 		return nil, err
 	}
@@ -300,13 +339,13 @@ func splitResourcesToDelete(toDelete []resource.Resource) [][]resource.Resource
 }
 
 // Delete returns a sync result which lists what needs to be deleted from state to remove template from the cluster.
-func (t *Template) Delete(ctx context.Context, st state.State) (*SyncResult, error) {
+func (t *Template) Delete(ctx context.Context, st Reader) (*SyncResult, error) {
 	actualResources, err := t.actualResources(ctx, st, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	clusterName, err := t.models.ClusterName()
+	clusterName, err := t.ClusterName()
 	if err != nil {
 		return nil, err
 	}
@@ -341,8 +380,8 @@ func metadataKey(md resource.Metadata) string {
 }
 
 // Sync the template against the resource state.
-func (t *Template) Sync(ctx context.Context, st state.State) (*SyncResult, error) {
-	clusterName, err := t.models.ClusterName()
+func (t *Template) Sync(ctx context.Context, st Reader) (*SyncResult, error) {
+	clusterName, err := t.ClusterName()
 	if err != nil {
 		return nil, err
 	}
@@ -382,6 +421,8 @@ func (t *Template) Sync(ctx context.Context, st state.State) (*SyncResult, error
 			expectedResource.Metadata().SetCreated(actualResource.Metadata().Created())
 			expectedResource.Metadata().Finalizers().Set(*actualResource.Metadata().Finalizers())
 
+			keepAutoscaledMachineCount(actualResource, expectedResource)
+
 			if !resource.Equal(actualResource, expectedResource) {
 				syncResult.Update = append(syncResult.Update, UpdateChange{Old: actualResource, New: expectedResource})
 			}
@@ -419,6 +460,38 @@ func (t *Template) Sync(ctx context.Context, st state.State) (*SyncResult, error
 	return &syncResult, nil
 }
 
+// keepAutoscaledMachineCount keeps the machine count of the machine set which is managed by the cluster autoscaler,
+// as long as it stays within the autoscaling bounds of the template.
+func keepAutoscaledMachineCount(actualResource, expectedResource resource.Resource) {
+	actualMachineSet, ok := actualResource.(*omni.MachineSet)
+	if !ok {
+		return
+	}
+
+	expectedMachineSet, ok := expectedResource.(*omni.MachineSet)
+	if !ok {
+		return
+	}
+
+	actualAllocation := omni.GetMachineAllocation(actualMachineSet)
+	expectedAllocation := omni.GetMachineAllocation(expectedMachineSet)
+
+	if actualAllocation == nil || expectedAllocation == nil || actualAllocation.Name != expectedAllocation.Name {
+		return
+	}
+
+	autoscaling := expectedAllocation.GetAutoscaling()
+	if autoscaling == nil || actualAllocation.GetAllocationType() != expectedAllocation.GetAllocationType() {
+		return
+	}
+
+	count := actualAllocation.GetMachineCount()
+
+	if count >= autoscaling.GetMinMachineCount() && count <= autoscaling.GetMaxMachineCount() {
+		expectedAllocation.MachineCount = count
+	}
+}
+
 func getOperationForNoMoreExpectedResource(actualResource resource.Resource, clusterName string, expectedMachineSetNodeIDs map[resource.ID]struct{}) (
 	update *UpdateChange, destroy resource.Resource, err error,
 ) {
@@ -466,7 +539,7 @@ func getOperationForNoMoreExpectedResource(actualResource resource.Resource, clu
 }
 
 // validateNoResourceConflictOnCreate checks that creating expectedResource will not conflict with existing resources in the state.
-func validateNoResourceConflictOnCreate(ctx context.Context, st state.State, expectedResource resource.Resource) error {
+func validateNoResourceConflictOnCreate(ctx context.Context, st Reader, expectedResource resource.Resource) error {
 	switch expectedResource.Metadata().Type() {
 	case omni.KernelArgsType, omni.MachineInstallDiskConfigType: // no cluster relation, nothing to check
 		return nil
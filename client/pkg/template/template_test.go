@@ -17,6 +17,7 @@ import (
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
 	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
@@ -47,6 +48,9 @@ var clusterWithKernelArgs []byte
 //go:embed testdata/cluster-with-manifests.yaml
 var clusterWithManifests []byte
 
+//go:embed testdata/cluster-with-helm.yaml
+var clusterWithHelm []byte
+
 //go:embed testdata/cluster-with-healthchecks.yaml
 var clusterWithKubernetesHealthChecks []byte
 
@@ -89,6 +93,18 @@ var clusterInvalid4 []byte
 //go:embed testdata/cluster-invalid-bootstrapspec.yaml
 var clusterInvalidBootstrapSpec []byte
 
+//go:embed testdata/cluster-invalid-canary.yaml
+var clusterInvalidCanary []byte
+
+//go:embed testdata/cluster-invalid-autoscaling.yaml
+var clusterInvalidAutoscaling []byte
+
+//go:embed testdata/cluster-invalid-backup-retention.yaml
+var clusterInvalidBackupRetention []byte
+
+//go:embed testdata/cluster-invalid-secret-rotation.yaml
+var clusterInvalidSecretRotation []byte
+
 //go:embed testdata/cluster1-resources.yaml
 var cluster1Resources []byte
 
@@ -107,6 +123,9 @@ var clusterWithKernelArgsResources []byte
 //go:embed testdata/cluster-with-manifests-resources.yaml
 var clusterWithManifestsResources []byte
 
+//go:embed testdata/cluster-with-helm-resources.yaml
+var clusterWithHelmResources []byte
+
 // clusterWithParentDirPatch is a minimal template that references a patch file outside
 // the testdata directory, at client/pkg/testdata/parent-patch.yaml.
 var clusterWithParentDirPatch = []byte(`kind: Cluster
@@ -145,6 +164,10 @@ func TestLoad(t *testing.T) {
 			name: "clusterWithManifests",
 			data: clusterWithManifests,
 		},
+		{
+			name: "clusterWithHelm",
+			data: clusterWithHelm,
+		},
 		{
 			name: "clusterWithKubernetesHealthChecks",
 			data: clusterWithKubernetesHealthChecks,
@@ -213,6 +236,10 @@ func TestValidate(t *testing.T) {
 			name: "clusterWithManifests",
 			data: clusterWithManifests,
 		},
+		{
+			name: "clusterWithHelm",
+			data: clusterWithHelm,
+		},
 		{
 			name: "clusterWithKubernetesHealthChecks",
 			data: clusterWithKubernetesHealthChecks,
@@ -360,6 +387,41 @@ machine:
 	* workers is invalid: 1 error occurred:
 	* bootstrapSpec is not allowed in workers`,
 		},
+		{
+			name: "clusterInvalidCanary",
+			data: clusterInvalidCanary,
+			expectedError: `1 error occurred:
+	* workers is invalid: 2 errors occurred:
+	* updateStrategy: canary config requires the strategy type to be Canary
+	* deleteStrategy: canary strategy is not supported`,
+		},
+		{
+			name: "clusterInvalidAutoscaling",
+			data: clusterInvalidAutoscaling,
+			expectedError: `2 errors occurred:
+	* controlplane is invalid: 1 error occurred:
+	* autoscaling is not allowed in the controlplane
+
+
+	* workers is invalid: 2 errors occurred:
+	* autoscaling requires a static machine class size
+	* autoscaling min 3 is greater than max 2`,
+		},
+		{
+			name: "clusterInvalidBackupRetention",
+			data: clusterInvalidBackupRetention,
+			expectedError: `1 error occurred:
+	* error validating cluster "my-first-cluster": 1 error occurred:
+	* backup retention requires the backup interval to be set`,
+		},
+		{
+			name: "clusterInvalidSecretRotation",
+			data: clusterInvalidSecretRotation,
+			expectedError: `1 error occurred:
+	* error validating cluster "my-first-cluster": 2 errors occurred:
+	* secret rotation interval must be at least 24h0m0s, actual 1h0m0s
+	* duplicate secret rotation component "talos-ca"`,
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			templ, err := template.Load(bytes.NewReader(tt.data), template.WithRoot(root))
@@ -437,6 +499,11 @@ func TestTranslate(t *testing.T) {
 			template: clusterWithManifests,
 			expected: clusterWithManifestsResources,
 		},
+		{
+			name:     "clusterWithHelm",
+			template: clusterWithHelm,
+			expected: clusterWithHelmResources,
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			templ, err := template.Load(bytes.NewReader(tt.template), template.WithRoot(root))
@@ -588,6 +655,74 @@ func TestSync(t *testing.T) {
 	}, xslices.Map(sync2.Create, resource.String))
 }
 
+func TestSync_AutoscaledMachineCount(t *testing.T) {
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+	ctx := t.Context()
+
+	syncTemplate := func(minCount, maxCount int) *template.SyncResult {
+		templ, err := template.Load(strings.NewReader(fmt.Sprintf(`kind: Cluster
+name: autoscaled
+kubernetes:
+  version: v1.30.0
+talos:
+  version: v1.7.0
+---
+kind: ControlPlane
+machineClass:
+  name: control-planes
+  size: 1
+---
+kind: Workers
+machineClass:
+  name: workers
+  size: 2
+  autoscaling:
+    min: %d
+    max: %d
+`, minCount, maxCount)), template.WithoutFileAccess())
+		require.NoError(t, err)
+
+		require.NoError(t, templ.Validate())
+
+		syncRes, err := templ.Sync(ctx, st)
+		require.NoError(t, err)
+
+		for _, r := range syncRes.Create {
+			require.NoError(t, st.Create(ctx, r))
+		}
+
+		return syncRes
+	}
+
+	syncTemplate(1, 5)
+
+	// the cluster autoscaler scales the machine set up
+	_, err := safe.StateUpdateWithConflicts(ctx, st, omni.NewMachineSet(omni.WorkersResourceID("autoscaled")).Metadata(), func(res *omni.MachineSet) error {
+		allocation := omni.GetMachineAllocation(res)
+
+		require.EqualValues(t, 2, allocation.GetMachineCount())
+
+		allocation.MachineCount = 4
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	// the sync keeps the count set by the cluster autoscaler
+	syncRes := syncTemplate(1, 5)
+	assert.Empty(t, syncRes.Update)
+
+	// unless it is out of the new bounds
+	syncRes = syncTemplate(1, 3)
+	require.Len(t, syncRes.Update, 1)
+
+	updated, ok := syncRes.Update[0].New.(*omni.MachineSet)
+	require.True(t, ok)
+
+	assert.EqualValues(t, 2, omni.GetMachineAllocation(updated).GetMachineCount())
+	assert.EqualValues(t, 3, omni.GetMachineAllocation(updated).GetAutoscaling().GetMaxMachineCount())
+}
+
 func TestSync_KubernetesHealthChecks(t *testing.T) {
 	t.Chdir("testdata")
 
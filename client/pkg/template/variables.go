@@ -0,0 +1,332 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package template
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"go.yaml.in/yaml/v4"
+)
+
+// kindVariables is the kind of the document which declares the template variables.
+const kindVariables = "Variables"
+
+// Variable types.
+const (
+	variableTypeString = "string"
+	variableTypeInt    = "int"
+	variableTypeNumber = "number"
+	variableTypeBool   = "bool"
+	variableTypeList   = "list"
+	variableTypeMap    = "map"
+)
+
+// variableReference matches `${{ name }}` references to the variables, `$${{ name }}` is an escaped literal `${{ name }}`.
+var variableReference = regexp.MustCompile(`\$?\$\{\{\s*([A-Za-z_][A-Za-z0-9_-]*)\s*\}\}`)
+
+// variable declares a template variable.
+type variable struct {
+	Default     yaml.Node `yaml:"default,omitempty"`
+	Type        string    `yaml:"type"`
+	Description string    `yaml:"description,omitempty"`
+}
+
+// variablesDocument is the `kind: Variables` document of the template.
+type variablesDocument struct {
+	Variables map[string]variable `yaml:"variables"`
+	Kind      string              `yaml:"kind"`
+}
+
+// WithValues sets the values of the template variables.
+//
+// The values are checked against the declared types of the variables, so they should be decoded from YAML or JSON.
+// The values set by the later options take precedence.
+func WithValues(values map[string]any) Option {
+	return func(t *Template) {
+		for name, value := range values {
+			var node yaml.Node
+
+			if err := node.Encode(value); err != nil {
+				t.valueErrs = append(t.valueErrs, fmt.Errorf("failed to encode the value of variable %q: %w", name, err))
+
+				continue
+			}
+
+			t.values[name] = &node
+			delete(t.rawValues, name)
+		}
+	}
+}
+
+// WithStringValues sets the values of the template variables from their string representation (e.g. `--set name=value`).
+//
+// The string values of the variables of type string are used as is, other values are parsed as YAML.
+// The values set by the later options take precedence.
+func WithStringValues(values map[string]string) Option {
+	return func(t *Template) {
+		for name, value := range values {
+			t.values[name] = &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+			t.rawValues[name] = struct{}{}
+		}
+	}
+}
+
+// ParseValues parses the values of the template variables from a YAML document.
+func ParseValues(data []byte) (map[string]any, error) {
+	var values map[string]any
+
+	if err := yaml.Unmarshal(data, &values); err != nil {
+		return nil, fmt.Errorf("error decoding values: %w", err)
+	}
+
+	return values, nil
+}
+
+// ParseStringValues parses the values of the template variables in the `name=value` format.
+func ParseStringValues(pairs []string) (map[string]string, error) {
+	values := make(map[string]string, len(pairs))
+
+	for _, pair := range pairs {
+		name, value, ok := strings.Cut(pair, "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid value %q, expected name=value", pair)
+		}
+
+		values[name] = value
+	}
+
+	return values, nil
+}
+
+// resolveVariables merges the variable declarations of the documents, and resolves the values of the variables.
+//
+// The declaration documents are removed from the returned documents.
+// The errors in the values of the variables are recorded in the template to be reported by Validate.
+func (t *Template) resolveVariables(docs []*yaml.Node) ([]*yaml.Node, map[string]*yaml.Node, error) {
+	declarations := map[string]variable{}
+	remaining := make([]*yaml.Node, 0, len(docs))
+
+	for _, doc := range docs {
+		kind, err := findKind(doc)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error in document at line %d:%d: %w", doc.Line, doc.Column, err)
+		}
+
+		if kind != kindVariables {
+			remaining = append(remaining, doc)
+
+			continue
+		}
+
+		var decl variablesDocument
+
+		if err = decodeStrict(doc, &decl); err != nil {
+			return nil, nil, fmt.Errorf("error decoding document at line %d:%d: %w", doc.Line, doc.Column, err)
+		}
+
+		maps.Copy(declarations, decl.Variables)
+	}
+
+	errs := errors.Join(t.valueErrs...)
+	resolved := make(map[string]*yaml.Node, len(declarations))
+
+	for _, name := range slices.Sorted(maps.Keys(t.values)) {
+		if _, declared := declarations[name]; !declared {
+			errs = errors.Join(errs, fmt.Errorf("value is set for the undeclared variable %q", name))
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(declarations)) {
+		value, err := t.variableValue(name, declarations[name])
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("variable %q: %w", name, err))
+
+			continue
+		}
+
+		resolved[name] = value
+	}
+
+	t.variablesErr = errs
+
+	return remaining, resolved, nil
+}
+
+// variableValue returns the value of the variable: the value set via the options, or the default one.
+func (t *Template) variableValue(name string, decl variable) (*yaml.Node, error) {
+	switch decl.Type {
+	case variableTypeString, variableTypeInt, variableTypeNumber, variableTypeBool, variableTypeList, variableTypeMap:
+	default:
+		return nil, fmt.Errorf("unsupported type %q", decl.Type)
+	}
+
+	value, ok := t.values[name]
+	if !ok {
+		if decl.Default.IsZero() || decl.Default.ShortTag() == "!!null" {
+			return nil, errors.New("value is not set")
+		}
+
+		if err := checkType(decl.Type, &decl.Default); err != nil {
+			return nil, fmt.Errorf("invalid default: %w", err)
+		}
+
+		return &decl.Default, nil
+	}
+
+	if _, raw := t.rawValues[name]; raw && decl.Type != variableTypeString {
+		var parsed yaml.Node
+
+		if err := yaml.Unmarshal([]byte(value.Value), &parsed); err != nil || len(parsed.Content) != 1 {
+			return nil, fmt.Errorf("failed to parse value %q as %s", value.Value, decl.Type)
+		}
+
+		value = parsed.Content[0]
+	}
+
+	if err := checkType(decl.Type, value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// checkType checks that the value node matches the variable type.
+func checkType(typ string, node *yaml.Node) error {
+	var actual string
+
+	switch node.Kind { //nolint:exhaustive
+	case yaml.SequenceNode:
+		actual = variableTypeList
+	case yaml.MappingNode:
+		actual = variableTypeMap
+	case yaml.ScalarNode:
+		switch node.ShortTag() {
+		case "!!str":
+			actual = variableTypeString
+		case "!!int":
+			actual = variableTypeInt
+		case "!!float":
+			actual = variableTypeNumber
+		case "!!bool":
+			actual = variableTypeBool
+		case "!!null":
+			actual = "null"
+		}
+	}
+
+	if actual == typ || (typ == variableTypeNumber && actual == variableTypeInt) {
+		return nil
+	}
+
+	if actual == "" {
+		actual = "unsupported value"
+	}
+
+	return fmt.Errorf("expected %s, got %s", typ, actual)
+}
+
+// substituteVariables replaces the references to the variables in the string scalars of the node tree.
+//
+// The scalar which consists of a single reference is replaced with the value as is, preserving its type,
+// otherwise the references are interpolated into the string.
+func substituteVariables(node *yaml.Node, values map[string]*yaml.Node) error {
+	var errs error
+
+	switch node.Kind { //nolint:exhaustive
+	case yaml.DocumentNode, yaml.SequenceNode:
+		for _, child := range node.Content {
+			errs = errors.Join(errs, substituteVariables(child, values))
+		}
+	case yaml.MappingNode:
+		// the keys are never substituted
+		for i := 1; i < len(node.Content); i += 2 {
+			errs = errors.Join(errs, substituteVariables(node.Content[i], values))
+		}
+	case yaml.ScalarNode:
+		if node.ShortTag() != "!!str" || !strings.Contains(node.Value, "${{") {
+			return nil
+		}
+
+		if match := variableReference.FindStringSubmatch(strings.TrimSpace(node.Value)); match != nil &&
+			match[0] == strings.TrimSpace(node.Value) && !strings.HasPrefix(match[0], "$$") {
+			value, err := lookupVariable(match[1], values, node)
+			if err != nil {
+				return err
+			}
+
+			line, column := node.Line, node.Column
+			*node = *cloneNode(value)
+			node.Line, node.Column = line, column
+
+			return nil
+		}
+
+		node.Value = variableReference.ReplaceAllStringFunc(node.Value, func(reference string) string {
+			if strings.HasPrefix(reference, "$$") {
+				return reference[1:]
+			}
+
+			value, err := lookupVariable(variableReference.FindStringSubmatch(reference)[1], values, node)
+			if err != nil {
+				errs = errors.Join(errs, err)
+
+				return reference
+			}
+
+			if value.Kind != yaml.ScalarNode {
+				errs = errors.Join(errs, fmt.Errorf("line %d: variable %q can't be interpolated into a string, as it is not a scalar",
+					node.Line, variableReference.FindStringSubmatch(reference)[1]))
+
+				return reference
+			}
+
+			return value.Value
+		})
+	}
+
+	return errs
+}
+
+func lookupVariable(name string, values map[string]*yaml.Node, node *yaml.Node) (*yaml.Node, error) {
+	value, ok := values[name]
+	if !ok {
+		return nil, fmt.Errorf("line %d: variable %q is not declared or has no value", node.Line, name)
+	}
+
+	return value, nil
+}
+
+// cloneNode returns a deep copy of the node, so that the substituted values are not shared between the documents.
+func cloneNode(node *yaml.Node) *yaml.Node {
+	clone := *node
+	clone.Content = make([]*yaml.Node, 0, len(node.Content))
+
+	for _, child := range node.Content {
+		clone.Content = append(clone.Content, cloneNode(child))
+	}
+
+	return &clone
+}
+
+// decodeStrict decodes the node into out, failing on the unknown fields.
+func decodeStrict(node *yaml.Node, out any) error {
+	// YAML decoder doesn't allow to decode with KnownFields: true from a Node
+	// so we do a roundtrip to bytes and back :sigh:
+	raw, err := yaml.Marshal(node)
+	if err != nil {
+		return err
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(raw))
+	decoder.KnownFields(true)
+
+	return decoder.Decode(out)
+}
@@ -0,0 +1,178 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package template_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/template"
+)
+
+var clusterWithVariables = []byte(`kind: Variables
+variables:
+  clusterName:
+    type: string
+  workers:
+    type: list
+    default: [430d882a-51a8-48b3-ab00-d4b5b0b5b0b0]
+  encryption:
+    type: bool
+    default: false
+---
+kind: Cluster
+name: ${{ clusterName }}
+kubernetes:
+  version: v1.30.1
+talos:
+  version: v1.7.0
+features:
+  diskEncryption: ${{ encryption }}
+patches:
+  - name: labels
+    inline:
+      machine:
+        nodeLabels:
+          cluster: prefix-${{ clusterName }}
+          literal: $${{ clusterName }}
+---
+kind: ControlPlane
+machines:
+  - 430d882a-51a8-48b3-ae00-90c5b0b5b0b0
+---
+kind: Workers
+machines: ${{ workers }}
+`)
+
+func TestVariables(t *testing.T) {
+	for _, tt := range []struct { //nolint:govet
+		name          string
+		opts          []template.Option
+		expectedError string
+	}{
+		{
+			name:          "unset",
+			expectedError: `variable "clusterName": value is not set`,
+		},
+		{
+			name: "set",
+			opts: []template.Option{template.WithValues(map[string]any{"clusterName": "omni"})},
+		},
+		{
+			name: "set from string",
+			opts: []template.Option{template.WithStringValues(map[string]string{"clusterName": "omni", "encryption": "true"})},
+		},
+		{
+			name: "mistyped",
+			opts: []template.Option{
+				template.WithValues(map[string]any{"clusterName": "omni", "workers": "430d882a-51a8-48b3-ab00-d4b5b0b5b0b0"}),
+			},
+			expectedError: `variable "workers": expected list, got string`,
+		},
+		{
+			name:          "mistyped from string",
+			opts:          []template.Option{template.WithStringValues(map[string]string{"clusterName": "omni", "encryption": "maybe"})},
+			expectedError: `variable "encryption": expected bool, got string`,
+		},
+		{
+			name:          "undeclared",
+			opts:          []template.Option{template.WithValues(map[string]any{"clusterName": "omni", "cluster": "omni"})},
+			expectedError: `value is set for the undeclared variable "cluster"`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			templ, err := template.Load(bytes.NewReader(clusterWithVariables), tt.opts...)
+			require.NoError(t, err)
+
+			err = templ.Validate()
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.ErrorContains(t, err, tt.expectedError)
+
+			_, err = templ.Translate()
+			require.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestVariablesSubstitution(t *testing.T) {
+	templ, err := template.Load(bytes.NewReader(clusterWithVariables), template.WithStringValues(map[string]string{
+		"clusterName": "omni",
+		"encryption":  "true",
+	}))
+	require.NoError(t, err)
+
+	require.NoError(t, templ.Validate())
+
+	resources, err := templ.Translate()
+	require.NoError(t, err)
+
+	cluster := findResource[*omni.Cluster](t, resources, omni.ClusterType, "omni")
+	assert.True(t, cluster.TypedSpec().Value.Features.DiskEncryption)
+
+	patch := findResource[*omni.ConfigPatch](t, resources, omni.ConfigPatchType, "200-cluster-omni-labels")
+
+	data, err := patch.TypedSpec().Value.GetUncompressedData()
+	require.NoError(t, err)
+
+	defer data.Free()
+
+	assert.Contains(t, string(data.Data()), "cluster: prefix-omni")
+	assert.Contains(t, string(data.Data()), "literal: ${{ clusterName }}")
+
+	findResource[*omni.MachineSetNode](t, resources, omni.MachineSetNodeType, "430d882a-51a8-48b3-ab00-d4b5b0b5b0b0")
+}
+
+func TestValuesParsing(t *testing.T) {
+	values, err := template.ParseValues([]byte("clusterName: omni\nworkers:\n  - a\n  - b\n"))
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]any{"clusterName": "omni", "workers": []any{"a", "b"}}, values)
+
+	stringValues, err := template.ParseStringValues([]string{"clusterName=omni", "extra=a=b"})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"clusterName": "omni", "extra": "a=b"}, stringValues)
+
+	_, err = template.ParseStringValues([]string{"clusterName"})
+	require.Error(t, err)
+}
+
+func findResource[T resource.Resource](t *testing.T, resources []resource.Resource, resourceType resource.Type, id resource.ID) T {
+	t.Helper()
+
+	for _, res := range resources {
+		if res.Metadata().Type() == resourceType && res.Metadata().ID() == id {
+			typed, ok := res.(T)
+			require.True(t, ok)
+
+			return typed
+		}
+	}
+
+	require.FailNow(t, "resource not found", "%s/%s in %s", resourceType, id, strings.Join(resourceIDs(resources), ", "))
+
+	panic("unreachable")
+}
+
+func resourceIDs(resources []resource.Resource) []string {
+	ids := make([]string, 0, len(resources))
+
+	for _, res := range resources {
+		ids = append(ids, res.Metadata().Type()+"/"+res.Metadata().ID())
+	}
+
+	return ids
+}
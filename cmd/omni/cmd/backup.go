@@ -0,0 +1,314 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+	"go.yaml.in/yaml/v4"
+
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/logging"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup/selfbackup"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup/store"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/migration"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/config"
+	"github.com/siderolabs/omni/internal/pkg/jsonschema"
+)
+
+// backupCmdFlags are the flags of the backup subcommands.
+type backupCmdFlags struct {
+	configPaths     []string
+	path            string
+	snapshot        string
+	storeConfigPath string
+	s3Bucket        string
+	s3Region        string
+	s3Endpoint      string
+	fromStore       bool
+}
+
+func buildBackupCommand(configSchema *jsonschema.Schema) *cobra.Command {
+	backupCmd := &cobra.Command{
+		Use:   "backup",
+		Short: "Back up and restore Omni's own state",
+		Long: `Back up and restore Omni's own state: the resources of the default storage and the secondary storage database.
+
+The backups are supported only with the etcd default storage. The resources are read from a single etcd revision.
+The backup is encrypted with the master key of the default storage, and the key storage of the master key is stored
+in the backup, so it can be restored by an instance configured with the private key of any of its slots: the private key
+of the default storage, or the private key of one of the public key files.
+Omni must not be running while the backup is created or restored.`,
+	}
+
+	var createFlags backupCmdFlags
+
+	createCmd := &cobra.Command{
+		Use:          "create",
+		Short:        "Create a backup of Omni's own state",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(*cobra.Command, []string) error {
+			return runBackupCommand(configSchema, &createFlags, createBackup)
+		},
+	}
+
+	var restoreFlags backupCmdFlags
+
+	restoreCmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restore Omni's own state from a backup",
+		Long: `Restore Omni's own state from a backup.
+
+The backup can only be restored into a fresh instance: the default storage must be empty and the secondary storage
+database must not exist. The migrations are run after the restore, so a backup of an older Omni version can be restored.
+
+The backup is read either from a file, or from the etcd backup store configured by the etcdBackup config. The store
+of a running Omni is configured in its state, so it is configured here by the flags instead: the GCS, Azure Blob and SFTP
+stores by the EtcdBackupStoreConfig resource file passed with --store-config, the same file which is applied with omnictl,
+and the S3 store by the --s3-* flags, the credentials are read from the AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and
+AWS_SESSION_TOKEN environment variables.`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(*cobra.Command, []string) error {
+			return runBackupCommand(configSchema, &restoreFlags, restoreBackup)
+		},
+	}
+
+	createCmd.Flags().StringArrayVar(&createFlags.configPaths, "config-path", nil, "config file(s) to load, can be specified multiple times, merged in order")
+	createCmd.Flags().StringVarP(&createFlags.path, "output", "o", "", "path to write the backup to")
+	createCmd.MarkFlagRequired("output") //nolint:errcheck
+
+	restoreCmd.Flags().StringArrayVar(&restoreFlags.configPaths, "config-path", nil, "config file(s) to load, can be specified multiple times, merged in order")
+	restoreCmd.Flags().StringVarP(&restoreFlags.path, "input", "i", "", "path to read the backup from")
+	restoreCmd.Flags().BoolVar(&restoreFlags.fromStore, "from-store", false, "read the backup from the configured etcd backup store")
+	restoreCmd.Flags().StringVar(&restoreFlags.snapshot, "snapshot", "", "name of the backup to read from the store, the latest one is used if not set")
+	restoreCmd.Flags().StringVar(&restoreFlags.storeConfigPath, "store-config", "", "path to the EtcdBackupStoreConfig resource file which selects the store, the S3 store is used if not set")
+	restoreCmd.Flags().StringVar(&restoreFlags.s3Bucket, "s3-bucket", "", "bucket of the S3 store")
+	restoreCmd.Flags().StringVar(&restoreFlags.s3Region, "s3-region", "", "region of the S3 store")
+	restoreCmd.Flags().StringVar(&restoreFlags.s3Endpoint, "s3-endpoint", "", "endpoint of the S3 store")
+	restoreCmd.MarkFlagsOneRequired("input", "from-store")
+	restoreCmd.MarkFlagsMutuallyExclusive("input", "from-store")
+
+	backupCmd.AddCommand(createCmd, restoreCmd)
+
+	return backupCmd
+}
+
+func runBackupCommand(
+	configSchema *jsonschema.Schema,
+	flags *backupCmdFlags,
+	run func(ctx context.Context, cfg *config.Params, logger *zap.Logger, flags *backupCmdFlags) error,
+) error {
+	cfg, err := loadConfig(configSchema, flags.configPaths, &config.Params{})
+	if err != nil {
+		return err
+	}
+
+	logger, err := buildLogger(cfg.Logs, false)
+	if err != nil {
+		return fmt.Errorf("failed to set up logging: %w", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	return run(actor.MarkContextAsInternalActor(ctx), cfg, logger, flags)
+}
+
+func createBackup(ctx context.Context, cfg *config.Params, logger *zap.Logger, flags *backupCmdFlags) (err error) {
+	path := flags.path
+
+	st, err := omni.NewState(ctx, cfg, logger, prometheus.NewRegistry())
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if closeErr := st.Close(); closeErr != nil {
+			logger.Error("failed to close the state gracefully", zap.Error(closeErr))
+		}
+	}()
+
+	key, err := st.SelfBackupKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to create the backup file: %w", err)
+	}
+
+	defer func() {
+		err = errors.Join(err, f.Close())
+
+		if err != nil {
+			os.Remove(path) //nolint:errcheck
+		}
+	}()
+
+	manifest, err := selfbackup.Create(ctx, st.SelfBackupSnapshot, st.SecondaryStorageDB(), f, key)
+	if err != nil {
+		return fmt.Errorf("failed to create the backup: %w", err)
+	}
+
+	logger.Info("backup created", zap.String("path", path), zap.Int("resources", manifest.Resources), zap.Uint64("db_version", manifest.DBVersion))
+
+	return nil
+}
+
+func restoreBackup(ctx context.Context, cfg *config.Params, logger *zap.Logger, flags *backupCmdFlags) error {
+	r, err := openBackup(ctx, cfg, logger, flags)
+	if err != nil {
+		return err
+	}
+
+	defer r.Close() //nolint:errcheck
+
+	var st *omni.State
+
+	defer func() {
+		if st == nil {
+			return
+		}
+
+		if closeErr := st.Close(); closeErr != nil {
+			logger.Error("failed to close the state gracefully", zap.Error(closeErr))
+		}
+	}()
+
+	manifest, err := selfbackup.Restore(ctx, r, omni.SelfBackupMasterKey(cfg, logger), cfg.Storage.Sqlite.GetPath(), func(ctx context.Context) (state.State, error) {
+		restoredState, openErr := omni.NewState(ctx, cfg, logger, prometheus.NewRegistry())
+		if openErr != nil {
+			return nil, openErr
+		}
+
+		st = restoredState
+
+		return st.Default(), nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to restore the backup: %w", err)
+	}
+
+	logger.Info("backup restored", zap.Time("created_at", manifest.CreatedAt), zap.String("omni_version", manifest.OmniVersion), zap.Int("resources", manifest.Resources))
+
+	// the restored resources are at the database version of the backup, bring them up to date
+	if _, err = migration.NewManager(st.Default(), logger.With(logging.Component("migration"))).Run(ctx); err != nil {
+		return fmt.Errorf("failed to run the migrations on the restored state: %w", err)
+	}
+
+	return nil
+}
+
+// openBackup opens the backup file, or downloads the backup from the configured etcd backup store.
+func openBackup(ctx context.Context, cfg *config.Params, logger *zap.Logger, flags *backupCmdFlags) (_ io.ReadCloser, err error) {
+	if !flags.fromStore {
+		f, openErr := os.Open(flags.path)
+		if openErr != nil {
+			return nil, fmt.Errorf("failed to open the backup file: %w", openErr)
+		}
+
+		return f, nil
+	}
+
+	s3Conf := omnires.NewEtcdBackupS3Conf()
+	s3Conf.TypedSpec().Value.Bucket = flags.s3Bucket
+	s3Conf.TypedSpec().Value.Region = flags.s3Region
+	s3Conf.TypedSpec().Value.Endpoint = flags.s3Endpoint
+	s3Conf.TypedSpec().Value.AccessKeyId = os.Getenv("AWS_ACCESS_KEY_ID")
+	s3Conf.TypedSpec().Value.SecretAccessKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+	s3Conf.TypedSpec().Value.SessionToken = os.Getenv("AWS_SESSION_TOKEN")
+
+	storeConfig, err := loadStoreConfig(flags.storeConfigPath)
+	if err != nil {
+		return nil, err
+	}
+
+	backupStore, closeStore, err := store.OpenStore(ctx, cfg.EtcdBackup, s3Conf, storeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the etcd backup store: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, closeStore())
+		}
+	}()
+
+	// the backups of Omni's own state are encrypted by selfbackup itself
+	backupStore, err = store.Unencrypted(backupStore)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := flags.snapshot
+	if snapshot == "" {
+		if snapshot, err = selfbackup.Latest(ctx, backupStore); err != nil {
+			return nil, err
+		}
+	}
+
+	logger.Info("downloading the backup from the etcd backup store", zap.String("snapshot_name", snapshot))
+
+	r, err := selfbackup.Download(ctx, backupStore, snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	// the store client is used while the backup is read, so it is closed together with the backup
+	return &storeBackupReader{ReadCloser: r, closeStore: closeStore}, nil
+}
+
+// loadStoreConfig reads the EtcdBackupStoreConfig resource from the file, nil is returned if the path is not set.
+func loadStoreConfig(path string) (*omnires.EtcdBackupStoreConfig, error) {
+	if path == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the store config file: %w", err)
+	}
+
+	var res protobuf.YAMLResource
+
+	if err = yaml.Unmarshal(data, &res); err != nil {
+		return nil, fmt.Errorf("failed to decode the store config file: %w", err)
+	}
+
+	storeConfig, ok := res.Resource().(*omnires.EtcdBackupStoreConfig)
+	if !ok {
+		return nil, fmt.Errorf("the store config file contains %s resource, expected %s", res.Resource().Metadata().Type(), omnires.EtcdBackupStoreConfigType)
+	}
+
+	return storeConfig, nil
+}
+
+// storeBackupReader closes the etcd backup store client after the backup downloaded from it is read.
+type storeBackupReader struct {
+	io.ReadCloser
+	closeStore func() error
+}
+
+// Close implements io.Closer.
+func (r *storeBackupReader) Close() error {
+	return errors.Join(r.ReadCloser.Close(), r.closeStore())
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/siderolabs/omni/internal/backend/runtime/omni"
 	"github.com/siderolabs/omni/internal/pkg/auth/actor"
 	"github.com/siderolabs/omni/internal/pkg/config"
+	"github.com/siderolabs/omni/internal/pkg/jsonschema"
 	"github.com/siderolabs/omni/internal/version"
 )
 
@@ -62,20 +63,7 @@ func buildRootCommand() (*cobra.Command, error) {
 				return fmt.Errorf("failed to bind flags: %w", err)
 			}
 
-			configs := make([]*config.Params, 0, len(configPaths)+1)
-
-			for _, configPath := range configPaths {
-				fileConfig, err := config.LoadFromFile(configPath)
-				if err != nil {
-					return fmt.Errorf("failed to load config from file %q: %w", configPath, err)
-				}
-
-				configs = append(configs, fileConfig)
-			}
-
-			configs = append(configs, flagConfig) // flags have the highest priority
-
-			cfg, err := config.Init(configSchema, configs...)
+			cfg, err := loadConfig(configSchema, configPaths, flagConfig)
 			if err != nil {
 				return err
 			}
@@ -157,9 +145,29 @@ func buildRootCommand() (*cobra.Command, error) {
 	defineEulaFlags(rootCmd, rootCmdFlagBinder, flagConfig)
 	defineSupportFlags(rootCmdFlagBinder, flagConfig)
 
+	rootCmd.AddCommand(buildBackupCommand(configSchema))
+
 	return rootCmd, nil
 }
 
+// loadConfig merges the config files and the config built from the flags, in order.
+func loadConfig(configSchema *jsonschema.Schema, configPaths []string, flagConfig *config.Params) (*config.Params, error) {
+	configs := make([]*config.Params, 0, len(configPaths)+1)
+
+	for _, configPath := range configPaths {
+		fileConfig, err := config.LoadFromFile(configPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load config from file %q: %w", configPath, err)
+		}
+
+		configs = append(configs, fileConfig)
+	}
+
+	configs = append(configs, flagConfig) // flags have the highest priority
+
+	return config.Init(configSchema, configs...)
+}
+
 func defineServiceFlags(b *FlagBinder, flagConfig *config.Params) {
 	// API
 	b.StringVar("services.api.endpoint", &flagConfig.Services.Api.Endpoint)
@@ -217,6 +225,23 @@ func defineServiceFlags(b *FlagBinder, flagConfig *config.Params) {
 
 	// DevServerProxy
 	b.StringVar("services.devServerProxy.proxyTo", &flagConfig.Services.DevServerProxy.ProxyTo)
+
+	// API rate limits
+	defineAPIActorRateLimitFlags(b, "user", &flagConfig.Services.ApiRateLimits.User)
+	defineAPIActorRateLimitFlags(b, "serviceAccount", &flagConfig.Services.ApiRateLimits.ServiceAccount)
+	defineAPIActorRateLimitFlags(b, "infraProvider", &flagConfig.Services.ApiRateLimits.InfraProvider)
+
+	// Cluster autoscaler
+	b.StringVar("services.clusterAutoscaler.endpoint", &flagConfig.Services.ClusterAutoscaler.Endpoint)
+	b.StringVar("services.clusterAutoscaler.advertisedEndpoint", &flagConfig.Services.ClusterAutoscaler.AdvertisedEndpoint)
+}
+
+func defineAPIActorRateLimitFlags(b *FlagBinder, actorType string, limit *config.APIActorRateLimit) {
+	prefix := "services.apiRateLimits." + actorType + "."
+
+	b.Uint64Var(prefix+"requestsPerSecond", &limit.RequestsPerSecond)
+	b.Uint64Var(prefix+"requestsBurst", &limit.RequestsBurst)
+	b.Uint64Var(prefix+"maxConcurrentRequests", &limit.MaxConcurrentRequests)
 }
 
 func defineAuthFlags(rootCmd *cobra.Command, b *FlagBinder, flagConfig *config.Params) {
@@ -278,6 +303,27 @@ func defineLogsFlags(rootCmd *cobra.Command, b *FlagBinder, flagConfig *config.P
 	b.Float64Var("logs.machine.storage.cleanupProbability", &flagConfig.Logs.Machine.Storage.CleanupProbability)
 	b.Uint64Var("logs.machine.ingestionRateLimitBytesPerSecond", &flagConfig.Logs.Machine.IngestionRateLimitBytesPerSecond)
 	b.Uint64Var("logs.machine.ingestionRateBurstBytes", &flagConfig.Logs.Machine.IngestionRateBurstBytes)
+	b.IntVar("logs.machine.forwarding.bufferSize", &flagConfig.Logs.Machine.Forwarding.BufferSize)
+	b.IntVar("logs.machine.forwarding.batchSize", &flagConfig.Logs.Machine.Forwarding.BatchSize)
+	b.DurationVar("logs.machine.forwarding.flushInterval", &flagConfig.Logs.Machine.Forwarding.FlushInterval)
+	b.BoolVar("logs.machine.forwarding.loki.enabled", &flagConfig.Logs.Machine.Forwarding.Loki.Enabled)
+	b.StringVar("logs.machine.forwarding.loki.url", &flagConfig.Logs.Machine.Forwarding.Loki.Url)
+	b.StringSliceVar("logs.machine.forwarding.loki.clusters", &flagConfig.Logs.Machine.Forwarding.Loki.Clusters, flagConfig.Logs.Machine.Forwarding.Loki.Clusters)
+	b.BoolVar("logs.machine.forwarding.elasticsearch.enabled", &flagConfig.Logs.Machine.Forwarding.Elasticsearch.Enabled)
+	b.StringVar("logs.machine.forwarding.elasticsearch.url", &flagConfig.Logs.Machine.Forwarding.Elasticsearch.Url)
+	b.StringVar("logs.machine.forwarding.elasticsearch.index", &flagConfig.Logs.Machine.Forwarding.Elasticsearch.Index)
+	b.StringSliceVar("logs.machine.forwarding.elasticsearch.clusters", &flagConfig.Logs.Machine.Forwarding.Elasticsearch.Clusters,
+		flagConfig.Logs.Machine.Forwarding.Elasticsearch.Clusters)
+	b.BoolVar("logs.machine.forwarding.otlp.enabled", &flagConfig.Logs.Machine.Forwarding.Otlp.Enabled)
+	b.StringVar("logs.machine.forwarding.otlp.endpoint", &flagConfig.Logs.Machine.Forwarding.Otlp.Endpoint)
+	b.StringSliceVar("logs.machine.forwarding.otlp.clusters", &flagConfig.Logs.Machine.Forwarding.Otlp.Clusters, flagConfig.Logs.Machine.Forwarding.Otlp.Clusters)
+	b.BoolVar("logs.machine.forwarding.syslog.enabled", &flagConfig.Logs.Machine.Forwarding.Syslog.Enabled)
+	b.StringVar("logs.machine.forwarding.syslog.endpoint", &flagConfig.Logs.Machine.Forwarding.Syslog.Endpoint)
+	b.BoolVar("logs.machine.forwarding.syslog.tls", &flagConfig.Logs.Machine.Forwarding.Syslog.Tls)
+	b.StringVar("logs.machine.forwarding.syslog.caFile", &flagConfig.Logs.Machine.Forwarding.Syslog.CaFile)
+	b.StringVar("logs.machine.forwarding.syslog.certFile", &flagConfig.Logs.Machine.Forwarding.Syslog.CertFile)
+	b.StringVar("logs.machine.forwarding.syslog.keyFile", &flagConfig.Logs.Machine.Forwarding.Syslog.KeyFile)
+	b.StringSliceVar("logs.machine.forwarding.syslog.clusters", &flagConfig.Logs.Machine.Forwarding.Syslog.Clusters, flagConfig.Logs.Machine.Forwarding.Syslog.Clusters)
 
 	if err := rootCmd.Flags().MarkHidden(b.mustFlagName("logs.machine.storage.cleanupProbability")); err != nil {
 		return err
@@ -290,6 +336,18 @@ func defineLogsFlags(rootCmd *cobra.Command, b *FlagBinder, flagConfig *config.P
 	b.DurationVar("logs.audit.retentionPeriod", &flagConfig.Logs.Audit.RetentionPeriod)
 	b.Uint64Var("logs.audit.maxSize", &flagConfig.Logs.Audit.MaxSize)
 	b.Float64Var("logs.audit.cleanupProbability", &flagConfig.Logs.Audit.CleanupProbability)
+	b.StringVar("logs.audit.signingKeyPath", &flagConfig.Logs.Audit.SigningKeyPath)
+	b.BoolVar("logs.audit.sinks.syslog.enabled", &flagConfig.Logs.Audit.Sinks.Syslog.Enabled)
+	b.StringVar("logs.audit.sinks.syslog.endpoint", &flagConfig.Logs.Audit.Sinks.Syslog.Endpoint)
+	b.BoolVar("logs.audit.sinks.syslog.tls", &flagConfig.Logs.Audit.Sinks.Syslog.Tls)
+	b.StringVar("logs.audit.sinks.syslog.caFile", &flagConfig.Logs.Audit.Sinks.Syslog.CaFile)
+	b.StringVar("logs.audit.sinks.syslog.certFile", &flagConfig.Logs.Audit.Sinks.Syslog.CertFile)
+	b.StringVar("logs.audit.sinks.syslog.keyFile", &flagConfig.Logs.Audit.Sinks.Syslog.KeyFile)
+	b.BoolVar("logs.audit.sinks.webhook.enabled", &flagConfig.Logs.Audit.Sinks.Webhook.Enabled)
+	b.StringVar("logs.audit.sinks.webhook.url", &flagConfig.Logs.Audit.Sinks.Webhook.Url)
+	b.StringVar("logs.audit.sinks.webhook.secret", &flagConfig.Logs.Audit.Sinks.Webhook.Secret)
+	b.BoolVar("logs.audit.sinks.otlp.enabled", &flagConfig.Logs.Audit.Sinks.Otlp.Enabled)
+	b.StringVar("logs.audit.sinks.otlp.endpoint", &flagConfig.Logs.Audit.Sinks.Otlp.Endpoint)
 	b.BoolVar("logs.stripe.enabled", &flagConfig.Logs.Stripe.Enabled)
 	b.Uint32Var("logs.stripe.minCommit", &flagConfig.Logs.Stripe.MinCommit)
 
@@ -374,6 +432,8 @@ func defineFeatureFlags(b *FlagBinder, flagConfig *config.Params) {
 	b.StringVar("services.workloadProxy.subdomain", &flagConfig.Services.WorkloadProxy.Subdomain)
 	b.BoolVar("services.workloadProxy.useOmniSubdomain", &flagConfig.Services.WorkloadProxy.UseOmniSubdomain)
 	b.DurationVar("services.workloadProxy.stopLBsAfter", &flagConfig.Services.WorkloadProxy.StopLBsAfter)
+	b.IntVar("services.workloadProxy.minPort", &flagConfig.Services.WorkloadProxy.MinPort)
+	b.IntVar("services.workloadProxy.maxPort", &flagConfig.Services.WorkloadProxy.MaxPort)
 	b.BoolVar("features.enableBreakGlassConfigs", &flagConfig.Features.EnableBreakGlassConfigs)
 	b.BoolVar("features.disableControllerRuntimeCache", &flagConfig.Features.DisableControllerRuntimeCache)
 	b.BoolVar("features.enableClusterImport", &flagConfig.Features.EnableClusterImport)
@@ -393,6 +453,11 @@ func defineEtcdBackupsFlags(rootCmd *cobra.Command, b *FlagBinder, flagConfig *c
 	b.DurationVar("etcdBackup.maxInterval", &flagConfig.EtcdBackup.MaxInterval)
 	b.Uint64Var("etcdBackup.uploadLimitMbps", &flagConfig.EtcdBackup.UploadLimitMbps)
 	b.Uint64Var("etcdBackup.downloadLimitMbps", &flagConfig.EtcdBackup.DownloadLimitMbps)
+	b.DurationVar("etcdBackup.selfBackupInterval", &flagConfig.EtcdBackup.SelfBackupInterval)
+	b.Uint32Var("etcdBackup.selfBackupKeepLast", &flagConfig.EtcdBackup.SelfBackupKeepLast)
+	b.Uint32Var("etcdBackup.selfBackupKeepDaily", &flagConfig.EtcdBackup.SelfBackupKeepDaily)
+	b.Uint32Var("etcdBackup.selfBackupKeepWeekly", &flagConfig.EtcdBackup.SelfBackupKeepWeekly)
+	b.Uint32Var("etcdBackup.selfBackupKeepMonthly", &flagConfig.EtcdBackup.SelfBackupKeepMonthly)
 
 	rootCmd.MarkFlagsMutuallyExclusive(b.mustFlagName("etcdBackup.s3Enabled"), b.mustFlagName("etcdBackup.localPath"))
 }
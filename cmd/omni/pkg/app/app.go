@@ -44,6 +44,7 @@ import (
 	"github.com/siderolabs/omni/internal/pkg/eula"
 	"github.com/siderolabs/omni/internal/pkg/features"
 	"github.com/siderolabs/omni/internal/pkg/siderolink"
+	"github.com/siderolabs/omni/internal/pkg/siderolink/logforward"
 )
 
 // Run the Omni service.
@@ -148,6 +149,15 @@ func Run(ctx context.Context, state *omni.State, cfg *config.Params, logger *zap
 		prometheus.MustRegister(logIngestionLimiter)
 	}
 
+	logForwarder, err := logforward.NewForwarderFromConfig(state.Default(), cfg.Logs.Machine.Forwarding, logger.With(logging.Component("machine_log_forwarder")))
+	if err != nil {
+		return fmt.Errorf("failed to set up machine log forwarding: %w", err)
+	}
+
+	if logForwarder != nil {
+		prometheus.MustRegister(logForwarder)
+	}
+
 	logHandler, err := siderolink.NewLogHandler(
 		state.SecondaryStorageDB(),
 		machineMap,
@@ -156,6 +166,7 @@ func Run(ctx context.Context, state *omni.State, cfg *config.Params, logger *zap
 		logger.With(logging.Component("siderolink_log_handler")),
 		siderolink.WithLogHandlerCleanupCallback(state.SQLiteMetrics().CleanupCallback(sqlite.SubsystemMachineLogs)),
 		siderolink.WithLogIngestionLimiter(logIngestionLimiter),
+		siderolink.WithLogForwarder(logForwarder),
 	)
 	if err != nil {
 		return fmt.Errorf("failed to set up log handler: %w", err)
@@ -27,6 +27,8 @@ export enum AuditLogEventType {
   AUDIT_LOG_EVENT_TYPE_TALOS_ACCESS = 6,
   AUDIT_LOG_EVENT_TYPE_K8S_ACCESS = 7,
   AUDIT_LOG_EVENT_TYPE_AUDIT_LOG_ACCESS = 8,
+  AUDIT_LOG_EVENT_TYPE_WORKLOAD_PROXY_ACCESS = 9,
+  AUDIT_LOG_EVENT_TYPE_RATE_LIMIT = 10,
 }
 
 export enum AuditLogOrderByField {
@@ -70,6 +72,11 @@ export enum BootAssetURLRequestBootAssetKind {
   BOOT_ASSET_KIND_DISK = 3,
 }
 
+export enum AuditLogChainMarkerKind {
+  CHECKPOINT = 0,
+  TRUNCATION = 1,
+}
+
 export enum MaintenanceLifecycleRequestOperation {
   OPERATION_UNSPECIFIED = 0,
   OPERATION_INSTALL = 1,
@@ -108,6 +115,7 @@ export type CreateServiceAccountRequest = {
   use_user_role?: boolean
   role?: string
   name?: string
+  custom_roles?: string[]
 }
 
 export type CreateServiceAccountResponse = {
@@ -140,6 +148,7 @@ export type ListServiceAccountsResponseServiceAccount = {
   pgp_public_keys?: ListServiceAccountsResponseServiceAccountPgpPublicKey[]
   role?: string
   last_active?: string
+  custom_roles?: string[]
 }
 
 export type ListServiceAccountsResponse = {
@@ -271,11 +280,32 @@ export type ReadAuditLogRequest = {
   follow?: boolean
   start_ts_ms?: string
   from_id?: string
+  with_proofs?: boolean
+}
+
+export type AuditLogChainMarker = {
+  kind?: AuditLogChainMarkerKind
+  id?: string
+  hash?: Uint8Array
+  timestamp_ms?: string
+  public_key?: Uint8Array
+  signature?: Uint8Array
+}
+
+export type AuditLogProof = {
+  prev_hash?: Uint8Array
+  hash?: Uint8Array
+  markers?: AuditLogChainMarker[]
 }
 
 export type ReadAuditLogResponse = {
   audit_log?: Uint8Array
   id?: string
+  proof?: AuditLogProof
+}
+
+export type AuditLogSigningKeyResponse = {
+  public_key?: Uint8Array
 }
 
 export type ValidateJsonSchemaRequest = {
@@ -313,6 +343,16 @@ export type MaintenanceLifecycleResponse = {
   message?: string
 }
 
+export type EtcdRestoreRequest = {
+  cluster?: string
+  snapshot?: string
+  wipe_ephemeral?: boolean
+}
+
+export type EtcdRestoreResponse = {
+  message?: string
+}
+
 export type GetMachineJoinConfigRequest = {
   use_grpc_tunnel?: boolean
   join_token?: string
@@ -346,15 +386,21 @@ export type ResetNodeUniqueTokenResponse = {
 export type CreateUserRequest = {
   email?: string
   role?: string
+  custom_roles?: string[]
 }
 
 export type CreateUserResponse = {
   user_id?: string
 }
 
+export type CustomRoles = {
+  roles?: string[]
+}
+
 export type UpdateUserRequest = {
   email?: string
   role?: string
+  custom_roles?: CustomRoles
 }
 
 export type DestroyUserRequest = {
@@ -381,12 +427,120 @@ export type ListUsersResponseUser = {
   role?: string
   saml_labels?: {[key: string]: string}
   last_active?: string
+  custom_roles?: string[]
 }
 
 export type ListUsersResponse = {
   users?: ListUsersResponseUser[]
 }
 
+export type RequestElevationRequest = {
+  role?: string
+  clusters?: string[]
+  reason?: string
+  duration?: GoogleProtobufDuration.Duration
+}
+
+export type RequestElevationResponse = {
+  id?: string
+}
+
+export type ApproveElevationRequest = {
+  id?: string
+}
+
+export type RevokeElevationRequest = {
+  id?: string
+}
+
+export type ListElevationsResponseElevation = {
+  id?: string
+  identity?: string
+  role?: string
+  clusters?: string[]
+  reason?: string
+  duration?: GoogleProtobufDuration.Duration
+  state?: string
+  approved_by?: string
+  revoked_by?: string
+  created?: GoogleProtobufTimestamp.Timestamp
+  expiration?: GoogleProtobufTimestamp.Timestamp
+}
+
+export type ListElevationsResponse = {
+  elevations?: ListElevationsResponseElevation[]
+}
+
+export type DetachClusterRequest = {
+  cluster?: string
+  control_plane_endpoint?: string
+}
+
+export type DetachClusterResponseMachine = {
+  id?: string
+  hostname?: string
+  control_plane?: boolean
+  config?: Uint8Array
+}
+
+export type DetachClusterResponse = {
+  machines?: DetachClusterResponseMachine[]
+  talosconfig?: Uint8Array
+  kubeconfig?: Uint8Array
+}
+
+export type ReleaseDetachedClusterRequest = {
+  cluster?: string
+  force?: boolean
+}
+
+export type ReleaseDetachedClusterResponse = {
+  message?: string
+}
+
+export type WorkloadProxyCredentialsRequest = {
+  alias?: string
+  csr?: Uint8Array
+  ttl?: GoogleProtobufDuration.Duration
+}
+
+export type WorkloadProxyCredentialsResponse = {
+  token?: string
+  certificate?: Uint8Array
+  expiration?: GoogleProtobufTimestamp.Timestamp
+  url?: string
+}
+
+export type ClusterAutoscalerCredentialsRequest = {
+  cluster?: string
+  csr?: Uint8Array
+  ttl?: GoogleProtobufDuration.Duration
+}
+
+export type ClusterAutoscalerCredentialsResponse = {
+  certificate?: Uint8Array
+  expiration?: GoogleProtobufTimestamp.Timestamp
+  address?: string
+}
+
+export type ClusterLogsRequest = {
+  cluster?: string
+  selectors?: string[]
+  follow?: boolean
+  tail_lines?: number
+  since?: GoogleProtobufTimestamp.Timestamp
+  until?: GoogleProtobufTimestamp.Timestamp
+  services?: string[]
+  pattern?: string
+}
+
+export type ClusterLogsResponse = {
+  machine_id?: string
+  node?: string
+  data?: Uint8Array
+  time?: GoogleProtobufTimestamp.Timestamp
+}
+
 export class ManagementService {
   static Kubeconfig(req: KubeconfigRequest, ...options: fm.fetchOption[]): Promise<KubeconfigResponse> {
     return fm.fetchReq<KubeconfigRequest, KubeconfigResponse>("POST", `/management.ManagementService/Kubeconfig`, req, ...options)
@@ -439,12 +593,18 @@ export class ManagementService {
   static ReadAuditLog(req: ReadAuditLogRequest, entityNotifier: fm.NotifyStreamEntityArrival<ReadAuditLogResponse>, ...options: fm.fetchOption[]): Promise<void> {
     return fm.fetchStreamingRequest<ReadAuditLogRequest, ReadAuditLogResponse>("POST", `/management.ManagementService/ReadAuditLog`, req, entityNotifier, ...options)
   }
+  static AuditLogSigningKey(req: GoogleProtobufEmpty.Empty, ...options: fm.fetchOption[]): Promise<AuditLogSigningKeyResponse> {
+    return fm.fetchReq<GoogleProtobufEmpty.Empty, AuditLogSigningKeyResponse>("POST", `/management.ManagementService/AuditLogSigningKey`, req, ...options)
+  }
   static MaintenanceUpgrade(req: MaintenanceUpgradeRequest, ...options: fm.fetchOption[]): Promise<MaintenanceUpgradeResponse> {
     return fm.fetchReq<MaintenanceUpgradeRequest, MaintenanceUpgradeResponse>("POST", `/management.ManagementService/MaintenanceUpgrade`, req, ...options)
   }
   static MaintenanceLifecycle(req: MaintenanceLifecycleRequest, entityNotifier: fm.NotifyStreamEntityArrival<MaintenanceLifecycleResponse>, ...options: fm.fetchOption[]): Promise<void> {
     return fm.fetchStreamingRequest<MaintenanceLifecycleRequest, MaintenanceLifecycleResponse>("POST", `/management.ManagementService/MaintenanceLifecycle`, req, entityNotifier, ...options)
   }
+  static EtcdRestore(req: EtcdRestoreRequest, entityNotifier: fm.NotifyStreamEntityArrival<EtcdRestoreResponse>, ...options: fm.fetchOption[]): Promise<void> {
+    return fm.fetchStreamingRequest<EtcdRestoreRequest, EtcdRestoreResponse>("POST", `/management.ManagementService/EtcdRestore`, req, entityNotifier, ...options)
+  }
   static GetMachineJoinConfig(req: GetMachineJoinConfigRequest, ...options: fm.fetchOption[]): Promise<GetMachineJoinConfigResponse> {
     return fm.fetchReq<GetMachineJoinConfigRequest, GetMachineJoinConfigResponse>("POST", `/management.ManagementService/GetMachineJoinConfig`, req, ...options)
   }
@@ -472,4 +632,31 @@ export class ManagementService {
   static MachinePowerOn(req: MachinePowerOnRequest, ...options: fm.fetchOption[]): Promise<MachinePowerOnResponse> {
     return fm.fetchReq<MachinePowerOnRequest, MachinePowerOnResponse>("POST", `/management.ManagementService/MachinePowerOn`, req, ...options)
   }
+  static RequestElevation(req: RequestElevationRequest, ...options: fm.fetchOption[]): Promise<RequestElevationResponse> {
+    return fm.fetchReq<RequestElevationRequest, RequestElevationResponse>("POST", `/management.ManagementService/RequestElevation`, req, ...options)
+  }
+  static ApproveElevation(req: ApproveElevationRequest, ...options: fm.fetchOption[]): Promise<GoogleProtobufEmpty.Empty> {
+    return fm.fetchReq<ApproveElevationRequest, GoogleProtobufEmpty.Empty>("POST", `/management.ManagementService/ApproveElevation`, req, ...options)
+  }
+  static RevokeElevation(req: RevokeElevationRequest, ...options: fm.fetchOption[]): Promise<GoogleProtobufEmpty.Empty> {
+    return fm.fetchReq<RevokeElevationRequest, GoogleProtobufEmpty.Empty>("POST", `/management.ManagementService/RevokeElevation`, req, ...options)
+  }
+  static ListElevations(req: GoogleProtobufEmpty.Empty, ...options: fm.fetchOption[]): Promise<ListElevationsResponse> {
+    return fm.fetchReq<GoogleProtobufEmpty.Empty, ListElevationsResponse>("POST", `/management.ManagementService/ListElevations`, req, ...options)
+  }
+  static DetachCluster(req: DetachClusterRequest, ...options: fm.fetchOption[]): Promise<DetachClusterResponse> {
+    return fm.fetchReq<DetachClusterRequest, DetachClusterResponse>("POST", `/management.ManagementService/DetachCluster`, req, ...options)
+  }
+  static ReleaseDetachedCluster(req: ReleaseDetachedClusterRequest, entityNotifier: fm.NotifyStreamEntityArrival<ReleaseDetachedClusterResponse>, ...options: fm.fetchOption[]): Promise<void> {
+    return fm.fetchStreamingRequest<ReleaseDetachedClusterRequest, ReleaseDetachedClusterResponse>("POST", `/management.ManagementService/ReleaseDetachedCluster`, req, entityNotifier, ...options)
+  }
+  static WorkloadProxyCredentials(req: WorkloadProxyCredentialsRequest, ...options: fm.fetchOption[]): Promise<WorkloadProxyCredentialsResponse> {
+    return fm.fetchReq<WorkloadProxyCredentialsRequest, WorkloadProxyCredentialsResponse>("POST", `/management.ManagementService/WorkloadProxyCredentials`, req, ...options)
+  }
+  static ClusterAutoscalerCredentials(req: ClusterAutoscalerCredentialsRequest, ...options: fm.fetchOption[]): Promise<ClusterAutoscalerCredentialsResponse> {
+    return fm.fetchReq<ClusterAutoscalerCredentialsRequest, ClusterAutoscalerCredentialsResponse>("POST", `/management.ManagementService/ClusterAutoscalerCredentials`, req, ...options)
+  }
+  static ClusterLogs(req: ClusterLogsRequest, entityNotifier: fm.NotifyStreamEntityArrival<ClusterLogsResponse>, ...options: fm.fetchOption[]): Promise<void> {
+    return fm.fetchStreamingRequest<ClusterLogsRequest, ClusterLogsResponse>("POST", `/management.ManagementService/ClusterLogs`, req, entityNotifier, ...options)
+  }
 }
\ No newline at end of file
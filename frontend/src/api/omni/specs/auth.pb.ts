@@ -4,6 +4,7 @@
 * This file is a generated Typescript file for GRPC Gateway, DO NOT MODIFY
 */
 
+import * as GoogleProtobufDuration from "../../google/protobuf/duration.pb"
 import * as GoogleProtobufTimestamp from "../../google/protobuf/timestamp.pb"
 
 export enum PublicKeySpecType {
@@ -59,6 +60,7 @@ export type SAMLAssertionSpec = {
 export type UserSpec = {
   scopes?: string[]
   role?: string
+  custom_roles?: string[]
 }
 
 export type IdentitySpec = {
@@ -106,11 +108,22 @@ export type AccessPolicyRuleKubernetes = {
   impersonate?: AccessPolicyRuleKubernetesImpersonate
 }
 
+export type AccessPolicyRuleExposedServices = {
+  aliases?: string[]
+}
+
+export type AccessPolicyRuleTalos = {
+  allow?: string[]
+  deny?: string[]
+}
+
 export type AccessPolicyRule = {
   users?: string[]
   clusters?: string[]
   kubernetes?: AccessPolicyRuleKubernetes
   role?: string
+  exposed_services?: AccessPolicyRuleExposedServices
+  talos?: AccessPolicyRuleTalos
 }
 
 export type AccessPolicyTestExpectedKubernetesImpersonate = {
@@ -121,9 +134,15 @@ export type AccessPolicyTestExpectedKubernetes = {
   impersonate?: AccessPolicyTestExpectedKubernetesImpersonate
 }
 
+export type AccessPolicyTestExpectedTalos = {
+  allowed?: string[]
+  denied?: string[]
+}
+
 export type AccessPolicyTestExpected = {
   kubernetes?: AccessPolicyTestExpectedKubernetes
   role?: string
+  talos?: AccessPolicyTestExpectedTalos
 }
 
 export type AccessPolicyTestUser = {
@@ -154,6 +173,40 @@ export type SAMLLabelRuleSpec = {
   assign_role_on_registration?: string
   assign_role?: string
   update_on_each_login?: boolean
+  assign_custom_roles?: string[]
+}
+
+export type RoleSpecRule = {
+  resource_types?: string[]
+  verbs?: string[]
+  clusters?: string[]
+  apis?: string[]
+  cluster_label_selectors?: string[]
+}
+
+export type RoleSpec = {
+  description?: string
+  rules?: RoleSpecRule[]
+}
+
+export type ElevationRequestSpec = {
+  identity?: string
+  role?: string
+  clusters?: string[]
+  reason?: string
+  duration?: GoogleProtobufDuration.Duration
+  approved_by?: string
+  approved_at?: GoogleProtobufTimestamp.Timestamp
+  revoked?: boolean
+  revoked_by?: string
+}
+
+export type ElevationGrantSpec = {
+  identity?: string
+  role?: string
+  clusters?: string[]
+  expiration?: GoogleProtobufTimestamp.Timestamp
+  approved_by?: string
 }
 
 export type IdentityLastActiveSpec = {
@@ -182,6 +235,7 @@ export type ServiceAccountStatusSpec = {
   role?: string
   public_keys?: ServiceAccountStatusSpecPgpPublicKey[]
   expiration?: GoogleProtobufTimestamp.Timestamp
+  custom_roles?: string[]
 }
 
 export type EulaAcceptanceSpec = {
@@ -68,6 +68,13 @@ export enum MachineStatusSpecPowerState {
   POWER_STATE_OFF = 3,
 }
 
+export enum EtcdBackupStoreConfigSpecBackend {
+  S3 = 0,
+  GCS = 1,
+  AZURE_BLOB = 2,
+  SFTP = 3,
+}
+
 export enum EtcdBackupStatusSpecStatus {
   Unknown = 0,
   Ok = 1,
@@ -101,6 +108,7 @@ export enum ClusterStatusSpecPhase {
 export enum MachineSetSpecUpdateStrategy {
   Unset = 0,
   Rolling = 1,
+  Canary = 2,
 }
 
 export enum MachineSetSpecMachineClassType {
@@ -122,6 +130,15 @@ export enum TalosUpgradeStatusSpecPhase {
   UpdatingMachineSchematics = 5,
 }
 
+export enum CanaryRolloutStatusPhase {
+  Unknown = 0,
+  Updating = 1,
+  Verifying = 2,
+  Promoted = 3,
+  RollingBack = 4,
+  Failed = 5,
+}
+
 export enum MachineStatusSnapshotSpecPowerStage {
   POWER_STAGE_NONE = 0,
   POWER_STAGE_POWERED_OFF = 1,
@@ -148,6 +165,18 @@ export enum KubernetesUpgradeStatusSpecPhase {
   Reverting = 4,
 }
 
+export enum ExposedServiceSpecProtocol {
+  HTTP = 0,
+  GRPC = 1,
+  TCP = 2,
+}
+
+export enum ExposedServiceSpecIdentityForwarding {
+  NONE = 0,
+  HEADERS = 1,
+  JWT = 2,
+}
+
 export enum MachineUpgradeStatusSpecPhase {
   Unknown = 0,
   Pending = 1,
@@ -198,6 +227,10 @@ export enum SecretRotationSpecComponent {
   NONE = 0,
   TALOS_CA = 1,
   KUBERNETES_CA = 2,
+  ETCD_CA = 3,
+  KUBERNETES_AGGREGATOR_CA = 4,
+  KUBERNETES_SERVICE_ACCOUNT_KEY = 5,
+  BOOTSTRAP_TOKEN = 6,
 }
 
 export enum NotificationSpecType {
@@ -206,6 +239,14 @@ export enum NotificationSpecType {
   ERROR = 2,
 }
 
+export enum NotificationChannelSpecType {
+  UNKNOWN = 0,
+  SLACK = 1,
+  TEAMS = 2,
+  WEBHOOK = 3,
+  SMTP = 4,
+}
+
 export enum KubernetesManifestGroupSpecMode {
   UNKNOWN = 0,
   FULL = 1,
@@ -234,6 +275,21 @@ export enum KubernetesHealthCheckStatusSpecState {
   PASSED = 3,
 }
 
+export enum ClusterTemplateStatusSpecPhase {
+  UNKNOWN = 0,
+  SYNCING = 1,
+  SYNCED = 2,
+  FAILED = 3,
+}
+
+export enum GitRepositoryStatusSpecPhase {
+  UNKNOWN = 0,
+  SYNCING = 1,
+  SYNCED = 2,
+  FAILED = 3,
+  SUSPENDED = 4,
+}
+
 export type MachineSpec = {
   management_address?: string
   connected?: boolean
@@ -391,6 +447,20 @@ export type ClusterSpec = {
   talos_version?: string
   features?: ClusterSpecFeatures
   backup_configuration?: EtcdBackupConf
+  maintenance_window?: string
+  secret_rotation_policy?: SecretRotationPolicy
+}
+
+export type SecretRotationPolicy = {
+  interval?: GoogleProtobufDuration.Duration
+  expiry_threshold?: GoogleProtobufDuration.Duration
+  components?: SecretRotationSpecComponent[]
+}
+
+export type MaintenanceWindowSpec = {
+  schedule?: string
+  duration?: GoogleProtobufDuration.Duration
+  timezone?: string
 }
 
 export type ClusterTaintSpec = {
@@ -399,6 +469,14 @@ export type ClusterTaintSpec = {
 export type EtcdBackupConf = {
   interval?: GoogleProtobufDuration.Duration
   enabled?: boolean
+  retention?: EtcdBackupRetention
+}
+
+export type EtcdBackupRetention = {
+  keep_last?: number
+  keep_daily?: number
+  keep_weekly?: number
+  keep_monthly?: number
 }
 
 export type EtcdBackupEncryptionSpec = {
@@ -421,6 +499,7 @@ export type BackupDataSpec = {
   encryption_key?: Uint8Array
   aes_cbc_encryption_secret?: string
   secretbox_encryption_secret?: string
+  retention?: EtcdBackupRetention
 }
 
 export type EtcdBackupS3ConfSpec = {
@@ -432,11 +511,42 @@ export type EtcdBackupS3ConfSpec = {
   session_token?: string
 }
 
+export type EtcdBackupStoreConfigSpecGCSConfig = {
+  bucket?: string
+  credentials_json?: string
+  endpoint?: string
+}
+
+export type EtcdBackupStoreConfigSpecAzureBlobConfig = {
+  account_name?: string
+  account_key?: string
+  container?: string
+  endpoint?: string
+}
+
+export type EtcdBackupStoreConfigSpecSFTPConfig = {
+  address?: string
+  user?: string
+  password?: string
+  private_key?: string
+  host_key?: string
+  path?: string
+}
+
+export type EtcdBackupStoreConfigSpec = {
+  backend?: EtcdBackupStoreConfigSpecBackend
+  gcs?: EtcdBackupStoreConfigSpecGCSConfig
+  azure_blob?: EtcdBackupStoreConfigSpecAzureBlobConfig
+  sftp?: EtcdBackupStoreConfigSpecSFTPConfig
+}
+
 export type EtcdBackupStatusSpec = {
   status?: EtcdBackupStatusSpecStatus
   error?: string
   last_backup_time?: GoogleProtobufTimestamp.Timestamp
   last_backup_attempt?: GoogleProtobufTimestamp.Timestamp
+  last_pruned_snapshots?: string[]
+  prune_error?: string
 }
 
 export type EtcdManualBackupSpec = {
@@ -461,6 +571,7 @@ export type ClusterMachineSpec = {
 export type ClusterMachineConfigPatchesSpec = {
   patches?: string[]
   compressed_patches?: Uint8Array[]
+  previous?: ClusterMachineConfigPatchesSpec
 }
 
 export type ClusterMachineTalosVersionSpec = {
@@ -505,6 +616,7 @@ export type ClusterMachineStatusSpec = {
   config_apply_status?: ConfigApplyStatus
   is_removed?: boolean
   provision_status?: ClusterMachineStatusSpecProvisionStatus
+  pending_reason?: string
 }
 
 export type Machines = {
@@ -548,6 +660,7 @@ export type ClusterMachineConfigStatusSpec = {
   pre_reboot_boot_id?: string
   applied_high_priority_config_hash?: string
   image_factory_host?: string
+  pending_reason?: string
 }
 
 export type MachinePendingUpdatesSpecUpgrade = {
@@ -575,6 +688,9 @@ export type ClusterSecretsSpecCertsCA = {
 export type ClusterSecretsSpecCerts = {
   os?: ClusterSecretsSpecCertsCA
   k8s?: ClusterSecretsSpecCertsCA
+  etcd?: ClusterSecretsSpecCertsCA
+  k8s_aggregator?: ClusterSecretsSpecCertsCA
+  k8s_sa?: ClusterSecretsSpecCertsCA
 }
 
 export type ClusterSecretsSpec = {
@@ -636,10 +752,16 @@ export type MachineSetSpecMachineClass = {
   allocation_type?: MachineSetSpecMachineClassType
 }
 
+export type MachineSetSpecMachineAllocationAutoscaling = {
+  min_machine_count?: number
+  max_machine_count?: number
+}
+
 export type MachineSetSpecMachineAllocation = {
   name?: string
   machine_count?: number
   allocation_type?: MachineSetSpecMachineAllocationType
+  autoscaling?: MachineSetSpecMachineAllocationAutoscaling
 }
 
 export type MachineSetSpecBootstrapSpec = {
@@ -651,8 +773,17 @@ export type MachineSetSpecRollingUpdateStrategyConfig = {
   max_parallelism?: number
 }
 
+export type MachineSetSpecCanaryUpdateStrategyConfig = {
+  count?: number
+  max_parallelism?: number
+  soak_duration?: GoogleProtobufDuration.Duration
+  require_approval?: boolean
+  failure_timeout?: GoogleProtobufDuration.Duration
+}
+
 export type MachineSetSpecUpdateStrategyConfig = {
   rolling?: MachineSetSpecRollingUpdateStrategyConfig
+  canary?: MachineSetSpecCanaryUpdateStrategyConfig
 }
 
 export type MachineSetSpec = {
@@ -665,6 +796,7 @@ export type MachineSetSpec = {
   machine_allocation?: MachineSetSpecMachineAllocation
   upgrade_strategy?: MachineSetSpecUpdateStrategy
   upgrade_strategy_config?: MachineSetSpecUpdateStrategyConfig
+  maintenance_window?: string
 }
 
 export type TalosUpgradeStatusSpec = {
@@ -685,6 +817,28 @@ export type MachineSetStatusSpec = {
   config_hash?: string
   machine_allocation?: MachineSetSpecMachineAllocation
   locked_updates?: number
+  upgrade_canary?: CanaryRolloutStatus
+  update_canary?: CanaryRolloutStatus
+}
+
+export type CanaryRolloutStatusRollbackVersion = {
+  talos_version?: string
+  schematic_id?: string
+}
+
+export type CanaryRolloutStatus = {
+  phase?: CanaryRolloutStatusPhase
+  machines?: string[]
+  targets?: {[key: string]: string}
+  verification_started?: GoogleProtobufTimestamp.Timestamp
+  unhealthy_since?: GoogleProtobufTimestamp.Timestamp
+  reason?: string
+  rollback_versions?: {[key: string]: CanaryRolloutStatusRollbackVersion}
+  rollback_config_machines?: string[]
+}
+
+export type CanaryApprovalSpec = {
+  comment?: string
 }
 
 export type MachineSetConfigStatusSpec = {
@@ -776,6 +930,12 @@ export type ClusterMachineEncryptionKeySpec = {
   data?: Uint8Array
 }
 
+export type ExposedServiceSpecAccess = {
+  min_role?: string
+  users?: string[]
+  label_selector?: string
+}
+
 export type ExposedServiceSpec = {
   port?: number
   label?: string
@@ -783,6 +943,10 @@ export type ExposedServiceSpec = {
   url?: string
   error?: string
   has_explicit_alias?: boolean
+  protocol?: ExposedServiceSpecProtocol
+  proxy_port?: number
+  access?: ExposedServiceSpecAccess
+  identity_forwarding?: ExposedServiceSpecIdentityForwarding
 }
 
 export type ClusterWorkloadProxyStatusSpec = {
@@ -1126,6 +1290,17 @@ export type SecretRotationSpec = {
   extra_certs?: ClusterSecretsSpecCerts
   backup_certs_os?: ClusterSecretsSpecCertsCA[]
   backup_certs_k8s?: ClusterSecretsSpecCertsCA[]
+  backup_certs_etcd?: ClusterSecretsSpecCertsCA[]
+  backup_certs_k8s_aggregator?: ClusterSecretsSpecCertsCA[]
+  backup_certs_k8s_sa?: ClusterSecretsSpecCertsCA[]
+  extra_bootstrap_token?: string
+}
+
+export type ClusterSecretsRotationStatusSpecSecret = {
+  component?: SecretRotationSpecComponent
+  expiration?: GoogleProtobufTimestamp.Timestamp
+  last_rotation?: GoogleProtobufTimestamp.Timestamp
+  next_rotation?: GoogleProtobufTimestamp.Timestamp
 }
 
 export type ClusterSecretsRotationStatusSpec = {
@@ -1134,6 +1309,7 @@ export type ClusterSecretsRotationStatusSpec = {
   error?: string
   step?: string
   status?: string
+  secrets?: ClusterSecretsRotationStatusSpecSecret[]
 }
 
 export type ClusterMachineSecretsSpecRotation = {
@@ -1152,8 +1328,22 @@ export type ClusterMachineSecretsSpec = {
 export type RotateKubernetesCASpec = {
 }
 
+export type RotateEtcdCASpec = {
+}
+
+export type RotateKubernetesAggregatorCASpec = {
+}
+
+export type RotateKubernetesServiceAccountKeySpec = {
+}
+
+export type RotateBootstrapTokenSpec = {
+}
+
 export type UpgradeRolloutSpec = {
   machine_sets_upgrade_quota?: {[key: string]: number}
+  machine_sets_upgrade_canary?: {[key: string]: CanaryRolloutStatus}
+  machine_sets_update_canary?: {[key: string]: CanaryRolloutStatus}
 }
 
 export type NotificationSpec = {
@@ -1162,10 +1352,54 @@ export type NotificationSpec = {
   type?: NotificationSpecType
 }
 
+export type NotificationChannelSpecSMTPConfig = {
+  host?: string
+  username?: string
+  password?: string
+  from?: string
+  to?: string[]
+}
+
+export type NotificationChannelSpec = {
+  type?: NotificationChannelSpecType
+  url?: string
+  secret?: string
+  smtp?: NotificationChannelSpecSMTPConfig
+}
+
+export type NotificationRuleSpec = {
+  channels?: string[]
+  events?: string[]
+  cluster_selector?: string
+  min_severity?: NotificationSpecType
+  throttle?: GoogleProtobufDuration.Duration
+}
+
+export type NotificationChannelStatusSpec = {
+  delivered?: string
+  failed?: string
+  pending?: number
+  last_delivery?: GoogleProtobufTimestamp.Timestamp
+  last_failure?: GoogleProtobufTimestamp.Timestamp
+  last_error?: string
+}
+
+export type KubernetesManifestGroupSpecHelmSource = {
+  repository?: string
+  chart?: string
+  version?: string
+  release_name?: string
+  namespace?: string
+  values?: string
+  values_from?: string[]
+  chart_archive?: Uint8Array
+}
+
 export type KubernetesManifestGroupSpec = {
   compressed_data?: Uint8Array
   data?: string
   mode?: KubernetesManifestGroupSpecMode
+  helm?: KubernetesManifestGroupSpecHelmSource
 }
 
 export type ClusterKubernetesManifestsStatusSpecManifestStatus = {
@@ -1229,4 +1463,59 @@ export type MachineInstallDiskStatusSpec = {
   message?: string
   selection_hash?: string
   disks?: MachineInstallDiskStatusSpecDisk[]
+}
+
+export type ClusterTemplateSpec = {
+  template?: string
+  values?: {[key: string]: string}
+}
+
+export type ClusterTemplateStatusSpec = {
+  phase?: ClusterTemplateStatusSpecPhase
+  error?: string
+  cluster?: string
+  template_version?: string
+  resources?: number
+  drifted_resources?: string[]
+  last_drift?: GoogleProtobufTimestamp.Timestamp
+}
+
+export type GitRepositorySpec = {
+  url?: string
+  branch?: string
+  path?: string
+  credentials?: string
+  poll_interval?: GoogleProtobufDuration.Duration
+  suspend?: boolean
+  prune?: boolean
+}
+
+export type GitCredentialsSpec = {
+  username?: string
+  password?: string
+  ssh_private_key?: string
+  ssh_known_hosts?: string
+}
+
+export type GitRepositoryStatusSpecCommitStatus = {
+  sha?: string
+  message?: string
+  author?: string
+  phase?: GitRepositoryStatusSpecPhase
+  error?: string
+  synced_at?: GoogleProtobufTimestamp.Timestamp
+}
+
+export type GitRepositoryStatusSpecManagedResource = {
+  type?: string
+  id?: string
+}
+
+export type GitRepositoryStatusSpec = {
+  phase?: GitRepositoryStatusSpecPhase
+  error?: string
+  commit?: string
+  commits?: GitRepositoryStatusSpecCommitStatus[]
+  resources?: GitRepositoryStatusSpecManagedResource[]
+  last_fetch?: GoogleProtobufTimestamp.Timestamp
 }
\ No newline at end of file
@@ -35,6 +35,11 @@ export const ExposedServiceLabelAnnotationKey = "omni-kube-service-exposer.sider
 export const ExposedServicePortAnnotationKey = "omni-kube-service-exposer.sidero.dev/port";
 export const ExposedServiceIconAnnotationKey = "omni-kube-service-exposer.sidero.dev/icon";
 export const ExposedServicePrefixAnnotationKey = "omni-kube-service-exposer.sidero.dev/prefix";
+export const ExposedServiceProtocolAnnotationKey = "omni-kube-service-exposer.sidero.dev/protocol";
+export const ExposedServiceMinRoleAnnotationKey = "omni-kube-service-exposer.sidero.dev/min-role";
+export const ExposedServiceAllowedUsersAnnotationKey = "omni-kube-service-exposer.sidero.dev/allowed-users";
+export const ExposedServiceAllowedLabelsAnnotationKey = "omni-kube-service-exposer.sidero.dev/allowed-labels";
+export const ExposedServiceIdentityForwardingAnnotationKey = "omni-kube-service-exposer.sidero.dev/identity-forwarding";
 export const PlatformMetalID = "metal";
 export const TalosServiceType = "Services.v1alpha1.talos.dev";
 export const TalosCPUType = "CPUStats.perf.talos.dev";
@@ -90,11 +95,14 @@ export const ConfigPatchDescription = "description";
 export const KubernetesManifestName = "name";
 export const ClusterLocked = "omni.sidero.dev/cluster-locked";
 export const ClusterImportIsInProgress = "omni.sidero.dev/cluster-import-is-in-progress";
+export const ClusterEtcdRestoreInProgress = "omni.sidero.dev/cluster-etcd-restore-in-progress";
+export const ClusterDetachInProgress = "omni.sidero.dev/cluster-detach-in-progress";
 export const KernelArgsInitialized = "omni.sidero.dev/kernel-args-initialized";
 export const PlatformTagLabelsInitialized = "omni.sidero.dev/platform-tag-labels-initialized";
 export const EtcdBackupS3ConfID = "etcd-backup-s3-conf";
 export const EtcdBackupS3ConfType = "EtcdBackupS3Configs.omni.sidero.dev";
 export const BackupDataType = "BackupDatas.omni.sidero.dev";
+export const CanaryApprovalType = "CanaryApprovals.omni.sidero.dev";
 export const ClusterType = "Clusters.omni.sidero.dev";
 export const ClusterBootstrapStatusType = "ClusterBootstrapStatuses.omni.sidero.dev";
 export const ClusterConfigVersionType = "ClusterConfigVersions.omni.sidero.dev";
@@ -117,6 +125,8 @@ export const ClusterStatusType = "ClusterStatuses.omni.sidero.dev";
 export const ClusterStatusMetricsType = "ClusterStatusMetrics.omni.sidero.dev";
 export const ClusterStatusMetricsID = "metrics";
 export const ClusterTaintType = "ClusterTaints.omni.sidero.dev";
+export const ClusterTemplateType = "ClusterTemplates.omni.sidero.dev";
+export const ClusterTemplateStatusType = "ClusterTemplateStatuses.omni.sidero.dev";
 export const ClusterUUIDType = "ClusterUUIDs.omni.sidero.dev";
 export const ClusterWorkloadProxyStatusType = "ClusterWorkloadProxyStatuses.omni.sidero.dev";
 export const ConfigPatchType = "ConfigPatches.omni.sidero.dev";
@@ -129,11 +139,16 @@ export const EtcdBackupEncryptionType = "EtcdBackupEncryptions.omni.sidero.dev";
 export const EtcdBackupOverallStatusID = "etcdbackup-overall-status";
 export const EtcdBackupOverallStatusType = "EtcdBackupOverallStatuses.omni.sidero.dev";
 export const EtcdBackupStatusType = "EtcdBackupStatuses.omni.sidero.dev";
+export const EtcdBackupStoreConfigID = "etcd-backup-store-config";
+export const EtcdBackupStoreConfigType = "EtcdBackupStoreConfigs.omni.sidero.dev";
 export const EtcdManualBackupType = "EtcdManualBackups.omni.sidero.dev";
 export const ExposedServiceType = "ExposedServices.omni.sidero.dev";
 export const ExtensionsConfigurationType = "ExtensionsConfigurations.omni.sidero.dev";
 export const FeaturesConfigID = "features-config";
 export const FeaturesConfigType = "FeaturesConfigs.omni.sidero.dev";
+export const GitCredentialsType = "GitCredentials.omni.sidero.dev";
+export const GitRepositoryType = "GitRepositories.omni.sidero.dev";
+export const GitRepositoryStatusType = "GitRepositoryStatuses.omni.sidero.dev";
 export const ImageFactoryAuthType = "ImageFactoryAuths.omni.sidero.dev";
 export const ImagePullRequestType = "ImagePullRequests.omni.sidero.dev";
 export const ImagePullStatusType = "ImagePullStatuses.omni.sidero.dev";
@@ -170,6 +185,7 @@ export const LabelInfraProviderID = "omni.sidero.dev/infra-provider-id";
 export const LabelIsStaticInfraProvider = "omni.sidero.dev/is-static-infra-provider";
 export const LabelMachineRequest = "omni.sidero.dev/machine-request";
 export const LabelMachineRequestSet = "omni.sidero.dev/machine-request-set";
+export const LabelGitRepository = "omni.sidero.dev/git-repository";
 export const LabelNoManualAllocation = "omni.sidero.dev/no-manual-allocation";
 export const LabelManagedByMachineSetNodeController = "omni.sidero.dev/managed-by-machine-set-node-controller";
 export const LabelIsManagedByStaticInfraProvider = "omni.sidero.dev/is-managed-by-static-infra-provider";
@@ -229,15 +245,29 @@ export const MachineStatusMetricsID = "metrics";
 export const MachineStatusSnapshotType = "MachineStatusSnapshots.omni.sidero.dev";
 export const MachineUpgradeStatusType = "MachineUpgradeStatuses.omni.sidero.dev";
 export const MaintenanceConfigStatusType = "MaintenanceConfigStatuses.omni.sidero.dev";
+export const MaintenanceWindowType = "MaintenanceWindows.omni.sidero.dev";
 export const NodeForceDestroyRequestType = "NodeForceDestroyRequests.omni.sidero.dev";
 export const NotificationType = "Notifications.omni.sidero.dev";
 export const NotificationMachineRegistrationLimitID = "machine-registration-limit";
 export const NotificationNonImageFactoryMachinesID = "non-image-factory-machines";
 export const NotificationApproachingTalosVersionEndOfSupportID = "approaching-talos-version-end-of-support";
 export const NotificationTalosVersionEndOfSupportID = "talos-version-end-of-support";
+export const NotificationChannelType = "NotificationChannels.omni.sidero.dev";
+export const NotificationChannelStatusType = "NotificationChannelStatuses.omni.sidero.dev";
+export const NotificationRuleType = "NotificationRules.omni.sidero.dev";
+export const NotificationEventClusterPhase = "cluster-phase";
+export const NotificationEventTalosUpgradeFailed = "talos-upgrade-failed";
+export const NotificationEventKubernetesUpgradeFailed = "kubernetes-upgrade-failed";
+export const NotificationEventEtcdBackupFailed = "etcd-backup-failed";
+export const NotificationEventMachineDisconnected = "machine-disconnected";
+export const NotificationEventSecretRotation = "secret-rotation";
 export const OngoingTaskType = "OngoingTasks.omni.sidero.dev";
 export const RedactedClusterMachineConfigType = "RedactedClusterMachineConfigs.omni.sidero.dev";
+export const RotateBootstrapTokenType = "RotateBootstrapTokens.omni.sidero.dev";
+export const RotateEtcdCAType = "RotateEtcdCAs.omni.sidero.dev";
+export const RotateKubernetesAggregatorCAType = "RotateKubernetesAggregatorCAs.omni.sidero.dev";
 export const RotateKubernetesCAType = "RotateKubernetesCAs.omni.sidero.dev";
+export const RotateKubernetesServiceAccountKeyType = "RotateKubernetesServiceAccountKeys.omni.sidero.dev";
 export const RotateTalosCAType = "RotateTalosCAs.omni.sidero.dev";
 export const SchematicConfigurationType = "SchematicConfigurations.omni.sidero.dev";
 export const SecretRotationType = "SecretRotations.omni.sidero.dev";
@@ -251,6 +281,8 @@ export const LifecycleServiceMinTalosVersion = "1.13";
 export const AccessPolicyType = "AccessPolicies.omni.sidero.dev";
 export const AuthConfigID = "auth-config";
 export const AuthConfigType = "AuthConfigs.omni.sidero.dev";
+export const ElevationGrantType = "ElevationGrants.omni.sidero.dev";
+export const ElevationRequestType = "ElevationRequests.omni.sidero.dev";
 export const EulaAcceptanceID = "eula";
 export const EulaAcceptanceType = "EulaAcceptances.omni.sidero.dev";
 export const IdentityType = "Identities.omni.sidero.dev";
@@ -262,6 +294,7 @@ export const LabelIdentityUserID = "user-id";
 export const LabelIdentityTypeServiceAccount = "type-service-account";
 export const PublicKeyType = "PublicKeys.omni.sidero.dev";
 export const PublicKeyLastActiveType = "PublicKeyLastActives.omni.sidero.dev";
+export const RoleType = "Roles.omni.sidero.dev";
 export const SAMLLabelRuleType = "SAMLLabelRules.omni.sidero.dev";
 export const ServiceAccountStatusType = "ServiceAccountStatuses.omni.sidero.dev";
 export const UserType = "Users.omni.sidero.dev";
@@ -72,6 +72,14 @@ function secretComponentName(component?: SecretRotationSpecComponent) {
       return 'Talos CA'
     case SecretRotationSpecComponent.KUBERNETES_CA:
       return 'Kubernetes CA'
+    case SecretRotationSpecComponent.ETCD_CA:
+      return 'etcd CA'
+    case SecretRotationSpecComponent.KUBERNETES_AGGREGATOR_CA:
+      return 'Kubernetes aggregator CA'
+    case SecretRotationSpecComponent.KUBERNETES_SERVICE_ACCOUNT_KEY:
+      return 'Kubernetes service account key'
+    case SecretRotationSpecComponent.BOOTSTRAP_TOKEN:
+      return 'bootstrap token'
     default:
       return undefined
   }
@@ -23,6 +23,8 @@ const EVENT_TYPES: AuditLogEvent[] = [
   'k8s_access',
   'talos_access',
   'audit_log_access',
+  'workload_proxy_access',
+  'rate_limit',
   'create',
   'destroy',
   'update_with_conflicts',
@@ -0,0 +1,216 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/access/role"
+	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+)
+
+// Elevation states reported by ListElevations.
+const (
+	elevationStatePending = "pending"
+	elevationStateActive  = "active"
+	elevationStateExpired = "expired"
+	elevationStateRevoked = "revoked"
+)
+
+func (s *managementServer) RequestElevation(ctx context.Context, req *management.RequestElevationRequest) (*management.RequestElevationResponse, error) {
+	checkResult, err := s.authCheckGRPC(ctx, auth.WithValidSignature(true))
+	if err != nil {
+		return nil, err
+	}
+
+	if checkResult.Identity == "" || checkResult.Role == role.InfraProvider {
+		return nil, status.Error(codes.PermissionDenied, "elevation can only be requested by the users and service accounts")
+	}
+
+	requestedRole, err := role.Parse(req.Role)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if checkResult.Role.Compare(requestedRole) >= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "the requested role %q is not higher than the current role %q", requestedRole, checkResult.Role)
+	}
+
+	request := authres.NewElevationRequest(uuid.NewString())
+
+	request.Metadata().Labels().Set(authres.LabelIdentity, checkResult.Identity)
+
+	request.TypedSpec().Value = &specs.ElevationRequestSpec{
+		Identity: checkResult.Identity,
+		Role:     string(requestedRole),
+		Clusters: req.Clusters,
+		Reason:   req.Reason,
+		Duration: req.Duration,
+	}
+
+	if err = auth.ValidateElevationRequest(request.TypedSpec().Value); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err = s.omniState.Create(actor.MarkContextAsInternalActor(ctx), request); err != nil {
+		return nil, wrapError(err)
+	}
+
+	return &management.RequestElevationResponse{Id: request.Metadata().ID()}, nil
+}
+
+func (s *managementServer) ApproveElevation(ctx context.Context, req *management.ApproveElevationRequest) (*emptypb.Empty, error) {
+	checkResult, err := s.authCheckGRPC(ctx, auth.WithRole(role.Admin))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	request, err := safe.StateGetByID[*authres.ElevationRequest](ctx, s.omniState, req.Id)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	if strings.EqualFold(checkResult.Identity, request.TypedSpec().Value.GetIdentity()) {
+		return nil, status.Error(codes.InvalidArgument, "approving your own elevation is not allowed")
+	}
+
+	if elevationState(request) != elevationStatePending {
+		return nil, status.Errorf(codes.FailedPrecondition, "only the pending elevations can be approved, the elevation is %s", elevationState(request))
+	}
+
+	if _, err = safe.StateUpdateWithConflicts(ctx, s.omniState, request.Metadata(), func(res *authres.ElevationRequest) error {
+		if res.TypedSpec().Value.GetApprovedAt() != nil || res.TypedSpec().Value.GetRevoked() {
+			return status.Error(codes.FailedPrecondition, "the elevation was already approved or revoked")
+		}
+
+		res.TypedSpec().Value.ApprovedBy = checkResult.Identity
+		res.TypedSpec().Value.ApprovedAt = timestamppb.Now()
+
+		return nil
+	}); err != nil {
+		return nil, wrapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *managementServer) RevokeElevation(ctx context.Context, req *management.RevokeElevationRequest) (*emptypb.Empty, error) {
+	checkResult, err := s.authCheckGRPC(ctx, auth.WithValidSignature(true))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	request, err := safe.StateGetByID[*authres.ElevationRequest](ctx, s.omniState, req.Id)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	// the requesters can give up their own elevations, everything else is up to the admins
+	if !strings.EqualFold(checkResult.Identity, request.TypedSpec().Value.GetIdentity()) {
+		if err = checkResult.Role.Check(role.Admin); err != nil {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+	}
+
+	if request.TypedSpec().Value.GetRevoked() {
+		return &emptypb.Empty{}, nil
+	}
+
+	if _, err = safe.StateUpdateWithConflicts(ctx, s.omniState, request.Metadata(), func(res *authres.ElevationRequest) error {
+		res.TypedSpec().Value.Revoked = true
+		res.TypedSpec().Value.RevokedBy = checkResult.Identity
+
+		return nil
+	}); err != nil {
+		return nil, wrapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *managementServer) ListElevations(ctx context.Context, _ *emptypb.Empty) (*management.ListElevationsResponse, error) {
+	checkResult, err := s.authCheckGRPC(ctx, auth.WithValidSignature(true))
+	if err != nil {
+		return nil, err
+	}
+
+	var opts []state.ListOption
+
+	// the admins see all elevations, everyone else only their own
+	if checkResult.Role.Check(role.Admin) != nil {
+		opts = append(opts, state.WithLabelQuery(resource.LabelEqual(authres.LabelIdentity, checkResult.Identity)))
+	}
+
+	requests, err := safe.StateListAll[*authres.ElevationRequest](actor.MarkContextAsInternalActor(ctx), s.omniState, opts...)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	result := make([]*management.ListElevationsResponse_Elevation, 0, requests.Len())
+
+	for request := range requests.All() {
+		spec := request.TypedSpec().Value
+
+		elevation := &management.ListElevationsResponse_Elevation{
+			Id:         request.Metadata().ID(),
+			Identity:   spec.GetIdentity(),
+			Role:       spec.GetRole(),
+			Clusters:   spec.GetClusters(),
+			Reason:     spec.GetReason(),
+			Duration:   spec.GetDuration(),
+			State:      elevationState(request),
+			ApprovedBy: spec.GetApprovedBy(),
+			RevokedBy:  spec.GetRevokedBy(),
+			Created:    timestamppb.New(request.Metadata().Created()),
+		}
+
+		if spec.GetApprovedAt() != nil {
+			elevation.Expiration = timestamppb.New(elevationExpiration(spec))
+		}
+
+		result = append(result, elevation)
+	}
+
+	return &management.ListElevationsResponse{Elevations: result}, nil
+}
+
+func elevationExpiration(spec *specs.ElevationRequestSpec) time.Time {
+	return spec.GetApprovedAt().AsTime().Add(spec.GetDuration().AsDuration())
+}
+
+func elevationState(request *authres.ElevationRequest) string {
+	spec := request.TypedSpec().Value
+
+	switch {
+	case spec.GetRevoked():
+		return elevationStateRevoked
+	case spec.GetApprovedAt() == nil:
+		return elevationStatePending
+	case time.Now().After(elevationExpiration(spec)):
+		return elevationStateExpired
+	default:
+		return elevationStateActive
+	}
+}
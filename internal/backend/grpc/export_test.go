@@ -13,6 +13,7 @@ import (
 
 	"github.com/siderolabs/omni/client/pkg/imagefactory"
 	"github.com/siderolabs/omni/internal/backend/runtime"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup/store"
 	"github.com/siderolabs/omni/internal/backend/talos/lifecycle"
 	"github.com/siderolabs/omni/internal/pkg/config"
 )
@@ -25,6 +26,9 @@ const AuditLogFollowBatchSize = auditLogFollowBatchSize
 // EncryptedOutput is exported for testing.
 var EncryptedOutput = encryptedOutput
 
+// DetachMachineConfig is exported for testing.
+var DetachMachineConfig = detachMachineConfig
+
 type AuthServer = authServer
 
 // ManagementServerOption configures a test management server.
@@ -83,6 +87,13 @@ func WithLifecycleManager(m LifecycleManager) ManagementServerOption {
 	}
 }
 
+// WithEtcdBackupStoreFactory configures the etcd backup store factory on a test management server.
+func WithEtcdBackupStoreFactory(f store.Factory) ManagementServerOption {
+	return func(server *ManagementServer) {
+		server.etcdBackupStoreFactory = f
+	}
+}
+
 func NewAuthServer(st state.State, services config.Services, logger *zap.Logger) (*AuthServer, error) {
 	return newAuthServer(st, services, logger)
 }
@@ -90,3 +101,6 @@ func NewAuthServer(st state.State, services config.Services, logger *zap.Logger)
 func NewResourceServer(st state.State, runtimes map[string]runtime.Runtime, depGrapher DependencyGrapher) *ResourceServer {
 	return newResourceServer(st, runtimes, depGrapher)
 }
+
+// EtcdRestoreResetRequest is exported for testing.
+var EtcdRestoreResetRequest = etcdRestoreResetRequest
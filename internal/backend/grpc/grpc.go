@@ -102,6 +102,7 @@ func MakeServiceServers(
 			talosRuntime,
 			omniRuntime,
 			lifecycleManager,
+			omniRuntime.EtcdBackupStoreFactory(),
 		),
 		auth,
 		&COSIResourceServer{
@@ -7,6 +7,7 @@ package grpc
 
 import (
 	"context"
+	"crypto/ed25519"
 	"crypto/tls"
 	"errors"
 	"fmt"
@@ -42,6 +43,7 @@ import (
 	"github.com/siderolabs/omni/client/api/omni/management"
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/access/role"
+	"github.com/siderolabs/omni/client/pkg/auditchain"
 	"github.com/siderolabs/omni/client/pkg/constants"
 	"github.com/siderolabs/omni/client/pkg/imagefactory"
 	"github.com/siderolabs/omni/client/pkg/jointoken"
@@ -57,6 +59,7 @@ import (
 	"github.com/siderolabs/omni/internal/backend/runtime/kubernetes"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/audit/auditlog"
 	omniCtrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup/store"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/validations"
 	"github.com/siderolabs/omni/internal/backend/runtime/talos"
 	"github.com/siderolabs/omni/internal/backend/talos/lifecycle"
@@ -102,22 +105,23 @@ type LifecycleManager interface {
 func newManagementServer(cfg *config.Params, omniState state.State, jwtSigningKeyProvider JWTSigningKeyProvider, logHandler *siderolinkinternal.LogHandler, logger *zap.Logger,
 	dnsService *dns.Service, imageFactoryClients *imagefactory.Clients, auditor AuditLogger, omniconfigDest string,
 	kubernetesRuntime KubernetesRuntime, talosRuntime TalosRuntime, talosconfigProvider TalosconfigProvider,
-	lifecycleManager LifecycleManager,
+	lifecycleManager LifecycleManager, etcdBackupStoreFactory store.Factory,
 ) *managementServer {
 	return &managementServer{
-		cfg:                   cfg,
-		omniState:             omniState,
-		jwtSigningKeyProvider: jwtSigningKeyProvider,
-		logHandler:            logHandler,
-		logger:                logger,
-		dnsService:            dnsService,
-		imageFactoryClients:   imageFactoryClients,
-		auditor:               auditor,
-		omniconfigDest:        omniconfigDest,
-		kubernetesRuntime:     kubernetesRuntime,
-		talosRuntime:          talosRuntime,
-		talosconfigProvider:   talosconfigProvider,
-		lifecycleManager:      lifecycleManager,
+		cfg:                    cfg,
+		omniState:              omniState,
+		jwtSigningKeyProvider:  jwtSigningKeyProvider,
+		logHandler:             logHandler,
+		logger:                 logger,
+		dnsService:             dnsService,
+		imageFactoryClients:    imageFactoryClients,
+		auditor:                auditor,
+		omniconfigDest:         omniconfigDest,
+		kubernetesRuntime:      kubernetesRuntime,
+		talosRuntime:           talosRuntime,
+		talosconfigProvider:    talosconfigProvider,
+		lifecycleManager:       lifecycleManager,
+		etcdBackupStoreFactory: etcdBackupStoreFactory,
 
 		auditLogFollowLease: auditLogFollowDefaultLease,
 	}
@@ -135,17 +139,18 @@ const (
 
 // managementServer implements omni management service.
 type managementServer struct {
-	cfg                   *config.Params
-	kubernetesRuntime     KubernetesRuntime
-	jwtSigningKeyProvider JWTSigningKeyProvider
-	auditor               AuditLogger
-	talosconfigProvider   TalosconfigProvider
-	talosRuntime          TalosRuntime
-	logHandler            *siderolinkinternal.LogHandler
-	logger                *zap.Logger
-	dnsService            *dns.Service
-	imageFactoryClients   *imagefactory.Clients
-	lifecycleManager      LifecycleManager
+	cfg                    *config.Params
+	kubernetesRuntime      KubernetesRuntime
+	jwtSigningKeyProvider  JWTSigningKeyProvider
+	auditor                AuditLogger
+	talosconfigProvider    TalosconfigProvider
+	talosRuntime           TalosRuntime
+	logHandler             *siderolinkinternal.LogHandler
+	logger                 *zap.Logger
+	dnsService             *dns.Service
+	imageFactoryClients    *imagefactory.Clients
+	lifecycleManager       LifecycleManager
+	etcdBackupStoreFactory store.Factory
 	management.UnimplementedManagementServiceServer
 	omniState      state.State
 	omniconfigDest string
@@ -606,10 +611,20 @@ func (s *managementServer) ReadAuditLog(req *management.ReadAuditLogRequest, srv
 		filters.Start = time.UnixMilli(startTsMs)
 	}
 
+	if req.GetWithProofs() {
+		if err = validateAuditLogProofsRequest(req); err != nil {
+			return err
+		}
+	}
+
 	if err = s.auditor.AuditAuditLogAccess(ctx, filters); err != nil {
 		return fmt.Errorf("failed to audit the audit log access: %w", err)
 	}
 
+	if req.GetWithProofs() {
+		return s.readAuditLogProofs(ctx, filters.Start, filters.End, srv)
+	}
+
 	rdr, err := s.auditor.Reader(ctx, filters)
 	if err != nil {
 		return err
@@ -636,6 +651,21 @@ func (s *managementServer) ReadAuditLog(req *management.ReadAuditLogRequest, srv
 	return closeFn()
 }
 
+// AuditLogSigningKey returns the public key the audit log chain markers are signed with, for the verifiers to pin it.
+func (s *managementServer) AuditLogSigningKey(ctx context.Context, _ *emptypb.Empty) (*management.AuditLogSigningKeyResponse, error) {
+	// checked by exact role, the same way as reading the audit log.
+	if _, err := s.authCheckGRPC(ctx, auth.WithExactRoles(role.AuditLogRoles...)); err != nil {
+		return nil, err
+	}
+
+	publicKey, err := s.auditor.SigningPublicKey()
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return &management.AuditLogSigningKeyResponse{PublicKey: publicKey}, nil
+}
+
 // followAuditLog streams the audit log: the backlog from the requested start position first,
 // then new events as they are written, until the stream lease expires. A client keeps
 // following by reconnecting and resuming from the id of the last received event.
@@ -754,6 +784,7 @@ func validateAuditLogFollowRequest(req *management.ReadAuditLogRequest) error {
 		{"resource_id", req.GetResourceId() != ""},
 		{"cluster_id", req.GetClusterId() != ""},
 		{"actor", req.GetActor() != ""},
+		{"with_proofs", req.GetWithProofs()},
 	}
 
 	for _, field := range incompatible {
@@ -765,6 +796,82 @@ func validateAuditLogFollowRequest(req *management.ReadAuditLogRequest) error {
 	return nil
 }
 
+func validateAuditLogProofsRequest(req *management.ReadAuditLogRequest) error {
+	incompatible := []struct {
+		name string
+		set  bool
+	}{
+		{"order_by_field", req.GetOrderByField() != management.AuditLogOrderByField_AUDIT_LOG_ORDER_BY_FIELD_UNSPECIFIED},
+		{"order_by_dir", req.GetOrderByDir() != management.AuditLogOrderByDir_AUDIT_LOG_ORDER_BY_DIR_UNSPECIFIED},
+		{"search", req.GetSearch() != ""},
+		{"event_type", req.GetEventType() != management.AuditLogEventType_AUDIT_LOG_EVENT_TYPE_UNSPECIFIED},
+		{"resource_type", req.GetResourceType() != ""},
+		{"resource_id", req.GetResourceId() != ""},
+		{"cluster_id", req.GetClusterId() != ""},
+		{"actor", req.GetActor() != ""},
+	}
+
+	for _, field := range incompatible {
+		if field.set {
+			return status.Errorf(codes.InvalidArgument, "%s cannot be combined with with_proofs", field.name)
+		}
+	}
+
+	return nil
+}
+
+// readAuditLogProofs streams the events with their hash chain proofs, for the client to verify the chain.
+func (s *managementServer) readAuditLogProofs(ctx context.Context, start, end time.Time, srv grpc.ServerStreamingServer[management.ReadAuditLogResponse]) error {
+	rdr, err := s.auditor.ProofReader(ctx, start, end)
+	if err != nil {
+		return err
+	}
+
+	closeFn := sync.OnceValue(rdr.Close)
+	defer closeFn() //nolint:errcheck
+
+	for {
+		event, err := rdr.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return err
+		}
+
+		proof := &management.AuditLogProof{
+			PrevHash: event.PrevHash,
+			Hash:     event.Hash,
+		}
+
+		for _, marker := range event.Markers {
+			proof.Markers = append(proof.Markers, &management.AuditLogChainMarker{
+				Kind:        auditLogChainMarkerKind(marker.Kind),
+				Id:          marker.ID,
+				Hash:        marker.Hash,
+				TimestampMs: marker.TimestampMs,
+				PublicKey:   marker.PublicKey,
+				Signature:   marker.Signature,
+			})
+		}
+
+		if err = srv.Send(&management.ReadAuditLogResponse{AuditLog: event.Payload, Id: event.ID, Proof: proof}); err != nil {
+			return err
+		}
+	}
+
+	return closeFn()
+}
+
+func auditLogChainMarkerKind(kind auditchain.MarkerKind) management.AuditLogChainMarker_Kind {
+	if kind == auditchain.MarkerTruncation {
+		return management.AuditLogChainMarker_TRUNCATION
+	}
+
+	return management.AuditLogChainMarker_CHECKPOINT
+}
+
 func (s *managementServer) ensureSchematic(ctx context.Context, talosVersion string, machineStatus *omnires.MachineStatus) (string, string, error) {
 	factoryClient, err := s.imageFactoryClients.ForTalosVersion(ctx, talosVersion)
 	if err != nil {
@@ -1535,6 +1642,8 @@ func generateDest(apiurl string) (string, error) {
 // AuditLogger is an interface for reading the audit log and logging access events.
 type AuditLogger interface {
 	Reader(ctx context.Context, filters auditlog.ReadFilters) (auditlog.Reader, error)
+	ProofReader(ctx context.Context, start, end time.Time) (auditlog.ProofReader, error)
+	SigningPublicKey() (ed25519.PublicKey, error)
 	FollowStart(ctx context.Context, startTsMs int64) (int64, error)
 	FollowBatch(ctx context.Context, afterID int64, limit int64) ([]auditlog.Entry, error)
 	FollowSubscribe() (<-chan struct{}, func())
@@ -1597,6 +1706,10 @@ func auditLogEventType(e management.AuditLogEventType) auditlog.EventType {
 		return auditlog.EventTypeK8SAccess
 	case management.AuditLogEventType_AUDIT_LOG_EVENT_TYPE_AUDIT_LOG_ACCESS:
 		return auditlog.EventTypeAuditLogAccess
+	case management.AuditLogEventType_AUDIT_LOG_EVENT_TYPE_WORKLOAD_PROXY_ACCESS:
+		return auditlog.EventTypeWorkloadProxyAccess
+	case management.AuditLogEventType_AUDIT_LOG_EVENT_TYPE_RATE_LIMIT:
+		return auditlog.EventTypeRateLimit
 	}
 
 	return auditlog.EventTypeUnspecified
@@ -7,6 +7,8 @@ package grpc_test
 
 import (
 	"context"
+	"crypto/ed25519"
+	"errors"
 	"net"
 	"path/filepath"
 	"sync/atomic"
@@ -249,6 +251,10 @@ func (a *e2eAuditor) AuditAuditLogAccess(context.Context, auditlog.ReadFilters)
 
 func (a *e2eAuditor) AuditAuditLogFollow(context.Context, int64, int64) error { return nil }
 
+func (a *e2eAuditor) SigningPublicKey() (ed25519.PublicKey, error) {
+	return nil, errors.New("not implemented")
+}
+
 // authStream overrides the stream context with one carrying admin authentication, standing in
 // for the signature interceptor of the full server.
 type authStream struct {
@@ -7,6 +7,7 @@ package grpc_test
 
 import (
 	"context"
+	"crypto/ed25519"
 	"errors"
 	"io"
 	"slices"
@@ -20,9 +21,11 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/siderolabs/omni/client/api/omni/management"
 	"github.com/siderolabs/omni/client/pkg/access/role"
+	"github.com/siderolabs/omni/client/pkg/auditchain"
 	grpcomni "github.com/siderolabs/omni/internal/backend/grpc"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/audit/auditlog"
 )
@@ -103,6 +106,74 @@ func TestReadAuditLogRequiresAuditorOrAdmin(t *testing.T) {
 	}
 }
 
+func TestReadAuditLogWithProofs(t *testing.T) {
+	marker := auditchain.Marker{Kind: auditchain.MarkerTruncation, ID: 5, Hash: []byte("prev"), TimestampMs: 1000, PublicKey: []byte("key"), Signature: []byte("sig")}
+
+	auditor := &auditLogAccessAuditor{
+		proofEvents: []auditchain.Event{
+			{ID: 5, Payload: []byte("{\"event_type\":\"create\"}\n"), PrevHash: []byte("prev"), Hash: []byte("hash-5"), Markers: []auditchain.Marker{marker}},
+			{ID: 6, Payload: []byte("{\"event_type\":\"update\"}\n"), PrevHash: []byte("hash-5"), Hash: []byte("hash-6")},
+		},
+	}
+	server := newAuditLogTestServer(t, auditor)
+
+	stream := &auditLogStream{ctx: managementPowerTestContext(t.Context(), "admin@example.com", role.Admin)}
+
+	err := server.ReadAuditLog(&management.ReadAuditLogRequest{StartTime: "2026-01-01", WithProofs: true}, stream)
+	require.NoError(t, err)
+
+	require.NotNil(t, auditor.accessFilters)
+	require.False(t, auditor.readerCalled)
+
+	responses := stream.snapshotResponses()
+	require.Len(t, responses, 2)
+
+	assert.Equal(t, int64(5), responses[0].Id)
+	assert.Equal(t, []byte("{\"event_type\":\"create\"}\n"), responses[0].AuditLog)
+	assert.Equal(t, []byte("prev"), responses[0].Proof.GetPrevHash())
+	assert.Equal(t, []byte("hash-5"), responses[0].Proof.GetHash())
+	require.Len(t, responses[0].Proof.GetMarkers(), 1)
+	assert.Equal(t, management.AuditLogChainMarker_TRUNCATION, responses[0].Proof.GetMarkers()[0].GetKind())
+	assert.Equal(t, []byte("sig"), responses[0].Proof.GetMarkers()[0].GetSignature())
+
+	assert.Equal(t, int64(6), responses[1].Id)
+	assert.Equal(t, []byte("hash-5"), responses[1].Proof.GetPrevHash())
+	assert.Empty(t, responses[1].Proof.GetMarkers())
+}
+
+func TestAuditLogSigningKey(t *testing.T) {
+	for _, tt := range auditLogRoles {
+		t.Run(string(tt.role), func(t *testing.T) {
+			auditor := &auditLogAccessAuditor{signingKey: []byte("key")}
+			server := newAuditLogTestServer(t, auditor)
+
+			resp, err := server.AuditLogSigningKey(managementPowerTestContext(t.Context(), "user@example.com", tt.role), &emptypb.Empty{})
+
+			if !tt.allowed {
+				require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, []byte("key"), resp.GetPublicKey())
+		})
+	}
+}
+
+func TestReadAuditLogWithProofsRejectsFilters(t *testing.T) {
+	auditor := &auditLogAccessAuditor{}
+	server := newAuditLogTestServer(t, auditor)
+	stream := &auditLogStream{ctx: managementPowerTestContext(t.Context(), "admin@example.com", role.Admin)}
+
+	err := server.ReadAuditLog(&management.ReadAuditLogRequest{WithProofs: true, Search: "something"}, stream)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.ErrorContains(t, err, "search")
+
+	require.Nil(t, auditor.accessFilters)
+	require.Empty(t, stream.numResponses())
+}
+
 func TestFollowAuditLogRejectsIncompatibleFields(t *testing.T) {
 	for _, testCase := range []struct {
 		req  *management.ReadAuditLogRequest
@@ -120,6 +191,7 @@ func TestFollowAuditLogRejectsIncompatibleFields(t *testing.T) {
 		{name: "actor", req: &management.ReadAuditLogRequest{Actor: "someone@example.com"}},
 		{name: "start_ts_ms", req: &management.ReadAuditLogRequest{StartTsMs: -1}},
 		{name: "from_id", req: &management.ReadAuditLogRequest{FromId: -1}},
+		{name: "with_proofs", req: &management.ReadAuditLogRequest{WithProofs: true}},
 	} {
 		t.Run(testCase.name, func(t *testing.T) {
 			auditor := &auditLogAccessAuditor{}
@@ -375,6 +447,8 @@ type auditLogAccessAuditor struct {
 	followBatchErr    error
 	accessFilters     *auditlog.ReadFilters
 	events            [][]byte
+	proofEvents       []auditchain.Event
+	signingKey        ed25519.PublicKey
 	followStartPos    int64
 	followFromID      int64
 	followStartTsMs   int64
@@ -418,6 +492,14 @@ func (a *auditLogAccessAuditor) Reader(context.Context, auditlog.ReadFilters) (a
 	return &sliceAuditLogReader{data: a.events}, nil
 }
 
+func (a *auditLogAccessAuditor) ProofReader(context.Context, time.Time, time.Time) (auditlog.ProofReader, error) {
+	return &sliceAuditLogProofReader{events: a.proofEvents}, nil
+}
+
+func (a *auditLogAccessAuditor) SigningPublicKey() (ed25519.PublicKey, error) {
+	return a.signingKey, nil
+}
+
 func (a *auditLogAccessAuditor) AuditTalosAccess(context.Context, string, string, string) error {
 	return nil
 }
@@ -480,6 +562,25 @@ func (r *sliceAuditLogReader) Close() error {
 	return nil
 }
 
+type sliceAuditLogProofReader struct {
+	events []auditchain.Event
+}
+
+func (r *sliceAuditLogProofReader) Read() (auditchain.Event, error) {
+	if len(r.events) == 0 {
+		return auditchain.Event{}, io.EOF
+	}
+
+	event := r.events[0]
+	r.events = r.events[1:]
+
+	return event, nil
+}
+
+func (r *sliceAuditLogProofReader) Close() error {
+	return nil
+}
+
 //nolint:govet // field grouping is preferred over alignment here
 type auditLogStream struct {
 	grpc.ServerStream
@@ -0,0 +1,131 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/access/role"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/oidc/external"
+	"github.com/siderolabs/omni/internal/backend/services/clusterautoscaler"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+)
+
+// ClusterAutoscalerCredentials issues the client certificate the cluster autoscaler of the cluster authenticates to Omni with.
+//
+// The certificate is signed with the current JWT signing key, so it can't outlive the key rotation interval.
+func (s *managementServer) ClusterAutoscalerCredentials(ctx context.Context, req *management.ClusterAutoscalerCredentialsRequest) (*management.ClusterAutoscalerCredentialsResponse, error) {
+	if req.Cluster == "" {
+		return nil, status.Error(codes.InvalidArgument, "cluster is required")
+	}
+
+	if len(req.Csr) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "csr is required")
+	}
+
+	address, err := s.clusterAutoscalerAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	ttl := external.KeyRotationInterval
+
+	if req.Ttl != nil {
+		ttl = req.Ttl.AsDuration()
+	}
+
+	if ttl <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "ttl must be positive")
+	}
+
+	if ttl > external.KeyRotationInterval {
+		return nil, status.Errorf(codes.InvalidArgument, "ttl is too long (max allowed: %s)", external.KeyRotationInterval)
+	}
+
+	_, authResult, err := s.checkClusterAuthorization(ctx, req.Cluster, role.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	if authResult.Identity == "" {
+		return nil, status.Error(codes.FailedPrecondition, "cluster autoscaler credentials can only be issued with the authentication enabled")
+	}
+
+	if _, err = safe.StateGetByID[*omnires.Cluster](actor.MarkContextAsInternalActor(ctx), s.omniState, req.Cluster); err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "cluster %q not found", req.Cluster)
+		}
+
+		return nil, err
+	}
+
+	signingKey, err := s.jwtSigningKeyProvider.SigningKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	credentials := clusterautoscaler.Credentials{
+		Expiration: time.Now().Add(ttl),
+		Identity:   authResult.Identity,
+		Cluster:    req.Cluster,
+	}
+
+	certificate, err := clusterautoscaler.IssueCertificate(signingKey, req.Csr, credentials)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s.logger.Info("issued cluster autoscaler credentials",
+		zap.String("identity", credentials.Identity),
+		zap.String("cluster", req.Cluster),
+		zap.Time("expiration", credentials.Expiration),
+	)
+
+	return &management.ClusterAutoscalerCredentialsResponse{
+		Certificate: certificate,
+		Expiration:  timestamppb.New(credentials.Expiration),
+		Address:     address,
+	}, nil
+}
+
+// clusterAutoscalerAddress returns the endpoint the cluster autoscaler connects to.
+//
+// Unless it is set explicitly, it is the host of the API advertised URL with the port the cluster autoscaler service listens on.
+func (s *managementServer) clusterAutoscalerAddress() (string, error) {
+	endpoint := s.cfg.Services.ClusterAutoscaler.GetEndpoint()
+	if endpoint == "" {
+		return "", status.Error(codes.FailedPrecondition, "the cluster autoscaler service is disabled")
+	}
+
+	if advertisedEndpoint := s.cfg.Services.ClusterAutoscaler.GetAdvertisedEndpoint(); advertisedEndpoint != "" {
+		return advertisedEndpoint, nil
+	}
+
+	_, port, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid cluster autoscaler endpoint %q: %w", endpoint, err)
+	}
+
+	advertisedURL, err := url.Parse(s.cfg.Services.Api.GetAdvertisedURL())
+	if err != nil {
+		return "", fmt.Errorf("invalid API advertised URL: %w", err)
+	}
+
+	return net.JoinHostPort(advertisedURL.Hostname(), port), nil
+}
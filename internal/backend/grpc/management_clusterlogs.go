@@ -0,0 +1,133 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"regexp"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/access/role"
+	"github.com/siderolabs/omni/client/pkg/cosi/labels"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/siderolink/logstore"
+)
+
+// ClusterLogs streams the logs of the machines of the cluster, interleaved by the time the lines were received at.
+//
+// The machines are selected when the stream starts: the machines added to the cluster later are not followed.
+func (s *managementServer) ClusterLogs(req *management.ClusterLogsRequest, serv grpc.ServerStreamingServer[management.ClusterLogsResponse]) error {
+	ctx := serv.Context()
+
+	if req.Cluster == "" {
+		return status.Error(codes.InvalidArgument, "cluster is required")
+	}
+
+	query := logstore.Query{
+		Services:  req.Services,
+		TailLines: int(req.TailLines),
+		Follow:    req.Follow,
+	}
+
+	if req.Since != nil {
+		query.Since = req.Since.AsTime()
+	}
+
+	if req.Until != nil {
+		query.Until = req.Until.AsTime()
+	}
+
+	if !query.Since.IsZero() && !query.Until.IsZero() && !query.Since.Before(query.Until) {
+		return status.Error(codes.InvalidArgument, "since must be before until")
+	}
+
+	if req.Pattern != "" {
+		pattern, err := regexp.Compile(req.Pattern)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid pattern: %s", err)
+		}
+
+		query.Pattern = pattern
+	}
+
+	selectors, err := labels.ParseSelectors(req.Selectors)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid selectors: %s", status.Convert(err).Message())
+	}
+
+	// getting cluster logs is equivalent to reading the machine resources of the cluster
+	authCtx, _, err := s.checkClusterAuthorization(ctx, req.Cluster, role.Reader)
+	if err != nil {
+		return err
+	}
+
+	internalCtx := actor.MarkContextAsInternalActor(authCtx)
+
+	if _, err = safe.StateGetByID[*omnires.Cluster](internalCtx, s.omniState, req.Cluster); err != nil {
+		if state.IsNotFoundError(err) {
+			return status.Errorf(codes.NotFound, "cluster %q not found", req.Cluster)
+		}
+
+		return err
+	}
+
+	machineStatuses, err := safe.StateListAll[*omnires.MachineStatus](internalCtx, s.omniState,
+		state.WithLabelQuery(resource.LabelEqual(omnires.LabelCluster, req.Cluster)),
+	)
+	if err != nil {
+		return err
+	}
+
+	for machineStatus := range machineStatuses.All() {
+		if len(selectors) == 0 || selectors.Matches(*machineStatus.Metadata().Labels()) {
+			query.MachineIDs = append(query.MachineIDs, machineStatus.Metadata().ID())
+		}
+	}
+
+	identities, err := safe.StateListAll[*omnires.ClusterMachineIdentity](internalCtx, s.omniState,
+		state.WithLabelQuery(resource.LabelEqual(omnires.LabelCluster, req.Cluster)),
+	)
+	if err != nil {
+		return err
+	}
+
+	nodenames := make(map[string]string, identities.Len())
+
+	for identity := range identities.All() {
+		nodenames[identity.Metadata().ID()] = identity.TypedSpec().Value.Nodename
+	}
+
+	logReader, err := s.logHandler.QueryLogs(authCtx, query)
+	if err != nil {
+		return handleError(err)
+	}
+
+	defer logReader.Close() //nolint:errcheck
+
+	for {
+		entry, err := logReader.ReadEntry(authCtx)
+		if err != nil {
+			return handleError(err)
+		}
+
+		if err = serv.Send(&management.ClusterLogsResponse{
+			MachineId: entry.MachineID,
+			Node:      nodenames[entry.MachineID],
+			Data:      entry.Message,
+			Time:      timestamppb.New(entry.Time),
+		}); err != nil {
+			return err
+		}
+	}
+}
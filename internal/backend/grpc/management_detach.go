@@ -0,0 +1,689 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
+	documentconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	configcluster "github.com/siderolabs/talos/pkg/machinery/config/types/cluster"
+	runtimecfg "github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+	talossiderolink "github.com/siderolabs/talos/pkg/machinery/config/types/siderolink"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	talosrole "github.com/siderolabs/talos/pkg/machinery/role"
+	"go.uber.org/zap"
+	"go.yaml.in/yaml/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/access/role"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
+	"github.com/siderolabs/omni/internal/backend/runtime/helpers"
+	omniCtrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	siderolinkinternal "github.com/siderolabs/omni/internal/pkg/siderolink"
+)
+
+const (
+	// detachApplyTimeout bounds the apply of the detached config on a single machine.
+	detachApplyTimeout = time.Minute
+
+	// detachTeardownTimeout bounds the wait for the cluster to be removed from Omni.
+	detachTeardownTimeout = 15 * time.Minute
+
+	// detachDisconnectTimeout bounds the wait for the machine to drop its SideroLink connection once the detached config is applied.
+	detachDisconnectTimeout = 5 * time.Minute
+)
+
+// DetachCluster exports the cluster for running it without Omni.
+//
+// The cluster is locked, so that Omni stops changing its machines, and marked as exported, so that its machines are not reset
+// once the cluster is removed. The returned machine configs carry the cluster secrets and have the Omni specific settings stripped.
+// Running it again for a cluster being detached returns the same configs.
+func (s *managementServer) DetachCluster(ctx context.Context, req *management.DetachClusterRequest) (*management.DetachClusterResponse, error) {
+	if req.Cluster == "" {
+		return nil, status.Error(codes.InvalidArgument, "cluster is required")
+	}
+
+	authCtx, _, err := s.checkClusterAuthorization(ctx, req.Cluster, role.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(authCtx)
+
+	cluster, err := safe.StateGetByID[*omnires.Cluster](ctx, s.omniState, req.Cluster)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Errorf(codes.NotFound, "cluster %q not found", req.Cluster)
+		}
+
+		return nil, err
+	}
+
+	if cluster.Metadata().Phase() == resource.PhaseTearingDown {
+		return nil, status.Errorf(codes.FailedPrecondition, "cluster %q is being torn down", req.Cluster)
+	}
+
+	detachEndpoint, detaching := cluster.Metadata().Annotations().Get(omnires.ClusterDetachInProgress)
+
+	if _, locked := cluster.Metadata().Annotations().Get(omnires.ClusterLocked); locked && !detaching {
+		return nil, status.Errorf(codes.FailedPrecondition, "cluster %q is locked", req.Cluster)
+	}
+
+	endpoints, err := helpers.GetMachineEndpoints(ctx, s.omniState, req.Cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(endpoints) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "cluster %q has no control plane nodes with known addresses", req.Cluster)
+	}
+
+	endpoint := req.ControlPlaneEndpoint
+
+	switch {
+	case detaching && endpoint == "":
+		endpoint = detachEndpoint
+	case detaching && endpoint != detachEndpoint:
+		return nil, status.Errorf(codes.FailedPrecondition, "cluster %q is already being detached with the control plane endpoint %q", req.Cluster, detachEndpoint)
+	case endpoint == "":
+		endpoint = "https://" + net.JoinHostPort(endpoints[0], "6443")
+	}
+
+	endpointURL, err := parseDetachEndpoint(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("cluster detach request received", zap.String("cluster", req.Cluster), zap.String("endpoint", endpoint))
+
+	// the locked cluster can't be updated, so it is locked only on the first run
+	if !detaching {
+		if err = s.lockClusterForDetach(ctx, req.Cluster, endpoint); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = s.markClusterAsExported(ctx, req.Cluster); err != nil {
+		return nil, err
+	}
+
+	machines, err := s.detachedMachineConfigs(ctx, req.Cluster, endpointURL)
+	if err != nil {
+		return nil, err
+	}
+
+	talosconfig, err := s.detachedTalosconfig(ctx, req.Cluster, endpoints)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate talosconfig: %w", err)
+	}
+
+	kubeconfig, err := s.detachedKubeconfig(ctx, req.Cluster, endpointURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate kubeconfig: %w", err)
+	}
+
+	if err = s.auditTalosAccess(ctx, management.ManagementService_DetachCluster_FullMethodName, req.Cluster, ""); err != nil {
+		return nil, err
+	}
+
+	return &management.DetachClusterResponse{
+		Machines:    machines,
+		Talosconfig: talosconfig,
+		Kubeconfig:  kubeconfig,
+	}, nil
+}
+
+// ReleaseDetachedCluster applies the detached configs to the machines of the cluster and removes the cluster and the machines from Omni.
+//
+// The machines are not reset: they keep running the cluster with the applied configs, without the SideroLink connection to Omni.
+//
+//nolint:gocognit
+func (s *managementServer) ReleaseDetachedCluster(req *management.ReleaseDetachedClusterRequest, srv grpc.ServerStreamingServer[management.ReleaseDetachedClusterResponse]) error {
+	ctx := srv.Context()
+
+	if req.Cluster == "" {
+		return status.Error(codes.InvalidArgument, "cluster is required")
+	}
+
+	authCtx, _, err := s.checkClusterAuthorization(ctx, req.Cluster, role.Admin)
+	if err != nil {
+		return err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(authCtx)
+
+	cluster, err := safe.StateGetByID[*omnires.Cluster](ctx, s.omniState, req.Cluster)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return status.Errorf(codes.NotFound, "cluster %q not found", req.Cluster)
+		}
+
+		return err
+	}
+
+	endpoint, detaching := cluster.Metadata().Annotations().Get(omnires.ClusterDetachInProgress)
+	if !detaching {
+		return status.Errorf(codes.FailedPrecondition, "cluster %q is not being detached", req.Cluster)
+	}
+
+	endpointURL, err := parseDetachEndpoint(endpoint)
+	if err != nil {
+		return err
+	}
+
+	var machines []*management.DetachClusterResponse_Machine
+
+	// the configs were applied already if the previous run got as far as the cluster teardown
+	if cluster.Metadata().Phase() == resource.PhaseRunning {
+		if machines, err = s.detachedMachineConfigs(ctx, req.Cluster, endpointURL); err != nil {
+			return err
+		}
+	}
+
+	// the machines released by the previous runs already run the detached config, so they are disconnected from Omni by design
+	released, err := s.releasedMachines(ctx, req.Cluster)
+	if err != nil {
+		return err
+	}
+
+	machines = slices.DeleteFunc(machines, func(machine *management.DetachClusterResponse_Machine) bool {
+		return slices.Contains(released, machine.Id)
+	})
+
+	// the machines which are not connected can't get the detached config, and they would keep running the Omni config after the release
+	disconnected, err := s.disconnectedMachines(ctx, machines)
+	if err != nil {
+		return err
+	}
+
+	if len(disconnected) > 0 && !req.Force {
+		return status.Errorf(codes.FailedPrecondition,
+			"machines %q of cluster %q are not connected to Omni, so the detached config can't be applied to them: "+
+				"release the cluster with force to apply the detached configs to them manually", disconnected, req.Cluster)
+	}
+
+	machineSetNodes, err := safe.StateListAll[*omnires.MachineSetNode](ctx, s.omniState, state.WithLabelQuery(resource.LabelEqual(omnires.LabelCluster, req.Cluster)))
+	if err != nil {
+		return fmt.Errorf("failed to list machine set nodes: %w", err)
+	}
+
+	// Best-effort progress send, same as EtcdRestore: the release keeps running if the client goes away.
+	var disconnectLogged bool
+
+	send := func(format string, args ...any) {
+		if srv.Context().Err() != nil {
+			return
+		}
+
+		if sendErr := srv.Send(&management.ReleaseDetachedClusterResponse{Message: fmt.Sprintf(format, args...)}); sendErr != nil && !disconnectLogged {
+			disconnectLogged = true
+
+			s.logger.Info("cluster release client disconnected; operation continues server-side", zap.String("cluster", req.Cluster), zap.Error(sendErr))
+		}
+	}
+
+	// Once the first machine has dropped its SideroLink connection, stopping halfway leaves the cluster partially managed.
+	runCtx := context.WithoutCancel(ctx)
+
+	for _, machine := range machines {
+		if err = s.applyDetachedConfig(runCtx, req.Cluster, machine, send); err != nil {
+			return err
+		}
+	}
+
+	send("[omni] removing cluster %q from Omni", req.Cluster)
+
+	if _, err = s.omniState.Teardown(runCtx, cluster.Metadata()); err != nil && !state.IsNotFoundError(err) {
+		return fmt.Errorf("failed to tear down cluster %q: %w", req.Cluster, err)
+	}
+
+	teardownCtx, cancel := context.WithTimeout(runCtx, detachTeardownTimeout)
+	defer cancel()
+
+	if _, err = s.omniState.WatchFor(teardownCtx, cluster.Metadata(), state.WithEventTypes(state.Destroyed)); err != nil {
+		return fmt.Errorf("failed to wait for cluster %q to be removed: %w", req.Cluster, err)
+	}
+
+	for machineSetNode := range machineSetNodes.All() {
+		send("[omni] removing machine %s from Omni", machineSetNode.Metadata().ID())
+
+		if _, err = s.omniState.Teardown(runCtx, siderolink.NewLink(machineSetNode.Metadata().ID(), nil).Metadata()); err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("failed to remove machine %q: %w", machineSetNode.Metadata().ID(), err)
+		}
+	}
+
+	send("cluster %q is detached from Omni", req.Cluster)
+
+	return nil
+}
+
+func parseDetachEndpoint(endpoint string) (*url.URL, error) {
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid control plane endpoint %q: %s", endpoint, err)
+	}
+
+	if endpointURL.Scheme != "https" || endpointURL.Host == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid control plane endpoint %q: should be an https URL", endpoint)
+	}
+
+	return endpointURL, nil
+}
+
+// lockClusterForDetach locks the cluster for the detach.
+func (s *managementServer) lockClusterForDetach(ctx context.Context, clusterID, endpoint string) error {
+	_, err := safe.StateUpdateWithConflicts(ctx, s.omniState, omnires.NewCluster(clusterID).Metadata(), func(res *omnires.Cluster) error {
+		if _, locked := res.Metadata().Annotations().Get(omnires.ClusterLocked); locked {
+			return status.Errorf(codes.FailedPrecondition, "cluster %q is locked", clusterID)
+		}
+
+		res.Metadata().Annotations().Set(omnires.ClusterDetachInProgress, endpoint)
+		res.Metadata().Annotations().Set(omnires.ClusterLocked, "")
+
+		return nil
+	})
+
+	return err
+}
+
+// markClusterAsExported taints the cluster as exported, so that its machines are not reset when the locked cluster is torn down.
+func (s *managementServer) markClusterAsExported(ctx context.Context, clusterID string) error {
+	_, err := safe.StateUpdateWithConflicts(
+		ctx,
+		s.omniState,
+		omnires.NewClusterStatus(clusterID).Metadata(),
+		func(res *omnires.ClusterStatus) error {
+			res.Metadata().Labels().Set(omnires.LabelClusterTaintedByExporting, "")
+
+			return nil
+		},
+		state.WithUpdateOwner(omniCtrl.ClusterStatusControllerName),
+	)
+
+	return err
+}
+
+// detachedMachineConfigs returns the detached configs of the cluster machines, the workers go first.
+func (s *managementServer) detachedMachineConfigs(ctx context.Context, clusterID string, endpoint *url.URL) ([]*management.DetachClusterResponse_Machine, error) {
+	clusterMachines, err := safe.StateListAll[*omnires.ClusterMachine](ctx, s.omniState, state.WithLabelQuery(resource.LabelEqual(omnires.LabelCluster, clusterID)))
+	if err != nil {
+		return nil, err
+	}
+
+	machines := make([]*management.DetachClusterResponse_Machine, 0, clusterMachines.Len())
+
+	for clusterMachine := range clusterMachines.All() {
+		machineID := clusterMachine.Metadata().ID()
+
+		machineConfig, err := safe.StateGetByID[*omnires.ClusterMachineConfig](ctx, s.omniState, machineID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the config of machine %q: %w", machineID, err)
+		}
+
+		if machineConfig.TypedSpec().Value.GenerationError != "" {
+			return nil, status.Errorf(codes.FailedPrecondition, "the config of machine %q failed to generate: %s", machineID, machineConfig.TypedSpec().Value.GenerationError)
+		}
+
+		buffer, err := machineConfig.TypedSpec().Value.GetUncompressedData()
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := detachMachineConfig(buffer.Data(), endpoint)
+
+		buffer.Free()
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to detach the config of machine %q: %w", machineID, err)
+		}
+
+		_, controlPlane := clusterMachine.Metadata().Labels().Get(omnires.LabelControlPlaneRole)
+
+		machine := &management.DetachClusterResponse_Machine{
+			Id:           machineID,
+			ControlPlane: controlPlane,
+			Config:       data,
+		}
+
+		identity, err := safe.StateGetByID[*omnires.ClusterMachineIdentity](ctx, s.omniState, machineID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return nil, err
+		}
+
+		if identity != nil {
+			machine.Hostname = identity.TypedSpec().Value.Nodename
+		}
+
+		machines = append(machines, machine)
+	}
+
+	slices.SortFunc(machines, func(a, b *management.DetachClusterResponse_Machine) int {
+		if a.ControlPlane != b.ControlPlane {
+			if b.ControlPlane {
+				return -1
+			}
+
+			return 1
+		}
+
+		return cmp.Compare(a.Id, b.Id)
+	})
+
+	return machines, nil
+}
+
+// detachMachineConfig strips the Omni specific settings from the machine config.
+//
+// The SideroLink, event sink and kernel log documents are dropped, the embedded discovery service is not used anymore,
+// and the control plane endpoint is replaced with the given one.
+func detachMachineConfig(data []byte, endpoint *url.URL) ([]byte, error) {
+	provider, err := configloader.NewFromBytes(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load machine config: %w", err)
+	}
+
+	documents := provider.Documents()
+	kept := make([]documentconfig.Document, 0, len(documents))
+
+	for _, document := range documents {
+		switch document.Kind() {
+		case talossiderolink.Kind, runtimecfg.EventSinkKind, runtimecfg.KmsgLogKind:
+			continue
+		case configcluster.DiscoveryServiceKind:
+			embedded, embeddedErr := isEmbeddedDiscoveryDocument(document)
+			if embeddedErr != nil {
+				return nil, embeddedErr
+			}
+
+			if embedded {
+				continue
+			}
+		}
+
+		kept = append(kept, document)
+	}
+
+	keptContainer, err := container.New(kept...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build config container: %w", err)
+	}
+
+	patched, err := keptContainer.PatchV1Alpha1(func(config *v1alpha1.Config) error {
+		if config.ClusterConfig == nil {
+			return errors.New("the machine config has no cluster section")
+		}
+
+		if config.ClusterConfig.ControlPlane == nil {
+			config.ClusterConfig.ControlPlane = &v1alpha1.ControlPlaneConfig{}
+		}
+
+		config.ClusterConfig.ControlPlane.Endpoint = &v1alpha1.Endpoint{URL: endpoint}
+
+		if discovery := config.ClusterConfig.ClusterDiscoveryConfig; discovery != nil &&
+			isEmbeddedDiscoveryEndpoint(discovery.DiscoveryRegistries.RegistryService.RegistryEndpoint) {
+			// fall back to the default public discovery service
+			discovery.DiscoveryRegistries.RegistryService.RegistryEndpoint = ""
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return patched.EncodeBytes(encoder.WithComments(encoder.CommentsDisabled))
+}
+
+// isEmbeddedDiscoveryDocument checks whether the DiscoveryServiceConfig document points at the Omni embedded discovery service.
+func isEmbeddedDiscoveryDocument(document documentconfig.Document) (bool, error) {
+	ctr, err := container.New(document)
+	if err != nil {
+		return false, err
+	}
+
+	data, err := ctr.EncodeBytes(encoder.WithComments(encoder.CommentsDisabled))
+	if err != nil {
+		return false, err
+	}
+
+	var doc struct {
+		Endpoint string `yaml:"endpoint"`
+	}
+
+	if err = yaml.NewDecoder(bytes.NewReader(data)).Decode(&doc); err != nil {
+		return false, fmt.Errorf("failed to decode the discovery service config: %w", err)
+	}
+
+	return isEmbeddedDiscoveryEndpoint(doc.Endpoint), nil
+}
+
+func isEmbeddedDiscoveryEndpoint(endpoint string) bool {
+	if endpoint == "" {
+		return false
+	}
+
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return false
+	}
+
+	return endpointURL.Hostname() == siderolinkinternal.ListenHost
+}
+
+// detachedTalosconfig generates the admin talosconfig which connects to the nodes directly.
+func (s *managementServer) detachedTalosconfig(ctx context.Context, clusterID string, endpoints []string) ([]byte, error) {
+	clusterSecrets, err := safe.StateGetByID[*omnires.ClusterSecrets](ctx, s.omniState, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle, err := omnires.ToSecretsBundle(clusterSecrets.TypedSpec().Value.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	clientCertificate, err := bundle.GenerateTalosAPIClientCertificate(talosrole.MakeSet(talosrole.Admin))
+	if err != nil {
+		return nil, err
+	}
+
+	return clientconfig.NewConfig(clusterID, endpoints, bundle.Certs.OS.Crt, clientCertificate).Bytes()
+}
+
+// detachedKubeconfig returns the admin kubeconfig pointing to the control plane endpoint instead of the Omni proxy.
+func (s *managementServer) detachedKubeconfig(ctx context.Context, clusterID string, endpoint *url.URL) ([]byte, error) {
+	kubeconfig, err := safe.StateGetByID[*omnires.Kubeconfig](ctx, s.omniState, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := clientcmd.Load(kubeconfig.TypedSpec().Value.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cluster := range config.Clusters {
+		cluster.Server = endpoint.String()
+	}
+
+	return clientcmd.Write(*config)
+}
+
+// disconnectedMachines returns the IDs of the machines which are not connected to Omni.
+func (s *managementServer) disconnectedMachines(ctx context.Context, machines []*management.DetachClusterResponse_Machine) ([]string, error) {
+	var disconnected []string
+
+	for _, machine := range machines {
+		machineStatus, err := safe.StateGetByID[*omnires.MachineStatus](ctx, s.omniState, machine.Id)
+		if err != nil && !state.IsNotFoundError(err) {
+			return nil, err
+		}
+
+		if machineStatus == nil || !machineStatus.TypedSpec().Value.Connected {
+			disconnected = append(disconnected, machine.Id)
+		}
+	}
+
+	return disconnected, nil
+}
+
+// releasedMachines returns the IDs of the machines of the cluster which got the detached config in the previous runs of the release.
+func (s *managementServer) releasedMachines(ctx context.Context, clusterID string) ([]string, error) {
+	clusterStatus, err := safe.StateGetByID[*omnires.ClusterStatus](ctx, s.omniState, clusterID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	released, _ := clusterStatus.Metadata().Annotations().Get(omnires.ClusterDetachReleasedMachines)
+	if released == "" {
+		return nil, nil
+	}
+
+	return strings.Split(released, ","), nil
+}
+
+// markMachineReleased records that the detached config was applied to the machine, so that the next runs of the release skip it.
+func (s *managementServer) markMachineReleased(ctx context.Context, clusterID, machineID string) error {
+	_, err := safe.StateUpdateWithConflicts(
+		ctx,
+		s.omniState,
+		omnires.NewClusterStatus(clusterID).Metadata(),
+		func(res *omnires.ClusterStatus) error {
+			var released []string
+
+			if value, _ := res.Metadata().Annotations().Get(omnires.ClusterDetachReleasedMachines); value != "" {
+				released = strings.Split(value, ",")
+			}
+
+			if !slices.Contains(released, machineID) {
+				released = append(released, machineID)
+			}
+
+			slices.Sort(released)
+
+			res.Metadata().Annotations().Set(omnires.ClusterDetachReleasedMachines, strings.Join(released, ","))
+
+			return nil
+		},
+		state.WithUpdateOwner(omniCtrl.ClusterStatusControllerName),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record machine %q as released: %w", machineID, err)
+	}
+
+	return nil
+}
+
+// applyDetachedConfig applies the detached config to the machine, and records the machine as released.
+//
+// The machines which are not connected to Omni are skipped, the release of such machines has to be forced.
+func (s *managementServer) applyDetachedConfig(ctx context.Context, clusterID string, machine *management.DetachClusterResponse_Machine, send func(format string, args ...any)) error {
+	machineStatus, err := safe.StateGetByID[*omnires.MachineStatus](ctx, s.omniState, machine.Id)
+	if err != nil && !state.IsNotFoundError(err) {
+		return err
+	}
+
+	if machineStatus == nil || !machineStatus.TypedSpec().Value.Connected {
+		send("[omni] skipping machine %s: it is not connected to Omni", machine.Id)
+
+		return nil
+	}
+
+	send("[omni] applying the detached config to machine %s", machine.Id)
+
+	talosClient, err := s.talosRuntime.GetClientForMachine(ctx, machine.Id)
+	if err != nil {
+		return fmt.Errorf("failed to get talos client for machine %q: %w", machine.Id, err)
+	}
+
+	if err = s.auditTalosAccess(ctx, machineapi.MachineService_ApplyConfiguration_FullMethodName, clusterID, machine.Id); err != nil {
+		return err
+	}
+
+	applyCtx, cancel := context.WithTimeout(ctx, detachApplyTimeout)
+	defer cancel()
+
+	if _, err = talosClient.ApplyConfiguration(applyCtx, &machineapi.ApplyConfigurationRequest{
+		Data: machine.Config,
+		Mode: machineapi.ApplyConfigurationRequest_AUTO,
+	}); err != nil {
+		// the machine drops the SideroLink connection the request goes through once the config is applied,
+		// but the connection might have been lost before the config got to the machine, so the disconnect is confirmed
+		if status.Code(err) != codes.Unavailable {
+			return fmt.Errorf("failed to apply the config to machine %q: %w", machine.Id, err)
+		}
+
+		send("[omni] machine %s disconnected while applying the config, waiting for it to leave Omni", machine.Id)
+
+		if err = s.waitForDetachedMachine(ctx, machine.Id); err != nil {
+			return err
+		}
+	}
+
+	return s.markMachineReleased(ctx, clusterID, machine.Id)
+}
+
+// waitForDetachedMachine waits for the machine to drop its SideroLink connection, which it does only when it runs the detached config.
+//
+// The machine which is still connected to Omni once the timeout expires didn't get the config, the release can be run again to retry it.
+func (s *managementServer) waitForDetachedMachine(ctx context.Context, machineID string) error {
+	waitCtx, cancel := context.WithTimeout(ctx, detachDisconnectTimeout)
+	defer cancel()
+
+	_, err := safe.StateWatchFor[*omnires.MachineStatus](
+		waitCtx,
+		s.omniState,
+		omnires.NewMachineStatus(machineID).Metadata(),
+		state.WithCondition(func(r resource.Resource) (bool, error) {
+			if resource.IsTombstone(r) {
+				return true, nil
+			}
+
+			machineStatus, ok := r.(*omnires.MachineStatus)
+			if !ok {
+				return false, fmt.Errorf("unexpected resource type %T", r)
+			}
+
+			return !machineStatus.TypedSpec().Value.Connected, nil
+		}),
+	)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return fmt.Errorf("machine %q is still connected to Omni, the detached config wasn't applied to it", machineID)
+		}
+
+		return fmt.Errorf("failed to wait for machine %q to disconnect: %w", machineID, err)
+	}
+
+	return nil
+}
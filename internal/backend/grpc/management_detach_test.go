@@ -0,0 +1,305 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/access/role"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	grpcomni "github.com/siderolabs/omni/internal/backend/grpc"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/siderolink"
+)
+
+func TestDetachClusterGuards(t *testing.T) {
+	const (
+		identityID = "user@example.com"
+		clusterID  = "cluster-1"
+	)
+
+	for _, tt := range []struct {
+		req           *management.DetachClusterRequest
+		name          string
+		annotation    string
+		wantMessage   string
+		role          role.Role
+		wantCode      codes.Code
+		createCluster bool
+	}{
+		{
+			name:     "missing cluster",
+			req:      &management.DetachClusterRequest{},
+			role:     role.Admin,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "cluster not found",
+			req:      &management.DetachClusterRequest{Cluster: clusterID},
+			role:     role.Admin,
+			wantCode: codes.NotFound,
+		},
+		{
+			name:          "requires admin",
+			req:           &management.DetachClusterRequest{Cluster: clusterID},
+			role:          role.Operator,
+			createCluster: true,
+			wantCode:      codes.PermissionDenied,
+		},
+		{
+			name:          "cluster locked",
+			req:           &management.DetachClusterRequest{Cluster: clusterID},
+			role:          role.Admin,
+			createCluster: true,
+			annotation:    omnires.ClusterLocked,
+			wantCode:      codes.FailedPrecondition,
+			wantMessage:   "is locked",
+		},
+		{
+			name:          "no control planes",
+			req:           &management.DetachClusterRequest{Cluster: clusterID},
+			role:          role.Admin,
+			createCluster: true,
+			wantCode:      codes.FailedPrecondition,
+			wantMessage:   "no control plane nodes",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			st := newEtcdRestoreTestState(t, identityID, clusterID, tt.createCluster, tt.annotation)
+
+			server := grpcomni.NewManagementServer(st, nil, zaptest.NewLogger(t), false, nil, nil)
+
+			_, err := server.DetachCluster(managementPowerTestContext(t.Context(), identityID, tt.role), tt.req)
+
+			require.Error(t, err)
+			require.Equal(t, tt.wantCode, status.Code(err), "unexpected status: %v", err)
+
+			if tt.wantMessage != "" {
+				require.Contains(t, status.Convert(err).Message(), tt.wantMessage)
+			}
+
+			if !tt.createCluster {
+				return
+			}
+
+			// a rejected detach never locks the cluster
+			cluster, err := safe.StateGetByID[*omnires.Cluster](actor.MarkContextAsInternalActor(t.Context()), st, clusterID)
+			require.NoError(t, err)
+
+			_, detaching := cluster.Metadata().Annotations().Get(omnires.ClusterDetachInProgress)
+			require.False(t, detaching)
+		})
+	}
+}
+
+func TestReleaseDetachedClusterRequiresDetach(t *testing.T) {
+	const identityID = "user@example.com"
+
+	st := newEtcdRestoreTestState(t, identityID, "cluster-1", true, omnires.ClusterLocked)
+
+	server := grpcomni.NewManagementServer(st, nil, zaptest.NewLogger(t), false, nil, nil)
+
+	ctx := managementPowerTestContext(t.Context(), identityID, role.Admin)
+
+	err := server.ReleaseDetachedCluster(&management.ReleaseDetachedClusterRequest{Cluster: "cluster-1"}, &fakeReleaseDetachedClusterStream{ctx: ctx})
+
+	require.Error(t, err)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err), "unexpected status: %v", err)
+	require.Contains(t, status.Convert(err).Message(), "is not being detached")
+}
+
+func TestReleaseDetachedClusterDisconnectedMachines(t *testing.T) {
+	const (
+		identityID = "user@example.com"
+		clusterID  = "cluster-1"
+		machineID  = "machine-1"
+	)
+
+	st := newEtcdRestoreTestState(t, identityID, clusterID, false, "")
+	ctx := actor.MarkContextAsInternalActor(t.Context())
+
+	cluster := omnires.NewCluster(clusterID)
+	cluster.Metadata().Annotations().Set(omnires.ClusterLocked, "")
+	cluster.Metadata().Annotations().Set(omnires.ClusterDetachInProgress, "https://10.5.0.2:6443")
+
+	require.NoError(t, st.Create(ctx, cluster))
+
+	clusterMachine := omnires.NewClusterMachine(machineID)
+	clusterMachine.Metadata().Labels().Set(omnires.LabelCluster, clusterID)
+
+	require.NoError(t, st.Create(ctx, clusterMachine))
+
+	machineConfig := omnires.NewClusterMachineConfig(machineID)
+	require.NoError(t, machineConfig.TypedSpec().Value.SetUncompressedData([]byte(`version: v1alpha1
+machine:
+  type: worker
+cluster:
+  controlPlane:
+    endpoint: https://[fdae:41e4:649b:9303::1]:10000
+`)))
+
+	require.NoError(t, st.Create(ctx, machineConfig))
+
+	// the machine is not connected, so it can't get the detached config
+	require.NoError(t, st.Create(ctx, omnires.NewMachineStatus(machineID)))
+
+	server := grpcomni.NewManagementServer(st, nil, zaptest.NewLogger(t), false, nil, nil)
+
+	err := server.ReleaseDetachedCluster(
+		&management.ReleaseDetachedClusterRequest{Cluster: clusterID},
+		&fakeReleaseDetachedClusterStream{ctx: managementPowerTestContext(t.Context(), identityID, role.Admin)},
+	)
+
+	require.Error(t, err)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err), "unexpected status: %v", err)
+	require.Contains(t, status.Convert(err).Message(), "not connected to Omni")
+
+	// the cluster is left as is
+	_, err = safe.StateGetByID[*omnires.Cluster](ctx, st, clusterID)
+	require.NoError(t, err)
+}
+
+func TestReleaseDetachedClusterReleasedMachines(t *testing.T) {
+	const (
+		identityID = "user@example.com"
+		clusterID  = "cluster-1"
+		machineID  = "machine-1"
+	)
+
+	st := newEtcdRestoreTestState(t, identityID, clusterID, false, "")
+	ctx := actor.MarkContextAsInternalActor(t.Context())
+
+	cluster := omnires.NewCluster(clusterID)
+	cluster.Metadata().Annotations().Set(omnires.ClusterLocked, "")
+	cluster.Metadata().Annotations().Set(omnires.ClusterDetachInProgress, "https://10.5.0.2:6443")
+
+	require.NoError(t, st.Create(ctx, cluster))
+
+	clusterMachine := omnires.NewClusterMachine(machineID)
+	clusterMachine.Metadata().Labels().Set(omnires.LabelCluster, clusterID)
+
+	require.NoError(t, st.Create(ctx, clusterMachine))
+
+	machineConfig := omnires.NewClusterMachineConfig(machineID)
+	require.NoError(t, machineConfig.TypedSpec().Value.SetUncompressedData([]byte(`version: v1alpha1
+machine:
+  type: worker
+cluster:
+  controlPlane:
+    endpoint: https://[fdae:41e4:649b:9303::1]:10000
+`)))
+
+	require.NoError(t, st.Create(ctx, machineConfig))
+
+	// the machine got the detached config in the previous run of the release, so it is not connected anymore
+	require.NoError(t, st.Create(ctx, omnires.NewMachineStatus(machineID)))
+
+	clusterStatus := omnires.NewClusterStatus(clusterID)
+	clusterStatus.Metadata().Annotations().Set(omnires.ClusterDetachReleasedMachines, machineID)
+
+	require.NoError(t, st.Create(ctx, clusterStatus, state.WithCreateOwner(omnictrl.ClusterStatusControllerName)))
+
+	// stand in for the controllers which remove the cluster once it is torn down
+	go func() {
+		if _, err := st.WatchFor(ctx, cluster.Metadata(), state.WithPhases(resource.PhaseTearingDown)); err != nil {
+			return
+		}
+
+		st.Destroy(ctx, cluster.Metadata()) //nolint:errcheck
+	}()
+
+	server := grpcomni.NewManagementServer(st, nil, zaptest.NewLogger(t), false, nil, nil)
+
+	err := server.ReleaseDetachedCluster(
+		&management.ReleaseDetachedClusterRequest{Cluster: clusterID},
+		&fakeReleaseDetachedClusterStream{ctx: managementPowerTestContext(t.Context(), identityID, role.Admin)},
+	)
+	require.NoError(t, err)
+
+	_, err = safe.StateGetByID[*omnires.Cluster](ctx, st, clusterID)
+	require.True(t, state.IsNotFoundError(err))
+}
+
+func TestDetachMachineConfig(t *testing.T) {
+	embeddedEndpoint := "http://" + net.JoinHostPort(siderolink.ListenHost, "8093")
+
+	config := fmt.Sprintf(`version: v1alpha1
+machine:
+  type: controlplane
+cluster:
+  controlPlane:
+    endpoint: https://[fdae:41e4:649b:9303::1]:10000
+  discovery:
+    enabled: true
+    registries:
+      service:
+        endpoint: %[1]s
+---
+apiVersion: v1alpha1
+kind: SideroLinkConfig
+apiUrl: grpc://omni.localhost:8090?jointoken=test-token
+---
+apiVersion: v1alpha1
+kind: EventSinkConfig
+endpoint: '[fdae:41e4:649b:9303::1]:8091'
+---
+apiVersion: v1alpha1
+kind: KmsgLogConfig
+name: omni-kmsg
+url: tcp://[fdae:41e4:649b:9303::1]:8092
+---
+apiVersion: v1alpha1
+kind: DiscoveryServiceConfig
+name: omni-embedded
+endpoint: %[1]s
+`, embeddedEndpoint)
+
+	endpoint, err := url.Parse("https://10.5.0.2:6443")
+	require.NoError(t, err)
+
+	detached, err := grpcomni.DetachMachineConfig([]byte(config), endpoint)
+	require.NoError(t, err)
+
+	assert.NotContains(t, string(detached), "SideroLinkConfig")
+	assert.NotContains(t, string(detached), "EventSinkConfig")
+	assert.NotContains(t, string(detached), "KmsgLogConfig")
+	assert.NotContains(t, string(detached), "DiscoveryServiceConfig")
+	assert.NotContains(t, string(detached), siderolink.ListenHost)
+
+	provider, err := configloader.NewFromBytes(detached)
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://10.5.0.2:6443", provider.Cluster().Endpoint().String())
+}
+
+// fakeReleaseDetachedClusterStream is a minimal grpc.ServerStreamingServer for ReleaseDetachedCluster guard tests.
+type fakeReleaseDetachedClusterStream struct {
+	grpc.ServerStream
+	ctx context.Context //nolint:containedctx
+}
+
+func (f *fakeReleaseDetachedClusterStream) Context() context.Context { return f.ctx }
+
+func (f *fakeReleaseDetachedClusterStream) Send(*management.ReleaseDetachedClusterResponse) error {
+	return nil
+}
@@ -0,0 +1,547 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-kubernetes/kubernetes/nodedrain"
+	"github.com/siderolabs/go-retry/retry"
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	k8s "k8s.io/client-go/kubernetes"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/access/role"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup/store"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+)
+
+const (
+	// etcdRestoreRebootTimeout bounds the wait for a control plane node to come back after its etcd data was wiped.
+	etcdRestoreRebootTimeout = 15 * time.Minute
+
+	// etcdRestoreBootstrapTimeout bounds the retries of the snapshot recovery and the bootstrap of the restored etcd.
+	etcdRestoreBootstrapTimeout = 10 * time.Minute
+
+	// etcdRestoreNodeTimeout bounds each single Kubernetes or Talos API call made on a node during the restore.
+	etcdRestoreNodeTimeout = time.Minute
+)
+
+// etcdDataVolumeMinVersion is the first Talos version which keeps the etcd data in its own volume instead of the EPHEMERAL partition.
+var etcdDataVolumeMinVersion = semver.MustParse("1.14.0-beta.1")
+
+// etcdRestoreNode is a control plane node of the cluster being restored.
+type etcdRestoreNode struct {
+	machineID    string
+	nodeName     string
+	talosVersion string
+	bootID       string
+}
+
+// EtcdRestore restores etcd of an existing cluster from a backup snapshot, streaming the progress.
+//
+// The control plane nodes are drained and their etcd data is wiped, then the snapshot is recovered on the first of them
+// and etcd is bootstrapped from it. The rest of the control plane nodes join the restored etcd afterwards.
+// The EPHEMERAL partition is only wiped if the request confirms it, which is required for the nodes that keep the etcd data in it.
+// The cluster is locked for the duration of the restore.
+//
+//nolint:gocyclo,cyclop
+func (s *managementServer) EtcdRestore(req *management.EtcdRestoreRequest, srv grpc.ServerStreamingServer[management.EtcdRestoreResponse]) error {
+	ctx := srv.Context()
+
+	if req.Cluster == "" {
+		return status.Error(codes.InvalidArgument, "cluster is required")
+	}
+
+	if req.Snapshot == "" {
+		return status.Error(codes.InvalidArgument, "snapshot is required")
+	}
+
+	authCtx, _, err := s.checkClusterAuthorization(ctx, req.Cluster, role.Operator)
+	if err != nil {
+		return err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(authCtx)
+
+	s.logger.Info("etcd restore request received", zap.String("cluster", req.Cluster), zap.String("snapshot", req.Snapshot))
+
+	cluster, err := safe.StateGetByID[*omnires.Cluster](ctx, s.omniState, req.Cluster)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return status.Errorf(codes.NotFound, "cluster %q not found", req.Cluster)
+		}
+
+		return err
+	}
+
+	if err = checkEtcdRestoreAllowed(cluster); err != nil {
+		return err
+	}
+
+	if s.etcdBackupStoreFactory == nil || s.etcdBackupStoreFactory == store.DisabledStoreFactory {
+		return status.Error(codes.FailedPrecondition, "etcd backups are not enabled")
+	}
+
+	backupStore, err := s.etcdBackupStoreFactory.GetStore()
+	if err != nil {
+		return fmt.Errorf("failed to get etcd backup store: %w", err)
+	}
+
+	clusterUUID, err := safe.StateGetByID[*omnires.ClusterUUID](ctx, s.omniState, req.Cluster)
+	if err != nil {
+		return fmt.Errorf("failed to get cluster UUID: %w", err)
+	}
+
+	backupData, err := safe.StateGetByID[*omnires.BackupData](ctx, s.omniState, req.Cluster)
+	if err != nil {
+		return fmt.Errorf("failed to get backup data: %w", err)
+	}
+
+	uuid := clusterUUID.TypedSpec().Value.Uuid
+
+	if err = findEtcdSnapshot(ctx, backupStore, uuid, req.Snapshot); err != nil {
+		return err
+	}
+
+	// make sure the snapshot can be restored before touching any of the nodes
+	snapshot, err := downloadEtcdSnapshot(ctx, backupStore, backupData, uuid, req.Snapshot)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		snapshot.Close()           //nolint:errcheck
+		os.Remove(snapshot.Name()) //nolint:errcheck
+	}()
+
+	nodes, err := s.etcdRestoreNodes(ctx, req.Cluster)
+	if err != nil {
+		return err
+	}
+
+	for _, node := range nodes {
+		if _, err = etcdRestoreResetRequest(node.talosVersion, req.WipeEphemeral); err != nil {
+			return status.Errorf(codes.FailedPrecondition, "machine %q can't be restored: %s", node.machineID, err)
+		}
+	}
+
+	// Best-effort progress send, same as MaintenanceLifecycle: the restore keeps running if the client goes away.
+	var disconnectLogged bool
+
+	send := func(format string, args ...any) {
+		if srv.Context().Err() != nil {
+			return
+		}
+
+		if sendErr := srv.Send(&management.EtcdRestoreResponse{Message: fmt.Sprintf(format, args...)}); sendErr != nil && !disconnectLogged {
+			disconnectLogged = true
+
+			s.logger.Info("etcd restore client disconnected; operation continues server-side", zap.String("cluster", req.Cluster), zap.Error(sendErr))
+		}
+	}
+
+	if err = s.setEtcdRestoreInProgress(ctx, req.Cluster, true); err != nil {
+		return err
+	}
+
+	send("cluster %q locked", req.Cluster)
+
+	// Detach the restore from the client stream: once the nodes are wiped, stopping halfway leaves the cluster without etcd.
+	runCtx := context.WithoutCancel(ctx)
+
+	defer func() {
+		if unlockErr := s.setEtcdRestoreInProgress(runCtx, req.Cluster, false); unlockErr != nil {
+			s.logger.Error("failed to unlock the cluster after etcd restore", zap.String("cluster", req.Cluster), zap.Error(unlockErr))
+
+			return
+		}
+
+		send("cluster %q unlocked", req.Cluster)
+	}()
+
+	if err = s.restoreEtcd(runCtx, req.Cluster, nodes, req.WipeEphemeral, send, snapshot); err != nil {
+		s.logger.Error("etcd restore failed", zap.String("cluster", req.Cluster), zap.String("snapshot", req.Snapshot), zap.Error(err))
+
+		return err
+	}
+
+	send("etcd of cluster %q restored from snapshot %q", req.Cluster, req.Snapshot)
+
+	return nil
+}
+
+func checkEtcdRestoreAllowed(cluster *omnires.Cluster) error {
+	if _, inProgress := cluster.Metadata().Annotations().Get(omnires.ClusterEtcdRestoreInProgress); inProgress {
+		return status.Errorf(codes.FailedPrecondition, "an etcd restore is already in progress for cluster %q", cluster.Metadata().ID())
+	}
+
+	if _, locked := cluster.Metadata().Annotations().Get(omnires.ClusterLocked); locked {
+		return status.Errorf(codes.FailedPrecondition, "cluster %q is locked", cluster.Metadata().ID())
+	}
+
+	return nil
+}
+
+// downloadEtcdSnapshot downloads the snapshot into a temporary file, verifying that it can be restored.
+//
+// The file is read again on each attempt to recover etcd, the caller is responsible for closing and removing it.
+func downloadEtcdSnapshot(ctx context.Context, backupStore etcdbackup.Store, backupData *omnires.BackupData, clusterUUID, snapshot string) (*os.File, error) {
+	reader, err := etcdbackup.DownloadForRestore(ctx, backupStore, backupData, clusterUUID, snapshot)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "snapshot %q can't be restored: %s", snapshot, err)
+	}
+
+	defer reader.Close() //nolint:errcheck
+
+	file, err := os.CreateTemp("", "etcd-restore-*.snapshot")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a temporary file for the snapshot: %w", err)
+	}
+
+	if _, err = io.Copy(file, reader); err != nil {
+		file.Close()           //nolint:errcheck
+		os.Remove(file.Name()) //nolint:errcheck
+
+		return nil, fmt.Errorf("failed to download snapshot %q: %w", snapshot, err)
+	}
+
+	return file, nil
+}
+
+func findEtcdSnapshot(ctx context.Context, lister etcdbackup.Lister, clusterUUID, snapshot string) error {
+	backups, err := lister.ListBackups(ctx, clusterUUID)
+	if err != nil {
+		return fmt.Errorf("failed to list etcd backups: %w", err)
+	}
+
+	for info, err := range backups {
+		if err != nil {
+			return fmt.Errorf("failed to list etcd backups: %w", err)
+		}
+
+		if info.Snapshot == snapshot {
+			return nil
+		}
+	}
+
+	return status.Errorf(codes.NotFound, "snapshot %q not found", snapshot)
+}
+
+// etcdRestoreNodes returns the control plane nodes of the cluster, sorted by the machine ID.
+//
+// The first node is the one the snapshot is recovered on.
+func (s *managementServer) etcdRestoreNodes(ctx context.Context, clusterID string) ([]etcdRestoreNode, error) {
+	clusterMachines, err := safe.StateListAll[*omnires.ClusterMachine](
+		ctx,
+		s.omniState,
+		state.WithLabelQuery(
+			resource.LabelEqual(omnires.LabelCluster, clusterID),
+			resource.LabelExists(omnires.LabelControlPlaneRole),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if clusterMachines.Len() == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "cluster %q has no control plane nodes", clusterID)
+	}
+
+	nodes := make([]etcdRestoreNode, 0, clusterMachines.Len())
+
+	for clusterMachine := range clusterMachines.All() {
+		machineID := clusterMachine.Metadata().ID()
+
+		machineStatus, err := safe.StateGetByID[*omnires.MachineStatus](ctx, s.omniState, machineID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get machine status for %q: %w", machineID, err)
+		}
+
+		node := etcdRestoreNode{
+			machineID:    machineID,
+			talosVersion: machineStatus.TypedSpec().Value.TalosVersion,
+		}
+
+		identity, err := safe.StateGetByID[*omnires.ClusterMachineIdentity](ctx, s.omniState, machineID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return nil, err
+		}
+
+		if identity != nil {
+			node.nodeName = identity.TypedSpec().Value.Nodename
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	slices.SortFunc(nodes, func(a, b etcdRestoreNode) int {
+		return cmp.Compare(a.machineID, b.machineID)
+	})
+
+	return nodes, nil
+}
+
+// setEtcdRestoreInProgress locks the cluster for the restore or unlocks it once the restore is over.
+func (s *managementServer) setEtcdRestoreInProgress(ctx context.Context, clusterID string, inProgress bool) error {
+	_, err := safe.StateUpdateWithConflicts(ctx, s.omniState, omnires.NewCluster(clusterID).Metadata(), func(res *omnires.Cluster) error {
+		if !inProgress {
+			res.Metadata().Annotations().Delete(omnires.ClusterEtcdRestoreInProgress)
+			res.Metadata().Annotations().Delete(omnires.ClusterLocked)
+
+			return nil
+		}
+
+		// check again, as the cluster might have been locked since it was read
+		if err := checkEtcdRestoreAllowed(res); err != nil {
+			return err
+		}
+
+		res.Metadata().Annotations().Set(omnires.ClusterEtcdRestoreInProgress, "")
+		res.Metadata().Annotations().Set(omnires.ClusterLocked, "")
+
+		return nil
+	})
+
+	return err
+}
+
+//nolint:gocognit
+func (s *managementServer) restoreEtcd(
+	ctx context.Context,
+	clusterID string,
+	nodes []etcdRestoreNode,
+	wipeEphemeral bool,
+	send func(format string, args ...any),
+	snapshot io.ReadSeeker,
+) error {
+	// Draining is best-effort: the Kubernetes API is often unavailable when etcd needs to be restored.
+	clientset, err := s.etcdRestoreClientset(ctx, clusterID)
+	if err != nil {
+		send("[omni] skipping drain, failed to get kubernetes client: %s", err)
+	}
+
+	for _, node := range nodes {
+		if clientset == nil || node.nodeName == "" {
+			continue
+		}
+
+		send("[omni] draining node %s", node.nodeName)
+
+		if err = drainNode(ctx, clientset, node.nodeName, send); err != nil {
+			send("[omni] failed to drain node %s, continuing: %s", node.nodeName, err)
+		}
+	}
+
+	for i, node := range nodes {
+		machineStatusSnapshot, err := safe.StateGetByID[*omnires.MachineStatusSnapshot](ctx, s.omniState, node.machineID)
+		if err != nil {
+			return fmt.Errorf("failed to get machine status snapshot for %q: %w", node.machineID, err)
+		}
+
+		nodes[i].bootID = machineStatusSnapshot.TypedSpec().Value.BootId
+
+		send("[omni] wiping etcd data on machine %s", node.machineID)
+
+		if err = s.wipeEtcdData(ctx, clusterID, node, wipeEphemeral); err != nil {
+			return fmt.Errorf("failed to wipe etcd data on machine %q: %w", node.machineID, err)
+		}
+	}
+
+	for _, node := range nodes {
+		send("[omni] waiting for machine %s to reboot", node.machineID)
+
+		if err = s.waitForReboot(ctx, node); err != nil {
+			return fmt.Errorf("machine %q didn't come back after the reboot: %w", node.machineID, err)
+		}
+	}
+
+	bootstrapNode := nodes[0]
+
+	send("[omni] recovering etcd from the snapshot on machine %s", bootstrapNode.machineID)
+
+	if err = retry.Constant(etcdRestoreBootstrapTimeout, retry.WithUnits(5*time.Second)).RetryWithContext(ctx, func(ctx context.Context) error {
+		return retry.ExpectedError(s.recoverEtcd(ctx, clusterID, bootstrapNode.machineID, snapshot))
+	}); err != nil {
+		return fmt.Errorf("failed to recover etcd on machine %q: %w", bootstrapNode.machineID, err)
+	}
+
+	send("[omni] etcd bootstrapped from the snapshot on machine %s", bootstrapNode.machineID)
+
+	for _, node := range nodes {
+		if clientset == nil || node.nodeName == "" {
+			continue
+		}
+
+		send("[omni] uncordoning node %s", node.nodeName)
+
+		if err = retry.Constant(etcdRestoreBootstrapTimeout, retry.WithUnits(5*time.Second)).RetryWithContext(ctx, func(ctx context.Context) error {
+			uncordonCtx, cancel := context.WithTimeout(ctx, etcdRestoreNodeTimeout)
+			defer cancel()
+
+			return retry.ExpectedError(nodedrain.Uncordon(uncordonCtx, clientset, node.nodeName))
+		}); err != nil {
+			send("[omni] failed to uncordon node %s: %s", node.nodeName, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *managementServer) etcdRestoreClientset(ctx context.Context, clusterID string) (k8s.Interface, error) { //nolint:ireturn
+	if s.kubernetesRuntime == nil {
+		return nil, errors.New("kubernetes runtime is not configured")
+	}
+
+	client, err := s.kubernetesRuntime.GetClient(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Clientset(), nil
+}
+
+func drainNode(ctx context.Context, clientset k8s.Interface, nodeName string, send func(format string, args ...any)) error {
+	cordonCtx, cancel := context.WithTimeout(ctx, etcdRestoreNodeTimeout)
+	defer cancel()
+
+	if err := nodedrain.Cordon(cordonCtx, clientset, nodeName); err != nil {
+		return err
+	}
+
+	return nodedrain.Drain(ctx, clientset, nodeName, nodedrain.DrainOptions{
+		Progress: func(msg string) { send("[omni] %s", msg) },
+	})
+}
+
+// etcdRestoreResetRequest returns the request which resets the node without a graceful etcd leave, wiping the partitions which hold
+// the etcd data, and reboots it.
+//
+// The EPHEMERAL partition is only wiped if wipeEphemeral is set, the Talos versions which keep the etcd data in it can't be restored otherwise.
+func etcdRestoreResetRequest(version string, wipeEphemeral bool) (*machineapi.ResetRequest, error) {
+	talosVersion, err := semver.ParseTolerant(version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse talos version %q: %w", version, err)
+	}
+
+	resetRequest := &machineapi.ResetRequest{
+		Graceful: false,
+		Reboot:   true,
+	}
+
+	if talosVersion.GTE(etcdDataVolumeMinVersion) {
+		resetRequest.SystemPartitionsToWipe = append(resetRequest.SystemPartitionsToWipe, &machineapi.ResetPartitionSpec{Label: constants.EtcdDataVolumeID, Wipe: true})
+	} else if !wipeEphemeral {
+		return nil, fmt.Errorf("talos %s keeps the etcd data in the %s partition, wiping it must be confirmed", version, constants.EphemeralPartitionLabel)
+	}
+
+	if wipeEphemeral {
+		resetRequest.SystemPartitionsToWipe = append(resetRequest.SystemPartitionsToWipe, &machineapi.ResetPartitionSpec{Label: constants.EphemeralPartitionLabel, Wipe: true})
+	}
+
+	return resetRequest, nil
+}
+
+// wipeEtcdData resets the node with the etcdRestoreResetRequest.
+//
+// The machine configuration is kept, so the node comes back as a control plane node waiting for etcd to be bootstrapped.
+func (s *managementServer) wipeEtcdData(ctx context.Context, clusterID string, node etcdRestoreNode, wipeEphemeral bool) error {
+	resetRequest, err := etcdRestoreResetRequest(node.talosVersion, wipeEphemeral)
+	if err != nil {
+		return err
+	}
+
+	talosClient, err := s.talosRuntime.GetClientForMachine(ctx, node.machineID)
+	if err != nil {
+		return fmt.Errorf("failed to get talos client: %w", err)
+	}
+
+	if err = s.auditTalosAccess(ctx, machineapi.MachineService_Reset_FullMethodName, clusterID, node.machineID); err != nil {
+		return err
+	}
+
+	resetCtx, cancel := context.WithTimeout(ctx, etcdRestoreNodeTimeout)
+	defer cancel()
+
+	return talosClient.ResetGeneric(resetCtx, resetRequest)
+}
+
+// waitForReboot waits for the machine to report a boot ID different from the one it had before the reset.
+func (s *managementServer) waitForReboot(ctx context.Context, node etcdRestoreNode) error {
+	ctx, cancel := context.WithTimeout(ctx, etcdRestoreRebootTimeout)
+	defer cancel()
+
+	_, err := safe.StateWatchFor[*omnires.MachineStatusSnapshot](
+		ctx,
+		s.omniState,
+		omnires.NewMachineStatusSnapshot(node.machineID).Metadata(),
+		state.WithCondition(func(r resource.Resource) (bool, error) {
+			if resource.IsTombstone(r) {
+				return false, nil
+			}
+
+			machineStatusSnapshot, ok := r.(*omnires.MachineStatusSnapshot)
+			if !ok {
+				return false, fmt.Errorf("unexpected resource type %T", r)
+			}
+
+			bootID := machineStatusSnapshot.TypedSpec().Value.BootId
+
+			return bootID != "" && bootID != node.bootID, nil
+		}),
+	)
+
+	return err
+}
+
+// recoverEtcd uploads the snapshot to the node and bootstraps etcd from it.
+func (s *managementServer) recoverEtcd(ctx context.Context, clusterID, machineID string, snapshot io.ReadSeeker) error {
+	talosClient, err := s.talosRuntime.GetClientForMachine(ctx, machineID)
+	if err != nil {
+		return fmt.Errorf("failed to get talos client: %w", err)
+	}
+
+	if _, err = snapshot.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("failed to rewind the snapshot: %w", err)
+	}
+
+	if err = s.auditTalosAccess(ctx, machineapi.MachineService_EtcdRecover_FullMethodName, clusterID, machineID); err != nil {
+		return err
+	}
+
+	if _, err = talosClient.EtcdRecover(ctx, snapshot); err != nil {
+		return fmt.Errorf("failed calling talos client EtcdRecover: %w", err)
+	}
+
+	if err = s.auditTalosAccess(ctx, machineapi.MachineService_Bootstrap_FullMethodName, clusterID, machineID); err != nil {
+		return err
+	}
+
+	bootstrapCtx, cancel := context.WithTimeout(ctx, etcdRestoreNodeTimeout)
+	defer cancel()
+
+	return talosClient.Bootstrap(bootstrapCtx, &machineapi.BootstrapRequest{
+		RecoverEtcd: true,
+	})
+}
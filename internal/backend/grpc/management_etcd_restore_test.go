@@ -0,0 +1,365 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"iter"
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/access/role"
+	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	grpcomni "github.com/siderolabs/omni/internal/backend/grpc"
+	omniruntime "github.com/siderolabs/omni/internal/backend/runtime/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+)
+
+func TestEtcdRestoreGuards(t *testing.T) {
+	const (
+		identityID = "user@example.com"
+		clusterID  = "cluster-1"
+		snapshot   = "FFFFFFFF9A5F1A3F.snapshot"
+	)
+
+	matchingStore := &fakeEtcdRestoreStore{
+		snapshots: []string{snapshot},
+		backupData: etcdbackup.BackupData{
+			AESCBCEncryptionSecret:    "aescbc",
+			SecretboxEncryptionSecret: "secretbox",
+		},
+	}
+
+	for _, tt := range []struct {
+		store         *fakeEtcdRestoreStore
+		annotation    string
+		name          string
+		talosVersion  string
+		req           *management.EtcdRestoreRequest
+		wantMessage   string
+		wantCode      codes.Code
+		createCluster bool
+	}{
+		{
+			name:     "missing cluster",
+			req:      &management.EtcdRestoreRequest{Snapshot: snapshot},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "missing snapshot",
+			req:      &management.EtcdRestoreRequest{Cluster: clusterID},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "cluster not found",
+			req:      &management.EtcdRestoreRequest{Cluster: clusterID, Snapshot: snapshot},
+			store:    matchingStore,
+			wantCode: codes.NotFound,
+		},
+		{
+			name:          "cluster locked",
+			req:           &management.EtcdRestoreRequest{Cluster: clusterID, Snapshot: snapshot},
+			store:         matchingStore,
+			createCluster: true,
+			annotation:    omnires.ClusterLocked,
+			wantCode:      codes.FailedPrecondition,
+			wantMessage:   "is locked",
+		},
+		{
+			name:          "restore in progress",
+			req:           &management.EtcdRestoreRequest{Cluster: clusterID, Snapshot: snapshot},
+			store:         matchingStore,
+			createCluster: true,
+			annotation:    omnires.ClusterEtcdRestoreInProgress,
+			wantCode:      codes.FailedPrecondition,
+			wantMessage:   "already in progress",
+		},
+		{
+			name:          "backups disabled",
+			req:           &management.EtcdRestoreRequest{Cluster: clusterID, Snapshot: snapshot},
+			createCluster: true,
+			wantCode:      codes.FailedPrecondition,
+			wantMessage:   "not enabled",
+		},
+		{
+			name:          "snapshot not found",
+			req:           &management.EtcdRestoreRequest{Cluster: clusterID, Snapshot: "FFFFFFFF00000000.snapshot"},
+			store:         matchingStore,
+			createCluster: true,
+			wantCode:      codes.NotFound,
+		},
+		{
+			name: "encryption secret mismatch",
+			req:  &management.EtcdRestoreRequest{Cluster: clusterID, Snapshot: snapshot},
+			store: &fakeEtcdRestoreStore{
+				snapshots: []string{snapshot},
+				backupData: etcdbackup.BackupData{
+					AESCBCEncryptionSecret:    "other",
+					SecretboxEncryptionSecret: "secretbox",
+				},
+			},
+			createCluster: true,
+			wantCode:      codes.FailedPrecondition,
+			wantMessage:   "aes cbc encryption secret mismatch",
+		},
+		{
+			name:          "no control planes",
+			req:           &management.EtcdRestoreRequest{Cluster: clusterID, Snapshot: snapshot},
+			store:         matchingStore,
+			createCluster: true,
+			wantCode:      codes.FailedPrecondition,
+			wantMessage:   "no control plane nodes",
+		},
+		{
+			name:          "ephemeral wipe not confirmed",
+			req:           &management.EtcdRestoreRequest{Cluster: clusterID, Snapshot: snapshot},
+			store:         matchingStore,
+			createCluster: true,
+			talosVersion:  "v1.13.2",
+			wantCode:      codes.FailedPrecondition,
+			wantMessage:   "wiping it must be confirmed",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			st := newEtcdRestoreTestState(t, identityID, clusterID, tt.createCluster, tt.annotation)
+
+			if tt.talosVersion != "" {
+				createEtcdRestoreTestControlPlane(t, st, clusterID, "machine-1", tt.talosVersion)
+			}
+
+			var opts []grpcomni.ManagementServerOption
+
+			if tt.store != nil {
+				opts = append(opts, grpcomni.WithEtcdBackupStoreFactory(&fakeEtcdRestoreStoreFactory{store: tt.store}))
+			}
+
+			server := grpcomni.NewManagementServer(st, nil, zaptest.NewLogger(t), false, nil, nil, opts...)
+
+			ctx := managementPowerTestContext(t.Context(), identityID, role.Operator)
+
+			err := server.EtcdRestore(tt.req, &fakeEtcdRestoreStream{ctx: ctx})
+
+			require.Error(t, err)
+			require.Equal(t, tt.wantCode, status.Code(err), "unexpected status: %v", err)
+
+			if tt.wantMessage != "" {
+				require.Contains(t, status.Convert(err).Message(), tt.wantMessage)
+			}
+
+			if !tt.createCluster {
+				return
+			}
+
+			// a rejected restore never changes the lock state of the cluster
+			cluster, err := safe.StateGetByID[*omnires.Cluster](actor.MarkContextAsInternalActor(t.Context()), st, clusterID)
+			require.NoError(t, err)
+
+			_, locked := cluster.Metadata().Annotations().Get(omnires.ClusterLocked)
+			require.Equal(t, tt.annotation != "", locked)
+		})
+	}
+}
+
+func TestEtcdRestoreRequiresOperator(t *testing.T) {
+	const identityID = "user@example.com"
+
+	st := newEtcdRestoreTestState(t, identityID, "cluster-1", true, "")
+
+	server := grpcomni.NewManagementServer(st, nil, zaptest.NewLogger(t), false, nil, nil)
+
+	ctx := managementPowerTestContext(t.Context(), identityID, role.Reader)
+
+	err := server.EtcdRestore(&management.EtcdRestoreRequest{Cluster: "cluster-1", Snapshot: "snapshot"}, &fakeEtcdRestoreStream{ctx: ctx})
+
+	require.Error(t, err)
+	require.Equal(t, codes.PermissionDenied, status.Code(err), "unexpected status: %v", err)
+}
+
+func newEtcdRestoreTestState(t *testing.T, identityID, clusterID string, createCluster bool, annotation string) state.State {
+	runtimeState, err := omniruntime.NewTestState(zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	st := runtimeState.Default()
+	ctx := actor.MarkContextAsInternalActor(t.Context())
+
+	require.NoError(t, st.Create(ctx, authres.NewIdentity(identityID)))
+
+	if !createCluster {
+		return st
+	}
+
+	cluster := omnires.NewCluster(clusterID)
+
+	if annotation != "" {
+		cluster.Metadata().Annotations().Set(annotation, "")
+
+		// the restore always sets the lock together with the in-progress annotation
+		cluster.Metadata().Annotations().Set(omnires.ClusterLocked, "")
+	}
+
+	require.NoError(t, st.Create(ctx, cluster))
+
+	clusterUUID := omnires.NewClusterUUID(clusterID)
+	clusterUUID.TypedSpec().Value.Uuid = "uuid-1"
+
+	require.NoError(t, st.Create(ctx, clusterUUID))
+
+	backupData := omnires.NewBackupData(clusterID)
+	backupData.TypedSpec().Value.ClusterUuid = "uuid-1"
+	backupData.TypedSpec().Value.AesCbcEncryptionSecret = "aescbc"
+	backupData.TypedSpec().Value.SecretboxEncryptionSecret = "secretbox"
+
+	require.NoError(t, st.Create(ctx, backupData))
+
+	return st
+}
+
+func createEtcdRestoreTestControlPlane(t *testing.T, st state.State, clusterID, machineID, talosVersion string) {
+	ctx := actor.MarkContextAsInternalActor(t.Context())
+
+	clusterMachine := omnires.NewClusterMachine(machineID)
+	clusterMachine.Metadata().Labels().Set(omnires.LabelCluster, clusterID)
+	clusterMachine.Metadata().Labels().Set(omnires.LabelControlPlaneRole, "")
+
+	require.NoError(t, st.Create(ctx, clusterMachine))
+
+	machineStatus := omnires.NewMachineStatus(machineID)
+	machineStatus.TypedSpec().Value.TalosVersion = talosVersion
+
+	require.NoError(t, st.Create(ctx, machineStatus))
+}
+
+func TestEtcdRestoreResetRequest(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		version       string
+		wantErr       string
+		wantLabels    []string
+		wipeEphemeral bool
+	}{
+		{
+			name:       "etcd volume",
+			version:    "v1.14.0",
+			wantLabels: []string{constants.EtcdDataVolumeID},
+		},
+		{
+			name:          "etcd volume and ephemeral",
+			version:       "v1.14.0",
+			wipeEphemeral: true,
+			wantLabels:    []string{constants.EtcdDataVolumeID, constants.EphemeralPartitionLabel},
+		},
+		{
+			name:    "ephemeral not confirmed",
+			version: "v1.13.2",
+			wantErr: "wiping it must be confirmed",
+		},
+		{
+			name:          "ephemeral confirmed",
+			version:       "v1.13.2",
+			wipeEphemeral: true,
+			wantLabels:    []string{constants.EphemeralPartitionLabel},
+		},
+		{
+			name:    "invalid version",
+			version: "latest",
+			wantErr: "failed to parse talos version",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			resetRequest, err := grpcomni.EtcdRestoreResetRequest(tt.version, tt.wipeEphemeral)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.False(t, resetRequest.Graceful)
+			require.True(t, resetRequest.Reboot)
+
+			labels := make([]string, 0, len(resetRequest.SystemPartitionsToWipe))
+
+			for _, partition := range resetRequest.SystemPartitionsToWipe {
+				require.True(t, partition.Wipe)
+
+				labels = append(labels, partition.Label)
+			}
+
+			require.Equal(t, tt.wantLabels, labels)
+		})
+	}
+}
+
+type fakeEtcdRestoreStoreFactory struct {
+	store *fakeEtcdRestoreStore
+}
+
+func (f *fakeEtcdRestoreStoreFactory) GetStore() (etcdbackup.Store, error) { return f.store, nil }
+
+func (f *fakeEtcdRestoreStoreFactory) Start(context.Context, state.State, *zap.Logger) error {
+	return nil
+}
+
+func (f *fakeEtcdRestoreStoreFactory) Description() string { return "fake" }
+
+func (f *fakeEtcdRestoreStoreFactory) SetThroughputs(uint64, uint64) {}
+
+type fakeEtcdRestoreStore struct {
+	backupData etcdbackup.BackupData
+	snapshots  []string
+}
+
+func (f *fakeEtcdRestoreStore) ListBackups(context.Context, string) (iter.Seq2[etcdbackup.Info, error], error) {
+	return func(yield func(etcdbackup.Info, error) bool) {
+		for _, snapshot := range f.snapshots {
+			if !yield(etcdbackup.Info{Snapshot: snapshot}, nil) {
+				return
+			}
+		}
+	}, nil
+}
+
+func (f *fakeEtcdRestoreStore) Upload(context.Context, etcdbackup.Description, io.Reader) error {
+	return errors.New("not implemented")
+}
+
+func (f *fakeEtcdRestoreStore) Download(context.Context, []byte, string, string) (etcdbackup.BackupData, io.ReadCloser, error) {
+	return f.backupData, io.NopCloser(bytes.NewReader(nil)), nil
+}
+
+func (f *fakeEtcdRestoreStore) Delete(context.Context, string, string) error {
+	return errors.New("not implemented")
+}
+
+// fakeEtcdRestoreStream is a minimal grpc.ServerStreamingServer for EtcdRestore guard tests.
+type fakeEtcdRestoreStream struct {
+	grpc.ServerStream
+	ctx  context.Context //nolint:containedctx
+	sent []*management.EtcdRestoreResponse
+}
+
+func (f *fakeEtcdRestoreStream) Context() context.Context { return f.ctx }
+
+func (f *fakeEtcdRestoreStream) Send(resp *management.EtcdRestoreResponse) error {
+	f.sent = append(f.sent, resp)
+
+	return nil
+}
@@ -7,8 +7,10 @@ package grpc_test
 
 import (
 	"context"
+	"crypto/ed25519"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/stretchr/testify/require"
@@ -137,6 +139,14 @@ func (c *capturingAuditLogger) Reader(context.Context, auditlog.ReadFilters) (au
 	return nil, errors.New("not implemented")
 }
 
+func (c *capturingAuditLogger) ProofReader(context.Context, time.Time, time.Time) (auditlog.ProofReader, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (c *capturingAuditLogger) SigningPublicKey() (ed25519.PublicKey, error) {
+	return nil, errors.New("not implemented")
+}
+
 func (c *capturingAuditLogger) AuditAuditLogAccess(context.Context, auditlog.ReadFilters) error {
 	return nil
 }
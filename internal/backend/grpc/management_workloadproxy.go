@@ -0,0 +1,122 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/pkg/access/role"
+	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/services/workloadproxy"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+)
+
+// defaultWorkloadProxyCredentialsTTL is the lifetime of the workload proxy credentials when the request does not set one.
+const defaultWorkloadProxyCredentialsTTL = time.Hour
+
+// WorkloadProxyCredentials issues the credentials to access an exposed service without a browser.
+//
+// The token is accepted by the HTTP and gRPC services in the TokenHeader header, and the client certificate
+// (issued only if the request carries a certificate signing request) by the TCP services.
+func (s *managementServer) WorkloadProxyCredentials(ctx context.Context, req *management.WorkloadProxyCredentialsRequest) (*management.WorkloadProxyCredentialsResponse, error) {
+	if req.Alias == "" {
+		return nil, status.Error(codes.InvalidArgument, "alias is required")
+	}
+
+	ttl := defaultWorkloadProxyCredentialsTTL
+
+	if req.Ttl != nil {
+		ttl = req.Ttl.AsDuration()
+	}
+
+	if ttl <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "ttl must be positive")
+	}
+
+	if ttl > workloadproxy.MaxCredentialsTTL {
+		return nil, status.Errorf(codes.InvalidArgument, "ttl is too long (max allowed: %s)", workloadproxy.MaxCredentialsTTL)
+	}
+
+	exposedServices, err := safe.StateListAll[*omnires.ExposedService](
+		actor.MarkContextAsInternalActor(ctx),
+		s.omniState,
+		state.WithLabelQuery(resource.LabelEqual(omnires.LabelExposedServiceAlias, req.Alias)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if exposedServices.Len() == 0 {
+		return nil, status.Errorf(codes.NotFound, "exposed service %q not found", req.Alias)
+	}
+
+	exposedService := exposedServices.Get(0)
+
+	clusterID, ok := exposedService.Metadata().Labels().Get(omnires.LabelCluster)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "exposed service %q not found", req.Alias)
+	}
+
+	_, authResult, err := s.checkClusterAuthorization(ctx, clusterID, role.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	if authResult.Identity == "" {
+		return nil, status.Error(codes.FailedPrecondition, "workload proxy credentials can only be issued with the authentication enabled")
+	}
+
+	signingKey, err := s.jwtSigningKeyProvider.SigningKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	credentials := workloadproxy.Credentials{
+		Expiration: time.Now().Add(ttl),
+		Identity:   authResult.Identity,
+		Cluster:    clusterID,
+		Alias:      req.Alias,
+	}
+
+	token, err := workloadproxy.IssueToken(signingKey, credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	var certificate []byte
+
+	if len(req.Csr) > 0 {
+		certificate, err = workloadproxy.IssueCertificate(signingKey, req.Csr, credentials)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	s.logger.Info("issued workload proxy credentials",
+		zap.String("identity", credentials.Identity),
+		zap.String("cluster", clusterID),
+		zap.String("alias", req.Alias),
+		zap.Bool("certificate", certificate != nil),
+		zap.Time("expiration", credentials.Expiration),
+	)
+
+	return &management.WorkloadProxyCredentialsResponse{
+		Token:       token,
+		Certificate: certificate,
+		Expiration:  timestamppb.New(credentials.Expiration),
+		Url:         exposedService.TypedSpec().Value.Url,
+	}, nil
+}
@@ -60,6 +60,7 @@ const (
 // TalosAuditor is an interface for auditing Talos access.
 type TalosAuditor interface {
 	AuditTalosAccess(context.Context, string, string, string) error
+	AuditTalosAccessDenied(context.Context, string, string, string) error
 }
 
 // Router wraps grpc-proxy StreamDirector.
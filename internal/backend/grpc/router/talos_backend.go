@@ -148,12 +148,29 @@ func (backend *TalosBackend) GetConnection(ctx context.Context, fullMethodName s
 		return ctx, nil, err
 	}
 
+	talosAccessAllowed := true
+
+	if backend.clusterID != "" {
+		if talosAccessAllowed, err = accesspolicy.CheckTalosAccess(ctx, backend.clusterID, backend.omniState, fullMethodName); err != nil {
+			return ctx, nil, err
+		}
+	}
+
 	if backend.talosAuditor != nil {
-		if err = backend.talosAuditor.AuditTalosAccess(ctx, strings.TrimLeft(fullMethodName, "/"), backend.clusterID, getNodeID(md)); err != nil {
+		auditTalosAccess := backend.talosAuditor.AuditTalosAccess
+		if !talosAccessAllowed {
+			auditTalosAccess = backend.talosAuditor.AuditTalosAccessDenied
+		}
+
+		if err = auditTalosAccess(ctx, strings.TrimLeft(fullMethodName, "/"), backend.clusterID, getNodeID(md)); err != nil {
 			return ctx, nil, err
 		}
 	}
 
+	if !talosAccessAllowed {
+		return ctx, nil, status.Errorf(codes.PermissionDenied, "the access policy denies calling %s on the cluster %q", strings.TrimLeft(fullMethodName, "/"), backend.clusterID)
+	}
+
 	hasModifyAccess := false
 
 	_, authErr := auth.Check(ctx, auth.WithRole(role.Operator))
@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"strings"
 	"testing"
 	"time"
 
@@ -23,13 +24,21 @@ import (
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	omnirole "github.com/siderolabs/omni/client/pkg/access/role"
+	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
 	"github.com/siderolabs/omni/internal/backend/dns"
 	"github.com/siderolabs/omni/internal/backend/grpc/router"
 	omniruntime "github.com/siderolabs/omni/internal/backend/runtime/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/ctxstore"
 )
 
 type testNodeResolver struct{}
@@ -181,6 +190,76 @@ func TestTalosBackendAuditsAfterVerifier(t *testing.T) {
 	require.Equal(t, "some-node", auditor.nodeID)
 }
 
+func TestTalosBackendAccessPolicyTalosMethods(t *testing.T) {
+	t.Parallel()
+
+	logger := zaptest.NewLogger(t)
+	st, err := omniruntime.NewTestState(logger)
+	require.NoError(t, err)
+
+	ctx := actor.MarkContextAsInternalActor(t.Context())
+
+	require.NoError(t, st.Default().Create(ctx, authres.NewIdentity("user@example.com")))
+
+	accessPolicy := authres.NewAccessPolicy()
+	accessPolicy.TypedSpec().Value.Rules = []*specs.AccessPolicyRule{
+		{
+			Users:    []string{"user@example.com"},
+			Clusters: []string{"test-cluster"},
+			Talos: &specs.AccessPolicyRule_Talos{
+				Allow: []string{"Hostname", "Logs", "Reboot"},
+				Deny:  []string{"machine.MachineService/Reboot"},
+			},
+		},
+	}
+
+	require.NoError(t, st.Default().Create(ctx, accessPolicy))
+
+	conn, err := dial("127.0.0.1:10501")
+	require.NoError(t, err)
+
+	auditor := &capturingTalosAuditor{}
+
+	backend := router.NewTalosBackend(
+		"test-backend",
+		"test-cluster",
+		&testNodeResolver{},
+		conn,
+		true,
+		func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			ctx = ctxstore.WithValue(ctx, auth.IdentityContextKey{Identity: "user@example.com"})
+			ctx = ctxstore.WithValue(ctx, auth.RoleContextKey{Role: omnirole.Operator})
+
+			return handler(ctx, req)
+		},
+		st.Default(),
+		auditor,
+	)
+
+	for _, tt := range []struct {
+		fullMethodName string
+		allowed        bool
+	}{
+		{fullMethodName: machine.MachineService_Hostname_FullMethodName, allowed: true},
+		{fullMethodName: machine.MachineService_Reboot_FullMethodName},
+		{fullMethodName: machine.MachineService_Reset_FullMethodName},
+	} {
+		t.Run(tt.fullMethodName, func(t *testing.T) {
+			incomingCtx := metadata.NewIncomingContext(t.Context(), metadata.Pairs("node", "some-node", "cluster", "test-cluster"))
+
+			_, _, err := backend.GetConnection(incomingCtx, tt.fullMethodName)
+			if tt.allowed {
+				require.NoError(t, err)
+			} else {
+				require.Equal(t, codes.PermissionDenied, status.Code(err))
+			}
+
+			require.Equal(t, strings.TrimLeft(tt.fullMethodName, "/"), auditor.fullMethod)
+			require.Equal(t, !tt.allowed, auditor.denied)
+		})
+	}
+}
+
 func makeGRPCProxy(ctx context.Context, endpoint, serverEndpoint string, st state.State) (func() error, error) {
 	grpcProxyServer := router.NewServer(&testDirector{serverEndpoint: serverEndpoint, omniState: st})
 
@@ -220,6 +299,7 @@ type capturingTalosAuditor struct {
 	fullMethod     string
 	clusterID      string
 	nodeID         string
+	denied         bool
 }
 
 func (c *capturingTalosAuditor) AuditTalosAccess(ctx context.Context, fullMethodName, clusterID, nodeID string) error {
@@ -227,6 +307,17 @@ func (c *capturingTalosAuditor) AuditTalosAccess(ctx context.Context, fullMethod
 	c.fullMethod = fullMethodName
 	c.clusterID = clusterID
 	c.nodeID = nodeID
+	c.denied = false
+
+	return nil
+}
+
+func (c *capturingTalosAuditor) AuditTalosAccessDenied(ctx context.Context, fullMethodName, clusterID, nodeID string) error {
+	if err := c.AuditTalosAccess(ctx, fullMethodName, clusterID, nodeID); err != nil {
+		return err
+	}
+
+	c.denied = true
 
 	return nil
 }
@@ -42,7 +42,7 @@ func (s *managementServer) CreateServiceAccount(ctx context.Context, req *manage
 		return nil, err
 	}
 
-	id, err := serviceaccount.Create(ctx, s.omniState, req.Name, req.Role, req.UseUserRole, []byte(req.ArmoredPgpPublicKey))
+	id, err := serviceaccount.Create(ctx, s.omniState, req.Name, req.Role, req.UseUserRole, []byte(req.ArmoredPgpPublicKey), req.CustomRoles...)
 	if err != nil {
 		return nil, wrapError(err)
 	}
@@ -151,7 +151,8 @@ func (s *managementServer) ListServiceAccounts(ctx context.Context, _ *emptypb.E
 					}
 				},
 			),
-			Role: status.TypedSpec().Value.Role,
+			Role:        status.TypedSpec().Value.Role,
+			CustomRoles: status.TypedSpec().Value.CustomRoles,
 		}
 
 		if is, ok := identityStatusByID[status.Metadata().ID()]; ok {
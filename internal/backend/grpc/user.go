@@ -29,7 +29,7 @@ func (s *managementServer) CreateUser(ctx context.Context, req *management.Creat
 		return nil, err
 	}
 
-	userID, err := user.Create(ctx, s.omniState, req.Email, req.Role)
+	userID, err := user.Create(ctx, s.omniState, req.Email, req.Role, req.CustomRoles...)
 	if err != nil {
 		return nil, wrapError(err)
 	}
@@ -51,6 +51,12 @@ func (s *managementServer) UpdateUser(ctx context.Context, req *management.Updat
 		return nil, wrapError(err)
 	}
 
+	if req.CustomRoles != nil {
+		if err := user.UpdateCustomRoles(ctx, s.omniState, req.Email, req.CustomRoles.GetRoles()); err != nil {
+			return nil, wrapError(err)
+		}
+	}
+
 	return &emptypb.Empty{}, nil
 }
 
@@ -98,6 +104,7 @@ func (s *managementServer) ListUsers(ctx context.Context, _ *emptypb.Empty) (*ma
 
 		if foundUser, ok := userByID[identity.TypedSpec().Value.UserId]; ok {
 			u.Role = foundUser.TypedSpec().Value.Role
+			u.CustomRoles = foundUser.TypedSpec().Value.CustomRoles
 		}
 
 		if is, ok := identityStatusByID[identity.Metadata().ID()]; ok {
@@ -10,6 +10,9 @@
 // until enough bytes-budget is available for the marshaled payload, bounded by
 // min(ctx.Deadline-now, maxWait). On timeout it returns codes.DeadlineExceeded.
 // Service accounts and unknown callers share the `user` bucket.
+//
+// RequestLimiter limits the rate and the concurrency of the gRPC API requests
+// per identity, with the limits of the actor type and of the called method.
 package ratelimit
 
 import (
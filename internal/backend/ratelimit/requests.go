@@ -0,0 +1,440 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/audit/auditlog"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+const (
+	reasonRate        = "rate"
+	reasonConcurrency = "concurrency"
+
+	// concurrencyRetryDelay is the retry hint of the requests rejected by the concurrency limits.
+	//
+	// There is no way to tell when one of the requests in flight completes, so the client is asked to back off for a while.
+	concurrencyRetryDelay = time.Second
+
+	// auditInterval is the minimum interval between the audit log entries of an identity tripping the same limit.
+	// The rejections in between are counted in the next entry.
+	auditInterval = time.Minute
+
+	// sweepInterval is the interval between the removals of the limiter state of the idle identities.
+	sweepInterval = 5 * time.Minute
+)
+
+// Auditor records the API requests rejected by the RequestLimiter.
+type Auditor interface {
+	AuditRateLimit(ctx context.Context, access *auditlog.RateLimit) error
+}
+
+// RequestLimiter limits the rate and the concurrency of the gRPC API requests, per identity.
+//
+// Each identity gets its own budget of the limits of its actor type, and of the limits of the methods it calls.
+// The internal and unauthenticated calls are not limited: the latter are rejected by the auth interceptors anyway,
+// unless the method is public.
+//
+// Streams count against the concurrency limits for as long as they are open, so the limits must leave room for the watches the UI keeps.
+//
+// A nil *RequestLimiter is a valid passthrough.
+type RequestLimiter struct {
+	auditor      Auditor
+	logger       *zap.Logger
+	actorLimits  map[actor.Type]limit
+	states       map[limiterKey]*limiterState
+	admitted     *prometheus.CounterVec
+	rejected     *prometheus.CounterVec
+	inFlight     *prometheus.GaugeVec
+	lastSweep    time.Time
+	methodLimits []methodLimit
+	mu           sync.Mutex
+}
+
+type limit struct {
+	name          string
+	perSecond     uint64
+	burst         uint64
+	maxConcurrent uint64
+}
+
+func (l limit) rateEnabled() bool {
+	return l.perSecond > 0 && l.burst > 0
+}
+
+func (l limit) enabled() bool {
+	return l.rateEnabled() || l.maxConcurrent > 0
+}
+
+type methodLimit struct {
+	pattern string
+	limit
+}
+
+// limiterKey identifies the budget of an identity under a single limit.
+type limiterKey struct {
+	identity string
+	limit    string
+}
+
+type limiterState struct {
+	lastAudit time.Time
+	rate      *rate.Limiter
+	inFlight  uint64
+	rejected  int
+}
+
+// NewRequestLimiter constructs a RequestLimiter from services.apiRateLimits.
+//
+// Returns nil when no limit is configured, so the interceptors pass the requests through without any overhead.
+func NewRequestLimiter(cfg config.APIRateLimits, registry prometheus.Registerer, auditor Auditor, logger *zap.Logger) (*RequestLimiter, error) {
+	actorLimits := map[actor.Type]limit{
+		actor.TypeUser:           actorLimit(actor.TypeUser, cfg.User),
+		actor.TypeServiceAccount: actorLimit(actor.TypeServiceAccount, cfg.ServiceAccount),
+		actor.TypeInfraProvider:  actorLimit(actor.TypeInfraProvider, cfg.InfraProvider),
+	}
+
+	anyEnabled := false
+
+	for _, l := range actorLimits {
+		if l.enabled() {
+			anyEnabled = true
+		}
+	}
+
+	methodLimits := make([]methodLimit, 0, len(cfg.Methods))
+
+	for _, m := range cfg.Methods {
+		if _, err := filepath.Match(m.Method, ""); err != nil || m.Method == "" {
+			return nil, fmt.Errorf("invalid method pattern %q in the API rate limits", m.Method)
+		}
+
+		ml := methodLimit{
+			pattern: m.Method,
+			limit: limit{
+				name:          m.Method,
+				perSecond:     m.GetRequestsPerSecond(),
+				burst:         m.GetRequestsBurst(),
+				maxConcurrent: m.GetMaxConcurrentRequests(),
+			},
+		}
+
+		if ml.enabled() {
+			anyEnabled = true
+		}
+
+		methodLimits = append(methodLimits, ml)
+	}
+
+	if !anyEnabled {
+		return nil, nil //nolint:nilnil
+	}
+
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	l := &RequestLimiter{
+		auditor:      auditor,
+		logger:       logger,
+		actorLimits:  actorLimits,
+		methodLimits: methodLimits,
+		states:       map[limiterKey]*limiterState{},
+		lastSweep:    time.Now(),
+		admitted: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "omni",
+			Subsystem: "api_rate_limit",
+			Name:      "admitted_total",
+			Help:      "Number of API requests admitted by the rate limits, per caller class.",
+		}, []string{"class"}),
+		rejected: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "omni",
+			Subsystem: "api_rate_limit",
+			Name:      "rejected_total",
+			Help: "Number of API requests rejected with ResourceExhausted by the rate limits, per caller class, method and reason. " +
+				"reason=rate: the request rate exceeded the configured budget. reason=concurrency: too many requests were in flight.",
+		}, []string{"class", "method", "reason"}),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "omni",
+			Subsystem: "api_rate_limit",
+			Name:      "in_flight_requests",
+			Help:      "Number of API requests and open streams subject to the rate limits, per caller class.",
+		}, []string{"class"}),
+	}
+
+	if registry != nil {
+		registry.MustRegister(l.admitted, l.rejected, l.inFlight)
+	}
+
+	return l, nil
+}
+
+func actorLimit(actorType actor.Type, cfg config.APIActorRateLimit) limit {
+	return limit{
+		name:          string(actorType),
+		perSecond:     cfg.GetRequestsPerSecond(),
+		burst:         cfg.GetRequestsBurst(),
+		maxConcurrent: cfg.GetMaxConcurrentRequests(),
+	}
+}
+
+// Unary returns the unary server interceptor enforcing the limits.
+func (l *RequestLimiter) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		release, err := l.Acquire(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		defer release()
+
+		return handler(ctx, req)
+	}
+}
+
+// Stream returns the stream server interceptor enforcing the limits.
+func (l *RequestLimiter) Stream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		release, err := l.Acquire(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		defer release()
+
+		return handler(srv, ss)
+	}
+}
+
+// Acquire admits the request of the caller to the method, or rejects it with codes.ResourceExhausted carrying a RetryInfo.
+//
+// The returned function must be called once the request completes, to free its concurrency slots.
+func (l *RequestLimiter) Acquire(ctx context.Context, fullMethodName string) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	classification := actor.Classify(ctx)
+
+	actorLim, ok := l.actorLimits[classification.Type]
+	if !ok {
+		return func() {}, nil
+	}
+
+	limits := make([]limit, 0, 2)
+
+	if actorLim.enabled() {
+		limits = append(limits, actorLim)
+	}
+
+	if ml, matched := l.methodLimit(fullMethodName); matched {
+		limits = append(limits, ml.limit)
+	}
+
+	if len(limits) == 0 {
+		return func() {}, nil
+	}
+
+	class := string(classification.Type)
+
+	states, rej := l.admit(classification.Identity(), limits, time.Now())
+	if rej != nil {
+		return nil, l.reject(ctx, class, fullMethodName, rej)
+	}
+
+	l.admitted.WithLabelValues(class).Inc()
+	l.inFlight.WithLabelValues(class).Inc()
+
+	var once sync.Once
+
+	return func() {
+		once.Do(func() {
+			l.mu.Lock()
+
+			for _, st := range states {
+				st.inFlight--
+			}
+
+			l.mu.Unlock()
+
+			l.inFlight.WithLabelValues(class).Dec()
+		})
+	}, nil
+}
+
+type rejection struct {
+	audit      *auditlog.RateLimit
+	limit      string
+	reason     string
+	retryAfter time.Duration
+}
+
+// admit takes a concurrency slot and a rate token of each of the limits for the identity, or none of them if any of the limits is exceeded.
+func (l *RequestLimiter) admit(identity string, limits []limit, now time.Time) ([]*limiterState, *rejection) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep(now)
+
+	states := make([]*limiterState, 0, len(limits))
+
+	for _, lim := range limits {
+		states = append(states, l.state(limiterKey{identity: identity, limit: lim.name}, lim))
+	}
+
+	// check the concurrency first, so that the rejected requests don't consume the rate budget
+	for i, lim := range limits {
+		if lim.maxConcurrent > 0 && states[i].inFlight >= lim.maxConcurrent {
+			return nil, newRejection(now, lim, states[i], reasonConcurrency, concurrencyRetryDelay)
+		}
+	}
+
+	reservations := make([]*rate.Reservation, 0, len(limits))
+
+	for i, lim := range limits {
+		if states[i].rate == nil {
+			continue
+		}
+
+		reservation := states[i].rate.ReserveN(now, 1)
+
+		if delay := reservation.DelayFrom(now); !reservation.OK() || delay > 0 {
+			reservation.CancelAt(now)
+
+			for _, r := range reservations {
+				r.CancelAt(now)
+			}
+
+			return nil, newRejection(now, lim, states[i], reasonRate, delay)
+		}
+
+		reservations = append(reservations, reservation)
+	}
+
+	for _, st := range states {
+		st.inFlight++
+	}
+
+	return states, nil
+}
+
+// newRejection counts the rejection against the state, and attaches the audit log entry to it unless one was written recently.
+func newRejection(now time.Time, lim limit, st *limiterState, reason string, retryAfter time.Duration) *rejection {
+	if retryAfter <= 0 {
+		// a reservation is not OK only if the burst is smaller than a single request, which never happens with a non-zero burst
+		retryAfter = concurrencyRetryDelay
+	}
+
+	st.rejected++
+
+	rej := &rejection{
+		limit:      lim.name,
+		reason:     reason,
+		retryAfter: retryAfter,
+	}
+
+	if now.Sub(st.lastAudit) >= auditInterval {
+		rej.audit = &auditlog.RateLimit{
+			Limit:      lim.name,
+			Reason:     reason,
+			RetryAfter: retryAfter.String(),
+			Rejected:   st.rejected,
+		}
+
+		st.lastAudit = now
+		st.rejected = 0
+	}
+
+	return rej
+}
+
+// reject records the rejection of the request and returns its error.
+func (l *RequestLimiter) reject(ctx context.Context, class, fullMethodName string, rej *rejection) error {
+	l.rejected.WithLabelValues(class, fullMethodName, rej.reason).Inc()
+
+	if rej.audit != nil && l.auditor != nil {
+		rej.audit.FullMethodName = fullMethodName
+
+		if err := l.auditor.AuditRateLimit(ctx, rej.audit); err != nil {
+			l.logger.Error("failed to audit the rate limited request", zap.String("method", fullMethodName), zap.Error(err))
+		}
+	}
+
+	st := status.Newf(codes.ResourceExhausted, "API rate limit exceeded (limit=%s, reason=%s), retry after %s", rej.limit, rej.reason, rej.retryAfter)
+
+	if detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(rej.retryAfter)}); err == nil {
+		st = detailed
+	}
+
+	return st.Err()
+}
+
+// methodLimit returns the first limit of the methods matching the full method name.
+func (l *RequestLimiter) methodLimit(fullMethodName string) (methodLimit, bool) {
+	for _, ml := range l.methodLimits {
+		if matched, _ := filepath.Match(ml.pattern, fullMethodName); matched { //nolint:errcheck
+			return ml, ml.enabled()
+		}
+	}
+
+	return methodLimit{}, false
+}
+
+// state returns the state of the key, creating it if needed. It must be called with the lock held.
+func (l *RequestLimiter) state(key limiterKey, lim limit) *limiterState {
+	st, ok := l.states[key]
+	if ok {
+		return st
+	}
+
+	st = &limiterState{}
+
+	if lim.rateEnabled() {
+		st.rate = buildLimiter(lim.perSecond, lim.burst)
+	}
+
+	l.states[key] = st
+
+	return st
+}
+
+// sweep removes the state of the identities which have no requests in flight and have their rate budget refilled,
+// as recreating it makes no difference. It must be called with the lock held.
+func (l *RequestLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+
+	l.lastSweep = now
+
+	for key, st := range l.states {
+		if st.inFlight > 0 || now.Sub(st.lastAudit) < auditInterval {
+			continue
+		}
+
+		if st.rate != nil && st.rate.TokensAt(now) < float64(st.rate.Burst()) {
+			continue
+		}
+
+		delete(l.states, key)
+	}
+}
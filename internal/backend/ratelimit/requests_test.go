@@ -0,0 +1,280 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package ratelimit_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/pkg/access"
+	"github.com/siderolabs/omni/internal/backend/ratelimit"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/audit/auditlog"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/config"
+	"github.com/siderolabs/omni/internal/pkg/ctxstore"
+)
+
+const (
+	listMethod          = "/omni.resources.ResourceService/List"
+	supportBundleMethod = "/management.ManagementService/GetSupportBundle"
+)
+
+type mockAuditor struct {
+	events []*auditlog.RateLimit
+	mu     sync.Mutex
+}
+
+func (m *mockAuditor) AuditRateLimit(_ context.Context, access *auditlog.RateLimit) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.events = append(m.events, access)
+
+	return nil
+}
+
+func (m *mockAuditor) get() []*auditlog.RateLimit {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.events
+}
+
+func actorLimit(perSec, burst, maxConcurrent uint64) config.APIActorRateLimit {
+	var l config.APIActorRateLimit
+
+	l.SetRequestsPerSecond(perSec)
+	l.SetRequestsBurst(burst)
+	l.SetMaxConcurrentRequests(maxConcurrent)
+
+	return l
+}
+
+func withIdentity(identity string) context.Context {
+	return ctxstore.WithValue(context.Background(), auth.IdentityContextKey{Identity: identity})
+}
+
+func requireResourceExhausted(t *testing.T, err error) {
+	t.Helper()
+
+	require.Error(t, err)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	var retryInfo *errdetails.RetryInfo
+
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			retryInfo = info
+		}
+	}
+
+	require.NotNil(t, retryInfo, "the error carries no retry hint")
+	require.Positive(t, retryInfo.GetRetryDelay().AsDuration())
+}
+
+func TestRequestLimiterNilIsPassthrough(t *testing.T) {
+	t.Parallel()
+
+	limiter, err := ratelimit.NewRequestLimiter(config.APIRateLimits{}, nil, nil, nil)
+	require.NoError(t, err)
+	assert.Nil(t, limiter)
+
+	for range 10_000 {
+		release, err := limiter.Acquire(withIdentity("alice@example.com"), listMethod)
+		require.NoError(t, err)
+
+		release()
+	}
+}
+
+func TestRequestLimiterInvalidMethodPattern(t *testing.T) {
+	t.Parallel()
+
+	_, err := ratelimit.NewRequestLimiter(config.APIRateLimits{
+		Methods: []config.APIMethodRateLimit{{Method: "/management.ManagementService/[", MaxConcurrentRequests: new(uint64(1))}},
+	}, nil, nil, nil)
+	require.ErrorContains(t, err, "invalid method pattern")
+}
+
+func TestRequestLimiterRate(t *testing.T) {
+	t.Parallel()
+
+	auditor := &mockAuditor{}
+
+	limiter, err := ratelimit.NewRequestLimiter(config.APIRateLimits{
+		User:           actorLimit(1, 2, 0),
+		ServiceAccount: actorLimit(1, 1, 0),
+	}, nil, auditor, nil)
+	require.NoError(t, err)
+	require.NotNil(t, limiter)
+
+	alice := withIdentity("alice@example.com")
+
+	for range 2 {
+		release, err := limiter.Acquire(alice, listMethod)
+		require.NoError(t, err)
+
+		release()
+	}
+
+	_, err = limiter.Acquire(alice, listMethod)
+	requireResourceExhausted(t, err)
+
+	_, err = limiter.Acquire(alice, supportBundleMethod)
+	requireResourceExhausted(t, err)
+
+	// the budget is per identity
+	_, err = limiter.Acquire(withIdentity("bob@example.com"), listMethod)
+	require.NoError(t, err)
+
+	// service accounts get the limits of their own actor type
+	ciBot := withIdentity("ci-bot" + access.ServiceAccountNameSuffix)
+
+	_, err = limiter.Acquire(ciBot, listMethod)
+	require.NoError(t, err)
+
+	_, err = limiter.Acquire(ciBot, listMethod)
+	requireResourceExhausted(t, err)
+
+	// Omni's own calls are never limited
+	for range 100 {
+		_, err = limiter.Acquire(actor.MarkContextAsInternalActor(context.Background()), listMethod)
+		require.NoError(t, err)
+	}
+
+	// the repeated rejections of the same identity under the same limit are audited once
+	events := auditor.get()
+	require.Len(t, events, 2)
+
+	assert.Equal(t, listMethod, events[0].FullMethodName)
+	assert.Equal(t, string(actor.TypeUser), events[0].Limit)
+	assert.Equal(t, "rate", events[0].Reason)
+	assert.Equal(t, 1, events[0].Rejected)
+
+	assert.Equal(t, string(actor.TypeServiceAccount), events[1].Limit)
+}
+
+func TestRequestLimiterConcurrency(t *testing.T) {
+	t.Parallel()
+
+	limiter, err := ratelimit.NewRequestLimiter(config.APIRateLimits{
+		User: actorLimit(0, 0, 2),
+	}, nil, nil, nil)
+	require.NoError(t, err)
+
+	alice := withIdentity("alice@example.com")
+
+	release1, err := limiter.Acquire(alice, listMethod)
+	require.NoError(t, err)
+
+	release2, err := limiter.Acquire(alice, listMethod)
+	require.NoError(t, err)
+
+	_, err = limiter.Acquire(alice, listMethod)
+	requireResourceExhausted(t, err)
+	assert.Contains(t, err.Error(), "reason=concurrency")
+
+	release1()
+	release1() // releasing twice must not free another slot
+
+	release3, err := limiter.Acquire(alice, listMethod)
+	require.NoError(t, err)
+
+	_, err = limiter.Acquire(alice, listMethod)
+	requireResourceExhausted(t, err)
+
+	release2()
+	release3()
+}
+
+func TestRequestLimiterMethods(t *testing.T) {
+	t.Parallel()
+
+	limiter, err := ratelimit.NewRequestLimiter(config.APIRateLimits{
+		User: actorLimit(1000, 1000, 0),
+		Methods: []config.APIMethodRateLimit{
+			{
+				Method:                supportBundleMethod,
+				MaxConcurrentRequests: new(uint64(1)),
+			},
+			{
+				Method:            "/omni.resources.ResourceService/*",
+				RequestsPerSecond: new(uint64(1)),
+				RequestsBurst:     new(uint64(1)),
+			},
+		},
+	}, nil, nil, nil)
+	require.NoError(t, err)
+
+	alice := withIdentity("alice@example.com")
+
+	release, err := limiter.Acquire(alice, supportBundleMethod)
+	require.NoError(t, err)
+
+	_, err = limiter.Acquire(alice, supportBundleMethod)
+	requireResourceExhausted(t, err)
+
+	// the method limits are per identity as well
+	_, err = limiter.Acquire(withIdentity("bob@example.com"), supportBundleMethod)
+	require.NoError(t, err)
+
+	release()
+
+	_, err = limiter.Acquire(alice, supportBundleMethod)
+	require.NoError(t, err)
+
+	_, err = limiter.Acquire(alice, listMethod)
+	require.NoError(t, err)
+
+	_, err = limiter.Acquire(alice, "/omni.resources.ResourceService/Watch")
+	requireResourceExhausted(t, err)
+
+	// the methods without own limits only use the actor type budget
+	for range 10 {
+		_, err = limiter.Acquire(alice, "/management.ManagementService/Kubeconfig")
+		require.NoError(t, err)
+	}
+}
+
+func TestRequestLimiterInterceptors(t *testing.T) {
+	t.Parallel()
+
+	limiter, err := ratelimit.NewRequestLimiter(config.APIRateLimits{
+		User: actorLimit(0, 0, 1),
+	}, nil, nil, nil)
+	require.NoError(t, err)
+
+	alice := withIdentity("alice@example.com")
+
+	handlerCalled := false
+
+	_, err = limiter.Unary()(alice, nil, &grpc.UnaryServerInfo{FullMethod: listMethod}, func(ctx context.Context, _ any) (any, error) {
+		handlerCalled = true
+
+		// the request holds the only slot while it is handled
+		_, acquireErr := limiter.Acquire(ctx, listMethod)
+		requireResourceExhausted(t, acquireErr)
+
+		return nil, nil //nolint:nilnil
+	})
+	require.NoError(t, err)
+	require.True(t, handlerCalled)
+
+	// the slot is freed once the request completes
+	release, err := limiter.Acquire(alice, listMethod)
+	require.NoError(t, err)
+
+	release()
+}
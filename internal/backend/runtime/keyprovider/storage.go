@@ -10,6 +10,7 @@ import (
 	"context"
 	"crypto/rand"
 	"errors"
+	"fmt"
 
 	"github.com/ProtonMail/gopenpgp/v3/crypto"
 	"github.com/cosi-project/runtime/pkg/keystorage"
@@ -42,6 +43,28 @@ func (s *KeyProvider) ProvideKey() ([]byte, error) {
 	return key, nil
 }
 
+// KeyStorage returns the marshaled key storage of the master key.
+//
+// The master key can only be read from it with the private key of one of its slots, see [MasterKey].
+func (s *KeyProvider) KeyStorage(ctx context.Context) ([]byte, error) {
+	got, err := s.recstore.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return storageMarshal(got.Res)
+}
+
+// MasterKey reads the master key from the marshaled key storage with the private key.
+func MasterKey(keyStorage []byte, privateKey PrivateKeyData) ([]byte, error) {
+	ks, err := storageUnmarshal(keyStorage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal key storage: %w", err)
+	}
+
+	return ks.GetMasterKey(privateKey.slot, privateKey.key)
+}
+
 // New creates a new key provider.
 func New(
 	client etcd.Client,
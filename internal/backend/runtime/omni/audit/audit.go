@@ -8,6 +8,7 @@ package audit
 
 import (
 	"context"
+	"crypto/ed25519"
 	"errors"
 	"fmt"
 	"maps"
@@ -22,7 +23,9 @@ import (
 	"github.com/cosi-project/state-sqlite/pkg/sqlitexx"
 	"go.uber.org/zap"
 
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/audit/auditlog"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/audit/auditsink"
 	"github.com/siderolabs/omni/internal/pkg/config"
 	"github.com/siderolabs/omni/internal/pkg/ctxstore"
 )
@@ -31,6 +34,7 @@ type Logger interface {
 	Write(ctx context.Context, event auditlog.Event) error
 	Remove(ctx context.Context, start, end time.Time) error
 	Reader(ctx context.Context, filters auditlog.ReadFilters) (auditlog.Reader, error)
+	ProofReader(ctx context.Context, start, end time.Time) (auditlog.ProofReader, error)
 	FollowStart(ctx context.Context, startTsMs int64) (int64, error)
 	FollowBatch(ctx context.Context, afterID int64, limit int64) ([]auditlog.Entry, error)
 	FollowSubscribe() (<-chan struct{}, func())
@@ -40,7 +44,8 @@ type Logger interface {
 type LogOption func(*logConfig)
 
 type logConfig struct {
-	onCleanup func(int)
+	onCleanup  func(int)
+	signingKey ed25519.PrivateKey
 }
 
 // WithCleanupCallback sets a callback that is called after cleanup with the number of deleted rows.
@@ -50,6 +55,13 @@ func WithCleanupCallback(cb func(int)) LogOption {
 	}
 }
 
+// WithSigningKey sets the key the hash chain checkpoints and truncation markers of the audit log are signed with.
+func WithSigningKey(key ed25519.PrivateKey) LogOption {
+	return func(c *logConfig) {
+		c.signingKey = key
+	}
+}
+
 // NewLog creates a new audit logger.
 func NewLog(ctx context.Context, config config.LogsAudit, db *sqlitexx.Pool, logger *zap.Logger, opts ...LogOption) (*Log, error) {
 	var cfg logConfig
@@ -57,13 +69,27 @@ func NewLog(ctx context.Context, config config.LogsAudit, db *sqlitexx.Pool, log
 		opt(&cfg)
 	}
 
-	auditLogger, err := initLogger(ctx, config, db, logger, cfg.onCleanup)
+	auditLogger, err := initLogger(ctx, config, db, logger, cfg)
 	if err != nil {
 		return nil, err
 	}
 
+	sinks, err := initSinks(config.Sinks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize audit log sinks: %w", err)
+	}
+
+	var forwarder *auditsink.Forwarder
+
+	if len(sinks) > 0 && config.GetEnabled() {
+		if forwarder, err = auditsink.NewForwarder(ctx, auditLogger, db, sinks, logger); err != nil {
+			return nil, err
+		}
+	}
+
 	return &Log{
 		auditLogger:              auditLogger,
+		forwarder:                forwarder,
 		retentionPeriod:          config.GetRetentionPeriod(),
 		logger:                   logger,
 		mu:                       sync.RWMutex{},
@@ -79,6 +105,7 @@ func NewLog(ctx context.Context, config config.LogsAudit, db *sqlitexx.Pool, log
 //nolint:govet
 type Log struct {
 	auditLogger     Logger
+	forwarder       *auditsink.Forwarder
 	retentionPeriod time.Duration
 	logger          *zap.Logger
 
@@ -114,6 +141,11 @@ func (l *Log) Reader(ctx context.Context, filters auditlog.ReadFilters) (auditlo
 	return l.auditLogger.Reader(ctx, filters)
 }
 
+// ProofReader reads the events in the given time range with their hash chain proofs, in insertion order.
+func (l *Log) ProofReader(ctx context.Context, start, end time.Time) (auditlog.ProofReader, error) {
+	return l.auditLogger.ProofReader(ctx, start, end)
+}
+
 // FollowStart resolves the initial follow position for the given inclusive start timestamp.
 func (l *Log) FollowStart(ctx context.Context, startTsMs int64) (int64, error) {
 	return l.auditLogger.FollowStart(ctx, startTsMs)
@@ -173,6 +205,15 @@ func (l *Log) LogUpdateWithConflicts(ptr resource.Pointer) UpdateWithConflictsHo
 
 // AuditTalosAccess logs the talos access event.
 func (l *Log) AuditTalosAccess(ctx context.Context, fullMethodName string, clusterID string, nodeID string) error {
+	return l.auditTalosAccess(ctx, fullMethodName, clusterID, nodeID, false)
+}
+
+// AuditTalosAccessDenied logs the talos access event for the call denied by the access policy.
+func (l *Log) AuditTalosAccessDenied(ctx context.Context, fullMethodName string, clusterID string, nodeID string) error {
+	return l.auditTalosAccess(ctx, fullMethodName, clusterID, nodeID, true)
+}
+
+func (l *Log) auditTalosAccess(ctx context.Context, fullMethodName string, clusterID string, nodeID string, denied bool) error {
 	data := extractData(ctx, options{
 		userAgent:     internalAgent,
 		newDataIfNone: true,
@@ -188,6 +229,7 @@ func (l *Log) AuditTalosAccess(ctx context.Context, fullMethodName string, clust
 	data.TalosAccess.FullMethodName = fullMethodName
 	data.TalosAccess.ClusterName = clusterID
 	data.TalosAccess.MachineIP = nodeID
+	data.TalosAccess.Denied = denied
 
 	return l.auditLogger.Write(ctx, auditlog.Event{
 		Type:       "talos_access",
@@ -246,6 +288,67 @@ func (l *Log) auditAuditLogAccess(ctx context.Context, access *auditlog.AuditLog
 	})
 }
 
+// AuditWorkloadProxyAccess logs the access to an exposed service through the workload proxy, be it allowed or denied.
+//
+// The workload proxy requests don't go through the gRPC interceptors, so the session is built from the identity and the user agent of the request.
+func (l *Log) AuditWorkloadProxyAccess(ctx context.Context, identity, userAgent string, access *auditlog.WorkloadProxy) error {
+	data := &auditlog.Data{
+		Session: auditlog.Session{
+			UserAgent: userAgent,
+			Email:     identity,
+		},
+		WorkloadProxy: access,
+	}
+
+	return l.auditLogger.Write(ctx, auditlog.Event{
+		Type:       auditlog.EventTypeWorkloadProxyAccess.SQLString(),
+		TimeMillis: time.Now().UnixMilli(),
+		Data:       data,
+	})
+}
+
+// AuditRateLimit logs the rejection of the API requests by the rate limits.
+func (l *Log) AuditRateLimit(ctx context.Context, access *auditlog.RateLimit) error {
+	data := extractData(ctx, options{
+		userAgent:     internalAgent,
+		newDataIfNone: true,
+	})
+	if data == nil {
+		return nil
+	}
+
+	data.RateLimit = access
+
+	return l.auditLogger.Write(ctx, auditlog.Event{
+		Type:       auditlog.EventTypeRateLimit.SQLString(),
+		TimeMillis: time.Now().UnixMilli(),
+		Data:       data,
+	})
+}
+
+// AuditEtcdBackupPrune logs the deletion of the etcd backup by the retention policy as the destroy event of the backup.
+func (l *Log) AuditEtcdBackupPrune(ctx context.Context, clusterID, snapshot string, timestamp time.Time) error {
+	data := extractData(ctx, options{
+		userAgent:     internalAgent,
+		newDataIfNone: true,
+	})
+	if data == nil {
+		return nil
+	}
+
+	data.EtcdBackupPrune = &auditlog.EtcdBackupPrune{
+		ClusterID: clusterID,
+		Snapshot:  snapshot,
+	}
+
+	return l.auditLogger.Write(ctx, auditlog.MakeEvent(
+		auditlog.EventTypeDestroy.SQLString(),
+		omni.EtcdBackupType,
+		omni.NewEtcdBackup(clusterID, timestamp).Metadata().ID(),
+		data,
+	))
+}
+
 // Wrap wraps the http.Handler with audit logging.
 func (l *Log) Wrap(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
@@ -313,6 +416,17 @@ func (l *Log) RunCleanup(ctx context.Context) error {
 	}
 }
 
+// RunSinks delivers the audit log events to the configured sinks. It blocks until the context is canceled.
+func (l *Log) RunSinks(ctx context.Context) error {
+	if l.forwarder == nil {
+		<-ctx.Done()
+
+		return nil
+	}
+
+	return l.forwarder.Run(ctx)
+}
+
 type (
 	// CreateHook is a hook for specific type resource creation.
 	CreateHook = func(ctx context.Context, res resource.Resource, option ...state.CreateOption) error
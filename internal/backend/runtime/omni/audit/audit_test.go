@@ -33,6 +33,7 @@ import (
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/access/role"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/audit"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/audit/auditlog"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/audit/hooks"
@@ -449,6 +450,36 @@ func TestAuditLogAccessIsAudited(t *testing.T) {
 	require.ErrorIs(t, err, io.EOF)
 }
 
+func TestEtcdBackupPruneIsAudited(t *testing.T) {
+	config := config.LogsAudit{
+		Enabled: new(true),
+	}
+
+	l := must.Value(audit.NewLog(t.Context(), config, testDB(t), zaptest.NewLogger(t)))(t)
+
+	timestamp := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	require.NoError(t, l.AuditEtcdBackupPrune(t.Context(), "cluster1", "FFFFFFFF9A5F1A3F.snapshot", timestamp))
+
+	events := readAuditEvents(t, l)
+	require.Len(t, events, 1)
+	require.Equal(t, "destroy", events[0]["event_type"])
+	require.Equal(t, omni.EtcdBackupType, events[0]["resource_type"])
+	require.Equal(t, omni.NewEtcdBackup("cluster1", timestamp).Metadata().ID(), events[0]["resource_id"])
+
+	eventData, ok := events[0]["event_data"].(map[string]any)
+	require.True(t, ok)
+
+	session, ok := eventData["session"].(map[string]any)
+	require.True(t, ok)
+	require.Equal(t, "Omni-Internal-Agent", session["user_agent"])
+
+	prune, ok := eventData["etcd_backup_prune"].(map[string]any)
+	require.True(t, ok)
+	require.Equal(t, "cluster1", prune["cluster_id"])
+	require.Equal(t, "FFFFFFFF9A5F1A3F.snapshot", prune["snapshot"])
+}
+
 func readAuditEvents(t *testing.T, l *audit.Log) []map[string]any {
 	t.Helper()
 
@@ -12,6 +12,8 @@ import (
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
+
+	"github.com/siderolabs/omni/client/pkg/auditchain"
 )
 
 type Reader interface {
@@ -26,6 +28,14 @@ type Entry struct {
 	ID      int64
 }
 
+// ProofReader reads the audit log events with their hash chain proofs, oldest first by id.
+//
+// The payload of the events is newline-terminated, like the one returned by [Reader].
+type ProofReader interface {
+	io.Closer
+	Read() (auditchain.Event, error)
+}
+
 // ErrFollowPositionLost means the position a follower reads from points beyond every stored
 // event. Cleanup cannot cause this, it always spares the newest event so ids keep increasing,
 // but a database replaced underneath, e.g. restored from a backup, can.
@@ -44,6 +54,8 @@ const (
 	EventTypeTalosAccess                   // "talos_access"
 	EventTypeK8SAccess                     // "k8s_access"
 	EventTypeAuditLogAccess                // "audit_log_access"
+	EventTypeWorkloadProxyAccess           // "workload_proxy_access"
+	EventTypeRateLimit                     // "rate_limit"
 )
 
 // SQLString returns the string stored in the database for this event type.
@@ -67,6 +79,10 @@ func (e EventType) SQLString() string {
 		return "k8s_access"
 	case EventTypeAuditLogAccess:
 		return "audit_log_access"
+	case EventTypeWorkloadProxyAccess:
+		return "workload_proxy_access"
+	case EventTypeRateLimit:
+		return "rate_limit"
 	}
 
 	return ""
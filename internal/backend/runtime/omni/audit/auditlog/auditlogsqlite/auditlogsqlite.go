@@ -7,7 +7,9 @@
 package auditlogsqlite
 
 import (
+	"bytes"
 	"context"
+	"crypto/ed25519"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -26,6 +28,7 @@ import (
 	zombiesqlite "zombiezen.com/go/sqlite"
 	"zombiezen.com/go/sqlite/sqlitex"
 
+	"github.com/siderolabs/omni/client/pkg/auditchain"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/audit/auditlog"
 )
 
@@ -45,6 +48,20 @@ const (
 	actorEmailColumn = "actor_email"
 	resourceIDColumn = "resource_id"
 	clusterIDColumn  = "cluster_id"
+
+	// Hash chain columns.
+	prevHashColumn = "prev_hash"
+	hashColumn     = "hash"
+
+	// MarkersTableName is the SQLite table name the signed hash chain markers are stored in.
+	MarkersTableName      = "audit_log_chain_markers"
+	markerKindColumn      = "kind"
+	markerTSMillisColumn  = "ts_ms"
+	markerPublicKeyColumn = "public_key"
+	markerSignatureColumn = "signature"
+
+	// defaultCheckpointInterval is the number of events between the signed checkpoints.
+	defaultCheckpointInterval = 1000
 )
 
 // Schema includes the new nullable TEXT columns for specific lookups.
@@ -58,13 +75,30 @@ const schemaTmpl = `
       
       {{.ActorEmailColumn}}    TEXT,
       {{.ResourceIDColumn}}    TEXT,
-      {{.ClusterIDColumn}}     TEXT
+      {{.ClusterIDColumn}}     TEXT,
+
+      {{.PrevHashColumn}}      BLOB,
+      {{.HashColumn}}          BLOB
     ) STRICT;
 
     CREATE INDEX IF NOT EXISTS idx_{{.TableName}}_{{.EventTSMillisColumn}}
     ON {{.TableName}}({{.EventTSMillisColumn}});
+
+    CREATE TABLE IF NOT EXISTS {{.MarkersTableName}} (
+      {{.IDColumn}}                    INTEGER NOT NULL,
+      {{.MarkerKindColumn}}            TEXT NOT NULL,
+      {{.HashColumn}}                  BLOB NOT NULL,
+      {{.MarkerTSMillisColumn}}        INTEGER NOT NULL,
+      {{.MarkerPublicKeyColumn}}       BLOB NOT NULL,
+      {{.MarkerSignatureColumn}}       BLOB NOT NULL,
+
+      PRIMARY KEY ({{.IDColumn}}, {{.MarkerKindColumn}})
+    ) STRICT;
 `
 
+// chainColumns are added to the tables created before the audit log was chained.
+var chainColumns = []string{prevHashColumn, hashColumn}
+
 type schemaParams struct {
 	TableName           string
 	IDColumn            string
@@ -75,6 +109,14 @@ type schemaParams struct {
 	ActorEmailColumn    string
 	ResourceIDColumn    string
 	ClusterIDColumn     string
+
+	PrevHashColumn        string
+	HashColumn            string
+	MarkersTableName      string
+	MarkerKindColumn      string
+	MarkerTSMillisColumn  string
+	MarkerPublicKeyColumn string
+	MarkerSignatureColumn string
 }
 
 // Option configures optional Store behavior.
@@ -87,11 +129,32 @@ func WithCleanupCallback(cb func(int)) Option {
 	}
 }
 
+// WithSigningKey sets the key the hash chain checkpoints and truncation markers are signed with.
+//
+// Without it, the events are still chained, but no markers are recorded.
+func WithSigningKey(key ed25519.PrivateKey) Option {
+	return func(s *Store) {
+		s.signingKey = key
+	}
+}
+
+// WithCheckpointInterval sets the number of events between the signed checkpoints.
+func WithCheckpointInterval(interval int64) Option {
+	return func(s *Store) {
+		s.checkpointInterval = interval
+	}
+}
+
 // Store is the SQLite-backed audit log store.
+//
+// Every event is chained to the previous one by its hash, see [auditchain], and every checkpointInterval events
+// the hash is signed with the signing key. The cleanup records a signed truncation marker for the first event
+// after each removed range, so the pruned log still verifies.
 type Store struct {
-	db        *sqlitexx.Pool
-	logger    *zap.Logger
-	onCleanup func(int)
+	db         *sqlitexx.Pool
+	logger     *zap.Logger
+	onCleanup  func(int)
+	signingKey ed25519.PrivateKey
 
 	// subscribers holds the follower wakeup channels, guarded by subscribersMu.
 	subscribers   []chan struct{}
@@ -100,6 +163,7 @@ type Store struct {
 	timeout            time.Duration
 	maxSize            uint64
 	cleanupProbability float64
+	checkpointInterval int64
 }
 
 // NewStore creates a new audit log SQLite store.
@@ -122,6 +186,14 @@ func NewStore(ctx context.Context, db *sqlitexx.Pool, timeout time.Duration, max
 		ActorEmailColumn:    actorEmailColumn,
 		ResourceIDColumn:    resourceIDColumn,
 		ClusterIDColumn:     clusterIDColumn,
+
+		PrevHashColumn:        prevHashColumn,
+		HashColumn:            hashColumn,
+		MarkersTableName:      MarkersTableName,
+		MarkerKindColumn:      markerKindColumn,
+		MarkerTSMillisColumn:  markerTSMillisColumn,
+		MarkerPublicKeyColumn: markerPublicKeyColumn,
+		MarkerSignatureColumn: markerSignatureColumn,
 	}
 
 	tmpl, err := template.New("schema").Parse(schemaTmpl)
@@ -151,27 +223,63 @@ func NewStore(ctx context.Context, db *sqlitexx.Pool, timeout time.Duration, max
 		return nil, fmt.Errorf("failed to create sqlite log table schema: %w", err)
 	}
 
+	if err = addChainColumns(conn); err != nil {
+		return nil, err
+	}
+
 	store := &Store{
 		db:                 db,
 		logger:             logger,
 		timeout:            timeout,
 		maxSize:            maxSize,
 		cleanupProbability: cleanupProbability,
+		checkpointInterval: defaultCheckpointInterval,
 	}
 
 	for _, opt := range opts {
 		opt(store)
 	}
 
+	if store.checkpointInterval <= 0 {
+		store.checkpointInterval = defaultCheckpointInterval
+	}
+
 	return store, nil
 }
 
-func (s *Store) Write(ctx context.Context, event auditlog.Event) error {
-	query := fmt.Sprintf(`INSERT INTO %s (%s, %s, %s, %s, %s, %s, %s) VALUES
-	($event_type, $resource_type, $event_ts_ms, $event_data, $actor_email, $resource_id, $cluster_id)`,
-		TableName, eventTypeColumn, resourceTypeColumn, eventTSMillisColumn, eventDataColumn,
-		actorEmailColumn, resourceIDColumn, clusterIDColumn)
+// addChainColumns adds the hash chain columns to a table created before the audit log was chained.
+//
+// The events already stored stay unchained, the chain starts with the first event written afterwards.
+func addChainColumns(conn *zombiesqlite.Conn) error {
+	q, err := sqlitexx.NewQuery(conn, fmt.Sprintf("SELECT name FROM pragma_table_info('%s')", TableName))
+	if err != nil {
+		return fmt.Errorf("failed to prepare sqlite statement: %w", err)
+	}
+
+	var columns []string
+
+	for stmt, iterErr := range q.QueryIter() {
+		if iterErr != nil {
+			return fmt.Errorf("failed to read audit log table columns: %w", iterErr)
+		}
+
+		columns = append(columns, stmt.GetText("name"))
+	}
 
+	for _, column := range chainColumns {
+		if slices.Contains(columns, column) {
+			continue
+		}
+
+		if err = sqlitex.ExecScript(conn, fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s BLOB;", TableName, column)); err != nil {
+			return fmt.Errorf("failed to add the %s column to the audit log table: %w", column, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Store) Write(ctx context.Context, event auditlog.Event) error {
 	ctx, cancel := context.WithTimeout(ctx, s.timeout)
 	defer cancel()
 
@@ -194,6 +302,12 @@ func (s *Store) Write(ctx context.Context, event auditlog.Event) error {
 		clusterID = extractClusterID(event.Data)
 	}
 
+	// the event is hashed the way it is read back, so the readers can verify the hash against the returned JSON
+	payload, err := marshalRawEvent(newRawEvent(event, dataJSON))
+	if err != nil {
+		return err
+	}
+
 	conn, err := s.db.Take(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to take connection from pool: %w", err)
@@ -201,12 +315,54 @@ func (s *Store) Write(ctx context.Context, event auditlog.Event) error {
 
 	defer s.db.Put(conn)
 
+	if err = s.insert(conn, event, dataJSON, actorEmail, clusterID, payload); err != nil {
+		return err
+	}
+
+	// waking the followers before the opportunistic cleanup is safe: cleanup spares the
+	// newest event, so it can never remove the one just announced
+	s.notifySubscribers()
+
+	if s.maxSize > 0 && rand.Float64() < s.cleanupProbability {
+		if err := s.removeBySize(conn); err != nil {
+			s.logger.Warn("failed to cleanup audit logs by size", zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// insert chains the event to the last stored event and inserts it, signing a checkpoint every checkpointInterval events.
+//
+// The transaction keeps the chain linear: no other event can be inserted between reading the last hash and the insert.
+func (s *Store) insert(conn *zombiesqlite.Conn, event auditlog.Event, dataJSON []byte, actorEmail, clusterID string, payload []byte) (err error) {
+	endTx, err := sqlitex.ImmediateTransaction(conn)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer endTx(&err)
+
+	lastID, prevHash, err := readLastHash(conn)
+	if err != nil {
+		return err
+	}
+
+	id := lastID + 1
+	hash := auditchain.Hash(prevHash, id, bytes.TrimSuffix(payload, []byte("\n")))
+
+	query := fmt.Sprintf(`INSERT INTO %s (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s) VALUES
+	($id, $event_type, $resource_type, $event_ts_ms, $event_data, $actor_email, $resource_id, $cluster_id, $prev_hash, $hash)`,
+		TableName, idColumn, eventTypeColumn, resourceTypeColumn, eventTSMillisColumn, eventDataColumn,
+		actorEmailColumn, resourceIDColumn, clusterIDColumn, prevHashColumn, hashColumn)
+
 	q, err := sqlitexx.NewQuery(conn, query)
 	if err != nil {
 		return fmt.Errorf("failed to prepare sqlite statement: %w", err)
 	}
 
-	err = q.
+	q = q.
+		BindInt64("$id", id).
 		BindStringIfSet("$event_type", event.Type).
 		BindStringIfSet("$resource_type", event.ResourceType).
 		BindInt64("$event_ts_ms", event.TimeMillis).
@@ -214,24 +370,172 @@ func (s *Store) Write(ctx context.Context, event auditlog.Event) error {
 		BindStringIfSet("$actor_email", actorEmail).
 		BindStringIfSet("$resource_id", event.ResourceID).
 		BindStringIfSet("$cluster_id", clusterID).
+		BindBytes("$hash", hash)
+
+	// the first event of the chain has no previous hash, it is stored as NULL
+	if len(prevHash) > 0 {
+		q = q.BindBytes("$prev_hash", prevHash)
+	}
+
+	if err = q.Exec(); err != nil {
+		return fmt.Errorf("failed to write audit log event: %w", err)
+	}
+
+	if s.signingKey != nil && id%s.checkpointInterval == 0 {
+		return s.writeMarker(conn, auditchain.MarkerCheckpoint, id, hash)
+	}
+
+	return nil
+}
+
+// readLastHash returns the id and the hash of the last event, the hash is nil for an unchained event or an empty table.
+func readLastHash(conn *zombiesqlite.Conn) (int64, []byte, error) {
+	q, err := sqlitexx.NewQuery(conn, fmt.Sprintf("SELECT %s, %s FROM %s ORDER BY %s DESC LIMIT 1", idColumn, hashColumn, TableName, idColumn))
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to prepare sqlite statement: %w", err)
+	}
+
+	var (
+		id   int64
+		hash []byte
+	)
+
+	err = q.QueryRow(func(stmt *zombiesqlite.Stmt) error {
+		id = stmt.GetInt64(idColumn)
+		hash = readBlob(stmt, hashColumn)
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, sqlitexx.ErrNoRows) {
+		return 0, nil, fmt.Errorf("failed to read the last audit log event hash: %w", err)
+	}
+
+	return id, hash, nil
+}
+
+// writeMarker signs and stores the chain marker, replacing the marker of the same kind for the same event.
+func (s *Store) writeMarker(conn *zombiesqlite.Conn, kind auditchain.MarkerKind, id int64, hash []byte) error {
+	marker := auditchain.Marker{
+		Kind:        kind,
+		ID:          id,
+		Hash:        hash,
+		TimestampMs: time.Now().UnixMilli(),
+	}
+
+	marker.Sign(s.signingKey)
+
+	q, err := sqlitexx.NewQuery(conn, fmt.Sprintf(`INSERT OR REPLACE INTO %s (%s, %s, %s, %s, %s, %s) VALUES
+	($id, $kind, $hash, $ts_ms, $public_key, $signature)`,
+		MarkersTableName, idColumn, markerKindColumn, hashColumn, markerTSMillisColumn, markerPublicKeyColumn, markerSignatureColumn))
+	if err != nil {
+		return fmt.Errorf("failed to prepare sqlite statement: %w", err)
+	}
+
+	err = q.
+		BindInt64("$id", marker.ID).
+		BindString("$kind", string(marker.Kind)).
+		BindBytes("$hash", marker.Hash).
+		BindInt64("$ts_ms", marker.TimestampMs).
+		BindBytes("$public_key", marker.PublicKey).
+		BindBytes("$signature", marker.Signature).
 		Exec()
 	if err != nil {
-		return fmt.Errorf("failed to write audit log event: %w", err)
+		return fmt.Errorf("failed to write audit log %s marker: %w", kind, err)
 	}
 
-	// waking the followers before the opportunistic cleanup is safe: cleanup spares the
-	// newest event, so it can never remove the one just announced
-	s.notifySubscribers()
+	return nil
+}
 
-	if s.maxSize > 0 && rand.Float64() < s.cleanupProbability {
-		if err := s.removeBySize(conn); err != nil {
-			s.logger.Warn("failed to cleanup audit logs by size", zap.Error(err))
+// recordTruncations keeps the chain verifiable after events with ids in [fromID, toID] were removed: it drops the
+// markers of the removed events, and records a signed truncation marker for the first event after each removed range.
+func (s *Store) recordTruncations(conn *zombiesqlite.Conn, fromID, toID int64) error {
+	q, err := sqlitexx.NewQuery(conn, fmt.Sprintf(
+		`DELETE FROM %s WHERE %s >= $from AND %s <= $to AND %s NOT IN (SELECT %s FROM %s WHERE %s >= $from AND %s <= $to)`,
+		MarkersTableName, idColumn, idColumn, idColumn, idColumn, TableName, idColumn, idColumn,
+	))
+	if err != nil {
+		return fmt.Errorf("failed to prepare sqlite statement: %w", err)
+	}
+
+	if err = q.BindInt64("$from", fromID).BindInt64("$to", toID).Exec(); err != nil {
+		return fmt.Errorf("failed to remove audit log chain markers: %w", err)
+	}
+
+	if s.signingKey == nil {
+		return nil
+	}
+
+	// the chained events right after a removed range, the range might also start before fromID if it was removed earlier
+	q, err = sqlitexx.NewQuery(conn, fmt.Sprintf(
+		`SELECT a.%s, a.%s FROM %s a WHERE a.%s >= $from AND a.%s <= $to + 1 AND a.%s IS NOT NULL
+		AND NOT EXISTS (SELECT 1 FROM %s b WHERE b.%s = a.%s - 1)`,
+		idColumn, prevHashColumn, TableName, idColumn, idColumn, prevHashColumn,
+		TableName, idColumn, idColumn,
+	))
+	if err != nil {
+		return fmt.Errorf("failed to prepare sqlite statement: %w", err)
+	}
+
+	type head struct {
+		prevHash []byte
+		id       int64
+	}
+
+	var heads []head
+
+	for stmt, iterErr := range q.BindInt64("$from", fromID).BindInt64("$to", toID).QueryIter() {
+		if iterErr != nil {
+			return fmt.Errorf("failed to read the audit log events after the removed ones: %w", iterErr)
+		}
+
+		heads = append(heads, head{id: stmt.GetInt64(idColumn), prevHash: readBlob(stmt, prevHashColumn)})
+	}
+
+	for _, h := range heads {
+		if err = s.writeMarker(conn, auditchain.MarkerTruncation, h.id, h.prevHash); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
+// readIDRange returns the lowest and the highest id of the events in the given time range, zeroes if there are none.
+func readIDRange(conn *zombiesqlite.Conn, start, end time.Time) (int64, int64, error) {
+	q, err := sqlitexx.NewQuery(conn, fmt.Sprintf(
+		`SELECT COALESCE(MIN(%s), 0) AS min_id, COALESCE(MAX(%s), 0) AS max_id FROM %s WHERE %s >= $start AND %s <= $end`,
+		idColumn, idColumn, TableName, eventTSMillisColumn, eventTSMillisColumn,
+	))
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to prepare sqlite statement: %w", err)
+	}
+
+	var minID, maxID int64
+
+	if err = q.BindInt64("$start", start.UnixMilli()).BindInt64("$end", end.UnixMilli()).QueryRow(func(stmt *zombiesqlite.Stmt) error {
+		minID = stmt.GetInt64("min_id")
+		maxID = stmt.GetInt64("max_id")
+
+		return nil
+	}); err != nil {
+		return 0, 0, fmt.Errorf("failed to read the audit log id range: %w", err)
+	}
+
+	return minID, maxID, nil
+}
+
+// readBlob returns the blob column, nil if it is NULL.
+func readBlob(stmt *zombiesqlite.Stmt, column string) []byte {
+	if stmt.IsNull(column) {
+		return nil
+	}
+
+	data := make([]byte, stmt.GetLen(column))
+	stmt.GetBytes(column, data)
+
+	return data
+}
+
 // Remove deletes audit log events in the given time range in batches of removeBatchSize.
 // Batching keeps each autocommit DELETE small, releasing the SQLite write lock between
 // statements so other writers sharing the same database are not blocked for long.
@@ -239,6 +543,9 @@ func (s *Store) Write(ctx context.Context, event auditlog.Event) error {
 // The event with the highest id is never deleted, no matter the range: without it, SQLite
 // would reuse its id for the next event, and the ids serve as the positions of the follow
 // streams, which reused ids would silently corrupt.
+//
+// With a signing key set, each removed range is recorded by a signed truncation marker on the event after it,
+// so the remaining chain still verifies.
 func (s *Store) Remove(ctx context.Context, start, end time.Time) error {
 	// DELETE ... LIMIT is not supported (requires SQLITE_ENABLE_UPDATE_DELETE_LIMIT), so we use a subquery to select the IDs to delete.
 	query := fmt.Sprintf(
@@ -256,24 +563,19 @@ func (s *Store) Remove(ctx context.Context, start, end time.Time) error {
 
 	defer s.db.Put(conn)
 
+	fromID, toID, err := readIDRange(conn, start, end)
+	if err != nil {
+		return err
+	}
+
 	var totalDeleted int
 
 	for {
-		q, qErr := sqlitexx.NewQuery(conn, query)
-		if qErr != nil {
-			return fmt.Errorf("failed to prepare sqlite statement: %w", qErr)
-		}
-
-		qErr = q.
-			BindInt64("$start", start.UnixMilli()).
-			BindInt64("$end", end.UnixMilli()).
-			BindInt64("$limit", removeBatchSize).
-			Exec()
+		deleted, qErr := s.removeBatch(conn, query, start, end, fromID, toID)
 		if qErr != nil {
-			return fmt.Errorf("failed to remove audit log events: %w", qErr)
+			return qErr
 		}
 
-		deleted := conn.Changes()
 		totalDeleted += deleted
 
 		if deleted == 0 || ctx.Err() != nil {
@@ -288,6 +590,37 @@ func (s *Store) Remove(ctx context.Context, start, end time.Time) error {
 	return nil
 }
 
+// removeBatch deletes a single batch of events, recording the truncation markers in the same savepoint, so the
+// chain never has an unrecorded gap.
+func (s *Store) removeBatch(conn *zombiesqlite.Conn, query string, start, end time.Time, fromID, toID int64) (deleted int, err error) {
+	release := sqlitex.Save(conn)
+	defer release(&err)
+
+	q, err := sqlitexx.NewQuery(conn, query)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prepare sqlite statement: %w", err)
+	}
+
+	err = q.
+		BindInt64("$start", start.UnixMilli()).
+		BindInt64("$end", end.UnixMilli()).
+		BindInt64("$limit", removeBatchSize).
+		Exec()
+	if err != nil {
+		return 0, fmt.Errorf("failed to remove audit log events: %w", err)
+	}
+
+	deleted = conn.Changes()
+
+	if deleted > 0 {
+		if err = s.recordTruncations(conn, fromID, toID); err != nil {
+			return 0, err
+		}
+	}
+
+	return deleted, nil
+}
+
 func (s *Store) removeBySize(conn *zombiesqlite.Conn) error {
 	sizeQuery := fmt.Sprintf(`SELECT COALESCE(SUM(d.pgsize), 0) FROM dbstat d JOIN sqlite_master m ON d.name = m.name WHERE m.tbl_name = '%s'`, TableName)
 
@@ -364,22 +697,41 @@ func (s *Store) removeBySize(conn *zombiesqlite.Conn) error {
 		cutoffID = maxID - 1
 	}
 
+	deleted, err := s.removeUpTo(conn, minID, cutoffID)
+	if err != nil {
+		return err
+	}
+
+	if s.onCleanup != nil {
+		s.onCleanup(deleted)
+	}
+
+	return nil
+}
+
+// removeUpTo deletes the events with ids up to cutoffID, recording the truncation marker in the same savepoint.
+func (s *Store) removeUpTo(conn *zombiesqlite.Conn, minID, cutoffID int64) (deleted int, err error) {
+	release := sqlitex.Save(conn)
+	defer release(&err)
+
 	deleteQuery := fmt.Sprintf(`DELETE FROM %s WHERE %s <= $cutoff_id`, TableName, idColumn)
 
-	q, err = sqlitexx.NewQuery(conn, deleteQuery)
+	q, err := sqlitexx.NewQuery(conn, deleteQuery)
 	if err != nil {
-		return fmt.Errorf("failed to prepare size-based delete query: %w", err)
+		return 0, fmt.Errorf("failed to prepare size-based delete query: %w", err)
 	}
 
 	if err = q.BindInt64("$cutoff_id", cutoffID).Exec(); err != nil {
-		return fmt.Errorf("failed to delete oldest audit log events by size: %w", err)
+		return 0, fmt.Errorf("failed to delete oldest audit log events by size: %w", err)
 	}
 
-	if s.onCleanup != nil {
-		s.onCleanup(conn.Changes())
+	deleted = conn.Changes()
+
+	if err = s.recordTruncations(conn, minID, cutoffID); err != nil {
+		return 0, err
 	}
 
-	return nil
+	return deleted, nil
 }
 
 func (s *Store) Reader(ctx context.Context, filters auditlog.ReadFilters) (auditlog.Reader, error) {
@@ -644,6 +996,109 @@ func readMaxID(conn *zombiesqlite.Conn) (int64, error) {
 	return maxID, nil
 }
 
+// ProofReader reads the events in the given time range with their hash chain proofs, in id order.
+//
+// The range spans from the first event at or after start to the last event at or before end, including every
+// event in between regardless of its timestamp, so a stepped-back clock does not show up as a gap in the chain.
+func (s *Store) ProofReader(ctx context.Context, start, end time.Time) (auditlog.ProofReader, error) {
+	// we take the connection here, but it will be released in proofReader.Close()
+	conn, err := s.db.Take(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to take connection from pool: %w", err)
+	}
+
+	rdr, err := s.proofReader(conn, start, end)
+	if err != nil {
+		s.db.Put(conn)
+
+		return nil, err
+	}
+
+	return rdr, nil
+}
+
+func (s *Store) proofReader(conn *zombiesqlite.Conn, start, end time.Time) (*proofReader, error) {
+	idRangeQuery := fmt.Sprintf(
+		`SELECT
+			COALESCE((SELECT MIN(%s) FROM %s WHERE %s >= $start), 0) AS min_id,
+			COALESCE((SELECT MAX(%s) FROM %s WHERE %s <= $end), 0) AS max_id`,
+		idColumn, TableName, eventTSMillisColumn, idColumn, TableName, eventTSMillisColumn,
+	)
+
+	q, err := sqlitexx.NewQuery(conn, idRangeQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare sqlite statement: %w", err)
+	}
+
+	var minID, maxID int64
+
+	if err = q.BindInt64("$start", start.UnixMilli()).BindInt64("$end", end.UnixMilli()).QueryRow(func(stmt *zombiesqlite.Stmt) error {
+		minID = stmt.GetInt64("min_id")
+		maxID = stmt.GetInt64("max_id")
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to resolve the audit log id range: %w", err)
+	}
+
+	markers, err := readMarkers(conn, minID, maxID)
+	if err != nil {
+		return nil, err
+	}
+
+	q, err = sqlitexx.NewQuery(conn, fmt.Sprintf(
+		`SELECT %s, %s, %s, %s, %s, %s, %s, %s FROM %s WHERE %s >= $min_id AND %s <= $max_id ORDER BY %s ASC`,
+		idColumn, eventTypeColumn, resourceTypeColumn, resourceIDColumn, eventTSMillisColumn, eventDataColumn, prevHashColumn, hashColumn,
+		TableName, idColumn, idColumn, idColumn,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare sqlite statement: %w", err)
+	}
+
+	next, stop := iter.Pull2(q.BindInt64("$min_id", minID).BindInt64("$max_id", maxID).QueryIter())
+
+	return &proofReader{
+		conn:    conn,
+		db:      s.db,
+		next:    next,
+		stop:    stop,
+		markers: markers,
+	}, nil
+}
+
+// readMarkers reads the chain markers of the events with ids in [fromID, toID], grouped by the event id.
+func readMarkers(conn *zombiesqlite.Conn, fromID, toID int64) (map[int64][]auditchain.Marker, error) {
+	q, err := sqlitexx.NewQuery(conn, fmt.Sprintf(
+		`SELECT %s, %s, %s, %s, %s, %s FROM %s WHERE %s >= $from AND %s <= $to ORDER BY %s ASC, %s ASC`,
+		idColumn, markerKindColumn, hashColumn, markerTSMillisColumn, markerPublicKeyColumn, markerSignatureColumn,
+		MarkersTableName, idColumn, idColumn, idColumn, markerKindColumn,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare sqlite statement: %w", err)
+	}
+
+	markers := map[int64][]auditchain.Marker{}
+
+	for stmt, iterErr := range q.BindInt64("$from", fromID).BindInt64("$to", toID).QueryIter() {
+		if iterErr != nil {
+			return nil, fmt.Errorf("failed to read audit log chain marker: %w", iterErr)
+		}
+
+		marker := auditchain.Marker{
+			Kind:        auditchain.MarkerKind(stmt.GetText(markerKindColumn)),
+			ID:          stmt.GetInt64(idColumn),
+			Hash:        readBlob(stmt, hashColumn),
+			TimestampMs: stmt.GetInt64(markerTSMillisColumn),
+			PublicKey:   readBlob(stmt, markerPublicKeyColumn),
+			Signature:   readBlob(stmt, markerSignatureColumn),
+		}
+
+		markers[marker.ID] = append(markers[marker.ID], marker)
+	}
+
+	return markers, nil
+}
+
 func (s *Store) HasData(ctx context.Context) (bool, error) {
 	query := fmt.Sprintf("SELECT 1 FROM %s LIMIT 1", TableName)
 
@@ -689,6 +1144,48 @@ func (l *logReader) Close() error {
 	return nil
 }
 
+type proofReader struct {
+	conn    *zombiesqlite.Conn
+	db      *sqlitexx.Pool
+	next    func() (*zombiesqlite.Stmt, error, bool)
+	stop    func()
+	markers map[int64][]auditchain.Marker
+}
+
+func (r *proofReader) Close() error {
+	r.stop()
+
+	r.db.Put(r.conn)
+
+	return nil
+}
+
+func (r *proofReader) Read() (auditchain.Event, error) {
+	result, err, ok := r.next()
+	if err != nil {
+		return auditchain.Event{}, fmt.Errorf("failed to read audit log event: %w", err)
+	}
+
+	if !ok {
+		return auditchain.Event{}, io.EOF
+	}
+
+	payload, err := marshalEventRow(result)
+	if err != nil {
+		return auditchain.Event{}, err
+	}
+
+	id := result.GetInt64(idColumn)
+
+	return auditchain.Event{
+		ID:       id,
+		Payload:  payload,
+		PrevHash: readBlob(result, prevHashColumn),
+		Hash:     readBlob(result, hashColumn),
+		Markers:  r.markers[id],
+	}, nil
+}
+
 // rawEvent is like auditlog.Event but with Data as json.RawMessage for efficiency, to avoid unnecessary unmarshal/marshal.
 type rawEvent struct {
 	Type         *string         `json:"event_type,omitempty"`
@@ -739,6 +1236,33 @@ func marshalEventRow(result *zombiesqlite.Stmt) ([]byte, error) {
 
 	event.TimeMillis = result.GetInt64(eventTSMillisColumn)
 
+	return marshalRawEvent(event)
+}
+
+// newRawEvent builds the event the way it is read back from its stored columns.
+func newRawEvent(event auditlog.Event, dataJSON []byte) rawEvent {
+	raw := rawEvent{
+		Data:       dataJSON,
+		TimeMillis: event.TimeMillis,
+	}
+
+	if event.Type != "" {
+		raw.Type = &event.Type
+	}
+
+	if event.ResourceType != "" {
+		raw.ResourceType = &event.ResourceType
+	}
+
+	if event.ResourceID != "" {
+		raw.ResourceID = &event.ResourceID
+	}
+
+	return raw
+}
+
+// marshalRawEvent marshals the event into its newline-terminated JSON payload.
+func marshalRawEvent(event rawEvent) ([]byte, error) {
 	marshaled, err := json.Marshal(event)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal audit log event: %w", err)
@@ -794,6 +1318,8 @@ func extractClusterID(d *auditlog.Data) string {
 		return d.ConfigPatch.ClusterID
 	case d.MachineConfigDiff != nil:
 		return d.MachineConfigDiff.ClusterID
+	case d.EtcdBackupPrune != nil:
+		return d.EtcdBackupPrune.ClusterID
 	default:
 		return ""
 	}
@@ -7,6 +7,7 @@ package auditlogsqlite_test
 
 import (
 	"context"
+	"crypto/ed25519"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -25,6 +26,7 @@ import (
 	zombiesqlite "zombiezen.com/go/sqlite"
 	"zombiezen.com/go/sqlite/sqlitex"
 
+	"github.com/siderolabs/omni/client/pkg/auditchain"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/audit/auditlog"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/audit/auditlog/auditlogsqlite"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/sqlite"
@@ -813,6 +815,24 @@ func TestReaderFilters(t *testing.T) {
 	}
 }
 
+func TestLoadOrCreateSigningKey(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 15*time.Second)
+	t.Cleanup(cancel)
+
+	_, db := setupStore(ctx, t, zaptest.NewLogger(t))
+
+	key, err := auditlogsqlite.LoadOrCreateSigningKey(ctx, db, 0)
+	require.NoError(t, err)
+	require.Len(t, key, ed25519.PrivateKeySize)
+
+	// the key is generated once, the later starts sign with the stored one
+	storedKey, err := auditlogsqlite.LoadOrCreateSigningKey(ctx, db, 0)
+	require.NoError(t, err)
+	assert.True(t, key.Equal(storedKey))
+}
+
 func setupStore(ctx context.Context, t *testing.T, logger *zap.Logger) (*auditlogsqlite.Store, *sqlitexx.Pool) {
 	return setupStoreWithOpts(ctx, t, logger, 0, 0)
 }
@@ -1215,3 +1235,77 @@ func writeEventAt(ctx context.Context, t *testing.T, store *auditlogsqlite.Store
 
 	require.NoError(t, store.Write(ctx, event))
 }
+
+func TestHashChain(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 15*time.Second)
+	t.Cleanup(cancel)
+
+	_, key, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	store, db := setupStoreWithOpts(ctx, t, zaptest.NewLogger(t), 0, 0,
+		auditlogsqlite.WithSigningKey(key),
+		auditlogsqlite.WithCheckpointInterval(2),
+	)
+
+	for ts := int64(1000); ts <= 6000; ts += 1000 {
+		writeEventAt(ctx, t, store, ts)
+	}
+
+	summary, issues := verifyChain(ctx, t, store, key)
+	assert.Empty(t, issues)
+	assert.Equal(t, 6, summary.Events)
+	assert.Equal(t, 3, summary.Checkpoints)
+	assert.Equal(t, 0, summary.Unchained)
+
+	// the removed events are recorded by a truncation marker, so the rest of the chain is still anchored
+	require.NoError(t, store.Remove(ctx, time.UnixMilli(0), time.UnixMilli(2000)))
+
+	summary, issues = verifyChain(ctx, t, store, key)
+	assert.Empty(t, issues)
+	assert.Equal(t, int64(3), summary.FirstID)
+	assert.Equal(t, 1, summary.Truncations)
+
+	// modifying an event directly in the database breaks the chain
+	conn, err := db.Take(ctx)
+	require.NoError(t, err)
+
+	err = sqlitex.ExecuteTransient(conn, "UPDATE "+auditlogsqlite.TableName+" SET event_data = CAST('{}' AS BLOB) WHERE id = 4", nil)
+
+	db.Put(conn)
+
+	require.NoError(t, err)
+
+	_, issues = verifyChain(ctx, t, store, key)
+	require.Len(t, issues, 1)
+	assert.Equal(t, auditchain.IssueModified, issues[0].Kind)
+	assert.Equal(t, int64(4), issues[0].ID)
+}
+
+func verifyChain(ctx context.Context, t *testing.T, store *auditlogsqlite.Store, key ed25519.PrivateKey) (auditchain.Summary, []auditchain.Issue) {
+	t.Helper()
+
+	rdr, err := store.ProofReader(ctx, time.UnixMilli(1), time.Now())
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, rdr.Close())
+	}()
+
+	verifier := &auditchain.Verifier{PublicKey: key.Public().(ed25519.PublicKey), RequireAnchor: true} //nolint:forcetypeassert,errcheck
+
+	var issues []auditchain.Issue
+
+	for {
+		event, readErr := rdr.Read()
+		if errors.Is(readErr, io.EOF) {
+			return verifier.Summary(), issues
+		}
+
+		require.NoError(t, readErr)
+
+		issues = append(issues, verifier.Add(event)...)
+	}
+}
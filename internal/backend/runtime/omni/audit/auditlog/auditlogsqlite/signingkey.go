@@ -0,0 +1,89 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package auditlogsqlite
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/state-sqlite/pkg/sqlitexx"
+	zombiesqlite "zombiezen.com/go/sqlite"
+	"zombiezen.com/go/sqlite/sqlitex"
+)
+
+const (
+	// SigningKeyTableName is the SQLite table name the generated audit log signing key is stored in.
+	SigningKeyTableName  = "audit_log_signing_key"
+	signingKeySeedColumn = "seed"
+
+	// signingKeyID is the id of the single row of the signing key table.
+	signingKeyID = 1
+)
+
+// LoadOrCreateSigningKey returns the key the audit log is signed with when no signing key is configured.
+//
+// The key is generated on the first call and stored in the database, so the markers stay signed with the same key across restarts.
+func LoadOrCreateSigningKey(ctx context.Context, db *sqlitexx.Pool, timeout time.Duration) (ed25519.PrivateKey, error) {
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	conn, err := db.Take(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to take connection from pool: %w", err)
+	}
+
+	defer db.Put(conn)
+
+	if err = sqlitex.ExecScript(conn, fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+      %s INTEGER PRIMARY KEY CHECK (%s = %d),
+      %s BLOB NOT NULL
+    ) STRICT;`, SigningKeyTableName, idColumn, idColumn, signingKeyID, signingKeySeedColumn)); err != nil {
+		return nil, fmt.Errorf("failed to create the audit log signing key table: %w", err)
+	}
+
+	seed := make([]byte, ed25519.SeedSize)
+
+	if _, err = rand.Read(seed); err != nil {
+		return nil, fmt.Errorf("failed to generate the audit log signing key: %w", err)
+	}
+
+	// the key stored by an earlier start wins, the generated one is only used the first time
+	q, err := sqlitexx.NewQuery(conn, fmt.Sprintf(`INSERT INTO %s (%s, %s) VALUES ($id, $seed) ON CONFLICT DO NOTHING`,
+		SigningKeyTableName, idColumn, signingKeySeedColumn))
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare sqlite statement: %w", err)
+	}
+
+	if err = q.BindInt64("$id", signingKeyID).BindBytes("$seed", seed).Exec(); err != nil {
+		return nil, fmt.Errorf("failed to store the audit log signing key: %w", err)
+	}
+
+	q, err = sqlitexx.NewQuery(conn, fmt.Sprintf(`SELECT %s FROM %s WHERE %s = $id`, signingKeySeedColumn, SigningKeyTableName, idColumn))
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare sqlite statement: %w", err)
+	}
+
+	if err = q.BindInt64("$id", signingKeyID).QueryRow(func(stmt *zombiesqlite.Stmt) error {
+		seed = readBlob(stmt, signingKeySeedColumn)
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to read the audit log signing key: %w", err)
+	}
+
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("the stored audit log signing key has an invalid size %d", len(seed))
+	}
+
+	return ed25519.NewKeyFromSeed(seed), nil
+}
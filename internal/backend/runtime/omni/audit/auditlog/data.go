@@ -33,6 +33,10 @@ type Data struct {
 	TalosAccess       *TalosAccess       `json:"talos_access,omitempty"`
 	K8SAccess         *K8SAccess         `json:"k8s_access,omitempty"`
 	AuditLogAccess    *AuditLogAccess    `json:"audit_log_access,omitempty"`
+	WorkloadProxy     *WorkloadProxy     `json:"workload_proxy_access,omitempty"`
+	RateLimit         *RateLimit         `json:"rate_limit,omitempty"`
+	EtcdBackupPrune   *EtcdBackupPrune   `json:"etcd_backup_prune,omitempty"`
+	Elevation         *Elevation         `json:"elevation,omitempty"`
 	MigrationError    *MigrationError    `json:"migration_error,omitempty"`
 	Session           Session            `json:"session"`
 }
@@ -85,6 +89,20 @@ type AccessPolicy struct {
 	Tests         []*specs.AccessPolicyTest                  `json:"tests,omitempty"`
 }
 
+// Elevation contains information about the privilege elevation, it is used both for the elevation requests and grants.
+type Elevation struct {
+	ID         resource.ID `json:"id,omitempty"`
+	Identity   string      `json:"identity,omitempty"`
+	Role       string      `json:"role,omitempty"`
+	Reason     string      `json:"reason,omitempty"`
+	Duration   string      `json:"duration,omitempty"`
+	ApprovedBy string      `json:"approved_by,omitempty"`
+	RevokedBy  string      `json:"revoked_by,omitempty"`
+	Clusters   []string    `json:"clusters,omitempty"`
+	Expiration int64       `json:"expiration,omitempty"`
+	Revoked    bool        `json:"revoked,omitempty"`
+}
+
 // Cluster struct contains information about the cluster.
 type Cluster struct {
 	ID                  string                      `json:"id,omitempty"`
@@ -135,6 +153,7 @@ type TalosAccess struct {
 	FullMethodName string `json:"full_method_name,omitempty"`
 	ClusterName    string `json:"cluster_name,omitempty"`
 	MachineIP      string `json:"machine_ip,omitempty"`
+	Denied         bool   `json:"denied,omitempty"`
 }
 
 // AuditLogAccess struct contains information about the access to the audit log itself.
@@ -151,6 +170,33 @@ type AuditLogAccess struct {
 	Follow       bool   `json:"follow,omitempty"`
 }
 
+// WorkloadProxy struct contains information about the access to an exposed service through the workload proxy.
+type WorkloadProxy struct {
+	ClusterName string `json:"cluster_name,omitempty"`
+	Alias       string `json:"alias,omitempty"`
+	Protocol    string `json:"protocol,omitempty"`
+	Method      string `json:"method,omitempty"`
+	Path        string `json:"path,omitempty"`
+	RemoteAddr  string `json:"remote_addr,omitempty"`
+	Error       string `json:"error,omitempty"`
+	Allowed     bool   `json:"allowed"`
+}
+
+// RateLimit struct contains information about the API requests rejected by the rate limits.
+type RateLimit struct {
+	FullMethodName string `json:"full_method_name,omitempty"`
+	Limit          string `json:"limit,omitempty"`
+	Reason         string `json:"reason,omitempty"`
+	RetryAfter     string `json:"retry_after,omitempty"`
+	Rejected       int    `json:"rejected,omitempty"`
+}
+
+// EtcdBackupPrune struct contains information about the etcd backup deleted by the retention policy.
+type EtcdBackupPrune struct {
+	ClusterID string `json:"cluster_id,omitempty"`
+	Snapshot  string `json:"snapshot,omitempty"`
+}
+
 // K8SAccess struct contains information about the access to the Kubernetes cluster.
 type K8SAccess struct {
 	FullMethodName string `json:"full_method_name,omitempty"`
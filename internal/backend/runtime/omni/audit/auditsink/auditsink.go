@@ -0,0 +1,347 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package auditsink exports the audit log events to external sinks.
+//
+// The events are read from the audit log store by their ids, the same way the audit log follow streams read them.
+// The position of each sink is persisted in the database and only advanced once the sink accepted the events, so
+// every event is delivered at least once, even if the sink or Omni itself is down for a while.
+package auditsink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/cosi-project/state-sqlite/pkg/sqlitexx"
+	"go.uber.org/zap"
+	zombiesqlite "zombiezen.com/go/sqlite"
+	"zombiezen.com/go/sqlite/sqlitex"
+
+	"github.com/siderolabs/omni/client/pkg/panichandler"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/audit/auditlog"
+)
+
+const (
+	// PositionsTableName is the SQLite table name the delivery positions of the sinks are stored in.
+	PositionsTableName = "audit_log_sink_positions"
+	sinkColumn         = "sink"
+	positionColumn     = "position"
+
+	defaultBatchSize        = 100
+	defaultMaxRetryInterval = time.Minute
+)
+
+// Sink delivers the audit log events to an external system.
+type Sink interface {
+	// Name is the unique name of the sink, its delivery position is persisted under this name.
+	Name() string
+
+	// Send delivers the events, oldest first. The events are considered delivered only when Send returns nil,
+	// otherwise the same events are sent again, so the sink might receive an event more than once.
+	Send(ctx context.Context, entries []auditlog.Entry) error
+}
+
+// Source is the audit log store the events are read from.
+type Source interface {
+	FollowStart(ctx context.Context, startTsMs int64) (int64, error)
+	FollowBatch(ctx context.Context, afterID int64, limit int64) ([]auditlog.Entry, error)
+	FollowSubscribe() (<-chan struct{}, func())
+}
+
+// Option configures optional Forwarder behavior.
+type Option func(*Forwarder)
+
+// WithBatchSize sets the maximum number of events sent to a sink at once.
+func WithBatchSize(size int64) Option {
+	return func(f *Forwarder) {
+		f.batchSize = size
+	}
+}
+
+// WithMaxRetryInterval sets the maximum interval between the retries of a failed delivery.
+func WithMaxRetryInterval(interval time.Duration) Option {
+	return func(f *Forwarder) {
+		f.maxRetryInterval = interval
+	}
+}
+
+// Forwarder delivers the audit log events to the sinks.
+type Forwarder struct {
+	source           Source
+	db               *sqlitexx.Pool
+	logger           *zap.Logger
+	sinks            []Sink
+	batchSize        int64
+	maxRetryInterval time.Duration
+}
+
+// NewForwarder creates a new Forwarder, creating the table the delivery positions are stored in if needed.
+func NewForwarder(ctx context.Context, source Source, db *sqlitexx.Pool, sinks []Sink, logger *zap.Logger, opts ...Option) (*Forwarder, error) {
+	conn, err := db.Take(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to take connection from pool: %w", err)
+	}
+
+	defer db.Put(conn)
+
+	schemaSQL := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+		%s TEXT PRIMARY KEY,
+		%s INTEGER NOT NULL
+	) STRICT;`, PositionsTableName, sinkColumn, positionColumn)
+
+	if err = sqlitex.ExecScript(conn, schemaSQL); err != nil {
+		return nil, fmt.Errorf("failed to create audit log sink positions table: %w", err)
+	}
+
+	forwarder := &Forwarder{
+		source:           source,
+		db:               db,
+		logger:           logger,
+		sinks:            sinks,
+		batchSize:        defaultBatchSize,
+		maxRetryInterval: defaultMaxRetryInterval,
+	}
+
+	for _, opt := range opts {
+		opt(forwarder)
+	}
+
+	return forwarder, nil
+}
+
+// Run delivers the events to every sink until the context is canceled.
+func (f *Forwarder) Run(ctx context.Context) error {
+	eg, ctx := panichandler.ErrGroupWithContext(ctx)
+
+	for _, sink := range f.sinks {
+		eg.Go(func() error {
+			return f.runSink(ctx, sink)
+		})
+	}
+
+	return eg.Wait()
+}
+
+//nolint:gocognit
+func (f *Forwarder) runSink(ctx context.Context, sink Sink) error {
+	logger := f.logger.With(zap.String("sink", sink.Name()))
+
+	if closer, ok := sink.(io.Closer); ok {
+		defer closer.Close() //nolint:errcheck
+	}
+
+	// subscribe before the first read, so that no event written after it goes unnoticed
+	wakeup, unsubscribe := f.source.FollowSubscribe()
+	defer unsubscribe()
+
+	retryBackoff := backoff.NewExponentialBackOff()
+	retryBackoff.MaxInterval = f.maxRetryInterval
+
+	retry := func() bool {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(retryBackoff.NextBackOff()):
+			return true
+		}
+	}
+
+	var (
+		position int64
+		err      error
+	)
+
+	for {
+		if position, err = f.initPosition(ctx, sink.Name(), logger); err == nil {
+			break
+		}
+
+		logger.Warn("failed to initialize the audit log sink position", zap.Error(err))
+
+		if !retry() {
+			return nil
+		}
+	}
+
+	for {
+		var entries []auditlog.Entry
+
+		entries, err = f.source.FollowBatch(ctx, position, f.batchSize)
+
+		switch {
+		case ctx.Err() != nil:
+			return nil
+		case errors.Is(err, auditlog.ErrFollowPositionLost):
+			logger.Warn("audit log sink position no longer exists, skipping to the latest event", zap.Int64("position", position))
+
+			latest, resetErr := f.resetPosition(ctx, sink.Name())
+			if resetErr != nil {
+				logger.Warn("failed to reset the audit log sink position", zap.Error(resetErr))
+
+				if !retry() {
+					return nil
+				}
+
+				continue
+			}
+
+			position = latest
+
+			continue
+		case err != nil:
+			logger.Warn("failed to read audit log events", zap.Error(err))
+
+			if !retry() {
+				return nil
+			}
+
+			continue
+		}
+
+		if len(entries) > 0 {
+			if err = sink.Send(ctx, entries); err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+
+				logger.Warn("failed to deliver audit log events", zap.Int64("position", position), zap.Int("events", len(entries)), zap.Error(err))
+
+				if !retry() {
+					return nil
+				}
+
+				continue
+			}
+
+			retryBackoff.Reset()
+
+			position = entries[len(entries)-1].ID
+
+			// if the position is not saved, the events are sent again after a restart, which is still at least once
+			if err = f.setPosition(ctx, sink.Name(), position); err != nil && ctx.Err() == nil {
+				logger.Warn("failed to save the audit log sink position", zap.Int64("position", position), zap.Error(err))
+			}
+		}
+
+		if int64(len(entries)) == f.batchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-wakeup:
+		}
+	}
+}
+
+// initPosition returns the persisted position of the sink. A new sink starts with the events written after it was added.
+func (f *Forwarder) initPosition(ctx context.Context, sink string, logger *zap.Logger) (int64, error) {
+	position, found, err := f.position(ctx, sink)
+	if err != nil || found {
+		return position, err
+	}
+
+	if position, err = f.resetPosition(ctx, sink); err != nil {
+		return 0, err
+	}
+
+	logger.Info("audit log sink added", zap.Int64("position", position))
+
+	return position, nil
+}
+
+// resetPosition moves the position of the sink to the latest event.
+func (f *Forwarder) resetPosition(ctx context.Context, sink string) (int64, error) {
+	position, err := f.source.FollowStart(ctx, 0)
+	if err != nil {
+		return 0, fmt.Errorf("failed to resolve the audit log sink position: %w", err)
+	}
+
+	if err = f.setPosition(ctx, sink, position); err != nil {
+		return 0, err
+	}
+
+	return position, nil
+}
+
+func (f *Forwarder) position(ctx context.Context, sink string) (position int64, found bool, err error) {
+	conn, err := f.db.Take(ctx)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to take connection from pool: %w", err)
+	}
+
+	defer f.db.Put(conn)
+
+	q, err := sqlitexx.NewQuery(conn, fmt.Sprintf("SELECT %s FROM %s WHERE %s = $sink", positionColumn, PositionsTableName, sinkColumn))
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to prepare sqlite statement: %w", err)
+	}
+
+	err = q.BindString("$sink", sink).QueryRow(func(stmt *zombiesqlite.Stmt) error {
+		position = stmt.GetInt64(positionColumn)
+
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, sqlitexx.ErrNoRows) {
+			return 0, false, nil
+		}
+
+		return 0, false, fmt.Errorf("failed to read audit log sink position: %w", err)
+	}
+
+	return position, true, nil
+}
+
+func (f *Forwarder) setPosition(ctx context.Context, sink string, position int64) error {
+	conn, err := f.db.Take(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to take connection from pool: %w", err)
+	}
+
+	defer f.db.Put(conn)
+
+	q, err := sqlitexx.NewQuery(conn, fmt.Sprintf("INSERT INTO %s (%s, %s) VALUES ($sink, $position) ON CONFLICT(%s) DO UPDATE SET %s=excluded.%s",
+		PositionsTableName, sinkColumn, positionColumn, sinkColumn, positionColumn, positionColumn))
+	if err != nil {
+		return fmt.Errorf("failed to prepare sqlite statement: %w", err)
+	}
+
+	if err = q.BindString("$sink", sink).BindInt64("$position", position).Exec(); err != nil {
+		return fmt.Errorf("failed to write audit log sink position: %w", err)
+	}
+
+	return nil
+}
+
+// header is the part of the audit log event the sinks describe the event with.
+type header struct {
+	Type         string `json:"event_type,omitempty"`
+	ResourceType string `json:"resource_type,omitempty"`
+	ResourceID   string `json:"resource_id,omitempty"`
+	TimeMillis   int64  `json:"event_ts,omitempty"`
+}
+
+// parseHeader extracts the header of the event. A malformed event is still delivered, with an empty header, so it never
+// blocks the delivery of the events after it.
+func parseHeader(entry auditlog.Entry) header {
+	var h header
+
+	json.Unmarshal(entry.Payload, &h) //nolint:errcheck
+
+	return h
+}
+
+// payload returns the JSON of the event without the trailing newline.
+func payload(entry auditlog.Entry) []byte {
+	return bytes.TrimSuffix(entry.Payload, []byte("\n"))
+}
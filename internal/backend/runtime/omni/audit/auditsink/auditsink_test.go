@@ -0,0 +1,377 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package auditsink_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/state-sqlite/pkg/sqlitexx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/protobuf/proto"
+	zombiesqlite "zombiezen.com/go/sqlite"
+
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/audit/auditlog"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/audit/auditlog/auditlogsqlite"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/audit/auditsink"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/sqlite"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+type fakeSink struct {
+	ids      []int64
+	failures int
+	mu       sync.Mutex
+}
+
+func (s *fakeSink) Name() string { return "fake" }
+
+func (s *fakeSink) Send(_ context.Context, entries []auditlog.Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.failures > 0 {
+		s.failures--
+
+		return errors.New("sink is down")
+	}
+
+	for _, entry := range entries {
+		s.ids = append(s.ids, entry.ID)
+	}
+
+	return nil
+}
+
+func (s *fakeSink) delivered() []int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]int64(nil), s.ids...)
+}
+
+func TestForwarder(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 30*time.Second)
+	t.Cleanup(cancel)
+
+	store, db := setupStore(ctx, t)
+
+	sink := &fakeSink{failures: 2}
+
+	stop := runForwarder(ctx, t, store, db, sink)
+
+	// the sink starts at the tail, wait for it to be added before writing
+	require.Eventually(t, func() bool {
+		_, found := sinkPosition(ctx, t, db, sink.Name())
+
+		return found
+	}, 10*time.Second, 10*time.Millisecond)
+
+	for range 3 {
+		writeEvent(ctx, t, store)
+	}
+
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		assert.Equal(collect, []int64{1, 2, 3}, sink.delivered())
+	}, 10*time.Second, 10*time.Millisecond)
+
+	stop()
+
+	position, _ := sinkPosition(ctx, t, db, sink.Name())
+	assert.Equal(t, int64(3), position)
+
+	// the events written while the forwarder is down are delivered after a restart, without the delivered ones
+	for range 2 {
+		writeEvent(ctx, t, store)
+	}
+
+	sink = &fakeSink{}
+
+	stop = runForwarder(ctx, t, store, db, sink)
+	defer stop()
+
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		assert.Equal(collect, []int64{4, 5}, sink.delivered())
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+func TestWebhook(t *testing.T) {
+	t.Parallel()
+
+	type request struct {
+		header http.Header
+		body   []byte
+	}
+
+	requests := make(chan request, 1)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		requests <- request{header: r.Header, body: body}
+	}))
+	t.Cleanup(srv.Close)
+
+	sink := auditsink.NewWebhook(auditsink.WebhookOptions{URL: srv.URL, Secret: "secret"})
+
+	require.NoError(t, sink.Send(t.Context(), testEntries()))
+
+	req := <-requests
+
+	timestamp := req.header.Get(auditsink.WebhookTimestampHeader)
+	require.NotEmpty(t, timestamp)
+	assert.Equal(t, "sha256="+auditsink.SignWebhook("secret", timestamp, req.body), req.header.Get(auditsink.WebhookSignatureHeader))
+
+	lines := strings.Split(strings.TrimSuffix(string(req.body), "\n"), "\n")
+	require.Len(t, lines, 2)
+
+	for i, line := range lines {
+		var event struct {
+			Event auditlog.Event `json:"event"`
+			ID    int64          `json:"id"`
+		}
+
+		require.NoError(t, json.Unmarshal([]byte(line), &event))
+
+		assert.Equal(t, int64(i+1), event.ID)
+		assert.Equal(t, "create", event.Event.Type)
+		assert.Equal(t, "res-"+strconv.Itoa(i+1), event.Event.ResourceID)
+	}
+}
+
+func TestWebhookErrorStatus(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(srv.Close)
+
+	sink := auditsink.NewWebhook(auditsink.WebhookOptions{URL: srv.URL})
+
+	require.Error(t, sink.Send(t.Context(), testEntries()))
+}
+
+func TestSyslog(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { listener.Close() }) //nolint:errcheck
+
+	messages := make(chan string, 2)
+
+	go func() {
+		conn, acceptErr := listener.Accept()
+		if acceptErr != nil {
+			return
+		}
+
+		defer conn.Close() //nolint:errcheck
+
+		reader := bufio.NewReader(conn)
+
+		for {
+			length, readErr := reader.ReadString(' ')
+			if readErr != nil {
+				return
+			}
+
+			n, convErr := strconv.Atoi(strings.TrimSuffix(length, " "))
+			if convErr != nil {
+				return
+			}
+
+			message := make([]byte, n)
+
+			if _, readErr = io.ReadFull(reader, message); readErr != nil {
+				return
+			}
+
+			messages <- string(message)
+		}
+	}()
+
+	sink := auditsink.NewSyslog(auditsink.SyslogOptions{Endpoint: listener.Addr().String(), Hostname: "omni-test"})
+	t.Cleanup(func() { sink.Close() }) //nolint:errcheck
+
+	require.NoError(t, sink.Send(t.Context(), testEntries()))
+
+	for i := range 2 {
+		message := <-messages
+
+		assert.True(t, strings.HasPrefix(message, "<110>1 "), message)
+		assert.Contains(t, message, " omni-test omni - audit ")
+		assert.Contains(t, message, `[audit@32473 id="`+strconv.Itoa(i+1)+`" type="create" resourceType="Clusters.omni.sidero.dev" resourceID="res-`+strconv.Itoa(i+1)+`"]`)
+		assert.True(t, strings.HasSuffix(message, "}"), message)
+	}
+}
+
+func TestOTLP(t *testing.T) {
+	t.Parallel()
+
+	requests := make(chan *collogspb.ExportLogsServiceRequest, 1)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer token" || r.Header.Get("Content-Type") != "application/x-protobuf" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		var req collogspb.ExportLogsServiceRequest
+
+		if err = proto.Unmarshal(body, &req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		requests <- &req
+	}))
+	t.Cleanup(srv.Close)
+
+	sink := auditsink.NewOTLP(auditsink.OTLPOptions{Endpoint: srv.URL, Headers: map[string]string{"Authorization": "Bearer token"}})
+
+	require.NoError(t, sink.Send(t.Context(), testEntries()))
+
+	req := <-requests
+
+	require.Len(t, req.ResourceLogs, 1)
+	require.Len(t, req.ResourceLogs[0].ScopeLogs, 1)
+
+	records := req.ResourceLogs[0].ScopeLogs[0].LogRecords
+	require.Len(t, records, 2)
+
+	for i, record := range records {
+		var event auditlog.Event
+
+		require.NoError(t, json.Unmarshal([]byte(record.Body.GetStringValue()), &event))
+		assert.Equal(t, "res-"+strconv.Itoa(i+1), event.ResourceID)
+		assert.Equal(t, uint64(time.UnixMilli(event.TimeMillis).UnixNano()), record.TimeUnixNano)
+
+		require.NotEmpty(t, record.Attributes)
+		assert.Equal(t, auditsink.OTLPEventIDAttribute, record.Attributes[0].Key)
+		assert.Equal(t, int64(i+1), record.Attributes[0].Value.GetIntValue())
+	}
+}
+
+func testEntries() []auditlog.Entry {
+	entries := make([]auditlog.Entry, 0, 2)
+
+	for i := range 2 {
+		event := auditlog.MakeEvent("create", "Clusters.omni.sidero.dev", "res-"+strconv.Itoa(i+1), &auditlog.Data{})
+
+		payload, err := json.Marshal(event)
+		if err != nil {
+			panic(err)
+		}
+
+		entries = append(entries, auditlog.Entry{ID: int64(i + 1), Payload: append(payload, '\n')})
+	}
+
+	return entries
+}
+
+func runForwarder(ctx context.Context, t *testing.T, store *auditlogsqlite.Store, db *sqlitexx.Pool, sink auditsink.Sink) func() {
+	t.Helper()
+
+	forwarder, err := auditsink.NewForwarder(ctx, store, db, []auditsink.Sink{sink}, zaptest.NewLogger(t), auditsink.WithMaxRetryInterval(50*time.Millisecond))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(ctx)
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- forwarder.Run(ctx)
+	}()
+
+	return func() {
+		cancel()
+
+		require.NoError(t, <-errCh)
+	}
+}
+
+func setupStore(ctx context.Context, t *testing.T) (*auditlogsqlite.Store, *sqlitexx.Pool) {
+	t.Helper()
+
+	conf := config.Default().Storage.Sqlite
+	conf.SetPath(filepath.Join(t.TempDir(), "test.db"))
+
+	db, err := sqlite.OpenDB(conf)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	store, err := auditlogsqlite.NewStore(ctx, db, 30*time.Second, 0, 0, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	return store, db
+}
+
+func writeEvent(ctx context.Context, t *testing.T, store *auditlogsqlite.Store) {
+	t.Helper()
+
+	require.NoError(t, store.Write(ctx, auditlog.MakeEvent("create", "test.resource", "test-id", &auditlog.Data{})))
+}
+
+func sinkPosition(ctx context.Context, t *testing.T, db *sqlitexx.Pool, sink string) (position int64, found bool) {
+	t.Helper()
+
+	conn, err := db.Take(ctx)
+	require.NoError(t, err)
+
+	defer db.Put(conn)
+
+	q, err := sqlitexx.NewQuery(conn, "SELECT position FROM "+auditsink.PositionsTableName+" WHERE sink = $sink")
+	require.NoError(t, err)
+
+	err = q.BindString("$sink", sink).QueryRow(func(stmt *zombiesqlite.Stmt) error {
+		position = stmt.GetInt64("position")
+
+		return nil
+	})
+	if errors.Is(err, sqlitexx.ErrNoRows) {
+		return 0, false
+	}
+
+	require.NoError(t, err)
+
+	return position, true
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package auditsink
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/audit/auditlog"
+	"github.com/siderolabs/omni/internal/pkg/logsink"
+	"github.com/siderolabs/omni/internal/version"
+)
+
+const (
+	otlpScopeName = "omni.audit"
+	otlpTimeout   = 30 * time.Second
+
+	// OTLPEventIDAttribute is the log record attribute with the id of the event.
+	OTLPEventIDAttribute = "omni.audit.event_id"
+)
+
+// OTLPOptions configures the OTLP sink.
+type OTLPOptions struct {
+	// Client is the HTTP client the requests are sent with. Defaults to a client with a 30 seconds timeout.
+	Client *http.Client
+
+	// Headers are sent with each export request.
+	Headers map[string]string
+
+	// Endpoint is the URL of the OTLP/HTTP logs endpoint.
+	Endpoint string
+}
+
+// OTLP exports the events as OpenTelemetry log records over OTLP/HTTP, using the binary protobuf encoding.
+//
+// The body of each record is the JSON of the event.
+type OTLP struct {
+	opts OTLPOptions
+}
+
+// NewOTLP creates a new OTLP sink.
+func NewOTLP(opts OTLPOptions) *OTLP {
+	if opts.Client == nil {
+		opts.Client = &http.Client{Timeout: otlpTimeout}
+	}
+
+	return &OTLP{opts: opts}
+}
+
+// Name implements [Sink].
+func (o *OTLP) Name() string {
+	return "otlp"
+}
+
+// Send implements [Sink].
+func (o *OTLP) Send(ctx context.Context, entries []auditlog.Entry) error {
+	body, err := proto.Marshal(otlpRequest(entries, time.Now()))
+	if err != nil {
+		return fmt.Errorf("failed to marshal OTLP logs request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, o.opts.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create OTLP request: %w", err)
+	}
+
+	for name, value := range o.opts.Headers {
+		req.Header.Set(name, value)
+	}
+
+	req.Header.Set("Content-Type", "application/x-protobuf")
+
+	resp, err := o.opts.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send OTLP request: %w", err)
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	// drain the body to reuse the connection
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024)) //nolint:errcheck
+
+	// the records rejected in a partially successful response must not be retried, so any 2xx is a success
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("OTLP endpoint responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func otlpRequest(entries []auditlog.Entry, observed time.Time) *collogspb.ExportLogsServiceRequest {
+	records := make([]*logspb.LogRecord, 0, len(entries))
+
+	for _, entry := range entries {
+		h := parseHeader(entry)
+
+		attributes := []*commonpb.KeyValue{
+			{Key: OTLPEventIDAttribute, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: entry.ID}}},
+		}
+
+		for _, attr := range []struct{ key, value string }{
+			{"omni.audit.event_type", h.Type},
+			{"omni.audit.resource_type", h.ResourceType},
+			{"omni.audit.resource_id", h.ResourceID},
+		} {
+			if attr.value != "" {
+				attributes = append(attributes, &commonpb.KeyValue{Key: attr.key, Value: logsink.StringValue(attr.value)})
+			}
+		}
+
+		records = append(records, &logspb.LogRecord{
+			TimeUnixNano:         uint64(time.UnixMilli(h.TimeMillis).UnixNano()),
+			ObservedTimeUnixNano: uint64(observed.UnixNano()),
+			SeverityNumber:       logspb.SeverityNumber_SEVERITY_NUMBER_INFO,
+			SeverityText:         "INFO",
+			Body:                 logsink.StringValue(string(payload(entry))),
+			Attributes:           attributes,
+		})
+	}
+
+	return &collogspb.ExportLogsServiceRequest{
+		ResourceLogs: []*logspb.ResourceLogs{
+			{
+				Resource: &resourcepb.Resource{
+					Attributes: []*commonpb.KeyValue{
+						{Key: "service.name", Value: logsink.StringValue("omni")},
+						{Key: "service.version", Value: logsink.StringValue(version.Tag)},
+					},
+				},
+				ScopeLogs: []*logspb.ScopeLogs{
+					{
+						Scope:      &commonpb.InstrumentationScope{Name: otlpScopeName},
+						LogRecords: records,
+					},
+				},
+			},
+		},
+	}
+}
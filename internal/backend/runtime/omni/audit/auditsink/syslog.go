@@ -0,0 +1,111 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package auditsink
+
+import (
+	"context"
+	"crypto/tls"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/audit/auditlog"
+	"github.com/siderolabs/omni/internal/pkg/logsink"
+)
+
+const (
+	// syslogPriority is the "log audit" facility (13) with the "informational" severity (6).
+	syslogPriority = 13*8 + 6
+
+	// syslogSDID is the structured data element the event is described with.
+	// The enterprise number is the one reserved for documentation by RFC 5612.
+	syslogSDID = "audit@32473"
+
+	syslogAppName = "omni"
+	syslogMsgID   = "audit"
+)
+
+// SyslogOptions configures the syslog sink.
+type SyslogOptions struct {
+	// TLSConfig enables TLS for the connection to the server if set.
+	TLSConfig *tls.Config
+
+	// Endpoint is the TCP endpoint of the syslog server in the form "host:port".
+	Endpoint string
+
+	// Hostname is reported as the origin of the messages. Defaults to the hostname of the machine.
+	Hostname string
+}
+
+// Syslog sends the events as RFC 5424 messages to a syslog server over TCP, framed with the octet counting of RFC 6587.
+type Syslog struct {
+	conn *logsink.SyslogConn
+	opts SyslogOptions
+}
+
+// NewSyslog creates a new syslog sink. The connection is established on the first send.
+func NewSyslog(opts SyslogOptions) *Syslog {
+	if opts.Hostname == "" {
+		opts.Hostname, _ = os.Hostname() //nolint:errcheck
+	}
+
+	return &Syslog{
+		conn: logsink.NewSyslogConn(opts.Endpoint, opts.TLSConfig),
+		opts: opts,
+	}
+}
+
+// Name implements [Sink].
+func (s *Syslog) Name() string {
+	return "syslog"
+}
+
+// Send implements [Sink].
+func (s *Syslog) Send(ctx context.Context, entries []auditlog.Entry) error {
+	messages := make([]logsink.SyslogMessage, 0, len(entries))
+
+	for _, entry := range entries {
+		messages = append(messages, syslogMessage(s.opts.Hostname, entry))
+	}
+
+	return s.conn.Send(ctx, messages)
+}
+
+// Close implements [io.Closer].
+func (s *Syslog) Close() error {
+	return s.conn.Close()
+}
+
+func syslogMessage(hostname string, entry auditlog.Entry) logsink.SyslogMessage {
+	h := parseHeader(entry)
+
+	var timestamp time.Time
+	if h.TimeMillis != 0 {
+		timestamp = time.UnixMilli(h.TimeMillis)
+	}
+
+	params := []logsink.SDParam{{Name: "id", Value: strconv.FormatInt(entry.ID, 10)}}
+
+	for _, param := range []logsink.SDParam{
+		{Name: "type", Value: h.Type},
+		{Name: "resourceType", Value: h.ResourceType},
+		{Name: "resourceID", Value: h.ResourceID},
+	} {
+		if param.Value != "" {
+			params = append(params, param)
+		}
+	}
+
+	return logsink.SyslogMessage{
+		Priority:       syslogPriority,
+		Time:           timestamp,
+		Hostname:       hostname,
+		AppName:        syslogAppName,
+		MsgID:          syslogMsgID,
+		StructuredData: []logsink.SDElement{{ID: syslogSDID, Params: params}},
+		Message:        payload(entry),
+	}
+}
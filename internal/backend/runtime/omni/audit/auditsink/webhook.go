@@ -0,0 +1,127 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package auditsink
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/audit/auditlog"
+)
+
+const (
+	// WebhookTimestampHeader is the header with the Unix time the request was signed at.
+	WebhookTimestampHeader = "X-Omni-Timestamp"
+
+	// WebhookSignatureHeader is the header with the signature of the request, in the form "sha256=<hex>".
+	//
+	// The signature is the HMAC-SHA256 of the timestamp, a dot and the request body.
+	WebhookSignatureHeader = "X-Omni-Signature"
+
+	webhookTimeout = 30 * time.Second
+)
+
+// WebhookOptions configures the webhook sink.
+type WebhookOptions struct {
+	// Client is the HTTP client the requests are sent with. Defaults to a client with a 30 seconds timeout.
+	Client *http.Client
+
+	// URL is the URL the events are posted to.
+	URL string
+
+	// Secret is the key the requests are signed with. The requests are not signed if it is empty.
+	Secret string
+}
+
+// Webhook posts the batches of events as newline delimited JSON to an HTTP endpoint.
+//
+// Each line is an object with the id of the event, which the receiver can deduplicate the events with, and the event itself.
+type Webhook struct {
+	opts WebhookOptions
+}
+
+// NewWebhook creates a new webhook sink.
+func NewWebhook(opts WebhookOptions) *Webhook {
+	if opts.Client == nil {
+		opts.Client = &http.Client{Timeout: webhookTimeout}
+	}
+
+	return &Webhook{opts: opts}
+}
+
+// Name implements [Sink].
+func (w *Webhook) Name() string {
+	return "webhook"
+}
+
+// webhookEvent is a single line of the webhook request body.
+type webhookEvent struct {
+	Event json.RawMessage `json:"event"`
+	ID    int64           `json:"id"`
+}
+
+// Send implements [Sink].
+func (w *Webhook) Send(ctx context.Context, entries []auditlog.Entry) error {
+	var body bytes.Buffer
+
+	encoder := json.NewEncoder(&body)
+
+	for _, entry := range entries {
+		if err := encoder.Encode(webhookEvent{ID: entry.ID, Event: payload(entry)}); err != nil {
+			return fmt.Errorf("failed to encode audit log event %d: %w", entry.ID, err)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.opts.URL, bytes.NewReader(body.Bytes()))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	req.Header.Set(WebhookTimestampHeader, timestamp)
+
+	if w.opts.Secret != "" {
+		req.Header.Set(WebhookSignatureHeader, "sha256="+SignWebhook(w.opts.Secret, timestamp, body.Bytes()))
+	}
+
+	resp, err := w.opts.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send webhook request: %w", err)
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	// drain the body to reuse the connection
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024)) //nolint:errcheck
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// SignWebhook returns the hex encoded signature of the webhook request body.
+func SignWebhook(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -59,6 +59,16 @@ func Init(a *audit.Log) {
 	audit.ShouldLogUpdateWithConflicts(a, auth.AccessPolicyType, accessPolicyUpdate, audit.WithInternalAgent())
 	audit.ShouldLogDestroy(a, auth.AccessPolicyType, accessPolicyDestroy, audit.WithInternalAgent())
 
+	audit.ShouldLogCreate(a, auth.ElevationRequestType, elevationRequestCreate, audit.WithInternalAgent())
+	audit.ShouldLogUpdate(a, auth.ElevationRequestType, elevationRequestUpdate, audit.WithInternalAgent())
+	audit.ShouldLogUpdateWithConflicts(a, auth.ElevationRequestType, elevationRequestUpdate, audit.WithInternalAgent())
+	audit.ShouldLogDestroy(a, auth.ElevationRequestType, elevationDestroy, audit.WithInternalAgent())
+
+	// The grants are created and removed by the controller when the elevation is approved, revoked or expires,
+	// so the expiration of the elevation is logged as the destroy of its grant.
+	audit.ShouldLogCreate(a, auth.ElevationGrantType, elevationGrantCreate, audit.WithInternalAgent())
+	audit.ShouldLogDestroy(a, auth.ElevationGrantType, elevationDestroy, audit.WithInternalAgent())
+
 	audit.ShouldLogCreate(a, omni.ClusterType, clusterCreate, audit.WithInternalAgent())
 	audit.ShouldLogUpdate(a, omni.ClusterType, clusterUpdate, audit.WithInternalAgent())
 	audit.ShouldLogUpdateWithConflicts(a, omni.ClusterType, clusterUpdate, audit.WithInternalAgent())
@@ -356,6 +366,69 @@ func accessPolicyDestroy(_ context.Context, data *auditlog.Data, ptr resource.Po
 	return nil
 }
 
+func elevationRequestCreate(_ context.Context, data *auditlog.Data, res resource.Resource, _ ...state.CreateOption) error {
+	return handleElevationRequest(data, res)
+}
+
+func elevationRequestUpdate(_ context.Context, data *auditlog.Data, _, newRes resource.Resource, _ ...state.UpdateOption) error {
+	return handleElevationRequest(data, newRes)
+}
+
+func handleElevationRequest(data *auditlog.Data, res resource.Resource) error {
+	request, ok := res.(*auth.ElevationRequest)
+	if !ok {
+		return fmt.Errorf("unexpected type: %q", res.Metadata().Type())
+	}
+
+	spec := request.TypedSpec().Value
+
+	initPtrField(&data.Elevation)
+
+	data.Elevation.ID = res.Metadata().ID()
+	data.Elevation.Identity = spec.GetIdentity()
+	data.Elevation.Role = spec.GetRole()
+	data.Elevation.Clusters = spec.GetClusters()
+	data.Elevation.Reason = spec.GetReason()
+	data.Elevation.Duration = spec.GetDuration().AsDuration().String()
+	data.Elevation.ApprovedBy = spec.GetApprovedBy()
+	data.Elevation.Revoked = spec.GetRevoked()
+	data.Elevation.RevokedBy = spec.GetRevokedBy()
+
+	if spec.GetApprovedAt() != nil {
+		data.Elevation.Expiration = spec.GetApprovedAt().AsTime().Add(spec.GetDuration().AsDuration()).Unix()
+	}
+
+	return nil
+}
+
+func elevationGrantCreate(_ context.Context, data *auditlog.Data, res resource.Resource, _ ...state.CreateOption) error {
+	grant, ok := res.(*auth.ElevationGrant)
+	if !ok {
+		return fmt.Errorf("unexpected type: %q", res.Metadata().Type())
+	}
+
+	spec := grant.TypedSpec().Value
+
+	initPtrField(&data.Elevation)
+
+	data.Elevation.ID = res.Metadata().ID()
+	data.Elevation.Identity = spec.GetIdentity()
+	data.Elevation.Role = spec.GetRole()
+	data.Elevation.Clusters = spec.GetClusters()
+	data.Elevation.ApprovedBy = spec.GetApprovedBy()
+	data.Elevation.Expiration = spec.GetExpiration().GetSeconds()
+
+	return nil
+}
+
+func elevationDestroy(_ context.Context, data *auditlog.Data, ptr resource.Pointer, _ ...state.DestroyOption) error {
+	initPtrField(&data.Elevation)
+
+	data.Elevation.ID = ptr.ID()
+
+	return nil
+}
+
 func clusterCreate(_ context.Context, data *auditlog.Data, res resource.Resource, _ ...state.CreateOption) error {
 	return handleCluster(data, res)
 }
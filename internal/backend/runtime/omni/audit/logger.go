@@ -7,7 +7,10 @@ package audit
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/cosi-project/state-sqlite/pkg/sqlitexx"
@@ -15,10 +18,11 @@ import (
 
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/audit/auditlog"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/audit/auditlog/auditlogsqlite"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/audit/auditsink"
 	"github.com/siderolabs/omni/internal/pkg/config"
 )
 
-func initLogger(ctx context.Context, config config.LogsAudit, db *sqlitexx.Pool, logger *zap.Logger, onCleanup func(int)) (Logger, error) {
+func initLogger(ctx context.Context, config config.LogsAudit, db *sqlitexx.Pool, logger *zap.Logger, cfg logConfig) (Logger, error) {
 	if !config.GetEnabled() {
 		logger.Info("audit logging is disabled")
 
@@ -26,8 +30,12 @@ func initLogger(ctx context.Context, config config.LogsAudit, db *sqlitexx.Pool,
 	}
 
 	var storeOpts []auditlogsqlite.Option
-	if onCleanup != nil {
-		storeOpts = append(storeOpts, auditlogsqlite.WithCleanupCallback(onCleanup))
+	if cfg.onCleanup != nil {
+		storeOpts = append(storeOpts, auditlogsqlite.WithCleanupCallback(cfg.onCleanup))
+	}
+
+	if cfg.signingKey != nil {
+		storeOpts = append(storeOpts, auditlogsqlite.WithSigningKey(cfg.signingKey))
 	}
 
 	dbAuditLogger, err := auditlogsqlite.NewStore(ctx, db, config.GetSqliteTimeout(), config.GetMaxSize(), config.GetCleanupProbability(), logger, storeOpts...)
@@ -38,6 +46,73 @@ func initLogger(ctx context.Context, config config.LogsAudit, db *sqlitexx.Pool,
 	return dbAuditLogger, nil
 }
 
+func initSinks(config config.LogsAuditSinks) ([]auditsink.Sink, error) {
+	var sinks []auditsink.Sink
+
+	if config.Syslog.GetEnabled() {
+		var tlsConfig *tls.Config
+
+		if config.Syslog.GetTls() {
+			var err error
+
+			if tlsConfig, err = syslogTLSConfig(config.Syslog); err != nil {
+				return nil, err
+			}
+		}
+
+		sinks = append(sinks, auditsink.NewSyslog(auditsink.SyslogOptions{
+			Endpoint:  config.Syslog.GetEndpoint(),
+			TLSConfig: tlsConfig,
+		}))
+	}
+
+	if config.Webhook.GetEnabled() {
+		sinks = append(sinks, auditsink.NewWebhook(auditsink.WebhookOptions{
+			URL:    config.Webhook.GetUrl(),
+			Secret: config.Webhook.GetSecret(),
+		}))
+	}
+
+	if config.Otlp.GetEnabled() {
+		sinks = append(sinks, auditsink.NewOTLP(auditsink.OTLPOptions{
+			Endpoint: config.Otlp.GetEndpoint(),
+			Headers:  config.Otlp.Headers,
+		}))
+	}
+
+	return sinks, nil
+}
+
+func syslogTLSConfig(config config.LogsAuditSyslogSink) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if caFile := config.GetCaFile(); caFile != "" {
+		caPEM, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read syslog CA file: %w", err)
+		}
+
+		tlsConfig.RootCAs = x509.NewCertPool()
+
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no certificates found in syslog CA file %q", caFile)
+		}
+	}
+
+	if config.GetCertFile() != "" || config.GetKeyFile() != "" {
+		cert, err := tls.LoadX509KeyPair(config.GetCertFile(), config.GetKeyFile())
+		if err != nil {
+			return nil, fmt.Errorf("failed to load syslog client certificate: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
 type nopLogger struct{}
 
 func (n *nopLogger) Write(context.Context, auditlog.Event) error { return nil }
@@ -48,6 +123,10 @@ func (n *nopLogger) Reader(context.Context, auditlog.ReadFilters) (auditlog.Read
 	return &nopReader{}, nil
 }
 
+func (n *nopLogger) ProofReader(context.Context, time.Time, time.Time) (auditlog.ProofReader, error) {
+	return nil, fmt.Errorf("audit logs are disabled")
+}
+
 func (n *nopLogger) FollowStart(context.Context, int64) (int64, error) {
 	return 0, fmt.Errorf("audit logs are disabled")
 }
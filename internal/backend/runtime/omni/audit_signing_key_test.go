@@ -0,0 +1,60 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/internal/backend/runtime/omni"
+)
+
+func TestLoadAuditLogSigningKey(t *testing.T) {
+	t.Parallel()
+
+	writeKey := func(t *testing.T, key any) string {
+		der, err := x509.MarshalPKCS8PrivateKey(key)
+		require.NoError(t, err)
+
+		path := filepath.Join(t.TempDir(), "key.pem")
+
+		require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600))
+
+		return path
+	}
+
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	key, err := omni.LoadAuditLogSigningKey(writeKey(t, ed25519Key))
+	require.NoError(t, err)
+	assert.True(t, ed25519Key.Equal(key))
+
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	_, err = omni.LoadAuditLogSigningKey(writeKey(t, ecdsaKey))
+	assert.ErrorContains(t, err, "not an Ed25519 key")
+
+	notPEM := filepath.Join(t.TempDir(), "key.pem")
+	require.NoError(t, os.WriteFile(notPEM, []byte("not a key"), 0o600))
+
+	_, err = omni.LoadAuditLogSigningKey(notPEM)
+	assert.ErrorContains(t, err, "no PEM data found")
+
+	_, err = omni.LoadAuditLogSigningKey(filepath.Join(t.TempDir(), "missing.pem"))
+	assert.Error(t, err)
+}
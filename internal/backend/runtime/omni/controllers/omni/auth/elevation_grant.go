@@ -0,0 +1,66 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
+	"github.com/siderolabs/gen/xerrors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+)
+
+// ElevationGrantController creates an ElevationGrant for each approved ElevationRequest, and removes it when the elevation expires or is revoked.
+type ElevationGrantController = qtransform.QController[*authres.ElevationRequest, *authres.ElevationGrant]
+
+// NewElevationGrantController instantiates the ElevationGrant controller.
+func NewElevationGrantController() *ElevationGrantController {
+	return qtransform.NewQController(
+		qtransform.Settings[*authres.ElevationRequest, *authres.ElevationGrant]{
+			Name: "ElevationGrantController",
+			MapMetadataFunc: func(request *authres.ElevationRequest) *authres.ElevationGrant {
+				return authres.NewElevationGrant(request.Metadata().ID())
+			},
+			UnmapMetadataFunc: func(grant *authres.ElevationGrant) *authres.ElevationRequest {
+				return authres.NewElevationRequest(grant.Metadata().ID())
+			},
+			TransformFunc: func(_ context.Context, _ controller.Reader, logger *zap.Logger, request *authres.ElevationRequest, grant *authres.ElevationGrant) error {
+				spec := request.TypedSpec().Value
+
+				switch {
+				case spec.GetRevoked():
+					return xerrors.NewTaggedf[qtransform.DestroyOutputTag]("elevation is revoked")
+				case spec.GetApprovedAt() == nil:
+					return xerrors.NewTaggedf[qtransform.DestroyOutputTag]("elevation is not approved")
+				}
+
+				expiration := spec.GetApprovedAt().AsTime().Add(spec.GetDuration().AsDuration())
+
+				untilExpiration := time.Until(expiration)
+				if untilExpiration <= 0 {
+					logger.Info("elevation expired", zap.String("identity", spec.GetIdentity()), zap.String("role", spec.GetRole()))
+
+					return xerrors.NewTaggedf[qtransform.DestroyOutputTag]("elevation is expired")
+				}
+
+				grant.Metadata().Labels().Set(authres.LabelIdentity, spec.GetIdentity())
+
+				grant.TypedSpec().Value.Identity = spec.GetIdentity()
+				grant.TypedSpec().Value.Role = spec.GetRole()
+				grant.TypedSpec().Value.Clusters = spec.GetClusters()
+				grant.TypedSpec().Value.Expiration = timestamppb.New(expiration)
+				grant.TypedSpec().Value.ApprovedBy = spec.GetApprovedBy()
+
+				return controller.NewRequeueInterval(untilExpiration)
+			},
+		},
+	)
+}
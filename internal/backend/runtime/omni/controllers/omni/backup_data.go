@@ -71,8 +71,10 @@ func NewBackupDataController() *BackupDataController {
 
 				if cluster.TypedSpec().Value.BackupConfiguration != nil && cluster.TypedSpec().Value.BackupConfiguration.Enabled {
 					backupData.TypedSpec().Value.Interval = cluster.TypedSpec().Value.GetBackupConfiguration().GetInterval()
+					backupData.TypedSpec().Value.Retention = cluster.TypedSpec().Value.GetBackupConfiguration().GetRetention()
 				} else {
 					backupData.TypedSpec().Value.Interval = durationpb.New(0)
+					backupData.TypedSpec().Value.Retention = nil
 				}
 
 				backupData.TypedSpec().Value.ClusterUuid = clusterUUID.TypedSpec().Value.GetUuid()
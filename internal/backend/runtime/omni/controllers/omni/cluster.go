@@ -106,6 +106,10 @@ func NewClusterController(kubernetesRuntime KubernetesRuntime) *ClusterControlle
 					&customcleanup.SameIDHandler[*omni.Cluster, *omni.ImportedClusterSecrets]{},
 					&customcleanup.SameIDHandler[*omni.Cluster, *omni.RotateTalosCA]{},
 					&customcleanup.SameIDHandler[*omni.Cluster, *omni.RotateKubernetesCA]{},
+					&customcleanup.SameIDHandler[*omni.Cluster, *omni.RotateEtcdCA]{},
+					&customcleanup.SameIDHandler[*omni.Cluster, *omni.RotateKubernetesAggregatorCA]{},
+					&customcleanup.SameIDHandler[*omni.Cluster, *omni.RotateKubernetesServiceAccountKey]{},
+					&customcleanup.SameIDHandler[*omni.Cluster, *omni.RotateBootstrapToken]{},
 				),
 			},
 		},
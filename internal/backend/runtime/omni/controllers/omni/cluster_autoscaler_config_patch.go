@@ -0,0 +1,122 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/xerrors"
+	"go.uber.org/zap"
+	"go.yaml.in/yaml/v4"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/constants"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+// ClusterAutoscalerConfigPatchPrefix is the prefix for the system config patch that sets the Kubernetes provider ID of the machines in the autoscaled machine sets.
+const ClusterAutoscalerConfigPatchPrefix = "950-cluster-autoscaler-provider-id-"
+
+// ClusterAutoscalerConfigPatchController creates the system config patch that sets the Kubernetes provider ID of the machines in the autoscaled machine sets.
+//
+// The cluster autoscaler matches the nodes to the machines by the provider ID.
+// Kubernetes doesn't allow changing the provider ID of a registered node, so the nodes which were registered before
+// their machine set got the autoscaling bounds keep the empty one, and are matched by their name instead.
+type ClusterAutoscalerConfigPatchController = qtransform.QController[*omni.ClusterMachine, *omni.ConfigPatch]
+
+// NewClusterAutoscalerConfigPatchController initializes ClusterAutoscalerConfigPatchController.
+func NewClusterAutoscalerConfigPatchController() *ClusterAutoscalerConfigPatchController {
+	return qtransform.NewQController(
+		qtransform.Settings[*omni.ClusterMachine, *omni.ConfigPatch]{
+			Name: "ClusterAutoscalerConfigPatchController",
+			MapMetadataFunc: func(clusterMachine *omni.ClusterMachine) *omni.ConfigPatch {
+				return omni.NewConfigPatch(ClusterAutoscalerConfigPatchPrefix + clusterMachine.Metadata().ID())
+			},
+			UnmapMetadataFunc: func(configPatch *omni.ConfigPatch) *omni.ClusterMachine {
+				return omni.NewClusterMachine(strings.TrimPrefix(configPatch.Metadata().ID(), ClusterAutoscalerConfigPatchPrefix))
+			},
+			TransformFunc: func(ctx context.Context, r controller.Reader, _ *zap.Logger, clusterMachine *omni.ClusterMachine, configPatch *omni.ConfigPatch) error {
+				clusterName, ok := clusterMachine.Metadata().Labels().Get(omni.LabelCluster)
+				if !ok {
+					return xerrors.NewTaggedf[qtransform.DestroyOutputTag]("cluster machine has no cluster label")
+				}
+
+				machineSetID, ok := clusterMachine.Metadata().Labels().Get(omni.LabelMachineSet)
+				if !ok {
+					return xerrors.NewTaggedf[qtransform.DestroyOutputTag]("cluster machine has no machine set label")
+				}
+
+				machineSet, err := safe.ReaderGetByID[*omni.MachineSet](ctx, r, machineSetID)
+				if err != nil {
+					if state.IsNotFoundError(err) {
+						return xerrors.NewTaggedf[qtransform.DestroyOutputTag]("machine set %q doesn't exist", machineSetID)
+					}
+
+					return err
+				}
+
+				allocation := omni.GetMachineAllocation(machineSet)
+				if allocation.GetAutoscaling() == nil || allocation.GetAllocationType() != specs.MachineSetSpec_MachineAllocation_Static {
+					return xerrors.NewTaggedf[qtransform.DestroyOutputTag]("machine set %q is not autoscaled", machineSetID)
+				}
+
+				patchYAML, err := buildProviderIDPatch(clusterMachine.Metadata().ID())
+				if err != nil {
+					return err
+				}
+
+				configPatch.Metadata().Labels().Set(omni.LabelSystemPatch, "")
+				configPatch.Metadata().Labels().Set(omni.LabelCluster, clusterName)
+				configPatch.Metadata().Labels().Set(omni.LabelClusterMachine, clusterMachine.Metadata().ID())
+
+				return configPatch.TypedSpec().Value.SetUncompressedData(patchYAML)
+			},
+		},
+		qtransform.WithExtraMappedInput[*omni.MachineSet](
+			func(ctx context.Context, _ *zap.Logger, r controller.QRuntime, machineSet controller.ReducedResourceMetadata) ([]resource.Pointer, error) {
+				clusterMachines, err := safe.ReaderListAll[*omni.ClusterMachine](ctx, r, state.WithLabelQuery(resource.LabelEqual(omni.LabelMachineSet, machineSet.ID())))
+				if err != nil {
+					return nil, err
+				}
+
+				return slices.Collect(clusterMachines.Pointers()), nil
+			},
+		),
+		qtransform.WithConcurrency(2),
+		qtransform.WithOutputKind(controller.OutputShared),
+	)
+}
+
+func buildProviderIDPatch(machineID resource.ID) ([]byte, error) {
+	var buf bytes.Buffer
+
+	enc := yaml.NewEncoder(&buf)
+
+	enc.SetIndent(2)
+
+	if err := enc.Encode(map[string]any{
+		"machine": map[string]any{
+			"kubelet": map[string]any{
+				"extraConfig": map[string]any{
+					"providerID": constants.ClusterAutoscalerProviderIDPrefix + machineID,
+				},
+			},
+		},
+	}); err != nil {
+		return nil, fmt.Errorf("failed to encode patch: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+)
+
+type ClusterAutoscalerConfigPatchSuite struct {
+	OmniSuite
+}
+
+func (suite *ClusterAutoscalerConfigPatchSuite) TestReconcile() {
+	require := suite.Require()
+
+	ctx, cancel := context.WithTimeout(suite.ctx, time.Second*10)
+	defer cancel()
+
+	suite.startRuntime()
+
+	require.NoError(suite.runtime.RegisterQController(omnictrl.NewClusterAutoscalerConfigPatchController()))
+
+	machineSet := omni.NewMachineSet("test-cluster-workers")
+	machineSet.Metadata().Labels().Set(omni.LabelCluster, "test-cluster")
+	machineSet.Metadata().Labels().Set(omni.LabelWorkerRole, "")
+	machineSet.TypedSpec().Value.MachineAllocation = &specs.MachineSetSpec_MachineAllocation{
+		Name:         "workers",
+		MachineCount: 1,
+		Autoscaling: &specs.MachineSetSpec_MachineAllocation_Autoscaling{
+			MinMachineCount: 1,
+			MaxMachineCount: 3,
+		},
+	}
+
+	require.NoError(suite.state.Create(ctx, machineSet))
+
+	clusterMachine := omni.NewClusterMachine("machine-1")
+	clusterMachine.Metadata().Labels().Set(omni.LabelCluster, "test-cluster")
+	clusterMachine.Metadata().Labels().Set(omni.LabelMachineSet, machineSet.Metadata().ID())
+
+	require.NoError(suite.state.Create(ctx, clusterMachine))
+
+	patchID := omnictrl.ClusterAutoscalerConfigPatchPrefix + clusterMachine.Metadata().ID()
+
+	rtestutils.AssertResource(ctx, suite.T(), suite.state, patchID, func(configPatch *omni.ConfigPatch, assertion *assert.Assertions) {
+		buffer, err := configPatch.TypedSpec().Value.GetUncompressedData()
+		assertion.NoError(err)
+
+		defer buffer.Free()
+
+		assertion.Contains(string(buffer.Data()), "providerID: omni://machine-1")
+
+		value, ok := configPatch.Metadata().Labels().Get(omni.LabelClusterMachine)
+		assertion.True(ok)
+		assertion.Equal(clusterMachine.Metadata().ID(), value)
+
+		value, ok = configPatch.Metadata().Labels().Get(omni.LabelCluster)
+		assertion.True(ok)
+		assertion.Equal("test-cluster", value)
+
+		_, ok = configPatch.Metadata().Labels().Get(omni.LabelSystemPatch)
+		assertion.True(ok)
+	})
+
+	// the patch is removed once the machine set is no longer autoscaled
+	_, err := safe.StateUpdateWithConflicts(ctx, suite.state, machineSet.Metadata(), func(res *omni.MachineSet) error {
+		res.TypedSpec().Value.MachineAllocation.Autoscaling = nil
+
+		return nil
+	})
+	require.NoError(err)
+
+	rtestutils.AssertNoResource[*omni.ConfigPatch](ctx, suite.T(), suite.state, patchID)
+}
+
+func TestClusterAutoscalerConfigPatchSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(ClusterAutoscalerConfigPatchSuite))
+}
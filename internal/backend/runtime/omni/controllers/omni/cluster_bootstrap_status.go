@@ -7,7 +7,6 @@ package omni
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
 	"github.com/cosi-project/runtime/pkg/controller"
@@ -21,6 +20,7 @@ import (
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup/store"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/mappers"
 	"github.com/siderolabs/omni/internal/backend/runtime/talos"
@@ -193,21 +193,13 @@ func recoverEtcdFromBackup(ctx context.Context, r controller.Reader, talosCli *c
 		return fmt.Errorf("failed to get backup store: %w", err)
 	}
 
-	downloadedBackupData, readCloser, err := backupStore.Download(ctx, backupData.TypedSpec().Value.GetEncryptionKey(), bootstrapSpec.GetClusterUuid(), bootstrapSpec.GetSnapshot())
+	readCloser, err := etcdbackup.DownloadForRestore(ctx, backupStore, backupData, bootstrapSpec.GetClusterUuid(), bootstrapSpec.GetSnapshot())
 	if err != nil {
-		return fmt.Errorf("failed to download backup: %w", err)
+		return err
 	}
 
 	defer readCloser.Close() //nolint:errcheck
 
-	if downloadedBackupData.AESCBCEncryptionSecret != backupData.TypedSpec().Value.GetAesCbcEncryptionSecret() {
-		return errors.New("aes cbc encryption secret mismatch")
-	}
-
-	if downloadedBackupData.SecretboxEncryptionSecret != backupData.TypedSpec().Value.GetSecretboxEncryptionSecret() {
-		return errors.New("secretbox encryption secret mismatch")
-	}
-
 	if _, err = talosCli.EtcdRecover(ctx, readCloser); err != nil {
 		return fmt.Errorf("failed calling talos client EtcdRecover: %w", err)
 	}
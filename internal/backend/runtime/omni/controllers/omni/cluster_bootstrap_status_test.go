@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"io"
 	"iter"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
@@ -166,6 +167,23 @@ func (m *mockEtcdBackupStore) Download(ctx context.Context, _ []byte, clusterUUI
 	return backupData, rdr, nil
 }
 
+func (m *mockEtcdBackupStore) Delete(_ context.Context, clusterUUID, snapshotName string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i, desc := range m.descs {
+		if desc.ClusterUUID == clusterUUID && m.backups[i].Snapshot == snapshotName {
+			m.descs = slices.Delete(m.descs, i, i+1)
+			m.backupDatas = slices.Delete(m.backupDatas, i, i+1)
+			m.backups = slices.Delete(m.backups, i, i+1)
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("not found: %s/%s", clusterUUID, snapshotName)
+}
+
 type ClusterBootstrapStatusSuite struct {
 	OmniSuite
 }
@@ -45,6 +45,7 @@ import (
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/installdisk"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/imagefactoryauth"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/mappers"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/secretrotation"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/uncached"
 	omnicfg "github.com/siderolabs/omni/internal/pkg/config"
 )
@@ -559,6 +560,8 @@ func (helper clusterMachineConfigControllerHelper) generateConfig(clusterMachine
 
 	cfg := genOutput.Config
 
+	var serviceAccountKeysPatch string
+
 	if rotation := clusterMachineSecrets.TypedSpec().Value.GetRotation(); rotation != nil {
 		cfg, err = cfg.PatchV1Alpha1(func(config *v1alpha1.Config) error {
 			machineAcceptedCAs := []*x509.PEMEncodedCertificate{{Crt: secretsBundle.Certs.OS.Crt}}
@@ -568,6 +571,24 @@ func (helper clusterMachineConfigControllerHelper) generateConfig(clusterMachine
 
 			config.MachineConfig.MachineAcceptedCAs = machineAcceptedCAs
 
+			// etcd and the aggregator CAs have no list of the accepted CAs, the rotation relies on the CA bundle instead,
+			// the certificates are issued with the first one of them
+			if extraEtcd := rotation.GetExtraCerts().GetEtcd(); extraEtcd != nil && config.ClusterConfig != nil &&
+				config.ClusterConfig.EtcdConfig != nil && config.ClusterConfig.EtcdConfig.RootCA != nil {
+				config.ClusterConfig.EtcdConfig.RootCA = &x509.PEMEncodedCertificateAndKey{
+					Crt: secretrotation.CABundle(config.ClusterConfig.EtcdConfig.RootCA.Crt, extraEtcd.Crt),
+					Key: config.ClusterConfig.EtcdConfig.RootCA.Key,
+				}
+			}
+
+			if extraAggregator := rotation.GetExtraCerts().GetK8SAggregator(); extraAggregator != nil && config.ClusterConfig != nil &&
+				config.ClusterConfig.ClusterAggregatorCA != nil {
+				config.ClusterConfig.ClusterAggregatorCA = &x509.PEMEncodedCertificateAndKey{
+					Crt: secretrotation.CABundle(config.ClusterConfig.ClusterAggregatorCA.Crt, extraAggregator.Crt),
+					Key: config.ClusterConfig.ClusterAggregatorCA.Key,
+				}
+			}
+
 			return nil
 		})
 		if err != nil {
@@ -580,6 +601,14 @@ func (helper clusterMachineConfigControllerHelper) generateConfig(clusterMachine
 				return nil, fmt.Errorf("failed to add the accepted Kubernetes CA: %w", err)
 			}
 		}
+
+		if extraServiceAccount := rotation.GetExtraCerts().GetK8SSa(); extraServiceAccount != nil && machineType == machineapi.TypeControlPlane &&
+			secretsBundle.Certs.K8sServiceAccount != nil {
+			serviceAccountKeysPatch, err = secretrotation.ServiceAccountKeysPatch(secretsBundle.Certs.K8sServiceAccount.Key, extraServiceAccount.Key)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	patchList, err := clusterMachineConfigPatches.TypedSpec().Value.GetUncompressedPatches()
@@ -618,6 +647,11 @@ func (helper clusterMachineConfigControllerHelper) generateConfig(clusterMachine
 		patchList = append(patchList, authPatch)
 	}
 
+	// the service account keys accepted during the rotation take precedence over the user patches
+	if serviceAccountKeysPatch != "" {
+		patchList = append(patchList, serviceAccountKeysPatch)
+	}
+
 	patches, err := configpatcher.LoadPatches(patchList)
 	if err != nil {
 		return nil, err
@@ -7,8 +7,10 @@ package omni_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"maps"
+	"strings"
 	"testing"
 	"time"
 
@@ -16,6 +18,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
+	talosx509 "github.com/siderolabs/crypto/x509"
 	"github.com/siderolabs/talos/pkg/machinery/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
 	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
@@ -25,11 +28,13 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/siderolink"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/helpers"
 	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/installdisk"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/secretrotation"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/testutils"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/testutils/rmock"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/testutils/rmock/options"
@@ -790,3 +795,99 @@ func TestClusterMachineConfigInstallDiskOverride(t *testing.T) {
 		})
 	}
 }
+
+func TestClusterMachineConfigServiceAccountKeyRotation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 30*time.Second)
+	t.Cleanup(cancel)
+
+	keys := make([][]byte, 0, 3)
+
+	for range 3 {
+		key, err := talosx509.NewECDSAKey()
+		require.NoError(t, err)
+
+		keys = append(keys, key.KeyPEM)
+	}
+
+	testutils.WithRuntime(
+		ctx, t, testutils.TestOptions{}, registerClusterMachineConfigControllers(t),
+		func(ctx context.Context, tc testutils.TestContext) {
+			_, machines := createConfigTestCluster(ctx, t, tc.State, "talos-default-sa-rotation", 0, TalosVersion)
+
+			machineID := machines[0].Metadata().ID()
+
+			setServiceAccountKeys := func(key, extraKey []byte) {
+				_, err := safe.StateUpdateWithConflicts(ctx, tc.State, omni.NewClusterMachineSecrets(machineID).Metadata(), func(res *omni.ClusterMachineSecrets) error {
+					bundle, err := omni.ToSecretsBundle(res.TypedSpec().Value.Data)
+					if err != nil {
+						return err
+					}
+
+					bundle.Certs.K8sServiceAccount = &talosx509.PEMEncodedKey{Key: key}
+
+					if res.TypedSpec().Value.Data, err = json.Marshal(bundle); err != nil {
+						return err
+					}
+
+					res.TypedSpec().Value.Rotation = nil
+
+					if extraKey != nil {
+						res.TypedSpec().Value.Rotation = &specs.ClusterMachineSecretsSpec_Rotation{
+							Status:     specs.SecretRotationSpec_IN_PROGRESS,
+							Phase:      specs.SecretRotationSpec_ROTATE,
+							Component:  specs.SecretRotationSpec_KUBERNETES_SERVICE_ACCOUNT_KEY,
+							ExtraCerts: &specs.ClusterSecretsSpec_Certs{K8SSa: &specs.ClusterSecretsSpec_Certs_CA{Key: extraKey}},
+						}
+					}
+
+					return nil
+				}, state.WithUpdateOwner(rmock.GetOwner[*omni.ClusterMachineSecrets]()))
+				require.NoError(t, err)
+			}
+
+			assertServiceAccountKeys := func(expectedKey []byte, rotating bool) {
+				rtestutils.AssertResource(ctx, t, tc.State, machineID, func(res *omni.ClusterMachineConfig, assertions *assert.Assertions) {
+					assertions.Empty(res.TypedSpec().Value.GenerationError)
+
+					buffer, err := res.TypedSpec().Value.GetUncompressedData()
+					assertions.NoError(err)
+
+					defer buffer.Free()
+
+					cfg, err := configloader.NewFromBytes(buffer.Data())
+					assertions.NoError(err)
+
+					if cfg == nil {
+						return
+					}
+
+					assertions.Equal(expectedKey, cfg.Cluster().ServiceAccount().Key)
+					assertions.Equal(rotating, strings.Contains(string(buffer.Data()), "service-account-key-file: "+secretrotation.ServiceAccountKeysPath))
+				})
+			}
+
+			// the first rotation replaces the first key with the second one
+			setServiceAccountKeys(keys[1], keys[0])
+			assertServiceAccountKeys(mustServiceAccountKeyBundle(t, keys[1], keys[0]), true)
+
+			setServiceAccountKeys(keys[1], nil)
+			assertServiceAccountKeys(keys[1], false)
+
+			// the second rotation must not leave the key of the first rotation accepted
+			setServiceAccountKeys(keys[2], keys[1])
+			assertServiceAccountKeys(mustServiceAccountKeyBundle(t, keys[2], keys[1]), true)
+
+			setServiceAccountKeys(keys[2], nil)
+			assertServiceAccountKeys(keys[2], false)
+		},
+	)
+}
+
+func mustServiceAccountKeyBundle(t *testing.T, key, extraKey []byte) []byte {
+	bundle, err := secretrotation.ServiceAccountKeyBundle(key, extraKey)
+	require.NoError(t, err)
+
+	return bundle
+}
@@ -178,9 +178,11 @@ func NewClusterMachineStatusController() *ClusterMachineStatusController {
 				if clusterMachineConfigStatus != nil && clusterMachineConfig != nil {
 					cmsVal.ConfigUpToDate = !isOutdated(pendingMachineUpdate, clusterMachineConfigStatus)
 					cmsVal.LastConfigError = clusterMachineConfigStatus.TypedSpec().Value.LastConfigError
+					cmsVal.PendingReason = clusterMachineConfigStatus.TypedSpec().Value.PendingReason
 				} else {
 					cmsVal.ConfigUpToDate = false
 					cmsVal.LastConfigError = ""
+					cmsVal.PendingReason = ""
 				}
 
 				cmsVal.ConfigApplyStatus = configApplyStatus(cmsVal)
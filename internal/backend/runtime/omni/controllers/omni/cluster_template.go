@@ -0,0 +1,315 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/xerrors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/template"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/helpers"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/mappers"
+)
+
+// ClusterTemplateControllerName is the name of the ClusterTemplateController.
+const ClusterTemplateControllerName = "ClusterTemplateController"
+
+// clusterTemplateRequeueInterval is the interval to retry the failed sync of the cluster template.
+const clusterTemplateRequeueInterval = time.Minute
+
+// ClusterTemplateController continuously syncs the cluster templates stored in Omni into the cluster resources.
+type ClusterTemplateController = qtransform.QController[*omni.ClusterTemplate, *omni.ClusterTemplateStatus]
+
+// NewClusterTemplateController instantiates the ClusterTemplateController.
+//
+// The resources of the templates are run through the validator on each sync before they are written.
+func NewClusterTemplateController(validator ResourceValidator) *ClusterTemplateController {
+	h := &clusterTemplateHandler{
+		validator: validator,
+	}
+
+	return qtransform.NewQController(
+		qtransform.Settings[*omni.ClusterTemplate, *omni.ClusterTemplateStatus]{
+			Name: ClusterTemplateControllerName,
+			MapMetadataFunc: func(clusterTemplate *omni.ClusterTemplate) *omni.ClusterTemplateStatus {
+				return omni.NewClusterTemplateStatus(clusterTemplate.Metadata().ID())
+			},
+			UnmapMetadataFunc: func(status *omni.ClusterTemplateStatus) *omni.ClusterTemplate {
+				return omni.NewClusterTemplate(status.Metadata().ID())
+			},
+			TransformExtraOutputFunc:        h.reconcileRunning,
+			FinalizerRemovalExtraOutputFunc: h.reconcileTearingDown,
+		},
+		qtransform.WithExtraMappedInput[*omni.Cluster](
+			qtransform.MapperSameID[*omni.ClusterTemplate](),
+		),
+		qtransform.WithExtraMappedInput[*omni.MachineSet](
+			mappers.MapByClusterLabel[*omni.ClusterTemplate](),
+		),
+		qtransform.WithExtraMappedInput[*omni.MachineSetNode](
+			mappers.MapByClusterLabel[*omni.ClusterTemplate](),
+		),
+		qtransform.WithExtraMappedInput[*omni.ConfigPatch](
+			mappers.MapByClusterLabel[*omni.ClusterTemplate](),
+		),
+		qtransform.WithExtraMappedInput[*omni.ExtensionsConfiguration](
+			mappers.MapByClusterLabel[*omni.ClusterTemplate](),
+		),
+		qtransform.WithExtraMappedInput[*omni.KubernetesManifestGroup](
+			mappers.MapByClusterLabel[*omni.ClusterTemplate](),
+		),
+		qtransform.WithExtraMappedInput[*omni.KubernetesHealthCheck](
+			mappers.MapByClusterLabel[*omni.ClusterTemplate](),
+		),
+		qtransform.WithExtraOutputs(
+			controller.Output{Type: omni.ClusterType, Kind: controller.OutputShared},
+			controller.Output{Type: omni.MachineSetType, Kind: controller.OutputShared},
+			controller.Output{Type: omni.MachineSetNodeType, Kind: controller.OutputShared},
+			controller.Output{Type: omni.ConfigPatchType, Kind: controller.OutputShared},
+			controller.Output{Type: omni.ExtensionsConfigurationType, Kind: controller.OutputShared},
+			controller.Output{Type: omni.KubernetesManifestGroupType, Kind: controller.OutputShared},
+			controller.Output{Type: omni.KubernetesHealthCheckType, Kind: controller.OutputShared},
+			controller.Output{Type: omni.KernelArgsType, Kind: controller.OutputShared},
+			controller.Output{Type: omni.MachineInstallDiskConfigType, Kind: controller.OutputShared},
+		),
+		qtransform.WithConcurrency(4),
+	)
+}
+
+type clusterTemplateHandler struct {
+	validator ResourceValidator
+}
+
+func (h *clusterTemplateHandler) reconcileRunning(ctx context.Context, r controller.ReaderWriter, logger *zap.Logger, clusterTemplate *omni.ClusterTemplate,
+	status *omni.ClusterTemplateStatus,
+) error {
+	spec := status.TypedSpec().Value
+	templateVersion := clusterTemplate.Metadata().Version().String()
+
+	tmpl, err := loadClusterTemplate(clusterTemplate)
+	if err != nil {
+		setClusterTemplateFailed(spec, templateVersion, err)
+
+		return nil
+	}
+
+	if spec.Cluster == "" {
+		// the cluster was never synced from the template, so an existing cluster with the same name belongs to someone else
+		var cluster *omni.Cluster
+
+		cluster, err = safe.ReaderGetByID[*omni.Cluster](ctx, r, clusterTemplate.Metadata().ID())
+		if err != nil && !state.IsNotFoundError(err) {
+			return err
+		}
+
+		if cluster != nil {
+			setClusterTemplateFailed(spec, templateVersion, fmt.Errorf("cluster %q already exists and is not managed by the template", cluster.Metadata().ID()))
+
+			return nil
+		}
+	}
+
+	expected, err := tmpl.Translate()
+	if err != nil {
+		setClusterTemplateFailed(spec, templateVersion, err)
+
+		return nil
+	}
+
+	syncResult, err := tmpl.Sync(ctx, r)
+	if err != nil {
+		setClusterTemplateFailed(spec, templateVersion, err)
+
+		return controller.NewRequeueError(err, clusterTemplateRequeueInterval)
+	}
+
+	changed := changedResources(syncResult)
+
+	// the template wasn't changed since the last sync, so the changes were made to the cluster resources directly
+	if len(changed) > 0 && templateVersion == spec.TemplateVersion && spec.Phase == specs.ClusterTemplateStatusSpec_SYNCED {
+		logger.Info("cluster resources drifted from the template", zap.Strings("resources", changed))
+
+		spec.DriftedResources = changed
+		spec.LastDrift = timestamppb.Now()
+	}
+
+	spec.Cluster = clusterTemplate.Metadata().ID()
+	spec.TemplateVersion = templateVersion
+	spec.Resources = uint32(len(expected))
+
+	done, err := h.applyClusterTemplateSync(ctx, r, syncResult)
+	if err != nil {
+		spec.Phase = specs.ClusterTemplateStatusSpec_FAILED
+		spec.Error = err.Error()
+
+		return controller.NewRequeueError(err, clusterTemplateRequeueInterval)
+	}
+
+	spec.Error = ""
+
+	if !done {
+		spec.Phase = specs.ClusterTemplateStatusSpec_SYNCING
+
+		return nil
+	}
+
+	spec.Phase = specs.ClusterTemplateStatusSpec_SYNCED
+
+	return nil
+}
+
+func (h *clusterTemplateHandler) reconcileTearingDown(ctx context.Context, r controller.ReaderWriter, _ *zap.Logger, clusterTemplate *omni.ClusterTemplate) error {
+	status, err := safe.ReaderGetByID[*omni.ClusterTemplateStatus](ctx, r, clusterTemplate.Metadata().ID())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	// the template was never synced, the cluster with the same name (if any) doesn't belong to it
+	if status.TypedSpec().Value.Cluster == "" {
+		return nil
+	}
+
+	syncResult, err := template.WithCluster(status.TypedSpec().Value.Cluster).Delete(ctx, r)
+	if err != nil {
+		return err
+	}
+
+	done, err := h.applyClusterTemplateSync(ctx, r, syncResult)
+	if err != nil {
+		return err
+	}
+
+	if !done {
+		return xerrors.NewTaggedf[qtransform.SkipReconcileTag]("the cluster %q is still being destroyed", status.TypedSpec().Value.Cluster)
+	}
+
+	return nil
+}
+
+// loadClusterTemplate loads and validates the template stored in the ClusterTemplate resource.
+func loadClusterTemplate(clusterTemplate *omni.ClusterTemplate) (*template.Template, error) {
+	tmpl, err := template.Load(
+		strings.NewReader(clusterTemplate.TypedSpec().Value.Template),
+		template.WithoutFileAccess(),
+		template.WithStringValues(clusterTemplate.TypedSpec().Value.Values),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tmpl.Validate(); err != nil {
+		return nil, err
+	}
+
+	clusterName, err := tmpl.ClusterName()
+	if err != nil {
+		return nil, err
+	}
+
+	if clusterName != clusterTemplate.Metadata().ID() {
+		return nil, fmt.Errorf("the template defines the cluster %q, but the cluster template is %q", clusterName, clusterTemplate.Metadata().ID())
+	}
+
+	return tmpl, nil
+}
+
+func setClusterTemplateFailed(spec *specs.ClusterTemplateStatusSpec, templateVersion string, err error) {
+	spec.Phase = specs.ClusterTemplateStatusSpec_FAILED
+	spec.Error = err.Error()
+	spec.TemplateVersion = templateVersion
+}
+
+// changedResources returns the resources which are going to be changed by the sync.
+func changedResources(syncResult *template.SyncResult) []string {
+	var changed []string
+
+	for _, res := range syncResult.Create {
+		changed = append(changed, resource.String(res))
+	}
+
+	for _, update := range syncResult.Update {
+		changed = append(changed, resource.String(update.New))
+	}
+
+	for _, phase := range syncResult.Destroy {
+		for _, res := range phase {
+			changed = append(changed, resource.String(res))
+		}
+	}
+
+	slices.Sort(changed)
+
+	return changed
+}
+
+// applyClusterTemplateSync applies the sync result to the state.
+//
+// The resources are not owned by the controller, so that they can be managed by the templates synced with omnictl as well.
+// The state the controller writes to is not validated, so each resource is validated right before it is written, on every sync.
+// It returns false if some of the resources are still being torn down.
+func (h *clusterTemplateHandler) applyClusterTemplateSync(ctx context.Context, r controller.ReaderWriter, syncResult *template.SyncResult) (bool, error) {
+	for _, res := range syncResult.Create {
+		if err := h.validator.Validate(ctx, res); err != nil {
+			return false, fmt.Errorf("invalid %s: %w", resource.String(res), err)
+		}
+
+		if err := r.Create(ctx, res, controller.WithCreateNoOwner()); err != nil {
+			return false, fmt.Errorf("failed to create %s: %w", resource.String(res), err)
+		}
+	}
+
+	for _, update := range syncResult.Update {
+		if err := h.validator.Validate(ctx, update.New); err != nil {
+			return false, fmt.Errorf("invalid %s: %w", resource.String(update.New), err)
+		}
+
+		if err := updateOwnerless(ctx, r, update.New); err != nil {
+			return false, fmt.Errorf("failed to update %s: %w", resource.String(update.New), err)
+		}
+	}
+
+	// the phases are destroyed in order: the machine sets should be gone before the cluster is torn down
+	for _, phase := range syncResult.Destroy {
+		if len(phase) == 0 {
+			continue
+		}
+
+		pointers := make([]resource.Pointer, 0, len(phase))
+
+		for _, res := range phase {
+			pointers = append(pointers, res.Metadata())
+		}
+
+		destroyed, err := helpers.TeardownAndDestroyAll(ctx, r, slices.Values(pointers), controller.WithOwner(""))
+		if err != nil {
+			return false, err
+		}
+
+		if !destroyed {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
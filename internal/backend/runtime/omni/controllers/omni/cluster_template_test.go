@@ -0,0 +1,234 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/testutils"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/validated"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/validations"
+)
+
+const clusterTemplateDocument = `kind: Variables
+variables:
+  clusterName:
+    type: string
+  talosVersion:
+    type: string
+    default: v1.7.0
+---
+kind: Cluster
+name: ${{ clusterName }}
+kubernetes:
+  version: v1.30.1
+talos:
+  version: ${{ talosVersion }}
+---
+kind: ControlPlane
+machines:
+  - 430d882a-51a8-48b3-ae00-90c5b0b5b0b0
+---
+kind: Workers
+machines:
+  - 430d882a-51a8-48b3-ab00-d4b5b0b5b0b0
+`
+
+func TestClusterTemplateController(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 30*time.Second)
+	t.Cleanup(cancel)
+
+	testutils.WithRuntime(
+		ctx, t, testutils.TestOptions{},
+		func(_ context.Context, tc testutils.TestContext) {
+			require.NoError(t, tc.Runtime.RegisterQController(omnictrl.NewClusterTemplateController(validations.NewDryRunValidator(tc.State))))
+		},
+		func(ctx context.Context, tc testutils.TestContext) {
+			clusterTemplate := omni.NewClusterTemplate("templated")
+			clusterTemplate.TypedSpec().Value.Template = clusterTemplateDocument
+			clusterTemplate.TypedSpec().Value.Values = map[string]string{"clusterName": "templated"}
+
+			require.NoError(t, tc.State.Create(ctx, clusterTemplate))
+
+			rtestutils.AssertResource(ctx, t, tc.State, "templated", func(res *omni.ClusterTemplateStatus, assertion *assert.Assertions) {
+				assertion.Equal(specs.ClusterTemplateStatusSpec_SYNCED, res.TypedSpec().Value.Phase)
+				assertion.Empty(res.TypedSpec().Value.Error)
+				assertion.Equal("templated", res.TypedSpec().Value.Cluster)
+				assertion.Empty(res.TypedSpec().Value.DriftedResources)
+			})
+
+			rtestutils.AssertResource(ctx, t, tc.State, "templated", func(res *omni.Cluster, assertion *assert.Assertions) {
+				assertion.Equal("1.7.0", res.TypedSpec().Value.TalosVersion)
+				assertion.Empty(res.Metadata().Owner())
+			})
+
+			rtestutils.AssertResources(ctx, t, tc.State, []string{"templated-control-planes", "templated-workers"}, func(*omni.MachineSet, *assert.Assertions) {})
+
+			// the changes made to the cluster directly are reported as a drift and reverted
+			_, err := safe.StateUpdateWithConflicts(ctx, tc.State, omni.NewCluster("templated").Metadata(), func(res *omni.Cluster) error {
+				res.TypedSpec().Value.TalosVersion = "1.7.1"
+
+				return nil
+			})
+			require.NoError(t, err)
+
+			rtestutils.AssertResource(ctx, t, tc.State, "templated", func(res *omni.ClusterTemplateStatus, assertion *assert.Assertions) {
+				assertion.Equal([]string{"Clusters.omni.sidero.dev(default/templated)"}, res.TypedSpec().Value.DriftedResources)
+				assertion.NotNil(res.TypedSpec().Value.LastDrift)
+			})
+
+			rtestutils.AssertResource(ctx, t, tc.State, "templated", func(res *omni.Cluster, assertion *assert.Assertions) {
+				assertion.Equal("1.7.0", res.TypedSpec().Value.TalosVersion)
+			})
+
+			// the changes of the template are applied
+			_, err = safe.StateUpdateWithConflicts(ctx, tc.State, clusterTemplate.Metadata(), func(res *omni.ClusterTemplate) error {
+				res.TypedSpec().Value.Values["talosVersion"] = "v1.8.0"
+
+				return nil
+			})
+			require.NoError(t, err)
+
+			rtestutils.AssertResource(ctx, t, tc.State, "templated", func(res *omni.Cluster, assertion *assert.Assertions) {
+				assertion.Equal("1.8.0", res.TypedSpec().Value.TalosVersion)
+			})
+
+			// the invalid template is reported, and the cluster is left as is
+			_, err = safe.StateUpdateWithConflicts(ctx, tc.State, clusterTemplate.Metadata(), func(res *omni.ClusterTemplate) error {
+				res.TypedSpec().Value.Values["clusterName"] = "other"
+
+				return nil
+			})
+			require.NoError(t, err)
+
+			rtestutils.AssertResource(ctx, t, tc.State, "templated", func(res *omni.ClusterTemplateStatus, assertion *assert.Assertions) {
+				assertion.Equal(specs.ClusterTemplateStatusSpec_FAILED, res.TypedSpec().Value.Phase)
+				assertion.Contains(res.TypedSpec().Value.Error, `the template defines the cluster "other"`)
+			})
+
+			rtestutils.AssertResources(ctx, t, tc.State, []string{"templated"}, func(*omni.Cluster, *assert.Assertions) {})
+
+			// the cluster is removed with the template
+			rtestutils.Destroy[*omni.ClusterTemplate](ctx, t, tc.State, []string{"templated"})
+
+			rtestutils.AssertNoResource[*omni.Cluster](ctx, t, tc.State, "templated")
+			rtestutils.AssertNoResource[*omni.MachineSet](ctx, t, tc.State, "templated-workers")
+			rtestutils.AssertNoResource[*omni.MachineSetNode](ctx, t, tc.State, "430d882a-51a8-48b3-ab00-d4b5b0b5b0b0")
+		},
+	)
+}
+
+func TestClusterTemplateControllerExistingCluster(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 30*time.Second)
+	t.Cleanup(cancel)
+
+	testutils.WithRuntime(
+		ctx, t, testutils.TestOptions{},
+		func(_ context.Context, tc testutils.TestContext) {
+			require.NoError(t, tc.Runtime.RegisterQController(omnictrl.NewClusterTemplateController(validations.NewDryRunValidator(tc.State))))
+		},
+		func(ctx context.Context, tc testutils.TestContext) {
+			require.NoError(t, tc.State.Create(ctx, omni.NewCluster("existing")))
+
+			clusterTemplate := omni.NewClusterTemplate("existing")
+			clusterTemplate.TypedSpec().Value.Template = clusterTemplateDocument
+			clusterTemplate.TypedSpec().Value.Values = map[string]string{"clusterName": "existing"}
+
+			require.NoError(t, tc.State.Create(ctx, clusterTemplate))
+
+			rtestutils.AssertResource(ctx, t, tc.State, "existing", func(res *omni.ClusterTemplateStatus, assertion *assert.Assertions) {
+				assertion.Equal(specs.ClusterTemplateStatusSpec_FAILED, res.TypedSpec().Value.Phase)
+				assertion.Contains(res.TypedSpec().Value.Error, "is not managed by the template")
+			})
+
+			// the cluster which doesn't belong to the template is kept
+			rtestutils.Destroy[*omni.ClusterTemplate](ctx, t, tc.State, []string{"existing"})
+
+			rtestutils.AssertResources(ctx, t, tc.State, []string{"existing"}, func(*omni.Cluster, *assert.Assertions) {})
+		},
+	)
+}
+
+func TestClusterTemplateControllerValidation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 30*time.Second)
+	t.Cleanup(cancel)
+
+	testutils.WithRuntime(
+		ctx, t, testutils.TestOptions{},
+		func(_ context.Context, tc testutils.TestContext) {
+			validator := validations.NewDryRunValidator(tc.State, validated.WithUpdateValidations(validated.NewUpdateValidationForType(
+				func(_ context.Context, oldRes, res *omni.Cluster, _ ...state.UpdateOption) error {
+					if oldRes.TypedSpec().Value.TalosVersion == "1.9.0" && res.TypedSpec().Value.TalosVersion != "1.9.0" {
+						return errors.New("downgrading Talos is not allowed")
+					}
+
+					return nil
+				},
+			)))
+
+			require.NoError(t, tc.Runtime.RegisterQController(omnictrl.NewClusterTemplateController(validator)))
+		},
+		func(ctx context.Context, tc testutils.TestContext) {
+			clusterTemplate := omni.NewClusterTemplate("validated")
+			clusterTemplate.TypedSpec().Value.Template = clusterTemplateDocument
+			clusterTemplate.TypedSpec().Value.Values = map[string]string{"clusterName": "validated"}
+
+			require.NoError(t, tc.State.Create(ctx, clusterTemplate))
+
+			rtestutils.AssertResource(ctx, t, tc.State, "validated", func(res *omni.ClusterTemplateStatus, assertion *assert.Assertions) {
+				assertion.Equal(specs.ClusterTemplateStatusSpec_SYNCED, res.TypedSpec().Value.Phase)
+			})
+
+			// the drift is reverted only if the revert passes the validations
+			_, err := safe.StateUpdateWithConflicts(ctx, tc.State, omni.NewCluster("validated").Metadata(), func(res *omni.Cluster) error {
+				res.TypedSpec().Value.TalosVersion = "1.9.0"
+
+				return nil
+			})
+			require.NoError(t, err)
+
+			rtestutils.AssertResource(ctx, t, tc.State, "validated", func(res *omni.ClusterTemplateStatus, assertion *assert.Assertions) {
+				assertion.Equal(specs.ClusterTemplateStatusSpec_FAILED, res.TypedSpec().Value.Phase)
+				assertion.Contains(res.TypedSpec().Value.Error, "downgrading Talos is not allowed")
+			})
+
+			rtestutils.AssertResource(ctx, t, tc.State, "validated", func(res *omni.Cluster, assertion *assert.Assertions) {
+				assertion.Equal("1.9.0", res.TypedSpec().Value.TalosVersion)
+			})
+
+			// the template is synced again once it passes the validations
+			_, err = safe.StateUpdateWithConflicts(ctx, tc.State, clusterTemplate.Metadata(), func(res *omni.ClusterTemplate) error {
+				res.TypedSpec().Value.Values["talosVersion"] = "v1.9.0"
+
+				return nil
+			})
+			require.NoError(t, err)
+
+			rtestutils.AssertResource(ctx, t, tc.State, "validated", func(res *omni.ClusterTemplateStatus, assertion *assert.Assertions) {
+				assertion.Equal(specs.ClusterTemplateStatusSpec_SYNCED, res.TypedSpec().Value.Phase)
+				assertion.Empty(res.TypedSpec().Value.Error)
+			})
+		},
+	)
+}
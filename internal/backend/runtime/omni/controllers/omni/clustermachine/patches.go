@@ -8,6 +8,7 @@ package clustermachine
 import (
 	"context"
 	"errors"
+	"fmt"
 	"slices"
 
 	"github.com/cosi-project/runtime/pkg/controller"
@@ -16,12 +17,14 @@ import (
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/siderolabs/gen/xiter"
+	"github.com/siderolabs/gen/xslices"
 	"go.uber.org/zap"
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/helpers"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/layeredresource"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/mappers"
 )
 
 type ConfigPatchesController struct {
@@ -77,6 +80,18 @@ func NewConfigPatchesController() *ConfigPatchesController {
 				return slices.Collect(res.Pointers()), err
 			},
 		),
+		qtransform.WithExtraMappedInput[*omni.MachineSet](
+			mappers.MapMachineSetToLabeledResources[*omni.ClusterMachine](),
+		),
+		qtransform.WithExtraMappedInput[*omni.UpgradeRollout](
+			mappers.MapClusterResourceToLabeledResources[*omni.ClusterMachine](),
+		),
+		qtransform.WithExtraMappedInput[*omni.ClusterMachineConfigStatus](
+			qtransform.MapperSameID[*omni.ClusterMachine](),
+		),
+		qtransform.WithExtraMappedInput[*omni.MachinePendingUpdates](
+			qtransform.MapperSameID[*omni.ClusterMachine](),
+		),
 	)
 
 	return ctrl
@@ -100,17 +115,119 @@ func (ctrl *ConfigPatchesController) transform(ctx context.Context, r controller
 		return err
 	}
 
+	machineSet, err := safe.ReaderGetByID[*omni.MachineSet](ctx, r, machineSetName)
+	if err != nil && !state.IsNotFoundError(err) {
+		return err
+	}
+
+	canaryUpdates := machineSet != nil && machineSet.TypedSpec().Value.UpdateStrategy == specs.MachineSetSpec_Canary
+
+	restore, err := ctrl.restoreCanary(ctx, r, clusterMachine, machineSetName)
+	if err != nil {
+		return err
+	}
+
+	versions := xslices.Map(configPatches, func(patch *omni.ConfigPatch) string {
+		return fmt.Sprintf("%s/%s@%s", patch.Metadata().Type(), patch.Metadata().ID(), patch.Metadata().Version())
+	})
+
+	if canaryUpdates {
+		versions = append(versions, "canary")
+	}
+
+	if restore {
+		versions = append(versions, "restore")
+	}
+
 	// update ClusterMachineConfigPatches resource with the list of matching patches for the machine
 	// nothing changed in the patch list, skip any updates
-	if !helpers.UpdateInputsVersions(clusterMachineConfigPatches, configPatches...) {
+	if !helpers.UpdateInputsAnnotation(clusterMachineConfigPatches, versions...) {
 		return nil
 	}
 
 	helpers.CopyAllLabels(clusterMachine, clusterMachineConfigPatches)
 
+	spec := clusterMachineConfigPatches.TypedSpec().Value
+
+	if !canaryUpdates {
+		spec.Previous = nil
+
+		return setPatches(clusterMachineConfigPatches, configPatches)
+	}
+
+	// the failed canary is pinned to the patches it ran before the rollout, until the rollout is started over
+	if restore && spec.Previous != nil {
+		spec.Patches = spec.Previous.Patches                     //nolint:staticcheck
+		spec.CompressedPatches = spec.Previous.CompressedPatches //nolint:staticcheck
+
+		return nil
+	}
+
+	inSync, err := ctrl.configInSync(ctx, r, clusterMachine)
+	if err != nil {
+		return err
+	}
+
+	// keep the patches the machine runs, the ones set while a change is still pending were never applied
+	if inSync {
+		spec.Previous = &specs.ClusterMachineConfigPatchesSpec{
+			Patches:           spec.Patches,           //nolint:staticcheck
+			CompressedPatches: spec.CompressedPatches, //nolint:staticcheck
+		}
+	}
+
 	return setPatches(clusterMachineConfigPatches, configPatches)
 }
 
+// restoreCanary reports whether the machine is a canary of the failed config update rollout of its machine set, which has the
+// previous patches to restore.
+func (ctrl *ConfigPatchesController) restoreCanary(ctx context.Context, r controller.Reader, clusterMachine *omni.ClusterMachine, machineSetName string) (bool, error) {
+	clusterName, ok := clusterMachine.Metadata().Labels().Get(omni.LabelCluster)
+	if !ok {
+		return false, nil
+	}
+
+	rollout, err := safe.ReaderGetByID[*omni.UpgradeRollout](ctx, r, clusterName)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	canary := rollout.TypedSpec().Value.MachineSetsUpdateCanary[machineSetName]
+
+	return canary.GetPhase() == specs.CanaryRolloutStatus_Failed && slices.Contains(canary.GetRollbackConfigMachines(), clusterMachine.Metadata().ID()), nil
+}
+
+// configInSync reports whether the machine was configured and has no pending config changes, so it runs the current patches.
+func (ctrl *ConfigPatchesController) configInSync(ctx context.Context, r controller.Reader, clusterMachine *omni.ClusterMachine) (bool, error) {
+	configStatus, err := safe.ReaderGetByID[*omni.ClusterMachineConfigStatus](ctx, r, clusterMachine.Metadata().ID())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	if configStatus.TypedSpec().Value.ClusterMachineConfigSha256 == "" {
+		return false, nil
+	}
+
+	pendingUpdates, err := safe.ReaderGetByID[*omni.MachinePendingUpdates](ctx, r, clusterMachine.Metadata().ID())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return true, nil
+		}
+
+		return false, err
+	}
+
+	return !pendingUpdates.TypedSpec().Value.HasConfigDiff(), nil
+}
+
 func mapToMachineID(ctx context.Context, r controller.QRuntime, res controller.ReducedResourceMetadata, label string) ([]resource.Pointer, error) {
 	id, ok := res.Labels().Get(label)
 	if !ok {
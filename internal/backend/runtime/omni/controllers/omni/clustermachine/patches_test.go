@@ -6,14 +6,21 @@
 package clustermachine_test
 
 import (
+	"context"
 	"slices"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/clustermachine"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/testutils"
 )
 
 func TestFromConfigPatches(t *testing.T) {
@@ -92,3 +99,95 @@ func TestFromConfigPatches(t *testing.T) {
 		assert.Equal(t, "small-patch-2", target.GetPatches()[1])
 	})
 }
+
+func TestConfigPatchesCanaryRestore(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	assertPatches := func(ctx context.Context, t *testing.T, testContext testutils.TestContext, expected, previous []string) {
+		rtestutils.AssertResource(ctx, t, testContext.State, "m1", func(res *omni.ClusterMachineConfigPatches, assertion *assert.Assertions) {
+			patches, err := res.TypedSpec().Value.GetUncompressedPatches()
+			assertion.NoError(err)
+			assertion.Equal(expected, patches)
+
+			if previous == nil {
+				assertion.Nil(res.TypedSpec().Value.GetPrevious())
+
+				return
+			}
+
+			previousPatches, err := res.TypedSpec().Value.GetPrevious().GetUncompressedPatches()
+			assertion.NoError(err)
+			assertion.Equal(previous, previousPatches)
+		})
+	}
+
+	testutils.WithRuntime(ctx, t, testutils.TestOptions{},
+		func(_ context.Context, testContext testutils.TestContext) {
+			require.NoError(t, testContext.Runtime.RegisterQController(clustermachine.NewConfigPatchesController()))
+		},
+		func(ctx context.Context, testContext testutils.TestContext) {
+			st := testContext.State
+
+			machineSet := omni.NewMachineSet("ms")
+			machineSet.Metadata().Labels().Set(omni.LabelCluster, "cluster")
+			machineSet.TypedSpec().Value.UpdateStrategy = specs.MachineSetSpec_Canary
+
+			require.NoError(t, st.Create(ctx, machineSet))
+
+			clusterMachine := omni.NewClusterMachine("m1")
+			clusterMachine.Metadata().Labels().Set(omni.LabelCluster, "cluster")
+			clusterMachine.Metadata().Labels().Set(omni.LabelMachineSet, "ms")
+
+			require.NoError(t, st.Create(ctx, clusterMachine))
+
+			patch := omni.NewConfigPatch("patch")
+			patch.Metadata().Labels().Set(omni.LabelCluster, "cluster")
+			patch.Metadata().Labels().Set(omni.LabelMachineSet, "ms")
+			require.NoError(t, patch.TypedSpec().Value.SetUncompressedData([]byte("old")))
+
+			require.NoError(t, st.Create(ctx, patch))
+
+			// the machine was not configured yet, so there is nothing to restore
+			assertPatches(ctx, t, testContext, []string{"old"}, nil)
+
+			configStatus := omni.NewClusterMachineConfigStatus("m1")
+			configStatus.TypedSpec().Value.ClusterMachineConfigSha256 = "sha"
+
+			require.NoError(t, st.Create(ctx, configStatus))
+
+			_, err := safe.StateUpdateWithConflicts(ctx, st, patch.Metadata(), func(res *omni.ConfigPatch) error {
+				return res.TypedSpec().Value.SetUncompressedData([]byte("new"))
+			})
+			require.NoError(t, err)
+
+			assertPatches(ctx, t, testContext, []string{"new"}, []string{"old"})
+
+			// the machine is a canary of the failed rollout, its previous patches are restored
+			rollout := omni.NewUpgradeRollout("cluster")
+			rollout.TypedSpec().Value.MachineSetsUpdateCanary = map[string]*specs.CanaryRolloutStatus{
+				"ms": {
+					Phase:                  specs.CanaryRolloutStatus_Failed,
+					Machines:               []string{"m1"},
+					RollbackConfigMachines: []string{"m1"},
+				},
+			}
+
+			require.NoError(t, st.Create(ctx, rollout))
+
+			assertPatches(ctx, t, testContext, []string{"old"}, []string{"old"})
+
+			// the rollout is started over, the canary gets the current patches again
+			_, err = safe.StateUpdateWithConflicts(ctx, st, rollout.Metadata(), func(res *omni.UpgradeRollout) error {
+				res.TypedSpec().Value.MachineSetsUpdateCanary["ms"].Phase = specs.CanaryRolloutStatus_Updating
+
+				return nil
+			})
+			require.NoError(t, err)
+
+			assertPatches(ctx, t, testContext, []string{"new"}, []string{"old"})
+		},
+	)
+}
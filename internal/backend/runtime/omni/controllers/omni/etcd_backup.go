@@ -51,8 +51,14 @@ type EtcdBackupController struct {
 type EtcdBackupControllerSettings struct {
 	ClientMaker  ClientMaker
 	StoreFactory store.Factory
-	TickInterval time.Duration // can be 0 in which case StoreFactory should be [store.DisabledStoreFactory]
-	Jitter       time.Duration // can be 0 in which case jitter will be disabled
+	TickInterval time.Duration     // can be 0 in which case StoreFactory should be [store.DisabledStoreFactory]
+	Auditor      EtcdBackupAuditor // can be nil in which case pruned backups are not audited
+	Jitter       time.Duration     // can be 0 in which case jitter will be disabled
+}
+
+// EtcdBackupAuditor records the backups removed by the retention policy.
+type EtcdBackupAuditor interface {
+	AuditEtcdBackupPrune(ctx context.Context, clusterID, snapshot string, timestamp time.Time) error
 }
 
 // ClientMaker is a function that creates Talos client.
@@ -175,6 +181,7 @@ func (ctrl *EtcdBackupController) run(ctx context.Context, r controller.Runtime,
 		time      time.Time
 		err       error
 		clusterID string
+		prune     pruneResult
 	}
 
 	backupResultCh := make(chan result, len(backupDataList))
@@ -188,10 +195,17 @@ func (ctrl *EtcdBackupController) run(ctx context.Context, r controller.Runtime,
 
 			backupErr := ctrl.doBackup(localCtx, backupData, clusterLogger)
 
+			var prune pruneResult
+
+			if backupErr == nil {
+				prune.pruned, prune.err = ctrl.prune(localCtx, backupData, clusterLogger)
+			}
+
 			backupResultCh <- result{
 				clusterID: backupData.Metadata().ID(),
 				time:      time.Now(),
 				err:       backupErr,
+				prune:     prune,
 			}
 
 			return nil
@@ -206,7 +220,7 @@ func (ctrl *EtcdBackupController) run(ctx context.Context, r controller.Runtime,
 	close(backupResultCh)
 
 	for backupResultData := range backupResultCh {
-		ctrl.updateBackupStatus(ctx, r, backupResultData.clusterID, backupResultData.time, backupResultData.err, logger)
+		ctrl.updateBackupStatus(ctx, r, backupResultData.clusterID, backupResultData.time, backupResultData.err, backupResultData.prune, logger)
 	}
 
 	return nil
@@ -275,7 +289,7 @@ func (ctrl *EtcdBackupController) findClustersToBackup(ctx context.Context, r co
 
 		latestBackupTime, err := ctrl.latestBackupTime(ctx, backupData.TypedSpec().Value.ClusterUuid)
 		if err != nil {
-			ctrl.updateBackupStatus(ctx, r, clusterID, time.Now(), err, logger)
+			ctrl.updateBackupStatus(ctx, r, clusterID, time.Now(), err, pruneResult{}, logger)
 
 			return nil, fmt.Errorf("failed to get latest backup time for cluster %q: %w", clusterID, err)
 		}
@@ -423,12 +437,81 @@ func (ctrl *EtcdBackupController) doBackup(
 	return nil
 }
 
+type pruneResult struct {
+	err    error
+	pruned []string
+}
+
+// prune removes the backups of the cluster which are not kept by its retention policy.
+func (ctrl *EtcdBackupController) prune(ctx context.Context, backupData *omni.BackupData, logger *zap.Logger) ([]string, error) {
+	retention := backupData.TypedSpec().Value.GetRetention()
+	if !etcdbackup.RetentionEnabled(retention) {
+		return nil, nil
+	}
+
+	clusterID := backupData.Metadata().ID()
+	clusterUUID := backupData.TypedSpec().Value.ClusterUuid
+
+	st, err := ctrl.settings.StoreFactory.GetStore()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get store: %w", err)
+	}
+
+	it, err := st.ListBackups(ctx, clusterUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list backups: %w", err)
+	}
+
+	var backups []etcdbackup.Info
+
+	for v, iterErr := range it {
+		if iterErr != nil {
+			return nil, fmt.Errorf("failed to list backups: %w", iterErr)
+		}
+
+		backups = append(backups, v)
+	}
+
+	var (
+		pruned []string
+		errs   error
+	)
+
+	for _, backup := range etcdbackup.Expired(backups, retention, time.Now()) {
+		if err = st.Delete(ctx, clusterUUID, backup.Snapshot); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("failed to delete snapshot %q: %w", backup.Snapshot, err))
+
+			continue
+		}
+
+		pruned = append(pruned, backup.Snapshot)
+
+		logger.Info(
+			"pruned etcd snapshot",
+			zap.String("cluster_uuid", clusterUUID),
+			zap.String("snapshot_name", backup.Snapshot),
+			zap.Time("ts", backup.Timestamp),
+		)
+
+		if ctrl.settings.Auditor == nil {
+			continue
+		}
+
+		if err = ctrl.settings.Auditor.AuditEtcdBackupPrune(ctx, clusterID, backup.Snapshot, backup.Timestamp); err != nil {
+			logger.Warn("failed to audit pruned etcd snapshot", zap.String("snapshot_name", backup.Snapshot), zap.Error(err))
+		}
+	}
+
+	return pruned, errs
+}
+
 func (ctrl *EtcdBackupController) updateBackupStatus(
 	ctx context.Context,
 	r controller.Runtime,
 	clusterID string,
 	backupTime time.Time,
 	backupErr error,
+	prune pruneResult,
 	logger *zap.Logger,
 ) {
 	if backupErr != nil {
@@ -439,6 +522,14 @@ func (ctrl *EtcdBackupController) updateBackupStatus(
 		)
 	}
 
+	if prune.err != nil {
+		logger.Warn(
+			"failed to prune cluster backups",
+			zap.String("cluster", clusterID),
+			zap.Error(prune.err),
+		)
+	}
+
 	err := safe.WriterModify(
 		ctx,
 		r,
@@ -453,6 +544,12 @@ func (ctrl *EtcdBackupController) updateBackupStatus(
 				status.TypedSpec().Value.Error = ""
 				status.TypedSpec().Value.Status = specs.EtcdBackupStatusSpec_Ok
 				status.TypedSpec().Value.LastBackupTime = timestamppb.New(backupTime)
+				status.TypedSpec().Value.LastPrunedSnapshots = prune.pruned
+				status.TypedSpec().Value.PruneError = ""
+
+				if prune.err != nil {
+					status.TypedSpec().Value.PruneError = prune.err.Error()
+				}
 			}
 
 			return nil
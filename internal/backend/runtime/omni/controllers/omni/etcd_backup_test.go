@@ -14,6 +14,7 @@ import (
 	"net/http/httptest"
 	"slices"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"testing/synctest"
@@ -768,6 +769,104 @@ func testS3Backup(ctx context.Context, t *testing.T, rt *runtime.Runtime, st sta
 	require.EqualValues(t, "Hello World", string(must.Value(io.ReadAll(decrypter))(t)))
 }
 
+func TestEtcdBackupRetention(t *testing.T) {
+	t.Parallel()
+
+	synctest.Test(t, func(t *testing.T) {
+		testutils.WithRuntime(
+			t.Context(),
+			t,
+			testutils.TestOptions{
+				DisableCache: true,
+			},
+			func(_ context.Context, testContext testutils.TestContext) { // prepare - register controllers
+				beforeStart(testContext.State, t, testContext.Runtime)
+			},
+			func(ctx context.Context, testContext testutils.TestContext) {
+				testEtcdBackupRetention(ctx, t, testContext)
+			},
+		)
+	})
+}
+
+func testEtcdBackupRetention(ctx context.Context, t *testing.T, testContext testutils.TestContext) {
+	factory := startFactory(ctx, t, store.NewFileStoreStoreFactory(t.TempDir()), testContext.State, testContext.Logger)
+	auditor := &mockEtcdBackupAuditor{}
+	st := testContext.State
+
+	clustersData := createClusters(ctx, t, []string{"talos-default-retention"}, st, time.Hour)
+	clusterID := clustersData[0].Metadata().ID()
+
+	_, err := safe.StateUpdateWithConflicts(ctx, st, omni.NewCluster(clusterID).Metadata(), func(res *omni.Cluster) error {
+		res.TypedSpec().Value.BackupConfiguration.Retention = &specs.EtcdBackupRetention{KeepLast: 2}
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	rtestutils.AssertResource(ctx, t, st, clusterID, func(r *omni.BackupData, assertion *assert.Assertions) {
+		assertion.EqualValues(2, r.TypedSpec().Value.GetRetention().GetKeepLast())
+	})
+
+	etcdBackupController, err := omnictrl.NewEtcdBackupController(omnictrl.EtcdBackupControllerSettings{
+		ClientMaker: func(context.Context, string) (omnictrl.TalosClient, error) {
+			return &mockTalosClient{}, nil
+		},
+		StoreFactory: factory,
+		TickInterval: 10 * time.Minute,
+		Auditor:      auditor,
+	})
+	require.NoError(t, err)
+	require.NoError(t, testContext.Runtime.RegisterController(etcdBackupController))
+
+	synctest.Wait()
+
+	for range 3 {
+		time.Sleep(time.Hour)
+		synctest.Wait()
+	}
+
+	// 4 backups were taken, only the last 2 are kept
+	backups := findBackups(ctx, t, st, factory, clusterID, 2)
+
+	rtestutils.AssertResource(ctx, t, st, clusterID, func(r *omni.EtcdBackupStatus, assertion *assert.Assertions) {
+		assertion.Equal(specs.EtcdBackupStatusSpec_Ok, r.TypedSpec().Value.Status)
+		assertion.Empty(r.TypedSpec().Value.PruneError)
+
+		if assertion.Len(r.TypedSpec().Value.LastPrunedSnapshots, 1) {
+			assertion.NotContains(
+				xslices.Map(backups, func(info etcdbackup.Info) string { return info.Snapshot }),
+				r.TypedSpec().Value.LastPrunedSnapshots[0],
+			)
+		}
+	})
+
+	assert.Len(t, auditor.getSnapshots(), 2)
+
+	rtestutils.Destroy[*omni.Cluster](ctx, t, st, []string{clusterID})
+}
+
+type mockEtcdBackupAuditor struct {
+	snapshots []string
+	mu        sync.Mutex
+}
+
+func (m *mockEtcdBackupAuditor) AuditEtcdBackupPrune(_ context.Context, _, snapshot string, _ time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.snapshots = append(m.snapshots, snapshot)
+
+	return nil
+}
+
+func (m *mockEtcdBackupAuditor) getSnapshots() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return slices.Clone(m.snapshots)
+}
+
 func TestBackupJitter(t *testing.T) {
 	t.Parallel()
 
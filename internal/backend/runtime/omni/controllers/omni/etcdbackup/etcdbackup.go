@@ -8,12 +8,15 @@ package etcdbackup
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"iter"
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 )
 
 // Lister is an interface that is used to list etcd backups.
@@ -26,6 +29,7 @@ type Store interface {
 	Lister
 	Upload(ctx context.Context, descr Description, r io.Reader) error
 	Download(ctx context.Context, encryptionKey []byte, clusterUUID, snapshotName string) (BackupData, io.ReadCloser, error)
+	Delete(ctx context.Context, clusterUUID, snapshotName string) error
 }
 
 // Description is a data that is used to describe backup.
@@ -76,3 +80,28 @@ func ParseSnapshotName(name string) (time.Time, error) {
 
 	return time.Unix(int64(-reverseTS), 0), nil
 }
+
+// DownloadForRestore downloads the snapshot of the cluster with the given UUID and verifies that it was taken
+// with the same secrets encryption secrets as the ones in the backup data, so that the restored etcd can be decrypted.
+//
+// The caller is responsible for closing the returned reader.
+func DownloadForRestore(ctx context.Context, store Store, backupData *omni.BackupData, clusterUUID, snapshot string) (io.ReadCloser, error) {
+	downloadedBackupData, readCloser, err := store.Download(ctx, backupData.TypedSpec().Value.GetEncryptionKey(), clusterUUID, snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download backup: %w", err)
+	}
+
+	if downloadedBackupData.AESCBCEncryptionSecret != backupData.TypedSpec().Value.GetAesCbcEncryptionSecret() {
+		readCloser.Close() //nolint:errcheck
+
+		return nil, errors.New("aes cbc encryption secret mismatch")
+	}
+
+	if downloadedBackupData.SecretboxEncryptionSecret != backupData.TypedSpec().Value.GetSecretboxEncryptionSecret() {
+		readCloser.Close() //nolint:errcheck
+
+		return nil, errors.New("secretbox encryption secret mismatch")
+	}
+
+	return readCloser, nil
+}
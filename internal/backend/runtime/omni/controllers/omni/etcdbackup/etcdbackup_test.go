@@ -6,12 +6,15 @@
 package etcdbackup_test
 
 import (
+	"slices"
 	"testing"
 	"time"
 
 	"github.com/siderolabs/gen/xtesting/check"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup"
 )
 
@@ -54,3 +57,78 @@ func TestParseSnapshotName(t *testing.T) {
 		})
 	}
 }
+
+func TestExpired(t *testing.T) {
+	t.Parallel()
+
+	// Friday
+	now := time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC)
+
+	// two backups per day for the last 90 days
+	var backups []etcdbackup.Info
+
+	for i := range 180 {
+		timestamp := now.Add(-time.Duration(i) * 12 * time.Hour)
+
+		backups = append(backups, etcdbackup.Info{Timestamp: timestamp, Snapshot: etcdbackup.CreateSnapshotName(timestamp)})
+	}
+
+	kept := func(retention *specs.EtcdBackupRetention) []time.Time {
+		expired := etcdbackup.Expired(backups, retention, now)
+
+		var result []time.Time
+
+		for _, backup := range backups {
+			if !slices.ContainsFunc(expired, func(info etcdbackup.Info) bool { return info.Snapshot == backup.Snapshot }) {
+				result = append(result, backup.Timestamp)
+			}
+		}
+
+		return result
+	}
+
+	assert.Empty(t, etcdbackup.Expired(backups, nil, now))
+	assert.Empty(t, etcdbackup.Expired(backups, &specs.EtcdBackupRetention{}, now))
+
+	day := func(month time.Month, day, hour int) time.Time {
+		return time.Date(2026, month, day, hour, 0, 0, 0, time.UTC)
+	}
+
+	for _, test := range []struct {
+		retention *specs.EtcdBackupRetention
+		name      string
+		expected  []time.Time
+	}{
+		{
+			name:      "keep last",
+			retention: &specs.EtcdBackupRetention{KeepLast: 3},
+			expected:  []time.Time{day(10, 16, 12), day(10, 16, 0), day(10, 15, 12)},
+		},
+		{
+			name:      "keep daily",
+			retention: &specs.EtcdBackupRetention{KeepDaily: 3},
+			expected:  []time.Time{day(10, 16, 12), day(10, 15, 12), day(10, 14, 12)},
+		},
+		{
+			name:      "keep weekly",
+			retention: &specs.EtcdBackupRetention{KeepWeekly: 3},
+			expected:  []time.Time{day(10, 16, 12), day(10, 11, 12), day(10, 4, 12)},
+		},
+		{
+			name:      "keep monthly",
+			retention: &specs.EtcdBackupRetention{KeepMonthly: 3},
+			expected:  []time.Time{day(10, 16, 12), day(9, 30, 12), day(8, 31, 12)},
+		},
+		{
+			name:      "combined",
+			retention: &specs.EtcdBackupRetention{KeepLast: 2, KeepDaily: 2, KeepWeekly: 2, KeepMonthly: 2},
+			expected:  []time.Time{day(10, 16, 12), day(10, 16, 0), day(10, 15, 12), day(10, 11, 12), day(9, 30, 12)},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, kept(test.retention))
+		})
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package etcdbackup
+
+import (
+	"slices"
+	"time"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+)
+
+// RetentionEnabled returns true if the retention policy deletes any backups.
+func RetentionEnabled(retention *specs.EtcdBackupRetention) bool {
+	return retention.GetKeepLast() > 0 ||
+		retention.GetKeepDaily() > 0 ||
+		retention.GetKeepWeekly() > 0 ||
+		retention.GetKeepMonthly() > 0
+}
+
+// Expired returns the backups which are not kept by the retention policy, oldest first.
+//
+// The latest backup of each day, week (starting on Monday) and month is kept for the configured number
+// of the most recent periods, including the current one. Periods are computed in UTC.
+func Expired(backups []Info, retention *specs.EtcdBackupRetention, now time.Time) []Info {
+	if !RetentionEnabled(retention) {
+		return nil
+	}
+
+	sorted := slices.SortedFunc(slices.Values(backups), func(a, b Info) int {
+		return b.Timestamp.Compare(a.Timestamp)
+	})
+
+	keep := make([]bool, len(sorted))
+
+	for i := range min(int(retention.GetKeepLast()), len(sorted)) {
+		keep[i] = true
+	}
+
+	for _, p := range []struct {
+		start func(time.Time) time.Time
+		back  func(time.Time, int) time.Time
+		count uint32
+	}{
+		{start: startOfDay, back: func(t time.Time, n int) time.Time { return t.AddDate(0, 0, -n) }, count: retention.GetKeepDaily()},
+		{start: startOfWeek, back: func(t time.Time, n int) time.Time { return t.AddDate(0, 0, -7*n) }, count: retention.GetKeepWeekly()},
+		{start: startOfMonth, back: func(t time.Time, n int) time.Time { return t.AddDate(0, -n, 0) }, count: retention.GetKeepMonthly()},
+	} {
+		if p.count == 0 {
+			continue
+		}
+
+		cutoff := p.back(p.start(now), int(p.count)-1)
+		seen := map[time.Time]struct{}{}
+
+		for i, backup := range sorted {
+			if backup.Timestamp.Before(cutoff) {
+				break
+			}
+
+			period := p.start(backup.Timestamp)
+
+			if _, ok := seen[period]; ok {
+				continue
+			}
+
+			seen[period] = struct{}{}
+			keep[i] = true
+		}
+	}
+
+	var expired []Info
+
+	for i := len(sorted) - 1; i >= 0; i-- {
+		if !keep[i] {
+			expired = append(expired, sorted[i])
+		}
+	}
+
+	return expired
+}
+
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func startOfWeek(t time.Time) time.Time {
+	day := startOfDay(t)
+
+	// time.Weekday starts on Sunday
+	return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+}
+
+func startOfMonth(t time.Time) time.Time {
+	year, month, _ := t.UTC().Date()
+
+	return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,582 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package selfbackup implements the backup and the restore of Omni's own state.
+//
+// The backup is a tar archive encrypted the same way as the etcd backups of the clusters, with the master key of the
+// default storage. The key storage of the master key is written in front of the encrypted archive, so the backup can
+// be restored by any instance configured with the private key of one of its slots. The archive contains the manifest,
+// the snapshot of the secondary storage SQLite database and every resource of the persistent namespaces of the
+// default storage.
+package selfbackup
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/store"
+	"github.com/cosi-project/state-sqlite/pkg/sqlitexx"
+	"zombiezen.com/go/sqlite/sqlitex"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	resourceregistry "github.com/siderolabs/omni/client/pkg/omni/resources/registry"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/system"
+	"github.com/siderolabs/omni/client/pkg/panichandler"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/etcdbackup/crypt"
+	"github.com/siderolabs/omni/internal/version"
+)
+
+// ClusterUUID is the pseudo cluster UUID the backups of Omni's own state are stored under in the etcd backup store.
+const ClusterUUID = "omni"
+
+const (
+	formatVersion = 2
+
+	manifestName    = "manifest.json"
+	secondaryDBName = "secondary.db"
+	resourcesDir    = "resources"
+
+	// maxKeyStorageSize limits the size of the key storage read in front of the archive.
+	maxKeyStorageSize = 1 << 20
+)
+
+// namespaces are the persistent namespaces of the default storage.
+//
+// The metrics namespace is backed by the secondary storage, which is included in the backup as a whole.
+var namespaces = []resource.Namespace{
+	resources.DefaultNamespace,
+	resources.InfraProviderNamespace,
+}
+
+// Manifest describes the backup.
+type Manifest struct {
+	CreatedAt     time.Time `json:"created_at"`
+	OmniVersion   string    `json:"omni_version"`
+	FormatVersion int       `json:"format_version"`
+	DBVersion     uint64    `json:"db_version"`
+	Resources     int       `json:"resources"`
+}
+
+// Snapshot reads every resource of the namespaces from a single consistent view of the storage.
+type Snapshot func(ctx context.Context, namespaces []resource.Namespace) ([]resource.Resource, error)
+
+// Key is the key the backup is encrypted with.
+type Key struct {
+	// Storage is the marshaled key storage of the master key, the master key is read from it when the backup is restored.
+	Storage []byte
+
+	// MasterKey is the master key of the default storage.
+	MasterKey []byte
+}
+
+// Create writes the encrypted backup of the default storage and the secondary storage database to w.
+//
+// The resources are read from a single snapshot of the default storage. The secondary storage database is copied
+// separately with VACUUM INTO, which is consistent on its own, but not taken at the same moment as the snapshot.
+func Create(ctx context.Context, snapshot Snapshot, db *sqlitexx.Pool, w io.Writer, key Key) (Manifest, error) {
+	return pipe(ctx, snapshot, db, key, func(r io.Reader) error {
+		_, err := io.Copy(w, r)
+
+		return err
+	})
+}
+
+// Upload uploads the backup of the default storage and the secondary storage database to the etcd backup store.
+//
+// The backup is uploaded in the same format [Create] writes it, so the store must not encrypt it again.
+func Upload(ctx context.Context, snapshot Snapshot, db *sqlitexx.Pool, backupStore etcdbackup.Store, key Key) (Manifest, error) {
+	return pipe(ctx, snapshot, db, key, func(r io.Reader) error {
+		return backupStore.Upload(ctx, etcdbackup.Description{
+			Timestamp:   time.Now(),
+			ClusterUUID: ClusterUUID,
+			ClusterName: ClusterUUID,
+		}, r)
+	})
+}
+
+// Latest returns the name of the latest backup in the etcd backup store.
+func Latest(ctx context.Context, backupStore etcdbackup.Store) (string, error) {
+	backups, err := list(ctx, backupStore)
+	if err != nil {
+		return "", err
+	}
+
+	var latest etcdbackup.Info
+
+	for _, backup := range backups {
+		if backup.Timestamp.After(latest.Timestamp) {
+			latest = backup
+		}
+	}
+
+	if latest.Snapshot == "" {
+		return "", errors.New("no backups of Omni's own state found in the store")
+	}
+
+	return latest.Snapshot, nil
+}
+
+// Download downloads the backup from the etcd backup store.
+//
+// As for [Upload], the store must not decrypt the backup.
+func Download(ctx context.Context, backupStore etcdbackup.Store, snapshot string) (io.ReadCloser, error) {
+	_, r, err := backupStore.Download(ctx, nil, ClusterUUID, snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download backup %q: %w", snapshot, err)
+	}
+
+	return r, nil
+}
+
+// Prune deletes the backups in the etcd backup store which are not kept by the retention policy.
+//
+// It returns the deleted backups.
+func Prune(ctx context.Context, backupStore etcdbackup.Store, retention *specs.EtcdBackupRetention, now time.Time) ([]etcdbackup.Info, error) {
+	if !etcdbackup.RetentionEnabled(retention) {
+		return nil, nil
+	}
+
+	backups, err := list(ctx, backupStore)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		pruned []etcdbackup.Info
+		errs   error
+	)
+
+	for _, backup := range etcdbackup.Expired(backups, retention, now) {
+		if err = backupStore.Delete(ctx, ClusterUUID, backup.Snapshot); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("failed to delete backup %q: %w", backup.Snapshot, err))
+
+			continue
+		}
+
+		pruned = append(pruned, backup)
+	}
+
+	return pruned, errs
+}
+
+func list(ctx context.Context, backupStore etcdbackup.Store) ([]etcdbackup.Info, error) {
+	it, err := backupStore.ListBackups(ctx, ClusterUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list backups: %w", err)
+	}
+
+	var backups []etcdbackup.Info
+
+	for backup, iterErr := range it {
+		if iterErr != nil {
+			return nil, fmt.Errorf("failed to list backups: %w", iterErr)
+		}
+
+		backups = append(backups, backup)
+	}
+
+	return backups, nil
+}
+
+// pipe passes the backup to consume: the key storage followed by the encrypted archive.
+func pipe(ctx context.Context, snapshot Snapshot, db *sqlitexx.Pool, key Key, consume func(r io.Reader) error) (Manifest, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	eg, ctx := panichandler.ErrGroupWithContext(ctx)
+	archiveReader, archiveWriter := io.Pipe()
+	reader, writer := io.Pipe()
+
+	var manifest Manifest
+
+	eg.Go(func() error {
+		var err error
+
+		manifest, err = writeArchive(ctx, snapshot, db, archiveWriter)
+
+		archiveWriter.CloseWithError(err)
+
+		return err
+	})
+
+	eg.Go(func() error {
+		err := writeKeyStorage(writer, key.Storage)
+		if err == nil {
+			err = crypt.Encrypt(writer, etcdbackup.EncryptionData{EncryptionKey: key.MasterKey}, archiveReader)
+		}
+
+		archiveReader.CloseWithError(err)
+		writer.CloseWithError(err)
+
+		return err
+	})
+
+	eg.Go(func() error {
+		err := consume(reader)
+
+		reader.CloseWithError(err)
+
+		return err
+	})
+
+	if err := eg.Wait(); err != nil {
+		return Manifest{}, err
+	}
+
+	return manifest, nil
+}
+
+// writeArchive writes the unencrypted tar archive to w.
+func writeArchive(ctx context.Context, snapshot Snapshot, db *sqlitexx.Pool, w io.Writer) (Manifest, error) {
+	manifest := Manifest{
+		CreatedAt:     time.Now(),
+		OmniVersion:   version.Tag,
+		FormatVersion: formatVersion,
+	}
+
+	snapshotItems, err := snapshot(ctx, namespaces)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("failed to read the snapshot of the default storage: %w", err)
+	}
+
+	registered := map[resource.Namespace]map[resource.Type]struct{}{}
+
+	for _, r := range resourceregistry.Resources {
+		rd := r.ResourceDefinition()
+
+		if registered[rd.DefaultNamespace] == nil {
+			registered[rd.DefaultNamespace] = map[resource.Type]struct{}{}
+		}
+
+		registered[rd.DefaultNamespace][rd.Type] = struct{}{}
+	}
+
+	var items []resource.Resource
+
+	for _, item := range snapshotItems {
+		if _, ok := registered[item.Metadata().Namespace()][item.Metadata().Type()]; !ok {
+			continue
+		}
+
+		if dbVersion, ok := item.(*system.DBVersion); ok {
+			manifest.DBVersion = dbVersion.TypedSpec().Value.Version
+		}
+
+		items = append(items, item)
+	}
+
+	manifest.Resources = len(items)
+
+	tw := tar.NewWriter(w)
+
+	manifestData, err := json.Marshal(manifest)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	if err = writeEntry(tw, manifestName, manifest.CreatedAt, manifestData); err != nil {
+		return Manifest{}, err
+	}
+
+	if err = writeSecondaryDB(ctx, tw, db, manifest.CreatedAt); err != nil {
+		return Manifest{}, err
+	}
+
+	for _, item := range items {
+		data, marshalErr := store.ProtobufMarshaler{}.MarshalResource(item)
+		if marshalErr != nil {
+			return Manifest{}, fmt.Errorf("failed to marshal %s: %w", item.Metadata(), marshalErr)
+		}
+
+		name := path.Join(resourcesDir, item.Metadata().Namespace(), item.Metadata().Type(), item.Metadata().ID())
+
+		if err = writeEntry(tw, name, manifest.CreatedAt, data); err != nil {
+			return Manifest{}, err
+		}
+	}
+
+	if err = tw.Close(); err != nil {
+		return Manifest{}, fmt.Errorf("failed to close archive: %w", err)
+	}
+
+	return manifest, nil
+}
+
+func writeSecondaryDB(ctx context.Context, tw *tar.Writer, db *sqlitexx.Pool, modTime time.Time) error {
+	dir, err := os.MkdirTemp("", "omni-self-backup-")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+
+	defer os.RemoveAll(dir) //nolint:errcheck
+
+	snapshotPath := filepath.Join(dir, secondaryDBName)
+
+	conn, err := db.Take(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to take connection from pool: %w", err)
+	}
+
+	err = sqlitex.Execute(conn, "VACUUM INTO ?", &sqlitex.ExecOptions{Args: []any{snapshotPath}})
+
+	db.Put(conn)
+
+	if err != nil {
+		return fmt.Errorf("failed to snapshot the secondary storage: %w", err)
+	}
+
+	f, err := os.Open(snapshotPath)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	stat, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	if err = tw.WriteHeader(&tar.Header{
+		Name:    secondaryDBName,
+		Mode:    0o600,
+		Size:    stat.Size(),
+		ModTime: modTime,
+	}); err != nil {
+		return fmt.Errorf("failed to write %s header: %w", secondaryDBName, err)
+	}
+
+	if _, err = io.Copy(tw, f); err != nil {
+		return fmt.Errorf("failed to write %s: %w", secondaryDBName, err)
+	}
+
+	return nil
+}
+
+func writeEntry(tw *tar.Writer, name string, modTime time.Time, data []byte) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0o600,
+		Size:    int64(len(data)),
+		ModTime: modTime,
+	}); err != nil {
+		return fmt.Errorf("failed to write %s header: %w", name, err)
+	}
+
+	if _, err := tw.Write(data); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// writeKeyStorage writes the size prefixed key storage to w.
+//
+// The key storage is written unencrypted, the master key in it is encrypted with the public keys of its slots.
+func writeKeyStorage(w io.Writer, keyStorage []byte) error {
+	if len(keyStorage) == 0 {
+		return errors.New("key storage is empty")
+	}
+
+	if err := binary.Write(w, binary.BigEndian, uint64(len(keyStorage))); err != nil {
+		return fmt.Errorf("failed to write key storage size: %w", err)
+	}
+
+	if _, err := w.Write(keyStorage); err != nil {
+		return fmt.Errorf("failed to write key storage: %w", err)
+	}
+
+	return nil
+}
+
+func readKeyStorage(r io.Reader) ([]byte, error) {
+	var size uint64
+
+	if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+		return nil, fmt.Errorf("failed to read key storage size: %w", err)
+	}
+
+	if size == 0 || size > maxKeyStorageSize {
+		return nil, fmt.Errorf("invalid key storage size %d, the backup is corrupted or has an unsupported format", size)
+	}
+
+	keyStorage := make([]byte, size)
+
+	if _, err := io.ReadFull(r, keyStorage); err != nil {
+		return nil, fmt.Errorf("failed to read key storage: %w", err)
+	}
+
+	return keyStorage, nil
+}
+
+// Restore restores the encrypted backup read from r into a fresh Omni instance.
+//
+// The master key the backup is encrypted with is read from the key storage of the backup by openKey.
+// The secondary storage database is written to sqlitePath, which must not exist yet. Then openState is called
+// to open the state of the instance, and the resources are created in it. The database version is restored as well,
+// so the migrations must be run on the state afterwards to bring it to the current version.
+func Restore(
+	ctx context.Context,
+	r io.Reader,
+	openKey func(keyStorage []byte) ([]byte, error),
+	sqlitePath string,
+	openState func(context.Context) (state.State, error),
+) (Manifest, error) {
+	keyStorage, err := readKeyStorage(r)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	masterKey, err := openKey(keyStorage)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("failed to read the master key from the key storage of the backup: %w", err)
+	}
+
+	_, decrypted, err := crypt.Decrypt(r, masterKey)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("failed to decrypt backup: %w", err)
+	}
+
+	tr := tar.NewReader(decrypted)
+
+	var manifest Manifest
+
+	if err = nextEntry(tr, manifestName); err != nil {
+		return Manifest{}, err
+	}
+
+	if err = json.NewDecoder(tr).Decode(&manifest); err != nil {
+		return Manifest{}, fmt.Errorf("failed to decode manifest: %w", err)
+	}
+
+	if manifest.FormatVersion != formatVersion {
+		return Manifest{}, fmt.Errorf("unsupported backup format version %d", manifest.FormatVersion)
+	}
+
+	if err = nextEntry(tr, secondaryDBName); err != nil {
+		return Manifest{}, err
+	}
+
+	if err = restoreSecondaryDB(tr, sqlitePath); err != nil {
+		return Manifest{}, err
+	}
+
+	st, err := openState(ctx)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	var restored int
+
+	for {
+		header, nextErr := tr.Next()
+		if errors.Is(nextErr, io.EOF) {
+			break
+		}
+
+		if nextErr != nil {
+			return Manifest{}, fmt.Errorf("failed to read backup: %w", nextErr)
+		}
+
+		data, readErr := io.ReadAll(tr)
+		if readErr != nil {
+			return Manifest{}, fmt.Errorf("failed to read %s: %w", header.Name, readErr)
+		}
+
+		res, unmarshalErr := store.ProtobufMarshaler{}.UnmarshalResource(data)
+		if unmarshalErr != nil {
+			return Manifest{}, fmt.Errorf("failed to unmarshal %s: %w", header.Name, unmarshalErr)
+		}
+
+		if err = restoreResource(ctx, st, res); err != nil {
+			return Manifest{}, fmt.Errorf("failed to restore %s: %w", res.Metadata(), err)
+		}
+
+		restored++
+	}
+
+	if restored != manifest.Resources {
+		return Manifest{}, fmt.Errorf("backup is incomplete: expected %d resources, restored %d", manifest.Resources, restored)
+	}
+
+	return manifest, nil
+}
+
+func nextEntry(tr *tar.Reader, name string) error {
+	header, err := tr.Next()
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", name, err)
+	}
+
+	if header.Name != name {
+		return fmt.Errorf("unexpected entry %q, expected %q", header.Name, name)
+	}
+
+	return nil
+}
+
+func restoreSecondaryDB(r io.Reader, sqlitePath string) error {
+	if err := os.MkdirAll(filepath.Dir(sqlitePath), 0o755); err != nil {
+		return fmt.Errorf("failed to create directory for sqlite database %q: %w", sqlitePath, err)
+	}
+
+	f, err := os.OpenFile(sqlitePath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		if errors.Is(err, os.ErrExist) {
+			return fmt.Errorf("sqlite database %q already exists, the backup can only be restored into a fresh instance", sqlitePath)
+		}
+
+		return err
+	}
+
+	if _, err = io.Copy(f, r); err != nil {
+		f.Close() //nolint:errcheck
+
+		return fmt.Errorf("failed to write sqlite database: %w", err)
+	}
+
+	return f.Close()
+}
+
+func restoreResource(ctx context.Context, st state.State, res resource.Resource) error {
+	res.Metadata().SetVersion(resource.VersionUndefined)
+
+	// the fresh instance has the database version set on startup, the restored one defines which migrations still have to run
+	if res.Metadata().Type() == system.DBVersionType {
+		current, err := st.Get(ctx, res.Metadata())
+		if err != nil && !state.IsNotFoundError(err) {
+			return err
+		}
+
+		if current != nil {
+			res.Metadata().SetVersion(current.Metadata().Version())
+
+			return st.Update(ctx, res, state.WithUpdateOwner(res.Metadata().Owner()))
+		}
+	}
+
+	if err := st.Create(ctx, res, state.WithCreateOwner(res.Metadata().Owner())); err != nil {
+		if state.IsConflictError(err) {
+			return fmt.Errorf("resource already exists, the backup can only be restored into a fresh instance: %w", err)
+		}
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,301 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package selfbackup_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/cosi-project/state-sqlite/pkg/sqlitexx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	zombiesqlite "zombiezen.com/go/sqlite"
+	"zombiezen.com/go/sqlite/sqlitex"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	resourceregistry "github.com/siderolabs/omni/client/pkg/omni/resources/registry"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/system"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup/selfbackup"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/etcdbackup/fstore"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/sqlite"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+func TestBackupRestore(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	key := testKey()
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	cluster := omni.NewCluster("cluster-1")
+	cluster.TypedSpec().Value.TalosVersion = "1.11.0"
+	cluster.Metadata().Labels().Set("label", "value")
+
+	dbVersion := system.NewDBVersion(system.DBVersionID)
+	dbVersion.TypedSpec().Value.Version = 5
+
+	require.NoError(t, st.Create(ctx, cluster))
+	require.NoError(t, st.Create(ctx, dbVersion))
+	require.NoError(t, st.Create(ctx, system.NewSysVersion(system.SysVersionID))) // ephemeral, not backed up
+
+	db := testDB(t, filepath.Join(t.TempDir(), "secondary.db"))
+
+	execScript(t, db, "CREATE TABLE logs (line TEXT); INSERT INTO logs (line) VALUES ('hello');")
+
+	var buf bytes.Buffer
+
+	manifest, err := selfbackup.Create(ctx, listSnapshot(st), db, &buf, key)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, manifest.Resources)
+	assert.EqualValues(t, 5, manifest.DBVersion)
+
+	archive := buf.Bytes()
+
+	// the fresh instance has its own database version set on startup
+	restoredState := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	freshDBVersion := system.NewDBVersion(system.DBVersionID)
+	freshDBVersion.TypedSpec().Value.Version = 10
+
+	require.NoError(t, restoredState.Create(ctx, freshDBVersion))
+
+	sqlitePath := filepath.Join(t.TempDir(), "restored.db")
+
+	restoredManifest, err := selfbackup.Restore(ctx, bytes.NewReader(archive), openKey(key), sqlitePath, func(context.Context) (state.State, error) {
+		return restoredState, nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, manifest.Resources, restoredManifest.Resources)
+
+	restoredCluster, err := safe.StateGetByID[*omni.Cluster](ctx, restoredState, cluster.Metadata().ID())
+	require.NoError(t, err)
+
+	assert.True(t, restoredCluster.TypedSpec().Value.EqualVT(cluster.TypedSpec().Value))
+	assert.True(t, restoredCluster.Metadata().Labels().Equal(*cluster.Metadata().Labels()))
+
+	restoredDBVersion, err := safe.StateGetByID[*system.DBVersion](ctx, restoredState, system.DBVersionID)
+	require.NoError(t, err)
+
+	assert.EqualValues(t, 5, restoredDBVersion.TypedSpec().Value.Version)
+
+	var line string
+
+	restoredDB := testDB(t, sqlitePath)
+
+	conn, err := restoredDB.Take(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, sqlitex.Execute(conn, "SELECT line FROM logs", &sqlitex.ExecOptions{
+		ResultFunc: func(stmt *zombiesqlite.Stmt) error {
+			line = stmt.ColumnText(0)
+
+			return nil
+		},
+	}))
+
+	restoredDB.Put(conn)
+
+	assert.Equal(t, "hello", line)
+
+	// the backup can't be restored into an instance which already has the resources
+	_, err = selfbackup.Restore(ctx, bytes.NewReader(archive), openKey(key), filepath.Join(t.TempDir(), "restored.db"), func(context.Context) (state.State, error) {
+		return restoredState, nil
+	})
+	require.ErrorContains(t, err, "fresh instance")
+
+	// the secondary storage database must not exist
+	_, err = selfbackup.Restore(ctx, bytes.NewReader(archive), openKey(key), sqlitePath, func(context.Context) (state.State, error) {
+		return state.WrapCore(namespaced.NewState(inmem.Build)), nil
+	})
+	require.ErrorContains(t, err, "fresh instance")
+
+	// the backup can't be decrypted with another key
+	otherKey := selfbackup.Key{Storage: key.Storage, MasterKey: bytes.Repeat([]byte{2}, 32)}
+
+	_, err = selfbackup.Restore(ctx, bytes.NewReader(archive), openKey(otherKey), filepath.Join(t.TempDir(), "restored.db"), func(context.Context) (state.State, error) {
+		return state.WrapCore(namespaced.NewState(inmem.Build)), nil
+	})
+	require.Error(t, err)
+
+	// the master key can't be read from the key storage
+	_, err = selfbackup.Restore(ctx, bytes.NewReader(archive), func([]byte) ([]byte, error) {
+		return nil, errors.New("no slot for the private key")
+	}, filepath.Join(t.TempDir(), "restored.db"), func(context.Context) (state.State, error) {
+		return state.WrapCore(namespaced.NewState(inmem.Build)), nil
+	})
+	require.ErrorContains(t, err, "no slot for the private key")
+}
+
+func TestBackupSnapshotError(t *testing.T) {
+	t.Parallel()
+
+	db := testDB(t, filepath.Join(t.TempDir(), "secondary.db"))
+
+	_, err := selfbackup.Create(t.Context(), func(context.Context, []resource.Namespace) ([]resource.Resource, error) {
+		return nil, errors.New("the default storage doesn't support snapshots")
+	}, db, io.Discard, testKey())
+	require.ErrorContains(t, err, "the default storage doesn't support snapshots")
+}
+
+func TestStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	key := testKey()
+	backupStore := fstore.NewFileStore(t.TempDir())
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	require.NoError(t, st.Create(ctx, omni.NewCluster("cluster-1")))
+
+	db := testDB(t, filepath.Join(t.TempDir(), "secondary.db"))
+
+	_, err := selfbackup.Latest(ctx, backupStore)
+	require.ErrorContains(t, err, "no backups")
+
+	manifest, err := selfbackup.Upload(ctx, listSnapshot(st), db, backupStore, key)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, manifest.Resources)
+
+	// the older backups are kept in the store next to the uploaded one
+	now := time.Now()
+
+	for _, age := range []time.Duration{time.Hour, 2 * time.Hour, 3 * time.Hour} {
+		require.NoError(t, backupStore.Upload(ctx, etcdbackup.Description{
+			Timestamp:   now.Add(-age),
+			ClusterUUID: selfbackup.ClusterUUID,
+		}, strings.NewReader("old backup")))
+	}
+
+	latest, err := selfbackup.Latest(ctx, backupStore)
+	require.NoError(t, err)
+
+	r, err := selfbackup.Download(ctx, backupStore, latest)
+	require.NoError(t, err)
+
+	restoredState := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	_, err = selfbackup.Restore(ctx, r, openKey(key), filepath.Join(t.TempDir(), "restored.db"), func(context.Context) (state.State, error) {
+		return restoredState, nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+
+	_, err = safe.StateGetByID[*omni.Cluster](ctx, restoredState, "cluster-1")
+	require.NoError(t, err)
+
+	// the retention policy is applied to the backups of Omni's own state
+	pruned, err := selfbackup.Prune(ctx, backupStore, &specs.EtcdBackupRetention{KeepLast: 2}, now)
+	require.NoError(t, err)
+	require.Len(t, pruned, 2)
+
+	it, err := backupStore.ListBackups(ctx, selfbackup.ClusterUUID)
+	require.NoError(t, err)
+
+	var remaining []string
+
+	for backup, iterErr := range it {
+		require.NoError(t, iterErr)
+
+		remaining = append(remaining, backup.Snapshot)
+	}
+
+	assert.ElementsMatch(t, []string{latest, etcdbackup.CreateSnapshotName(now.Add(-time.Hour))}, remaining)
+
+	// nothing is deleted without a retention policy
+	pruned, err = selfbackup.Prune(ctx, backupStore, &specs.EtcdBackupRetention{}, now)
+	require.NoError(t, err)
+	assert.Empty(t, pruned)
+}
+
+func testKey() selfbackup.Key {
+	return selfbackup.Key{
+		Storage:   []byte("key storage"),
+		MasterKey: bytes.Repeat([]byte{1}, 32),
+	}
+}
+
+// openKey returns the master key of the key if the key storage of the backup matches it.
+func openKey(key selfbackup.Key) func([]byte) ([]byte, error) {
+	return func(keyStorage []byte) ([]byte, error) {
+		if !bytes.Equal(keyStorage, key.Storage) {
+			return nil, errors.New("unexpected key storage")
+		}
+
+		return key.MasterKey, nil
+	}
+}
+
+// listSnapshot reads the resources of the registered types from the state, the state isn't modified concurrently in the tests.
+func listSnapshot(st state.State) selfbackup.Snapshot {
+	return func(ctx context.Context, namespaces []resource.Namespace) ([]resource.Resource, error) {
+		var items []resource.Resource
+
+		for _, r := range resourceregistry.Resources {
+			rd := r.ResourceDefinition()
+
+			for _, ns := range namespaces {
+				if rd.DefaultNamespace != ns {
+					continue
+				}
+
+				list, err := st.List(ctx, resource.NewMetadata(ns, rd.Type, "", resource.VersionUndefined))
+				if err != nil {
+					return nil, err
+				}
+
+				items = append(items, list.Items...)
+			}
+		}
+
+		return items, nil
+	}
+}
+
+func testDB(t *testing.T, path string) *sqlitexx.Pool {
+	t.Helper()
+
+	conf := config.Default().Storage.Sqlite
+	conf.SetPath(path)
+
+	db, err := sqlite.OpenDB(conf)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	return db
+}
+
+func execScript(t *testing.T, db *sqlitexx.Pool, script string) {
+	t.Helper()
+
+	conn, err := db.Take(t.Context())
+	require.NoError(t, err)
+
+	defer db.Put(conn)
+
+	require.NoError(t, sqlitex.ExecScript(conn, script))
+}
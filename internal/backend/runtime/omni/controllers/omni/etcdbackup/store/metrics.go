@@ -27,6 +27,7 @@ type factoryWithMetrics struct {
 	factory       Factory
 	uploads       *prometheus.CounterVec
 	downloads     *prometheus.CounterVec
+	deletes       *prometheus.CounterVec
 	uploadBytes   prometheus.Counter
 	downloadBytes prometheus.Counter
 }
@@ -42,6 +43,10 @@ func newFactoryWithMetrics(factory Factory) FactoryWithMetrics {
 			Name: "omni_etcdbackup_store_downloads_total",
 			Help: "Number of etcd backup downloads",
 		}, []string{"status"}),
+		deletes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "omni_etcdbackup_store_deletes_total",
+			Help: "Number of etcd backup deletions",
+		}, []string{"status"}),
 		uploadBytes: prometheus.NewCounter(prometheus.CounterOpts{
 			Name: "omni_etcdbackup_store_transferred_bytes_total",
 			Help: "Number of bytes transferred during etcd backup uploads",
@@ -60,6 +65,7 @@ func (s *factoryWithMetrics) Describe(ch chan<- *prometheus.Desc) {
 func (s *factoryWithMetrics) Collect(ch chan<- prometheus.Metric) {
 	s.uploads.Collect(ch)
 	s.downloads.Collect(ch)
+	s.deletes.Collect(ch)
 	s.uploadBytes.Collect(ch)
 	s.downloadBytes.Collect(ch)
 }
@@ -129,6 +135,18 @@ func (s *storeWithMetrics) Download(ctx context.Context, encryptionKey []byte, c
 	return backupData, &metricsWrapper, err
 }
 
+func (s *storeWithMetrics) Delete(ctx context.Context, clusterUUID, snapshotName string) error {
+	if err := s.store.Delete(ctx, clusterUUID, snapshotName); err != nil {
+		s.metrics.deletes.WithLabelValues("error").Inc()
+
+		return err
+	}
+
+	s.metrics.deletes.WithLabelValues("success").Inc()
+
+	return nil
+}
+
 type readCloserWithMetrics struct {
 	readCloser io.ReadCloser
 	counter    prometheus.Counter
@@ -0,0 +1,240 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"cloud.google.com/go/storage"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"golang.org/x/crypto/ssh"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/etcdbackup/azurestore"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/etcdbackup/crypt"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/etcdbackup/gcsstore"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/etcdbackup/sftpstore"
+)
+
+// BackendName returns the configuration name reported in the store status for the backend.
+func BackendName(backend specs.EtcdBackupStoreConfigSpec_Backend) string {
+	return strings.ReplaceAll(strings.ToLower(backend.String()), "_", "-")
+}
+
+// Backend returns the backend selected by the config, S3 is used when the config doesn't exist.
+func Backend(conf *omni.EtcdBackupStoreConfig) specs.EtcdBackupStoreConfigSpec_Backend {
+	if conf == nil {
+		return specs.EtcdBackupStoreConfigSpec_S3
+	}
+
+	return conf.TypedSpec().Value.GetBackend()
+}
+
+// CheckStoreConfig verifies that the store selected by the config can be created and accessed.
+func CheckStoreConfig(ctx context.Context, conf *omni.EtcdBackupStoreConfig) error {
+	if Backend(conf) == specs.EtcdBackupStoreConfigSpec_S3 {
+		return nil
+	}
+
+	remote, err := remoteStoreFromConfig(ctx, conf, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	return remote.close()
+}
+
+type remoteStore struct {
+	store       etcdbackup.Store
+	close       func() error
+	description string
+}
+
+// remoteStoreFromConfig creates the encrypted store for the non-S3 backend selected by the config and verifies that it is accessible.
+func remoteStoreFromConfig(ctx context.Context, conf *omni.EtcdBackupStoreConfig, upRate, downRate uint64) (remoteStore, error) {
+	spec := conf.TypedSpec().Value
+
+	switch spec.GetBackend() {
+	case specs.EtcdBackupStoreConfigSpec_GCS:
+		return gcsStoreFromConfig(ctx, spec.GetGcs(), upRate, downRate)
+	case specs.EtcdBackupStoreConfigSpec_AZURE_BLOB:
+		return azureStoreFromConfig(ctx, spec.GetAzureBlob(), upRate, downRate)
+	case specs.EtcdBackupStoreConfigSpec_SFTP:
+		return sftpStoreFromConfig(ctx, spec.GetSftp(), upRate, downRate)
+	case specs.EtcdBackupStoreConfigSpec_S3:
+		return remoteStore{}, errors.New("s3 store is configured by the EtcdBackupS3Conf resource")
+	default:
+		return remoteStore{}, fmt.Errorf("unknown backend %q", spec.GetBackend())
+	}
+}
+
+func gcsStoreFromConfig(ctx context.Context, conf *specs.EtcdBackupStoreConfigSpec_GCSConfig, upRate, downRate uint64) (remoteStore, error) {
+	bucket := conf.GetBucket()
+	if bucket == "" {
+		return remoteStore{}, errors.New("bucket must be specified")
+	}
+
+	var opts []option.ClientOption
+
+	switch {
+	case conf.GetCredentialsJson() != "":
+		opts = append(opts, option.WithAuthCredentialsJSON(option.ServiceAccount, []byte(conf.GetCredentialsJson())))
+	case conf.GetEndpoint() != "":
+		// emulators don't require authentication
+		opts = append(opts, option.WithoutAuthentication())
+	}
+
+	if conf.GetEndpoint() != "" {
+		opts = append(opts, option.WithEndpoint(conf.GetEndpoint()))
+	}
+
+	client, err := storage.NewClient(ctx, opts...)
+	if err != nil {
+		return remoteStore{}, fmt.Errorf("failed to create gcs client: %w", err)
+	}
+
+	it := client.Bucket(bucket).Objects(ctx, nil)
+	it.PageInfo().MaxSize = 1
+
+	if _, err = it.Next(); err != nil && !errors.Is(err, iterator.Done) {
+		return remoteStore{}, errors.Join(fmt.Errorf("failed to list objects in bucket %q: %w", bucket, err), client.Close())
+	}
+
+	return remoteStore{
+		store:       crypt.NewStore(gcsstore.NewStore(client, bucket, upRate, downRate)),
+		close:       client.Close,
+		description: fmt.Sprintf("gcs store: bucket %q", bucket),
+	}, nil
+}
+
+func azureStoreFromConfig(ctx context.Context, conf *specs.EtcdBackupStoreConfigSpec_AzureBlobConfig, upRate, downRate uint64) (remoteStore, error) {
+	accountName := conf.GetAccountName()
+	if accountName == "" {
+		return remoteStore{}, errors.New("account name must be specified")
+	}
+
+	container := conf.GetContainer()
+	if container == "" {
+		return remoteStore{}, errors.New("container must be specified")
+	}
+
+	serviceURL := conf.GetEndpoint()
+	if serviceURL == "" {
+		serviceURL = fmt.Sprintf("https://%s.blob.core.windows.net/", accountName)
+	}
+
+	var (
+		client *azblob.Client
+		err    error
+	)
+
+	if conf.GetAccountKey() != "" {
+		var cred *azblob.SharedKeyCredential
+
+		cred, err = azblob.NewSharedKeyCredential(accountName, conf.GetAccountKey())
+		if err != nil {
+			return remoteStore{}, fmt.Errorf("invalid account key: %w", err)
+		}
+
+		client, err = azblob.NewClientWithSharedKeyCredential(serviceURL, cred, nil)
+	} else {
+		var cred *azidentity.DefaultAzureCredential
+
+		cred, err = azidentity.NewDefaultAzureCredential(nil)
+		if err != nil {
+			return remoteStore{}, fmt.Errorf("failed to load azure credentials: %w", err)
+		}
+
+		client, err = azblob.NewClient(serviceURL, cred, nil)
+	}
+
+	if err != nil {
+		return remoteStore{}, fmt.Errorf("failed to create azure blob client: %w", err)
+	}
+
+	pager := client.NewListBlobsFlatPager(container, &azblob.ListBlobsFlatOptions{MaxResults: new(int32(1))})
+
+	if _, err = pager.NextPage(ctx); err != nil {
+		return remoteStore{}, fmt.Errorf("failed to list blobs in container %q: %w", container, err)
+	}
+
+	return remoteStore{
+		store:       crypt.NewStore(azurestore.NewStore(client, container, upRate, downRate)),
+		close:       func() error { return nil },
+		description: fmt.Sprintf("azure blob store: container %q", container),
+	}, nil
+}
+
+func sftpStoreFromConfig(ctx context.Context, conf *specs.EtcdBackupStoreConfigSpec_SFTPConfig, upRate, downRate uint64) (remoteStore, error) {
+	if conf.GetAddress() == "" {
+		return remoteStore{}, errors.New("address must be specified")
+	}
+
+	if conf.GetUser() == "" {
+		return remoteStore{}, errors.New("user must be specified")
+	}
+
+	if conf.GetHostKey() == "" {
+		return remoteStore{}, errors.New("host key must be specified")
+	}
+
+	hostKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(conf.GetHostKey())) //nolint:dogsled
+	if err != nil {
+		return remoteStore{}, fmt.Errorf("invalid host key: %w", err)
+	}
+
+	var auth []ssh.AuthMethod
+
+	if conf.GetPrivateKey() != "" {
+		signer, err := ssh.ParsePrivateKey([]byte(conf.GetPrivateKey()))
+		if err != nil {
+			return remoteStore{}, fmt.Errorf("invalid private key: %w", err)
+		}
+
+		auth = append(auth, ssh.PublicKeys(signer))
+	}
+
+	if conf.GetPassword() != "" {
+		auth = append(auth, ssh.Password(conf.GetPassword()))
+	}
+
+	if len(auth) == 0 {
+		return remoteStore{}, errors.New("password or private key must be specified")
+	}
+
+	dir := conf.GetPath()
+	if dir == "" {
+		dir = "."
+	}
+
+	store := sftpstore.NewStore(sftpstore.Config{
+		ClientConfig: &ssh.ClientConfig{
+			User:            conf.GetUser(),
+			Auth:            auth,
+			HostKeyCallback: ssh.FixedHostKey(hostKey),
+		},
+		Address: conf.GetAddress(),
+		Path:    dir,
+	}, upRate, downRate)
+
+	if err = store.Check(ctx); err != nil {
+		return remoteStore{}, err
+	}
+
+	return remoteStore{
+		store:       crypt.NewStore(store),
+		close:       func() error { return nil },
+		description: fmt.Sprintf("sftp store: %s:%s", conf.GetAddress(), dir),
+	}, nil
+}
@@ -19,10 +19,12 @@ import (
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/credentials/ec2rolecreds"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	"go.uber.org/zap"
 
+	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup/store/internal/s3middleware"
@@ -30,12 +32,16 @@ import (
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/etcdbackup/s3store"
 )
 
-// S3StoreFactory is a factory for S3 stores.
+// S3StoreFactory is a factory for the stores configured by the resources.
+//
+// It creates the S3 store configured by [omni.EtcdBackupS3Conf] unless [omni.EtcdBackupStoreConfig]
+// selects another backend.
 type S3StoreFactory struct { //nolint:govet
 	mx             sync.Mutex
 	store          etcdbackup.Store
+	closeStore     func() error
 	err            error
-	bucket         string
+	description    string
 	upThroughput   uint64
 	downThroughput uint64
 }
@@ -60,21 +66,35 @@ func (sf *S3StoreFactory) GetStore() (etcdbackup.Store, error) {
 	return sf.store, sf.err
 }
 
-// Start starts watching the state for [*omni.EtcdBackupS3Conf] updates and updates the store and error accordingly.
+// Start starts watching the state for [*omni.EtcdBackupS3Conf] and [*omni.EtcdBackupStoreConfig] updates and updates the store and error accordingly.
 func (sf *S3StoreFactory) Start(ctx context.Context, st state.State, logger *zap.Logger) error {
 	if err := setStatus(ctx, st, "s3", "initializing"); err != nil {
 		return err
 	}
 
-	eventCh := make(chan safe.WrappedStateEvent[*omni.EtcdBackupS3Conf])
+	defer sf.closeCurrentStore(logger)
+
+	s3EventCh := make(chan safe.WrappedStateEvent[*omni.EtcdBackupS3Conf])
 
-	err := safe.StateWatch(ctx, st, omni.NewEtcdBackupS3Conf().Metadata(), eventCh)
+	err := safe.StateWatch(ctx, st, omni.NewEtcdBackupS3Conf().Metadata(), s3EventCh)
 	if err != nil {
 		return fmt.Errorf("failed to watch EtcdBackupS3Conf: %w", err)
 	}
 
+	storeConfigEventCh := make(chan safe.WrappedStateEvent[*omni.EtcdBackupStoreConfig])
+
+	err = safe.StateWatch(ctx, st, omni.NewEtcdBackupStoreConfig().Metadata(), storeConfigEventCh)
+	if err != nil {
+		return fmt.Errorf("failed to watch EtcdBackupStoreConfig: %w", err)
+	}
+
 	logger.Debug("s3 store factory started")
 
+	var (
+		s3Conf      *omni.EtcdBackupS3Conf
+		storeConfig *omni.EtcdBackupStoreConfig
+	)
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -85,61 +105,118 @@ func (sf *S3StoreFactory) Start(ctx context.Context, st state.State, logger *zap
 			}
 
 			return fmt.Errorf("store factory context error: %w", ctx.Err())
-		case ev := <-eventCh:
-			if err = ev.Error(); err != nil {
-				logger.Error("s3 store factory error", zap.Error(err))
+		case ev := <-s3EventCh:
+			s3Conf, err = eventResource(ev)
+		case ev := <-storeConfigEventCh:
+			storeConfig, err = eventResource(ev)
+		}
 
-				return fmt.Errorf("s3 store factory error: %w", err)
-			}
+		if err != nil {
+			logger.Error("s3 store factory error", zap.Error(err))
 
-			var resource *omni.EtcdBackupS3Conf
+			return fmt.Errorf("s3 store factory error: %w", err)
+		}
 
-			if ev.Type() != state.Destroyed {
-				resource, err = ev.Resource()
-				if err != nil {
-					logger.Error("s3 store: got incorrect resource", zap.Error(err))
+		err = sf.updateStore(ctx, st, s3Conf, storeConfig, logger)
+		if err != nil {
+			logger.Error("s3 store factory error", zap.Error(err))
 
-					return fmt.Errorf("s3 store: got incorrect resource: %w", err)
-				}
-			}
+			return fmt.Errorf("s3 store factory error: %w", err)
+		}
+	}
+}
 
-			err = sf.updateStore(ctx, st, resource, logger)
-			if err != nil {
-				logger.Error("s3 store factory error", zap.Error(err))
+// eventResource returns the resource from the event, or nil if it was destroyed.
+func eventResource[T resource.Resource](ev safe.WrappedStateEvent[T]) (T, error) {
+	var zero T
 
-				return fmt.Errorf("s3 store factory error: %w", err)
-			}
-		}
+	if err := ev.Error(); err != nil {
+		return zero, err
 	}
+
+	if ev.Type() == state.Destroyed {
+		return zero, nil
+	}
+
+	res, err := ev.Resource()
+	if err != nil {
+		return zero, fmt.Errorf("got incorrect resource: %w", err)
+	}
+
+	return res, nil
 }
 
-func (sf *S3StoreFactory) updateStore(ctx context.Context, st state.State, resource *omni.EtcdBackupS3Conf, logger *zap.Logger) error {
+func (sf *S3StoreFactory) updateStore(
+	ctx context.Context,
+	st state.State,
+	s3Conf *omni.EtcdBackupS3Conf,
+	storeConfig *omni.EtcdBackupStoreConfig,
+	logger *zap.Logger,
+) error {
 	sf.mx.Lock()
 	defer sf.mx.Unlock()
 
-	sf.store = nil
-	sf.err = nil
-	sf.bucket = ""
+	sf.closeCurrentStoreLocked(logger)
+
+	backend := Backend(storeConfig)
+	name := BackendName(backend)
+
+	if backend != specs.EtcdBackupStoreConfigSpec_S3 {
+		remote, err := remoteStoreFromConfig(ctx, storeConfig, sf.upThroughput, sf.downThroughput)
+		if err != nil {
+			sf.err = err
+
+			return updateStatus(ctx, st, name, err.Error())
+		}
 
-	if IsEmptyS3Conf(resource) {
+		sf.store = remote.store
+		sf.closeStore = remote.close
+		sf.description = remote.description
+
+		logger.Debug("remote store client is now set", zap.String("description", remote.description))
+
+		return updateStatus(ctx, st, name, "")
+	}
+
+	if IsEmptyS3Conf(s3Conf) {
 		logger.Debug("s3 store client is now nil")
 
-		return updateS3Status(ctx, st, "not initialized")
+		return updateStatus(ctx, st, name, "not initialized")
 	}
 
-	client, bucket, err := S3ClientFromResource(ctx, resource)
+	client, bucket, err := S3ClientFromResource(ctx, s3Conf)
 	if err != nil {
 		sf.err = err
 
-		return updateS3Status(ctx, st, err.Error())
+		return updateStatus(ctx, st, name, err.Error())
 	}
 
 	sf.store = crypt.NewStore(s3store.NewStore(client, bucket, sf.upThroughput, sf.downThroughput))
-	sf.bucket = bucket
+	sf.description = fmt.Sprintf("s3 store: bucket %q", bucket)
 
 	logger.Debug("s3 store client is now set", zap.String("bucket", bucket))
 
-	return updateS3Status(ctx, st, "")
+	return updateStatus(ctx, st, name, "")
+}
+
+func (sf *S3StoreFactory) closeCurrentStore(logger *zap.Logger) {
+	sf.mx.Lock()
+	defer sf.mx.Unlock()
+
+	sf.closeCurrentStoreLocked(logger)
+}
+
+func (sf *S3StoreFactory) closeCurrentStoreLocked(logger *zap.Logger) {
+	if sf.closeStore != nil {
+		if err := sf.closeStore(); err != nil {
+			logger.Warn("failed to close the store client", zap.Error(err))
+		}
+	}
+
+	sf.store = nil
+	sf.closeStore = nil
+	sf.err = nil
+	sf.description = ""
 }
 
 // S3ClientFromResource returns an S3 client and a bucket name.
@@ -230,7 +307,7 @@ func (sf *S3StoreFactory) Description() string {
 	sf.mx.Lock()
 	defer sf.mx.Unlock()
 
-	return fmt.Sprintf("s3 store: bucket %q", sf.bucket)
+	return sf.description
 }
 
 // SetThroughputs sets the download and upload throughput for the store.
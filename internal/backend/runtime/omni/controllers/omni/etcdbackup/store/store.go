@@ -8,14 +8,19 @@ package store
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	"go.uber.org/zap"
 
+	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/etcdbackup/crypt"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/etcdbackup/fstore"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/etcdbackup/s3store"
 	"github.com/siderolabs/omni/internal/pkg/config"
 )
 
@@ -50,6 +55,77 @@ func NewStoreFactory(etcdBackupCfg config.EtcdBackup) (FactoryWithMetrics, error
 	return newFactoryWithMetrics(result), nil
 }
 
+// OpenStore returns the store configured by the config without starting the factory, so it can be used before Omni is started.
+//
+// The remote store of a running Omni is selected by the EtcdBackupStoreConfig resource and the S3 store is configured by the
+// EtcdBackupS3Conf resource in its state, so they are passed as storeConfig and s3Conf here instead, the same way the S3 store
+// factory uses them. The returned function closes the store client.
+func OpenStore(
+	ctx context.Context,
+	etcdBackupCfg config.EtcdBackup,
+	s3Conf *omni.EtcdBackupS3Conf,
+	storeConfig *omni.EtcdBackupStoreConfig,
+) (etcdbackup.Store, func() error, error) {
+	noClose := func() error { return nil }
+
+	storageType, err := etcdBackupCfg.GetStorageType()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch storageType {
+	case config.EtcdBackupTypeS3:
+		if Backend(storeConfig) != specs.EtcdBackupStoreConfigSpec_S3 {
+			remote, remoteErr := remoteStoreFromConfig(ctx, storeConfig, 0, 0)
+			if remoteErr != nil {
+				return nil, nil, remoteErr
+			}
+
+			return remote.store, remote.close, nil
+		}
+
+		if IsEmptyS3Conf(s3Conf) {
+			return nil, nil, errors.New("s3 store is not configured")
+		}
+
+		client, bucket, clientErr := S3ClientFromResource(ctx, s3Conf)
+		if clientErr != nil {
+			return nil, nil, clientErr
+		}
+
+		return crypt.NewStore(s3store.NewStore(client, bucket, 0, 0)), noClose, nil
+	case config.EtcdBackupTypeFS:
+		return crypt.NewStore(fstore.NewFileStore(etcdBackupCfg.GetLocalPath())), noClose, nil
+	case config.EtcdBackupTypeNone:
+		return nil, nil, errors.New("etcd backup store is disabled")
+	default:
+		return nil, nil, fmt.Errorf("unknown storage type: %q", storageType)
+	}
+}
+
+// Unencrypted returns the store which stores the data as is.
+//
+// The stores returned by the factories encrypt the data with the key passed to them, the data which is already encrypted by
+// the caller is stored in the unencrypted store.
+func Unencrypted(st etcdbackup.Store) (etcdbackup.Store, error) {
+	switch st := st.(type) {
+	case *storeWithMetrics:
+		unwrapped, err := Unencrypted(st.store)
+		if err != nil {
+			return nil, err
+		}
+
+		return &storeWithMetrics{
+			store:   unwrapped,
+			metrics: st.metrics,
+		}, nil
+	case *crypt.Store:
+		return st.Unwrap(), nil
+	default:
+		return nil, fmt.Errorf("store %T doesn't support storing unencrypted data", st)
+	}
+}
+
 func setStatus(ctx context.Context, st state.State, confName, errString string) error {
 	status := omni.NewEtcdBackupStoreStatus()
 	status.TypedSpec().Value.ConfigurationName = confName
@@ -62,8 +138,9 @@ func setStatus(ctx context.Context, st state.State, confName, errString string)
 	return nil
 }
 
-func updateS3Status(ctx context.Context, st state.State, errString string) error {
+func updateStatus(ctx context.Context, st state.State, confName, errString string) error {
 	if _, err := safe.StateUpdateWithConflicts(ctx, st, omni.NewEtcdBackupStoreStatus().Metadata(), func(result *omni.EtcdBackupStoreStatus) error {
+		result.TypedSpec().Value.ConfigurationName = confName
 		result.TypedSpec().Value.ConfigurationError = errString
 
 		return nil
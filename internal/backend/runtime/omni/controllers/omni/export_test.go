@@ -7,6 +7,7 @@ package omni
 
 import (
 	"context"
+	"slices"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/siderolabs/talos/pkg/machinery/compatibility"
@@ -39,3 +40,11 @@ func FetchTalosVersions(
 ) (versionToFactory map[string]talosVersionSource, failedFactoryURLs map[string]struct{}) {
 	return fetchTalosVersions(ctx, imageFactoryClients, logger)
 }
+
+func (ctrl *KubernetesStatusController) SeedProxyPorts(exposedServices []*omni.ExposedService) {
+	ctrl.seedProxyPorts(slices.Values(exposedServices))
+}
+
+func (ctrl *KubernetesStatusController) AllocatedProxyPorts(cluster string) map[int]resource.ID {
+	return ctrl.allocatedProxyPorts(cluster)
+}
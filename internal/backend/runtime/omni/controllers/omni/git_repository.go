@@ -0,0 +1,426 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/xerrors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/helpers"
+	"github.com/siderolabs/omni/internal/pkg/gitsync"
+)
+
+// GitRepositoryControllerName is the name of the GitRepositoryController.
+const GitRepositoryControllerName = "GitRepositoryController"
+
+const (
+	// DefaultGitRepositoryPollInterval is the poll interval of the Git repositories which don't set it.
+	DefaultGitRepositoryPollInterval = 5 * time.Minute
+
+	// gitRepositoryCommitHistory is the number of the recent commits the sync results are kept for.
+	gitRepositoryCommitHistory = 10
+)
+
+// gitSyncedResourceTypes are the types of the resources which can be synced from the Git repositories.
+var gitSyncedResourceTypes = []resource.Type{
+	omni.ClusterTemplateType,
+	omni.ClusterType,
+	omni.ConfigPatchType,
+	omni.ExtensionsConfigurationType,
+	omni.KernelArgsType,
+	omni.KubernetesHealthCheckType,
+	omni.KubernetesManifestGroupType,
+	omni.MachineClassType,
+	omni.MachineInstallDiskConfigType,
+	omni.MachineLabelsType,
+	omni.MachineSetNodeType,
+	omni.MachineSetType,
+	omni.MaintenanceWindowType,
+}
+
+// GitRepositoryController syncs the resources from the Git repositories.
+type GitRepositoryController = qtransform.QController[*omni.GitRepository, *omni.GitRepositoryStatus]
+
+// NewGitRepositoryController instantiates the GitRepositoryController.
+//
+// The resources synced from the repositories are run through the validator before they are written.
+func NewGitRepositoryController(validator ResourceValidator) *GitRepositoryController {
+	h := &gitRepositoryHandler{
+		fetcher:   gitsync.NewFetcher(),
+		validator: validator,
+	}
+
+	outputs := make([]controller.Output, 0, len(gitSyncedResourceTypes))
+
+	for _, resourceType := range gitSyncedResourceTypes {
+		outputs = append(outputs, controller.Output{Type: resourceType, Kind: controller.OutputShared})
+	}
+
+	return qtransform.NewQController(
+		qtransform.Settings[*omni.GitRepository, *omni.GitRepositoryStatus]{
+			Name: GitRepositoryControllerName,
+			MapMetadataFunc: func(repository *omni.GitRepository) *omni.GitRepositoryStatus {
+				return omni.NewGitRepositoryStatus(repository.Metadata().ID())
+			},
+			UnmapMetadataFunc: func(status *omni.GitRepositoryStatus) *omni.GitRepository {
+				return omni.NewGitRepository(status.Metadata().ID())
+			},
+			TransformExtraOutputFunc:        h.reconcileRunning,
+			FinalizerRemovalExtraOutputFunc: h.reconcileTearingDown,
+		},
+		qtransform.WithExtraMappedInput[*omni.GitCredentials](
+			func(ctx context.Context, _ *zap.Logger, r controller.QRuntime, ptr controller.ReducedResourceMetadata) ([]resource.Pointer, error) {
+				repositories, err := safe.ReaderListAll[*omni.GitRepository](ctx, r)
+				if err != nil {
+					return nil, err
+				}
+
+				var ptrs []resource.Pointer
+
+				for repository := range repositories.All() {
+					if repository.TypedSpec().Value.Credentials == ptr.ID() {
+						ptrs = append(ptrs, repository.Metadata())
+					}
+				}
+
+				return ptrs, nil
+			},
+		),
+		qtransform.WithExtraOutputs(outputs...),
+		qtransform.WithConcurrency(4),
+	)
+}
+
+type gitRepositoryHandler struct {
+	fetcher   *gitsync.Fetcher
+	validator ResourceValidator
+}
+
+func (h *gitRepositoryHandler) reconcileRunning(ctx context.Context, r controller.ReaderWriter, logger *zap.Logger, repository *omni.GitRepository,
+	status *omni.GitRepositoryStatus,
+) error {
+	spec := status.TypedSpec().Value
+
+	if repository.TypedSpec().Value.Suspend {
+		spec.Phase = specs.GitRepositoryStatusSpec_SUSPENDED
+		spec.Error = ""
+
+		return nil
+	}
+
+	pollInterval := DefaultGitRepositoryPollInterval
+	if interval := repository.TypedSpec().Value.PollInterval.AsDuration(); interval > 0 {
+		pollInterval = interval
+	}
+
+	source, err := gitRepositorySource(ctx, r, repository)
+	if err == nil {
+		var commit *gitsync.Commit
+
+		commit, err = h.fetcher.Fetch(ctx, repository.Metadata().ID(), source)
+
+		spec.LastFetch = timestamppb.Now()
+
+		if err == nil {
+			h.syncCommit(ctx, r, logger, repository, commit, spec)
+
+			return controller.NewRequeueInterval(pollInterval)
+		}
+	}
+
+	spec.Phase = specs.GitRepositoryStatusSpec_FAILED
+	spec.Error = err.Error()
+
+	return controller.NewRequeueInterval(pollInterval)
+}
+
+func (h *gitRepositoryHandler) reconcileTearingDown(ctx context.Context, r controller.ReaderWriter, _ *zap.Logger, repository *omni.GitRepository) error {
+	h.fetcher.Forget(repository.Metadata().ID())
+
+	if !repository.TypedSpec().Value.Prune {
+		return nil
+	}
+
+	status, err := safe.ReaderGetByID[*omni.GitRepositoryStatus](ctx, r, repository.Metadata().ID())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	remaining, err := pruneGitResources(ctx, r, repository.Metadata().ID(), status.TypedSpec().Value.Resources)
+	if err != nil {
+		return err
+	}
+
+	if len(remaining) > 0 {
+		return xerrors.NewTaggedf[qtransform.SkipReconcileTag]("%d resources synced from the repository are still being destroyed", len(remaining))
+	}
+
+	return nil
+}
+
+// syncCommit applies the resources of the commit, and prunes the ones removed from the repository.
+//
+// The resources which fail to apply don't block the others, the errors are reported in the status of the commit.
+func (h *gitRepositoryHandler) syncCommit(ctx context.Context, r controller.ReaderWriter, logger *zap.Logger, repository *omni.GitRepository,
+	commit *gitsync.Commit, spec *specs.GitRepositoryStatusSpec,
+) {
+	desired, err := commit.Resources()
+	if err != nil {
+		recordGitCommit(spec, commit, err, false)
+
+		return
+	}
+
+	var (
+		syncErr error
+		managed []*specs.GitRepositoryStatusSpec_ManagedResource
+	)
+
+	desiredKeys := make(map[string]struct{}, len(desired))
+
+	for _, res := range desired {
+		desiredKeys[gitManagedResourceKey(res.Metadata().Type(), res.Metadata().ID())] = struct{}{}
+
+		if err = h.applyGitResource(ctx, r, repository.Metadata().ID(), res); err != nil {
+			syncErr = errors.Join(syncErr, fmt.Errorf("failed to apply %s: %w", resource.String(res), err))
+
+			continue
+		}
+
+		managed = append(managed, &specs.GitRepositoryStatusSpec_ManagedResource{
+			Type: res.Metadata().Type(),
+			Id:   res.Metadata().ID(),
+		})
+	}
+
+	var removed []*specs.GitRepositoryStatusSpec_ManagedResource
+
+	for _, previous := range spec.Resources {
+		if _, ok := desiredKeys[gitManagedResourceKey(previous.Type, previous.Id)]; ok {
+			if !slices.ContainsFunc(managed, func(res *specs.GitRepositoryStatusSpec_ManagedResource) bool {
+				return res.Type == previous.Type && res.Id == previous.Id
+			}) {
+				// failed to apply this time, but it is still managed by the repository
+				managed = append(managed, previous)
+			}
+
+			continue
+		}
+
+		removed = append(removed, previous)
+	}
+
+	pending := false
+
+	if repository.TypedSpec().Value.Prune && len(removed) > 0 {
+		var remaining []*specs.GitRepositoryStatusSpec_ManagedResource
+
+		remaining, err = pruneGitResources(ctx, r, repository.Metadata().ID(), removed)
+		if err != nil {
+			syncErr = errors.Join(syncErr, err)
+			remaining = removed
+		}
+
+		// keep tracking the resources which are still being torn down
+		managed = append(managed, remaining...)
+		pending = len(remaining) > 0
+	}
+
+	spec.Resources = managed
+
+	if syncErr != nil {
+		logger.Warn("failed to sync the git repository", zap.String("commit", commit.SHA), zap.Error(syncErr))
+	}
+
+	recordGitCommit(spec, commit, syncErr, pending)
+}
+
+// applyGitResource creates or updates the resource synced from the repository.
+//
+// The existing resources are taken over only if they are managed by the GitOps tools.
+// The resources which don't pass the validations are not written.
+func (h *gitRepositoryHandler) applyGitResource(ctx context.Context, r controller.ReaderWriter, repositoryID string, res resource.Resource) error {
+	if !slices.Contains(gitSyncedResourceTypes, res.Metadata().Type()) {
+		return fmt.Errorf("syncing the resources of type %q is not supported", res.Metadata().Type())
+	}
+
+	res.Metadata().Labels().Set(omni.LabelGitRepository, repositoryID)
+
+	// keep the tool set in the repository, so that the resources moved from the other GitOps tools keep their annotation
+	if _, ok := res.Metadata().Annotations().Get(omni.ResourceManagedByGitopsTools); !ok {
+		res.Metadata().Annotations().Set(omni.ResourceManagedByGitopsTools, "git repository "+repositoryID)
+	}
+
+	existing, err := r.Get(ctx, res.Metadata())
+	if err != nil {
+		if !state.IsNotFoundError(err) {
+			return err
+		}
+
+		if err = h.validator.Validate(ctx, res); err != nil {
+			return err
+		}
+
+		return r.Create(ctx, res, controller.WithCreateNoOwner())
+	}
+
+	if owner := existing.Metadata().Owner(); owner != "" {
+		return fmt.Errorf("the resource is owned by %q", owner)
+	}
+
+	if syncedFrom, ok := existing.Metadata().Labels().Get(omni.LabelGitRepository); ok {
+		if syncedFrom != repositoryID {
+			return fmt.Errorf("the resource is synced from the git repository %q", syncedFrom)
+		}
+	} else if _, ok = existing.Metadata().Annotations().Get(omni.ResourceManagedByGitopsTools); !ok {
+		return fmt.Errorf("the resource is not managed by GitOps tools, set the %q annotation to sync it from the repository", omni.ResourceManagedByGitopsTools)
+	}
+
+	if existing.Metadata().Phase() == resource.PhaseTearingDown {
+		return errors.New("the resource is being torn down")
+	}
+
+	if err = h.validator.Validate(ctx, res); err != nil {
+		return err
+	}
+
+	return updateOwnerless(ctx, r, res)
+}
+
+// pruneGitResources destroys the resources which were synced from the repository, it returns the ones which are still being torn down.
+func pruneGitResources(ctx context.Context, r controller.ReaderWriter, repositoryID string, toPrune []*specs.GitRepositoryStatusSpec_ManagedResource,
+) ([]*specs.GitRepositoryStatusSpec_ManagedResource, error) {
+	var remaining []*specs.GitRepositoryStatusSpec_ManagedResource
+
+	for _, managed := range toPrune {
+		ptr := gitManagedResourcePointer(managed)
+
+		res, err := r.Get(ctx, ptr)
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		// the resource was taken over by someone else
+		if syncedFrom, _ := res.Metadata().Labels().Get(omni.LabelGitRepository); syncedFrom != repositoryID || res.Metadata().Owner() != "" {
+			continue
+		}
+
+		destroyed, err := helpers.TeardownAndDestroy(ctx, r, ptr, controller.WithOwner(""))
+		if err != nil {
+			return nil, err
+		}
+
+		if !destroyed {
+			remaining = append(remaining, managed)
+		}
+	}
+
+	return remaining, nil
+}
+
+func gitManagedResourceKey(resourceType resource.Type, id resource.ID) string {
+	return resourceType + "/" + id
+}
+
+func gitManagedResourcePointer(managed *specs.GitRepositoryStatusSpec_ManagedResource) resource.Pointer {
+	return resource.NewMetadata(resources.DefaultNamespace, managed.Type, managed.Id, resource.VersionUndefined)
+}
+
+// gitRepositorySource builds the source to fetch the repository from, including its credentials.
+func gitRepositorySource(ctx context.Context, r controller.Reader, repository *omni.GitRepository) (gitsync.Source, error) {
+	spec := repository.TypedSpec().Value
+
+	source := gitsync.Source{
+		URL:    spec.Url,
+		Branch: spec.Branch,
+		Path:   spec.Path,
+	}
+
+	if spec.Credentials == "" {
+		return source, nil
+	}
+
+	credentials, err := safe.ReaderGetByID[*omni.GitCredentials](ctx, r, spec.Credentials)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return source, fmt.Errorf("git credentials %q not found", spec.Credentials)
+		}
+
+		return source, err
+	}
+
+	source.Username = credentials.TypedSpec().Value.Username
+	source.Password = credentials.TypedSpec().Value.Password
+	source.SSHPrivateKey = credentials.TypedSpec().Value.SshPrivateKey
+	source.SSHKnownHosts = credentials.TypedSpec().Value.SshKnownHosts
+
+	return source, nil
+}
+
+// recordGitCommit records the result of the sync of the commit in the status.
+func recordGitCommit(spec *specs.GitRepositoryStatusSpec, commit *gitsync.Commit, syncErr error, pending bool) {
+	phase := specs.GitRepositoryStatusSpec_SYNCED
+
+	switch {
+	case syncErr != nil:
+		phase = specs.GitRepositoryStatusSpec_FAILED
+	case pending:
+		phase = specs.GitRepositoryStatusSpec_SYNCING
+	}
+
+	var errorMessage string
+
+	if syncErr != nil {
+		errorMessage = syncErr.Error()
+	}
+
+	spec.Phase = phase
+	spec.Error = errorMessage
+	spec.Commit = commit.SHA
+
+	if len(spec.Commits) == 0 || spec.Commits[0].Sha != commit.SHA {
+		spec.Commits = slices.Insert(spec.Commits, 0, &specs.GitRepositoryStatusSpec_CommitStatus{
+			Sha:     commit.SHA,
+			Message: commit.Message,
+			Author:  commit.Author,
+		})
+
+		if len(spec.Commits) > gitRepositoryCommitHistory {
+			spec.Commits = spec.Commits[:gitRepositoryCommitHistory]
+		}
+	}
+
+	commitStatus := spec.Commits[0]
+
+	if commitStatus.Phase != phase || commitStatus.Error != errorMessage || commitStatus.SyncedAt == nil {
+		commitStatus.Phase = phase
+		commitStatus.Error = errorMessage
+		commitStatus.SyncedAt = timestamppb.Now()
+	}
+}
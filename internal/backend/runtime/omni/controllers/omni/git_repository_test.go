@@ -0,0 +1,187 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/go-git/go-billy/v5/util"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport/client"
+	"github.com/go-git/go-git/v5/plumbing/transport/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/testutils"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/validated"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/validations"
+)
+
+const gitKernelArgs = `metadata:
+  namespace: default
+  type: KernelArgs.omni.sidero.dev
+  id: %s
+spec:
+  args:
+    - console=ttyS0
+`
+
+// pushGitFiles commits the files to the repository and pushes them to its origin.
+func pushGitFiles(t *testing.T, repository *git.Repository, files map[string]string) string {
+	wt, err := repository.Worktree()
+	require.NoError(t, err)
+
+	for name, contents := range files {
+		if contents == "" {
+			_, err = wt.Remove(name)
+			require.NoError(t, err)
+
+			continue
+		}
+
+		require.NoError(t, util.WriteFile(wt.Filesystem, name, []byte(contents), 0o644))
+
+		_, err = wt.Add(name)
+		require.NoError(t, err)
+	}
+
+	hash, err := wt.Commit("update", &git.CommitOptions{
+		Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, repository.Push(&git.PushOptions{
+		RefSpecs: []config.RefSpec{"refs/heads/master:refs/heads/master"},
+	}))
+
+	return hash.String()
+}
+
+func TestGitRepositoryController(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 30*time.Second)
+	t.Cleanup(cancel)
+
+	client.InstallProtocol("file", server.DefaultServer)
+
+	bare := filepath.Join(t.TempDir(), "fleet.git")
+
+	_, err := git.PlainInit(bare, true)
+	require.NoError(t, err)
+
+	repository, err := git.PlainInit(t.TempDir(), false)
+	require.NoError(t, err)
+
+	_, err = repository.CreateRemote(&config.RemoteConfig{Name: git.DefaultRemoteName, URLs: []string{bare}})
+	require.NoError(t, err)
+
+	firstSHA := pushGitFiles(t, repository, map[string]string{
+		"fleet/synced.yaml": fmt.Sprintf(gitKernelArgs, "synced"),
+	})
+
+	testutils.WithRuntime(
+		ctx, t, testutils.TestOptions{},
+		func(_ context.Context, tc testutils.TestContext) {
+			validator := validations.NewDryRunValidator(tc.State, validated.WithCreateValidations(validated.NewCreateValidationForType(
+				func(_ context.Context, res *omni.KernelArgs, _ ...state.CreateOption) error {
+					if res.Metadata().ID() == "invalid" {
+						return errors.New("the kernel args are invalid")
+					}
+
+					return nil
+				},
+			)))
+
+			require.NoError(t, tc.Runtime.RegisterQController(omnictrl.NewGitRepositoryController(validator)))
+		},
+		func(ctx context.Context, tc testutils.TestContext) {
+			// the resource created manually is not taken over by the repository
+			require.NoError(t, tc.State.Create(ctx, omni.NewKernelArgs("manual")))
+
+			gitRepository := omni.NewGitRepository("fleet")
+			gitRepository.TypedSpec().Value.Url = bare
+			gitRepository.TypedSpec().Value.Path = "fleet"
+			gitRepository.TypedSpec().Value.Prune = true
+			gitRepository.TypedSpec().Value.PollInterval = durationpb.New(time.Second)
+
+			require.NoError(t, tc.State.Create(ctx, gitRepository))
+
+			rtestutils.AssertResource(ctx, t, tc.State, "fleet", func(res *omni.GitRepositoryStatus, assertion *assert.Assertions) {
+				assertion.Equal(specs.GitRepositoryStatusSpec_SYNCED, res.TypedSpec().Value.Phase)
+				assertion.Equal(firstSHA, res.TypedSpec().Value.Commit)
+				assertion.Len(res.TypedSpec().Value.Resources, 1)
+			})
+
+			rtestutils.AssertResource(ctx, t, tc.State, "synced", func(res *omni.KernelArgs, assertion *assert.Assertions) {
+				assertion.Equal([]string{"console=ttyS0"}, res.TypedSpec().Value.Args)
+				assertion.Empty(res.Metadata().Owner())
+
+				syncedFrom, _ := res.Metadata().Labels().Get(omni.LabelGitRepository)
+				assertion.Equal("fleet", syncedFrom)
+
+				_, managed := res.Metadata().Annotations().Get(omni.ResourceManagedByGitopsTools)
+				assertion.True(managed)
+			})
+
+			// the removed resources are pruned, the conflicting and the invalid ones fail the sync
+			secondSHA := pushGitFiles(t, repository, map[string]string{
+				"fleet/synced.yaml":  "",
+				"fleet/manual.yaml":  fmt.Sprintf(gitKernelArgs, "manual"),
+				"fleet/invalid.yaml": fmt.Sprintf(gitKernelArgs, "invalid"),
+			})
+
+			rtestutils.AssertResource(ctx, t, tc.State, "fleet", func(res *omni.GitRepositoryStatus, assertion *assert.Assertions) {
+				assertion.Equal(specs.GitRepositoryStatusSpec_FAILED, res.TypedSpec().Value.Phase)
+				assertion.Equal(secondSHA, res.TypedSpec().Value.Commit)
+				assertion.Contains(res.TypedSpec().Value.Error, "the resource is not managed by GitOps tools")
+				assertion.Contains(res.TypedSpec().Value.Error, "the kernel args are invalid")
+				assertion.Empty(res.TypedSpec().Value.Resources)
+
+				if assertion.Len(res.TypedSpec().Value.Commits, 2) {
+					assertion.Equal(firstSHA, res.TypedSpec().Value.Commits[1].Sha)
+					assertion.Equal(specs.GitRepositoryStatusSpec_SYNCED, res.TypedSpec().Value.Commits[1].Phase)
+				}
+			})
+
+			rtestutils.AssertNoResource[*omni.KernelArgs](ctx, t, tc.State, "synced")
+			rtestutils.AssertNoResource[*omni.KernelArgs](ctx, t, tc.State, "invalid")
+			rtestutils.AssertResource(ctx, t, tc.State, "manual", func(res *omni.KernelArgs, assertion *assert.Assertions) {
+				assertion.Empty(res.TypedSpec().Value.Args)
+			})
+
+			// the suspended repository is not synced
+			_, err = safe.StateUpdateWithConflicts(ctx, tc.State, gitRepository.Metadata(), func(res *omni.GitRepository) error {
+				res.TypedSpec().Value.Suspend = true
+
+				return nil
+			})
+			require.NoError(t, err)
+
+			rtestutils.AssertResource(ctx, t, tc.State, "fleet", func(res *omni.GitRepositoryStatus, assertion *assert.Assertions) {
+				assertion.Equal(specs.GitRepositoryStatusSpec_SUSPENDED, res.TypedSpec().Value.Phase)
+			})
+
+			rtestutils.Destroy[*omni.GitRepository](ctx, t, tc.State, []string{"fleet"})
+
+			rtestutils.AssertResources(ctx, t, tc.State, []string{"manual"}, func(*omni.KernelArgs, *assert.Assertions) {})
+		},
+	)
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package azurestore implements [etcdbackup.Store] which stores backups in a specified Azure Blob Storage container.
+package azurestore
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"iter"
+	"path"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/google/uuid"
+	"github.com/siderolabs/go-pointer"
+	"golang.org/x/time/rate"
+
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/etcdbackup/limiter"
+)
+
+// Store stores etcd backups in a specified Azure Blob Storage container.
+type Store struct {
+	client       *azblob.Client
+	downloadRate *rate.Limiter
+	uploadRate   *rate.Limiter
+	container    string
+}
+
+// NewStore initializes [Store].
+func NewStore(client *azblob.Client, container string, upRate, downRate uint64) *Store {
+	return &Store{
+		client:       client,
+		downloadRate: limiter.New(downRate),
+		uploadRate:   limiter.New(upRate),
+		container:    container,
+	}
+}
+
+// Upload stores the data from [io.Reader] in a blob. Implements [etcdbackup.Store].
+func (s *Store) Upload(ctx context.Context, descr etcdbackup.Description, r io.Reader) error {
+	_, err := s.client.UploadStream(
+		ctx,
+		s.container,
+		path.Join(descr.ClusterUUID, etcdbackup.CreateSnapshotName(descr.Timestamp)),
+		limiter.NewReader(io.NopCloser(r), s.uploadRate),
+		nil,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upload backup to azure blob storage: %w", err)
+	}
+
+	return nil
+}
+
+// Download downloads the backup with the specified name. Implements [etcdbackup.Store].
+func (s *Store) Download(ctx context.Context, _ []byte, clusterUUID, snapshotName string) (etcdbackup.BackupData, io.ReadCloser, error) {
+	result, err := s.client.DownloadStream(ctx, s.container, path.Join(clusterUUID, snapshotName), nil)
+	if err != nil {
+		return etcdbackup.BackupData{}, nil, fmt.Errorf("failed to get blob: %w", err)
+	}
+
+	return etcdbackup.BackupData{}, limiter.NewReader(result.Body, s.downloadRate), nil
+}
+
+// Delete deletes the backup with the specified name. Implements [etcdbackup.Store].
+func (s *Store) Delete(ctx context.Context, clusterUUID, snapshotName string) error {
+	if _, err := s.client.DeleteBlob(ctx, s.container, path.Join(clusterUUID, snapshotName), nil); err != nil {
+		return fmt.Errorf("failed to delete blob: %w", err)
+	}
+
+	return nil
+}
+
+// ListBackups returns a list of backups. Implements [etcdbackup.Store].
+func (s *Store) ListBackups(ctx context.Context, clusterUUID string) (iter.Seq2[etcdbackup.Info, error], error) {
+	pager := s.client.NewListBlobsFlatPager(s.container, &azblob.ListBlobsFlatOptions{
+		Prefix: new(clusterUUID + "/"),
+	})
+
+	var blobs []*container.BlobItem
+
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list backups: %w", err)
+		}
+
+		if page.Segment != nil {
+			blobs = append(blobs, page.Segment.BlobItems...)
+		}
+	}
+
+	return func(yield func(etcdbackup.Info, error) bool) {
+		for _, blob := range blobs {
+			name := pointer.SafeDeref(blob.Name)
+
+			uuidStr, snapshotName, found := strings.Cut(name, "/")
+			if !found {
+				continue
+			}
+
+			if _, err := uuid.Parse(uuidStr); err != nil {
+				continue
+			}
+
+			timestamp, err := etcdbackup.ParseSnapshotName(snapshotName)
+			if err != nil {
+				if !yield(etcdbackup.Info{}, fmt.Errorf("failed to parse snapshot name: %w", err)) {
+					return
+				}
+
+				continue
+			}
+
+			var size int64
+
+			if blob.Properties != nil {
+				size = pointer.SafeDeref(blob.Properties.ContentLength)
+			}
+
+			if !yield(etcdbackup.Info{
+				Snapshot:  snapshotName,
+				Timestamp: timestamp,
+				Reader: func() (io.ReadCloser, error) {
+					result, err := s.client.DownloadStream(ctx, s.container, name, nil)
+					if err != nil {
+						return nil, fmt.Errorf("failed to get blob: %w", err)
+					}
+
+					return result.Body, nil
+				},
+				Size: size,
+			}, nil) {
+				return
+			}
+		}
+	}, nil
+}
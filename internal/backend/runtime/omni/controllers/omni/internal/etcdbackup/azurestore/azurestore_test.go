@@ -0,0 +1,48 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package azurestore_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/etcdbackup/azurestore"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/etcdbackup/storetest"
+)
+
+// Azurite well-known development account.
+const (
+	azuriteAccountName = "devstoreaccount1"
+	azuriteAccountKey  = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+)
+
+// TestStore runs against Azurite, e.g.:
+//
+//	docker run -p 10000:10000 mcr.microsoft.com/azure-storage/azurite azurite-blob --blobHost 0.0.0.0
+//	AZURITE_BLOB_ENDPOINT=http://localhost:10000/devstoreaccount1 go test ./...
+func TestStore(t *testing.T) {
+	endpoint := os.Getenv("AZURITE_BLOB_ENDPOINT")
+	if endpoint == "" {
+		t.Skip("AZURITE_BLOB_ENDPOINT is not set")
+	}
+
+	cred, err := azblob.NewSharedKeyCredential(azuriteAccountName, azuriteAccountKey)
+	require.NoError(t, err)
+
+	client, err := azblob.NewClientWithSharedKeyCredential(endpoint, cred, nil)
+	require.NoError(t, err)
+
+	container := "omni-" + uuid.NewString()
+
+	_, err = client.CreateContainer(t.Context(), container, nil)
+	require.NoError(t, err)
+
+	storetest.Run(t, azurestore.NewStore(client, container, 0, 0))
+}
@@ -90,6 +90,16 @@ func (c *Store) Download(ctx context.Context, encryptionKey []byte, clusterUUID,
 	}, readCloser, nil
 }
 
+// Delete deletes the backup from the wrapped store. Implements [Store].
+func (c *Store) Delete(ctx context.Context, clusterUUID, snapshotName string) error {
+	return c.wrapped.Delete(ctx, clusterUUID, snapshotName)
+}
+
+// Unwrap returns the wrapped store, which stores the data as is.
+func (c *Store) Unwrap() etcdbackup.Store {
+	return c.wrapped
+}
+
 // ListBackups returns a list of backups. Implements [Store].
 func (c *Store) ListBackups(ctx context.Context, uuid string) (iter.Seq2[etcdbackup.Info, error], error) {
 	return c.wrapped.ListBackups(ctx, uuid)
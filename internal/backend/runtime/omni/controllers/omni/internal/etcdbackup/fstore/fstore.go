@@ -39,6 +39,15 @@ func (store *FileStore) Download(_ context.Context, _ []byte, clusterUUID, snaps
 	return etcdbackup.BackupData{}, readCloser, err
 }
 
+// Delete deletes the backup file. Implements [etcdbackup.Store].
+func (store *FileStore) Delete(_ context.Context, clusterUUID, snapshotName string) error {
+	if err := os.Remove(filepath.Join(store.dir, clusterUUID, snapshotName)); err != nil {
+		return fmt.Errorf("failed to delete backup: %w", err)
+	}
+
+	return nil
+}
+
 // ListBackups returns a list of backups. Implements [Store].
 func (store *FileStore) ListBackups(_ context.Context, uuid string) (iter.Seq2[etcdbackup.Info, error], error) {
 	storeAbsDir, err := filepath.Abs(store.dir)
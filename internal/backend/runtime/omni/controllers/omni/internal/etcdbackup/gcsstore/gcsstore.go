@@ -0,0 +1,139 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package gcsstore implements [etcdbackup.Store] which stores backups in a specified Google Cloud Storage bucket.
+package gcsstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"path"
+	"strings"
+
+	"cloud.google.com/go/storage"
+	"github.com/google/uuid"
+	"golang.org/x/time/rate"
+	"google.golang.org/api/iterator"
+
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/etcdbackup/limiter"
+)
+
+// Store stores etcd backups in a specified GCS bucket.
+type Store struct {
+	client       *storage.Client
+	downloadRate *rate.Limiter
+	uploadRate   *rate.Limiter
+	bucket       string
+}
+
+// NewStore initializes [Store].
+func NewStore(client *storage.Client, bucket string, upRate, downRate uint64) *Store {
+	return &Store{
+		client:       client,
+		downloadRate: limiter.New(downRate),
+		uploadRate:   limiter.New(upRate),
+		bucket:       bucket,
+	}
+}
+
+// Upload stores the data from [io.Reader] in an object. Implements [etcdbackup.Store].
+func (s *Store) Upload(ctx context.Context, descr etcdbackup.Description, r io.Reader) error {
+	// canceling the context is the only way to abort the upload without creating the object
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	w := s.client.Bucket(s.bucket).Object(path.Join(descr.ClusterUUID, etcdbackup.CreateSnapshotName(descr.Timestamp))).NewWriter(ctx)
+
+	if _, err := io.Copy(w, limiter.NewReader(io.NopCloser(r), s.uploadRate)); err != nil {
+		return fmt.Errorf("failed to upload backup to gcs: %w", err)
+	}
+
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to upload backup to gcs: %w", err)
+	}
+
+	return nil
+}
+
+// Download downloads the backup with the specified name. Implements [etcdbackup.Store].
+func (s *Store) Download(ctx context.Context, _ []byte, clusterUUID, snapshotName string) (etcdbackup.BackupData, io.ReadCloser, error) {
+	rdr, err := s.client.Bucket(s.bucket).Object(path.Join(clusterUUID, snapshotName)).NewReader(ctx)
+	if err != nil {
+		return etcdbackup.BackupData{}, nil, fmt.Errorf("failed to get object: %w", err)
+	}
+
+	return etcdbackup.BackupData{}, limiter.NewReader(rdr, s.downloadRate), nil
+}
+
+// Delete deletes the backup with the specified name. Implements [etcdbackup.Store].
+func (s *Store) Delete(ctx context.Context, clusterUUID, snapshotName string) error {
+	if err := s.client.Bucket(s.bucket).Object(path.Join(clusterUUID, snapshotName)).Delete(ctx); err != nil {
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+
+	return nil
+}
+
+// ListBackups returns a list of backups. Implements [etcdbackup.Store].
+func (s *Store) ListBackups(ctx context.Context, clusterUUID string) (iter.Seq2[etcdbackup.Info, error], error) {
+	it := s.client.Bucket(s.bucket).Objects(ctx, &storage.Query{Prefix: clusterUUID + "/"})
+
+	var objects []*storage.ObjectAttrs
+
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to list backups: %w", err)
+		}
+
+		objects = append(objects, attrs)
+	}
+
+	return func(yield func(etcdbackup.Info, error) bool) {
+		for _, object := range objects {
+			uuidStr, snapshotName, found := strings.Cut(object.Name, "/")
+			if !found {
+				continue
+			}
+
+			if _, err := uuid.Parse(uuidStr); err != nil {
+				continue
+			}
+
+			timestamp, err := etcdbackup.ParseSnapshotName(snapshotName)
+			if err != nil {
+				if !yield(etcdbackup.Info{}, fmt.Errorf("failed to parse snapshot name: %w", err)) {
+					return
+				}
+
+				continue
+			}
+
+			if !yield(etcdbackup.Info{
+				Snapshot:  snapshotName,
+				Timestamp: timestamp,
+				Reader: func() (io.ReadCloser, error) {
+					rdr, err := s.client.Bucket(s.bucket).Object(object.Name).NewReader(ctx)
+					if err != nil {
+						return nil, fmt.Errorf("failed to get object: %w", err)
+					}
+
+					return rdr, nil
+				},
+				Size: object.Size,
+			}, nil) {
+				return
+			}
+		}
+	}, nil
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package gcsstore_test
+
+import (
+	"os"
+	"testing"
+
+	"cloud.google.com/go/storage"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/option"
+
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/etcdbackup/gcsstore"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/etcdbackup/storetest"
+)
+
+// TestStore runs against fake-gcs-server, e.g.:
+//
+//	docker run -p 4443:4443 fsouza/fake-gcs-server -scheme http
+//	FAKE_GCS_SERVER_ENDPOINT=http://localhost:4443/storage/v1/ go test ./...
+func TestStore(t *testing.T) {
+	endpoint := os.Getenv("FAKE_GCS_SERVER_ENDPOINT")
+	if endpoint == "" {
+		t.Skip("FAKE_GCS_SERVER_ENDPOINT is not set")
+	}
+
+	client, err := storage.NewClient(t.Context(), option.WithEndpoint(endpoint), option.WithoutAuthentication())
+	require.NoError(t, err)
+
+	t.Cleanup(func() { require.NoError(t, client.Close()) })
+
+	bucket := "omni-" + uuid.NewString()
+
+	require.NoError(t, client.Bucket(bucket).Create(t.Context(), "test", nil))
+
+	storetest.Run(t, gcsstore.NewStore(client, bucket, 0, 0))
+}
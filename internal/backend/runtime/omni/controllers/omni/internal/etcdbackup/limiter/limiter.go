@@ -0,0 +1,62 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package limiter implements the throughput limiting shared by the remote etcd backup stores.
+package limiter
+
+import (
+	"context"
+	"io"
+
+	"golang.org/x/time/rate"
+)
+
+// New creates a limiter allowing the specified throughput in bytes per second. Zero means no limit.
+func New(throughput uint64) *rate.Limiter {
+	limit := rate.Inf
+	if throughput > 0 {
+		limit = rate.Limit(throughput)
+	}
+
+	return rate.NewLimiter(limit, int(throughput))
+}
+
+// Reader limits the read throughput of the underlying [io.ReadCloser].
+type Reader struct {
+	rdr io.ReadCloser
+	l   *rate.Limiter
+}
+
+// NewReader wraps the reader with the limiter.
+func NewReader(rdr io.ReadCloser, l *rate.Limiter) *Reader {
+	return &Reader{rdr: rdr, l: l}
+}
+
+// Read implements [io.Reader].
+func (r *Reader) Read(p []byte) (int, error) {
+	burst := r.l.Burst()
+	if burst == 0 {
+		return r.rdr.Read(p)
+	}
+
+	readInto := p[:min(burst, len(p))]
+
+	n, err := r.rdr.Read(readInto)
+	if err != nil {
+		return n, err
+	}
+
+	err = r.l.WaitN(context.Background(), n)
+	if err != nil {
+		return n, err
+	}
+
+	return n, nil
+}
+
+// Close implements [io.Closer].
+func (r *Reader) Close() error {
+	return r.rdr.Close()
+}
@@ -9,10 +9,12 @@ import (
 	"io"
 
 	"golang.org/x/time/rate"
+
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/etcdbackup/limiter"
 )
 
-type ReaderLimiter = readerLimiter
+type ReaderLimiter = limiter.Reader
 
 func NewReaderLimiter(rdr io.ReadCloser, l *rate.Limiter) *ReaderLimiter {
-	return &readerLimiter{rdr: rdr, l: l}
+	return limiter.NewReader(rdr, l)
 }
@@ -22,6 +22,7 @@ import (
 	"golang.org/x/time/rate"
 
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/etcdbackup/limiter"
 )
 
 // Store stores etcd backups in a specified S3 bucket.
@@ -34,20 +35,10 @@ type Store struct {
 
 // NewStore initializes [Store].
 func NewStore(client *s3.Client, bucket string, upRate, downRate uint64) *Store {
-	upLimit := rate.Inf
-	if upRate > 0 {
-		upLimit = rate.Limit(upRate)
-	}
-
-	downLimit := rate.Inf
-	if downRate > 0 {
-		downLimit = rate.Limit(downRate)
-	}
-
 	return &Store{
 		client:       client,
-		downloadRate: rate.NewLimiter(upLimit, int(upRate)),
-		uploadRate:   rate.NewLimiter(downLimit, int(downRate)),
+		downloadRate: limiter.New(downRate),
+		uploadRate:   limiter.New(upRate),
 		bucket:       bucket,
 	}
 }
@@ -61,7 +52,7 @@ func (s *Store) Upload(ctx context.Context, descr etcdbackup.Description, r io.R
 	_, err := uploader.UploadObject(ctx, &transfermanager.UploadObjectInput{
 		Bucket: new(s.bucket),
 		Key:    new(path.Join(descr.ClusterUUID, etcdbackup.CreateSnapshotName(descr.Timestamp))),
-		Body:   newReaderLimiter(io.NopCloser(r), s.uploadRate),
+		Body:   limiter.NewReader(io.NopCloser(r), s.uploadRate),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to upload backup to s3: %w", err)
@@ -80,7 +71,19 @@ func (s *Store) Download(ctx context.Context, _ []byte, clusterUUID, snapshotNam
 		return etcdbackup.BackupData{}, nil, fmt.Errorf("failed to get object: %w", err)
 	}
 
-	return etcdbackup.BackupData{}, newReaderLimiter(result.Body, s.downloadRate), nil
+	return etcdbackup.BackupData{}, limiter.NewReader(result.Body, s.downloadRate), nil
+}
+
+// Delete deletes the backup with the specified name. Implements [EtcdBackupStore].
+func (s *Store) Delete(ctx context.Context, clusterUUID, snapshotName string) error {
+	if _, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: new(s.bucket),
+		Key:    new(path.Join(clusterUUID, snapshotName)),
+	}); err != nil {
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+
+	return nil
 }
 
 // ListBackups returns a list of backups. Implements [EtcdBackupStore].
@@ -139,37 +142,3 @@ func (s *Store) ListBackups(ctx context.Context, clusterUUID string) (iter.Seq2[
 		}
 	}, nil
 }
-
-type readerLimiter struct {
-	rdr io.ReadCloser
-	l   *rate.Limiter
-}
-
-func newReaderLimiter(rdr io.ReadCloser, l *rate.Limiter) *readerLimiter {
-	return &readerLimiter{rdr: rdr, l: l}
-}
-
-func (r *readerLimiter) Read(p []byte) (int, error) {
-	burst := r.l.Burst()
-	if burst == 0 {
-		return r.rdr.Read(p)
-	}
-
-	readInto := p[:min(burst, len(p))]
-
-	n, err := r.rdr.Read(readInto)
-	if err != nil {
-		return n, err
-	}
-
-	err = r.l.WaitN(context.Background(), n)
-	if err != nil {
-		return n, err
-	}
-
-	return n, nil
-}
-
-func (r *readerLimiter) Close() error {
-	return r.rdr.Close()
-}
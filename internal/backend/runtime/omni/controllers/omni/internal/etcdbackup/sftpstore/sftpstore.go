@@ -0,0 +1,259 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package sftpstore implements [etcdbackup.Store] which stores backups in a directory on an SFTP server.
+package sftpstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"iter"
+	"net"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/time/rate"
+
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/etcdbackup/limiter"
+)
+
+// Config describes the SFTP server connection.
+type Config struct {
+	ClientConfig *ssh.ClientConfig
+	Address      string
+	Path         string
+}
+
+// Store stores etcd backups in a directory on an SFTP server.
+//
+// Backups are rare, so the store opens a new connection for every operation instead of keeping one alive.
+type Store struct {
+	downloadRate *rate.Limiter
+	uploadRate   *rate.Limiter
+	config       Config
+}
+
+// NewStore initializes [Store].
+func NewStore(config Config, upRate, downRate uint64) *Store {
+	return &Store{
+		config:       config,
+		downloadRate: limiter.New(downRate),
+		uploadRate:   limiter.New(upRate),
+	}
+}
+
+// Check connects to the server and verifies that the backup directory can be created.
+func (s *Store) Check(ctx context.Context) error {
+	client, err := s.connect(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer client.Close() //nolint:errcheck
+
+	if err = client.MkdirAll(s.config.Path); err != nil {
+		return fmt.Errorf("failed to create directory %q: %w", s.config.Path, err)
+	}
+
+	return nil
+}
+
+// Upload stores the data from [io.Reader] in a file. Implements [etcdbackup.Store].
+func (s *Store) Upload(ctx context.Context, descr etcdbackup.Description, r io.Reader) error {
+	client, err := s.connect(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer client.Close() //nolint:errcheck
+
+	dir := path.Join(s.config.Path, descr.ClusterUUID)
+
+	if err = client.MkdirAll(dir); err != nil {
+		return fmt.Errorf("failed to create directory %q: %w", dir, err)
+	}
+
+	name := path.Join(dir, etcdbackup.CreateSnapshotName(descr.Timestamp))
+	tmpName := name + ".tmp"
+
+	// write to a temporary file first, so that the partially uploaded backups are never listed
+	if err = upload(client, tmpName, limiter.NewReader(io.NopCloser(r), s.uploadRate)); err != nil {
+		client.Remove(tmpName) //nolint:errcheck
+
+		return fmt.Errorf("failed to upload backup to sftp: %w", err)
+	}
+
+	if err = client.PosixRename(tmpName, name); err != nil {
+		client.Remove(tmpName) //nolint:errcheck
+
+		return fmt.Errorf("failed to rename backup %q: %w", tmpName, err)
+	}
+
+	return nil
+}
+
+func upload(client *sftpClient, name string, r io.Reader) error {
+	f, err := client.Create(name)
+	if err != nil {
+		return err
+	}
+
+	if _, err = f.ReadFrom(r); err != nil {
+		return errors.Join(err, f.Close())
+	}
+
+	return f.Close()
+}
+
+// Download downloads the backup with the specified name. Implements [etcdbackup.Store].
+func (s *Store) Download(ctx context.Context, _ []byte, clusterUUID, snapshotName string) (etcdbackup.BackupData, io.ReadCloser, error) {
+	rdr, err := s.open(ctx, path.Join(s.config.Path, clusterUUID, snapshotName))
+	if err != nil {
+		return etcdbackup.BackupData{}, nil, err
+	}
+
+	return etcdbackup.BackupData{}, limiter.NewReader(rdr, s.downloadRate), nil
+}
+
+// Delete deletes the backup with the specified name. Implements [etcdbackup.Store].
+func (s *Store) Delete(ctx context.Context, clusterUUID, snapshotName string) error {
+	client, err := s.connect(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer client.Close() //nolint:errcheck
+
+	name := path.Join(s.config.Path, clusterUUID, snapshotName)
+
+	if err = client.Remove(name); err != nil {
+		return fmt.Errorf("failed to delete file %q: %w", name, err)
+	}
+
+	return nil
+}
+
+// ListBackups returns a list of backups. Implements [etcdbackup.Store].
+func (s *Store) ListBackups(ctx context.Context, clusterUUID string) (iter.Seq2[etcdbackup.Info, error], error) {
+	client, err := s.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer client.Close() //nolint:errcheck
+
+	dir := path.Join(s.config.Path, clusterUUID)
+
+	entries, err := client.ReadDir(dir)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to list backups: %w", err)
+	}
+
+	return func(yield func(etcdbackup.Info, error) bool) {
+		for _, entry := range entries {
+			if !entry.Mode().IsRegular() || !strings.HasSuffix(entry.Name(), ".snapshot") {
+				continue
+			}
+
+			timestamp, err := etcdbackup.ParseSnapshotName(entry.Name())
+			if err != nil {
+				if !yield(etcdbackup.Info{}, fmt.Errorf("failed to parse snapshot name: %w", err)) {
+					return
+				}
+
+				continue
+			}
+
+			name := path.Join(dir, entry.Name())
+
+			if !yield(etcdbackup.Info{
+				Snapshot:  entry.Name(),
+				Timestamp: timestamp,
+				Reader:    func() (io.ReadCloser, error) { return s.open(ctx, name) },
+				Size:      entry.Size(),
+			}, nil) {
+				return
+			}
+		}
+	}, nil
+}
+
+// open opens the file, closing the returned reader closes the connection.
+func (s *Store) open(ctx context.Context, name string) (io.ReadCloser, error) {
+	client, err := s.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := client.Open(name)
+	if err != nil {
+		client.Close() //nolint:errcheck
+
+		return nil, fmt.Errorf("failed to open file %q: %w", name, err)
+	}
+
+	return &fileReader{File: f, client: client}, nil
+}
+
+func (s *Store) connect(ctx context.Context) (*sftpClient, error) {
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, "tcp", s.config.Address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %q: %w", s.config.Address, err)
+	}
+
+	// the SSH handshake doesn't accept a context, so bound it with the context deadline
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline) //nolint:errcheck
+	}
+
+	sshConn, chans, reqs, err := ssh.NewClientConn(conn, s.config.Address, s.config.ClientConfig)
+	if err != nil {
+		conn.Close() //nolint:errcheck
+
+		return nil, fmt.Errorf("failed to establish ssh connection to %q: %w", s.config.Address, err)
+	}
+
+	conn.SetDeadline(time.Time{}) //nolint:errcheck
+
+	sshClient := ssh.NewClient(sshConn, chans, reqs)
+
+	client, err := sftp.NewClient(sshClient)
+	if err != nil {
+		sshClient.Close() //nolint:errcheck
+
+		return nil, fmt.Errorf("failed to start sftp session: %w", err)
+	}
+
+	return &sftpClient{Client: client, ssh: sshClient}, nil
+}
+
+type sftpClient struct {
+	*sftp.Client
+
+	ssh *ssh.Client
+}
+
+func (c *sftpClient) Close() error {
+	return errors.Join(c.Client.Close(), c.ssh.Close())
+}
+
+type fileReader struct {
+	*sftp.File
+
+	client *sftpClient
+}
+
+func (r *fileReader) Close() error {
+	return errors.Join(r.File.Close(), r.client.Close())
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package sftpstore_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/sftp"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/etcdbackup/sftpstore"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/etcdbackup/storetest"
+)
+
+const (
+	user     = "omni"
+	password = "secret"
+)
+
+func TestStore(t *testing.T) {
+	address, hostKey := startServer(t)
+
+	store := sftpstore.NewStore(sftpstore.Config{
+		ClientConfig: &ssh.ClientConfig{
+			User:            user,
+			Auth:            []ssh.AuthMethod{ssh.Password(password)},
+			HostKeyCallback: ssh.FixedHostKey(hostKey),
+		},
+		Address: address,
+		Path:    t.TempDir() + "/backups",
+	}, 0, 0)
+
+	require.NoError(t, store.Check(t.Context()))
+
+	storetest.Run(t, store)
+}
+
+func TestStoreHostKeyMismatch(t *testing.T) {
+	address, _ := startServer(t)
+
+	_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	signer, err := ssh.NewSignerFromKey(otherKey)
+	require.NoError(t, err)
+
+	store := sftpstore.NewStore(sftpstore.Config{
+		ClientConfig: &ssh.ClientConfig{
+			User:            user,
+			Auth:            []ssh.AuthMethod{ssh.Password(password)},
+			HostKeyCallback: ssh.FixedHostKey(signer.PublicKey()),
+		},
+		Address: address,
+		Path:    t.TempDir(),
+	}, 0, 0)
+
+	err = store.Upload(t.Context(), etcdbackup.Description{ClusterUUID: "uuid", Timestamp: time.Now()}, strings.NewReader("data"))
+	require.ErrorContains(t, err, "failed to establish ssh connection")
+}
+
+// startServer starts an in-process SFTP server serving the local filesystem.
+func startServer(t *testing.T) (string, ssh.PublicKey) {
+	t.Helper()
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	signer, err := ssh.NewSignerFromKey(key)
+	require.NoError(t, err)
+
+	config := &ssh.ServerConfig{
+		PasswordCallback: func(conn ssh.ConnMetadata, pass []byte) (*ssh.Permissions, error) {
+			if conn.User() == user && string(pass) == password {
+				return nil, nil //nolint:nilnil
+			}
+
+			return nil, errors.New("access denied")
+		},
+	}
+
+	config.AddHostKey(signer)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { listener.Close() }) //nolint:errcheck
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go serveConn(conn, config)
+		}
+	}()
+
+	return listener.Addr().String(), signer.PublicKey()
+}
+
+func serveConn(conn net.Conn, config *ssh.ServerConfig) {
+	defer conn.Close() //nolint:errcheck
+
+	sshConn, chans, reqs, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		return
+	}
+
+	defer sshConn.Close() //nolint:errcheck
+
+	go ssh.DiscardRequests(reqs)
+
+	for newChannel := range chans {
+		if newChannel.ChannelType() != "session" {
+			newChannel.Reject(ssh.UnknownChannelType, "unknown channel type") //nolint:errcheck
+
+			continue
+		}
+
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			return
+		}
+
+		go serveSession(channel, requests)
+	}
+}
+
+func serveSession(channel ssh.Channel, requests <-chan *ssh.Request) {
+	for req := range requests {
+		// the subsystem request payload is the length-prefixed subsystem name
+		ok := req.Type == "subsystem" && len(req.Payload) > 4 &&
+			int(binary.BigEndian.Uint32(req.Payload)) == len(req.Payload)-4 && string(req.Payload[4:]) == "sftp"
+
+		req.Reply(ok, nil) //nolint:errcheck
+
+		if !ok {
+			continue
+		}
+
+		go func() {
+			defer channel.Close() //nolint:errcheck
+
+			server, err := sftp.NewServer(channel)
+			if err != nil {
+				return
+			}
+
+			server.Serve() //nolint:errcheck
+			server.Close() //nolint:errcheck
+		}()
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package storetest provides the common checks for the etcd backup store implementations.
+package storetest
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup"
+)
+
+// Run checks that the store uploads, lists, downloads and deletes the backups of a cluster.
+func Run(t *testing.T, store etcdbackup.Store) {
+	t.Helper()
+
+	ctx := t.Context()
+	clusterUUID := uuid.NewString()
+
+	contents := map[time.Time]string{}
+
+	for i := range 2 {
+		timestamp := time.Unix(1_760_000_000+int64(i)*3600, 0)
+		contents[timestamp] = fmt.Sprintf("snapshot %d of %s", i, clusterUUID)
+
+		require.NoError(t, store.Upload(ctx, etcdbackup.Description{
+			Timestamp:   timestamp,
+			ClusterUUID: clusterUUID,
+			ClusterName: "test",
+		}, strings.NewReader(contents[timestamp])))
+	}
+
+	backups, err := store.ListBackups(ctx, clusterUUID)
+	require.NoError(t, err)
+
+	var listed int
+
+	for info, err := range backups {
+		require.NoError(t, err)
+
+		expected, ok := contents[info.Timestamp]
+		require.True(t, ok, "unexpected backup %q", info.Snapshot)
+		require.Equal(t, etcdbackup.CreateSnapshotName(info.Timestamp), info.Snapshot)
+		require.EqualValues(t, len(expected), info.Size)
+
+		rdr, err := info.Reader()
+		require.NoError(t, err)
+
+		requireContent(t, expected, rdr)
+
+		_, rdr, err = store.Download(ctx, nil, clusterUUID, info.Snapshot)
+		require.NoError(t, err)
+
+		requireContent(t, expected, rdr)
+
+		listed++
+	}
+
+	require.Equal(t, len(contents), listed)
+
+	// the deleted backup is no longer listed
+	deleted := etcdbackup.CreateSnapshotName(time.Unix(1_760_000_000, 0))
+
+	require.NoError(t, store.Delete(ctx, clusterUUID, deleted))
+
+	backups, err = store.ListBackups(ctx, clusterUUID)
+	require.NoError(t, err)
+
+	listed = 0
+
+	for info, err := range backups {
+		require.NoError(t, err)
+		require.NotEqual(t, deleted, info.Snapshot)
+
+		listed++
+	}
+
+	require.Equal(t, len(contents)-1, listed)
+
+	// the backups of other clusters are not listed
+	backups, err = store.ListBackups(ctx, uuid.NewString())
+	require.NoError(t, err)
+
+	for info, err := range backups {
+		require.NoError(t, err)
+		require.Failf(t, "unexpected backup", "%q", info.Snapshot)
+	}
+}
+
+func requireContent(t *testing.T, expected string, rdr io.ReadCloser) {
+	t.Helper()
+
+	defer rdr.Close() //nolint:errcheck
+
+	data, err := io.ReadAll(rdr)
+	require.NoError(t, err)
+	require.Equal(t, expected, string(data))
+}
@@ -13,6 +13,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/url"
 	"slices"
 	"strconv"
@@ -24,7 +25,10 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/rand"
 
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/access/role"
 	"github.com/siderolabs/omni/client/pkg/constants"
+	"github.com/siderolabs/omni/client/pkg/cosi/labels"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/internal/pkg/dnslabel"
 )
@@ -34,10 +38,13 @@ type Reconciler struct {
 	logger                 *zap.Logger
 	exposedServices        map[resource.ID]*omni.ExposedService
 	usedAliases            map[string]resource.ID
+	usedProxyPorts         map[int]resource.ID
 	cluster                string
 	workloadProxySubdomain string
 	advertisedAPIURL       string
 	services               []*corev1.Service
+	minProxyPort           int
+	maxProxyPort           int
 	useOmniSubdomain       bool
 }
 
@@ -49,16 +56,22 @@ func NewReconciler(cluster, workloadProxySubdomain, advertisedAPIURL string, use
 ) (*Reconciler, error) {
 	exposedServicesMap := make(map[resource.ID]*omni.ExposedService, len(exposedServices))
 	usedAliases := make(map[string]resource.ID, len(exposedServicesMap))
+	usedProxyPorts := map[int]resource.ID{}
 
 	for _, exposedService := range exposedServices {
 		alias, _ := exposedService.Metadata().Labels().Get(omni.LabelExposedServiceAlias)
 		exposedServicesMap[exposedService.Metadata().ID()] = exposedService
 		usedAliases[alias] = exposedService.Metadata().ID()
+
+		if proxyPort := exposedService.TypedSpec().Value.ProxyPort; proxyPort != 0 {
+			usedProxyPorts[int(proxyPort)] = exposedService.Metadata().ID()
+		}
 	}
 
 	return &Reconciler{
 		logger:                 logger,
 		usedAliases:            usedAliases,
+		usedProxyPorts:         usedProxyPorts,
 		exposedServices:        exposedServicesMap,
 		cluster:                cluster,
 		workloadProxySubdomain: workloadProxySubdomain,
@@ -68,6 +81,21 @@ func NewReconciler(cluster, workloadProxySubdomain, advertisedAPIURL string, use
 	}, nil
 }
 
+// SetProxyPortRange sets the range the ports of the TCP services are allocated from.
+//
+// The allocatedPorts are the ports which are already taken by the exposed services that might be missing from
+// the exposed services the Reconciler was created with. Without a port range, TCP services are not exposed.
+func (reconciler *Reconciler) SetProxyPortRange(minPort, maxPort int, allocatedPorts map[int]resource.ID) {
+	reconciler.minProxyPort = minPort
+	reconciler.maxProxyPort = maxPort
+
+	for port, id := range allocatedPorts {
+		if _, ok := reconciler.usedProxyPorts[port]; !ok {
+			reconciler.usedProxyPorts[port] = id
+		}
+	}
+}
+
 // ReconcileServices reconciles the ExposedService resources for the given services and returns the ones that should be kept.
 //
 // It is supposed to be called only once.
@@ -197,10 +225,45 @@ func (reconciler *Reconciler) reconcileService(exposedService *omni.ExposedServi
 
 	explicitAliasOpt := reconciler.resolveExplicitAlias(service, port, multiPort, exposedService.Metadata().ID())
 
-	if err = reconciler.updateExposedService(exposedService, explicitAliasOpt, port, label, icon, logger); err != nil {
+	// the access restrictions are applied even if the rest of the service is misconfigured
+	access, accessErr := parseAccess(service, port)
+	exposedService.TypedSpec().Value.Access = access
+
+	identityForwardingStr, _ := annotationValue(service, constants.ExposedServiceIdentityForwardingAnnotationKey, port)
+
+	identityForwarding, identityForwardingErr := parseIdentityForwarding(identityForwardingStr)
+	exposedService.TypedSpec().Value.IdentityForwarding = identityForwarding
+
+	protocolStr, _ := annotationValue(service, constants.ExposedServiceProtocolAnnotationKey, port)
+
+	protocol, protocolErr := parseProtocol(protocolStr)
+	if protocolErr != nil {
+		exposedService.Metadata().Labels().Set(omni.LabelCluster, reconciler.cluster)
+		exposedService.TypedSpec().Value.Error = protocolErr.Error()
+
+		logger.Warn("invalid protocol on Service", zap.Error(protocolErr))
+
+		reconciler.exposedServices[exposedService.Metadata().ID()] = exposedService
+
+		return nil
+	}
+
+	if err = reconciler.updateExposedService(exposedService, explicitAliasOpt, port, label, icon, protocol, logger); err != nil {
 		return fmt.Errorf("error updating exposed service: %w", err)
 	}
 
+	if accessErr != nil && exposedService.TypedSpec().Value.Error == "" {
+		exposedService.TypedSpec().Value.Error = accessErr.Error()
+
+		logger.Warn("invalid access annotations on Service", zap.Error(accessErr))
+	}
+
+	if identityForwardingErr != nil && exposedService.TypedSpec().Value.Error == "" {
+		exposedService.TypedSpec().Value.Error = identityForwardingErr.Error()
+
+		logger.Warn("invalid identity forwarding on Service", zap.Error(identityForwardingErr))
+	}
+
 	alias, _ := exposedService.Metadata().Labels().Get(omni.LabelExposedServiceAlias)
 	reconciler.usedAliases[alias] = exposedService.Metadata().ID()
 	reconciler.exposedServices[exposedService.Metadata().ID()] = exposedService
@@ -241,7 +304,81 @@ func (reconciler *Reconciler) resolveExplicitAlias(service *corev1.Service, port
 	return optional.None[string]()
 }
 
-func (reconciler *Reconciler) updateExposedService(res *omni.ExposedService, explicitAliasOpt optional.Optional[string], port int, label, icon string, logger *zap.Logger) error {
+// parseProtocol parses the value of the protocol annotation, an empty value stands for HTTP.
+func parseProtocol(value string) (specs.ExposedServiceSpec_Protocol, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "http":
+		return specs.ExposedServiceSpec_HTTP, nil
+	case "grpc":
+		return specs.ExposedServiceSpec_GRPC, nil
+	case "tcp":
+		return specs.ExposedServiceSpec_TCP, nil
+	default:
+		return 0, fmt.Errorf("unsupported protocol %q, expected one of http, grpc, tcp", value)
+	}
+}
+
+// parseIdentityForwarding parses the value of the identity forwarding annotation, an empty value stands for none.
+//
+// The identity is not forwarded to a service with an invalid value.
+func parseIdentityForwarding(value string) (specs.ExposedServiceSpec_IdentityForwarding, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "none":
+		return specs.ExposedServiceSpec_NONE, nil
+	case "headers":
+		return specs.ExposedServiceSpec_HEADERS, nil
+	case "jwt":
+		return specs.ExposedServiceSpec_JWT, nil
+	default:
+		return specs.ExposedServiceSpec_NONE, fmt.Errorf("unsupported identity forwarding %q, expected one of none, headers, jwt", value)
+	}
+}
+
+// parseAccess parses the access annotations of the service, it returns nil if the service doesn't restrict the access.
+//
+// Invalid values are kept as they are, so that the workload proxy denies the access to a misconfigured service rather than allowing it.
+func parseAccess(service *corev1.Service, port int) (*specs.ExposedServiceSpec_Access, error) {
+	minRole, _ := annotationValue(service, constants.ExposedServiceMinRoleAnnotationKey, port)
+	users, _ := annotationValue(service, constants.ExposedServiceAllowedUsersAnnotationKey, port)
+	labelSelector, _ := annotationValue(service, constants.ExposedServiceAllowedLabelsAnnotationKey, port)
+
+	minRole = strings.TrimSpace(minRole)
+	labelSelector = strings.TrimSpace(labelSelector)
+
+	access := &specs.ExposedServiceSpec_Access{
+		MinRole:       minRole,
+		LabelSelector: labelSelector,
+	}
+
+	for user := range strings.SplitSeq(users, ",") {
+		if user = strings.TrimSpace(user); user != "" {
+			access.Users = append(access.Users, user)
+		}
+	}
+
+	if access.MinRole == "" && access.LabelSelector == "" && len(access.Users) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	if minRole != "" {
+		if _, err := role.Parse(minRole); err != nil {
+			return access, fmt.Errorf("invalid minimum role %q: %w", minRole, err)
+		}
+	}
+
+	if labelSelector != "" {
+		if _, err := labels.ParseSelectors([]string{labelSelector}); err != nil {
+			return access, fmt.Errorf("invalid allowed labels %q: %w", labelSelector, err)
+		}
+	}
+
+	return access, nil
+}
+
+//nolint:gocyclo,cyclop
+func (reconciler *Reconciler) updateExposedService(res *omni.ExposedService, explicitAliasOpt optional.Optional[string], port int, label, icon string,
+	protocol specs.ExposedServiceSpec_Protocol, logger *zap.Logger,
+) error {
 	res.Metadata().Labels().Set(omni.LabelCluster, reconciler.cluster)
 
 	requestedExplicitAlias, explicitAliasRequested := explicitAliasOpt.Get()
@@ -301,6 +438,26 @@ func (reconciler *Reconciler) updateExposedService(res *omni.ExposedService, exp
 		return nil
 	}
 
+	proxyPort := reconciler.releaseProxyPort(res)
+
+	if protocol == specs.ExposedServiceSpec_TCP {
+		if proxyPort, err = reconciler.allocateProxyPort(res, proxyPort); err != nil {
+			res.TypedSpec().Value.Error = err.Error()
+			res.TypedSpec().Value.Url = ""
+			res.TypedSpec().Value.ProxyPort = 0
+
+			logger.Warn(res.TypedSpec().Value.Error)
+
+			return nil
+		}
+
+		if serviceURL, err = reconciler.buildProxyPortURL(proxyPort); err != nil {
+			return fmt.Errorf("error building exposed service URL for proxy port %d: %w", proxyPort, err)
+		}
+	} else {
+		proxyPort = 0
+	}
+
 	res.Metadata().Labels().Set(omni.LabelExposedServiceAlias, alias)
 
 	// migration code to remove the old, now unused label
@@ -312,10 +469,69 @@ func (reconciler *Reconciler) updateExposedService(res *omni.ExposedService, exp
 	res.TypedSpec().Value.Url = serviceURL
 	res.TypedSpec().Value.Error = ""
 	res.TypedSpec().Value.HasExplicitAlias = explicitAliasRequested
+	res.TypedSpec().Value.Protocol = protocol
+	res.TypedSpec().Value.ProxyPort = uint32(proxyPort)
 
 	return nil
 }
 
+// releaseProxyPort frees the proxy port of the exposed service, returning it, so that the service can keep it.
+func (reconciler *Reconciler) releaseProxyPort(res *omni.ExposedService) int {
+	proxyPort := int(res.TypedSpec().Value.ProxyPort)
+
+	if owner, ok := reconciler.usedProxyPorts[proxyPort]; ok && owner == res.Metadata().ID() {
+		delete(reconciler.usedProxyPorts, proxyPort)
+	}
+
+	return proxyPort
+}
+
+// allocateProxyPort picks the proxy port of a TCP service, preferring the port the service already had.
+func (reconciler *Reconciler) allocateProxyPort(res *omni.ExposedService, currentPort int) (int, error) {
+	if reconciler.minProxyPort == 0 || reconciler.maxProxyPort == 0 {
+		return 0, errors.New("tcp services are not enabled on this Omni instance")
+	}
+
+	isFree := func(port int) bool {
+		owner, ok := reconciler.usedProxyPorts[port]
+
+		return !ok || owner == res.Metadata().ID()
+	}
+
+	if currentPort >= reconciler.minProxyPort && currentPort <= reconciler.maxProxyPort && isFree(currentPort) {
+		reconciler.usedProxyPorts[currentPort] = res.Metadata().ID()
+
+		return currentPort, nil
+	}
+
+	for port := reconciler.minProxyPort; port <= reconciler.maxProxyPort; port++ {
+		if !isFree(port) {
+			continue
+		}
+
+		reconciler.usedProxyPorts[port] = res.Metadata().ID()
+
+		return port, nil
+	}
+
+	return 0, errors.New("no more ports available for the tcp services to allocate")
+}
+
+// buildProxyPortURL builds the URL of a TCP service, which is the Omni host with the proxy port of the service.
+func (reconciler *Reconciler) buildProxyPortURL(proxyPort int) (string, error) {
+	apiURL, err := url.Parse(reconciler.advertisedAPIURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid advertised API URL: %w", err)
+	}
+
+	serviceURL := &url.URL{
+		Scheme: "tcp",
+		Host:   net.JoinHostPort(apiURL.Hostname(), strconv.Itoa(proxyPort)),
+	}
+
+	return serviceURL.String(), nil
+}
+
 func (reconciler *Reconciler) buildExposedServiceURL(alias string) (string, error) {
 	if alias == "" {
 		return "", errors.New("empty alias")
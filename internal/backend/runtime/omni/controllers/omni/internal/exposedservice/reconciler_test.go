@@ -8,14 +8,17 @@ package exposedservice_test
 import (
 	"fmt"
 	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/constants"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/exposedservice"
@@ -389,6 +392,204 @@ func TestReconcilerMultiPortServicePortPartIgnored(t *testing.T) {
 	assert.Equal(t, uint32(30443), exposedServices[1].TypedSpec().Value.Port)
 }
 
+func TestReconcilerProtocols(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+
+	kubernetesServices := makeKubernetesServices(
+		kubernetesService{ns: "default", name: "web", port: "30080"},
+		kubernetesService{ns: "default", name: "api", port: "30081"},
+		kubernetesService{ns: "default", name: "db", port: "30082"},
+		kubernetesService{ns: "default", name: "bad", port: "30083"},
+	)
+
+	kubernetesServices[1].Annotations[constants.ExposedServiceProtocolAnnotationKey] = "grpc"
+	kubernetesServices[2].Annotations[constants.ExposedServiceProtocolAnnotationKey] = "TCP"
+	kubernetesServices[3].Annotations[constants.ExposedServiceProtocolAnnotationKey] = "udp"
+
+	reconciler, err := exposedservice.NewReconciler(testClusterName, testProxySubdomain, "https://omni.example.com", false, nil, kubernetesServices, logger)
+	require.NoError(t, err)
+
+	reconciler.SetProxyPortRange(40000, 40010, map[int]resource.ID{40000: "other-cluster/db.default"})
+
+	exposedServices, err := reconciler.ReconcileServices()
+	require.NoError(t, err)
+
+	require.Len(t, exposedServices, 4)
+
+	byName := map[string]*omni.ExposedService{}
+
+	for _, exposedService := range exposedServices {
+		byName[exposedService.TypedSpec().Value.Label] = exposedService
+	}
+
+	web := byName["web.default"].TypedSpec().Value
+	assert.Equal(t, specs.ExposedServiceSpec_HTTP, web.Protocol)
+	assert.Zero(t, web.ProxyPort)
+	assert.Empty(t, web.Error)
+
+	api := byName["api.default"].TypedSpec().Value
+	assert.Equal(t, specs.ExposedServiceSpec_GRPC, api.Protocol)
+	assert.Zero(t, api.ProxyPort)
+	assert.True(t, strings.HasPrefix(api.Url, "https://"), api.Url)
+
+	db := byName["db.default"].TypedSpec().Value
+	assert.Equal(t, specs.ExposedServiceSpec_TCP, db.Protocol)
+	assert.Empty(t, db.Error)
+	assert.Equal(t, uint32(40001), db.ProxyPort, "the port allocated to the other cluster must be skipped")
+	assert.Equal(t, "tcp://omni.example.com:40001", db.Url)
+
+	assert.Contains(t, byName["bad.default"].TypedSpec().Value.Error, `unsupported protocol "udp"`)
+
+	// the TCP service keeps its port across the reconciles
+	reconciler, err = exposedservice.NewReconciler(testClusterName, testProxySubdomain, "https://omni.example.com", false, exposedServices, kubernetesServices, logger)
+	require.NoError(t, err)
+
+	reconciler.SetProxyPortRange(40000, 40010, nil)
+
+	exposedServices, err = reconciler.ReconcileServices()
+	require.NoError(t, err)
+
+	for _, exposedService := range exposedServices {
+		if exposedService.TypedSpec().Value.Label == "db.default" {
+			assert.Equal(t, uint32(40001), exposedService.TypedSpec().Value.ProxyPort)
+		}
+	}
+}
+
+func TestReconcilerTCPDisabled(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+
+	kubernetesServices := makeKubernetesServices(
+		kubernetesService{ns: "default", name: "db", port: "30082"},
+	)
+
+	kubernetesServices[0].Annotations[constants.ExposedServiceProtocolAnnotationKey] = "tcp"
+
+	reconciler, err := exposedservice.NewReconciler(testClusterName, testProxySubdomain, "https://omni.example.com", false, nil, kubernetesServices, logger)
+	require.NoError(t, err)
+
+	exposedServices, err := reconciler.ReconcileServices()
+	require.NoError(t, err)
+
+	require.Len(t, exposedServices, 1)
+
+	spec := exposedServices[0].TypedSpec().Value
+	assert.Contains(t, spec.Error, "tcp services are not enabled")
+	assert.Empty(t, spec.Url)
+	assert.Zero(t, spec.ProxyPort)
+}
+
+func TestReconcilerTCPPortsExhausted(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+
+	kubernetesServices := makeKubernetesServices(
+		kubernetesService{ns: "default", name: "db", port: "30082,30083"},
+	)
+
+	kubernetesServices[0].Annotations[constants.ExposedServiceProtocolAnnotationKey] = "tcp"
+
+	reconciler, err := exposedservice.NewReconciler(testClusterName, testProxySubdomain, "https://omni.example.com", false, nil, kubernetesServices, logger)
+	require.NoError(t, err)
+
+	reconciler.SetProxyPortRange(40000, 40000, nil)
+
+	exposedServices, err := reconciler.ReconcileServices()
+	require.NoError(t, err)
+
+	require.Len(t, exposedServices, 2)
+
+	assert.Equal(t, uint32(40000), exposedServices[0].TypedSpec().Value.ProxyPort)
+	assert.Empty(t, exposedServices[0].TypedSpec().Value.Error)
+
+	assert.Zero(t, exposedServices[1].TypedSpec().Value.ProxyPort)
+	assert.Contains(t, exposedServices[1].TypedSpec().Value.Error, "no more ports available")
+}
+
+func TestReconcilerAccess(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+
+	kubernetesServices := makeKubernetesServices(
+		kubernetesService{ns: "default", name: "app", port: "30080,30443"},
+		kubernetesService{ns: "default", name: "open", port: "30081"},
+		kubernetesService{ns: "default", name: "bad", port: "30082"},
+	)
+
+	kubernetesServices[0].Annotations[constants.ExposedServiceMinRoleAnnotationKey] = "Operator"
+	kubernetesServices[0].Annotations[constants.ExposedServiceAllowedUsersAnnotationKey] = " alice@example.com, group/sre ,"
+	kubernetesServices[0].Annotations[constants.ExposedServiceAllowedLabelsAnnotationKey+"-30443"] = "saml.omni.sidero.dev/role/ops"
+
+	kubernetesServices[2].Annotations[constants.ExposedServiceMinRoleAnnotationKey] = "Superuser"
+
+	reconciler, err := exposedservice.NewReconciler(testClusterName, testProxySubdomain, "https://omni.example.com", false, nil, kubernetesServices, logger)
+	require.NoError(t, err)
+
+	exposedServices, err := reconciler.ReconcileServices()
+	require.NoError(t, err)
+
+	require.Len(t, exposedServices, 4)
+
+	byName := map[string]*omni.ExposedService{}
+
+	for _, exposedService := range exposedServices {
+		byName[exposedService.TypedSpec().Value.Label] = exposedService
+	}
+
+	ui := byName["app.default:30080"].TypedSpec().Value
+	require.NotNil(t, ui.Access)
+	assert.Equal(t, "Operator", ui.Access.MinRole)
+	assert.Equal(t, []string{"alice@example.com", "group/sre"}, ui.Access.Users)
+	assert.Empty(t, ui.Access.LabelSelector)
+
+	api := byName["app.default:30443"].TypedSpec().Value
+	require.NotNil(t, api.Access)
+	assert.Equal(t, "saml.omni.sidero.dev/role/ops", api.Access.LabelSelector)
+
+	assert.Nil(t, byName["open.default"].TypedSpec().Value.Access)
+
+	// the invalid restrictions are kept on the service, so that the access to it is denied
+	bad := byName["bad.default"].TypedSpec().Value
+	require.NotNil(t, bad.Access)
+	assert.Equal(t, "Superuser", bad.Access.MinRole)
+	assert.Contains(t, bad.Error, "invalid minimum role")
+}
+
+func TestReconcilerIdentityForwarding(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+
+	kubernetesServices := makeKubernetesServices(
+		kubernetesService{ns: "default", name: "app", port: "30080,30443"},
+		kubernetesService{ns: "default", name: "jwt", port: "30081"},
+		kubernetesService{ns: "default", name: "bad", port: "30082"},
+	)
+
+	kubernetesServices[0].Annotations[constants.ExposedServiceIdentityForwardingAnnotationKey] = "Headers"
+	kubernetesServices[0].Annotations[constants.ExposedServiceIdentityForwardingAnnotationKey+"-30443"] = "none"
+	kubernetesServices[1].Annotations[constants.ExposedServiceIdentityForwardingAnnotationKey] = "jwt"
+	kubernetesServices[2].Annotations[constants.ExposedServiceIdentityForwardingAnnotationKey] = "cookies"
+
+	reconciler, err := exposedservice.NewReconciler(testClusterName, testProxySubdomain, "https://omni.example.com", false, nil, kubernetesServices, logger)
+	require.NoError(t, err)
+
+	exposedServices, err := reconciler.ReconcileServices()
+	require.NoError(t, err)
+
+	require.Len(t, exposedServices, 4)
+
+	byName := map[string]*omni.ExposedService{}
+
+	for _, exposedService := range exposedServices {
+		byName[exposedService.TypedSpec().Value.Label] = exposedService
+	}
+
+	assert.Equal(t, specs.ExposedServiceSpec_HEADERS, byName["app.default:30080"].TypedSpec().Value.IdentityForwarding)
+	assert.Equal(t, specs.ExposedServiceSpec_NONE, byName["app.default:30443"].TypedSpec().Value.IdentityForwarding)
+	assert.Equal(t, specs.ExposedServiceSpec_JWT, byName["jwt.default"].TypedSpec().Value.IdentityForwarding)
+
+	bad := byName["bad.default"].TypedSpec().Value
+	assert.Equal(t, specs.ExposedServiceSpec_NONE, bad.IdentityForwarding)
+	assert.Contains(t, bad.Error, `unsupported identity forwarding "cookies"`)
+}
+
 func TestReconcilerLegacyBareIDPreservedSinglePort(t *testing.T) {
 	// A pre-existing single-port ExposedService uses the legacy "<cluster>/<svc>.<ns>" ID
 	// (no host port suffix). After upgrade, a single-port reconcile must reuse that ID so
@@ -18,6 +18,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/siderolabs/gen/xslices"
 
+	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/system"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/helpers"
@@ -69,6 +70,7 @@ type ReconciliationContext struct {
 	machineSetNodesMap        map[resource.ID]*omni.MachineSetNode
 	clusterMachinesMap        map[resource.ID]*omni.ClusterMachine
 	clusterMachineStatusesMap map[resource.ID]*omni.ClusterMachineStatus
+	upgradeRollout            *omni.UpgradeRollout
 
 	clusterName string
 	configHash  string
@@ -140,7 +142,12 @@ func BuildReconciliationContext(ctx context.Context, r controller.Reader, machin
 		return nil, fmt.Errorf("failed to list machine statuses for the machine set %q: %w", machineSet.Metadata().ID(), err)
 	}
 
-	return NewReconciliationContext(
+	upgradeRollout, err := safe.ReaderGetByID[*omni.UpgradeRollout](ctx, r, clusterName)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	rc, err := NewReconciliationContext(
 		cluster,
 		machineSet,
 		loadBalancerStatus,
@@ -151,6 +158,36 @@ func BuildReconciliationContext(ctx context.Context, r controller.Reader, machin
 		toSlice(machinePendingUpdates),
 		toSlice(clusterMachineStatuses),
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	rc.SetUpgradeRollout(upgradeRollout)
+
+	return rc, nil
+}
+
+// SetUpgradeRollout sets the upgrade rollout of the cluster, which holds the state of the machine set canary rollouts.
+func (rc *ReconciliationContext) SetUpgradeRollout(upgradeRollout *omni.UpgradeRollout) {
+	rc.upgradeRollout = upgradeRollout
+}
+
+// GetUpgradeCanary returns the state of the canary rollout of the Talos upgrades in the machine set, nil if there is none.
+func (rc *ReconciliationContext) GetUpgradeCanary() *specs.CanaryRolloutStatus {
+	if rc.upgradeRollout == nil {
+		return nil
+	}
+
+	return rc.upgradeRollout.TypedSpec().Value.MachineSetsUpgradeCanary[rc.machineSet.Metadata().ID()]
+}
+
+// GetUpdateCanary returns the state of the canary rollout of the config updates in the machine set, nil if there is none.
+func (rc *ReconciliationContext) GetUpdateCanary() *specs.CanaryRolloutStatus {
+	if rc.upgradeRollout == nil {
+		return nil
+	}
+
+	return rc.upgradeRollout.TypedSpec().Value.MachineSetsUpdateCanary[rc.machineSet.Metadata().ID()]
 }
 
 // NewReconciliationContext creates new state for machine set status controller reconciliation flow.
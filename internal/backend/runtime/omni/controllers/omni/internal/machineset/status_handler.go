@@ -43,6 +43,8 @@ func ReconcileStatus(rc *ReconciliationContext, machineSetStatus *omni.MachineSe
 	}
 
 	spec.MachineAllocation = omni.GetMachineAllocation(machineSet)
+	spec.UpgradeCanary = rc.GetUpgradeCanary()
+	spec.UpdateCanary = rc.GetUpdateCanary()
 
 	switch {
 	case len(machineSetNodes) < int(spec.Machines.Requested):
@@ -0,0 +1,187 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package maintenance implements holding the disruptive cluster operations until their maintenance window opens.
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic"
+	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/pkg/maintenancewindow"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+// WindowID returns the ID of the maintenance window which applies to the machine set of the cluster.
+//
+// The machine set window overrides the cluster window, machineSet might be nil.
+func WindowID(cluster *omni.Cluster, machineSet *omni.MachineSet) string {
+	if machineSet != nil && machineSet.TypedSpec().Value.MaintenanceWindow != "" {
+		return machineSet.TypedSpec().Value.MaintenanceWindow
+	}
+
+	if cluster == nil {
+		return ""
+	}
+
+	return cluster.TypedSpec().Value.MaintenanceWindow
+}
+
+// Status is the state of a maintenance window at some point in time.
+type Status struct {
+	// Start and End are the bounds of the current window if it's open, or of the next one.
+	Start time.Time
+	End   time.Time
+
+	// WindowID is empty if there is no maintenance window, so the operations are never held.
+	WindowID string
+
+	// invalid is set if the window doesn't exist or can't be parsed.
+	invalid string
+}
+
+// GetStatus returns the status of the maintenance window at the given time.
+//
+// A missing or an invalid window holds the operations, as it's not known when they are allowed.
+func GetStatus(ctx context.Context, r controller.Reader, windowID string, now time.Time) (*Status, error) {
+	if windowID == "" {
+		return &Status{}, nil
+	}
+
+	res, err := safe.ReaderGetByID[*omni.MaintenanceWindow](ctx, r, windowID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return &Status{WindowID: windowID, invalid: "not found"}, nil
+		}
+
+		return nil, err
+	}
+
+	window, err := maintenancewindow.New(res.TypedSpec().Value)
+	if err != nil {
+		return &Status{WindowID: windowID, invalid: err.Error()}, nil //nolint:nilerr
+	}
+
+	status := &Status{WindowID: windowID}
+	status.Start, status.End = window.Next(now)
+
+	return status, nil
+}
+
+// IsOpen returns true if the operations are allowed at the given time.
+func (s *Status) IsOpen(now time.Time) bool {
+	if s.WindowID == "" {
+		return true
+	}
+
+	return s.invalid == "" && !s.Start.IsZero() && !s.Start.After(now)
+}
+
+// Reason returns the human-readable reason of holding the operations.
+func (s *Status) Reason() string {
+	switch {
+	case s.invalid != "":
+		return fmt.Sprintf("waiting for maintenance window %q: %s", s.WindowID, s.invalid)
+	case s.Start.IsZero():
+		return fmt.Sprintf("waiting for maintenance window %q: the window never opens", s.WindowID)
+	default:
+		return fmt.Sprintf("waiting for maintenance window %q (opens at %s)", s.WindowID, s.Start.UTC().Format(time.RFC3339))
+	}
+}
+
+// RequeueAfter returns the interval after which the held operations should be re-checked.
+//
+// It's zero if the window will never open by itself, then the change of the window resource triggers the reconcile.
+func (s *Status) RequeueAfter(now time.Time) time.Duration {
+	if s.invalid != "" || s.Start.IsZero() {
+		return 0
+	}
+
+	// add a second, so that the window is surely open when the controller runs again
+	return max(s.Start.Sub(now), 0) + time.Second
+}
+
+// MapToClusters returns a mapper which maps a maintenance window to the clusters it applies to, either directly or via their machine sets.
+func MapToClusters() qtransform.MapperFunc {
+	return func(ctx context.Context, _ *zap.Logger, r controller.QRuntime, in controller.ReducedResourceMetadata) ([]resource.Pointer, error) {
+		clusterIDs, err := clustersUsingWindow(ctx, r, in.ID())
+		if err != nil {
+			return nil, err
+		}
+
+		pointers := make([]resource.Pointer, 0, len(clusterIDs))
+
+		for _, id := range clusterIDs {
+			pointers = append(pointers, omni.NewCluster(id).Metadata())
+		}
+
+		return pointers, nil
+	}
+}
+
+// MapToLabeledResources returns a mapper which maps a maintenance window to the resources labeled with the clusters it applies to.
+func MapToLabeledResources[O generic.ResourceWithRD]() qtransform.MapperFunc {
+	return func(ctx context.Context, _ *zap.Logger, r controller.QRuntime, in controller.ReducedResourceMetadata) ([]resource.Pointer, error) {
+		clusterIDs, err := clustersUsingWindow(ctx, r, in.ID())
+		if err != nil {
+			return nil, err
+		}
+
+		var pointers []resource.Pointer
+
+		for _, id := range clusterIDs {
+			items, err := safe.ReaderListAll[O](ctx, r, state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, id)))
+			if err != nil {
+				return nil, err
+			}
+
+			pointers = slices.AppendSeq(pointers, items.Pointers())
+		}
+
+		return pointers, nil
+	}
+}
+
+func clustersUsingWindow(ctx context.Context, r controller.Reader, windowID string) ([]string, error) {
+	clusters, err := safe.ReaderListAll[*omni.Cluster](ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	machineSets, err := safe.ReaderListAll[*omni.MachineSet](ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	var clusterIDs []string
+
+	for cluster := range clusters.All() {
+		if cluster.TypedSpec().Value.MaintenanceWindow == windowID {
+			clusterIDs = append(clusterIDs, cluster.Metadata().ID())
+		}
+	}
+
+	for machineSet := range machineSets.All() {
+		if machineSet.TypedSpec().Value.MaintenanceWindow != windowID {
+			continue
+		}
+
+		if clusterID, ok := machineSet.Metadata().Labels().Get(omni.LabelCluster); ok && !slices.Contains(clusterIDs, clusterID) {
+			clusterIDs = append(clusterIDs, clusterID)
+		}
+	}
+
+	return clusterIDs, nil
+}
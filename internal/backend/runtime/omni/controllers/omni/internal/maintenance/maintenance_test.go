@@ -0,0 +1,74 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package maintenance_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/maintenance"
+)
+
+func TestWindowID(t *testing.T) {
+	t.Parallel()
+
+	cluster := omni.NewCluster("c")
+	cluster.TypedSpec().Value.MaintenanceWindow = "cluster-window"
+
+	machineSet := omni.NewMachineSet(omni.WorkersResourceID("c"))
+
+	assert.Equal(t, "cluster-window", maintenance.WindowID(cluster, nil))
+	assert.Equal(t, "cluster-window", maintenance.WindowID(cluster, machineSet))
+
+	machineSet.TypedSpec().Value.MaintenanceWindow = "machine-set-window"
+
+	assert.Equal(t, "machine-set-window", maintenance.WindowID(cluster, machineSet))
+	assert.Empty(t, maintenance.WindowID(nil, nil))
+}
+
+func TestGetStatus(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	window := omni.NewMaintenanceWindow("nightly")
+	window.TypedSpec().Value.Schedule = "0 22 * * *"
+	window.TypedSpec().Value.Duration = durationpb.New(4 * time.Hour)
+
+	require.NoError(t, st.Create(ctx, window))
+
+	closedAt := time.Date(2026, time.October, 16, 10, 0, 0, 0, time.UTC)
+	openAt := time.Date(2026, time.October, 16, 23, 0, 0, 0, time.UTC)
+
+	status, err := maintenance.GetStatus(ctx, st, "", closedAt)
+	require.NoError(t, err)
+	assert.True(t, status.IsOpen(closedAt))
+
+	status, err = maintenance.GetStatus(ctx, st, "nightly", closedAt)
+	require.NoError(t, err)
+	assert.False(t, status.IsOpen(closedAt))
+	assert.Equal(t, `waiting for maintenance window "nightly" (opens at 2026-10-16T22:00:00Z)`, status.Reason())
+	assert.Equal(t, 12*time.Hour+time.Second, status.RequeueAfter(closedAt))
+
+	status, err = maintenance.GetStatus(ctx, st, "nightly", openAt)
+	require.NoError(t, err)
+	assert.True(t, status.IsOpen(openAt))
+
+	status, err = maintenance.GetStatus(ctx, st, "weekly", openAt)
+	require.NoError(t, err)
+	assert.False(t, status.IsOpen(openAt))
+	assert.Equal(t, `waiting for maintenance window "weekly": not found`, status.Reason())
+	assert.Zero(t, status.RequeueAfter(openAt))
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package secretrotation
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"slices"
+
+	talosconstants "github.com/siderolabs/talos/pkg/machinery/constants"
+	"go.yaml.in/yaml/v4"
+)
+
+// ServiceAccountKeysPath is the path of the file with the service account keys which kube-apiserver verifies the service account tokens with
+// while the service account key is rotated.
+//
+// Talos writes the service account key from the machine config to it.
+const ServiceAccountKeysPath = talosconstants.KubernetesAPIServerSecretsDir + "/service-account.key"
+
+// ServiceAccountKeysPatch returns the machine config patch which makes kube-apiserver verify the service account tokens
+// with both the current and the extra service account keys.
+//
+// Talos passes the public key of a single key to kube-apiserver, so the service account key in the machine config is replaced with
+// the bundle of both keys, and the --service-account-key-file flag points at the key file Talos writes instead of the public key.
+// kube-apiserver signs the tokens with the first key of the bundle and accepts the tokens signed by any of them.
+//
+// The key file is a part of the cluster config, so Talos rewrites it and restarts kube-apiserver without a reboot
+// whenever the bundle changes.
+func ServiceAccountKeysPatch(key, extraKey []byte) (string, error) {
+	bundle, err := ServiceAccountKeyBundle(key, extraKey)
+	if err != nil {
+		return "", err
+	}
+
+	patch := map[string]any{
+		"cluster": map[string]any{
+			"serviceAccount": map[string]any{
+				"key": base64.StdEncoding.EncodeToString(bundle),
+			},
+			"apiServer": map[string]any{
+				"extraArgs": map[string]string{
+					"service-account-key-file": ServiceAccountKeysPath,
+				},
+			},
+		},
+	}
+
+	data, err := yaml.Marshal(patch)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal the service account keys patch: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// ServiceAccountKeyBundle returns the bundle of the current and the extra PEM encoded service account keys.
+func ServiceAccountKeyBundle(key, extraKey []byte) ([]byte, error) {
+	for _, privateKey := range [][]byte{key, extraKey} {
+		if block, _ := pem.Decode(privateKey); block == nil {
+			return nil, errors.New("failed to decode the service account key PEM")
+		}
+	}
+
+	return slices.Concat(bytes.TrimSpace(key), []byte("\n"), bytes.TrimSpace(extraKey), []byte("\n")), nil
+}
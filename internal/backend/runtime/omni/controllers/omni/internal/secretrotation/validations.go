@@ -15,6 +15,7 @@ import (
 	"fmt"
 	"io"
 	"path/filepath"
+	"slices"
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/safe"
@@ -44,6 +45,14 @@ func (c Candidate) Validate(ctx context.Context, lbConfig *omni.LoadBalancerConf
 		return c.validateTalosCARotation(ctx, status, secrets)
 	case specs.SecretRotationSpec_KUBERNETES_CA:
 		return c.validateKubernetesCARotation(ctx, lbConfig, secrets, status)
+	case specs.SecretRotationSpec_ETCD_CA:
+		return c.validateEtcdCARotation(ctx, status, secrets)
+	case specs.SecretRotationSpec_KUBERNETES_AGGREGATOR_CA:
+		return c.validateKubernetesAggregatorCARotation(ctx, lbConfig, secrets, status)
+	case specs.SecretRotationSpec_KUBERNETES_SERVICE_ACCOUNT_KEY:
+		return c.validateKubernetesServiceAccountKeyRotation(ctx, lbConfig, secrets, status)
+	case specs.SecretRotationSpec_BOOTSTRAP_TOKEN:
+		return c.validateBootstrapTokenRotation(ctx, status, secrets)
 	case specs.SecretRotationSpec_NONE:
 		return false, fmt.Errorf("unexpected rotation component: %s", secrets.TypedSpec().Value.Rotation.Component.String())
 	}
@@ -93,17 +102,173 @@ func (c Candidate) validateKubernetesCARotation(ctx context.Context, lbConfig *o
 		}
 	}
 
-	k8sClient, err := c.getKubernetesClient(secrets, lbConfig)
+	if err = c.checkNodesReady(ctx, lbConfig, secrets); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (c Candidate) validateEtcdCARotation(ctx context.Context, status *omni.ClusterMachineStatus, secrets *omni.ClusterMachineSecrets) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	talosClient, err := c.getTalosClient(ctx, status, secrets)
+	if err != nil {
+		return false, err
+	}
+	defer talosClient.Close() //nolint:errcheck
+
+	if _, err = talosClient.Version(ctx); err != nil {
+		return false, err
+	}
+
+	if !c.ControlPlane {
+		return true, nil
+	}
+
+	secretsBundle, err := omni.ToSecretsBundle(secrets.TypedSpec().Value.GetData())
+	if err != nil {
+		return false, fmt.Errorf("failed to parse ClusterMachineSecrets: %w", err)
+	}
+
+	return c.checkCABundle(ctx, talosClient, filepath.Join(talosconstants.EtcdPKIPath, "ca.crt"), secretsBundle.Certs.Etcd.Crt, secrets.TypedSpec().Value.Rotation.ExtraCerts.GetEtcd())
+}
+
+func (c Candidate) validateKubernetesAggregatorCARotation(
+	ctx context.Context,
+	lbConfig *omni.LoadBalancerConfig,
+	secrets *omni.ClusterMachineSecrets,
+	status *omni.ClusterMachineStatus,
+) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	if c.ControlPlane {
+		talosClient, err := c.getTalosClient(ctx, status, secrets)
+		if err != nil {
+			return false, err
+		}
+		defer talosClient.Close() //nolint:errcheck
+
+		secretsBundle, err := omni.ToSecretsBundle(secrets.TypedSpec().Value.GetData())
+		if err != nil {
+			return false, fmt.Errorf("failed to parse ClusterMachineSecrets: %w", err)
+		}
+
+		valid, err := c.checkCABundle(ctx, talosClient, filepath.Join(talosconstants.KubernetesAPIServerSecretsDir, "aggregator-ca.crt"),
+			secretsBundle.Certs.K8sAggregator.Crt, secrets.TypedSpec().Value.Rotation.ExtraCerts.GetK8SAggregator())
+		if !valid || err != nil {
+			return valid, err
+		}
+	}
+
+	if err := c.checkNodesReady(ctx, lbConfig, secrets); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// validateKubernetesServiceAccountKeyRotation checks that the control plane machines have the expected service account keys on the disk
+// and that Kubernetes is healthy after the service account key was replaced.
+func (c Candidate) validateKubernetesServiceAccountKeyRotation(
+	ctx context.Context,
+	lbConfig *omni.LoadBalancerConfig,
+	secrets *omni.ClusterMachineSecrets,
+	status *omni.ClusterMachineStatus,
+) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	talosClient, err := c.getTalosClient(ctx, status, secrets)
+	if err != nil {
+		return false, err
+	}
+	defer talosClient.Close() //nolint:errcheck
+
+	if _, err = talosClient.Version(ctx); err != nil {
+		return false, err
+	}
+
+	if c.ControlPlane {
+		valid, checkErr := c.checkServiceAccountKeys(ctx, talosClient, secrets)
+		if !valid || checkErr != nil {
+			return valid, checkErr
+		}
+	}
+
+	if err = c.checkNodesReady(ctx, lbConfig, secrets); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// checkServiceAccountKeys checks that the service account keys the machine has on the disk are the current and the extra keys of the rotation.
+func (c Candidate) checkServiceAccountKeys(ctx context.Context, talosClient *client.Client, secrets *omni.ClusterMachineSecrets) (bool, error) {
+	secretsBundle, err := omni.ToSecretsBundle(secrets.TypedSpec().Value.GetData())
+	if err != nil {
+		return false, fmt.Errorf("failed to parse ClusterMachineSecrets: %w", err)
+	}
+
+	if secretsBundle.Certs.K8sServiceAccount == nil {
+		return true, nil
+	}
+
+	expected := secretsBundle.Certs.K8sServiceAccount.Key
+
+	if extraKey := secrets.TypedSpec().Value.GetRotation().GetExtraCerts().GetK8SSa(); extraKey != nil {
+		if expected, err = ServiceAccountKeyBundle(expected, extraKey.Key); err != nil {
+			return false, err
+		}
+	}
+
+	r, err := talosClient.Read(ctx, ServiceAccountKeysPath)
+	if err != nil {
+		return false, fmt.Errorf("failed to read %s: %w", ServiceAccountKeysPath, err)
+	}
+
+	defer r.Close() //nolint:errcheck
+
+	actual, err := io.ReadAll(r)
+	if err != nil {
+		return false, fmt.Errorf("failed to read %s: %w", ServiceAccountKeysPath, err)
+	}
+
+	return slices.Equal(pemBlocks(actual), pemBlocks(expected)), nil
+}
+
+// validateBootstrapTokenRotation checks that the machine is reachable after the config with the new bootstrap token was applied.
+func (c Candidate) validateBootstrapTokenRotation(ctx context.Context, status *omni.ClusterMachineStatus, secrets *omni.ClusterMachineSecrets) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	talosClient, err := c.getTalosClient(ctx, status, secrets)
 	if err != nil {
 		return false, err
 	}
+	defer talosClient.Close() //nolint:errcheck
+
+	if _, err = talosClient.Version(ctx); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (c Candidate) checkNodesReady(ctx context.Context, lbConfig *omni.LoadBalancerConfig, secrets *omni.ClusterMachineSecrets) error {
+	k8sClient, err := c.getKubernetesClient(secrets, lbConfig)
+	if err != nil {
+		return err
+	}
 	defer k8sClient.Close()
 
 	clientset := k8sClient.Clientset()
 
 	nodes, err := clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
 	if err != nil {
-		return false, err
+		return err
 	}
 
 	var notReadyNodes []string
@@ -121,10 +286,55 @@ func (c Candidate) validateKubernetesCARotation(ctx context.Context, lbConfig *o
 	}
 
 	if len(notReadyNodes) > 0 {
-		return false, fmt.Errorf("nodes not ready: %q", notReadyNodes)
+		return fmt.Errorf("nodes not ready: %q", notReadyNodes)
 	}
 
-	return true, nil
+	return nil
+}
+
+// checkCABundle checks that the CA bundle the machine has on the disk consists of the expected certificates.
+//
+// Mismatches are expected during rotation convergence, so it returns (false, nil) rather than an error.
+func (c Candidate) checkCABundle(ctx context.Context, talosClient *client.Client, path string, crt []byte, extraCA *specs.ClusterSecretsSpec_Certs_CA) (bool, error) {
+	r, err := talosClient.Read(ctx, path)
+	if err != nil {
+		return false, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	actual, err := c.getCertBytes(r)
+	if err != nil {
+		return false, fmt.Errorf("failed to read certificate from %s: %w", path, err)
+	}
+
+	expected := crt
+	if extraCA != nil {
+		expected = CABundle(crt, extraCA.Crt)
+	}
+
+	return slices.Equal(pemBlocks(actual), pemBlocks(expected)), nil
+}
+
+func pemBlocks(data []byte) []string {
+	var blocks []string
+
+	for {
+		var block *pem.Block
+
+		block, data = pem.Decode(data)
+		if block == nil {
+			return blocks
+		}
+
+		blocks = append(blocks, string(block.Bytes))
+	}
+}
+
+// CABundle returns the bundle of the current and the new certificate authority, which is set in the machine config
+// for the certificate authorities Talos has no separate list of the accepted CAs for.
+//
+// Talos issues the certificates with the first certificate of the bundle and the key, and trusts all certificates of the bundle.
+func CABundle(crt, extraCrt []byte) []byte {
+	return slices.Concat(bytes.TrimSpace(crt), []byte("\n"), bytes.TrimSpace(extraCrt), []byte("\n"))
 }
 
 func (c Candidate) getTalosClient(ctx context.Context, status *omni.ClusterMachineStatus, secrets *omni.ClusterMachineSecrets) (*client.Client, error) {
@@ -364,6 +574,15 @@ func (c Candidate) kubernetesAPIClientCertificateFromSecrets(secrets *omni.Clust
 		return nil, nil, err
 	}
 
+	if secrets.TypedSpec().Value.Rotation.Component != specs.SecretRotationSpec_KUBERNETES_CA {
+		clientCert, certErr := certs.NewKubernetesCertificateAndKey(secretsBundle.Certs.K8s, certificateValidity)
+		if certErr != nil {
+			return nil, nil, fmt.Errorf("error generating Kubernetes API certificate: %w", certErr)
+		}
+
+		return clientCert, secretsBundle.Certs.K8s.Crt, nil
+	}
+
 	switch secrets.TypedSpec().Value.Rotation.Phase {
 	case specs.SecretRotationSpec_PRE_ROTATE:
 		newCA := &talosx509.PEMEncodedCertificateAndKey{
@@ -9,6 +9,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -48,6 +49,7 @@ import (
 	"github.com/siderolabs/omni/internal/backend/runtime/kubernetes"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/helpers"
 	omniconsts "github.com/siderolabs/omni/internal/pkg/constants"
+	"github.com/siderolabs/omni/internal/pkg/helm"
 )
 
 // KubernetesRuntime provides kubernetes cluster access capabilities.
@@ -59,6 +61,7 @@ type KubernetesRuntime interface {
 // ClusterManifestsStatusController manages config version for each cluster.
 type ClusterManifestsStatusController struct {
 	kubernetesRuntime KubernetesRuntime
+	helmRenderer      *helm.Renderer
 	generic.NamedController
 }
 
@@ -69,6 +72,7 @@ func NewClusterManifestsStatusController(kubernetesRuntime KubernetesRuntime) *C
 			ControllerName: "ClusterKubernetesManifestsStatusController",
 		},
 		kubernetesRuntime: kubernetesRuntime,
+		helmRenderer:      helm.NewRenderer(),
 	}
 
 	return ctrl
@@ -101,6 +105,11 @@ func (ctrl *ClusterManifestsStatusController) Settings() controller.QSettings {
 				Type:      omni.ClusterStatusType,
 				Kind:      controller.InputQMapped,
 			},
+			{
+				Namespace: resources.DefaultNamespace,
+				Type:      omni.ConfigPatchType,
+				Kind:      controller.InputQMapped,
+			},
 		},
 		Outputs: []controller.Output{
 			{
@@ -121,7 +130,7 @@ func (ctrl *ClusterManifestsStatusController) MapInput(_ context.Context, _ *zap
 		return []resource.Pointer{
 			omni.NewCluster(ptr.ID()).Metadata(),
 		}, nil
-	case omni.KubernetesManifestGroupType:
+	case omni.KubernetesManifestGroupType, omni.ConfigPatchType:
 		clusterID, ok := ptr.Labels().Get(omni.LabelCluster)
 		if !ok {
 			return nil, nil
@@ -244,7 +253,7 @@ func (ctrl *ClusterManifestsStatusController) reconcileRunning(ctx context.Conte
 
 		var manifests []*unstructured.Unstructured
 
-		manifests, err = ctrl.readManifests(res)
+		manifests, err = ctrl.readManifests(ctx, r, client, cluster, res)
 		if err != nil {
 			return err
 		}
@@ -272,7 +281,24 @@ func (ctrl *ClusterManifestsStatusController) reconcileRunning(ctx context.Conte
 		return nil
 	})
 	if err != nil {
-		return err
+		var renderErr *helmRenderError
+
+		if !errors.As(err, &renderErr) {
+			return err
+		}
+
+		// do not apply anything if any of the charts can't be rendered, as it would prune the objects of the chart
+		if statusErr := safe.WriterModify(ctx, r, omni.NewClusterKubernetesManifestsStatus(cluster.Metadata().ID()),
+			func(res *omni.ClusterKubernetesManifestsStatus) error {
+				res.TypedSpec().Value.LastError = renderErr.Error()
+
+				return nil
+			},
+		); statusErr != nil {
+			return statusErr
+		}
+
+		return controller.NewRequeueError(err, time.Minute)
 	}
 
 	id := cluster.Metadata().ID()
@@ -471,10 +497,28 @@ func (ctrl *ClusterManifestsStatusController) reconcileTearingDown(ctx context.C
 	return r.RemoveFinalizer(ctx, cluster.Metadata(), ctrl.Name())
 }
 
-func (ctrl *ClusterManifestsStatusController) readManifests(res *omni.KubernetesManifestGroup) ([]*unstructured.Unstructured, error) {
-	manifests, err := res.TypedSpec().Value.GetManifests()
-	if err != nil {
-		return nil, err
+func (ctrl *ClusterManifestsStatusController) readManifests(
+	ctx context.Context,
+	r controller.Reader,
+	client *kubernetes.Client,
+	cluster *omni.Cluster,
+	res *omni.KubernetesManifestGroup,
+) ([]*unstructured.Unstructured, error) {
+	var (
+		manifests []*unstructured.Unstructured
+		err       error
+	)
+
+	if source := res.TypedSpec().Value.GetHelm(); source != nil {
+		manifests, err = ctrl.renderChart(ctx, r, client, cluster, source)
+		if err != nil {
+			return nil, &helmRenderError{group: res.Metadata().ID(), err: err}
+		}
+	} else {
+		manifests, err = res.TypedSpec().Value.GetManifests()
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	for _, obj := range manifests {
@@ -492,6 +536,82 @@ func (ctrl *ClusterManifestsStatusController) readManifests(res *omni.Kubernetes
 	return manifests, nil
 }
 
+// renderChart renders the Helm chart, reading the values from the config patches of the cluster.
+//
+// The namespaced objects without a namespace are put into the release namespace, the same way Helm does it on install.
+func (ctrl *ClusterManifestsStatusController) renderChart(
+	ctx context.Context,
+	r controller.Reader,
+	client *kubernetes.Client,
+	cluster *omni.Cluster,
+	source *specs.KubernetesManifestGroupSpec_HelmSource,
+) ([]*unstructured.Unstructured, error) {
+	valueFiles := make([][]byte, 0, len(source.ValuesFrom))
+
+	for _, patchID := range source.ValuesFrom {
+		patch, err := safe.ReaderGetByID[*omni.ConfigPatch](ctx, r, patchID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get values from config patch %q: %w", patchID, err)
+		}
+
+		if patchCluster, _ := patch.Metadata().Labels().Get(omni.LabelCluster); patchCluster != cluster.Metadata().ID() {
+			return nil, fmt.Errorf("config patch %q doesn't belong to the cluster", patchID)
+		}
+
+		buffer, err := patch.TypedSpec().Value.GetUncompressedData()
+		if err != nil {
+			return nil, err
+		}
+
+		valueFiles = append(valueFiles, slices.Clone(buffer.Data()))
+
+		buffer.Free()
+	}
+
+	manifests, err := ctrl.helmRenderer.Render(ctx, source, valueFiles, cluster.TypedSpec().Value.KubernetesVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace := source.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	for _, obj := range manifests {
+		if obj.GetNamespace() != "" {
+			continue
+		}
+
+		gvk := obj.GroupVersionKind()
+
+		mapping, err := client.Mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if err != nil {
+			// the kind is not known yet, e.g. the CRD is created by the same chart
+			continue
+		}
+
+		if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+			obj.SetNamespace(namespace)
+		}
+	}
+
+	return manifests, nil
+}
+
+type helmRenderError struct {
+	err   error
+	group string
+}
+
+func (e *helmRenderError) Error() string {
+	return fmt.Sprintf("failed to render the helm chart of the manifest group %q: %s", e.group, e.err)
+}
+
+func (e *helmRenderError) Unwrap() error {
+	return e.err
+}
+
 func (ctrl *ClusterManifestsStatusController) sync(
 	ctx context.Context,
 	logger *zap.Logger,
@@ -9,6 +9,8 @@ import (
 	"cmp"
 	"context"
 	"fmt"
+	"iter"
+	"maps"
 	"slices"
 	"strings"
 	"sync/atomic"
@@ -18,7 +20,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/siderolabs/gen/channel"
-	"github.com/siderolabs/gen/maps"
+	xmaps "github.com/siderolabs/gen/maps"
 	"github.com/siderolabs/gen/optional"
 	"github.com/siderolabs/gen/xiter"
 	"go.uber.org/zap"
@@ -46,15 +48,23 @@ type KubernetesStatusController struct {
 	kubernetesRuntime KubernetesRuntime
 	watchers          map[string]*kubernetesWatcher
 
+	// proxyPortsByCluster tracks the proxy ports of the TCP services in-memory to avoid depending on the COSI reader cache,
+	// which may lag behind writes and cause duplicate port allocations.
+	proxyPortsByCluster map[string]map[int]resource.ID
+
 	advertisedAPIURL       string
 	workloadProxySubdomain string
+	minProxyPort           int
+	maxProxyPort           int
 	workloadProxyEnabled   bool
 	useOmniSubdomain       bool
 }
 
 // NewKubernetesStatusController creates a new KubernetesStatusController.
+//
+// The TCP services are allocated their proxy ports from the range between minProxyPort and maxProxyPort.
 func NewKubernetesStatusController(kubernetesRuntime KubernetesRuntime, advertisedAPIURL, workloadProxySubdomain string,
-	workloadProxyEnabled, useOmniSubdomain bool,
+	workloadProxyEnabled, useOmniSubdomain bool, minProxyPort, maxProxyPort int,
 ) *KubernetesStatusController {
 	return &KubernetesStatusController{
 		kubernetesRuntime:      kubernetesRuntime,
@@ -62,6 +72,8 @@ func NewKubernetesStatusController(kubernetesRuntime KubernetesRuntime, advertis
 		workloadProxySubdomain: workloadProxySubdomain,
 		workloadProxyEnabled:   workloadProxyEnabled,
 		useOmniSubdomain:       useOmniSubdomain,
+		minProxyPort:           minProxyPort,
+		maxProxyPort:           maxProxyPort,
 	}
 }
 
@@ -131,6 +143,13 @@ func (ctrl *KubernetesStatusController) Run(ctx context.Context, r controller.Ru
 		ctrl.watchers = nil
 	}()
 
+	exposedServices, err := safe.ReaderListAll[*omni.ExposedService](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing exposed services: %w", err)
+	}
+
+	ctrl.seedProxyPorts(exposedServices.All())
+
 	notifyCh := make(chan kubernetesWatcherNotify)
 
 	for {
@@ -179,6 +198,50 @@ func (ctrl *KubernetesStatusController) Run(ctx context.Context, r controller.Ru
 	}
 }
 
+// seedProxyPorts tracks the proxy ports of the exposed services of the clusters which are not tracked yet, so that the ports allocated
+// before the controller was started are never allocated to the services of another cluster, whichever cluster is reconciled first.
+func (ctrl *KubernetesStatusController) seedProxyPorts(exposedServices iter.Seq[*omni.ExposedService]) {
+	if ctrl.proxyPortsByCluster == nil {
+		ctrl.proxyPortsByCluster = map[string]map[int]resource.ID{}
+	}
+
+	seeded := map[string]map[int]resource.ID{}
+
+	for exposedService := range exposedServices {
+		proxyPort := exposedService.TypedSpec().Value.ProxyPort
+
+		cluster, ok := exposedService.Metadata().Labels().Get(omni.LabelCluster)
+		if !ok || proxyPort == 0 {
+			continue
+		}
+
+		if _, tracked := ctrl.proxyPortsByCluster[cluster]; tracked {
+			continue
+		}
+
+		if seeded[cluster] == nil {
+			seeded[cluster] = map[int]resource.ID{}
+		}
+
+		seeded[cluster][int(proxyPort)] = exposedService.Metadata().ID()
+	}
+
+	maps.Copy(ctrl.proxyPortsByCluster, seeded)
+}
+
+// allocatedProxyPorts returns the proxy ports of the TCP services of all the clusters except the given one.
+func (ctrl *KubernetesStatusController) allocatedProxyPorts(cluster string) map[int]resource.ID {
+	allocatedProxyPorts := map[int]resource.ID{}
+
+	for otherCluster, ports := range ctrl.proxyPortsByCluster {
+		if otherCluster != cluster {
+			maps.Copy(allocatedProxyPorts, ports)
+		}
+	}
+
+	return allocatedProxyPorts
+}
+
 func (ctrl *KubernetesStatusController) updateExposedServices(ctx context.Context, r controller.Runtime, cluster string, services []*corev1.Service, logger *zap.Logger) error {
 	tracker := trackResource(r, resources.DefaultNamespace, omni.ExposedServiceType, state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, cluster)))
 
@@ -194,11 +257,27 @@ func (ctrl *KubernetesStatusController) updateExposedServices(ctx context.Contex
 		return fmt.Errorf("error creating exposed service reconciler: %w", err)
 	}
 
+	reconciler.SetProxyPortRange(ctrl.minProxyPort, ctrl.maxProxyPort, ctrl.allocatedProxyPorts(cluster))
+
 	servicesToUpdate, err := reconciler.ReconcileServices()
 	if err != nil {
 		return fmt.Errorf("error reconciling services: %w", err)
 	}
 
+	clusterProxyPorts := map[int]resource.ID{}
+
+	for _, service := range servicesToUpdate {
+		if proxyPort := service.TypedSpec().Value.ProxyPort; proxyPort != 0 {
+			clusterProxyPorts[int(proxyPort)] = service.Metadata().ID()
+		}
+	}
+
+	if ctrl.proxyPortsByCluster == nil {
+		ctrl.proxyPortsByCluster = map[string]map[int]resource.ID{}
+	}
+
+	ctrl.proxyPortsByCluster[cluster] = clusterProxyPorts
+
 	for _, service := range servicesToUpdate {
 		version := service.Metadata().Version()
 
@@ -224,6 +303,8 @@ func (ctrl *KubernetesStatusController) updateExposedServices(ctx context.Contex
 				zap.Bool("has_explicit_alias", updatedService.TypedSpec().Value.HasExplicitAlias),
 				zap.String("url", updatedService.TypedSpec().Value.Url),
 				zap.Uint32("port", updatedService.TypedSpec().Value.Port),
+				zap.Stringer("protocol", updatedService.TypedSpec().Value.Protocol),
+				zap.Uint32("proxy_port", updatedService.TypedSpec().Value.ProxyPort),
 				zap.String("label", updatedService.TypedSpec().Value.Label),
 				zap.String("error", updatedService.TypedSpec().Value.Error),
 			)
@@ -335,6 +416,13 @@ func (ctrl *KubernetesStatusController) reconcileRunners(ctx context.Context, r
 		}
 	}
 
+	// free the proxy ports of the clusters which do not have secrets
+	for cluster := range ctrl.proxyPortsByCluster {
+		if _, ok := secretsPresent[cluster]; !ok {
+			delete(ctrl.proxyPortsByCluster, cluster)
+		}
+	}
+
 	// cleanup exposed services which are
 	// - either destroy ready
 	// - or clusters which do not have secrets
@@ -559,7 +647,7 @@ func (w *kubernetesWatcher) podsSync(ctx context.Context, notifyCh chan<- kubern
 		podsByNode[pod.Spec.NodeName] = append(podsByNode[pod.Spec.NodeName], pod)
 	}
 
-	nodes := maps.Keys(podsByNode)
+	nodes := xmaps.Keys(podsByNode)
 	slices.Sort(nodes)
 
 	status.StaticPods = make([]*specs.KubernetesStatusSpec_NodeStaticPods, 0, len(nodes))
@@ -0,0 +1,79 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/constants"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/exposedservice"
+)
+
+func TestKubernetesStatusProxyPortsAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	newTCPService := func(cluster, id string, proxyPort uint32) *omni.ExposedService {
+		exposedService := omni.NewExposedService(id)
+		exposedService.Metadata().Labels().Set(omni.LabelCluster, cluster)
+		exposedService.TypedSpec().Value.Protocol = specs.ExposedServiceSpec_TCP
+		exposedService.TypedSpec().Value.ProxyPort = proxyPort
+
+		return exposedService
+	}
+
+	// the controller is started with the exposed services which were allocated their ports before the restart
+	ctrl := omnictrl.NewKubernetesStatusController(nil, "https://omni.example.com", "", true, false, 40000, 40010)
+	ctrl.SeedProxyPorts([]*omni.ExposedService{
+		newTCPService("cluster-b", "cluster-b-db", 40000),
+		newTCPService("cluster-b", "cluster-b-web", 0),
+	})
+
+	allocated := ctrl.AllocatedProxyPorts("cluster-a")
+	assert.Equal(t, map[int]resource.ID{40000: "cluster-b-db"}, allocated)
+	assert.Empty(t, ctrl.AllocatedProxyPorts("cluster-b"))
+
+	// the cluster reconciled first after the restart must not be allocated the port of the other cluster
+	kubernetesServices := []*corev1.Service{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "db",
+				Annotations: map[string]string{
+					constants.ExposedServicePortAnnotationKey:     "30082",
+					constants.ExposedServiceProtocolAnnotationKey: "TCP",
+				},
+			},
+		},
+	}
+
+	reconciler, err := exposedservice.NewReconciler("cluster-a", "proxy", "https://omni.example.com", false, nil, kubernetesServices, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	reconciler.SetProxyPortRange(40000, 40010, allocated)
+
+	exposedServices, err := reconciler.ReconcileServices()
+	require.NoError(t, err)
+	require.Len(t, exposedServices, 1)
+
+	assert.Equal(t, uint32(40001), exposedServices[0].TypedSpec().Value.ProxyPort)
+
+	// the clusters which are already tracked are not seeded again, the tracked ports are more recent than the listed ones
+	ctrl.SeedProxyPorts([]*omni.ExposedService{
+		newTCPService("cluster-b", "cluster-b-db", 40005),
+	})
+
+	assert.Equal(t, map[int]resource.ID{40000: "cluster-b-db"}, ctrl.AllocatedProxyPorts("cluster-a"))
+}
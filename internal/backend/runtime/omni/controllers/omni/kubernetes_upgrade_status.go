@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/controller/generic/qtransform"
@@ -22,6 +23,7 @@ import (
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/kubernetes"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/maintenance"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/mappers"
 )
 
@@ -195,6 +197,26 @@ func NewKubernetesUpgradeStatusController() *KubernetesUpgradeStatusController {
 						return nil
 					}
 
+					now := time.Now()
+
+					window, windowErr := machineMaintenanceWindow(ctx, r, cluster, patch.MachineID, now)
+					if windowErr != nil {
+						return windowErr
+					}
+
+					if !window.IsOpen(now) {
+						upgradeStatus.TypedSpec().Value.Phase = specs.KubernetesUpgradeStatusSpec_Upgrading
+						upgradeStatus.TypedSpec().Value.Step = patch.Description
+						upgradeStatus.TypedSpec().Value.Status = window.Reason()
+						upgradeStatus.TypedSpec().Value.Error = ""
+
+						if requeueAfter := window.RequeueAfter(now); requeueAfter > 0 {
+							return controller.NewRequeueInterval(requeueAfter)
+						}
+
+						return nil
+					}
+
 					upgradeStatus.TypedSpec().Value.Phase = specs.KubernetesUpgradeStatusSpec_Upgrading
 					upgradeStatus.TypedSpec().Value.Step = patch.Description
 					upgradeStatus.TypedSpec().Value.Status = "waiting for a restart"
@@ -298,6 +320,12 @@ func NewKubernetesUpgradeStatusController() *KubernetesUpgradeStatusController {
 		qtransform.WithExtraMappedInput[*omni.ClusterMachineStatus](
 			mappers.MapByClusterLabel[*omni.Cluster](),
 		),
+		qtransform.WithExtraMappedInput[*omni.MachineSet](
+			mappers.MapByClusterLabel[*omni.Cluster](),
+		),
+		qtransform.WithExtraMappedInput[*omni.MaintenanceWindow](
+			maintenance.MapToClusters(),
+		),
 		qtransform.WithExtraOutputs(
 			controller.Output{
 				Type: omni.ConfigPatchType,
@@ -312,6 +340,26 @@ func NewKubernetesUpgradeStatusController() *KubernetesUpgradeStatusController {
 	)
 }
 
+// machineMaintenanceWindow returns the status of the maintenance window which applies to the cluster machine.
+func machineMaintenanceWindow(ctx context.Context, r controller.Reader, cluster *omni.Cluster, machineID string, now time.Time) (*maintenance.Status, error) {
+	var machineSet *omni.MachineSet
+
+	clusterMachineStatus, err := safe.ReaderGetByID[*omni.ClusterMachineStatus](ctx, r, machineID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	if clusterMachineStatus != nil {
+		if machineSetID, ok := clusterMachineStatus.Metadata().Labels().Get(omni.LabelMachineSet); ok {
+			if machineSet, err = safe.ReaderGetByID[*omni.MachineSet](ctx, r, machineSetID); err != nil && !state.IsNotFoundError(err) {
+				return nil, err
+			}
+		}
+	}
+
+	return maintenance.GetStatus(ctx, r, maintenance.WindowID(cluster, machineSet), now)
+}
+
 func updateImagePullRequest(ctx context.Context, r controller.ReaderWriter, upgradePath *kubernetes.UpgradePath) (sts *omni.ImagePullStatus, done bool, err error) {
 	request, err := safe.WriterModifyWithResult[*omni.ImagePullRequest](ctx, r, omni.NewImagePullRequest(upgradePath.ClusterID), func(r *omni.ImagePullRequest) error {
 		var nodeImageList []*specs.ImagePullRequestSpec_NodeImageList
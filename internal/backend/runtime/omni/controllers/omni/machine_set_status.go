@@ -79,6 +79,9 @@ func NewMachineSetStatusController() *MachineSetStatusController {
 		qtransform.WithExtraMappedInput[*omni.LoadBalancerStatus](
 			mappers.MapClusterResourceToLabeledResources[*omni.MachineSet](),
 		),
+		qtransform.WithExtraMappedInput[*omni.UpgradeRollout](
+			mappers.MapClusterResourceToLabeledResources[*omni.MachineSet](),
+		),
 		qtransform.WithExtraMappedInput[*machineStatusLabels](
 			mappers.MapByMachineSetLabel[*omni.MachineSet](),
 		),
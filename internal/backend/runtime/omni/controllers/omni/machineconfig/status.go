@@ -41,6 +41,7 @@ import (
 	"github.com/siderolabs/omni/internal/backend/installimage"
 	"github.com/siderolabs/omni/internal/backend/kernelargs"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/helpers"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/maintenance"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/mappers"
 	talosutils "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/talos"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/uncached"
@@ -148,6 +149,12 @@ func NewStatusController(lifecycleManager LifecycleManager) *StatusController {
 		qtransform.WithExtraMappedInput[*omni.Cluster](
 			mappers.MapClusterResourceToLabeledResources[*omni.ClusterMachineConfig](),
 		),
+		qtransform.WithExtraMappedInput[*omni.MachineSet](
+			mappers.MapMachineSetToLabeledResources[*omni.ClusterMachineConfig](),
+		),
+		qtransform.WithExtraMappedInput[*omni.MaintenanceWindow](
+			maintenance.MapToLabeledResources[*omni.ClusterMachineConfig](),
+		),
 		qtransform.WithExtraMappedInput[*omni.MachineSetNode](
 			qtransform.MapperSameID[*omni.ClusterMachineConfig](),
 		),
@@ -256,9 +263,30 @@ func (ctrl *StatusController) reconcileRunning(
 	// window leaves the recorded sha matching the desired config. Comparing the last pushed config
 	// lets us notice the machine still runs the reverted change and re-apply, instead of treating it
 	// as in sync.
+	machineConfigStatus.TypedSpec().Value.PendingReason = ""
+
 	if machineConfigStatus.TypedSpec().Value.ClusterMachineConfigSha256 != shaSumString || configChanged {
-		mode, err = ctrl.applyConfig(ctx, logger, r, rc)
+		now := time.Now()
+
+		window, windowErr := ctrl.maintenanceWindow(ctx, r, rc, now)
+		if windowErr != nil {
+			return windowErr
+		}
+
+		mode, err = ctrl.applyConfig(ctx, logger, r, rc, !window.IsOpen(now))
 		if err != nil {
+			if errors.Is(err, errRebootHeld) {
+				logger.Info("config change requires a reboot, holding it until the maintenance window opens", zap.String("window", window.WindowID))
+
+				machineConfigStatus.TypedSpec().Value.PendingReason = window.Reason()
+
+				if requeueAfter := window.RequeueAfter(now); requeueAfter > 0 {
+					return controller.NewRequeueInterval(requeueAfter)
+				}
+
+				return nil
+			}
+
 			grpcSt := client.Status(err)
 			if grpcSt != nil && grpcSt.Code() == codes.InvalidArgument {
 				machineConfigStatus.TypedSpec().Value.LastConfigError = grpcSt.Message()
@@ -956,12 +984,42 @@ func (ctrl *StatusController) stageUpgrade(actualTalosVersion string) (bool, err
 	return version.Major == 1 && version.Minor == 9 && version.Patch < 3, nil
 }
 
-var errAcquireConfigLock = errors.New("failed to acquire config update lock")
+var (
+	errAcquireConfigLock = errors.New("failed to acquire config update lock")
+	errRebootHeld        = errors.New("config change requires a reboot outside of the maintenance window")
+)
 
+// maintenanceWindow returns the status of the maintenance window which applies to the machine.
+func (ctrl *StatusController) maintenanceWindow(ctx context.Context, r controller.Reader, rc *ReconciliationContext, now time.Time) (*maintenance.Status, error) {
+	var (
+		cluster    *omni.Cluster
+		machineSet *omni.MachineSet
+		err        error
+	)
+
+	if clusterName, ok := rc.machineConfig.Metadata().Labels().Get(omni.LabelCluster); ok {
+		if cluster, err = safe.ReaderGetByID[*omni.Cluster](ctx, r, clusterName); err != nil && !state.IsNotFoundError(err) {
+			return nil, err
+		}
+	}
+
+	if machineSetName, ok := rc.machineConfig.Metadata().Labels().Get(omni.LabelMachineSet); ok {
+		if machineSet, err = safe.ReaderGetByID[*omni.MachineSet](ctx, r, machineSetName); err != nil && !state.IsNotFoundError(err) {
+			return nil, err
+		}
+	}
+
+	return maintenance.GetStatus(ctx, r, maintenance.WindowID(cluster, machineSet), now)
+}
+
+// applyConfig applies the machine config to the machine.
+//
+// If holdReboots is set, the config changes which require a reboot of a configured machine are not applied, errRebootHeld is returned instead.
 func (ctrl *StatusController) applyConfig(inputCtx context.Context,
 	logger *zap.Logger,
 	r controller.ReaderWriter,
 	rc *ReconciliationContext,
+	holdReboots bool,
 ) (machineapi.ApplyConfigurationRequest_Mode, error) {
 	ctx, cancel := context.WithTimeout(inputCtx, 5*time.Second)
 	defer cancel()
@@ -1011,6 +1069,23 @@ func (ctrl *StatusController) applyConfig(inputCtx context.Context,
 
 	defer data.Free()
 
+	// outside of the maintenance window only the changes which don't reboot the machine are applied,
+	// the dry run reports the mode the change would be applied with
+	if holdReboots && !applyMaintenance && rc.machineConfigStatus.TypedSpec().Value.ClusterMachineConfigSha256 != "" {
+		dryRun, dryRunErr := c.ApplyConfiguration(ctx, &machineapi.ApplyConfigurationRequest{
+			Data:   data.Data(),
+			Mode:   machineapi.ApplyConfigurationRequest_AUTO,
+			DryRun: true,
+		})
+		if dryRunErr != nil {
+			return 0, fmt.Errorf("failed to dry run config apply on machine '%s': %w", rc.ID(), dryRunErr)
+		}
+
+		if len(dryRun.Messages) == 1 && dryRun.Messages[0].GetMode() == machineapi.ApplyConfigurationRequest_REBOOT {
+			return 0, errRebootHeld
+		}
+	}
+
 	if err = ctrl.acquireConfigUpdateLock(ctx, r, rc); err != nil {
 		return 0, err
 	}
@@ -1434,6 +1509,10 @@ func (ctrl *StatusController) acquireUpgradeLock(ctx context.Context, r controll
 		return xerrors.NewTaggedf[qtransform.SkipReconcileTag]("%w: waiting for free quota for upgrade", errAcquireUpgradeLock)
 	}
 
+	if !omni.IsCanaryUpdateAllowed(rollout.TypedSpec().Value.MachineSetsUpgradeCanary[machineSetName], rc.clusterMachine.Metadata().ID()) {
+		return xerrors.NewTaggedf[qtransform.SkipReconcileTag]("%w: waiting for the canaries to be verified", errAcquireUpgradeLock)
+	}
+
 	clusterMachines, err := ctrl.listClusterMachines(ctx, r, clusterName)
 	if err != nil {
 		return err
@@ -1496,6 +1575,12 @@ func (ctrl *StatusController) acquireConfigUpdateLock(ctx context.Context, r con
 
 	updateParallelism := omni.GetParallelism(machineSetConfigStatus.TypedSpec().Value.UpdateStrategy, machineSetConfigStatus.TypedSpec().Value.UpdateStrategyConfig, 1)
 
+	if machineSetConfigStatus.TypedSpec().Value.UpdateStrategy == specs.MachineSetSpec_Canary {
+		if updateParallelism, err = ctrl.canaryUpdateParallelism(ctx, r, rc, machineSetName, machineSetConfigStatus); err != nil {
+			return err
+		}
+	}
+
 	quota := updateParallelism
 
 	pendingMachines := []string{}
@@ -1515,6 +1600,36 @@ func (ctrl *StatusController) acquireConfigUpdateLock(ctx context.Context, r con
 	return r.AddFinalizer(ctx, rc.clusterMachine.Metadata(), ConfigUpdateFinalizer)
 }
 
+// canaryUpdateParallelism returns the config update parallelism of the machine set in the current phase of its canary rollout.
+func (ctrl *StatusController) canaryUpdateParallelism(ctx context.Context, r controller.ReaderWriter, rc *ReconciliationContext,
+	machineSetName string, machineSetConfigStatus *omni.MachineSetConfigStatus,
+) (int, error) {
+	clusterName, ok := rc.clusterMachine.Metadata().Labels().Get(omni.LabelCluster)
+	if !ok {
+		return 0, errors.New("failed to get cluster name from the cluster machine")
+	}
+
+	rollout, err := safe.ReaderGetByID[*omni.UpgradeRollout](ctx, r, clusterName)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return 0, fmt.Errorf("%w: waiting for the canary rollout to be planned", errAcquireConfigLock)
+		}
+
+		return 0, fmt.Errorf("failed to get upgrade rollout: %w", err)
+	}
+
+	canary := rollout.TypedSpec().Value.MachineSetsUpdateCanary[machineSetName]
+	if canary == nil {
+		return 0, fmt.Errorf("%w: waiting for the canary rollout to be planned", errAcquireConfigLock)
+	}
+
+	if !omni.IsCanaryUpdateAllowed(canary, rc.clusterMachine.Metadata().ID()) {
+		return 0, fmt.Errorf("%w: waiting for the canaries to be verified: %s", errAcquireConfigLock, canary.Reason)
+	}
+
+	return omni.GetCanaryParallelism(canary, machineSetConfigStatus.TypedSpec().Value.UpdateStrategy, machineSetConfigStatus.TypedSpec().Value.UpdateStrategyConfig, 1), nil
+}
+
 func (ctrl *StatusController) releaseConfigUpdateLock(ctx context.Context, r controller.ReaderWriter, clusterMachine *omni.ClusterMachine) error {
 	if clusterMachine == nil || !clusterMachine.Metadata().Finalizers().Has(ConfigUpdateFinalizer) {
 		return nil
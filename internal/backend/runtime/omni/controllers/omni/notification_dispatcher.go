@@ -0,0 +1,645 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/helpers"
+	"github.com/siderolabs/omni/internal/pkg/notification"
+)
+
+const (
+	notificationMaxAttempts   = 5
+	notificationRetryInterval = 10 * time.Second
+	notificationMaxRetryDelay = 5 * time.Minute
+	notificationRetryCheck    = 5 * time.Second
+)
+
+// NotificationDispatcherController detects the events happening to the clusters and the machines,
+// and delivers them to the notification channels selected by the notification rules.
+//
+// The events are detected by comparing the observed state of the resources with the previous observation,
+// so the state which is already there when the controller starts doesn't produce any events.
+type NotificationDispatcherController struct {
+	results      chan notificationResult
+	observations map[string]string
+	lastSent     map[string]time.Time
+	channels     map[resource.ID]*notificationChannelState
+	synced       bool
+}
+
+// NewNotificationDispatcherController initializes NotificationDispatcherController.
+func NewNotificationDispatcherController() *NotificationDispatcherController {
+	return &NotificationDispatcherController{
+		results:      make(chan notificationResult),
+		observations: map[string]string{},
+		lastSent:     map[string]time.Time{},
+		channels:     map[resource.ID]*notificationChannelState{},
+	}
+}
+
+type notificationDelivery struct {
+	notBefore time.Time
+	event     notification.Event
+	attempt   int
+}
+
+type notificationResult struct {
+	err      error
+	delivery *notificationDelivery
+	channel  resource.ID
+}
+
+type notificationChannelState struct {
+	sender    notification.Sender
+	senderErr error
+	status    *specs.NotificationChannelStatusSpec
+	version   string
+	queue     []*notificationDelivery
+	inFlight  bool
+}
+
+// observation is the observed state of a resource, event builds the event for the state change, nil if the change is not worth a notification.
+type observation struct {
+	event func(previous string) *notification.Event
+	key   string
+	value string
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *NotificationDispatcherController) Name() string {
+	return "NotificationDispatcherController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *NotificationDispatcherController) Inputs() []controller.Input {
+	return []controller.Input{
+		safe.Input[*omni.NotificationChannel](controller.InputWeak),
+		safe.Input[*omni.NotificationRule](controller.InputWeak),
+		safe.Input[*omni.Cluster](controller.InputWeak),
+		safe.Input[*omni.ClusterStatus](controller.InputWeak),
+		safe.Input[*omni.TalosUpgradeStatus](controller.InputWeak),
+		safe.Input[*omni.KubernetesUpgradeStatus](controller.InputWeak),
+		safe.Input[*omni.EtcdBackupStatus](controller.InputWeak),
+		safe.Input[*omni.MachineStatus](controller.InputWeak),
+		safe.Input[*omni.ClusterSecretsRotationStatus](controller.InputWeak),
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *NotificationDispatcherController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: omni.NotificationChannelStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *NotificationDispatcherController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	ticker := time.NewTicker(notificationRetryCheck)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+			if err := ctrl.reconcile(ctx, r, logger); err != nil {
+				return err
+			}
+		case result := <-ctrl.results:
+			ctrl.handleResult(result, logger)
+		case <-ticker.C:
+		}
+
+		ctrl.startDeliveries(ctx)
+
+		if err := ctrl.writeStatuses(ctx, r); err != nil {
+			return err
+		}
+	}
+}
+
+func (ctrl *NotificationDispatcherController) reconcile(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if err := ctrl.updateChannels(ctx, r); err != nil {
+		return err
+	}
+
+	rules, err := ctrl.rules(ctx, r, logger)
+	if err != nil {
+		return err
+	}
+
+	clusters, err := safe.ReaderListAll[*omni.Cluster](ctx, r)
+	if err != nil {
+		return fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	clusterLabels := make(map[resource.ID]*resource.Labels, clusters.Len())
+
+	for cluster := range clusters.All() {
+		clusterLabels[cluster.Metadata().ID()] = cluster.Metadata().Labels()
+	}
+
+	observations, err := ctrl.observe(ctx, r)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	for _, event := range ctrl.detectEvents(observations) {
+		event.Time = now
+
+		ctrl.route(event, rules, clusterLabels[event.Cluster], now)
+	}
+
+	return nil
+}
+
+// updateChannels rebuilds the senders of the changed channels and drops the removed ones along with their undelivered events.
+func (ctrl *NotificationDispatcherController) updateChannels(ctx context.Context, r controller.Runtime) error {
+	channels, err := safe.ReaderListAll[*omni.NotificationChannel](ctx, r)
+	if err != nil {
+		return fmt.Errorf("failed to list notification channels: %w", err)
+	}
+
+	existing := make(map[resource.ID]struct{}, channels.Len())
+
+	for channel := range channels.All() {
+		id := channel.Metadata().ID()
+		existing[id] = struct{}{}
+
+		channelState, ok := ctrl.channels[id]
+		if !ok {
+			// keep the counters across the controller restarts
+			status, statusErr := safe.ReaderGetByID[*omni.NotificationChannelStatus](ctx, r, id)
+			if statusErr != nil && !state.IsNotFoundError(statusErr) {
+				return fmt.Errorf("failed to get notification channel status: %w", statusErr)
+			}
+
+			channelState = &notificationChannelState{status: &specs.NotificationChannelStatusSpec{}}
+
+			if status != nil {
+				channelState.status = status.TypedSpec().Value.CloneVT()
+			}
+
+			ctrl.channels[id] = channelState
+		}
+
+		version := channel.Metadata().Version().String()
+		if channelState.version == version {
+			continue
+		}
+
+		channelState.version = version
+		channelState.sender, channelState.senderErr = notification.NewSender(channel.TypedSpec().Value, nil)
+	}
+
+	for id := range ctrl.channels {
+		if _, ok := existing[id]; !ok {
+			delete(ctrl.channels, id)
+		}
+	}
+
+	return nil
+}
+
+func (ctrl *NotificationDispatcherController) rules(ctx context.Context, r controller.Runtime, logger *zap.Logger) ([]*notification.Rule, error) {
+	ruleList, err := safe.ReaderListAll[*omni.NotificationRule](ctx, r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notification rules: %w", err)
+	}
+
+	rules := make([]*notification.Rule, 0, ruleList.Len())
+
+	for res := range ruleList.All() {
+		rule, ruleErr := notification.NewRule(res.Metadata().ID(), res.TypedSpec().Value)
+		if ruleErr != nil {
+			logger.Warn("skipping invalid notification rule", zap.String("rule", res.Metadata().ID()), zap.Error(ruleErr))
+
+			continue
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+//nolint:gocognit,gocyclo,cyclop,maintidx
+func (ctrl *NotificationDispatcherController) observe(ctx context.Context, r controller.Runtime) ([]observation, error) {
+	var observations []observation
+
+	clusterStatuses, err := safe.ReaderListAll[*omni.ClusterStatus](ctx, r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cluster statuses: %w", err)
+	}
+
+	for status := range clusterStatuses.All() {
+		cluster := status.Metadata().ID()
+		phase := status.TypedSpec().Value.Phase
+
+		observations = append(observations, observation{
+			key:   omni.NotificationEventClusterPhase + "/" + cluster,
+			value: phase.String(),
+			event: func(previous string) *notification.Event {
+				if previous == "" {
+					previous = specs.ClusterStatusSpec_UNKNOWN.String()
+				}
+
+				return &notification.Event{
+					Type:     omni.NotificationEventClusterPhase,
+					Title:    fmt.Sprintf("Cluster %s is %s", cluster, phase),
+					Body:     fmt.Sprintf("The phase of the cluster %q changed from %s to %s.", cluster, previous, phase),
+					Cluster:  cluster,
+					Resource: cluster,
+					Severity: specs.NotificationSpec_INFO,
+				}
+			},
+		})
+	}
+
+	talosUpgradeStatuses, err := safe.ReaderListAll[*omni.TalosUpgradeStatus](ctx, r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list talos upgrade statuses: %w", err)
+	}
+
+	for status := range talosUpgradeStatuses.All() {
+		cluster := status.Metadata().ID()
+		spec := status.TypedSpec().Value
+
+		observations = append(observations, observation{
+			key:   omni.NotificationEventTalosUpgradeFailed + "/" + cluster,
+			value: spec.Phase.String() + "/" + spec.CurrentUpgradeVersion,
+			event: func(string) *notification.Event {
+				if spec.Phase != specs.TalosUpgradeStatusSpec_Failed {
+					return nil
+				}
+
+				return &notification.Event{
+					Type:     omni.NotificationEventTalosUpgradeFailed,
+					Title:    fmt.Sprintf("Talos upgrade of cluster %s failed", cluster),
+					Body:     fmt.Sprintf("The upgrade of the cluster %q to Talos %s failed: %s", cluster, spec.CurrentUpgradeVersion, spec.Error),
+					Cluster:  cluster,
+					Resource: cluster,
+					Severity: specs.NotificationSpec_ERROR,
+				}
+			},
+		})
+	}
+
+	kubernetesUpgradeStatuses, err := safe.ReaderListAll[*omni.KubernetesUpgradeStatus](ctx, r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list kubernetes upgrade statuses: %w", err)
+	}
+
+	for status := range kubernetesUpgradeStatuses.All() {
+		cluster := status.Metadata().ID()
+		spec := status.TypedSpec().Value
+
+		observations = append(observations, observation{
+			key:   omni.NotificationEventKubernetesUpgradeFailed + "/" + cluster,
+			value: spec.Phase.String() + "/" + spec.CurrentUpgradeVersion,
+			event: func(string) *notification.Event {
+				if spec.Phase != specs.KubernetesUpgradeStatusSpec_Failed {
+					return nil
+				}
+
+				return &notification.Event{
+					Type:     omni.NotificationEventKubernetesUpgradeFailed,
+					Title:    fmt.Sprintf("Kubernetes upgrade of cluster %s failed", cluster),
+					Body:     fmt.Sprintf("The upgrade of the cluster %q to Kubernetes %s failed: %s", cluster, spec.CurrentUpgradeVersion, spec.Error),
+					Cluster:  cluster,
+					Resource: cluster,
+					Severity: specs.NotificationSpec_ERROR,
+				}
+			},
+		})
+	}
+
+	etcdBackupStatuses, err := safe.ReaderListAll[*omni.EtcdBackupStatus](ctx, r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list etcd backup statuses: %w", err)
+	}
+
+	for status := range etcdBackupStatuses.All() {
+		cluster := status.Metadata().ID()
+		spec := status.TypedSpec().Value
+
+		// every failed attempt is a separate event
+		attempt := strconv.FormatInt(spec.GetLastBackupAttempt().GetSeconds(), 10)
+
+		observations = append(observations, observation{
+			key:   omni.NotificationEventEtcdBackupFailed + "/" + cluster,
+			value: spec.Status.String() + "/" + attempt,
+			event: func(string) *notification.Event {
+				if spec.Status != specs.EtcdBackupStatusSpec_Error {
+					return nil
+				}
+
+				return &notification.Event{
+					Type:     omni.NotificationEventEtcdBackupFailed,
+					Title:    fmt.Sprintf("Etcd backup of cluster %s failed", cluster),
+					Body:     fmt.Sprintf("The etcd backup of the cluster %q failed: %s", cluster, spec.Error),
+					Cluster:  cluster,
+					Resource: cluster,
+					Severity: specs.NotificationSpec_ERROR,
+				}
+			},
+		})
+	}
+
+	machineStatuses, err := safe.ReaderListAll[*omni.MachineStatus](ctx, r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list machine statuses: %w", err)
+	}
+
+	for status := range machineStatuses.All() {
+		machine := status.Metadata().ID()
+		spec := status.TypedSpec().Value
+
+		observations = append(observations, observation{
+			key:   omni.NotificationEventMachineDisconnected + "/" + machine,
+			value: strconv.FormatBool(spec.Connected),
+			event: func(previous string) *notification.Event {
+				if spec.Connected || previous != strconv.FormatBool(true) {
+					return nil
+				}
+
+				name := machine
+				if hostname := spec.GetNetwork().GetHostname(); hostname != "" {
+					name = hostname
+				}
+
+				body := fmt.Sprintf("The machine %q lost the connection to Omni.", machine)
+				if spec.Cluster != "" {
+					body = fmt.Sprintf("The machine %q of the cluster %q lost the connection to Omni.", machine, spec.Cluster)
+				}
+
+				return &notification.Event{
+					Type:     omni.NotificationEventMachineDisconnected,
+					Title:    fmt.Sprintf("Machine %s disconnected", name),
+					Body:     body,
+					Cluster:  spec.Cluster,
+					Resource: machine,
+					Severity: specs.NotificationSpec_WARNING,
+				}
+			},
+		})
+	}
+
+	rotationStatuses, err := safe.ReaderListAll[*omni.ClusterSecretsRotationStatus](ctx, r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cluster secrets rotation statuses: %w", err)
+	}
+
+	for status := range rotationStatuses.All() {
+		cluster := status.Metadata().ID()
+		spec := status.TypedSpec().Value
+
+		observations = append(observations, observation{
+			key:   omni.NotificationEventSecretRotation + "/" + cluster,
+			value: spec.Phase.String() + "/" + spec.Component.String() + "/" + spec.Error,
+			event: func(previous string) *notification.Event {
+				event := &notification.Event{
+					Type:     omni.NotificationEventSecretRotation,
+					Cluster:  cluster,
+					Resource: cluster,
+					Severity: specs.NotificationSpec_INFO,
+				}
+
+				switch {
+				case spec.Error != "":
+					event.Title = fmt.Sprintf("Secrets rotation of cluster %s failed", cluster)
+					event.Body = fmt.Sprintf("The rotation of %s of the cluster %q failed: %s", spec.Component, cluster, spec.Error)
+					event.Severity = specs.NotificationSpec_ERROR
+				case spec.Phase == specs.SecretRotationSpec_OK:
+					if previous == "" {
+						return nil
+					}
+
+					event.Title = fmt.Sprintf("Secrets rotation of cluster %s is done", cluster)
+					event.Body = fmt.Sprintf("The secrets rotation of the cluster %q is done.", cluster)
+				default:
+					event.Title = fmt.Sprintf("Secrets rotation of cluster %s: %s", cluster, spec.Phase)
+					event.Body = fmt.Sprintf("The rotation of %s of the cluster %q entered the %s phase.", spec.Component, cluster, spec.Phase)
+				}
+
+				return event
+			},
+		})
+	}
+
+	return observations, nil
+}
+
+// detectEvents compares the observations with the previous ones, and returns the events for the changes.
+//
+// The resources observed for the first time only produce events after the initial observation.
+func (ctrl *NotificationDispatcherController) detectEvents(observations []observation) []notification.Event {
+	var events []notification.Event
+
+	seen := make(map[string]struct{}, len(observations))
+
+	for _, obs := range observations {
+		seen[obs.key] = struct{}{}
+
+		previous, known := ctrl.observations[obs.key]
+		ctrl.observations[obs.key] = obs.value
+
+		if (known && previous == obs.value) || (!known && !ctrl.synced) {
+			continue
+		}
+
+		if event := obs.event(previous); event != nil {
+			events = append(events, *event)
+		}
+	}
+
+	for key := range ctrl.observations {
+		if _, ok := seen[key]; !ok {
+			delete(ctrl.observations, key)
+		}
+	}
+
+	ctrl.synced = true
+
+	return events
+}
+
+// route queues the event for the delivery to the channels of all matching rules, each channel gets the event once.
+func (ctrl *NotificationDispatcherController) route(event notification.Event, rules []*notification.Rule, clusterLabels *resource.Labels, now time.Time) {
+	queued := map[resource.ID]struct{}{}
+
+	for _, rule := range rules {
+		if !rule.Matches(event, clusterLabels) {
+			continue
+		}
+
+		throttleKey := rule.ID() + "/" + event.Type + "/" + event.Resource
+
+		if last, ok := ctrl.lastSent[throttleKey]; ok && now.Sub(last) < rule.Throttle() {
+			continue
+		}
+
+		ctrl.lastSent[throttleKey] = now
+
+		for _, id := range rule.Channels() {
+			channelState, ok := ctrl.channels[id]
+			if !ok {
+				continue
+			}
+
+			if _, ok = queued[id]; ok {
+				continue
+			}
+
+			queued[id] = struct{}{}
+
+			channelState.queue = append(channelState.queue, &notificationDelivery{event: event, notBefore: now})
+		}
+	}
+
+	for key, last := range ctrl.lastSent {
+		if now.Sub(last) > 24*time.Hour {
+			delete(ctrl.lastSent, key)
+		}
+	}
+}
+
+// startDeliveries sends the next due event of every idle channel, the events are delivered to each channel one by one in order.
+func (ctrl *NotificationDispatcherController) startDeliveries(ctx context.Context) {
+	now := time.Now()
+
+	for id, channelState := range ctrl.channels {
+		if channelState.inFlight || len(channelState.queue) == 0 || channelState.queue[0].notBefore.After(now) {
+			continue
+		}
+
+		delivery := channelState.queue[0]
+		channelState.queue = channelState.queue[1:]
+
+		if channelState.senderErr != nil {
+			ctrl.recordFailure(channelState, channelState.senderErr, now)
+
+			continue
+		}
+
+		channelState.inFlight = true
+
+		go func(sender notification.Sender) {
+			err := sender.Send(ctx, delivery.event)
+
+			select {
+			case ctrl.results <- notificationResult{channel: id, delivery: delivery, err: err}:
+			case <-ctx.Done():
+			}
+		}(channelState.sender)
+	}
+}
+
+func (ctrl *NotificationDispatcherController) handleResult(result notificationResult, logger *zap.Logger) {
+	channelState, ok := ctrl.channels[result.channel]
+	if !ok {
+		return
+	}
+
+	channelState.inFlight = false
+
+	now := time.Now()
+
+	if result.err == nil {
+		channelState.status.Delivered++
+		channelState.status.LastDelivery = timestamppb.New(now)
+
+		return
+	}
+
+	delivery := result.delivery
+	delivery.attempt++
+
+	if delivery.attempt < notificationMaxAttempts {
+		delivery.notBefore = now.Add(min(notificationRetryInterval<<(delivery.attempt-1), notificationMaxRetryDelay))
+
+		// retry before sending the next events to keep them in order
+		channelState.queue = append([]*notificationDelivery{delivery}, channelState.queue...)
+		channelState.status.LastError = result.err.Error()
+
+		return
+	}
+
+	logger.Warn("failed to deliver the notification",
+		zap.String("channel", result.channel),
+		zap.String("event", delivery.event.Type),
+		zap.Int("attempts", delivery.attempt),
+		zap.Error(result.err),
+	)
+
+	ctrl.recordFailure(channelState, result.err, now)
+}
+
+func (ctrl *NotificationDispatcherController) recordFailure(channelState *notificationChannelState, err error, now time.Time) {
+	channelState.status.Failed++
+	channelState.status.LastFailure = timestamppb.New(now)
+	channelState.status.LastError = err.Error()
+}
+
+func (ctrl *NotificationDispatcherController) writeStatuses(ctx context.Context, r controller.Runtime) error {
+	for id, channelState := range ctrl.channels {
+		pending := len(channelState.queue)
+		if channelState.inFlight {
+			pending++
+		}
+
+		channelState.status.Pending = uint32(pending)
+
+		if err := safe.WriterModify(ctx, r, omni.NewNotificationChannelStatus(id), func(res *omni.NotificationChannelStatus) error {
+			res.TypedSpec().Value.Delivered = channelState.status.Delivered
+			res.TypedSpec().Value.Failed = channelState.status.Failed
+			res.TypedSpec().Value.Pending = channelState.status.Pending
+			res.TypedSpec().Value.LastDelivery = channelState.status.LastDelivery
+			res.TypedSpec().Value.LastFailure = channelState.status.LastFailure
+			res.TypedSpec().Value.LastError = channelState.status.LastError
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("failed to update notification channel status: %w", err)
+		}
+	}
+
+	statuses, err := safe.ReaderListAll[*omni.NotificationChannelStatus](ctx, r)
+	if err != nil {
+		return fmt.Errorf("failed to list notification channel statuses: %w", err)
+	}
+
+	for status := range statuses.All() {
+		if _, ok := ctrl.channels[status.Metadata().ID()]; ok {
+			continue
+		}
+
+		if _, err = helpers.TeardownAndDestroy(ctx, r, status.Metadata()); err != nil {
+			return fmt.Errorf("failed to destroy notification channel status: %w", err)
+		}
+	}
+
+	return nil
+}
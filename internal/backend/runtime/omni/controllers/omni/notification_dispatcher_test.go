@@ -0,0 +1,109 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/testutils"
+)
+
+func TestNotificationDispatcherController(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 30*time.Second)
+	t.Cleanup(cancel)
+
+	events := make(chan map[string]any, 10)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]any
+
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		events <- payload
+	}))
+	t.Cleanup(server.Close)
+
+	testutils.WithRuntime(
+		ctx, t, testutils.TestOptions{},
+		func(_ context.Context, tc testutils.TestContext) {
+			require.NoError(t, tc.Runtime.RegisterController(omnictrl.NewNotificationDispatcherController()))
+		},
+		func(ctx context.Context, tc testutils.TestContext) {
+			channel := omni.NewNotificationChannel("webhook")
+			channel.TypedSpec().Value.Type = specs.NotificationChannelSpec_WEBHOOK
+			channel.TypedSpec().Value.Url = server.URL
+
+			require.NoError(t, tc.State.Create(ctx, channel))
+
+			rule := omni.NewNotificationRule("errors")
+			rule.TypedSpec().Value.Channels = []string{channel.Metadata().ID()}
+			rule.TypedSpec().Value.MinSeverity = specs.NotificationSpec_ERROR
+
+			require.NoError(t, tc.State.Create(ctx, rule))
+
+			rtestutils.AssertResource(ctx, t, tc.State, channel.Metadata().ID(), func(*omni.NotificationChannelStatus, *assert.Assertions) {})
+
+			// the info events are not matched by the rule
+			clusterStatus := omni.NewClusterStatus("cluster1")
+			clusterStatus.TypedSpec().Value.Phase = specs.ClusterStatusSpec_SCALING_UP
+
+			require.NoError(t, tc.State.Create(ctx, clusterStatus))
+
+			upgradeStatus := omni.NewTalosUpgradeStatus("cluster1")
+			upgradeStatus.TypedSpec().Value.Phase = specs.TalosUpgradeStatusSpec_Upgrading
+
+			require.NoError(t, tc.State.Create(ctx, upgradeStatus))
+
+			_, err := safe.StateUpdateWithConflicts(ctx, tc.State, upgradeStatus.Metadata(), func(res *omni.TalosUpgradeStatus) error {
+				res.TypedSpec().Value.Phase = specs.TalosUpgradeStatusSpec_Failed
+				res.TypedSpec().Value.Error = "boom"
+
+				return nil
+			})
+			require.NoError(t, err)
+
+			select {
+			case payload := <-events:
+				assert.Equal(t, omni.NotificationEventTalosUpgradeFailed, payload["type"])
+				assert.Equal(t, "cluster1", payload["cluster"])
+				assert.Contains(t, payload["body"], "boom")
+			case <-ctx.Done():
+				require.FailNow(t, "timeout waiting for the notification")
+			}
+
+			rtestutils.AssertResource(ctx, t, tc.State, channel.Metadata().ID(), func(res *omni.NotificationChannelStatus, a *assert.Assertions) {
+				a.EqualValues(1, res.TypedSpec().Value.Delivered)
+				a.Zero(res.TypedSpec().Value.Pending)
+			})
+
+			assert.Empty(t, events)
+
+			require.NoError(t, tc.State.Destroy(ctx, rule.Metadata()))
+			require.NoError(t, tc.State.Destroy(ctx, channel.Metadata()))
+
+			rtestutils.AssertNoResource[*omni.NotificationChannelStatus](ctx, t, tc.State, channel.Metadata().ID())
+		},
+	)
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+// ResourceValidator validates the resources which are written without an owner on behalf of the users, before they are written.
+//
+// The runtime state the controllers write to is not validated, so the resources synced from the user-provided sources are checked
+// with the same validations as if they were written through the API.
+type ResourceValidator interface {
+	Validate(ctx context.Context, res resource.Resource) error
+}
+
+// updateOwnerless updates the resource which is not owned by any controller with the spec, labels and annotations of res.
+func updateOwnerless(ctx context.Context, r controller.ReaderWriter, res resource.Resource) error {
+	switch res.Metadata().Type() {
+	case omni.ClusterTemplateType:
+		return modifyOwnerless[omni.ClusterTemplateSpec, *omni.ClusterTemplate](ctx, r, res)
+	case omni.ClusterType:
+		return modifyOwnerless[omni.ClusterSpec, *omni.Cluster](ctx, r, res)
+	case omni.MachineSetType:
+		return modifyOwnerless[omni.MachineSetSpec, *omni.MachineSet](ctx, r, res)
+	case omni.MachineSetNodeType:
+		return modifyOwnerless[omni.MachineSetNodeSpec, *omni.MachineSetNode](ctx, r, res)
+	case omni.ConfigPatchType:
+		return modifyOwnerless[omni.ConfigPatchSpec, *omni.ConfigPatch](ctx, r, res)
+	case omni.ExtensionsConfigurationType:
+		return modifyOwnerless[omni.ExtensionsConfigurationSpec, *omni.ExtensionsConfiguration](ctx, r, res)
+	case omni.KubernetesManifestGroupType:
+		return modifyOwnerless[omni.KubernetesManifestGroupSpec, *omni.KubernetesManifestGroup](ctx, r, res)
+	case omni.KubernetesHealthCheckType:
+		return modifyOwnerless[omni.KubernetesHealthCheckSpec, *omni.KubernetesHealthCheck](ctx, r, res)
+	case omni.KernelArgsType:
+		return modifyOwnerless[omni.KernelArgsSpec, *omni.KernelArgs](ctx, r, res)
+	case omni.MachineInstallDiskConfigType:
+		return modifyOwnerless[omni.MachineInstallDiskConfigSpec, *omni.MachineInstallDiskConfig](ctx, r, res)
+	case omni.MachineClassType:
+		return modifyOwnerless[omni.MachineClassSpec, *omni.MachineClass](ctx, r, res)
+	case omni.MachineLabelsType:
+		return modifyOwnerless[omni.MachineLabelsSpec, *omni.MachineLabels](ctx, r, res)
+	case omni.MaintenanceWindowType:
+		return modifyOwnerless[omni.MaintenanceWindowSpec, *omni.MaintenanceWindow](ctx, r, res)
+	default:
+		return fmt.Errorf("unsupported resource type %q", res.Metadata().Type())
+	}
+}
+
+func modifyOwnerless[S any, R interface {
+	resource.Resource
+	TypedSpec() *S
+}](ctx context.Context, r controller.ReaderWriter, expected resource.Resource,
+) error {
+	typed, ok := expected.(R)
+	if !ok {
+		return fmt.Errorf("unexpected resource %T", expected)
+	}
+
+	return safe.WriterModify(ctx, r, typed, func(res R) error {
+		*res.TypedSpec() = *typed.TypedSpec()
+		*res.Metadata().Labels() = *typed.Metadata().Labels()
+		*res.Metadata().Annotations() = *typed.Metadata().Annotations()
+
+		return nil
+	}, controller.WithModifyNoOwner())
+}
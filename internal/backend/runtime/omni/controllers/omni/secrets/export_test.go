@@ -0,0 +1,42 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package secrets
+
+import (
+	"time"
+
+	"github.com/siderolabs/gen/xslices"
+	talossecrets "github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+func RotationSchedule(
+	clusterSecrets *omni.ClusterSecrets, bundle *talossecrets.Bundle, policy *specs.SecretRotationPolicy, now time.Time,
+) ([]*specs.ClusterSecretsRotationStatusSpec_Secret, specs.SecretRotationSpec_Component, time.Duration, error) {
+	schedule, err := rotationSchedule(clusterSecrets, bundle, policy)
+	if err != nil {
+		return nil, specs.SecretRotationSpec_NONE, 0, err
+	}
+
+	due, requeueAfter := dueRotation(schedule, now)
+	if due == nil {
+		return xslices.Map(schedule, secretSchedule.toSpec), specs.SecretRotationSpec_NONE, requeueAfter, nil
+	}
+
+	return xslices.Map(schedule, secretSchedule.toSpec), due.component, requeueAfter, nil
+}
+
+func NewBootstrapToken() (string, error) {
+	return newBootstrapToken()
+}
+
+func PrepareKubernetesServiceAccountKeyRotation(
+	secretRotation *omni.SecretRotation, bundle *talossecrets.Bundle, rotation *specs.ClusterMachineSecretsSpec_Rotation, currentPhase specs.SecretRotationSpec_Phase,
+) {
+	(&Rotator{}).prepareKubernetesServiceAccountKeyRotation(secretRotation, bundle, rotation, currentPhase)
+}
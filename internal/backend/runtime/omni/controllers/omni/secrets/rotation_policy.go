@@ -0,0 +1,262 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package secrets
+
+import (
+	"context"
+	"crypto/rand"
+	stdx509 "crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/controller/generic"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/crypto/x509"
+	talossecrets "github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+// rotationComponent describes a rotatable cluster secret.
+type rotationComponent struct {
+	// requestVersion returns the version of the rotation request resource, or an empty string if there is no request.
+	requestVersion func(ctx context.Context, r controller.Reader, id resource.ID) (string, error)
+	// ca returns the certificate authority from the secrets bundle, nil for the secrets which are not certificate authorities.
+	ca func(bundle *talossecrets.Bundle) *x509.PEMEncodedCertificateAndKey
+
+	// versionAnnotation is set on ClusterSecretsRotationStatus to the version of the handled rotation request.
+	versionAnnotation string
+	// timestampAnnotation is set on ClusterSecrets to the time the last rotation was completed.
+	timestampAnnotation string
+
+	component specs.SecretRotationSpec_Component
+}
+
+// rotationComponents lists the rotatable cluster secrets in the order the pending rotations are started.
+var rotationComponents = []rotationComponent{
+	{
+		component:           specs.SecretRotationSpec_TALOS_CA,
+		requestVersion:      rotateRequestVersion[*omni.RotateTalosCA],
+		ca:                  func(bundle *talossecrets.Bundle) *x509.PEMEncodedCertificateAndKey { return bundle.Certs.OS },
+		versionAnnotation:   omni.RotateTalosCAVersion,
+		timestampAnnotation: omni.RotateTalosCATimestamp,
+	},
+	{
+		component:           specs.SecretRotationSpec_KUBERNETES_CA,
+		requestVersion:      rotateRequestVersion[*omni.RotateKubernetesCA],
+		ca:                  func(bundle *talossecrets.Bundle) *x509.PEMEncodedCertificateAndKey { return bundle.Certs.K8s },
+		versionAnnotation:   omni.RotateKubernetesCAVersion,
+		timestampAnnotation: omni.RotateKubernetesCATimestamp,
+	},
+	{
+		component:           specs.SecretRotationSpec_ETCD_CA,
+		requestVersion:      rotateRequestVersion[*omni.RotateEtcdCA],
+		ca:                  func(bundle *talossecrets.Bundle) *x509.PEMEncodedCertificateAndKey { return bundle.Certs.Etcd },
+		versionAnnotation:   omni.RotateEtcdCAVersion,
+		timestampAnnotation: omni.RotateEtcdCATimestamp,
+	},
+	{
+		component:           specs.SecretRotationSpec_KUBERNETES_AGGREGATOR_CA,
+		requestVersion:      rotateRequestVersion[*omni.RotateKubernetesAggregatorCA],
+		ca:                  func(bundle *talossecrets.Bundle) *x509.PEMEncodedCertificateAndKey { return bundle.Certs.K8sAggregator },
+		versionAnnotation:   omni.RotateKubernetesAggregatorCAVersion,
+		timestampAnnotation: omni.RotateKubernetesAggregatorCATimestamp,
+	},
+	{
+		component:           specs.SecretRotationSpec_KUBERNETES_SERVICE_ACCOUNT_KEY,
+		requestVersion:      rotateRequestVersion[*omni.RotateKubernetesServiceAccountKey],
+		ca:                  func(*talossecrets.Bundle) *x509.PEMEncodedCertificateAndKey { return nil },
+		versionAnnotation:   omni.RotateKubernetesServiceAccountKeyVersion,
+		timestampAnnotation: omni.RotateKubernetesServiceAccountKeyTimestamp,
+	},
+	{
+		component:           specs.SecretRotationSpec_BOOTSTRAP_TOKEN,
+		requestVersion:      rotateRequestVersion[*omni.RotateBootstrapToken],
+		ca:                  func(*talossecrets.Bundle) *x509.PEMEncodedCertificateAndKey { return nil },
+		versionAnnotation:   omni.RotateBootstrapTokenVersion,
+		timestampAnnotation: omni.RotateBootstrapTokenTimestamp,
+	},
+}
+
+func getRotationComponent(component specs.SecretRotationSpec_Component) (rotationComponent, bool) {
+	idx := slices.IndexFunc(rotationComponents, func(c rotationComponent) bool { return c.component == component })
+	if idx == -1 {
+		return rotationComponent{}, false
+	}
+
+	return rotationComponents[idx], true
+}
+
+func rotateRequestVersion[T generic.ResourceWithRD](ctx context.Context, r controller.Reader, id resource.ID) (string, error) {
+	res, err := safe.ReaderGetByID[T](ctx, r, id)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	return res.Metadata().Version().String(), nil
+}
+
+// rotationRequest is a rotation to be started.
+type rotationRequest struct {
+	// version is the version of the rotation request resource, empty if the rotation is started by the rotation policy.
+	version   string
+	component rotationComponent
+}
+
+// secretSchedule is the expiration and the rotation schedule of a cluster secret.
+type secretSchedule struct {
+	expiration   time.Time
+	lastRotation time.Time
+	nextRotation time.Time
+	component    specs.SecretRotationSpec_Component
+}
+
+func (s secretSchedule) toSpec() *specs.ClusterSecretsRotationStatusSpec_Secret {
+	toTimestamp := func(t time.Time) *timestamppb.Timestamp {
+		if t.IsZero() {
+			return nil
+		}
+
+		return timestamppb.New(t)
+	}
+
+	return &specs.ClusterSecretsRotationStatusSpec_Secret{
+		Component:    s.component,
+		Expiration:   toTimestamp(s.expiration),
+		LastRotation: toTimestamp(s.lastRotation),
+		NextRotation: toTimestamp(s.nextRotation),
+	}
+}
+
+// rotationSchedule computes the rotation schedule of the cluster secrets according to the rotation policy.
+//
+// The age of the secret is measured from its last rotation, or, if it was never rotated, from the moment
+// the certificate authority was issued or the cluster secrets were created.
+func rotationSchedule(clusterSecrets *omni.ClusterSecrets, bundle *talossecrets.Bundle, policy *specs.SecretRotationPolicy) ([]secretSchedule, error) {
+	schedule := make([]secretSchedule, 0, len(rotationComponents))
+
+	for _, component := range rotationComponents {
+		item := secretSchedule{
+			component: component.component,
+		}
+
+		issued := clusterSecrets.Metadata().Created()
+
+		if ca := component.ca(bundle); ca != nil {
+			cert, err := parseCertificate(ca.Crt)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %s: %w", omni.SecretRotationComponentName(component.component), err)
+			}
+
+			issued = cert.NotBefore
+			item.expiration = cert.NotAfter
+		}
+
+		if timestamp, ok := clusterSecrets.Metadata().Annotations().Get(component.timestampAnnotation); ok {
+			seconds, err := strconv.ParseInt(timestamp, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %s rotation timestamp: %w", omni.SecretRotationComponentName(component.component), err)
+			}
+
+			item.lastRotation = time.Unix(seconds, 0)
+			issued = item.lastRotation
+		}
+
+		if slices.Contains(policy.GetComponents(), component.component) {
+			if interval := policy.GetInterval().AsDuration(); interval > 0 {
+				item.nextRotation = issued.Add(interval)
+			}
+
+			if threshold := policy.GetExpiryThreshold().AsDuration(); threshold > 0 && !item.expiration.IsZero() {
+				if beforeExpiration := item.expiration.Add(-threshold); item.nextRotation.IsZero() || beforeExpiration.Before(item.nextRotation) {
+					item.nextRotation = beforeExpiration
+				}
+			}
+		}
+
+		schedule = append(schedule, item)
+	}
+
+	return schedule, nil
+}
+
+// dueRotation returns the first secret in the schedule which is due for rotation, and the time until the next scheduled rotation otherwise.
+//
+// The returned interval is zero if no rotation is scheduled.
+func dueRotation(schedule []secretSchedule, now time.Time) (*secretSchedule, time.Duration) {
+	var requeueAfter time.Duration
+
+	for i, item := range schedule {
+		if item.nextRotation.IsZero() {
+			continue
+		}
+
+		until := item.nextRotation.Sub(now)
+		if until <= 0 {
+			return &schedule[i], 0
+		}
+
+		if requeueAfter == 0 || until < requeueAfter {
+			requeueAfter = until
+		}
+	}
+
+	return nil, requeueAfter
+}
+
+func parseCertificate(crt []byte) (*stdx509.Certificate, error) {
+	block, _ := pem.Decode(crt)
+	if block == nil {
+		return nil, fmt.Errorf("failed to decode PEM")
+	}
+
+	return stdx509.ParseCertificate(block.Bytes)
+}
+
+const bootstrapTokenCharset = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+// newBootstrapToken generates a Kubernetes bootstrap token in the same format as Talos does.
+func newBootstrapToken() (string, error) {
+	randomString := func(length int) (string, error) {
+		result := make([]byte, length)
+
+		for i := range result {
+			n, err := rand.Int(rand.Reader, big.NewInt(int64(len(bootstrapTokenCharset))))
+			if err != nil {
+				return "", err
+			}
+
+			result[i] = bootstrapTokenCharset[n.Int64()]
+		}
+
+		return string(result), nil
+	}
+
+	id, err := randomString(6)
+	if err != nil {
+		return "", err
+	}
+
+	secret, err := randomString(16)
+	if err != nil {
+		return "", err
+	}
+
+	return id + "." + secret, nil
+}
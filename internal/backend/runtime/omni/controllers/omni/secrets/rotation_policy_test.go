@@ -0,0 +1,114 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package secrets_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/talos/pkg/machinery/config"
+	gensecrets "github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/secrets"
+)
+
+func TestRotationSchedule(t *testing.T) {
+	t.Parallel()
+
+	issued := time.Now().Add(-100 * 24 * time.Hour).Truncate(time.Second)
+
+	bundle, err := gensecrets.NewBundle(gensecrets.NewFixedClock(issued), config.TalosVersionCurrent)
+	require.NoError(t, err)
+
+	clusterSecrets := omni.NewClusterSecrets("test")
+
+	// the bootstrap token was rotated recently, and the service account key was rotated long ago
+	clusterSecrets.Metadata().Annotations().Set(omni.RotateBootstrapTokenTimestamp, strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10))
+	clusterSecrets.Metadata().Annotations().Set(omni.RotateKubernetesServiceAccountKeyTimestamp, strconv.FormatInt(issued.Unix(), 10))
+
+	for _, tt := range []struct {
+		policy       *specs.SecretRotationPolicy
+		name         string
+		expectedDue  specs.SecretRotationSpec_Component
+		expectedNext bool
+	}{
+		{
+			name: "no policy",
+		},
+		{
+			name: "interval not elapsed",
+			policy: &specs.SecretRotationPolicy{
+				Interval:   durationpb.New(180 * 24 * time.Hour),
+				Components: []specs.SecretRotationSpec_Component{specs.SecretRotationSpec_TALOS_CA, specs.SecretRotationSpec_BOOTSTRAP_TOKEN},
+			},
+			expectedNext: true,
+		},
+		{
+			name: "interval elapsed",
+			policy: &specs.SecretRotationPolicy{
+				Interval:   durationpb.New(30 * 24 * time.Hour),
+				Components: []specs.SecretRotationSpec_Component{specs.SecretRotationSpec_BOOTSTRAP_TOKEN, specs.SecretRotationSpec_ETCD_CA},
+			},
+			expectedDue: specs.SecretRotationSpec_ETCD_CA,
+		},
+		{
+			name: "interval elapsed since the last rotation",
+			policy: &specs.SecretRotationPolicy{
+				Interval:   durationpb.New(30 * 24 * time.Hour),
+				Components: []specs.SecretRotationSpec_Component{specs.SecretRotationSpec_KUBERNETES_SERVICE_ACCOUNT_KEY},
+			},
+			expectedDue: specs.SecretRotationSpec_KUBERNETES_SERVICE_ACCOUNT_KEY,
+		},
+		{
+			name: "expiry threshold reached",
+			policy: &specs.SecretRotationPolicy{
+				ExpiryThreshold: durationpb.New(10 * 365 * 24 * time.Hour),
+				Components:      []specs.SecretRotationSpec_Component{specs.SecretRotationSpec_KUBERNETES_CA},
+			},
+			expectedDue: specs.SecretRotationSpec_KUBERNETES_CA,
+		},
+		{
+			name: "expiry threshold not reached",
+			policy: &specs.SecretRotationPolicy{
+				ExpiryThreshold: durationpb.New(30 * 24 * time.Hour),
+				Components:      []specs.SecretRotationSpec_Component{specs.SecretRotationSpec_KUBERNETES_AGGREGATOR_CA},
+			},
+			expectedNext: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			schedule, due, requeueAfter, err := secrets.RotationSchedule(clusterSecrets, bundle, tt.policy, time.Now())
+			require.NoError(t, err)
+
+			require.Len(t, schedule, 6)
+			require.Equal(t, tt.expectedDue, due)
+			require.Equal(t, tt.expectedNext, requeueAfter > 0)
+
+			for _, secret := range schedule {
+				switch secret.Component {
+				case specs.SecretRotationSpec_KUBERNETES_SERVICE_ACCOUNT_KEY, specs.SecretRotationSpec_BOOTSTRAP_TOKEN:
+					require.Nil(t, secret.Expiration)
+					require.NotNil(t, secret.LastRotation)
+				default:
+					require.NotNil(t, secret.Expiration)
+					require.True(t, secret.Expiration.AsTime().After(time.Now()))
+					require.Nil(t, secret.LastRotation)
+				}
+
+				if tt.policy == nil {
+					require.Nil(t, secret.NextRotation)
+				}
+			}
+		})
+	}
+}
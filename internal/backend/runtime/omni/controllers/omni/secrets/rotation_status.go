@@ -111,6 +111,21 @@ func (s *Rotator) Options() []qtransform.ControllerOption {
 		qtransform.WithExtraMappedInput[*omni.RotateKubernetesCA](
 			qtransform.MapperSameID[*omni.ClusterSecrets](),
 		),
+		qtransform.WithExtraMappedInput[*omni.RotateEtcdCA](
+			qtransform.MapperSameID[*omni.ClusterSecrets](),
+		),
+		qtransform.WithExtraMappedInput[*omni.RotateKubernetesAggregatorCA](
+			qtransform.MapperSameID[*omni.ClusterSecrets](),
+		),
+		qtransform.WithExtraMappedInput[*omni.RotateKubernetesServiceAccountKey](
+			qtransform.MapperSameID[*omni.ClusterSecrets](),
+		),
+		qtransform.WithExtraMappedInput[*omni.RotateBootstrapToken](
+			qtransform.MapperSameID[*omni.ClusterSecrets](),
+		),
+		qtransform.WithExtraMappedInput[*omni.Cluster](
+			qtransform.MapperSameID[*omni.ClusterSecrets](),
+		),
 		qtransform.WithExtraMappedDestroyReadyInput[*omni.SecretRotation](
 			qtransform.MapperSameID[*omni.ClusterSecrets](),
 		),
@@ -157,7 +172,7 @@ func (s *Rotator) FinalizerRemoval(ctx context.Context, r controller.ReaderWrite
 	return nil
 }
 
-//nolint:gocognit
+//nolint:gocognit,gocyclo,cyclop
 func (s *Rotator) createInitialStage(currentPhase specs.SecretRotationSpec_Phase) func(
 	ctx context.Context,
 	logger *zap.Logger,
@@ -168,26 +183,47 @@ func (s *Rotator) createInitialStage(currentPhase specs.SecretRotationSpec_Phase
 		clusterSecrets := sequenceContext.Input
 		rotationStatus := sequenceContext.Output
 
-		rotateTalosCA, err := safe.ReaderGetByID[*omni.RotateTalosCA](ctx, r, clusterSecrets.Metadata().ID())
-		if err != nil && !state.IsNotFoundError(err) {
+		secretsBundle, err := omni.ToSecretsBundle(clusterSecrets.TypedSpec().Value.Data)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal cluster secrets bundle: %w", err)
+		}
+
+		request, err := s.pendingRotationRequest(ctx, r, rotationStatus, clusterSecrets.Metadata().ID())
+		if err != nil {
 			return err
 		}
 
-		version, _ := rotationStatus.Metadata().Annotations().Get(omni.RotateTalosCAVersion)
-		shouldRotateTalosCA := rotateTalosCA != nil && version != rotateTalosCA.Metadata().Version().String()
+		var policy *specs.SecretRotationPolicy
 
-		rotateKubernetesCA, err := safe.ReaderGetByID[*omni.RotateKubernetesCA](ctx, r, clusterSecrets.Metadata().ID())
+		cluster, err := safe.ReaderGetByID[*omni.Cluster](ctx, r, clusterSecrets.Metadata().ID())
 		if err != nil && !state.IsNotFoundError(err) {
 			return err
 		}
 
-		version, _ = rotationStatus.Metadata().Annotations().Get(omni.RotateKubernetesCAVersion)
-		shouldRotateKubernetesCA := rotateKubernetesCA != nil && version != rotateKubernetesCA.Metadata().Version().String()
+		if cluster != nil {
+			policy = cluster.TypedSpec().Value.GetSecretRotationPolicy()
+		}
+
+		schedule, err := rotationSchedule(clusterSecrets, secretsBundle, policy)
+		if err != nil {
+			return err
+		}
+
+		rotationStatus.TypedSpec().Value.Secrets = xslices.Map(schedule, secretSchedule.toSpec)
+
+		var requeueAfter time.Duration
+
+		if request == nil {
+			request, requeueAfter, err = s.scheduledRotationRequest(ctx, r, clusterSecrets, schedule)
+			if err != nil {
+				return err
+			}
+		}
 
 		// We should use an uncached reader if we need to do a rotation. This is because a rotation is a one-time operation, therefore
 		// we cannot tolerate stale reads during it - eventual consistency doesn't work for us here.
 		// We have observed a flake in the unit tests, caused by a stale read of ClusterMachineStatus resources.
-		if shouldRotateTalosCA || shouldRotateKubernetesCA {
+		if request != nil {
 			r = uncached.ReaderWriter(r)
 		}
 
@@ -202,11 +238,6 @@ func (s *Rotator) createInitialStage(currentPhase specs.SecretRotationSpec_Phase
 			return err
 		}
 
-		secretsBundle, err := omni.ToSecretsBundle(clusterSecrets.TypedSpec().Value.Data)
-		if err != nil {
-			return fmt.Errorf("failed to unmarshal cluster secrets bundle: %w", err)
-		}
-
 		secretsToCreate := s.toCreate(cmStatusesMap, cmSecretsMap)
 		for _, clusterMachineStatus := range secretsToCreate {
 			if err = s.modifyClusterMachineSecret(ctx, r, logger, secretsBundle, clusterMachineStatus, nil); err != nil {
@@ -235,8 +266,18 @@ func (s *Rotator) createInitialStage(currentPhase specs.SecretRotationSpec_Phase
 						Crt: secretsBundle.Certs.K8s.Crt,
 						Key: secretsBundle.Certs.K8s.Key,
 					},
+					Etcd:          certsCAFromBundle(secretsBundle.Certs.Etcd),
+					K8SAggregator: certsCAFromBundle(secretsBundle.Certs.K8sAggregator),
+				}
+
+				if secretsBundle.Certs.K8sServiceAccount != nil {
+					res.TypedSpec().Value.Certs.K8SSa = &specs.ClusterSecretsSpec_Certs_CA{
+						Key: secretsBundle.Certs.K8sServiceAccount.Key,
+					}
 				}
+
 				res.TypedSpec().Value.ExtraCerts = &specs.ClusterSecretsSpec_Certs{}
+				res.TypedSpec().Value.ExtraBootstrapToken = ""
 
 				return nil
 			})
@@ -245,63 +286,76 @@ func (s *Rotator) createInitialStage(currentPhase specs.SecretRotationSpec_Phase
 		}
 
 		// This is a trigger condition to move to the next stage
-		if shouldRotateTalosCA {
-			logger.Info("starting rotation", zap.String("cluster", secretRotation.Metadata().ID()), zap.String("component", specs.SecretRotationSpec_TALOS_CA.String()))
-
-			return s.startCARotation(rotationStatus, cmSecretsMap, specs.SecretRotationSpec_TALOS_CA, rotateTalosCA.Metadata().Version().String(),
-				func() (ca *x509.CertificateAuthority, err error) {
-					return talossecrets.NewTalosCA(talossecrets.NewFixedClock(time.Now()).Now())
-				},
-				func(rotatingComponent specs.SecretRotationSpec_Component, ca *x509.CertificateAuthority) error {
-					return safe.WriterModify[*omni.SecretRotation](ctx, r, secretRotation,
-						func(res *omni.SecretRotation) error {
-							res.TypedSpec().Value.Status = specs.SecretRotationSpec_IN_PROGRESS
-							res.TypedSpec().Value.Component = rotatingComponent
-							res.TypedSpec().Value.ExtraCerts.Os = &specs.ClusterSecretsSpec_Certs_CA{
-								Crt: ca.CrtPEM,
-								Key: ca.KeyPEM,
-							}
-
-							return nil
-						})
-				})
+		if request != nil {
+			logger.Info("starting rotation", zap.String("cluster", secretRotation.Metadata().ID()), zap.String("component", request.component.component.String()),
+				zap.Bool("scheduled", request.version == ""))
+
+			return s.startRotation(ctx, r, rotationStatus, secretRotation, cmSecretsMap, request)
 		}
 
-		// This is a trigger condition to move to the next stage
-		if shouldRotateKubernetesCA {
-			logger.Info("starting rotation", zap.String("cluster", secretRotation.Metadata().ID()), zap.String("component", specs.SecretRotationSpec_KUBERNETES_CA.String()))
-
-			return s.startCARotation(rotationStatus, cmSecretsMap, specs.SecretRotationSpec_KUBERNETES_CA, rotateKubernetesCA.Metadata().Version().String(),
-				func() (ca *x509.CertificateAuthority, err error) {
-					clusterConfigVersion, err := safe.ReaderGetByID[*omni.ClusterConfigVersion](ctx, r, secretRotation.Metadata().ID())
-					if err != nil {
-						return nil, err
-					}
+		if requeueAfter > 0 {
+			return controller.NewRequeueInterval(requeueAfter)
+		}
 
-					versionContract, err := config.ParseContractFromVersion(clusterConfigVersion.TypedSpec().Value.Version)
-					if err != nil {
-						return nil, err
-					}
+		return sequence.ErrWait // Continue processing the current stage
+	}
+}
+
+// pendingRotationRequest returns the first rotation requested with a Rotate* resource which wasn't handled yet.
+func (s *Rotator) pendingRotationRequest(ctx context.Context, r controller.Reader, rotationStatus *omni.ClusterSecretsRotationStatus, id resource.ID) (*rotationRequest, error) {
+	for _, component := range rotationComponents {
+		requestVersion, err := component.requestVersion(ctx, r, id)
+		if err != nil {
+			return nil, err
+		}
 
-					return talossecrets.NewKubernetesCA(talossecrets.NewFixedClock(time.Now()).Now(), versionContract)
-				},
-				func(rotatingComponent specs.SecretRotationSpec_Component, ca *x509.CertificateAuthority) error {
-					return safe.WriterModify[*omni.SecretRotation](ctx, r, secretRotation,
-						func(res *omni.SecretRotation) error {
-							res.TypedSpec().Value.Status = specs.SecretRotationSpec_IN_PROGRESS
-							res.TypedSpec().Value.Component = rotatingComponent
-							res.TypedSpec().Value.ExtraCerts.K8S = &specs.ClusterSecretsSpec_Certs_CA{
-								Crt: ca.CrtPEM,
-								Key: ca.KeyPEM,
-							}
+		version, _ := rotationStatus.Metadata().Annotations().Get(component.versionAnnotation)
 
-							return nil
-						})
-				})
+		if requestVersion != "" && version != requestVersion {
+			return &rotationRequest{
+				component: component,
+				version:   requestVersion,
+			}, nil
 		}
+	}
 
-		return sequence.ErrWait // Continue processing the current stage
+	return nil, nil //nolint:nilnil
+}
+
+// scheduledRotationRequest returns the rotation which is due according to the rotation policy, or the time until the next scheduled rotation.
+//
+// The schedule is only acted upon once ClusterSecrets caught up with the last SecretRotation change,
+// otherwise the timestamp of the rotation which has just completed might not be there yet, and the same secret would be rotated twice.
+func (s *Rotator) scheduledRotationRequest(
+	ctx context.Context,
+	r controller.Reader,
+	clusterSecrets *omni.ClusterSecrets,
+	schedule []secretSchedule,
+) (*rotationRequest, time.Duration, error) {
+	due, requeueAfter := dueRotation(schedule, time.Now())
+	if due == nil {
+		return nil, requeueAfter, nil
+	}
+
+	secretRotation, err := safe.ReaderGetByID[*omni.SecretRotation](ctx, r, clusterSecrets.Metadata().ID())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, 0, nil
+		}
+
+		return nil, 0, err
 	}
+
+	if version, _ := clusterSecrets.Metadata().Annotations().Get(omni.SecretRotationVersion); version != secretRotation.Metadata().Version().String() {
+		return nil, 0, nil
+	}
+
+	component, ok := getRotationComponent(due.component)
+	if !ok {
+		return nil, 0, fmt.Errorf("unknown secret rotation component %s", due.component.String())
+	}
+
+	return &rotationRequest{component: component}, 0, nil
 }
 
 func (s *Rotator) addRotationStage(previousPhase, currentPhase specs.SecretRotationSpec_Phase) func(
@@ -374,6 +428,20 @@ func (s *Rotator) addRotationStage(previousPhase, currentPhase specs.SecretRotat
 						res.TypedSpec().Value.BackupCertsK8S = updateBackups(res.TypedSpec().Value.BackupCertsK8S, res.TypedSpec().Value.Certs.K8S)
 						res.TypedSpec().Value.Certs.K8S = res.TypedSpec().Value.ExtraCerts.K8S
 						res.TypedSpec().Value.ExtraCerts.K8S = nil
+					case specs.SecretRotationSpec_ETCD_CA:
+						res.TypedSpec().Value.BackupCertsEtcd = updateBackups(res.TypedSpec().Value.BackupCertsEtcd, res.TypedSpec().Value.Certs.Etcd)
+						res.TypedSpec().Value.Certs.Etcd = res.TypedSpec().Value.ExtraCerts.Etcd
+						res.TypedSpec().Value.ExtraCerts.Etcd = nil
+					case specs.SecretRotationSpec_KUBERNETES_AGGREGATOR_CA:
+						res.TypedSpec().Value.BackupCertsK8SAggregator = updateBackups(res.TypedSpec().Value.BackupCertsK8SAggregator, res.TypedSpec().Value.Certs.K8SAggregator)
+						res.TypedSpec().Value.Certs.K8SAggregator = res.TypedSpec().Value.ExtraCerts.K8SAggregator
+						res.TypedSpec().Value.ExtraCerts.K8SAggregator = nil
+					case specs.SecretRotationSpec_KUBERNETES_SERVICE_ACCOUNT_KEY:
+						res.TypedSpec().Value.BackupCertsK8SSa = updateBackups(res.TypedSpec().Value.BackupCertsK8SSa, res.TypedSpec().Value.Certs.K8SSa)
+						res.TypedSpec().Value.Certs.K8SSa = res.TypedSpec().Value.ExtraCerts.K8SSa
+						res.TypedSpec().Value.ExtraCerts.K8SSa = nil
+					case specs.SecretRotationSpec_BOOTSTRAP_TOKEN:
+						res.TypedSpec().Value.ExtraBootstrapToken = ""
 					case specs.SecretRotationSpec_NONE:
 						// nothing to do
 					}
@@ -388,39 +456,114 @@ func (s *Rotator) addRotationStage(previousPhase, currentPhase specs.SecretRotat
 	}
 }
 
-func (s *Rotator) startCARotation(
+//nolint:gocyclo,cyclop
+func (s *Rotator) startRotation(
+	ctx context.Context,
+	r controller.ReaderWriter,
 	rotationStatus *omni.ClusterSecretsRotationStatus,
+	secretRotation *omni.SecretRotation,
 	cmSecretsMap map[resource.ID]*omni.ClusterMachineSecrets,
-	rotatingComponent specs.SecretRotationSpec_Component,
-	rotateRequestVersion string,
-	generateCA func() (ca *x509.CertificateAuthority, err error),
-	saveResult func(rotatingComponent specs.SecretRotationSpec_Component, ca *x509.CertificateAuthority) error,
+	request *rotationRequest,
 ) error {
+	rotatingComponent := request.component.component
 	nextPhase := specs.SecretRotationSpec_PRE_ROTATE
 	rotationStatus.TypedSpec().Value.Component = rotatingComponent
 	rotationStatus.TypedSpec().Value.Phase = nextPhase
 	rotationStatus.TypedSpec().Value.Status = fmt.Sprintf("rotation phase %s %d/%d", nextPhase.String(), 0, len(cmSecretsMap))
 	rotationStatus.TypedSpec().Value.Step = "starting secret rotation"
 
+	if request.version != "" {
+		rotationStatus.Metadata().Annotations().Set(request.component.versionAnnotation, request.version)
+	}
+
+	versionContract := func() (*config.VersionContract, error) {
+		clusterConfigVersion, err := safe.ReaderGetByID[*omni.ClusterConfigVersion](ctx, r, secretRotation.Metadata().ID())
+		if err != nil {
+			return nil, err
+		}
+
+		return config.ParseContractFromVersion(clusterConfigVersion.TypedSpec().Value.Version)
+	}
+
+	var (
+		newCA          *x509.CertificateAuthority
+		newKey         []byte
+		bootstrapToken string
+		contract       *config.VersionContract
+		err            error
+	)
+
+	now := talossecrets.NewFixedClock(time.Now()).Now()
+
 	switch rotatingComponent {
 	case specs.SecretRotationSpec_TALOS_CA:
-		rotationStatus.Metadata().Annotations().Set(omni.RotateTalosCAVersion, rotateRequestVersion)
+		newCA, err = talossecrets.NewTalosCA(now)
 	case specs.SecretRotationSpec_KUBERNETES_CA:
-		rotationStatus.Metadata().Annotations().Set(omni.RotateKubernetesCAVersion, rotateRequestVersion)
+		if contract, err = versionContract(); err == nil {
+			newCA, err = talossecrets.NewKubernetesCA(now, contract)
+		}
+	case specs.SecretRotationSpec_ETCD_CA:
+		if contract, err = versionContract(); err == nil {
+			newCA, err = talossecrets.NewEtcdCA(now, contract)
+		}
+	case specs.SecretRotationSpec_KUBERNETES_AGGREGATOR_CA:
+		if contract, err = versionContract(); err == nil {
+			newCA, err = talossecrets.NewAggregatorCA(now, contract)
+		}
+	case specs.SecretRotationSpec_KUBERNETES_SERVICE_ACCOUNT_KEY:
+		var key *x509.ECDSAKey
+
+		if key, err = x509.NewECDSAKey(); err == nil {
+			newKey = key.KeyPEM
+		}
+	case specs.SecretRotationSpec_BOOTSTRAP_TOKEN:
+		bootstrapToken, err = newBootstrapToken()
 	case specs.SecretRotationSpec_NONE:
-		// nothing to do
+		return nil
 	}
 
-	newCA, err := generateCA()
 	if err != nil {
 		return fmt.Errorf("failed to generate new %s: %w", rotatingComponent.String(), err)
 	}
 
-	if err = saveResult(rotatingComponent, newCA); err != nil {
-		return err
+	var newCerts *specs.ClusterSecretsSpec_Certs_CA
+
+	switch {
+	case newCA != nil:
+		newCerts = &specs.ClusterSecretsSpec_Certs_CA{
+			Crt: newCA.CrtPEM,
+			Key: newCA.KeyPEM,
+		}
+	case newKey != nil:
+		newCerts = &specs.ClusterSecretsSpec_Certs_CA{
+			Key: newKey,
+		}
 	}
 
-	return nil
+	return safe.WriterModify[*omni.SecretRotation](ctx, r, secretRotation,
+		func(res *omni.SecretRotation) error {
+			res.TypedSpec().Value.Status = specs.SecretRotationSpec_IN_PROGRESS
+			res.TypedSpec().Value.Component = rotatingComponent
+
+			switch rotatingComponent {
+			case specs.SecretRotationSpec_TALOS_CA:
+				res.TypedSpec().Value.ExtraCerts.Os = newCerts
+			case specs.SecretRotationSpec_KUBERNETES_CA:
+				res.TypedSpec().Value.ExtraCerts.K8S = newCerts
+			case specs.SecretRotationSpec_ETCD_CA:
+				res.TypedSpec().Value.ExtraCerts.Etcd = newCerts
+			case specs.SecretRotationSpec_KUBERNETES_AGGREGATOR_CA:
+				res.TypedSpec().Value.ExtraCerts.K8SAggregator = newCerts
+			case specs.SecretRotationSpec_KUBERNETES_SERVICE_ACCOUNT_KEY:
+				res.TypedSpec().Value.ExtraCerts.K8SSa = newCerts
+			case specs.SecretRotationSpec_BOOTSTRAP_TOKEN:
+				res.TypedSpec().Value.ExtraBootstrapToken = bootstrapToken
+			case specs.SecretRotationSpec_NONE:
+				// nothing to do
+			}
+
+			return nil
+		})
 }
 
 func (s *Rotator) handleClusterMachineScaling(
@@ -664,6 +807,14 @@ func (s *Rotator) prepareCMSecretsForSecretRotation(
 		s.prepareTalosCARotation(secretRotation, secretsBundle, rotation, currentPhase)
 	case specs.SecretRotationSpec_KUBERNETES_CA:
 		s.prepareKubernetesCARotation(secretRotation, secretsBundle, rotation, currentPhase)
+	case specs.SecretRotationSpec_ETCD_CA:
+		s.prepareEtcdCARotation(secretRotation, secretsBundle, rotation, currentPhase)
+	case specs.SecretRotationSpec_KUBERNETES_AGGREGATOR_CA:
+		s.prepareKubernetesAggregatorCARotation(secretRotation, secretsBundle, rotation, currentPhase)
+	case specs.SecretRotationSpec_KUBERNETES_SERVICE_ACCOUNT_KEY:
+		s.prepareKubernetesServiceAccountKeyRotation(secretRotation, secretsBundle, rotation, currentPhase)
+	case specs.SecretRotationSpec_BOOTSTRAP_TOKEN:
+		s.prepareBootstrapTokenRotation(secretRotation, secretsBundle, rotation, currentPhase)
 	case specs.SecretRotationSpec_NONE:
 		// nothing to do
 	}
@@ -741,6 +892,144 @@ func (s *Rotator) prepareKubernetesCARotation(
 	}
 }
 
+//nolint:dupl
+func (s *Rotator) prepareEtcdCARotation(
+	secretRotation *omni.SecretRotation,
+	secretsBundle *talossecrets.Bundle,
+	rotation *specs.ClusterMachineSecretsSpec_Rotation,
+	currentPhase specs.SecretRotationSpec_Phase,
+) {
+	if secretRotation.TypedSpec().Value.ExtraCerts.GetEtcd() == nil {
+		return
+	}
+
+	rotation.Component = specs.SecretRotationSpec_ETCD_CA
+	rotation.Phase = currentPhase
+
+	switch currentPhase {
+	case specs.SecretRotationSpec_OK:
+		// nothing to do
+	case specs.SecretRotationSpec_PRE_ROTATE:
+		rotation.ExtraCerts.Etcd = &specs.ClusterSecretsSpec_Certs_CA{
+			Crt: secretRotation.TypedSpec().Value.ExtraCerts.Etcd.Crt,
+			Key: secretRotation.TypedSpec().Value.ExtraCerts.Etcd.Key,
+		}
+	case specs.SecretRotationSpec_ROTATE:
+		secretsBundle.Certs.Etcd = &x509.PEMEncodedCertificateAndKey{
+			Crt: secretRotation.TypedSpec().Value.ExtraCerts.Etcd.Crt,
+			Key: secretRotation.TypedSpec().Value.ExtraCerts.Etcd.Key,
+		}
+		rotation.ExtraCerts.Etcd = &specs.ClusterSecretsSpec_Certs_CA{
+			Crt: secretRotation.TypedSpec().Value.Certs.Etcd.Crt,
+			Key: secretRotation.TypedSpec().Value.Certs.Etcd.Key,
+		}
+	case specs.SecretRotationSpec_POST_ROTATE:
+		rotation.ExtraCerts = nil
+	}
+}
+
+//nolint:dupl
+func (s *Rotator) prepareKubernetesAggregatorCARotation(
+	secretRotation *omni.SecretRotation,
+	secretsBundle *talossecrets.Bundle,
+	rotation *specs.ClusterMachineSecretsSpec_Rotation,
+	currentPhase specs.SecretRotationSpec_Phase,
+) {
+	if secretRotation.TypedSpec().Value.ExtraCerts.GetK8SAggregator() == nil {
+		return
+	}
+
+	rotation.Component = specs.SecretRotationSpec_KUBERNETES_AGGREGATOR_CA
+	rotation.Phase = currentPhase
+
+	switch currentPhase {
+	case specs.SecretRotationSpec_OK:
+		// nothing to do
+	case specs.SecretRotationSpec_PRE_ROTATE:
+		rotation.ExtraCerts.K8SAggregator = &specs.ClusterSecretsSpec_Certs_CA{
+			Crt: secretRotation.TypedSpec().Value.ExtraCerts.K8SAggregator.Crt,
+			Key: secretRotation.TypedSpec().Value.ExtraCerts.K8SAggregator.Key,
+		}
+	case specs.SecretRotationSpec_ROTATE:
+		secretsBundle.Certs.K8sAggregator = &x509.PEMEncodedCertificateAndKey{
+			Crt: secretRotation.TypedSpec().Value.ExtraCerts.K8SAggregator.Crt,
+			Key: secretRotation.TypedSpec().Value.ExtraCerts.K8SAggregator.Key,
+		}
+		rotation.ExtraCerts.K8SAggregator = &specs.ClusterSecretsSpec_Certs_CA{
+			Crt: secretRotation.TypedSpec().Value.Certs.K8SAggregator.Crt,
+			Key: secretRotation.TypedSpec().Value.Certs.K8SAggregator.Key,
+		}
+	case specs.SecretRotationSpec_POST_ROTATE:
+		rotation.ExtraCerts = nil
+	}
+}
+
+// prepareKubernetesServiceAccountKeyRotation replaces the service account signing key at the ROTATE phase.
+//
+// The extra key is accepted by kube-apiserver along with the signing key from PRE_ROTATE until POST_ROTATE:
+// at PRE_ROTATE the new key is accepted before any kube-apiserver issues tokens with it,
+// at ROTATE the old key is kept accepted for the tokens issued before the rotation, and it's dropped at POST_ROTATE.
+func (s *Rotator) prepareKubernetesServiceAccountKeyRotation(
+	secretRotation *omni.SecretRotation,
+	secretsBundle *talossecrets.Bundle,
+	rotation *specs.ClusterMachineSecretsSpec_Rotation,
+	currentPhase specs.SecretRotationSpec_Phase,
+) {
+	if secretRotation.TypedSpec().Value.ExtraCerts.GetK8SSa() == nil {
+		return
+	}
+
+	rotation.Component = specs.SecretRotationSpec_KUBERNETES_SERVICE_ACCOUNT_KEY
+	rotation.Phase = currentPhase
+
+	switch currentPhase {
+	case specs.SecretRotationSpec_OK:
+		// nothing to do
+	case specs.SecretRotationSpec_PRE_ROTATE:
+		rotation.ExtraCerts.K8SSa = &specs.ClusterSecretsSpec_Certs_CA{
+			Key: secretRotation.TypedSpec().Value.ExtraCerts.K8SSa.Key,
+		}
+	case specs.SecretRotationSpec_ROTATE:
+		secretsBundle.Certs.K8sServiceAccount = &x509.PEMEncodedKey{
+			Key: secretRotation.TypedSpec().Value.ExtraCerts.K8SSa.Key,
+		}
+
+		if oldKey := secretRotation.TypedSpec().Value.GetCerts().GetK8SSa().GetKey(); oldKey != nil {
+			rotation.ExtraCerts.K8SSa = &specs.ClusterSecretsSpec_Certs_CA{
+				Key: oldKey,
+			}
+		}
+	case specs.SecretRotationSpec_POST_ROTATE:
+		rotation.ExtraCerts = nil
+	}
+}
+
+// prepareBootstrapTokenRotation replaces the bootstrap token at the ROTATE phase.
+//
+// The bootstrap token is only used by the machines joining the cluster, so it doesn't need a phase where both tokens are accepted.
+func (s *Rotator) prepareBootstrapTokenRotation(
+	secretRotation *omni.SecretRotation,
+	secretsBundle *talossecrets.Bundle,
+	rotation *specs.ClusterMachineSecretsSpec_Rotation,
+	currentPhase specs.SecretRotationSpec_Phase,
+) {
+	if secretRotation.TypedSpec().Value.ExtraBootstrapToken == "" {
+		return
+	}
+
+	rotation.Component = specs.SecretRotationSpec_BOOTSTRAP_TOKEN
+	rotation.Phase = currentPhase
+
+	switch currentPhase {
+	case specs.SecretRotationSpec_OK, specs.SecretRotationSpec_PRE_ROTATE:
+		// nothing to do
+	case specs.SecretRotationSpec_ROTATE:
+		secretsBundle.Secrets.BootstrapToken = secretRotation.TypedSpec().Value.ExtraBootstrapToken
+	case specs.SecretRotationSpec_POST_ROTATE:
+		rotation.ExtraCerts = nil
+	}
+}
+
 func (s *Rotator) processMachine(
 	ctx context.Context,
 	r controller.ReaderWriter,
@@ -867,6 +1156,17 @@ func (s *Rotator) hostnamesToString(hostnames []string) string {
 	return strings.Join(hostnames, ", ")
 }
 
+func certsCAFromBundle(ca *x509.PEMEncodedCertificateAndKey) *specs.ClusterSecretsSpec_Certs_CA {
+	if ca == nil {
+		return nil
+	}
+
+	return &specs.ClusterSecretsSpec_Certs_CA{
+		Crt: ca.Crt,
+		Key: ca.Key,
+	}
+}
+
 func (s *Rotator) getSecretRotation(ctx context.Context, r controller.ReaderWriter, id resource.ID) (*omni.SecretRotation, error) {
 	return safe.ReaderGetByID[*omni.SecretRotation](ctx, r, id)
 }
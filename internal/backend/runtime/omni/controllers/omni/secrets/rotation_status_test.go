@@ -23,6 +23,7 @@ import (
 	"github.com/siderolabs/gen/xslices"
 	"github.com/siderolabs/talos/pkg/machinery/api/common"
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	gensecrets "github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
 	talosconstants "github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
 	"github.com/stretchr/testify/assert"
@@ -1292,6 +1293,56 @@ func Test_ComponentIsolationDuringRotation(t *testing.T) {
 	)
 }
 
+func Test_KubernetesServiceAccountKeyRotationPhases(t *testing.T) {
+	t.Parallel()
+
+	oldKey := []byte("old-key")
+	newKey := []byte("new-key")
+
+	secretRotation := omni.NewSecretRotation("test")
+	secretRotation.TypedSpec().Value.Component = specs.SecretRotationSpec_KUBERNETES_SERVICE_ACCOUNT_KEY
+	secretRotation.TypedSpec().Value.Certs = &specs.ClusterSecretsSpec_Certs{K8SSa: &specs.ClusterSecretsSpec_Certs_CA{Key: oldKey}}
+	secretRotation.TypedSpec().Value.ExtraCerts = &specs.ClusterSecretsSpec_Certs{K8SSa: &specs.ClusterSecretsSpec_Certs_CA{Key: newKey}}
+
+	for _, tt := range []struct {
+		name             string
+		phase            specs.SecretRotationSpec_Phase
+		expectedKey      []byte
+		expectedExtraKey []byte
+	}{
+		{
+			name:             "pre-rotate accepts the new key",
+			phase:            specs.SecretRotationSpec_PRE_ROTATE,
+			expectedKey:      oldKey,
+			expectedExtraKey: newKey,
+		},
+		{
+			name:             "rotate keeps the old key accepted",
+			phase:            specs.SecretRotationSpec_ROTATE,
+			expectedKey:      newKey,
+			expectedExtraKey: oldKey,
+		},
+		{
+			name:        "post-rotate drops the old key",
+			phase:       specs.SecretRotationSpec_POST_ROTATE,
+			expectedKey: oldKey, // the signing key comes from the bundle as is
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			bundle := &gensecrets.Bundle{Certs: &gensecrets.Certs{K8sServiceAccount: &talosx509.PEMEncodedKey{Key: oldKey}}}
+			rotation := &specs.ClusterMachineSecretsSpec_Rotation{ExtraCerts: &specs.ClusterSecretsSpec_Certs{}}
+
+			secretsctrl.PrepareKubernetesServiceAccountKeyRotation(secretRotation, bundle, rotation, tt.phase)
+
+			require.Equal(t, specs.SecretRotationSpec_KUBERNETES_SERVICE_ACCOUNT_KEY, rotation.Component)
+			require.Equal(t, tt.expectedKey, bundle.Certs.K8sServiceAccount.Key)
+			require.Equal(t, tt.expectedExtraKey, rotation.GetExtraCerts().GetK8SSa().GetKey())
+		})
+	}
+}
+
 func Test_BackupCertificateLimitConstant(t *testing.T) {
 	t.Parallel()
 
@@ -289,7 +289,21 @@ func (s *Controller) handlePreRotate(secrets *omni.ClusterSecrets, secretRotatio
 				Key: secretRotation.TypedSpec().Value.ExtraCerts.K8S.Key,
 			},
 		}
-	case specs.SecretRotationSpec_NONE:
+	case specs.SecretRotationSpec_ETCD_CA:
+		secrets.TypedSpec().Value.ExtraCerts = &specs.ClusterSecretsSpec_Certs{
+			Etcd: &specs.ClusterSecretsSpec_Certs_CA{
+				Crt: secretRotation.TypedSpec().Value.ExtraCerts.Etcd.Crt,
+				Key: secretRotation.TypedSpec().Value.ExtraCerts.Etcd.Key,
+			},
+		}
+	case specs.SecretRotationSpec_KUBERNETES_AGGREGATOR_CA:
+		secrets.TypedSpec().Value.ExtraCerts = &specs.ClusterSecretsSpec_Certs{
+			K8SAggregator: &specs.ClusterSecretsSpec_Certs_CA{
+				Crt: secretRotation.TypedSpec().Value.ExtraCerts.K8SAggregator.Crt,
+				Key: secretRotation.TypedSpec().Value.ExtraCerts.K8SAggregator.Key,
+			},
+		}
+	case specs.SecretRotationSpec_KUBERNETES_SERVICE_ACCOUNT_KEY, specs.SecretRotationSpec_BOOTSTRAP_TOKEN, specs.SecretRotationSpec_NONE:
 		// nothing to do
 	}
 }
@@ -318,18 +332,41 @@ func (s *Controller) handleRotate(secrets *omni.ClusterSecrets, secretRotation *
 				Key: secretRotation.TypedSpec().Value.Certs.K8S.Key,
 			},
 		}
+	case specs.SecretRotationSpec_ETCD_CA:
+		bundle.Certs.Etcd = &x509.PEMEncodedCertificateAndKey{
+			Crt: secretRotation.TypedSpec().Value.ExtraCerts.Etcd.Crt,
+			Key: secretRotation.TypedSpec().Value.ExtraCerts.Etcd.Key,
+		}
+		secrets.TypedSpec().Value.ExtraCerts = &specs.ClusterSecretsSpec_Certs{
+			Etcd: &specs.ClusterSecretsSpec_Certs_CA{
+				Crt: secretRotation.TypedSpec().Value.Certs.Etcd.Crt,
+				Key: secretRotation.TypedSpec().Value.Certs.Etcd.Key,
+			},
+		}
+	case specs.SecretRotationSpec_KUBERNETES_AGGREGATOR_CA:
+		bundle.Certs.K8sAggregator = &x509.PEMEncodedCertificateAndKey{
+			Crt: secretRotation.TypedSpec().Value.ExtraCerts.K8SAggregator.Crt,
+			Key: secretRotation.TypedSpec().Value.ExtraCerts.K8SAggregator.Key,
+		}
+		secrets.TypedSpec().Value.ExtraCerts = &specs.ClusterSecretsSpec_Certs{
+			K8SAggregator: &specs.ClusterSecretsSpec_Certs_CA{
+				Crt: secretRotation.TypedSpec().Value.Certs.K8SAggregator.Crt,
+				Key: secretRotation.TypedSpec().Value.Certs.K8SAggregator.Key,
+			},
+		}
+	case specs.SecretRotationSpec_KUBERNETES_SERVICE_ACCOUNT_KEY:
+		bundle.Certs.K8sServiceAccount = &x509.PEMEncodedKey{
+			Key: secretRotation.TypedSpec().Value.ExtraCerts.K8SSa.Key,
+		}
+	case specs.SecretRotationSpec_BOOTSTRAP_TOKEN:
+		bundle.Secrets.BootstrapToken = secretRotation.TypedSpec().Value.ExtraBootstrapToken
 	case specs.SecretRotationSpec_NONE:
 		// nothing to do
 	}
 }
 
 func (s *Controller) handlePostRotate(secrets *omni.ClusterSecrets, secretRotation *omni.SecretRotation) {
-	switch secretRotation.TypedSpec().Value.Component {
-	case specs.SecretRotationSpec_TALOS_CA:
-		secrets.Metadata().Annotations().Set(omni.RotateTalosCATimestamp, strconv.Itoa(int(time.Now().Unix())))
-	case specs.SecretRotationSpec_KUBERNETES_CA:
-		secrets.Metadata().Annotations().Set(omni.RotateKubernetesCATimestamp, strconv.Itoa(int(time.Now().Unix())))
-	case specs.SecretRotationSpec_NONE:
-		// nothing to do
+	if component, ok := getRotationComponent(secretRotation.TypedSpec().Value.Component); ok {
+		secrets.Metadata().Annotations().Set(component.timestampAnnotation, strconv.Itoa(int(time.Now().Unix())))
 	}
 }
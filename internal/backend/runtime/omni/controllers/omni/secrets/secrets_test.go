@@ -52,6 +52,10 @@ func (m *mockBackupStore) Download(context.Context, []byte, string, string) (etc
 	}, io.NopCloser(bytes.NewReader(nil)), nil
 }
 
+func (m *mockBackupStore) Delete(context.Context, string, string) error {
+	return nil
+}
+
 type mockBackupStoreFactory struct{}
 
 func (m *mockBackupStoreFactory) SetThroughputs(uint64, uint64) {}
@@ -504,4 +508,83 @@ func TestSecretRotation(t *testing.T) {
 			},
 		)
 	})
+
+	t.Run("trigger bootstrap token rotation", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(t.Context(), 30*time.Second)
+
+		t.Cleanup(cancel)
+
+		testutils.WithRuntime(
+			ctx, t, testutils.TestOptions{}, addControllers,
+			func(ctx context.Context, testContext testutils.TestContext) {
+				st := testContext.State
+
+				machineServices := testutils.NewMachineServices(t, testContext.State)
+				cluster, _ := createCluster(ctx, t, testContext.State, machineServices, "rotate-bootstrap-token", 1, 1)
+
+				clusterSecrets, err := safe.ReaderGetByID[*omni.ClusterSecrets](ctx, st, cluster.Metadata().ID())
+				require.NoError(t, err)
+
+				secretsBundle, err := omni.ToSecretsBundle(clusterSecrets.TypedSpec().Value.Data)
+				require.NoError(t, err)
+
+				bootstrapToken, err := secrets.NewBootstrapToken()
+				require.NoError(t, err)
+				require.Regexp(t, `^[a-z0-9]{6}\.[a-z0-9]{16}$`, bootstrapToken)
+
+				rmock.Mock[*omni.SecretRotation](ctx, t, testContext.State, options.SameID(cluster), options.Modify(func(res *omni.SecretRotation) error {
+					res.TypedSpec().Value.Component = specs.SecretRotationSpec_BOOTSTRAP_TOKEN
+					res.TypedSpec().Value.Phase = specs.SecretRotationSpec_PRE_ROTATE
+					res.TypedSpec().Value.ExtraBootstrapToken = bootstrapToken
+
+					return nil
+				}))
+
+				rtestutils.AssertResource(
+					ctx, t, st, cluster.Metadata().ID(),
+					func(res *omni.ClusterSecrets, assertions *assert.Assertions) {
+						bundle, innerErr := omni.ToSecretsBundle(res.TypedSpec().Value.Data)
+						assertions.NoError(innerErr)
+
+						assertions.Equal(secretsBundle.Secrets.BootstrapToken, bundle.Secrets.BootstrapToken)
+						assertions.Nil(res.TypedSpec().Value.ExtraCerts)
+					},
+				)
+
+				rmock.Mock[*omni.SecretRotation](ctx, t, testContext.State, options.SameID(cluster), options.Modify(func(res *omni.SecretRotation) error {
+					res.TypedSpec().Value.Phase = specs.SecretRotationSpec_ROTATE
+
+					return nil
+				}))
+
+				rtestutils.AssertResource(
+					ctx, t, st, cluster.Metadata().ID(),
+					func(res *omni.ClusterSecrets, assertions *assert.Assertions) {
+						bundle, innerErr := omni.ToSecretsBundle(res.TypedSpec().Value.Data)
+						assertions.NoError(innerErr)
+
+						assertions.Equal(bootstrapToken, bundle.Secrets.BootstrapToken)
+						assertions.Equal(secretsBundle.Certs.OS.Crt, bundle.Certs.OS.Crt)
+					},
+				)
+
+				rmock.Mock[*omni.SecretRotation](ctx, t, testContext.State, options.SameID(cluster), options.Modify(func(res *omni.SecretRotation) error {
+					res.TypedSpec().Value.Phase = specs.SecretRotationSpec_POST_ROTATE
+
+					return nil
+				}))
+
+				rtestutils.AssertResource(
+					ctx, t, st, cluster.Metadata().ID(),
+					func(res *omni.ClusterSecrets, assertions *assert.Assertions) {
+						timestamp, timestampOK := res.Metadata().Annotations().Get(omni.RotateBootstrapTokenTimestamp)
+						assertions.True(timestampOK)
+						assertions.NotEmpty(timestamp)
+					},
+				)
+			},
+		)
+	})
 }
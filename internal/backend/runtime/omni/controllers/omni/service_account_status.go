@@ -120,6 +120,7 @@ func NewServiceAccountStatusController() *ServiceAccountStatusController {
 				}
 
 				status.TypedSpec().Value.Role = user.TypedSpec().Value.Role
+				status.TypedSpec().Value.CustomRoles = user.TypedSpec().Value.CustomRoles
 
 				if hasRunningKey {
 					status.TypedSpec().Value.Expiration = timestamppb.New(expiration)
@@ -0,0 +1,553 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package talosupgrade
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+// defaultCanaryFailureTimeout is how long the updated canaries may stay unhealthy when the strategy doesn't set its own timeout.
+const defaultCanaryFailureTimeout = 10 * time.Minute
+
+// canaryChange is the pending change of a single machine tracked by a canary rollout.
+type canaryChange struct {
+	// rollback is the version the machine is rolled back to if the canaries fail, it is only set for the upgrades.
+	rollback *specs.CanaryRolloutStatus_RollbackVersion
+	hash     string
+	locked   bool
+	// restorable is set if the config patches the machine had before the change are kept, so they are restored if the canaries fail,
+	// it is only set for the config updates.
+	restorable bool
+}
+
+// canaryInput is the observed state of a machine set used to advance its canary rollout.
+type canaryInput struct {
+	now time.Time
+	// approved is the time the canaries of the machine set were last approved, zero if they were never approved.
+	approved time.Time
+	config   *specs.MachineSetSpec_CanaryUpdateStrategyConfig
+	// pending are the pending changes of the machines in the machine set.
+	pending map[string]canaryChange
+	// machines are all machines of the machine set mapped to whether they are running and ready.
+	machines map[string]bool
+	// versions are the Talos versions and schematics the machines are currently running.
+	versions     map[string]*specs.CanaryRolloutStatus_RollbackVersion
+	healthChecks healthCheckResult
+}
+
+// awaitsVerification reports whether the canary rollout is going to be verified in this reconcile,
+// so the cluster healthchecks must be evaluated for it.
+func (in canaryInput) awaitsVerification(prev *specs.CanaryRolloutStatus) bool {
+	switch prev.GetPhase() { //nolint:exhaustive
+	case specs.CanaryRolloutStatus_Verifying:
+		return true
+	case specs.CanaryRolloutStatus_Updating:
+		return len(prev.Machines) > 0 && !slices.ContainsFunc(prev.Machines, func(id string) bool {
+			_, pending := in.pending[id]
+
+			return pending
+		})
+	default:
+		return false
+	}
+}
+
+// reconcileCanary advances the canary rollout of a machine set.
+//
+// It returns the new state of the rollout, nil if there is nothing to roll out, and the interval after which the
+// rollout must be re-evaluated, zero if no time-based re-evaluation is needed.
+func reconcileCanary(prev *specs.CanaryRolloutStatus, in canaryInput) (*specs.CanaryRolloutStatus, time.Duration) {
+	if len(in.pending) == 0 {
+		return nil, 0
+	}
+
+	var status *specs.CanaryRolloutStatus
+
+	if prev != nil && !canaryTargetsChanged(prev, in.pending) {
+		status = prev.CloneVT()
+
+		// forget the canaries which were removed from the machine set
+		status.Machines = slices.DeleteFunc(status.Machines, func(id string) bool {
+			_, ok := in.machines[id]
+
+			return !ok
+		})
+	}
+
+	if status == nil || (len(status.Machines) == 0 && status.Phase != specs.CanaryRolloutStatus_Failed) {
+		status = startCanary(in)
+	}
+
+	switch status.Phase { //nolint:exhaustive
+	case specs.CanaryRolloutStatus_Updating:
+		return reconcileCanaryUpdating(status, in)
+	case specs.CanaryRolloutStatus_Verifying:
+		return reconcileCanaryVerifying(status, in)
+	case specs.CanaryRolloutStatus_RollingBack:
+		rolledBack := true
+
+		for id, version := range status.RollbackVersions {
+			current := in.versions[id]
+			if current.GetTalosVersion() != version.TalosVersion || current.GetSchematicId() != version.SchematicId {
+				rolledBack = false
+
+				break
+			}
+		}
+
+		if rolledBack {
+			status.Phase = specs.CanaryRolloutStatus_Failed
+		}
+	}
+
+	return status, 0
+}
+
+// startCanary starts a new rollout picking the canaries from the pending machines.
+func startCanary(in canaryInput) *specs.CanaryRolloutStatus {
+	status := &specs.CanaryRolloutStatus{
+		Phase:   specs.CanaryRolloutStatus_Updating,
+		Targets: make(map[string]string, len(in.pending)),
+	}
+
+	count := max(int(in.config.GetCount()), 1)
+
+	for _, id := range slices.Sorted(maps.Keys(in.pending)) {
+		change := in.pending[id]
+
+		status.Targets[id] = change.hash
+
+		if change.locked || len(status.Machines) == count {
+			continue
+		}
+
+		status.Machines = append(status.Machines, id)
+
+		if change.rollback != nil {
+			if status.RollbackVersions == nil {
+				status.RollbackVersions = map[string]*specs.CanaryRolloutStatus_RollbackVersion{}
+			}
+
+			status.RollbackVersions[id] = change.rollback
+		}
+
+		if change.restorable {
+			status.RollbackConfigMachines = append(status.RollbackConfigMachines, id)
+		}
+	}
+
+	if len(status.Machines) == 0 {
+		status.Reason = "waiting for the machines to be unlocked"
+	}
+
+	return status
+}
+
+// canaryTargetsChanged reports whether any of the pending changes is different from the ones the rollout was started for.
+//
+// The canaries of a failed rollout are pinned to their previous versions, so their pending changes are ignored.
+func canaryTargetsChanged(status *specs.CanaryRolloutStatus, pending map[string]canaryChange) bool {
+	pinned := status.Phase == specs.CanaryRolloutStatus_RollingBack || status.Phase == specs.CanaryRolloutStatus_Failed
+
+	for id, change := range pending {
+		if pinned && slices.Contains(status.Machines, id) {
+			continue
+		}
+
+		if hash, ok := status.Targets[id]; !ok || hash != change.hash {
+			return true
+		}
+	}
+
+	return false
+}
+
+func reconcileCanaryUpdating(status *specs.CanaryRolloutStatus, in canaryInput) (*specs.CanaryRolloutStatus, time.Duration) {
+	if len(status.Machines) == 0 {
+		return status, 0
+	}
+
+	var updating []string
+
+	for _, id := range status.Machines {
+		if _, pending := in.pending[id]; pending {
+			updating = append(updating, id)
+		}
+	}
+
+	if len(updating) == 0 {
+		status.Phase = specs.CanaryRolloutStatus_Verifying
+		status.VerificationStarted = timestamppb.New(in.now)
+		status.UnhealthySince = nil
+
+		return reconcileCanaryVerifying(status, in)
+	}
+
+	if unhealthy := unhealthyCanaries(status, in); len(unhealthy) > 0 {
+		return trackUnhealthyCanaries(status, in, notHealthyReason(unhealthy))
+	}
+
+	status.UnhealthySince = nil
+	status.Reason = "updating canary " + machinesReason(updating)
+
+	return status, 0
+}
+
+func reconcileCanaryVerifying(status *specs.CanaryRolloutStatus, in canaryInput) (*specs.CanaryRolloutStatus, time.Duration) {
+	if unhealthy := unhealthyCanaries(status, in); len(unhealthy) > 0 {
+		return trackUnhealthyCanaries(status, in, notHealthyReason(unhealthy))
+	}
+
+	if !in.healthChecks.ready {
+		return trackUnhealthyCanaries(status, in, in.healthChecks.reason)
+	}
+
+	status.UnhealthySince = nil
+
+	if soakLeft := in.config.GetSoakDuration().AsDuration() - in.now.Sub(status.VerificationStarted.AsTime()); soakLeft > 0 {
+		status.Reason = fmt.Sprintf("verifying the canaries, %s left", soakLeft.Round(time.Second))
+
+		return status, soakLeft
+	}
+
+	if in.config.GetRequireApproval() && (in.approved.IsZero() || in.approved.Before(status.VerificationStarted.AsTime())) {
+		status.Reason = "waiting for the canaries to be approved"
+
+		return status, 0
+	}
+
+	status.Phase = specs.CanaryRolloutStatus_Promoted
+	status.Reason = ""
+
+	return status, 0
+}
+
+// unhealthyCanaries returns the canaries which finished updating but are not running or ready.
+func unhealthyCanaries(status *specs.CanaryRolloutStatus, in canaryInput) []string {
+	var unhealthy []string
+
+	for _, id := range status.Machines {
+		if _, pending := in.pending[id]; pending && status.Phase == specs.CanaryRolloutStatus_Updating {
+			continue
+		}
+
+		if !in.machines[id] {
+			unhealthy = append(unhealthy, id)
+		}
+	}
+
+	return unhealthy
+}
+
+// trackUnhealthyCanaries fails the rollout once the canaries stay unhealthy longer than the failure timeout.
+func trackUnhealthyCanaries(status *specs.CanaryRolloutStatus, in canaryInput, reason string) (*specs.CanaryRolloutStatus, time.Duration) {
+	if status.UnhealthySince == nil {
+		status.UnhealthySince = timestamppb.New(in.now)
+	}
+
+	failureTimeout := in.config.GetFailureTimeout().AsDuration()
+	if failureTimeout <= 0 {
+		failureTimeout = defaultCanaryFailureTimeout
+	}
+
+	left := failureTimeout - in.now.Sub(status.UnhealthySince.AsTime())
+	if left > 0 {
+		status.Reason = reason
+
+		requeue := left
+		if in.healthChecks.interval > 0 && in.healthChecks.interval < requeue {
+			requeue = in.healthChecks.interval
+		}
+
+		return status, requeue
+	}
+
+	status.Reason = "canaries failed: " + reason
+
+	if len(status.RollbackVersions) > 0 {
+		status.Phase = specs.CanaryRolloutStatus_RollingBack
+
+		return status, 0
+	}
+
+	status.Phase = specs.CanaryRolloutStatus_Failed
+
+	return status, 0
+}
+
+func notHealthyReason(machines []string) string {
+	if len(machines) == 1 {
+		return "canary machine " + machines[0] + " is not healthy"
+	}
+
+	return "canary machines " + strings.Join(machines, ", ") + " are not healthy"
+}
+
+func machinesReason(machines []string) string {
+	if len(machines) == 1 {
+		return "machine " + machines[0]
+	}
+
+	return "machines " + strings.Join(machines, ", ")
+}
+
+// canariesAwaitVerification reports whether any of the canary rollouts is going to be verified in this reconcile.
+func canariesAwaitVerification(previous map[string]*specs.CanaryRolloutStatus, inputs map[string]canaryInput) bool {
+	for machineSetID, in := range inputs {
+		if in.awaitsVerification(previous[machineSetID]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// reconcileCanaries advances the canary rollouts of all machine sets, collecting the interval they have to be re-checked after.
+func reconcileCanaries(previous map[string]*specs.CanaryRolloutStatus, inputs map[string]canaryInput, healthChecks healthCheckResult,
+	rollout *rolloutState,
+) map[string]*specs.CanaryRolloutStatus {
+	var res map[string]*specs.CanaryRolloutStatus
+
+	for machineSetID, in := range inputs {
+		in.healthChecks = healthChecks
+
+		status, requeueAfter := reconcileCanary(previous[machineSetID], in)
+
+		rollout.requeue(requeueAfter)
+
+		if status == nil {
+			continue
+		}
+
+		if res == nil {
+			res = make(map[string]*specs.CanaryRolloutStatus, len(inputs))
+		}
+
+		res[machineSetID] = status
+	}
+
+	return res
+}
+
+// canariesStep describes the canary rollouts which hold the upgrade.
+func canariesStep(canaries map[string]*specs.CanaryRolloutStatus) string {
+	var steps []string
+
+	for _, machineSetID := range slices.Sorted(maps.Keys(canaries)) {
+		canary := canaries[machineSetID]
+
+		if canary.Phase == specs.CanaryRolloutStatus_Promoted || canary.Reason == "" {
+			continue
+		}
+
+		steps = append(steps, fmt.Sprintf("machine set %q: %s", machineSetID, canary.Reason))
+	}
+
+	return strings.Join(steps, "; ")
+}
+
+// collectCanaryInputs gathers the state of the machine sets which use the canary strategy for the upgrades or the config updates.
+//
+//nolint:gocognit,gocyclo,cyclop
+func (ctrl *TalosUpgradeStatusController) collectCanaryInputs(ctx context.Context, r controller.Reader, cluster *omni.Cluster,
+	machineSets safe.List[*omni.MachineSet], clusterMachineStatuses safe.List[*omni.ClusterMachineStatus], upgradeStatus *omni.TalosUpgradeStatus,
+) (upgrades, updates map[string]canaryInput, err error) {
+	upgrades = map[string]canaryInput{}
+	updates = map[string]canaryInput{}
+
+	now := time.Now()
+
+	newInput := func(config *specs.MachineSetSpec_CanaryUpdateStrategyConfig) canaryInput {
+		return canaryInput{
+			now:      now,
+			config:   config,
+			pending:  map[string]canaryChange{},
+			machines: map[string]bool{},
+			versions: map[string]*specs.CanaryRolloutStatus_RollbackVersion{},
+		}
+	}
+
+	for machineSet := range machineSets.All() {
+		spec := machineSet.TypedSpec().Value
+
+		// reverts skip the canaries, so a broken cluster can always be rolled back
+		if spec.UpgradeStrategy == specs.MachineSetSpec_Canary && upgradeStatus.TypedSpec().Value.Phase != specs.TalosUpgradeStatusSpec_Reverting {
+			upgrades[machineSet.Metadata().ID()] = newInput(spec.GetUpgradeStrategyConfig().GetCanary())
+		}
+
+		if spec.UpdateStrategy == specs.MachineSetSpec_Canary {
+			updates[machineSet.Metadata().ID()] = newInput(spec.GetUpdateStrategyConfig().GetCanary())
+		}
+	}
+
+	if len(upgrades) == 0 && len(updates) == 0 {
+		return upgrades, updates, nil
+	}
+
+	query := state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, cluster.Metadata().ID()))
+
+	pendingUpdates, err := safe.ReaderListAll[*omni.MachinePendingUpdates](ctx, r, query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configStatuses, err := safe.ReaderListAll[*omni.ClusterMachineConfigStatus](ctx, r, query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	machineSetNodes, err := safe.ReaderListAll[*omni.MachineSetNode](ctx, r, query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configPatches, err := safe.ReaderListAll[*omni.ClusterMachineConfigPatches](ctx, r, query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	restorableMachines := map[string]struct{}{}
+
+	for machineConfigPatches := range configPatches.All() {
+		if machineConfigPatches.TypedSpec().Value.Previous != nil {
+			restorableMachines[machineConfigPatches.Metadata().ID()] = struct{}{}
+		}
+	}
+
+	lockedMachines := map[string]struct{}{}
+
+	for machineSetNode := range machineSetNodes.All() {
+		if _, locked := machineSetNode.Metadata().Annotations().Get(omni.MachineLocked); locked {
+			lockedMachines[machineSetNode.Metadata().ID()] = struct{}{}
+		}
+	}
+
+	for _, inputs := range []map[string]canaryInput{upgrades, updates} {
+		for machineSetID, in := range inputs {
+			var approval *omni.CanaryApproval
+
+			approval, err = safe.ReaderGetByID[*omni.CanaryApproval](ctx, r, machineSetID)
+			if err != nil {
+				if state.IsNotFoundError(err) {
+					continue
+				}
+
+				return nil, nil, err
+			}
+
+			in.approved = approval.Metadata().Updated()
+			inputs[machineSetID] = in
+		}
+	}
+
+	for clusterMachineStatus := range clusterMachineStatuses.All() {
+		machineSetID, _ := clusterMachineStatus.Metadata().Labels().Get(omni.LabelMachineSet)
+		ready := clusterMachineStatus.TypedSpec().Value.Stage == specs.ClusterMachineStatusSpec_RUNNING && clusterMachineStatus.TypedSpec().Value.Ready
+
+		for _, inputs := range []map[string]canaryInput{upgrades, updates} {
+			if in, ok := inputs[machineSetID]; ok {
+				in.machines[clusterMachineStatus.Metadata().ID()] = ready
+			}
+		}
+	}
+
+	configured := map[string]struct{}{}
+
+	for configStatus := range configStatuses.All() {
+		if configStatus.TypedSpec().Value.ClusterMachineConfigSha256 != "" {
+			configured[configStatus.Metadata().ID()] = struct{}{}
+		}
+
+		machineSetID, _ := configStatus.Metadata().Labels().Get(omni.LabelMachineSet)
+
+		if in, ok := upgrades[machineSetID]; ok {
+			in.versions[configStatus.Metadata().ID()] = &specs.CanaryRolloutStatus_RollbackVersion{
+				TalosVersion: configStatus.TypedSpec().Value.TalosVersion,
+				SchematicId:  configStatus.TypedSpec().Value.SchematicId,
+			}
+		}
+	}
+
+	for pendingUpdate := range pendingUpdates.All() {
+		machineID := pendingUpdate.Metadata().ID()
+		machineSetID, _ := pendingUpdate.Metadata().Labels().Get(omni.LabelMachineSet)
+		_, locked := lockedMachines[machineID]
+
+		if in, ok := upgrades[machineSetID]; ok {
+			if upgrade := pendingUpdate.TypedSpec().Value.Upgrade; upgrade != nil && upgrade.FromSchematic != "" && upgrade.FromVersion != "" {
+				in.pending[machineID] = canaryChange{
+					hash:   upgrade.ToVersion + "/" + upgrade.ToSchematic,
+					locked: locked,
+					rollback: &specs.CanaryRolloutStatus_RollbackVersion{
+						TalosVersion: upgrade.FromVersion,
+						SchematicId:  upgrade.FromSchematic,
+					},
+				}
+			}
+		}
+
+		in, ok := updates[machineSetID]
+		if !ok {
+			continue
+		}
+
+		// the initial config of a new machine is not rolled out through the canaries
+		if _, ok = configured[machineID]; !ok {
+			continue
+		}
+
+		var hash string
+
+		if hash, err = configDiffHash(pendingUpdate); err != nil {
+			return nil, nil, err
+		}
+
+		if hash != "" {
+			_, restorable := restorableMachines[machineID]
+
+			in.pending[machineID] = canaryChange{
+				hash:       hash,
+				locked:     locked,
+				restorable: restorable,
+			}
+		}
+	}
+
+	return upgrades, updates, nil
+}
+
+func configDiffHash(pendingUpdate *omni.MachinePendingUpdates) (string, error) {
+	diff, err := pendingUpdate.TypedSpec().Value.GetUncompressedData()
+	if err != nil {
+		return "", err
+	}
+
+	defer diff.Free()
+
+	if len(diff.Data()) == 0 {
+		return "", nil
+	}
+
+	hash := sha256.Sum256(diff.Data())
+
+	return hex.EncodeToString(hash[:]), nil
+}
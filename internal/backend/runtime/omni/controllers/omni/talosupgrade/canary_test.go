@@ -0,0 +1,235 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package talosupgrade
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+func newTestCanaryInput(now time.Time, config *specs.MachineSetSpec_CanaryUpdateStrategyConfig, pending ...string) canaryInput {
+	in := canaryInput{
+		now:          now,
+		config:       config,
+		pending:      map[string]canaryChange{},
+		machines:     map[string]bool{"m1": true, "m2": true, "m3": true},
+		versions:     map[string]*specs.CanaryRolloutStatus_RollbackVersion{},
+		healthChecks: healthCheckResult{ready: true},
+	}
+
+	for _, id := range pending {
+		in.pending[id] = canaryChange{
+			hash:     "1.11.0/new",
+			rollback: &specs.CanaryRolloutStatus_RollbackVersion{TalosVersion: "1.10.0", SchematicId: "old"},
+		}
+
+		in.versions[id] = &specs.CanaryRolloutStatus_RollbackVersion{TalosVersion: "1.10.0", SchematicId: "old"}
+	}
+
+	return in
+}
+
+func TestCanaryPromotion(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	config := &specs.MachineSetSpec_CanaryUpdateStrategyConfig{
+		Count:           1,
+		SoakDuration:    durationpb.New(time.Minute),
+		RequireApproval: true,
+	}
+
+	// the rollout starts with the first pending machine
+	status, requeue := reconcileCanary(nil, newTestCanaryInput(now, config, "m1", "m2", "m3"))
+	require.NotNil(t, status)
+	assert.Equal(t, specs.CanaryRolloutStatus_Updating, status.Phase)
+	assert.Equal(t, []string{"m1"}, status.Machines)
+	assert.Len(t, status.Targets, 3)
+	assert.Equal(t, "1.10.0", status.RollbackVersions["m1"].TalosVersion)
+	assert.Zero(t, requeue)
+
+	// the canary is updated, verification starts and is soaking
+	status, requeue = reconcileCanary(status, newTestCanaryInput(now, config, "m2", "m3"))
+	assert.Equal(t, specs.CanaryRolloutStatus_Verifying, status.Phase)
+	assert.Equal(t, time.Minute, requeue)
+
+	// the soak duration passed, but the canaries are not approved
+	status, requeue = reconcileCanary(status, newTestCanaryInput(now.Add(2*time.Minute), config, "m2", "m3"))
+	assert.Equal(t, specs.CanaryRolloutStatus_Verifying, status.Phase)
+	assert.Equal(t, "waiting for the canaries to be approved", status.Reason)
+	assert.Zero(t, requeue)
+
+	// an approval given before the verification started is ignored
+	in := newTestCanaryInput(now.Add(2*time.Minute), config, "m2", "m3")
+	in.approved = now.Add(-time.Minute)
+
+	status, _ = reconcileCanary(status, in)
+	assert.Equal(t, specs.CanaryRolloutStatus_Verifying, status.Phase)
+
+	in.approved = now.Add(time.Minute)
+
+	status, _ = reconcileCanary(status, in)
+	assert.Equal(t, specs.CanaryRolloutStatus_Promoted, status.Phase)
+	assert.Empty(t, status.Reason)
+
+	// the rest of the machines are updated
+	status, _ = reconcileCanary(status, newTestCanaryInput(now.Add(3*time.Minute), config, "m3"))
+	assert.Equal(t, specs.CanaryRolloutStatus_Promoted, status.Phase)
+
+	// nothing is pending anymore
+	status, _ = reconcileCanary(status, newTestCanaryInput(now.Add(3*time.Minute), config))
+	assert.Nil(t, status)
+}
+
+func TestCanaryRollback(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	config := &specs.MachineSetSpec_CanaryUpdateStrategyConfig{
+		Count:          2,
+		FailureTimeout: durationpb.New(5 * time.Minute),
+	}
+
+	status, _ := reconcileCanary(nil, newTestCanaryInput(now, config, "m1", "m2", "m3"))
+	assert.Equal(t, []string{"m1", "m2"}, status.Machines)
+
+	// the canaries are updated, but one of them is not healthy
+	in := newTestCanaryInput(now, config, "m3")
+	in.machines["m2"] = false
+
+	status, requeue := reconcileCanary(status, in)
+	assert.Equal(t, specs.CanaryRolloutStatus_Verifying, status.Phase)
+	assert.Equal(t, "canary machine m2 is not healthy", status.Reason)
+	assert.Equal(t, 5*time.Minute, requeue)
+
+	// the failure timeout passes
+	in.now = now.Add(6 * time.Minute)
+
+	status, _ = reconcileCanary(status, in)
+	assert.Equal(t, specs.CanaryRolloutStatus_RollingBack, status.Phase)
+	assert.Equal(t, "canaries failed: canary machine m2 is not healthy", status.Reason)
+
+	// the canaries are pinned to the previous version: their pending changes don't restart the rollout
+	in.pending["m1"] = canaryChange{hash: "1.10.0/old"}
+	in.pending["m2"] = canaryChange{hash: "1.10.0/old"}
+	in.versions["m1"] = &specs.CanaryRolloutStatus_RollbackVersion{TalosVersion: "1.11.0", SchematicId: "new"}
+	in.versions["m2"] = &specs.CanaryRolloutStatus_RollbackVersion{TalosVersion: "1.11.0", SchematicId: "new"}
+
+	status, _ = reconcileCanary(status, in)
+	assert.Equal(t, specs.CanaryRolloutStatus_RollingBack, status.Phase)
+
+	// the canaries are rolled back, the rollout is held
+	in = newTestCanaryInput(now.Add(10*time.Minute), config, "m3")
+	in.versions["m1"] = &specs.CanaryRolloutStatus_RollbackVersion{TalosVersion: "1.10.0", SchematicId: "old"}
+	in.versions["m2"] = &specs.CanaryRolloutStatus_RollbackVersion{TalosVersion: "1.10.0", SchematicId: "old"}
+
+	status, _ = reconcileCanary(status, in)
+	assert.Equal(t, specs.CanaryRolloutStatus_Failed, status.Phase)
+	assert.Zero(t, omni.GetCanaryParallelism(status, specs.MachineSetSpec_Canary, nil, 1))
+
+	// a new target restarts the rollout
+	in.pending["m3"] = canaryChange{hash: "1.11.1/new"}
+
+	status, _ = reconcileCanary(status, in)
+	assert.Equal(t, specs.CanaryRolloutStatus_Updating, status.Phase)
+	assert.Equal(t, []string{"m3"}, status.Machines)
+}
+
+func TestCanaryConfigUpdateFailure(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	config := &specs.MachineSetSpec_CanaryUpdateStrategyConfig{}
+
+	in := newTestCanaryInput(now, config, "m1", "m2")
+
+	for id := range in.pending {
+		in.pending[id] = canaryChange{hash: "diff"}
+	}
+
+	status, _ := reconcileCanary(nil, in)
+	assert.Equal(t, []string{"m1"}, status.Machines)
+	assert.Empty(t, status.RollbackVersions)
+	assert.Empty(t, status.RollbackConfigMachines)
+
+	// the cluster healthchecks fail while verifying, the previous config patches of the canary were not kept, so it is not restored
+	in = newTestCanaryInput(now, config)
+	in.pending["m2"] = canaryChange{hash: "diff"}
+	in.healthChecks = healthCheckResult{reason: "waiting for healthchecks to pass", interval: time.Second}
+
+	status, requeue := reconcileCanary(status, in)
+	assert.Equal(t, specs.CanaryRolloutStatus_Verifying, status.Phase)
+	assert.Equal(t, time.Second, requeue)
+
+	in.now = now.Add(defaultCanaryFailureTimeout)
+
+	status, _ = reconcileCanary(status, in)
+	assert.Equal(t, specs.CanaryRolloutStatus_Failed, status.Phase)
+	assert.False(t, omni.IsCanaryUpdateAllowed(status, "m1"))
+}
+
+func TestCanaryConfigUpdateRestore(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	config := &specs.MachineSetSpec_CanaryUpdateStrategyConfig{}
+
+	in := newTestCanaryInput(now, config, "m1", "m2")
+
+	for id := range in.pending {
+		in.pending[id] = canaryChange{hash: "diff", restorable: true}
+	}
+
+	status, _ := reconcileCanary(nil, in)
+	assert.Equal(t, []string{"m1"}, status.Machines)
+	assert.Equal(t, []string{"m1"}, status.RollbackConfigMachines)
+
+	// the canary is updated, but doesn't become ready
+	in = newTestCanaryInput(now, config)
+	in.pending["m2"] = canaryChange{hash: "diff", restorable: true}
+	in.machines["m1"] = false
+
+	status, _ = reconcileCanary(status, in)
+	assert.Equal(t, specs.CanaryRolloutStatus_Verifying, status.Phase)
+
+	in.now = now.Add(defaultCanaryFailureTimeout)
+
+	// the rollout fails, only the canary is updated to get its previous config patches back
+	status, _ = reconcileCanary(status, in)
+	assert.Equal(t, specs.CanaryRolloutStatus_Failed, status.Phase)
+	assert.True(t, omni.IsCanaryUpdateAllowed(status, "m1"))
+	assert.False(t, omni.IsCanaryUpdateAllowed(status, "m2"))
+
+	// the restored canary has a pending change again while the config is held, the rollout stays failed
+	in.pending["m1"] = canaryChange{hash: "restore", restorable: true}
+
+	status, _ = reconcileCanary(status, in)
+	assert.Equal(t, specs.CanaryRolloutStatus_Failed, status.Phase)
+	assert.Equal(t, []string{"m1"}, status.RollbackConfigMachines)
+}
+
+func TestCanaryLockedMachines(t *testing.T) {
+	t.Parallel()
+
+	in := newTestCanaryInput(time.Now(), nil, "m1", "m2")
+	in.pending["m1"] = canaryChange{hash: "diff", locked: true}
+
+	status, _ := reconcileCanary(nil, in)
+	assert.Equal(t, []string{"m2"}, status.Machines)
+
+	in.pending["m2"] = canaryChange{hash: "diff", locked: true}
+
+	status, _ = reconcileCanary(nil, in)
+	assert.Empty(t, status.Machines)
+	assert.Equal(t, "waiting for the machines to be unlocked", status.Reason)
+}
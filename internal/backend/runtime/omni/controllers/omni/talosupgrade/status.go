@@ -37,6 +37,7 @@ import (
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/internal/backend/runtime/kubernetes"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/helpers"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/maintenance"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/mappers"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/internal/talos"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/machineconfig"
@@ -93,19 +94,31 @@ func NewStatusController(kubernetesRuntime KubernetesRuntime) *TalosUpgradeStatu
 					return err
 				}
 
-				if err := ctrl.updateRolloutState(ctx, r, logger, outdatedMachines, cluster, upgradeStatus); err != nil {
+				rollout, err := ctrl.updateRolloutState(ctx, r, logger, outdatedMachines, cluster, upgradeStatus)
+				if err != nil {
 					return err
 				}
 
-				if err := ctrl.reconcileTalosVersions(ctx, r, cluster, clusterMachines); err != nil {
+				if err := ctrl.reconcileTalosVersions(ctx, r, cluster, clusterMachines, rollout.rollbackVersions); err != nil {
 					return err
 				}
 
-				if err := ctrl.reconcileHealthCheckStatuses(ctx, r, logger, cluster, upgradeStatus); err != nil {
+				if err := ctrl.reconcileHealthCheckStatuses(ctx, r, logger, cluster, rollout.healthChecksGated); err != nil {
 					return err
 				}
 
-				return ctrl.reconcileUpgradeVersions(ctx, r, logger, cluster, upgradeStatus)
+				if err := ctrl.reconcileUpgradeVersions(ctx, r, logger, cluster, upgradeStatus); err != nil {
+					return err
+				}
+
+				// KubernetesHealthChecks are evaluated against the workload cluster, whose state does not feed back into Omni's
+				// resources, canaries are soaked for a duration and maintenance windows open by time, so nothing would re-trigger
+				// this controller. Requeue to re-check.
+				if rollout.requeueAfter > 0 {
+					return controller.NewRequeueInterval(rollout.requeueAfter)
+				}
+
+				return nil
 			},
 			FinalizerRemovalExtraOutputFunc: func(ctx context.Context, r controller.ReaderWriter, _ *zap.Logger, cluster *omni.Cluster) error {
 				clusterMachineTalosVersions, err := safe.ReaderListAll[*omni.ClusterMachineTalosVersion](ctx, r, state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, cluster.Metadata().ID())))
@@ -186,6 +199,15 @@ func NewStatusController(kubernetesRuntime KubernetesRuntime) *TalosUpgradeStatu
 		qtransform.WithExtraMappedInput[*omni.KubernetesHealthCheck](
 			mappers.MapByClusterLabel[*omni.Cluster](),
 		),
+		qtransform.WithExtraMappedInput[*omni.CanaryApproval](
+			mappers.MapByClusterLabel[*omni.Cluster](),
+		),
+		qtransform.WithExtraMappedInput[*omni.ClusterMachineConfigPatches](
+			mappers.MapByClusterLabel[*omni.Cluster](),
+		),
+		qtransform.WithExtraMappedInput[*omni.MaintenanceWindow](
+			maintenance.MapToClusters(),
+		),
 		qtransform.WithExtraOutputs(
 			controller.Output{
 				Type: omni.ClusterMachineTalosVersionType,
@@ -326,15 +348,31 @@ func (ctrl *TalosUpgradeStatusController) reconcileStatus(ctx context.Context, r
 	return nil
 }
 
+// rolloutState is the outcome of updating the UpgradeRollout, used by the rest of the reconcile.
+type rolloutState struct {
+	// rollbackVersions pins the canaries of the failed upgrade rollouts to the versions they had before the rollout.
+	rollbackVersions map[string]*specs.CanaryRolloutStatus_RollbackVersion
+	// requeueAfter is the interval after which the held rollout must be re-checked, zero if nothing is held.
+	requeueAfter time.Duration
+	// healthChecksGated is true if the cluster healthchecks gate the rollout.
+	healthChecksGated bool
+}
+
+func (s *rolloutState) requeue(after time.Duration) {
+	if after > 0 && (s.requeueAfter == 0 || after < s.requeueAfter) {
+		s.requeueAfter = after
+	}
+}
+
 //nolint:gocognit,gocyclo,cyclop
 func (ctrl *TalosUpgradeStatusController) updateRolloutState(ctx context.Context, r controller.ReaderWriter, logger *zap.Logger,
 	outdatedMachines *outdatedMachines, cluster *omni.Cluster, upgradeStatus *omni.TalosUpgradeStatus,
-) error {
+) (*rolloutState, error) {
 	clusterMachineStatuses, err := safe.ReaderListAll[*omni.ClusterMachineStatus](ctx, r, state.WithLabelQuery(
 		resource.LabelEqual(omni.LabelCluster, cluster.Metadata().ID()),
 	))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var (
@@ -357,42 +395,112 @@ func (ctrl *TalosUpgradeStatusController) updateRolloutState(ctx context.Context
 		}
 	}
 
-	// Gate the rollout on the cluster-wide healthchecks in between node upgrades: only while an upgrade is
-	// actually in progress, when the cluster is otherwise healthy (all machines ready) and nothing is
-	// currently upgrading.
+	machineSets, err := safe.ReaderListAll[*omni.MachineSet](ctx, r, state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, cluster.Metadata().ID())))
+	if err != nil {
+		return nil, err
+	}
+
+	upgradeCanaryInputs, updateCanaryInputs, err := ctrl.collectCanaryInputs(ctx, r, cluster, machineSets, clusterMachineStatuses, upgradeStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	previousRollout, err := safe.ReaderGetByID[*omni.UpgradeRollout](ctx, r, cluster.Metadata().ID())
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	var previousUpgradeCanaries, previousUpdateCanaries map[string]*specs.CanaryRolloutStatus
+
+	if previousRollout != nil {
+		previousUpgradeCanaries = previousRollout.TypedSpec().Value.MachineSetsUpgradeCanary
+		previousUpdateCanaries = previousRollout.TypedSpec().Value.MachineSetsUpdateCanary
+	}
+
+	rollout := &rolloutState{
+		healthChecksGated: gatesOnHealthChecks(upgradeStatus.TypedSpec().Value.Phase) ||
+			canariesAwaitVerification(previousUpgradeCanaries, upgradeCanaryInputs) ||
+			canariesAwaitVerification(previousUpdateCanaries, updateCanaryInputs),
+	}
+
+	// Gate the rollout on the cluster-wide healthchecks in between node upgrades and while verifying the canaries: only while
+	// an upgrade is actually in progress, when the cluster is otherwise healthy (all machines ready) and nothing is currently upgrading.
 	healthChecks := healthCheckResult{ready: true}
 
-	if gatesOnHealthChecks(upgradeStatus.TypedSpec().Value.Phase) && notReadyMachinesCounts == 0 && len(outdatedMachines.upgrading) == 0 {
+	if rollout.healthChecksGated && notReadyMachinesCounts == 0 && len(outdatedMachines.upgrading) == 0 {
 		healthChecks, err = ctrl.runHealthChecks(ctx, r, logger, cluster)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	if err = safe.WriterModify(ctx, r, omni.NewUpgradeRollout(cluster.Metadata().ID()), func(res *omni.UpgradeRollout) error {
-		// Hold the rollout (no upgrade quota for any machine set) until the cluster passes its healthchecks,
-		// surfacing the reason so it's visible while the upgrade is paused.
-		if !healthChecks.ready {
-			res.TypedSpec().Value.MachineSetsUpgradeQuota = nil
-			upgradeStatus.TypedSpec().Value.Step = healthChecks.reason
+	if !healthChecks.ready {
+		rollout.requeue(healthChecks.interval)
+	}
 
-			return nil
+	upgradeCanaries := reconcileCanaries(previousUpgradeCanaries, upgradeCanaryInputs, healthChecks, rollout)
+	updateCanaries := reconcileCanaries(previousUpdateCanaries, updateCanaryInputs, healthChecks, rollout)
+
+	for _, canary := range upgradeCanaries {
+		if canary.Phase != specs.CanaryRolloutStatus_RollingBack && canary.Phase != specs.CanaryRolloutStatus_Failed {
+			continue
+		}
+
+		for _, machineID := range canary.Machines {
+			if version, ok := canary.RollbackVersions[machineID]; ok {
+				if rollout.rollbackVersions == nil {
+					rollout.rollbackVersions = map[string]*specs.CanaryRolloutStatus_RollbackVersion{}
+				}
+
+				rollout.rollbackVersions[machineID] = version
+			}
 		}
+	}
 
-		var machineSets safe.List[*omni.MachineSet]
+	now := time.Now()
+	upgradePending := upgradeStatus.TypedSpec().Value.Phase != specs.TalosUpgradeStatusSpec_Done
+	maintenanceWindows := make(map[string]*maintenance.Status, machineSets.Len())
 
-		machineSets, err = safe.ReaderListAll[*omni.MachineSet](ctx, r, state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, cluster.Metadata().ID())))
+	for machineSet := range machineSets.All() {
+		maintenanceWindows[machineSet.Metadata().ID()], err = maintenance.GetStatus(ctx, r, maintenance.WindowID(cluster, machineSet), now)
 		if err != nil {
-			return err
+			return nil, err
 		}
+	}
+
+	if err = safe.WriterModify(ctx, r, omni.NewUpgradeRollout(cluster.Metadata().ID()), func(res *omni.UpgradeRollout) error {
+		res.TypedSpec().Value.MachineSetsUpgradeCanary = upgradeCanaries
+		res.TypedSpec().Value.MachineSetsUpdateCanary = updateCanaries
 
 		upgradeQuotas := make(map[string]int32, machineSets.Len())
 
+		var maintenanceReasons []string
+
 		for machineSet := range machineSets.All() {
-			quota := int32(omni.GetParallelism(machineSet.TypedSpec().Value.UpgradeStrategy, machineSet.TypedSpec().Value.UpgradeStrategyConfig, 1))
+			canary := upgradeCanaries[machineSet.Metadata().ID()]
+			rollingBack := canary.GetPhase() == specs.CanaryRolloutStatus_RollingBack
+
+			// Hold the rollout (no upgrade quota for any machine set) until the cluster passes its healthchecks,
+			// but never hold rolling back the failed canaries.
+			if !healthChecks.ready && !rollingBack {
+				continue
+			}
+
+			// Hold the machine sets outside of their maintenance window, until the window opens.
+			if window := maintenanceWindows[machineSet.Metadata().ID()]; !window.IsOpen(now) && !rollingBack {
+				if upgradePending {
+					maintenanceReasons = append(maintenanceReasons, window.Reason())
 
-			// if reverting, ignore not ready machines
-			if upgradeStatus.TypedSpec().Value.Phase != specs.TalosUpgradeStatusSpec_Reverting {
+					rollout.requeue(window.RequeueAfter(now))
+				}
+
+				continue
+			}
+
+			quota := int32(omni.GetCanaryParallelism(canary, machineSet.TypedSpec().Value.UpgradeStrategy, machineSet.TypedSpec().Value.UpgradeStrategyConfig, 1))
+
+			// if reverting or rolling back the canaries, ignore not ready machines
+			if upgradeStatus.TypedSpec().Value.Phase != specs.TalosUpgradeStatusSpec_Reverting && !rollingBack {
 				quota -= notReadyMachinesCounts
 			}
 
@@ -401,33 +509,43 @@ func (ctrl *TalosUpgradeStatusController) updateRolloutState(ctx context.Context
 			}
 
 			_, isControlPlane := machineSet.Metadata().Labels().Get(omni.LabelControlPlaneRole)
-			if isControlPlane || !controlPlanesUpgrading {
+			if isControlPlane || !controlPlanesUpgrading || rollingBack {
 				upgradeQuotas[machineSet.Metadata().ID()] = quota
 			}
 		}
 
 		res.TypedSpec().Value.MachineSetsUpgradeQuota = upgradeQuotas
 
-		if len(res.TypedSpec().Value.MachineSetsUpgradeQuota) == 0 && upgradeStatus.TypedSpec().Value.Phase != specs.TalosUpgradeStatusSpec_Done {
+		// surface the reason so it's visible while the upgrade is paused
+		switch {
+		case !healthChecks.ready:
+			upgradeStatus.TypedSpec().Value.Step = healthChecks.reason
+		case len(res.TypedSpec().Value.MachineSetsUpgradeQuota) == 0 && upgradeStatus.TypedSpec().Value.Phase != specs.TalosUpgradeStatusSpec_Done:
 			upgradeStatus.TypedSpec().Value.Step = "waiting for the cluster to be ready"
 		}
 
+		if step := canariesStep(upgradeCanaries); step != "" && upgradeStatus.TypedSpec().Value.Phase != specs.TalosUpgradeStatusSpec_Done {
+			upgradeStatus.TypedSpec().Value.Step = step
+		}
+
+		if len(upgradeQuotas) == 0 && len(maintenanceReasons) > 0 && healthChecks.ready {
+			slices.Sort(maintenanceReasons)
+
+			upgradeStatus.TypedSpec().Value.Step = strings.Join(slices.Compact(maintenanceReasons), "; ")
+		}
+
 		return nil
 	}); err != nil {
-		return err
+		return nil, err
 	}
 
-	// KubernetesHealthChecks are evaluated against the workload cluster, whose state does not feed back into Omni's
-	// resources, so nothing would re-trigger this controller once the cluster recovers. Requeue to re-check.
-	if !healthChecks.ready {
-		return controller.NewRequeueInterval(healthChecks.interval)
-	}
-
-	return nil
+	return rollout, nil
 }
 
+// reconcileTalosVersions sets the Talos version and schematic of each cluster machine, pinning the canaries which are
+// rolled back to the versions they had before the rollout.
 func (ctrl *TalosUpgradeStatusController) reconcileTalosVersions(ctx context.Context, r controller.ReaderWriter, cluster *omni.Cluster,
-	clusterMachines safe.List[*omni.ClusterMachine],
+	clusterMachines safe.List[*omni.ClusterMachine], rollbackVersions map[string]*specs.CanaryRolloutStatus_RollbackVersion,
 ) error {
 	clusterMachineTalosVersions, err := safe.ReaderListAll[*omni.ClusterMachineTalosVersion](
 		ctx, r,
@@ -461,13 +579,19 @@ func (ctrl *TalosUpgradeStatusController) reconcileTalosVersions(ctx context.Con
 
 		visited[schematicConfiguration.Metadata().ID()] = struct{}{}
 
-		if schematicConfiguration.TypedSpec().Value.TalosVersion != cluster.TypedSpec().Value.TalosVersion {
+		schematicID := schematicConfiguration.TypedSpec().Value.SchematicId
+		talosVersion := schematicConfiguration.TypedSpec().Value.TalosVersion
+
+		if rollbackVersion, ok := rollbackVersions[clusterMachine.Metadata().ID()]; ok {
+			schematicID = rollbackVersion.SchematicId
+			talosVersion = rollbackVersion.TalosVersion
+		} else if talosVersion != cluster.TypedSpec().Value.TalosVersion {
 			continue
 		}
 
 		if err = safe.WriterModify(ctx, r, omni.NewClusterMachineTalosVersion(schematicConfiguration.Metadata().ID()), func(res *omni.ClusterMachineTalosVersion) error {
-			res.TypedSpec().Value.SchematicId = schematicConfiguration.TypedSpec().Value.SchematicId
-			res.TypedSpec().Value.TalosVersion = schematicConfiguration.TypedSpec().Value.TalosVersion
+			res.TypedSpec().Value.SchematicId = schematicID
+			res.TypedSpec().Value.TalosVersion = talosVersion
 
 			helpers.CopyAllLabels(schematicConfiguration, res)
 
@@ -761,7 +885,7 @@ func gatesOnHealthChecks(phase specs.TalosUpgradeStatusSpec_Phase) bool {
 //
 // Statuses of existing healthchecks are kept after the upgrade completes, so the last outcome stays visible.
 func (ctrl *TalosUpgradeStatusController) reconcileHealthCheckStatuses(ctx context.Context, r controller.ReaderWriter,
-	logger *zap.Logger, cluster *omni.Cluster, upgradeStatus *omni.TalosUpgradeStatus,
+	logger *zap.Logger, cluster *omni.Cluster, healthChecksGated bool,
 ) error {
 	clusterQuery := state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, cluster.Metadata().ID()))
 
@@ -791,7 +915,7 @@ func (ctrl *TalosUpgradeStatusController) reconcileHealthCheckStatuses(ctx conte
 		return err
 	}
 
-	if gatesOnHealthChecks(upgradeStatus.TypedSpec().Value.Phase) {
+	if healthChecksGated {
 		// runHealthChecks keeps the statuses of the remaining healthchecks current
 		return nil
 	}
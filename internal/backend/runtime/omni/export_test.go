@@ -7,6 +7,7 @@ package omni
 
 import (
 	"context"
+	"crypto/ed25519"
 
 	"github.com/cosi-project/runtime/pkg/state"
 	"go.uber.org/zap"
@@ -31,6 +32,10 @@ func NewEtcdPersistentState(ctx context.Context, params *config.Params, logger *
 	return newEtcdPersistentState(ctx, params, nil, nil, logger)
 }
 
+func NewStateWithDefaultPersistentState(defaultPersistentState *PersistentState) *State {
+	return &State{defaultPersistentState: defaultPersistentState}
+}
+
 func GetEmbeddedEtcdClientWithServer(params *config.EtcdParams, logger *zap.Logger) (EtcdState, error) {
 	return getEmbeddedEtcdState(params, logger)
 }
@@ -39,3 +44,7 @@ func GetEmbeddedEtcdClientWithServer(params *config.EtcdParams, logger *zap.Logg
 func FilterAccessByType(access state.Access) error {
 	return filterAccessByType(access)
 }
+
+func LoadAuditLogSigningKey(path string) (ed25519.PrivateKey, error) {
+	return loadAuditLogSigningKey(path)
+}
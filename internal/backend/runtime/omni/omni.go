@@ -141,6 +141,7 @@ func NewRuntime(cfg *config.Params, talosClientFactory *talos.ClientFactory, dns
 		},
 		StoreFactory: etcdBackupStoreFactory,
 		TickInterval: cfg.EtcdBackup.GetTickInterval(),
+		Auditor:      st.Auditor(),
 		Jitter:       cfg.EtcdBackup.GetJitter(),
 	})
 	if err != nil {
@@ -174,12 +175,14 @@ func NewRuntime(cfg *config.Params, talosClientFactory *talos.ClientFactory, dns
 			NodeResolver:       dnsService,
 		}),
 		omnictrl.NewKubernetesStatusController(kubernetesRuntime, cfg.Services.Api.URL(), cfg.Services.WorkloadProxy.GetSubdomain(),
-			cfg.Services.WorkloadProxy.GetEnabled(), cfg.Services.WorkloadProxy.GetUseOmniSubdomain()),
+			cfg.Services.WorkloadProxy.GetEnabled(), cfg.Services.WorkloadProxy.GetUseOmniSubdomain(),
+			cfg.Services.WorkloadProxy.GetMinPort(), cfg.Services.WorkloadProxy.GetMaxPort()),
 		&omnictrl.LoadBalancerController{
 			LBConfig: cfg.Services.LoadBalancer,
 		},
 		omnictrl.NewMachineCleanupController(),
 		omnictrl.NewMachineStatusMetricsController(cfg.Account.GetMaxRegisteredMachines()),
+		omnictrl.NewNotificationDispatcherController(),
 		omnictrl.NewVersionsController(imageFactoryClients, st.Default(), cfg.Features.GetEnableTalosPreReleaseVersions(), cfg.Registries.GetKubernetes()),
 		omnictrl.NewClusterLoadBalancerController(
 			cfg.Services.LoadBalancer.GetMinPort(),
@@ -204,6 +207,11 @@ func NewRuntime(cfg *config.Params, talosClientFactory *talos.ClientFactory, dns
 		return nil, fmt.Errorf("failed to create extra kernel args initializer: %w", err)
 	}
 
+	resourceValidationOptions := validations.Options(defaultState, etcdBackupStoreFactory, cfg)
+
+	// the controllers syncing the resources from the user-provided sources write them directly to the runtime state
+	syncedResourceValidator := validations.NewDryRunValidator(defaultState, resourceValidationOptions...)
+
 	qcontrollers := []controller.QController{
 		// destroy controller for Link, which is not part of the user-managed resource set
 		destroy.NewController[*siderolinkres.Link](optional.Some[uint](4)),
@@ -211,6 +219,8 @@ func NewRuntime(cfg *config.Params, talosClientFactory *talos.ClientFactory, dns
 		omnictrl.NewBackupDataController(),
 		omnictrl.NewClusterBootstrapStatusController(etcdBackupStoreFactory),
 		omnictrl.NewClusterConfigVersionController(),
+		omnictrl.NewClusterTemplateController(syncedResourceValidator),
+		omnictrl.NewGitRepositoryController(syncedResourceValidator),
 		omnictrl.NewClusterDestroyStatusController(),
 		omnictrl.NewMachineSetDestroyStatusController(),
 		omnictrl.NewClusterEndpointController(),
@@ -232,6 +242,7 @@ func NewRuntime(cfg *config.Params, talosClientFactory *talos.ClientFactory, dns
 		omnictrl.NewClusterUUIDController(),
 		omnictrl.NewControlPlaneStatusController(),
 		omnictrl.NewDiscoveryServiceConfigPatchController(cfg.Services.EmbeddedDiscoveryService.GetPort()),
+		omnictrl.NewClusterAutoscalerConfigPatchController(),
 		omnictrl.NewKubernetesNodeAuditController(omnictrl.NewGetKubernetesClientFunc(kubernetesRuntime), time.Minute),
 		omnictrl.NewEtcdBackupEncryptionController(),
 		omnictrl.NewClusterWorkloadProxyStatusController(workloadProxyReconciler),
@@ -271,6 +282,7 @@ func NewRuntime(cfg *config.Params, talosClientFactory *talos.ClientFactory, dns
 		omnictrl.NewDiscoveryAffiliateDeleteTaskController(discoveryClientCache),
 		omnictrl.NewServiceAccountStatusController(),
 		authctrl.NewIdentityStatusController(),
+		authctrl.NewElevationGrantController(),
 		omnictrl.NewInfraMachineRegistrationController(),
 		omnictrl.NewSiderolinkAPIConfigController(cfg.Services.MachineAPI.URL(), cfg.Services.Siderolink),
 		omnictrl.NewProviderJoinConfigController(),
@@ -379,7 +391,7 @@ func NewRuntime(cfg *config.Params, talosClientFactory *talos.ClientFactory, dns
 
 	validationOptions := slices.Concat(
 		authorizationValidationOptions(defaultState),
-		validations.Options(defaultState, etcdBackupStoreFactory, cfg),
+		resourceValidationOptions,
 	)
 
 	return &Runtime{
@@ -465,7 +477,11 @@ func RuntimeCacheOptions() []options.Option {
 		safe.WithResourceCache[*omni.MachineStatusSnapshot](),
 		safe.WithResourceCache[*omni.NodeForceDestroyRequest](),
 		safe.WithResourceCache[*omni.RedactedClusterMachineConfig](),
+		safe.WithResourceCache[*omni.RotateBootstrapToken](),
+		safe.WithResourceCache[*omni.RotateEtcdCA](),
+		safe.WithResourceCache[*omni.RotateKubernetesAggregatorCA](),
 		safe.WithResourceCache[*omni.RotateKubernetesCA](),
+		safe.WithResourceCache[*omni.RotateKubernetesServiceAccountKey](),
 		safe.WithResourceCache[*omni.RotateTalosCA](),
 		safe.WithResourceCache[*omni.SchematicConfiguration](),
 		safe.WithResourceCache[*omni.SecretRotation](),
@@ -679,6 +695,11 @@ func (r *Runtime) CachedState() state.State { //nolint:ireturn
 	return r.cachedState
 }
 
+// EtcdBackupStoreFactory returns the etcd backup store factory.
+func (r *Runtime) EtcdBackupStoreFactory() store.Factory { //nolint:ireturn
+	return r.storeFactory
+}
+
 // GetCOSIRuntime returns COSI  controller runtime.
 func (r *Runtime) GetCOSIRuntime() *cosiruntime.Runtime {
 	return r.controllerRuntime
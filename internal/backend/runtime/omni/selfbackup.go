@@ -0,0 +1,155 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package omni
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/internal/backend/runtime/keyprovider"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup/selfbackup"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup/store"
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+// errSelfBackupUnsupported is returned when the default storage doesn't support the backups of Omni's own state.
+var errSelfBackupUnsupported = errors.New("the backups of Omni's own state are supported only with the etcd default storage")
+
+// SelfBackupSnapshot reads every resource of the namespaces of the default storage at the same etcd revision.
+func (s *State) SelfBackupSnapshot(ctx context.Context, namespaces []resource.Namespace) ([]resource.Resource, error) {
+	if s.defaultPersistentState == nil || s.defaultPersistentState.snapshot == nil {
+		return nil, errSelfBackupUnsupported
+	}
+
+	return s.defaultPersistentState.snapshot(ctx, namespaces)
+}
+
+// SelfBackupKey returns the key the backups of Omni's own state are encrypted with.
+//
+// It is the master key the default storage is encrypted with, along with its key storage, so any instance configured
+// with the private key of one of the key storage slots can restore the backup.
+func (s *State) SelfBackupKey(ctx context.Context) (selfbackup.Key, error) {
+	if s.defaultPersistentState == nil || s.defaultPersistentState.keyProvider == nil {
+		return selfbackup.Key{}, errSelfBackupUnsupported
+	}
+
+	provider := s.defaultPersistentState.keyProvider
+
+	masterKey, err := provider.ProvideKey()
+	if err != nil {
+		return selfbackup.Key{}, fmt.Errorf("failed to get the master key: %w", err)
+	}
+
+	keyStorage, err := provider.KeyStorage(ctx)
+	if err != nil {
+		return selfbackup.Key{}, fmt.Errorf("failed to get the key storage: %w", err)
+	}
+
+	return selfbackup.Key{
+		Storage:   keyStorage,
+		MasterKey: masterKey,
+	}, nil
+}
+
+// SelfBackupMasterKey returns the function which reads the master key from the key storage of a backup of Omni's own state
+// with the private key of the default storage.
+func SelfBackupMasterKey(params *config.Params, logger *zap.Logger) func(keyStorage []byte) ([]byte, error) {
+	return func(keyStorage []byte) ([]byte, error) {
+		loader, err := NewLoader(params.Storage.Default.Etcd.GetPrivateKeySource(), logger, params.Storage.Vault)
+		if err != nil {
+			return nil, err
+		}
+
+		privateKey, err := loader.PrivateKey()
+		if err != nil {
+			return nil, err
+		}
+
+		return keyprovider.MasterKey(keyStorage, privateKey)
+	}
+}
+
+// SelfBackupRetention returns the retention policy of the backups of Omni's own state.
+func SelfBackupRetention(cfg config.EtcdBackup) *specs.EtcdBackupRetention {
+	return &specs.EtcdBackupRetention{
+		KeepLast:    cfg.GetSelfBackupKeepLast(),
+		KeepDaily:   cfg.GetSelfBackupKeepDaily(),
+		KeepWeekly:  cfg.GetSelfBackupKeepWeekly(),
+		KeepMonthly: cfg.GetSelfBackupKeepMonthly(),
+	}
+}
+
+// RunSelfBackups periodically uploads the backups of Omni's own state to the etcd backup store,
+// and deletes the ones which are not kept by the retention policy.
+func (s *State) RunSelfBackups(ctx context.Context, interval time.Duration, retention *specs.EtcdBackupRetention, logger *zap.Logger) error {
+	if s.defaultPersistentState == nil || s.defaultPersistentState.keyProvider == nil {
+		return errSelfBackupUnsupported
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		if err := s.runSelfBackup(ctx, retention, logger); err != nil {
+			logger.Warn("self backup failed", zap.Error(err))
+		}
+	}
+}
+
+func (s *State) runSelfBackup(ctx context.Context, retention *specs.EtcdBackupRetention, logger *zap.Logger) error {
+	backupStore, err := s.storeFactory.GetStore()
+	if err != nil {
+		return fmt.Errorf("failed to get the etcd backup store: %w", err)
+	}
+
+	// the backup is encrypted with the master key by selfbackup, so that the key storage can be stored next to it
+	backupStore, err = store.Unencrypted(backupStore)
+	if err != nil {
+		return err
+	}
+
+	key, err := s.SelfBackupKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+
+	manifest, err := selfbackup.Upload(ctx, s.SelfBackupSnapshot, s.secondaryStorageDB, backupStore, key)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("self backup uploaded", zap.Int("resources", manifest.Resources), zap.Duration("duration", time.Since(start)))
+
+	pruned, err := selfbackup.Prune(ctx, backupStore, retention, time.Now())
+
+	for _, backup := range pruned {
+		logger.Info("pruned self backup", zap.String("snapshot_name", backup.Snapshot), zap.Time("ts", backup.Timestamp))
+
+		if auditErr := s.auditWrap.AuditEtcdBackupPrune(ctx, selfbackup.ClusterUUID, backup.Snapshot, backup.Timestamp); auditErr != nil {
+			logger.Warn("failed to audit the self backup prune", zap.String("snapshot_name", backup.Snapshot), zap.Error(auditErr))
+		}
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to prune self backups: %w", err)
+	}
+
+	return nil
+}
@@ -7,6 +7,9 @@ package omni
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"net/http"
@@ -30,8 +33,10 @@ import (
 	"github.com/siderolabs/omni/client/pkg/omni/resources/system"
 	"github.com/siderolabs/omni/internal/backend/logging"
 	"github.com/siderolabs/omni/internal/backend/ratelimit"
+	"github.com/siderolabs/omni/internal/backend/runtime/keyprovider"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/audit"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/audit/auditlog"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/audit/auditlog/auditlogsqlite"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/audit/hooks"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup/store"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/external"
@@ -48,6 +53,10 @@ type PersistentState struct {
 	State  state.CoreState
 	Close  func() error
 	errors <-chan error
+
+	// snapshot and keyProvider are set only for the etcd storage, they are used by the backups of Omni's own state.
+	snapshot    func(ctx context.Context, namespaces []resource.Namespace) ([]resource.Resource, error)
+	keyProvider *keyprovider.KeyProvider
 }
 
 // State wraps virtual and default cosi states.
@@ -105,6 +114,11 @@ func (s *State) RunAuditCleanup(ctx context.Context) error {
 	return s.auditWrap.RunCleanup(ctx)
 }
 
+// RunAuditSinks delivers the audit log events to the configured sinks.
+func (s *State) RunAuditSinks(ctx context.Context) error {
+	return s.auditWrap.RunSinks(ctx)
+}
+
 // RunSQLiteMetrics runs the SQLite metrics collector.
 func (s *State) RunSQLiteMetrics(ctx context.Context) error {
 	s.sqliteMetrics.Run(ctx)
@@ -348,6 +362,31 @@ func stateWithMetrics(namespacedState *namespaced.State, metricsRegistry prometh
 	return measuredState
 }
 
+// loadAuditLogSigningKey reads the PEM encoded Ed25519 private key the audit log chain markers are signed with.
+func loadAuditLogSigningKey(path string) (ed25519.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the audit log signing key: %w", err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("failed to decode the audit log signing key %q: no PEM data found", path)
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the audit log signing key %q: %w", path, err)
+	}
+
+	signingKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("the audit log signing key %q is a %T, not an Ed25519 key", path, key)
+	}
+
+	return signingKey, nil
+}
+
 // NewAuditWrap creates a new audit wrap.
 func NewAuditWrap(ctx context.Context, resState state.State, params *config.Params, auditLogDB *sqlitexx.Pool, logger *zap.Logger, onCleanup func(int)) (*AuditWrap, error) {
 	if !params.Logs.Audit.GetEnabled() {
@@ -363,6 +402,25 @@ func NewAuditWrap(ctx context.Context, resState state.State, params *config.Para
 		logOpts = append(logOpts, audit.WithCleanupCallback(onCleanup))
 	}
 
+	var (
+		signingKey ed25519.PrivateKey
+		err        error
+	)
+
+	if signingKeyPath := params.Logs.Audit.GetSigningKeyPath(); signingKeyPath != "" {
+		signingKey, err = loadAuditLogSigningKey(signingKeyPath)
+	} else {
+		logger.Info("audit log signing key is not configured, using the key stored in the audit log database")
+
+		signingKey, err = auditlogsqlite.LoadOrCreateSigningKey(ctx, auditLogDB, params.Logs.Audit.GetSqliteTimeout())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	logOpts = append(logOpts, audit.WithSigningKey(signingKey))
+
 	a, err := audit.NewLog(ctx, params.Logs.Audit, auditLogDB, logger, logOpts...)
 	if err != nil {
 		return nil, err
@@ -370,13 +428,23 @@ func NewAuditWrap(ctx context.Context, resState state.State, params *config.Para
 
 	hooks.Init(a)
 
-	return &AuditWrap{state: resState, log: a}, nil
+	return &AuditWrap{state: resState, log: a, signingPublicKey: signingKey.Public().(ed25519.PublicKey)}, nil //nolint:forcetypeassert,errcheck
 }
 
 // AuditWrap is builder/wrapper for creating logged access to Omni and Talos nodes.
 type AuditWrap struct {
-	state state.State
-	log   *audit.Log
+	state            state.State
+	log              *audit.Log
+	signingPublicKey ed25519.PublicKey
+}
+
+// SigningPublicKey returns the public key the audit log chain markers are signed with.
+func (w *AuditWrap) SigningPublicKey() (ed25519.PublicKey, error) {
+	if w.log == nil {
+		return nil, errors.New("audit log is disabled")
+	}
+
+	return w.signingPublicKey, nil
 }
 
 // Reader reads the audit log file by file, oldest to newest.
@@ -388,6 +456,15 @@ func (w *AuditWrap) Reader(ctx context.Context, filters auditlog.ReadFilters) (a
 	return w.log.Reader(ctx, filters)
 }
 
+// ProofReader reads the events in the given time range with their hash chain proofs.
+func (w *AuditWrap) ProofReader(ctx context.Context, start, end time.Time) (auditlog.ProofReader, error) {
+	if w.log == nil {
+		return nil, errors.New("audit log is disabled")
+	}
+
+	return w.log.ProofReader(ctx, start, end)
+}
+
 // FollowStart resolves the initial follow position for the given inclusive start timestamp.
 func (w *AuditWrap) FollowStart(ctx context.Context, startTsMs int64) (int64, error) {
 	if w.log == nil {
@@ -428,6 +505,18 @@ func (w *AuditWrap) RunCleanup(ctx context.Context) error {
 	return w.log.RunCleanup(ctx)
 }
 
+// RunSinks runs wrapped [audit.Log.RunSinks] if the audit log is enabled. Otherwise, blocks until context is
+// canceled.
+func (w *AuditWrap) RunSinks(ctx context.Context) error {
+	if w.log == nil {
+		<-ctx.Done()
+
+		return nil
+	}
+
+	return w.log.RunSinks(ctx)
+}
+
 // Wrap implements [k8sproxy.MiddlewareWrapper].
 func (w *AuditWrap) Wrap(handler http.Handler) http.Handler {
 	if w.log == nil {
@@ -446,6 +535,15 @@ func (w *AuditWrap) AuditTalosAccess(ctx context.Context, fullMethodName, cluste
 	return w.log.AuditTalosAccess(ctx, fullMethodName, clusterID, nodeID)
 }
 
+// AuditTalosAccessDenied logs a Talos access event for the call denied by the access policy. It does nothing if the audit log is disabled.
+func (w *AuditWrap) AuditTalosAccessDenied(ctx context.Context, fullMethodName, clusterID, nodeID string) error {
+	if w.log == nil {
+		return nil
+	}
+
+	return w.log.AuditTalosAccessDenied(ctx, fullMethodName, clusterID, nodeID)
+}
+
 // AuditAuditLogAccess logs an audit log access event. It does nothing if the audit log is disabled.
 func (w *AuditWrap) AuditAuditLogAccess(ctx context.Context, filters auditlog.ReadFilters) error {
 	if w.log == nil {
@@ -465,6 +563,34 @@ func (w *AuditWrap) AuditAuditLogFollow(ctx context.Context, fromID, startTsMs i
 	return w.log.AuditAuditLogFollow(ctx, fromID, startTsMs)
 }
 
+// AuditWorkloadProxyAccess logs an access to an exposed service. It does nothing if the audit log is disabled.
+func (w *AuditWrap) AuditWorkloadProxyAccess(ctx context.Context, identity, userAgent string, access *auditlog.WorkloadProxy) error {
+	if w == nil || w.log == nil {
+		return nil
+	}
+
+	return w.log.AuditWorkloadProxyAccess(ctx, identity, userAgent, access)
+}
+
+// AuditRateLimit logs the rejection of the API requests by the rate limits. It does nothing if the audit log is disabled.
+func (w *AuditWrap) AuditRateLimit(ctx context.Context, access *auditlog.RateLimit) error {
+	if w == nil || w.log == nil {
+		return nil
+	}
+
+	return w.log.AuditRateLimit(ctx, access)
+}
+
+// AuditEtcdBackupPrune logs the deletion of the etcd backup by the retention policy. It does nothing if the audit log
+// is disabled.
+func (w *AuditWrap) AuditEtcdBackupPrune(ctx context.Context, clusterID, snapshot string, timestamp time.Time) error {
+	if w == nil || w.log == nil {
+		return nil
+	}
+
+	return w.log.AuditEtcdBackupPrune(ctx, clusterID, snapshot, timestamp)
+}
+
 // WrapState wraps the state with audit logging. It does nothing if the audit log is disabled.
 func (w *AuditWrap) WrapState(resourceState state.State) state.State {
 	if w.log == nil {
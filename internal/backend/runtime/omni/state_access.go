@@ -50,6 +50,8 @@ var (
 		omni.ClusterWorkloadProxyStatusType,
 		omni.ClusterKubernetesNodesType,
 		omni.ClusterTaintType,
+		omni.ClusterTemplateType,
+		omni.ClusterTemplateStatusType,
 		omni.ClusterType,
 		omni.ClusterUUIDType,
 		omni.ClusterSecretsType,
@@ -63,6 +65,10 @@ var (
 		omni.RotateTalosCAType,
 		omni.SecretRotationType,
 		omni.RotateKubernetesCAType,
+		omni.RotateEtcdCAType,
+		omni.RotateKubernetesAggregatorCAType,
+		omni.RotateKubernetesServiceAccountKeyType,
+		omni.RotateBootstrapTokenType,
 		omni.ClusterKubernetesManifestsStatusType,
 		omni.KubernetesStatusType,
 		omni.KubernetesUpgradeStatusType,
@@ -112,6 +118,7 @@ var (
 		infra.InfraMachineStatusType,
 		omni.KubernetesHealthCheckType,
 		omni.KubernetesHealthCheckStatusType,
+		omni.CanaryApprovalType,
 	})
 
 	// userManagedResourceTypeSet is the set of resource types that are managed by the user.
@@ -288,8 +295,17 @@ func checkForRole(ctx context.Context, st state.State, access state.Access, clus
 		return nil
 	}
 
+	// the custom roles limited to the clusters apply only to the access to a single cluster
+	var cluster *resource.Metadata
+
 	if requireAll {
 		clusterID = "any"
+	} else if clusterID != "" && auth.PermissionsFromContext(ctx) != nil {
+		var err error
+
+		if cluster, err = accesspolicy.ClusterMetadata(ctx, clusterID, st); err != nil {
+			return err
+		}
 	}
 
 	if clusterID != "" {
@@ -304,7 +320,7 @@ func checkForRole(ctx context.Context, st state.State, access state.Access, clus
 		}
 	}
 
-	return filterAccess(ctx, access)
+	return filterAccess(ctx, access, cluster)
 }
 
 func checkForKindAccess(ctx context.Context, st state.State, verb state.Verb, kind resource.Kind, labelTerms []resource.LabelTerm) error {
@@ -379,7 +395,9 @@ func verbToRole(verb state.Verb) role.Role {
 }
 
 // filterAccess provides a filter to exclude some resources and operations from external sources.
-func filterAccess(ctx context.Context, access state.Access) error {
+//
+// The cluster is the metadata of the cluster the accessed resources belong to, it is used to match the custom roles of the actor.
+func filterAccess(ctx context.Context, access state.Access, cluster *resource.Metadata) error {
 	if actor.ContextIsInternalActor(ctx) {
 		return nil
 	}
@@ -421,6 +439,8 @@ func filterAccess(ctx context.Context, access state.Access) error {
 		omni.ClusterUUIDType,
 		omni.ClusterWorkloadProxyStatusType,
 		omni.ClusterTaintType,
+		omni.ClusterTemplateType,
+		omni.ClusterTemplateStatusType,
 		omni.ClusterKubernetesManifestsStatusType,
 		omni.ConfigPatchType,
 		omni.ControlPlaneStatusType,
@@ -482,10 +502,16 @@ func filterAccess(ctx context.Context, access state.Access) error {
 		omni.ClusterSecretsRotationStatusType,
 		omni.RotateTalosCAType,
 		omni.RotateKubernetesCAType,
+		omni.RotateEtcdCAType,
+		omni.RotateKubernetesAggregatorCAType,
+		omni.RotateKubernetesServiceAccountKeyType,
+		omni.RotateBootstrapTokenType,
 		omni.ImageFactoryAuthType,
 		omni.UpgradeRolloutType,
 		omni.KubernetesHealthCheckType,
 		omni.KubernetesHealthCheckStatusType,
+		omni.CanaryApprovalType,
+		omni.MaintenanceWindowType,
 		system.ResourceLabelsType[*omni.MachineStatus](),
 		virtual.SupportType,
 		virtual.LabelsCompletionType,
@@ -495,6 +521,10 @@ func filterAccess(ctx context.Context, access state.Access) error {
 		virtual.KubernetesUsageType:
 		_, err = auth.CheckGRPC(ctx, auth.WithRole(verbToRole(access.Verb)))
 
+		if status.Code(err) == codes.PermissionDenied && auth.PermissionsFromContext(ctx).AllowsResource(access.ResourceType, access.Verb, cluster) {
+			err = nil
+		}
+
 		if err == nil && access.Verb == state.Create && access.ResourceType == siderolink.JoinTokenType {
 			err = status.Error(codes.PermissionDenied, "only read, update and destroy access is permitted, create should be done via the management.CreateJoinToken API call")
 		}
@@ -528,8 +558,18 @@ func filterAccess(ctx context.Context, access state.Access) error {
 		authres.UserType,
 		authres.ServiceAccountStatusType,
 		authres.SAMLLabelRuleType,
+		authres.RoleType,
+		authres.ElevationRequestType,
+		authres.ElevationGrantType,
 		authres.AccessPolicyType,
 		omni.EtcdBackupS3ConfType,
+		omni.EtcdBackupStoreConfigType,
+		omni.NotificationChannelType,
+		omni.NotificationChannelStatusType,
+		omni.NotificationRuleType,
+		omni.GitRepositoryType,
+		omni.GitRepositoryStatusType,
+		omni.GitCredentialsType,
 		infra.ProviderType,
 		omni.InfraMachineBMCConfigType,
 		oidcres.JWTPublicKeyType:
@@ -616,6 +656,7 @@ func filterAccessByType(access state.Access) error {
 		omni.ClusterStatusType,
 		omni.ClusterDiagnosticsType,
 		omni.ClusterTaintType,
+		omni.ClusterTemplateStatusType,
 		omni.ClusterUUIDType,
 		omni.ClusterWorkloadProxyStatusType,
 		omni.ControlPlaneStatusType,
@@ -665,6 +706,8 @@ func filterAccessByType(access state.Access) error {
 		omni.MachineInstallDiskStatusType,
 		omni.MachineStatusMetricsType,
 		omni.NotificationType,
+		omni.NotificationChannelStatusType,
+		omni.GitRepositoryStatusType,
 		omni.UpgradeRolloutType,
 		omni.KubernetesHealthCheckStatusType,
 		authres.AuthConfigType,
@@ -674,6 +717,8 @@ func filterAccessByType(access state.Access) error {
 		authres.UserType,
 		authres.PublicKeyLastActiveType,
 		authres.ServiceAccountStatusType,
+		authres.ElevationRequestType,
+		authres.ElevationGrantType,
 		siderolink.JoinTokenStatusType,
 		siderolink.NodeUniqueTokenStatusType,
 		siderolink.ConnectionParamsType,
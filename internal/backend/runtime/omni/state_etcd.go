@@ -17,6 +17,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/state/impl/store"
 	"github.com/cosi-project/runtime/pkg/state/impl/store/compression"
 	"github.com/cosi-project/runtime/pkg/state/impl/store/encryption"
@@ -79,25 +80,27 @@ func newEtcdPersistentState(ctx context.Context, params *config.Params, observer
 		)
 	}
 
-	var cipher *encryption.Cipher
+	var provider *keyprovider.KeyProvider
 
-	cipher, err = makeCipher(accountID, params.Storage.Default.Etcd, etcdState.Client(), logger, params.Storage.Vault) //nolint:contextcheck
+	provider, err = makeKeyProvider(accountID, params.Storage.Default.Etcd, etcdState.Client(), logger, params.Storage.Vault) //nolint:contextcheck
 	if err != nil {
 		return nil, err
 	}
 
 	salt := sha256.Sum256([]byte(accountID))
 
+	marshaler := encryption.NewMarshaler(
+		compression.NewMarshaler(
+			store.ProtobufMarshaler{},
+			compression.ZStd(),
+			compressionThresholdBytes,
+		),
+		encryption.NewCipher(provider),
+	)
+
 	coreState := etcd.NewState(
 		etcdState.Client(),
-		encryption.NewMarshaler(
-			compression.NewMarshaler(
-				store.ProtobufMarshaler{},
-				compression.ZStd(),
-				compressionThresholdBytes,
-			),
-			cipher,
-		),
+		marshaler,
 		etcd.WithKeyPrefix(prefix),
 		etcd.WithSalt(salt[:]),
 		etcd.WithObserver(observer),
@@ -105,13 +108,66 @@ func newEtcdPersistentState(ctx context.Context, params *config.Params, observer
 	)
 
 	return &PersistentState{
-		State:  coreState,
-		Close:  etcdState.Close,
-		errors: etcdState.err(),
+		State:       coreState,
+		Close:       etcdState.Close,
+		errors:      etcdState.err(),
+		snapshot:    etcdSnapshot(etcdState.Client(), prefix, marshaler),
+		keyProvider: provider,
 	}, nil
 }
 
-func makeCipher(name string, etcdParams config.EtcdParams, etcdClient etcd.Client, logger *zap.Logger, vaultConfig config.Vault) (*encryption.Cipher, error) {
+// etcdSnapshotPageSize is the number of keys read from etcd at once while reading a snapshot.
+const etcdSnapshotPageSize = 1000
+
+// etcdSnapshot returns the function which reads every resource of the namespaces at the same etcd revision.
+func etcdSnapshot(client *clientv3.Client, prefix string, marshaler store.Marshaler) func(context.Context, []resource.Namespace) ([]resource.Resource, error) {
+	return func(ctx context.Context, namespaces []resource.Namespace) ([]resource.Resource, error) {
+		resp, err := client.Get(ctx, prefix+"/", clientv3.WithPrefix(), clientv3.WithCountOnly())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the current etcd revision: %w", err)
+		}
+
+		revision := resp.Header.Revision
+
+		var items []resource.Resource
+
+		for _, ns := range namespaces {
+			nsPrefix := prefix + "/" + url.PathEscape(ns) + "/"
+			rangeEnd := clientv3.GetPrefixRangeEnd(nsPrefix)
+			key := nsPrefix
+
+			for {
+				resp, err = client.Get(ctx, key,
+					clientv3.WithRange(rangeEnd),
+					clientv3.WithRev(revision),
+					clientv3.WithLimit(etcdSnapshotPageSize),
+				)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read namespace %q at revision %d: %w", ns, revision, err)
+				}
+
+				for _, kv := range resp.Kvs {
+					res, unmarshalErr := marshaler.UnmarshalResource(kv.Value)
+					if unmarshalErr != nil {
+						return nil, fmt.Errorf("failed to unmarshal %q: %w", kv.Key, unmarshalErr)
+					}
+
+					items = append(items, res)
+				}
+
+				if !resp.More || len(resp.Kvs) == 0 {
+					break
+				}
+
+				key = string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
+			}
+		}
+
+		return items, nil
+	}
+}
+
+func makeKeyProvider(name string, etcdParams config.EtcdParams, etcdClient etcd.Client, logger *zap.Logger, vaultConfig config.Vault) (*keyprovider.KeyProvider, error) {
 	publicKeys, err := loadPublicKeys(etcdParams)
 	if err != nil {
 		return nil, err
@@ -127,12 +183,7 @@ func makeCipher(name string, etcdParams config.EtcdParams, etcdClient etcd.Clien
 		return nil, err
 	}
 
-	provider, err := keyprovider.New(etcdClient, hexHash(name), privateKey, publicKeys, logger)
-	if err != nil {
-		return nil, err
-	}
-
-	return encryption.NewCipher(provider), nil
+	return keyprovider.New(etcdClient, hexHash(name), privateKey, publicKeys, logger)
 }
 
 func hexHash(name string) string {
@@ -21,6 +21,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
 	omnires "github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/internal/backend/runtime/keyprovider"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni"
@@ -246,3 +247,65 @@ func TestEncryptDecrypt(t *testing.T) {
 		}
 	}
 }
+
+func TestEtcdSelfBackup(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), time.Second*10)
+	defer cancel()
+
+	persistentState, err := omni.NewEtcdPersistentState(
+		ctx,
+		&config.Params{
+			Account: config.Account{
+				Name: new("instance-name"),
+			},
+			Storage: config.Storage{
+				Default: config.StorageDefault{
+					Etcd: config.EtcdParams{
+						Embedded:         new(true),
+						EmbeddedDBPath:   new(filepath.Join(t.TempDir(), "etcd")),
+						PrivateKeySource: new("file://testdata/pgp/old_key.private"),
+						PublicKeyFiles:   []string{"testdata/pgp/new_key.public"},
+						Endpoints:        []string{"http://localhost:0"},
+					},
+				},
+			},
+		},
+		zaptest.NewLogger(t),
+	)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, persistentState.Close())
+	})
+
+	for _, id := range []string{"cluster-1", "cluster-2"} {
+		require.NoError(t, persistentState.State.Create(ctx, omnires.NewCluster(id)))
+	}
+
+	st := omni.NewStateWithDefaultPersistentState(persistentState)
+
+	items, err := st.SelfBackupSnapshot(ctx, []resource.Namespace{resources.DefaultNamespace, resources.InfraProviderNamespace})
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+
+	for _, item := range items {
+		require.IsType(t, &omnires.Cluster{}, item)
+	}
+
+	key, err := st.SelfBackupKey(ctx)
+	require.NoError(t, err)
+
+	// the master key is read from the key storage with the private key of any slot
+	for _, privateKeyPath := range []string{"testdata/pgp/old_key.private", "testdata/pgp/new_key.private"} {
+		privateKeyData, readErr := os.ReadFile(privateKeyPath)
+		require.NoError(t, readErr)
+
+		privateKey, makeErr := keyprovider.MakePrivateKeyData(string(privateKeyData))
+		require.NoError(t, makeErr)
+
+		masterKey, keyErr := keyprovider.MasterKey(key.Storage, privateKey)
+		require.NoError(t, keyErr)
+
+		require.Equal(t, key.MasterKey, masterKey)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"net/mail"
+	"slices"
 	"unicode"
 
 	"github.com/cosi-project/runtime/pkg/resource"
@@ -46,12 +47,20 @@ func roleValidationOptions() []validated.StateOption {
 		return err
 	}
 
+	validateUser := func(res *authres.User) error {
+		if err := validateRole(res.TypedSpec().Value.GetRole()); err != nil {
+			return err
+		}
+
+		return validateCustomRoles(res.TypedSpec().Value.GetCustomRoles())
+	}
+
 	return []validated.StateOption{
 		validated.WithCreateValidations(validated.NewCreateValidationForType(func(_ context.Context, res *authres.User, _ ...state.CreateOption) error {
-			return validateRole(res.TypedSpec().Value.GetRole())
+			return validateUser(res)
 		})),
 		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(_ context.Context, _ *authres.User, newRes *authres.User, _ ...state.UpdateOption) error {
-			return validateRole(newRes.TypedSpec().Value.GetRole())
+			return validateUser(newRes)
 		})),
 		validated.WithCreateValidations(validated.NewCreateValidationForType(func(_ context.Context, res *authres.PublicKey, _ ...state.CreateOption) error {
 			return validateRole(res.TypedSpec().Value.GetRole())
@@ -62,6 +71,80 @@ func roleValidationOptions() []validated.StateOption {
 	}
 }
 
+func validateCustomRoles(ids []string) error {
+	var multiErr error
+
+	for _, id := range ids {
+		if err := auth.ValidateCustomRoleID(id); err != nil {
+			multiErr = multierror.Append(multiErr, err)
+		}
+	}
+
+	return multiErr
+}
+
+// customRoleValidationOptions returns the validation options for the custom role resource.
+func customRoleValidationOptions() []validated.StateOption {
+	validate := func(res *authres.Role) error {
+		var multiErr error
+
+		if err := auth.ValidateCustomRoleID(res.Metadata().ID()); err != nil {
+			multiErr = multierror.Append(multiErr, err)
+		}
+
+		if len(res.TypedSpec().Value.GetRules()) == 0 {
+			multiErr = multierror.Append(multiErr, errors.New("role must have at least one rule"))
+		}
+
+		for i, rule := range res.TypedSpec().Value.GetRules() {
+			if err := auth.ValidateRoleRule(rule); err != nil {
+				multiErr = multierror.Append(multiErr, fmt.Errorf("rule %d: %w", i, err))
+			}
+		}
+
+		return multiErr
+	}
+
+	return []validated.StateOption{
+		validated.WithCreateValidations(validated.NewCreateValidationForType(func(_ context.Context, res *authres.Role, _ ...state.CreateOption) error {
+			return validate(res)
+		})),
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(_ context.Context, _ *authres.Role, newRes *authres.Role, _ ...state.UpdateOption) error {
+			return validate(newRes)
+		})),
+	}
+}
+
+// elevationRequestValidationOptions returns the validation options for the elevation request resource.
+//
+// The requested elevation can't be changed after the request is created, only its approval and revocation fields.
+func elevationRequestValidationOptions() []validated.StateOption {
+	return []validated.StateOption{
+		validated.WithCreateValidations(validated.NewCreateValidationForType(func(_ context.Context, res *authres.ElevationRequest, _ ...state.CreateOption) error {
+			return auth.ValidateElevationRequest(res.TypedSpec().Value)
+		})),
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(
+			func(_ context.Context, oldRes *authres.ElevationRequest, newRes *authres.ElevationRequest, _ ...state.UpdateOption) error {
+				oldSpec, newSpec := oldRes.TypedSpec().Value, newRes.TypedSpec().Value
+
+				if oldSpec.GetIdentity() != newSpec.GetIdentity() ||
+					oldSpec.GetRole() != newSpec.GetRole() ||
+					!slices.Equal(oldSpec.GetClusters(), newSpec.GetClusters()) ||
+					oldSpec.GetReason() != newSpec.GetReason() ||
+					oldSpec.GetDuration().AsDuration() != newSpec.GetDuration().AsDuration() {
+					return errors.New("the requested elevation can not be changed")
+				}
+
+				if oldSpec.GetRevoked() && !newSpec.GetRevoked() {
+					return errors.New("the revoked elevation can not be restored")
+				}
+
+				return auth.ValidateElevationRequest(newSpec)
+			},
+		)),
+	}
+}
+
 func hasUppercaseLetters(s string) bool {
 	for _, r := range s {
 		if unicode.IsUpper(r) && unicode.IsLetter(r) {
@@ -160,6 +243,10 @@ func samlLabelRuleValidationOptions() []validated.StateOption {
 			multiErr = multierror.Append(multiErr, fmt.Errorf("role %q cannot be assigned by a SAML label rule", parsedRole))
 		}
 
+		if err := validateCustomRoles(res.TypedSpec().Value.GetAssignCustomRoles()); err != nil {
+			multiErr = multierror.Append(multiErr, err)
+		}
+
 		if _, err := labels.ParseSelectors(res.TypedSpec().Value.GetMatchLabels()); err != nil {
 			multiErr = multierror.Append(multiErr, fmt.Errorf("invalid match labels: %w", err))
 		}
@@ -188,6 +188,10 @@ func clusterValidationOptions(st state.State, etcdBackupConfig config.EtcdBackup
 				multiErr = multierror.Append(multiErr, err)
 			}
 
+			if err := omni.ValidateSecretRotationPolicy(res.TypedSpec().Value.GetSecretRotationPolicy()); err != nil {
+				multiErr = multierror.Append(multiErr, err)
+			}
+
 			if err := validateDiscoveryServiceSetting(ctx, nil, res); err != nil {
 				multiErr = multierror.Append(multiErr, err)
 			}
@@ -246,6 +250,10 @@ func clusterValidationOptions(st state.State, etcdBackupConfig config.EtcdBackup
 				multiErr = multierror.Append(multiErr, err)
 			}
 
+			if err := omni.ValidateSecretRotationPolicy(newRes.TypedSpec().Value.GetSecretRotationPolicy()); err != nil {
+				multiErr = multierror.Append(multiErr, err)
+			}
+
 			if err := validateDiscoveryServiceSetting(ctx, existingRes, newRes); err != nil {
 				multiErr = multierror.Append(multiErr, err)
 			}
@@ -272,8 +280,9 @@ func clusterValidationOptions(st state.State, etcdBackupConfig config.EtcdBackup
 
 			_, locked := res.Metadata().Annotations().Get(omni.ClusterLocked)
 			_, importing := clusterStatus.Metadata().Labels().Get(omni.LabelClusterTaintedByImporting)
+			_, detaching := res.Metadata().Annotations().Get(omni.ClusterDetachInProgress)
 
-			if locked && !importing {
+			if locked && !importing && !detaching {
 				return fmt.Errorf("deletion is not allowed: the cluster %q is locked", res.Metadata().ID())
 			}
 
@@ -0,0 +1,60 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package validations
+
+import (
+	"context"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/validated"
+)
+
+// DryRunValidator runs the resources through the state validations without writing them.
+//
+// The controllers which sync the resources provided by the users, e.g. from the cluster templates or the Git repositories,
+// write them directly to the runtime state. They validate each resource with the DryRunValidator before writing it,
+// the same way as if it was written through the API.
+type DryRunValidator struct {
+	st          state.State
+	dryRunState state.CoreState
+}
+
+// NewDryRunValidator creates a new DryRunValidator which runs the validations of the options against the state.
+func NewDryRunValidator(st state.State, options ...validated.StateOption) *DryRunValidator {
+	return &DryRunValidator{
+		st:          st,
+		dryRunState: validated.NewState(dryRunCoreState{CoreState: st}, options...),
+	}
+}
+
+// Validate runs the create or the update validations of the resource, depending on whether it already exists.
+func (v *DryRunValidator) Validate(ctx context.Context, res resource.Resource) error {
+	_, err := v.st.Get(ctx, res.Metadata())
+	if err != nil {
+		if !state.IsNotFoundError(err) {
+			return err
+		}
+
+		return v.dryRunState.Create(ctx, res)
+	}
+
+	return v.dryRunState.Update(ctx, res)
+}
+
+// dryRunCoreState reads from the underlying state, but drops the writes, so that only the validations of the writes are run.
+type dryRunCoreState struct {
+	state.CoreState
+}
+
+func (dryRunCoreState) Create(context.Context, resource.Resource, ...state.CreateOption) error {
+	return nil
+}
+
+func (dryRunCoreState) Update(context.Context, resource.Resource, ...state.UpdateOption) error {
+	return nil
+}
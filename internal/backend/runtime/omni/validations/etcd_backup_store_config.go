@@ -0,0 +1,36 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package validations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/state"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni/etcdbackup/store"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/validated"
+)
+
+func etcdBackupStoreConfigValidationOptions() []validated.StateOption {
+	return []validated.StateOption{
+		validated.WithCreateValidations(validated.NewCreateValidationForType(func(ctx context.Context, res *omni.EtcdBackupStoreConfig, _ ...state.CreateOption) error {
+			return validateEtcdBackupStoreConfig(ctx, res)
+		})),
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(func(ctx context.Context, _ *omni.EtcdBackupStoreConfig, newRes *omni.EtcdBackupStoreConfig, _ ...state.UpdateOption) error {
+			return validateEtcdBackupStoreConfig(ctx, newRes)
+		})),
+	}
+}
+
+func validateEtcdBackupStoreConfig(ctx context.Context, conf *omni.EtcdBackupStoreConfig) error {
+	if err := store.CheckStoreConfig(ctx, conf); err != nil {
+		return fmt.Errorf("incorrect settings for %s store: %w", store.BackendName(store.Backend(conf)), err)
+	}
+
+	return nil
+}
@@ -53,10 +53,22 @@ func SAMLLabelRuleValidationOptions() []validated.StateOption {
 	return samlLabelRuleValidationOptions()
 }
 
+func CustomRoleValidationOptions() []validated.StateOption {
+	return customRoleValidationOptions()
+}
+
+func ElevationRequestValidationOptions() []validated.StateOption {
+	return elevationRequestValidationOptions()
+}
+
 func S3ConfigValidationOptions() []validated.StateOption {
 	return s3ConfigValidationOptions()
 }
 
+func EtcdBackupStoreConfigValidationOptions() []validated.StateOption {
+	return etcdBackupStoreConfigValidationOptions()
+}
+
 func SchematicConfigurationValidationOptions() []validated.StateOption {
 	return schematicConfigurationValidationOptions()
 }
@@ -124,3 +136,15 @@ func KubernetesHealthCheckValidationOptions() []validated.StateOption {
 func ExtensionsConfigurationValidationOptions(st state.State) []validated.StateOption {
 	return extensionsConfigurationValidationOptions(st)
 }
+
+func MaintenanceWindowValidationOptions(st state.State) []validated.StateOption {
+	return maintenanceWindowValidationOptions(st)
+}
+
+func NotificationValidationOptions() []validated.StateOption {
+	return notificationValidationOptions()
+}
+
+func GitRepositoryValidationOptions() []validated.StateOption {
+	return gitRepositoryValidationOptions()
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package validations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/state"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/validated"
+	"github.com/siderolabs/omni/internal/pkg/gitsync"
+)
+
+// gitRepositoryValidationOptions returns the validation options for the Git repository and credentials resources.
+//
+// The credentials referenced by a repository are not required to exist, the repository sync fails until they are created.
+func gitRepositoryValidationOptions() []validated.StateOption {
+	validateRepository := func(res *omni.GitRepository) error {
+		if err := gitsync.ValidateRepository(res.TypedSpec().Value); err != nil {
+			return fmt.Errorf("invalid git repository: %w", err)
+		}
+
+		return nil
+	}
+
+	validateCredentials := func(res *omni.GitCredentials) error {
+		if err := gitsync.ValidateCredentials(res.TypedSpec().Value); err != nil {
+			return fmt.Errorf("invalid git credentials: %w", err)
+		}
+
+		return nil
+	}
+
+	return []validated.StateOption{
+		validated.WithCreateValidations(
+			validated.NewCreateValidationForType(func(_ context.Context, res *omni.GitRepository, _ ...state.CreateOption) error {
+				return validateRepository(res)
+			}),
+			validated.NewCreateValidationForType(func(_ context.Context, res *omni.GitCredentials, _ ...state.CreateOption) error {
+				return validateCredentials(res)
+			}),
+		),
+		validated.WithUpdateValidations(
+			validated.NewUpdateValidationForType(func(_ context.Context, _, newRes *omni.GitRepository, _ ...state.UpdateOption) error {
+				return validateRepository(newRes)
+			}),
+			validated.NewUpdateValidationForType(func(_ context.Context, _, newRes *omni.GitCredentials, _ ...state.UpdateOption) error {
+				return validateCredentials(newRes)
+			}),
+		),
+	}
+}
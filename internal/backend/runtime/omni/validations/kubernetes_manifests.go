@@ -14,6 +14,7 @@ import (
 	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/validated"
+	"github.com/siderolabs/omni/internal/pkg/helm"
 )
 
 func kubernetesManifestsValidationOptions() []validated.StateOption {
@@ -40,6 +41,18 @@ func validateKubernetesManifests(res *omni.KubernetesManifestGroup) error {
 		return fmt.Errorf("system manifests can't be created by the user")
 	}
 
+	if source := res.TypedSpec().Value.GetHelm(); source != nil {
+		if res.TypedSpec().Value.GetData() != "" || len(res.TypedSpec().Value.GetCompressedData()) > 0 {
+			return fmt.Errorf("the manifest can't have both the data and the helm chart set")
+		}
+
+		if err := helm.ValidateSource(source); err != nil {
+			return fmt.Errorf("invalid helm chart source: %w", err)
+		}
+
+		return nil
+	}
+
 	_, err := res.TypedSpec().Value.GetManifests()
 	if err != nil {
 		return err
@@ -88,6 +88,10 @@ func machineSetValidationOptions(st state.State, etcdBackupStoreFactory store.Fa
 			return err
 		}
 
+		if err := validateUpdateStrategies(res); err != nil {
+			return err
+		}
+
 		allocationConfig := omni.GetMachineAllocation(res)
 
 		if allocationConfig != nil {
@@ -99,6 +103,10 @@ func machineSetValidationOptions(st state.State, etcdBackupStoreFactory store.Fa
 				return errors.New("machine count can be set only if static allocation type is used")
 			}
 
+			if err := validateAutoscaling(allocationConfig, isControlPlane); err != nil {
+				return err
+			}
+
 			var oldAllocationConfig *specs.MachineSetSpec_MachineAllocation
 
 			if oldRes != nil {
@@ -213,6 +221,69 @@ func machineSetValidationOptions(st state.State, etcdBackupStoreFactory store.Fa
 	}
 }
 
+func validateAutoscaling(allocationConfig *specs.MachineSetSpec_MachineAllocation, isControlPlane bool) error {
+	autoscaling := allocationConfig.GetAutoscaling()
+	if autoscaling == nil {
+		return nil
+	}
+
+	if isControlPlane {
+		return errors.New("autoscaling is not supported for the control plane machine set")
+	}
+
+	if allocationConfig.AllocationType != specs.MachineSetSpec_MachineAllocation_Static {
+		return errors.New("autoscaling can be set only if static allocation type is used")
+	}
+
+	if autoscaling.MaxMachineCount == 0 {
+		return errors.New("autoscaling max machine count must be greater than zero")
+	}
+
+	if autoscaling.MinMachineCount > autoscaling.MaxMachineCount {
+		return fmt.Errorf("autoscaling min machine count %d is greater than max machine count %d", autoscaling.MinMachineCount, autoscaling.MaxMachineCount)
+	}
+
+	if allocationConfig.MachineCount < autoscaling.MinMachineCount || allocationConfig.MachineCount > autoscaling.MaxMachineCount {
+		return fmt.Errorf("machine count %d is out of the autoscaling bounds [%d, %d]",
+			allocationConfig.MachineCount, autoscaling.MinMachineCount, autoscaling.MaxMachineCount)
+	}
+
+	return nil
+}
+
+func validateUpdateStrategies(res *omni.MachineSet) error {
+	spec := res.TypedSpec().Value
+
+	if spec.GetDeleteStrategy() == specs.MachineSetSpec_Canary || spec.GetDeleteStrategyConfig().GetCanary() != nil {
+		return errors.New("canary strategy is not supported for deletes")
+	}
+
+	for _, strategy := range []struct {
+		config       *specs.MachineSetSpec_UpdateStrategyConfig
+		name         string
+		strategyType specs.MachineSetSpec_UpdateStrategy
+	}{
+		{name: "update", strategyType: spec.GetUpdateStrategy(), config: spec.GetUpdateStrategyConfig()},
+		{name: "upgrade", strategyType: spec.GetUpgradeStrategy(), config: spec.GetUpgradeStrategyConfig()},
+	} {
+		if strategy.strategyType != specs.MachineSetSpec_Canary && strategy.config.GetCanary() != nil {
+			return fmt.Errorf("canary %s strategy config is set, but the %s strategy is %s", strategy.name, strategy.name, strategy.strategyType)
+		}
+
+		if strategy.strategyType == specs.MachineSetSpec_Canary && strategy.config.GetRolling() != nil {
+			return fmt.Errorf("rolling %s strategy config is set, but the %s strategy is %s", strategy.name, strategy.name, strategy.strategyType)
+		}
+
+		canary := strategy.config.GetCanary()
+
+		if canary.GetSoakDuration().AsDuration() < 0 || canary.GetFailureTimeout().AsDuration() < 0 {
+			return fmt.Errorf("canary %s strategy durations must not be negative", strategy.name)
+		}
+	}
+
+	return nil
+}
+
 func validateBootstrapSpec(ctx context.Context, st state.State, etcdBackupStoreFactory store.Factory, oldres, res *omni.MachineSet) error {
 	bootstrapSpec := res.TypedSpec().Value.GetBootstrapSpec()
 	_, isControlPlane := res.Metadata().Labels().Get(omni.LabelControlPlaneRole)
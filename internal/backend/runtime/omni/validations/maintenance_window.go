@@ -0,0 +1,105 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package validations
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+
+	"github.com/siderolabs/omni/client/pkg/maintenancewindow"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/validated"
+)
+
+// maintenanceWindowValidationOptions returns the validation options for the maintenance window resource
+// and for the clusters and machine sets referencing it.
+func maintenanceWindowValidationOptions(st state.State) []validated.StateOption {
+	validate := func(res *omni.MaintenanceWindow) error {
+		if _, err := maintenancewindow.New(res.TypedSpec().Value); err != nil {
+			return fmt.Errorf("invalid maintenance window: %w", err)
+		}
+
+		return nil
+	}
+
+	// the referenced window must exist when the reference is set or changed
+	validateReference := func(ctx context.Context, oldID, id string) error {
+		if id == "" || id == oldID {
+			return nil
+		}
+
+		if _, err := safe.ReaderGetByID[*omni.MaintenanceWindow](ctx, st, id); err != nil {
+			if state.IsNotFoundError(err) {
+				return fmt.Errorf("maintenance window %q doesn't exist", id)
+			}
+
+			return err
+		}
+
+		return nil
+	}
+
+	return []validated.StateOption{
+		validated.WithCreateValidations(
+			validated.NewCreateValidationForType(func(_ context.Context, res *omni.MaintenanceWindow, _ ...state.CreateOption) error {
+				return validate(res)
+			}),
+			validated.NewCreateValidationForType(func(ctx context.Context, res *omni.Cluster, _ ...state.CreateOption) error {
+				return validateReference(ctx, "", res.TypedSpec().Value.MaintenanceWindow)
+			}),
+			validated.NewCreateValidationForType(func(ctx context.Context, res *omni.MachineSet, _ ...state.CreateOption) error {
+				return validateReference(ctx, "", res.TypedSpec().Value.MaintenanceWindow)
+			}),
+		),
+		validated.WithUpdateValidations(
+			validated.NewUpdateValidationForType(func(_ context.Context, _, newRes *omni.MaintenanceWindow, _ ...state.UpdateOption) error {
+				return validate(newRes)
+			}),
+			validated.NewUpdateValidationForType(func(ctx context.Context, oldRes, newRes *omni.Cluster, _ ...state.UpdateOption) error {
+				return validateReference(ctx, oldRes.TypedSpec().Value.MaintenanceWindow, newRes.TypedSpec().Value.MaintenanceWindow)
+			}),
+			validated.NewUpdateValidationForType(func(ctx context.Context, oldRes, newRes *omni.MachineSet, _ ...state.UpdateOption) error {
+				return validateReference(ctx, oldRes.TypedSpec().Value.MaintenanceWindow, newRes.TypedSpec().Value.MaintenanceWindow)
+			}),
+		),
+		validated.WithDestroyValidations(validated.NewDestroyValidationForType(func(ctx context.Context, _ resource.Pointer, res *omni.MaintenanceWindow, _ ...state.DestroyOption) error {
+			clusters, err := safe.ReaderListAll[*omni.Cluster](ctx, st)
+			if err != nil {
+				return err
+			}
+
+			machineSets, err := safe.ReaderListAll[*omni.MachineSet](ctx, st)
+			if err != nil {
+				return err
+			}
+
+			var inUseBy []string
+
+			clusters.ForEach(func(r *omni.Cluster) {
+				if r.TypedSpec().Value.MaintenanceWindow == res.Metadata().ID() {
+					inUseBy = append(inUseBy, "cluster "+r.Metadata().ID())
+				}
+			})
+
+			machineSets.ForEach(func(r *omni.MachineSet) {
+				if r.TypedSpec().Value.MaintenanceWindow == res.Metadata().ID() {
+					inUseBy = append(inUseBy, "machine set "+r.Metadata().ID())
+				}
+			})
+
+			if len(inUseBy) > 0 {
+				return fmt.Errorf("can not delete the maintenance window as it is still in use by %s", strings.Join(inUseBy, ", "))
+			}
+
+			return nil
+		})),
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package validations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/state"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/validated"
+	"github.com/siderolabs/omni/internal/pkg/notification"
+)
+
+// notificationValidationOptions returns the validation options for the notification channel and rule resources.
+//
+// The channels referenced by a rule are not required to exist, the events are not delivered to the missing channels.
+func notificationValidationOptions() []validated.StateOption {
+	validateChannel := func(res *omni.NotificationChannel) error {
+		if err := notification.ValidateChannel(res.TypedSpec().Value); err != nil {
+			return fmt.Errorf("invalid notification channel: %w", err)
+		}
+
+		return nil
+	}
+
+	validateRule := func(res *omni.NotificationRule) error {
+		if err := notification.ValidateRule(res.TypedSpec().Value); err != nil {
+			return fmt.Errorf("invalid notification rule: %w", err)
+		}
+
+		return nil
+	}
+
+	return []validated.StateOption{
+		validated.WithCreateValidations(
+			validated.NewCreateValidationForType(func(_ context.Context, res *omni.NotificationChannel, _ ...state.CreateOption) error {
+				return validateChannel(res)
+			}),
+			validated.NewCreateValidationForType(func(_ context.Context, res *omni.NotificationRule, _ ...state.CreateOption) error {
+				return validateRule(res)
+			}),
+		),
+		validated.WithUpdateValidations(
+			validated.NewUpdateValidationForType(func(_ context.Context, _, newRes *omni.NotificationChannel, _ ...state.UpdateOption) error {
+				return validateChannel(newRes)
+			}),
+			validated.NewUpdateValidationForType(func(_ context.Context, _, newRes *omni.NotificationRule, _ ...state.UpdateOption) error {
+				return validateRule(newRes)
+			}),
+		),
+	}
+}
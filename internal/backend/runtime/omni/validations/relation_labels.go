@@ -56,6 +56,9 @@ func relationLabelsValidationOptions() []validated.StateOption {
 			validated.NewCreateValidationForType(func(_ context.Context, res *omni.ExposedService, _ ...state.CreateOption) error {
 				return validateLabelIsSet(res, omni.LabelCluster)
 			}),
+			validated.NewCreateValidationForType(func(_ context.Context, res *omni.CanaryApproval, _ ...state.CreateOption) error {
+				return validateLabelIsSet(res, omni.LabelCluster)
+			}),
 		),
 		validated.WithUpdateValidations(
 			validated.NewUpdateValidationForType(func(_ context.Context, _ *omni.MachineSetNode, newRes *omni.MachineSetNode, _ ...state.UpdateOption) error {
@@ -70,6 +73,9 @@ func relationLabelsValidationOptions() []validated.StateOption {
 			validated.NewUpdateValidationForType(func(_ context.Context, _ *omni.ExposedService, newRes *omni.ExposedService, _ ...state.UpdateOption) error {
 				return validateLabelIsSet(newRes, omni.LabelCluster)
 			}),
+			validated.NewUpdateValidationForType(func(_ context.Context, _ *omni.CanaryApproval, newRes *omni.CanaryApproval, _ ...state.UpdateOption) error {
+				return validateLabelIsSet(newRes, omni.LabelCluster)
+			}),
 			validated.NewUpdateValidationForType(func(_ context.Context, oldRes *omni.MachineSetNode, newRes *omni.MachineSetNode, _ ...state.UpdateOption) error {
 				return validateLabelIsNotChanged(oldRes, newRes, omni.LabelCluster)
 			}),
@@ -82,6 +88,9 @@ func relationLabelsValidationOptions() []validated.StateOption {
 			validated.NewUpdateValidationForType(func(_ context.Context, oldRes *omni.ExposedService, newRes *omni.ExposedService, _ ...state.UpdateOption) error {
 				return validateLabelIsNotChanged(oldRes, newRes, omni.LabelCluster)
 			}),
+			validated.NewUpdateValidationForType(func(_ context.Context, oldRes *omni.CanaryApproval, newRes *omni.CanaryApproval, _ ...state.UpdateOption) error {
+				return validateLabelIsNotChanged(oldRes, newRes, omni.LabelCluster)
+			}),
 		),
 	}
 }
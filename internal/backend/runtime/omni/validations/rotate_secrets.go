@@ -30,6 +30,26 @@ func rotateSecretsValidationOptions(st state.State) []validated.StateOption {
 				return validateRotateSecretModify(ctx, st, newRes)
 			},
 		)),
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(
+			func(ctx context.Context, _ *omni.RotateEtcdCA, newRes *omni.RotateEtcdCA, _ ...state.UpdateOption) error {
+				return validateRotateSecretModify(ctx, st, newRes)
+			},
+		)),
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(
+			func(ctx context.Context, _ *omni.RotateKubernetesAggregatorCA, newRes *omni.RotateKubernetesAggregatorCA, _ ...state.UpdateOption) error {
+				return validateRotateSecretModify(ctx, st, newRes)
+			},
+		)),
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(
+			func(ctx context.Context, _ *omni.RotateKubernetesServiceAccountKey, newRes *omni.RotateKubernetesServiceAccountKey, _ ...state.UpdateOption) error {
+				return validateRotateSecretModify(ctx, st, newRes)
+			},
+		)),
+		validated.WithUpdateValidations(validated.NewUpdateValidationForType(
+			func(ctx context.Context, _ *omni.RotateBootstrapToken, newRes *omni.RotateBootstrapToken, _ ...state.UpdateOption) error {
+				return validateRotateSecretModify(ctx, st, newRes)
+			},
+		)),
 
 		validated.WithDestroyValidations(validated.NewDestroyValidationForType(
 			func(ctx context.Context, ptr resource.Pointer, existingRes *omni.RotateTalosCA, _ ...state.DestroyOption) error {
@@ -41,6 +61,26 @@ func rotateSecretsValidationOptions(st state.State) []validated.StateOption {
 				return validateRotateSecretDestroy(ctx, st, existingRes)
 			},
 		)),
+		validated.WithDestroyValidations(validated.NewDestroyValidationForType(
+			func(ctx context.Context, ptr resource.Pointer, existingRes *omni.RotateEtcdCA, _ ...state.DestroyOption) error {
+				return validateRotateSecretDestroy(ctx, st, existingRes)
+			},
+		)),
+		validated.WithDestroyValidations(validated.NewDestroyValidationForType(
+			func(ctx context.Context, ptr resource.Pointer, existingRes *omni.RotateKubernetesAggregatorCA, _ ...state.DestroyOption) error {
+				return validateRotateSecretDestroy(ctx, st, existingRes)
+			},
+		)),
+		validated.WithDestroyValidations(validated.NewDestroyValidationForType(
+			func(ctx context.Context, ptr resource.Pointer, existingRes *omni.RotateKubernetesServiceAccountKey, _ ...state.DestroyOption) error {
+				return validateRotateSecretDestroy(ctx, st, existingRes)
+			},
+		)),
+		validated.WithDestroyValidations(validated.NewDestroyValidationForType(
+			func(ctx context.Context, ptr resource.Pointer, existingRes *omni.RotateBootstrapToken, _ ...state.DestroyOption) error {
+				return validateRotateSecretDestroy(ctx, st, existingRes)
+			},
+		)),
 	}
 }
 
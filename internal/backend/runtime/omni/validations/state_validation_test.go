@@ -893,6 +893,158 @@ func TestMachineSetValidation(t *testing.T) {
 	assert.ErrorContains(t, err, "tearing down")
 }
 
+func TestMachineSetUpdateStrategyValidation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 3*time.Second)
+	t.Cleanup(cancel)
+
+	etcdBackupStoreFactory, err := store.NewStoreFactory(config.EtcdBackup{})
+	require.NoError(t, err)
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+	st := validated.NewState(innerSt, validations.MachineSetValidationOptions(innerSt, etcdBackupStoreFactory)...)
+
+	require.NoError(t, st.Create(ctx, omnires.NewCluster("test-cluster")))
+
+	machineSet := omnires.NewMachineSet(omnires.WorkersResourceID("test-cluster"))
+
+	machineSet.Metadata().Labels().Set(omnires.LabelCluster, "test-cluster")
+	machineSet.Metadata().Labels().Set(omnires.LabelWorkerRole, "")
+
+	// canary deletes are not supported
+
+	machineSet.TypedSpec().Value.DeleteStrategy = specs.MachineSetSpec_Canary
+
+	err = st.Create(ctx, machineSet)
+	assert.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "canary strategy is not supported for deletes")
+
+	machineSet.TypedSpec().Value.DeleteStrategy = specs.MachineSetSpec_Unset
+
+	// canary config without the canary strategy
+
+	machineSet.TypedSpec().Value.UpgradeStrategy = specs.MachineSetSpec_Rolling
+	machineSet.TypedSpec().Value.UpgradeStrategyConfig = &specs.MachineSetSpec_UpdateStrategyConfig{
+		Canary: &specs.MachineSetSpec_CanaryUpdateStrategyConfig{Count: 1},
+	}
+
+	err = st.Create(ctx, machineSet)
+	assert.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "canary upgrade strategy config is set, but the upgrade strategy is Rolling")
+
+	// negative soak duration
+
+	machineSet.TypedSpec().Value.UpgradeStrategy = specs.MachineSetSpec_Canary
+	machineSet.TypedSpec().Value.UpgradeStrategyConfig.Canary.SoakDuration = durationpb.New(-time.Minute)
+
+	err = st.Create(ctx, machineSet)
+	assert.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "canary upgrade strategy durations must not be negative")
+
+	// valid canary config
+
+	machineSet.TypedSpec().Value.UpgradeStrategyConfig.Canary.SoakDuration = durationpb.New(time.Minute)
+	machineSet.TypedSpec().Value.UpdateStrategy = specs.MachineSetSpec_Canary
+
+	assert.NoError(t, st.Create(ctx, machineSet))
+}
+
+func TestMachineSetAutoscalingValidation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 3*time.Second)
+	t.Cleanup(cancel)
+
+	etcdBackupStoreFactory, err := store.NewStoreFactory(config.EtcdBackup{})
+	require.NoError(t, err)
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+	st := validated.NewState(innerSt, validations.MachineSetValidationOptions(innerSt, etcdBackupStoreFactory)...)
+
+	require.NoError(t, st.Create(ctx, omnires.NewCluster("test-cluster")))
+	require.NoError(t, st.Create(ctx, omnires.NewMachineClass("test-class")))
+
+	for _, tt := range []struct {
+		allocation    *specs.MachineSetSpec_MachineAllocation
+		name          string
+		expectedError string
+		controlPlane  bool
+	}{
+		{
+			name: "control plane",
+			allocation: &specs.MachineSetSpec_MachineAllocation{
+				MachineCount: 1,
+				Autoscaling:  &specs.MachineSetSpec_MachineAllocation_Autoscaling{MinMachineCount: 1, MaxMachineCount: 3},
+			},
+			controlPlane:  true,
+			expectedError: "autoscaling is not supported for the control plane machine set",
+		},
+		{
+			name: "unlimited",
+			allocation: &specs.MachineSetSpec_MachineAllocation{
+				AllocationType: specs.MachineSetSpec_MachineAllocation_Unlimited,
+				Autoscaling:    &specs.MachineSetSpec_MachineAllocation_Autoscaling{MaxMachineCount: 3},
+			},
+			expectedError: "autoscaling can be set only if static allocation type is used",
+		},
+		{
+			name: "zero max",
+			allocation: &specs.MachineSetSpec_MachineAllocation{
+				Autoscaling: &specs.MachineSetSpec_MachineAllocation_Autoscaling{},
+			},
+			expectedError: "autoscaling max machine count must be greater than zero",
+		},
+		{
+			name: "min greater than max",
+			allocation: &specs.MachineSetSpec_MachineAllocation{
+				MachineCount: 3,
+				Autoscaling:  &specs.MachineSetSpec_MachineAllocation_Autoscaling{MinMachineCount: 4, MaxMachineCount: 3},
+			},
+			expectedError: "autoscaling min machine count 4 is greater than max machine count 3",
+		},
+		{
+			name: "count out of bounds",
+			allocation: &specs.MachineSetSpec_MachineAllocation{
+				MachineCount: 5,
+				Autoscaling:  &specs.MachineSetSpec_MachineAllocation_Autoscaling{MinMachineCount: 1, MaxMachineCount: 3},
+			},
+			expectedError: "machine count 5 is out of the autoscaling bounds [1, 3]",
+		},
+		{
+			name: "valid",
+			allocation: &specs.MachineSetSpec_MachineAllocation{
+				Autoscaling: &specs.MachineSetSpec_MachineAllocation_Autoscaling{MaxMachineCount: 3},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			machineSet := omnires.NewMachineSet(omnires.AdditionalWorkersResourceID("test-cluster", strings.ReplaceAll(tt.name, " ", "-")))
+			machineSet.Metadata().Labels().Set(omnires.LabelCluster, "test-cluster")
+			machineSet.Metadata().Labels().Set(omnires.LabelWorkerRole, "")
+
+			if tt.controlPlane {
+				machineSet = omnires.NewMachineSet(omnires.ControlPlanesResourceID("test-cluster"))
+				machineSet.Metadata().Labels().Set(omnires.LabelCluster, "test-cluster")
+				machineSet.Metadata().Labels().Set(omnires.LabelControlPlaneRole, "")
+			}
+
+			tt.allocation.Name = "test-class"
+			machineSet.TypedSpec().Value.MachineAllocation = tt.allocation
+
+			err := st.Create(ctx, machineSet)
+			if tt.expectedError == "" {
+				require.NoError(t, err)
+
+				return
+			}
+
+			assert.True(t, validated.IsValidationError(err), "expected validation error")
+			assert.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+}
+
 func TestMachineSetBootstrapSpecValidation(t *testing.T) {
 	t.Parallel()
 
@@ -1682,6 +1834,84 @@ func TestSAMLLabelRuleValidation(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCustomRoleValidation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 3*time.Second)
+	t.Cleanup(cancel)
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+	st := validated.NewState(innerSt, validations.CustomRoleValidationOptions()...)
+
+	err := st.Create(ctx, auth.NewRole("machine-viewer"))
+	assert.ErrorContains(t, err, "role must have at least one rule")
+
+	customRole := auth.NewRole(string(role.Admin))
+	customRole.TypedSpec().Value.Rules = []*specs.RoleSpec_Rule{
+		{
+			ResourceTypes: []string{omnires.MachineStatusType},
+			Verbs:         []string{"teardown"},
+		},
+	}
+
+	err = st.Create(ctx, customRole)
+	assert.ErrorContains(t, err, "conflicts with the built-in role")
+	assert.ErrorContains(t, err, "unknown verb")
+
+	customRole = auth.NewRole("machine-viewer")
+	customRole.TypedSpec().Value.Rules = []*specs.RoleSpec_Rule{
+		{
+			ResourceTypes: []string{omnires.MachineStatusType},
+			Verbs:         []string{"get", "list", "watch"},
+		},
+	}
+
+	require.NoError(t, st.Create(ctx, customRole))
+
+	customRole.TypedSpec().Value.Rules[0].Apis = []string{"NoSuchMethod"}
+
+	err = st.Update(ctx, customRole)
+	assert.ErrorContains(t, err, "unknown ManagementService method")
+}
+
+func TestElevationRequestValidation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 3*time.Second)
+	t.Cleanup(cancel)
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+	st := validated.NewState(innerSt, validations.ElevationRequestValidationOptions()...)
+
+	request := auth.NewElevationRequest("test")
+	request.TypedSpec().Value.Identity = "user@example.com"
+	request.TypedSpec().Value.Role = string(role.Admin)
+	request.TypedSpec().Value.Duration = durationpb.New(30 * 24 * time.Hour)
+
+	err := st.Create(ctx, request)
+	assert.ErrorContains(t, err, "reason must be set")
+
+	request.TypedSpec().Value.Reason = "incident"
+
+	err = st.Create(ctx, request)
+	assert.ErrorContains(t, err, "duration must be positive")
+
+	request.TypedSpec().Value.Duration = durationpb.New(time.Hour)
+
+	require.NoError(t, st.Create(ctx, request))
+
+	request.TypedSpec().Value.Role = string(role.Operator)
+
+	err = st.Update(ctx, request)
+	assert.ErrorContains(t, err, "the requested elevation can not be changed")
+
+	request.TypedSpec().Value.Role = string(role.Admin)
+	request.TypedSpec().Value.ApprovedBy = "admin@example.com"
+	request.TypedSpec().Value.ApprovedAt = timestamppb.Now()
+
+	require.NoError(t, st.Update(ctx, request))
+}
+
 func TestMachineSetClassesValidation(t *testing.T) {
 	t.Parallel()
 
@@ -1958,6 +2188,70 @@ func TestS3ConfigValidation(t *testing.T) {
 	require.NoError(t, st.Update(ctx, res))
 }
 
+func TestEtcdBackupStoreConfigValidation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+	t.Cleanup(cancel)
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	st := validated.NewState(innerSt, validations.EtcdBackupStoreConfigValidationOptions()...)
+
+	res := omnires.NewEtcdBackupStoreConfig()
+
+	// S3 is configured by the EtcdBackupS3Conf resource
+	require.NoError(t, st.Create(ctx, res))
+
+	for _, spec := range []*specs.EtcdBackupStoreConfigSpec{
+		{
+			Backend: specs.EtcdBackupStoreConfigSpec_GCS,
+			Gcs:     &specs.EtcdBackupStoreConfigSpec_GCSConfig{Endpoint: "http://localhost:27777"},
+		},
+		{
+			Backend:   specs.EtcdBackupStoreConfigSpec_AZURE_BLOB,
+			AzureBlob: &specs.EtcdBackupStoreConfigSpec_AzureBlobConfig{AccountName: "account"},
+		},
+		{
+			Backend:   specs.EtcdBackupStoreConfigSpec_AZURE_BLOB,
+			AzureBlob: &specs.EtcdBackupStoreConfigSpec_AzureBlobConfig{Container: "backups"},
+		},
+		{
+			Backend: specs.EtcdBackupStoreConfigSpec_SFTP,
+			Sftp: &specs.EtcdBackupStoreConfigSpec_SFTPConfig{
+				Address:  "localhost:27777",
+				User:     "omni",
+				Password: "secret",
+			},
+		},
+		{
+			Backend: specs.EtcdBackupStoreConfigSpec_SFTP,
+			Sftp: &specs.EtcdBackupStoreConfigSpec_SFTPConfig{
+				Address: "localhost:27777",
+				User:    "omni",
+				HostKey: "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl",
+			},
+		},
+		{
+			Backend: specs.EtcdBackupStoreConfigSpec_SFTP,
+			Sftp: &specs.EtcdBackupStoreConfigSpec_SFTPConfig{
+				Address:  "localhost:27777",
+				User:     "omni",
+				Password: "secret",
+				HostKey:  "not a key",
+			},
+		},
+	} {
+		res.TypedSpec().Value = spec
+
+		require.True(t, validated.IsValidationError(st.Update(ctx, res)), "expected validation error for %s", spec.String())
+	}
+
+	res.TypedSpec().Value = &specs.EtcdBackupStoreConfigSpec{}
+
+	require.NoError(t, st.Update(ctx, res))
+}
+
 func TestSchematicConfigurationValidation(t *testing.T) {
 	t.Parallel()
 
@@ -3503,6 +3797,185 @@ func TestKubernetesManifestValidation(t *testing.T) {
 
 	require.True(t, validated.IsValidationError(err), "expected validation error")
 	assert.ErrorContains(t, err, "error loading JSON manifest into unstructured")
+
+	chart := omnires.NewKubernetesManifestGroup("2")
+	chart.Metadata().Labels().Set(omnires.LabelCluster, "a")
+	chart.TypedSpec().Value.Mode = specs.KubernetesManifestGroupSpec_FULL
+	chart.TypedSpec().Value.Helm = &specs.KubernetesManifestGroupSpec_HelmSource{
+		Repository: "ftp://charts.example.com",
+		Chart:      "cilium",
+	}
+
+	err = st.Create(ctx, chart)
+
+	require.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "unsupported repository scheme")
+
+	chart.TypedSpec().Value.Helm.Repository = "oci://ghcr.io/example/charts"
+	chart.TypedSpec().Value.Data = manifest
+
+	err = st.Create(ctx, chart)
+
+	require.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "can't have both the data and the helm chart set")
+
+	chart.TypedSpec().Value.Data = ""
+
+	require.NoError(t, st.Create(ctx, chart))
+}
+
+func TestMaintenanceWindowValidation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+	t.Cleanup(cancel)
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+	st := validated.NewState(innerSt, validations.MaintenanceWindowValidationOptions(innerSt)...)
+
+	window := omnires.NewMaintenanceWindow("nightly")
+	window.TypedSpec().Value.Schedule = "0 25 * * *"
+	window.TypedSpec().Value.Duration = durationpb.New(time.Hour)
+
+	err := st.Create(ctx, window)
+
+	require.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "invalid maintenance window")
+
+	window.TypedSpec().Value.Schedule = "0 22 * * *"
+	window.TypedSpec().Value.Timezone = "Europe/Berlin"
+
+	require.NoError(t, st.Create(ctx, window))
+
+	cluster := omnires.NewCluster("c1")
+	cluster.TypedSpec().Value.MaintenanceWindow = "weekly"
+
+	err = st.Create(ctx, cluster)
+
+	require.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, `maintenance window "weekly" doesn't exist`)
+
+	cluster.TypedSpec().Value.MaintenanceWindow = window.Metadata().ID()
+
+	require.NoError(t, st.Create(ctx, cluster))
+
+	machineSet := omnires.NewMachineSet(omnires.WorkersResourceID(cluster.Metadata().ID()))
+	machineSet.TypedSpec().Value.MaintenanceWindow = window.Metadata().ID()
+
+	require.NoError(t, st.Create(ctx, machineSet))
+
+	_, err = safe.StateUpdateWithConflicts(ctx, state.WrapCore(st), window.Metadata(), func(res *omnires.MaintenanceWindow) error {
+		res.TypedSpec().Value.Duration = nil
+
+		return nil
+	})
+
+	require.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "duration must be positive")
+
+	err = st.Destroy(ctx, window.Metadata())
+
+	require.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "still in use by cluster c1, machine set c1-workers")
+
+	require.NoError(t, st.Destroy(ctx, machineSet.Metadata()))
+	require.NoError(t, st.Destroy(ctx, cluster.Metadata()))
+	require.NoError(t, st.Destroy(ctx, window.Metadata()))
+}
+
+func TestNotificationValidation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+	t.Cleanup(cancel)
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+	st := validated.NewState(innerSt, validations.NotificationValidationOptions()...)
+
+	channel := omnires.NewNotificationChannel("slack")
+	channel.TypedSpec().Value.Type = specs.NotificationChannelSpec_SLACK
+
+	err := st.Create(ctx, channel)
+
+	require.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "invalid notification channel")
+
+	channel.TypedSpec().Value.Url = "https://hooks.slack.com/services/T/B/X"
+
+	require.NoError(t, st.Create(ctx, channel))
+
+	rule := omnires.NewNotificationRule("prod")
+	rule.TypedSpec().Value.Channels = []string{channel.Metadata().ID()}
+	rule.TypedSpec().Value.Events = []string{"cluster-exploded"}
+
+	err = st.Create(ctx, rule)
+
+	require.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "unknown event")
+
+	rule.TypedSpec().Value.Events = []string{omnires.NotificationEventEtcdBackupFailed}
+	rule.TypedSpec().Value.ClusterSelector = "env=prod"
+
+	require.NoError(t, st.Create(ctx, rule))
+
+	_, err = safe.StateUpdateWithConflicts(ctx, state.WrapCore(st), rule.Metadata(), func(res *omnires.NotificationRule) error {
+		res.TypedSpec().Value.Channels = nil
+
+		return nil
+	})
+
+	require.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "at least one channel")
+}
+
+func TestGitRepositoryValidation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+	t.Cleanup(cancel)
+
+	innerSt := state.WrapCore(namespaced.NewState(inmem.Build))
+	st := validated.NewState(innerSt, validations.GitRepositoryValidationOptions()...)
+
+	repository := omnires.NewGitRepository("fleet")
+
+	err := st.Create(ctx, repository)
+
+	require.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "url is required")
+
+	repository.TypedSpec().Value.Url = "ftp://git.example.com/fleet.git"
+
+	err = st.Create(ctx, repository)
+
+	require.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "unsupported url protocol")
+
+	repository.TypedSpec().Value.Url = "git@github.com:example/fleet.git"
+	repository.TypedSpec().Value.PollInterval = durationpb.New(time.Second)
+
+	err = st.Create(ctx, repository)
+
+	require.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "poll interval should be at least")
+
+	repository.TypedSpec().Value.PollInterval = durationpb.New(time.Minute)
+
+	require.NoError(t, st.Create(ctx, repository))
+
+	credentials := omnires.NewGitCredentials("fleet")
+	credentials.TypedSpec().Value.SshPrivateKey = "not a key"
+
+	err = st.Create(ctx, credentials)
+
+	require.True(t, validated.IsValidationError(err), "expected validation error")
+	assert.ErrorContains(t, err, "invalid SSH private key")
+
+	credentials.TypedSpec().Value.SshPrivateKey = ""
+	credentials.TypedSpec().Value.Username = "omni"
+	credentials.TypedSpec().Value.Password = "token"
+
+	require.NoError(t, st.Create(ctx, credentials))
 }
 
 func TestKubernetesHealthCheckValidation(t *testing.T) {
@@ -3618,6 +4091,10 @@ func (m *mockEtcdBackupStore) Download(_ context.Context, _ []byte, clusterUUID,
 	return etcdbackup.BackupData{}, nil, errors.New("not found")
 }
 
+func (m *mockEtcdBackupStore) Delete(context.Context, string, string) error {
+	return nil
+}
+
 func TestAccountLimitsValidation(t *testing.T) {
 	t.Parallel()
 
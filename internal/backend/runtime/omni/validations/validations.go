@@ -36,7 +36,10 @@ func Options(st state.State, etcdBackupStoreFactory store.Factory, cfg *config.P
 		configPatchValidationOptions(st),
 		etcdManualBackupValidationOptions(),
 		samlLabelRuleValidationOptions(),
+		customRoleValidationOptions(),
+		elevationRequestValidationOptions(),
 		s3ConfigValidationOptions(),
+		etcdBackupStoreConfigValidationOptions(),
 		machineRequestSetValidationOptions(st),
 		infraMachineConfigValidationOptions(st),
 		nodeForceDestroyRequestValidationOptions(st),
@@ -52,5 +55,8 @@ func Options(st state.State, etcdBackupStoreFactory store.Factory, cfg *config.P
 		machineInstallDiskConfigValidationOptions(),
 		kubernetesHealthcheckValidationOptions(),
 		extensionsConfigurationValidationOptions(st),
+		maintenanceWindowValidationOptions(st),
+		notificationValidationOptions(),
+		gitRepositoryValidationOptions(),
 	)
 }
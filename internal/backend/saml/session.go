@@ -295,7 +295,10 @@ func (sp *SessionProvider) ensureUser(ctx context.Context, email string, samlLab
 		return err
 	}
 
-	var updateOnEachLogin bool
+	var (
+		updateOnEachLogin bool
+		customRoles       []string
+	)
 
 	r := role.Admin
 
@@ -312,6 +315,7 @@ func (sp *SessionProvider) ensureUser(ctx context.Context, email string, samlLab
 		if samlLabelRule != nil {
 			r = role.Role(getRoleFromRule(samlLabelRule))
 			updateOnEachLogin = samlLabelRule.TypedSpec().Value.UpdateOnEachLogin
+			customRoles = samlLabelRule.TypedSpec().Value.AssignCustomRoles
 		}
 	}
 
@@ -325,7 +329,7 @@ func (sp *SessionProvider) ensureUser(ctx context.Context, email string, samlLab
 		updateOnEachLogin = false
 	}
 
-	if err = user.Ensure(ctx, sp.state, email, r, updateOnEachLogin); err != nil {
+	if err = user.Ensure(ctx, sp.state, email, r, updateOnEachLogin, customRoles...); err != nil {
 		return err
 	}
 
@@ -73,12 +73,14 @@ import (
 	"github.com/siderolabs/omni/internal/backend/logging"
 	"github.com/siderolabs/omni/internal/backend/monitoring"
 	"github.com/siderolabs/omni/internal/backend/oidc"
+	apiratelimit "github.com/siderolabs/omni/internal/backend/ratelimit"
 	backendruntime "github.com/siderolabs/omni/internal/backend/runtime"
 	"github.com/siderolabs/omni/internal/backend/runtime/kubernetes"
 	"github.com/siderolabs/omni/internal/backend/runtime/omni"
 	talosruntime "github.com/siderolabs/omni/internal/backend/runtime/talos"
 	"github.com/siderolabs/omni/internal/backend/saml"
 	"github.com/siderolabs/omni/internal/backend/services"
+	"github.com/siderolabs/omni/internal/backend/services/clusterautoscaler"
 	"github.com/siderolabs/omni/internal/backend/services/workloadproxy"
 	"github.com/siderolabs/omni/internal/backend/talos/lifecycle"
 	"github.com/siderolabs/omni/internal/frontend"
@@ -249,7 +251,12 @@ func (s *Server) Run(ctx context.Context) error {
 		return err
 	}
 
-	workloadProxyHandler, err := s.workloadProxyHandler(mux)
+	workloadProxyCredentialValidator, err := s.workloadProxyCredentialValidator(oidcStorage)
+	if err != nil {
+		return fmt.Errorf("failed to create workload proxy credential validator: %w", err)
+	}
+
+	workloadProxyHandler, err := s.workloadProxyHandler(mux, workloadProxyCredentialValidator, oidcStorage, oidcIssuerEndpoint)
 	if err != nil {
 		return fmt.Errorf("failed to create workload proxy handler: %w", err)
 	}
@@ -284,6 +291,7 @@ func (s *Server) Run(ctx context.Context) error {
 		newSubsystem("machine API", func() error { return s.runMachineAPI(ctx) }),
 		newSubsystem("SQLite metrics", func() error { return s.state.RunSQLiteMetrics(ctx) }),
 		newSubsystem("audit cleanup", func() error { return s.state.RunAuditCleanup(ctx) }),
+		newSubsystem("audit sinks", func() error { return s.state.RunAuditSinks(ctx) }),
 		newSubsystem("state error handler", func() error { return s.state.HandleErrors(ctx) }),
 	}
 
@@ -291,6 +299,41 @@ func (s *Server) Run(ctx context.Context) error {
 		subsystems = append(subsystems, newSubsystem("pprof server", func() error { return runPprofServer(ctx, s.pprofBindAddress, s.logger) }))
 	}
 
+	if selfBackupInterval := s.cfg.EtcdBackup.GetSelfBackupInterval(); selfBackupInterval > 0 {
+		selfBackupRetention := omni.SelfBackupRetention(s.cfg.EtcdBackup)
+
+		subsystems = append(subsystems, newSubsystem("self backup", func() error {
+			return s.state.RunSelfBackups(ctx, selfBackupInterval, selfBackupRetention, s.logger.With(logging.Component("self_backup")))
+		}))
+	}
+
+	if s.cfg.Services.WorkloadProxy.GetMinPort() > 0 && s.cfg.Services.WorkloadProxy.GetMaxPort() > 0 {
+		if s.apiService.IsSecure() {
+			portProxy, portProxyErr := workloadproxy.NewPortProxy(s.state.Default(), s.workloadProxyReconciler, workloadProxyCredentialValidator, s.state.Auditor(),
+				s.apiService.GetCertFile(), s.apiService.GetKeyFile(), s.logger.With(logging.Component("workload_proxy_port_proxy")))
+			if portProxyErr != nil {
+				return fmt.Errorf("failed to create workload proxy port proxy: %w", portProxyErr)
+			}
+
+			subsystems = append(subsystems, newSubsystem("workload proxy port proxy", func() error { return portProxy.Run(ctx) }))
+		} else {
+			s.logger.Warn("the TCP services are not proxied, as the API service is not secure")
+		}
+	}
+
+	if endpoint := s.cfg.Services.ClusterAutoscaler.GetEndpoint(); endpoint != "" {
+		if s.apiService.IsSecure() {
+			clusterAutoscalerServer, clusterAutoscalerErr := s.clusterAutoscalerServer(oidcStorage, endpoint)
+			if clusterAutoscalerErr != nil {
+				return fmt.Errorf("failed to create cluster autoscaler server: %w", clusterAutoscalerErr)
+			}
+
+			subsystems = append(subsystems, newSubsystem("cluster autoscaler server", func() error { return clusterAutoscalerServer.Run(ctx) }))
+		} else {
+			s.logger.Warn("the cluster autoscaler service is not started, as the API service is not secure")
+		}
+	}
+
 	debugServerEndpoint := s.cfg.Debug.Server.GetEndpoint()
 	if debugServerEndpoint != "" && constants.IsDebugBuild {
 		subsystems = append(subsystems, newSubsystem("debug server", func() error {
@@ -493,6 +536,15 @@ func (s *Server) buildServerOptions(ctx context.Context) ([]grpc.ServerOption, e
 		streamInterceptors = append(streamInterceptors, authInterceptor.Stream())
 	}
 
+	// the limits are enforced after the auth interceptors, as they are applied per identity
+	requestLimiter, err := apiratelimit.NewRequestLimiter(s.cfg.Services.ApiRateLimits, prometheus.DefaultRegisterer, s.state.Auditor(), s.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	unaryInterceptors = append(unaryInterceptors, requestLimiter.Unary())
+	streamInterceptors = append(streamInterceptors, requestLimiter.Stream())
+
 	activityTracker := interceptor.NewActivity(s.state.Default(), s.logger)
 	unaryInterceptors = append(unaryInterceptors, activityTracker.Unary())
 	streamInterceptors = append(streamInterceptors, activityTracker.Stream()) //nolint:contextcheck
@@ -551,6 +603,21 @@ func (s *Server) getAuthInterceptors(ctx context.Context) ([]interceptorCreator,
 	return result, nil
 }
 
+// elevatedRole returns the role of the identity raised by its active elevation grants which are not limited to the clusters.
+func (s *Server) elevatedRole(ctx context.Context, identity string, userRole role.Role) (role.Role, error) {
+	grants, err := auth.ElevationGrants(ctx, s.state.Default(), identity)
+	if err != nil {
+		return role.None, err
+	}
+
+	elevatedRole, _, err := auth.ElevatedRole(grants, "")
+	if err != nil {
+		return role.None, err
+	}
+
+	return role.Max(userRole, elevatedRole)
+}
+
 func (s *Server) authenticatorFunc() auth.AuthenticatorFunc {
 	return func(ctx context.Context, fingerprint string) (*auth.Authenticator, error) {
 		ctx = actor.MarkContextAsInternalActor(ctx)
@@ -590,15 +657,30 @@ func (s *Server) authenticatorFunc() auth.AuthenticatorFunc {
 			return nil, err
 		}
 
+		var permissions *auth.Permissions
+
+		// the custom roles and the elevations are not applied to the keys registered with a role lower than the role of the user
+		if finalRole == role.Role(user.TypedSpec().Value.GetRole()) {
+			if permissions, err = auth.LoadPermissions(ctx, s.state.Default(), user.TypedSpec().Value.GetCustomRoles()); err != nil {
+				return nil, err
+			}
+
+			if finalRole, err = s.elevatedRole(ctx, pubKey.TypedSpec().Value.GetIdentity().GetEmail(), finalRole); err != nil {
+				return nil, err
+			}
+		}
+
 		if s.cfg.Auth.GetSuspended() {
 			finalRole = role.Reader
+			permissions = nil
 		}
 
 		return &auth.Authenticator{
-			UserID:   userID,
-			Identity: pubKey.TypedSpec().Value.GetIdentity().GetEmail(),
-			Role:     finalRole,
-			Verifier: verifier,
+			UserID:      userID,
+			Identity:    pubKey.TypedSpec().Value.GetIdentity().GetEmail(),
+			Role:        finalRole,
+			Permissions: permissions,
+			Verifier:    verifier,
 		}, nil
 	}
 }
@@ -696,7 +778,43 @@ func (s *Server) runMachineAPI(ctx context.Context) error {
 	return eg.Wait()
 }
 
-func (s *Server) workloadProxyHandler(next http.Handler) (http.Handler, error) {
+func (s *Server) workloadProxyCredentialValidator(oidcStorage oidcStore) (*workloadproxy.CredentialValidator, error) {
+	roleProvider, err := workloadproxy.NewAccessPolicyRoleProvider(s.state.Default())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create access policy role provider: %w", err)
+	}
+
+	return workloadproxy.NewCredentialValidator(s.state.Default(), roleProvider, oidcStorage.GetPublicKeyByID,
+		s.logger.With(logging.Component("workload_proxy_credential_validator")))
+}
+
+func (s *Server) clusterAutoscalerServer(oidcStorage oidcStore, endpoint string) (*clusterautoscaler.Server, error) {
+	roleProvider, err := workloadproxy.NewAccessPolicyRoleProvider(s.state.Default())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create access policy role provider: %w", err)
+	}
+
+	validator, err := clusterautoscaler.NewCredentialValidator(s.state.Default(), roleProvider, oidcStorage.GetPublicKeyByID)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := s.logger.With(logging.Component("cluster_autoscaler"))
+
+	provider, err := clusterautoscaler.NewProvider(s.state.Default(), logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return clusterautoscaler.NewServer(provider, validator, endpoint, s.apiService.GetCertFile(), s.apiService.GetKeyFile(), logger)
+}
+
+func (s *Server) workloadProxyHandler(
+	next http.Handler,
+	tokenValidator workloadproxy.TokenValidator,
+	signingKeyProvider workloadproxy.SigningKeyProvider,
+	oidcIssuerEndpoint string,
+) (*workloadproxy.HTTPHandler, error) {
 	roleProvider, err := workloadproxy.NewAccessPolicyRoleProvider(s.state.Default())
 	if err != nil {
 		return nil, fmt.Errorf("failed to create access policy role provider: %w", err)
@@ -708,6 +826,12 @@ func (s *Server) workloadProxyHandler(next http.Handler) (http.Handler, error) {
 		return nil, fmt.Errorf("failed to create pgp signature validator: %w", err)
 	}
 
+	identityForwarder, err := workloadproxy.NewSignedIdentityForwarder(s.state.Default(), roleProvider, signingKeyProvider, oidcIssuerEndpoint,
+		s.logger.With(logging.Component("workload_proxy_identity_forwarder")))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create workload proxy identity forwarder: %w", err)
+	}
+
 	mainURL, err := url.Parse(s.cfg.Services.Api.URL())
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse API URL: %w", err)
@@ -717,6 +841,9 @@ func (s *Server) workloadProxyHandler(next http.Handler) (http.Handler, error) {
 		next,
 		s.workloadProxyReconciler,
 		pgpSignatureValidator,
+		tokenValidator,
+		s.state.Auditor(),
+		identityForwarder,
 		mainURL,
 		s.cfg.Services.WorkloadProxy.GetSubdomain(),
 		s.cfg.Services.WorkloadProxy.GetUseOmniSubdomain(),
@@ -725,18 +852,18 @@ func (s *Server) workloadProxyHandler(next http.Handler) (http.Handler, error) {
 	)
 }
 
-func (s *Server) makeAPIServer(regular http.Handler, grpcServer *grpcServer) *apiServer {
+func (s *Server) makeAPIServer(regular *workloadproxy.HTTPHandler, grpcServer *grpcServer) *apiServer {
 	handler := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		if req.ProtoMajor == 2 && strings.HasPrefix(
 			req.Header.Get("Content-Type"), "application/grpc",
-		) {
+		) && !regular.IsWorkloadProxyRequest(req) {
 			// grpcServer provides top-level gRPC proxy handler.
 			grpcServer.ServeHTTP(w, setRealIPRequest(req))
 
 			return
 		}
 
-		// handler contains "regular" HTTP handlers
+		// handler contains "regular" HTTP handlers and the workload proxy, which also proxies the gRPC services
 		regular.ServeHTTP(w, req)
 	})
 
@@ -0,0 +1,217 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package clusterautoscaler
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/siderolabs/omni/client/pkg/access/role"
+	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/ctxstore"
+)
+
+const (
+	// certificateURIScheme is the scheme of the URI SAN of the client certificates, which names the cluster as <scheme>://<cluster>.
+	certificateURIScheme = "omni-cluster-autoscaler"
+
+	// certificateIssuer is the common name of the issuer of the client certificates.
+	certificateIssuer = "omni-cluster-autoscaler"
+
+	// certificateClockSkew backdates the client certificates to tolerate the clock of the client being behind.
+	certificateClockSkew = time.Minute
+)
+
+// Credentials describe the holder of the cluster autoscaler credentials and the cluster they grant access to.
+type Credentials struct {
+	Expiration time.Time
+	Identity   string
+	Cluster    resource.ID
+}
+
+// IssueCertificate issues a client certificate for the credentials to the key of the PEM encoded certificate signing request.
+//
+// The certificate is signed with the given key, and names the key in its authority key ID, so that it can be verified
+// with the public keys Omni keeps for the JWTs.
+func IssueCertificate(signingKey op.SigningKey, csrPEM []byte, credentials Credentials) ([]byte, error) {
+	block, _ := pem.Decode(csrPEM)
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return nil, errors.New("the certificate signing request is not PEM encoded")
+	}
+
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the certificate signing request: %w", err)
+	}
+
+	if err = csr.CheckSignature(); err != nil {
+		return nil, fmt.Errorf("invalid certificate signing request signature: %w", err)
+	}
+
+	signer, ok := signingKey.Key().(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported signing key type %T", signingKey.Key())
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate the serial number: %w", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      pkix.Name{CommonName: credentials.Identity},
+		URIs: []*url.URL{
+			{
+				Scheme: certificateURIScheme,
+				Host:   credentials.Cluster,
+			},
+		},
+		NotBefore:   time.Now().Add(-certificateClockSkew),
+		NotAfter:    credentials.Expiration,
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	issuer := &x509.Certificate{
+		Subject:      pkix.Name{CommonName: certificateIssuer},
+		SubjectKeyId: []byte(signingKey.ID()),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, issuer, csr.PublicKey, signer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the certificate: %w", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), nil
+}
+
+// KeyProvider returns the public key with the given ID to verify the client certificates with.
+type KeyProvider func(ctx context.Context, keyID string) (any, error)
+
+// RoleProvider provides the role of the identity in the context for a cluster.
+type RoleProvider interface {
+	RoleForCluster(ctx context.Context, clusterID resource.ID) (role.Role, error)
+}
+
+// CredentialValidator validates the cluster autoscaler client certificates issued by Omni.
+//
+// The certificates name the cluster they were issued for, and their holder is checked to still be at least an operator
+// of the cluster each time they are used.
+type CredentialValidator struct {
+	state        state.State
+	roleProvider RoleProvider
+	keyProvider  KeyProvider
+}
+
+// NewCredentialValidator creates a new CredentialValidator.
+func NewCredentialValidator(state state.State, roleProvider RoleProvider, keyProvider KeyProvider) (*CredentialValidator, error) {
+	if state == nil {
+		return nil, errors.New("state is nil")
+	}
+
+	if roleProvider == nil {
+		return nil, errors.New("role provider is nil")
+	}
+
+	if keyProvider == nil {
+		return nil, errors.New("key provider is nil")
+	}
+
+	return &CredentialValidator{
+		state:        state,
+		roleProvider: roleProvider,
+		keyProvider:  keyProvider,
+	}, nil
+}
+
+// ValidateCertificate validates the client certificate, and returns the credentials it was issued for.
+func (v *CredentialValidator) ValidateCertificate(ctx context.Context, cert *x509.Certificate) (Credentials, error) {
+	now := time.Now()
+
+	if now.Before(cert.NotBefore) || now.After(cert.NotAfter) {
+		return Credentials{}, errors.New("the certificate is expired or not yet valid")
+	}
+
+	if !slices.Contains(cert.ExtKeyUsage, x509.ExtKeyUsageClientAuth) {
+		return Credentials{}, errors.New("the certificate is not a client certificate")
+	}
+
+	publicKey, err := v.keyProvider(ctx, string(cert.AuthorityKeyId))
+	if err != nil {
+		return Credentials{}, fmt.Errorf("failed to get the issuer key of the certificate: %w", err)
+	}
+
+	if err = cert.CheckSignatureFrom(&x509.Certificate{PublicKey: publicKey}); err != nil {
+		return Credentials{}, fmt.Errorf("invalid certificate signature: %w", err)
+	}
+
+	credentials := Credentials{
+		Expiration: cert.NotAfter,
+		Identity:   cert.Subject.CommonName,
+	}
+
+	for _, uri := range cert.URIs {
+		if uri.Scheme == certificateURIScheme {
+			credentials.Cluster = uri.Host
+
+			break
+		}
+	}
+
+	if credentials.Cluster == "" {
+		return Credentials{}, errors.New("the certificate was not issued for the cluster autoscaler")
+	}
+
+	return credentials, v.checkAccess(ctx, credentials)
+}
+
+// checkAccess checks that the holder of the credentials is still at least an operator of the cluster.
+func (v *CredentialValidator) checkAccess(ctx context.Context, credentials Credentials) error {
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	identity, err := safe.StateGetByID[*authres.Identity](ctx, v.state, credentials.Identity)
+	if err != nil {
+		return fmt.Errorf("failed to get the identity %q: %w", credentials.Identity, err)
+	}
+
+	user, err := safe.StateGetByID[*authres.User](ctx, v.state, identity.TypedSpec().Value.UserId)
+	if err != nil {
+		return fmt.Errorf("failed to get the user of the identity %q: %w", credentials.Identity, err)
+	}
+
+	userRole, err := role.Parse(user.TypedSpec().Value.GetRole())
+	if err != nil {
+		return err
+	}
+
+	ctx = ctxstore.WithValue(ctx, auth.RoleContextKey{Role: userRole})
+	ctx = ctxstore.WithValue(ctx, auth.IdentityContextKey{Identity: credentials.Identity})
+
+	clusterRole, err := v.roleProvider.RoleForCluster(ctx, credentials.Cluster)
+	if err != nil {
+		return err
+	}
+
+	return clusterRole.Check(role.Operator)
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package clusterautoscaler_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/pkg/access/role"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/internal/backend/services/clusterautoscaler"
+)
+
+type testSigningKey struct {
+	key *rsa.PrivateKey
+	id  string
+}
+
+func (k *testSigningKey) SignatureAlgorithm() jose.SignatureAlgorithm { return jose.RS256 }
+
+func (k *testSigningKey) Key() any { return k.key }
+
+func (k *testSigningKey) ID() string { return k.id }
+
+type mockRoleProvider struct {
+	role role.Role
+}
+
+func (m *mockRoleProvider) RoleForCluster(context.Context, resource.ID) (role.Role, error) {
+	return m.role, nil
+}
+
+func TestCredentialValidator(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	user := auth.NewUser("test-user")
+	user.TypedSpec().Value.Role = string(role.Operator)
+
+	require.NoError(t, st.Create(ctx, user))
+
+	identity := auth.NewIdentity("user@example.com")
+	identity.TypedSpec().Value.UserId = user.Metadata().ID()
+
+	require.NoError(t, st.Create(ctx, identity))
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	signingKey := &testSigningKey{key: rsaKey, id: "test-key-id"}
+
+	keyProvider := func(_ context.Context, keyID string) (any, error) {
+		if keyID != signingKey.id {
+			return nil, errors.New("key not found")
+		}
+
+		return &rsaKey.PublicKey, nil
+	}
+
+	roleProvider := &mockRoleProvider{role: role.Operator}
+
+	validator, err := clusterautoscaler.NewCredentialValidator(st, roleProvider, keyProvider)
+	require.NoError(t, err)
+
+	clientKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{}, clientKey)
+	require.NoError(t, err)
+
+	csrPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr})
+
+	issue := func(key *testSigningKey, credentials clusterautoscaler.Credentials) *x509.Certificate {
+		certPEM, issueErr := clusterautoscaler.IssueCertificate(key, csrPEM, credentials)
+		require.NoError(t, issueErr)
+
+		block, _ := pem.Decode(certPEM)
+		require.NotNil(t, block)
+
+		cert, parseErr := x509.ParseCertificate(block.Bytes)
+		require.NoError(t, parseErr)
+
+		return cert
+	}
+
+	credentials := clusterautoscaler.Credentials{
+		Expiration: time.Now().Add(time.Hour),
+		Identity:   identity.Metadata().ID(),
+		Cluster:    "test-cluster",
+	}
+
+	_, err = clusterautoscaler.IssueCertificate(signingKey, []byte("not a csr"), credentials)
+	require.Error(t, err)
+
+	cert := issue(signingKey, credentials)
+
+	validated, err := validator.ValidateCertificate(ctx, cert)
+	require.NoError(t, err)
+	require.Equal(t, identity.Metadata().ID(), validated.Identity)
+	require.Equal(t, "test-cluster", validated.Cluster)
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	_, err = validator.ValidateCertificate(ctx, issue(&testSigningKey{key: otherKey, id: signingKey.id}, credentials))
+	require.ErrorContains(t, err, "invalid certificate signature")
+
+	expired := credentials
+	expired.Expiration = time.Now().Add(-time.Second)
+
+	_, err = validator.ValidateCertificate(ctx, issue(signingKey, expired))
+	require.ErrorContains(t, err, "expired")
+
+	// the access is checked on each use, so the certificate stops working once its holder is no longer an operator
+	roleProvider.role = role.Reader
+
+	_, err = validator.ValidateCertificate(ctx, cert)
+	require.Error(t, err)
+}
@@ -0,0 +1,19 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package clusterautoscaler
+
+import (
+	"context"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+
+	"github.com/siderolabs/omni/internal/pkg/ctxstore"
+)
+
+// WithCluster authorizes the context for the cluster, as the server does for the client certificate of the request.
+func WithCluster(ctx context.Context, clusterID resource.ID) context.Context {
+	return ctxstore.WithValue(ctx, clusterContextKey{Cluster: clusterID})
+}
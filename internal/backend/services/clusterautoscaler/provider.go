@@ -0,0 +1,536 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package clusterautoscaler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/autoscaler"
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/constants"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/ctxstore"
+)
+
+// deleteNodesFinalizer keeps the machine set nodes deleted by the cluster autoscaler until the machine count is decreased.
+const deleteNodesFinalizer = "ClusterAutoscalerProvider"
+
+// clusterContextKey is the context key of the cluster the cluster autoscaler client is authorized for.
+type clusterContextKey struct {
+	Cluster resource.ID
+}
+
+// Provider implements the externalgrpc cloud provider of the Kubernetes cluster autoscaler on top of the machine sets.
+//
+// The node groups are the worker machine sets of the cluster which have autoscaling bounds. Scaling a node group up
+// raises the machine count of its machine set, so the machine set allocates more machines from its machine class,
+// or requests them from the infrastructure provider if the class provisions them automatically.
+// Deleting nodes tears down their machine set nodes, which releases the machines the same way as scaling the machine set down.
+//
+// The methods taking or returning the Kubernetes API types (pricing, node templates and per node group options) are not implemented,
+// so the node groups can't be scaled up from zero nodes.
+type Provider struct {
+	autoscaler.UnimplementedCloudProviderServer
+
+	state  state.State
+	logger *zap.Logger
+}
+
+// NewProvider creates a new Provider.
+func NewProvider(st state.State, logger *zap.Logger) (*Provider, error) {
+	if st == nil {
+		return nil, errors.New("state is nil")
+	}
+
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	return &Provider{
+		state:  st,
+		logger: logger,
+	}, nil
+}
+
+// NodeGroups implements autoscaler.CloudProviderServer.
+func (p *Provider) NodeGroups(ctx context.Context, _ *autoscaler.NodeGroupsRequest) (*autoscaler.NodeGroupsResponse, error) {
+	ctx, clusterID, err := p.clusterContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	machineSets, err := safe.StateListAll[*omni.MachineSet](ctx, p.state, state.WithLabelQuery(
+		resource.LabelEqual(omni.LabelCluster, clusterID),
+		resource.LabelExists(omni.LabelWorkerRole),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &autoscaler.NodeGroupsResponse{}
+
+	for machineSet := range machineSets.All() {
+		if autoscalingBounds(machineSet) == nil {
+			continue
+		}
+
+		resp.NodeGroups = append(resp.NodeGroups, nodeGroup(machineSet))
+	}
+
+	return resp, nil
+}
+
+// NodeGroupForNode implements autoscaler.CloudProviderServer.
+func (p *Provider) NodeGroupForNode(ctx context.Context, req *autoscaler.NodeGroupForNodeRequest) (*autoscaler.NodeGroupForNodeResponse, error) {
+	ctx, clusterID, err := p.clusterContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	machineSetNode, err := p.machineSetNodeForNode(ctx, clusterID, req.GetNode())
+	if err != nil {
+		return nil, err
+	}
+
+	// the nodes outside of the autoscaled machine sets are reported with an empty node group
+	resp := &autoscaler.NodeGroupForNodeResponse{
+		NodeGroup: &autoscaler.NodeGroup{},
+	}
+
+	if machineSetNode == nil {
+		return resp, nil
+	}
+
+	machineSetID, _ := machineSetNode.Metadata().Labels().Get(omni.LabelMachineSet)
+
+	machineSet, err := safe.StateGetByID[*omni.MachineSet](ctx, p.state, machineSetID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return resp, nil
+		}
+
+		return nil, err
+	}
+
+	if isNodeGroup(machineSet, clusterID) {
+		resp.NodeGroup = nodeGroup(machineSet)
+	}
+
+	return resp, nil
+}
+
+// NodeGroupTargetSize implements autoscaler.CloudProviderServer.
+func (p *Provider) NodeGroupTargetSize(ctx context.Context, req *autoscaler.NodeGroupTargetSizeRequest) (*autoscaler.NodeGroupTargetSizeResponse, error) {
+	ctx, clusterID, err := p.clusterContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	machineSet, err := p.getNodeGroup(ctx, clusterID, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &autoscaler.NodeGroupTargetSizeResponse{
+		TargetSize: int32(omni.GetMachineAllocation(machineSet).GetMachineCount()),
+	}, nil
+}
+
+// NodeGroupIncreaseSize implements autoscaler.CloudProviderServer.
+func (p *Provider) NodeGroupIncreaseSize(ctx context.Context, req *autoscaler.NodeGroupIncreaseSizeRequest) (*autoscaler.NodeGroupIncreaseSizeResponse, error) {
+	ctx, clusterID, err := p.clusterContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetDelta() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "size increase must be positive")
+	}
+
+	if err = p.updateMachineCount(ctx, clusterID, req.GetId(), func(count, _, maxCount uint32) (uint32, error) {
+		newCount := int64(count) + int64(req.GetDelta())
+		if newCount > int64(maxCount) {
+			return 0, status.Errorf(codes.InvalidArgument, "size increase too large: desired %d, max %d", newCount, maxCount)
+		}
+
+		return uint32(newCount), nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &autoscaler.NodeGroupIncreaseSizeResponse{}, nil
+}
+
+// NodeGroupDecreaseTargetSize implements autoscaler.CloudProviderServer.
+//
+// It only gives up the machines which are not allocated to the machine set yet, e.g. the ones still being provisioned.
+func (p *Provider) NodeGroupDecreaseTargetSize(ctx context.Context, req *autoscaler.NodeGroupDecreaseTargetSizeRequest) (*autoscaler.NodeGroupDecreaseTargetSizeResponse, error) {
+	ctx, clusterID, err := p.clusterContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetDelta() >= 0 {
+		return nil, status.Error(codes.InvalidArgument, "size decrease must be negative")
+	}
+
+	machineSetNodes, err := safe.StateListAll[*omni.MachineSetNode](ctx, p.state, state.WithLabelQuery(resource.LabelEqual(omni.LabelMachineSet, req.GetId())))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.updateMachineCount(ctx, clusterID, req.GetId(), func(count, minCount, _ uint32) (uint32, error) {
+		newCount := int64(count) + int64(req.GetDelta())
+		if newCount < int64(minCount) {
+			return 0, status.Errorf(codes.InvalidArgument, "size decrease too large: desired %d, min %d", newCount, minCount)
+		}
+
+		if newCount < int64(machineSetNodes.Len()) {
+			return 0, status.Errorf(codes.FailedPrecondition, "attempt to delete existing nodes: desired %d, existing %d", newCount, machineSetNodes.Len())
+		}
+
+		return uint32(newCount), nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &autoscaler.NodeGroupDecreaseTargetSizeResponse{}, nil
+}
+
+// NodeGroupDeleteNodes implements autoscaler.CloudProviderServer.
+//
+// The machine set nodes of the nodes are torn down before the machine count is decreased, so that the machine set
+// doesn't pick other machines to remove. They are held with a finalizer until the machine count is decreased, so that
+// the machine set doesn't allocate the replacement machines for them in between.
+// The nodes still held by the finalizer are picked up again on retry, if the machine count failed to be decreased.
+func (p *Provider) NodeGroupDeleteNodes(ctx context.Context, req *autoscaler.NodeGroupDeleteNodesRequest) (*autoscaler.NodeGroupDeleteNodesResponse, error) {
+	ctx, clusterID, err := p.clusterContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	machineSet, err := p.getNodeGroup(ctx, clusterID, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.checkClusterNotLocked(ctx, clusterID); err != nil {
+		return nil, err
+	}
+
+	var toDelete []*omni.MachineSetNode
+
+	for _, node := range req.GetNodes() {
+		machineSetNode, nodeErr := p.machineSetNodeForNode(ctx, clusterID, node)
+		if nodeErr != nil {
+			return nil, nodeErr
+		}
+
+		if machineSetNode == nil {
+			return nil, status.Errorf(codes.NotFound, "node %q is not found in the cluster", node.GetName())
+		}
+
+		if machineSetID, _ := machineSetNode.Metadata().Labels().Get(omni.LabelMachineSet); machineSetID != machineSet.Metadata().ID() {
+			return nil, status.Errorf(codes.InvalidArgument, "node %q doesn't belong to the node group %q", node.GetName(), req.GetId())
+		}
+
+		if machineSetNode.Metadata().Phase() == resource.PhaseTearingDown && !machineSetNode.Metadata().Finalizers().Has(deleteNodesFinalizer) {
+			continue
+		}
+
+		toDelete = append(toDelete, machineSetNode)
+	}
+
+	if len(toDelete) == 0 {
+		return &autoscaler.NodeGroupDeleteNodesResponse{}, nil
+	}
+
+	bounds := autoscalingBounds(machineSet)
+	count := omni.GetMachineAllocation(machineSet).GetMachineCount()
+
+	if int64(count)-int64(len(toDelete)) < int64(bounds.GetMinMachineCount()) {
+		return nil, status.Errorf(codes.InvalidArgument, "deleting %d nodes would shrink the node group %q below its min size %d", len(toDelete), req.GetId(),
+			bounds.GetMinMachineCount())
+	}
+
+	for _, machineSetNode := range toDelete {
+		if err = p.state.AddFinalizer(ctx, machineSetNode.Metadata(), deleteNodesFinalizer); err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		if _, err = p.state.Teardown(ctx, machineSetNode.Metadata()); err != nil && !state.IsNotFoundError(err) {
+			return nil, err
+		}
+
+		p.logger.Info("removing node", zap.String("machine_set", req.GetId()), zap.String("machine", machineSetNode.Metadata().ID()))
+	}
+
+	if err = p.updateMachineCount(ctx, clusterID, req.GetId(), func(count, _, _ uint32) (uint32, error) {
+		return uint32(max(int64(count)-int64(len(toDelete)), 0)), nil
+	}); err != nil {
+		return nil, err
+	}
+
+	for _, machineSetNode := range toDelete {
+		if err = p.state.RemoveFinalizer(ctx, machineSetNode.Metadata(), deleteNodesFinalizer); err != nil && !state.IsNotFoundError(err) {
+			return nil, err
+		}
+	}
+
+	return &autoscaler.NodeGroupDeleteNodesResponse{}, nil
+}
+
+// NodeGroupNodes implements autoscaler.CloudProviderServer.
+func (p *Provider) NodeGroupNodes(ctx context.Context, req *autoscaler.NodeGroupNodesRequest) (*autoscaler.NodeGroupNodesResponse, error) {
+	ctx, clusterID, err := p.clusterContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = p.getNodeGroup(ctx, clusterID, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	machineSetNodes, err := safe.StateListAll[*omni.MachineSetNode](ctx, p.state, state.WithLabelQuery(resource.LabelEqual(omni.LabelMachineSet, req.GetId())))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &autoscaler.NodeGroupNodesResponse{
+		Instances: make([]*autoscaler.Instance, 0, machineSetNodes.Len()),
+	}
+
+	for machineSetNode := range machineSetNodes.All() {
+		instanceState, stateErr := p.instanceState(ctx, machineSetNode)
+		if stateErr != nil {
+			return nil, stateErr
+		}
+
+		resp.Instances = append(resp.Instances, &autoscaler.Instance{
+			Id: constants.ClusterAutoscalerProviderIDPrefix + machineSetNode.Metadata().ID(),
+			Status: &autoscaler.InstanceStatus{
+				InstanceState: instanceState,
+			},
+		})
+	}
+
+	return resp, nil
+}
+
+// instanceState returns the state of the instance of the machine set node: it is running once its machine is running in the cluster.
+func (p *Provider) instanceState(ctx context.Context, machineSetNode *omni.MachineSetNode) (autoscaler.InstanceStatus_InstanceState, error) {
+	if machineSetNode.Metadata().Phase() == resource.PhaseTearingDown {
+		return autoscaler.InstanceStatus_instanceDeleting, nil
+	}
+
+	clusterMachineStatus, err := safe.StateGetByID[*omni.ClusterMachineStatus](ctx, p.state, machineSetNode.Metadata().ID())
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return autoscaler.InstanceStatus_instanceCreating, nil
+		}
+
+		return autoscaler.InstanceStatus_unspecified, err
+	}
+
+	if clusterMachineStatus.TypedSpec().Value.Stage == specs.ClusterMachineStatusSpec_RUNNING {
+		return autoscaler.InstanceStatus_instanceRunning, nil
+	}
+
+	return autoscaler.InstanceStatus_instanceCreating, nil
+}
+
+// GPULabel implements autoscaler.CloudProviderServer.
+func (p *Provider) GPULabel(context.Context, *autoscaler.GPULabelRequest) (*autoscaler.GPULabelResponse, error) {
+	return &autoscaler.GPULabelResponse{}, nil
+}
+
+// GetAvailableGPUTypes implements autoscaler.CloudProviderServer.
+func (p *Provider) GetAvailableGPUTypes(context.Context, *autoscaler.GetAvailableGPUTypesRequest) (*autoscaler.GetAvailableGPUTypesResponse, error) {
+	return &autoscaler.GetAvailableGPUTypesResponse{}, nil
+}
+
+// Cleanup implements autoscaler.CloudProviderServer.
+func (p *Provider) Cleanup(context.Context, *autoscaler.CleanupRequest) (*autoscaler.CleanupResponse, error) {
+	return &autoscaler.CleanupResponse{}, nil
+}
+
+// Refresh implements autoscaler.CloudProviderServer.
+//
+// The machine sets are read from the state on each call, so there is nothing to refresh.
+func (p *Provider) Refresh(context.Context, *autoscaler.RefreshRequest) (*autoscaler.RefreshResponse, error) {
+	return &autoscaler.RefreshResponse{}, nil
+}
+
+// clusterContext returns the cluster the client is authorized for, and the context to access the state with.
+func (p *Provider) clusterContext(ctx context.Context) (context.Context, resource.ID, error) {
+	val, ok := ctxstore.Value[clusterContextKey](ctx)
+	if !ok || val.Cluster == "" {
+		return nil, "", status.Error(codes.Unauthenticated, "the client is not authorized for any cluster")
+	}
+
+	return actor.MarkContextAsInternalActor(ctx), val.Cluster, nil
+}
+
+// getNodeGroup returns the machine set of the node group, checking that it is an autoscaled worker machine set of the cluster.
+func (p *Provider) getNodeGroup(ctx context.Context, clusterID, id resource.ID) (*omni.MachineSet, error) {
+	machineSet, err := safe.StateGetByID[*omni.MachineSet](ctx, p.state, id)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	if machineSet == nil || !isNodeGroup(machineSet, clusterID) {
+		return nil, status.Errorf(codes.NotFound, "node group %q is not found", id)
+	}
+
+	return machineSet, nil
+}
+
+// updateMachineCount updates the machine count of the node group with the value returned by the update function,
+// which gets the current machine count and the autoscaling bounds of the node group.
+func (p *Provider) updateMachineCount(ctx context.Context, clusterID, id resource.ID, update func(count, minCount, maxCount uint32) (uint32, error)) error {
+	if err := p.checkClusterNotLocked(ctx, clusterID); err != nil {
+		return err
+	}
+
+	if _, err := p.getNodeGroup(ctx, clusterID, id); err != nil {
+		return err
+	}
+
+	machineSet, err := safe.StateUpdateWithConflicts(ctx, p.state, omni.NewMachineSet(id).Metadata(), func(machineSet *omni.MachineSet) error {
+		if !isNodeGroup(machineSet, clusterID) {
+			return status.Errorf(codes.NotFound, "node group %q is not found", id)
+		}
+
+		allocation := omni.GetMachineAllocation(machineSet)
+		bounds := allocation.GetAutoscaling()
+
+		count, err := update(allocation.GetMachineCount(), bounds.GetMinMachineCount(), bounds.GetMaxMachineCount())
+		if err != nil {
+			return err
+		}
+
+		allocation.MachineCount = count
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	p.logger.Info("scaled node group", zap.String("machine_set", id), zap.Uint32("machine_count", omni.GetMachineAllocation(machineSet).GetMachineCount()))
+
+	return nil
+}
+
+// checkClusterNotLocked checks that the cluster isn't locked, as the machine sets of the locked clusters are not reconciled.
+func (p *Provider) checkClusterNotLocked(ctx context.Context, clusterID resource.ID) error {
+	cluster, err := safe.StateGetByID[*omni.Cluster](ctx, p.state, clusterID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return status.Errorf(codes.NotFound, "cluster %q is not found", clusterID)
+		}
+
+		return err
+	}
+
+	if _, locked := cluster.Metadata().Annotations().Get(omni.ClusterLocked); locked {
+		return status.Errorf(codes.FailedPrecondition, "cluster %q is locked", clusterID)
+	}
+
+	return nil
+}
+
+// machineSetNodeForNode returns the machine set node of the Kubernetes node, or nil if the node is not a machine of the cluster.
+//
+// The node is looked up by its provider ID, which is set on the nodes of the autoscaled machine sets, and by its name otherwise.
+func (p *Provider) machineSetNodeForNode(ctx context.Context, clusterID resource.ID, node *autoscaler.ExternalGrpcNode) (*omni.MachineSetNode, error) {
+	machineID, ok := strings.CutPrefix(node.GetProviderID(), constants.ClusterAutoscalerProviderIDPrefix)
+	if !ok || machineID == "" {
+		if node.GetName() == "" {
+			return nil, nil //nolint:nilnil
+		}
+
+		identities, err := safe.StateListAll[*omni.ClusterMachineIdentity](ctx, p.state, state.WithLabelQuery(resource.LabelEqual(omni.LabelCluster, clusterID)))
+		if err != nil {
+			return nil, err
+		}
+
+		identity, found := identities.Find(func(identity *omni.ClusterMachineIdentity) bool {
+			return identity.TypedSpec().Value.Nodename == node.GetName()
+		})
+		if !found {
+			return nil, nil //nolint:nilnil
+		}
+
+		machineID = identity.Metadata().ID()
+	}
+
+	machineSetNode, err := safe.StateGetByID[*omni.MachineSetNode](ctx, p.state, machineID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, nil //nolint:nilnil
+		}
+
+		return nil, err
+	}
+
+	if nodeCluster, _ := machineSetNode.Metadata().Labels().Get(omni.LabelCluster); nodeCluster != clusterID {
+		return nil, nil //nolint:nilnil
+	}
+
+	return machineSetNode, nil
+}
+
+// isNodeGroup returns true if the machine set is an autoscaled worker machine set of the cluster.
+func isNodeGroup(machineSet *omni.MachineSet, clusterID resource.ID) bool {
+	if cluster, _ := machineSet.Metadata().Labels().Get(omni.LabelCluster); cluster != clusterID {
+		return false
+	}
+
+	if _, isWorker := machineSet.Metadata().Labels().Get(omni.LabelWorkerRole); !isWorker {
+		return false
+	}
+
+	return autoscalingBounds(machineSet) != nil
+}
+
+// autoscalingBounds returns the autoscaling bounds of the machine set, or nil if it is not autoscaled.
+func autoscalingBounds(machineSet *omni.MachineSet) *specs.MachineSetSpec_MachineAllocation_Autoscaling {
+	allocation := omni.GetMachineAllocation(machineSet)
+	if allocation == nil || allocation.AllocationType != specs.MachineSetSpec_MachineAllocation_Static {
+		return nil
+	}
+
+	return allocation.GetAutoscaling()
+}
+
+func nodeGroup(machineSet *omni.MachineSet) *autoscaler.NodeGroup {
+	allocation := omni.GetMachineAllocation(machineSet)
+	bounds := allocation.GetAutoscaling()
+
+	return &autoscaler.NodeGroup{
+		Id:      machineSet.Metadata().ID(),
+		MinSize: int32(bounds.GetMinMachineCount()),
+		MaxSize: int32(bounds.GetMaxMachineCount()),
+		Debug: fmt.Sprintf("machine set %q of the machine class %q, target size %d, bounds [%d, %d]",
+			machineSet.Metadata().ID(), allocation.GetName(), allocation.GetMachineCount(), bounds.GetMinMachineCount(), bounds.GetMaxMachineCount()),
+	}
+}
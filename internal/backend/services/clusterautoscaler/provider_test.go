@@ -0,0 +1,336 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package clusterautoscaler_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller/generic/destroy"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/siderolabs/gen/optional"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/autoscaler"
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/constants"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	omnictrl "github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/controllers/testutils"
+	"github.com/siderolabs/omni/internal/backend/services/clusterautoscaler"
+)
+
+func TestProvider(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	cluster := omni.NewCluster("test-cluster")
+
+	require.NoError(t, st.Create(ctx, cluster))
+
+	workers := omni.NewMachineSet(omni.WorkersResourceID(cluster.Metadata().ID()))
+	workers.Metadata().Labels().Set(omni.LabelCluster, cluster.Metadata().ID())
+	workers.Metadata().Labels().Set(omni.LabelWorkerRole, "")
+	workers.TypedSpec().Value.MachineAllocation = &specs.MachineSetSpec_MachineAllocation{
+		Name:         "workers",
+		MachineCount: 2,
+		Autoscaling: &specs.MachineSetSpec_MachineAllocation_Autoscaling{
+			MinMachineCount: 1,
+			MaxMachineCount: 3,
+		},
+	}
+
+	require.NoError(t, st.Create(ctx, workers))
+
+	// the worker machine sets without the bounds are not node groups
+	static := omni.NewMachineSet(cluster.Metadata().ID() + "-static")
+	static.Metadata().Labels().Set(omni.LabelCluster, cluster.Metadata().ID())
+	static.Metadata().Labels().Set(omni.LabelWorkerRole, "")
+	static.TypedSpec().Value.MachineAllocation = &specs.MachineSetSpec_MachineAllocation{
+		Name:         "workers",
+		MachineCount: 1,
+	}
+
+	require.NoError(t, st.Create(ctx, static))
+
+	for _, machineSetNode := range []*omni.MachineSetNode{
+		omni.NewMachineSetNode("machine-1", workers),
+		omni.NewMachineSetNode("machine-2", workers),
+		omni.NewMachineSetNode("machine-3", static),
+	} {
+		require.NoError(t, st.Create(ctx, machineSetNode))
+	}
+
+	clusterMachineStatus := omni.NewClusterMachineStatus("machine-1")
+	clusterMachineStatus.TypedSpec().Value.Stage = specs.ClusterMachineStatusSpec_RUNNING
+
+	require.NoError(t, st.Create(ctx, clusterMachineStatus))
+
+	for id, nodename := range map[resource.ID]string{"machine-2": "worker-2", "machine-3": "worker-3"} {
+		identity := omni.NewClusterMachineIdentity(id)
+		identity.Metadata().Labels().Set(omni.LabelCluster, cluster.Metadata().ID())
+		identity.TypedSpec().Value.Nodename = nodename
+
+		require.NoError(t, st.Create(ctx, identity))
+	}
+
+	provider, err := clusterautoscaler.NewProvider(st, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	clusterCtx := clusterautoscaler.WithCluster(ctx, cluster.Metadata().ID())
+
+	machineCount := func() uint32 {
+		machineSet, getErr := safe.StateGetByID[*omni.MachineSet](ctx, st, workers.Metadata().ID())
+		require.NoError(t, getErr)
+
+		return machineSet.TypedSpec().Value.MachineAllocation.MachineCount
+	}
+
+	t.Run("node groups", func(t *testing.T) {
+		resp, err := provider.NodeGroups(clusterCtx, &autoscaler.NodeGroupsRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.NodeGroups, 1)
+
+		assert.Equal(t, workers.Metadata().ID(), resp.NodeGroups[0].Id)
+		assert.EqualValues(t, 1, resp.NodeGroups[0].MinSize)
+		assert.EqualValues(t, 3, resp.NodeGroups[0].MaxSize)
+
+		// the clients see only the cluster they are authorized for
+		resp, err = provider.NodeGroups(clusterautoscaler.WithCluster(ctx, "other-cluster"), &autoscaler.NodeGroupsRequest{})
+		require.NoError(t, err)
+		assert.Empty(t, resp.NodeGroups)
+
+		_, err = provider.NodeGroupTargetSize(clusterautoscaler.WithCluster(ctx, "other-cluster"), &autoscaler.NodeGroupTargetSizeRequest{Id: workers.Metadata().ID()})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		_, err = provider.NodeGroups(ctx, &autoscaler.NodeGroupsRequest{})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("node group for node", func(t *testing.T) {
+		for _, tt := range []struct {
+			node     *autoscaler.ExternalGrpcNode
+			expected string
+		}{
+			{node: &autoscaler.ExternalGrpcNode{ProviderID: "omni://machine-1", Name: "worker-1"}, expected: workers.Metadata().ID()},
+			{node: &autoscaler.ExternalGrpcNode{Name: "worker-2"}, expected: workers.Metadata().ID()},
+			{node: &autoscaler.ExternalGrpcNode{Name: "worker-3"}},
+			{node: &autoscaler.ExternalGrpcNode{ProviderID: "omni://unknown"}},
+		} {
+			resp, err := provider.NodeGroupForNode(clusterCtx, &autoscaler.NodeGroupForNodeRequest{Node: tt.node})
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, resp.NodeGroup.Id, "node %q", tt.node.Name)
+		}
+	})
+
+	t.Run("node group nodes", func(t *testing.T) {
+		resp, err := provider.NodeGroupNodes(clusterCtx, &autoscaler.NodeGroupNodesRequest{Id: workers.Metadata().ID()})
+		require.NoError(t, err)
+		require.Len(t, resp.Instances, 2)
+
+		assert.Equal(t, "omni://machine-1", resp.Instances[0].Id)
+		assert.Equal(t, autoscaler.InstanceStatus_instanceRunning, resp.Instances[0].Status.InstanceState)
+		assert.Equal(t, "omni://machine-2", resp.Instances[1].Id)
+		assert.Equal(t, autoscaler.InstanceStatus_instanceCreating, resp.Instances[1].Status.InstanceState)
+
+		_, err = provider.NodeGroupNodes(clusterCtx, &autoscaler.NodeGroupNodesRequest{Id: static.Metadata().ID()})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("resize", func(t *testing.T) {
+		_, err := provider.NodeGroupIncreaseSize(clusterCtx, &autoscaler.NodeGroupIncreaseSizeRequest{Id: workers.Metadata().ID(), Delta: 1})
+		require.NoError(t, err)
+		assert.EqualValues(t, 3, machineCount())
+
+		_, err = provider.NodeGroupIncreaseSize(clusterCtx, &autoscaler.NodeGroupIncreaseSizeRequest{Id: workers.Metadata().ID(), Delta: 1})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		resp, err := provider.NodeGroupTargetSize(clusterCtx, &autoscaler.NodeGroupTargetSizeRequest{Id: workers.Metadata().ID()})
+		require.NoError(t, err)
+		assert.EqualValues(t, 3, resp.TargetSize)
+
+		_, err = provider.NodeGroupDecreaseTargetSize(clusterCtx, &autoscaler.NodeGroupDecreaseTargetSizeRequest{Id: workers.Metadata().ID(), Delta: -1})
+		require.NoError(t, err)
+		assert.EqualValues(t, 2, machineCount())
+
+		// decreasing the target size never removes the existing nodes
+		_, err = provider.NodeGroupDecreaseTargetSize(clusterCtx, &autoscaler.NodeGroupDecreaseTargetSizeRequest{Id: workers.Metadata().ID(), Delta: -1})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.EqualValues(t, 2, machineCount())
+	})
+
+	t.Run("delete nodes", func(t *testing.T) {
+		_, err := provider.NodeGroupDeleteNodes(clusterCtx, &autoscaler.NodeGroupDeleteNodesRequest{
+			Id:    workers.Metadata().ID(),
+			Nodes: []*autoscaler.ExternalGrpcNode{{Name: "worker-3"}},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = provider.NodeGroupDeleteNodes(clusterCtx, &autoscaler.NodeGroupDeleteNodesRequest{
+			Id:    workers.Metadata().ID(),
+			Nodes: []*autoscaler.ExternalGrpcNode{{ProviderID: "omni://machine-2", Name: "worker-2"}},
+		})
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, machineCount())
+
+		machineSetNode, err := safe.StateGetByID[*omni.MachineSetNode](ctx, st, "machine-2")
+		require.NoError(t, err)
+		assert.Equal(t, resource.PhaseTearingDown, machineSetNode.Metadata().Phase())
+
+		resp, err := provider.NodeGroupNodes(clusterCtx, &autoscaler.NodeGroupNodesRequest{Id: workers.Metadata().ID()})
+		require.NoError(t, err)
+		require.Len(t, resp.Instances, 2)
+		assert.Equal(t, autoscaler.InstanceStatus_instanceDeleting, resp.Instances[1].Status.InstanceState)
+
+		// the node group doesn't shrink below its min size
+		_, err = provider.NodeGroupDeleteNodes(clusterCtx, &autoscaler.NodeGroupDeleteNodesRequest{
+			Id:    workers.Metadata().ID(),
+			Nodes: []*autoscaler.ExternalGrpcNode{{ProviderID: "omni://machine-1"}},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.EqualValues(t, 1, machineCount())
+	})
+
+	t.Run("locked cluster", func(t *testing.T) {
+		_, err := safe.StateUpdateWithConflicts(ctx, st, cluster.Metadata(), func(res *omni.Cluster) error {
+			res.Metadata().Annotations().Set(omni.ClusterLocked, "")
+
+			return nil
+		})
+		require.NoError(t, err)
+
+		_, err = provider.NodeGroupIncreaseSize(clusterCtx, &autoscaler.NodeGroupIncreaseSizeRequest{Id: workers.Metadata().ID(), Delta: 1})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.EqualValues(t, 1, machineCount())
+	})
+}
+
+// slowTeardownState delays the teardowns, so that the controllers react to the changes made before each of them.
+type slowTeardownState struct {
+	state.State
+}
+
+func (st slowTeardownState) Teardown(ctx context.Context, ptr resource.Pointer, opts ...state.TeardownOption) (bool, error) {
+	select {
+	case <-ctx.Done():
+		return false, ctx.Err()
+	case <-time.After(500 * time.Millisecond):
+	}
+
+	return st.State.Teardown(ctx, ptr, opts...)
+}
+
+func TestDeleteNodesWithMachineSetNodeController(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), 30*time.Second)
+	t.Cleanup(cancel)
+
+	testutils.WithRuntime(
+		ctx, t, testutils.TestOptions{},
+		func(_ context.Context, tc testutils.TestContext) {
+			require.NoError(t, tc.Runtime.RegisterQController(omnictrl.NewMachineSetNodeController()))
+			require.NoError(t, tc.Runtime.RegisterQController(omnictrl.NewLabelsExtractorController[*omni.MachineStatus]()))
+			require.NoError(t, tc.Runtime.RegisterQController(destroy.NewController[*omni.MachineSetNode](optional.Some[uint](4))))
+		},
+		func(ctx context.Context, tc testutils.TestContext) {
+			cluster := omni.NewCluster("test-cluster")
+			cluster.TypedSpec().Value.TalosVersion = "1.6.0"
+
+			require.NoError(t, tc.State.Create(ctx, cluster))
+
+			// one machine more than the node group needs, so that the replacement machines can be allocated
+			for _, id := range []resource.ID{"machine-1", "machine-2", "machine-3", "machine-4"} {
+				machineStatus := omni.NewMachineStatus(id)
+				machineStatus.TypedSpec().Value.TalosVersion = "v1.6.0"
+				machineStatus.Metadata().Labels().Set(omni.MachineStatusLabelTalosVersion, "v1.6.0")
+				machineStatus.Metadata().Labels().Set(omni.MachineStatusLabelArch, "amd64")
+				machineStatus.Metadata().Labels().Set(omni.MachineStatusLabelAvailable, "")
+				machineStatus.Metadata().Labels().Set(omni.MachineStatusLabelConnected, "")
+				machineStatus.Metadata().Labels().Set(omni.MachineStatusLabelReadyToUse, "")
+				machineStatus.Metadata().Labels().Set(omni.MachineStatusLabelReportingEvents, "")
+
+				require.NoError(t, tc.State.Create(ctx, machineStatus))
+				require.NoError(t, tc.State.Create(ctx, omni.NewMachine(id)))
+			}
+
+			machineClass := omni.NewMachineClass("workers")
+			machineClass.TypedSpec().Value.MatchLabels = []string{omni.MachineStatusLabelArch + "==amd64"}
+
+			require.NoError(t, tc.State.Create(ctx, machineClass))
+
+			workers := omni.NewMachineSet(omni.WorkersResourceID(cluster.Metadata().ID()))
+			workers.Metadata().Labels().Set(omni.LabelCluster, cluster.Metadata().ID())
+			workers.Metadata().Labels().Set(omni.LabelWorkerRole, "")
+			workers.TypedSpec().Value.MachineAllocation = &specs.MachineSetSpec_MachineAllocation{
+				Name:         machineClass.Metadata().ID(),
+				MachineCount: 3,
+				Autoscaling: &specs.MachineSetSpec_MachineAllocation_Autoscaling{
+					MinMachineCount: 1,
+					MaxMachineCount: 4,
+				},
+			}
+
+			require.NoError(t, tc.State.Create(ctx, workers))
+
+			listMachineSetNodes := func() []*omni.MachineSetNode {
+				machineSetNodes, err := safe.StateListAll[*omni.MachineSetNode](ctx, tc.State)
+				require.NoError(t, err)
+
+				return slices.Collect(machineSetNodes.All())
+			}
+
+			require.EventuallyWithT(t, func(collect *assert.CollectT) {
+				assert.Len(collect, listMachineSetNodes(), 3)
+			}, 10*time.Second, 100*time.Millisecond)
+
+			// the machine set removes the oldest nodes first when it scales down, so delete the others
+			machineSetNodes := listMachineSetNodes()
+			slices.SortStableFunc(machineSetNodes, func(a, b *omni.MachineSetNode) int {
+				return a.Metadata().Created().Compare(b.Metadata().Created())
+			})
+
+			kept := machineSetNodes[0].Metadata().ID()
+
+			provider, err := clusterautoscaler.NewProvider(slowTeardownState{State: tc.State}, zaptest.NewLogger(t))
+			require.NoError(t, err)
+
+			_, err = provider.NodeGroupDeleteNodes(clusterautoscaler.WithCluster(ctx, cluster.Metadata().ID()), &autoscaler.NodeGroupDeleteNodesRequest{
+				Id: workers.Metadata().ID(),
+				Nodes: []*autoscaler.ExternalGrpcNode{
+					{ProviderID: constants.ClusterAutoscalerProviderIDPrefix + machineSetNodes[1].Metadata().ID()},
+					{ProviderID: constants.ClusterAutoscalerProviderIDPrefix + machineSetNodes[2].Metadata().ID()},
+				},
+			})
+			require.NoError(t, err)
+
+			rtestutils.AssertNoResource[*omni.MachineSetNode](ctx, t, tc.State, machineSetNodes[1].Metadata().ID())
+			rtestutils.AssertNoResource[*omni.MachineSetNode](ctx, t, tc.State, machineSetNodes[2].Metadata().ID())
+
+			// only the deleted nodes are removed, and no replacement machines are allocated for them
+			remaining := listMachineSetNodes()
+			require.Len(t, remaining, 1)
+			assert.Equal(t, kept, remaining[0].Metadata().ID())
+			assert.Equal(t, resource.PhaseRunning, remaining[0].Metadata().Phase())
+
+			machineSet, err := safe.StateGetByID[*omni.MachineSet](ctx, tc.State, workers.Metadata().ID())
+			require.NoError(t, err)
+			assert.EqualValues(t, 1, machineSet.TypedSpec().Value.MachineAllocation.MachineCount)
+		},
+	)
+}
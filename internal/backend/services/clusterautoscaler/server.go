@@ -0,0 +1,176 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package clusterautoscaler
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware/v2"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/omni/client/api/omni/autoscaler"
+	"github.com/siderolabs/omni/internal/pkg/ctxstore"
+	"github.com/siderolabs/omni/internal/pkg/errgroup"
+	"github.com/siderolabs/omni/internal/pkg/grpcutil"
+)
+
+// Server serves the cluster autoscaler cloud provider over TLS.
+//
+// The clients authenticate with the client certificates issued by Omni, each of them is limited to the cluster it was issued for.
+type Server struct {
+	provider  *Provider
+	validator *CredentialValidator
+	logger    *zap.Logger
+
+	endpoint string
+	certFile string
+	keyFile  string
+
+	certMu      sync.Mutex
+	cert        *tls.Certificate
+	certModTime time.Time
+}
+
+// NewServer creates a new Server listening on the endpoint, serving the TLS connections with the given certificate.
+func NewServer(provider *Provider, validator *CredentialValidator, endpoint, certFile, keyFile string, logger *zap.Logger) (*Server, error) {
+	if provider == nil {
+		return nil, errors.New("provider is nil")
+	}
+
+	if validator == nil {
+		return nil, errors.New("validator is nil")
+	}
+
+	if certFile == "" || keyFile == "" {
+		return nil, errors.New("the TLS certificate is required")
+	}
+
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	return &Server{
+		provider:  provider,
+		validator: validator,
+		logger:    logger,
+		endpoint:  endpoint,
+		certFile:  certFile,
+		keyFile:   keyFile,
+	}, nil
+}
+
+// Run runs the server until the context is canceled.
+func (s *Server) Run(ctx context.Context) error {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ClientAuth: tls.RequireAnyClientCert,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return s.certificate()
+		},
+	}
+
+	listener, err := (&net.ListenConfig{}).Listen(ctx, "tcp", s.endpoint)
+	if err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
+	}
+
+	server := grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(tlsConfig)),
+		grpc.ChainUnaryInterceptor(s.unaryInterceptor),
+		grpc.ChainStreamInterceptor(s.streamInterceptor),
+	)
+
+	autoscaler.RegisterCloudProviderServer(server, s.provider)
+
+	s.logger.Info("cluster autoscaler server started", zap.String("endpoint", s.endpoint))
+
+	eg, ctx := errgroup.WithContext(ctx)
+
+	grpcutil.RunServer(ctx, server, listener, eg, s.logger)
+
+	return eg.Wait()
+}
+
+func (s *Server) unaryInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, err := s.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func (s *Server) streamInterceptor(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := s.authenticate(ss.Context())
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &grpc_middleware.WrappedServerStream{
+		ServerStream:   ss,
+		WrappedContext: ctx,
+	})
+}
+
+// authenticate validates the client certificate of the request, and puts the cluster it was issued for into the context.
+//
+// The certificate is validated on each request, so that the access is revoked as soon as its holder loses the access to the cluster.
+func (s *Server) authenticate(ctx context.Context) (context.Context, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "no peer information")
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.PeerCertificates) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "client certificate is required")
+	}
+
+	creds, err := s.validator.ValidateCertificate(ctx, tlsInfo.State.PeerCertificates[0])
+	if err != nil {
+		s.logger.Warn("cluster autoscaler request denied", zap.String("identity", creds.Identity), zap.Error(err))
+
+		return nil, status.Error(codes.PermissionDenied, "access denied")
+	}
+
+	return ctxstore.WithValue(ctx, clusterContextKey{Cluster: creds.Cluster}), nil
+}
+
+func (s *Server) certificate() (*tls.Certificate, error) {
+	info, err := os.Stat(s.certFile)
+	if err != nil {
+		return nil, err
+	}
+
+	s.certMu.Lock()
+	defer s.certMu.Unlock()
+
+	if s.cert != nil && info.ModTime().Equal(s.certModTime) {
+		return s.cert, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(s.certFile, s.keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the TLS certificate: %w", err)
+	}
+
+	s.cert = &cert
+	s.certModTime = info.ModTime()
+
+	return s.cert, nil
+}
@@ -79,50 +79,49 @@ func NewSignatureAccessValidator(state state.State, roleProvider RoleProvider, l
 	}, nil
 }
 
-// ValidateAccess validates the access to an exposed service in the given cluster ID,
-// using the PGP public keys in the Omni database.
-func (p *SignatureAccessValidator) ValidateAccess(ctx context.Context, publicKeyID, publicKeyIDSignatureBase64 string, clusterID resource.ID) error {
+// ValidateAccess validates the access to the exposed service with the given alias in the cluster,
+// using the PGP public keys in the Omni database, and returns the identity of the key.
+//
+// The identity is returned also when the access is denied after the signature was verified.
+func (p *SignatureAccessValidator) ValidateAccess(ctx context.Context, publicKeyID, publicKeyIDSignatureBase64 string, clusterID resource.ID, alias string) (string, error) {
 	singatureBytes, err := base64.StdEncoding.DecodeString(publicKeyIDSignatureBase64)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	ctx = actor.MarkContextAsInternalActor(ctx)
 
 	publicKey, err := safe.StateGet[*authres.PublicKey](ctx, p.state, authres.NewPublicKey(publicKeyID).Metadata())
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	if publicKey.TypedSpec().Value.Expiration.AsTime().Before(time.Now()) {
-		return fmt.Errorf("key is expired")
+		return "", fmt.Errorf("key is expired")
 	}
 
 	verifier, err := authres.GetSignatureVerifier(publicKey)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	if err = verifier.Verify([]byte(publicKeyID), singatureBytes); err != nil {
-		return err
+		return "", err
 	}
 
+	identity := publicKey.TypedSpec().Value.GetIdentity().GetEmail()
+
 	publicKeyRoleStr := publicKey.TypedSpec().Value.GetRole()
 	if publicKeyRoleStr != "" {
 		publicKeyRole, parseErr := role.Parse(publicKeyRoleStr)
 		if parseErr != nil {
-			return parseErr
+			return identity, parseErr
 		}
 
 		ctx = ctxstore.WithValue(ctx, auth.RoleContextKey{Role: publicKeyRole})
 	}
 
-	ctx = ctxstore.WithValue(ctx, auth.IdentityContextKey{Identity: publicKey.TypedSpec().Value.GetIdentity().GetEmail()})
-
-	accessRole, err := p.roleProvider.RoleForCluster(ctx, clusterID)
-	if err != nil {
-		return err
-	}
+	ctx = ctxstore.WithValue(ctx, auth.IdentityContextKey{Identity: identity})
 
-	return accessRole.Check(role.Reader)
+	return identity, checkServiceAccess(ctx, p.state, p.roleProvider, clusterID, alias, identity)
 }
@@ -63,13 +63,13 @@ func TestAccessValidator(t *testing.T) {
 
 	require.NoError(t, st.Create(ctx, publicKey))
 
-	err = accessValidator.ValidateAccess(ctx, publicKey.Metadata().ID(), base64.StdEncoding.EncodeToString([]byte("invalid-test-signature")), "test-cluster")
+	_, err = accessValidator.ValidateAccess(ctx, publicKey.Metadata().ID(), base64.StdEncoding.EncodeToString([]byte("invalid-test-signature")), "test-cluster", "test-alias")
 	require.Error(t, err)
 
 	signature, err := key.Sign([]byte(publicKey.Metadata().ID()))
 	require.NoError(t, err)
 
-	err = accessValidator.ValidateAccess(ctx, publicKey.Metadata().ID(), base64.StdEncoding.EncodeToString(signature), "test-cluster")
+	_, err = accessValidator.ValidateAccess(ctx, publicKey.Metadata().ID(), base64.StdEncoding.EncodeToString(signature), "test-cluster", "test-alias")
 	require.NoError(t, err)
 
 	require.Len(t, roleProvider.clusterIDs, 1)
@@ -79,6 +79,6 @@ func TestAccessValidator(t *testing.T) {
 
 	roleProvider.role = role.None
 
-	err = accessValidator.ValidateAccess(ctx, publicKey.Metadata().ID(), base64.StdEncoding.EncodeToString(signature), "test-cluster")
+	_, err = accessValidator.ValidateAccess(ctx, publicKey.Metadata().ID(), base64.StdEncoding.EncodeToString(signature), "test-cluster", "test-alias")
 	require.Error(t, err)
 }
@@ -0,0 +1,298 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package workloadproxy
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/zitadel/oidc/v3/pkg/op"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/pkg/access/role"
+	"github.com/siderolabs/omni/client/pkg/constants"
+	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+	"github.com/siderolabs/omni/internal/pkg/ctxstore"
+)
+
+const (
+	// TokenHeader is the header which carries the workload proxy token of a request to an exposed service.
+	//
+	// It is removed from the request before it is proxied to the service.
+	TokenHeader = constants.ExposedServiceTokenHeader
+
+	// MaxCredentialsTTL is the longest lifetime of the workload proxy tokens and client certificates.
+	MaxCredentialsTTL = 24 * time.Hour
+
+	tokenAudience = "omni-workload-proxy"
+
+	// certificateURIScheme is the scheme of the URI SAN of the client certificates, which names the exposed service as <scheme>://<cluster>/<alias>.
+	certificateURIScheme = "omni-workload-proxy"
+
+	// certificateClockSkew backdates the client certificates to tolerate the clock of the client being behind.
+	certificateClockSkew = time.Minute
+)
+
+// Credentials describe the holder of the workload proxy credentials and the exposed service they grant access to.
+type Credentials struct {
+	Expiration time.Time
+	Identity   string
+	Cluster    resource.ID
+	Alias      string
+}
+
+type tokenClaims struct {
+	Cluster string `json:"cluster"`
+	Alias   string `json:"alias"`
+
+	jwt.RegisteredClaims
+}
+
+// IssueToken issues a workload proxy token for the credentials, signed with the given key.
+func IssueToken(signingKey op.SigningKey, credentials Credentials) (string, error) {
+	signingMethod := jwt.GetSigningMethod(string(signingKey.SignatureAlgorithm()))
+	if signingMethod == nil {
+		return "", fmt.Errorf("unsupported signing algorithm %q", signingKey.SignatureAlgorithm())
+	}
+
+	token := jwt.NewWithClaims(signingMethod, tokenClaims{
+		Cluster: credentials.Cluster,
+		Alias:   credentials.Alias,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   credentials.Identity,
+			Audience:  jwt.ClaimStrings{tokenAudience},
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(credentials.Expiration),
+		},
+	})
+
+	token.Header["kid"] = signingKey.ID()
+
+	return token.SignedString(signingKey.Key())
+}
+
+// IssueCertificate issues a client certificate for the credentials to the key of the PEM encoded certificate signing request.
+//
+// The certificate is signed with the given key, and names the key in its authority key ID, so that it can be verified
+// with the public keys Omni keeps for the JWTs.
+func IssueCertificate(signingKey op.SigningKey, csrPEM []byte, credentials Credentials) ([]byte, error) {
+	block, _ := pem.Decode(csrPEM)
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return nil, errors.New("the certificate signing request is not PEM encoded")
+	}
+
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the certificate signing request: %w", err)
+	}
+
+	if err = csr.CheckSignature(); err != nil {
+		return nil, fmt.Errorf("invalid certificate signing request signature: %w", err)
+	}
+
+	signer, ok := signingKey.Key().(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported signing key type %T", signingKey.Key())
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate the serial number: %w", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      pkix.Name{CommonName: credentials.Identity},
+		URIs: []*url.URL{
+			{
+				Scheme: certificateURIScheme,
+				Host:   credentials.Cluster,
+				Path:   "/" + credentials.Alias,
+			},
+		},
+		NotBefore:   time.Now().Add(-certificateClockSkew),
+		NotAfter:    credentials.Expiration,
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	issuer := &x509.Certificate{
+		Subject:      pkix.Name{CommonName: tokenAudience},
+		SubjectKeyId: []byte(signingKey.ID()),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, issuer, csr.PublicKey, signer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the certificate: %w", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), nil
+}
+
+// KeyProvider returns the public key with the given ID to verify the workload proxy credentials with.
+type KeyProvider func(ctx context.Context, keyID string) (any, error)
+
+// CredentialValidator validates the workload proxy tokens and client certificates issued by Omni.
+//
+// The credentials name the exposed service they were issued for, and their holder is checked to still have access
+// to the cluster of the service each time they are used.
+type CredentialValidator struct {
+	state        state.State
+	roleProvider RoleProvider
+	keyProvider  KeyProvider
+	logger       *zap.Logger
+}
+
+// NewCredentialValidator creates a new CredentialValidator.
+func NewCredentialValidator(state state.State, roleProvider RoleProvider, keyProvider KeyProvider, logger *zap.Logger) (*CredentialValidator, error) {
+	if state == nil {
+		return nil, errors.New("state is nil")
+	}
+
+	if roleProvider == nil {
+		return nil, errors.New("role provider is nil")
+	}
+
+	if keyProvider == nil {
+		return nil, errors.New("key provider is nil")
+	}
+
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	return &CredentialValidator{
+		state:        state,
+		roleProvider: roleProvider,
+		keyProvider:  keyProvider,
+		logger:       logger,
+	}, nil
+}
+
+// ValidateToken validates the token for the exposed service with the given alias in the cluster, and returns the identity it was issued to.
+//
+// The identity is returned also when the access is denied after the token was verified.
+func (v *CredentialValidator) ValidateToken(ctx context.Context, token string, clusterID resource.ID, alias string) (string, error) {
+	var claims tokenClaims
+
+	if _, err := jwt.ParseWithClaims(token, &claims, func(token *jwt.Token) (any, error) {
+		keyID, ok := token.Header["kid"].(string)
+		if !ok {
+			return nil, errors.New("invalid token header")
+		}
+
+		return v.keyProvider(ctx, keyID)
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}),
+		jwt.WithAudience(tokenAudience),
+		jwt.WithExpirationRequired(),
+	); err != nil {
+		return "", fmt.Errorf("invalid token: %w", err)
+	}
+
+	credentials := Credentials{
+		Identity: claims.Subject,
+		Cluster:  claims.Cluster,
+		Alias:    claims.Alias,
+	}
+
+	return credentials.Identity, v.checkAccess(ctx, credentials, clusterID, alias)
+}
+
+// ValidateCertificate validates the client certificate for the exposed service with the given alias in the cluster, and returns the identity it was issued to.
+//
+// The identity is returned also when the access is denied after the certificate was verified.
+func (v *CredentialValidator) ValidateCertificate(ctx context.Context, cert *x509.Certificate, clusterID resource.ID, alias string) (string, error) {
+	now := time.Now()
+
+	if now.Before(cert.NotBefore) || now.After(cert.NotAfter) {
+		return "", errors.New("the certificate is expired or not yet valid")
+	}
+
+	if !slices.Contains(cert.ExtKeyUsage, x509.ExtKeyUsageClientAuth) {
+		return "", errors.New("the certificate is not a client certificate")
+	}
+
+	publicKey, err := v.keyProvider(ctx, string(cert.AuthorityKeyId))
+	if err != nil {
+		return "", fmt.Errorf("failed to get the issuer key of the certificate: %w", err)
+	}
+
+	if err = cert.CheckSignatureFrom(&x509.Certificate{PublicKey: publicKey}); err != nil {
+		return "", fmt.Errorf("invalid certificate signature: %w", err)
+	}
+
+	credentials := Credentials{
+		Identity: cert.Subject.CommonName,
+	}
+
+	for _, uri := range cert.URIs {
+		if uri.Scheme == certificateURIScheme {
+			credentials.Cluster = uri.Host
+			credentials.Alias = strings.TrimPrefix(uri.Path, "/")
+
+			break
+		}
+	}
+
+	return credentials.Identity, v.checkAccess(ctx, credentials, clusterID, alias)
+}
+
+// checkAccess checks that the credentials were issued for the exposed service, and that their holder still has access to it.
+func (v *CredentialValidator) checkAccess(ctx context.Context, credentials Credentials, clusterID resource.ID, alias string) error {
+	if credentials.Cluster != clusterID || credentials.Alias != alias {
+		return errors.New("the credentials were issued for another exposed service")
+	}
+
+	ctx, _, err := identityContext(ctx, v.state, credentials.Identity)
+	if err != nil {
+		return err
+	}
+
+	return checkServiceAccess(ctx, v.state, v.roleProvider, clusterID, alias, credentials.Identity)
+}
+
+// identityContext returns the context of the identity with the role of its user, which the access policies are evaluated for.
+func identityContext(ctx context.Context, st state.State, identityID string) (context.Context, *authres.Identity, error) {
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	identity, err := safe.StateGetByID[*authres.Identity](ctx, st, identityID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get the identity %q: %w", identityID, err)
+	}
+
+	user, err := safe.StateGetByID[*authres.User](ctx, st, identity.TypedSpec().Value.UserId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get the user of the identity %q: %w", identityID, err)
+	}
+
+	userRole, err := role.Parse(user.TypedSpec().Value.GetRole())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx = ctxstore.WithValue(ctx, auth.RoleContextKey{Role: userRole})
+	ctx = ctxstore.WithValue(ctx, auth.IdentityContextKey{Identity: identityID})
+
+	return ctx, identity, nil
+}
@@ -0,0 +1,265 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package workloadproxy_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/access/role"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/services/workloadproxy"
+)
+
+type testSigningKey struct {
+	key *rsa.PrivateKey
+	id  string
+}
+
+func (k *testSigningKey) SignatureAlgorithm() jose.SignatureAlgorithm { return jose.RS256 }
+
+func (k *testSigningKey) Key() any { return k.key }
+
+func (k *testSigningKey) ID() string { return k.id }
+
+func TestCredentialValidator(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	user := auth.NewUser("test-user")
+	user.TypedSpec().Value.Role = string(role.Reader)
+
+	require.NoError(t, st.Create(ctx, user))
+
+	identity := auth.NewIdentity("user@example.com")
+	identity.TypedSpec().Value.UserId = user.Metadata().ID()
+
+	require.NoError(t, st.Create(ctx, identity))
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	signingKey := &testSigningKey{key: rsaKey, id: "test-key-id"}
+
+	keyProvider := func(_ context.Context, keyID string) (any, error) {
+		if keyID != signingKey.id {
+			return nil, errors.New("key not found")
+		}
+
+		return &rsaKey.PublicKey, nil
+	}
+
+	roleProvider := &mockRoleProvider{role: role.Reader}
+
+	validator, err := workloadproxy.NewCredentialValidator(st, roleProvider, keyProvider, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	credentials := workloadproxy.Credentials{
+		Expiration: time.Now().Add(time.Hour),
+		Identity:   identity.Metadata().ID(),
+		Cluster:    "test-cluster",
+		Alias:      "test-alias",
+	}
+
+	t.Run("token", func(t *testing.T) {
+		token, err := workloadproxy.IssueToken(signingKey, credentials)
+		require.NoError(t, err)
+
+		validatedIdentity, err := validator.ValidateToken(ctx, token, "test-cluster", "test-alias")
+		require.NoError(t, err)
+		require.Equal(t, identity.Metadata().ID(), validatedIdentity)
+
+		_, err = validator.ValidateToken(ctx, token, "test-cluster", "other-alias")
+		require.ErrorContains(t, err, "another exposed service")
+
+		_, err = validator.ValidateToken(ctx, token+"x", "test-cluster", "test-alias")
+		require.Error(t, err)
+
+		expired := credentials
+		expired.Expiration = time.Now().Add(-time.Minute)
+
+		expiredToken, err := workloadproxy.IssueToken(signingKey, expired)
+		require.NoError(t, err)
+
+		_, err = validator.ValidateToken(ctx, expiredToken, "test-cluster", "test-alias")
+		require.Error(t, err)
+	})
+
+	t.Run("certificate", func(t *testing.T) {
+		clientKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{}, clientKey)
+		require.NoError(t, err)
+
+		_, err = workloadproxy.IssueCertificate(signingKey, []byte("not a csr"), credentials)
+		require.Error(t, err)
+
+		certPEM, err := workloadproxy.IssueCertificate(signingKey, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr}), credentials)
+		require.NoError(t, err)
+
+		block, _ := pem.Decode(certPEM)
+		require.NotNil(t, block)
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		require.NoError(t, err)
+
+		validatedIdentity, err := validator.ValidateCertificate(ctx, cert, "test-cluster", "test-alias")
+		require.NoError(t, err)
+		require.Equal(t, identity.Metadata().ID(), validatedIdentity)
+
+		_, err = validator.ValidateCertificate(ctx, cert, "other-cluster", "test-alias")
+		require.ErrorContains(t, err, "another exposed service")
+
+		otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		forgedPEM, err := workloadproxy.IssueCertificate(&testSigningKey{key: otherKey, id: signingKey.id},
+			pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr}), credentials)
+		require.NoError(t, err)
+
+		block, _ = pem.Decode(forgedPEM)
+		require.NotNil(t, block)
+
+		forged, err := x509.ParseCertificate(block.Bytes)
+		require.NoError(t, err)
+
+		_, err = validator.ValidateCertificate(ctx, forged, "test-cluster", "test-alias")
+		require.ErrorContains(t, err, "invalid certificate signature")
+	})
+
+	t.Run("access revoked", func(t *testing.T) {
+		roleProvider.role = role.None
+
+		t.Cleanup(func() { roleProvider.role = role.Reader })
+
+		token, err := workloadproxy.IssueToken(signingKey, credentials)
+		require.NoError(t, err)
+
+		_, err = validator.ValidateToken(ctx, token, "test-cluster", "test-alias")
+		require.Error(t, err)
+	})
+}
+
+func TestCredentialValidatorServiceAccess(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	user := auth.NewUser("test-user")
+	user.TypedSpec().Value.Role = string(role.Reader)
+
+	require.NoError(t, st.Create(ctx, user))
+
+	for _, id := range []string{"alice@example.com", "bob@example.com", "carol@example.com"} {
+		identity := auth.NewIdentity(id)
+		identity.TypedSpec().Value.UserId = user.Metadata().ID()
+
+		if id == "bob@example.com" {
+			identity.Metadata().Labels().Set(auth.SAMLLabelPrefix+"role/ops", "")
+		}
+
+		require.NoError(t, st.Create(ctx, identity))
+	}
+
+	exposedService := omni.NewExposedService("test-cluster/grafana.monitoring")
+	exposedService.Metadata().Labels().Set(omni.LabelCluster, "test-cluster")
+	exposedService.Metadata().Labels().Set(omni.LabelExposedServiceAlias, "grafana")
+	exposedService.TypedSpec().Value.Access = &specs.ExposedServiceSpec_Access{
+		Users:         []string{"alice@*"},
+		LabelSelector: auth.SAMLLabelPrefix + "role/ops",
+	}
+
+	require.NoError(t, st.Create(ctx, exposedService))
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	signingKey := &testSigningKey{key: rsaKey, id: "test-key-id"}
+
+	keyProvider := func(context.Context, string) (any, error) {
+		return &rsaKey.PublicKey, nil
+	}
+
+	roleProvider := &mockRoleProvider{role: role.Reader}
+
+	validator, err := workloadproxy.NewCredentialValidator(st, roleProvider, keyProvider, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	validate := func(identity, alias string) error {
+		token, issueErr := workloadproxy.IssueToken(signingKey, workloadproxy.Credentials{
+			Expiration: time.Now().Add(time.Hour),
+			Identity:   identity,
+			Cluster:    "test-cluster",
+			Alias:      alias,
+		})
+		require.NoError(t, issueErr)
+
+		validatedIdentity, validateErr := validator.ValidateToken(ctx, token, "test-cluster", alias)
+		require.Equal(t, identity, validatedIdentity)
+
+		return validateErr
+	}
+
+	require.NoError(t, validate("alice@example.com", "grafana"))
+	require.NoError(t, validate("bob@example.com", "grafana"))
+	require.ErrorContains(t, validate("carol@example.com", "grafana"), "is not allowed to access the exposed service")
+
+	// the services without the restrictions are open to every reader of the cluster
+	require.NoError(t, validate("carol@example.com", "prometheus"))
+
+	t.Run("min role", func(t *testing.T) {
+		exposedService.TypedSpec().Value.Access.MinRole = string(role.Operator)
+		require.NoError(t, st.Update(ctx, exposedService))
+
+		require.Error(t, validate("alice@example.com", "grafana"))
+
+		roleProvider.role = role.Operator
+
+		require.NoError(t, validate("alice@example.com", "grafana"))
+
+		exposedService.TypedSpec().Value.Access.MinRole = "invalid"
+		require.NoError(t, st.Update(ctx, exposedService))
+
+		require.ErrorContains(t, validate("alice@example.com", "grafana"), "invalid minimum role")
+	})
+
+	t.Run("access policy", func(t *testing.T) {
+		accessPolicy := auth.NewAccessPolicy()
+		accessPolicy.TypedSpec().Value.Rules = []*specs.AccessPolicyRule{
+			{
+				Users:           []string{"carol@example.com"},
+				Clusters:        []string{"test-cluster"},
+				ExposedServices: &specs.AccessPolicyRule_ExposedServices{Aliases: []string{"prom*"}},
+			},
+		}
+
+		require.NoError(t, st.Create(ctx, accessPolicy))
+
+		require.NoError(t, validate("carol@example.com", "prometheus"))
+		require.ErrorContains(t, validate("alice@example.com", "prometheus"), "is not allowed to access the exposed service")
+	})
+}
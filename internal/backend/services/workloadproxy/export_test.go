@@ -0,0 +1,35 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package workloadproxy
+
+import "github.com/cosi-project/runtime/pkg/resource"
+
+// ResolvePorts is exported for testing.
+//
+// The services map the IDs of the TCP services to their ports, and listening maps the ports to the IDs of the services listening on them.
+func ResolvePorts(services map[resource.ID]int, listening map[int]resource.ID) (map[int]resource.ID, map[int][]resource.ID) {
+	portServices := make(map[resource.ID]portService, len(services))
+
+	for id, port := range services {
+		portServices[id] = portService{id: id, port: port}
+	}
+
+	listeningServices := make(map[int]portService, len(listening))
+
+	for port, id := range listening {
+		listeningServices[port] = portService{id: id, port: port}
+	}
+
+	desired, conflicts := resolvePorts(portServices, listeningServices)
+
+	desiredIDs := make(map[int]resource.ID, len(desired))
+
+	for port, service := range desired {
+		desiredIDs[port] = service.id
+	}
+
+	return desiredIDs, conflicts
+}
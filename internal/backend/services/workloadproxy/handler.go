@@ -17,6 +17,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/resource"
 	"go.uber.org/zap"
 
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/audit/auditlog"
 	"github.com/siderolabs/omni/internal/pkg/auth"
 )
 
@@ -35,9 +36,25 @@ type ProxyProvider interface {
 	GetProxy(alias string) (http.Handler, resource.ID, error)
 }
 
-// AccessValidator validates workload proxy requests against the given cluster by the given public key ID and its signed & base64'd form.
+// AccessValidator validates workload proxy requests to the exposed service with the given alias in the cluster
+// by the given public key ID and its signed & base64'd form.
 type AccessValidator interface {
-	ValidateAccess(ctx context.Context, publicKeyID, publicKeyIDSignatureBase64 string, clusterID resource.ID) error
+	ValidateAccess(ctx context.Context, publicKeyID, publicKeyIDSignatureBase64 string, clusterID resource.ID, alias string) (identity string, err error)
+}
+
+// TokenValidator validates the workload proxy token of a request to the exposed service with the given alias in the cluster.
+type TokenValidator interface {
+	ValidateToken(ctx context.Context, token string, clusterID resource.ID, alias string) (identity string, err error)
+}
+
+// Auditor records the accesses to the exposed services in the audit log.
+type Auditor interface {
+	AuditWorkloadProxyAccess(ctx context.Context, identity, userAgent string, access *auditlog.WorkloadProxy) error
+}
+
+// IdentityForwarder sets the identity headers of the requests to the exposed services.
+type IdentityForwarder interface {
+	ForwardIdentity(ctx context.Context, header http.Header, identity string, clusterID resource.ID, alias string) error
 }
 
 // HTTPHandler is an HTTP handler that will proxy matching requests to the workload proxy.
@@ -48,6 +65,9 @@ type HTTPHandler struct {
 	logger              *zap.Logger
 	proxyProvider       ProxyProvider
 	accessValidator     AccessValidator
+	tokenValidator      TokenValidator
+	auditor             Auditor
+	identityForwarder   IdentityForwarder
 	mainURL             *url.URL
 	mainDomain          string
 	workloadProxyDomain string
@@ -56,10 +76,17 @@ type HTTPHandler struct {
 }
 
 // NewHTTPHandler creates a new HTTP handler that will proxy requests to the workload proxy.
+//
+// The requests carrying a workload proxy token are authenticated by the tokenValidator, if it is nil, such requests are rejected.
+// Each authenticated request is recorded by the auditor, and is given the identity headers by the identityForwarder, if they are not nil.
+// The identity headers sent by the clients are always removed.
 func NewHTTPHandler(
 	next http.Handler,
 	proxyProvider ProxyProvider,
 	accessValidator AccessValidator,
+	tokenValidator TokenValidator,
+	auditor Auditor,
+	identityForwarder IdentityForwarder,
 	mainURL *url.URL,
 	workloadProxySubdomain string,
 	useOmniSubdomain bool,
@@ -104,6 +131,9 @@ func NewHTTPHandler(
 		next:                next,
 		proxyProvider:       proxyProvider,
 		accessValidator:     accessValidator,
+		tokenValidator:      tokenValidator,
+		auditor:             auditor,
+		identityForwarder:   identityForwarder,
 		mainURL:             mainURL,
 		mainDomain:          mainDomain,
 		workloadProxyDomain: workloadProxyDomain,
@@ -115,7 +145,7 @@ func NewHTTPHandler(
 
 // ServeHTTP implements http.Handler.
 func (h *HTTPHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
-	if !h.isWorkloadProxyRequest(request) {
+	if !h.IsWorkloadProxyRequest(request) {
 		h.next.ServeHTTP(writer, request)
 
 		return
@@ -141,7 +171,26 @@ func (h *HTTPHandler) ServeHTTP(writer http.ResponseWriter, request *http.Reques
 		return
 	}
 
-	if !h.checkCookies(writer, request, clusterID) {
+	stripIdentityHeaders(request.Header)
+
+	var (
+		identity string
+		valid    bool
+	)
+
+	switch token := request.Header.Get(TokenHeader); {
+	case token != "":
+		identity, valid = h.checkToken(writer, request, token, clusterID, alias)
+	case isGRPCRequest(request):
+		// a gRPC client can't go through the login flow, it has to present a token
+		http.Error(writer, "unauthenticated", http.StatusUnauthorized)
+
+		return
+	default:
+		identity, valid = h.checkCookies(writer, request, clusterID, alias)
+	}
+
+	if !valid {
 		return
 	}
 
@@ -153,15 +202,25 @@ func (h *HTTPHandler) ServeHTTP(writer http.ResponseWriter, request *http.Reques
 		return
 	}
 
+	if h.identityForwarder != nil {
+		if err = h.identityForwarder.ForwardIdentity(request.Context(), request.Header, identity, clusterID, alias); err != nil {
+			h.logger.Error("failed to forward identity", zap.Error(err), zap.String("alias", alias), zap.String("identity", identity))
+
+			http.Error(writer, "failed to forward identity", http.StatusInternalServerError)
+
+			return
+		}
+	}
+
 	proxy.ServeHTTP(writer, request)
 }
 
-// isWorkloadProxyRequest checks if the request is for the workload proxy.
+// IsWorkloadProxyRequest checks if the request is for the workload proxy.
 //
 // It supports two formats:
 // - Legacy format: p-g3a4ana-demo.omni.siderolabs.io
 // - New format with a dedicated subdomain for all workload services: g3a4ana-demo.proxy-us.omni.siderolabs.io.
-func (h *HTTPHandler) isWorkloadProxyRequest(request *http.Request) bool {
+func (h *HTTPHandler) IsWorkloadProxyRequest(request *http.Request) bool {
 	host, _, _ := net.SplitHostPort(request.Host) //nolint:errcheck
 
 	if host == "" {
@@ -218,7 +277,7 @@ func (h *HTTPHandler) checkFetchMetadata(writer http.ResponseWriter, request *ht
 	return false
 }
 
-func (h *HTTPHandler) checkCookies(writer http.ResponseWriter, request *http.Request, clusterID resource.ID) (valid bool) {
+func (h *HTTPHandler) checkCookies(writer http.ResponseWriter, request *http.Request, clusterID resource.ID, alias string) (identity string, valid bool) {
 	publicKeyID, publicKeyIDSignatureBase64 := h.getSignatureCookies(request)
 	if publicKeyID == "" || publicKeyIDSignatureBase64 == "" {
 		// Only a navigation can come back from the login flow with a cookie. A subresource fetch would
@@ -227,25 +286,89 @@ func (h *HTTPHandler) checkCookies(writer http.ResponseWriter, request *http.Req
 		if mode := request.Header.Get(secFetchModeHeader); mode != "" && mode != secFetchModeNavigate {
 			http.Error(writer, "unauthenticated", http.StatusUnauthorized)
 
-			return false
+			return "", false
 		}
 
 		h.redirectToLogin(writer, request)
 
-		return false
+		return "", false
 	}
 
-	if err := h.accessValidator.ValidateAccess(request.Context(), publicKeyID, publicKeyIDSignatureBase64, clusterID); err != nil {
-		h.logger.Warn("failed to validate access", zap.Error(err))
+	identity, err := h.accessValidator.ValidateAccess(request.Context(), publicKeyID, publicKeyIDSignatureBase64, clusterID, alias)
+
+	h.audit(request, identity, clusterID, alias, err)
+
+	if err != nil {
+		h.logger.Warn("failed to validate access", zap.Error(err), zap.String("alias", alias), zap.String("identity", identity))
 
 		forbiddenURL := h.mainURL.JoinPath("/forbidden").String()
 
 		http.Redirect(writer, request, forbiddenURL, http.StatusSeeOther)
 
-		return false
+		return "", false
+	}
+
+	return identity, true
+}
+
+// checkToken authenticates a request by its workload proxy token, which is then removed from the request.
+//
+// The token is presented by API clients, so the failures are reported with a status code rather than a redirect.
+func (h *HTTPHandler) checkToken(writer http.ResponseWriter, request *http.Request, token string, clusterID resource.ID, alias string) (identity string, valid bool) {
+	request.Header.Del(TokenHeader)
+
+	if h.tokenValidator == nil {
+		http.Error(writer, "workload proxy tokens are not supported", http.StatusUnauthorized)
+
+		return "", false
 	}
 
-	return true
+	identity, err := h.tokenValidator.ValidateToken(request.Context(), token, clusterID, alias)
+
+	h.audit(request, identity, clusterID, alias, err)
+
+	if err != nil {
+		h.logger.Warn("failed to validate workload proxy token", zap.Error(err), zap.String("alias", alias))
+
+		http.Error(writer, "forbidden", http.StatusForbidden)
+
+		return "", false
+	}
+
+	h.logger.Debug("workload proxy token accepted", zap.String("alias", alias), zap.String("identity", identity))
+
+	return identity, true
+}
+
+// audit records the access to the exposed service, a failure to write the audit log doesn't fail the request.
+//
+// The requests with credentials which failed to verify are not attributed to any identity, so they are not recorded.
+func (h *HTTPHandler) audit(request *http.Request, identity string, clusterID resource.ID, alias string, accessErr error) {
+	if h.auditor == nil || identity == "" {
+		return
+	}
+
+	access := &auditlog.WorkloadProxy{
+		ClusterName: clusterID,
+		Alias:       alias,
+		Protocol:    "http",
+		Method:      request.Method,
+		Path:        request.URL.Path,
+		RemoteAddr:  request.RemoteAddr,
+		Allowed:     accessErr == nil,
+	}
+
+	if isGRPCRequest(request) {
+		access.Protocol = "grpc"
+	}
+
+	if accessErr != nil {
+		access.Error = accessErr.Error()
+	}
+
+	if err := h.auditor.AuditWorkloadProxyAccess(request.Context(), identity, request.UserAgent(), access); err != nil {
+		h.logger.Error("failed to write audit log", zap.Error(err))
+	}
 }
 
 // parseServiceAliasFromHost parses the service alias from the request host.
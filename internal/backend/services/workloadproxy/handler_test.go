@@ -8,10 +8,12 @@ package workloadproxy_test
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -19,6 +21,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 
+	"github.com/siderolabs/omni/client/pkg/constants"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/audit/auditlog"
 	"github.com/siderolabs/omni/internal/backend/services/workloadproxy"
 )
 
@@ -45,14 +49,16 @@ type mockAccessValidator struct {
 	publicKeyIDs                []string
 	publicKeyIDSignatureBase64s []string
 	clusterIDs                  []resource.ID
+	aliases                     []string
 }
 
-func (m *mockAccessValidator) ValidateAccess(_ context.Context, publicKeyID, publicKeyIDSignatureBase64 string, clusterID resource.ID) error {
+func (m *mockAccessValidator) ValidateAccess(_ context.Context, publicKeyID, publicKeyIDSignatureBase64 string, clusterID resource.ID, alias string) (string, error) {
 	m.publicKeyIDs = append(m.publicKeyIDs, publicKeyID)
 	m.publicKeyIDSignatureBase64s = append(m.publicKeyIDSignatureBase64s, publicKeyIDSignatureBase64)
 	m.clusterIDs = append(m.clusterIDs, clusterID)
+	m.aliases = append(m.aliases, alias)
 
-	return nil
+	return "user@example.com", nil
 }
 
 type mockHandler struct {
@@ -80,7 +86,7 @@ func TestHandler(t *testing.T) {
 		accessValidator := &mockAccessValidator{}
 		logger := zaptest.NewLogger(t)
 
-		handler, err := workloadproxy.NewHTTPHandler(next, proxyProvider, accessValidator, mainURL, "proxy-us", false, logger, redirectSignature)
+		handler, err := workloadproxy.NewHTTPHandler(next, proxyProvider, accessValidator, nil, nil, nil, mainURL, "proxy-us", false, logger, redirectSignature)
 		require.NoError(t, err)
 
 		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://instanceid.example.com/example", nil)
@@ -102,7 +108,7 @@ func TestHandler(t *testing.T) {
 		accessValidator := &mockAccessValidator{}
 		logger := zaptest.NewLogger(t)
 
-		handler, err := workloadproxy.NewHTTPHandler(next, proxyProvider, accessValidator, mainURL, "proxy-us", false, logger, redirectSignature)
+		handler, err := workloadproxy.NewHTTPHandler(next, proxyProvider, accessValidator, nil, nil, nil, mainURL, "proxy-us", false, logger, redirectSignature)
 		require.NoError(t, err)
 
 		rr := httptest.NewRecorder()
@@ -157,7 +163,7 @@ func TestHandler(t *testing.T) {
 		accessValidator := &mockAccessValidator{}
 		logger := zaptest.NewLogger(t)
 
-		handler, err := workloadproxy.NewHTTPHandler(next, proxyProvider, accessValidator, mainURL, "proxy-us", false, logger, redirectSignature)
+		handler, err := workloadproxy.NewHTTPHandler(next, proxyProvider, accessValidator, nil, nil, nil, mainURL, "proxy-us", false, logger, redirectSignature)
 		require.NoError(t, err)
 
 		rr := httptest.NewRecorder()
@@ -201,7 +207,7 @@ func TestHandlerUseOmniSubdomain(t *testing.T) {
 		accessValidator := &mockAccessValidator{}
 		logger := zaptest.NewLogger(t)
 
-		handler, err := workloadproxy.NewHTTPHandler(next, proxyProvider, accessValidator, mainURL, "proxy", true, logger, redirectSignature)
+		handler, err := workloadproxy.NewHTTPHandler(next, proxyProvider, accessValidator, nil, nil, nil, mainURL, "proxy", true, logger, redirectSignature)
 		require.NoError(t, err)
 
 		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://omni.example.com/example", nil)
@@ -236,7 +242,7 @@ func TestHandlerUseOmniSubdomain(t *testing.T) {
 		accessValidator := &mockAccessValidator{}
 		logger := zaptest.NewLogger(t)
 
-		handler, err := workloadproxy.NewHTTPHandler(next, proxyProvider, accessValidator, mainURL, "proxy", true, logger, redirectSignature)
+		handler, err := workloadproxy.NewHTTPHandler(next, proxyProvider, accessValidator, nil, nil, nil, mainURL, "proxy", true, logger, redirectSignature)
 		require.NoError(t, err)
 
 		rr := httptest.NewRecorder()
@@ -280,7 +286,7 @@ func TestHandlerUseOmniSubdomainEmptySubdomain(t *testing.T) {
 		accessValidator := &mockAccessValidator{}
 		logger := zaptest.NewLogger(t)
 
-		handler, err := workloadproxy.NewHTTPHandler(next, proxyProvider, accessValidator, mainURL, "", true, logger, redirectSignature)
+		handler, err := workloadproxy.NewHTTPHandler(next, proxyProvider, accessValidator, nil, nil, nil, mainURL, "", true, logger, redirectSignature)
 		require.NoError(t, err)
 
 		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://omni.example.com/", nil)
@@ -296,6 +302,236 @@ func TestHandlerUseOmniSubdomainEmptySubdomain(t *testing.T) {
 	})
 }
 
+type mockTokenValidator struct {
+	err    error
+	tokens []string
+}
+
+func (m *mockTokenValidator) ValidateToken(_ context.Context, token string, _ resource.ID, _ string) (string, error) {
+	m.tokens = append(m.tokens, token)
+
+	return "user@example.com", m.err
+}
+
+type mockAuditor struct {
+	identities []string
+	accesses   []*auditlog.WorkloadProxy
+}
+
+func (m *mockAuditor) AuditWorkloadProxyAccess(_ context.Context, identity, _ string, access *auditlog.WorkloadProxy) error {
+	m.identities = append(m.identities, identity)
+	m.accesses = append(m.accesses, access)
+
+	return nil
+}
+
+func TestHandlerToken(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	mainURL, err := url.Parse("https://omni.example.com")
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		validator    *mockTokenValidator
+		name         string
+		token        string
+		contentType  string
+		expectedCode int
+	}{
+		{
+			name:         "valid token",
+			validator:    &mockTokenValidator{},
+			token:        "valid",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "invalid token",
+			validator:    &mockTokenValidator{err: errors.New("invalid token")},
+			token:        "invalid",
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "tokens not supported",
+			token:        "valid",
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "grpc request without token",
+			validator:    &mockTokenValidator{},
+			contentType:  "application/grpc",
+			expectedCode: http.StatusUnauthorized,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			next := &mockHandler{}
+			proxyProvider := &mockProxyProvider{}
+			accessValidator := &mockAccessValidator{}
+			auditor := &mockAuditor{}
+			logger := zaptest.NewLogger(t)
+
+			var tokenValidator workloadproxy.TokenValidator
+			if tc.validator != nil {
+				tokenValidator = tc.validator
+			}
+
+			handler, err := workloadproxy.NewHTTPHandler(next, proxyProvider, accessValidator, tokenValidator, auditor, nil, mainURL, "proxy", true, logger, redirectSignature)
+			require.NoError(t, err)
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://grpc-service.proxy.omni.example.com/service/Method", nil)
+			require.NoError(t, err)
+
+			req.ProtoMajor = 2
+
+			if tc.token != "" {
+				req.Header.Set(workloadproxy.TokenHeader, tc.token)
+			}
+
+			if tc.contentType != "" {
+				req.Header.Set("Content-Type", tc.contentType)
+			}
+
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expectedCode, rr.Code)
+			require.Empty(t, next.requests)
+			require.Empty(t, accessValidator.publicKeyIDs, "the cookies must not be checked")
+			require.Empty(t, req.Header.Get(workloadproxy.TokenHeader), "the token must not be proxied")
+
+			if tc.validator != nil && tc.token != "" {
+				require.Equal(t, []string{tc.token}, tc.validator.tokens)
+
+				require.Equal(t, []string{"user@example.com"}, auditor.identities)
+				require.Equal(t, "grpc-service", auditor.accesses[0].Alias)
+				require.Equal(t, "/service/Method", auditor.accesses[0].Path)
+				require.Equal(t, tc.validator.err == nil, auditor.accesses[0].Allowed)
+			} else {
+				require.Empty(t, auditor.accesses)
+			}
+		})
+	}
+}
+
+type headerRecordingProxyProvider struct {
+	headers []http.Header
+}
+
+func (m *headerRecordingProxyProvider) GetProxy(string) (http.Handler, resource.ID, error) {
+	return http.HandlerFunc(func(_ http.ResponseWriter, request *http.Request) {
+		m.headers = append(m.headers, request.Header.Clone())
+	}), "test-cluster", nil
+}
+
+type mockIdentityForwarder struct {
+	err        error
+	identities []string
+}
+
+func (m *mockIdentityForwarder) ForwardIdentity(_ context.Context, header http.Header, identity string, _ resource.ID, _ string) error {
+	m.identities = append(m.identities, identity)
+
+	if m.err != nil {
+		return m.err
+	}
+
+	header.Set(constants.ExposedServiceIdentityEmailHeader, identity)
+
+	return nil
+}
+
+func TestHandlerIdentityForwarding(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	mainURL, err := url.Parse("https://omni.example.com")
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		forwarder     *mockIdentityForwarder
+		name          string
+		expectedEmail string
+		expectedCode  int
+	}{
+		{
+			name:          "forwarded",
+			forwarder:     &mockIdentityForwarder{},
+			expectedEmail: "user@example.com",
+			expectedCode:  http.StatusOK,
+		},
+		{
+			name:         "not forwarded",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "forwarding failed",
+			forwarder:    &mockIdentityForwarder{err: errors.New("no signing key")},
+			expectedCode: http.StatusInternalServerError,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			proxyProvider := &headerRecordingProxyProvider{}
+			logger := zaptest.NewLogger(t)
+
+			var identityForwarder workloadproxy.IdentityForwarder
+			if tc.forwarder != nil {
+				identityForwarder = tc.forwarder
+			}
+
+			handler, err := workloadproxy.NewHTTPHandler(&mockHandler{}, proxyProvider, &mockAccessValidator{}, nil, nil, identityForwarder, mainURL, "proxy", true, logger, redirectSignature)
+			require.NoError(t, err)
+
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://web.proxy.omni.example.com/", nil)
+			require.NoError(t, err)
+
+			req.AddCookie(&http.Cookie{Name: workloadproxy.PublicKeyIDCookie, Value: testPublicKeyID})
+			req.AddCookie(&http.Cookie{Name: workloadproxy.PublicKeyIDSignatureBase64Cookie, Value: base64.StdEncoding.EncodeToString([]byte("test-signed-public-key-id"))})
+
+			// the identity headers sent by the client must never reach the service
+			req.Header.Set(constants.ExposedServiceIdentityEmailHeader, "admin@example.com")
+			req.Header.Set(constants.ExposedServiceIdentityGroupsHeader, "groups/admins")
+			req.Header["omni-identity-jwt"] = []string{"forged"}
+
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			require.Equal(t, tc.expectedCode, rr.Code)
+
+			if tc.forwarder != nil {
+				require.Equal(t, []string{"user@example.com"}, tc.forwarder.identities)
+			}
+
+			if tc.expectedCode != http.StatusOK {
+				require.Empty(t, proxyProvider.headers)
+
+				return
+			}
+
+			require.Len(t, proxyProvider.headers, 1)
+
+			for key := range proxyProvider.headers[0] {
+				if key == constants.ExposedServiceIdentityEmailHeader {
+					continue
+				}
+
+				require.NotContains(t, strings.ToLower(key), "omni-identity-")
+			}
+
+			require.Equal(t, tc.expectedEmail, proxyProvider.headers[0].Get(constants.ExposedServiceIdentityEmailHeader))
+		})
+	}
+}
+
 func TestHandlerFetchMetadata(t *testing.T) {
 	t.Parallel()
 
@@ -431,7 +667,7 @@ func TestHandlerFetchMetadata(t *testing.T) {
 			accessValidator := &mockAccessValidator{}
 			logger := zaptest.NewLogger(t)
 
-			handler, err := workloadproxy.NewHTTPHandler(next, proxyProvider, accessValidator, mainURL, "proxy", true, logger, redirectSignature)
+			handler, err := workloadproxy.NewHTTPHandler(next, proxyProvider, accessValidator, nil, nil, nil, mainURL, "proxy", true, logger, redirectSignature)
 			require.NoError(t, err)
 
 			req, err := http.NewRequestWithContext(ctx, test.method, "https://grafana.proxy.omni.example.com/dashboard", nil)
@@ -481,7 +717,7 @@ func testUseOmniSubdomainWithCookies(ctx context.Context, t *testing.T, mainURL
 	accessValidator := &mockAccessValidator{}
 	logger := zaptest.NewLogger(t)
 
-	handler, err := workloadproxy.NewHTTPHandler(next, proxyProvider, accessValidator, mainURL, subdomain, true, logger, redirectSignature)
+	handler, err := workloadproxy.NewHTTPHandler(next, proxyProvider, accessValidator, nil, nil, nil, mainURL, subdomain, true, logger, redirectSignature)
 	require.NoError(t, err)
 
 	rr := httptest.NewRecorder()
@@ -506,7 +742,7 @@ func testSubdomainRequestWithCookies(ctx context.Context, t *testing.T, mainURL
 	accessValidator := &mockAccessValidator{}
 	logger := zaptest.NewLogger(t)
 
-	handler, err := workloadproxy.NewHTTPHandler(next, proxyProvider, accessValidator, mainURL, "proxy-us", false, logger, redirectSignature)
+	handler, err := workloadproxy.NewHTTPHandler(next, proxyProvider, accessValidator, nil, nil, nil, mainURL, "proxy-us", false, logger, redirectSignature)
 	require.NoError(t, err)
 
 	rr := httptest.NewRecorder()
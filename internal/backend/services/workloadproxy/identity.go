@@ -0,0 +1,194 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package workloadproxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/zitadel/oidc/v3/pkg/op"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/constants"
+	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+)
+
+// IdentityJWTLifetime is the lifetime of the JWTs forwarded to the exposed services.
+//
+// A JWT is issued for each request, so it only has to outlive the request reaching the service.
+const IdentityJWTLifetime = 5 * time.Minute
+
+// SigningKeyProvider provides the key to sign the JWTs forwarded to the exposed services with.
+type SigningKeyProvider interface {
+	SigningKey(ctx context.Context) (op.SigningKey, error)
+}
+
+// IdentityClaims are the claims of the JWT forwarded to the exposed services.
+type IdentityClaims struct {
+	Email   string   `json:"email"`
+	Role    string   `json:"role"`
+	Cluster string   `json:"cluster"`
+	Groups  []string `json:"groups,omitempty"`
+
+	jwt.RegisteredClaims
+}
+
+// SignedIdentityForwarder forwards the identity of the user to the exposed services which are configured to receive it.
+//
+// The identity is carried by a JWT issued by the Omni OIDC provider, so that the services can verify it with the published keys,
+// and optionally by the plain headers for the services which trust the proxy.
+type SignedIdentityForwarder struct {
+	state              state.State
+	roleProvider       RoleProvider
+	signingKeyProvider SigningKeyProvider
+	logger             *zap.Logger
+	issuer             string
+}
+
+// NewSignedIdentityForwarder creates a new SignedIdentityForwarder issuing the JWTs on behalf of the given issuer.
+func NewSignedIdentityForwarder(state state.State, roleProvider RoleProvider, signingKeyProvider SigningKeyProvider, issuer string, logger *zap.Logger) (*SignedIdentityForwarder, error) {
+	if state == nil {
+		return nil, errors.New("state is nil")
+	}
+
+	if roleProvider == nil {
+		return nil, errors.New("role provider is nil")
+	}
+
+	if signingKeyProvider == nil {
+		return nil, errors.New("signing key provider is nil")
+	}
+
+	if issuer == "" {
+		return nil, errors.New("issuer is empty")
+	}
+
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	return &SignedIdentityForwarder{
+		state:              state,
+		roleProvider:       roleProvider,
+		signingKeyProvider: signingKeyProvider,
+		logger:             logger,
+		issuer:             issuer,
+	}, nil
+}
+
+// ForwardIdentity sets the identity headers of the request to the exposed service with the given alias in the cluster,
+// if the service is configured to receive them.
+func (f *SignedIdentityForwarder) ForwardIdentity(ctx context.Context, header http.Header, identityID string, clusterID resource.ID, alias string) error {
+	exposedService, err := getExposedService(actor.MarkContextAsInternalActor(ctx), f.state, clusterID, alias)
+	if err != nil {
+		return err
+	}
+
+	if exposedService == nil {
+		return nil
+	}
+
+	forwarding := exposedService.TypedSpec().Value.GetIdentityForwarding()
+	if forwarding == specs.ExposedServiceSpec_NONE {
+		return nil
+	}
+
+	if identityID == "" {
+		return errors.New("the identity of the request is unknown")
+	}
+
+	ctx, identity, err := identityContext(ctx, f.state, identityID)
+	if err != nil {
+		return err
+	}
+
+	accessRole, err := f.roleProvider.RoleForCluster(ctx, clusterID)
+	if err != nil {
+		return err
+	}
+
+	signingKey, err := f.signingKeyProvider.SigningKey(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get the signing key: %w", err)
+	}
+
+	signingMethod := jwt.GetSigningMethod(string(signingKey.SignatureAlgorithm()))
+	if signingMethod == nil {
+		return fmt.Errorf("unsupported signing algorithm %q", signingKey.SignatureAlgorithm())
+	}
+
+	now := time.Now()
+
+	claims := IdentityClaims{
+		Email:   identityID,
+		Role:    string(accessRole),
+		Cluster: clusterID,
+		Groups:  identityGroups(identity),
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    f.issuer,
+			Subject:   identityID,
+			Audience:  jwt.ClaimStrings{exposedService.TypedSpec().Value.GetUrl()},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(IdentityJWTLifetime)),
+		},
+	}
+
+	token := jwt.NewWithClaims(signingMethod, claims)
+	token.Header["kid"] = signingKey.ID()
+
+	signedToken, err := token.SignedString(signingKey.Key())
+	if err != nil {
+		return fmt.Errorf("failed to sign the identity JWT: %w", err)
+	}
+
+	header.Set(constants.ExposedServiceIdentityJWTHeader, signedToken)
+
+	if forwarding == specs.ExposedServiceSpec_HEADERS {
+		header.Set(constants.ExposedServiceIdentityEmailHeader, claims.Email)
+		header.Set(constants.ExposedServiceIdentityRoleHeader, claims.Role)
+
+		if len(claims.Groups) > 0 {
+			header.Set(constants.ExposedServiceIdentityGroupsHeader, strings.Join(claims.Groups, ","))
+		}
+	}
+
+	return nil
+}
+
+// identityGroups returns the groups of the identity, which are its SAML labels without the prefix, e.g. "groups/admins".
+func identityGroups(identity *authres.Identity) []string {
+	var groups []string
+
+	for key := range identity.Metadata().Labels().Raw() {
+		if group, ok := strings.CutPrefix(key, authres.SAMLLabelPrefix); ok {
+			groups = append(groups, group)
+		}
+	}
+
+	slices.Sort(groups)
+
+	return groups
+}
+
+// stripIdentityHeaders removes the identity headers from the request, so that the client can't pass them to the exposed service.
+func stripIdentityHeaders(header http.Header) {
+	for key := range header {
+		// the key is deleted as is, since a header which was set directly on the map might not be canonical
+		if strings.HasPrefix(http.CanonicalHeaderKey(key), constants.ExposedServiceIdentityHeaderPrefix) {
+			delete(header, key)
+		}
+	}
+}
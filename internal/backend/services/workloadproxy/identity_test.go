@@ -0,0 +1,133 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package workloadproxy_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/v3/pkg/op"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/access/role"
+	"github.com/siderolabs/omni/client/pkg/constants"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/services/workloadproxy"
+)
+
+type mockSigningKeyProvider struct {
+	key op.SigningKey
+}
+
+func (m *mockSigningKeyProvider) SigningKey(context.Context) (op.SigningKey, error) {
+	return m.key, nil
+}
+
+func TestSignedIdentityForwarder(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	user := auth.NewUser("test-user")
+	user.TypedSpec().Value.Role = string(role.Operator)
+
+	require.NoError(t, st.Create(ctx, user))
+
+	identity := auth.NewIdentity("user@example.com")
+	identity.TypedSpec().Value.UserId = user.Metadata().ID()
+	identity.Metadata().Labels().Set(auth.SAMLLabelPrefix+"groups/sre", "")
+	identity.Metadata().Labels().Set(auth.SAMLLabelPrefix+"groups/admins", "")
+
+	require.NoError(t, st.Create(ctx, identity))
+
+	exposedService := omni.NewExposedService("test-cluster/grafana.monitoring")
+	exposedService.Metadata().Labels().Set(omni.LabelCluster, "test-cluster")
+	exposedService.Metadata().Labels().Set(omni.LabelExposedServiceAlias, "grafana")
+	exposedService.TypedSpec().Value.Url = "https://grafana.proxy.omni.example.com"
+
+	require.NoError(t, st.Create(ctx, exposedService))
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	signingKey := &testSigningKey{key: rsaKey, id: "test-key-id"}
+
+	forwarder, err := workloadproxy.NewSignedIdentityForwarder(st, &mockRoleProvider{role: role.Operator}, &mockSigningKeyProvider{key: signingKey},
+		"https://omni.example.com/oidc", zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	forward := func(forwarding specs.ExposedServiceSpec_IdentityForwarding) http.Header {
+		exposedService.TypedSpec().Value.IdentityForwarding = forwarding
+		require.NoError(t, st.Update(ctx, exposedService))
+
+		header := http.Header{}
+
+		require.NoError(t, forwarder.ForwardIdentity(ctx, header, identity.Metadata().ID(), "test-cluster", "grafana"))
+
+		return header
+	}
+
+	t.Run("none", func(t *testing.T) {
+		require.Empty(t, forward(specs.ExposedServiceSpec_NONE))
+	})
+
+	t.Run("headers", func(t *testing.T) {
+		header := forward(specs.ExposedServiceSpec_HEADERS)
+
+		require.Equal(t, "user@example.com", header.Get(constants.ExposedServiceIdentityEmailHeader))
+		require.Equal(t, string(role.Operator), header.Get(constants.ExposedServiceIdentityRoleHeader))
+		require.Equal(t, "groups/admins,groups/sre", header.Get(constants.ExposedServiceIdentityGroupsHeader))
+		require.NotEmpty(t, header.Get(constants.ExposedServiceIdentityJWTHeader))
+	})
+
+	t.Run("jwt", func(t *testing.T) {
+		header := forward(specs.ExposedServiceSpec_JWT)
+
+		require.Empty(t, header.Get(constants.ExposedServiceIdentityEmailHeader))
+
+		var claims workloadproxy.IdentityClaims
+
+		_, err := jwt.ParseWithClaims(header.Get(constants.ExposedServiceIdentityJWTHeader), &claims, func(token *jwt.Token) (any, error) {
+			require.Equal(t, signingKey.id, token.Header["kid"])
+
+			return &rsaKey.PublicKey, nil
+		},
+			jwt.WithAudience("https://grafana.proxy.omni.example.com"),
+			jwt.WithIssuer("https://omni.example.com/oidc"),
+			jwt.WithExpirationRequired(),
+		)
+		require.NoError(t, err)
+
+		require.Equal(t, "user@example.com", claims.Subject)
+		require.Equal(t, "user@example.com", claims.Email)
+		require.Equal(t, string(role.Operator), claims.Role)
+		require.Equal(t, "test-cluster", claims.Cluster)
+		require.Equal(t, []string{"groups/admins", "groups/sre"}, claims.Groups)
+	})
+
+	t.Run("unknown identity", func(t *testing.T) {
+		exposedService.TypedSpec().Value.IdentityForwarding = specs.ExposedServiceSpec_HEADERS
+		require.NoError(t, st.Update(ctx, exposedService))
+
+		require.Error(t, forwarder.ForwardIdentity(ctx, http.Header{}, "", "test-cluster", "grafana"))
+		require.Error(t, forwarder.ForwardIdentity(ctx, http.Header{}, "unknown@example.com", "test-cluster", "grafana"))
+
+		// the services which are not configured to receive the identity don't need it
+		require.NoError(t, forwarder.ForwardIdentity(ctx, http.Header{}, "", "test-cluster", "prometheus"))
+	})
+}
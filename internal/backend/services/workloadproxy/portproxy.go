@@ -0,0 +1,487 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package workloadproxy
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/constants"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/audit/auditlog"
+)
+
+const (
+	// TokenPreamblePrefix starts the line a client without a client certificate sends first on a TCP service connection, followed by its workload proxy token.
+	TokenPreamblePrefix = constants.ExposedServiceTokenPreamblePrefix
+
+	// portProxyAuthTimeout bounds the TLS handshake and the token preamble of a TCP service connection.
+	portProxyAuthTimeout = 10 * time.Second
+
+	// maxTokenPreambleSize bounds the length of the token preamble line.
+	maxTokenPreambleSize = 16 * 1024
+)
+
+// AliasDialer dials the exposed services.
+type AliasDialer interface {
+	DialAlias(ctx context.Context, cluster resource.ID, alias string) (net.Conn, error)
+}
+
+// PortAccessValidator validates the credentials presented on a TCP service connection.
+type PortAccessValidator interface {
+	TokenValidator
+	ValidateCertificate(ctx context.Context, cert *x509.Certificate, clusterID resource.ID, alias string) (identity string, err error)
+}
+
+// PortProxy proxies the exposed services with the TCP protocol, each of them on the port allocated to it.
+//
+// The connections are TLS, and are authenticated either by a client certificate issued by Omni, or by a workload proxy token
+// sent as the first line of the connection, see TokenPreamblePrefix.
+type PortProxy struct {
+	state     state.State
+	dialer    AliasDialer
+	validator PortAccessValidator
+	auditor   Auditor
+	logger    *zap.Logger
+
+	listeners map[int]*portListener
+	services  map[resource.ID]portService
+
+	certFile string
+	keyFile  string
+
+	certMu      sync.Mutex
+	cert        *tls.Certificate
+	certModTime time.Time
+}
+
+type portService struct {
+	id      resource.ID
+	cluster resource.ID
+	alias   string
+	port    int
+}
+
+type portListener struct {
+	listener net.Listener
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+	service  portService
+}
+
+// NewPortProxy creates a new PortProxy, serving the TLS connections with the given certificate.
+//
+// Each authenticated connection is recorded by the auditor, if it is not nil.
+func NewPortProxy(st state.State, dialer AliasDialer, validator PortAccessValidator, auditor Auditor, certFile, keyFile string, logger *zap.Logger) (*PortProxy, error) {
+	if st == nil {
+		return nil, errors.New("state is nil")
+	}
+
+	if dialer == nil {
+		return nil, errors.New("dialer is nil")
+	}
+
+	if validator == nil {
+		return nil, errors.New("validator is nil")
+	}
+
+	if certFile == "" || keyFile == "" {
+		return nil, errors.New("the TLS certificate is required")
+	}
+
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	return &PortProxy{
+		state:     st,
+		dialer:    dialer,
+		validator: validator,
+		auditor:   auditor,
+		logger:    logger,
+		listeners: map[int]*portListener{},
+		services:  map[resource.ID]portService{},
+		certFile:  certFile,
+		keyFile:   keyFile,
+	}, nil
+}
+
+// Run watches the exposed services, opening and closing the ports of the TCP services.
+func (p *PortProxy) Run(ctx context.Context) error {
+	defer p.closeAll()
+
+	ch := make(chan state.Event)
+
+	if err := p.state.WatchKind(ctx, omni.NewExposedService("").Metadata(), ch, state.WithBootstrapContents(true)); err != nil {
+		return err
+	}
+
+	bootstrapped := false
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case ev := <-ch:
+			switch ev.Type {
+			case state.Errored:
+				return fmt.Errorf("port proxy received an error event: %w", ev.Error)
+			case state.Bootstrapped:
+				bootstrapped = true
+			case state.Noop:
+			case state.Destroyed:
+				delete(p.services, ev.Resource.Metadata().ID())
+			case state.Created, state.Updated:
+				exposedService, ok := ev.Resource.(*omni.ExposedService)
+				if !ok {
+					p.logger.Warn("port proxy received an event with an unexpected resource type", zap.String("type", fmt.Sprintf("%T", ev.Resource)))
+
+					continue
+				}
+
+				p.updateService(exposedService)
+			}
+
+			if bootstrapped {
+				p.reconcile(ctx)
+			}
+		}
+	}
+}
+
+func (p *PortProxy) updateService(exposedService *omni.ExposedService) {
+	proxyPort := int(exposedService.TypedSpec().Value.ProxyPort)
+
+	if exposedService.Metadata().Phase() == resource.PhaseTearingDown ||
+		exposedService.TypedSpec().Value.Protocol != specs.ExposedServiceSpec_TCP || proxyPort == 0 {
+		delete(p.services, exposedService.Metadata().ID())
+
+		return
+	}
+
+	cluster, _ := exposedService.Metadata().Labels().Get(omni.LabelCluster)
+	alias, _ := exposedService.Metadata().Labels().Get(omni.LabelExposedServiceAlias)
+
+	p.services[exposedService.Metadata().ID()] = portService{
+		id:      exposedService.Metadata().ID(),
+		cluster: cluster,
+		alias:   alias,
+		port:    proxyPort,
+	}
+}
+
+func (p *PortProxy) reconcile(ctx context.Context) {
+	listening := make(map[int]portService, len(p.listeners))
+
+	for port, listener := range p.listeners {
+		listening[port] = listener.service
+	}
+
+	desiredPorts, conflicts := resolvePorts(p.services, listening)
+
+	for port, ids := range conflicts {
+		_, kept := desiredPorts[port]
+
+		p.logger.Error("the port is allocated to several exposed services", zap.Int("port", port), zap.Strings("services", ids), zap.Bool("kept_listening", kept))
+	}
+
+	for port, listener := range p.listeners {
+		if service, ok := desiredPorts[port]; ok && service == listener.service {
+			continue
+		}
+
+		p.closeListener(port)
+	}
+
+	for port, service := range desiredPorts {
+		if _, ok := p.listeners[port]; ok {
+			continue
+		}
+
+		if err := p.openListener(ctx, port, service); err != nil {
+			p.logger.Error("failed to open the port of the exposed service", zap.Int("port", port),
+				zap.String("cluster", service.cluster), zap.String("alias", service.alias), zap.Error(err))
+		}
+	}
+}
+
+// resolvePorts maps the ports to the services which should be listening on them.
+//
+// A port allocated to several services stays with the service already listening on it, and is not opened otherwise, so that
+// the connections are never routed to a service of another cluster. Such ports are returned with the sorted IDs of the services claiming them.
+func resolvePorts(services map[resource.ID]portService, listening map[int]portService) (map[int]portService, map[int][]resource.ID) {
+	claims := make(map[int][]portService, len(services))
+
+	for _, service := range services {
+		claims[service.port] = append(claims[service.port], service)
+	}
+
+	desired := make(map[int]portService, len(claims))
+	conflicts := map[int][]resource.ID{}
+
+	for port, claimants := range claims {
+		if len(claimants) == 1 {
+			desired[port] = claimants[0]
+
+			continue
+		}
+
+		ids := make([]resource.ID, 0, len(claimants))
+
+		for _, claimant := range claimants {
+			ids = append(ids, claimant.id)
+		}
+
+		slices.Sort(ids)
+
+		conflicts[port] = ids
+
+		if current, ok := listening[port]; ok && slices.Contains(claimants, current) {
+			desired[port] = current
+		}
+	}
+
+	return desired, conflicts
+}
+
+func (p *PortProxy) openListener(ctx context.Context, port int, service portService) error {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ClientAuth: tls.RequestClientCert,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return p.certificate()
+		},
+	}
+
+	listener, err := tls.Listen("tcp", net.JoinHostPort("", strconv.Itoa(port)), tlsConfig)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	pl := &portListener{
+		listener: listener,
+		cancel:   cancel,
+		service:  service,
+	}
+
+	p.listeners[port] = pl
+
+	p.logger.Info("opened the port of the exposed service", zap.Int("port", port), zap.String("cluster", service.cluster), zap.String("alias", service.alias))
+
+	pl.wg.Go(func() {
+		for {
+			conn, acceptErr := listener.Accept()
+			if acceptErr != nil {
+				if !errors.Is(acceptErr, net.ErrClosed) {
+					p.logger.Warn("failed to accept a connection", zap.Int("port", port), zap.Error(acceptErr))
+				}
+
+				return
+			}
+
+			pl.wg.Go(func() {
+				p.handleConn(ctx, conn, service)
+			})
+		}
+	})
+
+	return nil
+}
+
+func (p *PortProxy) closeListener(port int) {
+	pl := p.listeners[port]
+
+	delete(p.listeners, port)
+
+	pl.cancel()
+
+	if err := pl.listener.Close(); err != nil {
+		p.logger.Warn("failed to close the listener", zap.Int("port", port), zap.Error(err))
+	}
+
+	pl.wg.Wait()
+
+	p.logger.Info("closed the port of the exposed service", zap.Int("port", port), zap.String("cluster", pl.service.cluster), zap.String("alias", pl.service.alias))
+}
+
+func (p *PortProxy) closeAll() {
+	for port := range p.listeners {
+		p.closeListener(port)
+	}
+}
+
+func (p *PortProxy) handleConn(ctx context.Context, conn net.Conn, service portService) {
+	defer conn.Close() //nolint:errcheck
+
+	logger := p.logger.With(zap.String("cluster", service.cluster), zap.String("alias", service.alias), zap.Stringer("remote_addr", conn.RemoteAddr()))
+
+	tlsConn, ok := conn.(*tls.Conn)
+	if !ok {
+		return
+	}
+
+	identity, reader, err := p.authenticate(ctx, tlsConn, service)
+
+	if identity != "" {
+		p.audit(ctx, conn, identity, service, err)
+	}
+
+	if err != nil {
+		logger.Warn("rejected the connection to the exposed service", zap.Error(err), zap.String("identity", identity))
+
+		return
+	}
+
+	logger = logger.With(zap.String("identity", identity))
+
+	upstream, err := p.dialer.DialAlias(ctx, service.cluster, service.alias)
+	if err != nil {
+		logger.Warn("failed to dial the exposed service", zap.Error(err))
+
+		return
+	}
+
+	defer upstream.Close() //nolint:errcheck
+
+	logger.Debug("proxying the connection to the exposed service")
+
+	stop := context.AfterFunc(ctx, func() {
+		conn.Close()     //nolint:errcheck
+		upstream.Close() //nolint:errcheck
+	})
+	defer stop()
+
+	var wg sync.WaitGroup
+
+	wg.Go(func() {
+		io.Copy(upstream, reader) //nolint:errcheck
+
+		if tcpConn, isTCP := upstream.(*net.TCPConn); isTCP {
+			tcpConn.CloseWrite() //nolint:errcheck
+		}
+	})
+
+	io.Copy(conn, upstream) //nolint:errcheck
+
+	tlsConn.CloseWrite() //nolint:errcheck
+
+	wg.Wait()
+}
+
+// audit records the connection to the exposed service, a failure to write the audit log doesn't fail the connection.
+func (p *PortProxy) audit(ctx context.Context, conn net.Conn, identity string, service portService, accessErr error) {
+	if p.auditor == nil {
+		return
+	}
+
+	access := &auditlog.WorkloadProxy{
+		ClusterName: service.cluster,
+		Alias:       service.alias,
+		Protocol:    "tcp",
+		RemoteAddr:  conn.RemoteAddr().String(),
+		Allowed:     accessErr == nil,
+	}
+
+	if accessErr != nil {
+		access.Error = accessErr.Error()
+	}
+
+	if err := p.auditor.AuditWorkloadProxyAccess(ctx, identity, "", access); err != nil {
+		p.logger.Error("failed to write audit log", zap.Error(err))
+	}
+}
+
+// authenticate completes the TLS handshake and authenticates the connection, returning the identity of the client and the reader of the rest of the connection.
+//
+// The identity is returned also when the access is denied after the credentials were verified.
+func (p *PortProxy) authenticate(ctx context.Context, conn *tls.Conn, service portService) (string, io.Reader, error) {
+	if err := conn.SetDeadline(time.Now().Add(portProxyAuthTimeout)); err != nil {
+		return "", nil, err
+	}
+
+	if err := conn.HandshakeContext(ctx); err != nil {
+		return "", nil, fmt.Errorf("TLS handshake failed: %w", err)
+	}
+
+	if peerCertificates := conn.ConnectionState().PeerCertificates; len(peerCertificates) > 0 {
+		identity, err := p.validator.ValidateCertificate(ctx, peerCertificates[0], service.cluster, service.alias)
+		if err != nil {
+			return identity, nil, err
+		}
+
+		return identity, conn, conn.SetDeadline(time.Time{})
+	}
+
+	reader := bufio.NewReaderSize(io.LimitReader(conn, maxTokenPreambleSize), maxTokenPreambleSize)
+
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to read the token preamble: %w", err)
+	}
+
+	token, ok := strings.CutPrefix(strings.TrimRight(line, "\r\n"), TokenPreamblePrefix)
+	if !ok {
+		return "", nil, errors.New("neither a client certificate nor a token was presented")
+	}
+
+	identity, err := p.validator.ValidateToken(ctx, token, service.cluster, service.alias)
+	if err != nil {
+		return identity, nil, err
+	}
+
+	// the data buffered after the preamble belongs to the proxied stream
+	buffered, err := reader.Peek(reader.Buffered())
+	if err != nil {
+		return "", nil, err
+	}
+
+	return identity, io.MultiReader(bytes.NewReader(buffered), conn), conn.SetDeadline(time.Time{})
+}
+
+// certificate returns the TLS certificate, reloading it when the certificate file changes.
+func (p *PortProxy) certificate() (*tls.Certificate, error) {
+	info, err := os.Stat(p.certFile)
+	if err != nil {
+		return nil, err
+	}
+
+	p.certMu.Lock()
+	defer p.certMu.Unlock()
+
+	if p.cert != nil && info.ModTime().Equal(p.certModTime) {
+		return p.cert, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(p.certFile, p.keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the TLS certificate: %w", err)
+	}
+
+	p.cert = &cert
+	p.certModTime = info.ModTime()
+
+	return p.cert, nil
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package workloadproxy_test
+
+import (
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/siderolabs/omni/internal/backend/services/workloadproxy"
+)
+
+func TestResolvePorts(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		services      map[resource.ID]int
+		listening     map[int]resource.ID
+		wantDesired   map[int]resource.ID
+		wantConflicts map[int][]resource.ID
+		name          string
+	}{
+		{
+			name:          "unique ports",
+			services:      map[resource.ID]int{"a": 40000, "b": 40001},
+			wantDesired:   map[int]resource.ID{40000: "a", 40001: "b"},
+			wantConflicts: map[int][]resource.ID{},
+		},
+		{
+			name:          "collision keeps the listening service",
+			services:      map[resource.ID]int{"a": 40000, "b": 40000, "c": 40001},
+			listening:     map[int]resource.ID{40000: "b"},
+			wantDesired:   map[int]resource.ID{40000: "b", 40001: "c"},
+			wantConflicts: map[int][]resource.ID{40000: {"a", "b"}},
+		},
+		{
+			name:          "collision without a listening service",
+			services:      map[resource.ID]int{"a": 40000, "b": 40000},
+			wantDesired:   map[int]resource.ID{},
+			wantConflicts: map[int][]resource.ID{40000: {"a", "b"}},
+		},
+		{
+			name:          "collision with a listener of another service",
+			services:      map[resource.ID]int{"a": 40000, "b": 40000},
+			listening:     map[int]resource.ID{40000: "gone"},
+			wantDesired:   map[int]resource.ID{},
+			wantConflicts: map[int][]resource.ID{40000: {"a", "b"}},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			desired, conflicts := workloadproxy.ResolvePorts(tt.services, tt.listening)
+
+			assert.Equal(t, tt.wantDesired, desired)
+			assert.Equal(t, tt.wantConflicts, conflicts)
+		})
+	}
+}
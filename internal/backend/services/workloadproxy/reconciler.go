@@ -256,7 +256,46 @@ func (registry *Reconciler) GetProxy(alias string) (http.Handler, resource.ID, e
 		ExpectContinueTimeout: 1 * time.Second,
 	}
 
-	return proxy, clusterID, nil
+	// gRPC needs HTTP/2 end to end: the exposed services are reached over plain TCP, so speak h2c to them.
+	// The response headers of a gRPC stream might only come with its first message, so there is no response header timeout.
+	grpcProtocols := new(http.Protocols)
+	grpcProtocols.SetUnencryptedHTTP2(true)
+
+	grpcProxy := httputil.NewSingleHostReverseProxy(targetURL)
+	grpcProxy.ErrorLog = proxyErrorLogger
+	grpcProxy.FlushInterval = -1
+	grpcProxy.Transport = &http.Transport{
+		DialContext: func(ctx context.Context, network, address string) (net.Conn, error) {
+			return registry.dialProxy(ctx, network, address)
+		},
+		Protocols:       grpcProtocols,
+		IdleConnTimeout: 90 * time.Second,
+	}
+
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if isGRPCRequest(request) {
+			grpcProxy.ServeHTTP(writer, request)
+
+			return
+		}
+
+		proxy.ServeHTTP(writer, request)
+	}), clusterID, nil
+}
+
+// DialAlias opens a TCP connection to the exposed service with the given alias in the cluster.
+func (registry *Reconciler) DialAlias(ctx context.Context, cluster resource.ID, alias string) (net.Conn, error) {
+	destAddress, err := registry.pickDestAddress(cluster, alias)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pick destination address for alias %s in cluster %s: %w", alias, cluster, err)
+	}
+
+	return registry.proxyDialer.DialContext(ctx, "tcp", destAddress)
+}
+
+// isGRPCRequest checks if the request is a gRPC call, which is an HTTP/2 request with the gRPC content type.
+func isGRPCRequest(request *http.Request) bool {
+	return request.ProtoMajor == 2 && strings.HasPrefix(request.Header.Get("Content-Type"), "application/grpc")
 }
 
 func (registry *Reconciler) dialProxy(ctx context.Context, network, address string) (net.Conn, error) {
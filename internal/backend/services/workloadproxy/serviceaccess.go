@@ -0,0 +1,145 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package workloadproxy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/access/role"
+	"github.com/siderolabs/omni/client/pkg/cosi/labels"
+	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth/accesspolicy"
+	"github.com/siderolabs/omni/internal/pkg/auth/actor"
+)
+
+// checkServiceAccess checks the access of the identity in the context to the exposed service with the given alias in the cluster.
+//
+// The identity needs at least the Reader role on the cluster, or the minimum role set on the service if it is higher.
+// If the service allows only some users, by its annotations or by the access policy rules granting the access to it,
+// the identity has to be one of them.
+func checkServiceAccess(ctx context.Context, st state.State, roleProvider RoleProvider, clusterID resource.ID, alias, identityID string) error {
+	accessRole, err := roleProvider.RoleForCluster(ctx, clusterID)
+	if err != nil {
+		return err
+	}
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	exposedService, err := getExposedService(ctx, st, clusterID, alias)
+	if err != nil {
+		return err
+	}
+
+	var access *specs.ExposedServiceSpec_Access
+
+	if exposedService != nil {
+		access = exposedService.TypedSpec().Value.GetAccess()
+	}
+
+	minRole := role.Reader
+
+	if access.GetMinRole() != "" {
+		serviceRole, parseErr := role.Parse(access.GetMinRole())
+		if parseErr != nil {
+			return fmt.Errorf("invalid minimum role of the exposed service %q: %w", alias, parseErr)
+		}
+
+		if minRole, err = role.Max(minRole, serviceRole); err != nil {
+			return err
+		}
+	}
+
+	if err = accessRole.Check(minRole); err != nil {
+		return err
+	}
+
+	accessPolicy, err := safe.StateGetByID[*authres.AccessPolicy](ctx, st, authres.AccessPolicyID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return err
+	}
+
+	identityMD := authres.NewIdentity(identityID).Metadata()
+
+	identity, err := safe.StateGetByID[*authres.Identity](ctx, st, identityID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return err
+	}
+
+	if identity != nil {
+		identityMD = identity.Metadata()
+	}
+
+	restricted := false
+
+	if len(access.GetUsers()) > 0 {
+		restricted = true
+
+		matches, matchErr := accesspolicy.MatchUser(accessPolicy, access.GetUsers(), identityMD)
+		if matchErr != nil {
+			return matchErr
+		}
+
+		if matches {
+			return nil
+		}
+	}
+
+	if access.GetLabelSelector() != "" {
+		restricted = true
+
+		query, parseErr := labels.ParseSelectors([]string{access.GetLabelSelector()})
+		if parseErr != nil {
+			return fmt.Errorf("invalid allowed labels of the exposed service %q: %w", alias, parseErr)
+		}
+
+		if query.Matches(*identityMD.Labels()) {
+			return nil
+		}
+	}
+
+	if accessPolicy != nil {
+		checkResult, checkErr := accesspolicy.CheckExposedService(accessPolicy, omni.NewCluster(clusterID).Metadata(), identityMD, alias)
+		if checkErr != nil {
+			return checkErr
+		}
+
+		if checkResult.Allowed {
+			return nil
+		}
+
+		restricted = restricted || checkResult.Restricted
+	}
+
+	if restricted {
+		return fmt.Errorf("%q is not allowed to access the exposed service %q", identityID, alias)
+	}
+
+	return nil
+}
+
+// getExposedService returns the exposed service with the given alias in the cluster, or nil if there is no such service.
+func getExposedService(ctx context.Context, st state.State, clusterID resource.ID, alias string) (*omni.ExposedService, error) {
+	exposedServices, err := safe.StateListAll[*omni.ExposedService](ctx, st, state.WithLabelQuery(
+		resource.LabelEqual(omni.LabelCluster, clusterID),
+		resource.LabelEqual(omni.LabelExposedServiceAlias, alias),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	if exposedServices.Len() == 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	return exposedServices.Get(0), nil
+}
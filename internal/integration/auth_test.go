@@ -611,6 +611,22 @@ func AssertAPIAuthz(rootCtx context.Context, rootCli *client.Client, clientFacto
 					return err
 				},
 			},
+			{
+				namePrefix:    "mgmt-approve-elevation",
+				requiredRole:  role.Admin,
+				assertSuccess: assertSuccess,
+				assertFailure: assertMissingRoleFailure,
+				fn: func(ctx context.Context, cli *client.Client) error {
+					err := cli.Management().ApproveElevation(ctx, "doesntmatter")
+
+					// ignore "not found" error
+					if status.Code(err) == codes.NotFound {
+						return nil
+					}
+
+					return err
+				},
+			},
 			{
 				namePrefix:    "mgmt-logs",
 				requiredRole:  role.Reader,
@@ -831,6 +847,7 @@ func AssertResourceAuthz(rootCtx context.Context, rootCli *client.Client, client
 		identity := authres.NewIdentity(uuid.New().String())
 		accessPolicy := authres.NewAccessPolicy()
 		samlLabelRule := authres.NewSAMLLabelRule(uuid.New().String())
+		customRole := authres.NewRole(uuid.New().String())
 		cluster := omni.NewCluster(uuid.New().String())
 		cluster.TypedSpec().Value.TalosVersion = "1.2.2"
 		configPatch := omni.NewConfigPatch(uuid.New().String())
@@ -845,6 +862,17 @@ func AssertResourceAuthz(rootCtx context.Context, rootCli *client.Client, client
 		installationMediaConfig := omni.NewInstallationMediaConfig(uuid.NewString())
 		kubernetesManifest := omni.NewKubernetesManifestGroup(uuid.NewString())
 
+		notificationChannel := omni.NewNotificationChannel(uuid.NewString())
+		notificationChannel.TypedSpec().Value.Type = specs.NotificationChannelSpec_WEBHOOK
+		notificationChannel.TypedSpec().Value.Url = "https://example.com/omni-events"
+
+		notificationRule := omni.NewNotificationRule(uuid.NewString())
+		notificationRule.TypedSpec().Value.Channels = []string{notificationChannel.Metadata().ID()}
+
+		gitRepository := omni.NewGitRepository(uuid.NewString())
+		gitRepository.TypedSpec().Value.Url = "https://git.example.com/fleet.git"
+		gitRepository.TypedSpec().Value.Suspend = true
+
 		extensionsConfiguration := omni.NewExtensionsConfiguration(uuid.New().String())
 		extensionsConfiguration.Metadata().Labels().Set(omni.LabelCluster, cluster.Metadata().ID())
 
@@ -892,6 +920,11 @@ func AssertResourceAuthz(rootCtx context.Context, rootCli *client.Client, client
 				allowedVerbSet: allVerbsSet,
 				isAdminOnly:    true,
 			},
+			{
+				resource:       customRole,
+				allowedVerbSet: allVerbsSet,
+				isAdminOnly:    true,
+			},
 			{
 				resource:       omni.NewInfraMachineBMCConfig(uuid.New().String()),
 				allowedVerbSet: allVerbsSet,
@@ -946,6 +979,49 @@ func AssertResourceAuthz(rootCtx context.Context, rootCli *client.Client, client
 				allowedVerbSet: allVerbsSet,
 				isAdminOnly:    true,
 			},
+			{
+				resource:       omni.NewEtcdBackupStoreConfig(),
+				allowedVerbSet: allVerbsSet,
+				isAdminOnly:    true,
+			},
+			{
+				resource:       notificationChannel,
+				allowedVerbSet: allVerbsSet,
+				isAdminOnly:    true,
+			},
+			{
+				resource:       notificationRule,
+				allowedVerbSet: allVerbsSet,
+				isAdminOnly:    true,
+			},
+			{
+				resource:       omni.NewNotificationChannelStatus(uuid.NewString()),
+				allowedVerbSet: readOnlyVerbSet,
+				isAdminOnly:    true,
+			},
+			{
+				resource:       gitRepository,
+				allowedVerbSet: allVerbsSet,
+				isAdminOnly:    true,
+			},
+			{
+				resource:       omni.NewGitCredentials(uuid.NewString()),
+				allowedVerbSet: allVerbsSet,
+				isAdminOnly:    true,
+			},
+			{
+				resource:       omni.NewGitRepositoryStatus(uuid.NewString()),
+				allowedVerbSet: readOnlyVerbSet,
+				isAdminOnly:    true,
+			},
+			{
+				resource:       omni.NewClusterTemplate(uuid.NewString()),
+				allowedVerbSet: allVerbsSet,
+			},
+			{
+				resource:       omni.NewClusterTemplateStatus(uuid.NewString()),
+				allowedVerbSet: readOnlyVerbSet,
+			},
 			{
 				resource:       extensionsConfiguration,
 				allowedVerbSet: allVerbsSet,
@@ -991,6 +1067,22 @@ func AssertResourceAuthz(rootCtx context.Context, rootCli *client.Client, client
 				resource:       omni.NewRotateKubernetesCA(cluster.Metadata().ID()),
 				allowedVerbSet: allVerbsSet,
 			},
+			{
+				resource:       omni.NewRotateEtcdCA(cluster.Metadata().ID()),
+				allowedVerbSet: allVerbsSet,
+			},
+			{
+				resource:       omni.NewRotateKubernetesAggregatorCA(cluster.Metadata().ID()),
+				allowedVerbSet: allVerbsSet,
+			},
+			{
+				resource:       omni.NewRotateKubernetesServiceAccountKey(cluster.Metadata().ID()),
+				allowedVerbSet: allVerbsSet,
+			},
+			{
+				resource:       omni.NewRotateBootstrapToken(cluster.Metadata().ID()),
+				allowedVerbSet: allVerbsSet,
+			},
 			{
 				resource:       kubernetesManifest,
 				allowedVerbSet: allVerbsSet,
@@ -1366,6 +1458,16 @@ func AssertResourceAuthz(rootCtx context.Context, rootCli *client.Client, client
 				allowedVerbSet: readOnlyVerbSet,
 				isAdminOnly:    true,
 			},
+			{
+				resource:       authres.NewElevationRequest(uuid.NewString()),
+				allowedVerbSet: readOnlyVerbSet,
+				isAdminOnly:    true,
+			},
+			{
+				resource:       authres.NewElevationGrant(uuid.NewString()),
+				allowedVerbSet: readOnlyVerbSet,
+				isAdminOnly:    true,
+			},
 			{
 				resource:       omni.NewInfraProviderCombinedStatus(uuid.NewString()),
 				allowedVerbSet: readOnlyVerbSet,
@@ -16,6 +16,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/hashicorp/go-multierror"
 
+	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/access/role"
 	"github.com/siderolabs/omni/client/pkg/cosi/labels"
 	"github.com/siderolabs/omni/client/pkg/omni/resources"
@@ -129,6 +130,18 @@ func Validate(accessPolicy *auth.AccessPolicy) error {
 
 	// check rules
 	for _, rule := range accessPolicySpec.GetRules() {
+		for _, alias := range rule.GetExposedServices().GetAliases() {
+			if _, err := filepath.Match(alias, ""); err != nil {
+				validationErrs = multierror.Append(validationErrs, fmt.Errorf("invalid exposed service alias pattern %q: %w", alias, err))
+			}
+		}
+
+		for _, method := range slices.Concat(rule.GetTalos().GetAllow(), rule.GetTalos().GetDeny()) {
+			if _, err := filepath.Match(method, ""); err != nil {
+				validationErrs = multierror.Append(validationErrs, fmt.Errorf("invalid Talos API method pattern %q: %w", method, err))
+			}
+		}
+
 		if rule.Role != "" {
 			parsedRole, err := role.Parse(rule.Role)
 			if err != nil {
@@ -196,6 +209,33 @@ func Validate(accessPolicy *auth.AccessPolicy) error {
 				checkResult.Role,
 			))
 		}
+
+		for _, expected := range []struct {
+			methods []string
+			allowed bool
+		}{
+			{methods: test.GetExpected().GetTalos().GetAllowed(), allowed: true},
+			{methods: test.GetExpected().GetTalos().GetDenied(), allowed: false},
+		} {
+			for _, method := range expected.methods {
+				allowed, err := CheckTalosMethod(accessPolicy, clusterMD, identityMD, method)
+				if err != nil {
+					validationErrs = multierror.Append(validationErrs, err)
+
+					continue
+				}
+
+				if allowed != expected.allowed {
+					validationErrs = multierror.Append(validationErrs, fmt.Errorf(
+						"access policy test %q failed: talos method %q mismatch: expected allowed %t, got %t",
+						test.GetName(),
+						method,
+						expected.allowed,
+						allowed,
+					))
+				}
+			}
+		}
 	}
 
 	return validationErrs
@@ -203,8 +243,6 @@ func Validate(accessPolicy *auth.AccessPolicy) error {
 
 // Check checks the given user against the given cluster, and returns the result of the check, containing
 // which role is assumed and which groups will be impersonated when the Kubernetes cluster is accessed.
-//
-//nolint:gocognit,gocyclo,cyclop
 func Check(accessPolicy *auth.AccessPolicy, clusterMD, identityMD *resource.Metadata) (CheckResult, error) {
 	if identityMD == nil {
 		return CheckResult{}, errors.New("no user metadata")
@@ -224,136 +262,353 @@ func Check(accessPolicy *auth.AccessPolicy, clusterMD, identityMD *resource.Meta
 	}
 
 	impersonateGroups := make([]string, 0, len(accessPolicySpec.GetRules()))
+	matchesAllClusters := false
 
-	match := func(md *resource.Metadata, exactMatchValue, matchPattern string, selectors []string) (bool, error) {
-		if exactMatchValue != "" && md.ID() == exactMatchValue {
-			return true, nil
+	for _, rule := range accessPolicySpec.GetRules() {
+		userMatches, err := matchUsers(accessPolicySpec, rule.GetUsers(), identityMD)
+		if err != nil {
+			return CheckResult{}, err
 		}
 
-		if matchPattern != "" {
-			matches, err := filepath.Match(matchPattern, md.ID())
-			if err != nil {
-				return false, fmt.Errorf("invalid match pattern %q for %s", matchPattern, md)
-			}
+		if !userMatches {
+			continue
+		}
 
-			if matches {
-				return true, nil
-			}
+		clusterMatches, ruleMatchesAllClusters, err := matchClusters(accessPolicySpec, rule.GetClusters(), clusterMD)
+		if err != nil {
+			return CheckResult{}, err
 		}
 
-		if len(selectors) != 0 && md.Labels() != nil {
-			query, err := labels.ParseSelectors([]string{strings.Join(selectors, ",")})
-			if err != nil {
-				return false, err
+		if !clusterMatches {
+			continue
+		}
+
+		matchesAllClusters = matchesAllClusters || ruleMatchesAllClusters
+
+		if rule.Role != "" {
+			parsedRole, parseErr := role.Parse(rule.Role)
+			if parseErr != nil {
+				return CheckResult{}, parseErr
 			}
 
-			if query.Matches(*md.Labels()) {
-				return true, nil
+			if parsedRole.Check(maxRole) == nil {
+				maxRole = parsedRole
 			}
 		}
 
-		return false, nil
+		impersonateGroups = append(impersonateGroups, rule.GetKubernetes().GetImpersonate().GetGroups()...)
 	}
 
-	matchesAllClusters := false
+	return CheckResult{
+		MatchesAllClusters:          matchesAllClusters,
+		Role:                        maxRole,
+		KubernetesImpersonateGroups: impersonateGroups,
+	}, nil
+}
+
+// ExposedServiceCheckResult is the result of an access policy check of an exposed service.
+type ExposedServiceCheckResult struct {
+	// Restricted is true if any rule grants the access to the exposed service, which limits the access to the users of such rules.
+	Restricted bool
+
+	// Allowed is true if a rule grants the access to the exposed service to the user.
+	Allowed bool
+}
+
+// CheckExposedService checks the rules of the given access policy which grant the access to the exposed service with the given alias in the cluster.
+func CheckExposedService(accessPolicy *auth.AccessPolicy, clusterMD, identityMD *resource.Metadata, alias string) (ExposedServiceCheckResult, error) {
+	if identityMD == nil {
+		return ExposedServiceCheckResult{}, errors.New("no user metadata")
+	}
+
+	if clusterMD == nil {
+		return ExposedServiceCheckResult{}, errors.New("no cluster metadata")
+	}
+
+	accessPolicySpec := accessPolicy.TypedSpec().Value
+
+	var result ExposedServiceCheckResult
 
 	for _, rule := range accessPolicySpec.GetRules() {
-		userMatches := false
+		aliasMatches, err := matchAliases(rule.GetExposedServices().GetAliases(), alias)
+		if err != nil {
+			return ExposedServiceCheckResult{}, err
+		}
 
-		for _, ruleUser := range rule.GetUsers() {
-			if ruleUser == identityMD.ID() {
-				userMatches = true
+		if !aliasMatches {
+			continue
+		}
 
-				break
-			}
+		clusterMatches, _, err := matchClusters(accessPolicySpec, rule.GetClusters(), clusterMD)
+		if err != nil {
+			return ExposedServiceCheckResult{}, err
+		}
 
-			if strings.HasPrefix(ruleUser, GroupPrefix) {
-				groupName := ruleUser[len(GroupPrefix):]
+		if !clusterMatches {
+			continue
+		}
 
-				group, groupOk := accessPolicySpec.GetUserGroups()[groupName]
-				if !groupOk {
-					continue
-				}
+		result.Restricted = true
 
-				for _, groupUser := range group.GetUsers() {
-					matches, err := match(identityMD, groupUser.GetName(), groupUser.GetMatch(), groupUser.GetLabelSelectors())
-					if err != nil {
-						return CheckResult{}, err
-					}
+		userMatches, err := matchUsers(accessPolicySpec, rule.GetUsers(), identityMD)
+		if err != nil {
+			return ExposedServiceCheckResult{}, err
+		}
 
-					if matches {
-						userMatches = true
+		if userMatches {
+			result.Allowed = true
 
-						break
-					}
-				}
-			}
+			return result, nil
+		}
+	}
+
+	return result, nil
+}
+
+// CheckTalosMethod checks whether the rules of the given access policy allow the user to call the Talos API method on the cluster.
+//
+// Only the rules matching both the user and the cluster are considered: the method is denied if it matches the deny list of any of them,
+// or if some of them have an allow list and the method matches none of these lists. Without such rules, all methods are allowed,
+// and the access is governed by the role of the user only.
+func CheckTalosMethod(accessPolicy *auth.AccessPolicy, clusterMD, identityMD *resource.Metadata, fullMethodName string) (bool, error) {
+	if identityMD == nil {
+		return false, errors.New("no user metadata")
+	}
+
+	if clusterMD == nil {
+		return false, errors.New("no cluster metadata")
+	}
+
+	accessPolicySpec := accessPolicy.TypedSpec().Value
+
+	restricted := false
+	allowed := false
+
+	for _, rule := range accessPolicySpec.GetRules() {
+		if len(rule.GetTalos().GetAllow()) == 0 && len(rule.GetTalos().GetDeny()) == 0 {
+			continue
+		}
+
+		userMatches, err := matchUsers(accessPolicySpec, rule.GetUsers(), identityMD)
+		if err != nil {
+			return false, err
 		}
 
 		if !userMatches {
 			continue
 		}
 
-		clusterMatches := false
+		clusterMatches, _, err := matchClusters(accessPolicySpec, rule.GetClusters(), clusterMD)
+		if err != nil {
+			return false, err
+		}
 
-		for _, ruleCluster := range rule.GetClusters() {
-			if ruleCluster == clusterMD.ID() {
-				clusterMatches = true
+		if !clusterMatches {
+			continue
+		}
 
-				break
-			}
+		denied, err := matchTalosMethods(rule.GetTalos().GetDeny(), fullMethodName)
+		if err != nil {
+			return false, err
+		}
+
+		if denied {
+			return false, nil
+		}
 
-			if strings.HasPrefix(ruleCluster, GroupPrefix) {
-				groupName := ruleCluster[len(GroupPrefix):]
+		if len(rule.GetTalos().GetAllow()) == 0 {
+			continue
+		}
 
-				group, groupOk := accessPolicySpec.GetClusterGroups()[groupName]
-				if !groupOk {
-					continue
-				}
+		restricted = true
+
+		ruleAllows, err := matchTalosMethods(rule.GetTalos().GetAllow(), fullMethodName)
+		if err != nil {
+			return false, err
+		}
 
-				for _, groupCluster := range group.GetClusters() {
-					if groupCluster.GetMatch() == "*" {
-						clusterMatches = true
-						matchesAllClusters = true
+		allowed = allowed || ruleAllows
+	}
 
-						break
-					}
+	return !restricted || allowed, nil
+}
 
-					matches, err := match(clusterMD, groupCluster.GetName(), groupCluster.GetMatch(), nil)
-					if err != nil {
-						return CheckResult{}, err
-					}
+// MatchUser checks if the user matches any of the given entries, which are either identities, glob patterns of identities,
+// or user groups of the access policy prefixed with GroupPrefix. The access policy might be nil.
+func MatchUser(accessPolicy *auth.AccessPolicy, entries []string, identityMD *resource.Metadata) (bool, error) {
+	accessPolicySpec := &specs.AccessPolicySpec{}
 
-					if matches {
-						clusterMatches = true
+	if accessPolicy != nil {
+		accessPolicySpec = accessPolicy.TypedSpec().Value
+	}
 
-						break
-					}
-				}
-			}
+	for _, entry := range entries {
+		if strings.HasPrefix(entry, GroupPrefix) {
+			continue
 		}
 
-		if !clusterMatches {
+		matches, err := filepath.Match(entry, identityMD.ID())
+		if err != nil {
+			return false, fmt.Errorf("invalid match pattern %q", entry)
+		}
+
+		if matches {
+			return true, nil
+		}
+	}
+
+	return matchUsers(accessPolicySpec, entries, identityMD)
+}
+
+// matchUsers checks if the user matches any of the users of a rule, which are either identities or user groups prefixed with GroupPrefix.
+func matchUsers(accessPolicySpec *specs.AccessPolicySpec, users []string, identityMD *resource.Metadata) (bool, error) {
+	for _, ruleUser := range users {
+		if ruleUser == identityMD.ID() {
+			return true, nil
+		}
+
+		groupName, isGroup := strings.CutPrefix(ruleUser, GroupPrefix)
+		if !isGroup {
 			continue
 		}
 
-		if rule.Role != "" {
-			parsedRole, err := role.Parse(rule.Role)
+		group, groupOk := accessPolicySpec.GetUserGroups()[groupName]
+		if !groupOk {
+			continue
+		}
+
+		for _, groupUser := range group.GetUsers() {
+			matches, err := match(identityMD, groupUser.GetName(), groupUser.GetMatch(), groupUser.GetLabelSelectors())
 			if err != nil {
-				return CheckResult{}, err
+				return false, err
 			}
 
-			if parsedRole.Check(maxRole) == nil {
-				maxRole = parsedRole
+			if matches {
+				return true, nil
 			}
 		}
+	}
 
-		impersonateGroups = append(impersonateGroups, rule.GetKubernetes().GetImpersonate().GetGroups()...)
+	return false, nil
+}
+
+// matchClusters checks if the cluster matches any of the clusters of a rule, which are either cluster names or cluster groups
+// prefixed with GroupPrefix, and whether the match is a match of all clusters.
+func matchClusters(accessPolicySpec *specs.AccessPolicySpec, clusters []string, clusterMD *resource.Metadata) (matches, matchesAll bool, err error) {
+	for _, ruleCluster := range clusters {
+		if ruleCluster == clusterMD.ID() {
+			matches = true
+
+			break
+		}
+
+		groupName, isGroup := strings.CutPrefix(ruleCluster, GroupPrefix)
+		if !isGroup {
+			continue
+		}
+
+		group, groupOk := accessPolicySpec.GetClusterGroups()[groupName]
+		if !groupOk {
+			continue
+		}
+
+		for _, groupCluster := range group.GetClusters() {
+			if groupCluster.GetMatch() == "*" {
+				matches = true
+				matchesAll = true
+
+				break
+			}
+
+			groupMatches, matchErr := match(clusterMD, groupCluster.GetName(), groupCluster.GetMatch(), nil)
+			if matchErr != nil {
+				return false, false, matchErr
+			}
+
+			if groupMatches {
+				matches = true
+
+				break
+			}
+		}
 	}
 
-	return CheckResult{
-		MatchesAllClusters:          matchesAllClusters,
-		Role:                        maxRole,
-		KubernetesImpersonateGroups: impersonateGroups,
-	}, nil
+	return matches, matchesAll, nil
+}
+
+// matchAliases checks if the alias matches any of the alias patterns of a rule.
+func matchAliases(patterns []string, alias string) (bool, error) {
+	for _, pattern := range patterns {
+		matches, err := filepath.Match(pattern, alias)
+		if err != nil {
+			return false, fmt.Errorf("invalid exposed service alias pattern %q", pattern)
+		}
+
+		if matches {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// matchTalosMethods checks if the Talos API method matches any of the method patterns of a rule.
+//
+// The patterns without the service name are matched against the name of the method only.
+func matchTalosMethods(patterns []string, fullMethodName string) (bool, error) {
+	fullMethodName = strings.TrimLeft(fullMethodName, "/")
+
+	_, methodName, hasService := strings.Cut(fullMethodName, "/")
+	if !hasService {
+		methodName = fullMethodName
+	}
+
+	for _, pattern := range patterns {
+		name := fullMethodName
+
+		if !strings.Contains(pattern, "/") {
+			name = methodName
+		}
+
+		matches, err := filepath.Match(pattern, name)
+		if err != nil {
+			return false, fmt.Errorf("invalid Talos API method pattern %q", pattern)
+		}
+
+		if matches {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func match(md *resource.Metadata, exactMatchValue, matchPattern string, selectors []string) (bool, error) {
+	if exactMatchValue != "" && md.ID() == exactMatchValue {
+		return true, nil
+	}
+
+	if matchPattern != "" {
+		matches, err := filepath.Match(matchPattern, md.ID())
+		if err != nil {
+			return false, fmt.Errorf("invalid match pattern %q for %s", matchPattern, md)
+		}
+
+		if matches {
+			return true, nil
+		}
+	}
+
+	if len(selectors) != 0 && md.Labels() != nil {
+		query, err := labels.ParseSelectors([]string{strings.Join(selectors, ",")})
+		if err != nil {
+			return false, err
+		}
+
+		if query.Matches(*md.Labels()) {
+			return true, nil
+		}
+	}
+
+	return false, nil
 }
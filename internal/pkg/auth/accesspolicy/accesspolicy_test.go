@@ -15,6 +15,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.yaml.in/yaml/v4"
 
+	"github.com/siderolabs/omni/client/api/omni/specs"
 	"github.com/siderolabs/omni/client/pkg/access/role"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/auth"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
@@ -100,6 +101,177 @@ func TestCheck(t *testing.T) {
 	assert.Empty(t, checkResult.KubernetesImpersonateGroups)
 }
 
+func TestCheckExposedService(t *testing.T) {
+	accessPolicy := getAccessPolicy(t, aclValidRaw)
+
+	accessPolicy.TypedSpec().Value.Rules[0].ExposedServices = &specs.AccessPolicyRule_ExposedServices{
+		Aliases: []string{"grafana-*"},
+	}
+
+	for _, tt := range []struct {
+		name     string
+		cluster  string
+		identity string
+		alias    string
+		expected accesspolicy.ExposedServiceCheckResult
+	}{
+		{
+			name:     "allowed",
+			cluster:  "cluster-group-1-cluster-1",
+			identity: "user-group-1-user-1",
+			alias:    "grafana-abcdef",
+			expected: accesspolicy.ExposedServiceCheckResult{Restricted: true, Allowed: true},
+		},
+		{
+			name:     "standalone user",
+			cluster:  "standalone-cluster-1",
+			identity: "standalone-user-1",
+			alias:    "grafana-abcdef",
+			expected: accesspolicy.ExposedServiceCheckResult{Restricted: true, Allowed: true},
+		},
+		{
+			name:     "denied",
+			cluster:  "cluster-group-1-cluster-1",
+			identity: "user-group-2-user-1",
+			alias:    "grafana-abcdef",
+			expected: accesspolicy.ExposedServiceCheckResult{Restricted: true},
+		},
+		{
+			name:     "other alias",
+			cluster:  "cluster-group-1-cluster-1",
+			identity: "user-group-2-user-1",
+			alias:    "prometheus-abcdef",
+		},
+		{
+			name:     "other cluster",
+			cluster:  "cluster-group-2-cluster-1",
+			identity: "user-group-2-user-1",
+			alias:    "grafana-abcdef",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			checkResult, err := accesspolicy.CheckExposedService(accessPolicy,
+				omni.NewCluster(tt.cluster).Metadata(),
+				auth.NewIdentity(tt.identity).Metadata(),
+				tt.alias)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, checkResult)
+		})
+	}
+
+	accessPolicy.TypedSpec().Value.Rules[0].ExposedServices.Aliases = []string{"["}
+
+	assert.ErrorContains(t, accesspolicy.Validate(accessPolicy), "invalid")
+}
+
+func TestCheckTalosMethod(t *testing.T) {
+	accessPolicy := getAccessPolicy(t, aclValidRaw)
+
+	for _, tt := range []struct {
+		name           string
+		cluster        string
+		identity       string
+		fullMethodName string
+		expected       bool
+	}{
+		{
+			name:           "allowed by name",
+			cluster:        "cluster-group-2-cluster-1",
+			identity:       "user-group-2-user-1",
+			fullMethodName: "/machine.MachineService/Logs",
+			expected:       true,
+		},
+		{
+			name:           "allowed by pattern",
+			cluster:        "standalone-cluster-2",
+			identity:       "standalone-user-2",
+			fullMethodName: "/machine.MachineService/ServiceRestart",
+			expected:       true,
+		},
+		{
+			name:           "allowed by service and name",
+			cluster:        "cluster-group-2-cluster-1",
+			identity:       "user-group-2-user-1",
+			fullMethodName: "/machine.MachineService/Reboot",
+			expected:       true,
+		},
+		{
+			name:           "denied",
+			cluster:        "cluster-group-2-cluster-1",
+			identity:       "user-group-2-user-1",
+			fullMethodName: "/machine.MachineService/Reset",
+		},
+		{
+			name:           "denied over allowed",
+			cluster:        "cluster-group-2-cluster-1",
+			identity:       "user-group-2-user-1",
+			fullMethodName: "/machine.MachineService/ServiceStop",
+		},
+		{
+			name:           "not allowed",
+			cluster:        "cluster-group-2-cluster-1",
+			identity:       "user-group-2-user-1",
+			fullMethodName: "/machine.MachineService/Version",
+		},
+		{
+			name:           "other cluster",
+			cluster:        "cluster-group-1-cluster-1",
+			identity:       "user-group-2-user-1",
+			fullMethodName: "/machine.MachineService/Reset",
+			expected:       true,
+		},
+		{
+			name:           "other user",
+			cluster:        "cluster-group-2-cluster-1",
+			identity:       "user-group-1-user-1",
+			fullMethodName: "/machine.MachineService/Reset",
+			expected:       true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			allowed, err := accesspolicy.CheckTalosMethod(accessPolicy,
+				omni.NewCluster(tt.cluster).Metadata(),
+				auth.NewIdentity(tt.identity).Metadata(),
+				tt.fullMethodName)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, allowed)
+		})
+	}
+
+	accessPolicy.TypedSpec().Value.Tests[1].Expected.Talos.Allowed = append(accessPolicy.TypedSpec().Value.Tests[1].Expected.Talos.Allowed, "Upgrade")
+
+	assert.ErrorContains(t, accesspolicy.Validate(accessPolicy), `access policy test "test-2" failed: talos method "Upgrade" mismatch`)
+
+	accessPolicy.TypedSpec().Value.Rules[1].Talos.Deny = []string{"["}
+
+	assert.ErrorContains(t, accesspolicy.Validate(accessPolicy), "invalid Talos API method pattern")
+}
+
+func TestMatchUser(t *testing.T) {
+	accessPolicy := getAccessPolicy(t, aclValidRaw)
+
+	entries := []string{"group/user-group-1", "*@example.com", "standalone-user-2"}
+
+	for identity, expected := range map[string]bool{
+		"user-group-1-user-2": true,
+		"user-group-2-user-1": false,
+		"someone@example.com": true,
+		"someone@example.org": false,
+		"standalone-user-2":   true,
+	} {
+		matches, err := accesspolicy.MatchUser(accessPolicy, entries, auth.NewIdentity(identity).Metadata())
+		require.NoError(t, err)
+		assert.Equal(t, expected, matches, identity)
+	}
+
+	matches, err := accesspolicy.MatchUser(nil, entries, auth.NewIdentity("user-group-1-user-2").Metadata())
+	require.NoError(t, err)
+	assert.False(t, matches)
+
+	_, err = accesspolicy.MatchUser(nil, []string{"["}, auth.NewIdentity("user").Metadata())
+	assert.Error(t, err)
+}
+
 func TestValidateFailingTests(t *testing.T) {
 	accessPolicy := getAccessPolicy(t, aclValidRaw)
 
@@ -21,6 +21,9 @@ import (
 )
 
 // RoleForCluster returns the role of the current user for the given cluster, and whether the role matches all clusters.
+//
+// The role is the highest of the role of the user, the role assigned by the access policy and the role given by the active
+// elevation grants of the user on the cluster.
 func RoleForCluster(ctx context.Context, id resource.ID, st state.State) (role.Role, bool, error) {
 	userRole := role.None
 
@@ -30,48 +33,127 @@ func RoleForCluster(ctx context.Context, id resource.ID, st state.State) (role.R
 
 	ctx = actor.MarkContextAsInternalActor(ctx)
 
-	accessPolicy, err := safe.StateGet[*authres.AccessPolicy](ctx, st, authres.NewAccessPolicy().Metadata())
+	identityVal, identityExists := ctxstore.Value[auth.IdentityContextKey](ctx)
+	if !identityExists {
+		return userRole, false, nil
+	}
+
+	grants, err := auth.ElevationGrants(ctx, st, identityVal.Identity)
 	if err != nil {
-		if state.IsNotFoundError(err) {
-			return userRole, false, nil
-		}
+		return role.None, false, err
+	}
+
+	elevatedRole, elevationMatchesAll, err := auth.ElevatedRole(grants, id)
+	if err != nil {
+		return role.None, false, err
+	}
 
+	policyRole, policyMatchesAll, err := policyRoleForCluster(ctx, id, identityVal.Identity, st)
+	if err != nil {
 		return role.None, false, err
 	}
 
+	maxRole, err := role.Max(userRole, policyRole, elevatedRole)
+	if err != nil {
+		return role.None, false, err
+	}
+
+	return maxRole, policyMatchesAll || elevationMatchesAll, nil
+}
+
+func policyRoleForCluster(ctx context.Context, id resource.ID, identityID string, st state.State) (role.Role, bool, error) {
+	accessPolicy, identity, err := getPolicyAndIdentity(ctx, identityID, st)
+	if err != nil {
+		return role.None, false, err
+	}
+
+	if accessPolicy == nil || identity == nil {
+		return role.None, false, nil
+	}
+
+	checkResult, err := Check(accessPolicy, omni.NewCluster(id).Metadata(), identity.Metadata())
+	if err != nil {
+		return role.None, false, err
+	}
+
+	return checkResult.Role, checkResult.MatchesAllClusters, nil
+}
+
+// CheckTalosAccess checks whether the access policy allows the current user to call the Talos API method on the given cluster.
+//
+// The access is allowed if there is no identity in the context, as the authentication is disabled then.
+func CheckTalosAccess(ctx context.Context, id resource.ID, st state.State, fullMethodName string) (bool, error) {
 	identityVal, identityExists := ctxstore.Value[auth.IdentityContextKey](ctx)
 	if !identityExists {
-		return userRole, false, nil
+		return true, nil
 	}
 
-	identity, err := safe.StateGet[*authres.Identity](ctx, st, authres.NewIdentity(identityVal.Identity).Metadata())
+	accessPolicy, identity, err := getPolicyAndIdentity(actor.MarkContextAsInternalActor(ctx), identityVal.Identity, st)
+	if err != nil {
+		return false, err
+	}
+
+	if accessPolicy == nil || identity == nil {
+		return true, nil
+	}
+
+	return CheckTalosMethod(accessPolicy, omni.NewCluster(id).Metadata(), identity.Metadata(), fullMethodName)
+}
+
+// ClusterMetadata returns the metadata of the cluster the custom roles of the user are matched against.
+//
+// The cluster might not exist yet, e.g. when it is being created, then only its ID is matched.
+func ClusterMetadata(ctx context.Context, id resource.ID, st state.State) (*resource.Metadata, error) {
+	cluster, err := safe.StateGetByID[*omni.Cluster](actor.MarkContextAsInternalActor(ctx), st, id)
 	if err != nil {
 		if state.IsNotFoundError(err) {
-			return userRole, false, nil
+			return omni.NewCluster(id).Metadata(), nil
 		}
 
-		return role.None, false, err
+		return nil, err
 	}
 
-	clusterMD := omni.NewCluster(id).Metadata()
+	return cluster.Metadata(), nil
+}
 
-	checkResult, err := Check(accessPolicy, clusterMD, identity.Metadata())
+// getPolicyAndIdentity returns the access policy and the given identity, either of them is nil if it doesn't exist.
+func getPolicyAndIdentity(ctx context.Context, identityID string, st state.State) (*authres.AccessPolicy, *authres.Identity, error) {
+	accessPolicy, err := safe.StateGet[*authres.AccessPolicy](ctx, st, authres.NewAccessPolicy().Metadata())
 	if err != nil {
-		return role.None, false, err
+		if state.IsNotFoundError(err) {
+			return nil, nil, nil
+		}
+
+		return nil, nil, err
 	}
 
-	maxRole, err := role.Max(userRole, checkResult.Role)
+	identity, err := safe.StateGet[*authres.Identity](ctx, st, authres.NewIdentity(identityID).Metadata())
 	if err != nil {
-		return role.None, false, err
+		if state.IsNotFoundError(err) {
+			return nil, nil, nil
+		}
+
+		return nil, nil, err
 	}
 
-	return maxRole, checkResult.MatchesAllClusters, nil
+	return accessPolicy, identity, nil
 }
 
 // ApplyClusterAccessPolicy checks the ACLs for the user in the context against the given cluster ID.
 // If there is a match and the matched role is higher than the user's role,
 // a child context containing the given role will be returned.
+//
+// The returned context also scopes the custom roles of the user to the cluster.
 func ApplyClusterAccessPolicy(ctx context.Context, clusterName string, st state.State) (context.Context, error) {
+	if auth.PermissionsFromContext(ctx) != nil {
+		cluster, err := ClusterMetadata(ctx, clusterName, st)
+		if err != nil {
+			return nil, err
+		}
+
+		ctx = ctxstore.WithValue(ctx, auth.ClusterContextKey{Cluster: cluster})
+	}
+
 	clusterRole, _, err := RoleForCluster(ctx, clusterName, st)
 	if err != nil {
 		return nil, err
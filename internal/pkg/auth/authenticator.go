@@ -16,6 +16,10 @@ import (
 // Authenticator represents an authenticator.
 type Authenticator struct {
 	Verifier message.SignatureVerifier
+
+	// Permissions are granted by the custom roles of the user, nil if there are none.
+	Permissions *Permissions
+
 	Identity string
 	UserID   string
 	Role     role.Role
@@ -62,6 +62,9 @@ type CheckOption func(*CheckOptions)
 
 // WithRole checks the context to have the given role.
 //
+// The check also passes if the custom roles of the actor grant the gRPC method being called and the role is
+// Reader or Operator, see Permissions.
+//
 // If the required role is other than role.None, WithValidSignature is ignored and the signature is always checked.
 func WithRole(role role.Role) CheckOption {
 	return func(opts *CheckOptions) {
@@ -172,7 +175,7 @@ func Check(ctx context.Context, opt ...CheckOption) (CheckResult, error) {
 		}
 	} else if opts.Role != role.None {
 		err := ctxRole.Check(opts.Role)
-		if err != nil {
+		if err != nil && !methodGranted(ctx, opts.Role) {
 			return CheckResult{}, fmt.Errorf("%w: %v", ErrUnauthorized, err) //nolint:errorlint
 		}
 	}
@@ -8,6 +8,7 @@ package auth
 import (
 	"context"
 
+	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/siderolabs/go-api-signature/pkg/message"
 
 	"github.com/siderolabs/omni/client/pkg/access/role"
@@ -29,6 +30,13 @@ type UserIDContextKey struct{ UserID string }
 // RoleContextKey is the context key for the role. Value has the type role.Role.
 type RoleContextKey struct{ Role role.Role }
 
+// PermissionsContextKey is the context key for the permissions granted by the custom roles of the actor.
+type PermissionsContextKey struct{ Permissions *Permissions }
+
+// ClusterContextKey is the context key for the metadata of the cluster the request is authorized against, the custom roles
+// limited to the clusters apply to the request only when it is set.
+type ClusterContextKey struct{ Cluster *resource.Metadata }
+
 // IdentityContextKey is the context key for the user identity.
 type IdentityContextKey struct{ Identity string }
 
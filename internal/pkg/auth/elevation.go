@@ -0,0 +1,125 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/access/role"
+	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+)
+
+// MaxElevationDuration is the longest elevation which can be requested.
+const MaxElevationDuration = 7 * 24 * time.Hour
+
+// ValidateElevationRequest checks that the elevation request is well-formed.
+func ValidateElevationRequest(spec *specs.ElevationRequestSpec) error {
+	if spec.GetIdentity() == "" {
+		return errors.New("identity must be set")
+	}
+
+	r, err := role.Parse(spec.GetRole())
+	if err != nil {
+		return err
+	}
+
+	if r == role.None || !RoleAssignableByPolicy(r) {
+		return fmt.Errorf("role %q cannot be requested for an elevation", r)
+	}
+
+	for _, cluster := range spec.GetClusters() {
+		if _, err = filepath.Match(cluster, ""); err != nil || cluster == "" {
+			return fmt.Errorf("invalid cluster pattern %q", cluster)
+		}
+	}
+
+	if spec.GetReason() == "" {
+		return errors.New("reason must be set")
+	}
+
+	duration := spec.GetDuration().AsDuration()
+	if duration <= 0 || duration > MaxElevationDuration {
+		return fmt.Errorf("duration must be positive and at most %s", MaxElevationDuration)
+	}
+
+	return nil
+}
+
+// ElevationGrants returns the unexpired elevation grants of the identity.
+//
+// The context must allow reading the ElevationGrant resources, i.e. be marked as an internal actor.
+func ElevationGrants(ctx context.Context, st state.State, identity string) ([]*authres.ElevationGrant, error) {
+	list, err := safe.StateListAll[*authres.ElevationGrant](ctx, st, state.WithLabelQuery(resource.LabelEqual(authres.LabelIdentity, identity)))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	grants := make([]*authres.ElevationGrant, 0, list.Len())
+
+	// the grants are removed on the expiration by the controller, but it might lag behind
+	for grant := range list.All() {
+		if grant.TypedSpec().Value.GetExpiration().AsTime().After(now) {
+			grants = append(grants, grant)
+		}
+	}
+
+	return grants, nil
+}
+
+// ElevatedRole returns the highest role given by the elevation grants on the cluster, and whether the role applies to all clusters.
+//
+// If the cluster is empty, only the grants which are not limited to the clusters apply. The grants for the "*" cluster
+// pattern apply to all clusters, but not to the resources outside of them.
+func ElevatedRole(grants []*authres.ElevationGrant, cluster resource.ID) (role.Role, bool, error) {
+	elevatedRole := role.None
+	matchesAll := false
+
+	for _, grant := range grants {
+		grantMatchesAll := len(grant.TypedSpec().Value.GetClusters()) == 0
+		matches := grantMatchesAll
+
+		for _, pattern := range grant.TypedSpec().Value.GetClusters() {
+			if pattern == "*" {
+				grantMatchesAll = true
+			}
+
+			if cluster == "" {
+				continue
+			}
+
+			if matched, _ := filepath.Match(pattern, cluster); matched { //nolint:errcheck
+				matches = true
+			}
+		}
+
+		if !matches {
+			continue
+		}
+
+		grantRole, err := role.Parse(grant.TypedSpec().Value.GetRole())
+		if err != nil {
+			return role.None, false, err
+		}
+
+		if elevatedRole, err = role.Max(elevatedRole, grantRole); err != nil {
+			return role.None, false, err
+		}
+
+		matchesAll = matchesAll || grantMatchesAll
+	}
+
+	return elevatedRole, matchesAll, nil
+}
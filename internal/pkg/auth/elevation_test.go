@@ -0,0 +1,129 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package auth_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/access/role"
+	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+)
+
+func newElevationGrant(r role.Role, clusters ...string) *authres.ElevationGrant {
+	grant := authres.NewElevationGrant(string(r))
+	grant.TypedSpec().Value.Role = string(r)
+	grant.TypedSpec().Value.Clusters = clusters
+
+	return grant
+}
+
+func TestElevatedRole(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name           string
+		cluster        string
+		grants         []*authres.ElevationGrant
+		wantRole       role.Role
+		wantMatchesAll bool
+	}{
+		{
+			name:     "no grants",
+			cluster:  "prod",
+			wantRole: role.None,
+		},
+		{
+			name:           "global grant",
+			grants:         []*authres.ElevationGrant{newElevationGrant(role.Operator)},
+			wantRole:       role.Operator,
+			wantMatchesAll: true,
+		},
+		{
+			name:     "cluster grant outside of clusters",
+			grants:   []*authres.ElevationGrant{newElevationGrant(role.Admin, "*")},
+			wantRole: role.None,
+		},
+		{
+			name:           "wildcard cluster grant",
+			cluster:        "prod",
+			grants:         []*authres.ElevationGrant{newElevationGrant(role.Admin, "*")},
+			wantRole:       role.Admin,
+			wantMatchesAll: true,
+		},
+		{
+			name:    "matching cluster grant",
+			cluster: "prod-1",
+			grants: []*authres.ElevationGrant{
+				newElevationGrant(role.Admin, "staging-*"),
+				newElevationGrant(role.Operator, "prod-*"),
+			},
+			wantRole: role.Operator,
+		},
+		{
+			name:     "non-matching cluster grant",
+			cluster:  "dev",
+			grants:   []*authres.ElevationGrant{newElevationGrant(role.Admin, "prod-*")},
+			wantRole: role.None,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			elevatedRole, matchesAll, err := auth.ElevatedRole(tt.grants, tt.cluster)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantRole, elevatedRole)
+			assert.Equal(t, tt.wantMatchesAll, matchesAll)
+		})
+	}
+}
+
+func TestValidateElevationRequest(t *testing.T) {
+	t.Parallel()
+
+	valid := func() *specs.ElevationRequestSpec {
+		return &specs.ElevationRequestSpec{
+			Identity: "user@example.com",
+			Role:     string(role.Admin),
+			Clusters: []string{"prod-*"},
+			Reason:   "incident",
+			Duration: durationpb.New(time.Hour),
+		}
+	}
+
+	require.NoError(t, auth.ValidateElevationRequest(valid()))
+
+	tooLong := durationpb.New(auth.MaxElevationDuration + time.Hour)
+
+	for _, tt := range []struct {
+		modify func(spec *specs.ElevationRequestSpec)
+		name   string
+	}{
+		{name: "no identity", modify: func(spec *specs.ElevationRequestSpec) { spec.Identity = "" }},
+		{name: "unknown role", modify: func(spec *specs.ElevationRequestSpec) { spec.Role = "Superuser" }},
+		{name: "infra provider role", modify: func(spec *specs.ElevationRequestSpec) { spec.Role = string(role.InfraProvider) }},
+		{name: "invalid cluster pattern", modify: func(spec *specs.ElevationRequestSpec) { spec.Clusters = []string{"prod-["} }},
+		{name: "no reason", modify: func(spec *specs.ElevationRequestSpec) { spec.Reason = "" }},
+		{name: "no duration", modify: func(spec *specs.ElevationRequestSpec) { spec.Duration = nil }},
+		{name: "too long", modify: func(spec *specs.ElevationRequestSpec) { spec.Duration = tooLong }},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			spec := valid()
+			tt.modify(spec)
+
+			assert.Error(t, auth.ValidateElevationRequest(spec))
+		})
+	}
+}
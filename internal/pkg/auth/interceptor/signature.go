@@ -132,5 +132,9 @@ func (i *Signature) intercept(ctx context.Context) (context.Context, error) {
 	ctx = ctxstore.WithValue(ctx, auth.RoleContextKey{Role: authenticator.Role})
 	ctx = ctxstore.WithValue(ctx, auth.FingerprintContextKey{Fingerprint: signature.KeyFingerprint})
 
+	if authenticator.Permissions != nil {
+		ctx = ctxstore.WithValue(ctx, auth.PermissionsContextKey{Permissions: authenticator.Permissions})
+	}
+
 	return ctx, nil
 }
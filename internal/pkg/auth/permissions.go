@@ -0,0 +1,296 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc"
+
+	"github.com/siderolabs/omni/client/api/omni/management"
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/access/role"
+	"github.com/siderolabs/omni/client/pkg/cosi/labels"
+	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/internal/pkg/ctxstore"
+)
+
+var roleVerbs = map[string]state.Verb{
+	"get":     state.Get,
+	"list":    state.List,
+	"watch":   state.Watch,
+	"create":  state.Create,
+	"update":  state.Update,
+	"destroy": state.Destroy,
+}
+
+// Permissions are the permissions granted to the actor by its custom roles, on top of its built-in role.
+//
+// The nil Permissions grant nothing.
+type Permissions struct {
+	rules []permissionRule
+}
+
+type permissionRule struct {
+	resourceTypes    []resource.Type
+	verbs            []state.Verb
+	clusters         []string
+	clusterSelectors resource.LabelQueries
+	methods          []string
+}
+
+// NewPermissions compiles the rules of the given custom roles.
+func NewPermissions(roles ...*authres.Role) (*Permissions, error) {
+	permissions := &Permissions{}
+
+	for _, r := range roles {
+		for i, rule := range r.TypedSpec().Value.GetRules() {
+			compiled, err := compileRoleRule(rule)
+			if err != nil {
+				return nil, fmt.Errorf("role %q rule %d: %w", r.Metadata().ID(), i, err)
+			}
+
+			permissions.rules = append(permissions.rules, compiled)
+		}
+	}
+
+	return permissions, nil
+}
+
+// LoadPermissions reads the custom roles with the given IDs and compiles their rules.
+//
+// The roles which do not exist are skipped, so removing a role revokes its permissions. The context must allow
+// reading the Role resources, i.e. be marked as an internal actor.
+func LoadPermissions(ctx context.Context, st state.State, ids []string) (*Permissions, error) {
+	if len(ids) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	roles := make([]*authres.Role, 0, len(ids))
+
+	for _, id := range ids {
+		r, err := safe.StateGetByID[*authres.Role](ctx, st, id)
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		roles = append(roles, r)
+	}
+
+	return NewPermissions(roles...)
+}
+
+// CheckCustomRoles checks that the custom roles with the given IDs exist, so that they can be assigned.
+func CheckCustomRoles(ctx context.Context, st state.State, ids []string) error {
+	for _, id := range ids {
+		if err := ValidateCustomRoleID(id); err != nil {
+			return err
+		}
+
+		if _, err := st.Get(ctx, authres.NewRole(id).Metadata()); err != nil {
+			return fmt.Errorf("custom role %q: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateRoleRule checks that the Role rule is well-formed.
+func ValidateRoleRule(rule *specs.RoleSpec_Rule) error {
+	_, err := compileRoleRule(rule)
+
+	return err
+}
+
+// ValidateCustomRoleID checks that the ID can be used for a custom role.
+func ValidateCustomRoleID(id string) error {
+	if id == "" {
+		return errors.New("custom role ID must not be empty")
+	}
+
+	if _, err := role.Parse(id); err == nil {
+		return fmt.Errorf("custom role ID %q conflicts with the built-in role", id)
+	}
+
+	return nil
+}
+
+func compileRoleRule(rule *specs.RoleSpec_Rule) (permissionRule, error) {
+	var compiled permissionRule
+
+	if len(rule.GetResourceTypes()) == 0 && len(rule.GetApis()) == 0 {
+		return compiled, errors.New("rule must grant access to resource types or API methods")
+	}
+
+	if len(rule.GetResourceTypes()) > 0 && len(rule.GetVerbs()) == 0 {
+		return compiled, errors.New("rule must have verbs for the resource types")
+	}
+
+	for _, resourceType := range rule.GetResourceTypes() {
+		if resourceType == "" {
+			return compiled, errors.New("resource type must not be empty")
+		}
+
+		compiled.resourceTypes = append(compiled.resourceTypes, resourceType)
+	}
+
+	for _, verb := range rule.GetVerbs() {
+		if verb == authres.RoleRuleWildcard {
+			compiled.verbs = append(compiled.verbs, state.Get, state.List, state.Watch, state.Create, state.Update, state.Destroy)
+
+			continue
+		}
+
+		parsed, ok := roleVerbs[verb]
+		if !ok {
+			return compiled, fmt.Errorf("unknown verb %q, must be one of %v", verb, authres.RoleVerbs)
+		}
+
+		compiled.verbs = append(compiled.verbs, parsed)
+	}
+
+	for _, cluster := range rule.GetClusters() {
+		if _, err := filepath.Match(cluster, ""); err != nil || cluster == "" {
+			return compiled, fmt.Errorf("invalid cluster pattern %q", cluster)
+		}
+
+		compiled.clusters = append(compiled.clusters, cluster)
+	}
+
+	for _, selector := range rule.GetClusterLabelSelectors() {
+		query, err := labels.ParseQuery(selector)
+		if err != nil {
+			return compiled, fmt.Errorf("invalid cluster label selector %q: %w", selector, err)
+		}
+
+		compiled.clusterSelectors = append(compiled.clusterSelectors, *query)
+	}
+
+	for _, api := range rule.GetApis() {
+		if !isManagementMethod(api) {
+			return compiled, fmt.Errorf("unknown ManagementService method %q", api)
+		}
+
+		compiled.methods = append(compiled.methods, "/"+management.ManagementService_ServiceDesc.ServiceName+"/"+api)
+	}
+
+	return compiled, nil
+}
+
+func isManagementMethod(name string) bool {
+	desc := management.ManagementService_ServiceDesc
+
+	return slices.ContainsFunc(desc.Methods, func(method grpc.MethodDesc) bool { return method.MethodName == name }) ||
+		slices.ContainsFunc(desc.Streams, func(stream grpc.StreamDesc) bool { return stream.StreamName == name })
+}
+
+// AllowsResource reports whether the permissions grant the verb on the resource type.
+//
+// The cluster is the metadata of the cluster the resource belongs to, the rules are matched against its ID and labels.
+// It is nil if the resource is not related to a cluster or if the access spans all clusters, and then only the rules
+// which are not limited to the clusters apply.
+func (p *Permissions) AllowsResource(resourceType resource.Type, verb state.Verb, cluster *resource.Metadata) bool {
+	if p == nil {
+		return false
+	}
+
+	for _, rule := range p.rules {
+		if !rule.matchesCluster(cluster) || !slices.Contains(rule.verbs, verb) {
+			continue
+		}
+
+		if slices.Contains(rule.resourceTypes, authres.RoleRuleWildcard) || slices.Contains(rule.resourceTypes, resourceType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AllowsMethod reports whether the permissions grant calling the gRPC method, given as the full method name.
+//
+// The cluster has the same meaning as in AllowsResource.
+func (p *Permissions) AllowsMethod(fullMethod string, cluster *resource.Metadata) bool {
+	if p == nil {
+		return false
+	}
+
+	for _, rule := range p.rules {
+		if rule.matchesCluster(cluster) && slices.Contains(rule.methods, fullMethod) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r permissionRule) matchesCluster(cluster *resource.Metadata) bool {
+	if len(r.clusters) == 0 && len(r.clusterSelectors) == 0 {
+		return true
+	}
+
+	if cluster == nil {
+		return false
+	}
+
+	for _, pattern := range r.clusters {
+		if matched, _ := filepath.Match(pattern, cluster.ID()); matched { //nolint:errcheck
+			return true
+		}
+	}
+
+	return len(r.clusterSelectors) > 0 && r.clusterSelectors.Matches(*cluster.Labels())
+}
+
+// PermissionsFromContext returns the permissions granted by the custom roles of the actor in the context, nil if there are none.
+func PermissionsFromContext(ctx context.Context) *Permissions {
+	if val, ok := ctxstore.Value[PermissionsContextKey](ctx); ok {
+		return val.Permissions
+	}
+
+	return nil
+}
+
+// methodGranted reports whether the custom roles of the actor grant the gRPC method being called.
+//
+// A granted method satisfies the role checks up to Operator, the methods requiring a higher or an exact role
+// can't be granted by a custom role, so the custom roles never give access to the Admin APIs, e.g. managing the users.
+//
+// The rules limited to the clusters grant the method only when the request is authorized against a single cluster.
+func methodGranted(ctx context.Context, required role.Role) bool {
+	if required != role.Reader && required != role.Operator {
+		return false
+	}
+
+	permissions := PermissionsFromContext(ctx)
+	if permissions == nil {
+		return false
+	}
+
+	method, ok := grpc.Method(ctx)
+	if !ok {
+		return false
+	}
+
+	var cluster *resource.Metadata
+
+	if val, ok := ctxstore.Value[ClusterContextKey](ctx); ok {
+		cluster = val.Cluster
+	}
+
+	return permissions.AllowsMethod(method, cluster)
+}
@@ -0,0 +1,226 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package auth_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/access/role"
+	authres "github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/auth"
+	"github.com/siderolabs/omni/internal/pkg/ctxstore"
+)
+
+func newRole(id string, rules ...*specs.RoleSpec_Rule) *authres.Role {
+	r := authres.NewRole(id)
+	r.TypedSpec().Value.Rules = rules
+
+	return r
+}
+
+func TestPermissions(t *testing.T) {
+	t.Parallel()
+
+	permissions, err := auth.NewPermissions(
+		newRole("machine-viewer", &specs.RoleSpec_Rule{
+			ResourceTypes: []string{omni.MachineStatusType},
+			Verbs:         []string{"get", "list"},
+		}),
+		newRole("staging-operator", &specs.RoleSpec_Rule{
+			ResourceTypes: []string{authres.RoleRuleWildcard},
+			Verbs:         []string{authres.RoleRuleWildcard},
+			Clusters:      []string{"staging-*"},
+			Apis:          []string{"Kubeconfig"},
+		}),
+		newRole("dev-upgrader", &specs.RoleSpec_Rule{
+			ResourceTypes:         []string{omni.MachineSetType},
+			Verbs:                 []string{"update"},
+			ClusterLabelSelectors: []string{"env=dev", "env=test"},
+			Apis:                  []string{"Talosconfig"},
+		}),
+	)
+	require.NoError(t, err)
+
+	staging := newCluster("staging-1", "prod")
+	prod := newCluster("prod", "prod")
+	dev := newCluster("dev-1", "dev")
+
+	assert.True(t, permissions.AllowsResource(omni.MachineStatusType, state.Get, nil))
+	assert.True(t, permissions.AllowsResource(omni.MachineStatusType, state.List, prod))
+	assert.False(t, permissions.AllowsResource(omni.MachineStatusType, state.Watch, nil))
+
+	assert.True(t, permissions.AllowsResource(omni.ClusterType, state.Update, staging))
+	assert.False(t, permissions.AllowsResource(omni.ClusterType, state.Update, prod))
+	assert.False(t, permissions.AllowsResource(omni.ClusterType, state.Update, nil))
+
+	assert.True(t, permissions.AllowsResource(omni.MachineSetType, state.Update, dev))
+	assert.True(t, permissions.AllowsResource(omni.MachineSetType, state.Update, newCluster("test-1", "test")))
+	assert.False(t, permissions.AllowsResource(omni.MachineSetType, state.Update, prod))
+	assert.False(t, permissions.AllowsResource(omni.MachineSetType, state.Update, omni.NewCluster("dev-2").Metadata()))
+	assert.False(t, permissions.AllowsResource(omni.MachineSetType, state.Update, nil))
+
+	assert.True(t, permissions.AllowsMethod("/management.ManagementService/Kubeconfig", staging))
+	assert.False(t, permissions.AllowsMethod("/management.ManagementService/Kubeconfig", prod))
+	assert.False(t, permissions.AllowsMethod("/management.ManagementService/Talosconfig", staging))
+	assert.True(t, permissions.AllowsMethod("/management.ManagementService/Talosconfig", dev))
+
+	var none *auth.Permissions
+
+	assert.False(t, none.AllowsResource(omni.MachineStatusType, state.Get, nil))
+	assert.False(t, none.AllowsMethod("/management.ManagementService/Kubeconfig", nil))
+}
+
+func newCluster(id, env string) *resource.Metadata {
+	cluster := omni.NewCluster(id)
+	cluster.Metadata().Labels().Set("env", env)
+
+	return cluster.Metadata()
+}
+
+// methodStream is the server transport stream of a call of the gRPC method.
+type methodStream struct {
+	grpc.ServerTransportStream
+
+	method string
+}
+
+func (s methodStream) Method() string {
+	return s.method
+}
+
+func TestMethodGranted(t *testing.T) {
+	t.Parallel()
+
+	permissions, err := auth.NewPermissions(
+		newRole("escalator", &specs.RoleSpec_Rule{
+			// the ServiceAccount and User management methods require Admin
+			Apis: []string{"CreateServiceAccount", "RenewServiceAccount", "Kubeconfig"},
+		}),
+		newRole("staging-talosconfig", &specs.RoleSpec_Rule{
+			Apis:     []string{"Talosconfig"},
+			Clusters: []string{"staging-*"},
+		}),
+	)
+	require.NoError(t, err)
+
+	callContext := func(method string, cluster *resource.Metadata) context.Context {
+		ctx := ctxstore.WithValue(t.Context(), auth.EnabledAuthContextKey{Enabled: true})
+		ctx = ctxstore.WithValue(ctx, auth.RoleContextKey{Role: role.Reader})
+		ctx = ctxstore.WithValue(ctx, auth.PermissionsContextKey{Permissions: permissions})
+
+		if cluster != nil {
+			ctx = ctxstore.WithValue(ctx, auth.ClusterContextKey{Cluster: cluster})
+		}
+
+		return grpc.NewContextWithServerTransportStream(ctx, methodStream{method: "/management.ManagementService/" + method})
+	}
+
+	for _, tt := range []struct {
+		cluster *resource.Metadata
+		name    string
+		method  string
+		opts    []auth.CheckOption
+		granted bool
+	}{
+		{name: "operator method", method: "Kubeconfig", opts: []auth.CheckOption{auth.WithRole(role.Operator)}, granted: true},
+		{name: "reader method", method: "Kubeconfig", opts: []auth.CheckOption{auth.WithRole(role.Reader)}, granted: true},
+		{name: "admin method", method: "CreateServiceAccount", opts: []auth.CheckOption{auth.WithRole(role.Admin)}},
+		{name: "another admin method", method: "RenewServiceAccount", opts: []auth.CheckOption{auth.WithRole(role.Admin)}},
+		{name: "exact role", method: "Kubeconfig", opts: []auth.CheckOption{auth.WithExactRoles(role.Operator)}},
+		{name: "not granted method", method: "MachineLogs", opts: []auth.CheckOption{auth.WithRole(role.Operator)}},
+		{name: "cluster method", method: "Talosconfig", cluster: newCluster("staging-1", "prod"), opts: []auth.CheckOption{auth.WithRole(role.Operator)}, granted: true},
+		{name: "cluster method on other cluster", method: "Talosconfig", cluster: newCluster("prod", "prod"), opts: []auth.CheckOption{auth.WithRole(role.Operator)}},
+		{name: "cluster method without cluster", method: "Talosconfig", opts: []auth.CheckOption{auth.WithRole(role.Operator)}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := auth.Check(callContext(tt.method, tt.cluster), tt.opts...)
+			if tt.granted {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, auth.ErrUnauthorized)
+			}
+		})
+	}
+}
+
+func TestValidateRoleRule(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		rule    *specs.RoleSpec_Rule
+		name    string
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			rule: &specs.RoleSpec_Rule{ResourceTypes: []string{omni.ClusterType}, Verbs: []string{"get"}, Clusters: []string{"prod-*"}},
+		},
+		{
+			name: "apis only",
+			rule: &specs.RoleSpec_Rule{Apis: []string{"Talosconfig"}},
+		},
+		{
+			name:    "empty",
+			rule:    &specs.RoleSpec_Rule{},
+			wantErr: true,
+		},
+		{
+			name:    "no verbs",
+			rule:    &specs.RoleSpec_Rule{ResourceTypes: []string{omni.ClusterType}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown verb",
+			rule:    &specs.RoleSpec_Rule{ResourceTypes: []string{omni.ClusterType}, Verbs: []string{"teardown"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid cluster pattern",
+			rule:    &specs.RoleSpec_Rule{ResourceTypes: []string{omni.ClusterType}, Verbs: []string{"get"}, Clusters: []string{"prod-["}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid cluster label selector",
+			rule:    &specs.RoleSpec_Rule{ResourceTypes: []string{omni.ClusterType}, Verbs: []string{"get"}, ClusterLabelSelectors: []string{"env in dev"}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown api",
+			rule:    &specs.RoleSpec_Rule{Apis: []string{"DoEverything"}},
+			wantErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := auth.ValidateRoleRule(tt.rule)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateCustomRoleID(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, auth.ValidateCustomRoleID("machine-viewer"))
+	assert.Error(t, auth.ValidateCustomRoleID(""))
+	assert.Error(t, auth.ValidateCustomRoleID(string(role.Admin)))
+}
@@ -8,6 +8,7 @@ package serviceaccount
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/cosi-project/runtime/pkg/resource"
@@ -27,7 +28,7 @@ import (
 )
 
 // Create a service account.
-func Create(ctx context.Context, st state.State, name, userRole string, useUserRole bool, armoredPGPPublicKey []byte) (string, error) {
+func Create(ctx context.Context, st state.State, name, userRole string, useUserRole bool, armoredPGPPublicKey []byte, customRoles ...string) (string, error) {
 	sa := access.ParseServiceAccountFromName(name)
 	saRole := role.Admin
 
@@ -35,6 +36,10 @@ func Create(ctx context.Context, st state.State, name, userRole string, useUserR
 		return "", fmt.Errorf("infra provider service accounts must have the role %q, but use-user-role was requested", role.InfraProvider)
 	}
 
+	if len(customRoles) > 0 && sa.IsInfraProvider {
+		return "", errors.New("infra provider service accounts can't have custom roles")
+	}
+
 	if !useUserRole {
 		var err error
 		if saRole, err = role.Parse(userRole); err != nil {
@@ -68,6 +73,10 @@ func Create(ctx context.Context, st state.State, name, userRole string, useUserR
 		return "", err
 	}
 
+	if err = auth.CheckCustomRoles(ctx, st, customRoles); err != nil {
+		return "", err
+	}
+
 	key, err := access.ValidatePGPPublicKey(
 		armoredPGPPublicKey,
 		pgp.WithMaxAllowedLifetime(auth.ServiceAccountMaxAllowedLifetime),
@@ -119,6 +128,7 @@ func Create(ctx context.Context, st state.State, name, userRole string, useUserR
 	// create the user resource representing the service account with the same scopes as the public key
 	user := authres.NewUser(newUserID)
 	user.TypedSpec().Value.Role = publicKeyResource.TypedSpec().Value.GetRole()
+	user.TypedSpec().Value.CustomRoles = customRoles
 
 	if sa.IsInfraProvider {
 		user.Metadata().Labels().Set(authres.LabelInfraProvider, "")
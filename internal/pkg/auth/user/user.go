@@ -21,6 +21,7 @@ import (
 
 	"github.com/siderolabs/omni/client/pkg/access/role"
 	"github.com/siderolabs/omni/client/pkg/omni/resources/auth"
+	pkgauth "github.com/siderolabs/omni/internal/pkg/auth"
 	"github.com/siderolabs/omni/internal/pkg/auth/actor"
 )
 
@@ -105,9 +106,9 @@ func ElevateRecoveryAdmin(ctx context.Context, st state.State, logger *zap.Logge
 	return nil
 }
 
-// Create creates a new user with the given email and role.
+// Create creates a new user with the given email, role and custom roles.
 // It returns the user ID. It fails if a user with the given email already exists.
-func Create(ctx context.Context, st state.State, email, userRole string) (string, error) {
+func Create(ctx context.Context, st state.State, email, userRole string, customRoles ...string) (string, error) {
 	email = strings.ToLower(email)
 
 	parsedRole, err := role.Parse(userRole)
@@ -126,7 +127,11 @@ func Create(ctx context.Context, st state.State, email, userRole string) (string
 		return "", err
 	}
 
-	return createIdentityAndUser(ctx, st, email, parsedRole)
+	if err = pkgauth.CheckCustomRoles(ctx, st, customRoles); err != nil {
+		return "", err
+	}
+
+	return createIdentityAndUser(ctx, st, email, parsedRole, customRoles)
 }
 
 // Update updates the role of the user with the given email.
@@ -158,6 +163,34 @@ func Update(ctx context.Context, st state.State, email, userRole string) error {
 	return err
 }
 
+// UpdateCustomRoles replaces the custom roles of the user with the given email.
+func UpdateCustomRoles(ctx context.Context, st state.State, email string, customRoles []string) error {
+	email = strings.ToLower(email)
+
+	ctx = actor.MarkContextAsInternalActor(ctx)
+
+	if err := pkgauth.CheckCustomRoles(ctx, st, customRoles); err != nil {
+		return err
+	}
+
+	identity, err := safe.StateGet[*auth.Identity](ctx, st, auth.NewIdentity(email).Metadata())
+	if err != nil {
+		return err
+	}
+
+	_, err = safe.StateUpdateWithConflicts(
+		ctx, st,
+		auth.NewUser(identity.TypedSpec().Value.UserId).Metadata(),
+		func(user *auth.User) error {
+			user.TypedSpec().Value.CustomRoles = customRoles
+
+			return nil
+		},
+	)
+
+	return err
+}
+
 // Destroy destroys the user with the given email, cleaning up Identity and User resources.
 func Destroy(ctx context.Context, st state.State, email string) error {
 	email = strings.ToLower(email)
@@ -204,9 +237,9 @@ func Destroy(ctx context.Context, st state.State, email string) error {
 	return destroyErr
 }
 
-// Ensure creates the auth.User and auth.Identity resources with the given role if they are not already present.
-// If the user already exists and updateRole is true, it updates the role.
-func Ensure(ctx context.Context, st state.State, email string, r role.Role, updateRole bool) error {
+// Ensure creates the auth.User and auth.Identity resources with the given role and custom roles if they are not already present.
+// If the user already exists and updateRole is true, it updates the role and the custom roles.
+func Ensure(ctx context.Context, st state.State, email string, r role.Role, updateRole bool, customRoles ...string) error {
 	email = strings.ToLower(email)
 
 	identity, err := safe.StateGet[*auth.Identity](ctx, st, auth.NewIdentity(email).Metadata())
@@ -216,7 +249,7 @@ func Ensure(ctx context.Context, st state.State, email string, r role.Role, upda
 		}
 
 		// User does not exist, create it.
-		_, createErr := createIdentityAndUser(ctx, st, email, r)
+		_, createErr := createIdentityAndUser(ctx, st, email, r, customRoles)
 
 		return createErr
 	}
@@ -228,6 +261,7 @@ func Ensure(ctx context.Context, st state.State, email string, r role.Role, upda
 
 	return safe.StateModify(ctx, st, auth.NewUser(identity.TypedSpec().Value.UserId), func(res *auth.User) error {
 		res.TypedSpec().Value.Role = string(r)
+		res.TypedSpec().Value.CustomRoles = customRoles
 
 		return nil
 	})
@@ -235,7 +269,7 @@ func Ensure(ctx context.Context, st state.State, email string, r role.Role, upda
 
 // createIdentityAndUser creates the Identity and User resources for a new user.
 // It cleans up the Identity if User creation fails.
-func createIdentityAndUser(ctx context.Context, st state.State, email string, r role.Role) (string, error) {
+func createIdentityAndUser(ctx context.Context, st state.State, email string, r role.Role, customRoles []string) (string, error) {
 	newUserID := uuid.NewString()
 
 	identity := auth.NewIdentity(email)
@@ -248,6 +282,7 @@ func createIdentityAndUser(ctx context.Context, st state.State, email string, r
 
 	user := auth.NewUser(newUserID)
 	user.TypedSpec().Value.Role = string(r)
+	user.TypedSpec().Value.CustomRoles = customRoles
 
 	if err := st.Create(ctx, user); err != nil {
 		_ = st.Destroy(ctx, identity.Metadata()) //nolint:errcheck // best-effort cleanup
@@ -6,6 +6,72 @@ import (
 	"time"
 )
 
+func (s *APIActorRateLimit) GetMaxConcurrentRequests() uint64 {
+	if s == nil || s.MaxConcurrentRequests == nil {
+		return *new(uint64)
+	}
+	return *s.MaxConcurrentRequests
+}
+
+func (s *APIActorRateLimit) SetMaxConcurrentRequests(v uint64) {
+	s.MaxConcurrentRequests = &v
+}
+
+func (s *APIActorRateLimit) GetRequestsBurst() uint64 {
+	if s == nil || s.RequestsBurst == nil {
+		return *new(uint64)
+	}
+	return *s.RequestsBurst
+}
+
+func (s *APIActorRateLimit) SetRequestsBurst(v uint64) {
+	s.RequestsBurst = &v
+}
+
+func (s *APIActorRateLimit) GetRequestsPerSecond() uint64 {
+	if s == nil || s.RequestsPerSecond == nil {
+		return *new(uint64)
+	}
+	return *s.RequestsPerSecond
+}
+
+func (s *APIActorRateLimit) SetRequestsPerSecond(v uint64) {
+	s.RequestsPerSecond = &v
+}
+
+func (s *APIMethodRateLimit) GetMaxConcurrentRequests() uint64 {
+	if s == nil || s.MaxConcurrentRequests == nil {
+		return *new(uint64)
+	}
+	return *s.MaxConcurrentRequests
+}
+
+func (s *APIMethodRateLimit) SetMaxConcurrentRequests(v uint64) {
+	s.MaxConcurrentRequests = &v
+}
+
+func (s *APIMethodRateLimit) GetRequestsBurst() uint64 {
+	if s == nil || s.RequestsBurst == nil {
+		return *new(uint64)
+	}
+	return *s.RequestsBurst
+}
+
+func (s *APIMethodRateLimit) SetRequestsBurst(v uint64) {
+	s.RequestsBurst = &v
+}
+
+func (s *APIMethodRateLimit) GetRequestsPerSecond() uint64 {
+	if s == nil || s.RequestsPerSecond == nil {
+		return *new(uint64)
+	}
+	return *s.RequestsPerSecond
+}
+
+func (s *APIMethodRateLimit) SetRequestsPerSecond(v uint64) {
+	s.RequestsPerSecond = &v
+}
+
 func (s *Account) GetId() string {
 	if s == nil || s.Id == nil {
 		return *new(string)
@@ -138,6 +204,28 @@ func (s *BoltDB) SetPath(v string) {
 	s.Path = &v
 }
 
+func (s *ClusterAutoscalerService) GetAdvertisedEndpoint() string {
+	if s == nil || s.AdvertisedEndpoint == nil {
+		return *new(string)
+	}
+	return *s.AdvertisedEndpoint
+}
+
+func (s *ClusterAutoscalerService) SetAdvertisedEndpoint(v string) {
+	s.AdvertisedEndpoint = &v
+}
+
+func (s *ClusterAutoscalerService) GetEndpoint() string {
+	if s == nil || s.Endpoint == nil {
+		return *new(string)
+	}
+	return *s.Endpoint
+}
+
+func (s *ClusterAutoscalerService) SetEndpoint(v string) {
+	s.Endpoint = &v
+}
+
 func (s *DebugPprof) GetEndpoint() string {
 	if s == nil || s.Endpoint == nil {
 		return *new(string)
@@ -303,6 +391,61 @@ func (s *EtcdBackup) SetS3Enabled(v bool) {
 	s.S3Enabled = &v
 }
 
+func (s *EtcdBackup) GetSelfBackupInterval() time.Duration {
+	if s == nil || s.SelfBackupInterval == nil {
+		return *new(time.Duration)
+	}
+	return *s.SelfBackupInterval
+}
+
+func (s *EtcdBackup) SetSelfBackupInterval(v time.Duration) {
+	s.SelfBackupInterval = &v
+}
+
+func (s *EtcdBackup) GetSelfBackupKeepDaily() uint32 {
+	if s == nil || s.SelfBackupKeepDaily == nil {
+		return *new(uint32)
+	}
+	return *s.SelfBackupKeepDaily
+}
+
+func (s *EtcdBackup) SetSelfBackupKeepDaily(v uint32) {
+	s.SelfBackupKeepDaily = &v
+}
+
+func (s *EtcdBackup) GetSelfBackupKeepLast() uint32 {
+	if s == nil || s.SelfBackupKeepLast == nil {
+		return *new(uint32)
+	}
+	return *s.SelfBackupKeepLast
+}
+
+func (s *EtcdBackup) SetSelfBackupKeepLast(v uint32) {
+	s.SelfBackupKeepLast = &v
+}
+
+func (s *EtcdBackup) GetSelfBackupKeepMonthly() uint32 {
+	if s == nil || s.SelfBackupKeepMonthly == nil {
+		return *new(uint32)
+	}
+	return *s.SelfBackupKeepMonthly
+}
+
+func (s *EtcdBackup) SetSelfBackupKeepMonthly(v uint32) {
+	s.SelfBackupKeepMonthly = &v
+}
+
+func (s *EtcdBackup) GetSelfBackupKeepWeekly() uint32 {
+	if s == nil || s.SelfBackupKeepWeekly == nil {
+		return *new(uint32)
+	}
+	return *s.SelfBackupKeepWeekly
+}
+
+func (s *EtcdBackup) SetSelfBackupKeepWeekly(v uint32) {
+	s.SelfBackupKeepWeekly = &v
+}
+
 func (s *EtcdBackup) GetTickInterval() time.Duration {
 	if s == nil || s.TickInterval == nil {
 		return *new(time.Duration)
@@ -886,6 +1029,17 @@ func (s *LogsAudit) SetRetentionPeriod(v time.Duration) {
 	s.RetentionPeriod = &v
 }
 
+func (s *LogsAudit) GetSigningKeyPath() string {
+	if s == nil || s.SigningKeyPath == nil {
+		return *new(string)
+	}
+	return *s.SigningKeyPath
+}
+
+func (s *LogsAudit) SetSigningKeyPath(v string) {
+	s.SigningKeyPath = &v
+}
+
 func (s *LogsAudit) GetSqliteTimeout() time.Duration {
 	if s == nil || s.SqliteTimeout == nil {
 		return *new(time.Duration)
@@ -897,6 +1051,127 @@ func (s *LogsAudit) SetSqliteTimeout(v time.Duration) {
 	s.SqliteTimeout = &v
 }
 
+func (s *LogsAuditOTLPSink) GetEnabled() bool {
+	if s == nil || s.Enabled == nil {
+		return *new(bool)
+	}
+	return *s.Enabled
+}
+
+func (s *LogsAuditOTLPSink) SetEnabled(v bool) {
+	s.Enabled = &v
+}
+
+func (s *LogsAuditOTLPSink) GetEndpoint() string {
+	if s == nil || s.Endpoint == nil {
+		return *new(string)
+	}
+	return *s.Endpoint
+}
+
+func (s *LogsAuditOTLPSink) SetEndpoint(v string) {
+	s.Endpoint = &v
+}
+
+func (s *LogsAuditSyslogSink) GetCaFile() string {
+	if s == nil || s.CaFile == nil {
+		return *new(string)
+	}
+	return *s.CaFile
+}
+
+func (s *LogsAuditSyslogSink) SetCaFile(v string) {
+	s.CaFile = &v
+}
+
+func (s *LogsAuditSyslogSink) GetCertFile() string {
+	if s == nil || s.CertFile == nil {
+		return *new(string)
+	}
+	return *s.CertFile
+}
+
+func (s *LogsAuditSyslogSink) SetCertFile(v string) {
+	s.CertFile = &v
+}
+
+func (s *LogsAuditSyslogSink) GetEnabled() bool {
+	if s == nil || s.Enabled == nil {
+		return *new(bool)
+	}
+	return *s.Enabled
+}
+
+func (s *LogsAuditSyslogSink) SetEnabled(v bool) {
+	s.Enabled = &v
+}
+
+func (s *LogsAuditSyslogSink) GetEndpoint() string {
+	if s == nil || s.Endpoint == nil {
+		return *new(string)
+	}
+	return *s.Endpoint
+}
+
+func (s *LogsAuditSyslogSink) SetEndpoint(v string) {
+	s.Endpoint = &v
+}
+
+func (s *LogsAuditSyslogSink) GetKeyFile() string {
+	if s == nil || s.KeyFile == nil {
+		return *new(string)
+	}
+	return *s.KeyFile
+}
+
+func (s *LogsAuditSyslogSink) SetKeyFile(v string) {
+	s.KeyFile = &v
+}
+
+func (s *LogsAuditSyslogSink) GetTls() bool {
+	if s == nil || s.Tls == nil {
+		return *new(bool)
+	}
+	return *s.Tls
+}
+
+func (s *LogsAuditSyslogSink) SetTls(v bool) {
+	s.Tls = &v
+}
+
+func (s *LogsAuditWebhookSink) GetEnabled() bool {
+	if s == nil || s.Enabled == nil {
+		return *new(bool)
+	}
+	return *s.Enabled
+}
+
+func (s *LogsAuditWebhookSink) SetEnabled(v bool) {
+	s.Enabled = &v
+}
+
+func (s *LogsAuditWebhookSink) GetSecret() string {
+	if s == nil || s.Secret == nil {
+		return *new(string)
+	}
+	return *s.Secret
+}
+
+func (s *LogsAuditWebhookSink) SetSecret(v string) {
+	s.Secret = &v
+}
+
+func (s *LogsAuditWebhookSink) GetUrl() string {
+	if s == nil || s.Url == nil {
+		return *new(string)
+	}
+	return *s.Url
+}
+
+func (s *LogsAuditWebhookSink) SetUrl(v string) {
+	s.Url = &v
+}
+
 func (s *LogsMachine) GetIngestionRateBurstBytes() uint64 {
 	if s == nil || s.IngestionRateBurstBytes == nil {
 		return *new(uint64)
@@ -919,6 +1194,116 @@ func (s *LogsMachine) SetIngestionRateLimitBytesPerSecond(v uint64) {
 	s.IngestionRateLimitBytesPerSecond = &v
 }
 
+func (s *LogsMachineElasticsearchForwarder) GetEnabled() bool {
+	if s == nil || s.Enabled == nil {
+		return *new(bool)
+	}
+	return *s.Enabled
+}
+
+func (s *LogsMachineElasticsearchForwarder) SetEnabled(v bool) {
+	s.Enabled = &v
+}
+
+func (s *LogsMachineElasticsearchForwarder) GetIndex() string {
+	if s == nil || s.Index == nil {
+		return *new(string)
+	}
+	return *s.Index
+}
+
+func (s *LogsMachineElasticsearchForwarder) SetIndex(v string) {
+	s.Index = &v
+}
+
+func (s *LogsMachineElasticsearchForwarder) GetUrl() string {
+	if s == nil || s.Url == nil {
+		return *new(string)
+	}
+	return *s.Url
+}
+
+func (s *LogsMachineElasticsearchForwarder) SetUrl(v string) {
+	s.Url = &v
+}
+
+func (s *LogsMachineForwarding) GetBatchSize() int {
+	if s == nil || s.BatchSize == nil {
+		return *new(int)
+	}
+	return *s.BatchSize
+}
+
+func (s *LogsMachineForwarding) SetBatchSize(v int) {
+	s.BatchSize = &v
+}
+
+func (s *LogsMachineForwarding) GetBufferSize() int {
+	if s == nil || s.BufferSize == nil {
+		return *new(int)
+	}
+	return *s.BufferSize
+}
+
+func (s *LogsMachineForwarding) SetBufferSize(v int) {
+	s.BufferSize = &v
+}
+
+func (s *LogsMachineForwarding) GetFlushInterval() time.Duration {
+	if s == nil || s.FlushInterval == nil {
+		return *new(time.Duration)
+	}
+	return *s.FlushInterval
+}
+
+func (s *LogsMachineForwarding) SetFlushInterval(v time.Duration) {
+	s.FlushInterval = &v
+}
+
+func (s *LogsMachineLokiForwarder) GetEnabled() bool {
+	if s == nil || s.Enabled == nil {
+		return *new(bool)
+	}
+	return *s.Enabled
+}
+
+func (s *LogsMachineLokiForwarder) SetEnabled(v bool) {
+	s.Enabled = &v
+}
+
+func (s *LogsMachineLokiForwarder) GetUrl() string {
+	if s == nil || s.Url == nil {
+		return *new(string)
+	}
+	return *s.Url
+}
+
+func (s *LogsMachineLokiForwarder) SetUrl(v string) {
+	s.Url = &v
+}
+
+func (s *LogsMachineOTLPForwarder) GetEnabled() bool {
+	if s == nil || s.Enabled == nil {
+		return *new(bool)
+	}
+	return *s.Enabled
+}
+
+func (s *LogsMachineOTLPForwarder) SetEnabled(v bool) {
+	s.Enabled = &v
+}
+
+func (s *LogsMachineOTLPForwarder) GetEndpoint() string {
+	if s == nil || s.Endpoint == nil {
+		return *new(string)
+	}
+	return *s.Endpoint
+}
+
+func (s *LogsMachineOTLPForwarder) SetEndpoint(v string) {
+	s.Endpoint = &v
+}
+
 func (s *LogsMachineStorage) GetCleanupInterval() time.Duration {
 	if s == nil || s.CleanupInterval == nil {
 		return *new(time.Duration)
@@ -985,6 +1370,72 @@ func (s *LogsMachineStorage) SetSqliteTimeout(v time.Duration) {
 	s.SqliteTimeout = &v
 }
 
+func (s *LogsMachineSyslogForwarder) GetCaFile() string {
+	if s == nil || s.CaFile == nil {
+		return *new(string)
+	}
+	return *s.CaFile
+}
+
+func (s *LogsMachineSyslogForwarder) SetCaFile(v string) {
+	s.CaFile = &v
+}
+
+func (s *LogsMachineSyslogForwarder) GetCertFile() string {
+	if s == nil || s.CertFile == nil {
+		return *new(string)
+	}
+	return *s.CertFile
+}
+
+func (s *LogsMachineSyslogForwarder) SetCertFile(v string) {
+	s.CertFile = &v
+}
+
+func (s *LogsMachineSyslogForwarder) GetEnabled() bool {
+	if s == nil || s.Enabled == nil {
+		return *new(bool)
+	}
+	return *s.Enabled
+}
+
+func (s *LogsMachineSyslogForwarder) SetEnabled(v bool) {
+	s.Enabled = &v
+}
+
+func (s *LogsMachineSyslogForwarder) GetEndpoint() string {
+	if s == nil || s.Endpoint == nil {
+		return *new(string)
+	}
+	return *s.Endpoint
+}
+
+func (s *LogsMachineSyslogForwarder) SetEndpoint(v string) {
+	s.Endpoint = &v
+}
+
+func (s *LogsMachineSyslogForwarder) GetKeyFile() string {
+	if s == nil || s.KeyFile == nil {
+		return *new(string)
+	}
+	return *s.KeyFile
+}
+
+func (s *LogsMachineSyslogForwarder) SetKeyFile(v string) {
+	s.KeyFile = &v
+}
+
+func (s *LogsMachineSyslogForwarder) GetTls() bool {
+	if s == nil || s.Tls == nil {
+		return *new(bool)
+	}
+	return *s.Tls
+}
+
+func (s *LogsMachineSyslogForwarder) SetTls(v bool) {
+	s.Tls = &v
+}
+
 func (s *LogsStripe) GetEnabled() bool {
 	if s == nil || s.Enabled == nil {
 		return *new(bool)
@@ -1612,6 +2063,28 @@ func (s *WorkloadProxy) SetEnabled(v bool) {
 	s.Enabled = &v
 }
 
+func (s *WorkloadProxy) GetMaxPort() int {
+	if s == nil || s.MaxPort == nil {
+		return *new(int)
+	}
+	return *s.MaxPort
+}
+
+func (s *WorkloadProxy) SetMaxPort(v int) {
+	s.MaxPort = &v
+}
+
+func (s *WorkloadProxy) GetMinPort() int {
+	if s == nil || s.MinPort == nil {
+		return *new(int)
+	}
+	return *s.MinPort
+}
+
+func (s *WorkloadProxy) SetMinPort(v int) {
+	s.MinPort = &v
+}
+
 func (s *WorkloadProxy) GetStopLBsAfter() time.Duration {
 	if s == nil || s.StopLBsAfter == nil {
 		return *new(time.Duration)
@@ -562,6 +562,13 @@ func TestSchemaDefaults(t *testing.T) {
 	assert.Equal(t, uint64(0), p.Logs.Machine.Storage.GetMaxSize())
 	assert.InDelta(t, 0.01, p.Logs.Machine.Storage.GetCleanupProbability(), 0.001)
 
+	// logs.machine.forwarding
+	assert.Equal(t, 10000, p.Logs.Machine.Forwarding.GetBufferSize())
+	assert.Equal(t, 500, p.Logs.Machine.Forwarding.GetBatchSize())
+	assert.Equal(t, time.Second, p.Logs.Machine.Forwarding.GetFlushInterval())
+	assert.False(t, p.Logs.Machine.Forwarding.Loki.GetEnabled())
+	assert.Equal(t, "omni-machine-logs", p.Logs.Machine.Forwarding.Elasticsearch.GetIndex())
+
 	// logs.audit
 	assert.True(t, p.Logs.Audit.GetEnabled())
 	assert.Equal(t, 30*time.Second, p.Logs.Audit.GetSqliteTimeout())
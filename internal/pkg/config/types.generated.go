@@ -4,6 +4,65 @@ package config
 
 import "time"
 
+// APIActorRateLimit defines the gRPC API limits of a single identity. Each limit
+// is disabled when set to 0.
+type APIActorRateLimit struct {
+	// MaxConcurrentRequests is the maximum number of requests in flight, including
+	// open streams, e.g. watches.
+	MaxConcurrentRequests *uint64 `json:"maxConcurrentRequests,omitempty,omitzero" yaml:"maxConcurrentRequests,omitempty"`
+
+	// RequestsBurst is the number of requests allowed above RequestsPerSecond in a
+	// burst.
+	RequestsBurst *uint64 `json:"requestsBurst,omitempty,omitzero" yaml:"requestsBurst,omitempty"`
+
+	// RequestsPerSecond is the sustained rate of the requests allowed. Both
+	// requestsPerSecond and requestsBurst must be non-zero for the rate limit to
+	// engage.
+	RequestsPerSecond *uint64 `json:"requestsPerSecond,omitempty,omitzero" yaml:"requestsPerSecond,omitempty"`
+}
+
+// APIMethodRateLimit defines the gRPC API limits of the RPC methods matching a
+// pattern. Each limit is disabled when set to 0.
+type APIMethodRateLimit struct {
+	// MaxConcurrentRequests is the maximum number of requests in flight, including
+	// open streams, e.g. watches.
+	MaxConcurrentRequests *uint64 `json:"maxConcurrentRequests,omitempty,omitzero" yaml:"maxConcurrentRequests,omitempty"`
+
+	// Method is the full name of the RPC method, e.g.
+	// /management.ManagementService/GetSupportBundle, or a glob pattern matching
+	// several methods, e.g. /omni.resources.ResourceService/*.
+	Method string `json:"method" yaml:"method"`
+
+	// RequestsBurst is the number of requests allowed above RequestsPerSecond in a
+	// burst.
+	RequestsBurst *uint64 `json:"requestsBurst,omitempty,omitzero" yaml:"requestsBurst,omitempty"`
+
+	// RequestsPerSecond is the sustained rate of the requests allowed. Both
+	// requestsPerSecond and requestsBurst must be non-zero for the rate limit to
+	// engage.
+	RequestsPerSecond *uint64 `json:"requestsPerSecond,omitempty,omitzero" yaml:"requestsPerSecond,omitempty"`
+}
+
+// APIRateLimits contains per-actor-type gRPC API limits plus the per-method
+// overrides. Each identity gets its own budget of the limits of its actor type;
+// the requests of Omni's in-process callers are never limited. A request over a
+// limit fails with ResourceExhausted carrying a retry hint.
+type APIRateLimits struct {
+	// InfraProvider contains the limits applied to each infrastructure provider.
+	InfraProvider APIActorRateLimit `json:"infraProvider" yaml:"infraProvider"`
+
+	// Methods contains the limits of specific RPC methods, e.g. the expensive ones
+	// like GetSupportBundle. They apply to each identity on top of the limits of its
+	// actor type. The first entry matching the method is used.
+	Methods []APIMethodRateLimit `json:"methods,omitempty,omitzero" yaml:"methods,omitempty" merge:"replace"`
+
+	// ServiceAccount contains the limits applied to each service account.
+	ServiceAccount APIActorRateLimit `json:"serviceAccount" yaml:"serviceAccount"`
+
+	// User contains the limits applied to each user.
+	User APIActorRateLimit `json:"user" yaml:"user"`
+}
+
 type Account struct {
 	// Id is the unique UUID identifier of the account. It is used to uniquely
 	// identify the account in etcd, therefore it should never be changed after
@@ -100,6 +159,19 @@ type BoltDB struct {
 	Path *string `json:"path,omitempty,omitzero" yaml:"path,omitempty"`
 }
 
+type ClusterAutoscalerService struct {
+	// AdvertisedEndpoint is the endpoint the cluster autoscaler connects to, in the
+	// form "host:port". It defaults to the host of the API advertised URL with the
+	// port of the endpoint.
+	AdvertisedEndpoint *string `json:"advertisedEndpoint,omitempty,omitzero" yaml:"advertisedEndpoint,omitempty"`
+
+	// Endpoint is the network endpoint the cluster autoscaler service listens on. It
+	// is in the form "host:port". The service is disabled when it is empty. It uses
+	// the TLS certificate of the API service and requires the cluster autoscaler to
+	// authenticate with a client certificate.
+	Endpoint *string `json:"endpoint,omitempty,omitzero" yaml:"endpoint,omitempty"`
+}
+
 type Debug struct {
 	// Pprof contains pprof profiling configuration.
 	Pprof DebugPprof `json:"pprof" yaml:"pprof"`
@@ -176,6 +248,28 @@ type EtcdBackup struct {
 	// Mutually exclusive with localPath (.localPath).
 	S3Enabled *bool `json:"s3Enabled,omitempty,omitzero" yaml:"s3Enabled,omitempty"`
 
+	// SelfBackupInterval is the interval between the backups of Omni's own state
+	// uploaded to the etcd backup store. If not specified or is set to 0, the
+	// scheduled backups of Omni's own state are disabled.
+	SelfBackupInterval *time.Duration `json:"selfBackupInterval,omitempty,omitzero" yaml:"selfBackupInterval,omitempty"`
+
+	// SelfBackupKeepDaily is the number of the most recent days the latest backup of
+	// Omni's own state is kept for.
+	SelfBackupKeepDaily *uint32 `json:"selfBackupKeepDaily,omitempty,omitzero" yaml:"selfBackupKeepDaily,omitempty"`
+
+	// SelfBackupKeepLast is the number of the most recent backups of Omni's own state
+	// kept in the etcd backup store. If none of the selfBackupKeep* options is set,
+	// the backups of Omni's own state are never deleted.
+	SelfBackupKeepLast *uint32 `json:"selfBackupKeepLast,omitempty,omitzero" yaml:"selfBackupKeepLast,omitempty"`
+
+	// SelfBackupKeepMonthly is the number of the most recent months the latest backup
+	// of Omni's own state is kept for.
+	SelfBackupKeepMonthly *uint32 `json:"selfBackupKeepMonthly,omitempty,omitzero" yaml:"selfBackupKeepMonthly,omitempty"`
+
+	// SelfBackupKeepWeekly is the number of the most recent weeks the latest backup of
+	// Omni's own state is kept for.
+	SelfBackupKeepWeekly *uint32 `json:"selfBackupKeepWeekly,omitempty,omitzero" yaml:"selfBackupKeepWeekly,omitempty"`
+
 	// TickInterval is the interval between etcd backups ticks (controller events to
 	// check if any cluster needs to be backed up)
 	TickInterval *time.Duration `json:"tickInterval,omitempty,omitzero" yaml:"tickInterval,omitempty"`
@@ -460,10 +554,85 @@ type LogsAudit struct {
 	// eligible for cleanup.
 	RetentionPeriod *time.Duration `json:"retentionPeriod,omitempty,omitzero" yaml:"retentionPeriod,omitempty"`
 
+	// SigningKeyPath is the path to the PEM encoded Ed25519 private key the hash
+	// chain checkpoints and truncation markers of the audit log are signed with. If
+	// it is not set, a key is generated and stored in the audit log database.
+	SigningKeyPath *string `json:"signingKeyPath,omitempty,omitzero" yaml:"signingKeyPath,omitempty"`
+
+	// Sinks contains the configuration of the external sinks the audit log events
+	// are exported to. Every event is delivered at least once: the position of each
+	// sink is persisted and only advanced after the sink accepted the events.
+	Sinks LogsAuditSinks `json:"sinks" yaml:"sinks"`
+
 	// SqliteTimeout is the timeout for SQLite operations used for audit logs storage.
 	SqliteTimeout *time.Duration `json:"sqliteTimeout,omitempty,omitzero" yaml:"sqliteTimeout,omitempty"`
 }
 
+type LogsAuditOTLPSink struct {
+	// Enabled controls whether the audit log events are exported as OpenTelemetry
+	// logs.
+	Enabled *bool `json:"enabled,omitempty,omitzero" yaml:"enabled,omitempty"`
+
+	// Endpoint is the URL of the OTLP/HTTP logs endpoint, e.g.
+	// "https://collector:4318/v1/logs".
+	Endpoint *string `json:"endpoint,omitempty,omitzero" yaml:"endpoint,omitempty"`
+
+	// Headers are the extra HTTP headers sent with each export request, e.g. for
+	// authentication.
+	Headers LogsAuditOTLPSinkHeaders `json:"headers,omitempty,omitzero" yaml:"headers,omitempty"`
+}
+
+// Headers are the extra HTTP headers sent with each export request, e.g. for
+// authentication.
+type LogsAuditOTLPSinkHeaders map[string]string
+
+type LogsAuditSinks struct {
+	// OTLP contains the configuration of the OpenTelemetry logs sink.
+	Otlp LogsAuditOTLPSink `json:"otlp" yaml:"otlp"`
+
+	// Syslog contains the configuration of the RFC5424 syslog sink.
+	Syslog LogsAuditSyslogSink `json:"syslog" yaml:"syslog"`
+
+	// Webhook contains the configuration of the HTTP webhook sink.
+	Webhook LogsAuditWebhookSink `json:"webhook" yaml:"webhook"`
+}
+
+type LogsAuditSyslogSink struct {
+	// CAFile is the path to the CA certificate used to verify the syslog server. If
+	// not set, the system CA certificates are used.
+	CaFile *string `json:"caFile,omitempty,omitzero" yaml:"caFile,omitempty"`
+
+	// CertFile is the path to the client TLS certificate presented to the syslog
+	// server.
+	CertFile *string `json:"certFile,omitempty,omitzero" yaml:"certFile,omitempty"`
+
+	// Enabled controls whether the audit log events are sent to the syslog server.
+	Enabled *bool `json:"enabled,omitempty,omitzero" yaml:"enabled,omitempty"`
+
+	// Endpoint is the TCP endpoint of the syslog server. It is in the form
+	// "host:port".
+	Endpoint *string `json:"endpoint,omitempty,omitzero" yaml:"endpoint,omitempty"`
+
+	// KeyFile is the path to the client TLS key presented to the syslog server.
+	KeyFile *string `json:"keyFile,omitempty,omitzero" yaml:"keyFile,omitempty"`
+
+	// TLS controls whether the connection to the syslog server uses TLS.
+	Tls *bool `json:"tls,omitempty,omitzero" yaml:"tls,omitempty"`
+}
+
+type LogsAuditWebhookSink struct {
+	// Enabled controls whether the audit log events are posted to the webhook.
+	Enabled *bool `json:"enabled,omitempty,omitzero" yaml:"enabled,omitempty"`
+
+	// Secret is the key of the HMAC-SHA256 signature sent in the X-Omni-Signature
+	// header of each request.
+	Secret *string `json:"secret,omitempty,omitzero" yaml:"secret,omitempty"`
+
+	// URL is the URL the batches of audit log events are posted to as newline
+	// delimited JSON.
+	Url *string `json:"url,omitempty,omitzero" yaml:"url,omitempty"`
+}
+
 type LogsFormat string
 
 const LogsFormatJson LogsFormat = "json"
@@ -477,6 +646,12 @@ const LogsLevelInfo LogsLevel = "info"
 const LogsLevelWarn LogsLevel = "warn"
 
 type LogsMachine struct {
+	// Forwarding contains the configuration of the external systems the machine logs
+	// are forwarded to. Every received line is forwarded with the machine ID,
+	// cluster, machine set and labels of the machine, independently of the ingestion
+	// rate limit of the local storage.
+	Forwarding LogsMachineForwarding `json:"forwarding" yaml:"forwarding"`
+
 	// IngestionRateBurstBytes is the maximum burst bytes for machine log ingestion
 	// from a single machine. Defaults to one second worth of rate when zero.
 	IngestionRateBurstBytes *uint64 `json:"ingestionRateBurstBytes,omitempty,omitzero" yaml:"ingestionRateBurstBytes,omitempty"`
@@ -489,6 +664,101 @@ type LogsMachine struct {
 	Storage LogsMachineStorage `json:"storage" yaml:"storage"`
 }
 
+type LogsMachineElasticsearchForwarder struct {
+	// Clusters are the IDs of the clusters the logs of the machines are forwarded to
+	// Elasticsearch for. If empty, the logs of all machines are forwarded, including
+	// the machines which are not part of any cluster.
+	Clusters []string `json:"clusters,omitempty,omitzero" yaml:"clusters,omitempty"`
+
+	// Enabled controls whether the machine logs are indexed in Elasticsearch.
+	Enabled *bool `json:"enabled,omitempty,omitzero" yaml:"enabled,omitempty"`
+
+	// Headers are the extra HTTP headers sent with each bulk request, e.g.
+	// Authorization.
+	Headers LogsMachineElasticsearchForwarderHeaders `json:"headers,omitempty,omitzero" yaml:"headers,omitempty"`
+
+	// Index is the index or the data stream the lines are written to.
+	Index *string `json:"index,omitempty,omitzero" yaml:"index,omitempty"`
+
+	// URL is the base URL of the Elasticsearch cluster, the lines are sent to its
+	// _bulk API.
+	Url *string `json:"url,omitempty,omitzero" yaml:"url,omitempty"`
+}
+
+// Headers are the extra HTTP headers sent with each bulk request, e.g.
+// Authorization.
+type LogsMachineElasticsearchForwarderHeaders map[string]string
+
+type LogsMachineForwarding struct {
+	// BatchSize is the maximum number of lines sent to a forwarder destination in a
+	// single request.
+	BatchSize *int `json:"batchSize,omitempty,omitzero" yaml:"batchSize,omitempty"`
+
+	// BufferSize is the maximum number of lines buffered for each forwarder. When the
+	// buffer of a forwarder is full, because the destination is slow or unavailable,
+	// the new lines are dropped for that forwarder only.
+	BufferSize *int `json:"bufferSize,omitempty,omitzero" yaml:"bufferSize,omitempty"`
+
+	// Elasticsearch contains the configuration of the Elasticsearch forwarder.
+	Elasticsearch LogsMachineElasticsearchForwarder `json:"elasticsearch" yaml:"elasticsearch"`
+
+	// FlushInterval is the maximum time a line is buffered before it is sent, if the
+	// batch is not full earlier.
+	FlushInterval *time.Duration `json:"flushInterval,omitempty,omitzero" yaml:"flushInterval,omitempty"`
+
+	// Loki contains the configuration of the Grafana Loki forwarder.
+	Loki LogsMachineLokiForwarder `json:"loki" yaml:"loki"`
+
+	// OTLP contains the configuration of the OpenTelemetry logs forwarder.
+	Otlp LogsMachineOTLPForwarder `json:"otlp" yaml:"otlp"`
+
+	// Syslog contains the configuration of the RFC5424 syslog forwarder.
+	Syslog LogsMachineSyslogForwarder `json:"syslog" yaml:"syslog"`
+}
+
+type LogsMachineLokiForwarder struct {
+	// Clusters are the IDs of the clusters the logs of the machines are forwarded to
+	// Loki for. If empty, the logs of all machines are forwarded, including the
+	// machines which are not part of any cluster.
+	Clusters []string `json:"clusters,omitempty,omitzero" yaml:"clusters,omitempty"`
+
+	// Enabled controls whether the machine logs are pushed to Loki.
+	Enabled *bool `json:"enabled,omitempty,omitzero" yaml:"enabled,omitempty"`
+
+	// Headers are the extra HTTP headers sent with each push request, e.g.
+	// X-Scope-OrgID for the tenant or Authorization.
+	Headers LogsMachineLokiForwarderHeaders `json:"headers,omitempty,omitzero" yaml:"headers,omitempty"`
+
+	// URL is the URL of the Loki push API, e.g. "http://loki:3100/loki/api/v1/push".
+	Url *string `json:"url,omitempty,omitzero" yaml:"url,omitempty"`
+}
+
+// Headers are the extra HTTP headers sent with each push request, e.g.
+// X-Scope-OrgID for the tenant or Authorization.
+type LogsMachineLokiForwarderHeaders map[string]string
+
+type LogsMachineOTLPForwarder struct {
+	// Clusters are the IDs of the clusters the logs of the machines are forwarded as
+	// OpenTelemetry logs for. If empty, the logs of all machines are forwarded,
+	// including the machines which are not part of any cluster.
+	Clusters []string `json:"clusters,omitempty,omitzero" yaml:"clusters,omitempty"`
+
+	// Enabled controls whether the machine logs are exported as OpenTelemetry logs.
+	Enabled *bool `json:"enabled,omitempty,omitzero" yaml:"enabled,omitempty"`
+
+	// Endpoint is the URL of the OTLP/HTTP logs endpoint, e.g.
+	// "https://collector:4318/v1/logs".
+	Endpoint *string `json:"endpoint,omitempty,omitzero" yaml:"endpoint,omitempty"`
+
+	// Headers are the extra HTTP headers sent with each export request, e.g. for
+	// authentication.
+	Headers LogsMachineOTLPForwarderHeaders `json:"headers,omitempty,omitzero" yaml:"headers,omitempty"`
+}
+
+// Headers are the extra HTTP headers sent with each export request, e.g. for
+// authentication.
+type LogsMachineOTLPForwarderHeaders map[string]string
+
 type LogsMachineStorage struct {
 	// CleanupInterval is the interval at which old machine logs are cleaned up.
 	CleanupInterval *time.Duration `json:"cleanupInterval,omitempty,omitzero" yaml:"cleanupInterval,omitempty"`
@@ -514,6 +784,34 @@ type LogsMachineStorage struct {
 	SqliteTimeout *time.Duration `json:"sqliteTimeout,omitempty,omitzero" yaml:"sqliteTimeout,omitempty"`
 }
 
+type LogsMachineSyslogForwarder struct {
+	// CAFile is the path to the CA certificate used to verify the syslog server. If
+	// not set, the system CA certificates are used.
+	CaFile *string `json:"caFile,omitempty,omitzero" yaml:"caFile,omitempty"`
+
+	// CertFile is the path to the client TLS certificate presented to the syslog
+	// server.
+	CertFile *string `json:"certFile,omitempty,omitzero" yaml:"certFile,omitempty"`
+
+	// Clusters are the IDs of the clusters the logs of the machines are forwarded to
+	// the syslog server for. If empty, the logs of all machines are forwarded,
+	// including the machines which are not part of any cluster.
+	Clusters []string `json:"clusters,omitempty,omitzero" yaml:"clusters,omitempty"`
+
+	// Enabled controls whether the machine logs are sent to the syslog server.
+	Enabled *bool `json:"enabled,omitempty,omitzero" yaml:"enabled,omitempty"`
+
+	// Endpoint is the TCP endpoint of the syslog server. It is in the form
+	// "host:port".
+	Endpoint *string `json:"endpoint,omitempty,omitzero" yaml:"endpoint,omitempty"`
+
+	// KeyFile is the path to the client TLS key presented to the syslog server.
+	KeyFile *string `json:"keyFile,omitempty,omitzero" yaml:"keyFile,omitempty"`
+
+	// TLS controls whether the connection to the syslog server uses TLS.
+	Tls *bool `json:"tls,omitempty,omitzero" yaml:"tls,omitempty"`
+}
+
 type LogsStripe struct {
 	// Enabled controls whether Stripe logging is enabled.
 	Enabled *bool `json:"enabled,omitempty,omitzero" yaml:"enabled,omitempty"`
@@ -764,6 +1062,16 @@ type Services struct {
 	// Api contains API/UI service configuration.
 	Api Service `json:"api" yaml:"api"`
 
+	// ApiRateLimits contains request rate and concurrency limits of the gRPC API.
+	// They protect Omni from the clients which flood it with requests, e.g. runaway
+	// scripts using a service account.
+	ApiRateLimits APIRateLimits `json:"apiRateLimits" yaml:"apiRateLimits"`
+
+	// ClusterAutoscaler contains the cluster autoscaler service configuration. It
+	// serves the externalgrpc cloud provider protocol of the Kubernetes cluster
+	// autoscaler, scaling the machine sets with autoscaling bounds.
+	ClusterAutoscaler ClusterAutoscalerService `json:"clusterAutoscaler" yaml:"clusterAutoscaler"`
+
 	// DevServerProxy is the node dev server proxy service configuration. It exists
 	// for the development purposes only.
 	DevServerProxy DevServerProxyService `json:"devServerProxy" yaml:"devServerProxy"`
@@ -941,6 +1249,16 @@ type WorkloadProxy struct {
 	// Enabled controls whether the workload proxy service is enabled.
 	Enabled *bool `json:"enabled,omitempty,omitzero" yaml:"enabled,omitempty"`
 
+	// MaxPort is the maximum port number that can be picked by the workload proxy
+	// service when allocating a port to an exposed service with the tcp protocol.
+	MaxPort *int `json:"maxPort,omitempty,omitzero" yaml:"maxPort,omitempty"`
+
+	// MinPort is the minimum port number that can be picked by the workload proxy
+	// service when allocating a port to an exposed service with the tcp protocol. TCP
+	// services are only proxied when both minPort and maxPort are set. Their
+	// connections are secured with the TLS certificate of the API service.
+	MinPort *int `json:"minPort,omitempty,omitzero" yaml:"minPort,omitempty"`
+
 	// StopLBsAfter is the duration after which the workload proxy service stops load
 	// balancers for workloads that have not received any traffic.
 	StopLBsAfter *time.Duration `json:"stopLBsAfter,omitempty,omitzero" yaml:"stopLBsAfter,omitempty"`
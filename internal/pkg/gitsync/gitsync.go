@@ -0,0 +1,324 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package gitsync fetches the resources synced from the Git repositories.
+package gitsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+)
+
+// MinPollInterval is the minimum interval between the polls of a repository.
+const MinPollInterval = 30 * time.Second
+
+const (
+	fetchTimeout = 2 * time.Minute
+	maxFileSize  = 4 * 1024 * 1024
+)
+
+// Source describes the repository to fetch.
+type Source struct {
+	URL    string
+	Branch string
+	Path   string
+
+	// Username and Password are used for the HTTP(S) repositories.
+	Username string
+	Password string
+
+	// SSHPrivateKey and SSHKnownHosts are used for the SSH repositories.
+	SSHPrivateKey string
+	SSHKnownHosts string
+}
+
+// Commit is a snapshot of the repository files at a commit.
+type Commit struct {
+	// Files are the YAML files under the source path, keyed by the path relative to it.
+	Files map[string][]byte
+
+	SHA    string
+	Author string
+
+	// Message is the first line of the commit message.
+	Message string
+}
+
+// Fetcher fetches the head commits of the Git repositories.
+//
+// The repositories are kept in memory after the first clone, so that only the new commits are fetched on the next polls.
+type Fetcher struct {
+	repositories map[string]*cachedRepository
+	mu           sync.Mutex
+}
+
+type cachedRepository struct {
+	repository *git.Repository
+	url        string
+	branch     string
+}
+
+// NewFetcher creates a new Fetcher.
+func NewFetcher() *Fetcher {
+	return &Fetcher{
+		repositories: map[string]*cachedRepository{},
+	}
+}
+
+// Fetch fetches the head commit of the source branch.
+//
+// The id identifies the cached clone of the repository, the fetches with the same id should not run concurrently.
+func (f *Fetcher) Fetch(ctx context.Context, id string, source Source) (*Commit, error) {
+	auth, err := source.auth()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, fetchTimeout)
+	defer cancel()
+
+	f.mu.Lock()
+	cached, ok := f.repositories[id]
+	f.mu.Unlock()
+
+	if ok && cached.url == source.URL && cached.branch == source.Branch {
+		err = cached.repository.FetchContext(ctx, &git.FetchOptions{
+			RemoteName: git.DefaultRemoteName,
+			Auth:       auth,
+			Tags:       git.NoTags,
+			Force:      true,
+		})
+		if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+			return nil, fmt.Errorf("failed to fetch %q: %w", source.URL, err)
+		}
+	} else {
+		referenceName := plumbing.HEAD
+		if source.Branch != "" {
+			referenceName = plumbing.NewBranchReferenceName(source.Branch)
+		}
+
+		// the clone is bare, the files are read from the commit tree
+		repository, cloneErr := git.CloneContext(ctx, memory.NewStorage(), nil, &git.CloneOptions{
+			URL:           source.URL,
+			Auth:          auth,
+			ReferenceName: referenceName,
+			SingleBranch:  true,
+			Tags:          git.NoTags,
+		})
+		if cloneErr != nil {
+			return nil, fmt.Errorf("failed to clone %q: %w", source.URL, cloneErr)
+		}
+
+		cached = &cachedRepository{
+			repository: repository,
+			url:        source.URL,
+			branch:     source.Branch,
+		}
+
+		f.mu.Lock()
+		f.repositories[id] = cached
+		f.mu.Unlock()
+	}
+
+	return readCommit(cached.repository, source.Path)
+}
+
+// Forget drops the cached clone of the repository.
+func (f *Fetcher) Forget(id string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.repositories, id)
+}
+
+// readCommit reads the YAML files of the fetched head of the cloned branch.
+func readCommit(repository *git.Repository, dir string) (*Commit, error) {
+	head, err := repository.Head()
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve the branch: %w", err)
+	}
+
+	// the local branch is not moved by the fetches, the remote one is
+	ref, err := repository.Reference(plumbing.NewRemoteReferenceName(git.DefaultRemoteName, head.Name().Short()), true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve the branch %q: %w", head.Name().Short(), err)
+	}
+
+	commit, err := repository.CommitObject(ref.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the commit %s: %w", ref.Hash(), err)
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the commit %s: %w", ref.Hash(), err)
+	}
+
+	if dir = strings.Trim(path.Clean("/"+dir), "/"); dir != "" {
+		tree, err = tree.Tree(dir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the path %q: %w", dir, err)
+		}
+	}
+
+	files := map[string][]byte{}
+
+	err = tree.Files().ForEach(func(file *object.File) error {
+		if ext := strings.ToLower(path.Ext(file.Name)); ext != ".yaml" && ext != ".yml" {
+			return nil
+		}
+
+		if file.Size > maxFileSize {
+			return fmt.Errorf("file %q is too large", file.Name)
+		}
+
+		contents, contentsErr := file.Contents()
+		if contentsErr != nil {
+			return fmt.Errorf("failed to read file %q: %w", file.Name, contentsErr)
+		}
+
+		files[file.Name] = []byte(contents)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Commit{
+		SHA:     commit.Hash.String(),
+		Message: firstLine(commit.Message),
+		Author:  commit.Author.String(),
+		Files:   files,
+	}, nil
+}
+
+func firstLine(message string) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(message), "\n")
+
+	return strings.TrimSpace(line)
+}
+
+func (source Source) auth() (transport.AuthMethod, error) {
+	switch {
+	case source.SSHPrivateKey != "":
+		endpoint, err := transport.NewEndpoint(source.URL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid repository URL: %w", err)
+		}
+
+		user := endpoint.User
+		if user == "" {
+			user = "git"
+		}
+
+		keys, err := gitssh.NewPublicKeys(user, []byte(source.SSHPrivateKey), "")
+		if err != nil {
+			return nil, fmt.Errorf("invalid SSH private key: %w", err)
+		}
+
+		keys.HostKeyCallback, err = knownHostsCallback(source.SSHKnownHosts)
+		if err != nil {
+			return nil, err
+		}
+
+		return keys, nil
+	case source.Username != "" || source.Password != "":
+		return &githttp.BasicAuth{
+			Username: source.Username,
+			Password: source.Password,
+		}, nil
+	default:
+		return nil, nil //nolint:nilnil
+	}
+}
+
+// knownHostsCallback verifies the SSH host keys against the known_hosts entries.
+func knownHostsCallback(knownHosts string) (ssh.HostKeyCallback, error) {
+	if strings.TrimSpace(knownHosts) == "" {
+		return nil, errors.New("SSH known hosts are required to verify the repository host key")
+	}
+
+	// knownhosts only reads the entries from the files
+	dir, err := os.MkdirTemp("", "gitsync")
+	if err != nil {
+		return nil, err
+	}
+
+	defer os.RemoveAll(dir) //nolint:errcheck
+
+	knownHostsPath := filepath.Join(dir, "known_hosts")
+
+	if err = os.WriteFile(knownHostsPath, []byte(knownHosts), 0o600); err != nil {
+		return nil, err
+	}
+
+	callback, err := knownhosts.New(knownHostsPath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SSH known hosts: %w", err)
+	}
+
+	return callback, nil
+}
+
+// ValidateRepository checks the Git repository spec.
+func ValidateRepository(spec *specs.GitRepositorySpec) error {
+	if spec.Url == "" {
+		return errors.New("url is required")
+	}
+
+	endpoint, err := transport.NewEndpoint(spec.Url)
+	if err != nil {
+		return fmt.Errorf("invalid url: %w", err)
+	}
+
+	switch endpoint.Protocol {
+	case "https", "http", "ssh", "file":
+	default:
+		return fmt.Errorf("unsupported url protocol %q", endpoint.Protocol)
+	}
+
+	if spec.PollInterval != nil && spec.PollInterval.AsDuration() < MinPollInterval {
+		return fmt.Errorf("poll interval should be at least %s", MinPollInterval)
+	}
+
+	return nil
+}
+
+// ValidateCredentials checks the Git credentials spec.
+func ValidateCredentials(spec *specs.GitCredentialsSpec) error {
+	if spec.SshPrivateKey == "" {
+		return nil
+	}
+
+	if _, err := ssh.ParsePrivateKey([]byte(spec.SshPrivateKey)); err != nil {
+		return fmt.Errorf("invalid SSH private key: %w", err)
+	}
+
+	if strings.TrimSpace(spec.SshKnownHosts) == "" {
+		return errors.New("SSH known hosts are required with the SSH private key")
+	}
+
+	return nil
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package gitsync_test
+
+import (
+	"maps"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-billy/v5/util"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport/client"
+	"github.com/go-git/go-git/v5/plumbing/transport/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/gitsync"
+)
+
+func init() {
+	// serve the local repositories in-process, so that the tests don't depend on the git binary
+	client.InstallProtocol("file", server.DefaultServer)
+}
+
+const clusterTemplate = `kind: Cluster
+name: fleet-prod
+kubernetes:
+  version: v1.30.1
+talos:
+  version: v1.7.0
+---
+kind: ControlPlane
+machines:
+  - 430d882a-51a8-48b3-ae00-90c5b0b5b0b0
+`
+
+const configPatch = `metadata:
+  namespace: default
+  type: ConfigPatches.omni.sidero.dev
+  id: 500-fleet-prod-registry
+  labels:
+    omni.sidero.dev/cluster: fleet-prod
+spec:
+  data: |
+    machine:
+      registries: {}
+`
+
+type testRepository struct {
+	t          *testing.T
+	repository *git.Repository
+	bare       string
+}
+
+func newTestRepository(t *testing.T) *testRepository {
+	bare := filepath.Join(t.TempDir(), "fleet.git")
+
+	_, err := git.PlainInit(bare, true)
+	require.NoError(t, err)
+
+	repository, err := git.PlainInit(t.TempDir(), false)
+	require.NoError(t, err)
+
+	_, err = repository.CreateRemote(&config.RemoteConfig{
+		Name: git.DefaultRemoteName,
+		URLs: []string{bare},
+	})
+	require.NoError(t, err)
+
+	return &testRepository{
+		t:          t,
+		repository: repository,
+		bare:       bare,
+	}
+}
+
+// commit commits the files and pushes them to the bare repository.
+func (r *testRepository) commit(message string, files map[string]string) string {
+	wt, err := r.repository.Worktree()
+	require.NoError(r.t, err)
+
+	for name, contents := range files {
+		require.NoError(r.t, util.WriteFile(wt.Filesystem, name, []byte(contents), 0o644))
+
+		_, err = wt.Add(name)
+		require.NoError(r.t, err)
+	}
+
+	hash, err := wt.Commit(message, &git.CommitOptions{
+		Author: &object.Signature{
+			Name:  "Fleet Admin",
+			Email: "admin@example.com",
+			When:  time.Now(),
+		},
+	})
+	require.NoError(r.t, err)
+
+	require.NoError(r.t, r.repository.Push(&git.PushOptions{
+		RemoteName: git.DefaultRemoteName,
+		RefSpecs:   []config.RefSpec{"refs/heads/master:refs/heads/master"},
+	}))
+
+	return hash.String()
+}
+
+func TestFetch(t *testing.T) {
+	t.Parallel()
+
+	repository := newTestRepository(t)
+
+	firstSHA := repository.commit("Add the production cluster\n\nWith the registry patch.", map[string]string{
+		"README.md":                   "# Fleet",
+		"clusters/prod/cluster.yaml":  clusterTemplate,
+		"clusters/prod/patches.yml":   configPatch,
+		"clusters/prod/notes.txt":     "not synced",
+		"unrelated/outside-path.yaml": configPatch,
+	})
+
+	fetcher := gitsync.NewFetcher()
+	source := gitsync.Source{
+		URL:  repository.bare,
+		Path: "/clusters/",
+	}
+
+	commit, err := fetcher.Fetch(t.Context(), "fleet", source)
+	require.NoError(t, err)
+
+	assert.Equal(t, firstSHA, commit.SHA)
+	assert.Equal(t, "Add the production cluster", commit.Message)
+	assert.Contains(t, commit.Author, "admin@example.com")
+	assert.ElementsMatch(t, []string{"prod/cluster.yaml", "prod/patches.yml"}, slices.Collect(maps.Keys(commit.Files)))
+
+	resources, err := commit.Resources()
+	require.NoError(t, err)
+	require.Len(t, resources, 2)
+
+	clusterTemplateResource, ok := resources[0].(*omni.ClusterTemplate)
+	require.True(t, ok)
+
+	assert.Equal(t, "fleet-prod", clusterTemplateResource.Metadata().ID())
+	assert.Equal(t, clusterTemplate, clusterTemplateResource.TypedSpec().Value.Template)
+
+	assert.Equal(t, omni.ConfigPatchType, resources[1].Metadata().Type())
+	assert.Equal(t, "500-fleet-prod-registry", resources[1].Metadata().ID())
+
+	// the next fetch picks up the new commits
+	secondSHA := repository.commit("Drop the registry patch", map[string]string{
+		"clusters/prod/patches.yml": "",
+	})
+
+	commit, err = fetcher.Fetch(t.Context(), "fleet", source)
+	require.NoError(t, err)
+
+	assert.Equal(t, secondSHA, commit.SHA)
+
+	resources, err = commit.Resources()
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+
+	// the branch which doesn't exist fails the clone
+	_, err = fetcher.Fetch(t.Context(), "fleet", gitsync.Source{
+		URL:    repository.bare,
+		Branch: "missing",
+	})
+	require.Error(t, err)
+
+	// as well as the path which doesn't exist
+	_, err = fetcher.Fetch(t.Context(), "other", gitsync.Source{
+		URL:    repository.bare,
+		Branch: plumbing.Master.Short(),
+		Path:   "missing",
+	})
+	require.ErrorContains(t, err, `failed to read the path "missing"`)
+}
+
+func TestResourcesDuplicate(t *testing.T) {
+	t.Parallel()
+
+	commit := &gitsync.Commit{
+		Files: map[string][]byte{
+			"a.yaml": []byte(configPatch),
+			"b.yaml": []byte(configPatch),
+		},
+	}
+
+	_, err := commit.Resources()
+	require.ErrorContains(t, err, `file "b.yaml": resource ConfigPatches.omni.sidero.dev(default/500-fleet-prod-registry) is already defined in "a.yaml"`)
+
+	commit = &gitsync.Commit{
+		Files: map[string][]byte{
+			"a.yaml": []byte("metadata:\n  namespace: ephemeral\n  type: ConfigPatches.omni.sidero.dev\n  id: patch\nspec: {}\n"),
+		},
+	}
+
+	_, err = commit.Resources()
+	require.ErrorContains(t, err, `should be in the "default" namespace`)
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package gitsync
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"go.yaml.in/yaml/v4"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/template"
+)
+
+// Resources decodes the resources from the files of the commit.
+//
+// The files are either resource documents in the `omnictl apply` format, or cluster templates.
+// A cluster template is turned into the ClusterTemplate resource with the ID of the cluster it defines.
+func (c *Commit) Resources() ([]resource.Resource, error) {
+	var result []resource.Resource
+
+	seen := map[string]string{}
+
+	for _, name := range slices.Sorted(maps.Keys(c.Files)) {
+		decoded, err := decodeFile(c.Files[name])
+		if err != nil {
+			return nil, fmt.Errorf("file %q: %w", name, err)
+		}
+
+		for _, res := range decoded {
+			key := resource.String(res)
+
+			if other, ok := seen[key]; ok {
+				return nil, fmt.Errorf("file %q: resource %s is already defined in %q", name, key, other)
+			}
+
+			seen[key] = name
+
+			result = append(result, res)
+		}
+	}
+
+	return result, nil
+}
+
+func decodeFile(data []byte) ([]resource.Resource, error) {
+	isTemplate, err := isClusterTemplate(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if isTemplate {
+		res, templateErr := decodeClusterTemplate(data)
+		if templateErr != nil {
+			return nil, templateErr
+		}
+
+		return []resource.Resource{res}, nil
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+
+	var decoded []resource.Resource
+
+	for {
+		var res protobuf.YAMLResource
+
+		if err = dec.Decode(&res); err != nil {
+			if errors.Is(err, io.EOF) {
+				return decoded, nil
+			}
+
+			return nil, err
+		}
+
+		if res.Resource().Metadata().Namespace() != resources.DefaultNamespace {
+			return nil, fmt.Errorf("resource %s should be in the %q namespace", resource.String(res.Resource()), resources.DefaultNamespace)
+		}
+
+		decoded = append(decoded, res.Resource())
+	}
+}
+
+// isClusterTemplate checks whether the file is a cluster template: the resource documents have metadata, the template documents have a kind.
+func isClusterTemplate(data []byte) (bool, error) {
+	var doc struct {
+		Metadata any    `yaml:"metadata"`
+		Kind     string `yaml:"kind"`
+	}
+
+	if err := yaml.NewDecoder(bytes.NewReader(data)).Decode(&doc); err != nil {
+		if errors.Is(err, io.EOF) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return doc.Metadata == nil && doc.Kind != "", nil
+}
+
+func decodeClusterTemplate(data []byte) (*omni.ClusterTemplate, error) {
+	tmpl, err := template.Load(bytes.NewReader(data), template.WithoutFileAccess())
+	if err != nil {
+		return nil, err
+	}
+
+	clusterName, err := tmpl.ClusterName()
+	if err != nil {
+		return nil, err
+	}
+
+	clusterTemplate := omni.NewClusterTemplate(clusterName)
+	clusterTemplate.TypedSpec().Value.Template = string(data)
+
+	return clusterTemplate, nil
+}
@@ -0,0 +1,392 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package helm renders the Helm charts referenced by the Kubernetes manifest groups.
+package helm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/engine"
+	"helm.sh/helm/v3/pkg/registry"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	"helm.sh/helm/v3/pkg/repo"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+)
+
+const (
+	// OCIScheme is the scheme of the OCI registry chart repositories.
+	OCIScheme = "oci://"
+
+	fetchTimeout   = time.Minute
+	maxArchiveSize = 16 * 1024 * 1024
+	maxIndexSize   = 64 * 1024 * 1024
+
+	chartCacheSize = 64
+	chartCacheTTL  = 10 * time.Minute
+)
+
+// Renderer fetches the Helm charts and renders them into the Kubernetes manifests.
+//
+// The fetched charts are cached for a while, so the charts without a pinned version pick up the new versions with a delay.
+type Renderer struct {
+	client *http.Client
+	charts *expirable.LRU[string, []byte]
+}
+
+// NewRenderer creates a new Renderer.
+func NewRenderer() *Renderer {
+	return &Renderer{
+		client: &http.Client{Timeout: fetchTimeout},
+		charts: expirable.NewLRU[string, []byte](chartCacheSize, nil, chartCacheTTL),
+	}
+}
+
+// Render renders the chart of the source into the manifests.
+//
+// The valueFiles are YAML encoded values merged in order before the inline values of the source,
+// kubernetesVersion is the version of the cluster the manifests are rendered for.
+//
+// The chart hooks and tests are not rendered, as they are not supported by the server-side apply.
+func (r *Renderer) Render(
+	ctx context.Context,
+	source *specs.KubernetesManifestGroupSpec_HelmSource,
+	valueFiles [][]byte,
+	kubernetesVersion string,
+) ([]*unstructured.Unstructured, error) {
+	archive, err := r.fetch(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+
+	ch, err := loader.LoadArchive(bytes.NewReader(archive))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the chart: %w", err)
+	}
+
+	values := map[string]any{}
+
+	for _, data := range slices.Concat(valueFiles, [][]byte{[]byte(source.GetValues())}) {
+		var fileValues chartutil.Values
+
+		fileValues, err = chartutil.ReadValues(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the values: %w", err)
+		}
+
+		mergeValues(values, fileValues)
+	}
+
+	if err = chartutil.ProcessDependencies(ch, values); err != nil {
+		return nil, fmt.Errorf("failed to process the chart dependencies: %w", err)
+	}
+
+	caps, err := capabilities(kubernetesVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	releaseName := source.GetReleaseName()
+	if releaseName == "" {
+		releaseName = ch.Name()
+	}
+
+	namespace := source.GetNamespace()
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	renderValues, err := chartutil.ToRenderValues(ch, values, chartutil.ReleaseOptions{
+		Name:      releaseName,
+		Namespace: namespace,
+		Revision:  1,
+		IsInstall: true,
+	}, caps)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build the render values: %w", err)
+	}
+
+	files, err := engine.Render(ch, renderValues)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render the chart: %w", err)
+	}
+
+	for name := range files {
+		if strings.HasSuffix(name, "NOTES.txt") {
+			delete(files, name)
+		}
+	}
+
+	_, sorted, err := releaseutil.SortManifests(files, caps.APIVersions, releaseutil.InstallOrder)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the rendered chart: %w", err)
+	}
+
+	var documents []string
+
+	for _, crd := range ch.CRDObjects() {
+		documents = append(documents, string(crd.File.Data))
+	}
+
+	for _, manifest := range sorted {
+		documents = append(documents, manifest.Content)
+	}
+
+	spec := &specs.KubernetesManifestGroupSpec{Data: strings.Join(documents, "\n---\n")}
+
+	manifests, err := spec.GetManifests()
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the rendered chart: %w", err)
+	}
+
+	return manifests, nil
+}
+
+// fetch returns the packaged chart of the source.
+func (r *Renderer) fetch(ctx context.Context, source *specs.KubernetesManifestGroupSpec_HelmSource) ([]byte, error) {
+	if len(source.GetChartArchive()) > 0 {
+		return source.GetChartArchive(), nil
+	}
+
+	key := source.GetRepository() + "|" + source.GetChart() + "|" + source.GetVersion()
+
+	if archive, ok := r.charts.Get(key); ok {
+		return archive, nil
+	}
+
+	var (
+		archive []byte
+		err     error
+	)
+
+	if strings.HasPrefix(source.GetRepository(), OCIScheme) {
+		archive, err = r.fetchOCI(source)
+	} else {
+		archive, err = r.fetchHTTP(ctx, source)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch chart %q from %q: %w", source.GetChart(), source.GetRepository(), err)
+	}
+
+	r.charts.Add(key, archive)
+
+	return archive, nil
+}
+
+func (r *Renderer) fetchOCI(source *specs.KubernetesManifestGroupSpec_HelmSource) ([]byte, error) {
+	client, err := registry.NewClient(registry.ClientOptHTTPClient(r.client))
+	if err != nil {
+		return nil, err
+	}
+
+	ref := strings.TrimSuffix(strings.TrimPrefix(source.GetRepository(), OCIScheme), "/") + "/" + source.GetChart()
+
+	version := source.GetVersion()
+	if version == "" {
+		// the tags are sorted by the semantic version in the descending order
+		tags, tagsErr := client.Tags(ref)
+		if tagsErr != nil {
+			return nil, tagsErr
+		}
+
+		if len(tags) == 0 {
+			return nil, errors.New("no chart versions found")
+		}
+
+		version = tags[0]
+	}
+
+	result, err := client.Pull(ref+":"+version, registry.PullOptWithChart(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Chart.Data, nil
+}
+
+func (r *Renderer) fetchHTTP(ctx context.Context, source *specs.KubernetesManifestGroupSpec_HelmSource) ([]byte, error) {
+	repoURL := strings.TrimSuffix(source.GetRepository(), "/")
+
+	indexData, err := r.download(ctx, repoURL+"/index.yaml", maxIndexSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download the repository index: %w", err)
+	}
+
+	indexJSON, err := k8syaml.ToJSON(indexData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the repository index: %w", err)
+	}
+
+	var index repo.IndexFile
+
+	if err = json.Unmarshal(indexJSON, &index); err != nil {
+		return nil, fmt.Errorf("failed to parse the repository index: %w", err)
+	}
+
+	index.SortEntries()
+
+	chartVersion, err := index.Get(source.GetChart(), source.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(chartVersion.URLs) == 0 {
+		return nil, fmt.Errorf("chart version %q has no download URLs", chartVersion.Version)
+	}
+
+	chartURL, err := repo.ResolveReferenceURL(repoURL, chartVersion.URLs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return r.download(ctx, chartURL, maxArchiveSize)
+}
+
+func (r *Renderer) download(ctx context.Context, rawURL string, limit int64) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(data)) > limit {
+		return nil, fmt.Errorf("response exceeds %d bytes", limit)
+	}
+
+	return data, nil
+}
+
+// ValidateSource checks that the Helm source is well-formed, without fetching the chart.
+func ValidateSource(source *specs.KubernetesManifestGroupSpec_HelmSource) error {
+	var errs error
+
+	if len(source.GetChartArchive()) > 0 {
+		if source.GetRepository() != "" {
+			errs = errors.Join(errs, errors.New("repository and chart archive are mutually exclusive"))
+		}
+
+		if _, err := loader.LoadArchive(bytes.NewReader(source.GetChartArchive())); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("invalid chart archive: %w", err))
+		}
+	} else {
+		if source.GetChart() == "" {
+			errs = errors.Join(errs, errors.New("chart name is required"))
+		}
+
+		if err := validateRepository(source.GetRepository()); err != nil {
+			errs = errors.Join(errs, err)
+		}
+	}
+
+	if source.GetVersion() != "" {
+		if _, err := semver.ParseTolerant(source.GetVersion()); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("invalid chart version %q: %w", source.GetVersion(), err))
+		}
+	}
+
+	if _, err := chartutil.ReadValues([]byte(source.GetValues())); err != nil {
+		errs = errors.Join(errs, fmt.Errorf("invalid values: %w", err))
+	}
+
+	if slices.Contains(source.GetValuesFrom(), "") {
+		errs = errors.Join(errs, errors.New("values from entries must not be empty"))
+	}
+
+	return errs
+}
+
+func validateRepository(repository string) error {
+	if repository == "" {
+		return errors.New("repository is required")
+	}
+
+	parsed, err := url.Parse(repository)
+	if err != nil {
+		return fmt.Errorf("invalid repository url: %w", err)
+	}
+
+	switch parsed.Scheme {
+	case "http", "https", "oci":
+	default:
+		return fmt.Errorf("unsupported repository scheme %q, must be one of http, https, oci", parsed.Scheme)
+	}
+
+	if parsed.Host == "" {
+		return errors.New("repository url must have a host")
+	}
+
+	return nil
+}
+
+func capabilities(kubernetesVersion string) (*chartutil.Capabilities, error) {
+	caps := *chartutil.DefaultCapabilities
+
+	if kubernetesVersion == "" {
+		return &caps, nil
+	}
+
+	version, err := semver.ParseTolerant(kubernetesVersion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid kubernetes version %q: %w", kubernetesVersion, err)
+	}
+
+	caps.KubeVersion = chartutil.KubeVersion{
+		Version: fmt.Sprintf("v%d.%d.%d", version.Major, version.Minor, version.Patch),
+		Major:   strconv.FormatUint(version.Major, 10),
+		Minor:   strconv.FormatUint(version.Minor, 10),
+	}
+
+	return &caps, nil
+}
+
+// mergeValues deeply merges src into dst, the values from src take precedence.
+func mergeValues(dst, src map[string]any) {
+	for key, value := range src {
+		srcTable, srcIsTable := value.(map[string]any)
+		dstTable, dstIsTable := dst[key].(map[string]any)
+
+		if srcIsTable && dstIsTable {
+			mergeValues(dstTable, srcTable)
+
+			continue
+		}
+
+		dst[key] = value
+	}
+}
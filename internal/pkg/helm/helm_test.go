@@ -0,0 +1,180 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package helm_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/internal/pkg/helm"
+)
+
+func packageChart(t *testing.T) []byte {
+	t.Helper()
+
+	ch, err := loader.LoadDir("testdata/demo")
+	require.NoError(t, err)
+
+	path, err := chartutil.Save(ch, t.TempDir())
+	require.NoError(t, err)
+
+	archive, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	return archive
+}
+
+func findManifest(manifests []*unstructured.Unstructured, kind, name string) *unstructured.Unstructured {
+	for _, manifest := range manifests {
+		if manifest.GetKind() == kind && manifest.GetName() == name {
+			return manifest
+		}
+	}
+
+	return nil
+}
+
+func TestRenderArchive(t *testing.T) {
+	t.Parallel()
+
+	manifests, err := helm.NewRenderer().Render(t.Context(), &specs.KubernetesManifestGroupSpec_HelmSource{
+		ChartArchive: packageChart(t),
+		ReleaseName:  "release",
+		Namespace:    "demo",
+		Values:       "config:\n  target: omni\n",
+	}, [][]byte{[]byte("replicas: 3\nconfig:\n  greeting: hi\n  target: everyone\n")}, "1.34.1")
+	require.NoError(t, err)
+
+	// the CRDs go first, the hooks and the notes are not rendered
+	require.Len(t, manifests, 3)
+	assert.Equal(t, "CustomResourceDefinition", manifests[0].GetKind())
+	assert.Nil(t, findManifest(manifests, "Pod", "release-test"))
+
+	configMap := findManifest(manifests, "ConfigMap", "release-config")
+	require.NotNil(t, configMap)
+	assert.Equal(t, "demo", configMap.GetNamespace())
+
+	data, _, err := unstructured.NestedStringMap(configMap.Object, "data")
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"greeting":    "hi",
+		"target":      "omni",
+		"kubeVersion": "v1.34.1",
+	}, data)
+
+	deployment := findManifest(manifests, "Deployment", "release")
+	require.NotNil(t, deployment)
+
+	replicas, _, err := unstructured.NestedInt64(deployment.Object, "spec", "replicas")
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, replicas)
+}
+
+func TestRenderHTTPRepository(t *testing.T) {
+	t.Parallel()
+
+	archive := packageChart(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/charts/index.yaml", func(w http.ResponseWriter, _ *http.Request) {
+		w.Write([]byte(`apiVersion: v1
+entries:
+  demo:
+    - name: demo
+      version: 0.1.0
+      apiVersion: v2
+      urls:
+        - demo-0.1.0.tgz
+`)) //nolint:errcheck
+	})
+	mux.HandleFunc("/charts/demo-0.1.0.tgz", func(w http.ResponseWriter, _ *http.Request) {
+		w.Write(archive) //nolint:errcheck
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	manifests, err := helm.NewRenderer().Render(t.Context(), &specs.KubernetesManifestGroupSpec_HelmSource{
+		Repository: server.URL + "/charts",
+		Chart:      "demo",
+	}, nil, "")
+	require.NoError(t, err)
+
+	assert.NotNil(t, findManifest(manifests, "ConfigMap", "demo-config"))
+
+	_, err = helm.NewRenderer().Render(t.Context(), &specs.KubernetesManifestGroupSpec_HelmSource{
+		Repository: server.URL + "/charts",
+		Chart:      "demo",
+		Version:    "0.2.0",
+	}, nil, "")
+	assert.Error(t, err)
+}
+
+func TestValidateSource(t *testing.T) {
+	t.Parallel()
+
+	archive := packageChart(t)
+
+	for _, tt := range []struct {
+		source  *specs.KubernetesManifestGroupSpec_HelmSource
+		name    string
+		wantErr string
+	}{
+		{
+			name:   "http repository",
+			source: &specs.KubernetesManifestGroupSpec_HelmSource{Repository: "https://helm.cilium.io", Chart: "cilium", Version: "1.16.0"},
+		},
+		{
+			name:   "oci repository",
+			source: &specs.KubernetesManifestGroupSpec_HelmSource{Repository: "oci://ghcr.io/example/charts", Chart: "demo"},
+		},
+		{
+			name:   "archive",
+			source: &specs.KubernetesManifestGroupSpec_HelmSource{ChartArchive: archive},
+		},
+		{
+			name:    "no repository",
+			source:  &specs.KubernetesManifestGroupSpec_HelmSource{Chart: "cilium"},
+			wantErr: "repository is required",
+		},
+		{
+			name:    "unsupported scheme",
+			source:  &specs.KubernetesManifestGroupSpec_HelmSource{Repository: "ftp://example.com", Chart: "cilium"},
+			wantErr: "unsupported repository scheme",
+		},
+		{
+			name:    "invalid archive",
+			source:  &specs.KubernetesManifestGroupSpec_HelmSource{ChartArchive: []byte("not a chart")},
+			wantErr: "invalid chart archive",
+		},
+		{
+			name:    "invalid values",
+			source:  &specs.KubernetesManifestGroupSpec_HelmSource{ChartArchive: archive, Values: "- a\n- b"},
+			wantErr: "invalid values",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := helm.ValidateSource(tt.source)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package logsink implements the protocols shared by the sinks of the audit log and of the forwarded machine logs.
+package logsink
+
+import (
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+)
+
+// StringValue returns the OTLP value of the string.
+func StringValue(value string) *commonpb.AnyValue {
+	return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}}
+}
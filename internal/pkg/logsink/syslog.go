@@ -0,0 +1,241 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package logsink
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	syslogHostnameMaxLength = 255
+	syslogAppNameMaxLength  = 48
+	syslogMsgIDMaxLength    = 32
+	syslogSDNameMaxLength   = 32
+
+	syslogTimeout = 30 * time.Second
+)
+
+// SyslogConn sends RFC 5424 messages to a syslog server over TCP, framed with the octet counting of RFC 6587.
+//
+// The connection is established on the first send, and dropped after a failed one.
+type SyslogConn struct {
+	conn      net.Conn
+	writer    *bufio.Writer
+	tlsConfig *tls.Config
+	endpoint  string
+}
+
+// NewSyslogConn creates a new syslog connection to the TCP endpoint in the form "host:port", using TLS if the TLS config is set.
+func NewSyslogConn(endpoint string, tlsConfig *tls.Config) *SyslogConn {
+	return &SyslogConn{
+		endpoint:  endpoint,
+		tlsConfig: tlsConfig,
+	}
+}
+
+// Send writes the messages to the server.
+func (c *SyslogConn) Send(ctx context.Context, messages []SyslogMessage) error {
+	if err := c.connect(ctx); err != nil {
+		return err
+	}
+
+	deadline := time.Now().Add(syslogTimeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+
+	if err := c.conn.SetWriteDeadline(deadline); err != nil {
+		return c.fail(err)
+	}
+
+	for _, message := range messages {
+		formatted := message.String()
+
+		if _, err := fmt.Fprintf(c.writer, "%d %s", len(formatted), formatted); err != nil {
+			return c.fail(err)
+		}
+	}
+
+	if err := c.writer.Flush(); err != nil {
+		return c.fail(err)
+	}
+
+	return nil
+}
+
+// Close implements [io.Closer].
+func (c *SyslogConn) Close() error {
+	if c.conn == nil {
+		return nil
+	}
+
+	err := c.conn.Close()
+
+	c.conn, c.writer = nil, nil
+
+	return err
+}
+
+func (c *SyslogConn) connect(ctx context.Context) error {
+	if c.conn != nil {
+		return nil
+	}
+
+	dialer := &net.Dialer{Timeout: syslogTimeout}
+
+	var (
+		conn net.Conn
+		err  error
+	)
+
+	if c.tlsConfig != nil {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: c.tlsConfig}).DialContext(ctx, "tcp", c.endpoint)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", c.endpoint)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to connect to syslog server %q: %w", c.endpoint, err)
+	}
+
+	c.conn = conn
+	c.writer = bufio.NewWriter(conn)
+
+	return nil
+}
+
+// fail drops the connection after a write error, the messages might have been partially written.
+func (c *SyslogConn) fail(err error) error {
+	c.Close() //nolint:errcheck
+
+	return fmt.Errorf("failed to write to syslog server %q: %w", c.endpoint, err)
+}
+
+// SyslogMessage is an RFC 5424 message.
+//
+// The header fields are stripped of the characters they don't allow and truncated to their max length, the empty ones are sent as nil values.
+type SyslogMessage struct {
+	// Time is sent as the timestamp of the message, unless it's zero.
+	Time time.Time
+
+	Hostname string
+	AppName  string
+	MsgID    string
+
+	StructuredData []SDElement
+
+	Message []byte
+
+	// Priority is the facility multiplied by 8 plus the severity.
+	Priority int
+}
+
+// SDElement is a structured data element of a syslog message. The elements without parameters are not sent.
+type SDElement struct {
+	ID     string
+	Params []SDParam
+}
+
+// SDParam is a structured data parameter of a syslog message.
+type SDParam struct {
+	Name  string
+	Value string
+}
+
+// String returns the message in the RFC 5424 format.
+func (m SyslogMessage) String() string {
+	var sb strings.Builder
+
+	sb.WriteString("<" + strconv.Itoa(m.Priority) + ">1 ")
+
+	if m.Time.IsZero() {
+		sb.WriteString("-")
+	} else {
+		sb.WriteString(m.Time.UTC().Format("2006-01-02T15:04:05.000000Z07:00"))
+	}
+
+	for _, field := range []struct {
+		value     string
+		maxLength int
+	}{
+		{m.Hostname, syslogHostnameMaxLength},
+		{m.AppName, syslogAppNameMaxLength},
+		{"", 0}, // PROCID
+		{m.MsgID, syslogMsgIDMaxLength},
+	} {
+		value := syslogName(field.value, field.maxLength)
+		if value == "" {
+			value = "-"
+		}
+
+		sb.WriteString(" " + value)
+	}
+
+	sb.WriteString(" ")
+
+	sdStarted := false
+
+	for _, element := range m.StructuredData {
+		if len(element.Params) == 0 {
+			continue
+		}
+
+		sb.WriteString("[" + element.ID)
+
+		for _, param := range element.Params {
+			sb.WriteString(" " + param.Name + `="` + escapeSDParam(param.Value) + `"`)
+		}
+
+		sb.WriteString("]")
+
+		sdStarted = true
+	}
+
+	if !sdStarted {
+		sb.WriteString("-")
+	}
+
+	if len(m.Message) > 0 {
+		sb.WriteString(" ")
+		sb.Write(m.Message)
+	}
+
+	return sb.String()
+}
+
+// ValidSDName returns true if the name can be used as a structured data parameter name as is.
+func ValidSDName(name string) bool {
+	return name != "" && name == syslogName(name, syslogSDNameMaxLength) && !strings.ContainsAny(name, `="]`)
+}
+
+// syslogName drops the characters which are not allowed in the header fields and the structured data names, and truncates the name to the given length.
+func syslogName(name string, maxLength int) string {
+	name = strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return -1
+		}
+
+		return r
+	}, name)
+
+	if len(name) > maxLength {
+		name = name[:maxLength]
+	}
+
+	return name
+}
+
+// escapeSDParam escapes the characters RFC 5424 requires to be escaped in the structured data parameter values.
+func escapeSDParam(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`).Replace(value)
+}
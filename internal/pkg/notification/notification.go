@@ -0,0 +1,133 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package notification delivers the events happening in Omni to the external notification channels.
+package notification
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/cosi/labels"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+)
+
+// Event is an event delivered to the notification channels.
+type Event struct {
+	Time  time.Time
+	Type  string
+	Title string
+	Body  string
+
+	// Cluster is the cluster the event is related to, empty if it is not related to a cluster.
+	Cluster string
+
+	// Resource is the ID of the resource the event is about, the events of the same type about the same resource are throttled together.
+	Resource string
+
+	Severity specs.NotificationSpec_Type
+}
+
+// Rule is a compiled NotificationRule.
+type Rule struct {
+	selector    *resource.LabelQuery
+	id          resource.ID
+	events      []string
+	channels    []string
+	throttle    time.Duration
+	minSeverity specs.NotificationSpec_Type
+}
+
+// NewRule compiles the NotificationRule spec.
+func NewRule(id resource.ID, spec *specs.NotificationRuleSpec) (*Rule, error) {
+	if len(spec.GetChannels()) == 0 {
+		return nil, errors.New("rule must have at least one channel")
+	}
+
+	for _, event := range spec.GetEvents() {
+		if !slices.Contains(omni.NotificationEvents, event) {
+			return nil, fmt.Errorf("unknown event %q, must be one of %s", event, strings.Join(omni.NotificationEvents, ", "))
+		}
+	}
+
+	if _, ok := specs.NotificationSpec_Type_name[int32(spec.GetMinSeverity())]; !ok {
+		return nil, fmt.Errorf("unknown severity %d", spec.GetMinSeverity())
+	}
+
+	throttle := spec.GetThrottle().AsDuration()
+	if throttle < 0 {
+		return nil, errors.New("throttle must not be negative")
+	}
+
+	rule := &Rule{
+		id:          id,
+		channels:    spec.GetChannels(),
+		events:      spec.GetEvents(),
+		minSeverity: spec.GetMinSeverity(),
+		throttle:    throttle,
+	}
+
+	if spec.GetClusterSelector() != "" {
+		selector, err := labels.ParseQuery(spec.GetClusterSelector())
+		if err != nil {
+			return nil, fmt.Errorf("invalid cluster selector: %w", err)
+		}
+
+		rule.selector = selector
+	}
+
+	return rule, nil
+}
+
+// ID returns the ID of the rule.
+func (r *Rule) ID() resource.ID {
+	return r.id
+}
+
+// Channels returns the IDs of the channels the matching events are delivered to.
+func (r *Rule) Channels() []string {
+	return r.channels
+}
+
+// Throttle returns the minimum interval between the deliveries of the events of the same type about the same resource.
+func (r *Rule) Throttle() time.Duration {
+	return r.throttle
+}
+
+// Matches reports whether the event is matched by the rule.
+//
+// The clusterLabels are the labels of the cluster the event is related to, nil if the cluster doesn't exist anymore.
+func (r *Rule) Matches(event Event, clusterLabels *resource.Labels) bool {
+	if event.Severity < r.minSeverity {
+		return false
+	}
+
+	if len(r.events) > 0 && !slices.Contains(r.events, event.Type) {
+		return false
+	}
+
+	if r.selector == nil {
+		return true
+	}
+
+	if event.Cluster == "" || clusterLabels == nil {
+		return false
+	}
+
+	return r.selector.Matches(*clusterLabels)
+}
+
+// ValidateRule checks that the NotificationRule spec is well-formed.
+func ValidateRule(spec *specs.NotificationRuleSpec) error {
+	_, err := NewRule("", spec)
+
+	return err
+}
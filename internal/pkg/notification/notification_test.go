@@ -0,0 +1,209 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package notification_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/audit/auditsink"
+	"github.com/siderolabs/omni/internal/pkg/notification"
+)
+
+func TestRuleMatches(t *testing.T) {
+	t.Parallel()
+
+	rule, err := notification.NewRule("prod", &specs.NotificationRuleSpec{
+		Channels:        []string{"slack"},
+		Events:          []string{omni.NotificationEventEtcdBackupFailed, omni.NotificationEventMachineDisconnected},
+		ClusterSelector: "env=prod",
+		MinSeverity:     specs.NotificationSpec_WARNING,
+		Throttle:        durationpb.New(time.Hour),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"slack"}, rule.Channels())
+	assert.Equal(t, time.Hour, rule.Throttle())
+
+	prod := resource.Labels{}
+	prod.Set("env", "prod")
+
+	staging := resource.Labels{}
+	staging.Set("env", "staging")
+
+	event := notification.Event{
+		Type:     omni.NotificationEventEtcdBackupFailed,
+		Cluster:  "prod-1",
+		Severity: specs.NotificationSpec_ERROR,
+	}
+
+	assert.True(t, rule.Matches(event, &prod))
+	assert.False(t, rule.Matches(event, &staging))
+	assert.False(t, rule.Matches(event, nil))
+
+	event.Severity = specs.NotificationSpec_INFO
+	assert.False(t, rule.Matches(event, &prod))
+
+	event.Severity = specs.NotificationSpec_ERROR
+	event.Type = omni.NotificationEventClusterPhase
+	assert.False(t, rule.Matches(event, &prod))
+
+	// the rules without a selector match the events which are not related to a cluster
+	rule, err = notification.NewRule("all", &specs.NotificationRuleSpec{Channels: []string{"slack"}})
+	require.NoError(t, err)
+
+	assert.True(t, rule.Matches(notification.Event{Type: omni.NotificationEventMachineDisconnected}, nil))
+}
+
+func TestValidateRule(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, notification.ValidateRule(&specs.NotificationRuleSpec{Channels: []string{"slack"}}))
+	assert.ErrorContains(t, notification.ValidateRule(&specs.NotificationRuleSpec{}), "at least one channel")
+	assert.ErrorContains(t, notification.ValidateRule(&specs.NotificationRuleSpec{Channels: []string{"slack"}, Events: []string{"cluster-exploded"}}), "unknown event")
+	assert.ErrorContains(t, notification.ValidateRule(&specs.NotificationRuleSpec{Channels: []string{"slack"}, ClusterSelector: "env in ("}), "invalid cluster selector")
+	assert.ErrorContains(t, notification.ValidateRule(&specs.NotificationRuleSpec{Channels: []string{"slack"}, Throttle: durationpb.New(-time.Minute)}), "throttle")
+}
+
+func TestValidateChannel(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		spec    *specs.NotificationChannelSpec
+		name    string
+		wantErr string
+	}{
+		{
+			name: "slack",
+			spec: &specs.NotificationChannelSpec{Type: specs.NotificationChannelSpec_SLACK, Url: "https://hooks.slack.com/services/T/B/X"},
+		},
+		{
+			name: "smtp",
+			spec: &specs.NotificationChannelSpec{
+				Type: specs.NotificationChannelSpec_SMTP,
+				Smtp: &specs.NotificationChannelSpec_SMTPConfig{Host: "smtp.example.com:587", From: "omni@example.com", To: []string{"ops@example.com"}},
+			},
+		},
+		{
+			name:    "no type",
+			spec:    &specs.NotificationChannelSpec{Url: "https://example.com"},
+			wantErr: "unsupported channel type",
+		},
+		{
+			name:    "relative url",
+			spec:    &specs.NotificationChannelSpec{Type: specs.NotificationChannelSpec_WEBHOOK, Url: "/events"},
+			wantErr: "absolute http or https URL",
+		},
+		{
+			name: "smtp without port",
+			spec: &specs.NotificationChannelSpec{
+				Type: specs.NotificationChannelSpec_SMTP,
+				Smtp: &specs.NotificationChannelSpec_SMTPConfig{Host: "smtp.example.com", From: "omni@example.com", To: []string{"ops@example.com"}},
+			},
+			wantErr: "host:port",
+		},
+		{
+			name: "smtp without recipients",
+			spec: &specs.NotificationChannelSpec{
+				Type: specs.NotificationChannelSpec_SMTP,
+				Smtp: &specs.NotificationChannelSpec_SMTPConfig{Host: "smtp.example.com:25", From: "omni@example.com"},
+			},
+			wantErr: "at least one recipient",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := notification.ValidateChannel(tt.spec)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWebhookSender(t *testing.T) {
+	t.Parallel()
+
+	type request struct {
+		headers http.Header
+		body    []byte
+	}
+
+	requests := make(chan request, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		requests <- request{headers: r.Header, body: body}
+	}))
+	t.Cleanup(server.Close)
+
+	sender, err := notification.NewSender(&specs.NotificationChannelSpec{
+		Type:   specs.NotificationChannelSpec_WEBHOOK,
+		Url:    server.URL,
+		Secret: "secret",
+	}, server.Client())
+	require.NoError(t, err)
+
+	require.NoError(t, sender.Send(t.Context(), notification.Event{
+		Time:     time.Now(),
+		Type:     omni.NotificationEventEtcdBackupFailed,
+		Title:    "Etcd backup failed",
+		Body:     "access denied",
+		Cluster:  "prod-1",
+		Resource: "prod-1",
+		Severity: specs.NotificationSpec_ERROR,
+	}))
+
+	req := <-requests
+
+	timestamp := req.headers.Get(auditsink.WebhookTimestampHeader)
+	assert.Equal(t, "sha256="+auditsink.SignWebhook("secret", timestamp, req.body), req.headers.Get(auditsink.WebhookSignatureHeader))
+
+	var payload map[string]any
+
+	require.NoError(t, json.Unmarshal(req.body, &payload))
+
+	assert.Equal(t, omni.NotificationEventEtcdBackupFailed, payload["type"])
+	assert.Equal(t, "ERROR", payload["severity"])
+	assert.Equal(t, "prod-1", payload["cluster"])
+}
+
+func TestSlackSenderError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+
+	sender, err := notification.NewSender(&specs.NotificationChannelSpec{
+		Type: specs.NotificationChannelSpec_SLACK,
+		Url:  server.URL,
+	}, server.Client())
+	require.NoError(t, err)
+
+	assert.ErrorContains(t, sender.Send(t.Context(), notification.Event{Title: "test"}), "status 404")
+}
@@ -0,0 +1,301 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/mail"
+	"net/smtp"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/siderolabs/omni/client/api/omni/specs"
+	"github.com/siderolabs/omni/internal/backend/runtime/omni/audit/auditsink"
+)
+
+const sendTimeout = 30 * time.Second
+
+// Sender delivers the events to a notification channel.
+type Sender interface {
+	Send(ctx context.Context, event Event) error
+}
+
+// NewSender creates the sender for the NotificationChannel spec.
+//
+// The HTTP based channels send the requests with the client, which defaults to a client with a 30 seconds timeout.
+func NewSender(spec *specs.NotificationChannelSpec, client *http.Client) (Sender, error) {
+	if client == nil {
+		client = &http.Client{Timeout: sendTimeout}
+	}
+
+	switch spec.GetType() {
+	case specs.NotificationChannelSpec_SLACK, specs.NotificationChannelSpec_TEAMS, specs.NotificationChannelSpec_WEBHOOK:
+		if err := validateURL(spec.GetUrl()); err != nil {
+			return nil, err
+		}
+
+		return &httpSender{
+			client:  client,
+			url:     spec.GetUrl(),
+			secret:  spec.GetSecret(),
+			encode:  encoders[spec.GetType()],
+			webhook: spec.GetType() == specs.NotificationChannelSpec_WEBHOOK,
+		}, nil
+	case specs.NotificationChannelSpec_SMTP:
+		if err := validateSMTP(spec.GetSmtp()); err != nil {
+			return nil, err
+		}
+
+		return &smtpSender{config: spec.GetSmtp()}, nil
+	case specs.NotificationChannelSpec_UNKNOWN:
+	}
+
+	return nil, fmt.Errorf("unsupported channel type %q", spec.GetType())
+}
+
+// ValidateChannel checks that the NotificationChannel spec is well-formed.
+func ValidateChannel(spec *specs.NotificationChannelSpec) error {
+	_, err := NewSender(spec, http.DefaultClient)
+
+	return err
+}
+
+func validateURL(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid url: %w", err)
+	}
+
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return errors.New("url must be an absolute http or https URL")
+	}
+
+	return nil
+}
+
+func validateSMTP(config *specs.NotificationChannelSpec_SMTPConfig) error {
+	if config == nil {
+		return errors.New("smtp configuration must be set")
+	}
+
+	if _, _, err := net.SplitHostPort(config.GetHost()); err != nil {
+		return fmt.Errorf("smtp host must be in the host:port form: %w", err)
+	}
+
+	if _, err := mail.ParseAddress(config.GetFrom()); err != nil {
+		return fmt.Errorf("invalid sender address: %w", err)
+	}
+
+	if len(config.GetTo()) == 0 {
+		return errors.New("at least one recipient must be set")
+	}
+
+	for _, to := range config.GetTo() {
+		if _, err := mail.ParseAddress(to); err != nil {
+			return fmt.Errorf("invalid recipient address %q: %w", to, err)
+		}
+	}
+
+	return nil
+}
+
+// webhookPayload is the body of the generic webhook requests.
+type webhookPayload struct {
+	Time     time.Time `json:"time"`
+	Type     string    `json:"type"`
+	Severity string    `json:"severity"`
+	Title    string    `json:"title"`
+	Body     string    `json:"body"`
+	Cluster  string    `json:"cluster,omitempty"`
+	Resource string    `json:"resource,omitempty"`
+}
+
+var encoders = map[specs.NotificationChannelSpec_Type]func(Event) any{
+	specs.NotificationChannelSpec_SLACK: func(event Event) any {
+		return map[string]string{
+			"text": fmt.Sprintf("*[%s] %s*\n%s", event.Severity, event.Title, event.Body),
+		}
+	},
+	specs.NotificationChannelSpec_TEAMS: func(event Event) any {
+		return map[string]string{
+			"@type":      "MessageCard",
+			"@context":   "https://schema.org/extensions",
+			"summary":    event.Title,
+			"title":      fmt.Sprintf("[%s] %s", event.Severity, event.Title),
+			"text":       event.Body,
+			"themeColor": themeColors[event.Severity],
+		}
+	},
+	specs.NotificationChannelSpec_WEBHOOK: func(event Event) any {
+		return webhookPayload{
+			Time:     event.Time,
+			Type:     event.Type,
+			Severity: event.Severity.String(),
+			Title:    event.Title,
+			Body:     event.Body,
+			Cluster:  event.Cluster,
+			Resource: event.Resource,
+		}
+	},
+}
+
+var themeColors = map[specs.NotificationSpec_Type]string{
+	specs.NotificationSpec_INFO:    "0076D7",
+	specs.NotificationSpec_WARNING: "FFA500",
+	specs.NotificationSpec_ERROR:   "D70000",
+}
+
+// httpSender posts the events as JSON to Slack and Microsoft Teams incoming webhooks, and to the generic webhooks.
+type httpSender struct {
+	client  *http.Client
+	encode  func(Event) any
+	url     string
+	secret  string
+	webhook bool
+}
+
+// Send implements [Sender].
+//
+// The generic webhook requests are signed the same way as the audit log webhook sink requests.
+func (s *httpSender) Send(ctx context.Context, event Event) error {
+	body, err := json.Marshal(s.encode(event))
+	if err != nil {
+		return fmt.Errorf("failed to encode the event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create the request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	if s.webhook {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+		req.Header.Set(auditsink.WebhookTimestampHeader, timestamp)
+
+		if s.secret != "" {
+			req.Header.Set(auditsink.WebhookSignatureHeader, "sha256="+auditsink.SignWebhook(s.secret, timestamp, body))
+		}
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send the request: %w", err)
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	// drain the body to reuse the connection
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024)) //nolint:errcheck
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("channel responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// smtpSender sends the events by email, using STARTTLS if the server supports it.
+type smtpSender struct {
+	config *specs.NotificationChannelSpec_SMTPConfig
+}
+
+// Send implements [Sender].
+func (s *smtpSender) Send(ctx context.Context, event Event) error {
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+
+	host, _, err := net.SplitHostPort(s.config.GetHost())
+	if err != nil {
+		return err
+	}
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", s.config.GetHost())
+	if err != nil {
+		return fmt.Errorf("failed to connect to the smtp server: %w", err)
+	}
+
+	deadline, _ := ctx.Deadline()
+
+	if err = conn.SetDeadline(deadline); err != nil {
+		conn.Close() //nolint:errcheck
+
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close() //nolint:errcheck
+
+		return fmt.Errorf("failed to create the smtp client: %w", err)
+	}
+
+	defer client.Close() //nolint:errcheck
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err = client.StartTLS(&tls.Config{ServerName: host, MinVersion: tls.VersionTLS12}); err != nil {
+			return fmt.Errorf("failed to start tls: %w", err)
+		}
+	}
+
+	if s.config.GetUsername() != "" {
+		if err = client.Auth(smtp.PlainAuth("", s.config.GetUsername(), s.config.GetPassword(), host)); err != nil {
+			return fmt.Errorf("failed to authenticate: %w", err)
+		}
+	}
+
+	if err = client.Mail(s.config.GetFrom()); err != nil {
+		return err
+	}
+
+	for _, to := range s.config.GetTo() {
+		if err = client.Rcpt(to); err != nil {
+			return err
+		}
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+
+	if _, err = writer.Write(s.message(event)); err != nil {
+		return err
+	}
+
+	if err = writer.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+func (s *smtpSender) message(event Event) []byte {
+	var msg bytes.Buffer
+
+	fmt.Fprintf(&msg, "From: %s\r\n", s.config.GetFrom())
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(s.config.GetTo(), ", "))
+	fmt.Fprintf(&msg, "Subject: [Omni %s] %s\r\n", event.Severity, event.Title)
+	fmt.Fprintf(&msg, "Date: %s\r\n", event.Time.Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	msg.WriteString(strings.ReplaceAll(event.Body, "\n", "\r\n"))
+	msg.WriteString("\r\n")
+
+	return msg.Bytes()
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package logforward
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/internal/pkg/config"
+)
+
+// NewForwarderFromConfig creates a Forwarder of the sinks enabled in the config. It returns nil if no sink is enabled.
+func NewForwarderFromConfig(st state.State, config config.LogsMachineForwarding, logger *zap.Logger) (*Forwarder, error) {
+	var destinations []Destination
+
+	if config.Loki.GetEnabled() {
+		destinations = append(destinations, Destination{
+			Sink: NewLoki(LokiOptions{
+				URL:     config.Loki.GetUrl(),
+				Headers: config.Loki.Headers,
+			}),
+			Clusters: config.Loki.Clusters,
+		})
+	}
+
+	if config.Elasticsearch.GetEnabled() {
+		destinations = append(destinations, Destination{
+			Sink: NewElasticsearch(ElasticsearchOptions{
+				URL:     config.Elasticsearch.GetUrl(),
+				Index:   config.Elasticsearch.GetIndex(),
+				Headers: config.Elasticsearch.Headers,
+			}),
+			Clusters: config.Elasticsearch.Clusters,
+		})
+	}
+
+	if config.Otlp.GetEnabled() {
+		destinations = append(destinations, Destination{
+			Sink: NewOTLP(OTLPOptions{
+				Endpoint: config.Otlp.GetEndpoint(),
+				Headers:  config.Otlp.Headers,
+			}),
+			Clusters: config.Otlp.Clusters,
+		})
+	}
+
+	if config.Syslog.GetEnabled() {
+		var tlsConfig *tls.Config
+
+		if config.Syslog.GetTls() {
+			var err error
+
+			if tlsConfig, err = syslogTLSConfig(config.Syslog); err != nil {
+				return nil, err
+			}
+		}
+
+		destinations = append(destinations, Destination{
+			Sink: NewSyslog(SyslogOptions{
+				Endpoint:  config.Syslog.GetEndpoint(),
+				TLSConfig: tlsConfig,
+			}),
+			Clusters: config.Syslog.Clusters,
+		})
+	}
+
+	if len(destinations) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	var opts []Option
+
+	if bufferSize := config.GetBufferSize(); bufferSize > 0 {
+		opts = append(opts, WithBufferSize(bufferSize))
+	}
+
+	if batchSize := config.GetBatchSize(); batchSize > 0 {
+		opts = append(opts, WithBatchSize(batchSize))
+	}
+
+	if flushInterval := config.GetFlushInterval(); flushInterval > 0 {
+		opts = append(opts, WithFlushInterval(flushInterval))
+	}
+
+	return NewForwarder(st, destinations, logger, opts...), nil
+}
+
+func syslogTLSConfig(config config.LogsMachineSyslogForwarder) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if caFile := config.GetCaFile(); caFile != "" {
+		caPEM, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read syslog CA file: %w", err)
+		}
+
+		tlsConfig.RootCAs = x509.NewCertPool()
+
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no certificates found in syslog CA file %q", caFile)
+		}
+	}
+
+	if config.GetCertFile() != "" || config.GetKeyFile() != "" {
+		cert, err := tls.LoadX509KeyPair(config.GetCertFile(), config.GetKeyFile())
+		if err != nil {
+			return nil, fmt.Errorf("failed to load syslog client certificate: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
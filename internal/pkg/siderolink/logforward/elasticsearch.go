@@ -0,0 +1,161 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package logforward
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ElasticsearchOptions configures the Elasticsearch sink.
+type ElasticsearchOptions struct {
+	// Client is the HTTP client the requests are sent with. Defaults to a client with a 30 seconds timeout.
+	Client *http.Client
+
+	// Headers are sent with each bulk request.
+	Headers map[string]string
+
+	// URL is the base URL of the Elasticsearch cluster.
+	URL string
+
+	// Index is the index or the data stream the lines are written to.
+	Index string
+}
+
+// Elasticsearch writes the lines to an index or a data stream with the bulk API.
+//
+// Each line is a document with the line in the message field, and the metadata of the machine in the other fields.
+// The documents are written with the create action, so that the data streams accept them too.
+type Elasticsearch struct {
+	opts ElasticsearchOptions
+}
+
+// NewElasticsearch creates a new Elasticsearch sink.
+func NewElasticsearch(opts ElasticsearchOptions) *Elasticsearch {
+	if opts.Client == nil {
+		opts.Client = &http.Client{Timeout: httpTimeout}
+	}
+
+	opts.URL = strings.TrimSuffix(opts.URL, "/")
+
+	return &Elasticsearch{opts: opts}
+}
+
+// Name implements [Sink].
+func (e *Elasticsearch) Name() string {
+	return "elasticsearch"
+}
+
+type elasticsearchDocument struct {
+	Labels     map[string]string `json:"labels,omitempty"`
+	Timestamp  string            `json:"@timestamp"`
+	Message    string            `json:"message"`
+	MachineID  string            `json:"machine_id"`
+	Cluster    string            `json:"cluster,omitempty"`
+	MachineSet string            `json:"machine_set,omitempty"`
+	Service    string            `json:"service,omitempty"`
+}
+
+type elasticsearchBulkResponse struct {
+	Items []map[string]struct {
+		Error *struct {
+			Type   string `json:"type"`
+			Reason string `json:"reason"`
+		} `json:"error"`
+	} `json:"items"`
+	Errors bool `json:"errors"`
+}
+
+// Send implements [Sink].
+func (e *Elasticsearch) Send(ctx context.Context, lines []Line) error {
+	body, err := elasticsearchRequest(e.opts.Index, lines)
+	if err != nil {
+		return err
+	}
+
+	resp, err := postHTTP(ctx, e.opts.Client, e.opts.URL+"/_bulk", "application/x-ndjson", e.opts.Headers, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to send Elasticsearch bulk request: %w", err)
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return checkResponse(resp, "Elasticsearch", len(lines))
+	}
+
+	var bulkResp elasticsearchBulkResponse
+
+	if err = json.NewDecoder(resp.Body).Decode(&bulkResp); err != nil {
+		return fmt.Errorf("failed to decode Elasticsearch bulk response: %w", err)
+	}
+
+	if !bulkResp.Errors {
+		return nil
+	}
+
+	// the documents which were not rejected are already written, so the failed ones are not sent again to avoid the duplicates
+	var (
+		failed   int
+		firstErr error
+	)
+
+	for _, item := range bulkResp.Items {
+		for _, result := range item {
+			if result.Error == nil {
+				continue
+			}
+
+			failed++
+
+			if firstErr == nil {
+				firstErr = errors.New(result.Error.Type + ": " + result.Error.Reason)
+			}
+		}
+	}
+
+	if failed == 0 {
+		return nil
+	}
+
+	return &RejectedError{Err: fmt.Errorf("elasticsearch bulk request partially failed: %w", firstErr), Lines: failed}
+}
+
+func elasticsearchRequest(index string, lines []Line) ([]byte, error) {
+	var buf bytes.Buffer
+
+	encoder := json.NewEncoder(&buf)
+
+	action := map[string]map[string]string{
+		"create": {"_index": index},
+	}
+
+	for _, line := range lines {
+		if err := encoder.Encode(action); err != nil {
+			return nil, fmt.Errorf("failed to marshal Elasticsearch bulk action: %w", err)
+		}
+
+		if err := encoder.Encode(elasticsearchDocument{
+			Timestamp:  line.Time.UTC().Format(time.RFC3339Nano),
+			Message:    string(line.Message),
+			MachineID:  line.MachineID,
+			Cluster:    line.Cluster,
+			MachineSet: line.MachineSet,
+			Service:    line.Service,
+			Labels:     line.Labels,
+		}); err != nil {
+			return nil, fmt.Errorf("failed to marshal Elasticsearch document: %w", err)
+		}
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,464 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+// Package logforward forwards the machine logs to external systems.
+//
+// Every line received from the machines is put into the buffer of each sink with the metadata of the machine attached.
+// The buffers are bounded and separate from the ingestion limits of the local log storage: when a sink is slow or
+// unavailable, its buffer fills up and the new lines are dropped for that sink only, so neither the other sinks nor
+// the log ingestion are ever blocked.
+package logforward
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/client/pkg/panichandler"
+)
+
+const (
+	defaultBufferSize       = 10000
+	defaultBatchSize        = 500
+	defaultFlushInterval    = time.Second
+	defaultMaxRetryInterval = time.Minute
+
+	httpTimeout = 30 * time.Second
+)
+
+// Line is a log line of a machine with the metadata of the machine.
+type Line struct {
+	// Time is the time the line was received at.
+	Time time.Time
+
+	// Labels are the user labels of the machine.
+	Labels map[string]string
+
+	MachineID  string
+	Cluster    string
+	MachineSet string
+
+	// Service is the Talos service which wrote the line, if known.
+	Service string
+
+	// Message is the line as it was sent by the machine.
+	Message []byte
+}
+
+// Sink delivers the machine log lines to an external system.
+type Sink interface {
+	// Name is the unique name of the sink.
+	Name() string
+
+	// Send delivers the lines, oldest first. If Send fails, the same lines are sent again, unless the error is a [RejectedError].
+	Send(ctx context.Context, lines []Line) error
+}
+
+// RejectedError is returned by the sinks when the system refused some of the lines for a reason which is not fixed by sending them again.
+//
+// The rejected lines are dropped.
+type RejectedError struct {
+	Err   error
+	Lines int
+}
+
+// Error implements the error interface.
+func (e *RejectedError) Error() string {
+	return fmt.Sprintf("%d lines rejected: %s", e.Lines, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *RejectedError) Unwrap() error {
+	return e.Err
+}
+
+// Destination is a sink with the clusters the lines are forwarded to it for.
+type Destination struct {
+	Sink Sink
+
+	// Clusters limits the lines forwarded to the sink to the lines of the machines of these clusters. If empty, the lines of all machines are forwarded.
+	Clusters []string
+}
+
+// Option configures optional Forwarder behavior.
+type Option func(*Forwarder)
+
+// WithBufferSize sets the maximum number of lines buffered for each sink.
+func WithBufferSize(size int) Option {
+	return func(f *Forwarder) {
+		f.bufferSize = size
+	}
+}
+
+// WithBatchSize sets the maximum number of lines sent to a sink at once.
+func WithBatchSize(size int) Option {
+	return func(f *Forwarder) {
+		f.batchSize = size
+	}
+}
+
+// WithFlushInterval sets the maximum time a line is buffered before it is sent if the batch is not full earlier.
+func WithFlushInterval(interval time.Duration) Option {
+	return func(f *Forwarder) {
+		f.flushInterval = interval
+	}
+}
+
+// WithMaxRetryInterval sets the maximum interval between the retries of a failed delivery.
+func WithMaxRetryInterval(interval time.Duration) Option {
+	return func(f *Forwarder) {
+		f.maxRetryInterval = interval
+	}
+}
+
+// Forwarder forwards the machine log lines to the sinks.
+type Forwarder struct {
+	state          state.State
+	logger         *zap.Logger
+	machines       map[string]machineMetadata
+	forwardedLines *prometheus.CounterVec
+	droppedLines   *prometheus.CounterVec
+	bufferedLines  *prometheus.GaugeVec
+	queues         []*queue
+
+	bufferSize       int
+	batchSize        int
+	flushInterval    time.Duration
+	maxRetryInterval time.Duration
+
+	machinesMu sync.RWMutex
+}
+
+type queue struct {
+	sink     Sink
+	clusters map[string]struct{}
+	lines    chan Line
+}
+
+type machineMetadata struct {
+	labels     map[string]string
+	cluster    string
+	machineSet string
+}
+
+var _ prometheus.Collector = &Forwarder{}
+
+// NewForwarder creates a new Forwarder. The metadata of the machines is read from the MachineStatus resources in the state.
+func NewForwarder(st state.State, destinations []Destination, logger *zap.Logger, opts ...Option) *Forwarder {
+	forwarder := &Forwarder{
+		state:    st,
+		logger:   logger,
+		machines: map[string]machineMetadata{},
+		forwardedLines: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "omni_machine_logs_forwarded_lines_total",
+			Help: "Total number of machine log lines delivered to the log forwarding sinks.",
+		}, []string{"sink"}),
+		droppedLines: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "omni_machine_logs_forwarding_dropped_lines_total",
+			Help: "Total number of machine log lines dropped by the log forwarding sinks.",
+		}, []string{"sink", "reason"}),
+		bufferedLines: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "omni_machine_logs_forwarding_buffered_lines",
+			Help: "Number of machine log lines waiting in the buffer of the log forwarding sinks.",
+		}, []string{"sink"}),
+		bufferSize:       defaultBufferSize,
+		batchSize:        defaultBatchSize,
+		flushInterval:    defaultFlushInterval,
+		maxRetryInterval: defaultMaxRetryInterval,
+	}
+
+	for _, opt := range opts {
+		opt(forwarder)
+	}
+
+	for _, destination := range destinations {
+		q := &queue{
+			sink:  destination.Sink,
+			lines: make(chan Line, forwarder.bufferSize),
+		}
+
+		if len(destination.Clusters) > 0 {
+			q.clusters = make(map[string]struct{}, len(destination.Clusters))
+
+			for _, cluster := range destination.Clusters {
+				q.clusters[cluster] = struct{}{}
+			}
+		}
+
+		forwarder.queues = append(forwarder.queues, q)
+	}
+
+	return forwarder
+}
+
+// Describe implements prometheus.Collector.
+func (f *Forwarder) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(f, ch)
+}
+
+// Collect implements prometheus.Collector.
+func (f *Forwarder) Collect(ch chan<- prometheus.Metric) {
+	for _, q := range f.queues {
+		f.bufferedLines.WithLabelValues(q.sink.Name()).Set(float64(len(q.lines)))
+	}
+
+	f.forwardedLines.Collect(ch)
+	f.droppedLines.Collect(ch)
+	f.bufferedLines.Collect(ch)
+}
+
+// Forward puts the line of the Talos service into the buffers of the sinks the machine's lines are forwarded to, the service is empty for the other lines.
+// It never blocks: if the buffer of a sink is full, the line is dropped for that sink.
+func (f *Forwarder) Forward(machineID string, message []byte, service string, receivedAt time.Time) {
+	f.machinesMu.RLock()
+	metadata := f.machines[machineID]
+	f.machinesMu.RUnlock()
+
+	var (
+		line   Line
+		parsed bool
+	)
+
+	for _, q := range f.queues {
+		if q.clusters != nil {
+			if _, ok := q.clusters[metadata.cluster]; !ok {
+				continue
+			}
+		}
+
+		if !parsed {
+			line = Line{
+				Time:       receivedAt,
+				Labels:     metadata.labels,
+				MachineID:  machineID,
+				Cluster:    metadata.cluster,
+				MachineSet: metadata.machineSet,
+				Service:    service,
+				Message:    slices.Clone(message),
+			}
+
+			parsed = true
+		}
+
+		select {
+		case q.lines <- line:
+		default:
+			f.droppedLines.WithLabelValues(q.sink.Name(), "buffer_full").Inc()
+		}
+	}
+}
+
+// Run keeps the metadata of the machines up to date and sends the buffered lines to the sinks until the context is canceled.
+func (f *Forwarder) Run(ctx context.Context) error {
+	eg, ctx := panichandler.ErrGroupWithContext(ctx)
+
+	eg.Go(func() error {
+		return f.watchMachines(ctx)
+	})
+
+	for _, q := range f.queues {
+		eg.Go(func() error {
+			f.runQueue(ctx, q)
+
+			return nil
+		})
+	}
+
+	return eg.Wait()
+}
+
+func (f *Forwarder) watchMachines(ctx context.Context) error {
+	eventCh := make(chan state.Event)
+
+	if err := f.state.WatchKind(ctx, omni.NewMachineStatus("").Metadata(), eventCh, state.WithBootstrapContents(true)); err != nil {
+		return fmt.Errorf("failed to watch machine statuses: %w", err)
+	}
+
+	for {
+		var event state.Event
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case event = <-eventCh:
+		}
+
+		switch event.Type {
+		case state.Bootstrapped, state.Noop:
+			// ignore
+		case state.Errored:
+			return fmt.Errorf("error watching machine statuses: %w", event.Error)
+		case state.Created, state.Updated:
+			metadata := newMachineMetadata(event.Resource.Metadata().Labels())
+
+			f.machinesMu.Lock()
+			f.machines[event.Resource.Metadata().ID()] = metadata
+			f.machinesMu.Unlock()
+		case state.Destroyed:
+			f.machinesMu.Lock()
+			delete(f.machines, event.Resource.Metadata().ID())
+			f.machinesMu.Unlock()
+		}
+	}
+}
+
+// newMachineMetadata picks the cluster, the machine set and the user labels from the labels of the machine status.
+//
+// The system labels other than the cluster and the machine set are left out: they describe the state of the machine
+// and change often, which would split the streams of the lines in the systems which index them by the labels.
+func newMachineMetadata(labels *resource.Labels) machineMetadata {
+	metadata := machineMetadata{}
+
+	metadata.cluster, _ = labels.Get(omni.LabelCluster)
+	metadata.machineSet, _ = labels.Get(omni.LabelMachineSet)
+
+	for key, value := range labels.Raw() {
+		if strings.HasPrefix(key, omni.SystemLabelPrefix) {
+			continue
+		}
+
+		if metadata.labels == nil {
+			metadata.labels = map[string]string{}
+		}
+
+		metadata.labels[key] = value
+	}
+
+	return metadata
+}
+
+func (f *Forwarder) runQueue(ctx context.Context, q *queue) {
+	logger := f.logger.With(zap.String("sink", q.sink.Name()))
+
+	if closer, ok := q.sink.(io.Closer); ok {
+		defer closer.Close() //nolint:errcheck
+	}
+
+	ticker := time.NewTicker(f.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]Line, 0, f.batchSize)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case line := <-q.lines:
+			batch = append(batch, line)
+
+			if len(batch) < f.batchSize {
+				continue
+			}
+		case <-ticker.C:
+			if len(batch) == 0 {
+				continue
+			}
+		}
+
+		if !f.send(ctx, q.sink, batch, logger) {
+			return
+		}
+
+		batch = make([]Line, 0, f.batchSize)
+	}
+}
+
+// send delivers the batch to the sink, retrying until it is delivered or rejected. It returns false if the context is canceled.
+//
+// The new lines are buffered while the delivery is retried, and dropped once the buffer is full.
+func (f *Forwarder) send(ctx context.Context, sink Sink, batch []Line, logger *zap.Logger) bool {
+	retryBackoff := backoff.NewExponentialBackOff()
+	retryBackoff.MaxInterval = f.maxRetryInterval
+
+	for {
+		err := sink.Send(ctx, batch)
+		if err == nil {
+			f.forwardedLines.WithLabelValues(sink.Name()).Add(float64(len(batch)))
+
+			return true
+		}
+
+		if ctx.Err() != nil {
+			return false
+		}
+
+		var rejectedErr *RejectedError
+
+		if errors.As(err, &rejectedErr) {
+			logger.Warn("machine log lines rejected", zap.Int("lines", rejectedErr.Lines), zap.Error(rejectedErr.Err))
+
+			f.droppedLines.WithLabelValues(sink.Name(), "rejected").Add(float64(rejectedErr.Lines))
+			f.forwardedLines.WithLabelValues(sink.Name()).Add(float64(len(batch) - rejectedErr.Lines))
+
+			return true
+		}
+
+		logger.Warn("failed to forward machine log lines", zap.Int("lines", len(batch)), zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(retryBackoff.NextBackOff()):
+		}
+	}
+}
+
+// checkResponse drains the body of the response and converts an unsuccessful status into an error.
+//
+// The statuses which mean that the request itself is invalid, so it fails the same way when sent again, are returned as a [RejectedError].
+func checkResponse(resp *http.Response, system string, lines int) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024)) //nolint:errcheck
+
+	// drain the rest of the body to reuse the connection
+	io.Copy(io.Discard, resp.Body) //nolint:errcheck
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	err := fmt.Errorf("%s responded with status %d: %s", system, resp.StatusCode, strings.TrimSpace(string(body)))
+
+	switch resp.StatusCode {
+	case http.StatusBadRequest, http.StatusRequestEntityTooLarge, http.StatusUnprocessableEntity:
+		return &RejectedError{Err: err, Lines: lines}
+	default:
+		return err
+	}
+}
+
+// postHTTP sends the body to the URL with the extra headers.
+func postHTTP(ctx context.Context, client *http.Client, url, contentType string, headers map[string]string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	req.Header.Set("Content-Type", contentType)
+
+	return client.Do(req)
+}
+
+// sortedLabels returns the labels sorted by their keys, so that the same labels are always sent in the same order.
+func sortedLabels(labels map[string]string) []string {
+	return slices.Sorted(maps.Keys(labels))
+}
@@ -0,0 +1,495 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package logforward_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
+	"github.com/siderolabs/omni/internal/pkg/siderolink/logforward"
+)
+
+type fakeSink struct {
+	name     string
+	lines    []logforward.Line
+	failures int
+	reject   int
+	mu       sync.Mutex
+}
+
+func (s *fakeSink) Name() string { return s.name }
+
+func (s *fakeSink) Send(_ context.Context, lines []logforward.Line) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.failures > 0 {
+		s.failures--
+
+		return errors.New("sink is down")
+	}
+
+	if s.reject > 0 {
+		return &logforward.RejectedError{Err: errors.New("invalid lines"), Lines: s.reject}
+	}
+
+	s.lines = append(s.lines, lines...)
+
+	return nil
+}
+
+func (s *fakeSink) messages() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	messages := make([]string, 0, len(s.lines))
+
+	for _, line := range s.lines {
+		messages = append(messages, string(line.Message))
+	}
+
+	return messages
+}
+
+func (s *fakeSink) received() []logforward.Line {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]logforward.Line(nil), s.lines...)
+}
+
+func TestForwarder(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 30*time.Second)
+	t.Cleanup(cancel)
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	machineStatus := omni.NewMachineStatus("machine-1")
+	machineStatus.Metadata().Labels().Set(omni.LabelCluster, "cluster-1")
+	machineStatus.Metadata().Labels().Set(omni.LabelMachineSet, "cluster-1-workers")
+	machineStatus.Metadata().Labels().Set(omni.MachineStatusLabelConnected, "")
+	machineStatus.Metadata().Labels().Set("region", "eu")
+
+	require.NoError(t, st.Create(ctx, machineStatus))
+	require.NoError(t, st.Create(ctx, omni.NewMachineStatus("machine-2")))
+
+	allSink := &fakeSink{name: "all", failures: 2}
+	clusterSink := &fakeSink{name: "cluster"}
+
+	forwarder := logforward.NewForwarder(st, []logforward.Destination{
+		{Sink: allSink},
+		{Sink: clusterSink, Clusters: []string{"cluster-1"}},
+	}, zaptest.NewLogger(t), logforward.WithFlushInterval(10*time.Millisecond), logforward.WithMaxRetryInterval(50*time.Millisecond))
+
+	stop := runForwarder(ctx, t, forwarder)
+	defer stop()
+
+	// the metadata of the machines is loaded in the background, wait for the lines of the cluster to be forwarded
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		forwarder.Forward("machine-1", []byte("probe"), "", time.Now())
+
+		assert.NotEmpty(collect, clusterSink.received())
+	}, 10*time.Second, 100*time.Millisecond)
+
+	forwarder.Forward("machine-1", []byte(`{"msg":"hello","talos-service":"apid"}`), "apid", time.Now())
+	forwarder.Forward("machine-2", []byte("not in a cluster"), "", time.Now())
+
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		messages := allSink.messages()
+
+		assert.Contains(collect, messages, `{"msg":"hello","talos-service":"apid"}`)
+		assert.Contains(collect, messages, "not in a cluster")
+		assert.Contains(collect, clusterSink.messages(), `{"msg":"hello","talos-service":"apid"}`)
+	}, 10*time.Second, 10*time.Millisecond)
+
+	lines := clusterSink.received()
+	line := lines[len(lines)-1]
+
+	assert.Equal(t, "machine-1", line.MachineID)
+	assert.Equal(t, "cluster-1", line.Cluster)
+	assert.Equal(t, "cluster-1-workers", line.MachineSet)
+	assert.Equal(t, "apid", line.Service)
+	assert.Equal(t, map[string]string{"region": "eu"}, line.Labels)
+
+	assert.NotContains(t, clusterSink.messages(), "not in a cluster")
+}
+
+func TestForwarderBufferFull(t *testing.T) {
+	t.Parallel()
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+	sink := &fakeSink{name: "fake"}
+
+	// the forwarder is not running, so the lines stay in the buffer
+	forwarder := logforward.NewForwarder(st, []logforward.Destination{{Sink: sink}}, zaptest.NewLogger(t), logforward.WithBufferSize(2))
+
+	for i := range 5 {
+		forwarder.Forward("machine-1", []byte("line "+strconv.Itoa(i)), "", time.Now())
+	}
+
+	expected := `
+# HELP omni_machine_logs_forwarding_buffered_lines Number of machine log lines waiting in the buffer of the log forwarding sinks.
+# TYPE omni_machine_logs_forwarding_buffered_lines gauge
+omni_machine_logs_forwarding_buffered_lines{sink="fake"} 2
+# HELP omni_machine_logs_forwarding_dropped_lines_total Total number of machine log lines dropped by the log forwarding sinks.
+# TYPE omni_machine_logs_forwarding_dropped_lines_total counter
+omni_machine_logs_forwarding_dropped_lines_total{reason="buffer_full",sink="fake"} 3
+`
+
+	assert.NoError(t, testutil.CollectAndCompare(forwarder, strings.NewReader(expected),
+		"omni_machine_logs_forwarding_buffered_lines", "omni_machine_logs_forwarding_dropped_lines_total"))
+}
+
+func TestForwarderRejected(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 30*time.Second)
+	t.Cleanup(cancel)
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+	sink := &fakeSink{name: "fake", reject: 1}
+
+	forwarder := logforward.NewForwarder(st, []logforward.Destination{{Sink: sink}}, zaptest.NewLogger(t), logforward.WithBatchSize(3))
+
+	stop := runForwarder(ctx, t, forwarder)
+	defer stop()
+
+	for i := range 3 {
+		forwarder.Forward("machine-1", []byte("line "+strconv.Itoa(i)), "", time.Now())
+	}
+
+	expected := `
+# HELP omni_machine_logs_forwarded_lines_total Total number of machine log lines delivered to the log forwarding sinks.
+# TYPE omni_machine_logs_forwarded_lines_total counter
+omni_machine_logs_forwarded_lines_total{sink="fake"} 2
+# HELP omni_machine_logs_forwarding_dropped_lines_total Total number of machine log lines dropped by the log forwarding sinks.
+# TYPE omni_machine_logs_forwarding_dropped_lines_total counter
+omni_machine_logs_forwarding_dropped_lines_total{reason="rejected",sink="fake"} 1
+`
+
+	// the rejected lines are not sent again
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		assert.NoError(collect, testutil.CollectAndCompare(forwarder, strings.NewReader(expected),
+			"omni_machine_logs_forwarded_lines_total", "omni_machine_logs_forwarding_dropped_lines_total"))
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+func TestLoki(t *testing.T) {
+	t.Parallel()
+
+	requests := make(chan map[string]any, 1)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Scope-OrgID") != "tenant" || r.Header.Get("Content-Type") != "application/json" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		var req map[string]any
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		requests <- req
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+
+	sink := logforward.NewLoki(logforward.LokiOptions{URL: srv.URL, Headers: map[string]string{"X-Scope-OrgID": "tenant"}})
+
+	require.NoError(t, sink.Send(t.Context(), testLines()))
+
+	req := <-requests
+
+	streams, ok := req["streams"].([]any)
+	require.True(t, ok)
+	require.Len(t, streams, 2)
+
+	stream, ok := streams[0].(map[string]any)
+	require.True(t, ok)
+
+	assert.Equal(t, map[string]any{
+		"machine_id":       "machine-1",
+		"cluster":          "cluster-1",
+		"machine_set":      "cluster-1-workers",
+		"service":          "apid",
+		"example_com_zone": "a",
+	}, stream["stream"])
+
+	values, ok := stream["values"].([]any)
+	require.True(t, ok)
+	require.Len(t, values, 2)
+	assert.Equal(t, []any{strconv.FormatInt(time.Unix(1, 0).UnixNano(), 10), `{"msg":"line 1","talos-service":"apid"}`}, values[0])
+}
+
+func TestLokiRejected(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "entry too far behind", http.StatusBadRequest)
+	}))
+	t.Cleanup(srv.Close)
+
+	sink := logforward.NewLoki(logforward.LokiOptions{URL: srv.URL})
+
+	var rejectedErr *logforward.RejectedError
+
+	require.ErrorAs(t, sink.Send(t.Context(), testLines()), &rejectedErr)
+	assert.Equal(t, 3, rejectedErr.Lines)
+}
+
+func TestElasticsearch(t *testing.T) {
+	t.Parallel()
+
+	bodies := make(chan string, 1)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/_bulk" || r.Header.Get("Authorization") != "ApiKey key" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		bodies <- string(body)
+
+		w.Header().Set("Content-Type", "application/json")
+
+		// the second document is rejected
+		w.Write([]byte(`{"errors":true,"items":[` + //nolint:errcheck
+			`{"create":{"status":201}},` +
+			`{"create":{"status":400,"error":{"type":"document_parsing_exception","reason":"failed to parse"}}},` +
+			`{"create":{"status":201}}]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	sink := logforward.NewElasticsearch(logforward.ElasticsearchOptions{
+		URL:     srv.URL + "/",
+		Index:   "machine-logs",
+		Headers: map[string]string{"Authorization": "ApiKey key"},
+	})
+
+	var rejectedErr *logforward.RejectedError
+
+	require.ErrorAs(t, sink.Send(t.Context(), testLines()), &rejectedErr)
+	assert.Equal(t, 1, rejectedErr.Lines)
+	assert.ErrorContains(t, rejectedErr, "document_parsing_exception")
+
+	requestLines := strings.Split(strings.TrimSuffix(<-bodies, "\n"), "\n")
+	require.Len(t, requestLines, 6)
+
+	assert.JSONEq(t, `{"create":{"_index":"machine-logs"}}`, requestLines[0])
+	assert.JSONEq(t, `{
+		"@timestamp": "1970-01-01T00:00:01Z",
+		"message": "{\"msg\":\"line 1\",\"talos-service\":\"apid\"}",
+		"machine_id": "machine-1",
+		"cluster": "cluster-1",
+		"machine_set": "cluster-1-workers",
+		"service": "apid",
+		"labels": {"example.com/zone": "a"}
+	}`, requestLines[1])
+}
+
+func TestOTLP(t *testing.T) {
+	t.Parallel()
+
+	requests := make(chan *collogspb.ExportLogsServiceRequest, 1)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer token" || r.Header.Get("Content-Type") != "application/x-protobuf" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		var req collogspb.ExportLogsServiceRequest
+
+		if err = proto.Unmarshal(body, &req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		requests <- &req
+	}))
+	t.Cleanup(srv.Close)
+
+	sink := logforward.NewOTLP(logforward.OTLPOptions{Endpoint: srv.URL, Headers: map[string]string{"Authorization": "Bearer token"}})
+
+	require.NoError(t, sink.Send(t.Context(), testLines()))
+
+	req := <-requests
+
+	// the records are grouped by the machine
+	require.Len(t, req.ResourceLogs, 2)
+
+	attributes := map[string]string{}
+
+	for _, attr := range req.ResourceLogs[0].Resource.Attributes {
+		attributes[attr.Key] = attr.Value.GetStringValue()
+	}
+
+	assert.Equal(t, map[string]string{
+		logforward.OTLPMachineIDAttribute: "machine-1",
+		"omni.cluster":                    "cluster-1",
+		"omni.machine_set":                "cluster-1-workers",
+		logforward.OTLPLabelAttributePrefix + "example.com/zone": "a",
+	}, attributes)
+
+	require.Len(t, req.ResourceLogs[0].ScopeLogs, 1)
+
+	records := req.ResourceLogs[0].ScopeLogs[0].LogRecords
+	require.Len(t, records, 2)
+
+	assert.Equal(t, `{"msg":"line 1","talos-service":"apid"}`, records[0].Body.GetStringValue())
+	assert.Equal(t, uint64(time.Unix(1, 0).UnixNano()), records[0].TimeUnixNano)
+
+	require.Len(t, req.ResourceLogs[1].ScopeLogs, 1)
+	assert.Len(t, req.ResourceLogs[1].ScopeLogs[0].LogRecords, 1)
+}
+
+func TestSyslog(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { listener.Close() }) //nolint:errcheck
+
+	messages := make(chan string, 3)
+
+	go func() {
+		conn, acceptErr := listener.Accept()
+		if acceptErr != nil {
+			return
+		}
+
+		defer conn.Close() //nolint:errcheck
+
+		reader := bufio.NewReader(conn)
+
+		for {
+			length, readErr := reader.ReadString(' ')
+			if readErr != nil {
+				return
+			}
+
+			n, convErr := strconv.Atoi(strings.TrimSuffix(length, " "))
+			if convErr != nil {
+				return
+			}
+
+			message := make([]byte, n)
+
+			if _, readErr = io.ReadFull(reader, message); readErr != nil {
+				return
+			}
+
+			messages <- string(message)
+		}
+	}()
+
+	sink := logforward.NewSyslog(logforward.SyslogOptions{Endpoint: listener.Addr().String()})
+	t.Cleanup(func() { sink.Close() }) //nolint:errcheck
+
+	require.NoError(t, sink.Send(t.Context(), testLines()))
+
+	assert.Equal(t,
+		`<14>1 1970-01-01T00:00:01.000000Z machine-1 apid - - [machine@32473 id="machine-1" cluster="cluster-1" machineSet="cluster-1-workers"]`+
+			`[labels@32473 example.com/zone="a"] {"msg":"line 1","talos-service":"apid"}`,
+		<-messages)
+
+	<-messages
+
+	assert.Equal(t, `<14>1 1970-01-01T00:00:03.000000Z machine-2 - - - [machine@32473 id="machine-2"] plain line`, <-messages)
+}
+
+func testLines() []logforward.Line {
+	machine1 := logforward.Line{
+		MachineID:  "machine-1",
+		Cluster:    "cluster-1",
+		MachineSet: "cluster-1-workers",
+		Service:    "apid",
+		Labels:     map[string]string{"example.com/zone": "a"},
+	}
+
+	line1, line2 := machine1, machine1
+
+	line1.Time = time.Unix(1, 0)
+	line1.Message = []byte(`{"msg":"line 1","talos-service":"apid"}`)
+
+	line2.Time = time.Unix(2, 0)
+	line2.Message = []byte(`{"msg":"line 2","talos-service":"apid"}`)
+
+	return []logforward.Line{
+		line1,
+		line2,
+		{
+			Time:      time.Unix(3, 0),
+			MachineID: "machine-2",
+			Message:   []byte("plain line"),
+		},
+	}
+}
+
+func runForwarder(ctx context.Context, t *testing.T, forwarder *logforward.Forwarder) func() {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(ctx)
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- forwarder.Run(ctx)
+	}()
+
+	return func() {
+		cancel()
+
+		require.NoError(t, <-errCh)
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package logforward
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// LokiOptions configures the Loki sink.
+type LokiOptions struct {
+	// Client is the HTTP client the requests are sent with. Defaults to a client with a 30 seconds timeout.
+	Client *http.Client
+
+	// Headers are sent with each push request.
+	Headers map[string]string
+
+	// URL is the URL of the Loki push API.
+	URL string
+}
+
+// Loki pushes the lines to the Loki push API as JSON.
+//
+// The lines are grouped into streams by the machine and the service. The machine ID, cluster, machine set, service and
+// the user labels of the machine are the labels of the stream, with the characters Loki doesn't allow in the label
+// names replaced with underscores.
+type Loki struct {
+	opts LokiOptions
+}
+
+// NewLoki creates a new Loki sink.
+func NewLoki(opts LokiOptions) *Loki {
+	if opts.Client == nil {
+		opts.Client = &http.Client{Timeout: httpTimeout}
+	}
+
+	return &Loki{opts: opts}
+}
+
+// Name implements [Sink].
+func (l *Loki) Name() string {
+	return "loki"
+}
+
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+type lokiPushRequest struct {
+	Streams []*lokiStream `json:"streams"`
+}
+
+// Send implements [Sink].
+func (l *Loki) Send(ctx context.Context, lines []Line) error {
+	body, err := json.Marshal(lokiRequest(lines))
+	if err != nil {
+		return fmt.Errorf("failed to marshal Loki push request: %w", err)
+	}
+
+	resp, err := postHTTP(ctx, l.opts.Client, l.opts.URL, "application/json", l.opts.Headers, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to send Loki push request: %w", err)
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	return checkResponse(resp, "Loki", len(lines))
+}
+
+func lokiRequest(lines []Line) *lokiPushRequest {
+	var (
+		req     lokiPushRequest
+		streams = map[string]*lokiStream{}
+	)
+
+	for _, line := range lines {
+		key := line.MachineID + "/" + line.Service
+
+		stream, ok := streams[key]
+		if !ok {
+			stream = &lokiStream{Stream: lokiLabels(line)}
+			streams[key] = stream
+
+			req.Streams = append(req.Streams, stream)
+		}
+
+		stream.Values = append(stream.Values, [2]string{strconv.FormatInt(line.Time.UnixNano(), 10), string(line.Message)})
+	}
+
+	return &req
+}
+
+// lokiLabels returns the labels of the stream of the line. The labels set by Omni take precedence over the user labels with the same name.
+func lokiLabels(line Line) map[string]string {
+	labels := make(map[string]string, len(line.Labels)+4)
+
+	for _, key := range sortedLabels(line.Labels) {
+		labels[lokiLabelName(key)] = line.Labels[key]
+	}
+
+	labels["machine_id"] = line.MachineID
+
+	for _, label := range []struct{ name, value string }{
+		{"cluster", line.Cluster},
+		{"machine_set", line.MachineSet},
+		{"service", line.Service},
+	} {
+		if label.value != "" {
+			labels[label.name] = label.value
+		} else {
+			delete(labels, label.name)
+		}
+	}
+
+	return labels
+}
+
+// lokiLabelName converts the label key into a valid Prometheus label name, which Loki requires.
+func lokiLabelName(key string) string {
+	var sb strings.Builder
+
+	for i, r := range key {
+		switch {
+		case r == '_', r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+			sb.WriteRune(r)
+		case r >= '0' && r <= '9':
+			if i == 0 {
+				sb.WriteRune('_')
+			}
+
+			sb.WriteRune(r)
+		default:
+			sb.WriteRune('_')
+		}
+	}
+
+	return sb.String()
+}
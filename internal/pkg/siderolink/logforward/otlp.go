@@ -0,0 +1,141 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package logforward
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/siderolabs/omni/internal/pkg/logsink"
+)
+
+const (
+	otlpScopeName = "omni.machine_logs"
+
+	// OTLPMachineIDAttribute is the resource attribute with the ID of the machine.
+	OTLPMachineIDAttribute = "omni.machine.id"
+
+	// OTLPLabelAttributePrefix is the prefix of the resource attributes with the user labels of the machine.
+	OTLPLabelAttributePrefix = "omni.machine.label."
+)
+
+// OTLPOptions configures the OTLP sink.
+type OTLPOptions struct {
+	// Client is the HTTP client the requests are sent with. Defaults to a client with a 30 seconds timeout.
+	Client *http.Client
+
+	// Headers are sent with each export request.
+	Headers map[string]string
+
+	// Endpoint is the URL of the OTLP/HTTP logs endpoint.
+	Endpoint string
+}
+
+// OTLP exports the lines as OpenTelemetry log records over OTLP/HTTP, using the binary protobuf encoding.
+//
+// The records of each machine are grouped under a resource with the metadata of the machine in its attributes.
+type OTLP struct {
+	opts OTLPOptions
+}
+
+// NewOTLP creates a new OTLP sink.
+func NewOTLP(opts OTLPOptions) *OTLP {
+	if opts.Client == nil {
+		opts.Client = &http.Client{Timeout: httpTimeout}
+	}
+
+	return &OTLP{opts: opts}
+}
+
+// Name implements [Sink].
+func (o *OTLP) Name() string {
+	return "otlp"
+}
+
+// Send implements [Sink].
+func (o *OTLP) Send(ctx context.Context, lines []Line) error {
+	body, err := proto.Marshal(otlpRequest(lines, time.Now()))
+	if err != nil {
+		return fmt.Errorf("failed to marshal OTLP logs request: %w", err)
+	}
+
+	resp, err := postHTTP(ctx, o.opts.Client, o.opts.Endpoint, "application/x-protobuf", o.opts.Headers, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to send OTLP request: %w", err)
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	// the records rejected in a partially successful response must not be retried, so any 2xx is a success
+	return checkResponse(resp, "OTLP endpoint", len(lines))
+}
+
+func otlpRequest(lines []Line, observed time.Time) *collogspb.ExportLogsServiceRequest {
+	var (
+		req       collogspb.ExportLogsServiceRequest
+		resources = map[string]*logspb.ScopeLogs{}
+	)
+
+	for _, line := range lines {
+		scopeLogs, ok := resources[line.MachineID]
+		if !ok {
+			scopeLogs = &logspb.ScopeLogs{
+				Scope: &commonpb.InstrumentationScope{Name: otlpScopeName},
+			}
+
+			resources[line.MachineID] = scopeLogs
+
+			req.ResourceLogs = append(req.ResourceLogs, &logspb.ResourceLogs{
+				Resource:  &resourcepb.Resource{Attributes: otlpResourceAttributes(line)},
+				ScopeLogs: []*logspb.ScopeLogs{scopeLogs},
+			})
+		}
+
+		record := &logspb.LogRecord{
+			TimeUnixNano:         uint64(line.Time.UnixNano()),
+			ObservedTimeUnixNano: uint64(observed.UnixNano()),
+			Body:                 logsink.StringValue(string(line.Message)),
+		}
+
+		if line.Service != "" {
+			record.Attributes = []*commonpb.KeyValue{{Key: "omni.machine.service", Value: logsink.StringValue(line.Service)}}
+		}
+
+		scopeLogs.LogRecords = append(scopeLogs.LogRecords, record)
+	}
+
+	return &req
+}
+
+func otlpResourceAttributes(line Line) []*commonpb.KeyValue {
+	attributes := []*commonpb.KeyValue{
+		{Key: OTLPMachineIDAttribute, Value: logsink.StringValue(line.MachineID)},
+	}
+
+	for _, attr := range []struct{ key, value string }{
+		{"omni.cluster", line.Cluster},
+		{"omni.machine_set", line.MachineSet},
+	} {
+		if attr.value != "" {
+			attributes = append(attributes, &commonpb.KeyValue{Key: attr.key, Value: logsink.StringValue(attr.value)})
+		}
+	}
+
+	for _, key := range sortedLabels(line.Labels) {
+		attributes = append(attributes, &commonpb.KeyValue{Key: OTLPLabelAttributePrefix + key, Value: logsink.StringValue(line.Labels[key])})
+	}
+
+	return attributes
+}
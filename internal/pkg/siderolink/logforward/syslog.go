@@ -0,0 +1,100 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package logforward
+
+import (
+	"context"
+	"crypto/tls"
+
+	"github.com/siderolabs/omni/internal/pkg/logsink"
+)
+
+const (
+	// syslogPriority is the "user-level messages" facility (1) with the "informational" severity (6).
+	syslogPriority = 1*8 + 6
+
+	// syslogMachineSDID is the structured data element the machine is described with, and syslogLabelsSDID is the one with the user labels of the machine.
+	// The enterprise number is the one reserved for documentation by RFC 5612.
+	syslogMachineSDID = "machine@32473"
+	syslogLabelsSDID  = "labels@32473"
+)
+
+// SyslogOptions configures the syslog sink.
+type SyslogOptions struct {
+	// TLSConfig enables TLS for the connection to the server if set.
+	TLSConfig *tls.Config
+
+	// Endpoint is the TCP endpoint of the syslog server in the form "host:port".
+	Endpoint string
+}
+
+// Syslog sends the lines as RFC 5424 messages to a syslog server over TCP, framed with the octet counting of RFC 6587.
+//
+// The hostname of each message is the machine ID and the app name is the service which wrote the line. The cluster and the machine set are
+// sent as the structured data. The user labels are sent as the structured data too, except for the ones with a key which is not a valid
+// structured data parameter name.
+type Syslog struct {
+	conn *logsink.SyslogConn
+}
+
+// NewSyslog creates a new syslog sink. The connection is established on the first send.
+func NewSyslog(opts SyslogOptions) *Syslog {
+	return &Syslog{conn: logsink.NewSyslogConn(opts.Endpoint, opts.TLSConfig)}
+}
+
+// Name implements [Sink].
+func (s *Syslog) Name() string {
+	return "syslog"
+}
+
+// Send implements [Sink].
+func (s *Syslog) Send(ctx context.Context, lines []Line) error {
+	messages := make([]logsink.SyslogMessage, 0, len(lines))
+
+	for _, line := range lines {
+		messages = append(messages, syslogMessage(line))
+	}
+
+	return s.conn.Send(ctx, messages)
+}
+
+// Close implements [io.Closer].
+func (s *Syslog) Close() error {
+	return s.conn.Close()
+}
+
+func syslogMessage(line Line) logsink.SyslogMessage {
+	machineParams := []logsink.SDParam{{Name: "id", Value: line.MachineID}}
+
+	for _, param := range []logsink.SDParam{
+		{Name: "cluster", Value: line.Cluster},
+		{Name: "machineSet", Value: line.MachineSet},
+	} {
+		if param.Value != "" {
+			machineParams = append(machineParams, param)
+		}
+	}
+
+	var labelParams []logsink.SDParam
+
+	for _, key := range sortedLabels(line.Labels) {
+		if logsink.ValidSDName(key) {
+			labelParams = append(labelParams, logsink.SDParam{Name: key, Value: line.Labels[key]})
+		}
+	}
+
+	return logsink.SyslogMessage{
+		Priority: syslogPriority,
+		Time:     line.Time,
+		Hostname: line.MachineID,
+		AppName:  line.Service,
+		StructuredData: []logsink.SDElement{
+			{ID: syslogMachineSDID, Params: machineParams},
+			{ID: syslogLabelsSDID, Params: labelParams},
+		},
+		Message: line.Message,
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/siderolabs/omni/client/pkg/omni/resources/omni"
 	"github.com/siderolabs/omni/client/pkg/panichandler"
 	"github.com/siderolabs/omni/internal/pkg/config"
+	"github.com/siderolabs/omni/internal/pkg/siderolink/logforward"
 	"github.com/siderolabs/omni/internal/pkg/siderolink/logstore"
 )
 
@@ -28,6 +29,7 @@ type LogStoreManager interface {
 	Exists(ctx context.Context, id string) (bool, error)
 	Create(id string) (logstore.LogStore, error)
 	Remove(ctx context.Context, id string) error
+	Query(ctx context.Context, query logstore.Query) (logstore.EntryReader, error)
 }
 
 // LogHandlerOption configures optional LogHandler behavior.
@@ -36,6 +38,7 @@ type LogHandlerOption func(*logHandlerOptions)
 type logHandlerOptions struct {
 	onCleanup func(int)
 	limiter   *LogIngestionLimiter
+	forwarder *logforward.Forwarder
 }
 
 // WithLogIngestionLimiter sets a per-machine log ingestion limiter.
@@ -45,6 +48,13 @@ func WithLogIngestionLimiter(limiter *LogIngestionLimiter) LogHandlerOption {
 	}
 }
 
+// WithLogForwarder sets the forwarder every received log line is forwarded to, regardless of the ingestion limiter.
+func WithLogForwarder(forwarder *logforward.Forwarder) LogHandlerOption {
+	return func(o *logHandlerOptions) {
+		o.forwarder = forwarder
+	}
+}
+
 // WithLogHandlerCleanupCallback sets a callback that is called after cleanup with the number of deleted rows.
 func WithLogHandlerCleanupCallback(cb func(int)) LogHandlerOption {
 	return func(o *logHandlerOptions) {
@@ -77,6 +87,7 @@ func NewLogHandler(secondaryStorageDB *sqlitexx.Pool, machineMap *MachineMap, om
 		cache:      cache,
 		logger:     logger,
 		limiter:    options.limiter,
+		forwarder:  options.forwarder,
 		ingestedBytes: prometheus.NewCounter(prometheus.CounterOpts{
 			Namespace: "omni",
 			Subsystem: "machine_logs",
@@ -95,6 +106,7 @@ type LogHandler struct {
 	logger        *zap.Logger
 	cache         *MachineCache
 	limiter       *LogIngestionLimiter
+	forwarder     *logforward.Forwarder
 	ingestedBytes prometheus.Counter
 }
 
@@ -120,6 +132,12 @@ func (h *LogHandler) Start(ctx context.Context) error {
 		return h.cache.Run(ctx)
 	})
 
+	if h.forwarder != nil {
+		eg.Go(func() error {
+			return h.forwarder.Run(ctx)
+		})
+	}
+
 	eg.Go(func() error {
 		eventCh := make(chan state.Event)
 
@@ -215,13 +233,21 @@ func (h *LogHandler) writeMessage(ctx context.Context, ip string, data []byte) e
 		return fmt.Errorf("failed to get machine ID for ip address %q: %w", ip, err)
 	}
 
+	now := time.Now()
+	service := logstore.MessageService(data)
+
+	// the forwarders buffer and drop the lines on their own, so the lines are forwarded before the ingestion limit of the local storage is applied
+	if h.forwarder != nil {
+		h.forwarder.Forward(string(id), data, service, now)
+	}
+
 	if h.limiter != nil {
-		if !h.limiter.Allow(time.Now(), id, len(data)) {
+		if !h.limiter.Allow(now, id, len(data)) {
 			return nil
 		}
 	}
 
-	err = h.cache.WriteMessage(ctx, id, data)
+	err = h.cache.WriteMessage(ctx, id, data, service)
 	if err != nil {
 		return fmt.Errorf("failed to write message to log store for machine %q: %w", id, err)
 	}
@@ -271,6 +297,16 @@ func (h *LogHandler) GetReader(ctx context.Context, machineID MachineID, follow
 	return reader, nil
 }
 
+// QueryLogs returns a reader of the log lines of multiple machines matching the query.
+func (h *LogHandler) QueryLogs(ctx context.Context, query logstore.Query) (logstore.EntryReader, error) {
+	reader, err := h.cache.query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query logs: %w", err)
+	}
+
+	return reader, nil
+}
+
 // trimNewlines trims a newline from the start and from end of a byte slice.
 func trimNewlines(data []byte) []byte {
 	if len(data) == 0 {
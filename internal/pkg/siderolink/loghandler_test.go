@@ -9,6 +9,7 @@ import (
 	"context"
 	"net/netip"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/siderolabs/omni/internal/backend/runtime/omni/sqlite"
 	"github.com/siderolabs/omni/internal/pkg/config"
 	"github.com/siderolabs/omni/internal/pkg/siderolink"
+	"github.com/siderolabs/omni/internal/pkg/siderolink/logforward"
 )
 
 func TestLogHandler_HandleMessage(t *testing.T) {
@@ -172,6 +174,50 @@ func TestLogHandler_IngestedBytesMetric(t *testing.T) {
 	})
 }
 
+type nopSink struct{}
+
+func (nopSink) Name() string { return "nop" }
+
+func (nopSink) Send(context.Context, []logforward.Line) error { return nil }
+
+func TestLogHandler_Forwarding(t *testing.T) {
+	storageConfig := config.LogsMachine{}
+
+	machineMap := siderolink.NewMachineMap(&siderolink.MapStorage{
+		IPToMachine: map[string]siderolink.MachineID{
+			"1.2.3.4": "machine1",
+		},
+	})
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	// the forwarder is not running, so the forwarded lines stay in its buffer
+	forwarder := logforward.NewForwarder(st, []logforward.Destination{{Sink: nopSink{}}}, zaptest.NewLogger(t))
+	limiter := siderolink.NewLogIngestionLimiter(1, 10, zaptest.NewLogger(t))
+
+	handler, err := siderolink.NewLogHandler(testDB(t), machineMap, st, &storageConfig, zaptest.NewLogger(t),
+		siderolink.WithLogIngestionLimiter(limiter),
+		siderolink.WithLogForwarder(forwarder),
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	t.Cleanup(cancel)
+
+	handler.HandleMessage(ctx, netip.MustParseAddr("1.2.3.4"), []byte("0123456789"))
+	handler.HandleMessage(ctx, netip.MustParseAddr("1.2.3.4"), []byte("x"))
+
+	// the line rejected by the rate limiter is forwarded too
+	expected := `
+# HELP omni_machine_logs_forwarding_buffered_lines Number of machine log lines waiting in the buffer of the log forwarding sinks.
+# TYPE omni_machine_logs_forwarding_buffered_lines gauge
+omni_machine_logs_forwarding_buffered_lines{sink="nop"} 2
+`
+
+	assert.NoError(t, testutil.CollectAndCompare(forwarder, strings.NewReader(expected), "omni_machine_logs_forwarding_buffered_lines"))
+	assert.InDelta(t, 10, testutil.ToFloat64(handler), 0.01)
+}
+
 func testDB(t *testing.T) *sqlitexx.Pool {
 	t.Helper()
 
@@ -9,11 +9,14 @@ package logstore
 import (
 	"context"
 	"io"
+	"regexp"
+	"time"
 )
 
 // LogStore is an interface for writing logs and getting readers to read them.
 type LogStore interface {
-	WriteLine(ctx context.Context, message []byte) error
+	// WriteLine writes the message sent by the Talos service, which is empty for the other messages, e.g. the kernel ones.
+	WriteLine(ctx context.Context, message []byte, service string) error
 
 	// Reader returns a reader starting from N lines before the end.
 	//
@@ -36,3 +39,48 @@ type LineReader interface {
 	// Blocks if follow=true and end is reached, until a new line is available or context is done.
 	ReadLine(ctx context.Context) ([]byte, error)
 }
+
+// Query selects the log lines of multiple machines.
+type Query struct {
+	// Since limits the lines to the ones received at or after the given time, if set.
+	Since time.Time
+
+	// Until limits the lines to the ones received before the given time, if set.
+	Until time.Time
+
+	// Pattern limits the lines to the ones matching the regular expression, if set.
+	Pattern *regexp.Regexp
+
+	// MachineIDs are the machines to read the logs of.
+	MachineIDs []string
+
+	// Services limits the lines to the ones of the given Talos services, if set.
+	Services []string
+
+	// TailLines is the number of the last matching lines to read, the lines are read from the very beginning if negative.
+	TailLines int
+
+	// Follow makes ReadEntry() block instead of returning EOF when catching up.
+	Follow bool
+}
+
+// Entry is a log line of a machine.
+type Entry struct {
+	// Time is the time the line was received at.
+	Time time.Time
+
+	MachineID string
+	Message   []byte
+}
+
+// EntryReader is an interface for reading the log lines of multiple machines in the order they were received.
+type EntryReader interface {
+	io.Closer
+
+	// ReadEntry reads the next line.
+	//
+	// Returns io.EOF if follow=false and end is reached.
+	//
+	// Blocks if follow=true and end is reached, until a new line is available or context is done.
+	ReadEntry(ctx context.Context) (Entry, error)
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package logstore
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// serviceKey is the quoted key of the Talos service in the JSON log messages.
+var serviceKey = []byte(`"talos-service"`)
+
+// MessageService returns the Talos service which has sent the log message, or an empty string for the other messages, e.g. the kernel ones.
+//
+// It is called for every received line, so the message is not decoded: the quotes are escaped inside the JSON strings,
+// so the quoted key followed by a colon can only be the key itself, and only its value is decoded.
+func MessageService(message []byte) string {
+	idx := bytes.Index(message, serviceKey)
+	if idx < 0 {
+		return ""
+	}
+
+	value, ok := bytes.CutPrefix(bytes.TrimLeft(message[idx+len(serviceKey):], " \t\r\n"), []byte(":"))
+	if !ok {
+		return ""
+	}
+
+	value = bytes.TrimLeft(value, " \t\r\n")
+	if len(value) == 0 || value[0] != '"' {
+		return ""
+	}
+
+	escaped := false
+
+	for i := 1; i < len(value); i++ {
+		switch value[i] {
+		case '\\':
+			escaped = true
+			i++
+		case '"':
+			if !escaped {
+				return string(value[1:i])
+			}
+
+			var service string
+
+			if err := json.Unmarshal(value[:i+1], &service); err != nil {
+				return ""
+			}
+
+			return service
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package logstore_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/siderolabs/omni/internal/pkg/siderolink/logstore"
+)
+
+func TestMessageService(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		message  string
+		expected string
+	}{
+		{message: `{"msg":"hello","talos-service":"apid"}`, expected: "apid"},
+		{message: `{"talos-service" : "machined", "msg":"hello"}`, expected: "machined"},
+		{message: `{"talos-service":"ext-\"quoted\"!"}`, expected: `ext-"quoted"!`},
+		{message: `{"msg":"\"talos-service\":\"apid\""}`},
+		{message: `{"msg":"talos-service"}`},
+		{message: `{"talos-service":1}`},
+		{message: `{"talos-service":"unterminated`},
+		{message: `[    1.000000] kernel line`},
+	} {
+		assert.Equal(t, tt.expected, logstore.MessageService([]byte(tt.message)), tt.message)
+	}
+}
@@ -9,7 +9,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
+	"sync"
 	"text/template"
 	"time"
 
@@ -31,6 +33,7 @@ const (
 	machineIDColumn = "machine_id"
 	createdAtColumn = "created_at"
 	messageColumn   = "message"
+	serviceColumn   = "service"
 
 	// cleanupBatchSize is the maximum number of rows deleted per batch in cleanup operations.
 	cleanupBatchSize = 1000
@@ -48,11 +51,13 @@ func WithCleanupCallback(cb func(int)) StoreManagerOption {
 
 // StoreManager manages log stores for machines.
 type StoreManager struct {
-	state     state.State
-	db        *sqlitexx.Pool
-	logger    *zap.Logger
-	onCleanup func(int)
-	config    config.LogsMachineStorage
+	state       state.State
+	db          *sqlitexx.Pool
+	logger      *zap.Logger
+	onCleanup   func(int)
+	subscribers []chan struct{}
+	config      config.LogsMachineStorage
+	mu          sync.Mutex
 }
 
 // Run implements the LogStoreManager interface.
@@ -475,19 +480,32 @@ const schemaTmpl = `
       {{.IDColumn}}        INTEGER PRIMARY KEY,
       {{.MachineIDColumn}} TEXT    NOT NULL,
       {{.MessageColumn}}   BLOB    NOT NULL,
-      {{.CreatedAtColumn}} INTEGER NOT NULL
+      {{.CreatedAtColumn}} INTEGER NOT NULL,
+      {{.ServiceColumn}}   TEXT    NOT NULL DEFAULT ''
     ) STRICT;
 
     CREATE INDEX IF NOT EXISTS idx_{{.TableName}}_{{.MachineIDColumn}} 
     ON {{.TableName}}({{.MachineIDColumn}}, {{.IDColumn}});
 `
 
+// queryIndexesTmpl creates the indexes used by the queries of the logs of multiple machines.
+//
+// It is applied after the service column is added to the tables created before the column was introduced.
+const queryIndexesTmpl = `
+    CREATE INDEX IF NOT EXISTS idx_{{.TableName}}_{{.MachineIDColumn}}_{{.CreatedAtColumn}}
+    ON {{.TableName}}({{.MachineIDColumn}}, {{.CreatedAtColumn}});
+
+    CREATE INDEX IF NOT EXISTS idx_{{.TableName}}_{{.MachineIDColumn}}_{{.ServiceColumn}}
+    ON {{.TableName}}({{.MachineIDColumn}}, {{.ServiceColumn}}, {{.IDColumn}});
+`
+
 type schemaParams struct {
 	TableName       string
 	IDColumn        string
 	MachineIDColumn string
 	MessageColumn   string
 	CreatedAtColumn string
+	ServiceColumn   string
 }
 
 // NewStoreManager creates a new StoreManager.
@@ -498,21 +516,19 @@ func NewStoreManager(ctx context.Context, db *sqlitexx.Pool, config config.LogsM
 		MachineIDColumn: machineIDColumn,
 		MessageColumn:   messageColumn,
 		CreatedAtColumn: createdAtColumn,
+		ServiceColumn:   serviceColumn,
 	}
 
-	tmpl, err := template.New("schema").Parse(schemaTmpl)
+	schemaSQL, err := renderSchema(schemaTmpl, templateParams)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse sqlite log table schema template: %w", err)
+		return nil, fmt.Errorf("failed to render sqlite log table schema: %w", err)
 	}
 
-	var sb strings.Builder
-
-	if err = tmpl.Execute(&sb, templateParams); err != nil {
-		return nil, fmt.Errorf("failed to execute sqlite log table schema template: %w", err)
+	queryIndexesSQL, err := renderSchema(queryIndexesTmpl, templateParams)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render sqlite log table query indexes: %w", err)
 	}
 
-	schemaSQL := sb.String()
-
 	conn, err := db.Take(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to take connection from pool: %w", err)
@@ -524,6 +540,14 @@ func NewStoreManager(ctx context.Context, db *sqlitexx.Pool, config config.LogsM
 		return nil, fmt.Errorf("failed to create sqlite log table schema: %w", err)
 	}
 
+	if err = addServiceColumn(conn); err != nil {
+		return nil, err
+	}
+
+	if err = sqlitex.ExecScript(conn, queryIndexesSQL); err != nil {
+		return nil, fmt.Errorf("failed to create sqlite log table query indexes: %w", err)
+	}
+
 	mgr := &StoreManager{
 		config: config,
 		db:     db,
@@ -538,12 +562,95 @@ func NewStoreManager(ctx context.Context, db *sqlitexx.Pool, config config.LogsM
 	return mgr, nil
 }
 
+func renderSchema(schemaTemplate string, params schemaParams) (string, error) {
+	tmpl, err := template.New("schema").Parse(schemaTemplate)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	var sb strings.Builder
+
+	if err = tmpl.Execute(&sb, params); err != nil {
+		return "", fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	return sb.String(), nil
+}
+
+// addServiceColumn adds the service column to the log table created before the column was introduced.
+func addServiceColumn(conn *sqlite.Conn) error {
+	q, err := sqlitexx.NewQuery(conn, fmt.Sprintf("SELECT COUNT(*) FROM pragma_table_info('%s') WHERE name = $column", TableName))
+	if err != nil {
+		return fmt.Errorf("failed to prepare sqlite statement: %w", err)
+	}
+
+	var count int64
+
+	if err = q.
+		BindString("$column", serviceColumn).
+		QueryRow(func(stmt *sqlite.Stmt) error {
+			count = stmt.ColumnInt64(0)
+
+			return nil
+		}); err != nil {
+		return fmt.Errorf("failed to query sqlite log table columns: %w", err)
+	}
+
+	if count > 0 {
+		return nil
+	}
+
+	if err = sqlitex.ExecScript(conn, fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s TEXT NOT NULL DEFAULT ''", TableName, serviceColumn)); err != nil {
+		return fmt.Errorf("failed to add the service column to the sqlite log table: %w", err)
+	}
+
+	return nil
+}
+
 // Create implements the LogStoreManager interface.
 func (m *StoreManager) Create(id string) (logstore.LogStore, error) {
-	var opts []StoreOption
+	opts := []StoreOption{WithStoreWriteCallback(m.notify)}
 	if m.onCleanup != nil {
 		opts = append(opts, WithStoreCleanupCallback(m.onCleanup))
 	}
 
 	return NewStore(m.config, m.db, id, m.logger, opts...)
 }
+
+// notify wakes up the followed readers of the logs of multiple machines.
+func (m *StoreManager) notify() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, ch := range m.subscribers {
+		select {
+		case ch <- struct{}{}:
+		default:
+			// channel is full, reader is already notified
+		}
+	}
+}
+
+func (m *StoreManager) subscribe() chan struct{} {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ch := make(chan struct{}, 1)
+
+	m.subscribers = append(m.subscribers, ch)
+
+	return ch
+}
+
+func (m *StoreManager) unsubscribe(ch chan struct{}) {
+	if ch == nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.subscribers = slices.DeleteFunc(m.subscribers, func(c chan struct{}) bool {
+		return c == ch
+	})
+}
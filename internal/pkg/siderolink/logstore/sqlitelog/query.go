@@ -0,0 +1,333 @@
+// Copyright (c) 2026 Sidero Labs, Inc.
+//
+// Use of this software is governed by the Business Source License
+// included in the LICENSE file.
+
+package sqlitelog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/state-sqlite/pkg/sqlitexx"
+	zombiesqlite "zombiezen.com/go/sqlite"
+
+	"github.com/siderolabs/omni/client/pkg/panichandler"
+	"github.com/siderolabs/omni/internal/pkg/siderolink/logstore"
+)
+
+// patternFunction is the name of the SQLite function which matches the log messages against the pattern of the query.
+const patternFunction = "omni_log_pattern_match"
+
+// Query implements the LogStoreManager interface.
+//
+// The lines are read in the order they were received in, which interleaves the lines of the machines by the time they were received at.
+// The filters except for the pattern are served by the indexes of the log table.
+func (m *StoreManager) Query(ctx context.Context, query logstore.Query) (logstore.EntryReader, error) {
+	query.MachineIDs = truncateMachineIDs(query.MachineIDs)
+
+	var (
+		closeCh  = make(chan struct{})
+		followCh chan struct{}
+	)
+
+	if query.Follow {
+		followCh = m.subscribe()
+
+		// Make sure that we unsubscribe when the context is done
+		panichandler.Go(func() {
+			select {
+			case <-closeCh: // normal close, reader is already unsubscribed
+				return
+			case <-ctx.Done():
+				m.unsubscribe(followCh)
+			}
+		}, m.logger)
+	}
+
+	cleanup := func() {
+		m.unsubscribe(followCh)
+		close(closeCh)
+	}
+
+	conn, err := m.db.Take(ctx)
+	if err != nil {
+		cleanup()
+
+		return nil, fmt.Errorf("failed to take connection from pool: %w", err)
+	}
+
+	reader := &entryReader{
+		manager:  m,
+		conn:     conn,
+		query:    query,
+		followCh: followCh,
+		closeCh:  closeCh,
+	}
+
+	if err = reader.init(); err != nil {
+		m.db.Put(conn)
+		cleanup()
+
+		return nil, err
+	}
+
+	return reader, nil
+}
+
+type entryReader struct {
+	manager   *StoreManager
+	conn      *zombiesqlite.Conn
+	next      func() (*zombiesqlite.Stmt, error, bool)
+	stop      func()
+	followCh  chan struct{}
+	closeCh   chan struct{}
+	query     logstore.Query
+	lastLogID int64
+	closeOnce sync.Once
+}
+
+// init registers the pattern function on the connection, determines the ID the reading starts after and queries the first batch of logs.
+func (r *entryReader) init() error {
+	if r.query.Pattern != nil {
+		pattern := r.query.Pattern
+
+		if err := r.conn.CreateFunction(patternFunction, &zombiesqlite.FunctionImpl{
+			NArgs:         1,
+			Deterministic: true,
+			Scalar: func(_ zombiesqlite.Context, args []zombiesqlite.Value) (zombiesqlite.Value, error) {
+				if pattern.Match(args[0].Blob()) {
+					return zombiesqlite.IntegerValue(1), nil
+				}
+
+				return zombiesqlite.IntegerValue(0), nil
+			},
+		}); err != nil {
+			return fmt.Errorf("failed to register the pattern function: %w", err)
+		}
+	}
+
+	var err error
+
+	switch {
+	case r.query.TailLines == 0:
+		// no lines are requested, start with the lines written from now on
+		r.lastLogID, err = r.queryID(fmt.Sprintf("SELECT COALESCE(MAX(%s), 0) FROM %s", idColumn, TableName), false)
+	case r.query.TailLines > 0:
+		var startID int64
+
+		// Do COALESCE to return 0 if there are no matching logs
+		startID, err = r.queryID(fmt.Sprintf("SELECT COALESCE(MIN(id), 0) FROM (SELECT %s AS id FROM %s WHERE %s ORDER BY %s DESC LIMIT $limit)",
+			idColumn, TableName, r.conditions(), idColumn), true)
+
+		r.lastLogID = max(startID-1, 0)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to determine start ID: %w", err)
+	}
+
+	r.next, r.stop, err = r.rowsAfter()
+	if err != nil {
+		return fmt.Errorf("failed to query logs: %w", err)
+	}
+
+	return nil
+}
+
+// ReadEntry implements the logstore.EntryReader interface.
+func (r *entryReader) ReadEntry(ctx context.Context) (logstore.Entry, error) {
+	for {
+		result, err, ok := r.next()
+		if err != nil {
+			// this error is returned when the context is canceled (via pool.Take(ctx))
+			if zombiesqlite.ErrCode(err) == zombiesqlite.ResultInterrupt {
+				return logstore.Entry{}, io.EOF
+			}
+
+			return logstore.Entry{}, fmt.Errorf("failed to read next log message: %w", err)
+		}
+
+		if ok {
+			r.lastLogID = result.GetInt64(idColumn)
+
+			message := make([]byte, result.GetLen(messageColumn))
+			result.GetBytes(messageColumn, message)
+
+			return logstore.Entry{
+				Time:      time.Unix(result.GetInt64(createdAtColumn), 0),
+				MachineID: result.GetText(machineIDColumn),
+				Message:   message,
+			}, nil
+		}
+
+		// Current batch is exhausted; wait for the next batch of logs.
+		if err = r.fetchNextBatch(ctx); err != nil {
+			return logstore.Entry{}, err
+		}
+	}
+}
+
+// fetchNextBatch closes the current exhausted result set, waits for a notification about the new logs and queries them.
+func (r *entryReader) fetchNextBatch(ctx context.Context) error {
+	r.stop()
+	r.stop = nil
+
+	if r.followCh == nil {
+		return io.EOF
+	}
+
+	select {
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.Canceled) {
+			return io.EOF
+		}
+
+		return ctx.Err()
+	case _, ok := <-r.followCh:
+		if !ok {
+			return io.EOF
+		}
+	}
+
+	var err error
+
+	r.next, r.stop, err = r.rowsAfter()
+	if err != nil {
+		// this error is returned when the context is canceled (via pool.Take(ctx))
+		if zombiesqlite.ErrCode(err) == zombiesqlite.ResultInterrupt {
+			return io.EOF
+		}
+
+		return fmt.Errorf("failed to query new logs: %w", err)
+	}
+
+	return nil
+}
+
+// Close implements the logstore.EntryReader interface.
+func (r *entryReader) Close() error {
+	if r.stop != nil {
+		r.stop()
+	}
+
+	r.closeOnce.Do(func() {
+		r.manager.db.Put(r.conn)
+		r.manager.unsubscribe(r.followCh)
+		close(r.closeCh)
+	})
+
+	return nil
+}
+
+// conditions returns the WHERE clause selecting the logs matching the query.
+func (r *entryReader) conditions() string {
+	conditions := []string{fmt.Sprintf("%s IN (%s)", machineIDColumn, listParams("$machine_id", len(r.query.MachineIDs)))}
+
+	if !r.query.Since.IsZero() {
+		conditions = append(conditions, createdAtColumn+" >= $since")
+	}
+
+	if !r.query.Until.IsZero() {
+		conditions = append(conditions, createdAtColumn+" < $until")
+	}
+
+	if len(r.query.Services) > 0 {
+		conditions = append(conditions, fmt.Sprintf("%s IN (%s)", serviceColumn, listParams("$service", len(r.query.Services))))
+	}
+
+	if r.query.Pattern != nil {
+		conditions = append(conditions, fmt.Sprintf("%s(%s)", patternFunction, messageColumn))
+	}
+
+	return strings.Join(conditions, " AND ")
+}
+
+// bind binds the parameters of the conditions to the query.
+func (r *entryReader) bind(q *sqlitexx.Query) *sqlitexx.Query {
+	for i, id := range r.query.MachineIDs {
+		q = q.BindString(fmt.Sprintf("$machine_id_%d", i), id)
+	}
+
+	if !r.query.Since.IsZero() {
+		q = q.BindInt64("$since", r.query.Since.Unix())
+	}
+
+	if !r.query.Until.IsZero() {
+		q = q.BindInt64("$until", r.query.Until.Unix())
+	}
+
+	for i, service := range r.query.Services {
+		q = q.BindString(fmt.Sprintf("$service_%d", i), service)
+	}
+
+	return q
+}
+
+func (r *entryReader) queryID(query string, withConditions bool) (int64, error) {
+	q, err := sqlitexx.NewQuery(r.conn, query)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prepare sqlite statement: %w", err)
+	}
+
+	if withConditions {
+		q = r.bind(q).BindInt("$limit", r.query.TailLines)
+	}
+
+	var id int64
+
+	if err = q.QueryRow(func(stmt *zombiesqlite.Stmt) error {
+		id = stmt.ColumnInt64(0)
+
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (r *entryReader) rowsAfter() (func() (*zombiesqlite.Stmt, error, bool), func(), error) {
+	query := fmt.Sprintf("SELECT %s, %s, %s, %s FROM %s WHERE %s AND %s > $last_log_id ORDER BY %s ASC",
+		idColumn, machineIDColumn, messageColumn, createdAtColumn, TableName, r.conditions(), idColumn, idColumn)
+
+	q, err := sqlitexx.NewQuery(r.conn, query)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to prepare sqlite statement: %w", err)
+	}
+
+	it := r.bind(q).
+		BindInt64("$last_log_id", r.lastLogID).
+		QueryIter()
+
+	next, stop := iter.Pull2(it)
+
+	return next, stop, nil
+}
+
+// listParams returns the comma separated list of n parameters with the given prefix.
+func listParams(prefix string, n int) string {
+	params := make([]string, 0, n)
+
+	for i := range n {
+		params = append(params, fmt.Sprintf("%s_%d", prefix, i))
+	}
+
+	return strings.Join(params, ", ")
+}
+
+func truncateMachineIDs(ids []string) []string {
+	truncated := make([]string, 0, len(ids))
+
+	for _, id := range ids {
+		truncated = append(truncated, truncateMachineID(id))
+	}
+
+	return truncated
+}
@@ -51,6 +51,13 @@ func WithStoreCleanupCallback(cb func(int)) StoreOption {
 	}
 }
 
+// WithStoreWriteCallback sets a callback that is called after each written log message.
+func WithStoreWriteCallback(cb func()) StoreOption {
+	return func(s *Store) {
+		s.onWrite = cb
+	}
+}
+
 // NewStore creates a new Store.
 func NewStore(config config.LogsMachineStorage, db *sqlitexx.Pool, id string, logger *zap.Logger, opts ...StoreOption) (*Store, error) {
 	sqliteTimeout := config.GetSqliteTimeout()
@@ -79,6 +86,7 @@ type Store struct {
 	db            *sqlitexx.Pool
 	logger        *zap.Logger
 	onCleanup     func(int)
+	onWrite       func()
 	id            string
 	subscribers   []chan struct{}
 	mu            sync.Mutex
@@ -87,7 +95,7 @@ type Store struct {
 }
 
 // WriteLine implements the logstore.LogStore interface.
-func (s *Store) WriteLine(ctx context.Context, message []byte) error {
+func (s *Store) WriteLine(ctx context.Context, message []byte, service string) error {
 	message = truncateMessage(message)
 
 	s.mu.Lock()
@@ -108,7 +116,8 @@ func (s *Store) WriteLine(ctx context.Context, message []byte) error {
 
 		defer s.db.Put(conn)
 
-		query := fmt.Sprintf(`INSERT INTO %s (%s, %s, %s) VALUES ($machine_id, $message, $created_at)`, TableName, machineIDColumn, messageColumn, createdAtColumn)
+		query := fmt.Sprintf(`INSERT INTO %s (%s, %s, %s, %s) VALUES ($machine_id, $message, $created_at, $service)`,
+			TableName, machineIDColumn, messageColumn, createdAtColumn, serviceColumn)
 
 		q, err := sqlitexx.NewQuery(conn, query)
 		if err != nil {
@@ -119,6 +128,7 @@ func (s *Store) WriteLine(ctx context.Context, message []byte) error {
 			BindString("$machine_id", s.id).
 			BindBytes("$message", message).
 			BindInt64("$created_at", time.Now().Unix()).
+			BindString("$service", service).
 			Exec()
 		if err != nil {
 			return fmt.Errorf("failed to write log message: %w", err)
@@ -136,6 +146,10 @@ func (s *Store) WriteLine(ctx context.Context, message []byte) error {
 		}
 	}
 
+	if s.onWrite != nil {
+		s.onWrite()
+	}
+
 	if len(s.subscribers) == 0 {
 		return nil
 	}
@@ -7,10 +7,12 @@ package sqlitelog_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"path/filepath"
+	"regexp"
 	"sync"
 	"testing"
 	"time"
@@ -69,8 +71,8 @@ func TestReadWrite(t *testing.T) {
 	numLines := 1000
 
 	for i := range numLines {
-		require.NoError(t, sqliteStore1.WriteLine(ctx, fmt.Appendf(nil, "Hello, World %d!", i)))
-		require.NoError(t, sqliteStore2.WriteLine(ctx, fmt.Appendf(nil, "Hello, World %d!", i)))
+		require.NoError(t, sqliteStore1.WriteLine(ctx, fmt.Appendf(nil, "Hello, World %d!", i), ""))
+		require.NoError(t, sqliteStore2.WriteLine(ctx, fmt.Appendf(nil, "Hello, World %d!", i), ""))
 	}
 
 	t.Run("read all", func(t *testing.T) {
@@ -102,8 +104,8 @@ func TestFollow(t *testing.T) {
 		require.NoError(t, store.Close())
 	})
 
-	require.NoError(t, store.WriteLine(ctx, []byte("Hello, World 1!")))
-	require.NoError(t, store.WriteLine(ctx, []byte("Hello, World 2!")))
+	require.NoError(t, store.WriteLine(ctx, []byte("Hello, World 1!"), ""))
+	require.NoError(t, store.WriteLine(ctx, []byte("Hello, World 2!"), ""))
 
 	rdr, err := store.Reader(ctx, -1, true)
 	require.NoError(t, err)
@@ -119,8 +121,8 @@ func TestFollow(t *testing.T) {
 	assertLine(ctx, t, lineCh, "Hello, World 1!")
 	assertLine(ctx, t, lineCh, "Hello, World 2!")
 
-	require.NoError(t, store.WriteLine(ctx, []byte("Hello, World 3!")))
-	require.NoError(t, store.WriteLine(ctx, []byte("Hello, World 4!")))
+	require.NoError(t, store.WriteLine(ctx, []byte("Hello, World 3!"), ""))
+	require.NoError(t, store.WriteLine(ctx, []byte("Hello, World 4!"), ""))
 
 	assertLine(ctx, t, lineCh, "Hello, World 3!")
 	assertLine(ctx, t, lineCh, "Hello, World 4!")
@@ -157,7 +159,7 @@ func TestTruncation(t *testing.T) {
 	// 2. Write the huge message immediately.
 	// This populates the DB so Exists() can return true,
 	// and allows us to test message truncation simultaneously.
-	err = store.WriteLine(ctx, hugeMessage)
+	err = store.WriteLine(ctx, hugeMessage, "")
 	require.NoError(t, err)
 
 	// 3. Test Machine ID Truncation (in Manager)
@@ -196,7 +198,7 @@ func TestManagerLifecycle(t *testing.T) {
 	// 2. Create and write.
 	store, err := storeManager.Create(id)
 	require.NoError(t, err)
-	require.NoError(t, store.WriteLine(ctx, []byte("log data")))
+	require.NoError(t, store.WriteLine(ctx, []byte("log data"), ""))
 
 	require.NoError(t, store.Close())
 
@@ -245,7 +247,7 @@ func TestReaderParameters(t *testing.T) {
 
 	// Write 10 lines.
 	for i := range 10 {
-		require.NoError(t, store.WriteLine(ctx, fmt.Appendf(nil, "line %d", i)))
+		require.NoError(t, store.WriteLine(ctx, fmt.Appendf(nil, "line %d", i), ""))
 	}
 
 	tests := []struct {
@@ -312,8 +314,8 @@ func TestFollowNoHistory(t *testing.T) {
 	})
 
 	// 1. Write historical data.
-	require.NoError(t, store.WriteLine(ctx, []byte("history 1")))
-	require.NoError(t, store.WriteLine(ctx, []byte("history 2")))
+	require.NoError(t, store.WriteLine(ctx, []byte("history 1"), ""))
+	require.NoError(t, store.WriteLine(ctx, []byte("history 2"), ""))
 
 	// 2. Request 0 lines, but follow=true.
 	rdr, err := store.Reader(ctx, 0, true)
@@ -325,7 +327,7 @@ func TestFollowNoHistory(t *testing.T) {
 
 	// 3. Write new data.
 	expected := "new 1"
-	require.NoError(t, store.WriteLine(ctx, []byte(expected)))
+	require.NoError(t, store.WriteLine(ctx, []byte(expected), ""))
 
 	// 4. Read the first line.
 	// Logic check: nLines=0 should skip "history 1/2" and stream "new 1" immediately.
@@ -352,7 +354,7 @@ func TestFollowTail(t *testing.T) {
 
 	// 1. Write 20 lines of history
 	for i := range 20 {
-		require.NoError(t, store.WriteLine(ctx, fmt.Appendf(nil, "history %d", i)))
+		require.NoError(t, store.WriteLine(ctx, fmt.Appendf(nil, "history %d", i), ""))
 	}
 
 	// 2. Request last 5 lines and follow
@@ -373,7 +375,7 @@ func TestFollowTail(t *testing.T) {
 	}
 
 	// 4. Write new data
-	require.NoError(t, store.WriteLine(ctx, []byte("new 1")))
+	require.NoError(t, store.WriteLine(ctx, []byte("new 1"), ""))
 
 	// 5. Verify we get the new line
 	assertLine(ctx, t, lineCh, "new 1")
@@ -420,7 +422,7 @@ func TestFollowRapidWrites(t *testing.T) {
 
 	eg.Go(func() error {
 		for i := range count {
-			if writeErr := store.WriteLine(ctx, fmt.Appendf(nil, "msg %d", i)); writeErr != nil {
+			if writeErr := store.WriteLine(ctx, fmt.Appendf(nil, "msg %d", i), ""); writeErr != nil {
 				return writeErr
 			}
 		}
@@ -514,7 +516,7 @@ func TestCleanupProbabilities(t *testing.T) {
 
 			// Perform Writes
 			for i := range tt.writes {
-				require.NoError(t, store.WriteLine(ctx, fmt.Appendf(nil, "msg-%d", i)))
+				require.NoError(t, store.WriteLine(ctx, fmt.Appendf(nil, "msg-%d", i), ""))
 			}
 
 			// Check Result
@@ -566,7 +568,7 @@ func TestGlobalSizeCleanup(t *testing.T) {
 		require.NoError(t, err)
 
 		for range linesPerMachine {
-			require.NoError(t, store.WriteLine(ctx, msg))
+			require.NoError(t, store.WriteLine(ctx, msg, ""))
 		}
 
 		require.NoError(t, store.Close())
@@ -623,7 +625,7 @@ func TestGlobalSizeCleanupWithGaps(t *testing.T) {
 
 	for range linesPerMachine {
 		for _, id := range machineIDs {
-			require.NoError(t, stores[id].WriteLine(ctx, msg))
+			require.NoError(t, stores[id].WriteLine(ctx, msg, ""))
 		}
 	}
 
@@ -766,8 +768,8 @@ func TestOrphanLogsCleanup(t *testing.T) {
 			store, storeErr := storeManager.Create(id)
 			require.NoError(t, storeErr)
 
-			require.NoError(t, store.WriteLine(ctx, fmt.Appendf(nil, "log line A for %s", id)))
-			require.NoError(t, store.WriteLine(ctx, fmt.Appendf(nil, "log line B for %s", id)))
+			require.NoError(t, store.WriteLine(ctx, fmt.Appendf(nil, "log line A for %s", id), ""))
+			require.NoError(t, store.WriteLine(ctx, fmt.Appendf(nil, "log line B for %s", id), ""))
 			require.NoError(t, store.Close())
 		}
 	}
@@ -814,6 +816,178 @@ func TestOrphanLogsCleanup(t *testing.T) {
 	checkExistence("gone", numNonExistent, false)
 }
 
+func TestQuery(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 15*time.Second)
+	t.Cleanup(cancel)
+
+	logger := zaptest.NewLogger(t)
+	storeManager, _ := setupDB(ctx, t, logger)
+
+	stores := map[string]logstore.LogStore{}
+
+	for _, id := range []string{"test-1", "test-2", "test-3"} {
+		store, err := storeManager.Create(id)
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			require.NoError(t, store.Close())
+		})
+
+		stores[id] = store
+	}
+
+	for i := range 3 {
+		for _, id := range []string{"test-1", "test-2", "test-3"} {
+			require.NoError(t, stores[id].WriteLine(ctx, fmt.Appendf(nil, `{"msg":"%s %d","talos-service":"machined"}`, id, i), "machined"))
+		}
+	}
+
+	require.NoError(t, stores["test-1"].WriteLine(ctx, []byte(`{"msg":"test-1 apid","talos-service":"apid"}`), "apid"))
+	require.NoError(t, stores["test-2"].WriteLine(ctx, []byte("not a JSON message"), ""))
+
+	for _, tt := range []struct {
+		name     string
+		query    logstore.Query
+		expected []string
+	}{
+		{
+			name:  "interleaved",
+			query: logstore.Query{MachineIDs: []string{"test-1", "test-2"}, TailLines: -1},
+			expected: []string{
+				"test-1 0", "test-2 0", "test-1 1", "test-2 1", "test-1 2", "test-2 2", "test-1 apid", "not a JSON message",
+			},
+		},
+		{
+			name:     "tail",
+			query:    logstore.Query{MachineIDs: []string{"test-1", "test-3"}, TailLines: 3},
+			expected: []string{"test-1 2", "test-3 2", "test-1 apid"},
+		},
+		{
+			name:     "service",
+			query:    logstore.Query{MachineIDs: []string{"test-1", "test-2", "test-3"}, TailLines: -1, Services: []string{"apid"}},
+			expected: []string{"test-1 apid"},
+		},
+		{
+			name:     "pattern",
+			query:    logstore.Query{MachineIDs: []string{"test-1", "test-2", "test-3"}, TailLines: 2, Pattern: regexp.MustCompile(`test-\d 1`)},
+			expected: []string{"test-2 1", "test-3 1"},
+		},
+		{
+			name:  "time range",
+			query: logstore.Query{MachineIDs: []string{"test-3"}, TailLines: -1, Since: time.Now().Add(-time.Hour), Until: time.Now().Add(time.Hour)},
+			expected: []string{
+				"test-3 0", "test-3 1", "test-3 2",
+			},
+		},
+		{
+			name:  "out of time range",
+			query: logstore.Query{MachineIDs: []string{"test-3"}, TailLines: -1, Until: time.Now().Add(-time.Hour)},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rdr, err := storeManager.Query(ctx, tt.query)
+			require.NoError(t, err)
+
+			t.Cleanup(func() {
+				require.NoError(t, rdr.Close())
+			})
+
+			var messages []string
+
+			for {
+				entry, err := rdr.ReadEntry(ctx)
+				if errors.Is(err, io.EOF) {
+					break
+				}
+
+				require.NoError(t, err)
+
+				messages = append(messages, entryMessage(entry))
+			}
+
+			assert.Equal(t, tt.expected, messages)
+		})
+	}
+}
+
+func TestQueryFollow(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 15*time.Second)
+	t.Cleanup(cancel)
+
+	logger := zaptest.NewLogger(t)
+	storeManager, _ := setupDB(ctx, t, logger)
+
+	store1, err := storeManager.Create("test-1")
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, store1.Close())
+	})
+
+	store2, err := storeManager.Create("test-2")
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, store2.Close())
+	})
+
+	require.NoError(t, store1.WriteLine(ctx, []byte("Hello, World 1!"), ""))
+
+	rdr, err := storeManager.Query(ctx, logstore.Query{MachineIDs: []string{"test-1", "test-2"}, TailLines: 0, Follow: true})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, rdr.Close())
+	})
+
+	var wg sync.WaitGroup
+
+	lineCh := make(chan string)
+
+	wg.Go(func() {
+		for {
+			entry, err := rdr.ReadEntry(ctx)
+			if err != nil {
+				return
+			}
+
+			select {
+			case lineCh <- entry.MachineID + ": " + string(entry.Message):
+			case <-ctx.Done():
+				return
+			}
+		}
+	})
+
+	require.NoError(t, store2.WriteLine(ctx, []byte("Hello, World 2!"), ""))
+	require.NoError(t, store1.WriteLine(ctx, []byte("Hello, World 3!"), ""))
+
+	assertLine(ctx, t, lineCh, "test-2: Hello, World 2!")
+	assertLine(ctx, t, lineCh, "test-1: Hello, World 3!")
+
+	cancel()
+
+	wg.Wait()
+}
+
+func entryMessage(entry logstore.Entry) string {
+	var message struct {
+		Msg string `json:"msg"`
+	}
+
+	if err := json.Unmarshal(entry.Message, &message); err != nil {
+		return string(entry.Message)
+	}
+
+	return message.Msg
+}
+
 func testRead(ctx context.Context, t *testing.T, sqliteStore logstore.LogStore, expectedLines, tailLines int) {
 	t.Helper()
 
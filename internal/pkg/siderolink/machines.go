@@ -92,13 +92,13 @@ func (m *MachineCache) initLocked(ctx context.Context) error {
 }
 
 // WriteMessage writes the message surrounded with '\n' to the log store for the given machine ID.
-func (m *MachineCache) WriteMessage(ctx context.Context, id MachineID, rawData []byte) error {
+func (m *MachineCache) WriteMessage(ctx context.Context, id MachineID, rawData []byte, service string) error {
 	logWriter, err := m.initAndGetLogStore(ctx, id)
 	if err != nil {
 		return err
 	}
 
-	return logWriter.WriteLine(ctx, rawData)
+	return logWriter.WriteLine(ctx, rawData, service)
 }
 
 // getLogStore returns the log backend for the given machine ID.
@@ -144,6 +144,15 @@ func (m *MachineCache) initAndGetLogStore(ctx context.Context, id MachineID) (lo
 	return val, nil
 }
 
+// query returns a reader of the log lines of multiple machines matching the query.
+func (m *MachineCache) query(ctx context.Context, query logstore.Query) (logstore.EntryReader, error) {
+	if err := m.initLocked(ctx); err != nil {
+		return nil, err
+	}
+
+	return m.machineLogStoreManager.Query(ctx, query)
+}
+
 // Remove removes the log store for the given machine ID.
 //
 // If storage is enabled, it also removes the logs from the storage.